@@ -56,6 +56,7 @@ import (
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/cosmos/cosmos-sdk/std"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
 	"github.com/cosmos/cosmos-sdk/version"
@@ -109,6 +110,7 @@ import (
 	appencoding "github.com/cosmos/interchain-security/v7/app/encoding"
 	testutil "github.com/cosmos/interchain-security/v7/testutil/integration"
 	consumer "github.com/cosmos/interchain-security/v7/x/ccv/consumer"
+	ccvconsumerclient "github.com/cosmos/interchain-security/v7/x/ccv/consumer/client"
 	consumerkeeper "github.com/cosmos/interchain-security/v7/x/ccv/consumer/keeper"
 	consumertypes "github.com/cosmos/interchain-security/v7/x/ccv/consumer/types"
 	ccvdistr "github.com/cosmos/interchain-security/v7/x/ccv/democracy/distribution"
@@ -441,9 +443,12 @@ func New(
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 		authcodec.NewBech32Codec(sdk.GetConfig().GetBech32ValidatorAddrPrefix()),
 		authcodec.NewBech32Codec(sdk.GetConfig().GetBech32ConsensusAddrPrefix()),
+		consumerkeeper.WithFeatureFlags(consumerkeeper.FeatureProviderClientUpdateFallback),
+		consumerkeeper.WithStandaloneTransitionHandler(NewStandaloneTransitionHandler(app.StakingKeeper, app.BankKeeper)),
 	)
 
 	// Setting the standalone staking keeper is only needed for standalone to consumer changeover chains
+	// and for the transition of the consumer chain to a standalone chain
 	app.ConsumerKeeper.SetStandaloneStakingKeeper(app.StakingKeeper)
 
 	// consumer keeper satisfies the staking keeper interface
@@ -456,6 +461,24 @@ func New(
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
+	ccvLogConfig, err := ccvtypes.LogConfigFromAppOptions(appOpts)
+	if err != nil {
+		panic(err)
+	}
+	app.ConsumerKeeper.SetLogConfig(ccvLogConfig)
+
+	// let the validators update the provider client with headers of the provider chain as a fallback
+	// when no relayer updated the client and it is about to expire
+	providerHeaderSource, err := ccvconsumerclient.ProviderHeaderSourceFromAppOptions(appOpts)
+	if err != nil {
+		panic(err)
+	}
+	defaultProposalHandler := baseapp.NewDefaultProposalHandler(mempool.NoOpMempool{}, bApp)
+	providerClientProposalHandler := consumerkeeper.NewProviderClientProposalHandler(app.ConsumerKeeper, providerHeaderSource,
+		defaultProposalHandler.PrepareProposalHandler(), defaultProposalHandler.ProcessProposalHandler())
+	bApp.SetPrepareProposal(providerClientProposalHandler.PrepareProposalHandler())
+	bApp.SetProcessProposal(providerClientProposalHandler.ProcessProposalHandler())
+
 	// register slashing module StakingHooks to the consumer keeper
 	app.ConsumerKeeper = *app.ConsumerKeeper.SetHooks(app.SlashingKeeper.Hooks())
 	consumerModule := consumer.NewAppModule(app.ConsumerKeeper, app.GetSubspace(consumertypes.ModuleName))
@@ -490,6 +513,11 @@ func New(
 		runtime.ProvideCometInfoService(),
 	)
 
+	// forward the double voting evidence submitted to the evidence module to the provider chain
+	evidenceRouter := evidencetypes.NewRouter().
+		AddRoute(consumertypes.RouteConsumerDoubleVote, consumerkeeper.NewDoubleVoteEvidenceHandler(app.ConsumerKeeper))
+	evidenceKeeper.SetRouter(evidenceRouter)
+
 	app.EvidenceKeeper = *evidenceKeeper
 
 	skipGenesisInvariants := cast.ToBool(appOpts.Get(crisis.FlagSkipGenesisInvariants))
@@ -515,7 +543,8 @@ func New(
 		mint.NewAppModule(appCodec, app.MintKeeper, app.AccountKeeper, nil, app.GetSubspace(minttypes.ModuleName)),
 		slashing.NewAppModule(appCodec, app.SlashingKeeper, app.AccountKeeper, app.BankKeeper, app.ConsumerKeeper, app.GetSubspace(slashingtypes.ModuleName), app.interfaceRegistry),
 		ccvdistr.NewAppModule(appCodec, app.DistrKeeper, app.AccountKeeper, app.BankKeeper, *app.StakingKeeper, authtypes.FeeCollectorName, app.GetSubspace(distrtypes.ModuleName)),
-		ccvstaking.NewAppModule(appCodec, app.StakingKeeper, app.AccountKeeper, app.BankKeeper, app.GetSubspace(stakingtypes.ModuleName)),
+		ccvstaking.NewAppModule(appCodec, app.StakingKeeper, app.AccountKeeper, app.BankKeeper, app.GetSubspace(stakingtypes.ModuleName)).
+			WithConsumerKeeper(&app.ConsumerKeeper),
 		upgrade.NewAppModule(&app.UpgradeKeeper, app.AccountKeeper.AddressCodec()),
 		evidence.NewAppModule(app.EvidenceKeeper),
 		params.NewAppModule(app.ParamsKeeper),
@@ -611,7 +640,7 @@ func New(
 
 	app.MM.RegisterInvariants(&app.CrisisKeeper)
 	app.configurator = module.NewConfigurator(app.appCodec, app.MsgServiceRouter(), app.GRPCQueryRouter())
-	err := app.MM.RegisterServices(app.configurator)
+	err = app.MM.RegisterServices(app.configurator)
 	if err != nil {
 		panic(err)
 	}
@@ -734,7 +763,9 @@ func New(
 // Name returns the name of the App
 func (app *App) Name() string { return app.BaseApp.Name() }
 
-func (app *App) PreBlocker(ctx sdk.Context, _ *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
+func (app *App) PreBlocker(ctx sdk.Context, req *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
+	// update the provider client with the header of the provider chain injected by the proposer, if any
+	app.ConsumerKeeper.ApplyProviderClientLaneTx(ctx, req.Txs)
 	return app.MM.PreBlock(ctx)
 }
 
@@ -938,6 +969,9 @@ func (app *App) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.APIConfig
 	// Register grpc-gateway routes for all modules.
 	ModuleBasics.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 
+	// Register the route returning the balance operations of the CCV module, e.g., for Rosetta and similar data APIs.
+	apiSvr.Router.HandleFunc(ccvconsumerclient.OperationsRoute, ccvconsumerclient.OperationsHandler(clientCtx)).Methods("GET")
+
 	// register swagger API from root so that other applications can override easily
 	if err := server.RegisterSwaggerAPI(apiSvr.ClientCtx, apiSvr.Router, apiConfig.Swagger); err != nil {
 		panic(err)
@@ -0,0 +1,140 @@
+package app
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	consumerkeeper "github.com/cosmos/interchain-security/v7/x/ccv/consumer/keeper"
+	consumertypes "github.com/cosmos/interchain-security/v7/x/ccv/consumer/types"
+)
+
+// NewStandaloneTransitionHandler returns the handler seeding the staking module on the transition
+// of the consumer chain to a standalone chain. For every delegation, the bonded tokens are minted
+// to the delegator, which becomes the operator of the validator with the consensus key of the CCV
+// validator, or delegates to this validator if it already exists. The delegated amount defaults to
+// the tokens corresponding to the voting power of the CCV validator. The validator set of the standalone
+// chain is the set of bonded validators of the staking module.
+func NewStandaloneTransitionHandler(
+	stakingKeeper *stakingkeeper.Keeper,
+	bankKeeper bankkeeper.Keeper,
+) consumerkeeper.StandaloneTransitionHandler {
+	return func(
+		ctx sdk.Context,
+		valset []consumertypes.CrossChainValidator,
+		delegations []consumertypes.StandaloneDelegation,
+	) ([]abci.ValidatorUpdate, error) {
+		msgServer := stakingkeeper.NewMsgServerImpl(stakingKeeper)
+		bondDenom, err := stakingKeeper.BondDenom(ctx)
+		if err != nil {
+			return nil, err
+		}
+		minCommissionRate, err := stakingKeeper.MinCommissionRate(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, delegation := range delegations {
+			consAddr, err := sdk.ConsAddressFromBech32(delegation.ConsensusAddress)
+			if err != nil {
+				return nil, err
+			}
+			delAddr, err := sdk.AccAddressFromBech32(delegation.DelegatorAddress)
+			if err != nil {
+				return nil, err
+			}
+			var ccvValidator *consumertypes.CrossChainValidator
+			for i := range valset {
+				if bytes.Equal(valset[i].Address, consAddr) {
+					ccvValidator = &valset[i]
+					break
+				}
+			}
+			if ccvValidator == nil {
+				return nil, fmt.Errorf("validator %s is not in the CCV validator set", delegation.ConsensusAddress)
+			}
+
+			amount := delegation.Amount
+			if amount.IsNil() || amount.IsZero() {
+				amount = stakingKeeper.TokensFromConsensusPower(ctx, ccvValidator.Power)
+			}
+			if !amount.IsPositive() {
+				return nil, fmt.Errorf("no tokens to delegate to validator %s", delegation.ConsensusAddress)
+			}
+			coin := sdk.NewCoin(bondDenom, amount)
+			if err := bankKeeper.MintCoins(ctx, minttypes.ModuleName, sdk.NewCoins(coin)); err != nil {
+				return nil, err
+			}
+			if err := bankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, delAddr, sdk.NewCoins(coin)); err != nil {
+				return nil, err
+			}
+
+			validator, err := stakingKeeper.GetValidatorByConsAddr(ctx, consAddr)
+			switch {
+			case err == nil:
+				// the validator was created by a previous delegation
+				_, err = msgServer.Delegate(ctx, stakingtypes.NewMsgDelegate(delegation.DelegatorAddress, validator.GetOperator(), coin))
+			case errors.Is(err, stakingtypes.ErrNoValidatorFound):
+				err = createStandaloneValidator(ctx, msgServer, *ccvValidator, delAddr, coin, minCommissionRate)
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		// the validator updates are the whole validator set of the standalone chain,
+		// since the staking module did not provide the validator set of the consumer chain
+		if _, err := stakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx); err != nil {
+			return nil, err
+		}
+		validators, err := stakingKeeper.GetBondedValidatorsByPower(ctx)
+		if err != nil {
+			return nil, err
+		}
+		powerReduction := stakingKeeper.PowerReduction(ctx)
+		valUpdates := make([]abci.ValidatorUpdate, 0, len(validators))
+		for _, validator := range validators {
+			valUpdates = append(valUpdates, validator.ABCIValidatorUpdate(powerReduction))
+		}
+		return valUpdates, nil
+	}
+}
+
+// createStandaloneValidator creates the validator with the consensus key of the CCV validator `ccvValidator`,
+// operated by `operator` and with the self-delegation `selfDelegation`
+func createStandaloneValidator(
+	ctx sdk.Context,
+	msgServer stakingtypes.MsgServer,
+	ccvValidator consumertypes.CrossChainValidator,
+	operator sdk.AccAddress,
+	selfDelegation sdk.Coin,
+	commissionRate math.LegacyDec,
+) error {
+	pubKey, err := ccvValidator.ConsPubKey()
+	if err != nil {
+		return err
+	}
+	msg, err := stakingtypes.NewMsgCreateValidator(
+		sdk.ValAddress(operator).String(),
+		pubKey,
+		selfDelegation,
+		stakingtypes.NewDescription(sdk.ConsAddress(ccvValidator.Address).String(), "", "", "", ""),
+		stakingtypes.NewCommissionRates(commissionRate, math.LegacyOneDec(), math.LegacyOneDec()),
+		math.OneInt(),
+	)
+	if err != nil {
+		return err
+	}
+	_, err = msgServer.CreateValidator(ctx, msg)
+	return err
+}
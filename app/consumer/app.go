@@ -53,6 +53,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/std"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata/testpb"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/auth"
@@ -91,6 +92,7 @@ import (
 	appencoding "github.com/cosmos/interchain-security/v7/app/encoding"
 	testutil "github.com/cosmos/interchain-security/v7/testutil/integration"
 	ibcconsumer "github.com/cosmos/interchain-security/v7/x/ccv/consumer"
+	ccvconsumerclient "github.com/cosmos/interchain-security/v7/x/ccv/consumer/client"
 	ibcconsumerkeeper "github.com/cosmos/interchain-security/v7/x/ccv/consumer/keeper"
 	ibcconsumertypes "github.com/cosmos/interchain-security/v7/x/ccv/consumer/types"
 	ccvtypes "github.com/cosmos/interchain-security/v7/x/ccv/types"
@@ -360,8 +362,27 @@ func New(
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 		authcodec.NewBech32Codec(sdk.GetConfig().GetBech32ValidatorAddrPrefix()),
 		authcodec.NewBech32Codec(sdk.GetConfig().GetBech32ConsensusAddrPrefix()),
+		ibcconsumerkeeper.WithFeatureFlags(ibcconsumerkeeper.FeatureProviderClientUpdateFallback),
 	)
 
+	ccvLogConfig, err := ccvtypes.LogConfigFromAppOptions(appOpts)
+	if err != nil {
+		panic(err)
+	}
+	app.ConsumerKeeper.SetLogConfig(ccvLogConfig)
+
+	// let the validators update the provider client with headers of the provider chain as a fallback
+	// when no relayer updated the client and it is about to expire
+	providerHeaderSource, err := ccvconsumerclient.ProviderHeaderSourceFromAppOptions(appOpts)
+	if err != nil {
+		panic(err)
+	}
+	defaultProposalHandler := baseapp.NewDefaultProposalHandler(mempool.NoOpMempool{}, bApp)
+	providerClientProposalHandler := ibcconsumerkeeper.NewProviderClientProposalHandler(app.ConsumerKeeper, providerHeaderSource,
+		defaultProposalHandler.PrepareProposalHandler(), defaultProposalHandler.ProcessProposalHandler())
+	bApp.SetPrepareProposal(providerClientProposalHandler.PrepareProposalHandler())
+	bApp.SetProcessProposal(providerClientProposalHandler.ProcessProposalHandler())
+
 	// register slashing module Slashing hooks to the consumer keeper
 	app.ConsumerKeeper = *app.ConsumerKeeper.SetHooks(app.SlashingKeeper.Hooks())
 	consumerModule := ibcconsumer.NewAppModule(app.ConsumerKeeper, app.GetSubspace(ibcconsumertypes.ModuleName))
@@ -396,6 +417,11 @@ func New(
 		runtime.ProvideCometInfoService(),
 	)
 
+	// forward the double voting evidence submitted to the evidence module to the provider chain
+	evidenceRouter := evidencetypes.NewRouter().
+		AddRoute(ibcconsumertypes.RouteConsumerDoubleVote, ibcconsumerkeeper.NewDoubleVoteEvidenceHandler(app.ConsumerKeeper))
+	evidenceKeeper.SetRouter(evidenceRouter)
+
 	app.EvidenceKeeper = *evidenceKeeper
 
 	skipGenesisInvariants := cast.ToBool(appOpts.Get(crisis.FlagSkipGenesisInvariants))
@@ -499,7 +525,7 @@ func New(
 
 	app.MM.RegisterInvariants(&app.CrisisKeeper)
 	app.configurator = module.NewConfigurator(app.appCodec, app.MsgServiceRouter(), app.GRPCQueryRouter())
-	err := app.MM.RegisterServices(app.configurator)
+	err = app.MM.RegisterServices(app.configurator)
 	if err != nil {
 		panic(err)
 	}
@@ -589,7 +615,9 @@ func New(
 // Name returns the name of the App
 func (app *App) Name() string { return app.BaseApp.Name() }
 
-func (app *App) PreBlocker(ctx sdk.Context, _ *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
+func (app *App) PreBlocker(ctx sdk.Context, req *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
+	// update the provider client with the header of the provider chain injected by the proposer, if any
+	app.ConsumerKeeper.ApplyProviderClientLaneTx(ctx, req.Txs)
 	return app.MM.PreBlock(ctx)
 }
 
@@ -770,6 +798,9 @@ func (app *App) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.APIConfig
 
 	// Register grpc query routes.
 	ModuleBasics.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
+
+	// Register the route returning the balance operations of the CCV module, e.g., for Rosetta and similar data APIs.
+	apiSvr.Router.HandleFunc(ccvconsumerclient.OperationsRoute, ccvconsumerclient.OperationsHandler(clientCtx)).Methods("GET")
 }
 
 // RegisterTxService implements the Application.RegisterTxService method.
@@ -0,0 +1,189 @@
+package app
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v10/modules/core/23-commitment/types"
+	ibctmtypes "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+	ccvtypes "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+const (
+	FlagProviderChainId = "provider-chain-id"
+	FlagConsumerId      = "consumer-id"
+	FlagUnbondingPeriod = "unbonding-period"
+	FlagTimestamp       = "timestamp"
+
+	// DefaultDevnetProviderChainId is the chain id of the fake provider chain
+	DefaultDevnetProviderChainId = "devnet-provider-1"
+)
+
+// devnetRoot is the commitment root of the consensus state of the fake provider chain
+var devnetRoot = sha256.Sum256([]byte("devnet provider, not for production"))
+
+// NewDevnetConsumerGenesisState returns the CCV part of the genesis of a new consumer chain
+// validated by `initialValSet`. The client and consensus states of the provider are synthesized
+// for a fake provider chain with chain id `providerChainId`, validated by the same validators.
+//
+// NOTE: The returned genesis state is solely meant for local development and CI. The consumer
+// chain cannot establish a CCV channel with a provider chain using it.
+func NewDevnetConsumerGenesisState(
+	providerChainId string,
+	consumerId string,
+	unbondingPeriod time.Duration,
+	timestamp time.Time,
+	initialValSet []abci.ValidatorUpdate,
+) (*ccvtypes.ConsumerGenesisState, error) {
+	if len(initialValSet) == 0 {
+		return nil, fmt.Errorf("empty validator set")
+	}
+	validators, err := cmttypes.PB2TM.ValidatorUpdates(initialValSet)
+	if err != nil {
+		return nil, fmt.Errorf("invalid validator set: %w", err)
+	}
+	valSet, err := cmttypes.ValidatorSetFromExistingValidators(validators)
+	if err != nil {
+		return nil, fmt.Errorf("invalid validator set: %w", err)
+	}
+
+	trustingPeriod, err := ccvtypes.CalculateTrustPeriod(unbondingPeriod, providertypes.DefaultTrustingPeriodFraction)
+	if err != nil {
+		return nil, fmt.Errorf("calculating trusting period: %w", err)
+	}
+
+	clientState := providertypes.DefaultTemplateClient()
+	clientState.ChainId = providerChainId
+	clientState.TrustingPeriod = trustingPeriod
+	clientState.UnbondingPeriod = unbondingPeriod
+	clientState.LatestHeight = clienttypes.NewHeight(clienttypes.ParseChainID(providerChainId), 1)
+
+	consensusState := ibctmtypes.NewConsensusState(
+		timestamp,
+		commitmenttypes.NewMerkleRoot(devnetRoot[:]),
+		valSet.Hash(),
+	)
+
+	params := ccvtypes.DefaultParams()
+	params.Enabled = true
+	params.ConsumerId = consumerId
+
+	gen := ccvtypes.NewInitialConsumerGenesisState(clientState, consensusState, initialValSet, false, "", params)
+	if err := gen.Validate(); err != nil {
+		return nil, err
+	}
+	return gen, nil
+}
+
+// DevnetConsumerGenesis reads the validator set from the validators file and writes
+// the resulting devnet consumer genesis to the output of the command
+func DevnetConsumerGenesis(cmd *cobra.Command, args []string) error {
+	clientCtx := client.GetClientContextFromCmd(cmd)
+
+	bz, err := os.ReadFile(filepath.Clean(args[0]))
+	if err != nil {
+		return err
+	}
+	var rawValidators []json.RawMessage
+	if err := json.Unmarshal(bz, &rawValidators); err != nil {
+		return fmt.Errorf("parsing validators file: %w", err)
+	}
+	initialValSet := make([]abci.ValidatorUpdate, 0, len(rawValidators))
+	for i, rawValidator := range rawValidators {
+		var validator abci.ValidatorUpdate
+		if err := clientCtx.Codec.UnmarshalJSON(rawValidator, &validator); err != nil {
+			return fmt.Errorf("parsing validator %d: %w", i, err)
+		}
+		initialValSet = append(initialValSet, validator)
+	}
+
+	providerChainId, err := cmd.Flags().GetString(FlagProviderChainId)
+	if err != nil {
+		return err
+	}
+	consumerId, err := cmd.Flags().GetString(FlagConsumerId)
+	if err != nil {
+		return err
+	}
+	unbondingPeriod, err := cmd.Flags().GetDuration(FlagUnbondingPeriod)
+	if err != nil {
+		return err
+	}
+	timestamp := time.Now().UTC()
+	timestampStr, err := cmd.Flags().GetString(FlagTimestamp)
+	if err != nil {
+		return err
+	}
+	if timestampStr != "" {
+		if timestamp, err = time.Parse(time.RFC3339, timestampStr); err != nil {
+			return fmt.Errorf("parsing timestamp: %w", err)
+		}
+	}
+
+	gen, err := NewDevnetConsumerGenesisState(providerChainId, consumerId, unbondingPeriod, timestamp, initialValSet)
+	if err != nil {
+		return err
+	}
+
+	genBz, err := clientCtx.Codec.MarshalJSON(gen)
+	if err != nil {
+		return fmt.Errorf("failed exporting devnet consumer genesis to JSON: %s", err)
+	}
+	sortedBz, err := sdk.SortJSON(genBz)
+	if err != nil {
+		return fmt.Errorf("failed sorting devnet consumer genesis JSON: %s", err)
+	}
+
+	cmd.PrintErrln("WARNING: the devnet consumer genesis is for local development and CI only, DO NOT use it in production")
+	cmd.Println(string(sortedBz))
+	return nil
+}
+
+// GetDevnetConsumerGenesisCmd returns a command that creates the CCV part of the genesis of
+// a consumer chain validated by a given validator set, for a fake provider chain.
+func GetDevnetConsumerGenesisCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "devnet-ccv-genesis [validators-file]",
+		Short: "Create the CCV consumer genesis for a fake provider chain (NOT for production)",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+Create the CCV consumer genesis of a new consumer chain validated by the validators in the validators file,
+with the client and consensus states of the provider synthesized for a fake provider chain.
+The result is printed to STDOUT and can be used to patch the consumer genesis file, e.g., for local development and CI.
+
+The validators file contains a list of validator updates in the format of the 'initial_val_set' of the consumer genesis, e.g.,
+[{"pub_key": {"ed25519": "Ui5Gf1+mtWUdH8u3xlmzdKID+F3PK0sfXZ73GZ6q6is="}, "power": "100"}]
+
+WARNING: The resulting consumer chain cannot establish a CCV channel with any provider chain. DO NOT use in production.
+
+Example:
+$ %s genesis devnet-ccv-genesis /path/to/validators.json
+$ %s genesis devnet-ccv-genesis /path/to/validators.json --provider-chain-id fake-provider-1 --consumer-id 3
+`, version.AppName, version.AppName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: DevnetConsumerGenesis,
+	}
+	cmd.Flags().String(FlagProviderChainId, DefaultDevnetProviderChainId, "chain id of the fake provider chain")
+	cmd.Flags().String(FlagConsumerId, "0", "consumer id of the consumer chain")
+	cmd.Flags().Duration(FlagUnbondingPeriod, stakingtypes.DefaultUnbondingTime, "unbonding period of the fake provider chain")
+	cmd.Flags().String(FlagTimestamp, "", "timestamp of the consensus state of the fake provider chain, in RFC3339 format (default now)")
+	return cmd
+}
@@ -0,0 +1,146 @@
+// Package genesis transforms the consumer genesis data exported by a provider chain to the formats
+// supported by the consumer chains running previous versions of ICS. It is used by the
+// `genesis transform` command of the consumer binary and can be embedded by launch tooling.
+package genesis
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"golang.org/x/exp/maps"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// IcsVersion is a version of the consumer chain to which the consumer genesis data can be transformed
+type IcsVersion string
+
+const (
+	v4_x_x IcsVersion = "<v4.5.x" // all v4 versions < v4.5.0
+	v4_5_x IcsVersion = "v4.5.x"
+	v5_x_x IcsVersion = "v5.x"    // all v5 version
+	v6_x_x IcsVersion = "<v6.4.x" // all v6 versions < v6.4.0
+	v6_4_x IcsVersion = "v6.4.x"  // all v6 versions >= v6.4.0
+
+	// DefaultTargetVersion is the version to which the consumer genesis data is transformed by default
+	DefaultTargetVersion = v5_x_x
+)
+
+// TransformationVersions maps the supported target versions of the consumer genesis transformation
+var TransformationVersions map[string]IcsVersion = map[string]IcsVersion{
+	"<v4.5.x": v4_x_x,
+	"v4.5.x":  v4_5_x,
+	"v5.x":    v5_x_x,
+	"<v6.4.x": v6_x_x,
+	"v6.4.x":  v6_4_x,
+}
+
+// consumerParamsAddedAfterV6_4 are the consumer params added after ICS v6.4.x,
+// which are unknown to the consumer chains running any of the supported target versions
+var consumerParamsAddedAfterV6_4 = []string{
+	"strict_vsc_id_ordering",
+	"max_validator_updates_per_block",
+	"retry_delay_multiplier",
+	"max_retry_delay_period",
+	"client_expiry_warning_threshold",
+	"denom_redistribution_fractions",
+	"reward_splits",
+}
+
+// SupportedVersions returns the supported target versions of the consumer genesis transformation, sorted
+func SupportedVersions() []string {
+	versions := maps.Keys(TransformationVersions)
+	sort.Strings(versions)
+	return versions
+}
+
+// TransformConsumerGenesis transforms the consumer genesis data `raw`, as exported by the provider chain
+// (i.e., a JSON encoded ConsumerGenesisState), to the format supported by the consumer chains running
+// the target version of ICS. The input is first decoded with `cdc` to make sure it is valid consumer genesis data.
+// It returns the transformed data as sorted JSON.
+func TransformConsumerGenesis(cdc codec.JSONCodec, targetVersion string, raw []byte) ([]byte, error) {
+	version, exists := TransformationVersions[targetVersion]
+	if !exists {
+		return nil, fmt.Errorf("unsupported target version '%s', supported versions are %v", targetVersion, SupportedVersions())
+	}
+
+	var genesis ccv.ConsumerGenesisState
+	if err := cdc.UnmarshalJSON(raw, &genesis); err != nil {
+		return nil, fmt.Errorf("invalid consumer genesis data: %w", err)
+	}
+
+	transformed, err := transformGenesis(version, raw)
+	if err != nil {
+		return nil, err
+	}
+
+	sorted, err := sdk.SortJSON(transformed)
+	if err != nil {
+		return nil, fmt.Errorf("failed sorting transformed consumer genesis JSON: %w", err)
+	}
+	return sorted, nil
+}
+
+// Remove parameters from a JSON object
+func removeParametersFromParams(params json.RawMessage, paramsToRemove []string) (json.RawMessage, error) {
+	paramsMap := map[string]json.RawMessage{}
+	if err := json.Unmarshal(params, &paramsMap); err != nil {
+		return nil, fmt.Errorf("unmarshalling 'params' failed: %v", err)
+	}
+	for _, param := range paramsToRemove {
+		delete(paramsMap, param)
+	}
+	return json.Marshal(paramsMap)
+}
+
+func removeFieldsFromGenesisState(genState map[string]json.RawMessage, keysToRemove []string) map[string]json.RawMessage {
+	for _, key := range keysToRemove {
+		delete(genState, key) // Remove the key from the map if it exists
+	}
+	return genState
+}
+
+// transformGenesis transforms ccv consumer genesis data to the specified target version
+// Returns the transformed data or an error in case the transformation failed or the format is not supported by current implementation
+func transformGenesis(targetVersion IcsVersion, jsonRaw []byte) (json.RawMessage, error) {
+	// Unmarshal genesis state from raw msg
+	genState := map[string]json.RawMessage{}
+	if err := json.Unmarshal(jsonRaw, &genState); err != nil {
+		return nil, fmt.Errorf("unmarshalling 'GenesisState' failed: %v", err)
+	}
+
+	// the params added after v6.4.x are removed for all target versions;
+	// the 'consumer_id' param introduced in ICS v6.2.x is removed for consumer chains
+	// with either SDK v0.47 and ICS < v4.5.0 or SDK v0.50 and ICS < v6.2.0;
+	// the 'connection_id' field introduced in ICS v6.4.x is removed for all versions before
+	paramsToRemove := append([]string{}, consumerParamsAddedAfterV6_4...)
+	switch targetVersion {
+	case v4_x_x, v5_x_x:
+		paramsToRemove = append(paramsToRemove, "consumer_id")
+		genState = removeFieldsFromGenesisState(genState, []string{"connection_id"})
+	case v4_5_x, v6_x_x:
+		genState = removeFieldsFromGenesisState(genState, []string{"connection_id"})
+	case v6_4_x:
+	default:
+		return nil, fmt.Errorf("transformation failed: unsupported target version '%s'", targetVersion)
+	}
+
+	if params, found := genState["params"]; found {
+		params, err := removeParametersFromParams(params, paramsToRemove)
+		if err != nil {
+			return nil, fmt.Errorf("transformation failed: %v", err)
+		}
+		genState["params"] = params
+	}
+
+	// Marshal genesis state to raw msg
+	newConsumerGenesis, err := json.Marshal(genState)
+	if err != nil {
+		return nil, fmt.Errorf("marshalling transformation result failed: %v", err)
+	}
+	return newConsumerGenesis, nil
+}
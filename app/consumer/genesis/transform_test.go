@@ -0,0 +1,85 @@
+package genesis_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+
+	consumergenesis "github.com/cosmos/interchain-security/v7/app/consumer/genesis"
+	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// TestTransformConsumerGenesis tests that the consumer genesis data exported by the current provider version
+// is transformed to the format of every supported target version
+func TestTransformConsumerGenesis(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+	params := ccv.DefaultParams()
+	params.ConsumerId = "13"
+	params.StrictVscIdOrdering = true
+	raw, err := cdc.MarshalJSON(&ccv.ConsumerGenesisState{
+		Params:       params,
+		NewChain:     true,
+		ConnectionId: "connection-0",
+	})
+	require.NoError(t, err)
+
+	newParams := []string{
+		"strict_vsc_id_ordering",
+		"max_validator_updates_per_block",
+		"retry_delay_multiplier",
+		"max_retry_delay_period",
+		"client_expiry_warning_threshold",
+		"denom_redistribution_fractions",
+		"reward_splits",
+	}
+
+	testCases := []struct {
+		targetVersion        string
+		expectedConsumerId   bool
+		expectedConnectionId bool
+	}{
+		{"<v4.5.x", false, false},
+		{"v4.5.x", true, false},
+		{"v5.x", false, false},
+		{"<v6.4.x", true, false},
+		{"v6.4.x", true, true},
+	}
+	require.Len(t, consumergenesis.SupportedVersions(), len(testCases))
+
+	for _, tc := range testCases {
+		t.Run(tc.targetVersion, func(t *testing.T) {
+			result, err := consumergenesis.TransformConsumerGenesis(cdc, tc.targetVersion, raw)
+			require.NoError(t, err)
+
+			// the transformed data can still be decoded by the current version
+			var genesis ccv.ConsumerGenesisState
+			require.NoError(t, cdc.UnmarshalJSON(result, &genesis))
+			require.True(t, genesis.NewChain)
+
+			resultRaw := map[string]json.RawMessage{}
+			require.NoError(t, json.Unmarshal(result, &resultRaw))
+			_, found := resultRaw["connection_id"]
+			require.Equal(t, tc.expectedConnectionId, found)
+
+			resultParams := map[string]json.RawMessage{}
+			require.NoError(t, json.Unmarshal(resultRaw["params"], &resultParams))
+			_, found = resultParams["consumer_id"]
+			require.Equal(t, tc.expectedConsumerId, found)
+			for _, param := range newParams {
+				require.NotContains(t, resultParams, param)
+			}
+			require.Contains(t, resultParams, "ccv_timeout_period")
+		})
+	}
+
+	// unsupported target versions and invalid consumer genesis data are rejected
+	_, err = consumergenesis.TransformConsumerGenesis(cdc, "v3.x", raw)
+	require.Error(t, err)
+	_, err = consumergenesis.TransformConsumerGenesis(cdc, "v5.x", []byte(`{"params": {"unknown_param": true}}`))
+	require.Error(t, err)
+}
@@ -10,14 +10,21 @@ import (
 	"path/filepath"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/require"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
 	app "github.com/cosmos/interchain-security/v7/app/consumer"
+	cryptotestutil "github.com/cosmos/interchain-security/v7/testutil/crypto"
+	consumertypes "github.com/cosmos/interchain-security/v7/x/ccv/consumer/types"
 	ccvtypes "github.com/cosmos/interchain-security/v7/x/ccv/types"
 )
 
@@ -473,3 +480,87 @@ func shouldContainConnectionId(version string) bool {
 
 	return false
 }
+
+// Check that the devnet consumer genesis is a valid consumer genesis
+// validated by the validators of the validators file
+func TestDevnetConsumerGenesis(t *testing.T) {
+	clientCtx := getClientCtx()
+	initialValSet := []abci.ValidatorUpdate{
+		{PubKey: cryptotestutil.NewCryptoIdentityFromIntSeed(1).TMProtoCryptoPublicKey(), Power: 100},
+		{PubKey: cryptotestutil.NewCryptoIdentityFromIntSeed(2).TMProtoCryptoPublicKey(), Power: 50},
+	}
+	rawValidators := []json.RawMessage{}
+	for _, val := range initialValSet {
+		rawValidators = append(rawValidators, clientCtx.Codec.MustMarshalJSON(&val))
+	}
+	bz, err := json.Marshal(rawValidators)
+	require.NoError(t, err)
+	filePath := filepath.Join(t.TempDir(), "validators.json")
+	require.NoError(t, os.WriteFile(filePath, bz, fs.FileMode(0o644)))
+
+	cmd := app.GetDevnetConsumerGenesisCmd()
+	ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+	cmd.SetContext(ctx)
+	require.NoError(t, client.SetCmdClientContext(cmd, clientCtx))
+	cmd.SetArgs([]string{filePath, "--provider-chain-id=fake-provider-2", "--consumer-id=3", "--timestamp=2024-01-01T00:00:00Z"})
+	result := new(bytes.Buffer)
+	cmd.SetOut(result)
+	cmd.SetErr(new(bytes.Buffer))
+	_, err = cmd.ExecuteC()
+	require.NoError(t, err)
+
+	var gen ccvtypes.ConsumerGenesisState
+	require.NoError(t, clientCtx.Codec.UnmarshalJSON(result.Bytes(), &gen))
+	require.NoError(t, gen.Validate())
+	require.True(t, gen.Params.Enabled)
+	require.Equal(t, "3", gen.Params.ConsumerId)
+	require.Equal(t, "fake-provider-2", gen.Provider.ClientState.ChainId)
+	require.Equal(t, uint64(2), gen.Provider.ClientState.LatestHeight.RevisionNumber)
+	require.Equal(t, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), gen.Provider.ConsensusState.Timestamp)
+	require.Equal(t, initialValSet, gen.Provider.InitialValSet)
+
+	// the fake provider chain is validated by the same validators
+	validators, err := cmttypes.PB2TM.ValidatorUpdates(initialValSet)
+	require.NoError(t, err)
+	require.Equal(t, cmttypes.NewValidatorSet(validators).Hash(), []byte(gen.Provider.ConsensusState.NextValidatorsHash))
+
+	// an empty validator set is rejected
+	_, err = app.NewDevnetConsumerGenesisState("fake-provider-2", "3", time.Hour, time.Now(), nil)
+	require.Error(t, err)
+}
+
+// Check that the provider switch message wraps the consumer genesis state issued by the new provider chain
+func TestProviderSwitchMsg(t *testing.T) {
+	clientCtx := getClientCtx()
+	initialValSet := []abci.ValidatorUpdate{
+		{PubKey: cryptotestutil.NewCryptoIdentityFromIntSeed(1).TMProtoCryptoPublicKey(), Power: 100},
+	}
+	gen, err := app.NewDevnetConsumerGenesisState("provider-2", "3", time.Hour, time.Now().UTC(), initialValSet)
+	require.NoError(t, err)
+	filePath := filepath.Join(t.TempDir(), "ccv_consumer_genesis.json")
+	require.NoError(t, os.WriteFile(filePath, clientCtx.Codec.MustMarshalJSON(gen), fs.FileMode(0o644)))
+
+	cmd := app.GetProviderSwitchMsgCmd()
+	ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+	cmd.SetContext(ctx)
+	require.NoError(t, client.SetCmdClientContext(cmd, clientCtx))
+	cmd.SetArgs([]string{"1", "100", filePath, "--authority=gov"})
+	result := new(bytes.Buffer)
+	cmd.SetOut(result)
+	cmd.SetErr(new(bytes.Buffer))
+	_, err = cmd.ExecuteC()
+	require.NoError(t, err)
+
+	var msg sdk.Msg
+	require.NoError(t, clientCtx.Codec.UnmarshalInterfaceJSON(result.Bytes(), &msg))
+	switchMsg, ok := msg.(*consumertypes.MsgScheduleProviderSwitch)
+	require.True(t, ok)
+	require.Equal(t, "gov", switchMsg.Authority)
+	require.Equal(t, "1", switchMsg.Switch.ProviderId)
+	require.Equal(t, int64(100), switchMsg.Switch.SwitchHeight)
+	require.Equal(t, initialValSet, switchMsg.Switch.Genesis.Provider.InitialValSet)
+
+	// an invalid switch height is rejected
+	_, err = app.NewProviderSwitchMsg("gov", "1", 0, *gen)
+	require.Error(t, err)
+}
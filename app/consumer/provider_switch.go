@@ -0,0 +1,118 @@
+package app
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	consumertypes "github.com/cosmos/interchain-security/v7/x/ccv/consumer/types"
+	ccvtypes "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+const FlagAuthority = "authority"
+
+// NewProviderSwitchMsg returns the message scheduling the switch of the consumer chain to the provider chain
+// identified by `providerId` at `switchHeight`, where `genesis` is the consumer genesis state issued
+// by the new provider chain
+func NewProviderSwitchMsg(
+	authority string,
+	providerId string,
+	switchHeight int64,
+	genesis ccvtypes.ConsumerGenesisState,
+) (*consumertypes.MsgScheduleProviderSwitch, error) {
+	msg := &consumertypes.MsgScheduleProviderSwitch{
+		Authority: authority,
+		Switch: consumertypes.ProviderSwitch{
+			ProviderId:   providerId,
+			SwitchHeight: switchHeight,
+			Genesis:      genesis,
+		},
+	}
+	if err := msg.Switch.Validate(); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// ProviderSwitchMsg reads the consumer genesis state issued by the new provider chain from the
+// genesis file and writes the resulting provider switch message to the output of the command
+func ProviderSwitchMsg(cmd *cobra.Command, args []string) error {
+	clientCtx := client.GetClientContextFromCmd(cmd)
+
+	providerId := args[0]
+	switchHeight, err := strconv.ParseInt(args[1], 10, 64)
+	if err != nil {
+		return fmt.Errorf("parsing switch height: %w", err)
+	}
+
+	bz, err := os.ReadFile(filepath.Clean(args[2]))
+	if err != nil {
+		return err
+	}
+	var genesis ccvtypes.ConsumerGenesisState
+	if err := clientCtx.Codec.UnmarshalJSON(bz, &genesis); err != nil {
+		return fmt.Errorf("parsing consumer genesis file: %w", err)
+	}
+
+	authority, err := cmd.Flags().GetString(FlagAuthority)
+	if err != nil {
+		return err
+	}
+	if authority == "" {
+		authority = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	}
+
+	msg, err := NewProviderSwitchMsg(authority, providerId, switchHeight, genesis)
+	if err != nil {
+		return err
+	}
+
+	msgBz, err := clientCtx.Codec.MarshalInterfaceJSON(msg)
+	if err != nil {
+		return fmt.Errorf("failed exporting provider switch message to JSON: %s", err)
+	}
+	sortedBz, err := sdk.SortJSON(msgBz)
+	if err != nil {
+		return fmt.Errorf("failed sorting provider switch message JSON: %s", err)
+	}
+
+	cmd.Println(string(sortedBz))
+	return nil
+}
+
+// GetProviderSwitchMsgCmd returns a command that creates the governance message scheduling
+// the switch of the consumer chain to a different provider chain.
+func GetProviderSwitchMsgCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "provider-switch-msg [provider-id] [switch-height] [ccv-consumer-genesis-file]",
+		Short: "Create the message scheduling the switch of the consumer chain to a different provider chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+Create the message scheduling the switch of the consumer chain to the provider chain identified by provider-id
+at switch-height. The CCV consumer genesis file contains the consumer genesis state issued by the new provider chain,
+i.e., the result of the consumer-genesis query of the new provider chain once it launched the consumer chain.
+The result is printed to STDOUT and can be used as the message of a governance proposal on the consumer chain.
+
+At the switch height, the state related to the previous provider chain is preserved under the namespace of the
+previous provider chain, and the consumer chain is validated by the initial validator set of the new provider chain.
+
+Example:
+$ %s genesis provider-switch-msg 1 1500000 /path/to/ccv_consumer_genesis.json
+`, version.AppName),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: ProviderSwitchMsg,
+	}
+	cmd.Flags().String(FlagAuthority, "", "address of the governance account of the consumer chain (default the gov module account)")
+	return cmd
+}
@@ -0,0 +1,45 @@
+package app
+
+import (
+	"encoding/json"
+	"errors"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+)
+
+// ExportAppStateAndValidators exports the state of the application for a genesis
+// file. Zero height genesis is not supported by the mock provider.
+func (app *App) ExportAppStateAndValidators(
+	forZeroHeight bool, jailAllowedAddrs, modulesToExport []string,
+) (servertypes.ExportedApp, error) {
+	if forZeroHeight {
+		return servertypes.ExportedApp{}, errors.New("zero height genesis is not supported")
+	}
+
+	ctx := app.NewContext(true)
+
+	// We export at last height + 1, because that's the height at which
+	// Tendermint will start InitChain.
+	height := app.LastBlockHeight() + 1
+
+	genState, err := app.MM.ExportGenesis(ctx, app.appCodec)
+	if err != nil {
+		return servertypes.ExportedApp{}, err
+	}
+	appState, err := json.MarshalIndent(genState, "", "  ")
+	if err != nil {
+		return servertypes.ExportedApp{}, err
+	}
+
+	validators, err := staking.WriteValidators(ctx, app.StakingKeeper)
+	if err != nil {
+		return servertypes.ExportedApp{}, err
+	}
+	return servertypes.ExportedApp{
+		AppState:        appState,
+		Validators:      validators,
+		Height:          height,
+		ConsensusParams: app.BaseApp.GetConsensusParams(ctx),
+	}, nil
+}
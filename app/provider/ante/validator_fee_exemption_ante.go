@@ -0,0 +1,39 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type (
+	// ProviderKeeper defines the interface required by a provider module keeper.
+	ProviderKeeper interface {
+		ConsumeValidatorFeeExemption(ctx sdk.Context, feePayer sdk.AccAddress, msgs []sdk.Msg) bool
+	}
+
+	// ValidatorFeeExemptionDecorator defines an AnteHandler decorator that exempts bonded validators
+	// from paying fees for the MsgAssignConsumerKey, MsgOptIn, and MsgOptOut messages up to a per-epoch
+	// quota, since these messages are mandated by the protocol. All the other transactions are handled
+	// by the wrapped fee decorator.
+	ValidatorFeeExemptionDecorator struct {
+		ProviderKeeper ProviderKeeper
+		FeeDecorator   sdk.AnteDecorator
+	}
+)
+
+func NewValidatorFeeExemptionDecorator(k ProviderKeeper, feeDecorator sdk.AnteDecorator) ValidatorFeeExemptionDecorator {
+	return ValidatorFeeExemptionDecorator{
+		ProviderKeeper: k,
+		FeeDecorator:   feeDecorator,
+	}
+}
+
+func (vfd ValidatorFeeExemptionDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	// Skip the fee decorator if the fees are paid by a validator that is exempt for the messages in the tx.
+	if feeTx, ok := tx.(sdk.FeeTx); ok {
+		if vfd.ProviderKeeper.ConsumeValidatorFeeExemption(ctx, sdk.AccAddress(feeTx.FeePayer()), tx.GetMsgs()) {
+			return next(ctx, tx, simulate)
+		}
+	}
+
+	return vfd.FeeDecorator.AnteHandle(ctx, tx, simulate, next)
+}
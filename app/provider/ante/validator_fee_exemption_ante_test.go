@@ -0,0 +1,83 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	appencoding "github.com/cosmos/interchain-security/v7/app/encoding"
+	"github.com/cosmos/interchain-security/v7/app/provider/ante"
+	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+)
+
+type providerKeeper struct {
+	exempt bool
+}
+
+func (k providerKeeper) ConsumeValidatorFeeExemption(_ sdk.Context, _ sdk.AccAddress, _ []sdk.Msg) bool {
+	return k.exempt
+}
+
+// feeDecorator records whether it was called
+type feeDecorator struct {
+	called *bool
+}
+
+func (d feeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	*d.called = true
+	return next(ctx, tx, simulate)
+}
+
+func noOpAnteDecorator() sdk.AnteHandler {
+	return func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+		return ctx, nil
+	}
+}
+
+func TestValidatorFeeExemptionDecorator(t *testing.T) {
+	txCfg := appencoding.MakeTestEncodingConfig().TxConfig
+
+	testCases := []struct {
+		name           string
+		providerKeeper ante.ProviderKeeper
+		msgs           []sdk.Msg
+		expectFeeCall  bool
+	}{
+		{
+			name:           "exempt tx",
+			providerKeeper: providerKeeper{exempt: true},
+			msgs: []sdk.Msg{
+				&providertypes.MsgOptIn{},
+			},
+			expectFeeCall: false,
+		},
+		{
+			name:           "non-exempt tx",
+			providerKeeper: providerKeeper{exempt: false},
+			msgs: []sdk.Msg{
+				&banktypes.MsgSend{},
+			},
+			expectFeeCall: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			called := false
+			handler := ante.NewValidatorFeeExemptionDecorator(tc.providerKeeper, feeDecorator{called: &called})
+
+			txBuilder := txCfg.NewTxBuilder()
+			require.NoError(t, txBuilder.SetMsgs(tc.msgs...))
+			txBuilder.SetFeePayer(sdk.AccAddress([]byte{0x01}))
+
+			_, err := handler.AnteHandle(sdk.Context{}, txBuilder.GetTx(), false, noOpAnteDecorator())
+			require.NoError(t, err)
+			require.Equal(t, tc.expectFeeCall, called)
+		})
+	}
+}
@@ -10,6 +10,11 @@ import (
 
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/cosmos/gogoproto/proto"
+	ica "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts"
+	icahost "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/host"
+	icahostkeeper "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/host/keeper"
+	icahosttypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
 	"github.com/cosmos/ibc-go/v10/modules/apps/transfer"
 	ibctransferkeeper "github.com/cosmos/ibc-go/v10/modules/apps/transfer/keeper"
 	ibctransfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
@@ -110,8 +115,10 @@ import (
 	no_valupdates_genutil "github.com/cosmos/interchain-security/v7/x/ccv/no_valupdates_genutil"
 	no_valupdates_staking "github.com/cosmos/interchain-security/v7/x/ccv/no_valupdates_staking"
 	ibcprovider "github.com/cosmos/interchain-security/v7/x/ccv/provider"
+	ibcproviderclient "github.com/cosmos/interchain-security/v7/x/ccv/provider/client"
 	ibcproviderkeeper "github.com/cosmos/interchain-security/v7/x/ccv/provider/keeper"
 	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+	ccvtypes "github.com/cosmos/interchain-security/v7/x/ccv/types"
 )
 
 const (
@@ -151,6 +158,7 @@ var (
 		ibctm.AppModuleBasic{},
 		params.AppModuleBasic{},
 		transfer.AppModuleBasic{},
+		ica.AppModuleBasic{},
 		ibcprovider.AppModuleBasic{},
 	)
 
@@ -163,10 +171,24 @@ var (
 		stakingtypes.NotBondedPoolName:    {authtypes.Burner, authtypes.Staking},
 		govtypes.ModuleName:               {authtypes.Burner},
 		ibctransfertypes.ModuleName:       {authtypes.Minter, authtypes.Burner},
+		icatypes.ModuleName:               nil,
 		providertypes.ConsumerRewardsPool: nil,
 	}
+
+	// the messages that the interchain accounts hosted on the provider chain are allowed to execute,
+	// i.e., only the messages needed to own consumer chains
+	icaHostAllowMessages = []string{
+		sdk.MsgTypeURL(&providertypes.MsgCreateConsumer{}),
+		sdk.MsgTypeURL(&providertypes.MsgUpdateConsumer{}),
+		sdk.MsgTypeURL(&providertypes.MsgRemoveConsumer{}),
+	}
 )
 
+// ICAHostParams returns the params of the ICA host module of the provider chain
+func ICAHostParams() icahosttypes.Params {
+	return icahosttypes.NewParams(true, icaHostAllowMessages)
+}
+
 var (
 	_ runtime.AppI            = (*App)(nil)
 	_ servertypes.Application = (*App)(nil)
@@ -207,6 +229,7 @@ type App struct { // nolint: golint
 	IBCKeeper             *ibckeeper.Keeper // IBC Keeper must be a pointer in the app, so we can SetRouter on it correctly
 	EvidenceKeeper        evidencekeeper.Keeper
 	TransferKeeper        ibctransferkeeper.Keeper
+	ICAHostKeeper         icahostkeeper.Keeper
 	ProviderKeeper        ibcproviderkeeper.Keeper
 	ConsensusParamsKeeper consensusparamkeeper.Keeper
 
@@ -269,7 +292,7 @@ func New(
 		authtypes.StoreKey, banktypes.StoreKey, stakingtypes.StoreKey, crisistypes.StoreKey,
 		minttypes.StoreKey, distrtypes.StoreKey, slashingtypes.StoreKey,
 		govtypes.StoreKey, paramstypes.StoreKey, ibcexported.StoreKey, upgradetypes.StoreKey,
-		evidencetypes.StoreKey, ibctransfertypes.StoreKey,
+		evidencetypes.StoreKey, ibctransfertypes.StoreKey, icahosttypes.StoreKey,
 		providertypes.StoreKey,
 		consensusparamtypes.StoreKey,
 	)
@@ -431,6 +454,12 @@ func New(
 		authtypes.FeeCollectorName,
 	)
 
+	ccvLogConfig, err := ccvtypes.LogConfigFromAppOptions(appOpts)
+	if err != nil {
+		panic(err)
+	}
+	app.ProviderKeeper.SetLogConfig(ccvLogConfig)
+
 	govConfig := govtypes.DefaultConfig()
 	app.GovKeeper = govkeeper.NewKeeper(
 		appCodec,
@@ -492,6 +521,20 @@ func New(
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
+	// the interchain accounts hosted on the provider chain can, e.g., own consumer chains,
+	// so that the consumer chains can be administered from other chains
+	app.ICAHostKeeper = icahostkeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(keys[icahosttypes.StoreKey]),
+		app.GetSubspace(icahosttypes.SubModuleName),
+		app.IBCKeeper.ChannelKeeper,
+		app.IBCKeeper.ChannelKeeper,
+		app.AccountKeeper,
+		app.MsgServiceRouter(),
+		app.GRPCQueryRouter(),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
 	// Add an IBC middleware callback to track the consumer rewards
 	var transferStack porttypes.IBCModule
 	transferStack = transfer.NewIBCModule(app.TransferKeeper)
@@ -500,6 +543,7 @@ func New(
 	// create static IBC router, add transfer route, then set and seal it
 	ibcRouter := porttypes.NewRouter()
 	ibcRouter.AddRoute(ibctransfertypes.ModuleName, transferStack)
+	ibcRouter.AddRoute(icahosttypes.SubModuleName, icahost.NewIBCModule(app.ICAHostKeeper))
 	ibcRouter.AddRoute(providertypes.ModuleName, providerModule)
 	app.IBCKeeper.SetRouter(ibcRouter)
 
@@ -534,6 +578,7 @@ func New(
 		ibctm.NewAppModule(tmLightClientModule),
 		params.NewAppModule(app.ParamsKeeper),
 		transfer.NewAppModule(app.TransferKeeper),
+		ica.NewAppModule(nil, &app.ICAHostKeeper),
 		providerModule,
 	)
 
@@ -557,7 +602,7 @@ func New(
 		EnabledSignModes:           enabledSignModes,
 		TextualCoinMetadataQueryFn: txmodule.NewBankKeeperCoinMetadataQueryFn(app.BankKeeper),
 	}
-	txConfig, err := authtx.NewTxConfigWithOptions(
+	txConfig, err = authtx.NewTxConfigWithOptions(
 		appCodec,
 		txConfigOpts,
 	)
@@ -581,6 +626,7 @@ func New(
 		stakingtypes.ModuleName,
 		ibctransfertypes.ModuleName,
 		ibcexported.ModuleName,
+		icatypes.ModuleName,
 		authtypes.ModuleName,
 		banktypes.ModuleName,
 		distrtypes.ModuleName,
@@ -601,6 +647,7 @@ func New(
 		stakingtypes.ModuleName,
 		ibctransfertypes.ModuleName,
 		ibcexported.ModuleName,
+		icatypes.ModuleName,
 		authtypes.ModuleName,
 		banktypes.ModuleName,
 		distrtypes.ModuleName,
@@ -630,6 +677,7 @@ func New(
 		ibcexported.ModuleName,
 		evidencetypes.ModuleName,
 		ibctransfertypes.ModuleName,
+		icatypes.ModuleName,
 		genutiltypes.ModuleName,
 		paramstypes.ModuleName,
 		upgradetypes.ModuleName,
@@ -672,9 +720,20 @@ func New(
 				}
 			}
 
+			// the ICA host module is added by this upgrade, i.e., its genesis is initialized by RunMigrations
+			delete(fromVM, icatypes.ModuleName)
+
 			app.Logger().Info("start to run module migrations...")
 
-			return app.MM.RunMigrations(ctx, app.configurator, fromVM)
+			vm, err := app.MM.RunMigrations(ctx, app.configurator, fromVM)
+			if err != nil {
+				return vm, err
+			}
+
+			// the default ICA host params allow all the messages
+			app.ICAHostKeeper.SetParams(sdkCtx, ICAHostParams())
+
+			return vm, nil
 		},
 	)
 
@@ -684,7 +743,9 @@ func New(
 	}
 
 	if upgradeInfo.Name == upgradeName && !app.UpgradeKeeper.IsSkipHeight(upgradeInfo.Height) {
-		storeUpgrades := storetypes.StoreUpgrades{}
+		storeUpgrades := storetypes.StoreUpgrades{
+			Added: []string{icahosttypes.StoreKey},
+		}
 
 		// configure store loader that checks if version == upgradeHeight and applies store upgrades
 		app.SetStoreLoader(upgradetypes.UpgradeStoreLoader(upgradeInfo.Height, &storeUpgrades))
@@ -713,7 +774,8 @@ func New(
 				SignModeHandler: txConfig.SignModeHandler(),
 				SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
 			},
-			IBCKeeper: app.IBCKeeper,
+			IBCKeeper:      app.IBCKeeper,
+			ProviderKeeper: app.ProviderKeeper,
 		},
 	)
 	if err != nil {
@@ -728,6 +790,12 @@ func New(
 
 	app.setPostHandler()
 
+	// publish the VSC packets queued in a block to the subscribers of the StreamValidatorSetChanges query
+	// once the block is committed, alongside the configured streaming services
+	streamingManager := app.StreamingManager()
+	streamingManager.ABCIListeners = append(streamingManager.ABCIListeners, app.ProviderKeeper.VSCPacketStreamListener())
+	app.SetStreamingManager(streamingManager)
+
 	// At startup, after all modules have been registered, check that all prot
 	// annotations are correct.
 	protoFiles, err := proto.MergedRegistry()
@@ -976,6 +1044,9 @@ func (app *App) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.APIConfig
 	// Register legacy and grpc-gateway routes for all modules.
 	ModuleBasics.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 
+	// Register the route returning the balance operations of the CCV module, e.g., for Rosetta and similar data APIs.
+	apiSvr.Router.HandleFunc(ibcproviderclient.OperationsRoute, ibcproviderclient.OperationsHandler(clientCtx)).Methods("GET")
+
 	// register swagger API from root so that other applications can override easily
 	if err := server.RegisterSwaggerAPI(apiSvr.ClientCtx, apiSvr.Router, apiConfig.Swagger); err != nil {
 		panic(err)
@@ -1020,6 +1091,7 @@ func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino
 	paramsKeeper.Subspace(crisistypes.ModuleName)
 	paramsKeeper.Subspace(ibctransfertypes.ModuleName)
 	paramsKeeper.Subspace(ibcexported.ModuleName)
+	paramsKeeper.Subspace(icahosttypes.SubModuleName).WithKeyTable(icahosttypes.ParamKeyTable())
 	paramsKeeper.Subspace(providertypes.ModuleName)
 
 	return paramsKeeper
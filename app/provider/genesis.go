@@ -3,6 +3,9 @@ package app
 import (
 	"encoding/json"
 
+	icagenesistypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/genesis/types"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 )
 
@@ -17,5 +20,12 @@ type GenesisState map[string]json.RawMessage
 
 // NewDefaultGenesisState generates the default state for the application.
 func NewDefaultGenesisState(cdc codec.JSONCodec) GenesisState {
-	return ModuleBasics.DefaultGenesis(cdc)
+	genesis := ModuleBasics.DefaultGenesis(cdc)
+
+	// restrict the messages that the interchain accounts can execute
+	icaGenesis := icagenesistypes.DefaultGenesis()
+	icaGenesis.HostGenesisState.Params = ICAHostParams()
+	genesis[icatypes.ModuleName] = cdc.MustMarshalJSON(icaGenesis)
+
+	return genesis
 }
@@ -0,0 +1,160 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/log"
+)
+
+const (
+	flagProviderGRPC            = "provider-grpc"
+	flagConsumerGRPC            = "consumer-grpc"
+	flagConsumerId              = "consumer-id"
+	flagListenAddr              = "listen-addr"
+	flagPollInterval            = "poll-interval"
+	flagClientExpiryThreshold   = "client-expiry-threshold"
+	flagVSCAckThreshold         = "vsc-ack-threshold"
+	flagPendingPacketsThreshold = "pending-packets-threshold"
+)
+
+func main() {
+	if err := NewRootCmd().Execute(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+// NewRootCmd creates the root command of ccv-watch, a watchtower that monitors
+// the health of the CCV connection between a provider and a consumer chain
+func NewRootCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ccv-watch",
+		Short: "Monitor the health of the CCV connection between a provider and a consumer chain",
+		Long: `Monitor the health of the CCV connection between a provider and a consumer chain.
+
+ccv-watch periodically queries the gRPC endpoints of the provider and the consumer chain and
+exposes Prometheus metrics for the expiry of the clients, the depth of the packet queues,
+the last acknowledgement of a VSC packet, and the throttle state. Alerts are exposed as the
+ccv_watch_alert gauge and are logged once raised.
+
+Example:
+$ ccv-watch --provider-grpc localhost:9090 --consumer-grpc localhost:9092 --consumer-id 0
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := configFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			return run(cmd.Context(), cfg)
+		},
+	}
+
+	cmd.Flags().String(flagProviderGRPC, "localhost:9090", "gRPC endpoint of the provider chain")
+	cmd.Flags().String(flagConsumerGRPC, "localhost:9092", "gRPC endpoint of the consumer chain")
+	cmd.Flags().String(flagConsumerId, "", "consumer id of the consumer chain on the provider chain")
+	cmd.Flags().String(flagListenAddr, ":9200", "address on which the Prometheus metrics are exposed")
+	cmd.Flags().Duration(flagPollInterval, 30*time.Second, "interval between two queries of the chains")
+	cmd.Flags().Duration(flagClientExpiryThreshold, 72*time.Hour, "raise an alert if a client expires within this duration")
+	cmd.Flags().Duration(flagVSCAckThreshold, time.Hour, "raise an alert if a VSC packet is not acknowledged within this duration")
+	cmd.Flags().Int(flagPendingPacketsThreshold, 100, "raise an alert if the consumer has more pending packets than this threshold")
+	if err := cmd.MarkFlagRequired(flagConsumerId); err != nil {
+		panic(err)
+	}
+
+	return cmd
+}
+
+// Config defines the configuration of the watcher
+type Config struct {
+	ProviderGRPC            string
+	ConsumerGRPC            string
+	ConsumerId              string
+	ListenAddr              string
+	PollInterval            time.Duration
+	ClientExpiryThreshold   time.Duration
+	VSCAckThreshold         time.Duration
+	PendingPacketsThreshold int
+}
+
+func configFromFlags(cmd *cobra.Command) (cfg Config, err error) {
+	flags := cmd.Flags()
+	if cfg.ProviderGRPC, err = flags.GetString(flagProviderGRPC); err != nil {
+		return cfg, err
+	}
+	if cfg.ConsumerGRPC, err = flags.GetString(flagConsumerGRPC); err != nil {
+		return cfg, err
+	}
+	if cfg.ConsumerId, err = flags.GetString(flagConsumerId); err != nil {
+		return cfg, err
+	}
+	if cfg.ListenAddr, err = flags.GetString(flagListenAddr); err != nil {
+		return cfg, err
+	}
+	if cfg.PollInterval, err = flags.GetDuration(flagPollInterval); err != nil {
+		return cfg, err
+	}
+	if cfg.ClientExpiryThreshold, err = flags.GetDuration(flagClientExpiryThreshold); err != nil {
+		return cfg, err
+	}
+	if cfg.VSCAckThreshold, err = flags.GetDuration(flagVSCAckThreshold); err != nil {
+		return cfg, err
+	}
+	if cfg.PendingPacketsThreshold, err = flags.GetInt(flagPendingPacketsThreshold); err != nil {
+		return cfg, err
+	}
+	if cfg.PollInterval <= 0 {
+		return cfg, fmt.Errorf("invalid poll interval: %s", cfg.PollInterval)
+	}
+	return cfg, nil
+}
+
+func run(ctx context.Context, cfg Config) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ctx, cancel := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	logger := log.NewLogger(os.Stdout).With("module", "ccv-watch")
+
+	registry := prometheus.NewRegistry()
+	metrics := NewMetrics(registry)
+
+	watcher, err := NewWatcher(cfg, metrics, logger)
+	if err != nil {
+		return err
+	}
+	defer watcher.Close()
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(registry, promhttp.HandlerOpts{}))
+	server := &http.Server{
+		Addr:              cfg.ListenAddr,
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() {
+		logger.Info("serving metrics", "address", cfg.ListenAddr)
+		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			logger.Error("metrics server stopped", "error", err)
+			cancel()
+		}
+	}()
+
+	watcher.Run(ctx)
+
+	shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer shutdownCancel()
+	return server.Shutdown(shutdownCtx)
+}
@@ -0,0 +1,36 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfigFromFlags(t *testing.T) {
+	cmd := NewRootCmd()
+	require.NoError(t, cmd.ParseFlags([]string{
+		"--consumer-id", "3",
+		"--client-expiry-threshold", "24h",
+		"--vsc-ack-threshold", "30m",
+		"--pending-packets-threshold", "10",
+	}))
+	cfg, err := configFromFlags(cmd)
+	require.NoError(t, err)
+	require.Equal(t, Config{
+		ProviderGRPC:            "localhost:9090",
+		ConsumerGRPC:            "localhost:9092",
+		ConsumerId:              "3",
+		ListenAddr:              ":9200",
+		PollInterval:            30 * time.Second,
+		ClientExpiryThreshold:   24 * time.Hour,
+		VSCAckThreshold:         30 * time.Minute,
+		PendingPacketsThreshold: 10,
+	}, cfg)
+
+	// the poll interval must be positive
+	cmd = NewRootCmd()
+	require.NoError(t, cmd.ParseFlags([]string{"--consumer-id", "3", "--poll-interval", "0s"}))
+	_, err = configFromFlags(cmd)
+	require.Error(t, err)
+}
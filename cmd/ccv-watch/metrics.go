@@ -0,0 +1,102 @@
+package main
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const metricsNamespace = "ccv_watch"
+
+// Alerts exposed through the ccv_watch_alert gauge
+const (
+	AlertClientExpiry    = "client_expiry"
+	AlertClientNotActive = "client_not_active"
+	AlertVSCAck          = "vsc_ack"
+	AlertPendingPackets  = "pending_packets"
+	AlertSlashThrottled  = "slash_throttled"
+	AlertQueryFailure    = "query_failure"
+)
+
+// Metrics holds the Prometheus metrics exposed by the watcher
+type Metrics struct {
+	ClientTimeToExpiry     *prometheus.GaugeVec
+	ClientActive           *prometheus.GaugeVec
+	UnackedVSCPackets      prometheus.Gauge
+	LastVSCAckTimestamp    prometheus.Gauge
+	ConsumerPendingPackets prometheus.Gauge
+	ConsumerWaitingOnReply prometheus.Gauge
+	SlashMeter             prometheus.Gauge
+	SlashMeterAllowance    prometheus.Gauge
+	Alert                  *prometheus.GaugeVec
+	QueryErrors            *prometheus.CounterVec
+}
+
+// NewMetrics creates the watcher metrics and registers them with the given registerer
+func NewMetrics(registerer prometheus.Registerer) *Metrics {
+	m := &Metrics{
+		ClientTimeToExpiry: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "client_time_to_expiry_seconds",
+			Help:      "Time until the client expires, i.e., latest consensus state timestamp plus trusting period minus now",
+		}, []string{"chain", "client_id"}),
+		ClientActive: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "client_active",
+			Help:      "1 if the status of the client is Active, 0 otherwise",
+		}, []string{"chain", "client_id"}),
+		UnackedVSCPackets: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "unacked_vsc_packets",
+			Help:      "Number of VSC packets sent by the provider that are not yet acknowledged",
+		}),
+		LastVSCAckTimestamp: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "last_vsc_ack_timestamp_seconds",
+			Help:      "Unix time at which the watcher last observed that the VSC packets sent by the provider were acknowledged",
+		}),
+		ConsumerPendingPackets: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "consumer_pending_packets",
+			Help:      "Number of packets queued on the consumer chain to be sent to the provider chain",
+		}),
+		ConsumerWaitingOnReply: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "consumer_slash_waiting_on_reply",
+			Help:      "1 if the consumer chain is waiting on the reply to a slash packet, 0 otherwise",
+		}),
+		SlashMeter: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "provider_slash_meter",
+			Help:      "Current value of the slash meter of the provider chain",
+		}),
+		SlashMeterAllowance: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "provider_slash_meter_allowance",
+			Help:      "Allowance of the slash meter of the provider chain per replenish period",
+		}),
+		Alert: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "alert",
+			Help:      "1 if the alert is raised, 0 otherwise",
+		}, []string{"alert"}),
+		QueryErrors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "query_errors_total",
+			Help:      "Number of failed queries",
+		}, []string{"chain", "query"}),
+	}
+
+	registerer.MustRegister(
+		m.ClientTimeToExpiry,
+		m.ClientActive,
+		m.UnackedVSCPackets,
+		m.LastVSCAckTimestamp,
+		m.ConsumerPendingPackets,
+		m.ConsumerWaitingOnReply,
+		m.SlashMeter,
+		m.SlashMeterAllowance,
+		m.Alert,
+		m.QueryErrors,
+	)
+
+	return m
+}
@@ -0,0 +1,313 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+	ibctmtypes "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+
+	consumertypes "github.com/cosmos/interchain-security/v7/x/ccv/consumer/types"
+	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+const (
+	chainProvider = "provider"
+	chainConsumer = "consumer"
+)
+
+// Watcher periodically queries the provider and the consumer chain and updates the metrics
+type Watcher struct {
+	cfg     Config
+	metrics *Metrics
+	logger  log.Logger
+	cdc     *codec.ProtoCodec
+
+	providerConn *grpc.ClientConn
+	consumerConn *grpc.ClientConn
+
+	providerQuery       providertypes.QueryClient
+	consumerQuery       consumertypes.QueryClient
+	providerClientQuery clienttypes.QueryClient
+	consumerClientQuery clienttypes.QueryClient
+	providerChanQuery   channeltypes.QueryClient
+
+	// lastVSCAck is the last time the watcher observed that VSC packets were acknowledged
+	lastVSCAck time.Time
+	// oldestUnackedVSC is the sequence of the oldest VSC packet that was not acknowledged
+	oldestUnackedVSC uint64
+	// alerts holds the alerts that are currently raised
+	alerts map[string]bool
+}
+
+// NewWatcher creates a watcher connected to the gRPC endpoints of the given config
+func NewWatcher(cfg Config, metrics *Metrics, logger log.Logger) (*Watcher, error) {
+	cdc := newCodec()
+
+	dial := func(target string) (*grpc.ClientConn, error) {
+		return grpc.NewClient(
+			target,
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+			grpc.WithDefaultCallOptions(grpc.ForceCodec(cdc.GRPCCodec())),
+		)
+	}
+	providerConn, err := dial(cfg.ProviderGRPC)
+	if err != nil {
+		return nil, fmt.Errorf("cannot connect to provider gRPC endpoint %s: %w", cfg.ProviderGRPC, err)
+	}
+	consumerConn, err := dial(cfg.ConsumerGRPC)
+	if err != nil {
+		providerConn.Close()
+		return nil, fmt.Errorf("cannot connect to consumer gRPC endpoint %s: %w", cfg.ConsumerGRPC, err)
+	}
+
+	return &Watcher{
+		cfg:                 cfg,
+		metrics:             metrics,
+		logger:              logger,
+		cdc:                 cdc,
+		providerConn:        providerConn,
+		consumerConn:        consumerConn,
+		providerQuery:       providertypes.NewQueryClient(providerConn),
+		consumerQuery:       consumertypes.NewQueryClient(consumerConn),
+		providerClientQuery: clienttypes.NewQueryClient(providerConn),
+		consumerClientQuery: clienttypes.NewQueryClient(consumerConn),
+		providerChanQuery:   channeltypes.NewQueryClient(providerConn),
+		lastVSCAck:          time.Now(),
+		alerts:              map[string]bool{},
+	}, nil
+}
+
+// newCodec returns the codec used to decode the client and consensus states of the Tendermint clients
+func newCodec() *codec.ProtoCodec {
+	interfaceRegistry := codectypes.NewInterfaceRegistry()
+	clienttypes.RegisterInterfaces(interfaceRegistry)
+	ibctmtypes.RegisterInterfaces(interfaceRegistry)
+	return codec.NewProtoCodec(interfaceRegistry)
+}
+
+// Close closes the gRPC connections of the watcher
+func (w *Watcher) Close() {
+	w.providerConn.Close()
+	w.consumerConn.Close()
+}
+
+// Run polls the chains until the given context is done
+func (w *Watcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		w.Poll(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Poll queries the chains once and updates the metrics and alerts
+func (w *Watcher) Poll(ctx context.Context) {
+	now := time.Now()
+	alerts := map[string]bool{}
+
+	queryFailed := func(chain, query string, err error) {
+		w.logger.Error("query failed", "chain", chain, "query", query, "error", err)
+		w.metrics.QueryErrors.WithLabelValues(chain, query).Inc()
+		alerts[AlertQueryFailure] = true
+	}
+
+	// the client to the consumer chain on the provider chain
+	consumerChain, err := w.providerQuery.QueryConsumerChain(ctx,
+		&providertypes.QueryConsumerChainRequest{ConsumerId: w.cfg.ConsumerId})
+	if err != nil {
+		queryFailed(chainProvider, "consumer_chain", err)
+	} else if consumerChain.ClientId != "" {
+		w.watchClient(ctx, now, chainProvider, w.providerClientQuery, consumerChain.ClientId, alerts, queryFailed)
+	}
+
+	// the client to the provider chain on the consumer chain and the CCV channel
+	providerInfo, err := w.consumerQuery.QueryProviderInfo(ctx, &consumertypes.QueryProviderInfoRequest{})
+	if err != nil {
+		queryFailed(chainConsumer, "provider_info", err)
+	} else {
+		w.watchClient(ctx, now, chainConsumer, w.consumerClientQuery, providerInfo.Consumer.ClientID, alerts, queryFailed)
+		w.watchVSCAcks(ctx, now, providerInfo.Provider.ChannelID, alerts, queryFailed)
+	}
+
+	// the queue and throttle state of the consumer chain
+	consumerThrottle, err := w.consumerQuery.QueryThrottleState(ctx, &consumertypes.QueryThrottleStateRequest{})
+	if err != nil {
+		queryFailed(chainConsumer, "throttle_state", err)
+	} else {
+		pending := len(consumerThrottle.PacketDataQueue)
+		w.metrics.ConsumerPendingPackets.Set(float64(pending))
+		if pending > w.cfg.PendingPacketsThreshold {
+			alerts[AlertPendingPackets] = true
+		}
+		waitingOnReply := consumerThrottle.SlashRecord != nil && consumerThrottle.SlashRecord.WaitingOnReply
+		w.metrics.ConsumerWaitingOnReply.Set(boolToFloat(waitingOnReply))
+	}
+
+	// the throttle state of the provider chain
+	providerThrottle, err := w.providerQuery.QueryThrottleState(ctx, &providertypes.QueryThrottleStateRequest{})
+	if err != nil {
+		queryFailed(chainProvider, "throttle_state", err)
+	} else {
+		w.metrics.SlashMeter.Set(float64(providerThrottle.SlashMeter))
+		w.metrics.SlashMeterAllowance.Set(float64(providerThrottle.SlashMeterAllowance))
+		if providerThrottle.SlashMeter < 0 {
+			alerts[AlertSlashThrottled] = true
+		}
+	}
+
+	w.updateAlerts(alerts)
+}
+
+// watchClient updates the metrics of the given client
+func (w *Watcher) watchClient(
+	ctx context.Context,
+	now time.Time,
+	chain string,
+	queryClient clienttypes.QueryClient,
+	clientID string,
+	alerts map[string]bool,
+	queryFailed func(chain, query string, err error),
+) {
+	status, err := queryClient.ClientStatus(ctx, &clienttypes.QueryClientStatusRequest{ClientId: clientID})
+	if err != nil {
+		queryFailed(chain, "client_status", err)
+		return
+	}
+	active := status.Status == ibcexported.Active.String()
+	w.metrics.ClientActive.WithLabelValues(chain, clientID).Set(boolToFloat(active))
+	if !active {
+		alerts[AlertClientNotActive] = true
+	}
+
+	clientStateResp, err := queryClient.ClientState(ctx, &clienttypes.QueryClientStateRequest{ClientId: clientID})
+	if err != nil {
+		queryFailed(chain, "client_state", err)
+		return
+	}
+	var clientState ibcexported.ClientState
+	if err := w.cdc.UnpackAny(clientStateResp.ClientState, &clientState); err != nil {
+		queryFailed(chain, "client_state", err)
+		return
+	}
+	tmClientState, ok := clientState.(*ibctmtypes.ClientState)
+	if !ok {
+		queryFailed(chain, "client_state", fmt.Errorf("unexpected client type %s", clientState.ClientType()))
+		return
+	}
+
+	consensusStateResp, err := queryClient.ConsensusState(ctx, &clienttypes.QueryConsensusStateRequest{
+		ClientId:     clientID,
+		LatestHeight: true,
+	})
+	if err != nil {
+		queryFailed(chain, "consensus_state", err)
+		return
+	}
+	var consensusState ibcexported.ConsensusState
+	if err := w.cdc.UnpackAny(consensusStateResp.ConsensusState, &consensusState); err != nil {
+		queryFailed(chain, "consensus_state", err)
+		return
+	}
+	tmConsensusState, ok := consensusState.(*ibctmtypes.ConsensusState)
+	if !ok {
+		queryFailed(chain, "consensus_state", fmt.Errorf("unexpected consensus state type %s", consensusState.ClientType()))
+		return
+	}
+
+	timeToExpiry := tmConsensusState.Timestamp.Add(tmClientState.TrustingPeriod).Sub(now)
+	w.metrics.ClientTimeToExpiry.WithLabelValues(chain, clientID).Set(timeToExpiry.Seconds())
+	if timeToExpiry < w.cfg.ClientExpiryThreshold {
+		alerts[AlertClientExpiry] = true
+	}
+}
+
+// watchVSCAcks updates the metrics of the VSC packets sent by the provider on the given channel.
+// The VSC packets are considered acknowledged once their packet commitments are removed.
+func (w *Watcher) watchVSCAcks(
+	ctx context.Context,
+	now time.Time,
+	channelID string,
+	alerts map[string]bool,
+	queryFailed func(chain, query string, err error),
+) {
+	commitments, err := w.providerChanQuery.PacketCommitments(ctx, &channeltypes.QueryPacketCommitmentsRequest{
+		PortId:    ccv.ProviderPortID,
+		ChannelId: channelID,
+	})
+	if err != nil {
+		queryFailed(chainProvider, "packet_commitments", err)
+		return
+	}
+
+	unacked := len(commitments.Commitments)
+	w.metrics.UnackedVSCPackets.Set(float64(unacked))
+
+	if unacked == 0 {
+		w.lastVSCAck = now
+		w.oldestUnackedVSC = 0
+	} else {
+		oldest := commitments.Commitments[0].Sequence
+		for _, commitment := range commitments.Commitments {
+			if commitment.Sequence < oldest {
+				oldest = commitment.Sequence
+			}
+		}
+		if oldest != w.oldestUnackedVSC {
+			// the previously oldest VSC packet was acknowledged
+			if w.oldestUnackedVSC != 0 {
+				w.lastVSCAck = now
+			}
+			w.oldestUnackedVSC = oldest
+		}
+		if now.Sub(w.lastVSCAck) > w.cfg.VSCAckThreshold {
+			alerts[AlertVSCAck] = true
+		}
+	}
+	w.metrics.LastVSCAckTimestamp.Set(float64(w.lastVSCAck.Unix()))
+}
+
+// updateAlerts sets the alert gauges and logs the alerts that are raised or resolved
+func (w *Watcher) updateAlerts(alerts map[string]bool) {
+	for _, alert := range []string{
+		AlertClientExpiry,
+		AlertClientNotActive,
+		AlertVSCAck,
+		AlertPendingPackets,
+		AlertSlashThrottled,
+		AlertQueryFailure,
+	} {
+		raised := alerts[alert]
+		w.metrics.Alert.WithLabelValues(alert).Set(boolToFloat(raised))
+		if raised && !w.alerts[alert] {
+			w.logger.Warn("alert raised", "alert", alert, "consumerId", w.cfg.ConsumerId)
+		} else if !raised && w.alerts[alert] {
+			w.logger.Info("alert resolved", "alert", alert, "consumerId", w.cfg.ConsumerId)
+		}
+	}
+	w.alerts = alerts
+}
+
+func boolToFloat(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,265 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v10/modules/core/23-commitment/types"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+	ibctmtypes "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"cosmossdk.io/log"
+
+	consumertypes "github.com/cosmos/interchain-security/v7/x/ccv/consumer/types"
+	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// fakeClientQuery returns the status, the client state, and the latest consensus state of a Tendermint client
+type fakeClientQuery struct {
+	clienttypes.QueryClient
+	status         string
+	trustingPeriod time.Duration
+	timestamp      time.Time
+	err            error
+}
+
+func (q *fakeClientQuery) ClientStatus(context.Context, *clienttypes.QueryClientStatusRequest, ...grpc.CallOption) (*clienttypes.QueryClientStatusResponse, error) {
+	if q.err != nil {
+		return nil, q.err
+	}
+	return &clienttypes.QueryClientStatusResponse{Status: q.status}, nil
+}
+
+func (q *fakeClientQuery) ClientState(context.Context, *clienttypes.QueryClientStateRequest, ...grpc.CallOption) (*clienttypes.QueryClientStateResponse, error) {
+	clientState := ibctmtypes.NewClientState("chain", ibctmtypes.DefaultTrustLevel, q.trustingPeriod, 3*q.trustingPeriod,
+		10*time.Second, clienttypes.NewHeight(0, 10), commitmenttypes.GetSDKSpecs(), nil)
+	any, err := clienttypes.PackClientState(clientState)
+	if err != nil {
+		return nil, err
+	}
+	return &clienttypes.QueryClientStateResponse{ClientState: any}, nil
+}
+
+func (q *fakeClientQuery) ConsensusState(context.Context, *clienttypes.QueryConsensusStateRequest, ...grpc.CallOption) (*clienttypes.QueryConsensusStateResponse, error) {
+	consensusState := ibctmtypes.NewConsensusState(q.timestamp, commitmenttypes.NewMerkleRoot([]byte("apphash")), []byte("valhash"))
+	any, err := clienttypes.PackConsensusState(consensusState)
+	if err != nil {
+		return nil, err
+	}
+	return &clienttypes.QueryConsensusStateResponse{ConsensusState: any}, nil
+}
+
+// fakeChanQuery returns the packet commitments of the VSC packets with the given sequences
+type fakeChanQuery struct {
+	channeltypes.QueryClient
+	sequences []uint64
+}
+
+func (q *fakeChanQuery) PacketCommitments(context.Context, *channeltypes.QueryPacketCommitmentsRequest, ...grpc.CallOption) (*channeltypes.QueryPacketCommitmentsResponse, error) {
+	commitments := []*channeltypes.PacketState{}
+	for _, seq := range q.sequences {
+		commitments = append(commitments, &channeltypes.PacketState{Sequence: seq})
+	}
+	return &channeltypes.QueryPacketCommitmentsResponse{Commitments: commitments}, nil
+}
+
+type fakeProviderQuery struct {
+	providertypes.QueryClient
+	throttleState providertypes.QueryThrottleStateResponse
+}
+
+func (q *fakeProviderQuery) QueryConsumerChain(context.Context, *providertypes.QueryConsumerChainRequest, ...grpc.CallOption) (*providertypes.QueryConsumerChainResponse, error) {
+	return &providertypes.QueryConsumerChainResponse{ClientId: "07-tendermint-0"}, nil
+}
+
+func (q *fakeProviderQuery) QueryThrottleState(context.Context, *providertypes.QueryThrottleStateRequest, ...grpc.CallOption) (*providertypes.QueryThrottleStateResponse, error) {
+	return &q.throttleState, nil
+}
+
+type fakeConsumerQuery struct {
+	consumertypes.QueryClient
+	throttleState consumertypes.QueryThrottleStateResponse
+}
+
+func (q *fakeConsumerQuery) QueryProviderInfo(context.Context, *consumertypes.QueryProviderInfoRequest, ...grpc.CallOption) (*consumertypes.QueryProviderInfoResponse, error) {
+	return &consumertypes.QueryProviderInfoResponse{
+		Consumer: consumertypes.ChainInfo{ClientID: "07-tendermint-1"},
+		Provider: consumertypes.ChainInfo{ChannelID: "channel-0"},
+	}, nil
+}
+
+func (q *fakeConsumerQuery) QueryThrottleState(context.Context, *consumertypes.QueryThrottleStateRequest, ...grpc.CallOption) (*consumertypes.QueryThrottleStateResponse, error) {
+	return &q.throttleState, nil
+}
+
+func newTestWatcher(t *testing.T) *Watcher {
+	t.Helper()
+	return &Watcher{
+		cfg: Config{
+			ConsumerId:              "0",
+			ClientExpiryThreshold:   72 * time.Hour,
+			VSCAckThreshold:         time.Hour,
+			PendingPacketsThreshold: 2,
+		},
+		metrics: NewMetrics(prometheus.NewRegistry()),
+		logger:  log.NewNopLogger(),
+		cdc:     newCodec(),
+		alerts:  map[string]bool{},
+	}
+}
+
+// TestWatchClient tests that the time to expiry of a client is decoded from its client and consensus states,
+// and that the alerts are raised once the client is about to expire or is not active
+func TestWatchClient(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	trustingPeriod := 14 * 24 * time.Hour
+
+	testCases := []struct {
+		name                 string
+		query                *fakeClientQuery
+		expectedTimeToExpiry time.Duration
+		expectedAlerts       map[string]bool
+	}{
+		{
+			"active client far from expiry",
+			&fakeClientQuery{status: ibcexported.Active.String(), trustingPeriod: trustingPeriod, timestamp: now.Add(-10 * 24 * time.Hour)},
+			4 * 24 * time.Hour,
+			map[string]bool{},
+		},
+		{
+			"active client expiring within the threshold",
+			&fakeClientQuery{status: ibcexported.Active.String(), trustingPeriod: trustingPeriod, timestamp: now.Add(-13 * 24 * time.Hour)},
+			24 * time.Hour,
+			map[string]bool{AlertClientExpiry: true},
+		},
+		{
+			"expired client",
+			&fakeClientQuery{status: ibcexported.Expired.String(), trustingPeriod: trustingPeriod, timestamp: now.Add(-15 * 24 * time.Hour)},
+			-24 * time.Hour,
+			map[string]bool{AlertClientNotActive: true, AlertClientExpiry: true},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := newTestWatcher(t)
+			alerts := map[string]bool{}
+			w.watchClient(context.Background(), now, chainProvider, tc.query, "07-tendermint-0", alerts,
+				func(chain, query string, err error) { t.Fatalf("unexpected query failure: %s %s %v", chain, query, err) })
+
+			require.Equal(t, tc.expectedAlerts, alerts)
+			require.Equal(t, tc.expectedTimeToExpiry.Seconds(),
+				testutil.ToFloat64(w.metrics.ClientTimeToExpiry.WithLabelValues(chainProvider, "07-tendermint-0")))
+			require.Equal(t, boolToFloat(tc.query.status == ibcexported.Active.String()),
+				testutil.ToFloat64(w.metrics.ClientActive.WithLabelValues(chainProvider, "07-tendermint-0")))
+		})
+	}
+}
+
+// TestWatchClientQueryFailure tests that a failed query is reported and stops the watch of the client
+func TestWatchClientQueryFailure(t *testing.T) {
+	w := newTestWatcher(t)
+	failures := []string{}
+	w.watchClient(context.Background(), time.Now(), chainConsumer, &fakeClientQuery{err: errors.New("unavailable")},
+		"07-tendermint-1", map[string]bool{}, func(chain, query string, _ error) { failures = append(failures, chain+"/"+query) })
+	require.Equal(t, []string{"consumer/client_status"}, failures)
+}
+
+// TestWatchVSCAcks tests that the last acknowledgement of the VSC packets is updated once the oldest
+// unacknowledged VSC packet changes, and that an alert is raised once it exceeds the threshold
+func TestWatchVSCAcks(t *testing.T) {
+	w := newTestWatcher(t)
+	chanQuery := &fakeChanQuery{}
+	w.providerChanQuery = chanQuery
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	steps := []struct {
+		elapsed         time.Duration
+		sequences       []uint64
+		expectedLastAck time.Duration
+		expectedAlert   bool
+	}{
+		// all the VSC packets are acknowledged
+		{0, nil, 0, false},
+		// new VSC packets are sent
+		{30 * time.Minute, []uint64{3, 2}, 0, false},
+		// the VSC packets are not acknowledged within the threshold
+		{90 * time.Minute, []uint64{3, 2, 4}, 0, true},
+		// the oldest VSC packet is acknowledged
+		{100 * time.Minute, []uint64{4, 3}, 100 * time.Minute, false},
+		// all the VSC packets are acknowledged
+		{3 * time.Hour, nil, 3 * time.Hour, false},
+	}
+
+	for i, step := range steps {
+		chanQuery.sequences = step.sequences
+		alerts := map[string]bool{}
+		w.watchVSCAcks(context.Background(), start.Add(step.elapsed), "channel-0", alerts,
+			func(chain, query string, err error) { t.Fatalf("unexpected query failure: %s %s %v", chain, query, err) })
+
+		require.Equal(t, step.expectedAlert, alerts[AlertVSCAck], "step %d", i)
+		require.Equal(t, start.Add(step.expectedLastAck), w.lastVSCAck, "step %d", i)
+		require.Equal(t, float64(len(step.sequences)), testutil.ToFloat64(w.metrics.UnackedVSCPackets), "step %d", i)
+		require.Equal(t, float64(start.Add(step.expectedLastAck).Unix()), testutil.ToFloat64(w.metrics.LastVSCAckTimestamp), "step %d", i)
+	}
+}
+
+// TestPoll tests that the alerts on the pending packets and the slash meter are raised and resolved
+func TestPoll(t *testing.T) {
+	w := newTestWatcher(t)
+	providerQuery := &fakeProviderQuery{}
+	consumerQuery := &fakeConsumerQuery{}
+	clientQuery := &fakeClientQuery{
+		status:         ibcexported.Active.String(),
+		trustingPeriod: 14 * 24 * time.Hour,
+		timestamp:      time.Now(),
+	}
+	w.providerQuery = providerQuery
+	w.consumerQuery = consumerQuery
+	w.providerClientQuery = clientQuery
+	w.consumerClientQuery = clientQuery
+	w.providerChanQuery = &fakeChanQuery{}
+	w.lastVSCAck = time.Now()
+
+	alert := func(name string) float64 {
+		return testutil.ToFloat64(w.metrics.Alert.WithLabelValues(name))
+	}
+
+	// the consumer has more pending packets than the threshold and the slash meter is negative
+	consumerQuery.throttleState = consumertypes.QueryThrottleStateResponse{
+		SlashRecord:     &consumertypes.SlashRecord{WaitingOnReply: true},
+		PacketDataQueue: make([]ccv.ConsumerPacketData, 3),
+	}
+	providerQuery.throttleState = providertypes.QueryThrottleStateResponse{SlashMeter: -5, SlashMeterAllowance: 10}
+	w.Poll(context.Background())
+
+	require.Equal(t, map[string]bool{AlertPendingPackets: true, AlertSlashThrottled: true}, w.alerts)
+	require.Equal(t, float64(1), alert(AlertPendingPackets))
+	require.Equal(t, float64(1), alert(AlertSlashThrottled))
+	require.Equal(t, float64(0), alert(AlertClientExpiry))
+	require.Equal(t, float64(3), testutil.ToFloat64(w.metrics.ConsumerPendingPackets))
+	require.Equal(t, float64(1), testutil.ToFloat64(w.metrics.ConsumerWaitingOnReply))
+	require.Equal(t, float64(-5), testutil.ToFloat64(w.metrics.SlashMeter))
+	require.Equal(t, float64(10), testutil.ToFloat64(w.metrics.SlashMeterAllowance))
+
+	// the packets are sent and the slash meter is replenished, so the alerts are resolved
+	consumerQuery.throttleState = consumertypes.QueryThrottleStateResponse{
+		PacketDataQueue: make([]ccv.ConsumerPacketData, 2),
+	}
+	providerQuery.throttleState = providertypes.QueryThrottleStateResponse{SlashMeter: 10, SlashMeterAllowance: 10}
+	w.Poll(context.Background())
+
+	require.Empty(t, w.alerts)
+	require.Equal(t, float64(0), alert(AlertPendingPackets))
+	require.Equal(t, float64(0), alert(AlertSlashThrottled))
+	require.Equal(t, float64(0), testutil.ToFloat64(w.metrics.ConsumerWaitingOnReply))
+}
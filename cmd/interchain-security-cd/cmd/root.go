@@ -35,6 +35,8 @@ import (
 
 	consumer "github.com/cosmos/interchain-security/v7/app/consumer"
 	appencoding "github.com/cosmos/interchain-security/v7/app/encoding"
+	ccvconsumerclient "github.com/cosmos/interchain-security/v7/x/ccv/consumer/client"
+	ccvtypes "github.com/cosmos/interchain-security/v7/x/ccv/types"
 )
 
 // NewRootCmd creates a new root command for simd. It is called once in the
@@ -216,7 +218,8 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig appencoding.EncodingConf
 	// add keybase, auxiliary RPC, query, genesis, and tx child commands
 	rootCmd.AddCommand(
 		server.StatusCommand(),
-		genesisCommand(encodingConfig, consumer.GetConsumerGenesisTransformCmd()),
+		genesisCommand(encodingConfig, consumer.GetConsumerGenesisTransformCmd(), consumer.GetDevnetConsumerGenesisCmd(),
+			consumer.GetProviderSwitchMsgCmd()),
 		queryCommand(),
 		txCommand(),
 		keys.Commands(),
@@ -284,6 +287,8 @@ func appExport(
 
 func addModuleInitFlags(startCmd *cobra.Command) {
 	crisis.AddModuleInitFlags(startCmd)
+	ccvtypes.AddLogFlags(startCmd)
+	ccvconsumerclient.AddProviderRPCFlag(startCmd)
 }
 
 // genesisCommand builds genesis-related `simd genesis` command. Users may provide application specific commands as a parameter
@@ -39,6 +39,8 @@ import (
 
 	cdd "github.com/cosmos/interchain-security/v7/app/consumer-democracy"
 	appencoding "github.com/cosmos/interchain-security/v7/app/encoding"
+	ccvconsumerclient "github.com/cosmos/interchain-security/v7/x/ccv/consumer/client"
+	ccvtypes "github.com/cosmos/interchain-security/v7/x/ccv/types"
 )
 
 // NewRootCmd creates a new root command for simd. It is called once in the
@@ -306,6 +308,8 @@ func appExport(
 
 func addModuleInitFlags(startCmd *cobra.Command) {
 	crisis.AddModuleInitFlags(startCmd)
+	ccvtypes.AddLogFlags(startCmd)
+	ccvconsumerclient.AddProviderRPCFlag(startCmd)
 }
 
 // genesisCommand builds genesis-related `simd genesis` command. Users may provide application specific commands as a parameter
@@ -39,6 +39,7 @@ import (
 
 	appEncoding "github.com/cosmos/interchain-security/v7/app/encoding"
 	providerApp "github.com/cosmos/interchain-security/v7/app/provider"
+	ccvtypes "github.com/cosmos/interchain-security/v7/x/ccv/types"
 )
 
 // NewRootCmd creates a new root command for simd. It is called once in the
@@ -229,6 +230,7 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig appEncoding.EncodingConf
 
 func addModuleInitFlags(startCmd *cobra.Command) {
 	crisis.AddModuleInitFlags(startCmd)
+	ccvtypes.AddLogFlags(startCmd)
 }
 
 func queryCommand() *cobra.Command {
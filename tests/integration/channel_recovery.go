@@ -0,0 +1,115 @@
+package integration
+
+import (
+	"time"
+
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+
+	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// TestVSCPacketTimeoutRecovery tests that the timeout of a VSC packet does not remove the consumer chain
+// and that the VSC packet is sent again on a new CCV channel that replaces the closed one.
+// @Long Description@
+// * Set up a CCV channel and set a CCV timeout period shorter than the trusting period of the clients.
+// * Send a VSC packet and let it time out on the provider chain, which closes the CCV channel.
+// * Check that the consumer chain is still launched and that the VSC packet is queued again.
+// * Relay the closing of the channel to the consumer chain and open a new CCV channel on the same connection.
+// * Check that the VSC packet is sent on the new channel and that both chains use the new channel.
+func (s *CCVTestSuite) TestVSCPacketTimeoutRecovery() {
+	s.SetupCCVChannel(s.path)
+	s.SendEmptyVSCPacket()
+
+	providerKeeper := s.providerApp.GetProviderKeeper()
+	consumerKeeper := s.consumerApp.GetConsumerKeeper()
+	consumerId := s.getFirstBundle().ConsumerId
+
+	params := providerKeeper.GetParams(s.providerCtx())
+	params.CcvTimeoutPeriod = time.Hour
+	providerKeeper.SetParams(s.providerCtx(), params)
+
+	// send a VSC packet at the end of the epoch
+	valsetUpdateId := providerKeeper.GetValidatorSetUpdateId(s.providerCtx())
+	providerKeeper.AppendPendingVSCPackets(s.providerCtx(), consumerId,
+		ccv.ValidatorSetChangePacketData{ValsetUpdateId: valsetUpdateId})
+	s.nextEpoch()
+	s.Require().Empty(providerKeeper.GetPendingVSCPackets(s.providerCtx(), consumerId))
+
+	channelID := s.path.EndpointB.ChannelID
+	commitments := s.providerApp.GetIBCKeeper().ChannelKeeper.GetAllPacketCommitmentsAtChannel(
+		s.providerCtx(), ccv.ProviderPortID, channelID)
+	s.Require().NotEmpty(commitments)
+	packet, found := s.getSentPacket(s.providerChain, commitments[len(commitments)-1].Sequence, channelID)
+	s.Require().True(found)
+
+	// the VSC packet times out
+	incrementTime(s, 2*time.Hour)
+	err := s.path.EndpointB.TimeoutPacket(packet)
+	s.Require().NoError(err)
+	s.Require().Equal(channeltypes.CLOSED, s.path.EndpointB.GetChannel().State)
+
+	// the consumer chain is not removed and the VSC packet is queued again
+	s.Require().Equal(providertypes.CONSUMER_PHASE_LAUNCHED, providerKeeper.GetConsumerPhase(s.providerCtx(), consumerId))
+	_, found = providerKeeper.GetConsumerChannelRecoveryTime(s.providerCtx(), consumerId)
+	s.Require().True(found)
+	pendingPackets := providerKeeper.GetPendingVSCPackets(s.providerCtx(), consumerId)
+	s.Require().Len(pendingPackets, 1)
+	s.Require().Equal(valsetUpdateId, pendingPackets[0].ValsetUpdateId)
+
+	// the VSC packet is held while the channel is closed
+	s.nextEpoch()
+	s.Require().Len(providerKeeper.GetPendingVSCPackets(s.providerCtx(), consumerId), 1)
+
+	// relay the closing of the channel to the consumer chain
+	err = s.path.EndpointA.UpdateClient()
+	s.Require().NoError(err)
+	proof, proofHeight := s.path.EndpointB.QueryProof(host.ChannelKey(ccv.ProviderPortID, channelID))
+	_, err = s.consumerChain.SendMsgs(channeltypes.NewMsgChannelCloseConfirm(
+		ccv.ConsumerPortID, s.path.EndpointA.ChannelID, proof, proofHeight,
+		s.consumerChain.SenderAccount.GetAddress().String(),
+	))
+	s.Require().NoError(err)
+	s.Require().Equal(channeltypes.CLOSED, s.path.EndpointA.GetChannel().State)
+	providerChannel, found := consumerKeeper.GetProviderChannel(s.consumerCtx())
+	s.Require().True(found)
+	s.Require().Equal(s.path.EndpointA.ChannelID, providerChannel)
+
+	// open a new CCV channel on the same connection
+	recoveryPath := ibctesting.NewPath(s.consumerChain, s.providerChain)
+	recoveryPath.EndpointA.ClientID = s.path.EndpointA.ClientID
+	recoveryPath.EndpointB.ClientID = s.path.EndpointB.ClientID
+	recoveryPath.EndpointA.ConnectionID = s.path.EndpointA.ConnectionID
+	recoveryPath.EndpointB.ConnectionID = s.path.EndpointB.ConnectionID
+	recoveryPath.EndpointA.ChannelConfig.PortID = ccv.ConsumerPortID
+	recoveryPath.EndpointB.ChannelConfig.PortID = ccv.ProviderPortID
+	recoveryPath.EndpointA.ChannelConfig.Version = ccv.Version
+	recoveryPath.EndpointB.ChannelConfig.Version = ccv.Version
+	recoveryPath.EndpointA.ChannelConfig.Order = channeltypes.ORDERED
+	recoveryPath.EndpointB.ChannelConfig.Order = channeltypes.ORDERED
+	recoveryPath.CreateChannels()
+
+	// the VSC packet is sent on the new channel
+	s.nextEpoch()
+	s.Require().Empty(providerKeeper.GetPendingVSCPackets(s.providerCtx(), consumerId))
+	_, found = providerKeeper.GetConsumerChannelRecoveryTime(s.providerCtx(), consumerId)
+	s.Require().False(found)
+	newChannelID, found := providerKeeper.GetConsumerIdToChannelId(s.providerCtx(), consumerId)
+	s.Require().True(found)
+	s.Require().Equal(recoveryPath.EndpointB.ChannelID, newChannelID)
+	_, found = providerKeeper.GetChannelIdToConsumerId(s.providerCtx(), channelID)
+	s.Require().False(found)
+
+	relayAllCommittedPackets(s, s.providerChain, recoveryPath, ccv.ProviderPortID, recoveryPath.EndpointB.ChannelID, 1)
+
+	// the consumer chain uses the new channel
+	providerChannel, found = consumerKeeper.GetProviderChannel(s.consumerCtx())
+	s.Require().True(found)
+	s.Require().Equal(recoveryPath.EndpointA.ChannelID, providerChannel)
+	_, found = consumerKeeper.GetPreviousProviderChannel(s.consumerCtx())
+	s.Require().False(found)
+	_, found = consumerKeeper.GetMigrationProviderChannel(s.consumerCtx())
+	s.Require().False(found)
+}
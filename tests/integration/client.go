@@ -0,0 +1,103 @@
+package integration
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	consumerclient "github.com/cosmos/interchain-security/v7/x/ccv/consumer/client"
+	providerclient "github.com/cosmos/interchain-security/v7/x/ccv/provider/client"
+	providerkeeper "github.com/cosmos/interchain-security/v7/x/ccv/provider/keeper"
+	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+)
+
+// TestQueryAndTxClients tests the typed Go clients of the provider and consumer modules.
+// @Long Description@
+// * Set up a CCV channel and send an empty VSC packet.
+// * Query the consumer and provider chains through the typed clients and verify that
+// the results match the state of the chains.
+// * Build key assignment and commission rate messages with the client helpers,
+// deliver them to the provider, and verify the results through the typed clients.
+func (s *CCVTestSuite) TestQueryAndTxClients() {
+	s.SetupCCVChannel(s.path)
+	s.SendEmptyVSCPacket()
+
+	ctx := context.Background()
+	consumerId := s.getFirstBundle().ConsumerId
+	providerClient := providerclient.NewClient(&baseapp.QueryServiceTestHelper{
+		GRPCQueryRouter: s.providerApp.GetBaseApp().GRPCQueryRouter(),
+		Ctx:             s.providerCtx(),
+	})
+	consumerClient := consumerclient.NewClient(&baseapp.QueryServiceTestHelper{
+		GRPCQueryRouter: s.consumerApp.GetBaseApp().GRPCQueryRouter(),
+		Ctx:             s.consumerCtx(),
+	})
+
+	// the consumer chain is launched and can be found from its client id on the provider
+	chains, err := providerClient.ConsumerChains(ctx, providertypes.CONSUMER_PHASE_LAUNCHED)
+	s.Require().NoError(err)
+	s.Require().NotEmpty(chains)
+	s.Require().Equal(consumerId, chains[0].ConsumerId)
+
+	_, providerInfo, err := consumerClient.ProviderInfo(ctx)
+	s.Require().NoError(err)
+	id, err := providerClient.ConsumerIdFromClientId(ctx, providerInfo.ClientID)
+	s.Require().NoError(err)
+	s.Require().Equal(consumerId, id)
+
+	consumerParams, err := consumerClient.Params(ctx)
+	s.Require().NoError(err)
+	s.Require().True(consumerParams.Enabled)
+
+	consumerValidators, err := providerClient.ConsumerValidators(ctx, consumerId)
+	s.Require().NoError(err)
+	s.Require().Len(consumerValidators, len(s.providerChain.Vals.Validators))
+
+	// assign a consumer key to a validator using the client helpers
+	validator, valAddr := s.getValByIdx(0)
+	providerConsAddr, err := validator.GetConsAddr()
+	s.Require().NoError(err)
+	consumerPubKey := ed25519.GenPrivKey().PubKey()
+
+	consumerKey, err := providerclient.ConsumerKeyFromPubKey(consumerPubKey)
+	s.Require().NoError(err)
+	parsedPubKey, err := providerclient.ParseConsumerKey(consumerKey)
+	s.Require().NoError(err)
+	s.Require().True(consumerPubKey.Equals(parsedPubKey))
+
+	providerKeeper := s.providerApp.GetProviderKeeper()
+	msgServer := providerkeeper.NewMsgServerImpl(&providerKeeper)
+
+	assignMsg, err := providerclient.NewMsgAssignConsumerKey(consumerId, valAddr, consumerPubKey)
+	s.Require().NoError(err)
+	_, err = msgServer.AssignConsumerKey(s.providerCtx(), assignMsg)
+	s.Require().NoError(err)
+
+	consumerAddr, err := providerClient.ValidatorConsumerAddr(ctx, consumerId, sdk.ConsAddress(providerConsAddr).String())
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.ConsAddress(consumerPubKey.Address()).String(), consumerAddr)
+	providerAddr, err := providerClient.ValidatorProviderAddr(ctx, consumerId, consumerAddr)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.ConsAddress(providerConsAddr).String(), providerAddr)
+
+	// set a consumer commission rate using the client helpers
+	rate := math.LegacyNewDecWithPrec(15, 2)
+	commissionMsg, err := providerclient.NewMsgSetConsumerCommissionRate(consumerId, valAddr, rate)
+	s.Require().NoError(err)
+	_, err = msgServer.SetConsumerCommissionRate(s.providerCtx(), commissionMsg)
+	s.Require().NoError(err)
+
+	commissionRate, err := providerClient.ValidatorConsumerCommissionRate(ctx, consumerId, sdk.ConsAddress(providerConsAddr).String())
+	s.Require().NoError(err)
+	s.Require().Equal(rate, commissionRate)
+
+	// invalid messages are rejected by the client helpers
+	_, err = providerclient.NewMsgSetConsumerCommissionRate(consumerId, valAddr, math.LegacyNewDec(2))
+	s.Require().Error(err)
+	_, err = providerclient.NewMsgAssignConsumerKey("invalid", valAddr, consumerPubKey)
+	s.Require().Error(err)
+}
@@ -305,7 +305,7 @@ func (suite *CCVTestSuite) SendEmptyVSCPacket() {
 	valUpdateID := providerKeeper.GetValidatorSetUpdateId(suite.providerChain.GetContext())
 
 	pd := ccv.NewValidatorSetChangePacketData(
-		[]abci.ValidatorUpdate{},
+		ccv.NewValsetDiff(),
 		valUpdateID,
 		nil,
 	)
@@ -0,0 +1,191 @@
+package integration
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/cosmos/gogoproto/proto"
+	icahost "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/host"
+	icahosttypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+	"github.com/stretchr/testify/require"
+
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	appProvider "github.com/cosmos/interchain-security/v7/app/provider"
+	icstestingutils "github.com/cosmos/interchain-security/v7/testutil/ibc_testing"
+	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+)
+
+const (
+	icaControllerOwner     = "owner"
+	icaControllerChannelID = "channel-0"
+)
+
+// icaHost is an interchain account hosted on the provider chain. The packets of the controller chain
+// are delivered directly to the ICA host module of the provider, as the test apps do not have an ICA controller.
+type icaHost struct {
+	t          *testing.T
+	provider   *ibctesting.TestChain
+	module     icahost.IBCModule
+	portID     string
+	channelID  string
+	version    string
+	address    string
+	nextPacket uint64
+}
+
+// setupICAHost opens an ICA channel on the provider chain over a connection with the controller chain,
+// which creates the interchain account of the controller owner on the provider chain
+func setupICAHost(t *testing.T, controller, provider *ibctesting.TestChain, providerApp *appProvider.App) *icaHost {
+	t.Helper()
+
+	path := ibctesting.NewPath(controller, provider)
+	path.SetupConnections()
+
+	controllerPortID, err := icatypes.NewControllerPortID(icaControllerOwner)
+	require.NoError(t, err)
+	counterparty := channeltypes.NewCounterparty(controllerPortID, icaControllerChannelID)
+	connectionHops := []string{path.EndpointB.ConnectionID}
+
+	ctx := provider.GetContext()
+	host := &icaHost{
+		t:          t,
+		provider:   provider,
+		module:     icahost.NewIBCModule(providerApp.ICAHostKeeper),
+		portID:     controllerPortID,
+		channelID:  channeltypes.FormatChannelIdentifier(providerApp.IBCKeeper.ChannelKeeper.GetNextChannelSequence(ctx)),
+		nextPacket: 1,
+	}
+	host.version, err = host.module.OnChanOpenTry(ctx, channeltypes.ORDERED, connectionHops, icatypes.HostPortID,
+		host.channelID, counterparty, icatypes.NewDefaultMetadataString(path.EndpointA.ConnectionID, path.EndpointB.ConnectionID))
+	require.NoError(t, err)
+	providerApp.IBCKeeper.ChannelKeeper.SetChannel(ctx, icatypes.HostPortID, host.channelID,
+		channeltypes.NewChannel(channeltypes.OPEN, channeltypes.ORDERED, counterparty, connectionHops, host.version))
+	require.NoError(t, host.module.OnChanOpenConfirm(ctx, icatypes.HostPortID, host.channelID))
+	provider.NextBlock()
+
+	var found bool
+	host.address, found = providerApp.ICAHostKeeper.GetInterchainAccountAddress(provider.GetContext(), path.EndpointB.ConnectionID, controllerPortID)
+	require.True(t, found)
+
+	return host
+}
+
+// execute delivers an interchain accounts transaction with the messages to the provider chain
+// and returns whether the transaction was successfully executed by the interchain account
+func (h *icaHost) execute(msgs ...proto.Message) bool {
+	h.t.Helper()
+
+	ctx := h.provider.GetContext()
+	data, err := icatypes.SerializeCosmosTx(h.provider.Codec, msgs, icatypes.EncodingProtobuf)
+	require.NoError(h.t, err)
+	packetData := icatypes.InterchainAccountPacketData{Type: icatypes.EXECUTE_TX, Data: data}
+	packet := channeltypes.NewPacket(packetData.GetBytes(), h.nextPacket, h.portID, icaControllerChannelID,
+		icatypes.HostPortID, h.channelID, clienttypes.ZeroHeight(), uint64(ctx.BlockTime().Add(time.Hour).UnixNano()))
+	h.nextPacket++
+
+	ack := h.module.OnRecvPacket(ctx, h.version, packet, h.provider.SenderAccount.GetAddress())
+	h.provider.NextBlock()
+	return ack.Success()
+}
+
+// TestConsumerOwnerInterchainAccount tests that the owner of a consumer chain can be an interchain account
+// hosted on the provider chain.
+// @Long Description@
+// * Open an ICA channel on the provider chain, so that an interchain account is created for the controller chain.
+// * Create a consumer chain via ICA, so that the interchain account becomes the owner of the consumer chain.
+// * Update the consumer chain via ICA and verify that the update is applied.
+// * Verify that the interchain account cannot update the consumer chain of another owner.
+// * Remove the launched consumer chain via ICA and verify that the chain is stopped.
+func TestConsumerOwnerInterchainAccount(t *testing.T) {
+	coordinator := ibctesting.NewCoordinator(t, 0)
+	provider, providerApp := icstestingutils.AddProvider[*appProvider.App](t, coordinator, icstestingutils.ProviderAppIniter)
+	controller := ibctesting.NewCustomAppTestChain(t, coordinator, ibctesting.GetChainID(2), icstestingutils.ProviderAppIniter)
+	coordinator.Chains[controller.ChainID] = controller
+
+	host := setupICAHost(t, controller, provider, providerApp)
+	providerKeeper := providerApp.GetProviderKeeper()
+
+	// create the consumer chain via ICA
+	id, _ := providerKeeper.GetConsumerId(provider.GetContext())
+	consumerId := strconv.FormatUint(id, 10)
+	require.True(t, host.execute(&providertypes.MsgCreateConsumer{
+		Submitter: host.address,
+		ChainId:   "consumer-1",
+		Metadata:  providertypes.ConsumerMetadata{Name: "name", Description: "description", Metadata: "metadata"},
+	}))
+	owner, err := providerKeeper.GetConsumerOwnerAddress(provider.GetContext(), consumerId)
+	require.NoError(t, err)
+	require.Equal(t, host.address, owner)
+
+	// update the consumer chain via ICA
+	require.True(t, host.execute(&providertypes.MsgUpdateConsumer{
+		Owner:      host.address,
+		ConsumerId: consumerId,
+		Metadata:   &providertypes.ConsumerMetadata{Name: "new name", Description: "new description", Metadata: "metadata"},
+	}))
+	metadata, err := providerKeeper.GetConsumerMetadata(provider.GetContext(), consumerId)
+	require.NoError(t, err)
+	require.Equal(t, "new name", metadata.Name)
+
+	// the interchain account cannot update a consumer chain that it does not own
+	otherConsumerId := providerKeeper.FetchAndIncrementConsumerId(provider.GetContext())
+	providerKeeper.SetConsumerOwnerAddress(provider.GetContext(), otherConsumerId, provider.SenderAccount.GetAddress().String())
+	providerKeeper.SetConsumerChainId(provider.GetContext(), otherConsumerId, "consumer-2")
+	providerKeeper.SetConsumerPhase(provider.GetContext(), otherConsumerId, providertypes.CONSUMER_PHASE_REGISTERED)
+	require.False(t, host.execute(&providertypes.MsgUpdateConsumer{
+		Owner:      host.address,
+		ConsumerId: otherConsumerId,
+		Metadata:   &providertypes.ConsumerMetadata{Name: "new name", Description: "new description", Metadata: "metadata"},
+	}))
+
+	// the interchain account cannot execute messages that are not needed to own consumer chains
+	coins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	require.NoError(t, providerApp.BankKeeper.SendCoins(provider.GetContext(),
+		provider.SenderAccount.GetAddress(), sdk.MustAccAddressFromBech32(host.address), coins))
+	require.False(t, host.execute(&banktypes.MsgSend{
+		FromAddress: host.address,
+		ToAddress:   provider.SenderAccount.GetAddress().String(),
+		Amount:      coins,
+	}))
+
+	// remove the consumer chain via ICA, once it has launched
+	providerKeeper.SetConsumerPhase(provider.GetContext(), consumerId, providertypes.CONSUMER_PHASE_LAUNCHED)
+	require.True(t, host.execute(&providertypes.MsgRemoveConsumer{
+		Owner:      host.address,
+		ConsumerId: consumerId,
+	}))
+	require.Equal(t, providertypes.CONSUMER_PHASE_STOPPED, providerKeeper.GetConsumerPhase(provider.GetContext(), consumerId))
+}
+
+// TestICAHostUpgradeHandler tests that the upgrade handler of the provider app, which adds the ICA host module,
+// initializes the ICA host module with the params that only allow the messages needed to own consumer chains.
+// @Long Description@
+// * Set the default ICA host params, which allow all the messages.
+// * Apply the upgrade and verify that the ICA host params are restricted to the consumer chain messages.
+func TestICAHostUpgradeHandler(t *testing.T) {
+	coordinator := ibctesting.NewCoordinator(t, 0)
+	provider, providerApp := icstestingutils.AddProvider[*appProvider.App](t, coordinator, icstestingutils.ProviderAppIniter)
+
+	ctx := provider.GetContext()
+	providerApp.ICAHostKeeper.SetParams(ctx, icahosttypes.DefaultParams())
+
+	err := providerApp.UpgradeKeeper.ApplyUpgrade(ctx, upgradetypes.Plan{Name: "ics-v1-to-v2", Height: ctx.BlockHeight()})
+	require.NoError(t, err)
+
+	params := providerApp.ICAHostKeeper.GetParams(ctx)
+	require.True(t, params.HostEnabled)
+	require.Equal(t, []string{
+		sdk.MsgTypeURL(&providertypes.MsgCreateConsumer{}),
+		sdk.MsgTypeURL(&providertypes.MsgUpdateConsumer{}),
+		sdk.MsgTypeURL(&providertypes.MsgRemoveConsumer{}),
+	}, params.AllowMessages)
+}
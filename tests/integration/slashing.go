@@ -271,8 +271,8 @@ func (suite *CCVTestSuite) TestHandleSlashPacketDowntime() {
 
 // TestOnRecvSlashPacketErrors tests errors for the OnRecvSlashPacket method in an integration testing setting.
 // @Long Description@
-// * Set up all CCV channels and expect panic if the channel is not established via dest channel of packet.
-// * After the correct channelID is added to the packet, a panic shouldn't occur anymore.
+// * Set up all CCV channels and expect an error if the channel is not established via dest channel of packet.
+// * After the correct channelID is added to the packet, the unknown channel error shouldn't occur anymore.
 // * Create an instance of SlashPacketData and then verify correct processing and error handling
 // for slashing packets received by the provider chain.
 // TODO: Move to unit tests.
@@ -285,16 +285,14 @@ func (suite *CCVTestSuite) TestOnRecvSlashPacketErrors() {
 	// sync contexts block height
 	ctx := suite.providerCtx()
 
-	// Expect panic if ccv channel is not established via dest channel of packet
-	suite.Panics(func() {
-		_, _ = providerKeeper.OnRecvSlashPacket(ctx, channeltypes.Packet{}, ccv.SlashPacketData{})
-	})
+	// Expect an error if ccv channel is not established via dest channel of packet
+	_, err := providerKeeper.OnRecvSlashPacket(ctx, channeltypes.Packet{}, ccv.SlashPacketData{})
+	suite.Require().ErrorIs(err, ccv.ErrUnknownChannel)
 
-	// Add correct channelID to packet. Now we will not panic anymore.
+	// Add correct channelID to packet. Now the channel is known.
 	packet := channeltypes.Packet{DestinationChannel: firstBundle.Path.EndpointB.ChannelID}
-	suite.NotPanics(func() {
-		_, _ = providerKeeper.OnRecvSlashPacket(ctx, packet, ccv.SlashPacketData{})
-	})
+	_, err = providerKeeper.OnRecvSlashPacket(ctx, packet, ccv.SlashPacketData{})
+	suite.Require().NotErrorIs(err, ccv.ErrUnknownChannel)
 
 	// Check Validate for SlashPacket data
 	validAddress := ed25519.GenPrivKey().PubKey().Address()
@@ -307,7 +305,7 @@ func (suite *CCVTestSuite) TestOnRecvSlashPacketErrors() {
 
 	// Expect an error if validator address is too long
 	slashPacketData.Validator.Address = make([]byte, sdkaddress.MaxAddrLen+1)
-	_, err := providerKeeper.OnRecvSlashPacket(ctx, packet, *slashPacketData)
+	_, err = providerKeeper.OnRecvSlashPacket(ctx, packet, *slashPacketData)
 	suite.Require().Error(err, "validating SlashPacket data should fail - invalid validator address")
 
 	// Expect an error if validator power is zero
@@ -0,0 +1,210 @@
+package integration
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	testutil "github.com/cosmos/interchain-security/v7/testutil/integration"
+	"github.com/cosmos/interchain-security/v7/x/ccv/provider/lsm"
+	ccvtypes "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// TestProviderKeepersConformance tests that the staking and slashing keepers of the provider app
+// behave as expected by the provider module.
+// @Long Description@
+// * Set up a CCV channel and the signing infos of the provider validators.
+// * Check the staking and slashing keepers of the provider app through the interfaces
+// expected by the provider module, i.e., the validator lookups, powers, slashing, jailing and tombstoning.
+func (s *CCVTestSuite) TestProviderKeepersConformance() {
+	s.SetupCCVChannel(s.path)
+	for _, val := range s.providerChain.Vals.Validators {
+		s.setDefaultValSigningInfo(*val)
+	}
+
+	testutil.CheckProviderKeepersConformance(
+		s.T(),
+		s.providerCtx(),
+		s.providerApp.GetTestStakingKeeper(),
+		s.providerApp.GetTestSlashingKeeper(),
+	)
+}
+
+// TestLSMAdapterConformance tests that the adapters of the staking and slashing keepers of the LSM fork
+// behave as expected by the provider module.
+// @Long Description@
+// * Set up a CCV channel and the signing infos of the provider validators.
+// * Expose the staking and slashing keepers of the provider app with the API of the keepers of the LSM fork.
+// * Check the adapters of these keepers through the interfaces expected by the provider module,
+// i.e., the validator lookups, powers, slashing, jailing and tombstoning.
+func (s *CCVTestSuite) TestLSMAdapterConformance() {
+	s.SetupCCVChannel(s.path)
+	for _, val := range s.providerChain.Vals.Validators {
+		s.setDefaultValSigningInfo(*val)
+	}
+
+	testutil.CheckProviderKeepersConformance(
+		s.T(),
+		s.providerCtx(),
+		lsm.NewStakingKeeperAdapter(lsmStakingKeeper{s.providerApp.GetTestStakingKeeper()}),
+		lsm.NewSlashingKeeperAdapter(lsmSlashingKeeper{s.providerApp.GetTestSlashingKeeper()}),
+	)
+}
+
+// lsmStakingKeeper exposes a staking keeper with the API of the staking keeper of the LSM fork
+type lsmStakingKeeper struct {
+	keeper ccvtypes.ProviderStakingKeeper
+}
+
+var _ lsm.StakingKeeper = lsmStakingKeeper{}
+
+// must panics on errors, as the keepers of the LSM fork do not return errors
+func must[T any](v T, err error) T {
+	mustSucceed(err)
+	return v
+}
+
+func mustSucceed(err error) {
+	if err != nil {
+		panic(err)
+	}
+}
+
+func (k lsmStakingKeeper) UnbondingCanComplete(ctx sdk.Context, id uint64) error {
+	return k.keeper.UnbondingCanComplete(ctx, id)
+}
+
+func (k lsmStakingKeeper) UnbondingTime(ctx sdk.Context) time.Duration {
+	return must(k.keeper.UnbondingTime(ctx))
+}
+
+func (k lsmStakingKeeper) GetValidatorByConsAddr(ctx sdk.Context, consAddr sdk.ConsAddress) (stakingtypes.Validator, bool) {
+	validator, err := k.keeper.GetValidatorByConsAddr(ctx, consAddr)
+	return validator, err == nil
+}
+
+func (k lsmStakingKeeper) GetLastValidatorPower(ctx sdk.Context, operator sdk.ValAddress) int64 {
+	return must(k.keeper.GetLastValidatorPower(ctx, operator))
+}
+
+func (k lsmStakingKeeper) Jail(ctx sdk.Context, consAddr sdk.ConsAddress) {
+	mustSucceed(k.keeper.Jail(ctx, consAddr))
+}
+
+func (k lsmStakingKeeper) Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeight, power int64, slashFactor math.LegacyDec) math.Int {
+	return must(k.keeper.Slash(ctx, consAddr, infractionHeight, power, slashFactor))
+}
+
+func (k lsmStakingKeeper) SlashWithInfractionReason(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeight, power int64,
+	slashFactor math.LegacyDec, infraction stakingtypes.Infraction,
+) math.Int {
+	return must(k.keeper.SlashWithInfractionReason(ctx, consAddr, infractionHeight, power, slashFactor, infraction))
+}
+
+func (k lsmStakingKeeper) SlashUnbondingDelegation(ctx sdk.Context, unbondingDelegation stakingtypes.UnbondingDelegation,
+	infractionHeight int64, slashFactor math.LegacyDec,
+) math.Int {
+	return must(k.keeper.SlashUnbondingDelegation(ctx, unbondingDelegation, infractionHeight, slashFactor))
+}
+
+func (k lsmStakingKeeper) SlashRedelegation(ctx sdk.Context, srcValidator stakingtypes.Validator, redelegation stakingtypes.Redelegation,
+	infractionHeight int64, slashFactor math.LegacyDec,
+) math.Int {
+	return must(k.keeper.SlashRedelegation(ctx, srcValidator, redelegation, infractionHeight, slashFactor))
+}
+
+func (k lsmStakingKeeper) GetValidator(ctx sdk.Context, addr sdk.ValAddress) (stakingtypes.Validator, bool) {
+	validator, err := k.keeper.GetValidator(ctx, addr)
+	return validator, err == nil
+}
+
+func (k lsmStakingKeeper) PowerReduction(ctx sdk.Context) math.Int {
+	return k.keeper.PowerReduction(ctx)
+}
+
+func (k lsmStakingKeeper) MaxValidators(ctx sdk.Context) uint32 {
+	return must(k.keeper.MaxValidators(ctx))
+}
+
+func (k lsmStakingKeeper) GetLastTotalPower(ctx sdk.Context) math.Int {
+	return must(k.keeper.GetLastTotalPower(ctx))
+}
+
+func (k lsmStakingKeeper) BondDenom(ctx sdk.Context) string {
+	return must(k.keeper.BondDenom(ctx))
+}
+
+func (k lsmStakingKeeper) GetUnbondingDelegationsFromValidator(ctx sdk.Context, valAddr sdk.ValAddress) []stakingtypes.UnbondingDelegation {
+	return must(k.keeper.GetUnbondingDelegationsFromValidator(ctx, valAddr))
+}
+
+func (k lsmStakingKeeper) GetRedelegationsFromSrcValidator(ctx sdk.Context, valAddr sdk.ValAddress) []stakingtypes.Redelegation {
+	return must(k.keeper.GetRedelegationsFromSrcValidator(ctx, valAddr))
+}
+
+func (k lsmStakingKeeper) MinCommissionRate(ctx sdk.Context) math.LegacyDec {
+	return must(k.keeper.MinCommissionRate(ctx))
+}
+
+func (k lsmStakingKeeper) GetBondedValidatorsByPower(ctx sdk.Context) []stakingtypes.Validator {
+	return must(k.keeper.GetBondedValidatorsByPower(ctx))
+}
+
+func (k lsmStakingKeeper) IterateDelegations(ctx sdk.Context, delegator sdk.AccAddress,
+	fn func(index int64, delegation stakingtypes.DelegationI) (stop bool),
+) {
+	mustSucceed(k.keeper.IterateDelegations(ctx, delegator, fn))
+}
+
+func (k lsmStakingKeeper) IterateBondedValidatorsByPower(ctx sdk.Context,
+	fn func(index int64, validator stakingtypes.ValidatorI) (stop bool),
+) {
+	mustSucceed(k.keeper.IterateBondedValidatorsByPower(ctx, fn))
+}
+
+func (k lsmStakingKeeper) StakingTokenSupply(ctx sdk.Context) math.Int {
+	return must(k.keeper.StakingTokenSupply(ctx))
+}
+
+func (k lsmStakingKeeper) BondedRatio(ctx sdk.Context) math.LegacyDec {
+	return must(k.keeper.BondedRatio(ctx))
+}
+
+func (k lsmStakingKeeper) TotalBondedTokens(ctx sdk.Context) math.Int {
+	return must(k.keeper.TotalBondedTokens(ctx))
+}
+
+func (k lsmStakingKeeper) GetHistoricalInfo(ctx sdk.Context, height int64) (stakingtypes.HistoricalInfo, bool) {
+	historicalInfo, err := k.keeper.GetHistoricalInfo(ctx, height)
+	return historicalInfo, err == nil
+}
+
+// lsmSlashingKeeper exposes a slashing keeper with the API of the slashing keeper of the LSM fork
+type lsmSlashingKeeper struct {
+	keeper ccvtypes.ProviderSlashingKeeper
+}
+
+var _ lsm.SlashingKeeper = lsmSlashingKeeper{}
+
+func (k lsmSlashingKeeper) JailUntil(ctx sdk.Context, consAddr sdk.ConsAddress, jailTime time.Time) {
+	mustSucceed(k.keeper.JailUntil(ctx, consAddr, jailTime))
+}
+
+func (k lsmSlashingKeeper) DowntimeJailDuration(ctx sdk.Context) time.Duration {
+	return must(k.keeper.DowntimeJailDuration(ctx))
+}
+
+func (k lsmSlashingKeeper) SlashFractionDoubleSign(ctx sdk.Context) math.LegacyDec {
+	return must(k.keeper.SlashFractionDoubleSign(ctx))
+}
+
+func (k lsmSlashingKeeper) Tombstone(ctx sdk.Context, consAddr sdk.ConsAddress) {
+	mustSucceed(k.keeper.Tombstone(ctx, consAddr))
+}
+
+func (k lsmSlashingKeeper) IsTombstoned(ctx sdk.Context, consAddr sdk.ConsAddress) bool {
+	return k.keeper.IsTombstoned(ctx, consAddr)
+}
@@ -1,8 +1,12 @@
 package integration
 
 import (
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+
 	"cosmossdk.io/math"
 
+	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
 	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
 )
 
@@ -27,3 +31,44 @@ func (s *CCVTestSuite) TestPacketRoundtrip() {
 	// Relay 1 VSC packet from provider to consumer
 	relayAllCommittedPackets(s, s.providerChain, s.path, ccv.ProviderPortID, s.path.EndpointB.ChannelID, 1)
 }
+
+// TestNonMonotonicVSCPacketDropped tests that a VSC packet with a non-monotonic vscID is dropped
+// by a consumer with strict VSC ID ordering without stopping the consumer chain on the provider.
+// @Long Description@
+// * Set up a CCV channel and enable the strict VSC ID ordering on the consumer chain.
+// * Relay a VSC packet from the provider chain to the consumer chain.
+// * Send a VSC packet with the same vscID from the provider chain to the consumer chain.
+// * Relay the acknowledgement back to the provider chain and check that it is a successful one,
+// that the consumer chain is still launched and that the last received vscID is not updated.
+func (s *CCVTestSuite) TestNonMonotonicVSCPacketDropped() {
+	s.SetupCCVChannel(s.path)
+
+	consumerKeeper := s.consumerApp.GetConsumerKeeper()
+	providerKeeper := s.providerApp.GetProviderKeeper()
+
+	params := consumerKeeper.GetConsumerParams(s.consumerCtx())
+	params.StrictVscIdOrdering = true
+	consumerKeeper.SetParams(s.consumerCtx(), params)
+
+	// relay a VSC packet from provider to consumer
+	delegate(s, s.providerChain.SenderAccount.GetAddress(), math.NewInt(1000000))
+	s.nextEpoch()
+	relayAllCommittedPackets(s, s.providerChain, s.path, ccv.ProviderPortID, s.path.EndpointB.ChannelID, 1)
+	lastVscID := consumerKeeper.GetLastReceivedValsetUpdateID(s.consumerCtx())
+	s.Require().NotZero(lastVscID)
+
+	// send a VSC packet with the same vscID, e.g., due to a provider bug
+	data := ccv.NewValidatorSetChangePacketData(ccv.NewValsetDiff(), lastVscID, nil)
+	timeout := uint64(s.providerCtx().BlockTime().Add(ccv.DefaultCCVTimeoutPeriod).UnixNano())
+	packet := sendOnProviderRecvOnConsumer(s, s.path, clienttypes.Height{}, timeout, data.GetBytes())
+	s.Require().Equal(lastVscID, consumerKeeper.GetLastReceivedValsetUpdateID(s.consumerCtx()))
+
+	// the consumer wrote a successful acknowledgement, i.e., relaying it succeeds
+	ack := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+	err := s.path.EndpointB.AcknowledgePacket(packet, ack.Acknowledgement())
+	s.Require().NoError(err)
+
+	// the consumer chain is not stopped by the provider
+	s.Require().Equal(providertypes.CONSUMER_PHASE_LAUNCHED,
+		providerKeeper.GetConsumerPhase(s.providerCtx(), s.getFirstBundle().ConsumerId))
+}
@@ -29,6 +29,7 @@ import (
 	consumerkeeper "github.com/cosmos/interchain-security/v7/x/ccv/consumer/keeper"
 	consumertypes "github.com/cosmos/interchain-security/v7/x/ccv/consumer/types"
 	providerkeeper "github.com/cosmos/interchain-security/v7/x/ccv/provider/keeper"
+	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
 	"github.com/cosmos/interchain-security/v7/x/ccv/types"
 )
 
@@ -453,6 +454,92 @@ func (s *Driver) stopConsumer(chain ChainId) error {
 	return nil
 }
 
+// consumerInitializationParameters returns the initialization parameters of a consumer chain
+// that is launched at spawnTime, or that is not scheduled to launch if spawnTime is zero.
+func consumerInitializationParameters(spawnTime time.Time) *providertypes.ConsumerInitializationParameters {
+	initializationParameters := providertypes.DefaultConsumerInitializationParameters()
+	initializationParameters.SpawnTime = spawnTime
+	return &initializationParameters
+}
+
+// createConsumer creates a consumer chain on the provider, owned by the delegator account,
+// and returns the consumer id assigned to it.
+func (s *Driver) createConsumer(chainId string, spawnTime time.Time) (string, error) {
+	providerKeeper := s.providerKeeper()
+	server := providerkeeper.NewMsgServerImpl(&providerKeeper)
+	msg, err := providertypes.NewMsgCreateConsumer(s.delegator().String(), chainId,
+		providertypes.ConsumerMetadata{Name: chainId, Description: "consumer chain created by the MBT driver", Metadata: "{}"},
+		consumerInitializationParameters(spawnTime), nil, nil, nil)
+	if err != nil {
+		return "", err
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return "", err
+	}
+	resp, err := server.CreateConsumer(s.providerCtx(), msg)
+	if err != nil {
+		return "", err
+	}
+	return resp.ConsumerId, nil
+}
+
+// updateConsumer updates the spawn time of the consumer chain with consumerId on the provider.
+func (s *Driver) updateConsumer(consumerId string, spawnTime time.Time) error {
+	providerKeeper := s.providerKeeper()
+	server := providerkeeper.NewMsgServerImpl(&providerKeeper)
+	owner := s.delegator().String()
+	msg, err := providertypes.NewMsgUpdateConsumer(owner, consumerId, owner, nil,
+		consumerInitializationParameters(spawnTime), nil, nil, "", nil)
+	if err != nil {
+		return err
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+	_, err = server.UpdateConsumer(s.providerCtx(), msg)
+	return err
+}
+
+// optIn opts the validator with index valIndex in to the consumer chain with consumerId.
+func (s *Driver) optIn(consumerId string, valIndex int64) error {
+	providerKeeper := s.providerKeeper()
+	server := providerkeeper.NewMsgServerImpl(&providerKeeper)
+	msg, err := providertypes.NewMsgOptIn(consumerId, s.validator(valIndex), "", s.delegator().String())
+	if err != nil {
+		return err
+	}
+	_, err = server.OptIn(s.providerCtx(), msg)
+	return err
+}
+
+// optOut opts the validator with index valIndex out from the consumer chain with consumerId.
+func (s *Driver) optOut(consumerId string, valIndex int64) error {
+	providerKeeper := s.providerKeeper()
+	server := providerkeeper.NewMsgServerImpl(&providerKeeper)
+	msg, err := providertypes.NewMsgOptOut(consumerId, s.validator(valIndex), s.delegator().String())
+	if err != nil {
+		return err
+	}
+	_, err = server.OptOut(s.providerCtx(), msg)
+	return err
+}
+
+// removeConsumer stops the consumer chain with consumerId on the provider.
+// Its state is deleted once the unbonding period of the provider elapsed.
+func (s *Driver) removeConsumer(consumerId string) error {
+	providerKeeper := s.providerKeeper()
+	server := providerkeeper.NewMsgServerImpl(&providerKeeper)
+	msg, err := providertypes.NewMsgRemoveConsumer(s.delegator().String(), consumerId)
+	if err != nil {
+		return err
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+	_, err = server.RemoveConsumer(s.providerCtx(), msg)
+	return err
+}
+
 // newDriver creates a new Driver object.
 // It creates a new coordinator, but does not start any chains.
 // The caller must call setupChains to start the chains and
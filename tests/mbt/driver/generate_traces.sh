@@ -12,4 +12,6 @@ echo "Generating traces with many jails"
 go run ./... -modelPath=../model/ccv_sync.qnt -init initSync -step stepSync -invariant CanJail -traceFolder traces/sync_jailmany -numTraces 2 -numSteps 100 -numSamples 20
 echo "Generating long synced traces without invariants"
 go run ./... -modelPath=../model/ccv_sync.qnt -init initSync -step stepSync -traceFolder traces/sync_noinv -numTraces 2 -numSteps 100 -numSamples 1
-go run ./... -modelPath=../model/ccv_boundeddrift.qnt --step stepBoundedDriftKeyAssignment --traceFolder traces/bound_key -numTraces 2 -numSteps 100 -numSamples 20
\ No newline at end of file
+go run ./... -modelPath=../model/ccv_boundeddrift.qnt --step stepBoundedDriftKeyAssignment --traceFolder traces/bound_key -numTraces 2 -numSteps 100 -numSamples 20
+echo "Generating permissionless consumer lifecycle traces with deleted consumers"
+go run ./... -modelPath=../model/ccv_lifecycle.qnt -invariant CanDeleteConsumer -traceFolder lifecycle_traces/lifecycle_delete -numTraces 2 -numSteps 100 -numSamples 200
@@ -0,0 +1,293 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+	"github.com/informalsystems/itf-go/itf"
+	"github.com/stretchr/testify/require"
+
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+	"github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// TestMBTConsumerLifecycle runs the traces of the permissionless consumer lifecycle model (ccv_lifecycle.qnt)
+// from the lifecycle_traces folder.
+// The traces are kept apart from the ones in the traces folder, since they are generated by a different model.
+func TestMBTConsumerLifecycle(t *testing.T) {
+	dir := "lifecycle_traces"
+
+	numTraces := 0
+
+	ibctesting.TimeIncrement = 1 * time.Nanosecond
+
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if info.IsDir() {
+			return nil
+		}
+
+		ext := filepath.Ext(path)
+		if ext == ".json" || ext == ".itf" {
+			fmt.Println("Running trace:", path)
+			numTraces++
+			RunLifecycleItfTrace(t, path)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal("Error:", err, "(generate the traces with generate_traces.sh)")
+	}
+	if numTraces == 0 {
+		t.Fatal("No traces found in the lifecycle_traces folder, generate them with generate_traces.sh")
+	}
+
+	t.Log("✅ Running traces from the lifecycle_traces folder done")
+	t.Log(numTraces, "traces run")
+}
+
+func RunLifecycleItfTrace(t *testing.T, path string) {
+	t.Helper()
+	t.Logf("🟡 Testing trace %s", path)
+
+	// Load trace
+	trace := &itf.Trace{}
+	if err := trace.LoadFromFile(path); err != nil {
+		t.Fatalf("Error loading trace file: %s", err)
+	}
+
+	t.Log("Reading params...")
+	params := trace.States[0].VarValues["params"].Value.(itf.MapExprType)
+
+	initialValSetExpr := params["InitialValidatorSet"].Value.(itf.MapExprType)
+	initialValSet := make(map[string]int64)
+	for val, power := range initialValSetExpr {
+		initialValSet[val] = power.Value.(int64)
+	}
+
+	valExprs := params["Nodes"].Value.(itf.ListExprType)
+	valNames := make([]string, len(valExprs))
+	for i, val := range valExprs {
+		valNames[i] = val.Value.(string)
+	}
+
+	// only the provider is set up, since the consumer chains are not modelled
+	unbondingPeriod := time.Duration(params["UnbondingPeriod"].Value.(int64)) * time.Second
+	modelParams := ModelParams{
+		CcvTimeout:              map[ChainId]time.Duration{PROVIDER: types.DefaultCCVTimeoutPeriod},
+		UnbondingPeriodPerChain: map[ChainId]time.Duration{PROVIDER: unbondingPeriod},
+		TrustingPeriodPerChain:  map[ChainId]time.Duration{PROVIDER: unbondingPeriod / 2},
+	}
+
+	valSet, addressMap, signers, err := CreateValSet(initialValSet, PROVIDER)
+	require.NoError(t, err, "Error creating validator set")
+
+	// get a slice of validators in the right order
+	nodes := make([]*cmttypes.Validator, len(valNames))
+	for i, valName := range valNames {
+		nodes[i] = addressMap[valName]
+	}
+
+	driver := newDriver(t, nodes, valNames)
+	driver.DriverStats = &stats
+	driver.setupProvider(modelParams, valSet, signers, nodes, valNames)
+
+	// remember the time offset to be able to compare times to the model
+	timeOffset := driver.runningTime(PROVIDER)
+
+	t.Log("Reading the trace...")
+
+	for index, state := range trace.States {
+		t.Logf("Reading state %v of trace %v", index, path)
+
+		trace := state.VarValues["trace"].Value.(itf.ListExprType)
+		// lastAction is the action that was executed last along the model trace,
+		// i.e. the action we perform before checking model vs actual system equivalence
+		lastAction := trace[len(trace)-1].Value.(itf.MapExprType)
+
+		currentModelState := state.VarValues["currentState"].Value.(itf.MapExprType)
+		modelRunningTime := currentModelState["runningTimestamp"].Value.(int64)
+
+		actionKind := lastAction["kind"].Value.(string)
+		consumerId := lastAction["consumerId"].Value.(string)
+		expectError := lastAction["expectedError"].Value.(string) != ""
+
+		// the spawn time that the action sets, relative to the provider time before the action
+		spawnTime := time.Time{}
+		if spawnDelay := lastAction["spawnDelay"].Value.(int64); spawnDelay > 0 {
+			spawnTime = driver.runningTime(PROVIDER).Add(time.Duration(spawnDelay) * time.Second)
+		}
+
+		switch actionKind {
+		case "init":
+			t.Log("Initializing...")
+			continue
+		case "CreateConsumer":
+			t.Log("CreateConsumer", consumerId, spawnTime)
+			actualConsumerId, err := driver.createConsumer(fmt.Sprintf("lifecycle%s-1", consumerId), spawnTime)
+			require.NoError(t, err, "Error creating consumer chain %v", consumerId)
+			require.Equal(t, consumerId, actualConsumerId, "Consumer id of the created consumer chain does not match the model")
+			stats.numTxs++
+		case "UpdateConsumer":
+			t.Log("UpdateConsumer", consumerId, spawnTime)
+			err := driver.updateConsumer(consumerId, spawnTime)
+			checkLifecycleError(t, actionKind, err, expectError)
+			stats.numTxs++
+		case "OptIn":
+			node := lastAction["validator"].Value.(string)
+			t.Log("OptIn", consumerId, node)
+			err := driver.optIn(consumerId, int64(getIndexOfString(node, valNames)))
+			checkLifecycleError(t, actionKind, err, expectError)
+			stats.numTxs++
+		case "OptOut":
+			node := lastAction["validator"].Value.(string)
+			t.Log("OptOut", consumerId, node)
+			err := driver.optOut(consumerId, int64(getIndexOfString(node, valNames)))
+			checkLifecycleError(t, actionKind, err, expectError)
+			stats.numTxs++
+		case "RemoveConsumer":
+			t.Log("RemoveConsumer", consumerId)
+			err := driver.removeConsumer(consumerId)
+			checkLifecycleError(t, actionKind, err, expectError)
+			if err == nil {
+				stats.numStops++
+			}
+			stats.numTxs++
+		case "EndAndBeginBlockForProvider":
+			timeAdvancement := lastAction["timeAdvancement"].Value.(int64)
+			t.Log("EndAndBeginBlockForProvider", timeAdvancement)
+
+			// the consumer chains are launched and deleted in the first block,
+			// which has the time of the provider in the model before the action
+			driver.endAndBeginBlock(PROVIDER, 1*time.Nanosecond)
+			driver.endAndBeginBlock(PROVIDER, time.Duration(timeAdvancement)*time.Second-1*time.Nanosecond)
+		default:
+			log.Fatalf("Error loading trace file %s, step %v: do not know action type %s",
+				path, index, actionKind)
+		}
+
+		// check that the times of the model and the system match
+		require.Equal(t, timeOffset.Add(time.Duration(modelRunningTime)*time.Second), driver.runningTime(PROVIDER),
+			"Running times do not match")
+
+		CompareConsumerLifecycle(t, driver, currentModelState, timeOffset, valNames)
+	}
+}
+
+// checkLifecycleError checks that an action of the consumer lifecycle failed if and only if the model expected it to fail.
+func checkLifecycleError(t *testing.T, actionKind string, err error, expectError bool) {
+	t.Helper()
+	if expectError {
+		require.Error(t, err, "%v should have failed", actionKind)
+	} else {
+		require.NoError(t, err, "%v should have succeeded", actionKind)
+	}
+}
+
+// CompareConsumerLifecycle checks that the consumer chains on the provider are in the same phase as in the model,
+// and that the state of the provider is consistent with the phase of each consumer chain.
+func CompareConsumerLifecycle(
+	t *testing.T,
+	driver *Driver,
+	currentModelState itf.MapExprType,
+	timeOffset time.Time,
+	valNames []string,
+) {
+	t.Helper()
+	providerKeeper := driver.providerKeeper()
+	ctx := driver.providerCtx()
+
+	modelConsumers := currentModelState["consumers"].Value.(itf.MapExprType)
+	modelConsumerIds := make([]string, 0, len(modelConsumers))
+	for consumerId := range modelConsumers {
+		modelConsumerIds = append(modelConsumerIds, consumerId)
+	}
+	sort.Strings(modelConsumerIds)
+	actualConsumerIds := providerKeeper.GetAllConsumerIds(ctx)
+	sort.Strings(actualConsumerIds)
+	require.Equal(t, modelConsumerIds, actualConsumerIds, "Consumer ids do not match")
+
+	for consumerId, consumerExpr := range modelConsumers {
+		modelConsumer := consumerExpr.Value.(itf.MapExprType)
+		modelPhase := modelConsumer["phase"].Value.(string)
+
+		phase := providerKeeper.GetConsumerPhase(ctx, consumerId)
+		require.Equal(t, "CONSUMER_PHASE_"+modelPhase, phase.String(),
+			"Phases of consumer chain %v do not match", consumerId)
+
+		// the opted-in validators match the model
+		modelOptedIn := []string{}
+		for _, val := range modelConsumer["optedIn"].Value.(itf.ListExprType) {
+			modelOptedIn = append(modelOptedIn, val.Value.(string))
+		}
+		sort.Strings(modelOptedIn)
+		actualOptedIn := []string{}
+		for i, valName := range valNames {
+			stakingVal, err := driver.stakingValidator(int64(i))
+			require.NoError(t, err, "Error getting validator %v", valName)
+			consAddr, err := stakingVal.GetConsAddr()
+			require.NoError(t, err, "Error getting consensus address of validator %v", valName)
+			if providerKeeper.IsOptedIn(ctx, consumerId, providertypes.NewProviderConsAddress(consAddr)) {
+				actualOptedIn = append(actualOptedIn, valName)
+			}
+		}
+		sort.Strings(actualOptedIn)
+		require.Equal(t, modelOptedIn, actualOptedIn, "Opted-in validators of consumer chain %v do not match", consumerId)
+
+		// only initialized consumer chains are scheduled to launch
+		initializationParameters, err := providerKeeper.GetConsumerInitializationParameters(ctx, consumerId)
+		require.NoError(t, err, "Error getting initialization parameters of consumer chain %v", consumerId)
+		if phase == providertypes.CONSUMER_PHASE_INITIALIZED {
+			modelSpawnTime := timeOffset.Add(time.Duration(modelConsumer["spawnTime"].Value.(int64)) * time.Second)
+			require.Equal(t, modelSpawnTime, initializationParameters.SpawnTime,
+				"Spawn times of consumer chain %v do not match", consumerId)
+			consumersToBeLaunched, err := providerKeeper.GetConsumersToBeLaunched(ctx, modelSpawnTime)
+			require.NoError(t, err)
+			require.Contains(t, consumersToBeLaunched.Ids, consumerId,
+				"Initialized consumer chain %v is not scheduled to launch", consumerId)
+		} else if phase == providertypes.CONSUMER_PHASE_REGISTERED {
+			require.True(t, initializationParameters.SpawnTime.IsZero(),
+				"Registered consumer chain %v has a spawn time", consumerId)
+		}
+
+		// only stopped consumer chains are scheduled to be removed
+		removalTime, err := providerKeeper.GetConsumerRemovalTime(ctx, consumerId)
+		if phase == providertypes.CONSUMER_PHASE_STOPPED {
+			require.NoError(t, err, "Stopped consumer chain %v has no removal time", consumerId)
+			modelRemovalTime := timeOffset.Add(time.Duration(modelConsumer["removalTime"].Value.(int64)) * time.Second)
+			require.Equal(t, modelRemovalTime, removalTime, "Removal times of consumer chain %v do not match", consumerId)
+			consumersToBeRemoved, err := providerKeeper.GetConsumersToBeRemoved(ctx, removalTime)
+			require.NoError(t, err)
+			require.Contains(t, consumersToBeRemoved.Ids, consumerId,
+				"Stopped consumer chain %v is not scheduled to be removed", consumerId)
+		} else {
+			require.Error(t, err, "Consumer chain %v in phase %v has a removal time", consumerId, phase)
+		}
+
+		// only launched and stopped consumer chains have a consumer client, a genesis, and a validator set.
+		// Note that the validator set of a launched consumer chain can be empty if all validators opted out.
+		_, hasClient := providerKeeper.GetConsumerClientId(ctx, consumerId)
+		_, hasGenesis := providerKeeper.GetConsumerGenesis(ctx, consumerId)
+		valSet, err := providerKeeper.GetConsumerValSet(ctx, consumerId)
+		require.NoError(t, err)
+		if phase == providertypes.CONSUMER_PHASE_LAUNCHED || phase == providertypes.CONSUMER_PHASE_STOPPED {
+			require.True(t, hasClient, "Consumer chain %v in phase %v has no consumer client", consumerId, phase)
+			require.True(t, hasGenesis, "Consumer chain %v in phase %v has no genesis", consumerId, phase)
+		} else {
+			require.False(t, hasClient, "Consumer chain %v in phase %v has a consumer client", consumerId, phase)
+			require.False(t, hasGenesis, "Consumer chain %v in phase %v has a genesis", consumerId, phase)
+			require.Empty(t, valSet, "Consumer chain %v in phase %v has a validator set", consumerId, phase)
+		}
+	}
+}
@@ -185,8 +185,9 @@ func getAppBytesAndSenders(
 
 		stakingValidators = append(stakingValidators, validator)
 
-		// Store delegation from the model delegator account
-		delegations = append(delegations, stakingtypes.NewDelegation(senderAccounts[0].SenderAccount.GetAddress().String(), val.Address.String(), delShares))
+		// Store delegation from the model delegator account,
+		// the staking module expects the validator operator address of the delegation
+		delegations = append(delegations, stakingtypes.NewDelegation(senderAccounts[0].SenderAccount.GetAddress().String(), sdk.ValAddress(val.Address).String(), delShares))
 
 		// add initial validator powers so consumer InitGenesis runs correctly
 		pub, _ := val.ToProto()
@@ -272,16 +273,8 @@ func newChain(
 		},
 	)
 
-	app.Commit()
-
-	app.FinalizeBlock(
-		&abcitypes.RequestFinalizeBlock{
-			Hash:               app.LastCommitID().Hash,
-			Height:             app.LastBlockHeight() + 1,
-			NextValidatorsHash: validators.Hash(),
-		},
-	)
-
+	// the first block is finalized and committed by chain.NextBlock(), which also records
+	// the trusted validators of the committed headers
 	chain := &ibctesting.TestChain{
 		TB:          t,
 		Coordinator: coord,
@@ -292,14 +285,15 @@ func newChain(
 			Height:  1,
 			Time:    coord.CurrentTime.UTC(),
 		},
-		TxConfig:       app.GetTxConfig(),
-		Codec:          app.AppCodec(),
-		Vals:           validators,
-		NextVals:       validators,
-		Signers:        signers,
-		SenderPrivKey:  senderAccounts[0].SenderPrivKey,
-		SenderAccount:  senderAccounts[0].SenderAccount,
-		SenderAccounts: senderAccounts,
+		TxConfig:          app.GetTxConfig(),
+		Codec:             app.AppCodec(),
+		Vals:              validators,
+		NextVals:          validators,
+		TrustedValidators: make(map[uint64]*cmttypes.ValidatorSet, 0),
+		Signers:           signers,
+		SenderPrivKey:     senderAccounts[0].SenderPrivKey,
+		SenderAccount:     senderAccounts[0].SenderAccount,
+		SenderAccounts:    senderAccounts,
 	}
 
 	chain.NextBlock()
@@ -505,6 +499,13 @@ func createConsumerGenesis(modelParams ModelParams, providerChain *ibctesting.Te
 		[]string{},
 		ccvtypes.DefaultRetryDelayPeriod,
 		"",
+		false,
+		ccvtypes.DefaultMaxValidatorUpdatesPerBlock,
+		ccvtypes.DefaultRetryDelayMultiplier,
+		ccvtypes.DefaultMaxRetryDelayPeriod,
+		ccvtypes.DefaultClientExpiryWarningThreshold,
+		nil,
+		nil,
 	)
 
 	return consumertypes.NewInitialGenesisState(consumerClientState, providerConsState, valUpdates, params)
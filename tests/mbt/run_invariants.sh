@@ -7,6 +7,8 @@ quint test ccv_model.qnt
 quint test ccv_test.qnt
 quint run --invariant "all{ValidatorUpdatesArePropagatedInv,ValidatorSetHasExistedInv,SameVscPacketsInv,MatureOnTimeInv,EventuallyMatureOnProviderInv,WaitingForSlashPacketAckInv}" ccv_model.qnt --max-steps 200 --max-samples 200
 quint run --invariant "all{ValidatorUpdatesArePropagatedKeyAssignmentInv,ValidatorSetHasExistedKeyAssignmentInv,SameVscPacketsKeyAssignmentInv,MatureOnTimeInv,EventuallyMatureOnProviderInv,KeyAssignmentRulesInv,WaitingForSlashPacketAckInv}" ccv_model.qnt --step stepKeyAssignment --max-steps 200 --max-samples 200
+quint test ccv_lifecycle.qnt
+quint run --invariant "all{SpawnTimeInv,RemovalTimeInv,DeletedConsumerInv}" ccv_lifecycle.qnt --max-steps 200 --max-samples 200
 
 
 # do not stop on errors anymore, so we can give better output if we error
@@ -41,3 +43,12 @@ run_invariant "CanHaveConsumerAddresses" "stepKeyAssignment" '[violation]'
 run_invariant "CanReceiveMaturations" "stepKeyAssignment" '[violation]'
 run_invariant "CanSendSlashPacket" "stepKeyAssignment" '[violation]'
 run_invariant "CanJail" "stepKeyAssignment" '[violation]'
+
+for invariant in CanLaunchConsumer CanStopConsumer CanDeleteConsumer; do
+  if quint run --invariant $invariant ccv_lifecycle.qnt | grep -q '[violation]'; then
+    echo "sanity check $invariant ok"
+  else
+    echo "sanity check $invariant not ok"
+    exit 1
+  fi
+done
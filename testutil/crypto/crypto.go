@@ -96,6 +96,16 @@ func (v *CryptoIdentity) OperatorSDKPubKey() sdkcryptotypes.PubKey {
 	return v.operator.PubKey()
 }
 
+// SignWithOperatorKey signs msg with the private key of the validator operator account
+func (v *CryptoIdentity) SignWithOperatorKey(msg []byte) ([]byte, error) {
+	return v.operator.Sign(msg)
+}
+
+// SignWithConsensusKey signs msg with the private key of the validator to run consensus
+func (v *CryptoIdentity) SignWithConsensusKey(msg []byte) ([]byte, error) {
+	return v.consensus.Sign(msg)
+}
+
 func (v *CryptoIdentity) SDKValOpAddress() sdktypes.ValAddress {
 	return sdktypes.ValAddress(v.OperatorSDKPubKey().Address())
 }
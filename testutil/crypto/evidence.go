@@ -4,12 +4,15 @@ import (
 	"fmt"
 	"time"
 
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
 	ibctmtypes "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
 
 	"github.com/cometbft/cometbft/crypto/tmhash"
 	"github.com/cometbft/cometbft/libs/bytes"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	tmprotoversion "github.com/cometbft/cometbft/proto/tendermint/version"
 	tmtypes "github.com/cometbft/cometbft/types"
+	tmversion "github.com/cometbft/cometbft/version"
 )
 
 // utility function duplicated from CometBFT
@@ -112,6 +115,80 @@ func MakeAndSignVoteWithForgedValAddress(
 	return vote
 }
 
+// MakeDuplicateVoteEvidence makes a double voting evidence of the given signer at the given height
+// of the given chain, i.e., two precommits signed by the signer for different blocks
+func MakeDuplicateVoteEvidence(
+	blockHeight int64,
+	blockTime time.Time,
+	valSet *tmtypes.ValidatorSet,
+	signer tmtypes.PrivValidator,
+	chainID string,
+) *tmtypes.DuplicateVoteEvidence {
+	voteA := MakeAndSignVote(
+		MakeBlockID([]byte("block_hash_a"), 1000, []byte("part_set_hash_a")),
+		blockHeight, blockTime, valSet, signer, chainID,
+	)
+	voteB := MakeAndSignVote(
+		MakeBlockID([]byte("block_hash_b"), 1000, []byte("part_set_hash_b")),
+		blockHeight, blockTime, valSet, signer, chainID,
+	)
+	evidence, err := tmtypes.NewDuplicateVoteEvidence(voteA, voteB, blockTime, valSet)
+	if err != nil {
+		panic(err)
+	}
+	return evidence
+}
+
+// MakeAndSignHeader makes a header of the given chain at the given height with the given app hash,
+// which is committed by all the validators of valSet. The header is trusted at the previous height,
+// with valSet as trusted validator set. Note that signers maps the validator addresses to their signers.
+func MakeAndSignHeader(
+	chainID string,
+	height int64,
+	blockTime time.Time,
+	appHash []byte,
+	valSet *tmtypes.ValidatorSet,
+	signers map[string]tmtypes.PrivValidator,
+) *ibctmtypes.Header {
+	header := tmtypes.Header{
+		Version:            tmprotoversion.Consensus{Block: tmversion.BlockProtocol, App: 2},
+		ChainID:            chainID,
+		Height:             height,
+		Time:               blockTime,
+		ValidatorsHash:     valSet.Hash(),
+		NextValidatorsHash: valSet.Hash(),
+		AppHash:            appHash,
+		ProposerAddress:    valSet.Proposer.Address,
+	}
+	blockID := MakeBlockID(header.Hash(), 1, tmhash.Sum([]byte("part_set")))
+
+	// MakeExtCommit expects the signers in the same order as the validators
+	signerArr := make([]tmtypes.PrivValidator, len(valSet.Validators))
+	for i, v := range valSet.Validators {
+		signerArr[i] = signers[v.Address.String()]
+	}
+	voteSet := tmtypes.NewVoteSet(chainID, height, 1, tmproto.PrecommitType, valSet)
+	extCommit, err := tmtypes.MakeExtCommit(blockID, height, 1, voteSet, signerArr, blockTime, false)
+	if err != nil {
+		panic(err)
+	}
+
+	vs, err := valSet.ToProto()
+	if err != nil {
+		panic(err)
+	}
+
+	return &ibctmtypes.Header{
+		SignedHeader: &tmproto.SignedHeader{
+			Header: header.ToProto(),
+			Commit: extCommit.ToCommit().ToProto(),
+		},
+		ValidatorSet:      vs,
+		TrustedHeight:     clienttypes.NewHeight(clienttypes.ParseChainID(chainID), uint64(height-1)),
+		TrustedValidators: vs,
+	}
+}
+
 // CorruptCommitSigsInHeader corrupts the header by changing the value
 // of the commit signature for given validator address.
 // Note that this method is solely used for testing purposes
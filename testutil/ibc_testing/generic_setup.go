@@ -143,7 +143,7 @@ func AddConsumer[Tp testutil.ProviderApp, Tc testutil.ConsumerApp](
 
 	consumerMetadata := testkeeper.GetTestConsumerMetadata()
 
-	initializationParameters := testkeeper.GetTestInitializationParameters()
+	initializationParameters := testkeeper.GetTestInitializationParameters(providertypes.InitParamsProfileMainnetConservative)
 	// NOTE: we cannot use the time.Now() because the coordinator chooses a hardcoded start time
 	// using time.Now() could set the spawn time to be too far in the past or too far in the future
 	initializationParameters.SpawnTime = coordinator.CurrentTime
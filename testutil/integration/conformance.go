@@ -0,0 +1,131 @@
+package integration
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	ccvtypes "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// CheckProviderKeepersConformance checks that the staking and slashing keepers of a provider chain
+// behave as expected by the provider module, i.e., as the keepers of the SDK. It requires at least
+// one bonded validator with a signing info. All the state changes are discarded.
+//
+// Chains running a staking or slashing module other than the SDK ones can run this check in their
+// tests before passing the keepers to the provider keeper.
+func CheckProviderKeepersConformance(
+	t testing.TB,
+	ctx sdk.Context,
+	stakingKeeper ccvtypes.ProviderStakingKeeper,
+	slashingKeeper ccvtypes.ProviderSlashingKeeper,
+) {
+	t.Helper()
+	ctx, _ = ctx.CacheContext()
+
+	// params
+	bondDenom, err := stakingKeeper.BondDenom(ctx)
+	require.NoError(t, err)
+	require.NoError(t, sdk.ValidateDenom(bondDenom))
+	powerReduction := stakingKeeper.PowerReduction(ctx)
+	require.True(t, powerReduction.IsPositive())
+	unbondingTime, err := stakingKeeper.UnbondingTime(ctx)
+	require.NoError(t, err)
+	require.Positive(t, unbondingTime)
+	maxValidators, err := stakingKeeper.MaxValidators(ctx)
+	require.NoError(t, err)
+	require.Positive(t, maxValidators)
+	minCommissionRate, err := stakingKeeper.MinCommissionRate(ctx)
+	require.NoError(t, err)
+	require.False(t, minCommissionRate.IsNegative())
+	downtimeJailDuration, err := slashingKeeper.DowntimeJailDuration(ctx)
+	require.NoError(t, err)
+	require.Positive(t, downtimeJailDuration)
+	slashFractionDoubleSign, err := slashingKeeper.SlashFractionDoubleSign(ctx)
+	require.NoError(t, err)
+	require.False(t, slashFractionDoubleSign.IsNegative())
+
+	// the bonded validators are consistent with their last powers and can be found by address
+	bondedValidators, err := stakingKeeper.GetBondedValidatorsByPower(ctx)
+	require.NoError(t, err)
+	require.NotEmpty(t, bondedValidators)
+	require.LessOrEqual(t, len(bondedValidators), int(maxValidators))
+
+	totalPower := int64(0)
+	totalTokens := math.ZeroInt()
+	for i, val := range bondedValidators {
+		require.True(t, val.IsBonded())
+		if i > 0 {
+			require.True(t, bondedValidators[i-1].GetTokens().GTE(val.GetTokens()), "bonded validators are not sorted by power")
+		}
+		valAddr, err := sdk.ValAddressFromBech32(val.GetOperator())
+		require.NoError(t, err)
+		consAddr, err := val.GetConsAddr()
+		require.NoError(t, err)
+
+		byConsAddr, err := stakingKeeper.GetValidatorByConsAddr(ctx, consAddr)
+		require.NoError(t, err)
+		require.Equal(t, val.GetOperator(), byConsAddr.GetOperator())
+		byValAddr, err := stakingKeeper.GetValidator(ctx, valAddr)
+		require.NoError(t, err)
+		require.Equal(t, val.GetOperator(), byValAddr.GetOperator())
+
+		power, err := stakingKeeper.GetLastValidatorPower(ctx, valAddr)
+		require.NoError(t, err)
+		require.Equal(t, val.ConsensusPower(powerReduction), power)
+		totalPower += power
+		totalTokens = totalTokens.Add(val.GetBondedTokens())
+	}
+	lastTotalPower, err := stakingKeeper.GetLastTotalPower(ctx)
+	require.NoError(t, err)
+	require.Equal(t, totalPower, lastTotalPower.Int64())
+	totalBondedTokens, err := stakingKeeper.TotalBondedTokens(ctx)
+	require.NoError(t, err)
+	require.Equal(t, totalTokens, totalBondedTokens)
+
+	iterated := []string{}
+	err = stakingKeeper.IterateBondedValidatorsByPower(ctx, func(_ int64, val stakingtypes.ValidatorI) bool {
+		iterated = append(iterated, val.GetOperator())
+		return false
+	})
+	require.NoError(t, err)
+	require.Len(t, iterated, len(bondedValidators))
+	for i, val := range bondedValidators {
+		require.Equal(t, val.GetOperator(), iterated[i])
+	}
+
+	// slashing reduces the tokens of a validator by the returned amount
+	val := bondedValidators[0]
+	valAddr, err := sdk.ValAddressFromBech32(val.GetOperator())
+	require.NoError(t, err)
+	consAddr, err := val.GetConsAddr()
+	require.NoError(t, err)
+	power := val.ConsensusPower(powerReduction)
+
+	burned, err := stakingKeeper.SlashWithInfractionReason(ctx, consAddr, ctx.BlockHeight(), power,
+		math.LegacyNewDecWithPrec(1, 1), stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN)
+	require.NoError(t, err)
+	require.True(t, burned.IsPositive())
+	slashed, err := stakingKeeper.GetValidator(ctx, valAddr)
+	require.NoError(t, err)
+	require.Equal(t, val.GetTokens().Sub(burned), slashed.GetTokens())
+
+	// jailing and tombstoning are reflected in the validator state
+	jailed, err := stakingKeeper.IsValidatorJailed(ctx, consAddr)
+	require.NoError(t, err)
+	require.False(t, jailed)
+	require.NoError(t, stakingKeeper.Jail(ctx, consAddr))
+	jailed, err = stakingKeeper.IsValidatorJailed(ctx, consAddr)
+	require.NoError(t, err)
+	require.True(t, jailed)
+
+	require.NoError(t, slashingKeeper.JailUntil(ctx, consAddr, ctx.BlockTime().Add(downtimeJailDuration)))
+	require.False(t, slashingKeeper.IsTombstoned(ctx, consAddr))
+	require.NoError(t, slashingKeeper.Tombstone(ctx, consAddr))
+	require.True(t, slashingKeeper.IsTombstoned(ctx, consAddr))
+}
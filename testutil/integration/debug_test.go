@@ -157,6 +157,14 @@ func TestStopConsumerOnChannelClosed(t *testing.T) {
 	runCCVTestByName(t, "TestStopConsumerOnChannelClosed")
 }
 
+//
+// Channel recovery tests
+//
+
+func TestVSCPacketTimeoutRecovery(t *testing.T) {
+	runCCVTestByName(t, "TestVSCPacketTimeoutRecovery")
+}
+
 //
 // Throttle tests
 //
@@ -209,6 +217,10 @@ func TestPacketRoundtrip(t *testing.T) {
 	runCCVTestByName(t, "TestPacketRoundtrip")
 }
 
+func TestNonMonotonicVSCPacketDropped(t *testing.T) {
+	runCCVTestByName(t, "TestNonMonotonicVSCPacketDropped")
+}
+
 func TestQueueAndSendVSCMaturedPackets(t *testing.T) {
 	runCCVTestByName(t, "TestQueueAndSendVSCMaturedPackets")
 }
@@ -95,17 +95,18 @@ func GetMocksForHandleSlashPacket(ctx sdk.Context, mocks MockedKeepers,
 	}
 
 	if expectJailing {
+		// the validator is slashed and jailed in a cached context
 		// slash
-		calls = append(calls, mocks.MockStakingKeeper.EXPECT().SlashWithInfractionReason(ctx, expectedProviderValConsAddr.ToSdkConsAddr(), gomock.Any(),
+		calls = append(calls, mocks.MockStakingKeeper.EXPECT().SlashWithInfractionReason(gomock.Any(), expectedProviderValConsAddr.ToSdkConsAddr(), gomock.Any(),
 			gomock.Any(), gomock.Any(), gomock.Any()).Return(math.NewInt(0), nil).Times(1))
 		// jail
 		calls = append(calls, mocks.MockStakingKeeper.EXPECT().Jail(
-			gomock.Eq(ctx),
+			gomock.Any(),
 			gomock.Eq(expectedProviderValConsAddr.ToSdkConsAddr()),
 		).Return(nil))
 
 		// JailUntil is set in this code path.
-		calls = append(calls, mocks.MockSlashingKeeper.EXPECT().JailUntil(ctx,
+		calls = append(calls, mocks.MockSlashingKeeper.EXPECT().JailUntil(gomock.Any(),
 			expectedProviderValConsAddr.ToSdkConsAddr(), gomock.Any()).Return(nil).Times(1))
 	}
 
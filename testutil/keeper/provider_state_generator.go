@@ -0,0 +1,233 @@
+package keeper
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v10/modules/core/23-commitment/types"
+	ibctmtypes "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
+	"github.com/stretchr/testify/require"
+
+	math "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	cryptotestutil "github.com/cosmos/interchain-security/v7/testutil/crypto"
+	providerkeeper "github.com/cosmos/interchain-security/v7/x/ccv/provider/keeper"
+	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+	"github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// generatedStateTime is the fixed time used in the generated provider state, which must not depend on the wall clock
+var generatedStateTime = time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+// ProviderStateConfig parameterizes the provider state synthesized by GenerateProviderState
+type ProviderStateConfig struct {
+	// Seed makes the generated state reproducible, i.e., the same config always results in the same state
+	Seed int64
+	// NumValidators is the number of provider consensus validators
+	NumValidators int
+	// NumConsumers is the number of launched consumer chains
+	NumConsumers int
+	// NumKeyAssignments is the number of consumer keys assigned by the validators,
+	// spread over distinct (validator, consumer chain) pairs
+	NumKeyAssignments int
+	// NumQueuedPackets is the number of VSC packets queued for the consumer chains
+	NumQueuedPackets int
+}
+
+// ProviderState describes the provider state synthesized by GenerateProviderState
+type ProviderState struct {
+	// Validators are the bonded staking validators backing the provider consensus validators,
+	// e.g., to set up the expectations of the mocked staking keeper
+	Validators []stakingtypes.Validator
+	// ConsensusValidators are the provider consensus validators
+	ConsensusValidators []providertypes.ConsensusValidator
+	// ConsumerIds are the ids of the launched consumer chains
+	ConsumerIds []string
+	// Version is the version of the store in which the state was committed,
+	// or 0 if the store of the context cannot be committed
+	Version int64
+}
+
+// GenerateProviderState synthesizes a large, internally consistent provider state directly into
+// the store of ctx, e.g., the IAVL store mounted by NewInMemKeeperParams, for use in benchmarks,
+// migration rehearsals, and invariant checks. The state consists of NumValidators provider consensus
+// validators and of NumConsumers launched consumer chains, alternately Top N and opt-in chains, on
+// which the validators assigned NumKeyAssignments consumer keys and for which NumQueuedPackets
+// VSC packets are pending. The provider params are the default params, which are amended to allow
+// NumValidators provider consensus validators. If the store can be committed, the state is committed.
+func GenerateProviderState(tb testing.TB, ctx sdk.Context, k providerkeeper.Keeper, cfg ProviderStateConfig) ProviderState {
+	tb.Helper()
+	require.Positive(tb, cfg.NumValidators, "at least one validator is needed")
+	require.LessOrEqual(tb, cfg.NumKeyAssignments, cfg.NumValidators*cfg.NumConsumers,
+		"there cannot be more key assignments than (validator, consumer chain) pairs")
+	if cfg.NumQueuedPackets > 0 {
+		require.Positive(tb, cfg.NumConsumers, "VSC packets can only be queued for consumer chains")
+	}
+
+	rng := rand.New(rand.NewSource(cfg.Seed))
+	newIdentity := func() *cryptotestutil.CryptoIdentity {
+		seed := make([]byte, 32)
+		_, _ = rng.Read(seed)
+		return cryptotestutil.NewCryptoIdentityFromBytesSeed(seed)
+	}
+
+	params := providertypes.DefaultParams()
+	if params.MaxProviderConsensusValidators < int64(cfg.NumValidators) {
+		params.MaxProviderConsensusValidators = int64(cfg.NumValidators)
+	}
+	k.SetParams(ctx, params)
+
+	// provider consensus validators
+	state := ProviderState{}
+	for i := 0; i < cfg.NumValidators; i++ {
+		identity := newIdentity()
+		power := 1 + rng.Int63n(1000)
+
+		validator := identity.SDKStakingValidator()
+		validator.Status = stakingtypes.Bonded
+		validator.Tokens = sdk.TokensFromConsensusPower(power, sdk.DefaultPowerReduction)
+		validator.DelegatorShares = math.LegacyNewDecFromInt(validator.Tokens)
+		state.Validators = append(state.Validators, validator)
+
+		pubKey := identity.TMProtoCryptoPublicKey()
+		state.ConsensusValidators = append(state.ConsensusValidators, providertypes.ConsensusValidator{
+			ProviderConsAddr: identity.SDKValConsAddress(),
+			Power:            power,
+			PublicKey:        &pubKey,
+		})
+	}
+	require.NoError(tb, k.SetLastProviderConsensusValSet(ctx, state.ConsensusValidators))
+
+	// launched consumer chains
+	for i := 0; i < cfg.NumConsumers; i++ {
+		consumerId := k.FetchAndIncrementConsumerId(ctx)
+		chainId := fmt.Sprintf("consumer-%d", i)
+		clientId := fmt.Sprintf("07-tendermint-%d", i)
+		channelId := fmt.Sprintf("channel-%d", i)
+
+		k.SetConsumerChainId(ctx, consumerId, chainId)
+		k.SetConsumerOwnerAddress(ctx, consumerId, k.GetAuthority())
+		require.NoError(tb, k.SetConsumerMetadata(ctx, consumerId, GetTestConsumerMetadata()))
+		initializationParameters := GetTestInitializationParameters(providertypes.InitParamsProfileMainnetConservative)
+		initializationParameters.InitialHeight = clienttypes.NewHeight(clienttypes.ParseChainID(chainId), 5)
+		initializationParameters.SpawnTime = generatedStateTime
+		require.NoError(tb, k.SetConsumerInitializationParameters(ctx, consumerId, initializationParameters))
+		powerShapingParameters := GetTestPowerShapingParameters()
+		if i%2 == 0 {
+			powerShapingParameters.Top_N = 100
+		}
+		require.NoError(tb, k.SetConsumerPowerShapingParameters(ctx, consumerId, powerShapingParameters))
+		require.NoError(tb, k.SetInfractionParameters(ctx, consumerId, GetTestInfractionParameters()))
+		k.SetConsumerPhase(ctx, consumerId, providertypes.CONSUMER_PHASE_LAUNCHED)
+		k.SetConsumerClientId(ctx, consumerId, clientId)
+		k.SetConsumerIdToChannelId(ctx, consumerId, channelId)
+		k.SetChannelToConsumerId(ctx, channelId, consumerId)
+		k.SetInitChainHeight(ctx, consumerId, uint64(ctx.BlockHeight()))
+
+		// Top N chains are validated by all the validators, opt-in chains by a random subset of them
+		for _, validator := range state.ConsensusValidators {
+			if powerShapingParameters.Top_N == 0 && rng.Intn(2) == 0 {
+				continue
+			}
+			k.SetOptedIn(ctx, consumerId, providertypes.NewProviderConsAddress(validator.ProviderConsAddr))
+		}
+
+		state.ConsumerIds = append(state.ConsumerIds, consumerId)
+	}
+
+	// consumer key assignments
+	for _, pair := range rng.Perm(cfg.NumValidators * cfg.NumConsumers)[:cfg.NumKeyAssignments] {
+		validator := state.ConsensusValidators[pair%cfg.NumValidators]
+		consumerId := state.ConsumerIds[pair/cfg.NumValidators]
+		consumerIdentity := newIdentity()
+		providerAddr := providertypes.NewProviderConsAddress(validator.ProviderConsAddr)
+
+		k.SetValidatorConsumerPubKey(ctx, consumerId, providerAddr, consumerIdentity.TMProtoCryptoPublicKey())
+		k.SetValidatorByConsumerAddr(ctx, consumerId, consumerIdentity.ConsumerConsAddress(), providerAddr)
+	}
+
+	// consumer validator sets and genesis states, which use the assigned consumer keys
+	for _, consumerId := range state.ConsumerIds {
+		var consumerValSet []providertypes.ConsensusValidator
+		var initialValSet []abci.ValidatorUpdate
+		for _, validator := range state.ConsensusValidators {
+			providerAddr := providertypes.NewProviderConsAddress(validator.ProviderConsAddr)
+			if !k.IsOptedIn(ctx, consumerId, providerAddr) {
+				continue
+			}
+			pubKey, found := k.GetValidatorConsumerPubKey(ctx, consumerId, providerAddr)
+			if !found {
+				pubKey = *validator.PublicKey
+			}
+			consumerValSet = append(consumerValSet, providertypes.ConsensusValidator{
+				ProviderConsAddr: validator.ProviderConsAddr,
+				Power:            validator.Power,
+				PublicKey:        &pubKey,
+				JoinHeight:       ctx.BlockHeight(),
+			})
+			initialValSet = append(initialValSet, abci.ValidatorUpdate{PubKey: pubKey, Power: validator.Power})
+		}
+		require.NoError(tb, k.SetConsumerValSet(ctx, consumerId, consumerValSet))
+
+		chainId, err := k.GetConsumerChainId(ctx, consumerId)
+		require.NoError(tb, err)
+		require.NoError(tb, k.SetConsumerGenesis(ctx, consumerId, generateConsumerGenesis(chainId, initialValSet)))
+	}
+
+	// queued VSC packets, which are distributed round-robin over the consumer chains
+	valsetUpdateId := k.GetValidatorSetUpdateId(ctx)
+	if valsetUpdateId == 0 {
+		valsetUpdateId = providertypes.DefaultValsetUpdateID
+	}
+	for i := 0; i < cfg.NumQueuedPackets; i++ {
+		consumerId := state.ConsumerIds[i%cfg.NumConsumers]
+		consumerValSet, err := k.GetConsumerValSet(ctx, consumerId)
+		require.NoError(tb, err)
+
+		var updates []abci.ValidatorUpdate
+		for _, validator := range consumerValSet {
+			if rng.Intn(4) == 0 {
+				updates = append(updates, abci.ValidatorUpdate{PubKey: *validator.PublicKey, Power: rng.Int63n(1000)})
+			}
+		}
+		k.AppendPendingVSCPackets(ctx, consumerId, types.NewValidatorSetChangePacketData(types.NewValsetDiff(updates...), valsetUpdateId, nil))
+		k.SetValsetUpdateBlockHeight(ctx, valsetUpdateId, uint64(ctx.BlockHeight()))
+		valsetUpdateId++
+	}
+	k.SetValidatorSetUpdateId(ctx, valsetUpdateId)
+
+	if cms, ok := ctx.MultiStore().(storetypes.CommitMultiStore); ok {
+		state.Version = cms.Commit().Version
+	}
+
+	return state
+}
+
+// generateConsumerGenesis returns the genesis state of a new consumer chain with the given initial validator set
+func generateConsumerGenesis(chainId string, initialValSet []abci.ValidatorUpdate) types.ConsumerGenesisState {
+	clientState := ibctmtypes.NewClientState(
+		chainId,
+		ibctmtypes.DefaultTrustLevel,
+		types.DefaultConsumerUnbondingPeriod*2/3,
+		types.DefaultConsumerUnbondingPeriod,
+		providertypes.DefaultMaxClockDrift,
+		clienttypes.Height{RevisionNumber: clienttypes.ParseChainID(chainId), RevisionHeight: 1},
+		commitmenttypes.GetSDKSpecs(),
+		[]string{"upgrade", "upgradedIBCState"},
+	)
+	consensusState := ibctmtypes.NewConsensusState(generatedStateTime,
+		commitmenttypes.NewMerkleRoot([]byte("apphash")), []byte("next_vals_hash_of_32_bytes______"))
+
+	params := types.DefaultParams()
+	params.Enabled = true
+	return *types.NewInitialConsumerGenesisState(clientState, consensusState, initialValSet, false, "", params)
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
+	addresscodec "cosmossdk.io/core/address"
 	"cosmossdk.io/log"
 	math "cosmossdk.io/math"
 	"cosmossdk.io/store"
@@ -42,10 +43,14 @@ import (
 
 // Parameters needed to instantiate an in-memory keeper
 type InMemKeeperParams struct {
-	Cdc            *codec.ProtoCodec
-	StoreKey       *storetypes.KVStoreKey
-	ParamsSubspace *paramstypes.Subspace
-	Ctx            sdk.Context
+	Cdc                   *codec.ProtoCodec
+	StoreKey              *storetypes.KVStoreKey
+	ParamsSubspace        *paramstypes.Subspace
+	Ctx                   sdk.Context
+	ValidatorAddressCodec addresscodec.Codec
+	ConsensusAddressCodec addresscodec.Codec
+	ProviderOptions       []providerkeeper.Option
+	ConsumerOptions       []consumerkeeper.Option
 }
 
 // NewInMemKeeperParams instantiates in-memory keeper params with default values
@@ -73,10 +78,12 @@ func NewInMemKeeperParams(tb testing.TB) InMemKeeperParams {
 	ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, log.NewNopLogger())
 
 	return InMemKeeperParams{
-		Cdc:            cdc,
-		StoreKey:       storeKey,
-		ParamsSubspace: &paramsSubspace,
-		Ctx:            ctx,
+		Cdc:                   cdc,
+		StoreKey:              storeKey,
+		ParamsSubspace:        &paramsSubspace,
+		Ctx:                   ctx,
+		ValidatorAddressCodec: address.NewBech32Codec(sdk.GetConfig().GetBech32ValidatorAddrPrefix()),
+		ConsensusAddressCodec: address.NewBech32Codec(sdk.GetConfig().GetBech32ConsensusAddrPrefix()),
 	}
 }
 
@@ -128,9 +135,10 @@ func NewInMemProviderKeeper(params InMemKeeperParams, mocks MockedKeepers) provi
 		// mocks.MockGovKeeper,
 		govkeeper.Keeper{}, // HACK: to make parts of the test work
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
-		address.NewBech32Codec("cosmosvaloper"),
-		address.NewBech32Codec("cosmosvalcons"),
+		params.ValidatorAddressCodec,
+		params.ConsensusAddressCodec,
 		authtypes.FeeCollectorName,
+		params.ProviderOptions...,
 	)
 }
 
@@ -149,8 +157,9 @@ func NewInMemConsumerKeeper(params InMemKeeperParams, mocks MockedKeepers) consu
 		mocks.MockIBCCoreKeeper,
 		authtypes.FeeCollectorName,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
-		address.NewBech32Codec("cosmosvaloper"),
-		address.NewBech32Codec("cosmosvalcons"),
+		params.ValidatorAddressCodec,
+		params.ConsensusAddressCodec,
+		params.ConsumerOptions...,
 	)
 }
 
@@ -222,7 +231,7 @@ func SetupForDeleteConsumerChain(t *testing.T, ctx sdk.Context,
 	providerKeeper.SetConsumerChainId(ctx, consumerId, "chainID")
 	err := providerKeeper.SetConsumerMetadata(ctx, consumerId, GetTestConsumerMetadata())
 	require.NoError(t, err)
-	err = providerKeeper.SetConsumerInitializationParameters(ctx, consumerId, GetTestInitializationParameters())
+	err = providerKeeper.SetConsumerInitializationParameters(ctx, consumerId, GetTestInitializationParameters(providertypes.InitParamsProfileMainnetConservative))
 	require.NoError(t, err)
 	err = providerKeeper.SetConsumerPowerShapingParameters(ctx, consumerId, GetTestPowerShapingParameters())
 	require.NoError(t, err)
@@ -265,6 +274,7 @@ func TestProviderStateIsCleanedAfterConsumerChainIsDeleted(t *testing.T, ctx sdk
 	require.Empty(t, providerKeeper.GetAllConsumerAddrsToPrune(ctx, consumerId))
 	require.Empty(t, providerKeeper.GetAllCommissionRateValidators(ctx, consumerId))
 	require.Zero(t, providerKeeper.GetEquivocationEvidenceMinHeight(ctx, consumerId))
+	require.Equal(t, providertypes.ConsumerPacketStats{}, providerKeeper.GetConsumerPacketStats(ctx, consumerId))
 }
 
 func GetTestConsumerMetadata() providertypes.ConsumerMetadata {
@@ -275,20 +285,18 @@ func GetTestConsumerMetadata() providertypes.ConsumerMetadata {
 	}
 }
 
-func GetTestInitializationParameters() providertypes.ConsumerInitializationParameters {
-	return providertypes.ConsumerInitializationParameters{
-		InitialHeight:                     clienttypes.NewHeight(0, 5),
-		GenesisHash:                       []byte("gen_hash"),
-		BinaryHash:                        []byte("bin_hash"),
-		SpawnTime:                         time.Now().UTC(),
-		ConsumerRedistributionFraction:    types.DefaultConsumerRedistributeFrac,
-		BlocksPerDistributionTransmission: types.DefaultBlocksPerDistributionTransmission,
-		DistributionTransmissionChannel:   "",
-		HistoricalEntries:                 types.DefaultHistoricalEntries,
-		CcvTimeoutPeriod:                  types.DefaultCCVTimeoutPeriod,
-		TransferTimeoutPeriod:             types.DefaultTransferTimeoutPeriod,
-		UnbondingPeriod:                   types.DefaultConsumerUnbondingPeriod,
+// GetTestInitializationParameters returns initialization parameters for testing that use
+// the periods and distribution settings of the given profile
+func GetTestInitializationParameters(profile providertypes.InitializationParametersProfile) providertypes.ConsumerInitializationParameters {
+	initializationParameters, err := providertypes.ConsumerInitializationParametersForProfile(profile)
+	if err != nil {
+		panic(err)
 	}
+	initializationParameters.InitialHeight = clienttypes.NewHeight(0, 5)
+	initializationParameters.GenesisHash = []byte("gen_hash")
+	initializationParameters.BinaryHash = []byte("bin_hash")
+	initializationParameters.SpawnTime = time.Now().UTC()
+	return initializationParameters
 }
 
 func GetTestInfractionParameters() providertypes.InfractionParameters {
@@ -0,0 +1,170 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagConsumerRPC  = "consumer-rpc"
+	flagProviderGRPC = "provider-grpc"
+	flagConsumerId   = "consumer-id"
+	flagFromHeight   = "from-height"
+	flagToHeight     = "to-height"
+	flagOutput       = "output"
+
+	outputJSON = "json"
+	outputText = "text"
+)
+
+func main() {
+	if err := NewRootCmd().Execute(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+// NewRootCmd creates the root command of ccv-signing-report, a tool that reports
+// which provider validators signed the blocks of a consumer chain
+func NewRootCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ccv-signing-report",
+		Short: "Report which provider validators signed a range of blocks of a consumer chain",
+		Long: `Report which provider validators signed a range of blocks of a consumer chain.
+
+ccv-signing-report fetches the commits and the validator sets of the consumer chain in the given
+height range from its CometBFT RPC endpoint, and maps the consumer keys that signed, or were expected
+to sign, to the validators of the provider chain through the key assignments of the provider chain.
+For every provider validator, the report counts the signed and missed blocks and flags the discrepancies:
+
+- signed_while_opted_out: the validator signed blocks while it was opted out of the consumer chain
+  according to its opt-in history on the provider chain.
+- missed_while_assigned: the validator was in the consumer validator set with an assigned consumer key,
+  but it did not sign some blocks.
+
+Consumer keys that cannot be mapped to a provider validator are reported separately.
+
+Example:
+$ ccv-signing-report --consumer-rpc http://localhost:26647 --provider-grpc localhost:9090 --consumer-id 0 --from-height 100 --to-height 200
+`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cfg, err := configFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+			return run(cmd.Context(), cfg, cmd.OutOrStdout())
+		},
+	}
+
+	cmd.Flags().String(flagConsumerRPC, "http://localhost:26657", "CometBFT RPC endpoint of the consumer chain")
+	cmd.Flags().String(flagProviderGRPC, "localhost:9090", "gRPC endpoint of the provider chain")
+	cmd.Flags().String(flagConsumerId, "", "consumer id of the consumer chain on the provider chain")
+	cmd.Flags().Int64(flagFromHeight, 0, "first consumer height of the report")
+	cmd.Flags().Int64(flagToHeight, 0, "last consumer height of the report")
+	cmd.Flags().String(flagOutput, outputText, "output format of the report (text|json)")
+	for _, flag := range []string{flagConsumerId, flagFromHeight, flagToHeight} {
+		if err := cmd.MarkFlagRequired(flag); err != nil {
+			panic(err)
+		}
+	}
+
+	return cmd
+}
+
+// Config defines the configuration of the report
+type Config struct {
+	ConsumerRPC  string
+	ProviderGRPC string
+	ConsumerId   string
+	FromHeight   int64
+	ToHeight     int64
+	Output       string
+}
+
+func configFromFlags(cmd *cobra.Command) (cfg Config, err error) {
+	flags := cmd.Flags()
+	if cfg.ConsumerRPC, err = flags.GetString(flagConsumerRPC); err != nil {
+		return cfg, err
+	}
+	if cfg.ProviderGRPC, err = flags.GetString(flagProviderGRPC); err != nil {
+		return cfg, err
+	}
+	if cfg.ConsumerId, err = flags.GetString(flagConsumerId); err != nil {
+		return cfg, err
+	}
+	if cfg.FromHeight, err = flags.GetInt64(flagFromHeight); err != nil {
+		return cfg, err
+	}
+	if cfg.ToHeight, err = flags.GetInt64(flagToHeight); err != nil {
+		return cfg, err
+	}
+	if cfg.Output, err = flags.GetString(flagOutput); err != nil {
+		return cfg, err
+	}
+	if cfg.FromHeight <= 0 || cfg.ToHeight < cfg.FromHeight {
+		return cfg, fmt.Errorf("invalid height range: [%d, %d]", cfg.FromHeight, cfg.ToHeight)
+	}
+	if cfg.Output != outputJSON && cfg.Output != outputText {
+		return cfg, fmt.Errorf("invalid output format: %s", cfg.Output)
+	}
+	return cfg, nil
+}
+
+func run(ctx context.Context, cfg Config, out io.Writer) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ctx, cancel := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	reporter, err := NewReporter(cfg)
+	if err != nil {
+		return err
+	}
+	defer reporter.Close()
+
+	report, err := reporter.Report(ctx)
+	if err != nil {
+		return err
+	}
+
+	if cfg.Output == outputJSON {
+		enc := json.NewEncoder(out)
+		enc.SetIndent("", "  ")
+		return enc.Encode(report)
+	}
+	return writeText(out, report)
+}
+
+// writeText writes the report as a table with one row per provider validator,
+// followed by the consumer keys that cannot be mapped to a provider validator
+func writeText(out io.Writer, report Report) error {
+	fmt.Fprintf(out, "consumer %s (%s), heights %d to %d\n\n", report.ConsumerId, report.ChainId, report.FromHeight, report.ToHeight)
+
+	w := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "PROVIDER ADDRESS\tEXPECTED\tSIGNED\tMISSED\tSIGNED OPTED OUT\tDISCREPANCIES")
+	for _, val := range report.Validators {
+		fmt.Fprintf(w, "%s\t%d\t%d\t%d\t%d\t%v\n",
+			val.ProviderAddress, val.Expected, val.Signed, val.Missed, val.SignedWhileOptedOut, val.Discrepancies)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	if len(report.UnmappedKeys) != 0 {
+		fmt.Fprintln(out, "\nconsumer keys without a provider validator:")
+		for _, key := range report.UnmappedKeys {
+			fmt.Fprintf(out, "  %s: %d expected, %d signed\n", key.ConsumerAddress, key.Expected, key.Signed)
+		}
+	}
+	return nil
+}
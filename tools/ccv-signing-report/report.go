@@ -0,0 +1,361 @@
+package main
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+
+	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+)
+
+const (
+	discrepancySignedWhileOptedOut = "signed_while_opted_out"
+	discrepancyMissedWhileAssigned = "missed_while_assigned"
+
+	// defaultConsAddrPrefix is the prefix of the consensus addresses used if the provider chain
+	// has no key assignments on the consumer chain
+	defaultConsAddrPrefix = "cosmosvalcons"
+	// validatorsPerPage is the number of validators fetched per page from the CometBFT RPC endpoint
+	validatorsPerPage = 100
+)
+
+// Report is the signing report of a range of blocks of a consumer chain
+type Report struct {
+	ConsumerId   string            `json:"consumer_id"`
+	ChainId      string            `json:"chain_id"`
+	FromHeight   int64             `json:"from_height"`
+	ToHeight     int64             `json:"to_height"`
+	Validators   []ValidatorReport `json:"validators"`
+	UnmappedKeys []KeyReport       `json:"unmapped_keys"`
+}
+
+// ValidatorReport describes the blocks signed and missed by a provider validator on the consumer chain
+type ValidatorReport struct {
+	ProviderAddress string `json:"provider_address"`
+	// the consumer keys of the validator in the consumer validator sets of the range
+	ConsumerAddresses []string `json:"consumer_addresses"`
+	// the number of blocks in which the validator was in the consumer validator set
+	Expected int64 `json:"expected"`
+	Signed   int64 `json:"signed"`
+	Missed   int64 `json:"missed"`
+	// the number of blocks signed while the validator was opted out according to its opt-in history
+	SignedWhileOptedOut        int64    `json:"signed_while_opted_out"`
+	MissedHeights              []int64  `json:"missed_heights,omitempty"`
+	SignedWhileOptedOutHeights []int64  `json:"signed_while_opted_out_heights,omitempty"`
+	Discrepancies              []string `json:"discrepancies"`
+}
+
+// KeyReport describes the blocks signed by a consumer key that cannot be mapped to a provider validator
+type KeyReport struct {
+	ConsumerAddress string `json:"consumer_address"`
+	Expected        int64  `json:"expected"`
+	Signed          int64  `json:"signed"`
+}
+
+// keyStats holds the heights at which a consumer key signed or missed a block
+type keyStats struct {
+	address       []byte
+	signedHeights []int64
+	missedHeights []int64
+}
+
+// Reporter fetches the blocks of the consumer chain and the key assignments of the provider chain
+type Reporter struct {
+	cfg Config
+
+	consumerRPC   *rpchttp.HTTP
+	providerConn  *grpc.ClientConn
+	providerQuery providertypes.QueryClient
+}
+
+// NewReporter creates a reporter connected to the endpoints of the given config
+func NewReporter(cfg Config) (*Reporter, error) {
+	consumerRPC, err := rpchttp.New(cfg.ConsumerRPC, "/websocket")
+	if err != nil {
+		return nil, fmt.Errorf("cannot connect to consumer RPC endpoint %s: %w", cfg.ConsumerRPC, err)
+	}
+
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	providerConn, err := grpc.NewClient(
+		cfg.ProviderGRPC,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(grpc.ForceCodec(cdc.GRPCCodec())),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("cannot connect to provider gRPC endpoint %s: %w", cfg.ProviderGRPC, err)
+	}
+
+	return &Reporter{
+		cfg:           cfg,
+		consumerRPC:   consumerRPC,
+		providerConn:  providerConn,
+		providerQuery: providertypes.NewQueryClient(providerConn),
+	}, nil
+}
+
+// Close closes the gRPC connection of the reporter
+func (r *Reporter) Close() {
+	r.providerConn.Close()
+}
+
+// Report builds the signing report of the configured range of consumer blocks
+func (r *Reporter) Report(ctx context.Context) (Report, error) {
+	status, err := r.consumerRPC.Status(ctx)
+	if err != nil {
+		return Report{}, fmt.Errorf("cannot query consumer status: %w", err)
+	}
+
+	stats, blockTimes, err := r.collectSignatures(ctx)
+	if err != nil {
+		return Report{}, err
+	}
+
+	prefix, providerAddrs, err := r.mapConsumerKeys(ctx, stats)
+	if err != nil {
+		return Report{}, err
+	}
+
+	// the opt-in history of every provider validator with a consumer key in the validator sets of the range
+	histories := map[string][]providertypes.ValidatorOptInRecord{}
+	for key := range stats {
+		providerAddr, mapped := providerAddrs[key]
+		if _, found := histories[providerAddr]; !mapped || found {
+			continue
+		}
+		history, err := r.optInHistory(ctx, providerAddr)
+		if err != nil {
+			return Report{}, err
+		}
+		histories[providerAddr] = history
+	}
+
+	report, err := aggregateReport(prefix, stats, blockTimes, providerAddrs, histories)
+	if err != nil {
+		return Report{}, err
+	}
+	report.ConsumerId = r.cfg.ConsumerId
+	report.ChainId = status.NodeInfo.Network
+	report.FromHeight = r.cfg.FromHeight
+	report.ToHeight = r.cfg.ToHeight
+	return report, nil
+}
+
+// aggregateReport aggregates the signatures of the consumer keys per provider validator and flags the discrepancies
+// with the opt-in histories of the validators. The consumer keys are mapped to the provider validators with
+// `providerAddrs`, i.e., by the hex encoding of their addresses, and encoded with the consensus address prefix `prefix`.
+func aggregateReport(
+	prefix string,
+	stats map[string]*keyStats,
+	blockTimes map[int64]time.Time,
+	providerAddrs map[string]string,
+	histories map[string][]providertypes.ValidatorOptInRecord,
+) (Report, error) {
+	report := Report{
+		Validators:   []ValidatorReport{},
+		UnmappedKeys: []KeyReport{},
+	}
+
+	// group the consumer keys by provider validator
+	keysByValidator := map[string][]*keyStats{}
+	for key, s := range stats {
+		consumerAddr, err := bech32.ConvertAndEncode(prefix, s.address)
+		if err != nil {
+			return Report{}, err
+		}
+		providerAddr, found := providerAddrs[key]
+		if !found {
+			report.UnmappedKeys = append(report.UnmappedKeys, KeyReport{
+				ConsumerAddress: consumerAddr,
+				Expected:        int64(len(s.signedHeights) + len(s.missedHeights)),
+				Signed:          int64(len(s.signedHeights)),
+			})
+			continue
+		}
+		keysByValidator[providerAddr] = append(keysByValidator[providerAddr], s)
+	}
+
+	for providerAddr, keys := range keysByValidator {
+		history := histories[providerAddr]
+		valReport := ValidatorReport{ProviderAddress: providerAddr, Discrepancies: []string{}}
+		for _, s := range keys {
+			consumerAddr, err := bech32.ConvertAndEncode(prefix, s.address)
+			if err != nil {
+				return Report{}, err
+			}
+			valReport.ConsumerAddresses = append(valReport.ConsumerAddresses, consumerAddr)
+			valReport.Signed += int64(len(s.signedHeights))
+			valReport.Missed += int64(len(s.missedHeights))
+			valReport.MissedHeights = append(valReport.MissedHeights, s.missedHeights...)
+			for _, height := range s.signedHeights {
+				if optedIn, known := optedInAt(history, blockTimes[height]); known && !optedIn {
+					valReport.SignedWhileOptedOutHeights = append(valReport.SignedWhileOptedOutHeights, height)
+				}
+			}
+		}
+		valReport.Expected = valReport.Signed + valReport.Missed
+		valReport.SignedWhileOptedOut = int64(len(valReport.SignedWhileOptedOutHeights))
+		sort.Strings(valReport.ConsumerAddresses)
+		sort.Slice(valReport.MissedHeights, func(i, j int) bool { return valReport.MissedHeights[i] < valReport.MissedHeights[j] })
+		sort.Slice(valReport.SignedWhileOptedOutHeights, func(i, j int) bool {
+			return valReport.SignedWhileOptedOutHeights[i] < valReport.SignedWhileOptedOutHeights[j]
+		})
+		if valReport.SignedWhileOptedOut > 0 {
+			valReport.Discrepancies = append(valReport.Discrepancies, discrepancySignedWhileOptedOut)
+		}
+		if valReport.Missed > 0 {
+			valReport.Discrepancies = append(valReport.Discrepancies, discrepancyMissedWhileAssigned)
+		}
+		report.Validators = append(report.Validators, valReport)
+	}
+
+	sort.Slice(report.Validators, func(i, j int) bool {
+		return report.Validators[i].ProviderAddress < report.Validators[j].ProviderAddress
+	})
+	sort.Slice(report.UnmappedKeys, func(i, j int) bool {
+		return report.UnmappedKeys[i].ConsumerAddress < report.UnmappedKeys[j].ConsumerAddress
+	})
+	return report, nil
+}
+
+// collectSignatures returns, for every consumer key in the validator sets of the range, the heights
+// at which it signed or missed a block, together with the time of every block of the range
+func (r *Reporter) collectSignatures(ctx context.Context) (map[string]*keyStats, map[int64]time.Time, error) {
+	stats := map[string]*keyStats{}
+	blockTimes := map[int64]time.Time{}
+	for height := r.cfg.FromHeight; height <= r.cfg.ToHeight; height++ {
+		valset, err := r.validators(ctx, height)
+		if err != nil {
+			return nil, nil, err
+		}
+		h := height
+		commit, err := r.consumerRPC.Commit(ctx, &h)
+		if err != nil {
+			return nil, nil, fmt.Errorf("cannot query consumer commit at height %d: %w", height, err)
+		}
+		blockTimes[height] = commit.Header.Time
+
+		// the commit of a block contains the signatures of the validator set of the block
+		signed := map[string]bool{}
+		for _, sig := range commit.Commit.Signatures {
+			if sig.BlockIDFlag == cmttypes.BlockIDFlagCommit {
+				signed[hex.EncodeToString(sig.ValidatorAddress)] = true
+			}
+		}
+		for _, val := range valset {
+			key := hex.EncodeToString(val.Address)
+			s, found := stats[key]
+			if !found {
+				s = &keyStats{address: val.Address}
+				stats[key] = s
+			}
+			if signed[key] {
+				s.signedHeights = append(s.signedHeights, height)
+			} else {
+				s.missedHeights = append(s.missedHeights, height)
+			}
+		}
+	}
+	return stats, blockTimes, nil
+}
+
+// validators returns the validator set of the consumer chain at `height`
+func (r *Reporter) validators(ctx context.Context, height int64) ([]*cmttypes.Validator, error) {
+	valset := []*cmttypes.Validator{}
+	perPage := validatorsPerPage
+	for page := 1; ; page++ {
+		h, p := height, page
+		res, err := r.consumerRPC.Validators(ctx, &h, &p, &perPage)
+		if err != nil {
+			return nil, fmt.Errorf("cannot query consumer validators at height %d: %w", height, err)
+		}
+		valset = append(valset, res.Validators...)
+		if len(valset) >= res.Total || len(res.Validators) == 0 {
+			return valset, nil
+		}
+	}
+}
+
+// mapConsumerKeys maps the given consumer keys to the provider validators through the key assignments
+// of the provider chain. The current assignments are queried first; the keys that are no longer assigned,
+// but that are still mapped by the provider chain until they are pruned, are then queried one by one.
+// It returns the prefix of the consensus addresses of the provider chain and the provider addresses
+// of the mapped keys.
+func (r *Reporter) mapConsumerKeys(ctx context.Context, stats map[string]*keyStats) (string, map[string]string, error) {
+	pairs, err := r.providerQuery.QueryAllPairsValConsAddrByConsumer(ctx,
+		&providertypes.QueryAllPairsValConsAddrByConsumerRequest{ConsumerId: r.cfg.ConsumerId})
+	if err != nil {
+		return "", nil, fmt.Errorf("cannot query key assignments of consumer %s: %w", r.cfg.ConsumerId, err)
+	}
+
+	prefix := defaultConsAddrPrefix
+	providerAddrs := map[string]string{}
+	for _, pair := range pairs.PairValConAddr {
+		hrp, consumerAddr, err := bech32.DecodeAndConvert(pair.ConsumerAddress)
+		if err != nil {
+			return "", nil, fmt.Errorf("invalid consumer address %s: %w", pair.ConsumerAddress, err)
+		}
+		prefix = hrp
+		providerAddrs[hex.EncodeToString(consumerAddr)] = pair.ProviderAddress
+	}
+
+	for key, s := range stats {
+		if _, found := providerAddrs[key]; found {
+			continue
+		}
+		consumerAddr, err := bech32.ConvertAndEncode(prefix, s.address)
+		if err != nil {
+			return "", nil, err
+		}
+		res, err := r.providerQuery.QueryValidatorProviderAddr(ctx, &providertypes.QueryValidatorProviderAddrRequest{
+			ConsumerId:      r.cfg.ConsumerId,
+			ConsumerAddress: consumerAddr,
+		})
+		// note that a consumer key without an assignment is mapped to the provider key with the same address,
+		// as validators use their provider keys on the consumer chains by default
+		if err != nil || res.ProviderAddress == "" {
+			continue
+		}
+		providerAddrs[key] = res.ProviderAddress
+	}
+	return prefix, providerAddrs, nil
+}
+
+// optInHistory returns the opt-ins and opt-outs of the provider validator on the consumer chain, oldest first
+func (r *Reporter) optInHistory(ctx context.Context, providerAddr string) ([]providertypes.ValidatorOptInRecord, error) {
+	res, err := r.providerQuery.QueryValidatorOptInHistory(ctx,
+		&providertypes.QueryValidatorOptInHistoryRequest{ProviderAddress: providerAddr})
+	if err != nil {
+		return nil, fmt.Errorf("cannot query opt-in history of validator %s: %w", providerAddr, err)
+	}
+	for _, history := range res.Histories {
+		if history.ConsumerId == r.cfg.ConsumerId {
+			return history.Records, nil
+		}
+	}
+	return nil, nil
+}
+
+// optedInAt returns whether the validator was opted in at time `t` according to its opt-in history,
+// i.e., according to its last opt-in or opt-out before `t`. It returns false as second value
+// if the history has no record before `t`.
+func optedInAt(history []providertypes.ValidatorOptInRecord, t time.Time) (optedIn, known bool) {
+	for _, record := range history {
+		if record.Time.After(t) {
+			break
+		}
+		optedIn, known = record.OptedIn, true
+	}
+	return optedIn, known
+}
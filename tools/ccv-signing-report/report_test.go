@@ -0,0 +1,113 @@
+package main
+
+import (
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+
+	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+)
+
+func consAddr(t *testing.T, address []byte) string {
+	t.Helper()
+	addr, err := bech32.ConvertAndEncode(defaultConsAddrPrefix, address)
+	require.NoError(t, err)
+	return addr
+}
+
+// TestAggregateReport tests that the signatures of the consumer keys are aggregated per provider validator
+// and that the discrepancies with the opt-in histories of the validators are flagged
+func TestAggregateReport(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	blockTimes := map[int64]time.Time{}
+	for height := int64(1); height <= 6; height++ {
+		blockTimes[height] = start.Add(time.Duration(height) * time.Minute)
+	}
+
+	// validator A signed all the blocks with two consumer keys, i.e., it assigned a new key at height 4,
+	// but it opted out before height 3; validator B missed two blocks; the key C is not assigned to any validator
+	keyA1, keyA2, keyB, keyC := []byte{0x01}, []byte{0x02}, []byte{0x03}, []byte{0x04}
+	stats := map[string]*keyStats{
+		hex.EncodeToString(keyA1): {address: keyA1, signedHeights: []int64{1, 2, 3}},
+		hex.EncodeToString(keyA2): {address: keyA2, signedHeights: []int64{4, 5, 6}},
+		hex.EncodeToString(keyB):  {address: keyB, signedHeights: []int64{1, 3, 4, 6}, missedHeights: []int64{5, 2}},
+		hex.EncodeToString(keyC):  {address: keyC, signedHeights: []int64{1}, missedHeights: []int64{2}},
+	}
+	providerAddrs := map[string]string{
+		hex.EncodeToString(keyA1): "cosmosvalcons1a",
+		hex.EncodeToString(keyA2): "cosmosvalcons1a",
+		hex.EncodeToString(keyB):  "cosmosvalcons1b",
+	}
+	histories := map[string][]providertypes.ValidatorOptInRecord{
+		"cosmosvalcons1a": {
+			{OptedIn: true, Time: start},
+			{OptedIn: false, Time: blockTimes[3].Add(-time.Second)},
+		},
+		// validator B has no opt-in history, e.g., it was pruned
+	}
+
+	report, err := aggregateReport(defaultConsAddrPrefix, stats, blockTimes, providerAddrs, histories)
+	require.NoError(t, err)
+	require.Equal(t, []ValidatorReport{
+		{
+			ProviderAddress:            "cosmosvalcons1a",
+			ConsumerAddresses:          []string{consAddr(t, keyA2), consAddr(t, keyA1)}, // sorted by address
+			Expected:                   6,
+			Signed:                     6,
+			Missed:                     0,
+			SignedWhileOptedOut:        4,
+			SignedWhileOptedOutHeights: []int64{3, 4, 5, 6},
+			Discrepancies:              []string{discrepancySignedWhileOptedOut},
+		},
+		{
+			ProviderAddress:   "cosmosvalcons1b",
+			ConsumerAddresses: []string{consAddr(t, keyB)},
+			Expected:          6,
+			Signed:            4,
+			Missed:            2,
+			MissedHeights:     []int64{2, 5},
+			Discrepancies:     []string{discrepancyMissedWhileAssigned},
+		},
+	}, report.Validators)
+	require.Equal(t, []KeyReport{
+		{ConsumerAddress: consAddr(t, keyC), Expected: 2, Signed: 1},
+	}, report.UnmappedKeys)
+
+	// an empty range yields an empty report
+	report, err = aggregateReport(defaultConsAddrPrefix, map[string]*keyStats{}, blockTimes, providerAddrs, histories)
+	require.NoError(t, err)
+	require.Empty(t, report.Validators)
+	require.Empty(t, report.UnmappedKeys)
+}
+
+// TestOptedInAt tests that the opt-in status of a validator is given by its last record before a given time
+func TestOptedInAt(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	history := []providertypes.ValidatorOptInRecord{
+		{OptedIn: true, Time: start},
+		{OptedIn: false, Time: start.Add(time.Hour)},
+	}
+
+	testCases := []struct {
+		name    string
+		t       time.Time
+		optedIn bool
+		known   bool
+	}{
+		{"before the history", start.Add(-time.Second), false, false},
+		{"at the opt-in", start, true, true},
+		{"after the opt-in", start.Add(time.Minute), true, true},
+		{"after the opt-out", start.Add(2 * time.Hour), false, true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			optedIn, known := optedInAt(history, tc.t)
+			require.Equal(t, tc.optedIn, optedIn)
+			require.Equal(t, tc.known, known)
+		})
+	}
+}
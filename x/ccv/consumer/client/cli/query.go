@@ -1,12 +1,19 @@
 package cli
 
 import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
 
 	"github.com/cosmos/interchain-security/v7/x/ccv/consumer/types"
+	ccvtypes "github.com/cosmos/interchain-security/v7/x/ccv/types"
 )
 
 // NewQueryCmd returns a root CLI command handler for all x/ccv/provider query commands.
@@ -24,6 +31,14 @@ func NewQueryCmd() *cobra.Command {
 		CmdProviderInfo(),
 		CmdThrottleState(),
 		CmdParams(),
+		CmdNearTimeoutPackets(),
+		CmdSlashRetryDelay(),
+		CmdProviderClientExpiry(),
+		CmdProviderSwitch(),
+		CmdPendingPacket(),
+		CmdModuleVersionInfo(),
+		CmdChangeoverRehearsal(),
+		CmdSlashRecord(),
 	)
 
 	return cmd
@@ -136,3 +151,245 @@ func CmdParams() *cobra.Command {
 
 	return cmd
 }
+
+func CmdNearTimeoutPackets() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "near-timeout-packets [duration]",
+		Short: "Query the unacknowledged packets sent to the provider that time out within the given duration, e.g., 30m",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			within, err := time.ParseDuration(args[0])
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryNearTimeoutPacketsRequest{Within: within}
+			res, err := queryClient.QueryNearTimeoutPackets(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdSlashRetryDelay() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "slash-retry-delay",
+		Short: "Query the remaining delay until the bounced slash packet can be resent",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QuerySlashRetryDelayRequest{}
+			res, err := queryClient.QuerySlashRetryDelay(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdSlashRecord() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "slash-record",
+		Short: "Query whether a slash packet is in flight, how many times it was bounced, and when it is retried",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QuerySlashRecordRequest{}
+			res, err := queryClient.QuerySlashRecord(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdProviderClientExpiry() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "provider-client-expiry",
+		Short: "Query the time at which the client to the provider expires",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryProviderClientExpiryRequest{}
+			res, err := queryClient.QueryProviderClientExpiry(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdProviderSwitch() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "provider-switch",
+		Short: "Query the active and previous provider chains and the pending switch to a different provider chain",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryProviderSwitchRequest{}
+			res, err := queryClient.QueryProviderSwitch(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdPendingPacket() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-packet [index]",
+		Short: "Query the packet at the given position of the pending packets queue, starting from 0 for the next packet to be sent",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			index, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryPendingPacketRequest{Index: index}
+			res, err := queryClient.QueryPendingPacket(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdModuleVersionInfo() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "module-version-info",
+		Short: "Query the version of the consumer module and the features enabled on the consumer chain",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryModuleVersionInfoRequest{}
+			res, err := queryClient.QueryModuleVersionInfo(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdChangeoverRehearsal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "changeover-rehearsal [consumer-genesis-file]",
+		Short: "Rehearse the standalone to consumer changeover with the initial valset of a consumer genesis state",
+		Long: `Rehearse the standalone to consumer changeover with the initial valset of the consumer genesis state in the given JSON file,
+e.g., the output of "query provider consumer-genesis [consumer-id] --output json" on the provider chain.
+The command returns the validator updates and the voting power changes that the changeover would produce,
+without handing over the control of the validator set from the standalone staking module.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			genesisJson, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			cdc := codec.NewProtoCodec(clientCtx.InterfaceRegistry)
+
+			genesisState := ccvtypes.ConsumerGenesisState{}
+			if err := cdc.UnmarshalJSON(genesisJson, &genesisState); err != nil {
+				return fmt.Errorf("consumer genesis state unmarshalling failed: %s", err)
+			}
+
+			req := &types.QueryChangeoverRehearsalRequest{InitialValSet: genesisState.Provider.InitialValSet}
+			res, err := queryClient.QueryChangeoverRehearsal(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,128 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	ibctmtypes "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/consumer/types"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("%s transactions subcommands", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(NewRetrySlashPacketCmd())
+	cmd.AddCommand(NewReportMisbehaviourCmd())
+
+	return cmd
+}
+
+func NewRetrySlashPacketCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "retry-slash-packet",
+		Short: "resend the bounced slash packet once the retry delay period has elapsed",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Resends the slash packet that was bounced by the provider chain without waiting for the next block,
+provided that the retry delay period has elapsed. The remaining delay can be queried with the slash-retry-delay query.
+Example:
+%s tx %s retry-slash-packet --from=<key_or_address>
+`, version.AppName, types.ModuleName)),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			txf = txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			msg := &types.MsgRetrySlashPacket{Signer: clientCtx.GetFromAddress().String()}
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
+
+func NewReportMisbehaviourCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "report-misbehaviour [header-1] [header-2]",
+		Short: "report a light client attack on the consumer chain to the provider chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Reports a light client attack on the consumer chain, i.e., two conflicting headers of the consumer chain.
+Both headers must be signed by more than a third of the voting power of the consumer validator set at their trusted heights.
+The consumer chain constructs the misbehaviour for the client to the consumer chain on the provider chain and sends it
+to the provider chain in a ConsumerMisbehaviour packet, which punishes the Byzantine validators.
+The header type definition can be found in ibc-go/proto/ibc/lightclients/tendermint/v1/tendermint.proto.
+
+Example:
+%s tx %s report-misbehaviour [path/to/header1.json] [path/to/header2.json] --from=<key_or_address>
+`, version.AppName, types.ModuleName)),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			txf = txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			cdc := codec.NewProtoCodec(clientCtx.InterfaceRegistry)
+
+			headers := make([]*ibctmtypes.Header, len(args))
+			for i, path := range args {
+				headerJson, err := os.ReadFile(path)
+				if err != nil {
+					return err
+				}
+				headers[i] = &ibctmtypes.Header{}
+				if err := cdc.UnmarshalJSON(headerJson, headers[i]); err != nil {
+					return fmt.Errorf("header unmarshalling failed: %s", err)
+				}
+			}
+
+			msg := &types.MsgReportMisbehaviour{
+				Submitter: clientCtx.GetFromAddress().String(),
+				Header_1:  headers[0],
+				Header_2:  headers[1],
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
@@ -0,0 +1,86 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/consumer/types"
+	ccvtypes "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// Client wraps the gRPC query client of the consumer module with typed helpers,
+// so that tools do not need to build the requests and unpack the responses themselves.
+// The underlying query client is embedded and can be used for the queries without a helper.
+type Client struct {
+	types.QueryClient
+}
+
+// NewClient returns a Client that sends the consumer queries over `conn`,
+// e.g., a `client.Context` or a `*grpc.ClientConn`
+func NewClient(conn gogogrpc.ClientConn) Client {
+	return Client{QueryClient: types.NewQueryClient(conn)}
+}
+
+// Params returns the parameters of the consumer module
+func (c Client) Params(ctx context.Context) (ccvtypes.ConsumerParams, error) {
+	res, err := c.QueryParams(ctx, &types.QueryParamsRequest{})
+	if err != nil {
+		return ccvtypes.ConsumerParams{}, err
+	}
+	return res.Params, nil
+}
+
+// ProviderInfo returns the client, connection and channel ids used by the CCV channel
+// on both the consumer and the provider chain
+func (c Client) ProviderInfo(ctx context.Context) (consumer, provider types.ChainInfo, err error) {
+	res, err := c.QueryProviderInfo(ctx, &types.QueryProviderInfoRequest{})
+	if err != nil {
+		return types.ChainInfo{}, types.ChainInfo{}, err
+	}
+	return res.Consumer, res.Provider, nil
+}
+
+// NextFeeDistribution returns an estimate of the next distribution of the consumer rewards
+func (c Client) NextFeeDistribution(ctx context.Context) (*types.NextFeeDistributionEstimate, error) {
+	res, err := c.QueryNextFeeDistribution(ctx, &types.QueryNextFeeDistributionEstimateRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return res.Data, nil
+}
+
+// NearTimeoutPackets returns the CCV packets sent to the provider chain that time out within `within`
+func (c Client) NearTimeoutPackets(ctx context.Context, within time.Duration) ([]types.PacketTimeout, error) {
+	res, err := c.QueryNearTimeoutPackets(ctx, &types.QueryNearTimeoutPacketsRequest{Within: within})
+	if err != nil {
+		return nil, err
+	}
+	return res.Packets, nil
+}
+
+// ModuleVersionInfo returns the version of the consumer module and the features enabled on the consumer chain
+func (c Client) ModuleVersionInfo(ctx context.Context) (ccvtypes.ModuleVersionInfo, error) {
+	res, err := c.QueryModuleVersionInfo(ctx, &types.QueryModuleVersionInfoRequest{})
+	if err != nil {
+		return ccvtypes.ModuleVersionInfo{}, err
+	}
+	return res.VersionInfo, nil
+}
+
+// ChangeoverRehearsal rehearses the standalone to consumer changeover with the given initial valset
+func (c Client) ChangeoverRehearsal(ctx context.Context, initialValSet []abci.ValidatorUpdate) (types.ChangeoverRehearsal, error) {
+	res, err := c.QueryChangeoverRehearsal(ctx, &types.QueryChangeoverRehearsalRequest{InitialValSet: initialValSet})
+	if err != nil {
+		return types.ChangeoverRehearsal{}, err
+	}
+	return res.Rehearsal, nil
+}
+
+// SlashRecord returns the state of the slash packet at the head of the pending packets queue
+func (c Client) SlashRecord(ctx context.Context) (*types.QuerySlashRecordResponse, error) {
+	return c.QuerySlashRecord(ctx, &types.QuerySlashRecordRequest{})
+}
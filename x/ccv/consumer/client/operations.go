@@ -0,0 +1,110 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/consumer/types"
+	ccvtypes "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// OperationsRoute is the route of the API server returning the balance operations performed by the consumer module
+// in the block at the `height` query parameter (see OperationsHandler)
+const OperationsRoute = "/interchain_security/ccv/consumer/operations"
+
+// Types of the balance operations performed by the consumer module
+const (
+	// OperationTypeRewardsToProvider debits the rewards sent to the provider chain over IBC
+	// from the account buffering them
+	OperationTypeRewardsToProvider = "ccv_rewards_to_provider"
+	// OperationTypeRewardSplit moves a reward split from the account buffering the rewards
+	// sent to the provider chain to the local address of the split
+	OperationTypeRewardSplit = "ccv_reward_split"
+)
+
+// Operation is a change of the balance of an account caused by the consumer module, in the form
+// used by Rosetta and similar data APIs, i.e., a signed amount of a single denom for a single account
+type Operation struct {
+	// the type of the operation, i.e., one of the OperationType constants
+	Type string `json:"type"`
+	// the address of the account whose balance changed
+	Account string `json:"account"`
+	Denom   string `json:"denom"`
+	// the change of the balance, negative for debits
+	Amount math.Int `json:"amount"`
+}
+
+// OperationsFromEvents extracts the balance operations performed by the consumer module from the ABCI events
+// of a block, in the order of the events. The bank events of the same block contain the same transfers,
+// but do not indicate that they are rewards of the provider chain.
+func OperationsFromEvents(events []abci.Event) ([]Operation, error) {
+	toSendToProvider := authtypes.NewModuleAddress(types.ConsumerToSendToProviderName).String()
+
+	operations := []Operation{}
+	for _, event := range events {
+		attrs := make(map[string]string, len(event.Attributes))
+		for _, attr := range event.Attributes {
+			attrs[attr.Key] = attr.Value
+		}
+		if attrs[sdk.AttributeKeyModule] != types.ModuleName {
+			continue
+		}
+
+		switch event.Type {
+		case types.EventTypeRewardSplit:
+			coins, err := sdk.ParseCoinsNormalized(attrs[types.AttributeRewardSplitAmount])
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s attribute in %s event: %w", types.AttributeRewardSplitAmount, event.Type, err)
+			}
+			for _, coin := range coins {
+				operations = append(operations,
+					Operation{
+						Type:    OperationTypeRewardSplit,
+						Account: toSendToProvider,
+						Denom:   coin.Denom,
+						Amount:  coin.Amount.Neg(),
+					},
+					Operation{
+						Type:    OperationTypeRewardSplit,
+						Account: attrs[types.AttributeRewardSplitAddress],
+						Denom:   coin.Denom,
+						Amount:  coin.Amount,
+					},
+				)
+			}
+		case types.EventTypeFeeDistribution:
+			coins, err := sdk.ParseCoinsNormalized(attrs[types.AttributeDistributionToProvider])
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s attribute in %s event: %w", types.AttributeDistributionToProvider, event.Type, err)
+			}
+			for _, coin := range coins {
+				operations = append(operations, Operation{
+					Type:    OperationTypeRewardsToProvider,
+					Account: toSendToProvider,
+					Denom:   coin.Denom,
+					Amount:  coin.Amount.Neg(),
+				})
+			}
+		}
+	}
+
+	return operations, nil
+}
+
+// OperationsHandler returns the handler of OperationsRoute, which extracts the balance operations performed by
+// the consumer module from the events of a block queried through `clientCtx`
+func OperationsHandler(clientCtx client.Context) http.HandlerFunc {
+	return ccvtypes.NewBlockOperationsHandler(clientCtx,
+		func(_ context.Context, _ client.Context, events []abci.Event) ([]Operation, error) {
+			return OperationsFromEvents(events)
+		})
+}
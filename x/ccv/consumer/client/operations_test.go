@@ -0,0 +1,59 @@
+package client_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/consumer/client"
+	"github.com/cosmos/interchain-security/v7/x/ccv/consumer/types"
+)
+
+func TestOperationsFromEvents(t *testing.T) {
+	toSendToProvider := authtypes.NewModuleAddress(types.ConsumerToSendToProviderName).String()
+	devFund := "cosmos1dkas8mu4kyhl5jrh4nzvm65qz588hy9qcz08la"
+
+	events := sdk.Events{
+		// reward split sent to a local address
+		sdk.NewEvent(types.EventTypeRewardSplit,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeRewardSplitAddress, devFund),
+			sdk.NewAttribute(types.AttributeRewardSplitAmount, "15stake"),
+		),
+		// event of another module
+		sdk.NewEvent(types.EventTypeFeeDistribution,
+			sdk.NewAttribute(sdk.AttributeKeyModule, "other"),
+			sdk.NewAttribute(types.AttributeDistributionToProvider, "1000stake"),
+		),
+		// rewards sent to the provider chain
+		sdk.NewEvent(types.EventTypeFeeDistribution,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeDistributionTotal, "100stake,10untrn"),
+			sdk.NewAttribute(types.AttributeDistributionToProvider, "85stake,10untrn"),
+			sdk.NewAttribute(types.AttributeDistributionToSplits, "15stake"),
+		),
+	}
+
+	operations, err := client.OperationsFromEvents(events.ToABCIEvents())
+	require.NoError(t, err)
+	require.Equal(t, []client.Operation{
+		{Type: client.OperationTypeRewardSplit, Account: toSendToProvider, Denom: "stake", Amount: math.NewInt(-15)},
+		{Type: client.OperationTypeRewardSplit, Account: devFund, Denom: "stake", Amount: math.NewInt(15)},
+		{Type: client.OperationTypeRewardsToProvider, Account: toSendToProvider, Denom: "stake", Amount: math.NewInt(-85)},
+		{Type: client.OperationTypeRewardsToProvider, Account: toSendToProvider, Denom: "untrn", Amount: math.NewInt(-10)},
+	}, operations)
+
+	// invalid amounts are reported
+	_, err = client.OperationsFromEvents(sdk.Events{
+		sdk.NewEvent(types.EventTypeRewardSplit,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeRewardSplitAmount, "invalid"),
+		),
+	}.ToABCIEvents())
+	require.Error(t, err)
+}
@@ -0,0 +1,108 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	ibctmtypes "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/consumer/keeper"
+)
+
+const (
+	// FlagProviderRPC is the RPC endpoint of the provider node from which the validator
+	// fetches the headers updating the client to the provider (see keeper.ProviderClientProposalHandler)
+	FlagProviderRPC = "ccv.provider-rpc"
+
+	// providerHeaderTimeout bounds the time spent fetching a header while preparing a proposal
+	providerHeaderTimeout = 2 * time.Second
+
+	// validatorsPerPage is the page size of the validator set queries
+	validatorsPerPage = 100
+)
+
+// AddProviderRPCFlag adds the flag setting the RPC endpoint of the provider node to the start command
+func AddProviderRPCFlag(startCmd *cobra.Command) {
+	startCmd.Flags().String(FlagProviderRPC, "", "RPC endpoint of a provider node, from which the validator fetches the provider headers "+
+		"updating the provider client when it is about to expire and the fallback is enabled, e.g., tcp://localhost:26657")
+}
+
+// ProviderHeaderSourceFromAppOptions returns the header source fetching the headers from the provider node
+// set through the app options, or nil if no provider node is set
+func ProviderHeaderSourceFromAppOptions(appOpts servertypes.AppOptions) (keeper.ProviderHeaderSource, error) {
+	endpoint := cast.ToString(appOpts.Get(FlagProviderRPC))
+	if endpoint == "" {
+		return nil, nil
+	}
+	client, err := rpchttp.New(endpoint, "/websocket")
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", FlagProviderRPC, err)
+	}
+	return NewRPCProviderHeaderSource(client), nil
+}
+
+// NewRPCProviderHeaderSource returns a header source that fetches the latest header of the provider chain,
+// together with the validator sets required to verify it from the trusted height, from a provider node
+func NewRPCProviderHeaderSource(client rpcclient.SignClient) keeper.ProviderHeaderSource {
+	return func(ctx sdk.Context, trustedHeight clienttypes.Height) (*ibctmtypes.Header, error) {
+		goCtx, cancel := context.WithTimeout(ctx.Context(), providerHeaderTimeout)
+		defer cancel()
+
+		commit, err := client.Commit(goCtx, nil)
+		if err != nil {
+			return nil, fmt.Errorf("cannot get latest provider commit: %w", err)
+		}
+		validators, err := fetchValidatorSet(goCtx, client, commit.Height)
+		if err != nil {
+			return nil, err
+		}
+		// the validators trusted by the client are the next validators of the trusted height
+		trustedValidators, err := fetchValidatorSet(goCtx, client, int64(trustedHeight.RevisionHeight)+1)
+		if err != nil {
+			return nil, err
+		}
+
+		validatorsProto, err := validators.ToProto()
+		if err != nil {
+			return nil, err
+		}
+		trustedValidatorsProto, err := trustedValidators.ToProto()
+		if err != nil {
+			return nil, err
+		}
+		return &ibctmtypes.Header{
+			SignedHeader:      commit.SignedHeader.ToProto(),
+			ValidatorSet:      validatorsProto,
+			TrustedHeight:     trustedHeight,
+			TrustedValidators: trustedValidatorsProto,
+		}, nil
+	}
+}
+
+// fetchValidatorSet returns the validator set of the provider chain at the given height
+func fetchValidatorSet(ctx context.Context, client rpcclient.SignClient, height int64) (*cmttypes.ValidatorSet, error) {
+	validators := []*cmttypes.Validator{}
+	perPage := validatorsPerPage
+	for page := 1; ; page++ {
+		res, err := client.Validators(ctx, &height, &page, &perPage)
+		if err != nil {
+			return nil, fmt.Errorf("cannot get provider validators at height %d: %w", height, err)
+		}
+		validators = append(validators, res.Validators...)
+		if len(res.Validators) == 0 || len(validators) >= res.Total {
+			break
+		}
+	}
+	return cmttypes.NewValidatorSet(validators), nil
+}
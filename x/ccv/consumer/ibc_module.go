@@ -37,10 +37,13 @@ func (am AppModule) OnChanOpenInit(
 		version = types.Version
 	}
 
-	// ensure provider channel hasn't already been created
+	// ensure provider channel hasn't already been created,
+	// unless the channel is a migration channel
 	if providerChannel, ok := am.keeper.GetProviderChannel(ctx); ok {
-		return "", errorsmod.Wrapf(types.ErrDuplicateChannel,
-			"provider channel: %s already set", providerChannel)
+		if err := am.keeper.ValidateChannelMigration(ctx, providerChannel, connectionHops); err != nil {
+			return "", errorsmod.Wrapf(types.ErrDuplicateChannel,
+				"provider channel: %s already set: %s", providerChannel, err.Error())
+		}
 	}
 
 	// Validate parameters
@@ -110,10 +113,18 @@ func (am AppModule) OnChanOpenAck(
 	_ string, // Counter party channel ID is unused per spec
 	counterpartyMetadata string,
 ) error {
-	// ensure provider channel has not already been created
-	if providerChannel, ok := am.keeper.GetProviderChannel(ctx); ok {
-		return errorsmod.Wrapf(types.ErrDuplicateChannel,
-			"provider channel: %s already established", providerChannel)
+	// ensure provider channel has not already been created,
+	// unless the channel is a migration channel
+	providerChannel, isMigration := am.keeper.GetProviderChannel(ctx)
+	if isMigration {
+		connHops, err := am.keeper.GetConnectionHops(ctx, portID, channelID)
+		if err != nil {
+			return err
+		}
+		if err := am.keeper.ValidateChannelMigration(ctx, providerChannel, connHops); err != nil {
+			return errorsmod.Wrapf(types.ErrDuplicateChannel,
+				"provider channel: %s already established: %s", providerChannel, err.Error())
+		}
 	}
 
 	var md types.HandshakeMetadata
@@ -127,6 +138,16 @@ func (am AppModule) OnChanOpenAck(
 			"invalid counterparty version: %s, expected %s", md.Version, types.Version)
 	}
 
+	if isMigration {
+		// the provider must acknowledge that the channel replaces the established CCV channel
+		if err := am.keeper.VerifyPreviousChannel(ctx, providerChannel, md.PreviousChannelId); err != nil {
+			return err
+		}
+		am.keeper.SetMigrationProviderChannel(ctx, channelID)
+		am.keeper.SetProviderFeePoolAddrStr(ctx, md.ProviderFeePoolAddr)
+		return nil
+	}
+
 	am.keeper.SetProviderFeePoolAddrStr(ctx, md.ProviderFeePoolAddr)
 
 	///////////////////////////////////////////////////
@@ -204,6 +225,7 @@ func (am AppModule) OnChanCloseConfirm(
 	portID,
 	channelID string,
 ) error {
+	am.keeper.OnChanCloseConfirm(ctx, channelID)
 	return nil
 }
 
@@ -230,13 +252,16 @@ func (am AppModule) OnRecvPacket(
 	// only attempt the application logic if the packet data
 	// was successfully decoded
 	if ack.Success() {
-		err := am.keeper.OnRecvVSCPacket(ctx, packet, data)
+		err := types.RunWithRecovery(ctx, consumertypes.ModuleName, func(ctx sdk.Context) error {
+			return am.keeper.OnRecvVSCPacket(ctx, packet, data)
+		})
+		err = types.HandleUnexpectedState(ctx, consumertypes.ModuleName, err)
 		if err != nil {
 			ack = channeltypes.NewErrorAcknowledgement(err)
 			ackErr = err
 			logger.Error(fmt.Sprintf("%s sequence %d", ackErr.Error(), packet.Sequence))
 		} else {
-			logger.Info("successfully handled VSCPacket", "sequence", packet.Sequence)
+			am.keeper.SubsystemLogger(ctx, types.LogSubsystemVSC).Info("successfully handled VSCPacket", "sequence", packet.Sequence)
 		}
 	}
 
@@ -313,6 +338,8 @@ func (am AppModule) OnTimeoutPacket(
 	packet channeltypes.Packet,
 	_ sdk.AccAddress,
 ) error {
+	am.keeper.OnTimeoutPacket(ctx, packet)
+
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeTimeout,
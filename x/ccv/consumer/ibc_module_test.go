@@ -69,6 +69,36 @@ func TestOnChanOpenInit(t *testing.T) {
 			"invalid: channel to provider already established",
 			func(keeper *consumerkeeper.Keeper, params *params, mocks testkeeper.MockedKeepers) {
 				keeper.SetProviderChannel(params.ctx, "existingProviderChanID")
+				gomock.InOrder(
+					mocks.MockChannelKeeper.EXPECT().GetChannel(
+						params.ctx, ccv.ConsumerPortID, "existingProviderChanID").Return(channeltypes.Channel{
+						State:          channeltypes.OPEN,
+						ConnectionHops: []string{"connectionIDToProvider"},
+					}, true).Times(1),
+				)
+			}, false,
+		},
+		{
+			"success: migration channel on a different connection",
+			func(keeper *consumerkeeper.Keeper, params *params, mocks testkeeper.MockedKeepers) {
+				keeper.SetProviderChannel(params.ctx, "existingProviderChanID")
+				gomock.InOrder(
+					mocks.MockChannelKeeper.EXPECT().GetChannel(
+						params.ctx, ccv.ConsumerPortID, "existingProviderChanID").Return(channeltypes.Channel{
+						State:          channeltypes.OPEN,
+						ConnectionHops: []string{"oldConnectionIDToProvider"},
+					}, true).Times(1),
+					mocks.MockConnectionKeeper.EXPECT().GetConnection(
+						params.ctx, "connectionIDToProvider").Return(
+						conntypes.ConnectionEnd{ClientId: "clientIDToProvider"}, true).Times(1),
+				)
+			}, true,
+		},
+		{
+			"invalid: channel migration already in progress",
+			func(keeper *consumerkeeper.Keeper, params *params, mocks testkeeper.MockedKeepers) {
+				keeper.SetProviderChannel(params.ctx, "existingProviderChanID")
+				keeper.SetMigrationProviderChannel(params.ctx, "migrationProviderChanID")
 			}, false,
 		},
 		{
@@ -235,6 +265,66 @@ func TestOnChanOpenAck(t *testing.T) {
 			"invalid: provider channel already established",
 			func(keeper *consumerkeeper.Keeper, params *params, mocks testkeeper.MockedKeepers) {
 				keeper.SetProviderChannel(params.ctx, "existingProviderChannelID")
+				gomock.InOrder(
+					mocks.MockChannelKeeper.EXPECT().GetChannel(
+						params.ctx, params.portID, params.channelID).Return(channeltypes.Channel{
+						ConnectionHops: []string{"connectionID"},
+					}, true).Times(1),
+					mocks.MockChannelKeeper.EXPECT().GetChannel(
+						params.ctx, ccv.ConsumerPortID, "existingProviderChannelID").Return(channeltypes.Channel{
+						State:          channeltypes.OPEN,
+						ConnectionHops: []string{"connectionID"},
+					}, true).Times(1),
+				)
+			}, false,
+		},
+		{
+			"success - migration channel",
+			func(keeper *consumerkeeper.Keeper, params *params, mocks testkeeper.MockedKeepers) {
+				keeper.SetProviderChannel(params.ctx, "existingProviderChannelID")
+				existingChannel := channeltypes.Channel{
+					State:          channeltypes.OPEN,
+					ConnectionHops: []string{"oldConnectionID"},
+					Counterparty:   channeltypes.NewCounterparty(ccv.ProviderPortID, "previousProviderCCVChannelID"),
+				}
+				md := ccv.HandshakeMetadata{
+					ProviderFeePoolAddr: "someAcct",
+					Version:             ccv.Version,
+					PreviousChannelId:   "previousProviderCCVChannelID",
+				}
+				metadataBz, err := md.Marshal()
+				require.NoError(t, err)
+				params.counterpartyMetadata = string(metadataBz)
+
+				gomock.InOrder(
+					mocks.MockChannelKeeper.EXPECT().GetChannel(
+						params.ctx, params.portID, params.channelID).Return(channeltypes.Channel{
+						ConnectionHops: []string{"connectionID"},
+					}, true).Times(1),
+					mocks.MockChannelKeeper.EXPECT().GetChannel(
+						params.ctx, ccv.ConsumerPortID, "existingProviderChannelID").Return(existingChannel, true).Times(2),
+				)
+			},
+			true,
+		},
+		{
+			"invalid: migration channel with unexpected previous channel",
+			func(keeper *consumerkeeper.Keeper, params *params, mocks testkeeper.MockedKeepers) {
+				keeper.SetProviderChannel(params.ctx, "existingProviderChannelID")
+				existingChannel := channeltypes.Channel{
+					State:          channeltypes.OPEN,
+					ConnectionHops: []string{"oldConnectionID"},
+					Counterparty:   channeltypes.NewCounterparty(ccv.ProviderPortID, "previousProviderCCVChannelID"),
+				}
+
+				gomock.InOrder(
+					mocks.MockChannelKeeper.EXPECT().GetChannel(
+						params.ctx, params.portID, params.channelID).Return(channeltypes.Channel{
+						ConnectionHops: []string{"connectionID"},
+					}, true).Times(1),
+					mocks.MockChannelKeeper.EXPECT().GetChannel(
+						params.ctx, ccv.ConsumerPortID, "existingProviderChannelID").Return(existingChannel, true).Times(2),
+				)
 			}, false,
 		},
 		{
@@ -1,9 +1,19 @@
 package keeper
 
 import (
+	"fmt"
+	"sort"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/consumer/types"
 )
 
 // ChangeoverIsComplete returns whether the standalone to consumer changeover process is complete.
@@ -28,9 +38,36 @@ func (k Keeper) FirstConsumerHeight(ctx sdk.Context) int64 {
 // that will be given to tendermint, which allows the consumer chain to
 // start using the provider valset, while the standalone valset is given zero voting power where appropriate.
 func (k Keeper) ChangeoverToConsumer(ctx sdk.Context) (initialValUpdates []abci.ValidatorUpdate) {
-	// populate cross chain validators states with initial valset
-	initialValUpdates = k.GetInitialValSet(ctx)
-	k.ApplyCCValidatorChanges(ctx, initialValUpdates)
+	initialValUpdates, err := k.changeoverValUpdates(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	// Note: this method should only be executed once as a part of the changeover process.
+	// Therefore we set the PreCCV state to false so the endblocker caller doesn't call this method again.
+	k.DeletePreCCV(ctx)
+
+	k.Logger(ctx).Info("ICS changeover complete - you are now a consumer chain!")
+	return initialValUpdates
+}
+
+// changeoverValUpdates populates the cross chain validators states with the initial valset
+// and returns the validator updates of the changeover, i.e., the provider validators with their full power
+// and the "old" validators returned from the standalone staking module with zero power
+func (k Keeper) changeoverValUpdates(ctx sdk.Context) ([]abci.ValidatorUpdate, error) {
+	// record the tombstoned and jailed standalone validators before the provider valset takes over,
+	// so that their consensus keys cannot re-enter consensus through the provider valset
+	if err := k.RecordStandaloneValidators(ctx); err != nil {
+		return nil, err
+	}
+
+	// populate cross chain validators states with initial valset;
+	// note that the validators using the consensus keys of the recorded standalone validators are skipped
+	initialValSet := k.GetInitialValSet(ctx)
+	initialValUpdates := k.ApplyCCValidatorChanges(ctx, initialValSet)
+	if len(initialValSet) != 0 && len(initialValUpdates) == 0 {
+		return nil, fmt.Errorf("none of the %d validators of the initial valset can validate the consumer chain", len(initialValSet))
+	}
 
 	// Add validator updates to initialValUpdates, such that the "old" validators returned from standalone staking module
 	// are given zero power, and the provider validators are given their full power.
@@ -41,7 +78,7 @@ func (k Keeper) ChangeoverToConsumer(ctx sdk.Context) (initialValUpdates []abci.
 
 	standaloneValset, err := k.GetLastStandaloneValidators(ctx)
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
 	for _, val := range standaloneValset {
 		zeroPowerUpdate := val.ABCIValidatorUpdateZero()
@@ -50,10 +87,259 @@ func (k Keeper) ChangeoverToConsumer(ctx sdk.Context) (initialValUpdates []abci.
 		}
 	}
 
-	// Note: this method should only be executed once as a part of the changeover process.
-	// Therefore we set the PreCCV state to false so the endblocker caller doesn't call this method again.
-	k.DeletePreCCV(ctx)
+	return initialValUpdates, nil
+}
 
-	k.Logger(ctx).Info("ICS changeover complete - you are now a consumer chain!")
-	return initialValUpdates
+// RehearseChangeover rehearses the standalone to consumer changeover with the given initial valset, e.g.,
+// the initial valset of the consumer genesis state obtained from the provider chain. The preCCV logic runs
+// on a branch of the state that is discarded, i.e., the control of the validator set is not handed over
+// from the standalone staking module. This allows standalone chains to verify their changeover configuration
+// before the upgrade height.
+func (k Keeper) RehearseChangeover(ctx sdk.Context, initialValSet []abci.ValidatorUpdate) (types.ChangeoverRehearsal, error) {
+	if k.standaloneStakingKeeper == nil {
+		return types.ChangeoverRehearsal{}, errorsmod.Wrap(types.ErrInvalidChangeoverRehearsal,
+			"the chain has no standalone staking module")
+	}
+	if len(k.GetAllCCValidator(ctx)) > 0 {
+		return types.ChangeoverRehearsal{}, errorsmod.Wrap(types.ErrInvalidChangeoverRehearsal,
+			"the chain is already a consumer chain")
+	}
+	if len(initialValSet) == 0 {
+		return types.ChangeoverRehearsal{}, errorsmod.Wrap(types.ErrInvalidChangeoverRehearsal,
+			"the initial valset is empty")
+	}
+	for i, update := range initialValSet {
+		if _, err := cryptocodec.FromCmtProtoPublicKey(update.PubKey); err != nil {
+			return types.ChangeoverRehearsal{}, errorsmod.Wrapf(types.ErrInvalidChangeoverRehearsal,
+				"invalid public key of validator %d of the initial valset: %s", i, err.Error())
+		}
+		if update.Power <= 0 {
+			return types.ChangeoverRehearsal{}, errorsmod.Wrapf(types.ErrInvalidChangeoverRehearsal,
+				"non-positive power of validator %d of the initial valset: %d", i, update.Power)
+		}
+	}
+
+	// the state changes of the changeover are never written
+	cacheCtx, _ := ctx.CacheContext()
+	k.SetPreCCVTrue(cacheCtx)
+	k.SetInitialValSet(cacheCtx, initialValSet)
+
+	standaloneValset, err := k.GetLastStandaloneValidators(cacheCtx)
+	if err != nil {
+		return types.ChangeoverRehearsal{}, err
+	}
+	valUpdates, err := k.changeoverValUpdates(cacheCtx)
+	if err != nil {
+		return types.ChangeoverRehearsal{}, errorsmod.Wrap(types.ErrInvalidChangeoverRehearsal, err.Error())
+	}
+
+	powerChanges := map[string]*types.ChangeoverPowerChange{}
+	getPowerChange := func(consAddr sdk.ConsAddress) *types.ChangeoverPowerChange {
+		change, found := powerChanges[consAddr.String()]
+		if !found {
+			change = &types.ChangeoverPowerChange{ConsensusAddress: consAddr.String()}
+			powerChanges[consAddr.String()] = change
+		}
+		return change
+	}
+	powerReduction := k.standaloneStakingKeeper.PowerReduction(cacheCtx)
+	for _, val := range standaloneValset {
+		consAddr, err := val.GetConsAddr()
+		if err != nil {
+			return types.ChangeoverRehearsal{}, err
+		}
+		getPowerChange(consAddr).StandalonePower = val.ConsensusPower(powerReduction)
+	}
+	for _, update := range valUpdates {
+		if update.Power <= 0 {
+			continue
+		}
+		pubKey, err := cryptocodec.FromCmtProtoPublicKey(update.PubKey)
+		if err != nil {
+			return types.ChangeoverRehearsal{}, err
+		}
+		getPowerChange(sdk.ConsAddress(pubKey.Address())).ConsumerPower = update.Power
+	}
+
+	rehearsal := types.ChangeoverRehearsal{
+		ValidatorUpdates:   valUpdates,
+		PowerChanges:       []types.ChangeoverPowerChange{},
+		ExcludedValidators: []string{},
+	}
+	for _, change := range powerChanges {
+		rehearsal.PowerChanges = append(rehearsal.PowerChanges, *change)
+	}
+	sort.Slice(rehearsal.PowerChanges, func(i, j int) bool {
+		return rehearsal.PowerChanges[i].ConsensusAddress < rehearsal.PowerChanges[j].ConsensusAddress
+	})
+	for _, update := range initialValSet {
+		// the public keys of the initial valset were validated above
+		pubKey, _ := cryptocodec.FromCmtProtoPublicKey(update.PubKey)
+		consAddr := sdk.ConsAddress(pubKey.Address())
+		if k.IsStandaloneValidatorExcluded(cacheCtx, consAddr) {
+			rehearsal.ExcludedValidators = append(rehearsal.ExcludedValidators, consAddr.String())
+		}
+	}
+
+	return rehearsal, nil
+}
+
+// RecordStandaloneValidators records the validators of the standalone chain that are tombstoned,
+// or that are jailed for a period that has not elapsed yet. As the provider valset is not aware of
+// the infractions committed on the standalone chain, the consensus keys of these validators
+// cannot be used by the provider validators (see ApplyCCValidatorChanges).
+func (k Keeper) RecordStandaloneValidators(ctx sdk.Context) error {
+	var recordErr error
+	err := k.standaloneStakingKeeper.IterateValidators(ctx, func(_ int64, validator stakingtypes.ValidatorI) bool {
+		consAddr, err := validator.GetConsAddr()
+		if err != nil {
+			recordErr = err
+			return true
+		}
+		consAddrStr, err := k.consensusAddressCodec.BytesToString(consAddr)
+		if err != nil {
+			recordErr = err
+			return true
+		}
+		record := types.StandaloneValidatorRecord{
+			ConsensusAddress: consAddrStr,
+			Tombstoned:       k.slashingKeeper.IsTombstoned(ctx, consAddr),
+		}
+		if validator.IsJailed() {
+			signingInfo, err := k.slashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+			// a validator without signing info has no jail period, and it can unjail at any time
+			if err == nil && signingInfo.JailedUntil.After(ctx.BlockTime()) {
+				record.JailedUntil = signingInfo.JailedUntil
+			}
+		}
+		if record.Tombstoned || !record.JailedUntil.IsZero() {
+			k.SetStandaloneValidatorRecord(ctx, record)
+		}
+		return false
+	})
+	if err != nil {
+		return err
+	}
+	return recordErr
+}
+
+// GetStandaloneValidatorRecord returns the record of the tombstoned or jailed standalone validator with consensus address `consAddr`
+func (k Keeper) GetStandaloneValidatorRecord(ctx sdk.Context, consAddr sdk.ConsAddress) (types.StandaloneValidatorRecord, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.StandaloneValidatorRecordKey(consAddr))
+	if bz == nil {
+		return types.StandaloneValidatorRecord{}, false
+	}
+	var record types.StandaloneValidatorRecord
+	if err := record.Unmarshal(bz); err != nil {
+		// An error here would indicate something is very wrong,
+		// the record is assumed to be correctly serialized in SetStandaloneValidatorRecord.
+		panic(fmt.Errorf("failed to unmarshal standalone validator record: %w", err))
+	}
+	return record, true
+}
+
+// SetStandaloneValidatorRecord sets the record of a tombstoned or jailed standalone validator
+func (k Keeper) SetStandaloneValidatorRecord(ctx sdk.Context, record types.StandaloneValidatorRecord) {
+	consAddr, err := k.consensusAddressCodec.StringToBytes(record.ConsensusAddress)
+	if err != nil {
+		// An error here would indicate something is very wrong,
+		// the record is either built from a standalone validator or validated in genesis.
+		panic(fmt.Errorf("invalid consensus address in standalone validator record: %w", err))
+	}
+	bz, err := record.Marshal()
+	if err != nil {
+		// An error here would indicate something is very wrong,
+		// the record is obtained from the standalone staking and slashing modules.
+		panic(fmt.Errorf("failed to marshal standalone validator record: %w", err))
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.StandaloneValidatorRecordKey(consAddr), bz)
+}
+
+// DeleteStandaloneValidatorRecord deletes the record of the standalone validator with consensus address `consAddr`
+func (k Keeper) DeleteStandaloneValidatorRecord(ctx sdk.Context, consAddr sdk.ConsAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.StandaloneValidatorRecordKey(consAddr))
+}
+
+// GetAllStandaloneValidatorRecords returns the records of all the tombstoned and jailed standalone validators,
+// in the order of their consensus addresses
+func (k Keeper) GetAllStandaloneValidatorRecords(ctx sdk.Context) []types.StandaloneValidatorRecord {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.StandaloneValidatorRecordKeyPrefix())
+	defer iterator.Close()
+
+	records := []types.StandaloneValidatorRecord{}
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.StandaloneValidatorRecord
+		if err := record.Unmarshal(iterator.Value()); err != nil {
+			// An error here would indicate something is very wrong,
+			// the record is assumed to be correctly serialized in SetStandaloneValidatorRecord.
+			panic(fmt.Errorf("failed to unmarshal standalone validator record: %w", err))
+		}
+		records = append(records, record)
+	}
+	return records
+}
+
+// IsStandaloneValidatorExcluded returns whether the consensus key with address `consAddr` belongs to a standalone validator
+// that was tombstoned, or that is still jailed, and thus cannot be part of the consumer validator set
+func (k Keeper) IsStandaloneValidatorExcluded(ctx sdk.Context, consAddr sdk.ConsAddress) bool {
+	record, found := k.GetStandaloneValidatorRecord(ctx, consAddr)
+	return found && record.ExcludesValidatorAt(ctx.BlockTime())
+}
+
+// DeferStandaloneValidatorUpdate stores the update `change` of the provider chain for the consensus key of
+// the jailed standalone validator with consensus address `consAddr`, until the end of its jail period.
+// An update with zero power drops the deferred update. Updates for tombstoned validators are never applied.
+func (k Keeper) DeferStandaloneValidatorUpdate(ctx sdk.Context, consAddr sdk.ConsAddress, change abci.ValidatorUpdate) {
+	record, found := k.GetStandaloneValidatorRecord(ctx, consAddr)
+	if !found || record.Tombstoned {
+		return
+	}
+	if change.Power < 1 {
+		if record.DeferredUpdate == nil {
+			return
+		}
+		record.DeferredUpdate = nil
+	} else {
+		record.DeferredUpdate = &change
+	}
+	k.SetStandaloneValidatorRecord(ctx, record)
+}
+
+// ReadmitStandaloneValidators deletes the records of the standalone validators whose jail period elapsed,
+// and returns `changes` preceded by the updates deferred for their consensus keys,
+// except for the keys already updated by `changes`
+func (k Keeper) ReadmitStandaloneValidators(ctx sdk.Context, changes []abci.ValidatorUpdate) []abci.ValidatorUpdate {
+	updated := map[string]bool{}
+	for _, change := range changes {
+		if pubkey, err := cryptocodec.FromCmtProtoPublicKey(change.GetPubKey()); err == nil {
+			updated[string(pubkey.Address())] = true
+		}
+	}
+
+	readmitted := []abci.ValidatorUpdate{}
+	for _, record := range k.GetAllStandaloneValidatorRecords(ctx) {
+		if record.ExcludesValidatorAt(ctx.BlockTime()) {
+			continue
+		}
+		consAddr, err := k.consensusAddressCodec.StringToBytes(record.ConsensusAddress)
+		if err != nil {
+			// An error here would indicate something is very wrong,
+			// the record is validated in SetStandaloneValidatorRecord.
+			panic(fmt.Errorf("invalid consensus address in standalone validator record: %w", err))
+		}
+		k.DeleteStandaloneValidatorRecord(ctx, consAddr)
+		if record.DeferredUpdate == nil || updated[string(consAddr)] {
+			continue
+		}
+		k.Logger(ctx).Info("readmitting validator of a standalone validator after the end of the jail period",
+			"consensus address", record.ConsensusAddress,
+			"power", record.DeferredUpdate.Power,
+		)
+		readmitted = append(readmitted, *record.DeferredUpdate)
+	}
+	return append(readmitted, changes...)
 }
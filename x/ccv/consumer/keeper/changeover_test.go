@@ -1,17 +1,26 @@
 package keeper_test
 
 import (
+	"context"
+	"sort"
 	"testing"
+	"time"
 
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
+	evidencetypes "cosmossdk.io/x/evidence/types"
+
 	sdkcryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 
 	"github.com/cosmos/interchain-security/v7/testutil/crypto"
 	uthelpers "github.com/cosmos/interchain-security/v7/testutil/keeper"
+	"github.com/cosmos/interchain-security/v7/x/ccv/consumer/types"
 )
 
 func TestChangeoverToConsumer(t *testing.T) {
@@ -106,6 +115,9 @@ func TestChangeoverToConsumer(t *testing.T) {
 			tc.lastSovVals,
 			-1) // any times
 
+		// none of the standalone validators is tombstoned or jailed
+		mocks.MockStakingKeeper.EXPECT().IterateValidators(ctx, gomock.Any()).Return(nil)
+
 		// Add ref to standalone staking keeper
 		consumerKeeper.SetStandaloneStakingKeeper(mocks.MockStakingKeeper)
 
@@ -171,3 +183,275 @@ func TestChangeoverToConsumer(t *testing.T) {
 		require.Len(t, returnedInitialValUpdates, tc.expectedReturnValUpdatesLen)
 	}
 }
+
+// TestChangeoverToConsumerStandaloneValidatorRecords tests that the tombstoned and jailed validators of the standalone chain
+// are recorded during the changeover, and that their consensus keys cannot be used by the provider validators
+func TestChangeoverToConsumerStandaloneValidatorRecords(t *testing.T) {
+	keeperParams := uthelpers.NewInMemKeeperParams(t)
+	consumerKeeper, ctx, ctrl, mocks := uthelpers.GetConsumerKeeperAndCtx(t, keeperParams)
+	defer ctrl.Finish()
+	blockTime := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(blockTime)
+
+	cIds := []crypto.CryptoIdentity{}
+	for i := 0; i < 5; i++ {
+		cIds = append(cIds, *crypto.NewCryptoIdentityFromIntSeed(i + 7842))
+	}
+
+	// the standalone validator 0 is tombstoned, the validator 1 is jailed for one more hour,
+	// the jail period of the validator 2 elapsed, and the validator 3 is bonded
+	sovVals := []stakingtypes.Validator{}
+	for i := 0; i < 4; i++ {
+		val := cIds[i].SDKStakingValidator()
+		val.Jailed = i < 3
+		sovVals = append(sovVals, val)
+	}
+	mocks.MockStakingKeeper.EXPECT().IterateValidators(ctx, gomock.Any()).DoAndReturn(
+		func(_ context.Context, cb func(int64, stakingtypes.ValidatorI) bool) error {
+			for i, val := range sovVals {
+				if cb(int64(i), val) {
+					break
+				}
+			}
+			return nil
+		})
+	for i := 0; i < 4; i++ {
+		mocks.MockSlashingKeeper.EXPECT().IsTombstoned(ctx, cIds[i].SDKValConsAddress()).Return(i == 0)
+	}
+	jailedUntil := []time.Time{evidencetypes.DoubleSignJailEndTime, blockTime.Add(time.Hour), blockTime.Add(-time.Hour)}
+	for i := 0; i < 3; i++ {
+		mocks.MockSlashingKeeper.EXPECT().GetValidatorSigningInfo(ctx, cIds[i].SDKValConsAddress()).Return(
+			slashingtypes.ValidatorSigningInfo{JailedUntil: jailedUntil[i]}, nil)
+	}
+	uthelpers.SetupMocksForLastBondedValidatorsExpectation(mocks.MockStakingKeeper, 180, sovVals[3:], -1)
+	consumerKeeper.SetStandaloneStakingKeeper(mocks.MockStakingKeeper)
+
+	// the provider validators use the consensus keys of the standalone validators 0, 1, and 2, and a new key
+	consumerKeeper.SetPreCCVTrue(ctx)
+	consumerKeeper.SetInitialValSet(ctx, []abci.ValidatorUpdate{
+		{Power: 10, PubKey: cIds[0].TMProtoCryptoPublicKey()},
+		{Power: 20, PubKey: cIds[1].TMProtoCryptoPublicKey()},
+		{Power: 30, PubKey: cIds[2].TMProtoCryptoPublicKey()},
+		{Power: 40, PubKey: cIds[4].TMProtoCryptoPublicKey()},
+	})
+
+	returnedValUpdates := consumerKeeper.ChangeoverToConsumer(ctx)
+
+	// only the tombstoned validator and the validator that is still jailed are recorded
+	records := consumerKeeper.GetAllStandaloneValidatorRecords(ctx)
+	require.Len(t, records, 2)
+	record, found := consumerKeeper.GetStandaloneValidatorRecord(ctx, cIds[0].SDKValConsAddress())
+	require.True(t, found)
+	require.True(t, record.Tombstoned)
+	record, found = consumerKeeper.GetStandaloneValidatorRecord(ctx, cIds[1].SDKValConsAddress())
+	require.True(t, found)
+	require.False(t, record.Tombstoned)
+	require.Equal(t, blockTime.Add(time.Hour), record.JailedUntil)
+
+	// the provider validators using the keys of the recorded validators are skipped
+	require.Equal(t, []abci.ValidatorUpdate{
+		{Power: 30, PubKey: cIds[2].TMProtoCryptoPublicKey()},
+		{Power: 40, PubKey: cIds[4].TMProtoCryptoPublicKey()},
+		cIds[3].SDKStakingValidator().ABCIValidatorUpdateZero(),
+	}, returnedValUpdates)
+	require.Len(t, consumerKeeper.GetAllCCValidator(ctx), 2)
+
+	// once the jail period elapses, the validator using the key of the jailed validator can be added,
+	// while the validator using the key of the tombstoned validator is still skipped
+	changes := []abci.ValidatorUpdate{
+		{Power: 15, PubKey: cIds[0].TMProtoCryptoPublicKey()},
+		{Power: 25, PubKey: cIds[1].TMProtoCryptoPublicKey()},
+	}
+	require.Empty(t, consumerKeeper.ApplyCCValidatorChanges(ctx.WithBlockTime(blockTime.Add(time.Minute)), changes))
+	require.Equal(t, changes[1:], consumerKeeper.ApplyCCValidatorChanges(ctx.WithBlockTime(blockTime.Add(time.Hour)), changes))
+	require.Len(t, consumerKeeper.GetAllCCValidator(ctx), 3)
+}
+
+// TestChangeoverToConsumerAllValidatorsExcluded tests that the changeover panics
+// if all the provider validators use the consensus keys of tombstoned standalone validators
+func TestChangeoverToConsumerAllValidatorsExcluded(t *testing.T) {
+	keeperParams := uthelpers.NewInMemKeeperParams(t)
+	consumerKeeper, ctx, ctrl, mocks := uthelpers.GetConsumerKeeperAndCtx(t, keeperParams)
+	defer ctrl.Finish()
+
+	cId := crypto.NewCryptoIdentityFromIntSeed(7842)
+	sovVal := cId.SDKStakingValidator()
+	sovVal.Jailed = true
+	mocks.MockStakingKeeper.EXPECT().IterateValidators(ctx, gomock.Any()).DoAndReturn(
+		func(_ context.Context, cb func(int64, stakingtypes.ValidatorI) bool) error {
+			cb(0, sovVal)
+			return nil
+		})
+	mocks.MockSlashingKeeper.EXPECT().IsTombstoned(ctx, cId.SDKValConsAddress()).Return(true)
+	mocks.MockSlashingKeeper.EXPECT().GetValidatorSigningInfo(ctx, cId.SDKValConsAddress()).Return(
+		slashingtypes.ValidatorSigningInfo{JailedUntil: evidencetypes.DoubleSignJailEndTime}, nil)
+	consumerKeeper.SetStandaloneStakingKeeper(mocks.MockStakingKeeper)
+
+	consumerKeeper.SetPreCCVTrue(ctx)
+	consumerKeeper.SetInitialValSet(ctx, []abci.ValidatorUpdate{{Power: 10, PubKey: cId.TMProtoCryptoPublicKey()}})
+
+	require.Panics(t, func() { consumerKeeper.ChangeoverToConsumer(ctx) })
+}
+
+// TestReadmitStandaloneValidators tests that the updates for the consensus keys of jailed standalone validators
+// are deferred, and that they are applied once the jail period elapses
+func TestReadmitStandaloneValidators(t *testing.T) {
+	consumerKeeper, ctx, ctrl, _ := uthelpers.GetConsumerKeeperAndCtx(t, uthelpers.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	blockTime := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(blockTime)
+
+	cIds := []crypto.CryptoIdentity{}
+	for i := 0; i < 5; i++ {
+		cIds = append(cIds, *crypto.NewCryptoIdentityFromIntSeed(i + 9731))
+	}
+
+	// the standalone validator 0 is tombstoned, and the validators 1, 2, and 4 are jailed for one more hour
+	consumerKeeper.SetStandaloneValidatorRecord(ctx, types.StandaloneValidatorRecord{
+		ConsensusAddress: cIds[0].SDKValConsAddress().String(),
+		Tombstoned:       true,
+	})
+	for _, i := range []int{1, 2, 4} {
+		consumerKeeper.SetStandaloneValidatorRecord(ctx, types.StandaloneValidatorRecord{
+			ConsensusAddress: cIds[i].SDKValConsAddress().String(),
+			JailedUntil:      blockTime.Add(time.Hour),
+		})
+	}
+
+	// the updates for the keys of the standalone validators are not applied,
+	// and the updates for the keys of the jailed validators are deferred
+	require.Empty(t, consumerKeeper.ApplyCCValidatorChanges(ctx, []abci.ValidatorUpdate{
+		{Power: 10, PubKey: cIds[0].TMProtoCryptoPublicKey()},
+		{Power: 20, PubKey: cIds[1].TMProtoCryptoPublicKey()},
+		{Power: 30, PubKey: cIds[2].TMProtoCryptoPublicKey()},
+		{Power: 40, PubKey: cIds[4].TMProtoCryptoPublicKey()},
+	}))
+	record, found := consumerKeeper.GetStandaloneValidatorRecord(ctx, cIds[0].SDKValConsAddress())
+	require.True(t, found)
+	require.Nil(t, record.DeferredUpdate)
+	record, found = consumerKeeper.GetStandaloneValidatorRecord(ctx, cIds[1].SDKValConsAddress())
+	require.True(t, found)
+	require.Equal(t, &abci.ValidatorUpdate{Power: 20, PubKey: cIds[1].TMProtoCryptoPublicKey()}, record.DeferredUpdate)
+
+	// an update with zero power drops the deferred update
+	require.Empty(t, consumerKeeper.ApplyCCValidatorChanges(ctx, []abci.ValidatorUpdate{
+		{Power: 0, PubKey: cIds[2].TMProtoCryptoPublicKey()},
+	}))
+	record, found = consumerKeeper.GetStandaloneValidatorRecord(ctx, cIds[2].SDKValConsAddress())
+	require.True(t, found)
+	require.Nil(t, record.DeferredUpdate)
+
+	// no validator is readmitted before the end of the jail period
+	require.Empty(t, consumerKeeper.ReadmitStandaloneValidators(ctx.WithBlockTime(blockTime.Add(time.Minute)), nil))
+	require.Len(t, consumerKeeper.GetAllStandaloneValidatorRecords(ctx), 4)
+
+	// once the jail period elapses, the deferred updates are applied, unless the provider sent a new update,
+	// and only the record of the tombstoned validator is kept
+	ctx = ctx.WithBlockTime(blockTime.Add(time.Hour))
+	changes := consumerKeeper.ReadmitStandaloneValidators(ctx, []abci.ValidatorUpdate{
+		{Power: 50, PubKey: cIds[4].TMProtoCryptoPublicKey()},
+		{Power: 5, PubKey: cIds[3].TMProtoCryptoPublicKey()},
+	})
+	expectedChanges := []abci.ValidatorUpdate{
+		{Power: 20, PubKey: cIds[1].TMProtoCryptoPublicKey()},
+		{Power: 50, PubKey: cIds[4].TMProtoCryptoPublicKey()},
+		{Power: 5, PubKey: cIds[3].TMProtoCryptoPublicKey()},
+	}
+	require.Equal(t, expectedChanges, changes)
+	records := consumerKeeper.GetAllStandaloneValidatorRecords(ctx)
+	require.Len(t, records, 1)
+	require.True(t, records[0].Tombstoned)
+
+	require.Equal(t, expectedChanges, consumerKeeper.ApplyCCValidatorChanges(ctx, changes))
+	require.Len(t, consumerKeeper.GetAllCCValidator(ctx), 3)
+}
+
+// of the changeover, without changing the state of the consumer module
+// TestRehearseChangeover tests that the rehearsal of the changeover returns the validator updates and the power changes
+func TestRehearseChangeover(t *testing.T) {
+	keeperParams := uthelpers.NewInMemKeeperParams(t)
+	consumerKeeper, ctx, ctrl, mocks := uthelpers.GetConsumerKeeperAndCtx(t, keeperParams)
+	defer ctrl.Finish()
+
+	cIds := []crypto.CryptoIdentity{}
+	for i := 0; i < 4; i++ {
+		cIds = append(cIds, *crypto.NewCryptoIdentityFromIntSeed(i + 9321))
+	}
+	initialValSet := []abci.ValidatorUpdate{
+		{Power: 10, PubKey: cIds[0].TMProtoCryptoPublicKey()},
+		{Power: 20, PubKey: cIds[1].TMProtoCryptoPublicKey()},
+		{Power: 30, PubKey: cIds[3].TMProtoCryptoPublicKey()},
+	}
+
+	// a chain without a standalone staking module cannot rehearse the changeover
+	_, err := consumerKeeper.RehearseChangeover(ctx, initialValSet)
+	require.ErrorIs(t, err, types.ErrInvalidChangeoverRehearsal)
+
+	// the standalone validator 0 is tombstoned, and the validators 1 and 2 are bonded
+	sovVals := []stakingtypes.Validator{}
+	for i := 0; i < 3; i++ {
+		val := cIds[i].SDKStakingValidator()
+		val.Status = stakingtypes.Bonded
+		val.Tokens = sdk.TokensFromConsensusPower(int64(5*(i+1)), sdk.DefaultPowerReduction)
+		sovVals = append(sovVals, val)
+	}
+	sovVals[0].Jailed = true
+	mocks.MockStakingKeeper.EXPECT().IterateValidators(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, cb func(int64, stakingtypes.ValidatorI) bool) error {
+			for i, val := range sovVals {
+				if cb(int64(i), val) {
+					break
+				}
+			}
+			return nil
+		}).AnyTimes()
+	for i := 0; i < 3; i++ {
+		mocks.MockSlashingKeeper.EXPECT().IsTombstoned(gomock.Any(), cIds[i].SDKValConsAddress()).Return(i == 0).AnyTimes()
+	}
+	mocks.MockSlashingKeeper.EXPECT().GetValidatorSigningInfo(gomock.Any(), cIds[0].SDKValConsAddress()).Return(
+		slashingtypes.ValidatorSigningInfo{JailedUntil: evidencetypes.DoubleSignJailEndTime}, nil).AnyTimes()
+	mocks.MockStakingKeeper.EXPECT().PowerReduction(gomock.Any()).Return(sdk.DefaultPowerReduction).AnyTimes()
+	uthelpers.SetupMocksForLastBondedValidatorsExpectation(mocks.MockStakingKeeper, 180, sovVals[1:], -1)
+	consumerKeeper.SetStandaloneStakingKeeper(mocks.MockStakingKeeper)
+
+	// the initial valset must be non-empty and valid
+	_, err = consumerKeeper.RehearseChangeover(ctx, nil)
+	require.ErrorIs(t, err, types.ErrInvalidChangeoverRehearsal)
+	_, err = consumerKeeper.RehearseChangeover(ctx, []abci.ValidatorUpdate{{Power: 0, PubKey: cIds[3].TMProtoCryptoPublicKey()}})
+	require.ErrorIs(t, err, types.ErrInvalidChangeoverRehearsal)
+
+	rehearsal, err := consumerKeeper.RehearseChangeover(ctx, initialValSet)
+	require.NoError(t, err)
+
+	// the provider validator using the key of the tombstoned validator is skipped,
+	// and the standalone validator 2 that is not in the initial valset is removed
+	require.Equal(t, []abci.ValidatorUpdate{
+		{Power: 20, PubKey: cIds[1].TMProtoCryptoPublicKey()},
+		{Power: 30, PubKey: cIds[3].TMProtoCryptoPublicKey()},
+		sovVals[2].ABCIValidatorUpdateZero(),
+	}, rehearsal.ValidatorUpdates)
+	require.Equal(t, []string{cIds[0].SDKValConsAddress().String()}, rehearsal.ExcludedValidators)
+
+	expectedPowerChanges := []types.ChangeoverPowerChange{
+		{ConsensusAddress: cIds[1].SDKValConsAddress().String(), StandalonePower: 10, ConsumerPower: 20},
+		{ConsensusAddress: cIds[2].SDKValConsAddress().String(), StandalonePower: 15, ConsumerPower: 0},
+		{ConsensusAddress: cIds[3].SDKValConsAddress().String(), StandalonePower: 0, ConsumerPower: 30},
+	}
+	sort.Slice(expectedPowerChanges, func(i, j int) bool {
+		return expectedPowerChanges[i].ConsensusAddress < expectedPowerChanges[j].ConsensusAddress
+	})
+	require.Equal(t, expectedPowerChanges, rehearsal.PowerChanges)
+
+	// the state of the consumer module is not changed
+	require.False(t, consumerKeeper.IsPreCCV(ctx))
+	require.Empty(t, consumerKeeper.GetInitialValSet(ctx))
+	require.Empty(t, consumerKeeper.GetAllCCValidator(ctx))
+	require.Empty(t, consumerKeeper.GetAllStandaloneValidatorRecords(ctx))
+
+	// the changeover cannot be rehearsed once the chain is a consumer chain
+	consumerKeeper.SetPreCCVTrue(ctx)
+	consumerKeeper.SetInitialValSet(ctx, initialValSet)
+	consumerKeeper.ChangeoverToConsumer(ctx)
+	_, err = consumerKeeper.RehearseChangeover(ctx, initialValSet)
+	require.ErrorIs(t, err, types.ErrInvalidChangeoverRehearsal)
+}
@@ -0,0 +1,172 @@
+package keeper
+
+import (
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/consumer/types"
+	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// A migration channel is a second CCV channel opened on a different connection built on top of the provider client.
+// Once the migration channel receives the first VSC packet, it replaces the established CCV channel.
+// Note that the provider sends VSC packets on the migration channel only after all the packets
+// it sent on the previous CCV channel were acknowledged, so VSC packets are received in order.
+
+// SetMigrationProviderChannel sets the channelID of the migration channel to the provider.
+func (k Keeper) SetMigrationProviderChannel(ctx sdk.Context, channelID string) {
+	store := k.providerStore(ctx)
+	store.Set(types.MigrationProviderChannelIDKey(), []byte(channelID))
+}
+
+// GetMigrationProviderChannel gets the channelID of the migration channel to the provider.
+func (k Keeper) GetMigrationProviderChannel(ctx sdk.Context) (string, bool) {
+	store := k.providerStore(ctx)
+	channelIdBytes := store.Get(types.MigrationProviderChannelIDKey())
+	if len(channelIdBytes) == 0 {
+		return "", false
+	}
+	return string(channelIdBytes), true
+}
+
+// DeleteMigrationProviderChannel deletes the channelID of the migration channel to the provider.
+func (k Keeper) DeleteMigrationProviderChannel(ctx sdk.Context) {
+	store := k.providerStore(ctx)
+	store.Delete(types.MigrationProviderChannelIDKey())
+}
+
+// SetPreviousProviderChannel sets the channelID of the CCV channel that was replaced by a migration channel.
+func (k Keeper) SetPreviousProviderChannel(ctx sdk.Context, channelID string) {
+	store := k.providerStore(ctx)
+	store.Set(types.PreviousProviderChannelIDKey(), []byte(channelID))
+}
+
+// GetPreviousProviderChannel gets the channelID of the CCV channel that was replaced by a migration channel.
+func (k Keeper) GetPreviousProviderChannel(ctx sdk.Context) (string, bool) {
+	store := k.providerStore(ctx)
+	channelIdBytes := store.Get(types.PreviousProviderChannelIDKey())
+	if len(channelIdBytes) == 0 {
+		return "", false
+	}
+	return string(channelIdBytes), true
+}
+
+// DeletePreviousProviderChannel deletes the channelID of the CCV channel that was replaced by a migration channel.
+func (k Keeper) DeletePreviousProviderChannel(ctx sdk.Context) {
+	store := k.providerStore(ctx)
+	store.Delete(types.PreviousProviderChannelIDKey())
+}
+
+// ValidateChannelMigration validates that a channel opened on the given connection hops
+// can replace the established CCV channel
+func (k Keeper) ValidateChannelMigration(ctx sdk.Context, providerChannelID string, connectionHops []string) error {
+	if migrationChannelID, found := k.GetMigrationProviderChannel(ctx); found {
+		return errorsmod.Wrapf(ccv.ErrDuplicateChannel,
+			"the migration to channel %s is still in progress", migrationChannelID)
+	}
+	if previousChannelID, found := k.GetPreviousProviderChannel(ctx); found {
+		return errorsmod.Wrapf(ccv.ErrDuplicateChannel,
+			"the previous provider channel %s is not yet closed", previousChannelID)
+	}
+
+	channel, found := k.channelKeeper.GetChannel(ctx, ccv.ConsumerPortID, providerChannelID)
+	if found && channel.State == channeltypes.CLOSED {
+		// the provider channel was closed, e.g., by a timeout,
+		// so it can be replaced by a channel on the same connection
+		return nil
+	}
+	if !found || channel.State != channeltypes.OPEN {
+		return errorsmod.Wrapf(channeltypes.ErrInvalidChannelState,
+			"provider channel %s is not open", providerChannelID)
+	}
+	if len(connectionHops) == 1 && len(channel.ConnectionHops) == 1 && channel.ConnectionHops[0] == connectionHops[0] {
+		return errorsmod.Wrapf(ccv.ErrDuplicateChannel,
+			"migration channel must be opened on a different connection than %s", connectionHops[0])
+	}
+
+	return nil
+}
+
+// VerifyPreviousChannel verifies that the provider considers the channel being opened as
+// a migration channel replacing the established CCV channel, i.e., that the previous channel ID
+// in the handshake metadata is the counterparty of the established CCV channel
+func (k Keeper) VerifyPreviousChannel(ctx sdk.Context, providerChannelID, previousChannelID string) error {
+	channel, found := k.channelKeeper.GetChannel(ctx, ccv.ConsumerPortID, providerChannelID)
+	if !found {
+		return errorsmod.Wrapf(channeltypes.ErrChannelNotFound, "channel not found for channel ID: %s", providerChannelID)
+	}
+	if channel.Counterparty.ChannelId != previousChannelID {
+		return errorsmod.Wrapf(ccv.ErrInvalidHandshakeMetadata,
+			"invalid previous channel: %s, expected %s", previousChannelID, channel.Counterparty.ChannelId)
+	}
+	return nil
+}
+
+// completeChannelMigration replaces the established CCV channel by the migration channel
+func (k Keeper) completeChannelMigration(ctx sdk.Context, providerChannelID, migrationChannelID string) {
+	// a closed channel is not waiting for the provider to close it
+	if !k.IsChannelClosed(ctx, providerChannelID) {
+		k.SetPreviousProviderChannel(ctx, providerChannelID)
+	}
+	k.SetProviderChannel(ctx, migrationChannelID)
+	k.DeleteMigrationProviderChannel(ctx)
+
+	k.Logger(ctx).Info("CCV channel migrated", "previous channel", providerChannelID, "channel", migrationChannelID)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			ccv.EventTypeChannelMigrated,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(ccv.AttributePreviousChannelID, providerChannelID),
+			sdk.NewAttribute(channeltypes.AttributeKeyChannelID, migrationChannelID),
+		),
+	)
+}
+
+// OnChanCloseConfirm updates the channel migration state when a CCV channel is closed
+func (k Keeper) OnChanCloseConfirm(ctx sdk.Context, channelID string) {
+	// the packets sent on a closed channel are never acknowledged
+	k.DeleteAllPacketTimeouts(ctx, channelID)
+
+	if previousChannelID, found := k.GetPreviousProviderChannel(ctx); found && previousChannelID == channelID {
+		// the provider closed the previous channel once the migration was completed
+		k.DeletePreviousProviderChannel(ctx)
+		return
+	}
+
+	migrationChannelID, found := k.GetMigrationProviderChannel(ctx)
+	if !found {
+		return
+	}
+	if migrationChannelID == channelID {
+		// the migration channel was closed before replacing the established CCV channel
+		k.DeleteMigrationProviderChannel(ctx)
+		return
+	}
+	if providerChannelID, found := k.GetProviderChannel(ctx); found && providerChannelID == channelID {
+		// the provider closed the established CCV channel before the first VSC packet
+		// on the migration channel was received
+		k.completeChannelMigration(ctx, providerChannelID, migrationChannelID)
+		k.DeletePreviousProviderChannel(ctx)
+	}
+}
+
+// OnTimeoutPacket handles the timeout of a packet sent to the provider. As the timed out slash packet
+// is still at the head of the pending packets queue, the slash record is cleared so that the packet
+// is resent on the current provider channel, i.e., either the migration channel that replaced
+// the previous provider channel, or the channel that replaces the channel closed by the timeout.
+func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet) {
+	k.DeletePacketTimeout(ctx, packet.SourceChannel, packet.Sequence)
+
+	consumerPacket, err := ccv.UnmarshalConsumerPacketData(packet.GetData())
+	if err != nil {
+		return
+	}
+	if consumerPacket.Type == ccv.SlashPacket {
+		// unblock the sending of the slash packet at the head of the pending packets queue
+		k.ClearSlashRecord(ctx)
+	}
+}
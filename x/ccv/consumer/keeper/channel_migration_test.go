@@ -0,0 +1,155 @@
+package keeper_test
+
+import (
+	"testing"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
+	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// TestOnRecvVSCPacketOnMigrationChannel tests that the first VSC packet received on the migration channel
+// replaces the established CCV channel
+func TestOnRecvVSCPacketOnMigrationChannel(t *testing.T) {
+	consumerKeeper, ctx, ctrl, mocks := testkeeper.GetConsumerKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	consumerKeeper.SetParams(ctx, ccv.DefaultParams())
+
+	mocks.MockChannelKeeper.EXPECT().GetChannel(ctx, ccv.ConsumerPortID, "channel-0").Return(
+		channeltypes.Channel{State: channeltypes.OPEN}, true).Times(1)
+
+	consumerKeeper.SetProviderChannel(ctx, "channel-0")
+	consumerKeeper.SetMigrationProviderChannel(ctx, "channel-1")
+
+	pd := ccv.NewValidatorSetChangePacketData(ccv.NewValsetDiff(), 1, nil)
+	packet := channeltypes.NewPacket(pd.GetBytes(), 1, ccv.ProviderPortID, "providerChannel-1",
+		ccv.ConsumerPortID, "channel-1", clienttypes.NewHeight(1, 0), 0)
+
+	require.NoError(t, consumerKeeper.OnRecvVSCPacket(ctx, packet, pd))
+
+	providerChannel, found := consumerKeeper.GetProviderChannel(ctx)
+	require.True(t, found)
+	require.Equal(t, "channel-1", providerChannel)
+	previousChannel, found := consumerKeeper.GetPreviousProviderChannel(ctx)
+	require.True(t, found)
+	require.Equal(t, "channel-0", previousChannel)
+	_, found = consumerKeeper.GetMigrationProviderChannel(ctx)
+	require.False(t, found)
+
+	// VSC packets on any other channel are rejected
+	packet.DestinationChannel = "channel-0"
+	require.ErrorIs(t, consumerKeeper.OnRecvVSCPacket(ctx, packet, pd), ccv.ErrUnknownChannel)
+
+	// the previous channel is forgotten once closed
+	consumerKeeper.OnChanCloseConfirm(ctx, "channel-0")
+	_, found = consumerKeeper.GetPreviousProviderChannel(ctx)
+	require.False(t, found)
+}
+
+// TestOnChanCloseConfirmDuringChannelMigration tests that closing the established CCV channel
+// during a migration switches to the migration channel, and that closing the migration channel aborts it
+func TestOnChanCloseConfirmDuringChannelMigration(t *testing.T) {
+	consumerKeeper, ctx, ctrl, mocks := testkeeper.GetConsumerKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	mocks.MockChannelKeeper.EXPECT().GetChannel(ctx, ccv.ConsumerPortID, "channel-0").Return(
+		channeltypes.Channel{State: channeltypes.CLOSED}, true).Times(1)
+
+	consumerKeeper.SetProviderChannel(ctx, "channel-0")
+	consumerKeeper.SetMigrationProviderChannel(ctx, "channel-1")
+
+	// the migration channel is closed
+	consumerKeeper.OnChanCloseConfirm(ctx, "channel-1")
+	_, found := consumerKeeper.GetMigrationProviderChannel(ctx)
+	require.False(t, found)
+	providerChannel, _ := consumerKeeper.GetProviderChannel(ctx)
+	require.Equal(t, "channel-0", providerChannel)
+
+	// the established channel is closed
+	consumerKeeper.SetMigrationProviderChannel(ctx, "channel-2")
+	consumerKeeper.OnChanCloseConfirm(ctx, "channel-0")
+	providerChannel, _ = consumerKeeper.GetProviderChannel(ctx)
+	require.Equal(t, "channel-2", providerChannel)
+	_, found = consumerKeeper.GetMigrationProviderChannel(ctx)
+	require.False(t, found)
+	_, found = consumerKeeper.GetPreviousProviderChannel(ctx)
+	require.False(t, found)
+}
+
+// TestOnRecvVSCPacketOnRecoveryChannel tests that the first VSC packet received on a channel
+// that replaces a closed CCV channel does not wait for the provider to close the previous channel
+func TestOnRecvVSCPacketOnRecoveryChannel(t *testing.T) {
+	consumerKeeper, ctx, ctrl, mocks := testkeeper.GetConsumerKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	consumerKeeper.SetParams(ctx, ccv.DefaultParams())
+
+	mocks.MockChannelKeeper.EXPECT().GetChannel(ctx, ccv.ConsumerPortID, "channel-0").Return(
+		channeltypes.Channel{State: channeltypes.CLOSED}, true).AnyTimes()
+
+	consumerKeeper.SetProviderChannel(ctx, "channel-0")
+	// a channel on the same connection can replace the closed channel
+	require.NoError(t, consumerKeeper.ValidateChannelMigration(ctx, "channel-0", []string{"connection-0"}))
+	consumerKeeper.SetMigrationProviderChannel(ctx, "channel-1")
+
+	pd := ccv.NewValidatorSetChangePacketData(ccv.NewValsetDiff(), 1, nil)
+	packet := channeltypes.NewPacket(pd.GetBytes(), 1, ccv.ProviderPortID, "providerChannel-1",
+		ccv.ConsumerPortID, "channel-1", clienttypes.NewHeight(1, 0), 0)
+	require.NoError(t, consumerKeeper.OnRecvVSCPacket(ctx, packet, pd))
+
+	providerChannel, found := consumerKeeper.GetProviderChannel(ctx)
+	require.True(t, found)
+	require.Equal(t, "channel-1", providerChannel)
+	_, found = consumerKeeper.GetPreviousProviderChannel(ctx)
+	require.False(t, found)
+}
+
+// TestOnTimeoutPacketClearsSlashRecord tests that a timed out slash packet is resent
+// on the current provider channel
+func TestOnTimeoutPacketClearsSlashRecord(t *testing.T) {
+	consumerKeeper, ctx, ctrl, _ := testkeeper.GetConsumerKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	consumerKeeper.SetParams(ctx, ccv.DefaultParams())
+
+	slashPacket := ccv.ConsumerPacketData{
+		Type: ccv.SlashPacket,
+		Data: &ccv.ConsumerPacketData_SlashPacketData{
+			SlashPacketData: ccv.NewSlashPacketData(abci.Validator{}, 1, stakingtypes.Infraction_INFRACTION_DOWNTIME),
+		},
+	}
+	vscMaturedPacket := ccv.ConsumerPacketData{
+		Type: ccv.VscMaturedPacket,
+		Data: &ccv.ConsumerPacketData_VscMaturedPacketData{
+			VscMaturedPacketData: &ccv.VSCMaturedPacketData{ValsetUpdateId: 1},
+		},
+	}
+
+	consumerKeeper.SetProviderChannel(ctx, "channel-1")
+	consumerKeeper.UpdateSlashRecordOnSend(ctx)
+	require.False(t, consumerKeeper.PacketSendingPermitted(ctx))
+
+	// the timeout of a packet that is not a slash packet does not unblock the slash packet
+	packet := channeltypes.Packet{SourceChannel: "channel-1", Sequence: 1, Data: vscMaturedPacket.GetBytes()}
+	consumerKeeper.SetPacketTimeout(ctx, "channel-1", 1, 100)
+	consumerKeeper.OnTimeoutPacket(ctx, packet)
+	require.False(t, consumerKeeper.PacketSendingPermitted(ctx))
+	require.Empty(t, consumerKeeper.GetAllPacketTimeouts(ctx, "channel-1"))
+
+	// the timeout of a slash packet on the current provider channel closes the channel,
+	// so the slash packet is resent on the channel that replaces it
+	packet = channeltypes.Packet{SourceChannel: "channel-1", Sequence: 2, Data: slashPacket.GetBytes()}
+	consumerKeeper.OnTimeoutPacket(ctx, packet)
+	require.True(t, consumerKeeper.PacketSendingPermitted(ctx))
+
+	// the timeout of a slash packet on a previous provider channel
+	consumerKeeper.UpdateSlashRecordOnSend(ctx)
+	packet.SourceChannel = "channel-0"
+	consumerKeeper.OnTimeoutPacket(ctx, packet)
+	require.True(t, consumerKeeper.PacketSendingPermitted(ctx))
+}
@@ -0,0 +1,194 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	ibctmtypes "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/consumer/types"
+	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// GetProviderClientExpiry returns the time at which the client to the provider expires
+func (k Keeper) GetProviderClientExpiry(ctx sdk.Context) (time.Time, bool) {
+	store := k.providerStore(ctx)
+	buf := store.Get(types.ProviderClientExpiryKey())
+	if buf == nil {
+		return time.Time{}, false
+	}
+	var expiry time.Time
+	if err := expiry.UnmarshalBinary(buf); err != nil {
+		panic(fmt.Errorf("failed to unmarshal provider client expiry: %w", err))
+	}
+	return expiry, true
+}
+
+// SetProviderClientExpiry sets the time at which the client to the provider expires
+func (k Keeper) SetProviderClientExpiry(ctx sdk.Context, expiry time.Time) {
+	store := k.providerStore(ctx)
+	buf, err := expiry.MarshalBinary()
+	if err != nil {
+		panic(fmt.Errorf("failed to marshal provider client expiry (%+v): %w", expiry, err))
+	}
+	store.Set(types.ProviderClientExpiryKey(), buf)
+}
+
+// IsProviderClientExpiryWarned returns whether the consumer warned that the client to the provider is about
+// to expire, i.e., since the client expiry last crossed the ClientExpiryWarningThreshold param
+func (k Keeper) IsProviderClientExpiryWarned(ctx sdk.Context) bool {
+	store := k.providerStore(ctx)
+	return store.Has(types.ProviderClientExpiryWarnedKey())
+}
+
+// SetProviderClientExpiryWarned records that the consumer warned that the client to the provider is about to expire
+func (k Keeper) SetProviderClientExpiryWarned(ctx sdk.Context) {
+	store := k.providerStore(ctx)
+	store.Set(types.ProviderClientExpiryWarnedKey(), []byte{})
+}
+
+// DeleteProviderClientExpiryWarned deletes the record that the consumer warned that the client
+// to the provider is about to expire
+func (k Keeper) DeleteProviderClientExpiryWarned(ctx sdk.Context) {
+	store := k.providerStore(ctx)
+	store.Delete(types.ProviderClientExpiryWarnedKey())
+}
+
+// UpdateProviderClientExpiry records the expiry time of the client to the provider, which changes
+// whenever the client is updated, and warns if the client expires in less than the
+// ClientExpiryWarningThreshold param. The consumer warns about once every time the client expiry
+// crosses the threshold, i.e., until the client is updated and no longer about to expire.
+func (k Keeper) UpdateProviderClientExpiry(ctx sdk.Context) {
+	clientID, found := k.GetProviderClientID(ctx)
+	if !found {
+		// the client to the provider is created in InitGenesis, unless the chain was previously standalone
+		return
+	}
+	expiry, err := ccv.GetClientExpiry(ctx, k.clientKeeper, clientID)
+	if err != nil {
+		k.Logger(ctx).Error("cannot get provider client expiry", "clientID", clientID, "error", err.Error())
+		return
+	}
+	if previousExpiry, found := k.GetProviderClientExpiry(ctx); !found || !previousExpiry.Equal(expiry) {
+		k.SetProviderClientExpiry(ctx, expiry)
+	}
+
+	timeUntilExpiry := expiry.Sub(ctx.BlockTime())
+	telemetry.SetGauge(float32(timeUntilExpiry.Seconds()), types.ModuleName, "provider_client_time_until_expiry_seconds")
+
+	warned := k.IsProviderClientExpiryWarned(ctx)
+	if !ccv.IsNearClientExpiry(ctx.BlockTime(), expiry, k.GetClientExpiryWarningThreshold(ctx)) {
+		if warned {
+			k.DeleteProviderClientExpiryWarned(ctx)
+		}
+	} else if !warned {
+		k.SetProviderClientExpiryWarned(ctx)
+		k.Logger(ctx).Error("provider client is about to expire",
+			"clientID", clientID,
+			"expiry", expiry,
+			"timeUntilExpiry", timeUntilExpiry,
+		)
+		ctx.EventManager().EmitEvent(ccv.NewClientExpiryWarningEvent(types.ModuleName, clientID, ctx.BlockTime(), expiry))
+	}
+}
+
+// IsProviderClientUpdateNeeded returns true if the FeatureProviderClientUpdateFallback feature is enabled
+// and the client to the provider expires in less than the ClientExpiryWarningThreshold param,
+// i.e., if the validators may update the client with a header of the provider chain
+func (k Keeper) IsProviderClientUpdateNeeded(ctx sdk.Context) bool {
+	if !k.IsFeatureEnabled(FeatureProviderClientUpdateFallback) {
+		return false
+	}
+	clientID, found := k.GetProviderClientID(ctx)
+	if !found {
+		return false
+	}
+	expiry, err := ccv.GetClientExpiry(ctx, k.clientKeeper, clientID)
+	if err != nil {
+		return false
+	}
+	return ccv.IsNearClientExpiry(ctx.BlockTime(), expiry, k.GetClientExpiryWarningThreshold(ctx))
+}
+
+// GetProviderClientLatestHeight returns the latest height of the client to the provider
+func (k Keeper) GetProviderClientLatestHeight(ctx sdk.Context) (clienttypes.Height, error) {
+	clientID, found := k.GetProviderClientID(ctx)
+	if !found {
+		return clienttypes.Height{}, errorsmod.Wrap(ccv.ErrClientNotFound, "client to the provider not found")
+	}
+	clientState, found := k.clientKeeper.GetClientState(ctx, clientID)
+	if !found {
+		return clienttypes.Height{}, errorsmod.Wrapf(ccv.ErrClientNotFound, "cannot find client state for client %s", clientID)
+	}
+	tmClientState, ok := clientState.(*ibctmtypes.ClientState)
+	if !ok {
+		return clienttypes.Height{}, fmt.Errorf("invalid client type for client %s: %s", clientID, clientState.ClientType())
+	}
+	return tmClientState.LatestHeight, nil
+}
+
+// UpdateProviderClientWithHeader updates the client to the provider with a header of the provider chain
+// injected in a block by the proposer (see ProviderClientProposalHandler). It is a fallback for when no relayer
+// updates the client, so it is only permitted if IsProviderClientUpdateNeeded is true. The header is verified
+// by the IBC client keeper against the trusted consensus state of the client and must extend the expiry
+// of the client; otherwise, e.g., if the header proves misbehaviour, the state is not changed.
+func (k Keeper) UpdateProviderClientWithHeader(ctx sdk.Context, header *ibctmtypes.Header) error {
+	if !k.IsFeatureEnabled(FeatureProviderClientUpdateFallback) {
+		return errorsmod.Wrap(types.ErrProviderClientUpdateNotPermitted, "provider client update fallback is not enabled")
+	}
+	if header == nil {
+		return errorsmod.Wrap(types.ErrProviderClientUpdateNotPermitted, "header cannot be nil")
+	}
+	clientID, found := k.GetProviderClientID(ctx)
+	if !found {
+		return errorsmod.Wrap(types.ErrProviderClientUpdateNotPermitted, "client to the provider not found")
+	}
+	expiry, err := ccv.GetClientExpiry(ctx, k.clientKeeper, clientID)
+	if err != nil {
+		return err
+	}
+	if !ccv.IsNearClientExpiry(ctx.BlockTime(), expiry, k.GetClientExpiryWarningThreshold(ctx)) {
+		return errorsmod.Wrapf(types.ErrProviderClientUpdateNotPermitted,
+			"provider client is not about to expire; time until expiry: %s", expiry.Sub(ctx.BlockTime()))
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	if err := k.clientKeeper.UpdateClient(cacheCtx, clientID, header); err != nil {
+		return errorsmod.Wrapf(err, "cannot update provider client %s", clientID)
+	}
+	newExpiry, err := ccv.GetClientExpiry(cacheCtx, k.clientKeeper, clientID)
+	if err != nil {
+		return err
+	}
+	if !newExpiry.After(expiry) {
+		return errorsmod.Wrapf(types.ErrProviderClientUpdateNotPermitted,
+			"header at height %s does not extend the provider client expiry %s", header.GetHeight(), expiry)
+	}
+	writeCache()
+
+	k.UpdateProviderClientExpiry(ctx)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeProviderClientUpdated,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(clienttypes.AttributeKeyClientID, clientID),
+			sdk.NewAttribute(clienttypes.AttributeKeyConsensusHeight, header.GetHeight().String()),
+		),
+	)
+
+	return nil
+}
+
+// VerifyProviderClientHeader returns an error if the header of the provider chain cannot update
+// the client to the provider through UpdateProviderClientWithHeader, without changing the state
+func (k Keeper) VerifyProviderClientHeader(ctx sdk.Context, header *ibctmtypes.Header) error {
+	cacheCtx, _ := ctx.CacheContext()
+	return k.UpdateProviderClientWithHeader(cacheCtx, header)
+}
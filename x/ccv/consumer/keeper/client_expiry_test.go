@@ -0,0 +1,197 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	ibctmtypes "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
+	consumerkeeper "github.com/cosmos/interchain-security/v7/x/ccv/consumer/keeper"
+	"github.com/cosmos/interchain-security/v7/x/ccv/consumer/types"
+	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// TestProviderClientExpiry tests that the expiry time of the client to the provider is recorded
+// and that a warning event is emitted when the client is about to expire
+func TestProviderClientExpiry(t *testing.T) {
+	consumerKeeper, ctx, ctrl, mocks := testkeeper.GetConsumerKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	params := ccv.DefaultParams()
+	params.ClientExpiryWarningThreshold = 24 * time.Hour
+	consumerKeeper.SetParams(ctx, params)
+
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(now).WithEventManager(sdk.NewEventManager())
+
+	// nothing is recorded before the client to the provider is created
+	consumerKeeper.UpdateProviderClientExpiry(ctx)
+	_, found := consumerKeeper.GetProviderClientExpiry(ctx)
+	require.False(t, found)
+	_, err := consumerKeeper.QueryProviderClientExpiry(ctx, &types.QueryProviderClientExpiryRequest{})
+	require.Error(t, err)
+
+	consumerKeeper.SetProviderClientID(ctx, "clientId")
+	clientState := &ibctmtypes.ClientState{
+		ChainId:        "provider",
+		LatestHeight:   clienttypes.NewHeight(0, 100),
+		TrustingPeriod: 10 * 24 * time.Hour,
+	}
+	consensusState := &ibctmtypes.ConsensusState{Timestamp: now}
+	mocks.MockClientKeeper.EXPECT().GetClientState(gomock.Any(), "clientId").Return(clientState, true).AnyTimes()
+	mocks.MockClientKeeper.EXPECT().GetClientConsensusState(gomock.Any(), "clientId", clientState.LatestHeight).
+		Return(consensusState, true).AnyTimes()
+
+	consumerKeeper.UpdateProviderClientExpiry(ctx)
+	expiry, found := consumerKeeper.GetProviderClientExpiry(ctx)
+	require.True(t, found)
+	require.Equal(t, now.Add(10*24*time.Hour), expiry)
+	require.Empty(t, ctx.EventManager().Events())
+
+	resp, err := consumerKeeper.QueryProviderClientExpiry(ctx, &types.QueryProviderClientExpiryRequest{})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryProviderClientExpiryResponse{
+		ClientId:        "clientId",
+		ExpiryTime:      expiry,
+		TimeUntilExpiry: 10 * 24 * time.Hour,
+	}, resp)
+
+	// a warning is emitted once the client expires in less than the threshold
+	ctx = ctx.WithBlockTime(now.Add(9*24*time.Hour + time.Second))
+	consumerKeeper.UpdateProviderClientExpiry(ctx)
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, ccv.EventTypeClientExpiryWarning, events[0].Type)
+	clientIdAttr, found := events[0].GetAttribute(clienttypes.AttributeKeyClientID)
+	require.True(t, found)
+	require.Equal(t, "clientId", clientIdAttr.Value)
+
+	// the warning is not emitted again while the client is about to expire
+	ctx = ctx.WithBlockTime(now.Add(9*24*time.Hour + time.Hour)).WithEventManager(sdk.NewEventManager())
+	consumerKeeper.UpdateProviderClientExpiry(ctx)
+	require.Empty(t, ctx.EventManager().Events())
+	require.True(t, consumerKeeper.IsProviderClientExpiryWarned(ctx))
+
+	// the warning is reset when the client is updated
+	consensusState.Timestamp = now.Add(9 * 24 * time.Hour)
+	consumerKeeper.UpdateProviderClientExpiry(ctx)
+	require.Empty(t, ctx.EventManager().Events())
+	require.False(t, consumerKeeper.IsProviderClientExpiryWarned(ctx))
+
+	// a warning is emitted again once the updated client expires in less than the threshold
+	ctx = ctx.WithBlockTime(now.Add(18*24*time.Hour + time.Hour))
+	consumerKeeper.UpdateProviderClientExpiry(ctx)
+	require.Len(t, ctx.EventManager().Events(), 1)
+}
+
+// TestUpdateProviderClientWithHeader tests that the client to the provider can be updated with a header
+// only if the fallback is enabled, the client is about to expire, and the header extends its expiry
+func TestUpdateProviderClientWithHeader(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	nearExpiry := now.Add(9*24*time.Hour + time.Second)
+
+	testCases := []struct {
+		name          string
+		enabled       bool
+		blockTime     time.Time
+		header        *ibctmtypes.Header
+		expectedError error
+	}{
+		{
+			name:          "fallback not enabled",
+			enabled:       false,
+			blockTime:     nearExpiry,
+			header:        newProviderHeader(101, nearExpiry),
+			expectedError: types.ErrProviderClientUpdateNotPermitted,
+		},
+		{
+			name:          "client not about to expire",
+			enabled:       true,
+			blockTime:     now,
+			header:        newProviderHeader(101, now),
+			expectedError: types.ErrProviderClientUpdateNotPermitted,
+		},
+		{
+			name:          "nil header",
+			enabled:       true,
+			blockTime:     nearExpiry,
+			expectedError: types.ErrProviderClientUpdateNotPermitted,
+		},
+		{
+			name:          "header rejected by the client",
+			enabled:       true,
+			blockTime:     nearExpiry,
+			header:        newProviderHeader(102, nearExpiry),
+			expectedError: clienttypes.ErrInvalidHeader,
+		},
+		{
+			name:          "header does not extend the expiry",
+			enabled:       true,
+			blockTime:     nearExpiry,
+			header:        newProviderHeader(101, now.Add(-time.Hour)),
+			expectedError: types.ErrProviderClientUpdateNotPermitted,
+		},
+		{
+			name:      "client updated",
+			enabled:   true,
+			blockTime: nearExpiry,
+			header:    newProviderHeader(101, nearExpiry),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			keeperParams := testkeeper.NewInMemKeeperParams(t)
+			if tc.enabled {
+				keeperParams.ConsumerOptions = []consumerkeeper.Option{
+					consumerkeeper.WithFeatureFlags(consumerkeeper.FeatureProviderClientUpdateFallback),
+				}
+			}
+			consumerKeeper, ctx, ctrl, mocks := testkeeper.GetConsumerKeeperAndCtx(t, keeperParams)
+			defer ctrl.Finish()
+			params := ccv.DefaultParams()
+			params.ClientExpiryWarningThreshold = 24 * time.Hour
+			consumerKeeper.SetParams(ctx, params)
+			ctx = ctx.WithBlockTime(tc.blockTime).WithEventManager(sdk.NewEventManager())
+			consumerKeeper.SetProviderClientID(ctx, "clientId")
+			// the client can be updated to height 101
+			clientHeader := newProviderHeader(101, nearExpiry)
+			if tc.header != nil && tc.header.GetHeight().GetRevisionHeight() == 101 {
+				clientHeader = tc.header
+			}
+			mockProviderClient(keeperParams, mocks, now, clientHeader)
+
+			// the verification does not change the state
+			err := consumerKeeper.VerifyProviderClientHeader(ctx, tc.header)
+			if tc.expectedError != nil {
+				require.ErrorIs(t, err, tc.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+			height, heightErr := consumerKeeper.GetProviderClientLatestHeight(ctx)
+			require.NoError(t, heightErr)
+			require.Equal(t, clienttypes.NewHeight(0, 100), height)
+
+			err = consumerKeeper.UpdateProviderClientWithHeader(ctx, tc.header)
+			height, heightErr = consumerKeeper.GetProviderClientLatestHeight(ctx)
+			require.NoError(t, heightErr)
+			if tc.expectedError != nil {
+				require.ErrorIs(t, err, tc.expectedError)
+				require.Equal(t, clienttypes.NewHeight(0, 100), height)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, clienttypes.NewHeight(0, 101), height)
+			expiry, found := consumerKeeper.GetProviderClientExpiry(ctx)
+			require.True(t, found)
+			require.Equal(t, nearExpiry.Add(10*24*time.Hour), expiry)
+			events := ctx.EventManager().Events()
+			require.Equal(t, types.EventTypeProviderClientUpdated, events[len(events)-1].Type)
+		})
+	}
+}
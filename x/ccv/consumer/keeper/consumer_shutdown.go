@@ -0,0 +1,100 @@
+package keeper
+
+import (
+	"strconv"
+
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/consumer/types"
+	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// IsConsumerShutdownInitiated returns whether the consumer chain initiated its shutdown
+func (k Keeper) IsConsumerShutdownInitiated(ctx sdk.Context) bool {
+	store := k.providerStore(ctx)
+	return store.Has(types.ConsumerShutdownKey())
+}
+
+// SetConsumerShutdownInitiated marks that the consumer chain initiated its shutdown
+func (k Keeper) SetConsumerShutdownInitiated(ctx sdk.Context) {
+	store := k.providerStore(ctx)
+	store.Set(types.ConsumerShutdownKey(), []byte{})
+}
+
+// InitiateConsumerShutdown initiates the shutdown of the consumer chain from the consumer side.
+// It appends a final VSCMatured packet and a ConsumerShutdown packet to the pending packets,
+// so that they are sent after all the packets queued before, and flushes the pending packets.
+// The CCV channel is closed once the provider chain acknowledges the ConsumerShutdown packet,
+// see OnConsumerShutdownAcknowledged.
+func (k Keeper) InitiateConsumerShutdown(ctx sdk.Context) error {
+	channelID, found := k.GetProviderChannel(ctx)
+	if !found || k.IsChannelClosed(ctx, channelID) {
+		return errorsmod.Wrap(types.ErrNoProposerChannelId, "cannot shut down the consumer chain")
+	}
+	if k.IsConsumerShutdownInitiated(ctx) {
+		return errorsmod.Wrap(types.ErrConsumerShutdownNotPermitted, "consumer shutdown already initiated")
+	}
+
+	vscID := k.GetLastReceivedValsetUpdateID(ctx)
+
+	// the final VSCMatured packet notifies the provider chain that
+	// all the received VSC packets matured on the consumer chain
+	k.AppendPendingPacket(ctx,
+		ccv.VscMaturedPacket,
+		&ccv.ConsumerPacketData_VscMaturedPacketData{
+			VscMaturedPacketData: ccv.NewVSCMaturedPacketData(vscID),
+		},
+	)
+	k.AppendPendingPacket(ctx,
+		ccv.ConsumerShutdownPacket,
+		&ccv.ConsumerPacketData_ConsumerShutdownPacketData{
+			ConsumerShutdownPacketData: ccv.NewConsumerShutdownPacketData(vscID),
+		},
+	)
+	k.SetConsumerShutdownInitiated(ctx)
+
+	// flush the pending packets; the packets that cannot be sent yet,
+	// e.g., due to a pending slash packet, are sent in the next blocks
+	k.SendPackets(ctx)
+
+	k.Logger(ctx).Info("consumer shutdown initiated", "channel", channelID, "vscID", vscID)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeVSCMatured,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(ccv.AttributeValSetUpdateID, strconv.FormatUint(vscID, 10)),
+		),
+		sdk.NewEvent(
+			types.EventTypeConsumerShutdownInit,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(channeltypes.AttributeKeyChannelID, channelID),
+			sdk.NewAttribute(ccv.AttributeValSetUpdateID, strconv.FormatUint(vscID, 10)),
+		),
+	})
+
+	return nil
+}
+
+// OnConsumerShutdownAcknowledged closes the CCV channel once the provider chain
+// acknowledged the ConsumerShutdown packet, i.e., once it stopped the consumer chain
+func (k Keeper) OnConsumerShutdownAcknowledged(ctx sdk.Context, channelID string) error {
+	if err := k.ChanCloseInit(ctx, ccv.ConsumerPortID, channelID); err != nil {
+		return errorsmod.Wrapf(err, "cannot close CCV channel %s on consumer shutdown", channelID)
+	}
+
+	k.Logger(ctx).Info("consumer shutdown completed, CCV channel closed", "channel", channelID)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeConsumerShutdown,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(channeltypes.AttributeKeyChannelID, channelID),
+		),
+	)
+	return nil
+}
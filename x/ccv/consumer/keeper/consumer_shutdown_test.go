@@ -0,0 +1,103 @@
+package keeper_test
+
+import (
+	"testing"
+
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
+	consumertypes "github.com/cosmos/interchain-security/v7/x/ccv/consumer/types"
+	"github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// TestInitiateConsumerShutdown tests that initiating the consumer shutdown sends a final VSCMatured packet
+// and a ConsumerShutdown packet after the pending packets, and that the shutdown can only be initiated once
+func TestInitiateConsumerShutdown(t *testing.T) {
+	consumerKeeper, ctx, ctrl, mocks := testkeeper.GetConsumerKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	consumerKeeper.SetParams(ctx, types.DefaultParams())
+
+	// the shutdown cannot be initiated before the CCV channel is established
+	err := consumerKeeper.InitiateConsumerShutdown(ctx)
+	require.ErrorIs(t, err, consumertypes.ErrNoProposerChannelId)
+
+	consumerKeeper.SetProviderChannel(ctx, "consumerCCVChannelID")
+	consumerKeeper.SetHeightValsetUpdateID(ctx, uint64(ctx.BlockHeight())+1, 7)
+
+	// a pending packet is sent before the shutdown packets
+	consumerKeeper.AppendPendingPacket(ctx, types.VscMaturedPacket, &types.ConsumerPacketData_VscMaturedPacketData{
+		VscMaturedPacketData: types.NewVSCMaturedPacketData(6),
+	})
+
+	gomock.InOrder(
+		mocks.MockChannelKeeper.EXPECT().GetChannel(ctx, types.ConsumerPortID, "consumerCCVChannelID").
+			Return(channeltypes.Channel{State: channeltypes.OPEN}, true).Times(1),
+		mocks.MockChannelKeeper.EXPECT().GetChannel(ctx, types.ConsumerPortID, "consumerCCVChannelID").
+			Return(channeltypes.Channel{}, true).Times(3),
+	)
+	var sentData [][]byte
+	mocks.MockChannelKeeper.EXPECT().SendPacket(ctx, types.ConsumerPortID, "consumerCCVChannelID",
+		gomock.Any(), gomock.Any(), gomock.Any(),
+	).DoAndReturn(func(_, _, _, _, _ interface{}, data []byte) (uint64, error) {
+		sentData = append(sentData, data)
+		return uint64(len(sentData)), nil
+	}).Times(3)
+
+	err = consumerKeeper.InitiateConsumerShutdown(ctx)
+	require.NoError(t, err)
+	require.True(t, consumerKeeper.IsConsumerShutdownInitiated(ctx))
+	require.Empty(t, consumerKeeper.GetPendingPackets(ctx))
+
+	// the packets are sent in FIFO order
+	require.Len(t, sentData, 3)
+	expectedTypes := []types.ConsumerPacketDataType{types.VscMaturedPacket, types.VscMaturedPacket, types.ConsumerShutdownPacket}
+	for i, data := range sentData {
+		packet, err := types.UnmarshalConsumerPacketData(data)
+		require.NoError(t, err)
+		require.Equal(t, expectedTypes[i], packet.Type)
+	}
+	lastVscMatured, err := types.UnmarshalConsumerPacketData(sentData[1])
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), lastVscMatured.GetVscMaturedPacketData().ValsetUpdateId)
+
+	// the shutdown cannot be initiated twice
+	mocks.MockChannelKeeper.EXPECT().GetChannel(ctx, types.ConsumerPortID, "consumerCCVChannelID").
+		Return(channeltypes.Channel{State: channeltypes.OPEN}, true).Times(1)
+	err = consumerKeeper.InitiateConsumerShutdown(ctx)
+	require.ErrorIs(t, err, consumertypes.ErrConsumerShutdownNotPermitted)
+}
+
+// TestOnAcknowledgementPacketConsumerShutdown tests that the CCV channel is closed
+// once the provider chain acknowledges the ConsumerShutdown packet
+func TestOnAcknowledgementPacketConsumerShutdown(t *testing.T) {
+	consumerKeeper, ctx, ctrl, mocks := testkeeper.GetConsumerKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	consumerKeeper.SetProviderChannel(ctx, "consumerCCVChannelID")
+
+	shutdownPacket := types.NewConsumerPacketData(types.ConsumerShutdownPacket,
+		&types.ConsumerPacketData_ConsumerShutdownPacketData{
+			ConsumerShutdownPacketData: types.NewConsumerShutdownPacketData(7),
+		},
+	)
+	packet := channeltypes.Packet{
+		SourcePort:    types.ConsumerPortID,
+		SourceChannel: "consumerCCVChannelID",
+		Data:          shutdownPacket.GetBytes(),
+	}
+
+	mocks.MockChannelKeeper.EXPECT().ChanCloseInit(ctx, types.ConsumerPortID, "consumerCCVChannelID").
+		Return(nil).Times(1)
+
+	err := consumerKeeper.OnAcknowledgementPacket(ctx, packet, channeltypes.NewResultAcknowledgement(types.V1Result))
+	require.NoError(t, err)
+
+	found := false
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == consumertypes.EventTypeConsumerShutdown {
+			found = true
+		}
+	}
+	require.True(t, found)
+}
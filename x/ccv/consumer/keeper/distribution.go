@@ -47,22 +47,16 @@ func (k Keeper) EndBlockRD(ctx sdk.Context) {
 }
 
 // DistributeRewardsInternally splits the block rewards according to the
-// ConsumerRedistributionFrac param.
+// ConsumerRedistributionFrac param, or the DenomRedistributionFractions param
+// for the denoms it contains.
 // Returns true if it's time to send rewards to provider
 func (k Keeper) DistributeRewardsInternally(ctx sdk.Context) {
 	consumerFeePoolAddr := k.authKeeper.GetModuleAccount(ctx, k.feeCollectorName).GetAddress()
 	fpTokens := k.bankKeeper.GetAllBalances(ctx, consumerFeePoolAddr)
 
 	// split the fee pool, send the consumer's fraction to the consumer redistribution address
-	frac, err := math.LegacyNewDecFromStr(k.GetConsumerRedistributionFrac(ctx))
-	if err != nil {
-		// ConsumerRedistributionFrac was already validated when set as a param
-		panic(fmt.Errorf("ConsumerRedistributionFrac is invalid: %w", err))
-	}
-	decFPTokens := sdk.NewDecCoinsFromCoins(fpTokens...)
-	// NOTE the truncated decimal remainder will be sent to the provider fee pool
-	consRedistrTokens, _ := decFPTokens.MulDec(frac).TruncateDecimal()
-	err = k.bankKeeper.SendCoinsFromModuleToModule(ctx, k.feeCollectorName,
+	consRedistrTokens := k.splitBlockFees(ctx, fpTokens)
+	err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, k.feeCollectorName,
 		types.ConsumerRedistributeName, consRedistrTokens)
 	if err != nil {
 		// SendCoinsFromModuleToModule will panic if either module account does not exist,
@@ -89,6 +83,53 @@ func (k Keeper) DistributeRewardsInternally(ctx sdk.Context) {
 	}
 }
 
+// splitBlockFees returns the part of the block fees that is redistributed on the consumer chain.
+// The fees of the denoms in the DenomRedistributionFractions param are split according to their
+// fractions, while the other fees are split according to the ConsumerRedistributionFrac param.
+func (k Keeper) splitBlockFees(ctx sdk.Context, fees sdk.Coins) sdk.Coins {
+	frac, err := math.LegacyNewDecFromStr(k.GetConsumerRedistributionFrac(ctx))
+	if err != nil {
+		// ConsumerRedistributionFrac was already validated when set as a param
+		panic(fmt.Errorf("ConsumerRedistributionFrac is invalid: %w", err))
+	}
+
+	consumerFees := sdk.NewCoins()
+	defaultFracFees := fees
+	for _, denomFrac := range k.GetDenomRedistributionFractions(ctx) {
+		amount := fees.AmountOf(denomFrac.Denom)
+		if amount.IsZero() {
+			continue
+		}
+		denomFees := sdk.NewCoins(sdk.NewCoin(denomFrac.Denom, amount))
+		defaultFracFees = defaultFracFees.Sub(denomFees...)
+
+		fracForDenom, err := math.LegacyNewDecFromStr(denomFrac.Fraction)
+		if err != nil {
+			// DenomRedistributionFractions was already validated when set as a param
+			panic(fmt.Errorf("DenomRedistributionFractions is invalid: %w", err))
+		}
+		consumerFees = consumerFees.Add(k.splitFees(ctx, denomFees, fracForDenom)...)
+	}
+
+	return consumerFees.Add(k.splitFees(ctx, defaultFracFees, frac)...)
+}
+
+// splitFees returns the part of the block fees that is redistributed on the consumer chain,
+// as computed by the fee splitter of the keeper. If the fee splitter fails or returns more
+// than the block fees, the fees are split by DefaultFeeSplitter.
+func (k Keeper) splitFees(ctx sdk.Context, fees sdk.Coins, consumerRedistributionFrac math.LegacyDec) sdk.Coins {
+	consumerFees, err := k.feeSplitter(ctx, fees, consumerRedistributionFrac)
+	if err == nil && (!consumerFees.IsValid() || !consumerFees.IsAllLTE(fees)) {
+		err = fmt.Errorf("invalid consumer fees %s for the block fees %s", consumerFees, fees)
+	}
+	if err != nil {
+		k.SubsystemLogger(ctx, ccv.LogSubsystemRewards).Error("cannot split the block fees; using the default split",
+			"error", err)
+		consumerFees, _ = DefaultFeeSplitter(ctx, fees, consumerRedistributionFrac)
+	}
+	return consumerFees
+}
+
 // Check whether it's time to send rewards to provider
 func (k Keeper) shouldSendRewardsToProvider(ctx sdk.Context) bool {
 	bpdt := k.GetBlocksPerDistributionTransmission(ctx)
@@ -104,11 +145,17 @@ func (k Keeper) SendRewardsToProvider(ctx sdk.Context) error {
 	sourceChannelID := k.GetDistributionTransmissionChannel(ctx)
 	transferChannel, found := k.channelKeeper.GetChannel(ctx, transfertypes.PortID, sourceChannelID)
 	if !found || transferChannel.State != channeltypes.OPEN {
-		k.Logger(ctx).Info("WARNING: cannot send rewards to provider;",
+		k.SubsystemLogger(ctx, ccv.LogSubsystemRewards).Warn("cannot send rewards to provider;",
 			"transmission channel not in OPEN state", "channelID", sourceChannelID)
 		return nil
 	}
 
+	// make sure the transfers are not blocked by the rate limits of an IBC rate-limiting middleware
+	if err := k.UpdateRewardsRateLimitExemption(ctx); err != nil {
+		k.SubsystemLogger(ctx, ccv.LogSubsystemRewards).Error("cannot exempt the transfers of the consumer rewards from the rate limits",
+			"channelID", sourceChannelID, "error", err)
+	}
+
 	// get params for sending rewards
 	toSendToProviderAddr := k.authKeeper.GetModuleAccount(ctx,
 		types.ConsumerToSendToProviderName).GetAddress() // sender address
@@ -123,6 +170,9 @@ func (k Keeper) SendRewardsToProvider(ctx sdk.Context) error {
 		return err
 	}
 
+	// send the reward splits to their local addresses before sending the remaining rewards to the provider
+	splitCoins := k.sendRewardSplits(ctx, toSendToProviderAddr)
+
 	// iterate over all whitelisted reward denoms
 	for _, denom := range k.AllowedRewardDenoms(ctx) {
 		// get the balance of the denom in the toSendToProviderTokens address
@@ -157,9 +207,11 @@ func (k Keeper) SendRewardsToProvider(ctx sdk.Context) error {
 		}
 	}
 
-	k.Logger(ctx).Info("sent block rewards to provider",
+	allBalances = allBalances.Add(splitCoins...)
+	k.SubsystemLogger(ctx, ccv.LogSubsystemRewards).Info("sent block rewards to provider",
 		"total fee pool", allBalances.String(),
 		"sent", sentCoins.String(),
+		"split", splitCoins.String(),
 	)
 	currentHeight := ctx.BlockHeight()
 	ctx.EventManager().EmitEvent(
@@ -171,12 +223,70 @@ func (k Keeper) SendRewardsToProvider(ctx sdk.Context) error {
 			sdk.NewAttribute(types.AttributeDistributionFraction, (k.GetConsumerRedistributionFrac(ctx))),
 			sdk.NewAttribute(types.AttributeDistributionTotal, allBalances.String()),
 			sdk.NewAttribute(types.AttributeDistributionToProvider, sentCoins.String()),
+			sdk.NewAttribute(types.AttributeDistributionToSplits, splitCoins.String()),
 		),
 	)
 
 	return nil
 }
 
+// sendRewardSplits sends, for every reward split in the RewardSplits param, its fraction of the
+// rewards in the toSendToProviderTokens address to its local address and returns the sent rewards.
+// The fractions apply to the rewards before any split is sent. If a split cannot be sent,
+// e.g., because its address is not allowed to receive funds, its rewards are sent to the provider.
+func (k Keeper) sendRewardSplits(ctx sdk.Context, toSendToProviderAddr sdk.AccAddress) sdk.Coins {
+	splitCoins := sdk.NewCoins()
+	rewardSplits := k.GetRewardSplits(ctx)
+	if len(rewardSplits) == 0 {
+		return splitCoins
+	}
+
+	rewards := sdk.NewCoins()
+	for _, denom := range k.AllowedRewardDenoms(ctx) {
+		rewards = rewards.Add(k.bankKeeper.GetBalance(ctx, toSendToProviderAddr, denom))
+	}
+
+	for _, split := range rewardSplits {
+		fraction, err := math.LegacyNewDecFromStr(split.Fraction)
+		if err != nil {
+			// RewardSplits was already validated when set as a param
+			panic(fmt.Errorf("RewardSplits is invalid: %w", err))
+		}
+		addr, err := k.authKeeper.AddressCodec().StringToBytes(split.Address)
+		if err != nil {
+			// RewardSplits was already validated when set as a param
+			panic(fmt.Errorf("RewardSplits is invalid: %w", err))
+		}
+
+		coins := sdk.NewCoins()
+		for _, reward := range rewards {
+			amount := math.LegacyNewDecFromInt(reward.Amount).Mul(fraction).TruncateInt()
+			coins = coins.Add(sdk.NewCoin(reward.Denom, amount))
+		}
+		if coins.IsZero() {
+			continue
+		}
+
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ConsumerToSendToProviderName, addr, coins); err != nil {
+			k.SubsystemLogger(ctx, ccv.LogSubsystemRewards).Error("cannot send the reward split; sending it to the provider",
+				"address", split.Address, "rewards", coins.String(), "error", err)
+			continue
+		}
+		splitCoins = splitCoins.Add(coins...)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeRewardSplit,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				sdk.NewAttribute(types.AttributeRewardSplitAddress, split.Address),
+				sdk.NewAttribute(types.AttributeRewardSplitAmount, coins.String()),
+			),
+		)
+	}
+
+	return splitCoins
+}
+
 // AllowedRewardDenoms returns a list of all denoms that are allowed
 // to be sent to the provider as rewards
 func (k Keeper) AllowedRewardDenoms(ctx sdk.Context) []string {
@@ -256,15 +366,9 @@ func (k Keeper) GetEstimatedNextFeeDistribution(ctx sdk.Context) types.NextFeeDi
 	total := k.bankKeeper.GetAllBalances(ctx, consumerFeePoolAddr)
 
 	fracParam := k.GetConsumerRedistributionFrac(ctx)
-	frac, err := math.LegacyNewDecFromStr(fracParam)
-	if err != nil {
-		// ConsumerRedistributionFrac was already validated when set as a param
-		panic(fmt.Errorf("ConsumerRedistributionFrac is invalid: %w", err))
-	}
 
 	totalTokens := sdk.NewDecCoinsFromCoins(total...)
-	// truncated decimals are implicitly added to provider
-	consumerTokens, _ := totalTokens.MulDec(frac).TruncateDecimal()
+	consumerTokens := k.splitBlockFees(ctx, total)
 	providerTokens := total.Sub(consumerTokens...)
 
 	return types.NextFeeDistributionEstimate{
@@ -1,14 +1,19 @@
 package keeper_test
 
 import (
+	"context"
+	"fmt"
 	"strings"
 	"testing"
 
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
 	"cosmossdk.io/math"
 
+	"github.com/cosmos/cosmos-sdk/codec/address"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authTypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
@@ -92,3 +97,98 @@ func TestAllowedRewardDenoms(t *testing.T) {
 	require.Equal(t, allowedDenoms[0], "ustake")
 	require.True(t, strings.HasPrefix(allowedDenoms[1], "ibc/"))
 }
+
+// TestDenomRedistributionFractions tests that the block fees of the denoms with a specific
+// redistribution fraction are split according to it, and the other fees according to
+// the ConsumerRedistributionFraction param
+func TestDenomRedistributionFractions(t *testing.T) {
+	consumerKeeper, ctx, ctrl, mocks := testkeeper.GetConsumerKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	params := ccvtypes.DefaultParams()
+	params.ConsumerRedistributionFraction = "0.75"
+	params.DenomRedistributionFractions = []ccvtypes.DenomRedistributionFraction{
+		{Denom: "local", Fraction: "1"},
+		{Denom: "forwarded", Fraction: "0"},
+		{Denom: "absent", Fraction: "0.5"},
+	}
+	consumerKeeper.SetParams(ctx, params)
+
+	fees := sdk.NewCoins(
+		sdk.NewInt64Coin("local", 100),
+		sdk.NewInt64Coin("forwarded", 100),
+		sdk.NewInt64Coin("stake", 100),
+	)
+	expectedToConsumer := sdk.NewCoins(
+		sdk.NewInt64Coin("local", 100),
+		sdk.NewInt64Coin("stake", 75),
+	)
+
+	mAcc := authTypes.NewModuleAccount(&authTypes.BaseAccount{}, "", "auth")
+	mocks.MockAccountKeeper.EXPECT().GetModuleAccount(ctx, authTypes.FeeCollectorName).Return(mAcc).Times(2)
+	mocks.MockBankKeeper.EXPECT().GetAllBalances(ctx, mAcc.GetAddress()).Return(fees).Times(2)
+	gomock.InOrder(
+		mocks.MockBankKeeper.EXPECT().SendCoinsFromModuleToModule(ctx, authTypes.FeeCollectorName,
+			types.ConsumerRedistributeName, expectedToConsumer).Return(nil),
+		mocks.MockBankKeeper.EXPECT().SendCoinsFromModuleToModule(ctx, authTypes.FeeCollectorName,
+			types.ConsumerToSendToProviderName, fees.Sub(expectedToConsumer...)).Return(nil),
+	)
+
+	consumerKeeper.DistributeRewardsInternally(ctx)
+
+	res := consumerKeeper.GetEstimatedNextFeeDistribution(ctx)
+	require.Equal(t, sdk.NewDecCoinsFromCoins(expectedToConsumer...).String(), res.ToConsumer)
+	require.Equal(t, sdk.NewDecCoinsFromCoins(fees.Sub(expectedToConsumer...)...).String(), res.ToProvider)
+}
+
+// TestRewardSplits tests that the reward splits are sent to their local addresses
+// before the remaining rewards are sent to the provider
+func TestRewardSplits(t *testing.T) {
+	consumerKeeper, ctx, ctrl, mocks := testkeeper.GetConsumerKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	devFund := sdk.AccAddress([]byte("devFund"))
+	blocked := sdk.AccAddress([]byte("blocked"))
+	params := ccvtypes.DefaultParams()
+	params.RewardDenoms = []string{"stake"}
+	params.DistributionTransmissionChannel = "channel-1"
+	params.ProviderFeePoolAddrStr = "cosmos19pe9pg5dv9k5fzgzmsrgnw9rl9asf7ddwhu7lm"
+	params.RewardSplits = []ccvtypes.RewardSplit{
+		{Address: devFund.String(), Fraction: "0.15"},
+		{Address: blocked.String(), Fraction: "0.1"},
+	}
+	consumerKeeper.SetParams(ctx, params)
+
+	mAcc := authTypes.NewEmptyModuleAccount(types.ConsumerToSendToProviderName)
+	mocks.MockChannelKeeper.EXPECT().GetChannel(ctx, transfertypes.PortID, "channel-1").
+		Return(channeltypes.Channel{State: channeltypes.OPEN}, true)
+	mocks.MockAccountKeeper.EXPECT().GetModuleAccount(ctx, types.ConsumerToSendToProviderName).Return(mAcc).AnyTimes()
+	mocks.MockAccountKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec("cosmos")).AnyTimes()
+	gomock.InOrder(
+		mocks.MockBankKeeper.EXPECT().GetBalance(ctx, mAcc.GetAddress(), "stake").Return(sdk.NewInt64Coin("stake", 100)),
+		mocks.MockBankKeeper.EXPECT().SendCoinsFromModuleToAccount(ctx, types.ConsumerToSendToProviderName,
+			devFund, sdk.NewCoins(sdk.NewInt64Coin("stake", 15))).Return(nil),
+		// the split that cannot be sent is sent to the provider
+		mocks.MockBankKeeper.EXPECT().SendCoinsFromModuleToAccount(ctx, types.ConsumerToSendToProviderName,
+			blocked, sdk.NewCoins(sdk.NewInt64Coin("stake", 10))).Return(fmt.Errorf("blocked address")),
+		mocks.MockBankKeeper.EXPECT().GetBalance(ctx, mAcc.GetAddress(), "stake").Return(sdk.NewInt64Coin("stake", 85)),
+		mocks.MockIBCTransferKeeper.EXPECT().Transfer(ctx, gomock.Any()).DoAndReturn(
+			func(_ context.Context, msg *transfertypes.MsgTransfer) (*transfertypes.MsgTransferResponse, error) {
+				require.Equal(t, sdk.NewInt64Coin("stake", 85), msg.Token)
+				return &transfertypes.MsgTransferResponse{}, nil
+			}),
+	)
+
+	require.NoError(t, consumerKeeper.SendRewardsToProvider(ctx))
+
+	// only the split that was sent is reported
+	splitEvents := []sdk.Event{}
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeRewardSplit {
+			splitEvents = append(splitEvents, event)
+		}
+	}
+	require.Equal(t, []sdk.Event{sdk.NewEvent(types.EventTypeRewardSplit,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeRewardSplitAddress, devFund.String()),
+		sdk.NewAttribute(types.AttributeRewardSplitAmount, "15stake"),
+	)}, splitEvents)
+}
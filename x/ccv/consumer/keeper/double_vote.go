@@ -0,0 +1,188 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"cosmossdk.io/core/comet"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/evidence/exported"
+	evidencetypes "cosmossdk.io/x/evidence/types"
+
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	tmcrypto "github.com/cometbft/cometbft/crypto"
+	tmtypes "github.com/cometbft/cometbft/types"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/consumer/types"
+	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// NewDoubleVoteEvidenceHandler returns the handler of the ConsumerDoubleVoteEvidence submitted to the evidence module
+// of the consumer chain. The app registers it in the evidence router under types.RouteConsumerDoubleVote, e.g.,
+//
+//	evidenceRouter := evidencetypes.NewRouter().
+//		AddRoute(consumertypes.RouteConsumerDoubleVote, consumerkeeper.NewDoubleVoteEvidenceHandler(app.ConsumerKeeper))
+//	evidenceKeeper.SetRouter(evidenceRouter)
+func NewDoubleVoteEvidenceHandler(k Keeper) evidencetypes.Handler {
+	return func(goCtx context.Context, evidence exported.Evidence) error {
+		ctx := sdk.UnwrapSDKContext(goCtx)
+
+		doubleVoteEvidence, ok := evidence.(*types.ConsumerDoubleVoteEvidence)
+		if !ok {
+			return errorsmod.Wrapf(types.ErrInvalidDoubleVoteEvidence, "unexpected evidence type: %T", evidence)
+		}
+		duplicateVoteEvidence, err := tmtypes.DuplicateVoteEvidenceFromProto(doubleVoteEvidence.DuplicateVoteEvidence)
+		if err != nil {
+			return errorsmod.Wrap(types.ErrInvalidDoubleVoteEvidence, err.Error())
+		}
+
+		return k.ForwardDoubleVoteEvidence(ctx, duplicateVoteEvidence)
+	}
+}
+
+// ForwardDoubleVoteEvidence forwards the evidence of a consumer validator double voting on the consumer chain
+// to the provider chain. It verifies the conflicting votes with the consensus public key of the validator
+// and appends them in a ConsumerDoubleVote packet to the pending packets, which are sent in EndBlock.
+// The provider chain verifies the evidence again and slashes, jails, and tombstones the validator.
+//
+// Note that the validator must still be in the consumer validator set, as the consumer chain
+// does not store the public keys of the validators that left the validator set.
+func (k Keeper) ForwardDoubleVoteEvidence(ctx sdk.Context, evidence *tmtypes.DuplicateVoteEvidence) error {
+	channelID, found := k.GetProviderChannel(ctx)
+	if !found || k.IsChannelClosed(ctx, channelID) {
+		return errorsmod.Wrap(types.ErrNoProposerChannelId, "cannot forward double voting evidence")
+	}
+	if k.IsConsumerShutdownInitiated(ctx) {
+		return errorsmod.Wrap(types.ErrInvalidDoubleVoteEvidence, "consumer shutdown initiated")
+	}
+	// the infractions committed while the chain is a standalone chain are punished by the standalone staking module
+	if k.isStandaloneStaking(ctx) {
+		return errorsmod.Wrap(types.ErrStandaloneChain, "cannot forward double voting evidence")
+	}
+	if evidence == nil {
+		return errorsmod.Wrap(types.ErrInvalidDoubleVoteEvidence, "evidence cannot be nil")
+	}
+	if err := evidence.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(types.ErrInvalidDoubleVoteEvidence, err.Error())
+	}
+	if k.IsPrevStandaloneChain(ctx) && evidence.Height() < k.FirstConsumerHeight(ctx) {
+		return errorsmod.Wrapf(types.ErrInvalidDoubleVoteEvidence,
+			"infraction height %d is before the first consumer height %d", evidence.Height(), k.FirstConsumerHeight(ctx))
+	}
+
+	validator, found := k.GetCCValidator(ctx, evidence.VoteA.ValidatorAddress)
+	if !found {
+		return errorsmod.Wrapf(types.ErrInvalidDoubleVoteEvidence,
+			"validator %s is not in the consumer validator set", evidence.VoteA.ValidatorAddress)
+	}
+	pubKey, err := validator.ConsPubKey()
+	if err != nil {
+		return err
+	}
+	tmPubKey, err := cryptocodec.ToCmtPubKeyInterface(pubKey)
+	if err != nil {
+		return err
+	}
+	if err := verifyDoubleVote(ctx.ChainID(), evidence, tmPubKey); err != nil {
+		return errorsmod.Wrap(types.ErrInvalidDoubleVoteEvidence, err.Error())
+	}
+
+	tmProtoPubKey, err := cryptocodec.ToCmtProtoPublicKey(pubKey)
+	if err != nil {
+		return err
+	}
+	data := ccv.NewConsumerDoubleVotePacketData(evidence.ToProto(), &tmProtoPubKey)
+	if err := data.Validate(); err != nil {
+		return errorsmod.Wrap(types.ErrInvalidDoubleVoteEvidence, err.Error())
+	}
+
+	k.AppendPendingPacket(ctx,
+		ccv.ConsumerDoubleVotePacket,
+		&ccv.ConsumerPacketData_ConsumerDoubleVotePacketData{
+			ConsumerDoubleVotePacketData: data,
+		},
+	)
+
+	consAddr := sdk.ConsAddress(evidence.VoteA.ValidatorAddress)
+	k.Logger(ctx).Info("consumer double voting evidence forwarded",
+		"validator", consAddr.String(),
+		"height", evidence.Height(),
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDoubleVoteForwarded,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(ccv.AttributeValidatorAddress, consAddr.String()),
+			sdk.NewAttribute(ccv.AttributeInfractionHeight, strconv.FormatInt(evidence.Height(), 10)),
+		),
+	)
+
+	return nil
+}
+
+// TrackCommittedDoubleVotes handles the double voting misbehaviour committed by CometBFT in the current block,
+// i.e., the misbehaviour handled by the evidence module in its BeginBlocker. ABCI passes to the app only the
+// validator and the height of the misbehaviour, but not the conflicting votes the provider chain needs to verify it.
+// Thus, for the misbehaviour of a consumer validator, it emits an event that signals the off-chain submitters
+// (e.g., the relayer) to submit the DuplicateVoteEvidence of the block as ConsumerDoubleVoteEvidence,
+// which the evidence handler forwards to the provider chain.
+func (k Keeper) TrackCommittedDoubleVotes(ctx sdk.Context) {
+	cometInfo := ctx.CometInfo()
+	if cometInfo == nil || cometInfo.GetEvidence() == nil {
+		return
+	}
+	if _, found := k.GetProviderChannel(ctx); !found || k.isStandaloneStaking(ctx) {
+		return
+	}
+
+	evidenceList := cometInfo.GetEvidence()
+	for i := 0; i < evidenceList.Len(); i++ {
+		misbehaviour := evidenceList.Get(i)
+		if misbehaviour.Type() != comet.DuplicateVote {
+			continue
+		}
+		consAddr := sdk.ConsAddress(misbehaviour.Validator().Address())
+		if _, found := k.GetCCValidator(ctx, consAddr); !found {
+			continue
+		}
+
+		k.Logger(ctx).Info("consumer double voting misbehaviour committed; waiting for the evidence to be forwarded",
+			"validator", consAddr.String(),
+			"height", misbehaviour.Height(),
+		)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeDoubleVoteCommitted,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				sdk.NewAttribute(ccv.AttributeValidatorAddress, consAddr.String()),
+				sdk.NewAttribute(ccv.AttributeInfractionHeight, strconv.FormatInt(misbehaviour.Height(), 10)),
+			),
+		)
+	}
+}
+
+// verifyDoubleVote verifies that the conflicting votes of the evidence are signed with `pubKey`
+// for different blocks at the same height, round, and step of the consumer chain `chainID`
+func verifyDoubleVote(chainID string, evidence *tmtypes.DuplicateVoteEvidence, pubKey tmcrypto.PubKey) error {
+	voteA, voteB := evidence.VoteA, evidence.VoteB
+	if voteA.Height != voteB.Height || voteA.Round != voteB.Round || voteA.Type != voteB.Type {
+		return fmt.Errorf("height/round/type are not the same: %d/%d/%v vs %d/%d/%v",
+			voteA.Height, voteA.Round, voteA.Type, voteB.Height, voteB.Round, voteB.Type)
+	}
+	if voteA.BlockID.Equals(voteB.BlockID) {
+		return fmt.Errorf("block IDs are the same (%v) - not a real duplicate vote", voteA.BlockID)
+	}
+	// Verify checks also that the validator address of the vote is derived from the public key
+	if err := voteA.Verify(chainID, pubKey); err != nil {
+		return fmt.Errorf("verifying VoteA: %w", err)
+	}
+	if err := voteB.Verify(chainID, pubKey); err != nil {
+		return fmt.Errorf("verifying VoteB: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	cryptotestutil "github.com/cosmos/interchain-security/v7/testutil/crypto"
+	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
+	consumerkeeper "github.com/cosmos/interchain-security/v7/x/ccv/consumer/keeper"
+	consumertypes "github.com/cosmos/interchain-security/v7/x/ccv/consumer/types"
+	"github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// TestForwardDoubleVoteEvidence tests that the double voting evidence of a consumer validator
+// submitted to the evidence module is sent to the provider chain in a ConsumerDoubleVote packet
+func TestForwardDoubleVoteEvidence(t *testing.T) {
+	consumerKeeper, ctx, ctrl, mocks := testkeeper.GetConsumerKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	chainID := "consumer-1"
+	ctx = ctx.WithChainID(chainID)
+
+	privVal := cmttypes.NewMockPV()
+	pubKey, err := privVal.GetPubKey()
+	require.NoError(t, err)
+	valSet := cmttypes.NewValidatorSet([]*cmttypes.Validator{cmttypes.NewValidator(pubKey, 10)})
+	blockTime := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	evidence := cryptotestutil.MakeDuplicateVoteEvidence(5, blockTime, valSet, privVal, chainID)
+	handler := consumerkeeper.NewDoubleVoteEvidenceHandler(consumerKeeper)
+
+	// evidence cannot be forwarded before the CCV channel is established
+	err = handler(ctx, consumertypes.NewConsumerDoubleVoteEvidence(evidence.ToProto()))
+	require.ErrorIs(t, err, consumertypes.ErrNoProposerChannelId)
+
+	consumerKeeper.SetProviderChannel(ctx, "consumerCCVChannelID")
+	mocks.MockChannelKeeper.EXPECT().GetChannel(ctx, types.ConsumerPortID, "consumerCCVChannelID").
+		Return(channeltypes.Channel{State: channeltypes.OPEN}, true).AnyTimes()
+
+	// evidence of a validator that is not a consumer validator is rejected
+	err = handler(ctx, consumertypes.NewConsumerDoubleVoteEvidence(evidence.ToProto()))
+	require.ErrorIs(t, err, consumertypes.ErrInvalidDoubleVoteEvidence)
+
+	sdkPubKey, err := cryptocodec.FromCmtPubKeyInterface(pubKey)
+	require.NoError(t, err)
+	validator, err := consumertypes.NewCCValidator(pubKey.Address(), 10, sdkPubKey)
+	require.NoError(t, err)
+	consumerKeeper.SetCCValidator(ctx, validator)
+
+	// evidence of another chain is rejected
+	otherEvidence := cryptotestutil.MakeDuplicateVoteEvidence(5, blockTime, valSet, privVal, "other-1")
+	err = handler(ctx, consumertypes.NewConsumerDoubleVoteEvidence(otherEvidence.ToProto()))
+	require.ErrorIs(t, err, consumertypes.ErrInvalidDoubleVoteEvidence)
+	require.Empty(t, consumerKeeper.GetPendingPackets(ctx))
+
+	// valid evidence is forwarded with the public key of the validator
+	err = handler(ctx, consumertypes.NewConsumerDoubleVoteEvidence(evidence.ToProto()))
+	require.NoError(t, err)
+
+	pendingPackets := consumerKeeper.GetPendingPackets(ctx)
+	require.Len(t, pendingPackets, 1)
+	require.Equal(t, types.ConsumerDoubleVotePacket, pendingPackets[0].Type)
+
+	// the packet is sent over the wire in the current format
+	packetData, err := types.UnmarshalConsumerPacketData(pendingPackets[0].GetBytes())
+	require.NoError(t, err)
+	require.NoError(t, packetData.Validate())
+	require.Equal(t, evidence.ToProto(), packetData.GetConsumerDoubleVotePacketData().DuplicateVoteEvidence)
+	tmPubKey, err := cryptocodec.ToCmtProtoPublicKey(sdkPubKey)
+	require.NoError(t, err)
+	require.Equal(t, tmPubKey, *packetData.GetConsumerDoubleVotePacketData().ValidatorPubKey)
+
+	// an error ack of the packet, e.g., from a provider chain that does not support
+	// forwarded double voting evidence, does not close the CCV channel
+	packet := channeltypes.Packet{
+		SourcePort:    types.ConsumerPortID,
+		SourceChannel: "consumerCCVChannelID",
+		Data:          pendingPackets[0].GetBytes(),
+	}
+	err = consumerKeeper.OnAcknowledgementPacket(ctx, packet, channeltypes.NewErrorAcknowledgement(types.ErrInvalidPacketData))
+	require.NoError(t, err)
+}
+
+// TestTrackCommittedDoubleVotes tests that an event is emitted for the double voting misbehaviour
+// of a consumer validator committed by CometBFT
+func TestTrackCommittedDoubleVotes(t *testing.T) {
+	consumerKeeper, ctx, ctrl, _ := testkeeper.GetConsumerKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	consumerVal := cryptotestutil.NewCryptoIdentityFromIntSeed(0)
+	otherVal := cryptotestutil.NewCryptoIdentityFromIntSeed(1)
+	validator, err := consumertypes.NewCCValidator(consumerVal.SDKValConsAddress(), 10, consumerVal.ConsensusSDKPubKey())
+	require.NoError(t, err)
+	consumerKeeper.SetCCValidator(ctx, validator)
+
+	ctx = ctx.WithCometInfo(baseapp.NewBlockInfo([]abci.Misbehavior{
+		{Type: abci.MisbehaviorType_DUPLICATE_VOTE, Validator: abci.Validator{Address: consumerVal.SDKValConsAddress()}, Height: 5},
+		{Type: abci.MisbehaviorType_DUPLICATE_VOTE, Validator: abci.Validator{Address: otherVal.SDKValConsAddress()}, Height: 5},
+		{Type: abci.MisbehaviorType_LIGHT_CLIENT_ATTACK, Validator: abci.Validator{Address: consumerVal.SDKValConsAddress()}, Height: 6},
+	}, nil, nil, abci.CommitInfo{}))
+
+	// nothing is signaled before the CCV channel is established
+	consumerKeeper.TrackCommittedDoubleVotes(ctx)
+	require.Empty(t, ctx.EventManager().Events())
+
+	// only the double votes of consumer validators are signaled
+	consumerKeeper.SetProviderChannel(ctx, "consumerCCVChannelID")
+	consumerKeeper.TrackCommittedDoubleVotes(ctx)
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, consumertypes.EventTypeDoubleVoteCommitted, events[0].Type)
+	attr, found := events[0].GetAttribute(types.AttributeValidatorAddress)
+	require.True(t, found)
+	require.Equal(t, consumerVal.SDKValConsAddress().String(), attr.Value)
+	attr, found = events[0].GetAttribute(types.AttributeInfractionHeight)
+	require.True(t, found)
+	require.Equal(t, "5", attr.Value)
+}
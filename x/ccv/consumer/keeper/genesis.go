@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"sort"
+
 	conntypes "github.com/cosmos/ibc-go/v10/modules/core/03-connection/types"
 	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
 	ibchost "github.com/cosmos/ibc-go/v10/modules/core/exported"
@@ -35,6 +37,9 @@ func (k Keeper) InitGenesis(ctx sdk.Context, state *types.GenesisState) []abci.V
 	}
 	k.SetInitGenesisHeight(ctx, ctx.BlockHeight()) // Usually 0, but not the case for changeover chains
 
+	if err := k.ValidateRewardSplitAddresses(state.Params.RewardSplits); err != nil {
+		panic(err)
+	}
 	k.SetParams(ctx, state.Params)
 	// TODO: Remove enabled flag and find a better way to setup integration tests
 	// See: https://github.com/cosmos/interchain-security/issues/339
@@ -47,87 +52,35 @@ func (k Keeper) InitGenesis(ctx sdk.Context, state *types.GenesisState) []abci.V
 	// initialValSet is checked in NewChain case by ValidateGenesis
 	// start a new chain
 	if state.NewChain {
-		var clientID string
-		if state.ConnectionId == "" {
-			// create the provider client in InitGenesis for new consumer chain. CCV Handshake must be established with this client id.
-			clientStateBytes, err := state.Provider.ClientState.Marshal()
-			if err != nil {
-				panic(err)
-			}
-			consensusStateBytes, err := state.Provider.ConsensusState.Marshal()
-			if err != nil {
-				panic(err)
-			}
-
-			// this means the client must be tendermint
-			cid, err := k.clientKeeper.CreateClient(ctx, ibchost.Tendermint, clientStateBytes, consensusStateBytes)
-			if err != nil {
-				// If the client creation fails, the chain MUST NOT start
-				panic(err)
-			}
-			clientID = cid
-
-			k.Logger(ctx).Info("create new provider chain client",
-				"client id", clientID,
-			)
-		} else {
-			// if connection id is provided, then the client is already created
-			connectionEnd, found := k.connectionKeeper.GetConnection(ctx, state.ConnectionId)
-			if !found {
-				panic(errorsmod.Wrapf(conntypes.ErrConnectionNotFound, "could not find connection(%s)", state.ConnectionId))
-			}
-			clientID = connectionEnd.ClientId
-
-			k.Logger(ctx).Info("use existing client and connection to provider chain",
-				"client id", clientID,
-				"connection id", state.ConnectionId,
-			)
-		}
-
-		// set provider client id.
-		k.SetProviderClientID(ctx, clientID)
-
-		// set default value for valset update ID
-		k.SetHeightValsetUpdateID(ctx, uint64(ctx.BlockHeight()), uint64(0))
-
-		if state.ConnectionId != "" {
-			// initiate CCV channel handshake
-			ccvChannelOpenInitMsg := channeltypes.NewMsgChannelOpenInit(
-				ccv.ConsumerPortID,
-				ccv.Version,
-				channeltypes.ORDERED,
-				[]string{state.ConnectionId},
-				ccv.ProviderPortID,
-				"", // signer unused
-			)
-			_, err := k.ChannelOpenInit(ctx, ccvChannelOpenInitMsg)
-			if err != nil {
-				panic(err)
-			}
-
-			// Note that if the connection ID is not provider, we cannot initiate
-			// the connection handshake as the counterparty client ID is unknown
-			// at this point. The connection handshake must be initiated by a relayer.
+		if err := k.initProviderConnection(ctx, state.Provider, state.ConnectionId); err != nil {
+			// If the client creation fails, the chain MUST NOT start
+			panic(err)
 		}
-
 	} else {
 		// chain restarts with the CCV channel established
 		if state.ProviderChannelId != "" {
 			// set provider channel ID
 			k.SetProviderChannel(ctx, state.ProviderChannelId)
-			// set outstanding downtime slashing requests
-			for _, od := range state.OutstandingDowntimeSlashing {
-				consAddr, err := sdk.ConsAddressFromBech32(od.ValidatorConsensusAddress)
-				if err != nil {
-					panic(err)
-				}
-				k.SetOutstandingDowntime(ctx, consAddr)
-			}
 
 			// set last transmission block height
 			k.SetLastTransmissionBlockHeight(ctx, state.LastTransmissionBlockHeight)
 		}
 
+		// set outstanding downtime slashing requests;
+		// note that slash packets can be queued before the handshake is completed
+		for _, od := range state.OutstandingDowntimeSlashing {
+			consAddr, err := k.ConsensusAddressCodec().StringToBytes(od.ValidatorConsensusAddress)
+			if err != nil {
+				panic(err)
+			}
+			k.SetOutstandingDowntime(ctx, sdk.ConsAddress(consAddr))
+		}
+
+		// set the slash record of the slash packet waiting on a reply or being retried
+		if state.SlashRecord != nil {
+			k.SetSlashRecord(ctx, *state.SlashRecord)
+		}
+
 		// Set pending consumer packets, using the depreciated ConsumerPacketDataList type
 		// that exists for genesis.
 		// note that the list includes pending mature VSC packet only if the handshake is completed
@@ -135,8 +88,18 @@ func (k Keeper) InitGenesis(ctx sdk.Context, state *types.GenesisState) []abci.V
 			k.AppendPendingPacket(ctx, packet.Type, packet.Data)
 		}
 
-		// set height to valset update id mapping
-		for _, h2v := range state.HeightToValsetUpdateId {
+		// set height to valset update id mapping;
+		// note that the mappings with the same valset update id as the mapping of
+		// the previous height are skipped, as a genesis exported by previous versions
+		// contains a mapping for every height
+		heightToValsetUpdateIDs := append([]types.HeightToValsetUpdateID{}, state.HeightToValsetUpdateId...)
+		sort.SliceStable(heightToValsetUpdateIDs, func(i, j int) bool {
+			return heightToValsetUpdateIDs[i].Height < heightToValsetUpdateIDs[j].Height
+		})
+		for i, h2v := range heightToValsetUpdateIDs {
+			if i > 0 && h2v.ValsetUpdateId == heightToValsetUpdateIDs[i-1].ValsetUpdateId {
+				continue
+			}
 			k.SetHeightValsetUpdateID(ctx, h2v.Height, h2v.ValsetUpdateId)
 		}
 
@@ -144,16 +107,34 @@ func (k Keeper) InitGenesis(ctx sdk.Context, state *types.GenesisState) []abci.V
 		k.SetProviderClientID(ctx, state.ProviderClientId)
 	}
 
+	// set the pending switch to a different provider chain
+	if state.PendingProviderSwitch != nil {
+		k.SetPendingProviderSwitch(ctx, *state.PendingProviderSwitch)
+	}
+
+	// set the pending transition to a standalone chain
+	if state.PendingStandaloneTransition != nil {
+		k.SetPendingStandaloneTransition(ctx, *state.PendingStandaloneTransition)
+	}
+
+	// set the tombstoned and jailed validators of the previously standalone chain,
+	// before applying the initial valset
+	for _, record := range state.StandaloneValidatorRecords {
+		k.SetStandaloneValidatorRecord(ctx, record)
+	}
+
 	if state.PreCCV {
 		return []abci.ValidatorUpdate{}
 	}
 
-	// populate cross chain validators states with initial valset
-	k.ApplyCCValidatorChanges(ctx, state.Provider.InitialValSet)
-	return state.Provider.InitialValSet
+	// populate cross chain validators states with initial valset;
+	// note that the validators using the consensus keys of the recorded standalone validators are skipped
+	return k.ApplyCCValidatorChanges(ctx, state.Provider.InitialValSet)
 }
 
-// ExportGenesis returns the CCV consumer module's exported genesis
+// ExportGenesis returns the CCV consumer module's exported genesis.
+// The pending packets are exported in the order they are sent and the
+// outstanding downtimes in the order of the validator addresses.
 func (k Keeper) ExportGenesis(ctx sdk.Context) (genesis *types.GenesisState) {
 	params := k.GetConsumerParams(ctx)
 	if !params.Enabled {
@@ -201,11 +182,100 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) (genesis *types.GenesisState) {
 			valset,
 			k.GetAllHeightToValsetUpdateIDs(ctx),
 			pendingPacketsDepreciated,
-			nil,
+			k.GetAllOutstandingDowntimes(ctx),
 			types.LastTransmissionBlockHeight{},
 			params,
 		)
 	}
 
+	// export the slash record, so that the pending slash packets
+	// are not sent again before the provider replies
+	if record, found := k.GetSlashRecord(ctx); found {
+		genesis.SlashRecord = &record
+	}
+
+	// export the pending switch to a different provider chain
+	if providerSwitch, found := k.GetPendingProviderSwitch(ctx); found {
+		genesis.PendingProviderSwitch = &providerSwitch
+	}
+
+	// export the pending transition to a standalone chain
+	if transition, found := k.GetPendingStandaloneTransition(ctx); found {
+		genesis.PendingStandaloneTransition = &transition
+	}
+
+	// export the tombstoned and jailed validators of the previously standalone chain
+	if records := k.GetAllStandaloneValidatorRecords(ctx); len(records) != 0 {
+		genesis.StandaloneValidatorRecords = records
+	}
+
 	return genesis
 }
+
+// initProviderConnection initializes the state of a new connection to the provider chain described by
+// `provider`, i.e., it creates the provider client, or it uses the client of the connection with
+// `connectionId` if provided, in which case the CCV channel handshake is initiated as well
+func (k Keeper) initProviderConnection(ctx sdk.Context, provider ccv.ProviderInfo, connectionId string) error {
+	var clientID string
+	if connectionId == "" {
+		// create the provider client for new consumer chain. CCV Handshake must be established with this client id.
+		clientStateBytes, err := provider.ClientState.Marshal()
+		if err != nil {
+			return err
+		}
+		consensusStateBytes, err := provider.ConsensusState.Marshal()
+		if err != nil {
+			return err
+		}
+
+		// this means the client must be tendermint
+		cid, err := k.clientKeeper.CreateClient(ctx, ibchost.Tendermint, clientStateBytes, consensusStateBytes)
+		if err != nil {
+			return err
+		}
+		clientID = cid
+
+		k.Logger(ctx).Info("create new provider chain client",
+			"client id", clientID,
+		)
+	} else {
+		// if connection id is provided, then the client is already created
+		connectionEnd, found := k.connectionKeeper.GetConnection(ctx, connectionId)
+		if !found {
+			return errorsmod.Wrapf(conntypes.ErrConnectionNotFound, "could not find connection(%s)", connectionId)
+		}
+		clientID = connectionEnd.ClientId
+
+		k.Logger(ctx).Info("use existing client and connection to provider chain",
+			"client id", clientID,
+			"connection id", connectionId,
+		)
+	}
+
+	// set provider client id.
+	k.SetProviderClientID(ctx, clientID)
+
+	// set default value for valset update ID
+	k.SetHeightValsetUpdateID(ctx, uint64(ctx.BlockHeight()), uint64(0))
+
+	if connectionId != "" {
+		// initiate CCV channel handshake
+		ccvChannelOpenInitMsg := channeltypes.NewMsgChannelOpenInit(
+			ccv.ConsumerPortID,
+			ccv.Version,
+			channeltypes.ORDERED,
+			[]string{connectionId},
+			ccv.ProviderPortID,
+			"", // signer unused
+		)
+		if _, err := k.ChannelOpenInit(ctx, ccvChannelOpenInitMsg); err != nil {
+			return err
+		}
+
+		// Note that if the connection ID is not provider, we cannot initiate
+		// the connection handshake as the counterparty client ID is unknown
+		// at this point. The connection handshake must be initiated by a relayer.
+	}
+
+	return nil
+}
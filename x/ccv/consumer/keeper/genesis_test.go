@@ -370,3 +370,79 @@ func assertHeightValsetUpdateIDs(t *testing.T, ctx sdk.Context, ck *consumerkeep
 		ctr++
 	}
 }
+
+// TestExportImportGenesisRoundTrip tests that exporting the consumer state to genesis and
+// initializing a consumer chain from the exported genesis restores the pending packets,
+// the outstanding downtimes and the slash record, in a deterministic order
+func TestExportImportGenesisRoundTrip(t *testing.T) {
+	params := ccv.DefaultParams()
+	params.Enabled = true
+
+	// validators sorted in reverse order of their addresses
+	validators := []abci.Validator{}
+	for i := 3; i > 0; i-- {
+		validators = append(validators, abci.Validator{Address: crypto.NewCryptoIdentityFromIntSeed(i).SDKValConsAddress(), Power: int64(i)})
+	}
+
+	testCases := []struct {
+		name      string
+		channelID string
+	}{
+		{"restart during the CCV channel handshake", ""},
+		{"restart with an established CCV channel", "channel-0"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ck, ctx, ctrl, _ := testkeeper.GetConsumerKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+			defer ctrl.Finish()
+			ck.SetParams(ctx, params)
+			ck.SetProviderClientID(ctx, "07-tendermint-0")
+			if tc.channelID != "" {
+				ck.SetProviderChannel(ctx, tc.channelID)
+				ck.SetLastTransmissionBlockHeight(ctx, consumertypes.LastTransmissionBlockHeight{Height: 10})
+			}
+
+			pubKey := ed25519.GenPrivKey().PubKey()
+			cVal, err := consumertypes.NewCCValidator(pubKey.Address(), 1, pubKey)
+			require.NoError(t, err)
+			ck.SetCCValidator(ctx, cVal)
+			ck.SetHeightValsetUpdateID(ctx, 1, 1)
+
+			// queue slash packets interleaved with a VSC matured packet
+			ck.QueueSlashPacket(ctx, validators[0], 1, stakingtypes.Infraction_INFRACTION_DOWNTIME)
+			if tc.channelID != "" {
+				ck.AppendPendingPacket(ctx, ccv.VscMaturedPacket, &ccv.ConsumerPacketData_VscMaturedPacketData{
+					VscMaturedPacketData: ccv.NewVSCMaturedPacketData(1),
+				})
+			}
+			ck.QueueSlashPacket(ctx, validators[1], 1, stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN)
+			ck.QueueSlashPacket(ctx, validators[2], 1, stakingtypes.Infraction_INFRACTION_DOWNTIME)
+			slashRecord := consumertypes.NewSlashRecord(ctx.BlockTime().UTC(), true)
+			ck.SetSlashRecord(ctx, slashRecord)
+
+			exported := ck.ExportGenesis(ctx)
+			require.NoError(t, exported.Validate())
+			require.Equal(t, &slashRecord, exported.SlashRecord)
+			require.Len(t, exported.OutstandingDowntimeSlashing, 2)
+			require.Equal(t, ck.GetPendingPackets(ctx), exported.PendingConsumerPackets.List)
+
+			// initialize a new consumer chain from the exported genesis
+			ck2, ctx2, ctrl2, _ := testkeeper.GetConsumerKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+			defer ctrl2.Finish()
+			ck2.InitGenesis(ctx2, exported)
+
+			require.Equal(t, ck.GetPendingPackets(ctx), ck2.GetPendingPackets(ctx2))
+			require.Equal(t, ck.GetAllOutstandingDowntimes(ctx), ck2.GetAllOutstandingDowntimes(ctx2))
+			record, found := ck2.GetSlashRecord(ctx2)
+			require.True(t, found)
+			require.Equal(t, slashRecord, record)
+			for _, val := range validators {
+				require.Equal(t, ck.OutstandingDowntime(ctx, val.Address), ck2.OutstandingDowntime(ctx2, val.Address))
+			}
+
+			// exporting again results in the same genesis
+			require.Equal(t, exported, ck2.ExportGenesis(ctx2))
+		})
+	}
+}
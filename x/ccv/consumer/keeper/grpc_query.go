@@ -74,3 +74,199 @@ func (k Keeper) QueryThrottleState(c context.Context,
 	}
 	return &resp, nil
 }
+
+// QuerySlashRetryDelay returns the remaining delay until the bounced slash packet can be resent
+func (k Keeper) QuerySlashRetryDelay(c context.Context,
+	req *types.QuerySlashRetryDelayRequest,
+) (*types.QuerySlashRetryDelayResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	resp := types.QuerySlashRetryDelayResponse{}
+	if slashRecord, found := k.GetSlashRecord(ctx); found {
+		resp.SlashRecord = &slashRecord
+		resp.RemainingDelay = k.GetSlashRetryRemainingDelay(ctx, slashRecord)
+	}
+	return &resp, nil
+}
+
+// QuerySlashRecord returns the state of the slash packet at the head of the pending packets queue
+func (k Keeper) QuerySlashRecord(c context.Context, //nolint:golint
+	req *types.QuerySlashRecordRequest,
+) (*types.QuerySlashRecordResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	resp := &types.QuerySlashRecordResponse{}
+	record, found := k.GetSlashRecord(ctx)
+	if !found {
+		return resp, nil
+	}
+
+	resp.Found = true
+	resp.InFlight = record.WaitingOnReply
+	resp.SendTime = record.SendTime
+	resp.Bounces = record.Bounces()
+	if !record.WaitingOnReply {
+		retryTime := k.GetSlashRetryTime(ctx, record)
+		resp.NextRetryTime = &retryTime
+	}
+	if packet, found := k.GetHeadOfPendingPackets(ctx); found {
+		if slashPacket := packet.GetSlashPacketData(); slashPacket != nil {
+			resp.SlashPacket = slashPacket
+			resp.ValidatorConsAddress = sdk.ConsAddress(slashPacket.Validator.Address).String()
+		}
+	}
+
+	return resp, nil
+}
+
+// QueryNearTimeoutPackets returns the packets sent to the provider chain that are not yet acknowledged
+// and that time out within the given duration, including the packets that already timed out
+func (k Keeper) QueryNearTimeoutPackets(c context.Context,
+	req *types.QueryNearTimeoutPacketsRequest,
+) (*types.QueryNearTimeoutPacketsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	if req.Within < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid duration: %s", req.Within)
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// packets may still be in flight on the previous channel during a channel migration
+	channelIDs := []string{}
+	if channelID, found := k.GetPreviousProviderChannel(ctx); found {
+		channelIDs = append(channelIDs, channelID)
+	}
+	if channelID, found := k.GetProviderChannel(ctx); found {
+		channelIDs = append(channelIDs, channelID)
+	}
+
+	deadline := ctx.BlockTime().Add(req.Within)
+	packets := []types.PacketTimeout{}
+	for _, channelID := range channelIDs {
+		for _, packet := range k.GetAllPacketTimeouts(ctx, channelID) {
+			if packet.TimeoutTimestamp.After(deadline) {
+				continue
+			}
+			packets = append(packets, packet)
+		}
+	}
+
+	return &types.QueryNearTimeoutPacketsResponse{Packets: packets}, nil
+}
+
+// QueryProviderClientExpiry returns the time at which the client to the provider expires
+func (k Keeper) QueryProviderClientExpiry(c context.Context, //nolint:golint
+	req *types.QueryProviderClientExpiryRequest,
+) (*types.QueryProviderClientExpiryResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	clientID, found := k.GetProviderClientID(ctx)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "client to the provider not found")
+	}
+	expiry, found := k.GetProviderClientExpiry(ctx)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "provider client expiry not yet recorded")
+	}
+
+	return &types.QueryProviderClientExpiryResponse{
+		ClientId:        clientID,
+		ExpiryTime:      expiry,
+		TimeUntilExpiry: expiry.Sub(ctx.BlockTime()),
+	}, nil
+}
+
+// QueryProviderSwitch returns the identifiers of the active and previous provider chains
+// together with the pending switch to a different provider chain
+func (k Keeper) QueryProviderSwitch(c context.Context, //nolint:golint
+	req *types.QueryProviderSwitchRequest,
+) (*types.QueryProviderSwitchResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	resp := &types.QueryProviderSwitchResponse{
+		ActiveProviderId: k.GetActiveProviderId(ctx),
+	}
+	if previousProviderId, found := k.GetPreviousProviderId(ctx); found {
+		resp.PreviousProviderId = previousProviderId
+	}
+	if providerSwitch, found := k.GetPendingProviderSwitch(ctx); found {
+		resp.PendingSwitch = &providerSwitch
+	}
+
+	return resp, nil
+}
+
+// QueryPendingPacket returns the packet at the given position of the pending packets queue
+func (k Keeper) QueryPendingPacket(c context.Context, //nolint:golint
+	req *types.QueryPendingPacketRequest,
+) (*types.QueryPendingPacketResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	pendingPackets := k.GetPendingPackets(ctx)
+	if req.Index >= uint64(len(pendingPackets)) {
+		return nil, status.Errorf(codes.NotFound, "no pending packet at index %d; the queue has %d packets",
+			req.Index, len(pendingPackets))
+	}
+
+	resp := &types.QueryPendingPacketResponse{
+		NumPendingPackets: uint64(len(pendingPackets)),
+		Packet:            pendingPackets[req.Index],
+	}
+	if slashPacket := resp.Packet.GetSlashPacketData(); slashPacket != nil {
+		consAddr := sdk.ConsAddress(slashPacket.Validator.Address)
+		resp.ValidatorConsAddress = consAddr.String()
+		// the validators are known to the staking module of previously standalone chains
+		if k.standaloneStakingKeeper != nil {
+			if validator, err := k.standaloneStakingKeeper.GetValidatorByConsAddr(ctx, consAddr); err == nil {
+				resp.ValidatorMoniker = validator.GetMoniker()
+			}
+		}
+	}
+
+	return resp, nil
+}
+
+// QueryModuleVersionInfo returns the version of the consumer module and the features enabled on the consumer chain
+func (k Keeper) QueryModuleVersionInfo(c context.Context, //nolint:golint
+	req *types.QueryModuleVersionInfoRequest,
+) (*types.QueryModuleVersionInfoResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryModuleVersionInfoResponse{VersionInfo: k.GetModuleVersionInfo(ctx)}, nil
+}
+
+// QueryChangeoverRehearsal rehearses the standalone to consumer changeover with the given initial valset
+func (k Keeper) QueryChangeoverRehearsal(c context.Context, //nolint:golint
+	req *types.QueryChangeoverRehearsalRequest,
+) (*types.QueryChangeoverRehearsalResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	rehearsal, err := k.RehearseChangeover(ctx, req.InitialValSet)
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	return &types.QueryChangeoverRehearsalResponse{Rehearsal: rehearsal}, nil
+}
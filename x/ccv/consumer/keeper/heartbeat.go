@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/consumer/types"
+	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// TrackHeartbeatSigners records the validators whose votes are in the last commit, i.e., that signed
+// the previous block, so that they are reported to the provider in the next heartbeat
+func (k Keeper) TrackHeartbeatSigners(ctx sdk.Context) {
+	if k.IsStandaloneChain(ctx) {
+		return
+	}
+	store := k.providerStore(ctx)
+	for _, voteInfo := range ctx.VoteInfos() {
+		if voteInfo.BlockIdFlag != cmtproto.BlockIDFlagCommit {
+			continue
+		}
+		key := types.HeartbeatSignerKey(voteInfo.Validator.Address)
+		if !store.Has(key) {
+			store.Set(key, []byte{})
+		}
+	}
+}
+
+// GetHeartbeatSigners returns the consensus addresses of the validators
+// that signed at least one block since the last heartbeat
+func (k Keeper) GetHeartbeatSigners(ctx sdk.Context) [][]byte {
+	store := k.providerStore(ctx)
+	prefix := types.HeartbeatSignerKeyPrefix()
+	iterator := storetypes.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	signers := [][]byte{}
+	for ; iterator.Valid(); iterator.Next() {
+		signers = append(signers, iterator.Key()[len(prefix):])
+	}
+	return signers
+}
+
+// DeleteHeartbeatSigners deletes the validators that signed blocks since the last heartbeat
+func (k Keeper) DeleteHeartbeatSigners(ctx sdk.Context) {
+	store := k.providerStore(ctx)
+	for _, signer := range k.GetHeartbeatSigners(ctx) {
+		store.Delete(types.HeartbeatSignerKey(signer))
+	}
+}
+
+// GetLastHeartbeatHeight returns the height at which the last heartbeat was queued
+func (k Keeper) GetLastHeartbeatHeight(ctx sdk.Context) int64 {
+	store := k.providerStore(ctx)
+	bz := store.Get(types.LastHeartbeatHeightKey())
+	if bz == nil {
+		return 0
+	}
+	return int64(sdk.BigEndianToUint64(bz))
+}
+
+// SetLastHeartbeatHeight sets the height at which the last heartbeat was queued
+func (k Keeper) SetLastHeartbeatHeight(ctx sdk.Context, height int64) {
+	store := k.providerStore(ctx)
+	store.Set(types.LastHeartbeatHeightKey(), sdk.Uint64ToBigEndian(uint64(height)))
+}
+
+// QueueHeartbeat appends a ConsumerHeartbeat packet with the validators that signed at least one block
+// since the last heartbeat to the pending packets, once every BlocksPerDistributionTransmission blocks.
+// The provider uses the heartbeats to detect the assigned consumer keys that are not used by any running node.
+// It returns whether a heartbeat was queued.
+func (k Keeper) QueueHeartbeat(ctx sdk.Context) bool {
+	channelID, found := k.GetProviderChannel(ctx)
+	if !found || k.IsChannelClosed(ctx, channelID) || k.IsConsumerShutdownInitiated(ctx) {
+		return false
+	}
+
+	lastHeartbeatHeight := k.GetLastHeartbeatHeight(ctx)
+	if lastHeartbeatHeight == 0 {
+		// the first heartbeat period starts once the CCV channel is established
+		k.SetLastHeartbeatHeight(ctx, ctx.BlockHeight())
+		return false
+	}
+	if ctx.BlockHeight()-lastHeartbeatHeight < k.GetBlocksPerDistributionTransmission(ctx) {
+		return false
+	}
+
+	signers := k.GetHeartbeatSigners(ctx)
+	k.SetLastHeartbeatHeight(ctx, ctx.BlockHeight())
+	if len(signers) == 0 {
+		return false
+	}
+	k.AppendPendingPacket(ctx,
+		ccv.ConsumerHeartbeatPacket,
+		&ccv.ConsumerPacketData_ConsumerHeartbeatPacketData{
+			ConsumerHeartbeatPacketData: ccv.NewConsumerHeartbeatPacketData(signers),
+		},
+	)
+	k.DeleteHeartbeatSigners(ctx)
+
+	k.Logger(ctx).Debug("consumer heartbeat queued", "signers", len(signers))
+	return true
+}
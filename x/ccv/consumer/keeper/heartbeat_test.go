@@ -0,0 +1,69 @@
+package keeper_test
+
+import (
+	"testing"
+
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	cryptotestutil "github.com/cosmos/interchain-security/v7/testutil/crypto"
+	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
+	"github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// TestQueueHeartbeat tests that the validators that signed blocks since the last heartbeat
+// are reported to the provider once every BlocksPerDistributionTransmission blocks
+func TestQueueHeartbeat(t *testing.T) {
+	consumerKeeper, ctx, ctrl, mocks := testkeeper.GetConsumerKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	params := types.DefaultParams()
+	params.BlocksPerDistributionTransmission = 10
+	consumerKeeper.SetParams(ctx, params)
+
+	// no heartbeat is queued before the CCV channel is established
+	ctx = ctx.WithBlockHeight(5)
+	require.False(t, consumerKeeper.QueueHeartbeat(ctx))
+	require.Zero(t, consumerKeeper.GetLastHeartbeatHeight(ctx))
+
+	consumerKeeper.SetProviderChannel(ctx, "consumerCCVChannelID")
+	mocks.MockChannelKeeper.EXPECT().GetChannel(gomock.Any(), types.ConsumerPortID, "consumerCCVChannelID").
+		Return(channeltypes.Channel{State: channeltypes.OPEN}, true).AnyTimes()
+
+	// the first heartbeat period starts once the CCV channel is established
+	require.False(t, consumerKeeper.QueueHeartbeat(ctx))
+	require.Equal(t, int64(5), consumerKeeper.GetLastHeartbeatHeight(ctx))
+
+	// only the validators whose votes are committed are tracked
+	signer := cryptotestutil.NewCryptoIdentityFromIntSeed(0).SDKValConsAddress()
+	absent := cryptotestutil.NewCryptoIdentityFromIntSeed(1).SDKValConsAddress()
+	ctx = ctx.WithVoteInfos([]abci.VoteInfo{
+		{Validator: abci.Validator{Address: signer, Power: 1}, BlockIdFlag: cmtproto.BlockIDFlagCommit},
+		{Validator: abci.Validator{Address: absent, Power: 1}, BlockIdFlag: cmtproto.BlockIDFlagAbsent},
+	})
+	consumerKeeper.TrackHeartbeatSigners(ctx)
+	consumerKeeper.TrackHeartbeatSigners(ctx)
+	require.Equal(t, [][]byte{signer.Bytes()}, consumerKeeper.GetHeartbeatSigners(ctx))
+
+	// the heartbeat is queued once the period elapsed
+	ctx = ctx.WithBlockHeight(14)
+	require.False(t, consumerKeeper.QueueHeartbeat(ctx))
+	ctx = ctx.WithBlockHeight(15)
+	require.True(t, consumerKeeper.QueueHeartbeat(ctx))
+	require.Equal(t, int64(15), consumerKeeper.GetLastHeartbeatHeight(ctx))
+	require.Empty(t, consumerKeeper.GetHeartbeatSigners(ctx))
+
+	pendingPackets := consumerKeeper.GetPendingPackets(ctx)
+	require.Len(t, pendingPackets, 1)
+	require.Equal(t, types.ConsumerHeartbeatPacket, pendingPackets[0].Type)
+	require.Equal(t, [][]byte{signer.Bytes()}, pendingPackets[0].GetConsumerHeartbeatPacketData().ValidatorAddresses)
+
+	// no heartbeat is queued if no validator signed a block
+	ctx = ctx.WithBlockHeight(25)
+	require.False(t, consumerKeeper.QueueHeartbeat(ctx))
+	require.Equal(t, int64(25), consumerKeeper.GetLastHeartbeatHeight(ctx))
+	require.Len(t, consumerKeeper.GetPendingPackets(ctx), 1)
+}
@@ -16,6 +16,7 @@ import (
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
@@ -50,11 +51,23 @@ type Keeper struct {
 
 	validatorAddressCodec addresscodec.Codec
 	consensusAddressCodec addresscodec.Codec
+
+	subsystemLoggers *ccv.SubsystemLoggers
+
+	// set with the constructor options, see options.go
+	feeSplitter     FeeSplitter
+	packetSender    ccv.PacketSender
+	logger          log.Logger
+	features        map[string]bool
+	rateLimitKeeper ccv.RateLimitKeeper
+
+	standaloneTransitionHandler StandaloneTransitionHandler
 }
 
 // NewKeeper creates a new Consumer Keeper instance
 // NOTE: the feeCollectorName is in reference to the consumer-chain fee
 // collector (and not the provider chain)
+// The options customize the keeper, e.g., WithFeeSplitter or WithPacketSender.
 func NewKeeper(
 	cdc codec.BinaryCodec, key storetypes.StoreKey,
 	channelKeeper ccv.ChannelKeeper,
@@ -63,6 +76,7 @@ func NewKeeper(
 	ibcTransferKeeper ccv.IBCTransferKeeper, ibcCoreKeeper ccv.IBCCoreKeeper,
 	feeCollectorName, authority string, validatorAddressCodec,
 	consensusAddressCodec addresscodec.Codec,
+	opts ...Option,
 ) Keeper {
 	k := Keeper{
 		authority:               authority,
@@ -80,6 +94,12 @@ func NewKeeper(
 		standaloneStakingKeeper: nil,
 		validatorAddressCodec:   validatorAddressCodec,
 		consensusAddressCodec:   consensusAddressCodec,
+		subsystemLoggers:        ccv.NewSubsystemLoggers(ccv.DefaultLogConfig()),
+		feeSplitter:             DefaultFeeSplitter,
+		packetSender:            ccv.SendIBCPacket,
+	}
+	for _, opt := range opts {
+		opt(&k)
 	}
 
 	k.mustValidateFields()
@@ -110,13 +130,14 @@ func (k *Keeper) SetStandaloneStakingKeeper(sk ccv.StakingKeeper) {
 // non-nil values for all its fields. Otherwise this method will panic.
 func (k Keeper) mustValidateFields() {
 	// Ensures no fields are missed in this validation
-	if reflect.ValueOf(k).NumField() != 16 {
-		panic("number of fields in consumer keeper is not 16")
+	if reflect.ValueOf(k).NumField() != 23 {
+		panic("number of fields in consumer keeper is not 23")
 	}
 
-	// Note 116 / 16 fields will be validated,
+	// Note 17 / 23 fields will be validated,
 	// hooks are explicitly set after the constructor,
 	// stakingKeeper is optionally set after the constructor,
+	// logger, features, rateLimitKeeper and standaloneTransitionHandler are optionally set with the constructor options
 
 	ccv.PanicIfZeroOrNil(k.storeKey, "storeKey")                           // 1
 	ccv.PanicIfZeroOrNil(k.cdc, "cdc")                                     // 2
@@ -132,6 +153,13 @@ func (k Keeper) mustValidateFields() {
 	ccv.PanicIfZeroOrNil(k.authority, "authority")                         // 14
 	ccv.PanicIfZeroOrNil(k.validatorAddressCodec, "validatorAddressCodec") // 15
 	ccv.PanicIfZeroOrNil(k.consensusAddressCodec, "consensusAddressCodec") // 16
+	ccv.PanicIfZeroOrNil(k.subsystemLoggers, "subsystemLoggers")           // 17
+	ccv.PanicIfZeroOrNil(k.feeSplitter, "feeSplitter")                     // 18
+	ccv.PanicIfZeroOrNil(k.packetSender, "packetSender")                   // 19
+
+	if err := ccv.ValidateAddressCodecs(k.validatorAddressCodec, k.consensusAddressCodec); err != nil {
+		panic(fmt.Sprintf("invalid address codecs: %s", err))
+	}
 }
 
 // ValidatorAddressCodec returns the app validator address codec.
@@ -146,7 +174,26 @@ func (k Keeper) ConsensusAddressCodec() addresscodec.Codec {
 
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
-	return ctx.Logger().With("module", "x/"+host.SubModuleName+"-"+types.ModuleName)
+	logger := ctx.Logger()
+	if k.logger != nil {
+		logger = k.logger
+	}
+	return logger.With("module", "x/"+host.SubModuleName+"-"+types.ModuleName)
+}
+
+// SubsystemLogger returns a module-specific logger for the logs of a high-frequency
+// subsystem, filtered and sampled according to the CCV logging config
+func (k Keeper) SubsystemLogger(ctx sdk.Context, subsystem ccv.LogSubsystem) log.Logger {
+	return k.subsystemLoggers.Logger(k.Logger(ctx), subsystem)
+}
+
+// SetLogConfig sets the CCV logging config, e.g., from the app options
+func (k *Keeper) SetLogConfig(config ccv.LogConfig) {
+	if k.subsystemLoggers == nil {
+		k.subsystemLoggers = ccv.NewSubsystemLoggers(config)
+		return
+	}
+	k.subsystemLoggers.SetConfig(config)
 }
 
 func (k *Keeper) SetHooks(sh ccv.ConsumerHooks) *Keeper {
@@ -182,13 +229,13 @@ func (k Keeper) SetPort(ctx sdk.Context, portID string) {
 // SetProviderClientID sets the clientID for the client to the provider.
 // Set in InitGenesis
 func (k Keeper) SetProviderClientID(ctx sdk.Context, clientID string) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.providerStore(ctx)
 	store.Set(types.ProviderClientIDKey(), []byte(clientID))
 }
 
 // GetProviderClientID gets the clientID for the client to the provider.
 func (k Keeper) GetProviderClientID(ctx sdk.Context) (string, bool) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.providerStore(ctx)
 	clientIdBytes := store.Get(types.ProviderClientIDKey())
 	if clientIdBytes == nil {
 		return "", false
@@ -198,13 +245,13 @@ func (k Keeper) GetProviderClientID(ctx sdk.Context) (string, bool) {
 
 // SetProviderChannel sets the channelID for the channel to the provider.
 func (k Keeper) SetProviderChannel(ctx sdk.Context, channelID string) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.providerStore(ctx)
 	store.Set(types.ProviderChannelIDKey(), []byte(channelID))
 }
 
 // GetProviderChannel gets the channelID for the channel to the provider.
 func (k Keeper) GetProviderChannel(ctx sdk.Context) (string, bool) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.providerStore(ctx)
 	channelIdBytes := store.Get(types.ProviderChannelIDKey())
 	if len(channelIdBytes) == 0 {
 		return "", false
@@ -214,13 +261,13 @@ func (k Keeper) GetProviderChannel(ctx sdk.Context) (string, bool) {
 
 // DeleteProviderChannel deletes the channelID for the channel to the provider.
 func (k Keeper) DeleteProviderChannel(ctx sdk.Context) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.providerStore(ctx)
 	store.Delete(types.ProviderChannelIDKey())
 }
 
 // SetPendingChanges sets the pending validator set change packet that haven't been flushed to ABCI
 func (k Keeper) SetPendingChanges(ctx sdk.Context, updates ccv.ValidatorSetChangePacketData) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.providerStore(ctx)
 	bz, err := updates.Marshal()
 	if err != nil {
 		// This should never happen
@@ -231,7 +278,7 @@ func (k Keeper) SetPendingChanges(ctx sdk.Context, updates ccv.ValidatorSetChang
 
 // GetPendingChanges gets the pending changes that haven't been flushed over ABCI
 func (k Keeper) GetPendingChanges(ctx sdk.Context) (*ccv.ValidatorSetChangePacketData, bool) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.providerStore(ctx)
 	bz := store.Get(types.PendingChangesKey())
 	if bz == nil {
 		return nil, false
@@ -247,12 +294,40 @@ func (k Keeper) GetPendingChanges(ctx sdk.Context) (*ccv.ValidatorSetChangePacke
 
 // DeletePendingChanges deletes the pending changes after they've been flushed to ABCI
 func (k Keeper) DeletePendingChanges(ctx sdk.Context) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.providerStore(ctx)
 	store.Delete(types.PendingChangesKey())
 }
 
+// DequeuePendingChanges returns the pending validator updates to be sent to the consensus engine
+// in the current block and removes them from the pending changes. If MaxValidatorUpdatesPerBlock
+// is set, at most MaxValidatorUpdatesPerBlock updates are returned and the rest are kept for the
+// following blocks. Note that the pending changes are sorted in decreasing order of power
+// (see ValsetDiff), i.e., the removals of validators are dequeued last.
+func (k Keeper) DequeuePendingChanges(ctx sdk.Context) ([]tmtypes.ValidatorUpdate, bool) {
+	data, ok := k.GetPendingChanges(ctx)
+	if !ok {
+		return nil, false
+	}
+
+	updates := data.GetValsetDiff().Updates()
+	maxUpdates := k.GetMaxValidatorUpdatesPerBlock(ctx)
+	if maxUpdates == 0 || uint64(len(updates)) <= maxUpdates {
+		k.DeletePendingChanges(ctx)
+		return updates, true
+	}
+
+	k.SetPendingChanges(ctx, ccv.ValidatorSetChangePacketData{
+		ValidatorUpdates: updates[maxUpdates:],
+	})
+	k.SubsystemLogger(ctx, ccv.LogSubsystemVSC).Info("deferring validator updates to the following blocks",
+		"len updates", maxUpdates,
+		"len deferred updates", uint64(len(updates))-maxUpdates,
+	)
+	return updates[:maxUpdates], true
+}
+
 func (k Keeper) GetInitGenesisHeight(ctx sdk.Context) int64 {
-	store := ctx.KVStore(k.storeKey)
+	store := k.providerStore(ctx)
 	bz := store.Get(types.InitGenesisHeightKey())
 	if bz == nil {
 		panic("last standalone height not set")
@@ -263,7 +338,7 @@ func (k Keeper) GetInitGenesisHeight(ctx sdk.Context) int64 {
 
 func (k Keeper) SetInitGenesisHeight(ctx sdk.Context, height int64) {
 	bz := sdk.Uint64ToBigEndian(uint64(height))
-	store := ctx.KVStore(k.storeKey)
+	store := k.providerStore(ctx)
 	store.Set(types.InitGenesisHeightKey(), bz)
 }
 
@@ -335,27 +410,39 @@ func (k Keeper) VerifyProviderChain(ctx sdk.Context, connectionHops []string) er
 	return nil
 }
 
-// SetHeightValsetUpdateID sets the valset update id for a given block height
+// SetHeightValsetUpdateID sets the valset update id for a given block height.
+// The valset update id applies to all the following heights until the next height with a set valset update id,
+// i.e., the mapping is stored only for the heights where the valset update id changes.
 func (k Keeper) SetHeightValsetUpdateID(ctx sdk.Context, height, valsetUpdateId uint64) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.providerStore(ctx)
 	valBytes := make([]byte, 8)
 	binary.BigEndian.PutUint64(valBytes, valsetUpdateId)
 	store.Set(types.HeightValsetUpdateIDKey(height), valBytes)
 }
 
-// GetHeightValsetUpdateID gets the valset update id recorded for a given block height
+// GetHeightValsetUpdateID gets the valset update id for a given block height,
+// i.e., the valset update id set for the greatest height lower than or equal to the given height.
+// If no valset update id is set for such heights, it returns 0.
 func (k Keeper) GetHeightValsetUpdateID(ctx sdk.Context, height uint64) uint64 {
-	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(types.HeightValsetUpdateIDKey(height))
-	if bz == nil {
+	store := k.providerStore(ctx)
+	iterator := store.ReverseIterator(types.HeightValsetUpdateIDKeyPrefix(), types.HeightValsetUpdateIDKey(height+1))
+	defer iterator.Close()
+
+	if !iterator.Valid() {
 		return 0
 	}
-	return binary.BigEndian.Uint64(bz)
+	return binary.BigEndian.Uint64(iterator.Value())
+}
+
+// GetLastReceivedValsetUpdateID returns the valset update id of the last received VSC packet,
+// i.e., the valset update id mapped to the next block height
+func (k Keeper) GetLastReceivedValsetUpdateID(ctx sdk.Context) uint64 {
+	return k.GetHeightValsetUpdateID(ctx, uint64(ctx.BlockHeight())+1)
 }
 
 // DeleteHeightValsetUpdateID deletes the valset update id for a given block height
 func (k Keeper) DeleteHeightValsetUpdateID(ctx sdk.Context, height uint64) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.providerStore(ctx)
 	store.Delete(types.HeightValsetUpdateIDKey(height))
 }
 
@@ -365,7 +452,7 @@ func (k Keeper) DeleteHeightValsetUpdateID(ctx sdk.Context, height uint64) {
 // HeightValsetUpdateIDKeyPrefix | height
 // Thus, the returned array is in ascending order of heights.
 func (k Keeper) GetAllHeightToValsetUpdateIDs(ctx sdk.Context) (heightToValsetUpdateIDs []types.HeightToValsetUpdateID) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.providerStore(ctx)
 	iterator := storetypes.KVStorePrefixIterator(store, types.HeightValsetUpdateIDKeyPrefix())
 
 	defer iterator.Close()
@@ -384,20 +471,20 @@ func (k Keeper) GetAllHeightToValsetUpdateIDs(ctx sdk.Context) (heightToValsetUp
 
 // OutstandingDowntime returns the outstanding downtime flag for a given validator
 func (k Keeper) OutstandingDowntime(ctx sdk.Context, address sdk.ConsAddress) bool {
-	store := ctx.KVStore(k.storeKey)
+	store := k.providerStore(ctx)
 	bz := store.Get(types.OutstandingDowntimeKey(address))
 	return bz != nil
 }
 
 // SetOutstandingDowntime sets the outstanding downtime flag for a given validator
 func (k Keeper) SetOutstandingDowntime(ctx sdk.Context, address sdk.ConsAddress) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.providerStore(ctx)
 	store.Set(types.OutstandingDowntimeKey(address), []byte{})
 }
 
 // DeleteOutstandingDowntime deletes the outstanding downtime flag for the given validator consensus address
 func (k Keeper) DeleteOutstandingDowntime(ctx sdk.Context, address sdk.ConsAddress) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.providerStore(ctx)
 	store.Delete(types.OutstandingDowntimeKey(address))
 }
 
@@ -407,13 +494,16 @@ func (k Keeper) DeleteOutstandingDowntime(ctx sdk.Context, address sdk.ConsAddre
 // OutstandingDowntimeKeyPrefix | consAddress
 // Thus, the returned array is in ascending order of consAddresses.
 func (k Keeper) GetAllOutstandingDowntimes(ctx sdk.Context) (downtimes []types.OutstandingDowntime) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.providerStore(ctx)
 	iterator := storetypes.KVStorePrefixIterator(store, types.OutstandingDowntimeKeyPrefix())
 
 	defer iterator.Close()
 	for ; iterator.Valid(); iterator.Next() {
 		addrBytes := iterator.Key()[1:]
-		addr := sdk.ConsAddress(addrBytes).String()
+		addr, err := k.ConsensusAddressCodec().BytesToString(addrBytes)
+		if err != nil {
+			panic(fmt.Errorf("failed to encode consensus address: %w", err))
+		}
 
 		downtimes = append(downtimes, types.OutstandingDowntime{
 			ValidatorConsensusAddress: addr,
@@ -425,7 +515,7 @@ func (k Keeper) GetAllOutstandingDowntimes(ctx sdk.Context) (downtimes []types.O
 
 // SetCCValidator sets a cross-chain validator under its validator address
 func (k Keeper) SetCCValidator(ctx sdk.Context, v types.CrossChainValidator) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.providerStore(ctx)
 	bz := k.cdc.MustMarshal(&v)
 
 	store.Set(types.CrossChainValidatorKey(v.Address), bz)
@@ -433,7 +523,7 @@ func (k Keeper) SetCCValidator(ctx sdk.Context, v types.CrossChainValidator) {
 
 // GetCCValidator returns a cross-chain validator for a given address
 func (k Keeper) GetCCValidator(ctx sdk.Context, addr []byte) (validator types.CrossChainValidator, found bool) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.providerStore(ctx)
 	v := store.Get(types.CrossChainValidatorKey(addr))
 	if v == nil {
 		return
@@ -446,7 +536,7 @@ func (k Keeper) GetCCValidator(ctx sdk.Context, addr []byte) (validator types.Cr
 
 // DeleteCCValidator deletes a cross-chain validator for a given address
 func (k Keeper) DeleteCCValidator(ctx sdk.Context, addr []byte) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.providerStore(ctx)
 	store.Delete(types.CrossChainValidatorKey(addr))
 }
 
@@ -456,7 +546,7 @@ func (k Keeper) DeleteCCValidator(ctx sdk.Context, addr []byte) {
 // CrossChainValidatorKeyPrefix | address
 // Thus, the returned array is in ascending order of addresses.
 func (k Keeper) GetAllCCValidator(ctx sdk.Context) (validators []types.CrossChainValidator) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.providerStore(ctx)
 	iterator := storetypes.KVStorePrefixIterator(store, types.CrossChainValidatorKeyPrefix())
 
 	defer iterator.Close()
@@ -472,7 +562,7 @@ func (k Keeper) GetAllCCValidator(ctx sdk.Context) (validators []types.CrossChai
 // getAndIncrementPendingPacketsIdx returns the current pending packets index and increments it.
 // This index is used for implementing a FIFO queue of pending packets in the KV store.
 func (k Keeper) getAndIncrementPendingPacketsIdx(ctx sdk.Context) (toReturn uint64) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.providerStore(ctx)
 	bz := store.Get(types.PendingPacketsIndexKey())
 	if bz != nil {
 		toReturn = sdk.BigEndianToUint64(bz)
@@ -484,7 +574,7 @@ func (k Keeper) getAndIncrementPendingPacketsIdx(ctx sdk.Context) (toReturn uint
 
 // DeleteHeadOfPendingPackets deletes the head of the pending packets queue.
 func (k Keeper) DeleteHeadOfPendingPackets(ctx sdk.Context) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.providerStore(ctx)
 	iterator := storetypes.KVStorePrefixIterator(store, types.PendingDataPacketsV1KeyPrefix())
 	defer iterator.Close()
 	if !iterator.Valid() {
@@ -493,6 +583,21 @@ func (k Keeper) DeleteHeadOfPendingPackets(ctx sdk.Context) {
 	store.Delete(iterator.Key())
 }
 
+// GetHeadOfPendingPackets returns the packet at the head of the pending packets queue
+func (k Keeper) GetHeadOfPendingPackets(ctx sdk.Context) (packet ccv.ConsumerPacketData, found bool) {
+	store := k.providerStore(ctx)
+	iterator := storetypes.KVStorePrefixIterator(store, types.PendingDataPacketsV1KeyPrefix())
+	defer iterator.Close()
+	if !iterator.Valid() {
+		return packet, false
+	}
+	if err := packet.Unmarshal(iterator.Value()); err != nil {
+		// An error here would indicate something is very wrong,
+		panic(fmt.Errorf("failed to unmarshal pending data packet: %w", err))
+	}
+	return packet, true
+}
+
 // GetPendingPackets returns ALL the pending CCV packets from the store without indexes.
 func (k Keeper) GetPendingPackets(ctx sdk.Context) []ccv.ConsumerPacketData {
 	ppWithIndexes := k.GetAllPendingPacketsWithIdx(ctx)
@@ -516,7 +621,7 @@ type ConsumerPacketDataWithIdx struct {
 // with indexes relevant to the pending packets queue.
 func (k Keeper) GetAllPendingPacketsWithIdx(ctx sdk.Context) []ConsumerPacketDataWithIdx {
 	packets := []ConsumerPacketDataWithIdx{}
-	store := ctx.KVStore(k.storeKey)
+	store := k.providerStore(ctx)
 	iterator := storetypes.KVStorePrefixIterator(store, types.PendingDataPacketsV1KeyPrefix())
 	defer iterator.Close()
 	for ; iterator.Valid(); iterator.Next() {
@@ -539,14 +644,17 @@ func (k Keeper) GetAllPendingPacketsWithIdx(ctx sdk.Context) []ConsumerPacketDat
 
 // DeletePendingDataPackets deletes pending data packets with given indexes
 func (k Keeper) DeletePendingDataPackets(ctx sdk.Context, idxs ...uint64) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.providerStore(ctx)
 	for _, idx := range idxs {
 		store.Delete(types.PendingDataPacketsV1Key(idx))
 	}
+	if len(idxs) > 0 {
+		telemetry.IncrCounter(float32(len(idxs)), types.MetricNamespace, types.MetricPendingPacketsDeleted)
+	}
 }
 
 func (k Keeper) DeleteAllPendingDataPackets(ctx sdk.Context) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.providerStore(ctx)
 	iterator := storetypes.KVStorePrefixIterator(store, types.PendingDataPacketsV1KeyPrefix())
 	keysToDel := [][]byte{}
 	defer iterator.Close()
@@ -562,7 +670,7 @@ func (k Keeper) DeleteAllPendingDataPackets(ctx sdk.Context) {
 func (k Keeper) AppendPendingPacket(ctx sdk.Context, packetType ccv.ConsumerPacketDataType, data ccv.ExportedIsConsumerPacketData_Data) {
 	idx := k.getAndIncrementPendingPacketsIdx(ctx) // for FIFO queue
 	key := types.PendingDataPacketsV1Key(idx)
-	store := ctx.KVStore(k.storeKey)
+	store := k.providerStore(ctx)
 	cpd := ccv.NewConsumerPacketData(packetType, data)
 	bz, err := cpd.Marshal()
 	if err != nil {
@@ -570,6 +678,7 @@ func (k Keeper) AppendPendingPacket(ctx sdk.Context, packetType ccv.ConsumerPack
 		panic(fmt.Errorf("failed to marshal ConsumerPacketData: %w", err))
 	}
 	store.Set(key, bz)
+	telemetry.IncrCounter(1, types.MetricNamespace, types.MetricPacketsQueued, types.PacketTypeMetricKey(packetType))
 }
 
 func (k Keeper) MarkAsPrevStandaloneChain(ctx sdk.Context) {
@@ -9,6 +9,7 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/cosmos/cosmos-sdk/codec/address"
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -56,7 +57,7 @@ func TestPendingChanges(t *testing.T) {
 	require.NoError(t, err)
 
 	pd := ccv.NewValidatorSetChangePacketData(
-		[]abci.ValidatorUpdate{
+		ccv.NewValsetDiff([]abci.ValidatorUpdate{
 			{
 				PubKey: pk1,
 				Power:  30,
@@ -65,7 +66,7 @@ func TestPendingChanges(t *testing.T) {
 				PubKey: pk2,
 				Power:  20,
 			},
-		},
+		}...),
 		1,
 		nil,
 	)
@@ -83,6 +84,53 @@ func TestPendingChanges(t *testing.T) {
 	require.Nil(t, gotPd, "got non-nil pending changes after Delete")
 }
 
+// TestDequeuePendingChanges tests that at most MaxValidatorUpdatesPerBlock pending validator updates
+// are dequeued at once and that the rest are kept for the following blocks
+func TestDequeuePendingChanges(t *testing.T) {
+	updates := []abci.ValidatorUpdate{}
+	for _, power := range []int64{30, 20, 10, 0} {
+		pk, err := cryptocodec.ToCmtProtoPublicKey(ed25519.GenPrivKey().PubKey())
+		require.NoError(t, err)
+		updates = append(updates, abci.ValidatorUpdate{PubKey: pk, Power: power})
+	}
+
+	consumerKeeper, ctx, ctrl, _ := testkeeper.GetConsumerKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	params := ccv.DefaultParams()
+	consumerKeeper.SetParams(ctx, params)
+
+	// no pending changes
+	_, ok := consumerKeeper.DequeuePendingChanges(ctx)
+	require.False(t, ok)
+
+	// no limit, all the updates are dequeued
+	consumerKeeper.SetPendingChanges(ctx, ccv.ValidatorSetChangePacketData{ValidatorUpdates: updates})
+	dequeued, ok := consumerKeeper.DequeuePendingChanges(ctx)
+	require.True(t, ok)
+	require.Equal(t, updates, dequeued)
+	_, ok = consumerKeeper.GetPendingChanges(ctx)
+	require.False(t, ok)
+
+	// at most 3 updates per block
+	params.MaxValidatorUpdatesPerBlock = 3
+	consumerKeeper.SetParams(ctx, params)
+	consumerKeeper.SetPendingChanges(ctx, ccv.ValidatorSetChangePacketData{ValidatorUpdates: updates})
+	dequeued, ok = consumerKeeper.DequeuePendingChanges(ctx)
+	require.True(t, ok)
+	require.Equal(t, updates[:3], dequeued)
+	pending, ok := consumerKeeper.GetPendingChanges(ctx)
+	require.True(t, ok)
+	require.Equal(t, updates[3:], pending.ValidatorUpdates)
+
+	// the remaining update is dequeued in the following block
+	dequeued, ok = consumerKeeper.DequeuePendingChanges(ctx)
+	require.True(t, ok)
+	require.Equal(t, updates[3:], dequeued)
+	_, ok = consumerKeeper.GetPendingChanges(ctx)
+	require.False(t, ok)
+}
+
 // TestLastSovereignHeight tests the getter and setter for the ccv init genesis height
 func TestInitGenesisHeight(t *testing.T) {
 	consumerKeeper, ctx, ctrl, _ := testkeeper.GetConsumerKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
@@ -487,6 +535,31 @@ func TestGetAllHeightToValsetUpdateIDs(t *testing.T) {
 	require.Equal(t, expectedGetAllOrder, result)
 }
 
+// TestGetHeightValsetUpdateID tests that GetHeightValsetUpdateID returns the valset update ID
+// of the greatest height lower than or equal to the given height that has a mapping
+func TestGetHeightValsetUpdateID(t *testing.T) {
+	ck, ctx, ctrl, _ := testkeeper.GetConsumerKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	// no mappings
+	require.Equal(t, uint64(0), ck.GetHeightValsetUpdateID(ctx, 10))
+
+	ck.SetHeightValsetUpdateID(ctx, 10, 1)
+	ck.SetHeightValsetUpdateID(ctx, 15, 2)
+	ck.SetHeightValsetUpdateID(ctx, 20, 0)
+
+	expected := map[uint64]uint64{
+		0: 0, 9: 0, 10: 1, 14: 1, 15: 2, 19: 2, 20: 0, 100: 0,
+	}
+	for height, vscID := range expected {
+		require.Equal(t, vscID, ck.GetHeightValsetUpdateID(ctx, height), "height %d", height)
+	}
+
+	// the last received valset update ID is mapped to the next block height
+	ctx = ctx.WithBlockHeight(17)
+	require.Equal(t, uint64(2), ck.GetLastReceivedValsetUpdateID(ctx))
+}
+
 // TestGetAllOutstandingDowntimes tests GetAllOutstandingDowntimes behaviour correctness
 func TestGetAllOutstandingDowntimes(t *testing.T) {
 	ck, ctx, ctrl, _ := testkeeper.GetConsumerKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
@@ -517,6 +590,37 @@ func TestGetAllOutstandingDowntimes(t *testing.T) {
 	require.Equal(t, result, expectedGetAllOrder)
 }
 
+// TestKeeperWithCustomAddressPrefixes tests that the consumer keeper formats
+// consensus addresses with the address codec it is instantiated with
+func TestKeeperWithCustomAddressPrefixes(t *testing.T) {
+	consCodec := address.NewBech32Codec("neutronvalcons")
+
+	params := testkeeper.NewInMemKeeperParams(t)
+	params.ValidatorAddressCodec = address.NewBech32Codec("neutronvaloper")
+	params.ConsensusAddressCodec = consCodec
+	ck, ctx, ctrl, _ := testkeeper.GetConsumerKeeperAndCtx(t, params)
+	defer ctrl.Finish()
+
+	consAddr := sdk.ConsAddress([]byte("consAddress1"))
+	consAddrStr, err := consCodec.BytesToString(consAddr)
+	require.NoError(t, err)
+
+	ck.SetOutstandingDowntime(ctx, consAddr)
+	require.Equal(t, []types.OutstandingDowntime{{ValidatorConsensusAddress: consAddrStr}}, ck.GetAllOutstandingDowntimes(ctx))
+}
+
+// TestKeeperPanicsOnInvalidAddressCodecs tests that the consumer keeper cannot be instantiated
+// with address codecs that cannot tell validator and consensus addresses apart
+func TestKeeperPanicsOnInvalidAddressCodecs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mocks := testkeeper.NewMockedKeepers(ctrl)
+
+	params := testkeeper.NewInMemKeeperParams(t)
+	params.ConsensusAddressCodec = params.ValidatorAddressCodec
+	require.Panics(t, func() { testkeeper.NewInMemConsumerKeeper(params, mocks) })
+}
+
 func TestPrevStandaloneChainFlag(t *testing.T) {
 	ck, ctx, ctrl, _ := testkeeper.GetConsumerKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
 	defer ctrl.Finish()
@@ -555,3 +659,59 @@ func TestDeleteHeadOfPendingPackets(t *testing.T) {
 	require.Len(t, pp, 1)
 	require.Equal(t, pp[0].Type, ccv.VscMaturedPacket)
 }
+
+// TestQueryPendingPacket tests that the pending packets are returned by their position in the queue,
+// with the validator of a slash packet resolved
+func TestQueryPendingPacket(t *testing.T) {
+	consumerKeeper, ctx, ctrl, mocks := testkeeper.GetConsumerKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	_, err := consumerKeeper.QueryPendingPacket(ctx, nil)
+	require.Error(t, err)
+	_, err = consumerKeeper.QueryPendingPacket(ctx, &types.QueryPendingPacketRequest{Index: 0})
+	require.Error(t, err)
+
+	val := crypto.NewCryptoIdentityFromIntSeed(1)
+	slashPacketData := ccv.NewSlashPacketData(
+		abci.Validator{Address: val.SDKValConsAddress(), Power: 10},
+		5,
+		stakingtypes.Infraction_INFRACTION_DOWNTIME,
+	)
+	consumerKeeper.AppendPendingPacket(ctx, ccv.VscMaturedPacket, &ccv.ConsumerPacketData_VscMaturedPacketData{
+		VscMaturedPacketData: ccv.NewVSCMaturedPacketData(4),
+	})
+	consumerKeeper.AppendPendingPacket(ctx, ccv.SlashPacket, &ccv.ConsumerPacketData_SlashPacketData{
+		SlashPacketData: slashPacketData,
+	})
+	// the head of the queue is not the first appended packet once it is sent
+	consumerKeeper.DeleteHeadOfPendingPackets(ctx)
+	consumerKeeper.AppendPendingPacket(ctx, ccv.VscMaturedPacket, &ccv.ConsumerPacketData_VscMaturedPacketData{
+		VscMaturedPacketData: ccv.NewVSCMaturedPacketData(6),
+	})
+
+	res, err := consumerKeeper.QueryPendingPacket(ctx, &types.QueryPendingPacketRequest{Index: 0})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), res.NumPendingPackets)
+	require.Equal(t, ccv.SlashPacket, res.Packet.Type)
+	require.Equal(t, *slashPacketData, *res.Packet.GetSlashPacketData())
+	require.Equal(t, val.SDKValConsAddress().String(), res.ValidatorConsAddress)
+	require.Empty(t, res.ValidatorMoniker)
+
+	res, err = consumerKeeper.QueryPendingPacket(ctx, &types.QueryPendingPacketRequest{Index: 1})
+	require.NoError(t, err)
+	require.Equal(t, ccv.VscMaturedPacket, res.Packet.Type)
+	require.Equal(t, uint64(6), res.Packet.GetVscMaturedPacketData().ValsetUpdateId)
+	require.Empty(t, res.ValidatorConsAddress)
+
+	_, err = consumerKeeper.QueryPendingPacket(ctx, &types.QueryPendingPacketRequest{Index: 2})
+	require.Error(t, err)
+
+	// the moniker is resolved by the staking module of a previously standalone chain
+	consumerKeeper.SetStandaloneStakingKeeper(mocks.MockStakingKeeper)
+	stakingVal := val.SDKStakingValidator()
+	stakingVal.Description.Moniker = "validator"
+	mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(ctx, val.SDKValConsAddress()).Return(stakingVal, nil).Times(1)
+	res, err = consumerKeeper.QueryPendingPacket(ctx, &types.QueryPendingPacketRequest{Index: 0})
+	require.NoError(t, err)
+	require.Equal(t, "validator", res.ValidatorMoniker)
+}
@@ -7,6 +7,9 @@ import (
 	v2 "github.com/cosmos/interchain-security/v7/x/ccv/consumer/migrations/v2"
 	v3 "github.com/cosmos/interchain-security/v7/x/ccv/consumer/migrations/v3"
 	v4 "github.com/cosmos/interchain-security/v7/x/ccv/consumer/migrations/v4"
+	v5 "github.com/cosmos/interchain-security/v7/x/ccv/consumer/migrations/v5"
+	v6 "github.com/cosmos/interchain-security/v7/x/ccv/consumer/migrations/v6"
+	v7 "github.com/cosmos/interchain-security/v7/x/ccv/consumer/migrations/v7"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -41,3 +44,28 @@ func (m Migrator) Migrate3to4(ctx sdk.Context) error {
 
 	return nil
 }
+
+// Migrate4to5 migrates x/ccvconsumer from consensus version 4 to 5.
+// This migration compacts the block height to valset update ID mapping.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	store := ctx.KVStore(m.keeper.storeKey)
+	v5.CompactHeightValsetUpdateIDs(store)
+
+	return nil
+}
+
+// Migrate5to6 migrates x/ccvconsumer from consensus version 5 to 6.
+// This migration moves the state related to the provider chain to the namespace of the provider chain.
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	store := ctx.KVStore(m.keeper.storeKey)
+	v6.MigrateToProviderNamespace(store)
+
+	return nil
+}
+
+// Migrate6to7 migrates x/ccvconsumer from consensus version 6 to 7.
+// This migration initializes the ClientExpiryWarningThreshold param.
+func (m Migrator) Migrate6to7(ctx sdk.Context) error {
+	store := ctx.KVStore(m.keeper.storeKey)
+	return v7.MigrateClientExpiryWarningThreshold(m.keeper.cdc, store)
+}
@@ -0,0 +1,250 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	conntypes "github.com/cosmos/ibc-go/v10/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	ibctmtypes "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	cryptoenc "github.com/cometbft/cometbft/crypto/encoding"
+	cmtmath "github.com/cometbft/cometbft/libs/math"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/consumer/types"
+	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// GetConsumerClientIDOnProvider returns the ID of the client to the consumer chain on the provider chain,
+// i.e., the counterparty client of the connection underlying the CCV channel
+func (k Keeper) GetConsumerClientIDOnProvider(ctx sdk.Context, channelID string) (string, error) {
+	connectionHops, err := k.GetConnectionHops(ctx, ccv.ConsumerPortID, channelID)
+	if err != nil {
+		return "", err
+	}
+	if len(connectionHops) != 1 {
+		return "", errorsmod.Wrap(channeltypes.ErrTooManyConnectionHops, "must have direct connection to provider chain")
+	}
+	conn, found := k.connectionKeeper.GetConnection(ctx, connectionHops[0])
+	if !found {
+		return "", errorsmod.Wrapf(conntypes.ErrConnectionNotFound, "connection not found for connection ID: %s", connectionHops[0])
+	}
+	return conn.Counterparty.ClientId, nil
+}
+
+// ReportMisbehaviour reports a light client attack on the consumer chain proven by two conflicting headers
+// of the consumer chain. Both headers must be signed by more than a third of the voting power of the validator set
+// of the consumer chain at their trusted heights, and a misbehaviour is reported at most once per height.
+// It constructs the misbehaviour for the client to the consumer chain on the provider chain and stores it
+// until it is queued by QueueMisbehaviourReport, which sends at most one ConsumerMisbehaviour packet per block.
+// The Byzantine validators are punished only on the provider chain.
+func (k Keeper) ReportMisbehaviour(ctx sdk.Context, header1, header2 *ibctmtypes.Header) (*ibctmtypes.Misbehaviour, error) {
+	channelID, found := k.GetProviderChannel(ctx)
+	if !found || k.IsChannelClosed(ctx, channelID) {
+		return nil, errorsmod.Wrap(types.ErrNoProposerChannelId, "cannot report misbehaviour")
+	}
+	if k.IsConsumerShutdownInitiated(ctx) {
+		return nil, errorsmod.Wrap(types.ErrInvalidMisbehaviourReport, "consumer shutdown initiated")
+	}
+	if header1 == nil || header2 == nil || header1.Header == nil || header2.Header == nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidMisbehaviourReport, "headers cannot be nil")
+	}
+	if header1.Header.ChainID != ctx.ChainID() || header2.Header.ChainID != ctx.ChainID() {
+		return nil, errorsmod.Wrapf(types.ErrInvalidMisbehaviourReport,
+			"headers must be of the consumer chain %s, got %s and %s", ctx.ChainID(), header1.Header.ChainID, header2.Header.ChainID)
+	}
+
+	clientID, err := k.GetConsumerClientIDOnProvider(ctx, channelID)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidMisbehaviourReport, "cannot get the client to the consumer chain: %s", err.Error())
+	}
+
+	data := ccv.NewConsumerMisbehaviourPacketData(ibctmtypes.NewMisbehaviour(clientID, header1, header2))
+	if err := data.Validate(); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidMisbehaviourReport, err.Error())
+	}
+	misbehaviour := data.Misbehaviour
+
+	height := misbehaviour.Header1.GetHeight().GetRevisionHeight()
+	if k.IsMisbehaviourReported(ctx, height) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidMisbehaviourReport, "misbehaviour already reported at height %d", height)
+	}
+
+	for _, header := range []*ibctmtypes.Header{misbehaviour.Header1, misbehaviour.Header2} {
+		if err := k.VerifyMisbehaviourHeader(ctx, header); err != nil {
+			return nil, errorsmod.Wrapf(types.ErrInvalidMisbehaviourReport,
+				"cannot verify header at height %s: %s", header.GetHeight().String(), err.Error())
+		}
+	}
+
+	k.SetPendingMisbehaviourReport(ctx, height, *misbehaviour)
+	k.SetMisbehaviourReported(ctx, height)
+
+	k.Logger(ctx).Info("consumer misbehaviour reported",
+		"client", clientID,
+		"height1", header1.GetHeight().String(),
+		"height2", header2.GetHeight().String(),
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMisbehaviourReported,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(channeltypes.AttributeKeyChannelID, channelID),
+			sdk.NewAttribute(clienttypes.AttributeKeyClientID, clientID),
+			sdk.NewAttribute(ccv.AttributeMisbehaviourHeight1, header1.GetHeight().String()),
+			sdk.NewAttribute(ccv.AttributeMisbehaviourHeight2, header2.GetHeight().String()),
+		),
+	)
+
+	return misbehaviour, nil
+}
+
+// VerifyMisbehaviourHeader verifies that a header of the consumer chain is signed by more than a third of
+// the voting power of the validator set of the consumer chain at the trusted height of the header, i.e.,
+// the validator set that the client to the consumer chain on the provider chain trusts. The validator set
+// at the trusted height is given by the historical info of the consumer chain.
+func (k Keeper) VerifyMisbehaviourHeader(ctx sdk.Context, header *ibctmtypes.Header) error {
+	if err := header.ValidateBasic(); err != nil {
+		return err
+	}
+
+	trustedHeight := header.TrustedHeight.GetRevisionHeight()
+	historicalInfo, err := k.GetHistoricalInfo(ctx, int64(trustedHeight))
+	if err != nil {
+		return errorsmod.Wrapf(err, "cannot get the validator set at the trusted height %d", trustedHeight)
+	}
+	trustedValset, err := historicalValidatorSet(historicalInfo)
+	if err != nil {
+		return err
+	}
+
+	headerTrustedValset, err := cmttypes.ValidatorSetFromProto(header.TrustedValidators)
+	if err != nil {
+		return errorsmod.Wrap(err, "invalid trusted validator set")
+	}
+	if !bytes.Equal(trustedValset.Hash(), headerTrustedValset.Hash()) {
+		return fmt.Errorf("trusted validator set does not match the validator set at height %d", trustedHeight)
+	}
+
+	commit, err := cmttypes.CommitFromProto(header.Commit)
+	if err != nil {
+		return errorsmod.Wrap(err, "invalid commit")
+	}
+	return trustedValset.VerifyCommitLightTrusting(header.Header.ChainID, commit, cmtmath.Fraction{Numerator: 1, Denominator: 3})
+}
+
+// historicalValidatorSet returns the CometBFT validator set of the given historical info
+func historicalValidatorSet(historicalInfo stakingtypes.HistoricalInfo) (*cmttypes.ValidatorSet, error) {
+	validators := make([]*cmttypes.Validator, 0, len(historicalInfo.Valset))
+	for _, v := range historicalInfo.Valset {
+		protoPubKey, err := v.CmtConsPublicKey()
+		if err != nil {
+			return nil, err
+		}
+		pubKey, err := cryptoenc.PubKeyFromProto(protoPubKey)
+		if err != nil {
+			return nil, err
+		}
+		validators = append(validators, cmttypes.NewValidator(pubKey, v.ConsensusPower(sdk.DefaultPowerReduction)))
+	}
+	return cmttypes.ValidatorSetFromExistingValidators(validators)
+}
+
+// QueueMisbehaviourReport appends the pending misbehaviour report with the lowest height in a ConsumerMisbehaviour
+// packet to the pending packets, which are sent in EndBlock. As at most one report is queued per block,
+// the misbehaviour reports cannot delay the other packets, e.g., the slash packets.
+// It also prunes the heights of the reported misbehaviour that are older than the historical entries,
+// as a misbehaviour at these heights cannot be verified anymore.
+func (k Keeper) QueueMisbehaviourReport(ctx sdk.Context) {
+	store := k.providerStore(ctx)
+
+	pruneHeight := ctx.BlockHeight() - k.GetHistoricalEntries(ctx)
+	if pruneHeight > 0 {
+		iterator := storetypes.KVStorePrefixIterator(store, types.MisbehaviourReportHeightKeyPrefix())
+		keysToDel := [][]byte{}
+		for ; iterator.Valid(); iterator.Next() {
+			if sdk.BigEndianToUint64(iterator.Key()[1:]) > uint64(pruneHeight) {
+				break
+			}
+			keysToDel = append(keysToDel, iterator.Key())
+		}
+		iterator.Close()
+		for _, key := range keysToDel {
+			store.Delete(key)
+		}
+	}
+
+	if channelID, found := k.GetProviderChannel(ctx); !found || k.IsChannelClosed(ctx, channelID) {
+		return
+	}
+
+	height, misbehaviour, found := k.GetFirstPendingMisbehaviourReport(ctx)
+	if !found {
+		return
+	}
+	k.DeletePendingMisbehaviourReport(ctx, height)
+
+	k.AppendPendingPacket(ctx,
+		ccv.ConsumerMisbehaviourPacket,
+		&ccv.ConsumerPacketData_ConsumerMisbehaviourPacketData{
+			ConsumerMisbehaviourPacketData: ccv.NewConsumerMisbehaviourPacketData(&misbehaviour),
+		},
+	)
+}
+
+// SetPendingMisbehaviourReport stores a misbehaviour report that is not yet sent to the provider
+func (k Keeper) SetPendingMisbehaviourReport(ctx sdk.Context, height uint64, misbehaviour ibctmtypes.Misbehaviour) {
+	store := k.providerStore(ctx)
+	bz, err := misbehaviour.Marshal()
+	if err != nil {
+		// An error here would indicate something is very wrong,
+		// the misbehaviour is assumed to be correctly serialized.
+		panic(fmt.Errorf("failed to marshal misbehaviour: %w", err))
+	}
+	store.Set(types.PendingMisbehaviourReportKey(height), bz)
+}
+
+// GetFirstPendingMisbehaviourReport returns the pending misbehaviour report with the lowest height
+func (k Keeper) GetFirstPendingMisbehaviourReport(ctx sdk.Context) (uint64, ibctmtypes.Misbehaviour, bool) {
+	store := k.providerStore(ctx)
+	iterator := storetypes.KVStorePrefixIterator(store, types.PendingMisbehaviourReportKeyPrefix())
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return 0, ibctmtypes.Misbehaviour{}, false
+	}
+	var misbehaviour ibctmtypes.Misbehaviour
+	if err := misbehaviour.Unmarshal(iterator.Value()); err != nil {
+		// An error here would indicate something is very wrong,
+		// the misbehaviour is assumed to be correctly serialized in SetPendingMisbehaviourReport.
+		panic(fmt.Errorf("failed to unmarshal misbehaviour: %w", err))
+	}
+	return sdk.BigEndianToUint64(iterator.Key()[1:]), misbehaviour, true
+}
+
+// DeletePendingMisbehaviourReport deletes the pending misbehaviour report at the given height
+func (k Keeper) DeletePendingMisbehaviourReport(ctx sdk.Context, height uint64) {
+	store := k.providerStore(ctx)
+	store.Delete(types.PendingMisbehaviourReportKey(height))
+}
+
+// SetMisbehaviourReported records that a misbehaviour was reported at the given height
+func (k Keeper) SetMisbehaviourReported(ctx sdk.Context, height uint64) {
+	store := k.providerStore(ctx)
+	store.Set(types.MisbehaviourReportHeightKey(height), []byte{})
+}
+
+// IsMisbehaviourReported returns whether a misbehaviour was reported at the given height
+func (k Keeper) IsMisbehaviourReported(ctx sdk.Context, height uint64) bool {
+	store := k.providerStore(ctx)
+	return store.Has(types.MisbehaviourReportHeightKey(height))
+}
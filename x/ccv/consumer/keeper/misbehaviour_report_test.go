@@ -0,0 +1,134 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	conntypes "github.com/cosmos/ibc-go/v10/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	cryptotestutil "github.com/cosmos/interchain-security/v7/testutil/crypto"
+	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
+	consumertypes "github.com/cosmos/interchain-security/v7/x/ccv/consumer/types"
+	"github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// TestReportMisbehaviour tests that a reported light client attack on the consumer chain is verified
+// against the validator set at the trusted height and sent to the provider chain in a ConsumerMisbehaviour packet
+func TestReportMisbehaviour(t *testing.T) {
+	consumerKeeper, ctx, ctrl, mocks := testkeeper.GetConsumerKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	chainID := "consumer-1"
+	ctx = ctx.WithChainID(chainID)
+	consumerKeeper.SetParams(ctx, types.DefaultParams())
+
+	privVal := cmttypes.NewMockPV()
+	pubKey, err := privVal.GetPubKey()
+	require.NoError(t, err)
+	valSet := cmttypes.NewValidatorSet([]*cmttypes.Validator{cmttypes.NewValidator(pubKey, 10)})
+	signers := map[string]cmttypes.PrivValidator{pubKey.Address().String(): privVal}
+	blockTime := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	header1 := cryptotestutil.MakeAndSignHeader(chainID, 5, blockTime, []byte("app_hash_1"), valSet, signers)
+	header2 := cryptotestutil.MakeAndSignHeader(chainID, 5, blockTime, []byte("app_hash_2"), valSet, signers)
+
+	// misbehaviour cannot be reported before the CCV channel is established
+	_, err = consumerKeeper.ReportMisbehaviour(ctx, header1, header2)
+	require.ErrorIs(t, err, consumertypes.ErrNoProposerChannelId)
+
+	consumerKeeper.SetProviderChannel(ctx, "consumerCCVChannelID")
+	mocks.MockChannelKeeper.EXPECT().GetChannel(gomock.Any(), types.ConsumerPortID, "consumerCCVChannelID").
+		Return(channeltypes.Channel{State: channeltypes.OPEN, ConnectionHops: []string{"connection-0"}}, true).AnyTimes()
+	mocks.MockConnectionKeeper.EXPECT().GetConnection(gomock.Any(), "connection-0").
+		Return(conntypes.ConnectionEnd{Counterparty: conntypes.Counterparty{ClientId: "07-tendermint-5"}}, true).AnyTimes()
+
+	// headers of another chain are rejected
+	_, err = consumerKeeper.ReportMisbehaviour(ctx, header1, cryptotestutil.MakeAndSignHeader("other-1", 5, blockTime, []byte("app_hash_2"), valSet, signers))
+	require.ErrorIs(t, err, consumertypes.ErrInvalidMisbehaviourReport)
+
+	// invalid misbehaviour is rejected
+	_, err = consumerKeeper.ReportMisbehaviour(ctx, header1, nil)
+	require.ErrorIs(t, err, consumertypes.ErrInvalidMisbehaviourReport)
+
+	// headers cannot be verified without the validator set at the trusted height
+	_, err = consumerKeeper.ReportMisbehaviour(ctx, header1, header2)
+	require.ErrorIs(t, err, consumertypes.ErrInvalidMisbehaviourReport)
+
+	// the validator set at the trusted height, i.e., height 4, is recorded in the historical info
+	sdkPubKey, err := cryptocodec.FromCmtPubKeyInterface(pubKey)
+	require.NoError(t, err)
+	validator, err := consumertypes.NewCCValidator(pubKey.Address(), 10, sdkPubKey)
+	require.NoError(t, err)
+	consumerKeeper.SetCCValidator(ctx, validator)
+	require.NoError(t, consumerKeeper.TrackHistoricalInfo(ctx.WithBlockHeight(4)))
+	ctx = ctx.WithBlockHeight(10)
+
+	// headers that are not signed by the trusted validator set are rejected
+	otherPrivVal := cmttypes.NewMockPV()
+	otherPubKey, err := otherPrivVal.GetPubKey()
+	require.NoError(t, err)
+	otherValSet := cmttypes.NewValidatorSet([]*cmttypes.Validator{cmttypes.NewValidator(otherPubKey, 10)})
+	forgedHeader := cryptotestutil.MakeAndSignHeader(chainID, 5, blockTime, []byte("app_hash_2"), otherValSet,
+		map[string]cmttypes.PrivValidator{otherPubKey.Address().String(): otherPrivVal})
+	_, err = consumerKeeper.ReportMisbehaviour(ctx, header1, forgedHeader)
+	require.ErrorIs(t, err, consumertypes.ErrInvalidMisbehaviourReport)
+	require.False(t, consumerKeeper.IsMisbehaviourReported(ctx, 5))
+
+	// the misbehaviour is constructed for the client to the consumer chain on the provider chain
+	misbehaviour, err := consumerKeeper.ReportMisbehaviour(ctx, header1, header2)
+	require.NoError(t, err)
+	require.Equal(t, "07-tendermint-5", misbehaviour.ClientId)
+	require.True(t, consumerKeeper.IsMisbehaviourReported(ctx, 5))
+
+	// a misbehaviour is reported at most once per height
+	_, err = consumerKeeper.ReportMisbehaviour(ctx, header2, header1)
+	require.ErrorIs(t, err, consumertypes.ErrInvalidMisbehaviourReport)
+
+	// a second misbehaviour at another height is reported as well
+	header3 := cryptotestutil.MakeAndSignHeader(chainID, 6, blockTime, []byte("app_hash_3"), valSet, signers)
+	header4 := cryptotestutil.MakeAndSignHeader(chainID, 6, blockTime, []byte("app_hash_4"), valSet, signers)
+	header3.TrustedHeight, header4.TrustedHeight = header1.TrustedHeight, header2.TrustedHeight
+	_, err = consumerKeeper.ReportMisbehaviour(ctx, header3, header4)
+	require.NoError(t, err)
+
+	// the reports are sent to the provider one per block, starting with the lowest height
+	require.Empty(t, consumerKeeper.GetPendingPackets(ctx))
+	consumerKeeper.QueueMisbehaviourReport(ctx)
+	pendingPackets := consumerKeeper.GetPendingPackets(ctx)
+	require.Len(t, pendingPackets, 1)
+	require.Equal(t, types.ConsumerMisbehaviourPacket, pendingPackets[0].Type)
+
+	// the packet is sent over the wire in the current format
+	packetData, err := types.UnmarshalConsumerPacketData(pendingPackets[0].GetBytes())
+	require.NoError(t, err)
+	require.NoError(t, packetData.Validate())
+	require.Equal(t, misbehaviour.ClientId, packetData.GetConsumerMisbehaviourPacketData().Misbehaviour.ClientId)
+	require.Equal(t, header2.Header.AppHash, packetData.GetConsumerMisbehaviourPacketData().Misbehaviour.Header2.Header.AppHash)
+
+	consumerKeeper.QueueMisbehaviourReport(ctx)
+	pendingPackets = consumerKeeper.GetPendingPackets(ctx)
+	require.Len(t, pendingPackets, 2)
+	require.Equal(t, uint64(6), pendingPackets[1].GetConsumerMisbehaviourPacketData().Misbehaviour.Header1.GetHeight().GetRevisionHeight())
+	consumerKeeper.QueueMisbehaviourReport(ctx)
+	require.Len(t, consumerKeeper.GetPendingPackets(ctx), 2)
+
+	// the reported heights are pruned once they are older than the historical entries
+	consumerKeeper.QueueMisbehaviourReport(ctx.WithBlockHeight(5 + consumerKeeper.GetHistoricalEntries(ctx)))
+	require.False(t, consumerKeeper.IsMisbehaviourReported(ctx, 5))
+	require.True(t, consumerKeeper.IsMisbehaviourReported(ctx, 6))
+
+	// an error ack of the packet, e.g., from a provider chain that does not support
+	// misbehaviour reports, does not close the CCV channel
+	packet := channeltypes.Packet{
+		SourcePort:    types.ConsumerPortID,
+		SourceChannel: "consumerCCVChannelID",
+		Data:          pendingPackets[0].GetBytes(),
+	}
+	err = consumerKeeper.OnAcknowledgementPacket(ctx, packet, channeltypes.NewErrorAcknowledgement(types.ErrInvalidPacketData))
+	require.NoError(t, err)
+}
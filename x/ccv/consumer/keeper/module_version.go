@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/consumer/types"
+	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// The built-in features of the consumer module reported by QueryModuleVersionInfo,
+// in addition to the features enabled with WithFeatureFlags
+const (
+	// FeatureHeartbeats is the reporting of the validators that signed consumer blocks in heartbeat packets
+	FeatureHeartbeats = "heartbeats"
+	// FeatureMisbehaviourReports is the reporting of light client attacks on the consumer chain
+	// in ConsumerMisbehaviour packets
+	FeatureMisbehaviourReports = "misbehaviour-reports"
+	// FeatureDoubleVoteForwarding is the forwarding of the double voting evidence
+	// submitted to the evidence module in ConsumerDoubleVote packets
+	FeatureDoubleVoteForwarding = "double-vote-forwarding"
+	// FeatureStrictVscIdOrdering is enabled by the StrictVscIdOrdering param
+	FeatureStrictVscIdOrdering = "strict-vsc-id-ordering"
+)
+
+// GetEnabledFeatures returns the features enabled on the consumer chain, i.e., the built-in features,
+// the features enabled by the module params, and the features enabled with WithFeatureFlags
+func (k Keeper) GetEnabledFeatures(ctx sdk.Context) []string {
+	features := []string{
+		FeatureHeartbeats,
+		FeatureMisbehaviourReports,
+		FeatureDoubleVoteForwarding,
+	}
+	if k.GetStrictVscIdOrdering(ctx) {
+		features = append(features, FeatureStrictVscIdOrdering)
+	}
+	for feature, enabled := range k.features {
+		if enabled {
+			features = append(features, feature)
+		}
+	}
+	return features
+}
+
+// GetModuleVersionInfo returns the version of the consumer module and the features enabled on the consumer chain
+func (k Keeper) GetModuleVersionInfo(ctx sdk.Context) ccv.ModuleVersionInfo {
+	return ccv.NewModuleVersionInfo(types.ConsensusVersion, k.GetEnabledFeatures(ctx))
+}
@@ -32,9 +32,85 @@ func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParam
 	if err := msg.Params.Validate(); err != nil {
 		return nil, err
 	}
+	if err := k.ValidateRewardSplitAddresses(msg.Params.RewardSplits); err != nil {
+		return nil, err
+	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	k.Keeper.SetParams(ctx, msg.Params)
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// RetrySlashPacket resends the bounced slash packet once the retry delay period has elapsed.
+func (k msgServer) RetrySlashPacket(goCtx context.Context, msg *types.MsgRetrySlashPacket) (*types.MsgRetrySlashPacketResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.Keeper.RetrySlashPacket(ctx); err != nil {
+		return nil, err
+	}
+
+	k.Logger(ctx).Info("slash packet retried", "signer", msg.Signer)
+
+	return &types.MsgRetrySlashPacketResponse{}, nil
+}
+
+// ScheduleProviderSwitch schedules the switch of the consumer chain to a different provider chain.
+func (k msgServer) ScheduleProviderSwitch(goCtx context.Context, msg *types.MsgScheduleProviderSwitch) (*types.MsgScheduleProviderSwitchResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.Keeper.ScheduleProviderSwitch(ctx, msg.Switch); err != nil {
+		return nil, err
+	}
+
+	k.Logger(ctx).Info("provider switch scheduled",
+		"providerId", msg.Switch.ProviderId,
+		"switchHeight", msg.Switch.SwitchHeight,
+	)
+
+	return &types.MsgScheduleProviderSwitchResponse{}, nil
+}
+
+// InitiateConsumerShutdown initiates the shutdown of the consumer chain from the consumer side.
+func (k msgServer) InitiateConsumerShutdown(goCtx context.Context, msg *types.MsgInitiateConsumerShutdown) (*types.MsgInitiateConsumerShutdownResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.Keeper.InitiateConsumerShutdown(ctx); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgInitiateConsumerShutdownResponse{}, nil
+}
+
+// ScheduleStandaloneTransition schedules the transition of the consumer chain to a standalone chain.
+func (k msgServer) ScheduleStandaloneTransition(goCtx context.Context, msg *types.MsgScheduleStandaloneTransition) (*types.MsgScheduleStandaloneTransitionResponse, error) {
+	if k.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.Keeper.ScheduleStandaloneTransition(ctx, msg.Transition); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgScheduleStandaloneTransitionResponse{}, nil
+}
+
+// ReportMisbehaviour reports a light client attack on the consumer chain to the provider chain.
+func (k msgServer) ReportMisbehaviour(goCtx context.Context, msg *types.MsgReportMisbehaviour) (*types.MsgReportMisbehaviourResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := k.Keeper.ReportMisbehaviour(ctx, msg.Header_1, msg.Header_2); err != nil {
+		return nil, err
+	}
+
+	k.Logger(ctx).Info("consumer misbehaviour report submitted", "submitter", msg.Submitter)
+
+	return &types.MsgReportMisbehaviourResponse{}, nil
+}
@@ -0,0 +1,96 @@
+package keeper
+
+import (
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/consumer/types"
+	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// FeatureProviderClientUpdateFallback enables the validators to update the client to the provider with
+// a header of the provider chain once the client is about to expire (see ProviderClientProposalHandler)
+const FeatureProviderClientUpdateFallback = "provider-client-update-fallback"
+
+// Option customizes the consumer keeper at construction, so that integrators can
+// extend its behavior without changes to the positional arguments of NewKeeper
+type Option func(*Keeper)
+
+// FeeSplitter returns the part of the block fees in the fee collector that is
+// redistributed on the consumer chain; the remainder is sent to the provider chain.
+// The returned coins must not exceed the block fees.
+type FeeSplitter func(ctx sdk.Context, fees sdk.Coins, consumerRedistributionFrac math.LegacyDec) (sdk.Coins, error)
+
+// DefaultFeeSplitter redistributes the ConsumerRedistributionFrac of the block fees
+// on the consumer chain, with the truncated decimal remainder sent to the provider chain
+func DefaultFeeSplitter(_ sdk.Context, fees sdk.Coins, consumerRedistributionFrac math.LegacyDec) (sdk.Coins, error) {
+	consumerFees, _ := sdk.NewDecCoinsFromCoins(fees...).MulDec(consumerRedistributionFrac).TruncateDecimal()
+	return consumerFees, nil
+}
+
+// WithFeeSplitter sets the function splitting the block fees between the consumer and the provider chain
+func WithFeeSplitter(feeSplitter FeeSplitter) Option {
+	return func(k *Keeper) {
+		k.feeSplitter = feeSplitter
+	}
+}
+
+// WithPacketSender sets the function used to send the CCV packets to the provider chain
+func WithPacketSender(packetSender ccv.PacketSender) Option {
+	return func(k *Keeper) {
+		k.packetSender = packetSender
+	}
+}
+
+// WithRateLimitKeeper sets the keeper of the IBC rate-limiting middleware wrapping the transfer module,
+// so that the transfers of the consumer rewards to the provider chain are exempted from the rate limits
+func WithRateLimitKeeper(rateLimitKeeper ccv.RateLimitKeeper) Option {
+	return func(k *Keeper) {
+		k.rateLimitKeeper = rateLimitKeeper
+	}
+}
+
+// StandaloneTransitionHandler seeds the staking module of the app from the final CCV validator set
+// `valset` and the `delegations` of a transition to a standalone chain. It returns the validator updates
+// of the validator set of the standalone chain, which replaces the CCV validator set.
+type StandaloneTransitionHandler func(
+	ctx sdk.Context,
+	valset []types.CrossChainValidator,
+	delegations []types.StandaloneDelegation,
+) ([]abci.ValidatorUpdate, error)
+
+// WithStandaloneTransitionHandler sets the function seeding the staking module of the app on a transition
+// to a standalone chain; without it, the consumer chain cannot transition to a standalone chain
+func WithStandaloneTransitionHandler(handler StandaloneTransitionHandler) Option {
+	return func(k *Keeper) {
+		k.standaloneTransitionHandler = handler
+	}
+}
+
+// WithLogger sets the logger of the module, which otherwise logs with the logger of the context
+func WithLogger(logger log.Logger) Option {
+	return func(k *Keeper) {
+		k.logger = logger
+	}
+}
+
+// WithFeatureFlags enables the given features, which can be queried with IsFeatureEnabled
+func WithFeatureFlags(features ...string) Option {
+	return func(k *Keeper) {
+		if k.features == nil {
+			k.features = map[string]bool{}
+		}
+		for _, feature := range features {
+			k.features[feature] = true
+		}
+	}
+}
+
+// IsFeatureEnabled returns true if the feature was enabled with WithFeatureFlags
+func (k Keeper) IsFeatureEnabled(feature string) bool {
+	return k.features[feature]
+}
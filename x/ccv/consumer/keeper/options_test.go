@@ -0,0 +1,130 @@
+package keeper_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
+	consumerkeeper "github.com/cosmos/interchain-security/v7/x/ccv/consumer/keeper"
+	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// TestFeeSplitterOption tests that the block fees are split by the fee splitter
+// passed to the constructor, and by the default one if the custom one fails
+func TestFeeSplitterOption(t *testing.T) {
+	fees := sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+
+	testCases := []struct {
+		name               string
+		feeSplitter        consumerkeeper.FeeSplitter
+		expectedToConsumer sdk.Coins
+	}{
+		{
+			"all fees redistributed on the consumer chain",
+			func(_ sdk.Context, fees sdk.Coins, _ math.LegacyDec) (sdk.Coins, error) {
+				return fees, nil
+			},
+			fees,
+		},
+		{
+			"fee splitter fails",
+			func(sdk.Context, sdk.Coins, math.LegacyDec) (sdk.Coins, error) {
+				return nil, errors.New("failure")
+			},
+			sdk.NewCoins(sdk.NewInt64Coin("stake", 75)),
+		},
+		{
+			"fee splitter exceeds the block fees",
+			func(_ sdk.Context, fees sdk.Coins, _ math.LegacyDec) (sdk.Coins, error) {
+				return fees.Add(fees...), nil
+			},
+			sdk.NewCoins(sdk.NewInt64Coin("stake", 75)),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			keeperParams := testkeeper.NewInMemKeeperParams(t)
+			keeperParams.ConsumerOptions = []consumerkeeper.Option{consumerkeeper.WithFeeSplitter(tc.feeSplitter)}
+			consumerKeeper, ctx, ctrl, mocks := testkeeper.GetConsumerKeeperAndCtx(t, keeperParams)
+			defer ctrl.Finish()
+			params := ccv.DefaultParams()
+			params.ConsumerRedistributionFraction = "0.75"
+			consumerKeeper.SetParams(ctx, params)
+
+			mAcc := authtypes.NewModuleAccount(&authtypes.BaseAccount{}, "", "auth")
+			mocks.MockAccountKeeper.EXPECT().GetModuleAccount(ctx, authtypes.FeeCollectorName).Return(mAcc)
+			mocks.MockBankKeeper.EXPECT().GetAllBalances(ctx, mAcc.GetAddress()).Return(fees)
+
+			res := consumerKeeper.GetEstimatedNextFeeDistribution(ctx)
+			require.Equal(t, sdk.NewDecCoinsFromCoins(tc.expectedToConsumer...).String(), res.ToConsumer)
+			require.Equal(t, sdk.NewDecCoinsFromCoins(fees.Sub(tc.expectedToConsumer...)...).String(), res.ToProvider)
+		})
+	}
+}
+
+// TestPacketSenderOption tests that the CCV packets are sent by the packet sender passed to the constructor
+func TestPacketSenderOption(t *testing.T) {
+	sentPackets := [][]byte{}
+	packetSender := func(_ sdk.Context, _ ccv.ChannelKeeper, sourceChannelID, sourcePortID string,
+		packetData []byte, _ time.Duration,
+	) (uint64, uint64, error) {
+		require.Equal(t, "consumerCCVChannelID", sourceChannelID)
+		require.Equal(t, ccv.ConsumerPortID, sourcePortID)
+		sentPackets = append(sentPackets, packetData)
+		return uint64(len(sentPackets)), 100, nil
+	}
+
+	keeperParams := testkeeper.NewInMemKeeperParams(t)
+	keeperParams.ConsumerOptions = []consumerkeeper.Option{consumerkeeper.WithPacketSender(packetSender)}
+	consumerKeeper, ctx, ctrl, _ := testkeeper.GetConsumerKeeperAndCtx(t, keeperParams)
+	defer ctrl.Finish()
+	consumerKeeper.SetParams(ctx, ccv.DefaultParams())
+	consumerKeeper.SetProviderChannel(ctx, "consumerCCVChannelID")
+
+	consumerKeeper.AppendPendingPacket(ctx, ccv.VscMaturedPacket, &ccv.ConsumerPacketData_VscMaturedPacketData{
+		VscMaturedPacketData: &ccv.VSCMaturedPacketData{ValsetUpdateId: 1},
+	})
+	consumerKeeper.SendPackets(ctx)
+
+	// the packet is sent without calling the channel keeper
+	require.Len(t, sentPackets, 1)
+	require.Empty(t, consumerKeeper.GetPendingPackets(ctx))
+}
+
+// TestLoggerAndFeatureFlagsOptions tests the logger and feature flags passed to the constructor
+func TestLoggerAndFeatureFlagsOptions(t *testing.T) {
+	var buf bytes.Buffer
+	keeperParams := testkeeper.NewInMemKeeperParams(t)
+	keeperParams.ConsumerOptions = []consumerkeeper.Option{
+		consumerkeeper.WithLogger(log.NewLogger(&buf)),
+		consumerkeeper.WithFeatureFlags("feature-a", "feature-b"),
+	}
+	consumerKeeper, ctx, ctrl, _ := testkeeper.GetConsumerKeeperAndCtx(t, keeperParams)
+	defer ctrl.Finish()
+
+	consumerKeeper.Logger(ctx).Info("custom logger")
+	require.Contains(t, buf.String(), "custom logger")
+
+	require.True(t, consumerKeeper.IsFeatureEnabled("feature-a"))
+	require.True(t, consumerKeeper.IsFeatureEnabled("feature-b"))
+	require.False(t, consumerKeeper.IsFeatureEnabled("feature-c"))
+
+	// the keeper cannot be instantiated without a fee splitter or packet sender
+	mocks := testkeeper.NewMockedKeepers(gomock.NewController(t))
+	keeperParams.ConsumerOptions = []consumerkeeper.Option{consumerkeeper.WithFeeSplitter(nil)}
+	require.Panics(t, func() { testkeeper.NewInMemConsumerKeeper(keeperParams, mocks) })
+	keeperParams.ConsumerOptions = []consumerkeeper.Option{consumerkeeper.WithPacketSender(nil)}
+	require.Panics(t, func() { testkeeper.NewInMemConsumerKeeper(keeperParams, mocks) })
+}
@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/consumer/types"
+)
+
+// SetPacketTimeout sets the timeout timestamp of a packet sent to the provider on the given channel
+// that is not yet acknowledged
+func (k Keeper) SetPacketTimeout(ctx sdk.Context, channelID string, sequence, timeoutTimestamp uint64) {
+	store := k.providerStore(ctx)
+	store.Set(types.PacketTimeoutKey(channelID, sequence), sdk.Uint64ToBigEndian(timeoutTimestamp))
+}
+
+// DeletePacketTimeout deletes the timeout timestamp of a packet sent to the provider on the given channel
+func (k Keeper) DeletePacketTimeout(ctx sdk.Context, channelID string, sequence uint64) {
+	store := k.providerStore(ctx)
+	store.Delete(types.PacketTimeoutKey(channelID, sequence))
+}
+
+// DeleteAllPacketTimeouts deletes the timeout timestamps of all the packets sent to the provider on the given channel
+func (k Keeper) DeleteAllPacketTimeouts(ctx sdk.Context, channelID string) {
+	store := k.providerStore(ctx)
+	iterator := storetypes.KVStorePrefixIterator(store, types.PacketTimeoutKeyPrefix(channelID))
+	defer iterator.Close()
+
+	var keysToDel [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keysToDel = append(keysToDel, iterator.Key())
+	}
+	for _, key := range keysToDel {
+		store.Delete(key)
+	}
+}
+
+// GetAllPacketTimeouts returns the timeout timestamps of all the packets sent to the provider on the given channel
+// that are not yet acknowledged, ordered by sequence
+func (k Keeper) GetAllPacketTimeouts(ctx sdk.Context, channelID string) []types.PacketTimeout {
+	store := k.providerStore(ctx)
+	prefix := types.PacketTimeoutKeyPrefix(channelID)
+	iterator := storetypes.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	packetTimeouts := []types.PacketTimeout{}
+	for ; iterator.Valid(); iterator.Next() {
+		packetTimeouts = append(packetTimeouts, types.PacketTimeout{
+			ChannelId:        channelID,
+			Sequence:         sdk.BigEndianToUint64(iterator.Key()[len(prefix):]),
+			TimeoutTimestamp: time.Unix(0, int64(sdk.BigEndianToUint64(iterator.Value()))).UTC(),
+		})
+	}
+	return packetTimeouts
+}
@@ -0,0 +1,63 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
+	"github.com/cosmos/interchain-security/v7/x/ccv/consumer/types"
+)
+
+// TestPacketTimeouts tests the getter, setter, and deletion methods of the packet timeouts
+func TestPacketTimeouts(t *testing.T) {
+	consumerKeeper, ctx, ctrl, _ := testkeeper.GetConsumerKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	require.Empty(t, consumerKeeper.GetAllPacketTimeouts(ctx, "channel-0"))
+
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	consumerKeeper.SetPacketTimeout(ctx, "channel-0", 2, uint64(now.Add(2*time.Hour).UnixNano()))
+	consumerKeeper.SetPacketTimeout(ctx, "channel-0", 1, uint64(now.Add(time.Hour).UnixNano()))
+	consumerKeeper.SetPacketTimeout(ctx, "channel-1", 1, uint64(now.UnixNano()))
+
+	require.Equal(t, []types.PacketTimeout{
+		{ChannelId: "channel-0", Sequence: 1, TimeoutTimestamp: now.Add(time.Hour)},
+		{ChannelId: "channel-0", Sequence: 2, TimeoutTimestamp: now.Add(2 * time.Hour)},
+	}, consumerKeeper.GetAllPacketTimeouts(ctx, "channel-0"))
+
+	consumerKeeper.DeletePacketTimeout(ctx, "channel-0", 1)
+	require.Len(t, consumerKeeper.GetAllPacketTimeouts(ctx, "channel-0"), 1)
+
+	consumerKeeper.DeleteAllPacketTimeouts(ctx, "channel-0")
+	require.Empty(t, consumerKeeper.GetAllPacketTimeouts(ctx, "channel-0"))
+	require.Len(t, consumerKeeper.GetAllPacketTimeouts(ctx, "channel-1"), 1)
+}
+
+// TestQueryNearTimeoutPackets tests that only the packets that time out within the given duration
+// are returned, including the packets sent on the previous provider channel
+func TestQueryNearTimeoutPackets(t *testing.T) {
+	consumerKeeper, ctx, ctrl, _ := testkeeper.GetConsumerKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(now)
+
+	_, err := consumerKeeper.QueryNearTimeoutPackets(ctx, nil)
+	require.Error(t, err)
+
+	consumerKeeper.SetProviderChannel(ctx, "channel-1")
+	consumerKeeper.SetPreviousProviderChannel(ctx, "channel-0")
+	consumerKeeper.SetPacketTimeout(ctx, "channel-0", 5, uint64(now.Add(-time.Minute).UnixNano()))
+	consumerKeeper.SetPacketTimeout(ctx, "channel-1", 1, uint64(now.Add(10*time.Minute).UnixNano()))
+	consumerKeeper.SetPacketTimeout(ctx, "channel-1", 2, uint64(now.Add(time.Hour).UnixNano()))
+	// packets on unrelated channels are ignored
+	consumerKeeper.SetPacketTimeout(ctx, "channel-2", 1, uint64(now.UnixNano()))
+
+	res, err := consumerKeeper.QueryNearTimeoutPackets(ctx, &types.QueryNearTimeoutPacketsRequest{Within: 30 * time.Minute})
+	require.NoError(t, err)
+	require.Equal(t, []types.PacketTimeout{
+		{ChannelId: "channel-0", Sequence: 5, TimeoutTimestamp: now.Add(-time.Minute)},
+		{ChannelId: "channel-1", Sequence: 1, TimeoutTimestamp: now.Add(10 * time.Minute)},
+	}, res.Packets)
+}
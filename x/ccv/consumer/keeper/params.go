@@ -2,8 +2,11 @@ package keeper
 
 import (
 	"context"
+	"fmt"
 	"time"
 
+	"cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
@@ -132,3 +135,64 @@ func (k Keeper) GetConsumerId(ctx sdk.Context) string {
 	params := k.GetConsumerParams(ctx)
 	return params.ConsumerId
 }
+
+// GetStrictVscIdOrdering returns whether the VSC packets with non-monotonic valset update IDs are rejected
+func (k Keeper) GetStrictVscIdOrdering(ctx sdk.Context) bool {
+	params := k.GetConsumerParams(ctx)
+	return params.StrictVscIdOrdering
+}
+
+// GetMaxValidatorUpdatesPerBlock returns the maximum number of validator updates sent to the consensus engine in a block
+func (k Keeper) GetMaxValidatorUpdatesPerBlock(ctx sdk.Context) uint64 {
+	params := k.GetConsumerParams(ctx)
+	return params.MaxValidatorUpdatesPerBlock
+}
+
+// GetRetryDelayMultiplier returns the factor by which the delay before retrying to send
+// a bounced slash packet is multiplied after every retry
+func (k Keeper) GetRetryDelayMultiplier(ctx sdk.Context) math.LegacyDec {
+	params := k.GetConsumerParams(ctx)
+	if params.RetryDelayMultiplier == "" {
+		// an empty multiplier is equivalent to a fixed retry delay
+		return math.LegacyOneDec()
+	}
+	return math.LegacyMustNewDecFromStr(params.RetryDelayMultiplier)
+}
+
+// GetMaxRetryDelayPeriod returns the maximum delay before retrying to send a bounced slash packet
+func (k Keeper) GetMaxRetryDelayPeriod(ctx sdk.Context) time.Duration {
+	params := k.GetConsumerParams(ctx)
+	return params.MaxRetryDelayPeriod
+}
+
+// GetClientExpiryWarningThreshold returns the duration before the expiry of the client to the provider
+// from which the consumer emits warning events
+func (k Keeper) GetClientExpiryWarningThreshold(ctx sdk.Context) time.Duration {
+	params := k.GetConsumerParams(ctx)
+	return params.ClientExpiryWarningThreshold
+}
+
+// GetDenomRedistributionFractions returns the fractions of tokens allocated to the consumer
+// redistribution address during distribution events for specific denoms
+func (k Keeper) GetDenomRedistributionFractions(ctx sdk.Context) []ccvtypes.DenomRedistributionFraction {
+	params := k.GetConsumerParams(ctx)
+	return params.DenomRedistributionFractions
+}
+
+// GetRewardSplits returns the fractions of the rewards allocated to the provider chain
+// that are sent to local addresses on the consumer chain
+func (k Keeper) GetRewardSplits(ctx sdk.Context) []ccvtypes.RewardSplit {
+	params := k.GetConsumerParams(ctx)
+	return params.RewardSplits
+}
+
+// ValidateRewardSplitAddresses validates that the addresses of the reward splits
+// can be decoded with the address codec of the consumer chain
+func (k Keeper) ValidateRewardSplitAddresses(rewardSplits []ccvtypes.RewardSplit) error {
+	for _, split := range rewardSplits {
+		if _, err := k.authKeeper.AddressCodec().StringToBytes(split.Address); err != nil {
+			return fmt.Errorf("invalid reward split address %s: %w", split.Address, err)
+		}
+	}
+	return nil
+}
@@ -6,6 +6,9 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
 	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
 )
@@ -32,6 +35,13 @@ func TestParams(t *testing.T) {
 		provideRewardDenoms,
 		ccv.DefaultRetryDelayPeriod,
 		"0",
+		false,
+		ccv.DefaultMaxValidatorUpdatesPerBlock,
+		ccv.DefaultRetryDelayMultiplier,
+		ccv.DefaultMaxRetryDelayPeriod,
+		ccv.DefaultClientExpiryWarningThreshold,
+		nil,
+		nil,
 	) // these are the default params, IBC suite independently sets enabled=true
 
 	params := consumerKeeper.GetConsumerParams(ctx)
@@ -39,7 +49,9 @@ func TestParams(t *testing.T) {
 
 	newParams := ccv.NewParams(false, 1000,
 		"channel-2", "cosmos19pe9pg5dv9k5fzgzmsrgnw9rl9asf7ddwhu7lm",
-		7*24*time.Hour, 25*time.Hour, "0.5", 500, 24*21*time.Hour, []string{"untrn"}, []string{"uatom"}, 2*time.Hour, "1")
+		7*24*time.Hour, 25*time.Hour, "0.5", 500, 24*21*time.Hour, []string{"untrn"}, []string{"uatom"}, 2*time.Hour, "1", true, 10, "2", 4*time.Hour, 24*time.Hour,
+		[]ccv.DenomRedistributionFraction{{Denom: "untrn", Fraction: "1"}},
+		[]ccv.RewardSplit{{Address: "cosmos19pe9pg5dv9k5fzgzmsrgnw9rl9asf7ddwhu7lm", Fraction: "0.1"}})
 	consumerKeeper.SetParams(ctx, newParams)
 	params = consumerKeeper.GetConsumerParams(ctx)
 	require.Equal(t, newParams, params)
@@ -61,3 +73,23 @@ func TestParams(t *testing.T) {
 	storedUnbondingPeriod := consumerKeeper.GetUnbondingPeriod(ctx)
 	require.Equal(t, time.Hour*24*10, storedUnbondingPeriod)
 }
+
+// TestValidateRewardSplitAddresses tests that the reward split addresses are decoded
+// with the address codec of the consumer chain
+func TestValidateRewardSplitAddresses(t *testing.T) {
+	consumerKeeper, _, ctrl, mocks := testkeeper.GetConsumerKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	codec := address.NewBech32Codec("consumer")
+	mocks.MockAccountKeeper.EXPECT().AddressCodec().Return(codec).AnyTimes()
+
+	addr, err := codec.BytesToString(sdk.AccAddress([]byte("devFund")))
+	require.NoError(t, err)
+	require.NoError(t, consumerKeeper.ValidateRewardSplitAddresses(nil))
+	require.NoError(t, consumerKeeper.ValidateRewardSplitAddresses([]ccv.RewardSplit{{Address: addr, Fraction: "0.1"}}))
+	// the address of another chain is rejected
+	require.Error(t, consumerKeeper.ValidateRewardSplitAddresses([]ccv.RewardSplit{
+		{Address: addr, Fraction: "0.1"},
+		{Address: sdk.AccAddress([]byte("devFund")).String(), Fraction: "0.1"},
+	}))
+	require.Error(t, consumerKeeper.ValidateRewardSplitAddresses([]ccv.RewardSplit{{Address: "invalid", Fraction: "0.1"}}))
+}
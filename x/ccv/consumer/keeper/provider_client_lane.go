@@ -0,0 +1,147 @@
+package keeper
+
+import (
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	ibctmtypes "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/consumer/types"
+)
+
+// ProviderHeaderSource returns a header of the provider chain that updates the client to the provider
+// from its latest height `trustedHeight`, e.g., fetched from a provider node run by the validator
+type ProviderHeaderSource func(ctx sdk.Context, trustedHeight clienttypes.Height) (*ibctmtypes.Header, error)
+
+// ProviderClientProposalHandler lets the validators update the client to the provider with a header
+// of the provider chain, as a last-resort fallback when no relayer updated the client and it is about to expire.
+// If IsProviderClientUpdateNeeded is true, the proposer injects a header obtained from its ProviderHeaderSource
+// as the first tx of the block, the validators reject the proposal if the header cannot update the client,
+// and ApplyProviderClientLaneTx updates the client with the header in PreBlock.
+// The injected tx cannot be decoded as a tx, so it fails in FinalizeBlock without changing the state.
+type ProviderClientProposalHandler struct {
+	keeper          Keeper
+	headerSource    ProviderHeaderSource
+	prepareProposal sdk.PrepareProposalHandler
+	processProposal sdk.ProcessProposalHandler
+}
+
+// NewProviderClientProposalHandler returns a ProviderClientProposalHandler wrapping the proposal handlers of the app.
+// Validators without a header source, i.e., with a nil `headerSource`, verify the headers injected by
+// the other validators, but do not inject headers themselves.
+func NewProviderClientProposalHandler(
+	k Keeper,
+	headerSource ProviderHeaderSource,
+	prepareProposal sdk.PrepareProposalHandler,
+	processProposal sdk.ProcessProposalHandler,
+) *ProviderClientProposalHandler {
+	return &ProviderClientProposalHandler{
+		keeper:          k,
+		headerSource:    headerSource,
+		prepareProposal: prepareProposal,
+		processProposal: processProposal,
+	}
+}
+
+// PrepareProposalHandler returns the handler that injects the header of the provider chain
+// as the first tx of the proposal, if the client to the provider needs to be updated
+func (h *ProviderClientProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHandler {
+	return func(ctx sdk.Context, req *abci.RequestPrepareProposal) (*abci.ResponsePrepareProposal, error) {
+		laneTx := h.providerClientLaneTx(ctx)
+
+		// the lane txs cannot be submitted through the mempool
+		txs := make([][]byte, 0, len(req.Txs))
+		for _, tx := range req.Txs {
+			if !types.IsProviderClientLaneTx(tx) {
+				txs = append(txs, tx)
+			}
+		}
+		nextReq := *req
+		nextReq.Txs = txs
+		nextReq.MaxTxBytes -= int64(len(laneTx))
+
+		resp, err := h.prepareProposal(ctx, &nextReq)
+		if err != nil || laneTx == nil {
+			return resp, err
+		}
+		resp.Txs = append([][]byte{laneTx}, resp.Txs...)
+		return resp, nil
+	}
+}
+
+// ProcessProposalHandler returns the handler that rejects the proposals with a header of the provider chain
+// that cannot update the client to the provider, or with a header that is not the first tx of the proposal
+func (h *ProviderClientProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHandler {
+	return func(ctx sdk.Context, req *abci.RequestProcessProposal) (*abci.ResponseProcessProposal, error) {
+		txs := req.Txs
+		if len(txs) > 0 && types.IsProviderClientLaneTx(txs[0]) {
+			header, err := types.DecodeProviderClientLaneTx(txs[0])
+			if err == nil {
+				err = h.keeper.VerifyProviderClientHeader(ctx, header)
+			}
+			if err != nil {
+				h.keeper.Logger(ctx).Error("rejecting proposal with invalid provider header", "error", err.Error())
+				return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, nil
+			}
+			txs = txs[1:]
+		}
+		for _, tx := range txs {
+			if types.IsProviderClientLaneTx(tx) {
+				h.keeper.Logger(ctx).Error("rejecting proposal with a provider header that is not the first tx")
+				return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, nil
+			}
+		}
+
+		nextReq := *req
+		nextReq.Txs = txs
+		return h.processProposal(ctx, &nextReq)
+	}
+}
+
+// providerClientLaneTx returns the tx injecting a header of the provider chain that updates the client
+// to the provider, or nil if the client does not need to be updated or no valid header is available
+func (h *ProviderClientProposalHandler) providerClientLaneTx(ctx sdk.Context) []byte {
+	if h.headerSource == nil || !h.keeper.IsProviderClientUpdateNeeded(ctx) {
+		return nil
+	}
+	trustedHeight, err := h.keeper.GetProviderClientLatestHeight(ctx)
+	if err != nil {
+		h.keeper.Logger(ctx).Error("cannot get provider client height", "error", err.Error())
+		return nil
+	}
+	header, err := h.headerSource(ctx, trustedHeight)
+	if err != nil {
+		h.keeper.Logger(ctx).Error("cannot get provider header", "trustedHeight", trustedHeight, "error", err.Error())
+		return nil
+	}
+	if err := h.keeper.VerifyProviderClientHeader(ctx, header); err != nil {
+		h.keeper.Logger(ctx).Error("provider header cannot update the provider client", "error", err.Error())
+		return nil
+	}
+	laneTx, err := types.EncodeProviderClientLaneTx(header)
+	if err != nil {
+		h.keeper.Logger(ctx).Error("cannot encode provider header", "error", err.Error())
+		return nil
+	}
+	return laneTx
+}
+
+// ApplyProviderClientLaneTx updates the client to the provider with the header of the provider chain
+// injected as the first tx of the block, if any. It must be called in the PreBlocker of the app.
+// The header was already verified in ProcessProposal, so an error is only logged.
+func (k Keeper) ApplyProviderClientLaneTx(ctx sdk.Context, txs [][]byte) {
+	if len(txs) == 0 || !types.IsProviderClientLaneTx(txs[0]) {
+		return
+	}
+	header, err := types.DecodeProviderClientLaneTx(txs[0])
+	if err == nil {
+		err = k.UpdateProviderClientWithHeader(ctx, header)
+	}
+	if err != nil {
+		k.Logger(ctx).Error("cannot update provider client with the injected header", "error", err.Error())
+		return
+	}
+	k.Logger(ctx).Info("provider client updated with the injected header", "height", header.GetHeight())
+}
@@ -0,0 +1,179 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+	ibctmtypes "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
+	consumerkeeper "github.com/cosmos/interchain-security/v7/x/ccv/consumer/keeper"
+	"github.com/cosmos/interchain-security/v7/x/ccv/consumer/types"
+	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// newProviderHeader returns a header of the provider chain at the given height and time
+func newProviderHeader(height int64, time time.Time) *ibctmtypes.Header {
+	return &ibctmtypes.Header{
+		SignedHeader: &cmtproto.SignedHeader{Header: &cmtproto.Header{ChainID: "provider", Height: height, Time: time}},
+	}
+}
+
+// mockProviderClient mocks the client to the provider at height 100, which expires 10 days after `now`,
+// and which can only be updated with `header`. The update is recorded in the store,
+// so that the updates in a cached context are discarded as for the IBC client keeper.
+func mockProviderClient(keeperParams testkeeper.InMemKeeperParams, mocks testkeeper.MockedKeepers, now time.Time, header *ibctmtypes.Header) {
+	updatedKey := []byte("mockProviderClientUpdated")
+	trustingPeriod := 10 * 24 * time.Hour
+	mocks.MockClientKeeper.EXPECT().GetClientState(gomock.Any(), "clientId").DoAndReturn(
+		func(ctx sdk.Context, _ string) (ibcexported.ClientState, bool) {
+			height := clienttypes.NewHeight(0, 100)
+			if ctx.KVStore(keeperParams.StoreKey).Has(updatedKey) {
+				height = header.GetHeight().(clienttypes.Height)
+			}
+			return &ibctmtypes.ClientState{ChainId: "provider", LatestHeight: height, TrustingPeriod: trustingPeriod}, true
+		}).AnyTimes()
+	mocks.MockClientKeeper.EXPECT().GetClientConsensusState(gomock.Any(), "clientId", gomock.Any()).DoAndReturn(
+		func(_ sdk.Context, _ string, height ibcexported.Height) (ibcexported.ConsensusState, bool) {
+			if height.EQ(header.GetHeight()) {
+				return &ibctmtypes.ConsensusState{Timestamp: header.GetTime()}, true
+			}
+			return &ibctmtypes.ConsensusState{Timestamp: now}, true
+		}).AnyTimes()
+	mocks.MockClientKeeper.EXPECT().UpdateClient(gomock.Any(), "clientId", gomock.Any()).DoAndReturn(
+		func(ctx sdk.Context, _ string, clientMsg ibcexported.ClientMessage) error {
+			if !clientMsg.(*ibctmtypes.Header).GetHeight().EQ(header.GetHeight()) {
+				return clienttypes.ErrInvalidHeader
+			}
+			ctx.KVStore(keeperParams.StoreKey).Set(updatedKey, []byte{})
+			return nil
+		}).AnyTimes()
+}
+
+// TestProviderClientProposalHandler tests that the proposer injects a header of the provider chain
+// when the client to the provider is about to expire, that the validators reject the proposals
+// with invalid headers, and that the header is applied in PreBlock
+func TestProviderClientProposalHandler(t *testing.T) {
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	nearExpiry := now.Add(9*24*time.Hour + time.Second)
+	header := newProviderHeader(101, nearExpiry)
+	laneTx, err := types.EncodeProviderClientLaneTx(header)
+	require.NoError(t, err)
+	decoded, err := types.DecodeProviderClientLaneTx(laneTx)
+	require.NoError(t, err)
+	require.Equal(t, header, decoded)
+	invalidLaneTx, err := types.EncodeProviderClientLaneTx(newProviderHeader(102, nearExpiry))
+	require.NoError(t, err)
+
+	keeperParams := testkeeper.NewInMemKeeperParams(t)
+	keeperParams.ConsumerOptions = []consumerkeeper.Option{
+		consumerkeeper.WithFeatureFlags(consumerkeeper.FeatureProviderClientUpdateFallback),
+	}
+	consumerKeeper, ctx, ctrl, mocks := testkeeper.GetConsumerKeeperAndCtx(t, keeperParams)
+	defer ctrl.Finish()
+	params := ccv.DefaultParams()
+	params.ClientExpiryWarningThreshold = 24 * time.Hour
+	consumerKeeper.SetParams(ctx, params)
+	consumerKeeper.SetProviderClientID(ctx, "clientId")
+	mockProviderClient(keeperParams, mocks, now, header)
+
+	// the next handlers of the app receive the txs without the injected header
+	var nextTxs [][]byte
+	var nextMaxTxBytes int64
+	prepareProposal := func(_ sdk.Context, req *abci.RequestPrepareProposal) (*abci.ResponsePrepareProposal, error) {
+		nextTxs, nextMaxTxBytes = req.Txs, req.MaxTxBytes
+		return &abci.ResponsePrepareProposal{Txs: req.Txs}, nil
+	}
+	processProposal := func(_ sdk.Context, req *abci.RequestProcessProposal) (*abci.ResponseProcessProposal, error) {
+		nextTxs = req.Txs
+		return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_ACCEPT}, nil
+	}
+	var headerSourceErr error
+	headerSource := func(_ sdk.Context, trustedHeight clienttypes.Height) (*ibctmtypes.Header, error) {
+		require.Equal(t, clienttypes.NewHeight(0, 100), trustedHeight)
+		return header, headerSourceErr
+	}
+	handler := consumerkeeper.NewProviderClientProposalHandler(consumerKeeper, headerSource, prepareProposal, processProposal)
+	handlerWithoutSource := consumerkeeper.NewProviderClientProposalHandler(consumerKeeper, nil, prepareProposal, processProposal)
+	prepareReq := &abci.RequestPrepareProposal{Txs: [][]byte{[]byte("tx1"), invalidLaneTx, []byte("tx2")}, MaxTxBytes: 1000}
+	expectedTxs := [][]byte{[]byte("tx1"), []byte("tx2")}
+
+	// no header is injected before the client is about to expire
+	ctx = ctx.WithBlockTime(now)
+	resp, err := handler.PrepareProposalHandler()(ctx, prepareReq)
+	require.NoError(t, err)
+	require.Equal(t, expectedTxs, resp.Txs)
+	require.Equal(t, int64(1000), nextMaxTxBytes)
+
+	// no header is injected without a header source or if the header source fails
+	ctx = ctx.WithBlockTime(nearExpiry)
+	resp, err = handlerWithoutSource.PrepareProposalHandler()(ctx, prepareReq)
+	require.NoError(t, err)
+	require.Equal(t, expectedTxs, resp.Txs)
+	headerSourceErr = errors.New("provider node unavailable")
+	resp, err = handler.PrepareProposalHandler()(ctx, prepareReq)
+	require.NoError(t, err)
+	require.Equal(t, expectedTxs, resp.Txs)
+	headerSourceErr = nil
+
+	// the header is injected as the first tx once the client is about to expire
+	resp, err = handler.PrepareProposalHandler()(ctx, prepareReq)
+	require.NoError(t, err)
+	require.Equal(t, append([][]byte{laneTx}, expectedTxs...), resp.Txs)
+	require.Equal(t, expectedTxs, nextTxs)
+	require.Equal(t, int64(1000-len(laneTx)), nextMaxTxBytes)
+
+	// the proposals with an invalid header or with a header that is not the first tx are rejected,
+	// also by the validators without a header source
+	for _, txs := range [][][]byte{
+		{invalidLaneTx, []byte("tx1")},
+		{[]byte("tx1"), laneTx},
+		{append(append([]byte{}, types.ProviderClientLaneTxPrefix...), []byte("invalid")...)},
+	} {
+		for _, h := range []*consumerkeeper.ProviderClientProposalHandler{handler, handlerWithoutSource} {
+			processResp, err := h.ProcessProposalHandler()(ctx, &abci.RequestProcessProposal{Txs: txs})
+			require.NoError(t, err)
+			require.Equal(t, abci.ResponseProcessProposal_REJECT, processResp.Status)
+		}
+	}
+
+	// the proposal with a valid header is accepted without changing the state
+	processResp, err := handlerWithoutSource.ProcessProposalHandler()(ctx, &abci.RequestProcessProposal{Txs: resp.Txs})
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseProcessProposal_ACCEPT, processResp.Status)
+	require.Equal(t, expectedTxs, nextTxs)
+	height, err := consumerKeeper.GetProviderClientLatestHeight(ctx)
+	require.NoError(t, err)
+	require.Equal(t, clienttypes.NewHeight(0, 100), height)
+
+	// the header is applied in PreBlock
+	consumerKeeper.ApplyProviderClientLaneTx(ctx, expectedTxs)
+	height, err = consumerKeeper.GetProviderClientLatestHeight(ctx)
+	require.NoError(t, err)
+	require.Equal(t, clienttypes.NewHeight(0, 100), height)
+	consumerKeeper.ApplyProviderClientLaneTx(ctx, resp.Txs)
+	height, err = consumerKeeper.GetProviderClientLatestHeight(ctx)
+	require.NoError(t, err)
+	require.Equal(t, clienttypes.NewHeight(0, 101), height)
+	expiry, found := consumerKeeper.GetProviderClientExpiry(ctx)
+	require.True(t, found)
+	require.Equal(t, nearExpiry.Add(10*24*time.Hour), expiry)
+
+	// once the client no longer expires soon, the header is no longer injected nor accepted
+	resp, err = handler.PrepareProposalHandler()(ctx, prepareReq)
+	require.NoError(t, err)
+	require.Equal(t, expectedTxs, resp.Txs)
+	processResp, err = handler.ProcessProposalHandler()(ctx, &abci.RequestProcessProposal{Txs: [][]byte{laneTx}})
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseProcessProposal_REJECT, processResp.Status)
+}
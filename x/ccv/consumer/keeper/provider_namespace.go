@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/consumer/types"
+)
+
+// GetActiveProviderId returns the identifier of the provider chain that secures the consumer chain,
+// i.e., DefaultProviderId unless the consumer chain switched provider chains
+func (k Keeper) GetActiveProviderId(ctx sdk.Context) string {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ActiveProviderIdKey())
+	if bz == nil {
+		return types.DefaultProviderId
+	}
+	return string(bz)
+}
+
+// SetActiveProviderId sets the identifier of the provider chain that secures the consumer chain.
+// The state related to a provider chain is read from and written to the namespace of the active provider chain.
+func (k Keeper) SetActiveProviderId(ctx sdk.Context, providerId string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ActiveProviderIdKey(), []byte(providerId))
+}
+
+// ProviderStore returns the store of the state related to the provider chain with `providerId`,
+// i.e., the state stored under the keys returned by types.ProviderScopedKeyPrefixes()
+func (k Keeper) ProviderStore(ctx sdk.Context, providerId string) storetypes.KVStore {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.ProviderNamespaceKeyPrefix(providerId))
+}
+
+// providerStore returns the store of the state related to the active provider chain
+func (k Keeper) providerStore(ctx sdk.Context) storetypes.KVStore {
+	return k.ProviderStore(ctx, k.GetActiveProviderId(ctx))
+}
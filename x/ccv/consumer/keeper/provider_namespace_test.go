@@ -0,0 +1,43 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
+	"github.com/cosmos/interchain-security/v7/x/ccv/consumer/types"
+)
+
+// TestProviderNamespace tests that the state related to the provider chain is namespaced by the active provider id
+func TestProviderNamespace(t *testing.T) {
+	keeperParams := testkeeper.NewInMemKeeperParams(t)
+	consumerKeeper, ctx, ctrl, _ := testkeeper.GetConsumerKeeperAndCtx(t, keeperParams)
+	defer ctrl.Finish()
+
+	require.Equal(t, types.DefaultProviderId, consumerKeeper.GetActiveProviderId(ctx))
+	consumerKeeper.SetProviderClientID(ctx, "clientId")
+	consumerKeeper.SetProviderChannel(ctx, "channel-0")
+	consumerKeeper.SetHeightValsetUpdateID(ctx, 10, 1)
+
+	// the state is stored in the namespace of the default provider chain
+	providerStore := consumerKeeper.ProviderStore(ctx, types.DefaultProviderId)
+	require.Equal(t, []byte("clientId"), providerStore.Get(types.ProviderClientIDKey()))
+	require.Nil(t, ctx.KVStore(keeperParams.StoreKey).Get(types.ProviderClientIDKey()))
+
+	// the state of another provider chain is separate
+	consumerKeeper.SetActiveProviderId(ctx, "1")
+	_, found := consumerKeeper.GetProviderClientID(ctx)
+	require.False(t, found)
+	_, found = consumerKeeper.GetProviderChannel(ctx)
+	require.False(t, found)
+	require.Empty(t, consumerKeeper.GetAllHeightToValsetUpdateIDs(ctx))
+	consumerKeeper.SetProviderClientID(ctx, "otherClientId")
+
+	// the state of the default provider chain is preserved
+	consumerKeeper.SetActiveProviderId(ctx, types.DefaultProviderId)
+	clientID, found := consumerKeeper.GetProviderClientID(ctx)
+	require.True(t, found)
+	require.Equal(t, "clientId", clientID)
+	require.Equal(t, uint64(1), consumerKeeper.GetHeightValsetUpdateID(ctx, 10))
+}
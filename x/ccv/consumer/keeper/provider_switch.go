@@ -0,0 +1,194 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/consumer/types"
+)
+
+// A consumer chain switches to a different provider chain by scheduling a provider switch,
+// i.e., the identifier of the new provider chain, the switch height, and the consumer genesis
+// state issued by the new provider chain once it launched the consumer chain. At the switch height,
+// the state related to the new provider chain is initialized under its own namespace, while the state
+// related to the previous provider chain is preserved under the namespace of the previous provider chain.
+// The VSC packets the previous provider chain sends afterwards are rejected. The switch is registered on the
+// previous provider chain as well (see MsgRegisterConsumerProviderSwitch of the provider module), so that the
+// previous provider chain stops the consumer chain at the switch height rather than as a failed consumer chain.
+
+// GetPendingProviderSwitch returns the pending switch of the consumer chain to a different provider chain
+func (k Keeper) GetPendingProviderSwitch(ctx sdk.Context) (types.ProviderSwitch, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.PendingProviderSwitchKey())
+	if bz == nil {
+		return types.ProviderSwitch{}, false
+	}
+	var providerSwitch types.ProviderSwitch
+	if err := providerSwitch.Unmarshal(bz); err != nil {
+		// An error here would indicate something is very wrong,
+		// the provider switch is assumed to be correctly serialized in SetPendingProviderSwitch.
+		panic(fmt.Errorf("failed to unmarshal pending provider switch: %w", err))
+	}
+	return providerSwitch, true
+}
+
+// SetPendingProviderSwitch sets the pending switch of the consumer chain to a different provider chain
+func (k Keeper) SetPendingProviderSwitch(ctx sdk.Context, providerSwitch types.ProviderSwitch) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := providerSwitch.Marshal()
+	if err != nil {
+		// An error here would indicate something is very wrong,
+		// the provider switch is obtained from a validated message.
+		panic(fmt.Errorf("failed to marshal pending provider switch: %w", err))
+	}
+	store.Set(types.PendingProviderSwitchKey(), bz)
+}
+
+// DeletePendingProviderSwitch deletes the pending switch of the consumer chain to a different provider chain
+func (k Keeper) DeletePendingProviderSwitch(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.PendingProviderSwitchKey())
+}
+
+// GetPreviousProviderId returns the identifier of the provider chain that secured
+// the consumer chain before the last switch of provider chains
+func (k Keeper) GetPreviousProviderId(ctx sdk.Context) (string, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.PreviousProviderIdKey())
+	if bz == nil {
+		return "", false
+	}
+	return string(bz), true
+}
+
+// SetPreviousProviderId sets the identifier of the provider chain that secured
+// the consumer chain before the last switch of provider chains
+func (k Keeper) SetPreviousProviderId(ctx sdk.Context, providerId string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.PreviousProviderIdKey(), []byte(providerId))
+}
+
+// IsPreviousProviderChannel returns whether `channelID` is the CCV channel of the provider chain
+// that secured the consumer chain before the last switch of provider chains
+func (k Keeper) IsPreviousProviderChannel(ctx sdk.Context, channelID string) bool {
+	previousProviderId, found := k.GetPreviousProviderId(ctx)
+	if !found {
+		return false
+	}
+	bz := k.ProviderStore(ctx, previousProviderId).Get(types.ProviderChannelIDKey())
+	return bz != nil && string(bz) == channelID
+}
+
+// ScheduleProviderSwitch schedules the switch of the consumer chain to a different provider chain.
+// The new provider chain must not have secured the consumer chain before, so that its state
+// is initialized in an empty namespace, and the switch height must be in the future.
+func (k Keeper) ScheduleProviderSwitch(ctx sdk.Context, providerSwitch types.ProviderSwitch) error {
+	if err := providerSwitch.Validate(); err != nil {
+		return err
+	}
+	if providerSwitch.SwitchHeight <= ctx.BlockHeight() {
+		return errorsmod.Wrapf(types.ErrInvalidProviderSwitch,
+			"switch height (%d) must be after the current height (%d)", providerSwitch.SwitchHeight, ctx.BlockHeight())
+	}
+	if providerSwitch.ProviderId == k.GetActiveProviderId(ctx) {
+		return errorsmod.Wrapf(types.ErrInvalidProviderSwitch,
+			"provider chain %s already secures the consumer chain", providerSwitch.ProviderId)
+	}
+	if k.ProviderStore(ctx, providerSwitch.ProviderId).Has(types.ProviderClientIDKey()) {
+		return errorsmod.Wrapf(types.ErrInvalidProviderSwitch,
+			"provider chain %s already secured the consumer chain", providerSwitch.ProviderId)
+	}
+
+	k.SetPendingProviderSwitch(ctx, providerSwitch)
+
+	return nil
+}
+
+// EndBlockSwitchProvider switches the consumer chain to the new provider chain of the pending provider switch
+// once the switch height is reached. It returns the validator updates that replace the validator set of the
+// previous provider chain by the initial validator set of the new provider chain, and whether the consumer
+// chain switched provider chains. If the switch fails, the pending provider switch is dropped and the
+// consumer chain remains secured by the previous provider chain.
+func (k Keeper) EndBlockSwitchProvider(ctx sdk.Context) ([]abci.ValidatorUpdate, bool) {
+	providerSwitch, found := k.GetPendingProviderSwitch(ctx)
+	if !found || ctx.BlockHeight() < providerSwitch.SwitchHeight {
+		return nil, false
+	}
+	k.DeletePendingProviderSwitch(ctx)
+
+	previousProviderId := k.GetActiveProviderId(ctx)
+
+	cachedCtx, writeFn := ctx.CacheContext()
+	valUpdates, err := k.switchProvider(cachedCtx, providerSwitch)
+	if err != nil {
+		k.Logger(ctx).Error("cannot switch provider chains",
+			"providerId", providerSwitch.ProviderId,
+			"error", err.Error(),
+		)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeProviderSwitchFailed,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				sdk.NewAttribute(types.AttributeProviderId, providerSwitch.ProviderId),
+				sdk.NewAttribute(types.AttributeSwitchError, err.Error()),
+			),
+		)
+		return nil, false
+	}
+	writeFn()
+
+	k.Logger(ctx).Info("consumer chain switched provider chains",
+		"previousProviderId", previousProviderId,
+		"providerId", providerSwitch.ProviderId,
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeProviderSwitched,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributePreviousProviderId, previousProviderId),
+			sdk.NewAttribute(types.AttributeProviderId, providerSwitch.ProviderId),
+		),
+	)
+
+	return valUpdates, true
+}
+
+// switchProvider initializes the state related to the new provider chain of `providerSwitch`
+// from its consumer genesis state and makes it the active provider chain
+func (k Keeper) switchProvider(ctx sdk.Context, providerSwitch types.ProviderSwitch) ([]abci.ValidatorUpdate, error) {
+	// the validators of the previous provider chain that do not validate
+	// for the new provider chain are given zero voting power
+	previousValset := k.MustGetCurrentValidatorsAsABCIUpdates(ctx)
+
+	k.SetPreviousProviderId(ctx, k.GetActiveProviderId(ctx))
+	k.SetActiveProviderId(ctx, providerSwitch.ProviderId)
+
+	genesis := providerSwitch.Genesis
+	k.SetParams(ctx, genesis.Params)
+	if err := k.initProviderConnection(ctx, genesis.Provider, genesis.ConnectionId); err != nil {
+		return nil, err
+	}
+
+	// populate cross chain validators states with the initial valset of the new provider chain
+	k.ApplyCCValidatorChanges(ctx, genesis.Provider.InitialValSet)
+
+	valUpdates := append([]abci.ValidatorUpdate{}, genesis.Provider.InitialValSet...)
+	newValset := make(map[string]bool)
+	for _, val := range genesis.Provider.InitialValSet {
+		newValset[val.PubKey.String()] = true
+	}
+	for _, val := range previousValset {
+		if !newValset[val.PubKey.String()] {
+			valUpdates = append(valUpdates, abci.ValidatorUpdate{PubKey: val.PubKey, Power: 0})
+		}
+	}
+
+	return valUpdates, nil
+}
@@ -0,0 +1,157 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v10/modules/core/23-commitment/types"
+	ibctmtypes "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/cosmos/interchain-security/v7/testutil/crypto"
+	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
+	consumertypes "github.com/cosmos/interchain-security/v7/x/ccv/consumer/types"
+	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// TestProviderSwitch tests that the consumer chain switches to the new provider chain at the switch height,
+// while the state related to the previous provider chain is preserved
+func TestProviderSwitch(t *testing.T) {
+	consumerKeeper, ctx, ctrl, mocks := testkeeper.GetConsumerKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	ctx = ctx.WithBlockHeight(10)
+
+	// the consumer chain is secured by the default provider chain
+	sharedVal := crypto.NewCryptoIdentityFromIntSeed(1)
+	previousVal := crypto.NewCryptoIdentityFromIntSeed(2)
+	newVal := crypto.NewCryptoIdentityFromIntSeed(3)
+	valUpdate := func(val *crypto.CryptoIdentity, power int64) abci.ValidatorUpdate {
+		return abci.ValidatorUpdate{PubKey: val.TMProtoCryptoPublicKey(), Power: power}
+	}
+	consumerKeeper.SetProviderClientID(ctx, "07-tendermint-0")
+	consumerKeeper.SetProviderChannel(ctx, "channel-0")
+	consumerKeeper.ApplyCCValidatorChanges(ctx, []abci.ValidatorUpdate{
+		valUpdate(sharedVal, 10),
+		valUpdate(previousVal, 20),
+	})
+
+	// the consumer genesis state issued by the new provider chain
+	clientState := ibctmtypes.NewClientState(
+		"provider",
+		ibctmtypes.DefaultTrustLevel,
+		time.Hour,
+		stakingtypes.DefaultUnbondingTime,
+		time.Second*10,
+		clienttypes.NewHeight(0, 5),
+		commitmenttypes.GetSDKSpecs(),
+		[]string{"upgrade", "upgradedIBCState"},
+	)
+	consensusState := ibctmtypes.NewConsensusState(time.Now().UTC(), commitmenttypes.NewMerkleRoot([]byte("apphash")), make([]byte, 32))
+	params := ccv.DefaultParams()
+	params.Enabled = true
+	params.ConsumerRedistributionFraction = "0.5"
+	initialValSet := []abci.ValidatorUpdate{
+		valUpdate(sharedVal, 30),
+		valUpdate(newVal, 40),
+	}
+	providerSwitch := consumertypes.ProviderSwitch{
+		ProviderId:   "1",
+		SwitchHeight: 20,
+		Genesis:      *ccv.NewInitialConsumerGenesisState(clientState, consensusState, initialValSet, false, "", params),
+	}
+
+	// the switch height must be in the future
+	invalidSwitch := providerSwitch
+	invalidSwitch.SwitchHeight = 10
+	require.ErrorIs(t, consumerKeeper.ScheduleProviderSwitch(ctx, invalidSwitch), consumertypes.ErrInvalidProviderSwitch)
+	// the new provider chain must not secure the consumer chain
+	invalidSwitch = providerSwitch
+	invalidSwitch.ProviderId = consumertypes.DefaultProviderId
+	require.ErrorIs(t, consumerKeeper.ScheduleProviderSwitch(ctx, invalidSwitch), consumertypes.ErrInvalidProviderSwitch)
+	// the consumer genesis state must have an initial validator set
+	invalidSwitch = providerSwitch
+	invalidSwitch.Genesis.Provider.InitialValSet = nil
+	require.ErrorIs(t, consumerKeeper.ScheduleProviderSwitch(ctx, invalidSwitch), consumertypes.ErrInvalidProviderSwitch)
+	_, found := consumerKeeper.GetPendingProviderSwitch(ctx)
+	require.False(t, found)
+
+	require.NoError(t, consumerKeeper.ScheduleProviderSwitch(ctx, providerSwitch))
+	pendingSwitch, found := consumerKeeper.GetPendingProviderSwitch(ctx)
+	require.True(t, found)
+	require.Equal(t, providerSwitch, pendingSwitch)
+
+	// a misbehaviour report to the previous provider chain is pending when the consumer chain switches
+	misbehaviour := ibctmtypes.Misbehaviour{ClientId: "07-tendermint-5"}
+	consumerKeeper.SetPendingMisbehaviourReport(ctx, 5, misbehaviour)
+	consumerKeeper.SetMisbehaviourReported(ctx, 5)
+
+	// the consumer chain does not switch before the switch height
+	_, switched := consumerKeeper.EndBlockSwitchProvider(ctx.WithBlockHeight(19))
+	require.False(t, switched)
+
+	// the consumer chain switches at the switch height
+	ctx = ctx.WithBlockHeight(20).WithEventManager(sdk.NewEventManager())
+	clientStateBytes, err := clientState.Marshal()
+	require.NoError(t, err)
+	consensusStateBytes, err := consensusState.Marshal()
+	require.NoError(t, err)
+	// the provider client is created in a cached context
+	mocks.MockClientKeeper.EXPECT().CreateClient(gomock.Any(), "07-tendermint", clientStateBytes, consensusStateBytes).
+		Return("07-tendermint-1", nil).Times(1)
+	valUpdates, switched := consumerKeeper.EndBlockSwitchProvider(ctx)
+	require.True(t, switched)
+	require.ElementsMatch(t, []abci.ValidatorUpdate{
+		valUpdate(sharedVal, 30),
+		valUpdate(newVal, 40),
+		valUpdate(previousVal, 0),
+	}, valUpdates)
+	require.Equal(t, consumertypes.EventTypeProviderSwitched, ctx.EventManager().Events()[0].Type)
+
+	// the state related to the new provider chain is initialized
+	require.Equal(t, "1", consumerKeeper.GetActiveProviderId(ctx))
+	previousProviderId, found := consumerKeeper.GetPreviousProviderId(ctx)
+	require.True(t, found)
+	require.Equal(t, consumertypes.DefaultProviderId, previousProviderId)
+	_, found = consumerKeeper.GetPendingProviderSwitch(ctx)
+	require.False(t, found)
+	clientID, found := consumerKeeper.GetProviderClientID(ctx)
+	require.True(t, found)
+	require.Equal(t, "07-tendermint-1", clientID)
+	_, found = consumerKeeper.GetProviderChannel(ctx)
+	require.False(t, found)
+	require.ElementsMatch(t, initialValSet, consumerKeeper.MustGetCurrentValidatorsAsABCIUpdates(ctx))
+	require.Equal(t, "0.5", consumerKeeper.GetConsumerRedistributionFrac(ctx))
+
+	// the state related to the previous provider chain is preserved
+	providerStore := consumerKeeper.ProviderStore(ctx, consumertypes.DefaultProviderId)
+	require.Equal(t, []byte("channel-0"), providerStore.Get(consumertypes.ProviderChannelIDKey()))
+	require.True(t, providerStore.Has(consumertypes.PendingMisbehaviourReportKey(5)))
+	require.True(t, providerStore.Has(consumertypes.MisbehaviourReportHeightKey(5)))
+
+	// the pending misbehaviour report is not sent to the new provider chain
+	_, _, found = consumerKeeper.GetFirstPendingMisbehaviourReport(ctx)
+	require.False(t, found)
+	require.False(t, consumerKeeper.IsMisbehaviourReported(ctx, 5))
+
+	// the VSC packets of the previous provider chain are rejected
+	require.True(t, consumerKeeper.IsPreviousProviderChannel(ctx, "channel-0"))
+	packet := channeltypes.NewPacket(nil, 1, ccv.ProviderPortID, "channel-0", ccv.ConsumerPortID, "channel-0",
+		clienttypes.NewHeight(1, 0), 0)
+	err = consumerKeeper.OnRecvVSCPacket(ctx, packet, ccv.NewValidatorSetChangePacketData(ccv.NewValsetDiff(), 1, nil))
+	require.ErrorIs(t, err, ccv.ErrUnknownChannel)
+	_, found = consumerKeeper.GetProviderChannel(ctx)
+	require.False(t, found)
+
+	// the consumer chain cannot switch back to a provider chain that already secured it
+	providerSwitch.ProviderId = consumertypes.DefaultProviderId
+	providerSwitch.SwitchHeight = 30
+	require.ErrorIs(t, consumerKeeper.ScheduleProviderSwitch(ctx, providerSwitch), consumertypes.ErrInvalidProviderSwitch)
+}
@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/consumer/types"
+	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// GetRateLimitExemption returns the transfers of the consumer rewards exempted from the rate limits
+func (k Keeper) GetRateLimitExemption(ctx sdk.Context) (exemption types.RateLimitExemption, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.RateLimitExemptionKey())
+	if bz == nil {
+		return exemption, false
+	}
+	if err := exemption.Unmarshal(bz); err != nil {
+		// This should never happen
+		panic(fmt.Errorf("failed to unmarshal rate limit exemption: %w", err))
+	}
+	return exemption, true
+}
+
+// SetRateLimitExemption sets the transfers of the consumer rewards exempted from the rate limits
+func (k Keeper) SetRateLimitExemption(ctx sdk.Context, exemption types.RateLimitExemption) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := exemption.Marshal()
+	if err != nil {
+		// This should never happen
+		panic(fmt.Errorf("failed to marshal rate limit exemption: %w", err))
+	}
+	store.Set(types.RateLimitExemptionKey(), bz)
+}
+
+// DeleteRateLimitExemption deletes the transfers of the consumer rewards exempted from the rate limits
+func (k Keeper) DeleteRateLimitExemption(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.RateLimitExemptionKey())
+}
+
+// UpdateRewardsRateLimitExemption exempts the transfers of the consumer rewards to the provider chain,
+// i.e., the transfers from the ConsumerToSendToProviderName module account to the provider fee pool
+// on the distribution transmission channel, from the rate limits of the IBC rate-limiting middleware.
+// The exemption follows the changes of the distribution transmission channel and of the provider fee pool address,
+// i.e., the previous exemption is removed. It is a no-op if no rate limit keeper was set with WithRateLimitKeeper.
+func (k Keeper) UpdateRewardsRateLimitExemption(ctx sdk.Context) error {
+	if k.rateLimitKeeper == nil {
+		return nil
+	}
+
+	exemption := types.RateLimitExemption{
+		ChannelId: k.GetDistributionTransmissionChannel(ctx),
+		Sender:    k.authKeeper.GetModuleAccount(ctx, types.ConsumerToSendToProviderName).GetAddress().String(),
+		Receiver:  k.GetProviderFeePoolAddrStr(ctx),
+	}
+
+	prevExemption, found := k.GetRateLimitExemption(ctx)
+	if found && prevExemption == exemption {
+		return nil
+	}
+	if found {
+		if err := k.rateLimitKeeper.RemoveRateLimitExemption(ctx,
+			prevExemption.ChannelId, prevExemption.Sender, prevExemption.Receiver); err != nil {
+			return fmt.Errorf("removing rate limit exemption on channel %s: %w", prevExemption.ChannelId, err)
+		}
+		k.DeleteRateLimitExemption(ctx)
+	}
+
+	if exemption.ChannelId == "" || exemption.Receiver == "" {
+		// the distribution transmission channel is not established yet
+		return nil
+	}
+	if err := k.rateLimitKeeper.SetRateLimitExemption(ctx,
+		exemption.ChannelId, exemption.Sender, exemption.Receiver); err != nil {
+		return fmt.Errorf("setting rate limit exemption on channel %s: %w", exemption.ChannelId, err)
+	}
+	k.SetRateLimitExemption(ctx, exemption)
+
+	k.SubsystemLogger(ctx, ccv.LogSubsystemRewards).Info("exempted the transfers of the consumer rewards from the rate limits",
+		"channelID", exemption.ChannelId,
+		"receiver", exemption.Receiver,
+	)
+	return nil
+}
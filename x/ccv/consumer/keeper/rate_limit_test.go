@@ -0,0 +1,72 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
+	consumerkeeper "github.com/cosmos/interchain-security/v7/x/ccv/consumer/keeper"
+	"github.com/cosmos/interchain-security/v7/x/ccv/consumer/types"
+	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// mockRateLimitKeeper records the exemptions from the rate limits
+type mockRateLimitKeeper struct {
+	exemptions map[types.RateLimitExemption]bool
+}
+
+func (m *mockRateLimitKeeper) SetRateLimitExemption(_ context.Context, channelID, sender, receiver string) error {
+	m.exemptions[types.RateLimitExemption{ChannelId: channelID, Sender: sender, Receiver: receiver}] = true
+	return nil
+}
+
+func (m *mockRateLimitKeeper) RemoveRateLimitExemption(_ context.Context, channelID, sender, receiver string) error {
+	delete(m.exemptions, types.RateLimitExemption{ChannelId: channelID, Sender: sender, Receiver: receiver})
+	return nil
+}
+
+// TestUpdateRewardsRateLimitExemption tests that the transfers of the consumer rewards are exempted
+// from the rate limits and that the exemption follows the changes of the distribution transmission channel
+func TestUpdateRewardsRateLimitExemption(t *testing.T) {
+	rateLimitKeeper := &mockRateLimitKeeper{exemptions: map[types.RateLimitExemption]bool{}}
+	keeperParams := testkeeper.NewInMemKeeperParams(t)
+	keeperParams.ConsumerOptions = []consumerkeeper.Option{consumerkeeper.WithRateLimitKeeper(rateLimitKeeper)}
+	consumerKeeper, ctx, ctrl, mocks := testkeeper.GetConsumerKeeperAndCtx(t, keeperParams)
+	defer ctrl.Finish()
+	consumerKeeper.SetParams(ctx, ccv.DefaultParams())
+
+	mAcc := authtypes.NewModuleAccount(&authtypes.BaseAccount{}, types.ConsumerToSendToProviderName)
+	mocks.MockAccountKeeper.EXPECT().GetModuleAccount(ctx, types.ConsumerToSendToProviderName).Return(mAcc).AnyTimes()
+
+	// the distribution transmission channel is not established
+	require.NoError(t, consumerKeeper.UpdateRewardsRateLimitExemption(ctx))
+	_, found := consumerKeeper.GetRateLimitExemption(ctx)
+	require.False(t, found)
+	require.Empty(t, rateLimitKeeper.exemptions)
+
+	consumerKeeper.SetDistributionTransmissionChannel(ctx, "channel-1")
+	consumerKeeper.SetProviderFeePoolAddrStr(ctx, "providerFeePool")
+	require.NoError(t, consumerKeeper.UpdateRewardsRateLimitExemption(ctx))
+	exemption := types.RateLimitExemption{
+		ChannelId: "channel-1",
+		Sender:    mAcc.GetAddress().String(),
+		Receiver:  "providerFeePool",
+	}
+	stored, found := consumerKeeper.GetRateLimitExemption(ctx)
+	require.True(t, found)
+	require.Equal(t, exemption, stored)
+	require.Equal(t, map[types.RateLimitExemption]bool{exemption: true}, rateLimitKeeper.exemptions)
+
+	// the exemption is moved to the new distribution transmission channel
+	consumerKeeper.SetDistributionTransmissionChannel(ctx, "channel-2")
+	require.NoError(t, consumerKeeper.UpdateRewardsRateLimitExemption(ctx))
+	exemption.ChannelId = "channel-2"
+	stored, found = consumerKeeper.GetRateLimitExemption(ctx)
+	require.True(t, found)
+	require.Equal(t, exemption, stored)
+	require.Equal(t, map[types.RateLimitExemption]bool{exemption: true}, rateLimitKeeper.exemptions)
+}
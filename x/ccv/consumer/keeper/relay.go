@@ -10,6 +10,7 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
@@ -31,13 +32,31 @@ func (k Keeper) OnRecvVSCPacket(ctx sdk.Context, packet channeltypes.Packet, new
 		return errorsmod.Wrapf(err, "error validating VSCPacket data")
 	}
 
+	// reject the VSC packets once the consumer chain transitioned to a standalone chain
+	if k.IsStandaloneChain(ctx) {
+		return errorsmod.Wrap(types.ErrStandaloneChain, "VSCPacket received after the transition to a standalone chain")
+	}
+
+	// reject the VSC packets of the provider chain that secured the consumer chain before the last switch;
+	// the error acknowledgement leads the previous provider chain to stop the consumer chain
+	if k.IsPreviousProviderChannel(ctx, packet.DestinationChannel) {
+		return errorsmod.Wrapf(ccv.ErrUnknownChannel,
+			"VSCPacket received on the channel %s of the previous provider chain", packet.DestinationChannel)
+	}
+
 	// get the provider channel
 	providerChannel, found := k.GetProviderChannel(ctx)
 	if found && providerChannel != packet.DestinationChannel {
-		// VSC packet was sent on a channel different than the provider channel;
-		// this should never happen
-		panic(fmt.Errorf("VSCPacket received on unknown channel %s; expected: %s",
-			packet.DestinationChannel, providerChannel))
+		migrationChannel, ok := k.GetMigrationProviderChannel(ctx)
+		if !ok || migrationChannel != packet.DestinationChannel {
+			// VSC packet was sent on a channel different than the provider channel;
+			// this should never happen
+			return errorsmod.Wrapf(ccv.ErrUnknownChannel, "VSCPacket received on unknown channel %s; expected: %s",
+				packet.DestinationChannel, providerChannel)
+		}
+		// the first packet on the migration channel;
+		// the provider sends it only after all the packets on the previous channel were acknowledged
+		k.completeChannelMigration(ctx, providerChannel, migrationChannel)
 	}
 	if !found {
 		// the first packet from the provider chain
@@ -55,22 +74,46 @@ func (k Keeper) OnRecvVSCPacket(ctx sdk.Context, packet channeltypes.Packet, new
 			),
 		)
 	}
+
+	// drop the VSC packets that do not increase the valset update ID, if enabled;
+	// this catches provider or relayer bugs before inconsistent changes are accumulated.
+	// Note that an error acknowledgement is not returned as it would lead to the consumer being
+	// removed by the provider
+	if k.GetStrictVscIdOrdering(ctx) {
+		lastVscID := k.GetLastReceivedValsetUpdateID(ctx)
+		if newChanges.ValsetUpdateId <= lastVscID {
+			err := errorsmod.Wrapf(types.ErrNonMonotonicValsetUpdateID,
+				"vscID %d is not greater than the last received vscID %d", newChanges.ValsetUpdateId, lastVscID)
+			k.Logger(ctx).Error("dropping VSCPacket", "sequence", packet.Sequence, "error", err.Error())
+
+			// alert that the provider or a relayer sent the VSC packets out of order
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeNonMonotonicVSCPacket,
+					sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+					sdk.NewAttribute(ccv.AttributeValSetUpdateID, strconv.FormatUint(newChanges.ValsetUpdateId, 10)),
+					sdk.NewAttribute(types.AttributeLastValSetUpdateID, strconv.FormatUint(lastVscID, 10)),
+					sdk.NewAttribute(ccv.AttributePacketSequence, strconv.FormatUint(packet.Sequence, 10)),
+				),
+			)
+			return nil
+		}
+	}
+
 	// Set pending changes by accumulating changes from this packet with all prior changes
-	currentValUpdates := []abci.ValidatorUpdate{}
-	currentChanges, exists := k.GetPendingChanges(ctx)
-	if exists {
-		currentValUpdates = currentChanges.ValidatorUpdates
+	pendingChanges := newChanges.GetValsetDiff()
+	if currentChanges, exists := k.GetPendingChanges(ctx); exists {
+		pendingChanges = currentChanges.GetValsetDiff().Merge(pendingChanges)
 	}
-	pendingChanges := ccv.AccumulateChanges(currentValUpdates, newChanges.ValidatorUpdates)
 
 	k.SetPendingChanges(ctx, ccv.ValidatorSetChangePacketData{
-		ValidatorUpdates: pendingChanges,
+		ValidatorUpdates: pendingChanges.Updates(),
 	})
 
 	// set height to VSC id mapping
 	blockHeight := uint64(ctx.BlockHeight()) + 1
 	k.SetHeightValsetUpdateID(ctx, blockHeight, newChanges.ValsetUpdateId)
-	k.Logger(ctx).Debug("block height was mapped to vscID", "height", blockHeight, "vscID", newChanges.ValsetUpdateId)
+	k.SubsystemLogger(ctx, ccv.LogSubsystemVSC).Debug("block height was mapped to vscID", "height", blockHeight, "vscID", newChanges.ValsetUpdateId)
 
 	// remove outstanding slashing flags of the validators
 	// for which the slashing was acknowledged by the provider chain
@@ -89,7 +132,7 @@ func (k Keeper) OnRecvVSCPacket(ctx sdk.Context, packet channeltypes.Packet, new
 		k.DeleteOutstandingDowntime(ctx, consAddr)
 	}
 
-	k.Logger(ctx).Info("finished receiving/handling VSCPacket",
+	k.SubsystemLogger(ctx, ccv.LogSubsystemVSC).Info("finished receiving/handling VSCPacket",
 		"vscID", newChanges.ValsetUpdateId,
 		"len updates", len(newChanges.ValidatorUpdates),
 		"len slash acks", len(newChanges.SlashAcks),
@@ -125,7 +168,7 @@ func (k Keeper) QueueSlashPacket(ctx sdk.Context, validator abci.Validator, vals
 		},
 	)
 
-	k.Logger(ctx).Info("SlashPacket enqueued",
+	k.SubsystemLogger(ctx, ccv.LogSubsystemSlash).Info("SlashPacket enqueued",
 		"vscID", slashPacket.ValsetUpdateId,
 		"validator cons addr", sdk.ConsAddress(slashPacket.Validator.Address).String(),
 		"infraction", slashPacket.Infraction,
@@ -164,7 +207,7 @@ func (k Keeper) SendPackets(ctx sdk.Context) {
 		}
 
 		// Send packet over IBC
-		err := ccv.SendIBCPacket(
+		sequence, timeoutTimestamp, err := k.packetSender(
 			ctx,
 			k.channelKeeper,
 			channelID,          // source channel id
@@ -177,6 +220,7 @@ func (k Keeper) SendPackets(ctx sdk.Context) {
 				// IBC client is expired!
 				// leave the packet data stored to be sent once the client is upgraded
 				k.Logger(ctx).Info("IBC client is expired, cannot send IBC packet; leaving packet data stored:", "type", p.Type.String())
+				telemetry.IncrCounter(1, types.MetricNamespace, types.MetricPacketSendFailures, types.SendFailureReasonClientExpired)
 				break
 			}
 			// Not able to send packet over IBC!
@@ -184,12 +228,21 @@ func (k Keeper) SendPackets(ctx sdk.Context) {
 			// Note that if VSCMaturedPackets are not sent for long enough, the provider
 			// will remove the consumer anyway.
 			k.Logger(ctx).Error("cannot send IBC packet; leaving packet data stored:", "type", p.Type.String(), "err", err.Error())
+			telemetry.IncrCounter(1, types.MetricNamespace, types.MetricPacketSendFailures, types.SendFailureReasonOther)
 			break
 		}
+		k.SetPacketTimeout(ctx, channelID, sequence, timeoutTimestamp)
+		telemetry.IncrCounter(1, types.MetricNamespace, types.MetricPacketsSent, types.PacketTypeMetricKey(p.Type))
 		// If the packet that was just sent was a Slash packet, set the waiting on slash reply flag.
 		// This flag will be toggled false again when consumer hears back from provider. See OnAcknowledgementPacket below.
 		if p.Type == ccv.SlashPacket {
 			k.UpdateSlashRecordOnSend(ctx)
+			// Hint relayers to prioritize the relaying of the slash packet
+			ctx.EventManager().EmitEvent(ccv.NewPriorityPacketEvent(
+				types.ModuleName, ccv.ConsumerPortID, channelID,
+				ccv.PacketTypeSlash, ccv.PriorityReasonSlashPacket,
+				sequence, timeoutTimestamp,
+			))
 			// Break so slash stays at head of queue.
 			// This blocks the sending of any other packet until the leading slash packet is handled.
 			// Also see OnAcknowledgementPacket below which will eventually delete the leading slash packet.
@@ -200,28 +253,50 @@ func (k Keeper) SendPackets(ctx sdk.Context) {
 	}
 	// Delete pending packets that were successfully sent and did not return an error from SendIBCPacket
 	k.DeletePendingDataPackets(ctx, idxsForDeletion...)
+	// Report the length of the queue, which grows while the packets cannot be sent, e.g., when the provider client is expired
+	telemetry.SetGauge(float32(len(pending)-len(idxsForDeletion)), types.MetricNamespace, types.MetricPendingPackets)
 }
 
-// OnAcknowledgementPacket executes application logic for acknowledgments of sent VSCMatured and Slash packets
+// OnAcknowledgementPacket executes application logic for acknowledgments of sent VSCMatured, Slash, ConsumerShutdown,
+// ConsumerMisbehaviour, ConsumerHeartbeat, and ConsumerDoubleVote packets
 // in conjunction with the ibc module's execution of "acknowledgePacket",
 // according to https://github.com/cosmos/ibc/tree/main/spec/core/ics-004-channel-and-packet-semantics#processing-acknowledgements
 func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, ack channeltypes.Acknowledgement) error {
+	k.DeletePacketTimeout(ctx, packet.SourceChannel, packet.Sequence)
+
 	if res := ack.GetResult(); res != nil {
 		if len(res) != 1 {
 			return fmt.Errorf("acknowledgement result length must be 1, got %d", len(res))
 		}
 
-		// Unmarshal into V1 consumer packet data type. We trust data is formed correctly
-		// as it was originally marshalled by this module, and consumers must trust the provider
-		// did not tamper with the data. Note ConsumerPacketData.GetBytes() always JSON marshals to the
-		// ConsumerPacketDataV1 type which is sent over the wire.
-		var consumerPacket ccv.ConsumerPacketDataV1
-		ccv.ModuleCdc.MustUnmarshalJSON(packet.GetData(), &consumerPacket)
-		// If this ack is regarding a provider handling a vsc matured packet, there's nothing to do.
-		// As vsc matured packets are popped from the consumer pending packets queue on send.
-		if consumerPacket.Type == ccv.VscMaturedPacket {
+		// Decode the consumer packet data. Note ConsumerPacketData.GetBytes() marshals slash packets
+		// to the ConsumerPacketDataV1 type, while VSC matured packets use the current format.
+		consumerPacket, err := ccv.UnmarshalConsumerPacketData(packet.GetData())
+		if err != nil {
+			// The packet was originally marshalled by this module, so this should never happen.
+			// Skip the acknowledgement rather than failing, so that a malformed packet relayed
+			// by a buggy relayer cannot block the handling of the acknowledgements of the consumer.
+			k.Logger(ctx).Error(
+				"cannot unmarshal consumer packet data of acknowledgement, skipping",
+				"channel", packet.SourceChannel,
+				"sequence", packet.Sequence,
+				"error", err,
+			)
+			telemetry.IncrCounter(1, types.ModuleName, "malformed_ack_packet_data")
+			return nil
+		}
+		// If this ack is regarding a provider handling a vsc matured, a consumer misbehaviour, a consumer heartbeat,
+		// or a consumer double vote packet, there's nothing to do. As these packets are popped from the consumer
+		// pending packets queue on send.
+		if consumerPacket.Type == ccv.VscMaturedPacket || consumerPacket.Type == ccv.ConsumerMisbehaviourPacket ||
+			consumerPacket.Type == ccv.ConsumerHeartbeatPacket || consumerPacket.Type == ccv.ConsumerDoubleVotePacket {
 			return nil
 		}
+		// If this ack is regarding a provider handling a consumer shutdown packet,
+		// the consumer chain was stopped by the provider and the CCV channel can be closed.
+		if consumerPacket.Type == ccv.ConsumerShutdownPacket {
+			return k.OnConsumerShutdownAcknowledged(ctx, packet.SourceChannel)
+		}
 
 		// Otherwise we handle the result of the slash packet acknowledgement.
 		switch res[0] {
@@ -233,7 +308,11 @@ func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Pac
 			k.ClearSlashRecord(ctx)           // Clears slash record state, unblocks sending of pending packets.
 			k.DeleteHeadOfPendingPackets(ctx) // Remove slash from head of queue. It's been handled.
 		case ccv.SlashPacketBouncedResult[0]:
-			k.UpdateSlashRecordOnBounce(ctx)
+			if err := k.UpdateSlashRecordOnBounce(ctx); err != nil {
+				return ccv.HandleUnexpectedState(ctx, types.ModuleName, err)
+			}
+			record, _ := k.GetSlashRecord(ctx)
+			k.EmitSlashPacketBouncedEvent(ctx, record)
 			// Note slash is still at head of queue and will now be retried after appropriate delay period.
 		default:
 			return fmt.Errorf("unrecognized acknowledgement result: %c", res[0])
@@ -241,6 +320,21 @@ func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Pac
 	}
 
 	if err := ack.GetError(); err != "" {
+		// Misbehaviour reports, heartbeats, and forwarded double voting evidence are not essential to the CCV protocol,
+		// e.g., a provider chain running an older version does not support them, so that they do not close the channel
+		if consumerPacket, decodeErr := ccv.UnmarshalConsumerPacketData(packet.GetData()); decodeErr == nil &&
+			(consumerPacket.Type == ccv.ConsumerMisbehaviourPacket || consumerPacket.Type == ccv.ConsumerHeartbeatPacket ||
+				consumerPacket.Type == ccv.ConsumerDoubleVotePacket) {
+			k.Logger(ctx).Error(
+				"consumer packet rejected by the provider",
+				"type", consumerPacket.Type.String(),
+				"channel", packet.SourceChannel,
+				"sequence", packet.Sequence,
+				"error", err,
+			)
+			return nil
+		}
+
 		// Reasons for ErrorAcknowledgment
 		//  - packet data could not be successfully decoded
 		//  - invalid Slash packet
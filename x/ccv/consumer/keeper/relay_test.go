@@ -61,19 +61,19 @@ func TestOnRecvVSCPacket(t *testing.T) {
 	}
 
 	pd := types.NewValidatorSetChangePacketData(
-		changes1,
+		types.NewValsetDiff(changes1...),
 		1,
 		nil,
 	)
 
 	pd2 := types.NewValidatorSetChangePacketData(
-		changes2,
+		types.NewValsetDiff(changes2...),
 		2,
 		nil,
 	)
 
 	pd3 := types.NewValidatorSetChangePacketData(
-		[]abci.ValidatorUpdate{},
+		types.NewValsetDiff(),
 		3,
 		[]string{
 			"invalid_slash_ack",
@@ -207,7 +207,7 @@ func TestOnRecvVSCPacketDuplicateUpdates(t *testing.T) {
 		},
 	}
 	vscData := types.NewValidatorSetChangePacketData(
-		valUpdates,
+		types.NewValsetDiff(valUpdates...),
 		1,
 		nil,
 	)
@@ -231,6 +231,70 @@ func TestOnRecvVSCPacketDuplicateUpdates(t *testing.T) {
 	require.Equal(t, valUpdates[1], gotPendingChanges.ValidatorUpdates[0]) // Only latest update should be kept
 }
 
+// TestOnRecvVSCPacketStrictVscIdOrdering tests that, if the strict VSC ID ordering is enabled,
+// OnRecvVSCPacket drops the VSC packets with a vscID not greater than the last received vscID
+// without returning an error, as an error acknowledgement would lead to the consumer being removed
+func TestOnRecvVSCPacketStrictVscIdOrdering(t *testing.T) {
+	consumerCCVChannelID := "consumerCCVChannelID"
+	providerCCVChannelID := "providerCCVChannelID"
+
+	cId := crypto.NewCryptoIdentityFromIntSeed(43278947)
+	newPacket := func(vscID uint64) (channeltypes.Packet, types.ValidatorSetChangePacketData) {
+		vscData := types.NewValidatorSetChangePacketData(
+			types.NewValsetDiff([]abci.ValidatorUpdate{{PubKey: cId.TMProtoCryptoPublicKey(), Power: int64(vscID)}}...),
+			vscID,
+			nil,
+		)
+		packet := channeltypes.NewPacket(vscData.GetBytes(), vscID, types.ProviderPortID,
+			providerCCVChannelID, types.ConsumerPortID, consumerCCVChannelID, clienttypes.NewHeight(1, 0), 0)
+		return packet, vscData
+	}
+
+	testCases := []struct {
+		name       string
+		strict     bool
+		vscIDs     []uint64
+		expDropped []bool
+	}{
+		{"increasing vscIDs", true, []uint64{1, 2, 5}, []bool{false, false, false}},
+		{"repeated vscID", true, []uint64{1, 2, 2}, []bool{false, false, true}},
+		{"decreasing vscID", true, []uint64{1, 3, 2}, []bool{false, false, true}},
+		{"decreasing vscID with strict ordering disabled", false, []uint64{1, 3, 2}, []bool{false, false, false}},
+	}
+
+	for _, tc := range testCases {
+		consumerKeeper, ctx, ctrl, _ := testkeeper.GetConsumerKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+		params := types.DefaultParams()
+		params.StrictVscIdOrdering = tc.strict
+		consumerKeeper.SetParams(ctx, params)
+		consumerKeeper.SetProviderChannel(ctx, consumerCCVChannelID)
+
+		for i, vscID := range tc.vscIDs {
+			packet, vscData := newPacket(vscID)
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+			pendingChanges, _ := consumerKeeper.GetPendingChanges(ctx)
+			err := consumerKeeper.OnRecvVSCPacket(ctx, packet, vscData)
+			require.NoError(t, err, tc.name)
+			dropped := false
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type == consumertypes.EventTypeNonMonotonicVSCPacket {
+					dropped = true
+				}
+			}
+			require.Equal(t, tc.expDropped[i], dropped, tc.name)
+			if tc.expDropped[i] {
+				// the last received vscID and the pending changes are not updated
+				require.Equal(t, tc.vscIDs[i-1], consumerKeeper.GetLastReceivedValsetUpdateID(ctx), tc.name)
+				gotPendingChanges, _ := consumerKeeper.GetPendingChanges(ctx)
+				require.Equal(t, pendingChanges, gotPendingChanges, tc.name)
+			} else {
+				require.Equal(t, vscID, consumerKeeper.GetLastReceivedValsetUpdateID(ctx), tc.name)
+			}
+		}
+		ctrl.Finish()
+	}
+}
+
 // TestSendPackets tests the SendPackets method failing
 func TestSendPacketsFailure(t *testing.T) {
 	// Keeper setup
@@ -306,6 +370,20 @@ func TestSendPackets(t *testing.T) {
 	// Packet sending not permitted
 	require.False(t, consumerKeeper.PacketSendingPermitted(ctx))
 
+	// A priority event should be emitted for the slash packet
+	priorityEvents := 0
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypePriorityPacket {
+			priorityEvents++
+		}
+	}
+	require.Equal(t, 1, priorityEvents)
+
+	// The timeout of the sent packets should be tracked until they are acknowledged
+	packetTimeouts := consumerKeeper.GetAllPacketTimeouts(ctx, "consumerCCVChannelID")
+	require.Len(t, packetTimeouts, 1)
+	require.Equal(t, uint64(888), packetTimeouts[0].Sequence)
+
 	// Now delete slash record as would be done by a recv SlashPacketHandledResult
 	// then confirm last vsc matured is sent
 	consumerKeeper.ClearSlashRecord(ctx)
@@ -455,6 +533,75 @@ func TestOnAcknowledgementPacketResult(t *testing.T) {
 		slashRecordBefore.SendTime.UnixNano()) // send time NOT updated. Bounce result shouldn't affect that
 }
 
+// TestOnAcknowledgementPacketMalformedData tests that RESULT acknowledgments of packets
+// with malformed data are skipped without failing and without changing the state
+func TestOnAcknowledgementPacketMalformedData(t *testing.T) {
+	consumerKeeper, ctx, ctrl, _ := testkeeper.GetConsumerKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	setupSlashBeforeVscMatured(ctx, &consumerKeeper)
+	slashRecordBefore, found := consumerKeeper.GetSlashRecord(ctx)
+	require.True(t, found)
+	pendingPacketsBefore := consumerKeeper.GetPendingPackets(ctx)
+
+	malformedData := [][]byte{
+		nil,
+		[]byte("invalid"),
+		[]byte(`{"type":"CONSUMER_PACKET_TYPE_SLASH","slashPacketData":"invalid"}`),
+		// v1 VSC matured packets are not valid
+		[]byte(`{"type":"CONSUMER_PACKET_TYPE_VSCM","slashPacketData":{"validator":{"address":null,"power":"0"},"valset_update_id":"1","infraction":"INFRACTION_TYPE_DOWNTIME"}}`),
+	}
+	for _, data := range malformedData {
+		packet := channeltypes.Packet{Data: data, SourceChannel: "channel-0", Sequence: 1}
+		err := consumerKeeper.OnAcknowledgementPacket(ctx, packet, channeltypes.NewResultAcknowledgement(types.SlashPacketHandledResult))
+		require.NoError(t, err)
+
+		slashRecordAfter, found := consumerKeeper.GetSlashRecord(ctx)
+		require.True(t, found)
+		require.Equal(t, slashRecordBefore.WaitingOnReply, slashRecordAfter.WaitingOnReply)
+		require.Equal(t, pendingPacketsBefore, consumerKeeper.GetPendingPackets(ctx))
+	}
+}
+
+// FuzzOnAcknowledgementPacket tests that OnAcknowledgementPacket does not panic
+// for arbitrary packet data and acknowledgement results
+func FuzzOnAcknowledgementPacket(f *testing.F) {
+	slashPacket := types.NewConsumerPacketData(
+		types.SlashPacket,
+		&types.ConsumerPacketData_SlashPacketData{
+			SlashPacketData: types.NewSlashPacketData(
+				abci.Validator{Address: bytes.HexBytes{}, Power: int64(1)}, uint64(1), stakingtypes.Infraction_INFRACTION_DOWNTIME,
+			),
+		},
+	)
+	vscMaturedPacket := types.NewConsumerPacketData(
+		types.VscMaturedPacket,
+		&types.ConsumerPacketData_VscMaturedPacketData{
+			VscMaturedPacketData: types.NewVSCMaturedPacketData(1),
+		},
+	)
+	f.Add(slashPacket.GetBytes(), []byte(types.SlashPacketHandledResult))
+	f.Add(slashPacket.GetBytes(), []byte(types.SlashPacketBouncedResult))
+	f.Add(vscMaturedPacket.GetBytes(), []byte(types.V1Result))
+	f.Add([]byte("invalid"), []byte(types.V1Result))
+	f.Add([]byte{}, []byte{})
+	f.Add([]byte(`{"type":"CONSUMER_PACKET_TYPE_SLASH"}`), []byte{0xff})
+
+	f.Fuzz(func(t *testing.T, data, result []byte) {
+		if len(result) == 0 {
+			return
+		}
+		consumerKeeper, ctx, ctrl, _ := testkeeper.GetConsumerKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+		defer ctrl.Finish()
+		setupSlashBeforeVscMatured(ctx, &consumerKeeper)
+
+		packet := channeltypes.Packet{Data: data, SourceChannel: "channel-0", Sequence: 1}
+		require.NotPanics(t, func() {
+			_ = consumerKeeper.OnAcknowledgementPacket(ctx, packet, channeltypes.NewResultAcknowledgement(result))
+		})
+	})
+}
+
 func setupSlashBeforeVscMatured(ctx sdk.Context, k *consumerkeeper.Keeper) {
 	// clear old state
 	k.ClearSlashRecord(ctx)
@@ -0,0 +1,204 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/consumer/types"
+)
+
+// A consumer chain transitions to a standalone chain, i.e., the reverse of the standalone to consumer changeover,
+// by scheduling a standalone transition, i.e., the transition height and the delegations that seed the staking
+// module of the app. At the transition height, the app seeds its staking module from the final CCV validator set
+// through the StandaloneTransitionHandler set with WithStandaloneTransitionHandler, the validator set of the staking
+// module replaces the CCV validator set, and the consumer chain initiates its shutdown, which leads the provider
+// chain to stop the consumer chain and the CCV channel to be closed (see InitiateConsumerShutdown).
+
+// GetPendingStandaloneTransition returns the pending transition of the consumer chain to a standalone chain
+func (k Keeper) GetPendingStandaloneTransition(ctx sdk.Context) (types.StandaloneTransition, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.PendingStandaloneTransitionKey())
+	if bz == nil {
+		return types.StandaloneTransition{}, false
+	}
+	var transition types.StandaloneTransition
+	if err := transition.Unmarshal(bz); err != nil {
+		// An error here would indicate something is very wrong,
+		// the standalone transition is assumed to be correctly serialized in SetPendingStandaloneTransition.
+		panic(fmt.Errorf("failed to unmarshal pending standalone transition: %w", err))
+	}
+	return transition, true
+}
+
+// SetPendingStandaloneTransition sets the pending transition of the consumer chain to a standalone chain
+func (k Keeper) SetPendingStandaloneTransition(ctx sdk.Context, transition types.StandaloneTransition) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := transition.Marshal()
+	if err != nil {
+		// An error here would indicate something is very wrong,
+		// the standalone transition is obtained from a validated message.
+		panic(fmt.Errorf("failed to marshal pending standalone transition: %w", err))
+	}
+	store.Set(types.PendingStandaloneTransitionKey(), bz)
+}
+
+// DeletePendingStandaloneTransition deletes the pending transition of the consumer chain to a standalone chain
+func (k Keeper) DeletePendingStandaloneTransition(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.PendingStandaloneTransitionKey())
+}
+
+// MarkAsStandaloneChain marks that the consumer chain transitioned to a standalone chain
+func (k Keeper) MarkAsStandaloneChain(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.StandaloneChainKey(), []byte{})
+}
+
+// IsStandaloneChain returns whether the consumer chain transitioned to a standalone chain
+func (k Keeper) IsStandaloneChain(ctx sdk.Context) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.StandaloneChainKey())
+}
+
+// isStandaloneStaking returns whether the staking module of the app replaced
+// the CCV module as the source of the validator set
+func (k Keeper) isStandaloneStaking(ctx sdk.Context) bool {
+	return k.standaloneStakingKeeper != nil && k.IsStandaloneChain(ctx)
+}
+
+// ScheduleStandaloneTransition schedules the transition of the consumer chain to a standalone chain.
+// The app must set a StandaloneTransitionHandler and the transition height must be in the future.
+func (k Keeper) ScheduleStandaloneTransition(ctx sdk.Context, transition types.StandaloneTransition) error {
+	if err := transition.Validate(); err != nil {
+		return err
+	}
+	if k.standaloneTransitionHandler == nil {
+		return errorsmod.Wrap(types.ErrInvalidStandaloneTransition,
+			"the consumer chain does not support the transition to a standalone chain")
+	}
+	if k.IsStandaloneChain(ctx) {
+		return errorsmod.Wrap(types.ErrInvalidStandaloneTransition,
+			"the consumer chain already transitioned to a standalone chain")
+	}
+	if transition.TransitionHeight <= ctx.BlockHeight() {
+		return errorsmod.Wrapf(types.ErrInvalidStandaloneTransition,
+			"transition height (%d) must be after the current height (%d)", transition.TransitionHeight, ctx.BlockHeight())
+	}
+
+	k.SetPendingStandaloneTransition(ctx, transition)
+
+	return nil
+}
+
+// EndBlockStandaloneTransition transitions the consumer chain to a standalone chain once the transition height
+// of the pending standalone transition is reached. It returns the validator updates that replace the CCV
+// validator set by the validator set of the staking module, and whether the consumer chain transitioned.
+// If the transition fails, the pending standalone transition is dropped and the consumer chain remains
+// secured by the provider chain.
+func (k Keeper) EndBlockStandaloneTransition(ctx sdk.Context) ([]abci.ValidatorUpdate, bool) {
+	transition, found := k.GetPendingStandaloneTransition(ctx)
+	if !found || ctx.BlockHeight() < transition.TransitionHeight {
+		return nil, false
+	}
+	k.DeletePendingStandaloneTransition(ctx)
+
+	cachedCtx, writeFn := ctx.CacheContext()
+	valUpdates, err := k.transitionToStandalone(cachedCtx, transition)
+	if err != nil {
+		k.Logger(ctx).Error("cannot transition to a standalone chain",
+			"transitionHeight", transition.TransitionHeight,
+			"error", err.Error(),
+		)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeStandaloneTransitionFail,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				sdk.NewAttribute(types.AttributeTransitionHeight, strconv.FormatInt(transition.TransitionHeight, 10)),
+				sdk.NewAttribute(types.AttributeSwitchError, err.Error()),
+			),
+		)
+		return nil, false
+	}
+	writeFn()
+
+	k.Logger(ctx).Info("consumer chain transitioned to a standalone chain", "len updates", len(valUpdates))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeStandaloneTransition,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeTransitionHeight, strconv.FormatInt(transition.TransitionHeight, 10)),
+			sdk.NewAttribute(types.AttributeNumValidators, strconv.Itoa(len(valUpdates))),
+		),
+	)
+
+	return valUpdates, true
+}
+
+// transitionToStandalone seeds the staking module of the app from the final CCV validator set
+// and the delegations of `transition`, and initiates the shutdown of the consumer chain
+func (k Keeper) transitionToStandalone(ctx sdk.Context, transition types.StandaloneTransition) ([]abci.ValidatorUpdate, error) {
+	// the final CCV validator set, which is kept in state as a snapshot
+	// since the VSC packets are rejected once the chain is standalone
+	valset := k.GetAllCCValidator(ctx)
+	for _, delegation := range transition.Delegations {
+		consAddr, err := k.consensusAddressCodec.StringToBytes(delegation.ConsensusAddress)
+		if err != nil {
+			return nil, err
+		}
+		found := false
+		for _, val := range valset {
+			if bytes.Equal(val.Address, consAddr) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, errorsmod.Wrapf(types.ErrInvalidStandaloneTransition,
+				"validator %s is not in the CCV validator set", delegation.ConsensusAddress)
+		}
+	}
+	// the validators of the CCV validator set that are not in the validator set
+	// of the staking module are given zero voting power
+	ccvValset := k.MustGetCurrentValidatorsAsABCIUpdates(ctx)
+
+	valUpdates, err := k.standaloneTransitionHandler(ctx, valset, transition.Delegations)
+	if err != nil {
+		return nil, err
+	}
+	if len(valUpdates) == 0 {
+		return nil, errorsmod.Wrap(types.ErrInvalidStandaloneTransition,
+			"the validator set of the standalone chain cannot be empty")
+	}
+
+	standaloneValset := make(map[string]bool)
+	for _, val := range valUpdates {
+		standaloneValset[val.PubKey.String()] = true
+	}
+	for _, val := range ccvValset {
+		if !standaloneValset[val.PubKey.String()] {
+			valUpdates = append(valUpdates, abci.ValidatorUpdate{PubKey: val.PubKey, Power: 0})
+		}
+	}
+
+	k.DeletePendingChanges(ctx)
+	k.MarkAsStandaloneChain(ctx)
+
+	// the shutdown packet leads the provider chain to stop the consumer chain;
+	// the chain becomes standalone even if the CCV channel is already closed
+	if !k.IsConsumerShutdownInitiated(ctx) {
+		if err := k.InitiateConsumerShutdown(ctx); err != nil {
+			k.Logger(ctx).Info("consumer shutdown not initiated on the transition to a standalone chain", "reason", err.Error())
+		}
+	}
+
+	return valUpdates, nil
+}
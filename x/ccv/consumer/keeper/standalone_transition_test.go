@@ -0,0 +1,136 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/cosmos/interchain-security/v7/testutil/crypto"
+	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
+	consumerkeeper "github.com/cosmos/interchain-security/v7/x/ccv/consumer/keeper"
+	consumertypes "github.com/cosmos/interchain-security/v7/x/ccv/consumer/types"
+	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// TestStandaloneTransition tests that the consumer chain transitions to a standalone chain at the transition height,
+// with the validator set returned by the standalone transition handler replacing the CCV validator set
+func TestStandaloneTransition(t *testing.T) {
+	stakingVal := crypto.NewCryptoIdentityFromIntSeed(1)
+	ccvOnlyVal := crypto.NewCryptoIdentityFromIntSeed(2)
+	valUpdate := func(val *crypto.CryptoIdentity, power int64) abci.ValidatorUpdate {
+		return abci.ValidatorUpdate{PubKey: val.TMProtoCryptoPublicKey(), Power: power}
+	}
+
+	// the handler stands in for the staking module of the app
+	var handlerErr error
+	var seededDelegations []consumertypes.StandaloneDelegation
+	handler := func(ctx sdk.Context, valset []consumertypes.CrossChainValidator, delegations []consumertypes.StandaloneDelegation) ([]abci.ValidatorUpdate, error) {
+		require.Len(t, valset, 2)
+		seededDelegations = delegations
+		return []abci.ValidatorUpdate{valUpdate(stakingVal, 50)}, handlerErr
+	}
+	keeperParams := testkeeper.NewInMemKeeperParams(t)
+	keeperParams.ConsumerOptions = append(keeperParams.ConsumerOptions, consumerkeeper.WithStandaloneTransitionHandler(handler))
+	consumerKeeper, ctx, ctrl, _ := testkeeper.GetConsumerKeeperAndCtx(t, keeperParams)
+	defer ctrl.Finish()
+	ctx = ctx.WithBlockHeight(10)
+
+	consumerKeeper.ApplyCCValidatorChanges(ctx, []abci.ValidatorUpdate{
+		valUpdate(stakingVal, 10),
+		valUpdate(ccvOnlyVal, 20),
+	})
+
+	transition := consumertypes.StandaloneTransition{
+		TransitionHeight: 20,
+		Delegations: []consumertypes.StandaloneDelegation{{
+			ConsensusAddress: stakingVal.SDKValConsAddress().String(),
+			DelegatorAddress: sdk.AccAddress(stakingVal.SDKValOpAddress()).String(),
+			Amount:           math.NewInt(100),
+		}},
+	}
+
+	// the transition height must be in the future
+	invalidTransition := transition
+	invalidTransition.TransitionHeight = 10
+	require.ErrorIs(t, consumerKeeper.ScheduleStandaloneTransition(ctx, invalidTransition), consumertypes.ErrInvalidStandaloneTransition)
+	_, found := consumerKeeper.GetPendingStandaloneTransition(ctx)
+	require.False(t, found)
+
+	// the transition fails if the handler fails, and the consumer chain remains secured by the provider chain
+	require.NoError(t, consumerKeeper.ScheduleStandaloneTransition(ctx, transition))
+	handlerErr = errors.New("handler error")
+	ctx = ctx.WithBlockHeight(20).WithEventManager(sdk.NewEventManager())
+	_, transitioned := consumerKeeper.EndBlockStandaloneTransition(ctx)
+	require.False(t, transitioned)
+	require.False(t, consumerKeeper.IsStandaloneChain(ctx))
+	require.Equal(t, consumertypes.EventTypeStandaloneTransitionFail, ctx.EventManager().Events()[0].Type)
+	_, found = consumerKeeper.GetPendingStandaloneTransition(ctx)
+	require.False(t, found)
+
+	// the consumer chain does not transition before the transition height
+	handlerErr = nil
+	transition.TransitionHeight = 30
+	require.NoError(t, consumerKeeper.ScheduleStandaloneTransition(ctx, transition))
+	pendingTransition, found := consumerKeeper.GetPendingStandaloneTransition(ctx)
+	require.True(t, found)
+	require.Equal(t, transition, pendingTransition)
+	_, transitioned = consumerKeeper.EndBlockStandaloneTransition(ctx.WithBlockHeight(29))
+	require.False(t, transitioned)
+
+	// the consumer chain transitions at the transition height
+	ctx = ctx.WithBlockHeight(30).WithEventManager(sdk.NewEventManager())
+	valUpdates, transitioned := consumerKeeper.EndBlockStandaloneTransition(ctx)
+	require.True(t, transitioned)
+	require.Equal(t, transition.Delegations, seededDelegations)
+	require.ElementsMatch(t, []abci.ValidatorUpdate{
+		valUpdate(stakingVal, 50),
+		valUpdate(ccvOnlyVal, 0),
+	}, valUpdates)
+	require.True(t, consumerKeeper.IsStandaloneChain(ctx))
+	_, found = consumerKeeper.GetPendingStandaloneTransition(ctx)
+	require.False(t, found)
+	found = false
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == consumertypes.EventTypeStandaloneTransition {
+			found = true
+		}
+	}
+	require.True(t, found)
+
+	// the VSC packets are rejected once the consumer chain is standalone
+	packet := channeltypes.NewPacket(nil, 1, ccv.ProviderPortID, "channel-0", ccv.ConsumerPortID, "channel-0",
+		clienttypes.NewHeight(1, 0), 0)
+	err := consumerKeeper.OnRecvVSCPacket(ctx, packet, ccv.NewValidatorSetChangePacketData(ccv.NewValsetDiff(), 1, nil))
+	require.ErrorIs(t, err, consumertypes.ErrStandaloneChain)
+
+	// the consumer chain cannot transition twice
+	transition.TransitionHeight = 40
+	require.ErrorIs(t, consumerKeeper.ScheduleStandaloneTransition(ctx, transition), consumertypes.ErrInvalidStandaloneTransition)
+}
+
+// TestScheduleStandaloneTransitionWithoutHandler tests that the consumer chain
+// cannot transition to a standalone chain if the app does not support it
+func TestScheduleStandaloneTransitionWithoutHandler(t *testing.T) {
+	consumerKeeper, ctx, ctrl, _ := testkeeper.GetConsumerKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	val := crypto.NewCryptoIdentityFromIntSeed(1)
+	transition := consumertypes.StandaloneTransition{
+		TransitionHeight: ctx.BlockHeight() + 10,
+		Delegations: []consumertypes.StandaloneDelegation{{
+			ConsensusAddress: val.SDKValConsAddress().String(),
+			DelegatorAddress: sdk.AccAddress(val.SDKValOpAddress()).String(),
+			Amount:           math.NewInt(100),
+		}},
+	}
+	require.ErrorIs(t, consumerKeeper.ScheduleStandaloneTransition(ctx, transition), consumertypes.ErrInvalidStandaloneTransition)
+}
@@ -2,10 +2,18 @@ package keeper
 
 import (
 	"fmt"
+	"math"
+	"strconv"
+	"time"
 
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
 
 	consumertypes "github.com/cosmos/interchain-security/v7/x/ccv/consumer/types"
+	ccvtypes "github.com/cosmos/interchain-security/v7/x/ccv/types"
 )
 
 //
@@ -37,6 +45,7 @@ import (
 //
 // - Else if the consumer receives an ack from the provider that the slash packet was bounced (not handled),
 // then SlashRecord.WaitingOnReply is set false, and the consumer retries sending the slash packet after a delay period.
+// The delay period is multiplied by RetryDelayMultiplier after every retry, up to MaxRetryDelayPeriod.
 //
 // Once a retry is sent, the consumer enters a new cycle of the "Standby" state and the process repeats.
 //
@@ -56,30 +65,141 @@ func (k Keeper) PacketSendingPermitted(ctx sdktypes.Context) bool {
 		return false
 	}
 	// If retry delay period has elapsed, we can send again
-	return ctx.BlockTime().After(record.SendTime.Add(k.GetRetryDelayPeriod(ctx)))
+	return ctx.BlockTime().After(k.GetSlashRetryTime(ctx, record))
+}
+
+// GetSlashRetryDelay returns the delay before retrying to send the bounced slash packet
+// recorded in the given slash record. The RetryDelayPeriod is multiplied by the RetryDelayMultiplier
+// for every retry already sent, and the result is capped by the MaxRetryDelayPeriod, if set.
+func (k Keeper) GetSlashRetryDelay(ctx sdktypes.Context, record consumertypes.SlashRecord) time.Duration {
+	delay := k.GetRetryDelayPeriod(ctx)
+	multiplier := k.GetRetryDelayMultiplier(ctx)
+	maxDelay := k.GetMaxRetryDelayPeriod(ctx)
+	if maxDelay <= 0 {
+		maxDelay = time.Duration(math.MaxInt64)
+	}
+
+	// the first attempt uses the RetryDelayPeriod; slash records created
+	// before the attempts were tracked (i.e., Attempts == 0) are treated the same
+	if multiplier.GT(sdkmath.LegacyOneDec()) {
+		for i := uint32(1); i < record.Attempts && delay < maxDelay; i++ {
+			next := sdkmath.LegacyNewDec(int64(delay)).Mul(multiplier)
+			if next.GTE(sdkmath.LegacyNewDec(int64(maxDelay))) {
+				// avoid overflowing the duration
+				return maxDelay
+			}
+			delay = time.Duration(next.TruncateInt64())
+		}
+	}
+	return min(delay, maxDelay)
+}
+
+// GetSlashRetryRemainingDelay returns the remaining delay until the bounced slash packet
+// recorded in the given slash record can be resent. It returns zero if the retry delay period
+// has elapsed or if the consumer is waiting on a reply from the provider.
+func (k Keeper) GetSlashRetryRemainingDelay(ctx sdktypes.Context, record consumertypes.SlashRecord) time.Duration {
+	if record.WaitingOnReply {
+		return 0
+	}
+	remaining := k.GetSlashRetryTime(ctx, record).Sub(ctx.BlockTime())
+	if remaining < 0 {
+		return 0
+	}
+	return remaining
+}
+
+// GetSlashRetryTime returns the time from which the bounced slash packet
+// recorded in the given slash record can be resent
+func (k Keeper) GetSlashRetryTime(ctx sdktypes.Context, record consumertypes.SlashRecord) time.Time {
+	return record.SendTime.Add(k.GetSlashRetryDelay(ctx, record))
+}
+
+// RetrySlashPacket resends the bounced slash packet at the head of the pending packets queue
+// without waiting for the next EndBlock. It returns ErrSlashPacketRetryNotPermitted if there is
+// no bounced slash packet, if the retry delay period has not elapsed, or if the packet could not be sent.
+func (k Keeper) RetrySlashPacket(ctx sdktypes.Context) error {
+	record, found := k.GetSlashRecord(ctx)
+	if !found {
+		return errorsmod.Wrap(consumertypes.ErrSlashPacketRetryNotPermitted, "no slash packet was sent")
+	}
+	if record.WaitingOnReply {
+		return errorsmod.Wrap(consumertypes.ErrSlashPacketRetryNotPermitted, "waiting on a reply from the provider")
+	}
+	if !k.PacketSendingPermitted(ctx) {
+		return errorsmod.Wrapf(consumertypes.ErrSlashPacketRetryNotPermitted,
+			"retry delay period has not elapsed; remaining delay: %s", k.GetSlashRetryRemainingDelay(ctx, record))
+	}
+
+	k.SendPackets(ctx)
+
+	// SendPackets updates the slash record only if the slash packet was sent
+	record, found = k.GetSlashRecord(ctx)
+	if !found || !record.WaitingOnReply {
+		return errorsmod.Wrap(consumertypes.ErrSlashPacketRetryNotPermitted, "slash packet could not be sent")
+	}
+	return nil
 }
 
 func (k Keeper) UpdateSlashRecordOnSend(ctx sdktypes.Context) {
+	attempts := uint32(1)
+	if prevRecord, found := k.GetSlashRecord(ctx); found {
+		// this is a retry
+		attempts = prevRecord.Attempts + 1
+	}
 	record := consumertypes.NewSlashRecord(
 		ctx.BlockTime(), // sendTime
 		true,            // waitingOnReply
 	)
+	record.Attempts = attempts
 	// We don't mind overwriting here, since this is either a retry or the first time we send a slash
 	k.SetSlashRecord(ctx, record)
 }
 
-func (k Keeper) UpdateSlashRecordOnBounce(ctx sdktypes.Context) {
+// UpdateSlashRecordOnBounce marks the slash record as not waiting on a reply, so that
+// the bounced slash packet is retried. It returns ErrSlashRecordNotFound if there is no slash record.
+func (k Keeper) UpdateSlashRecordOnBounce(ctx sdktypes.Context) error {
 	record, found := k.GetSlashRecord(ctx)
 	if !found {
 		// This should never happen
-		panic("could not find slash record, but reply was received from provider")
+		return errorsmod.Wrap(ccvtypes.ErrSlashRecordNotFound, "reply was received from provider")
 	}
 	record.WaitingOnReply = false
 	k.SetSlashRecord(ctx, record)
+	return nil
+}
+
+// EmitSlashPacketBouncedEvent emits an event and updates the telemetry for the slash packet
+// bounced by the provider, i.e., the slash packet at the head of the pending packets queue
+func (k Keeper) EmitSlashPacketBouncedEvent(ctx sdktypes.Context, record consumertypes.SlashRecord) {
+	telemetry.IncrCounter(1, consumertypes.MetricNamespace, consumertypes.MetricSlashPacketBounces)
+	telemetry.SetGauge(float32(k.GetSlashRetryDelay(ctx, record).Seconds()),
+		consumertypes.MetricNamespace, consumertypes.MetricSlashRetryDelay)
+
+	attributes := []sdktypes.Attribute{
+		sdktypes.NewAttribute(sdktypes.AttributeKeyModule, consumertypes.ModuleName),
+		sdktypes.NewAttribute(consumertypes.AttributeSlashBounces, strconv.FormatUint(uint64(record.Bounces()), 10)),
+		sdktypes.NewAttribute(consumertypes.AttributeSlashSendTime, record.SendTime.UTC().Format(time.RFC3339Nano)),
+		sdktypes.NewAttribute(consumertypes.AttributeSlashNextRetryTime, k.GetSlashRetryTime(ctx, record).Format(time.RFC3339Nano)),
+	}
+	if packet, found := k.GetHeadOfPendingPackets(ctx); found {
+		if slashPacket := packet.GetSlashPacketData(); slashPacket != nil {
+			attributes = append(attributes,
+				sdktypes.NewAttribute(ccvtypes.AttributeValidatorAddress, sdktypes.ConsAddress(slashPacket.Validator.Address).String()),
+				sdktypes.NewAttribute(ccvtypes.AttributeInfractionType, slashPacket.Infraction.String()),
+				sdktypes.NewAttribute(ccvtypes.AttributeValSetUpdateID, strconv.FormatUint(slashPacket.ValsetUpdateId, 10)),
+			)
+		}
+	}
+	ctx.EventManager().EmitEvent(sdktypes.NewEvent(consumertypes.EventTypeSlashPacketBounced, attributes...))
+
+	k.Logger(ctx).Info("slash packet bounced by the provider",
+		"bounces", record.Bounces(),
+		"next retry time", k.GetSlashRetryTime(ctx, record),
+	)
 }
 
 func (k Keeper) GetSlashRecord(ctx sdktypes.Context) (record consumertypes.SlashRecord, found bool) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.providerStore(ctx)
 	bz := store.Get(consumertypes.SlashRecordKey())
 	if bz == nil {
 		return record, false
@@ -93,7 +213,7 @@ func (k Keeper) GetSlashRecord(ctx sdktypes.Context) (record consumertypes.Slash
 }
 
 func (k Keeper) SetSlashRecord(ctx sdktypes.Context, record consumertypes.SlashRecord) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.providerStore(ctx)
 	bz, err := record.Marshal()
 	if err != nil {
 		// This should never happen
@@ -103,6 +223,6 @@ func (k Keeper) SetSlashRecord(ctx sdktypes.Context, record consumertypes.SlashR
 }
 
 func (k Keeper) ClearSlashRecord(ctx sdktypes.Context) {
-	store := ctx.KVStore(k.storeKey)
+	store := k.providerStore(ctx)
 	store.Delete(consumertypes.SlashRecordKey())
 }
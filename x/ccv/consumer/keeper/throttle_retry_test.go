@@ -1,12 +1,21 @@
 package keeper_test
 
 import (
+	"math"
+	"strconv"
 	"testing"
 	"time"
 
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
 	"github.com/stretchr/testify/require"
 
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	sdktypes "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
 	testutil "github.com/cosmos/interchain-security/v7/testutil/keeper"
+	consumerkeeper "github.com/cosmos/interchain-security/v7/x/ccv/consumer/keeper"
 	consumertypes "github.com/cosmos/interchain-security/v7/x/ccv/consumer/types"
 	ccvtypes "github.com/cosmos/interchain-security/v7/x/ccv/types"
 )
@@ -35,7 +44,7 @@ func TestPacketSendingPermitted(t *testing.T) {
 	require.False(t, consumerKeeper.PacketSendingPermitted(ctx))
 
 	// Call update that happens when provider bounces slash packet
-	consumerKeeper.UpdateSlashRecordOnBounce(ctx)
+	require.NoError(t, consumerKeeper.UpdateSlashRecordOnBounce(ctx))
 	slashRecord, found = consumerKeeper.GetSlashRecord(ctx)
 	require.True(t, found)
 	require.False(t, slashRecord.WaitingOnReply)
@@ -51,6 +60,62 @@ func TestPacketSendingPermitted(t *testing.T) {
 	require.True(t, consumerKeeper.PacketSendingPermitted(ctx))
 }
 
+// TestGetSlashRetryDelay tests that the retry delay grows with the number of attempts
+// by the retry delay multiplier, up to the max retry delay period
+func TestGetSlashRetryDelay(t *testing.T) {
+	consumerKeeper, ctx, ctrl, _ := testutil.GetConsumerKeeperAndCtx(t, testutil.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	params := ccvtypes.DefaultParams()
+	params.RetryDelayPeriod = time.Hour
+	consumerKeeper.SetParams(ctx, params)
+	ctx = ctx.WithBlockTime(time.Now())
+
+	// the default multiplier keeps the retry delay fixed
+	require.Equal(t, time.Hour, consumerKeeper.GetSlashRetryDelay(ctx, consumertypes.SlashRecord{Attempts: 5}))
+
+	params.RetryDelayMultiplier = "1.5"
+	params.MaxRetryDelayPeriod = 3 * time.Hour
+	consumerKeeper.SetParams(ctx, params)
+	testCases := []struct {
+		attempts uint32
+		expDelay time.Duration
+	}{
+		{0, time.Hour},
+		{1, time.Hour},
+		{2, 90 * time.Minute},
+		{3, 135 * time.Minute},
+		{4, 3 * time.Hour},
+		{math.MaxUint32, 3 * time.Hour},
+	}
+	for _, tc := range testCases {
+		record := consumertypes.SlashRecord{Attempts: tc.attempts}
+		require.Equal(t, tc.expDelay, consumerKeeper.GetSlashRetryDelay(ctx, record), "attempts %d", tc.attempts)
+	}
+
+	// without a max retry delay period, the delay keeps growing without overflowing
+	params.MaxRetryDelayPeriod = 0
+	consumerKeeper.SetParams(ctx, params)
+	require.Equal(t, 135*time.Minute, consumerKeeper.GetSlashRetryDelay(ctx, consumertypes.SlashRecord{Attempts: 3}))
+	require.Equal(t, time.Duration(math.MaxInt64), consumerKeeper.GetSlashRetryDelay(ctx, consumertypes.SlashRecord{Attempts: math.MaxUint32}))
+
+	// the attempts are counted on every send and the backoff applies to the next retry
+	params.MaxRetryDelayPeriod = 3 * time.Hour
+	consumerKeeper.SetParams(ctx, params)
+	consumerKeeper.UpdateSlashRecordOnSend(ctx)
+	require.NoError(t, consumerKeeper.UpdateSlashRecordOnBounce(ctx))
+	consumerKeeper.UpdateSlashRecordOnSend(ctx)
+	require.NoError(t, consumerKeeper.UpdateSlashRecordOnBounce(ctx))
+	record, found := consumerKeeper.GetSlashRecord(ctx)
+	require.True(t, found)
+	require.Equal(t, uint32(2), record.Attempts)
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour + time.Minute))
+	require.False(t, consumerKeeper.PacketSendingPermitted(ctx))
+	require.Equal(t, 29*time.Minute, consumerKeeper.GetSlashRetryRemainingDelay(ctx, record))
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(30 * time.Minute))
+	require.True(t, consumerKeeper.PacketSendingPermitted(ctx))
+}
+
 func TestThrottleRetryCRUD(t *testing.T) {
 	consumerKeeper, ctx, ctrl, _ := testutil.GetConsumerKeeperAndCtx(t, testutil.NewInMemKeeperParams(t))
 	defer ctrl.Finish()
@@ -72,7 +137,7 @@ func TestThrottleRetryCRUD(t *testing.T) {
 	// UpdateSlashRecordOnBounce should set WaitingOnReply to false, and leave SendTime unchanged
 	oldBlocktime := ctx.BlockTime()
 	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
-	consumerKeeper.UpdateSlashRecordOnBounce(ctx)
+	require.NoError(t, consumerKeeper.UpdateSlashRecordOnBounce(ctx))
 	slashRecord, found = consumerKeeper.GetSlashRecord(ctx)
 	require.True(t, found)
 	require.False(t, slashRecord.WaitingOnReply)
@@ -92,3 +157,147 @@ func TestThrottleRetryCRUD(t *testing.T) {
 	require.False(t, found)
 	require.Zero(t, slashRecord)
 }
+
+// TestUpdateSlashRecordOnBounceWithoutRecord tests that a bounce acknowledgement received
+// without a slash record is skipped instead of panicking
+func TestUpdateSlashRecordOnBounceWithoutRecord(t *testing.T) {
+	consumerKeeper, ctx, ctrl, _ := testutil.GetConsumerKeeperAndCtx(t, testutil.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	require.ErrorIs(t, consumerKeeper.UpdateSlashRecordOnBounce(ctx), ccvtypes.ErrSlashRecordNotFound)
+
+	packet := channeltypes.Packet{Data: ccvtypes.NewConsumerPacketData(
+		ccvtypes.SlashPacket,
+		&ccvtypes.ConsumerPacketData_SlashPacketData{SlashPacketData: &ccvtypes.SlashPacketData{ValsetUpdateId: 1}},
+	).GetBytes()}
+	err := consumerKeeper.OnAcknowledgementPacket(ctx, packet, channeltypes.NewResultAcknowledgement(ccvtypes.SlashPacketBouncedResult))
+	require.NoError(t, err)
+	_, found := consumerKeeper.GetSlashRecord(ctx)
+	require.False(t, found)
+	events := ctx.EventManager().Events()
+	require.Equal(t, ccvtypes.EventTypeUnexpectedState, events[len(events)-1].Type)
+}
+
+// TestRetrySlashPacket tests that the bounced slash packet is resent only once the retry delay period has elapsed
+func TestRetrySlashPacket(t *testing.T) {
+	sentPackets := 0
+	packetSender := func(_ sdktypes.Context, _ ccvtypes.ChannelKeeper, _, _ string,
+		_ []byte, _ time.Duration,
+	) (uint64, uint64, error) {
+		sentPackets++
+		return uint64(sentPackets), 100, nil
+	}
+
+	keeperParams := testutil.NewInMemKeeperParams(t)
+	keeperParams.ConsumerOptions = []consumerkeeper.Option{consumerkeeper.WithPacketSender(packetSender)}
+	consumerKeeper, ctx, ctrl, _ := testutil.GetConsumerKeeperAndCtx(t, keeperParams)
+	defer ctrl.Finish()
+	consumerKeeper.SetParams(ctx, ccvtypes.DefaultParams())
+	consumerKeeper.SetProviderChannel(ctx, "consumerCCVChannelID")
+	ctx = ctx.WithBlockTime(time.Now())
+	period := consumerKeeper.GetRetryDelayPeriod(ctx)
+
+	// no slash packet was sent
+	require.ErrorIs(t, consumerKeeper.RetrySlashPacket(ctx), consumertypes.ErrSlashPacketRetryNotPermitted)
+
+	consumerKeeper.AppendPendingPacket(ctx, ccvtypes.SlashPacket, &ccvtypes.ConsumerPacketData_SlashPacketData{
+		SlashPacketData: &ccvtypes.SlashPacketData{ValsetUpdateId: 1},
+	})
+	consumerKeeper.SendPackets(ctx)
+	require.Equal(t, 1, sentPackets)
+
+	// waiting on a reply from the provider
+	require.ErrorIs(t, consumerKeeper.RetrySlashPacket(ctx), consumertypes.ErrSlashPacketRetryNotPermitted)
+	record, _ := consumerKeeper.GetSlashRecord(ctx)
+	require.Zero(t, consumerKeeper.GetSlashRetryRemainingDelay(ctx, record))
+
+	// the slash packet is bounced and the retry delay period has not elapsed
+	require.NoError(t, consumerKeeper.UpdateSlashRecordOnBounce(ctx))
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Minute))
+	record, _ = consumerKeeper.GetSlashRecord(ctx)
+	require.Equal(t, period-time.Minute, consumerKeeper.GetSlashRetryRemainingDelay(ctx, record))
+	require.ErrorIs(t, consumerKeeper.RetrySlashPacket(ctx), consumertypes.ErrSlashPacketRetryNotPermitted)
+	require.Equal(t, 1, sentPackets)
+
+	// the retry delay period has elapsed
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(period))
+	require.Zero(t, consumerKeeper.GetSlashRetryRemainingDelay(ctx, record))
+	require.NoError(t, consumerKeeper.RetrySlashPacket(ctx))
+	require.Equal(t, 2, sentPackets)
+	record, found := consumerKeeper.GetSlashRecord(ctx)
+	require.True(t, found)
+	require.True(t, record.WaitingOnReply)
+	require.Equal(t, ctx.BlockTime(), record.SendTime)
+	// the slash packet stays at the head of the queue until the provider replies
+	require.Len(t, consumerKeeper.GetPendingPackets(ctx), 1)
+}
+
+// TestSlashPacketBounced tests that an event is emitted on every bounce of a slash packet
+// and that the slash record query returns the bounces and the next retry time
+func TestSlashPacketBounced(t *testing.T) {
+	consumerKeeper, ctx, ctrl, _ := testutil.GetConsumerKeeperAndCtx(t, testutil.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	params := ccvtypes.DefaultParams()
+	params.RetryDelayPeriod = time.Hour
+	params.RetryDelayMultiplier = "2"
+	consumerKeeper.SetParams(ctx, params)
+	ctx = ctx.WithBlockTime(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	res, err := consumerKeeper.QuerySlashRecord(ctx, &consumertypes.QuerySlashRecordRequest{})
+	require.NoError(t, err)
+	require.Equal(t, &consumertypes.QuerySlashRecordResponse{}, res)
+
+	valAddr := sdktypes.ConsAddress([]byte("validator_address___"))
+	slashPacket := ccvtypes.NewSlashPacketData(abci.Validator{Address: valAddr, Power: 1}, 1, stakingtypes.Infraction_INFRACTION_DOWNTIME)
+	consumerKeeper.AppendPendingPacket(ctx, ccvtypes.SlashPacket, &ccvtypes.ConsumerPacketData_SlashPacketData{SlashPacketData: slashPacket})
+	consumerKeeper.UpdateSlashRecordOnSend(ctx)
+
+	// the slash packet is in flight
+	res, err = consumerKeeper.QuerySlashRecord(ctx, &consumertypes.QuerySlashRecordRequest{})
+	require.NoError(t, err)
+	require.True(t, res.Found)
+	require.True(t, res.InFlight)
+	require.Equal(t, ctx.BlockTime(), res.SendTime)
+	require.Zero(t, res.Bounces)
+	require.Nil(t, res.NextRetryTime)
+	require.Equal(t, slashPacket, res.SlashPacket)
+	require.Equal(t, valAddr.String(), res.ValidatorConsAddress)
+
+	packet := channeltypes.Packet{Data: ccvtypes.NewConsumerPacketData(
+		ccvtypes.SlashPacket,
+		&ccvtypes.ConsumerPacketData_SlashPacketData{SlashPacketData: slashPacket},
+	).GetBytes()}
+	for bounces := 1; bounces <= 2; bounces++ {
+		ctx = ctx.WithEventManager(sdktypes.NewEventManager())
+		err = consumerKeeper.OnAcknowledgementPacket(ctx, packet, channeltypes.NewResultAcknowledgement(ccvtypes.SlashPacketBouncedResult))
+		require.NoError(t, err)
+
+		// the retry delay doubles after every retry
+		nextRetryTime := ctx.BlockTime().Add(time.Duration(bounces) * time.Hour)
+		events := ctx.EventManager().Events()
+		require.Len(t, events, 1)
+		require.Equal(t, consumertypes.EventTypeSlashPacketBounced, events[0].Type)
+		attributes := map[string]string{}
+		for _, attr := range events[0].Attributes {
+			attributes[attr.Key] = attr.Value
+		}
+		require.Equal(t, strconv.Itoa(bounces), attributes[consumertypes.AttributeSlashBounces])
+		require.Equal(t, nextRetryTime.Format(time.RFC3339Nano), attributes[consumertypes.AttributeSlashNextRetryTime])
+		require.Equal(t, valAddr.String(), attributes[ccvtypes.AttributeValidatorAddress])
+
+		res, err = consumerKeeper.QuerySlashRecord(ctx, &consumertypes.QuerySlashRecordRequest{})
+		require.NoError(t, err)
+		require.False(t, res.InFlight)
+		require.Equal(t, uint32(bounces), res.Bounces)
+		require.Equal(t, nextRetryTime, *res.NextRetryTime)
+
+		// the slash packet is retried
+		ctx = ctx.WithBlockTime(nextRetryTime)
+		consumerKeeper.UpdateSlashRecordOnSend(ctx)
+		res, err = consumerKeeper.QuerySlashRecord(ctx, &consumertypes.QuerySlashRecordRequest{})
+		require.NoError(t, err)
+		require.True(t, res.InFlight)
+		require.Equal(t, uint32(bounces), res.Bounces)
+	}
+}
@@ -45,9 +45,23 @@ func (k Keeper) ApplyCCValidatorChanges(ctx sdk.Context, changes []abci.Validato
 				k.SetCCValidator(ctx, val)
 			}
 		} else if 0 < change.Power {
-			// create a new validator
 			consAddr := sdk.ConsAddress(addr)
 
+			// a validator using the consensus key of a tombstoned or jailed validator of
+			// the previously standalone chain is not added to the consumer validator set;
+			// the update of a jailed validator is deferred until the end of the jail period
+			// (see ReadmitStandaloneValidators)
+			if k.IsStandaloneValidatorExcluded(ctx, consAddr) {
+				k.DeferStandaloneValidatorUpdate(ctx, consAddr, change)
+				k.Logger(ctx).Info("skipping validator update of a tombstoned or jailed standalone validator",
+					"consensus address", consAddr.String(),
+					"power", change.Power,
+				)
+				continue
+			}
+
+			// create a new validator
+
 			ccVal, err := types.NewCCValidator(addr, change.Power, pubkey)
 			if err != nil {
 				// An error here would indicate that the validator updates
@@ -68,7 +82,9 @@ func (k Keeper) ApplyCCValidatorChanges(ctx sdk.Context, changes []abci.Validato
 		} else {
 			// edge case: we received an update for 0 power
 			// but the validator is already deleted. Do not forward
-			// to tendermint.
+			// to tendermint, but drop the deferred update of a
+			// jailed standalone validator, if any.
+			k.DeferStandaloneValidatorUpdate(ctx, sdk.ConsAddress(addr), change)
 			continue
 		}
 
@@ -99,16 +115,22 @@ func (k Keeper) IsValidatorJailed(goCtx context.Context, addr sdk.ConsAddress) (
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	// if the changeover is not complete for prev standalone chain,
+	// or the consumer chain transitioned to a standalone chain,
 	// return the standalone staking keeper's jailed status
-	if k.IsPrevStandaloneChain(ctx) && !k.ChangeoverIsComplete(ctx) {
+	if (k.IsPrevStandaloneChain(ctx) && !k.ChangeoverIsComplete(ctx)) || k.isStandaloneStaking(ctx) {
 		return k.standaloneStakingKeeper.IsValidatorJailed(ctx, addr)
 	}
 	// Otherwise, return the ccv consumer keeper's notion of a validator being jailed
 	return k.OutstandingDowntime(ctx, addr), nil
 }
 
-// ValidatorByConsAddr returns an empty validator
-func (k Keeper) ValidatorByConsAddr(context.Context, sdk.ConsAddress) (stakingtypes.ValidatorI, error) {
+// ValidatorByConsAddr returns an empty validator, unless the consumer chain
+// transitioned to a standalone chain
+func (k Keeper) ValidatorByConsAddr(goCtx context.Context, addr sdk.ConsAddress) (stakingtypes.ValidatorI, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if k.isStandaloneStaking(ctx) {
+		return k.standaloneStakingKeeper.ValidatorByConsAddr(ctx, addr)
+	}
 	/*
 		NOTE:
 
@@ -139,6 +161,11 @@ func (k Keeper) SlashWithInfractionReason(goCtx context.Context, addr sdk.ConsAd
 		return math.ZeroInt(), nil
 	}
 
+	// If the consumer chain transitioned to a standalone chain, slash on the standalone staking keeper.
+	if k.isStandaloneStaking(ctx) {
+		return k.standaloneStakingKeeper.SlashWithInfractionReason(ctx, addr, infractionHeight, power, slashFactor, infraction)
+	}
+
 	// If this is a previously standalone chain and infraction happened before the changeover was completed,
 	// slash only on the standalone staking keeper.
 	if k.IsPrevStandaloneChain(ctx) && infractionHeight < k.FirstConsumerHeight(ctx) {
@@ -177,7 +204,14 @@ func (k Keeper) SlashWithInfractionReason(goCtx context.Context, addr sdk.ConsAd
 // This method should be a no-op even during a standalone to consumer changeover.
 // Once the upgrade has happened as a part of the changeover,
 // the provider validator set will soon be in effect, and jailing is n/a.
-func (k Keeper) Jail(context.Context, sdk.ConsAddress) error { return nil }
+// Once the consumer chain transitioned to a standalone chain, jailing is done on the standalone staking keeper.
+func (k Keeper) Jail(goCtx context.Context, addr sdk.ConsAddress) error {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if k.isStandaloneStaking(ctx) {
+		return k.standaloneStakingKeeper.Jail(ctx, addr)
+	}
+	return nil
+}
 
 // Unjail is enabled for previously standalone chains and chains implementing democracy staking.
 //
@@ -19,7 +19,8 @@ import (
 func TestMigrateConsumerPacketData(t *testing.T) {
 	testingParams := testutil.NewInMemKeeperParams(t)
 	consumerKeeper, ctx, ctrl, _ := testutil.GetConsumerKeeperAndCtx(t, testingParams)
-	testStore := ctx.KVStore(testingParams.StoreKey)
+	// the keeper reads the pending packets from the namespace of the provider chain (see Migrate5to6)
+	testStore := consumerKeeper.ProviderStore(ctx, consumertypes.DefaultProviderId)
 
 	defer ctrl.Finish()
 
@@ -25,6 +25,13 @@ func GetConsumerParamsLegacy(ctx sdk.Context, paramSpace ccvtypes.LegacyParamSub
 		getProviderRewardDenoms(ctx, paramSpace),
 		getRetryDelayPeriod(ctx, paramSpace),
 		"0",
+		false,
+		ccvtypes.DefaultMaxValidatorUpdatesPerBlock,
+		ccvtypes.DefaultRetryDelayMultiplier,
+		ccvtypes.DefaultMaxRetryDelayPeriod,
+		ccvtypes.DefaultClientExpiryWarningThreshold,
+		nil,
+		nil,
 	)
 }
 
@@ -0,0 +1,31 @@
+package v5
+
+import (
+	"bytes"
+
+	storetypes "cosmossdk.io/store/types"
+
+	consumertypes "github.com/cosmos/interchain-security/v7/x/ccv/consumer/types"
+)
+
+// CompactHeightValsetUpdateIDs removes the block height to valset update ID mappings
+// that have the same valset update ID as the mapping of the previous block height.
+// After the migration, the mapping is stored only for the heights where the valset
+// update ID changes, see GetHeightValsetUpdateID.
+func CompactHeightValsetUpdateIDs(store storetypes.KVStore) {
+	iterator := storetypes.KVStorePrefixIterator(store, consumertypes.HeightValsetUpdateIDKeyPrefix())
+	defer iterator.Close()
+
+	var keysToDel [][]byte
+	var prevValue []byte
+	for ; iterator.Valid(); iterator.Next() {
+		if prevValue != nil && bytes.Equal(prevValue, iterator.Value()) {
+			keysToDel = append(keysToDel, iterator.Key())
+			continue
+		}
+		prevValue = iterator.Value()
+	}
+	for _, delKey := range keysToDel {
+		store.Delete(delKey)
+	}
+}
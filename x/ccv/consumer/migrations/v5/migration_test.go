@@ -0,0 +1,41 @@
+package v5
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+
+	consumertypes "github.com/cosmos/interchain-security/v7/x/ccv/consumer/types"
+)
+
+func TestCompactHeightValsetUpdateIDs(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey("ccvconsumer")
+	ctx := testutil.DefaultContext(storeKey, storetypes.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(storeKey)
+
+	// dense mapping, i.e., every height is mapped to a vscID
+	vscIDs := []uint64{0, 0, 0, 1, 1, 2, 2, 2, 2, 5, 0}
+	for i, vscID := range vscIDs {
+		valBytes := make([]byte, 8)
+		binary.BigEndian.PutUint64(valBytes, vscID)
+		store.Set(consumertypes.HeightValsetUpdateIDKey(uint64(10+i)), valBytes)
+	}
+
+	CompactHeightValsetUpdateIDs(store)
+
+	// only the heights where the vscID changes are kept
+	expected := map[uint64]uint64{10: 0, 13: 1, 15: 2, 19: 5, 20: 0}
+	iterator := storetypes.KVStorePrefixIterator(store, consumertypes.HeightValsetUpdateIDKeyPrefix())
+	defer iterator.Close()
+	actual := map[uint64]uint64{}
+	for ; iterator.Valid(); iterator.Next() {
+		height := binary.BigEndian.Uint64(iterator.Key()[1:])
+		actual[height] = binary.BigEndian.Uint64(iterator.Value())
+	}
+	require.Equal(t, expected, actual)
+}
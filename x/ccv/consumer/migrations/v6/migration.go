@@ -0,0 +1,31 @@
+package v6
+
+import (
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	consumertypes "github.com/cosmos/interchain-security/v7/x/ccv/consumer/types"
+)
+
+// MigrateToProviderNamespace moves the state related to the provider chain, i.e., the keys
+// with the prefixes returned by consumertypes.ProviderScopedKeyPrefixes(), to the namespace
+// of the provider chain with consumertypes.DefaultProviderId. The keys are preserved
+// within the namespace, so that the keeper reads the same state after the migration.
+func MigrateToProviderNamespace(store storetypes.KVStore) {
+	providerStore := prefix.NewStore(store, consumertypes.ProviderNamespaceKeyPrefix(consumertypes.DefaultProviderId))
+
+	for _, keyPrefix := range consumertypes.ProviderScopedKeyPrefixes() {
+		iterator := storetypes.KVStorePrefixIterator(store, []byte{keyPrefix})
+		var keys, values [][]byte
+		for ; iterator.Valid(); iterator.Next() {
+			keys = append(keys, iterator.Key())
+			values = append(values, iterator.Value())
+		}
+		iterator.Close()
+
+		for i, key := range keys {
+			providerStore.Set(key, values[i])
+			store.Delete(key)
+		}
+	}
+}
@@ -0,0 +1,61 @@
+package v6_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	testutil "github.com/cosmos/interchain-security/v7/testutil/keeper"
+	v6 "github.com/cosmos/interchain-security/v7/x/ccv/consumer/migrations/v6"
+	consumertypes "github.com/cosmos/interchain-security/v7/x/ccv/consumer/types"
+	ccvtypes "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+func TestMigrateToProviderNamespace(t *testing.T) {
+	testingParams := testutil.NewInMemKeeperParams(t)
+	consumerKeeper, ctx, ctrl, _ := testutil.GetConsumerKeeperAndCtx(t, testingParams)
+	defer ctrl.Finish()
+	store := ctx.KVStore(testingParams.StoreKey)
+
+	// set state with the layout prior to the migration
+	consumerKeeper.SetParams(ctx, ccvtypes.DefaultParams())
+	store.Set(consumertypes.ProviderClientIDKey(), []byte("07-tendermint-0"))
+	store.Set(consumertypes.ProviderChannelIDKey(), []byte("channel-0"))
+	store.Set(consumertypes.HeightValsetUpdateIDKey(10), sdk.Uint64ToBigEndian(5))
+	store.Set(consumertypes.HeightValsetUpdateIDKey(20), sdk.Uint64ToBigEndian(6))
+	store.Set(consumertypes.OutstandingDowntimeKey(sdk.ConsAddress("validator")), []byte{})
+	expiry := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	expiryBz, err := expiry.MarshalBinary()
+	require.NoError(t, err)
+	store.Set(consumertypes.ProviderClientExpiryKey(), expiryBz)
+
+	v6.MigrateToProviderNamespace(store)
+
+	// the provider state is no longer stored under the previous keys
+	for _, keyPrefix := range consumertypes.ProviderScopedKeyPrefixes() {
+		iterator := store.Iterator([]byte{keyPrefix}, []byte{keyPrefix + 1})
+		require.False(t, iterator.Valid(), "state left under prefix %d", keyPrefix)
+		iterator.Close()
+	}
+
+	// the keeper reads the same state after the migration
+	require.Equal(t, consumertypes.DefaultProviderId, consumerKeeper.GetActiveProviderId(ctx))
+	clientID, found := consumerKeeper.GetProviderClientID(ctx)
+	require.True(t, found)
+	require.Equal(t, "07-tendermint-0", clientID)
+	channelID, found := consumerKeeper.GetProviderChannel(ctx)
+	require.True(t, found)
+	require.Equal(t, "channel-0", channelID)
+	require.Equal(t, uint64(5), consumerKeeper.GetHeightValsetUpdateID(ctx, 15))
+	require.Equal(t, uint64(6), consumerKeeper.GetHeightValsetUpdateID(ctx, 25))
+	require.True(t, consumerKeeper.OutstandingDowntime(ctx, sdk.ConsAddress("validator")))
+	storedExpiry, found := consumerKeeper.GetProviderClientExpiry(ctx)
+	require.True(t, found)
+	require.Equal(t, expiry, storedExpiry)
+
+	// the state not related to the provider chain is not moved
+	require.Equal(t, ccvtypes.DefaultParams(), consumerKeeper.GetConsumerParams(ctx))
+}
@@ -0,0 +1,32 @@
+package v7
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	consumertypes "github.com/cosmos/interchain-security/v7/x/ccv/consumer/types"
+	ccvtypes "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// MigrateClientExpiryWarningThreshold initializes the ClientExpiryWarningThreshold param to its default value,
+// unless the param is already set
+func MigrateClientExpiryWarningThreshold(cdc codec.BinaryCodec, store storetypes.KVStore) error {
+	var params ccvtypes.ConsumerParams
+	if err := cdc.Unmarshal(store.Get(consumertypes.ParametersKey()), &params); err != nil {
+		return err
+	}
+	if params.ClientExpiryWarningThreshold == 0 {
+		params.ClientExpiryWarningThreshold = ccvtypes.DefaultClientExpiryWarningThreshold
+	}
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+	store.Set(consumertypes.ParametersKey(), bz)
+	return nil
+}
@@ -0,0 +1,49 @@
+package v7
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+
+	consumertypes "github.com/cosmos/interchain-security/v7/x/ccv/consumer/types"
+	ccvtypes "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+func TestMigrateClientExpiryWarningThreshold(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey("ccvconsumer")
+	ctx := testutil.DefaultContext(storeKey, storetypes.NewTransientStoreKey("transient_test"))
+	store := ctx.KVStore(storeKey)
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+	setParams := func(params ccvtypes.ConsumerParams) {
+		store.Set(consumertypes.ParametersKey(), cdc.MustMarshal(&params))
+	}
+	getParams := func() (params ccvtypes.ConsumerParams) {
+		cdc.MustUnmarshal(store.Get(consumertypes.ParametersKey()), &params)
+		return params
+	}
+
+	// the params before the migration do not contain the ClientExpiryWarningThreshold param
+	params := ccvtypes.DefaultParams()
+	params.ClientExpiryWarningThreshold = 0
+	setParams(params)
+
+	require.NoError(t, MigrateClientExpiryWarningThreshold(cdc, store))
+	params = getParams()
+	require.Equal(t, ccvtypes.DefaultClientExpiryWarningThreshold, params.ClientExpiryWarningThreshold)
+	require.NoError(t, params.Validate())
+
+	// an already set ClientExpiryWarningThreshold param is kept
+	params.ClientExpiryWarningThreshold = time.Hour
+	setParams(params)
+
+	require.NoError(t, MigrateClientExpiryWarningThreshold(cdc, store))
+	require.Equal(t, time.Hour, getParams().ClientExpiryWarningThreshold)
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
@@ -91,7 +92,7 @@ func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *r
 
 // GetTxCmd implements AppModuleBasic interface
 func (AppModuleBasic) GetTxCmd() *cobra.Command {
-	return nil
+	return cli.GetTxCmd()
 }
 
 // GetQueryCmd implements AppModuleBasic interface
@@ -134,6 +135,15 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	if err := cfg.RegisterMigration(consumertypes.ModuleName, 3, m.Migrate3to4); err != nil {
 		panic(fmt.Sprintf("failed to register migrator for %s: %s -- from 3 -> 4", consumertypes.ModuleName, err))
 	}
+	if err := cfg.RegisterMigration(consumertypes.ModuleName, 4, m.Migrate4to5); err != nil {
+		panic(fmt.Sprintf("failed to register migrator for %s: %s -- from 4 -> 5", consumertypes.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(consumertypes.ModuleName, 5, m.Migrate5to6); err != nil {
+		panic(fmt.Sprintf("failed to register migrator for %s: %s -- from 5 -> 6", consumertypes.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(consumertypes.ModuleName, 6, m.Migrate6to7); err != nil {
+		panic(fmt.Sprintf("failed to register migrator for %s: %s -- from 6 -> 7", consumertypes.ModuleName, err))
+	}
 }
 
 // InitGenesis performs genesis initialization for the consumer module. It returns
@@ -153,11 +163,10 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 
 // ConsensusVersion implements AppModule/ConsensusVersion.
 func (AppModule) ConsensusVersion() uint64 {
-	return 4
+	return consumertypes.ConsensusVersion
 }
 
 // BeginBlock implements the AppModule interface
-// Set the VSC ID for the subsequent block to the same value as the current block
 // Panic if the provider's channel was established and then closed
 func (am AppModule) BeginBlock(goCtx context.Context) error {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -165,21 +174,25 @@ func (am AppModule) BeginBlock(goCtx context.Context) error {
 	channelID, found := am.keeper.GetProviderChannel(ctx)
 	if found && am.keeper.IsChannelClosed(ctx, channelID) {
 		// The CCV channel was established, but it was then closed;
-		// the consumer chain is not secured anymore, but we allow it to run as a POA chain and log an error.
-		channelClosedMsg := fmt.Sprintf("CCV channel %q was closed - shutdown consumer chain since it is not secured anymore", channelID)
+		// the consumer chain is not secured until a new CCV channel replaces the closed one,
+		// but we allow it to run as a POA chain and log an error.
+		channelClosedMsg := fmt.Sprintf("CCV channel %q was closed - the consumer chain is not secured until a new CCV channel is established", channelID)
 		am.keeper.Logger(ctx).Error(channelClosedMsg)
 	}
 
-	// map next block height to the vscID of the current block height
-	blockHeight := uint64(ctx.BlockHeight())
-	vID := am.keeper.GetHeightValsetUpdateID(ctx, blockHeight)
-	am.keeper.SetHeightValsetUpdateID(ctx, blockHeight+1, vID)
-	am.keeper.Logger(ctx).Debug("block height was mapped to vscID", "height", blockHeight+1, "vscID", vID)
-
 	err := am.keeper.TrackHistoricalInfo(ctx)
 	if err != nil {
 		am.keeper.Logger(ctx).Warn("failed to track historical info", "error", err)
 	}
+
+	// record the expiry time of the client to the provider and warn if the client is about to expire
+	am.keeper.UpdateProviderClientExpiry(ctx)
+
+	// record the validators that signed the previous block for the next heartbeat
+	am.keeper.TrackHeartbeatSigners(ctx)
+
+	// signal the double voting misbehaviour committed by CometBFT, which is also handled by the evidence module
+	am.keeper.TrackCommittedDoubleVotes(ctx)
 	return nil
 }
 
@@ -197,19 +210,51 @@ func (am AppModule) EndBlock(goCtx context.Context) ([]abci.ValidatorUpdate, err
 		return initialValUpdates, nil
 	}
 
+	// If the consumer chain transitioned to a standalone chain, the validator set
+	// is given by the staking module and only the remaining packets are sent
+	if am.keeper.IsStandaloneChain(ctx) {
+		am.keeper.SendPackets(ctx)
+		return []abci.ValidatorUpdate{}, nil
+	}
+
+	// If the switch height of a pending provider switch is reached,
+	// replace the validator set of the previous provider chain
+	if valUpdates, switched := am.keeper.EndBlockSwitchProvider(ctx); switched {
+		return valUpdates, nil
+	}
+
+	// If the transition height of a pending standalone transition is reached,
+	// replace the CCV validator set by the validator set of the staking module
+	if valUpdates, transitioned := am.keeper.EndBlockStandaloneTransition(ctx); transitioned {
+		return valUpdates, nil
+	}
+
 	// Execute EndBlock logic for the Reward Distribution sub-protocol
+	start := telemetry.Now()
 	am.keeper.EndBlockRD(ctx)
+	ccvtypes.MeasureBlockStage(consumertypes.ModuleName, start, telemetry.MetricKeyEndBlocker, ccvtypes.BlockStageRewards)
+
+	// report the validators that signed blocks to the provider, once every BlocksPerDistributionTransmission blocks
+	am.keeper.QueueHeartbeat(ctx)
+
+	// send at most one of the verified misbehaviour reports to the provider
+	am.keeper.QueueMisbehaviourReport(ctx)
 
 	// panics on invalid packets and unexpected send errors
+	start = telemetry.Now()
 	am.keeper.SendPackets(ctx)
+	ccvtypes.MeasureBlockStage(consumertypes.ModuleName, start, telemetry.MetricKeyEndBlocker, ccvtypes.BlockStageSendPackets)
 
-	data, ok := am.keeper.GetPendingChanges(ctx)
-	if !ok {
+	changes, _ := am.keeper.DequeuePendingChanges(ctx)
+	// add the validators using the consensus keys of standalone validators whose jail period elapsed
+	changes = am.keeper.ReadmitStandaloneValidators(ctx, changes)
+	if len(changes) == 0 {
 		return []abci.ValidatorUpdate{}, nil
 	}
 	// apply changes to cross-chain validator set
-	tendermintUpdates := am.keeper.ApplyCCValidatorChanges(ctx, data.ValidatorUpdates)
-	am.keeper.DeletePendingChanges(ctx)
+	start = telemetry.Now()
+	tendermintUpdates := am.keeper.ApplyCCValidatorChanges(ctx, changes)
+	ccvtypes.MeasureBlockStage(consumertypes.ModuleName, start, telemetry.MetricKeyEndBlocker, ccvtypes.BlockStageApplyValsetChanges)
 
 	am.keeper.Logger(ctx).Debug("sending validator updates to consensus engine", "len updates", len(tendermintUpdates))
 
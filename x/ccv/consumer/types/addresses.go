@@ -0,0 +1,19 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// ConsumerRedistributeAddress returns the address of the consumer module account holding
+// the share of the consumer rewards that remains on the consumer chain
+func ConsumerRedistributeAddress() sdk.AccAddress {
+	return ccv.ModuleAddress(ConsumerRedistributeName)
+}
+
+// ConsumerToSendToProviderAddress returns the address of the consumer module account holding
+// the ICS rewards until they are sent to the provider chain, i.e., the sender of the reward transfers
+func ConsumerToSendToProviderAddress() sdk.AccAddress {
+	return ccv.ModuleAddress(ConsumerToSendToProviderName)
+}
@@ -1,16 +1,28 @@
 package types
 
 import (
+	"cosmossdk.io/x/evidence/exported"
+
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
 )
 
-// RegisterInterfaces registers the consumer Tx message types to the interface registry
+// RegisterInterfaces registers the consumer Tx message types and the consumer evidence types
+// to the interface registry
 func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
 		&MsgUpdateParams{},
+		&MsgRetrySlashPacket{},
+		&MsgScheduleProviderSwitch{},
+		&MsgInitiateConsumerShutdown{},
+		&MsgScheduleStandaloneTransition{},
+		&MsgReportMisbehaviour{},
+	)
+	registry.RegisterImplementations(
+		(*exported.Evidence)(nil),
+		&ConsumerDoubleVoteEvidence{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
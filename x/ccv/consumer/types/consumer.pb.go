@@ -4,12 +4,15 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	types2 "github.com/cometbft/cometbft/abci/types"
 	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	types1 "github.com/cosmos/interchain-security/v7/x/ccv/types"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -114,6 +117,9 @@ func (m *CrossChainValidator) GetOptedOut() bool {
 type SlashRecord struct {
 	WaitingOnReply bool      `protobuf:"varint,1,opt,name=waiting_on_reply,json=waitingOnReply,proto3" json:"waiting_on_reply,omitempty"`
 	SendTime       time.Time `protobuf:"bytes,2,opt,name=send_time,json=sendTime,proto3,stdtime" json:"send_time"`
+	// the number of times the slash packet was sent, including the retries;
+	// used to compute the delay before the next retry
+	Attempts uint32 `protobuf:"varint,3,opt,name=attempts,proto3" json:"attempts,omitempty"`
 }
 
 func (m *SlashRecord) Reset()         { *m = SlashRecord{} }
@@ -163,9 +169,485 @@ func (m *SlashRecord) GetSendTime() time.Time {
 	return time.Time{}
 }
 
+func (m *SlashRecord) GetAttempts() uint32 {
+	if m != nil {
+		return m.Attempts
+	}
+	return 0
+}
+
+// RateLimitExemption describes the ICS-20 transfers of the consumer rewards to the provider chain
+// that are exempted from the rate limits of an IBC rate-limiting middleware.
+//
+// Note this type is only used internally to the consumer CCV module.
+type RateLimitExemption struct {
+	// the distribution transmission channel
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// the address of the module account sending the rewards to the provider chain
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	// the address of the fee pool on the provider chain
+	Receiver string `protobuf:"bytes,3,opt,name=receiver,proto3" json:"receiver,omitempty"`
+}
+
+func (m *RateLimitExemption) Reset()         { *m = RateLimitExemption{} }
+func (m *RateLimitExemption) String() string { return proto.CompactTextString(m) }
+func (*RateLimitExemption) ProtoMessage()    {}
+func (*RateLimitExemption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b27a82b276e7f93, []int{2}
+}
+func (m *RateLimitExemption) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimitExemption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimitExemption.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RateLimitExemption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimitExemption.Merge(m, src)
+}
+func (m *RateLimitExemption) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimitExemption) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimitExemption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimitExemption proto.InternalMessageInfo
+
+func (m *RateLimitExemption) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *RateLimitExemption) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *RateLimitExemption) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+// ProviderSwitch describes the switch of the consumer chain to a different provider chain.
+//
+// Note this type is only used internally to the consumer CCV module.
+type ProviderSwitch struct {
+	// the identifier of the new provider chain, under which the state related
+	// to the new provider chain is namespaced
+	ProviderId string `protobuf:"bytes,1,opt,name=provider_id,json=providerId,proto3" json:"provider_id,omitempty"`
+	// the height at which the consumer chain switches to the new provider chain
+	SwitchHeight int64 `protobuf:"varint,2,opt,name=switch_height,json=switchHeight,proto3" json:"switch_height,omitempty"`
+	// the consumer genesis state issued by the new provider chain,
+	// i.e., as returned by its consumer genesis query
+	Genesis types1.ConsumerGenesisState `protobuf:"bytes,3,opt,name=genesis,proto3" json:"genesis"`
+}
+
+func (m *ProviderSwitch) Reset()         { *m = ProviderSwitch{} }
+func (m *ProviderSwitch) String() string { return proto.CompactTextString(m) }
+func (*ProviderSwitch) ProtoMessage()    {}
+func (*ProviderSwitch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b27a82b276e7f93, []int{3}
+}
+func (m *ProviderSwitch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProviderSwitch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProviderSwitch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProviderSwitch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProviderSwitch.Merge(m, src)
+}
+func (m *ProviderSwitch) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProviderSwitch) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProviderSwitch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProviderSwitch proto.InternalMessageInfo
+
+func (m *ProviderSwitch) GetProviderId() string {
+	if m != nil {
+		return m.ProviderId
+	}
+	return ""
+}
+
+func (m *ProviderSwitch) GetSwitchHeight() int64 {
+	if m != nil {
+		return m.SwitchHeight
+	}
+	return 0
+}
+
+func (m *ProviderSwitch) GetGenesis() types1.ConsumerGenesisState {
+	if m != nil {
+		return m.Genesis
+	}
+	return types1.ConsumerGenesisState{}
+}
+
+// StandaloneTransition describes the transition of the consumer chain to a standalone chain.
+//
+// Note this type is only used internally to the consumer CCV module.
+type StandaloneTransition struct {
+	// the height at which the consumer chain becomes a standalone chain
+	TransitionHeight int64 `protobuf:"varint,1,opt,name=transition_height,json=transitionHeight,proto3" json:"transition_height,omitempty"`
+	// the delegations seeding the staking module of the standalone chain
+	Delegations []StandaloneDelegation `protobuf:"bytes,2,rep,name=delegations,proto3" json:"delegations"`
+}
+
+func (m *StandaloneTransition) Reset()         { *m = StandaloneTransition{} }
+func (m *StandaloneTransition) String() string { return proto.CompactTextString(m) }
+func (*StandaloneTransition) ProtoMessage()    {}
+func (*StandaloneTransition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b27a82b276e7f93, []int{4}
+}
+func (m *StandaloneTransition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StandaloneTransition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StandaloneTransition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StandaloneTransition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StandaloneTransition.Merge(m, src)
+}
+func (m *StandaloneTransition) XXX_Size() int {
+	return m.Size()
+}
+func (m *StandaloneTransition) XXX_DiscardUnknown() {
+	xxx_messageInfo_StandaloneTransition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StandaloneTransition proto.InternalMessageInfo
+
+func (m *StandaloneTransition) GetTransitionHeight() int64 {
+	if m != nil {
+		return m.TransitionHeight
+	}
+	return 0
+}
+
+func (m *StandaloneTransition) GetDelegations() []StandaloneDelegation {
+	if m != nil {
+		return m.Delegations
+	}
+	return nil
+}
+
+// StandaloneDelegation describes the self-delegation that bonds a validator
+// of the final CCV validator set in the staking module of the standalone chain.
+type StandaloneDelegation struct {
+	// the consensus address of the validator in the final CCV validator set
+	ConsensusAddress string `protobuf:"bytes,1,opt,name=consensus_address,json=consensusAddress,proto3" json:"consensus_address,omitempty"`
+	// the address of the account operating the validator on the standalone chain
+	DelegatorAddress string `protobuf:"bytes,2,opt,name=delegator_address,json=delegatorAddress,proto3" json:"delegator_address,omitempty"`
+	// the amount of bond denom tokens delegated to the validator;
+	// if zero, the tokens matching the CCV voting power of the validator
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *StandaloneDelegation) Reset()         { *m = StandaloneDelegation{} }
+func (m *StandaloneDelegation) String() string { return proto.CompactTextString(m) }
+func (*StandaloneDelegation) ProtoMessage()    {}
+func (*StandaloneDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b27a82b276e7f93, []int{5}
+}
+func (m *StandaloneDelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StandaloneDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StandaloneDelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StandaloneDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StandaloneDelegation.Merge(m, src)
+}
+func (m *StandaloneDelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *StandaloneDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_StandaloneDelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StandaloneDelegation proto.InternalMessageInfo
+
+func (m *StandaloneDelegation) GetConsensusAddress() string {
+	if m != nil {
+		return m.ConsensusAddress
+	}
+	return ""
+}
+
+func (m *StandaloneDelegation) GetDelegatorAddress() string {
+	if m != nil {
+		return m.DelegatorAddress
+	}
+	return ""
+}
+
+// StandaloneValidatorRecord describes a validator of a previously standalone chain that was tombstoned
+// or jailed when the chain changed over to a consumer chain. The consensus key of such a validator
+// cannot be used by a validator of the provider validator set.
+//
+// Note this type is only used internally to the consumer CCV module.
+type StandaloneValidatorRecord struct {
+	// the consensus address of the validator on the standalone chain
+	ConsensusAddress string `protobuf:"bytes,1,opt,name=consensus_address,json=consensusAddress,proto3" json:"consensus_address,omitempty"`
+	// whether the validator was tombstoned on the standalone chain
+	Tombstoned bool `protobuf:"varint,2,opt,name=tombstoned,proto3" json:"tombstoned,omitempty"`
+	// the time until which the validator was jailed on the standalone chain
+	JailedUntil time.Time `protobuf:"bytes,3,opt,name=jailed_until,json=jailedUntil,proto3,stdtime" json:"jailed_until"`
+	// the last update of the provider chain for the consensus key of the validator,
+	// which is deferred until the end of the jail period
+	DeferredUpdate *types2.ValidatorUpdate `protobuf:"bytes,4,opt,name=deferred_update,json=deferredUpdate,proto3" json:"deferred_update,omitempty"`
+}
+
+func (m *StandaloneValidatorRecord) Reset()         { *m = StandaloneValidatorRecord{} }
+func (m *StandaloneValidatorRecord) String() string { return proto.CompactTextString(m) }
+func (*StandaloneValidatorRecord) ProtoMessage()    {}
+func (*StandaloneValidatorRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b27a82b276e7f93, []int{6}
+}
+func (m *StandaloneValidatorRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StandaloneValidatorRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StandaloneValidatorRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StandaloneValidatorRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StandaloneValidatorRecord.Merge(m, src)
+}
+func (m *StandaloneValidatorRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *StandaloneValidatorRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_StandaloneValidatorRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StandaloneValidatorRecord proto.InternalMessageInfo
+
+func (m *StandaloneValidatorRecord) GetConsensusAddress() string {
+	if m != nil {
+		return m.ConsensusAddress
+	}
+	return ""
+}
+
+func (m *StandaloneValidatorRecord) GetTombstoned() bool {
+	if m != nil {
+		return m.Tombstoned
+	}
+	return false
+}
+
+func (m *StandaloneValidatorRecord) GetJailedUntil() time.Time {
+	if m != nil {
+		return m.JailedUntil
+	}
+	return time.Time{}
+}
+
+func (m *StandaloneValidatorRecord) GetDeferredUpdate() *types2.ValidatorUpdate {
+	if m != nil {
+		return m.DeferredUpdate
+	}
+	return nil
+}
+
+// ChangeoverRehearsal is the outcome of a rehearsal of the standalone to consumer changeover,
+// i.e., the validator updates that the changeover would return to CometBFT for a given initial valset,
+// computed without handing over the control of the validator set from the standalone staking module.
+type ChangeoverRehearsal struct {
+	// the validator updates that the changeover would return to CometBFT, i.e., the validators
+	// of the initial valset with their power and the standalone validators with zero power
+	ValidatorUpdates []types2.ValidatorUpdate `protobuf:"bytes,1,rep,name=validator_updates,json=validatorUpdates,proto3" json:"validator_updates"`
+	// the changes of the voting powers of all the validators involved in the changeover,
+	// in the order of their consensus addresses
+	PowerChanges []ChangeoverPowerChange `protobuf:"bytes,2,rep,name=power_changes,json=powerChanges,proto3" json:"power_changes"`
+	// the consensus addresses of the validators of the initial valset that would be skipped,
+	// because their consensus keys belong to tombstoned or jailed standalone validators
+	ExcludedValidators []string `protobuf:"bytes,3,rep,name=excluded_validators,json=excludedValidators,proto3" json:"excluded_validators,omitempty"`
+}
+
+func (m *ChangeoverRehearsal) Reset()         { *m = ChangeoverRehearsal{} }
+func (m *ChangeoverRehearsal) String() string { return proto.CompactTextString(m) }
+func (*ChangeoverRehearsal) ProtoMessage()    {}
+func (*ChangeoverRehearsal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b27a82b276e7f93, []int{7}
+}
+func (m *ChangeoverRehearsal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChangeoverRehearsal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChangeoverRehearsal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChangeoverRehearsal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChangeoverRehearsal.Merge(m, src)
+}
+func (m *ChangeoverRehearsal) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChangeoverRehearsal) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChangeoverRehearsal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChangeoverRehearsal proto.InternalMessageInfo
+
+func (m *ChangeoverRehearsal) GetValidatorUpdates() []types2.ValidatorUpdate {
+	if m != nil {
+		return m.ValidatorUpdates
+	}
+	return nil
+}
+
+func (m *ChangeoverRehearsal) GetPowerChanges() []ChangeoverPowerChange {
+	if m != nil {
+		return m.PowerChanges
+	}
+	return nil
+}
+
+func (m *ChangeoverRehearsal) GetExcludedValidators() []string {
+	if m != nil {
+		return m.ExcludedValidators
+	}
+	return nil
+}
+
+// ChangeoverPowerChange is the change of the voting power of a validator at the standalone to consumer changeover
+type ChangeoverPowerChange struct {
+	// the consensus address of the validator
+	ConsensusAddress string `protobuf:"bytes,1,opt,name=consensus_address,json=consensusAddress,proto3" json:"consensus_address,omitempty"`
+	// the voting power of the validator in the standalone validator set
+	StandalonePower int64 `protobuf:"varint,2,opt,name=standalone_power,json=standalonePower,proto3" json:"standalone_power,omitempty"`
+	// the voting power of the validator in the consumer validator set after the changeover
+	ConsumerPower int64 `protobuf:"varint,3,opt,name=consumer_power,json=consumerPower,proto3" json:"consumer_power,omitempty"`
+}
+
+func (m *ChangeoverPowerChange) Reset()         { *m = ChangeoverPowerChange{} }
+func (m *ChangeoverPowerChange) String() string { return proto.CompactTextString(m) }
+func (*ChangeoverPowerChange) ProtoMessage()    {}
+func (*ChangeoverPowerChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5b27a82b276e7f93, []int{8}
+}
+func (m *ChangeoverPowerChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChangeoverPowerChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChangeoverPowerChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChangeoverPowerChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChangeoverPowerChange.Merge(m, src)
+}
+func (m *ChangeoverPowerChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChangeoverPowerChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChangeoverPowerChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChangeoverPowerChange proto.InternalMessageInfo
+
+func (m *ChangeoverPowerChange) GetConsensusAddress() string {
+	if m != nil {
+		return m.ConsensusAddress
+	}
+	return ""
+}
+
+func (m *ChangeoverPowerChange) GetStandalonePower() int64 {
+	if m != nil {
+		return m.StandalonePower
+	}
+	return 0
+}
+
+func (m *ChangeoverPowerChange) GetConsumerPower() int64 {
+	if m != nil {
+		return m.ConsumerPower
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*CrossChainValidator)(nil), "interchain_security.ccv.consumer.v1.CrossChainValidator")
 	proto.RegisterType((*SlashRecord)(nil), "interchain_security.ccv.consumer.v1.SlashRecord")
+	proto.RegisterType((*RateLimitExemption)(nil), "interchain_security.ccv.consumer.v1.RateLimitExemption")
+	proto.RegisterType((*ProviderSwitch)(nil), "interchain_security.ccv.consumer.v1.ProviderSwitch")
+	proto.RegisterType((*StandaloneTransition)(nil), "interchain_security.ccv.consumer.v1.StandaloneTransition")
+	proto.RegisterType((*StandaloneDelegation)(nil), "interchain_security.ccv.consumer.v1.StandaloneDelegation")
+	proto.RegisterType((*StandaloneValidatorRecord)(nil), "interchain_security.ccv.consumer.v1.StandaloneValidatorRecord")
+	proto.RegisterType((*ChangeoverRehearsal)(nil), "interchain_security.ccv.consumer.v1.ChangeoverRehearsal")
+	proto.RegisterType((*ChangeoverPowerChange)(nil), "interchain_security.ccv.consumer.v1.ChangeoverPowerChange")
 }
 
 func init() {
@@ -173,35 +655,69 @@ func init() {
 }
 
 var fileDescriptor_5b27a82b276e7f93 = []byte{
-	// 436 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x52, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0xce, 0xb6, 0x50, 0xdc, 0x0d, 0x42, 0xc8, 0x44, 0xc2, 0xcd, 0xc1, 0x8e, 0xc2, 0xc5, 0x97,
-	0xda, 0x6a, 0x7a, 0x40, 0x42, 0xe2, 0xd0, 0xf4, 0xc8, 0xa1, 0xc8, 0x20, 0x90, 0xb8, 0x58, 0xeb,
-	0xf5, 0xe2, 0x58, 0xd8, 0x3b, 0xab, 0xfd, 0x71, 0x59, 0x9e, 0xa2, 0x0f, 0xc3, 0x43, 0x14, 0x4e,
-	0x3d, 0x72, 0x2a, 0x28, 0x79, 0x03, 0x9e, 0x00, 0xf9, 0x27, 0x41, 0xfc, 0xdc, 0x66, 0xbe, 0xd9,
-	0x6f, 0xe6, 0x9b, 0xd9, 0x0f, 0x2f, 0x4a, 0xae, 0x99, 0xa4, 0x2b, 0x52, 0xf2, 0x54, 0x31, 0x6a,
-	0x64, 0xa9, 0x6d, 0x4c, 0x69, 0x13, 0x53, 0xe0, 0xca, 0xd4, 0x4c, 0xc6, 0xcd, 0xc9, 0x2e, 0x8e,
-	0x84, 0x04, 0x0d, 0xee, 0x93, 0xff, 0x70, 0x22, 0x4a, 0x9b, 0x68, 0xf7, 0xae, 0x39, 0x99, 0x1e,
-	0x15, 0x00, 0x45, 0xc5, 0xe2, 0x8e, 0x92, 0x99, 0xf7, 0x31, 0xe1, 0xb6, 0xe7, 0x4f, 0x27, 0x05,
-	0x14, 0xd0, 0x85, 0x71, 0x1b, 0x0d, 0xe8, 0x11, 0x05, 0x55, 0x83, 0x4a, 0xfb, 0x42, 0x9f, 0x0c,
-	0xa5, 0xe0, 0xef, 0x5e, 0xba, 0xac, 0x99, 0xd2, 0xa4, 0x16, 0xfd, 0x83, 0xf9, 0x17, 0x84, 0x1f,
-	0x9d, 0x4b, 0x50, 0xea, 0xbc, 0x15, 0xf5, 0x86, 0x54, 0x65, 0x4e, 0x34, 0x48, 0xd7, 0xc3, 0xf7,
-	0x48, 0x9e, 0x4b, 0xa6, 0x94, 0x87, 0x66, 0x28, 0xbc, 0x9f, 0x6c, 0x53, 0x77, 0x82, 0xef, 0x0a,
-	0xb8, 0x64, 0xd2, 0xdb, 0x9b, 0xa1, 0x70, 0x3f, 0xe9, 0x13, 0x97, 0xe0, 0x03, 0x61, 0xb2, 0x0f,
-	0xcc, 0x7a, 0xfb, 0x33, 0x14, 0x8e, 0x17, 0x93, 0xa8, 0x9f, 0x1c, 0x6d, 0x27, 0x47, 0x67, 0xdc,
-	0x2e, 0x4f, 0x7f, 0xde, 0x06, 0x8f, 0x2d, 0xa9, 0xab, 0x67, 0xf3, 0x76, 0x63, 0xc6, 0x95, 0x51,
-	0x69, 0xcf, 0x9b, 0x7f, 0xfd, 0x7c, 0x3c, 0x19, 0xb4, 0x53, 0x69, 0x85, 0x86, 0xe8, 0xa5, 0xc9,
-	0x5e, 0x30, 0x9b, 0x0c, 0x8d, 0xdd, 0x00, 0x1f, 0x82, 0xd0, 0x2c, 0x4f, 0xc1, 0x68, 0xef, 0xce,
-	0x0c, 0x85, 0xce, 0x72, 0xcf, 0x43, 0x89, 0xd3, 0x81, 0x17, 0x46, 0xcf, 0x3f, 0xe1, 0xf1, 0xab,
-	0x8a, 0xa8, 0x55, 0xc2, 0x28, 0xc8, 0xdc, 0x0d, 0xf1, 0xc3, 0x4b, 0x52, 0xea, 0x92, 0x17, 0x29,
-	0xf0, 0x54, 0x32, 0x51, 0xd9, 0x6e, 0x17, 0x27, 0x79, 0x30, 0xe0, 0x17, 0x3c, 0x69, 0x51, 0xf7,
-	0x0c, 0x1f, 0x2a, 0xc6, 0xf3, 0xb4, 0x3d, 0x4e, 0xb7, 0xd6, 0x78, 0x31, 0xfd, 0x47, 0xff, 0xeb,
-	0xed, 0xe5, 0x96, 0xce, 0xf5, 0x6d, 0x30, 0xba, 0xfa, 0x1e, 0xa0, 0xc4, 0x69, 0x69, 0x6d, 0x61,
-	0xf9, 0xf6, 0x7a, 0xed, 0xa3, 0x9b, 0xb5, 0x8f, 0x7e, 0xac, 0x7d, 0x74, 0xb5, 0xf1, 0x47, 0x37,
-	0x1b, 0x7f, 0xf4, 0x6d, 0xe3, 0x8f, 0xde, 0x3d, 0x2f, 0x4a, 0xbd, 0x32, 0x59, 0x44, 0xa1, 0x1e,
-	0xfe, 0x26, 0xfe, 0xed, 0x82, 0xe3, 0x9d, 0x73, 0x9a, 0xa7, 0xf1, 0xc7, 0x3f, 0xed, 0xa3, 0xad,
-	0x60, 0x2a, 0x3b, 0xe8, 0x04, 0x9c, 0xfe, 0x0a, 0x00, 0x00, 0xff, 0xff, 0x93, 0x59, 0x99, 0x3a,
-	0x6f, 0x02, 0x00, 0x00,
+	// 991 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0xcf, 0x6e, 0xdc, 0x44,
+	0x18, 0x8f, 0xb3, 0x25, 0xdd, 0x9d, 0x4d, 0xd2, 0xad, 0x9b, 0xc2, 0x26, 0x88, 0xdd, 0xd5, 0x56,
+	0x48, 0x8b, 0xaa, 0xd8, 0x4d, 0x7a, 0x40, 0x54, 0xe2, 0x90, 0x0d, 0x55, 0x89, 0x40, 0x6a, 0xe4,
+	0x6d, 0x41, 0xe2, 0x62, 0xcd, 0x7a, 0xbe, 0xda, 0x43, 0xed, 0x19, 0x6b, 0x66, 0xec, 0x64, 0xaf,
+	0x3c, 0x41, 0x4f, 0x3c, 0x02, 0xe2, 0x01, 0xfa, 0x10, 0x85, 0x0b, 0x55, 0x4f, 0x88, 0x43, 0x40,
+	0xc9, 0x1b, 0x70, 0xe6, 0x80, 0x3c, 0x1e, 0xdb, 0x09, 0xb4, 0x52, 0x72, 0x9b, 0xef, 0x37, 0xdf,
+	0x9f, 0xdf, 0x6f, 0xe6, 0xfb, 0x66, 0xd0, 0x2e, 0x65, 0x0a, 0x44, 0x10, 0x61, 0xca, 0x7c, 0x09,
+	0x41, 0x26, 0xa8, 0x5a, 0xb8, 0x41, 0x90, 0xbb, 0x01, 0x67, 0x32, 0x4b, 0x40, 0xb8, 0xf9, 0x4e,
+	0xbd, 0x76, 0x52, 0xc1, 0x15, 0xb7, 0xef, 0xbc, 0x25, 0xc6, 0x09, 0x82, 0xdc, 0xa9, 0xfd, 0xf2,
+	0x9d, 0xad, 0xcd, 0x90, 0xf3, 0x30, 0x06, 0x57, 0x87, 0xcc, 0xb3, 0x67, 0x2e, 0x66, 0x8b, 0x32,
+	0x7e, 0x6b, 0x23, 0xe4, 0x21, 0xd7, 0x4b, 0xb7, 0x58, 0x19, 0x74, 0x33, 0xe0, 0x32, 0xe1, 0xd2,
+	0x2f, 0x37, 0x4a, 0xc3, 0x6c, 0x0d, 0xff, 0x9b, 0x4b, 0xd1, 0x04, 0xa4, 0xc2, 0x49, 0x6a, 0x1c,
+	0xee, 0xbd, 0x4b, 0x45, 0xbe, 0xe3, 0xca, 0x08, 0x0b, 0x20, 0xfe, 0x45, 0x0d, 0x5b, 0x1f, 0x2a,
+	0x60, 0x04, 0x44, 0x42, 0x99, 0x72, 0xf1, 0x3c, 0xa0, 0xae, 0x5a, 0xa4, 0x60, 0xea, 0x8d, 0x7f,
+	0xb1, 0xd0, 0xad, 0x7d, 0xc1, 0xa5, 0xdc, 0x2f, 0x32, 0x7e, 0x83, 0x63, 0x4a, 0xb0, 0xe2, 0xc2,
+	0xee, 0xa3, 0xeb, 0x98, 0x10, 0x01, 0x52, 0xf6, 0xad, 0x91, 0x35, 0x59, 0xf5, 0x2a, 0xd3, 0xde,
+	0x40, 0xef, 0xa5, 0xfc, 0x08, 0x44, 0x7f, 0x79, 0x64, 0x4d, 0x5a, 0x5e, 0x69, 0xd8, 0x18, 0xad,
+	0xa4, 0xd9, 0xfc, 0x39, 0x2c, 0xfa, 0xad, 0x91, 0x35, 0xe9, 0xee, 0x6e, 0x38, 0xa5, 0x10, 0xa7,
+	0x12, 0xe2, 0xec, 0xb1, 0xc5, 0xf4, 0xfe, 0xdf, 0x27, 0xc3, 0x0f, 0x16, 0x38, 0x89, 0x1f, 0x8c,
+	0x0b, 0x92, 0xc0, 0x64, 0x26, 0xfd, 0x32, 0x6e, 0xfc, 0xeb, 0xcb, 0xed, 0x0d, 0x73, 0x14, 0x81,
+	0x58, 0xa4, 0x8a, 0x3b, 0x87, 0xd9, 0xfc, 0x2b, 0x58, 0x78, 0x26, 0xb1, 0x3d, 0x44, 0x1d, 0x9e,
+	0x2a, 0x20, 0x3e, 0xcf, 0x54, 0xff, 0xda, 0xc8, 0x9a, 0xb4, 0xa7, 0xcb, 0x7d, 0xcb, 0x6b, 0x6b,
+	0xf0, 0x71, 0xa6, 0xc6, 0x3f, 0x5a, 0xa8, 0x3b, 0x8b, 0xb1, 0x8c, 0x3c, 0x08, 0xb8, 0x20, 0xf6,
+	0x04, 0xf5, 0x8e, 0x30, 0x55, 0x94, 0x85, 0x3e, 0x67, 0xbe, 0x80, 0x34, 0x5e, 0x68, 0x31, 0x6d,
+	0x6f, 0xdd, 0xe0, 0x8f, 0x99, 0x57, 0xa0, 0xf6, 0x1e, 0xea, 0x48, 0x60, 0xc4, 0x2f, 0x0e, 0x5b,
+	0xeb, 0xea, 0xee, 0x6e, 0xfd, 0x4f, 0xc0, 0x93, 0xea, 0x26, 0xa6, 0xed, 0x57, 0x27, 0xc3, 0xa5,
+	0x17, 0x7f, 0x0e, 0x2d, 0xaf, 0x5d, 0x84, 0x15, 0x1b, 0xf6, 0x16, 0x6a, 0x63, 0xa5, 0x20, 0x49,
+	0x95, 0xd4, 0x47, 0xb0, 0xe6, 0xd5, 0xf6, 0x38, 0x44, 0xb6, 0x87, 0x15, 0x7c, 0x4d, 0x13, 0xaa,
+	0x1e, 0x1e, 0x17, 0x18, 0xe5, 0xcc, 0xfe, 0x08, 0xa1, 0x20, 0xc2, 0x8c, 0x41, 0xec, 0x53, 0xa2,
+	0x89, 0x75, 0xbc, 0x8e, 0x41, 0x0e, 0x88, 0xfd, 0x3e, 0x5a, 0x91, 0xfa, 0xe2, 0x34, 0xa1, 0x8e,
+	0x67, 0xac, 0xa2, 0x90, 0x80, 0x00, 0x68, 0x0e, 0x42, 0x17, 0xea, 0x78, 0xb5, 0x3d, 0xfe, 0xd9,
+	0x42, 0xeb, 0x87, 0x82, 0xe7, 0x94, 0x80, 0x98, 0x1d, 0x51, 0x15, 0x44, 0xf6, 0x10, 0x75, 0x53,
+	0x83, 0x34, 0x65, 0x50, 0x05, 0x1d, 0x10, 0xfb, 0x0e, 0x5a, 0x93, 0xda, 0xd5, 0x8f, 0x80, 0x86,
+	0x91, 0x32, 0xf7, 0xba, 0x5a, 0x82, 0x5f, 0x6a, 0xcc, 0x3e, 0x44, 0xd7, 0x43, 0x60, 0x20, 0xa9,
+	0x34, 0xf7, 0x7b, 0xcf, 0x79, 0xd7, 0x64, 0xe4, 0x3b, 0xce, 0xbe, 0x69, 0xc0, 0x47, 0x65, 0xc8,
+	0x4c, 0x61, 0x05, 0xd3, 0x6b, 0xc5, 0xa1, 0x79, 0x55, 0x9a, 0xf1, 0x4f, 0x16, 0xda, 0x98, 0x29,
+	0xcc, 0x08, 0x8e, 0x39, 0x83, 0x27, 0x02, 0x33, 0x49, 0xf5, 0xb1, 0xdc, 0x45, 0x37, 0x55, 0x6d,
+	0x55, 0x9c, 0x2c, 0xcd, 0xa9, 0xd7, 0x6c, 0x18, 0x5e, 0x18, 0x75, 0x09, 0xc4, 0x10, 0xe2, 0x02,
+	0x93, 0xfd, 0xe5, 0x51, 0x6b, 0xd2, 0xdd, 0xfd, 0xcc, 0xb9, 0xc4, 0xd4, 0x3a, 0x4d, 0xf1, 0x2f,
+	0xea, 0x0c, 0x86, 0xe4, 0xf9, 0x9c, 0xe3, 0xdf, 0x2e, 0x10, 0x6d, 0x7c, 0x0b, 0xa2, 0x4d, 0x13,
+	0x9f, 0x1f, 0x96, 0x8e, 0xd7, 0xab, 0x37, 0xf6, 0xcc, 0xd4, 0x3c, 0x44, 0x37, 0x4d, 0x52, 0x2e,
+	0x6a, 0x67, 0x7d, 0xb1, 0xd3, 0xfe, 0x9b, 0xa6, 0xf3, 0x8d, 0xfb, 0x4c, 0x09, 0xca, 0x42, 0xaf,
+	0x57, 0x87, 0x54, 0x69, 0xf6, 0xd1, 0x0a, 0x4e, 0x78, 0xc6, 0x54, 0x79, 0xf5, 0xd3, 0xbb, 0x05,
+	0xdf, 0x3f, 0x4e, 0x86, 0xb7, 0xcb, 0x78, 0x49, 0x9e, 0x3b, 0x94, 0xbb, 0x09, 0x56, 0x91, 0x73,
+	0xc0, 0xd4, 0x9b, 0x97, 0xdb, 0xc8, 0x24, 0x3e, 0x60, 0xca, 0x33, 0xa1, 0xe3, 0x7f, 0x2c, 0xb4,
+	0xd9, 0x28, 0xaa, 0x67, 0xde, 0x4c, 0xcd, 0x95, 0x64, 0x0d, 0x10, 0x52, 0x3c, 0x99, 0x4b, 0xc5,
+	0x19, 0x10, 0xad, 0xa7, 0xed, 0x9d, 0x43, 0xec, 0x47, 0x68, 0xf5, 0x7b, 0x4c, 0x63, 0x20, 0x7e,
+	0xc6, 0x14, 0x8d, 0xfb, 0xad, 0x2b, 0xcc, 0x56, 0xb7, 0x8c, 0x7c, 0x5a, 0x04, 0xda, 0x07, 0xe8,
+	0x06, 0x81, 0x67, 0x20, 0x8a, 0xf7, 0x2d, 0x4b, 0x09, 0x56, 0xa0, 0x9f, 0x80, 0xee, 0xee, 0xc8,
+	0x69, 0x9e, 0x37, 0xa7, 0x78, 0xde, 0x9c, 0x5a, 0xd0, 0x53, 0xed, 0xe7, 0xad, 0x57, 0x81, 0xa5,
+	0x3d, 0xfe, 0x61, 0x19, 0xdd, 0xda, 0x8f, 0x30, 0x0b, 0x81, 0xe7, 0x20, 0x3c, 0x88, 0x00, 0x0b,
+	0x89, 0x63, 0x7b, 0x86, 0x6e, 0xe6, 0x55, 0xa8, 0xa9, 0x51, 0x08, 0x6f, 0x5d, 0xa6, 0x88, 0x69,
+	0x9c, 0x5e, 0x7e, 0x11, 0x96, 0x36, 0xa0, 0x35, 0xfd, 0x40, 0xfa, 0x81, 0xae, 0x58, 0xb5, 0xe8,
+	0x83, 0x4b, 0xb5, 0x68, 0xc3, 0xf2, 0xb0, 0xc8, 0x51, 0x9a, 0xa6, 0xd4, 0x6a, 0xda, 0x40, 0xd2,
+	0x76, 0xd1, 0x2d, 0x38, 0x0e, 0xe2, 0x8c, 0x00, 0xf1, 0x6b, 0x0e, 0xc5, 0xac, 0xb6, 0x26, 0x1d,
+	0xcf, 0xae, 0xb6, 0x6a, 0xd2, 0xb2, 0x78, 0x2b, 0x6f, 0xbf, 0x35, 0xfd, 0xd5, 0xee, 0xff, 0x13,
+	0xd4, 0x93, 0x75, 0x27, 0xf9, 0xe7, 0xff, 0x85, 0x1b, 0x0d, 0xae, 0xb3, 0xdb, 0x1f, 0xa3, 0xf5,
+	0x4a, 0x9b, 0x71, 0x6c, 0x69, 0xc7, 0xb5, 0x0a, 0xd5, 0x6e, 0xd3, 0x6f, 0x5f, 0x9d, 0x0e, 0xac,
+	0xd7, 0xa7, 0x03, 0xeb, 0xaf, 0xd3, 0x81, 0xf5, 0xe2, 0x6c, 0xb0, 0xf4, 0xfa, 0x6c, 0xb0, 0xf4,
+	0xfb, 0xd9, 0x60, 0xe9, 0xbb, 0xcf, 0x43, 0xaa, 0xa2, 0x6c, 0xee, 0x04, 0x3c, 0x31, 0x7f, 0xa6,
+	0xdb, 0x1c, 0xe2, 0x76, 0xfd, 0x17, 0xe6, 0x9f, 0xba, 0xc7, 0x17, 0xbf, 0x75, 0xfd, 0xdf, 0xcd,
+	0x57, 0x74, 0xb3, 0xdd, 0xff, 0x77, 0x00, 0x73, 0x49, 0x5a, 0x5e, 0x07, 0x08, 0x00, 0x00,
 }
 
 func (m *CrossChainValidator) Marshal() (dAtA []byte, err error) {
@@ -281,6 +797,11 @@ func (m *SlashRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Attempts != 0 {
+		i = encodeVarintConsumer(dAtA, i, uint64(m.Attempts))
+		i--
+		dAtA[i] = 0x18
+	}
 	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.SendTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SendTime):])
 	if err2 != nil {
 		return 0, err2
@@ -302,9 +823,347 @@ func (m *SlashRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintConsumer(dAtA []byte, offset int, v uint64) int {
-	offset -= sovConsumer(v)
-	base := offset
+func (m *RateLimitExemption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimitExemption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimitExemption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintConsumer(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintConsumer(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintConsumer(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProviderSwitch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProviderSwitch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProviderSwitch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Genesis.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintConsumer(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.SwitchHeight != 0 {
+		i = encodeVarintConsumer(dAtA, i, uint64(m.SwitchHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ProviderId) > 0 {
+		i -= len(m.ProviderId)
+		copy(dAtA[i:], m.ProviderId)
+		i = encodeVarintConsumer(dAtA, i, uint64(len(m.ProviderId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StandaloneTransition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StandaloneTransition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StandaloneTransition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Delegations) > 0 {
+		for iNdEx := len(m.Delegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Delegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintConsumer(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.TransitionHeight != 0 {
+		i = encodeVarintConsumer(dAtA, i, uint64(m.TransitionHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StandaloneDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StandaloneDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StandaloneDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintConsumer(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.DelegatorAddress) > 0 {
+		i -= len(m.DelegatorAddress)
+		copy(dAtA[i:], m.DelegatorAddress)
+		i = encodeVarintConsumer(dAtA, i, uint64(len(m.DelegatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConsensusAddress) > 0 {
+		i -= len(m.ConsensusAddress)
+		copy(dAtA[i:], m.ConsensusAddress)
+		i = encodeVarintConsumer(dAtA, i, uint64(len(m.ConsensusAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StandaloneValidatorRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StandaloneValidatorRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StandaloneValidatorRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DeferredUpdate != nil {
+		{
+			size, err := m.DeferredUpdate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintConsumer(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.JailedUntil, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.JailedUntil):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintConsumer(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x1a
+	if m.Tombstoned {
+		i--
+		if m.Tombstoned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ConsensusAddress) > 0 {
+		i -= len(m.ConsensusAddress)
+		copy(dAtA[i:], m.ConsensusAddress)
+		i = encodeVarintConsumer(dAtA, i, uint64(len(m.ConsensusAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChangeoverRehearsal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChangeoverRehearsal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChangeoverRehearsal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExcludedValidators) > 0 {
+		for iNdEx := len(m.ExcludedValidators) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ExcludedValidators[iNdEx])
+			copy(dAtA[i:], m.ExcludedValidators[iNdEx])
+			i = encodeVarintConsumer(dAtA, i, uint64(len(m.ExcludedValidators[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.PowerChanges) > 0 {
+		for iNdEx := len(m.PowerChanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PowerChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintConsumer(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ValidatorUpdates) > 0 {
+		for iNdEx := len(m.ValidatorUpdates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorUpdates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintConsumer(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChangeoverPowerChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChangeoverPowerChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChangeoverPowerChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ConsumerPower != 0 {
+		i = encodeVarintConsumer(dAtA, i, uint64(m.ConsumerPower))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StandalonePower != 0 {
+		i = encodeVarintConsumer(dAtA, i, uint64(m.StandalonePower))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ConsensusAddress) > 0 {
+		i -= len(m.ConsensusAddress)
+		copy(dAtA[i:], m.ConsensusAddress)
+		i = encodeVarintConsumer(dAtA, i, uint64(len(m.ConsensusAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintConsumer(dAtA []byte, offset int, v uint64) int {
+	offset -= sovConsumer(v)
+	base := offset
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
 		v >>= 7
@@ -333,30 +1192,989 @@ func (m *CrossChainValidator) Size() (n int) {
 	if m.OptedOut {
 		n += 2
 	}
-	return n
-}
+	return n
+}
+
+func (m *SlashRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WaitingOnReply {
+		n += 2
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SendTime)
+	n += 1 + l + sovConsumer(uint64(l))
+	if m.Attempts != 0 {
+		n += 1 + sovConsumer(uint64(m.Attempts))
+	}
+	return n
+}
+
+func (m *RateLimitExemption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovConsumer(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovConsumer(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovConsumer(uint64(l))
+	}
+	return n
+}
+
+func (m *ProviderSwitch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProviderId)
+	if l > 0 {
+		n += 1 + l + sovConsumer(uint64(l))
+	}
+	if m.SwitchHeight != 0 {
+		n += 1 + sovConsumer(uint64(m.SwitchHeight))
+	}
+	l = m.Genesis.Size()
+	n += 1 + l + sovConsumer(uint64(l))
+	return n
+}
+
+func (m *StandaloneTransition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TransitionHeight != 0 {
+		n += 1 + sovConsumer(uint64(m.TransitionHeight))
+	}
+	if len(m.Delegations) > 0 {
+		for _, e := range m.Delegations {
+			l = e.Size()
+			n += 1 + l + sovConsumer(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *StandaloneDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsensusAddress)
+	if l > 0 {
+		n += 1 + l + sovConsumer(uint64(l))
+	}
+	l = len(m.DelegatorAddress)
+	if l > 0 {
+		n += 1 + l + sovConsumer(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovConsumer(uint64(l))
+	return n
+}
+
+func (m *StandaloneValidatorRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsensusAddress)
+	if l > 0 {
+		n += 1 + l + sovConsumer(uint64(l))
+	}
+	if m.Tombstoned {
+		n += 2
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.JailedUntil)
+	n += 1 + l + sovConsumer(uint64(l))
+	if m.DeferredUpdate != nil {
+		l = m.DeferredUpdate.Size()
+		n += 1 + l + sovConsumer(uint64(l))
+	}
+	return n
+}
+
+func (m *ChangeoverRehearsal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ValidatorUpdates) > 0 {
+		for _, e := range m.ValidatorUpdates {
+			l = e.Size()
+			n += 1 + l + sovConsumer(uint64(l))
+		}
+	}
+	if len(m.PowerChanges) > 0 {
+		for _, e := range m.PowerChanges {
+			l = e.Size()
+			n += 1 + l + sovConsumer(uint64(l))
+		}
+	}
+	if len(m.ExcludedValidators) > 0 {
+		for _, s := range m.ExcludedValidators {
+			l = len(s)
+			n += 1 + l + sovConsumer(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ChangeoverPowerChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsensusAddress)
+	if l > 0 {
+		n += 1 + l + sovConsumer(uint64(l))
+	}
+	if m.StandalonePower != 0 {
+		n += 1 + sovConsumer(uint64(m.StandalonePower))
+	}
+	if m.ConsumerPower != 0 {
+		n += 1 + sovConsumer(uint64(m.ConsumerPower))
+	}
+	return n
+}
+
+func sovConsumer(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozConsumer(x uint64) (n int) {
+	return sovConsumer(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *CrossChainValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConsumer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CrossChainValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CrossChainValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsumer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthConsumer
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConsumer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsumer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pubkey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsumer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConsumer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConsumer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pubkey == nil {
+				m.Pubkey = &types.Any{}
+			}
+			if err := m.Pubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptedOut", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsumer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OptedOut = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConsumer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConsumer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SlashRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConsumer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SlashRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SlashRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WaitingOnReply", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsumer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WaitingOnReply = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsumer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConsumer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConsumer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.SendTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempts", wireType)
+			}
+			m.Attempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsumer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempts |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConsumer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConsumer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateLimitExemption) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConsumer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimitExemption: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimitExemption: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsumer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConsumer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConsumer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsumer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConsumer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConsumer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsumer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConsumer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConsumer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConsumer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConsumer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProviderSwitch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConsumer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProviderSwitch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProviderSwitch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsumer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConsumer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConsumer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwitchHeight", wireType)
+			}
+			m.SwitchHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsumer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SwitchHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Genesis", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsumer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConsumer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConsumer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Genesis.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConsumer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConsumer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StandaloneTransition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConsumer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StandaloneTransition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StandaloneTransition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransitionHeight", wireType)
+			}
+			m.TransitionHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsumer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TransitionHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsumer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthConsumer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConsumer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegations = append(m.Delegations, StandaloneDelegation{})
+			if err := m.Delegations[len(m.Delegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConsumer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConsumer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StandaloneDelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConsumer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StandaloneDelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StandaloneDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsumer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConsumer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConsumer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsensusAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsumer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConsumer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConsumer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsumer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConsumer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConsumer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConsumer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConsumer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *SlashRecord) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.WaitingOnReply {
-		n += 2
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SendTime)
-	n += 1 + l + sovConsumer(uint64(l))
-	return n
-}
-
-func sovConsumer(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozConsumer(x uint64) (n int) {
-	return sovConsumer(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *CrossChainValidator) Unmarshal(dAtA []byte) error {
+func (m *StandaloneValidatorRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -379,17 +2197,17 @@ func (m *CrossChainValidator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CrossChainValidator: wiretype end group for non-group")
+			return fmt.Errorf("proto: StandaloneValidatorRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CrossChainValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StandaloneValidatorRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusAddress", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConsumer
@@ -399,31 +2217,29 @@ func (m *CrossChainValidator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthConsumer
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthConsumer
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
-			if m.Address == nil {
-				m.Address = []byte{}
-			}
+			m.ConsensusAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tombstoned", wireType)
 			}
-			m.Power = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConsumer
@@ -433,14 +2249,15 @@ func (m *CrossChainValidator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Power |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Tombstoned = bool(v != 0)
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pubkey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JailedUntil", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -467,18 +2284,15 @@ func (m *CrossChainValidator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pubkey == nil {
-				m.Pubkey = &types.Any{}
-			}
-			if err := m.Pubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.JailedUntil, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OptedOut", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeferredUpdate", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConsumer
@@ -488,12 +2302,28 @@ func (m *CrossChainValidator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.OptedOut = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthConsumer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConsumer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DeferredUpdate == nil {
+				m.DeferredUpdate = &types2.ValidatorUpdate{}
+			}
+			if err := m.DeferredUpdate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipConsumer(dAtA[iNdEx:])
@@ -515,7 +2345,7 @@ func (m *CrossChainValidator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SlashRecord) Unmarshal(dAtA []byte) error {
+func (m *ChangeoverRehearsal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -538,17 +2368,17 @@ func (m *SlashRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SlashRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChangeoverRehearsal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SlashRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChangeoverRehearsal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WaitingOnReply", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorUpdates", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowConsumer
@@ -558,15 +2388,29 @@ func (m *SlashRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.WaitingOnReply = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthConsumer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthConsumer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorUpdates = append(m.ValidatorUpdates, types2.ValidatorUpdate{})
+			if err := m.ValidatorUpdates[len(m.ValidatorUpdates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SendTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PowerChanges", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -593,10 +2437,163 @@ func (m *SlashRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.SendTime, dAtA[iNdEx:postIndex]); err != nil {
+			m.PowerChanges = append(m.PowerChanges, ChangeoverPowerChange{})
+			if err := m.PowerChanges[len(m.PowerChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcludedValidators", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsumer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConsumer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConsumer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExcludedValidators = append(m.ExcludedValidators, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipConsumer(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthConsumer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChangeoverPowerChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowConsumer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChangeoverPowerChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChangeoverPowerChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsumer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthConsumer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthConsumer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsensusAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StandalonePower", wireType)
+			}
+			m.StandalonePower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsumer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StandalonePower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerPower", wireType)
+			}
+			m.ConsumerPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowConsumer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsumerPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipConsumer(dAtA[iNdEx:])
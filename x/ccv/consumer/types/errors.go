@@ -8,4 +8,15 @@ import (
 var (
 	ErrNoProposerChannelId                  = errorsmod.Register(ModuleName, 1, "no established CCV channel")
 	ErrConsumerRewardDenomAlreadyRegistered = errorsmod.Register(ModuleName, 2, "consumer reward denom already registered")
+	ErrNonMonotonicValsetUpdateID           = errorsmod.Register(ModuleName, 3, "valset update id is not greater than the last received one")
+	ErrSlashPacketRetryNotPermitted         = errorsmod.Register(ModuleName, 4, "slash packet retry not permitted")
+	ErrProviderClientUpdateNotPermitted     = errorsmod.Register(ModuleName, 5, "provider client update not permitted")
+	ErrInvalidProviderSwitch                = errorsmod.Register(ModuleName, 6, "invalid provider switch")
+	ErrConsumerShutdownNotPermitted         = errorsmod.Register(ModuleName, 7, "consumer shutdown not permitted")
+	ErrInvalidStandaloneTransition          = errorsmod.Register(ModuleName, 8, "invalid standalone transition")
+	ErrStandaloneChain                      = errorsmod.Register(ModuleName, 9, "consumer chain transitioned to a standalone chain")
+	ErrInvalidMisbehaviourReport            = errorsmod.Register(ModuleName, 10, "invalid misbehaviour report")
+	ErrInvalidStandaloneValidatorRecord     = errorsmod.Register(ModuleName, 11, "invalid standalone validator record")
+	ErrInvalidDoubleVoteEvidence            = errorsmod.Register(ModuleName, 12, "invalid double voting evidence")
+	ErrInvalidChangeoverRehearsal           = errorsmod.Register(ModuleName, 13, "invalid changeover rehearsal")
 )
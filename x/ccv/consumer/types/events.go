@@ -4,10 +4,36 @@ const (
 	AttributeConsumerHeight = "consumer_height"
 	AttributeTimestamp      = "timestamp"
 
+	AttributeLastValSetUpdateID = "last_valset_update_id"
+
+	AttributeProviderId         = "provider_id"
+	AttributePreviousProviderId = "previous_provider_id"
+	AttributeSwitchError        = "error"
+
+	AttributeTransitionHeight = "transition_height"
+	AttributeNumValidators    = "num_validators"
+
 	EventTypeFeeDistribution          = "fee_distribution"
 	EventTypeVSCMatured               = "vsc_matured"
 	EventTypeConsumerSlashRequest     = "consumer_slash_request"
 	EventTypeFeeTransferChannelOpened = "fee_transfer_channel_opened"
+	EventTypeNonMonotonicVSCPacket    = "non_monotonic_vsc_packet"
+	EventTypeProviderClientUpdated    = "provider_client_fallback_update"
+	EventTypeProviderSwitched         = "provider_switched"
+	EventTypeProviderSwitchFailed     = "provider_switch_failed"
+	EventTypeConsumerShutdownInit     = "consumer_shutdown_initiated"
+	EventTypeConsumerShutdown         = "consumer_shutdown"
+	EventTypeStandaloneTransition     = "standalone_transition"
+	EventTypeStandaloneTransitionFail = "standalone_transition_failed"
+	EventTypeMisbehaviourReported     = "consumer_misbehaviour_reported"
+	EventTypeDoubleVoteForwarded      = "consumer_double_vote_forwarded"
+	EventTypeDoubleVoteCommitted      = "consumer_double_vote_committed"
+	EventTypeSlashPacketBounced       = "slash_packet_bounced"
+	EventTypeRewardSplit              = "reward_split"
+
+	AttributeSlashBounces       = "bounces"
+	AttributeSlashSendTime      = "send_time"
+	AttributeSlashNextRetryTime = "next_retry_time"
 
 	AttributeDistributionCurrentHeight = "current_distribution_height"
 	//#nosec G101 -- (false positive) this is not a hardcoded credential
@@ -15,4 +41,8 @@ const (
 	AttributeDistributionFraction   = "distribution_fraction"
 	AttributeDistributionTotal      = "total"
 	AttributeDistributionToProvider = "provider_amount"
+	AttributeDistributionToSplits   = "split_amount"
+
+	AttributeRewardSplitAddress = "address"
+	AttributeRewardSplitAmount  = "amount"
 )
@@ -0,0 +1,71 @@
+package types
+
+import (
+	"bytes"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/evidence/exported"
+
+	"github.com/cometbft/cometbft/crypto/tmhash"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+)
+
+// RouteConsumerDoubleVote is the route of the evidence handler of the consumer CCV module
+// in the evidence router of the app
+const RouteConsumerDoubleVote = "consumerdoublevote"
+
+var _ exported.Evidence = &ConsumerDoubleVoteEvidence{}
+
+func NewConsumerDoubleVoteEvidence(evidence *tmproto.DuplicateVoteEvidence) *ConsumerDoubleVoteEvidence {
+	return &ConsumerDoubleVoteEvidence{
+		DuplicateVoteEvidence: evidence,
+	}
+}
+
+// Route returns the route of the evidence handler of the consumer CCV module
+func (e *ConsumerDoubleVoteEvidence) Route() string {
+	return RouteConsumerDoubleVote
+}
+
+// Hash returns the hash of the evidence, used by the evidence module to reject duplicate evidence.
+// It covers only the conflicting votes, including their signatures, in canonical order, so that the same
+// double vote submitted with swapped votes or different (unsigned) powers and timestamp has the same hash.
+func (e *ConsumerDoubleVoteEvidence) Hash() []byte {
+	hashA := hashVote(e.GetDuplicateVoteEvidence().GetVoteA())
+	hashB := hashVote(e.GetDuplicateVoteEvidence().GetVoteB())
+	if bytes.Compare(hashA, hashB) > 0 {
+		hashA, hashB = hashB, hashA
+	}
+	return tmhash.Sum(append(hashA, hashB...))
+}
+
+// hashVote returns the hash of the marshalled vote, or the hash of empty bytes if the vote is nil
+func hashVote(vote *tmproto.Vote) []byte {
+	if vote == nil {
+		return tmhash.Sum(nil)
+	}
+	bz, err := vote.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	return tmhash.Sum(bz)
+}
+
+// ValidateBasic performs the basic validation of the double voting evidence.
+// Note that the signatures of the conflicting votes are verified by the evidence handler.
+func (e *ConsumerDoubleVoteEvidence) ValidateBasic() error {
+	if e.DuplicateVoteEvidence == nil {
+		return errorsmod.Wrap(ErrInvalidDoubleVoteEvidence, "double voting evidence cannot be nil")
+	}
+	// DuplicateVoteEvidenceFromProto also performs the basic validation of the evidence
+	if _, err := tmtypes.DuplicateVoteEvidenceFromProto(e.DuplicateVoteEvidence); err != nil {
+		return errorsmod.Wrap(ErrInvalidDoubleVoteEvidence, err.Error())
+	}
+	return nil
+}
+
+// GetHeight returns the height at which the validator double voted
+func (e *ConsumerDoubleVoteEvidence) GetHeight() int64 {
+	return e.DuplicateVoteEvidence.GetVoteA().GetHeight()
+}
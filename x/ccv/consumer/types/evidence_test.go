@@ -0,0 +1,48 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	tmtypes "github.com/cometbft/cometbft/types"
+
+	"github.com/cosmos/interchain-security/v7/testutil/crypto"
+	"github.com/cosmos/interchain-security/v7/x/ccv/consumer/types"
+)
+
+// TestConsumerDoubleVoteEvidenceHash tests that the hash of the evidence depends only on the conflicting votes
+func TestConsumerDoubleVoteEvidenceHash(t *testing.T) {
+	privVal := tmtypes.NewMockPV()
+	pubKey, err := privVal.GetPubKey()
+	require.NoError(t, err)
+	valSet := tmtypes.NewValidatorSet([]*tmtypes.Validator{tmtypes.NewValidator(pubKey, 10)})
+	blockTime := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	evidence := crypto.MakeDuplicateVoteEvidence(5, blockTime, valSet, privVal, "consumer-1")
+	hash := types.NewConsumerDoubleVoteEvidence(evidence.ToProto()).Hash()
+
+	// swapping the votes does not change the hash
+	swapped := evidence.ToProto()
+	swapped.VoteA, swapped.VoteB = swapped.VoteB, swapped.VoteA
+	require.Equal(t, hash, types.NewConsumerDoubleVoteEvidence(swapped).Hash())
+
+	// the unsigned fields of the evidence do not change the hash
+	unsigned := evidence.ToProto()
+	unsigned.TotalVotingPower = 100
+	unsigned.ValidatorPower = 50
+	unsigned.Timestamp = blockTime.Add(time.Hour)
+	require.Equal(t, hash, types.NewConsumerDoubleVoteEvidence(unsigned).Hash())
+
+	// the signatures of the votes change the hash
+	resigned := evidence.ToProto()
+	resigned.VoteA.Signature = []byte("signature")
+	require.NotEqual(t, hash, types.NewConsumerDoubleVoteEvidence(resigned).Hash())
+
+	// a double vote at another height has a different hash
+	other := crypto.MakeDuplicateVoteEvidence(6, blockTime, valSet, privVal, "consumer-1")
+	require.NotEqual(t, hash, types.NewConsumerDoubleVoteEvidence(other.ToProto()).Hash())
+
+	// the hash of an evidence without votes does not panic
+	require.NotPanics(t, func() { types.NewConsumerDoubleVoteEvidence(nil).Hash() })
+}
@@ -136,6 +136,9 @@ func (gs GenesisState) Validate() error {
 		if gs.LastTransmissionBlockHeight.Height != 0 {
 			return errorsmod.Wrap(ccv.ErrInvalidGenesis, "last transmission block height must be empty for new chain")
 		}
+		if gs.SlashRecord != nil {
+			return errorsmod.Wrap(ccv.ErrInvalidGenesis, "slash record must be nil for new chain")
+		}
 	} else {
 		// NOTE: For restart genesis, we will verify initial validator set in InitGenesis.
 		if gs.ProviderClientId == "" {
@@ -144,10 +147,6 @@ func (gs GenesisState) Validate() error {
 		// handshake is still in progress
 		handshakeInProgress := gs.ProviderChannelId == ""
 		if handshakeInProgress {
-			if len(gs.OutstandingDowntimeSlashing) != 0 {
-				return errorsmod.Wrap(
-					ccv.ErrInvalidGenesis, "outstanding downtime must be empty when handshake in progress")
-			}
 			if gs.LastTransmissionBlockHeight.Height != 0 {
 				return errorsmod.Wrap(
 					ccv.ErrInvalidGenesis, "last transmission block height must be zero when handshake in progress")
@@ -170,5 +169,25 @@ func (gs GenesisState) Validate() error {
 			return errorsmod.Wrap(ccv.ErrInvalidGenesis, "provider client state and consensus state must be nil for a restarting genesis state")
 		}
 	}
+	if gs.PendingProviderSwitch != nil {
+		if err := gs.PendingProviderSwitch.Validate(); err != nil {
+			return errorsmod.Wrapf(ccv.ErrInvalidGenesis, "invalid pending provider switch: %s", err.Error())
+		}
+	}
+	if gs.PendingStandaloneTransition != nil {
+		if err := gs.PendingStandaloneTransition.Validate(); err != nil {
+			return errorsmod.Wrapf(ccv.ErrInvalidGenesis, "invalid pending standalone transition: %s", err.Error())
+		}
+	}
+	seen := make(map[string]bool, len(gs.StandaloneValidatorRecords))
+	for _, record := range gs.StandaloneValidatorRecords {
+		if err := record.Validate(); err != nil {
+			return errorsmod.Wrapf(ccv.ErrInvalidGenesis, "invalid standalone validator record: %s", err.Error())
+		}
+		if seen[record.ConsensusAddress] {
+			return errorsmod.Wrapf(ccv.ErrInvalidGenesis, "duplicate standalone validator record for %s", record.ConsensusAddress)
+		}
+		seen[record.ConsensusAddress] = true
+	}
 	return nil
 }
@@ -56,6 +56,16 @@ type GenesisState struct {
 	// the provider chain and a new connection on top of this client are created.
 	// The new client is initialized using provider.client_state and provider.consensus_state.
 	ConnectionId string `protobuf:"bytes,15,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// The slash record of the last slash packet sent to the provider,
+	// nil if no slash packet is waiting on a reply or being retried.
+	SlashRecord *SlashRecord `protobuf:"bytes,16,opt,name=slash_record,json=slashRecord,proto3" json:"slash_record,omitempty"`
+	// The pending switch to a different provider chain, nil if none is scheduled.
+	PendingProviderSwitch *ProviderSwitch `protobuf:"bytes,17,opt,name=pending_provider_switch,json=pendingProviderSwitch,proto3" json:"pending_provider_switch,omitempty"`
+	// The pending transition to a standalone chain, nil if none is scheduled.
+	PendingStandaloneTransition *StandaloneTransition `protobuf:"bytes,18,opt,name=pending_standalone_transition,json=pendingStandaloneTransition,proto3" json:"pending_standalone_transition,omitempty"`
+	// The tombstoned and jailed validators of the previously standalone chain,
+	// recorded during the standalone to consumer changeover.
+	StandaloneValidatorRecords []StandaloneValidatorRecord `protobuf:"bytes,19,rep,name=standalone_validator_records,json=standaloneValidatorRecords,proto3" json:"standalone_validator_records"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -168,6 +178,34 @@ func (m *GenesisState) GetConnectionId() string {
 	return ""
 }
 
+func (m *GenesisState) GetSlashRecord() *SlashRecord {
+	if m != nil {
+		return m.SlashRecord
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingProviderSwitch() *ProviderSwitch {
+	if m != nil {
+		return m.PendingProviderSwitch
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingStandaloneTransition() *StandaloneTransition {
+	if m != nil {
+		return m.PendingStandaloneTransition
+	}
+	return nil
+}
+
+func (m *GenesisState) GetStandaloneValidatorRecords() []StandaloneValidatorRecord {
+	if m != nil {
+		return m.StandaloneValidatorRecords
+	}
+	return nil
+}
+
 // HeightValsetUpdateID represents a mapping internal to the consumer CCV module
 // which links a block height to each recv valset update id.
 type HeightToValsetUpdateID struct {
@@ -377,55 +415,63 @@ func init() {
 }
 
 var fileDescriptor_2db73a6057a27482 = []byte{
-	// 763 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0x4d, 0x6b, 0xeb, 0x46,
-	0x14, 0xb5, 0x12, 0xd5, 0x91, 0x27, 0x1f, 0x75, 0x27, 0xc5, 0xa8, 0x31, 0x75, 0x8c, 0x43, 0xc1,
-	0x94, 0x56, 0xaa, 0x53, 0x4a, 0x0b, 0xa5, 0xa5, 0x8d, 0x03, 0x8d, 0x4d, 0xa0, 0xc1, 0xf9, 0x28,
-	0x64, 0x23, 0xc6, 0xa3, 0x89, 0x34, 0x44, 0x9a, 0x11, 0x9a, 0xb1, 0xdc, 0x50, 0xba, 0x69, 0x97,
-	0x6f, 0xf3, 0x7e, 0x56, 0x96, 0x59, 0xbe, 0xd5, 0xe3, 0x91, 0xfc, 0x91, 0x87, 0x46, 0x23, 0x3b,
-	0x21, 0x4e, 0xf0, 0xce, 0x57, 0xf7, 0xdc, 0x73, 0xef, 0x9c, 0x7b, 0x66, 0x0c, 0x7a, 0x94, 0x49,
-	0x92, 0xe2, 0x10, 0x51, 0xe6, 0x09, 0x82, 0x27, 0x29, 0x95, 0x37, 0x2e, 0xc6, 0x99, 0x8b, 0x39,
-	0x13, 0x93, 0x98, 0xa4, 0x6e, 0xd6, 0x73, 0x03, 0xc2, 0x88, 0xa0, 0xc2, 0x49, 0x52, 0x2e, 0x39,
-	0xdc, 0x5b, 0x50, 0xe2, 0x60, 0x9c, 0x39, 0x65, 0x89, 0x93, 0xf5, 0x76, 0xbe, 0x7b, 0x89, 0x37,
-	0xeb, 0xb9, 0x22, 0x44, 0x29, 0xf1, 0xbd, 0x19, 0x5c, 0xd1, 0xee, 0xb8, 0x74, 0x8c, 0xdd, 0x88,
-	0x06, 0xa1, 0xc4, 0x11, 0x25, 0x4c, 0x0a, 0x57, 0x12, 0xe6, 0x93, 0x34, 0xa6, 0x4c, 0xe6, 0x55,
-	0xf3, 0x48, 0x17, 0x7c, 0x1e, 0xf0, 0x80, 0xab, 0x9f, 0x6e, 0xfe, 0x4b, 0x7f, 0xfd, 0xea, 0x95,
-	0xc6, 0x53, 0x9a, 0x12, 0x0d, 0xdb, 0x0d, 0x38, 0x0f, 0x22, 0xe2, 0xaa, 0x68, 0x3c, 0xb9, 0x72,
-	0x25, 0x8d, 0x89, 0x90, 0x28, 0x4e, 0x34, 0xa0, 0xf9, 0xa8, 0x3b, 0x1a, 0x63, 0xea, 0xca, 0x9b,
-	0x84, 0x68, 0x09, 0x3a, 0xff, 0xaf, 0x81, 0x8d, 0x3f, 0x0a, 0x51, 0x4e, 0x25, 0x92, 0x04, 0x1e,
-	0x81, 0x6a, 0x82, 0x52, 0x14, 0x0b, 0xdb, 0x68, 0x1b, 0xdd, 0xf5, 0xfd, 0xaf, 0x9d, 0x97, 0x44,
-	0xca, 0x7a, 0x4e, 0x5f, 0x1f, 0xfc, 0x44, 0x55, 0x1c, 0x98, 0xb7, 0xef, 0x77, 0x2b, 0x23, 0x5d,
-	0x0f, 0xbf, 0x01, 0x30, 0x49, 0x79, 0x46, 0x7d, 0x92, 0x7a, 0x85, 0x10, 0x1e, 0xf5, 0xed, 0x95,
-	0xb6, 0xd1, 0xad, 0x8d, 0xea, 0x65, 0xa6, 0xaf, 0x12, 0x03, 0x1f, 0x3a, 0x60, 0x7b, 0x8e, 0x0e,
-	0x11, 0x63, 0x24, 0xca, 0xe1, 0xab, 0x0a, 0xfe, 0xd9, 0x0c, 0x5e, 0x64, 0x06, 0x3e, 0x6c, 0x82,
-	0x1a, 0x23, 0x53, 0x4f, 0xcd, 0x65, 0x9b, 0x6d, 0xa3, 0x6b, 0x8d, 0x2c, 0x46, 0xa6, 0xfd, 0x3c,
-	0x86, 0xff, 0x82, 0x9d, 0x90, 0xe4, 0x0b, 0xf0, 0x24, 0xf7, 0x32, 0x14, 0x09, 0x22, 0xbd, 0x49,
-	0xe2, 0x23, 0x49, 0x72, 0xce, 0x5a, 0x7b, 0xb5, 0xbb, 0xbe, 0xff, 0xb3, 0xb3, 0xc4, 0xf6, 0x9d,
-	0x23, 0x45, 0x73, 0xc6, 0x2f, 0x14, 0xc9, 0xb9, 0xe2, 0x18, 0x1c, 0xea, 0x93, 0x36, 0xc2, 0x45,
-	0x59, 0x1f, 0xfe, 0x67, 0x80, 0x2f, 0xf9, 0x44, 0x0a, 0x89, 0x98, 0x4f, 0x59, 0xe0, 0xf9, 0x7c,
-	0xca, 0xf2, 0xad, 0x78, 0x22, 0x42, 0x22, 0xa4, 0x2c, 0xb0, 0x81, 0x1a, 0xe1, 0xa7, 0xa5, 0x46,
-	0xf8, 0x73, 0xce, 0x74, 0xa8, 0x89, 0x74, 0xff, 0x26, 0x7f, 0x9e, 0x3a, 0xd5, 0x2d, 0xe0, 0x3f,
-	0xc0, 0x4e, 0x48, 0xd1, 0xbf, 0x64, 0xf3, 0x12, 0x84, 0xaf, 0x89, 0x14, 0xf6, 0xba, 0x5a, 0xed,
-	0x72, 0x0a, 0xcc, 0x77, 0x9c, 0xd7, 0x1e, 0x22, 0x89, 0x8e, 0xa9, 0x90, 0xa5, 0x02, 0xba, 0xc5,
-	0x53, 0x90, 0x80, 0x6f, 0x0c, 0xd0, 0x8a, 0x90, 0x90, 0x9e, 0x4c, 0x11, 0x13, 0x31, 0x15, 0x82,
-	0x72, 0xe6, 0x8d, 0x23, 0x8e, 0xaf, 0xbd, 0x42, 0x34, 0x7b, 0x43, 0xcd, 0xf0, 0xdb, 0x52, 0x33,
-	0x1c, 0x23, 0x21, 0xcf, 0x1e, 0x31, 0x1d, 0xe4, 0x44, 0xc5, 0x6a, 0x4a, 0x29, 0xa2, 0x97, 0x21,
-	0xb0, 0x01, 0xaa, 0x49, 0x4a, 0xfa, 0xfd, 0x0b, 0x7b, 0x53, 0x19, 0x45, 0x47, 0x70, 0x08, 0xac,
-	0xd2, 0x58, 0xf6, 0x96, 0x1a, 0xa7, 0xfb, 0x9a, 0xdb, 0x4f, 0x34, 0x76, 0xc0, 0xae, 0xb8, 0x6e,
-	0x3b, 0xab, 0x87, 0x7b, 0x60, 0x13, 0x73, 0xc6, 0x08, 0x96, 0xf9, 0x49, 0xa9, 0x6f, 0x7f, 0xaa,
-	0x9c, 0xbb, 0x31, 0xff, 0x38, 0xf0, 0x87, 0xa6, 0xf5, 0x49, 0xbd, 0x3a, 0x34, 0xad, 0x6a, 0x7d,
-	0x6d, 0x68, 0x5a, 0x6b, 0x75, 0x6b, 0x68, 0x5a, 0x56, 0xbd, 0xd6, 0xb9, 0x04, 0x8d, 0xc5, 0x46,
-	0xcb, 0x47, 0xd7, 0x7a, 0xe5, 0xd7, 0xd1, 0x1c, 0xe9, 0x08, 0x76, 0x41, 0xfd, 0x99, 0xaf, 0x57,
-	0x14, 0x62, 0x2b, 0x7b, 0x62, 0xc6, 0xce, 0x39, 0xd8, 0x5e, 0xe0, 0x20, 0xf8, 0x2b, 0x68, 0x66,
-	0x28, 0xa2, 0x3e, 0x92, 0x3c, 0x55, 0x06, 0x21, 0x4c, 0x4c, 0x84, 0x87, 0x7c, 0x3f, 0x25, 0xa2,
-	0xb8, 0xfc, 0xb5, 0xd1, 0x17, 0x33, 0x48, 0xbf, 0x44, 0xfc, 0x5e, 0x00, 0x3a, 0x3f, 0x80, 0xe6,
-	0xf1, 0xeb, 0x92, 0x3f, 0x9a, 0x7b, 0xb5, 0x9c, 0xbb, 0x33, 0x06, 0x8d, 0xc5, 0x86, 0x82, 0x47,
-	0xc0, 0x8c, 0xa8, 0xc8, 0xf1, 0xf9, 0xd5, 0x70, 0x96, 0x7b, 0x76, 0x4a, 0x06, 0xbd, 0x0e, 0xc5,
-	0x70, 0xf0, 0xd7, 0xed, 0x7d, 0xcb, 0xb8, 0xbb, 0x6f, 0x19, 0x1f, 0xee, 0x5b, 0xc6, 0xdb, 0x87,
-	0x56, 0xe5, 0xee, 0xa1, 0x55, 0x79, 0xf7, 0xd0, 0xaa, 0x5c, 0xfe, 0x12, 0x50, 0x19, 0x4e, 0xc6,
-	0x0e, 0xe6, 0xb1, 0x8b, 0xb9, 0x88, 0xb9, 0x70, 0xe7, 0x6d, 0xbe, 0x9d, 0x3d, 0xb2, 0xd9, 0x8f,
-	0xee, 0xdf, 0x4f, 0xff, 0x3a, 0xd4, 0x93, 0x39, 0xae, 0xaa, 0x37, 0xf3, 0xfb, 0x8f, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0xd9, 0x14, 0xdc, 0x1c, 0x6b, 0x06, 0x00, 0x00,
+	// 890 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0x41, 0x6f, 0xdb, 0x36,
+	0x14, 0x8e, 0x1a, 0xcf, 0x95, 0x99, 0xb4, 0x73, 0x99, 0x2d, 0xd3, 0xe2, 0xd5, 0x35, 0x5c, 0x0c,
+	0x30, 0x86, 0x4d, 0xaa, 0x53, 0x0c, 0xdb, 0x30, 0xac, 0xd8, 0xe2, 0x00, 0x8b, 0x8d, 0x00, 0x2b,
+	0x94, 0x34, 0x03, 0x7a, 0x11, 0x68, 0x8a, 0x95, 0x88, 0xc8, 0xa4, 0x20, 0xd2, 0xf2, 0x8a, 0xa1,
+	0x97, 0x1d, 0x76, 0xd9, 0x65, 0x3f, 0xab, 0x97, 0x01, 0x3d, 0xee, 0x34, 0x0c, 0xc9, 0x1f, 0x19,
+	0x48, 0x51, 0x56, 0xb2, 0xda, 0x81, 0x7a, 0x13, 0xf5, 0xbe, 0xf7, 0xbd, 0xf7, 0xbe, 0xf7, 0xf8,
+	0x08, 0x86, 0x94, 0x49, 0x92, 0xe1, 0x18, 0x51, 0x16, 0x08, 0x82, 0xe7, 0x19, 0x95, 0x2f, 0x3d,
+	0x8c, 0x73, 0x0f, 0x73, 0x26, 0xe6, 0x33, 0x92, 0x79, 0xf9, 0xd0, 0x8b, 0x08, 0x23, 0x82, 0x0a,
+	0x37, 0xcd, 0xb8, 0xe4, 0xf0, 0xe1, 0x0a, 0x17, 0x17, 0xe3, 0xdc, 0x2d, 0x5d, 0xdc, 0x7c, 0xb8,
+	0xf7, 0x68, 0x1d, 0x6f, 0x3e, 0xf4, 0x44, 0x8c, 0x32, 0x12, 0x06, 0x4b, 0xb8, 0xa6, 0xdd, 0xdb,
+	0xaf, 0x93, 0xc9, 0xff, 0x7c, 0x3c, 0x3a, 0xc5, 0x5e, 0x42, 0xa3, 0x58, 0xe2, 0x84, 0x12, 0x26,
+	0x85, 0x27, 0x09, 0x0b, 0x49, 0x36, 0xa3, 0x4c, 0x2a, 0x78, 0x75, 0x32, 0x0e, 0x1f, 0x44, 0x3c,
+	0xe2, 0xfa, 0xd3, 0x53, 0x5f, 0xe6, 0xef, 0xa7, 0x37, 0x24, 0xbb, 0xa0, 0x19, 0x31, 0xb0, 0x07,
+	0x11, 0xe7, 0x51, 0x42, 0x3c, 0x7d, 0x9a, 0xce, 0x5f, 0x78, 0x92, 0xce, 0x88, 0x90, 0x68, 0x96,
+	0x1a, 0x40, 0xe7, 0x4a, 0x74, 0x34, 0xc5, 0xd4, 0x93, 0x2f, 0x53, 0x62, 0x64, 0xeb, 0xff, 0x05,
+	0xc0, 0xf6, 0x8f, 0x85, 0x90, 0x27, 0x12, 0x49, 0x02, 0x8f, 0x40, 0x33, 0x45, 0x19, 0x9a, 0x09,
+	0xc7, 0xea, 0x59, 0x83, 0xad, 0xfd, 0xcf, 0xdc, 0x75, 0xc2, 0xe6, 0x43, 0x77, 0x64, 0x0a, 0x7f,
+	0xaa, 0x3d, 0x0e, 0x1a, 0xaf, 0xff, 0x79, 0xb0, 0xe1, 0x1b, 0x7f, 0xf8, 0x39, 0x80, 0x69, 0xc6,
+	0x73, 0x1a, 0x92, 0x2c, 0x28, 0x84, 0x08, 0x68, 0xe8, 0xdc, 0xea, 0x59, 0x83, 0x96, 0xdf, 0x2e,
+	0x2d, 0x23, 0x6d, 0x18, 0x87, 0xd0, 0x05, 0x3b, 0x15, 0x3a, 0x46, 0x8c, 0x91, 0x44, 0xc1, 0x37,
+	0x35, 0xfc, 0xde, 0x12, 0x5e, 0x58, 0xc6, 0x21, 0xec, 0x80, 0x16, 0x23, 0x8b, 0x40, 0xe7, 0xe5,
+	0x34, 0x7a, 0xd6, 0xc0, 0xf6, 0x6d, 0x46, 0x16, 0x23, 0x75, 0x86, 0xaf, 0xc0, 0x5e, 0x4c, 0x54,
+	0x03, 0x02, 0xc9, 0x83, 0x1c, 0x25, 0x82, 0xc8, 0x60, 0x9e, 0x86, 0x48, 0x12, 0xc5, 0xd9, 0xea,
+	0x6d, 0x0e, 0xb6, 0xf6, 0xbf, 0x75, 0x6b, 0x4c, 0x8c, 0x7b, 0xa4, 0x69, 0x4e, 0xf9, 0x99, 0x26,
+	0x79, 0xa6, 0x39, 0xc6, 0x87, 0xa6, 0xd2, 0xdd, 0x78, 0x95, 0x35, 0x84, 0xbf, 0x59, 0xe0, 0x3e,
+	0x9f, 0x4b, 0x21, 0x11, 0x0b, 0x29, 0x8b, 0x82, 0x90, 0x2f, 0x98, 0xea, 0x4a, 0x20, 0x12, 0x24,
+	0x62, 0xca, 0x22, 0x07, 0xe8, 0x14, 0xbe, 0xae, 0x95, 0xc2, 0x4f, 0x15, 0xd3, 0xa1, 0x21, 0x32,
+	0xf1, 0x3b, 0xfc, 0x6d, 0xd3, 0x89, 0x09, 0x01, 0x7f, 0x05, 0x4e, 0x4a, 0x8a, 0xf8, 0x25, 0x5b,
+	0x90, 0x22, 0x7c, 0x4e, 0xa4, 0x70, 0xb6, 0x7a, 0x56, 0x6d, 0x05, 0xaa, 0x1e, 0x2b, 0xdf, 0x43,
+	0x24, 0xd1, 0x31, 0x15, 0xb2, 0x54, 0xc0, 0x84, 0xb8, 0x0e, 0x12, 0xf0, 0x0f, 0x0b, 0x74, 0x13,
+	0x24, 0x64, 0x20, 0x33, 0xc4, 0xc4, 0x8c, 0x0a, 0x41, 0x39, 0x0b, 0xa6, 0x09, 0xc7, 0xe7, 0x41,
+	0x21, 0x9a, 0xb3, 0xad, 0x73, 0xf8, 0xbe, 0x56, 0x0e, 0xc7, 0x48, 0xc8, 0xd3, 0x2b, 0x4c, 0x07,
+	0x8a, 0xa8, 0x68, 0x4d, 0x29, 0x45, 0xb2, 0x1e, 0x02, 0x77, 0x41, 0x33, 0xcd, 0xc8, 0x68, 0x74,
+	0xe6, 0xdc, 0xd1, 0x83, 0x62, 0x4e, 0x70, 0x02, 0xec, 0x72, 0xb0, 0x9c, 0xbb, 0x3a, 0x9d, 0xc1,
+	0x4d, 0xd3, 0xfe, 0xd4, 0x60, 0xc7, 0xec, 0x05, 0x37, 0x61, 0x97, 0xfe, 0xf0, 0x21, 0xb8, 0x83,
+	0x39, 0x63, 0x04, 0x4b, 0x55, 0x29, 0x0d, 0x9d, 0xf7, 0xf5, 0xe4, 0x6e, 0x57, 0x3f, 0xc7, 0x21,
+	0x3c, 0x01, 0xdb, 0x7a, 0x04, 0x82, 0x8c, 0x60, 0x9e, 0x85, 0x4e, 0x5b, 0x07, 0x7d, 0x54, 0x4b,
+	0x03, 0xdd, 0x58, 0x5f, 0xfb, 0xf9, 0x5b, 0xa2, 0x3a, 0xc0, 0x73, 0xf0, 0x51, 0xd9, 0xe8, 0xe5,
+	0x0d, 0x12, 0x0b, 0x2a, 0x71, 0xec, 0xdc, 0xd3, 0xfc, 0x8f, 0x6b, 0xf1, 0x97, 0xd5, 0x9d, 0x68,
+	0x57, 0xff, 0x43, 0xc3, 0x79, 0xfd, 0x37, 0x7c, 0x05, 0xee, 0x97, 0xc1, 0xf4, 0xe4, 0xa1, 0x84,
+	0x33, 0x52, 0x74, 0x99, 0xaa, 0x22, 0x1d, 0xa8, 0x43, 0x7e, 0x53, 0xaf, 0xa4, 0x25, 0xc3, 0xe9,
+	0x92, 0xc0, 0xef, 0x18, 0xfe, 0x55, 0x46, 0xf8, 0xbb, 0x05, 0x3e, 0xb9, 0x12, 0x37, 0x47, 0x09,
+	0x0d, 0x91, 0xe4, 0x99, 0x11, 0x54, 0x38, 0x3b, 0xfa, 0x62, 0x3d, 0x79, 0xc7, 0xf0, 0x67, 0x25,
+	0x4f, 0x21, 0xa9, 0x69, 0xee, 0x9e, 0x58, 0x07, 0x10, 0x93, 0x86, 0xfd, 0x5e, 0xbb, 0x39, 0x69,
+	0xd8, 0xcd, 0xf6, 0xed, 0x49, 0xc3, 0xbe, 0xdd, 0xb6, 0x27, 0x0d, 0xdb, 0x6e, 0xb7, 0xfa, 0xcf,
+	0xc1, 0xee, 0xea, 0x95, 0xa1, 0x86, 0xd0, 0x4c, 0xbe, 0x5a, 0xac, 0x0d, 0xdf, 0x9c, 0xe0, 0x00,
+	0xb4, 0xdf, 0xda, 0x50, 0xb7, 0x34, 0xe2, 0x6e, 0x7e, 0x6d, 0xad, 0xf4, 0x9f, 0x81, 0x9d, 0x15,
+	0xbb, 0x00, 0x3e, 0x01, 0x9d, 0x4a, 0x07, 0x55, 0x1f, 0x61, 0x62, 0x2e, 0x02, 0x14, 0x86, 0x19,
+	0x11, 0xc5, 0x1a, 0x6f, 0xf9, 0x1f, 0x2f, 0x21, 0xa3, 0x12, 0xf1, 0x43, 0x01, 0xe8, 0x7f, 0x09,
+	0x3a, 0xc7, 0x37, 0x5f, 0x9e, 0x2b, 0x79, 0x6f, 0x96, 0x79, 0xf7, 0xa7, 0x60, 0x77, 0xf5, 0x6a,
+	0x80, 0x47, 0xa0, 0x91, 0x50, 0xa1, 0xf0, 0xaa, 0x17, 0x6e, 0xbd, 0x07, 0xa4, 0x64, 0x30, 0xda,
+	0x6b, 0x86, 0x83, 0x9f, 0x5f, 0x5f, 0x74, 0xad, 0x37, 0x17, 0x5d, 0xeb, 0xdf, 0x8b, 0xae, 0xf5,
+	0xe7, 0x65, 0x77, 0xe3, 0xcd, 0x65, 0x77, 0xe3, 0xef, 0xcb, 0xee, 0xc6, 0xf3, 0xef, 0x22, 0x2a,
+	0xe3, 0xf9, 0xd4, 0xc5, 0x7c, 0xe6, 0x61, 0x2e, 0x66, 0x5c, 0x78, 0x55, 0x98, 0x2f, 0x96, 0xcf,
+	0x65, 0xfe, 0x95, 0xf7, 0xcb, 0xf5, 0xe7, 0x5a, 0x3f, 0x7e, 0xd3, 0xa6, 0x7e, 0xfd, 0x1e, 0xff,
+	0x37, 0x00, 0xfc, 0x96, 0x3d, 0xf1, 0x69, 0x08, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -448,6 +494,64 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.StandaloneValidatorRecords) > 0 {
+		for iNdEx := len(m.StandaloneValidatorRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StandaloneValidatorRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if m.PendingStandaloneTransition != nil {
+		{
+			size, err := m.PendingStandaloneTransition.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if m.PendingProviderSwitch != nil {
+		{
+			size, err := m.PendingProviderSwitch.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.SlashRecord != nil {
+		{
+			size, err := m.SlashRecord.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
 	if len(m.ConnectionId) > 0 {
 		i -= len(m.ConnectionId)
 		copy(dAtA[i:], m.ConnectionId)
@@ -743,6 +847,24 @@ func (m *GenesisState) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if m.SlashRecord != nil {
+		l = m.SlashRecord.Size()
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	if m.PendingProviderSwitch != nil {
+		l = m.PendingProviderSwitch.Size()
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	if m.PendingStandaloneTransition != nil {
+		l = m.PendingStandaloneTransition.Size()
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	if len(m.StandaloneValidatorRecords) > 0 {
+		for _, e := range m.StandaloneValidatorRecords {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1172,6 +1294,148 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			}
 			m.ConnectionId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashRecord", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SlashRecord == nil {
+				m.SlashRecord = &SlashRecord{}
+			}
+			if err := m.SlashRecord.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingProviderSwitch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PendingProviderSwitch == nil {
+				m.PendingProviderSwitch = &ProviderSwitch{}
+			}
+			if err := m.PendingProviderSwitch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingStandaloneTransition", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PendingStandaloneTransition == nil {
+				m.PendingStandaloneTransition = &StandaloneTransition{}
+			}
+			if err := m.PendingStandaloneTransition.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StandaloneValidatorRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StandaloneValidatorRecords = append(m.StandaloneValidatorRecords, StandaloneValidatorRecord{})
+			if err := m.StandaloneValidatorRecords[len(m.StandaloneValidatorRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
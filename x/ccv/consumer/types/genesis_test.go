@@ -162,6 +162,23 @@ func TestValidateInitialGenesisState(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"invalid new consumer genesis state: non-nil slash record",
+			&types.GenesisState{
+				Params:            params,
+				ProviderClientId:  "",
+				ProviderChannelId: "",
+				NewChain:          true,
+				Provider: ccv.ProviderInfo{
+					ClientState:    cs,
+					ConsensusState: consensusState,
+					InitialValSet:  valUpdates,
+				},
+				PendingConsumerPackets: types.ConsumerPacketDataList{},
+				SlashRecord:            &types.SlashRecord{WaitingOnReply: true},
+			},
+			true,
+		},
 		{
 			"invalid new consumer genesis state: nil initial validator set",
 			types.NewInitialGenesisState(cs, consensusState, nil, params),
@@ -208,6 +225,13 @@ func TestValidateInitialGenesisState(t *testing.T) {
 					[]string{},
 					ccv.DefaultRetryDelayPeriod,
 					"1",
+					false,
+					ccv.DefaultMaxValidatorUpdatesPerBlock,
+					ccv.DefaultRetryDelayMultiplier,
+					ccv.DefaultMaxRetryDelayPeriod,
+					ccv.DefaultClientExpiryWarningThreshold,
+					nil,
+					nil,
 				)),
 			true,
 		},
@@ -228,6 +252,13 @@ func TestValidateInitialGenesisState(t *testing.T) {
 					[]string{},
 					ccv.DefaultRetryDelayPeriod,
 					"1",
+					false,
+					ccv.DefaultMaxValidatorUpdatesPerBlock,
+					ccv.DefaultRetryDelayMultiplier,
+					ccv.DefaultMaxRetryDelayPeriod,
+					ccv.DefaultClientExpiryWarningThreshold,
+					nil,
+					nil,
 				)),
 			true,
 		},
@@ -361,6 +392,15 @@ func TestValidateRestartConsumerGenesisState(t *testing.T) {
 	params := ccv.DefaultParams()
 	params.Enabled = true
 
+	// restart genesis state with the given records of tombstoned and jailed standalone validators
+	withStandaloneValidatorRecords := func(records ...types.StandaloneValidatorRecord) *types.GenesisState {
+		gs := types.NewRestartGenesisState("ccvclient", "ccvchannel", valUpdates, heightToValsetUpdateID,
+			types.ConsumerPacketDataList{}, nil, types.LastTransmissionBlockHeight{}, params)
+		gs.StandaloneValidatorRecords = records
+		return gs
+	}
+	consAddr := cId.SDKValConsAddress().String()
+
 	cases := []struct {
 		name     string
 		gs       *types.GenesisState
@@ -423,11 +463,11 @@ func TestValidateRestartConsumerGenesisState(t *testing.T) {
 			true,
 		},
 		{
-			"invalid restart consumer genesis state: outstanding downtime defined when handshake is still in progress",
+			"valid restart consumer genesis state: outstanding downtime defined when handshake is still in progress",
 			types.NewRestartGenesisState("ccvclient", "",
 				valUpdates, heightToValsetUpdateID, types.ConsumerPacketDataList{}, []types.OutstandingDowntime{{ValidatorConsensusAddress: "cosmosvalconsxxx"}},
 				types.LastTransmissionBlockHeight{}, params),
-			true,
+			false,
 		},
 		{
 			"invalid restart consumer genesis state: last transmission block height defined when handshake is still in progress",
@@ -452,9 +492,60 @@ func TestValidateRestartConsumerGenesisState(t *testing.T) {
 					[]string{},
 					ccv.DefaultRetryDelayPeriod,
 					"1",
+					false,
+					ccv.DefaultMaxValidatorUpdatesPerBlock,
+					ccv.DefaultRetryDelayMultiplier,
+					ccv.DefaultMaxRetryDelayPeriod,
+					ccv.DefaultClientExpiryWarningThreshold,
+					nil,
+					nil,
 				)),
 			true,
 		},
+		{
+			"valid restart consumer genesis state: tombstoned and jailed standalone validators",
+			withStandaloneValidatorRecords(
+				types.StandaloneValidatorRecord{ConsensusAddress: consAddr, Tombstoned: true},
+				types.StandaloneValidatorRecord{ConsensusAddress: crypto.NewCryptoIdentityFromIntSeed(1).SDKValConsAddress().String(), JailedUntil: time.Now()},
+			),
+			false,
+		},
+		{
+			"invalid restart consumer genesis state: invalid consensus address of standalone validator",
+			withStandaloneValidatorRecords(types.StandaloneValidatorRecord{ConsensusAddress: "cosmosvalconsxxx", Tombstoned: true}),
+			true,
+		},
+		{
+			"invalid restart consumer genesis state: standalone validator neither tombstoned nor jailed",
+			withStandaloneValidatorRecords(types.StandaloneValidatorRecord{ConsensusAddress: consAddr}),
+			true,
+		},
+		{
+			"invalid restart consumer genesis state: deferred update of tombstoned standalone validator",
+			withStandaloneValidatorRecords(types.StandaloneValidatorRecord{
+				ConsensusAddress: consAddr,
+				Tombstoned:       true,
+				DeferredUpdate:   &abci.ValidatorUpdate{Power: 1},
+			}),
+			true,
+		},
+		{
+			"invalid restart consumer genesis state: deferred update with zero power",
+			withStandaloneValidatorRecords(types.StandaloneValidatorRecord{
+				ConsensusAddress: consAddr,
+				JailedUntil:      time.Now(),
+				DeferredUpdate:   &abci.ValidatorUpdate{},
+			}),
+			true,
+		},
+		{
+			"invalid restart consumer genesis state: duplicate standalone validator records",
+			withStandaloneValidatorRecords(
+				types.StandaloneValidatorRecord{ConsensusAddress: consAddr, Tombstoned: true},
+				types.StandaloneValidatorRecord{ConsensusAddress: consAddr, JailedUntil: time.Now()},
+			),
+			true,
+		},
 	}
 
 	for _, c := range cases {
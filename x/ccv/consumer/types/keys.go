@@ -21,9 +21,17 @@ const (
 	// QuerierRoute is the querier route for IBC consumer
 	QuerierRoute = ModuleName
 
+	// ConsensusVersion is the consensus version of the module, which is incremented
+	// with every migration of the module state
+	ConsensusVersion = 7
+
 	// ConsumerRedistributeName the root string for the consumer-redistribution account address
 	ConsumerRedistributeName = "cons_redistribute"
 
+	// DefaultProviderId is the identifier of the provider chain the consumer chain was launched with.
+	// The state related to a provider chain is namespaced by the identifier of the provider chain.
+	DefaultProviderId = "0"
+
 	// ConsumerToSendToProviderName is a "buffer" address for outgoing fees to be transferred to the provider chain
 	//#nosec G101 -- (false positive) this is not a hardcoded credential
 	ConsumerToSendToProviderName = "cons_to_send_to_provider"
@@ -77,6 +85,42 @@ const (
 	SlashRecordKeyName = "SlashRecordKey"
 
 	ParametersKeyName = "ParametersKey"
+
+	MigrationProviderChannelIDKeyName = "MigrationProviderChannelIDKey"
+
+	PreviousProviderChannelIDKeyName = "PreviousProviderChannelIDKey"
+
+	PacketTimeoutKeyName = "PacketTimeoutKey"
+
+	RateLimitExemptionKeyName = "RateLimitExemptionKey"
+
+	ProviderClientExpiryKeyName = "ProviderClientExpiryKey"
+
+	ProviderNamespaceKeyName = "ProviderNamespaceKey"
+
+	ActiveProviderIdKeyName = "ActiveProviderIdKey"
+
+	PendingProviderSwitchKeyName = "PendingProviderSwitchKey"
+
+	PreviousProviderIdKeyName = "PreviousProviderIdKey"
+
+	ConsumerShutdownKeyName = "ConsumerShutdownKey"
+
+	PendingStandaloneTransitionKeyName = "PendingStandaloneTransitionKey"
+
+	StandaloneChainKeyName = "StandaloneChainKey"
+
+	HeartbeatSignerKeyName = "HeartbeatSignerKey"
+
+	LastHeartbeatHeightKeyName = "LastHeartbeatHeightKey"
+
+	StandaloneValidatorRecordKeyName = "StandaloneValidatorRecordKey"
+
+	PendingMisbehaviourReportKeyName = "PendingMisbehaviourReportKey"
+
+	MisbehaviourReportHeightKeyName = "MisbehaviourReportHeightKey"
+
+	ProviderClientExpiryWarnedKeyName = "ProviderClientExpiryWarnedKey"
 )
 
 // getKeyPrefixes returns a constant map of all the byte prefixes for existing keys
@@ -161,6 +205,77 @@ func getKeyPrefixes() map[string]byte {
 		// ParametersKey is the key for storing the consumer's parameters.
 		ParametersKeyName: 22,
 
+		// MigrationProviderChannelIDKey is the key for storing the channelID of a migration channel,
+		// i.e., a channel that replaces the CCV channel once it receives the first VSC packet
+		MigrationProviderChannelIDKeyName: 23,
+
+		// PreviousProviderChannelIDKey is the key for storing the channelID of the CCV channel
+		// that was replaced by a migration channel
+		PreviousProviderChannelIDKeyName: 24,
+
+		// PacketTimeoutKey is the key for storing the timeout timestamps of the packets
+		// sent to the provider that are not yet acknowledged
+		PacketTimeoutKeyName: 25,
+
+		// RateLimitExemptionKey is the key for storing the transfers of the consumer rewards
+		// exempted from the rate limits of an IBC rate-limiting middleware
+		RateLimitExemptionKeyName: 26,
+
+		// ProviderClientExpiryKey is the key for storing the time at which the client to the provider
+		// expires, i.e., the end of the trusting period of its latest consensus state
+		ProviderClientExpiryKeyName: 27,
+
+		// ProviderNamespaceKey is the key prefix for storing the state related to a provider chain,
+		// i.e., the keys returned by ProviderScopedKeyPrefixes(), namespaced by the provider id
+		ProviderNamespaceKeyName: 28,
+
+		// ActiveProviderIdKey is the key for storing the identifier of the provider chain
+		// that currently secures the consumer chain
+		ActiveProviderIdKeyName: 29,
+
+		// PendingProviderSwitchKey is the key for storing the pending switch of the consumer chain
+		// to a different provider chain
+		PendingProviderSwitchKeyName: 30,
+
+		// PreviousProviderIdKey is the key for storing the identifier of the provider chain
+		// that secured the consumer chain before the last switch of provider chains
+		PreviousProviderIdKeyName: 31,
+
+		// ConsumerShutdownKey is the key for storing the flag marking whether
+		// the consumer chain initiated its shutdown
+		ConsumerShutdownKeyName: 32,
+
+		// PendingStandaloneTransitionKey is the key for storing the pending transition
+		// of the consumer chain to a standalone chain
+		PendingStandaloneTransitionKeyName: 33,
+
+		// StandaloneChainKey is the key for storing the flag marking whether
+		// the consumer chain transitioned to a standalone chain
+		StandaloneChainKeyName: 34,
+
+		// HeartbeatSignerKey is the key prefix for storing the consensus addresses of the validators
+		// that signed at least one block since the last heartbeat sent to the provider
+		HeartbeatSignerKeyName: 35,
+
+		// LastHeartbeatHeightKey is the key for storing the height at which the last heartbeat was sent to the provider
+		LastHeartbeatHeightKeyName: 36,
+
+		// StandaloneValidatorRecordKey is the key prefix for storing the tombstoned and jailed validators
+		// of a previously standalone chain, recorded during the standalone to consumer changeover
+		StandaloneValidatorRecordKeyName: 37,
+
+		// PendingMisbehaviourReportKey is the key prefix for storing the verified misbehaviour reports
+		// that are not yet sent to the provider, indexed by the height of the misbehaviour
+		PendingMisbehaviourReportKeyName: 38,
+
+		// MisbehaviourReportHeightKey is the key prefix for storing the heights of the reported misbehaviour,
+		// so that a misbehaviour is reported at most once per height
+		MisbehaviourReportHeightKeyName: 39,
+
+		// ProviderClientExpiryWarnedKey is the key for storing the flag marking whether the consumer
+		// warned that the client to the provider is about to expire
+		ProviderClientExpiryWarnedKeyName: 40,
+
 		// NOTE: DO NOT ADD NEW BYTE PREFIXES HERE WITHOUT ADDING THEM TO TestPreserveBytePrefix() IN keys_test.go
 	}
 }
@@ -192,6 +307,33 @@ func GetAllKeyPrefixes() []byte {
 	return prefixList
 }
 
+// ProviderScopedKeyPrefixes returns the byte prefixes of the keys storing the state related to
+// a provider chain, which are stored under the namespace of the provider chain (see ProviderNamespaceKeyPrefix)
+func ProviderScopedKeyPrefixes() []byte {
+	return []byte{
+		mustGetKeyPrefix(ProviderClientIDKeyName),
+		mustGetKeyPrefix(ProviderChannelIDKeyName),
+		mustGetKeyPrefix(PendingChangesKeyName),
+		mustGetKeyPrefix(HeightValsetUpdateIDKeyName),
+		mustGetKeyPrefix(OutstandingDowntimeKeyName),
+		mustGetKeyPrefix(PendingDataPacketsV1KeyName),
+		mustGetKeyPrefix(CrossChainValidatorKeyName),
+		mustGetKeyPrefix(InitGenesisHeightKeyName),
+		mustGetKeyPrefix(PendingPacketsIndexKeyName),
+		mustGetKeyPrefix(SlashRecordKeyName),
+		mustGetKeyPrefix(MigrationProviderChannelIDKeyName),
+		mustGetKeyPrefix(PreviousProviderChannelIDKeyName),
+		mustGetKeyPrefix(PacketTimeoutKeyName),
+		mustGetKeyPrefix(ProviderClientExpiryKeyName),
+		mustGetKeyPrefix(ConsumerShutdownKeyName),
+		mustGetKeyPrefix(HeartbeatSignerKeyName),
+		mustGetKeyPrefix(LastHeartbeatHeightKeyName),
+		mustGetKeyPrefix(PendingMisbehaviourReportKeyName),
+		mustGetKeyPrefix(MisbehaviourReportHeightKeyName),
+		mustGetKeyPrefix(ProviderClientExpiryWarnedKeyName),
+	}
+}
+
 // GetAllKeyNames returns the names of all the keys.
 // Only used for testing
 func GetAllKeyNames() []string {
@@ -234,6 +376,16 @@ func ProviderChannelIDKey() []byte {
 	return []byte{mustGetKeyPrefix(ProviderChannelIDKeyName)}
 }
 
+// MigrationProviderChannelIDKey returns the key for storing the channelID of a migration channel to the provider chain
+func MigrationProviderChannelIDKey() []byte {
+	return []byte{mustGetKeyPrefix(MigrationProviderChannelIDKeyName)}
+}
+
+// PreviousProviderChannelIDKey returns the key for storing the channelID of the replaced CCV channel
+func PreviousProviderChannelIDKey() []byte {
+	return []byte{mustGetKeyPrefix(PreviousProviderChannelIDKeyName)}
+}
+
 // PendingChangesKey returns the key for storing pending validator set changes
 func PendingChangesKey() []byte {
 	return []byte{mustGetKeyPrefix(PendingChangesKeyName)}
@@ -340,6 +492,121 @@ func ParametersKey() []byte {
 	return []byte{mustGetKeyPrefix(ParametersKeyName)}
 }
 
+// PacketTimeoutKeyPrefix returns the key prefix for storing the timeout timestamps
+// of the unacknowledged packets sent to the provider on the given channel
+func PacketTimeoutKeyPrefix(channelID string) []byte {
+	key := append([]byte{mustGetKeyPrefix(PacketTimeoutKeyName)}, sdk.Uint64ToBigEndian(uint64(len(channelID)))...)
+	return append(key, []byte(channelID)...)
+}
+
+// PacketTimeoutKey returns the key for storing the timeout timestamp
+// of the unacknowledged packet with the given sequence sent to the provider on the given channel
+func PacketTimeoutKey(channelID string, sequence uint64) []byte {
+	return append(PacketTimeoutKeyPrefix(channelID), sdk.Uint64ToBigEndian(sequence)...)
+}
+
+// RateLimitExemptionKey returns the key for storing the transfers of the consumer rewards
+// exempted from the rate limits of an IBC rate-limiting middleware
+func RateLimitExemptionKey() []byte {
+	return []byte{mustGetKeyPrefix(RateLimitExemptionKeyName)}
+}
+
+// ProviderClientExpiryKey returns the key for storing the expiry time of the client to the provider
+func ProviderClientExpiryKey() []byte {
+	return []byte{mustGetKeyPrefix(ProviderClientExpiryKeyName)}
+}
+
+// ProviderNamespaceKeyPrefix returns the key prefix for storing the state related to the provider chain with `providerId`
+func ProviderNamespaceKeyPrefix(providerId string) []byte {
+	key := append([]byte{mustGetKeyPrefix(ProviderNamespaceKeyName)}, sdk.Uint64ToBigEndian(uint64(len(providerId)))...)
+	return append(key, []byte(providerId)...)
+}
+
+// ActiveProviderIdKey returns the key for storing the identifier of the provider chain that secures the consumer chain
+func ActiveProviderIdKey() []byte {
+	return []byte{mustGetKeyPrefix(ActiveProviderIdKeyName)}
+}
+
+// PendingProviderSwitchKey returns the key for storing the pending switch of the consumer chain to a different provider chain
+func PendingProviderSwitchKey() []byte {
+	return []byte{mustGetKeyPrefix(PendingProviderSwitchKeyName)}
+}
+
+// PreviousProviderIdKey returns the key for storing the identifier of the provider chain
+// that secured the consumer chain before the last switch of provider chains
+func PreviousProviderIdKey() []byte {
+	return []byte{mustGetKeyPrefix(PreviousProviderIdKeyName)}
+}
+
+// ConsumerShutdownKey returns the key for storing the flag marking whether the consumer chain initiated its shutdown
+func ConsumerShutdownKey() []byte {
+	return []byte{mustGetKeyPrefix(ConsumerShutdownKeyName)}
+}
+
+// PendingStandaloneTransitionKey returns the key for storing the pending transition of the consumer chain to a standalone chain
+func PendingStandaloneTransitionKey() []byte {
+	return []byte{mustGetKeyPrefix(PendingStandaloneTransitionKeyName)}
+}
+
+// StandaloneChainKey returns the key for storing the flag marking whether the consumer chain transitioned to a standalone chain
+func StandaloneChainKey() []byte {
+	return []byte{mustGetKeyPrefix(StandaloneChainKeyName)}
+}
+
+// HeartbeatSignerKeyPrefix returns the key prefix for storing the validators that signed blocks since the last heartbeat
+func HeartbeatSignerKeyPrefix() []byte {
+	return []byte{mustGetKeyPrefix(HeartbeatSignerKeyName)}
+}
+
+// HeartbeatSignerKey returns the key for storing that the validator with consensus address `address`
+// signed at least one block since the last heartbeat
+func HeartbeatSignerKey(address sdk.ConsAddress) []byte {
+	return append(HeartbeatSignerKeyPrefix(), address.Bytes()...)
+}
+
+// LastHeartbeatHeightKey returns the key for storing the height at which the last heartbeat was sent to the provider
+func LastHeartbeatHeightKey() []byte {
+	return []byte{mustGetKeyPrefix(LastHeartbeatHeightKeyName)}
+}
+
+// StandaloneValidatorRecordKeyPrefix returns the key prefix for storing the tombstoned and jailed validators
+// of a previously standalone chain
+func StandaloneValidatorRecordKeyPrefix() []byte {
+	return []byte{mustGetKeyPrefix(StandaloneValidatorRecordKeyName)}
+}
+
+// StandaloneValidatorRecordKey returns the key for storing the record of the standalone validator
+// with consensus address `address`
+func StandaloneValidatorRecordKey(address sdk.ConsAddress) []byte {
+	return append(StandaloneValidatorRecordKeyPrefix(), address.Bytes()...)
+}
+
+// PendingMisbehaviourReportKeyPrefix returns the key prefix for storing the misbehaviour reports not yet sent to the provider
+func PendingMisbehaviourReportKeyPrefix() []byte {
+	return []byte{mustGetKeyPrefix(PendingMisbehaviourReportKeyName)}
+}
+
+// PendingMisbehaviourReportKey returns the key for storing the misbehaviour report at the given height
+func PendingMisbehaviourReportKey(height uint64) []byte {
+	return append(PendingMisbehaviourReportKeyPrefix(), sdk.Uint64ToBigEndian(height)...)
+}
+
+// MisbehaviourReportHeightKeyPrefix returns the key prefix for storing the heights of the reported misbehaviour
+func MisbehaviourReportHeightKeyPrefix() []byte {
+	return []byte{mustGetKeyPrefix(MisbehaviourReportHeightKeyName)}
+}
+
+// MisbehaviourReportHeightKey returns the key for storing that a misbehaviour was reported at the given height
+func MisbehaviourReportHeightKey(height uint64) []byte {
+	return append(MisbehaviourReportHeightKeyPrefix(), sdk.Uint64ToBigEndian(height)...)
+}
+
+// ProviderClientExpiryWarnedKey returns the key for storing whether the consumer warned
+// that the client to the provider is about to expire
+func ProviderClientExpiryWarnedKey() []byte {
+	return []byte{mustGetKeyPrefix(ProviderClientExpiryWarnedKeyName)}
+}
+
 // NOTE: DO	NOT ADD FULLY DEFINED KEY FUNCTIONS WITHOUT ADDING THEM TO getAllFullyDefinedKeys() IN keys_test.go
 
 //
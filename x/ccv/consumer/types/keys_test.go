@@ -71,6 +71,42 @@ func TestPreserveBytePrefix(t *testing.T) {
 	i++
 	require.Equal(t, byte(22), consumertypes.ParametersKey()[0])
 	i++
+	require.Equal(t, byte(23), consumertypes.MigrationProviderChannelIDKey()[0])
+	i++
+	require.Equal(t, byte(24), consumertypes.PreviousProviderChannelIDKey()[0])
+	i++
+	require.Equal(t, byte(25), consumertypes.PacketTimeoutKeyPrefix("channel-0")[0])
+	i++
+	require.Equal(t, byte(26), consumertypes.RateLimitExemptionKey()[0])
+	i++
+	require.Equal(t, byte(27), consumertypes.ProviderClientExpiryKey()[0])
+	i++
+	require.Equal(t, byte(28), consumertypes.ProviderNamespaceKeyPrefix(consumertypes.DefaultProviderId)[0])
+	i++
+	require.Equal(t, byte(29), consumertypes.ActiveProviderIdKey()[0])
+	i++
+	require.Equal(t, byte(30), consumertypes.PendingProviderSwitchKey()[0])
+	i++
+	require.Equal(t, byte(31), consumertypes.PreviousProviderIdKey()[0])
+	i++
+	require.Equal(t, byte(32), consumertypes.ConsumerShutdownKey()[0])
+	i++
+	require.Equal(t, byte(33), consumertypes.PendingStandaloneTransitionKey()[0])
+	i++
+	require.Equal(t, byte(34), consumertypes.StandaloneChainKey()[0])
+	i++
+	require.Equal(t, byte(35), consumertypes.HeartbeatSignerKey(sdk.ConsAddress([]byte{0x05}))[0])
+	i++
+	require.Equal(t, byte(36), consumertypes.LastHeartbeatHeightKey()[0])
+	i++
+	require.Equal(t, byte(37), consumertypes.StandaloneValidatorRecordKey(sdk.ConsAddress([]byte{0x05}))[0])
+	i++
+	require.Equal(t, byte(38), consumertypes.PendingMisbehaviourReportKey(5)[0])
+	i++
+	require.Equal(t, byte(39), consumertypes.MisbehaviourReportHeightKey(5)[0])
+	i++
+	require.Equal(t, byte(40), consumertypes.ProviderClientExpiryWarnedKey()[0])
+	i++
 
 	prefixes := consumertypes.GetAllKeyPrefixes()
 	require.Equal(t, len(prefixes), i)
@@ -120,5 +156,23 @@ func getAllFullyDefinedKeys() [][]byte {
 		consumertypes.PendingPacketsIndexKey(),
 		consumertypes.SlashRecordKey(),
 		consumertypes.ParametersKey(),
+		consumertypes.MigrationProviderChannelIDKey(),
+		consumertypes.PreviousProviderChannelIDKey(),
+		consumertypes.PacketTimeoutKey("channel-0", 0),
+		consumertypes.RateLimitExemptionKey(),
+		consumertypes.ProviderClientExpiryKey(),
+		consumertypes.ProviderNamespaceKeyPrefix(consumertypes.DefaultProviderId),
+		consumertypes.ActiveProviderIdKey(),
+		consumertypes.PendingProviderSwitchKey(),
+		consumertypes.PreviousProviderIdKey(),
+		consumertypes.ConsumerShutdownKey(),
+		consumertypes.PendingStandaloneTransitionKey(),
+		consumertypes.StandaloneChainKey(),
+		consumertypes.HeartbeatSignerKey(sdk.ConsAddress([]byte{0x05})),
+		consumertypes.LastHeartbeatHeightKey(),
+		consumertypes.StandaloneValidatorRecordKey(sdk.ConsAddress([]byte{0x05})),
+		consumertypes.PendingMisbehaviourReportKey(5),
+		consumertypes.MisbehaviourReportHeightKey(5),
+		consumertypes.ProviderClientExpiryWarnedKey(),
 	}
 }
@@ -21,67 +21,131 @@ func TestValidateParams(t *testing.T) {
 		{"default params", ccvtypes.DefaultParams(), true},
 		{
 			"custom valid params",
-			ccvtypes.NewParams(true, 5, "", "", 1004, 1005, "0.5", 1000, 24*21*time.Hour, []string{"untrn"}, []string{"uatom"}, 2*time.Hour, consumerId), true,
+			ccvtypes.NewParams(true, 5, "", "", 1004, 1005, "0.5", 1000, 24*21*time.Hour, []string{"untrn"}, []string{"uatom"}, 2*time.Hour, consumerId, false, 0, "1", 0, time.Hour, nil, nil), true,
 		},
 		{
 			"custom invalid params, block per dist transmission",
-			ccvtypes.NewParams(true, -5, "", "", 5, 1005, "0.5", 1000, 24*21*time.Hour, []string{"untrn"}, []string{"uatom"}, 2*time.Hour, consumerId), false,
+			ccvtypes.NewParams(true, -5, "", "", 5, 1005, "0.5", 1000, 24*21*time.Hour, []string{"untrn"}, []string{"uatom"}, 2*time.Hour, consumerId, false, 0, "1", 0, time.Hour, nil, nil), false,
 		},
 		{
 			"custom invalid params, dist transmission channel",
-			ccvtypes.NewParams(true, 5, "badchannel/", "", 5, 1005, "0.5", 1000, 24*21*time.Hour, []string{"untrn"}, []string{"uatom"}, 2*time.Hour, consumerId), false,
+			ccvtypes.NewParams(true, 5, "badchannel/", "", 5, 1005, "0.5", 1000, 24*21*time.Hour, []string{"untrn"}, []string{"uatom"}, 2*time.Hour, consumerId, false, 0, "1", 0, time.Hour, nil, nil), false,
 		},
 		{
 			"custom invalid params, ccv timeout",
-			ccvtypes.NewParams(true, 5, "", "", -5, 1005, "0.5", 1000, 24*21*time.Hour, []string{"untrn"}, []string{"uatom"}, 2*time.Hour, consumerId), false,
+			ccvtypes.NewParams(true, 5, "", "", -5, 1005, "0.5", 1000, 24*21*time.Hour, []string{"untrn"}, []string{"uatom"}, 2*time.Hour, consumerId, false, 0, "1", 0, time.Hour, nil, nil), false,
 		},
 		{
 			"custom invalid params, transfer timeout",
-			ccvtypes.NewParams(true, 5, "", "", 1004, -7, "0.5", 1000, 24*21*time.Hour, []string{"untrn"}, []string{"uatom"}, 2*time.Hour, consumerId), false,
+			ccvtypes.NewParams(true, 5, "", "", 1004, -7, "0.5", 1000, 24*21*time.Hour, []string{"untrn"}, []string{"uatom"}, 2*time.Hour, consumerId, false, 0, "1", 0, time.Hour, nil, nil), false,
 		},
 		{
 			"custom invalid params, consumer redist fraction is negative",
-			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "-0.5", 1000, 24*21*time.Hour, []string{"untrn"}, []string{"uatom"}, 2*time.Hour, consumerId), false,
+			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "-0.5", 1000, 24*21*time.Hour, []string{"untrn"}, []string{"uatom"}, 2*time.Hour, consumerId, false, 0, "1", 0, time.Hour, nil, nil), false,
 		},
 		{
 			"custom invalid params, consumer redist fraction is over 1",
-			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "1.2", 1000, 24*21*time.Hour, []string{"untrn"}, []string{"uatom"}, 2*time.Hour, consumerId), false,
+			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "1.2", 1000, 24*21*time.Hour, []string{"untrn"}, []string{"uatom"}, 2*time.Hour, consumerId, false, 0, "1", 0, time.Hour, nil, nil), false,
 		},
 		{
 			"custom invalid params, bad consumer redist fraction ",
-			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "notFrac", 1000, 24*21*time.Hour, []string{"untrn"}, []string{"uatom"}, 2*time.Hour, consumerId), false,
+			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "notFrac", 1000, 24*21*time.Hour, []string{"untrn"}, []string{"uatom"}, 2*time.Hour, consumerId, false, 0, "1", 0, time.Hour, nil, nil), false,
 		},
 		{
 			"custom invalid params, negative num historical entries",
-			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "0.5", -100, 24*21*time.Hour, []string{"untrn"}, []string{"uatom"}, 2*time.Hour, consumerId), false,
+			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "0.5", -100, 24*21*time.Hour, []string{"untrn"}, []string{"uatom"}, 2*time.Hour, consumerId, false, 0, "1", 0, time.Hour, nil, nil), false,
 		},
 		{
 			"custom invalid params, negative unbonding period",
-			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "0.5", 1000, -24*21*time.Hour, []string{"untrn"}, []string{"uatom"}, 2*time.Hour, consumerId), false,
+			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "0.5", 1000, -24*21*time.Hour, []string{"untrn"}, []string{"uatom"}, 2*time.Hour, consumerId, false, 0, "1", 0, time.Hour, nil, nil), false,
 		},
 		{
 			"custom invalid params, invalid reward denom",
-			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "0.5", 1000, 24*21*time.Hour, []string{"u"}, []string{}, 2*time.Hour, consumerId), false,
+			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "0.5", 1000, 24*21*time.Hour, []string{"u"}, []string{}, 2*time.Hour, consumerId, false, 0, "1", 0, time.Hour, nil, nil), false,
 		},
 		{
 			"custom invalid params, invalid provider reward denom",
-			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "0.5", 1000, 24*21*time.Hour, []string{}, []string{"a"}, 2*time.Hour, consumerId), false,
+			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "0.5", 1000, 24*21*time.Hour, []string{}, []string{"a"}, 2*time.Hour, consumerId, false, 0, "1", 0, time.Hour, nil, nil), false,
 		},
 		{
 			"custom invalid params, retry delay period is negative",
-			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "0.5", 1000, 24*21*time.Hour, []string{}, []string{}, -2*time.Hour, consumerId), false,
+			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "0.5", 1000, 24*21*time.Hour, []string{}, []string{}, -2*time.Hour, consumerId, false, 0, "1", 0, time.Hour, nil, nil), false,
 		},
 		{
 			"custom invalid params, retry delay period is zero",
-			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "0.5", 1000, 24*21*time.Hour, []string{}, []string{}, 0, consumerId), false,
+			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "0.5", 1000, 24*21*time.Hour, []string{}, []string{}, 0, consumerId, false, 0, "1", 0, time.Hour, nil, nil), false,
 		},
 		{
 			"custom invalid params, consumer ID is blank",
-			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "0.5", 1000, 24*21*time.Hour, []string{}, []string{}, time.Hour, ""), false,
+			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "0.5", 1000, 24*21*time.Hour, []string{}, []string{}, time.Hour, "", false, 0, "1", 0, time.Hour, nil, nil), false,
 		},
 		{
 			"custom invalid params, consumer ID is not a uint64",
-			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "0.5", 1000, 24*21*time.Hour, []string{}, []string{}, time.Hour, "consumerId"), false,
+			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "0.5", 1000, 24*21*time.Hour, []string{}, []string{}, time.Hour, "consumerId", false, 0, "1", 0, time.Hour, nil, nil), false,
+		},
+		{
+			"custom valid params, empty retry delay multiplier",
+			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "0.5", 1000, 24*21*time.Hour, []string{}, []string{}, time.Hour, consumerId, false, 0, "", 0, time.Hour, nil, nil), true,
+		},
+		{
+			"custom valid params, exponential retry delay with maximum",
+			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "0.5", 1000, 24*21*time.Hour, []string{}, []string{}, time.Hour, consumerId, false, 0, "1.5", 8*time.Hour, time.Hour, nil, nil), true,
+		},
+		{
+			"custom invalid params, retry delay multiplier smaller than 1",
+			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "0.5", 1000, 24*21*time.Hour, []string{}, []string{}, time.Hour, consumerId, false, 0, "0.5", 0, time.Hour, nil, nil), false,
+		},
+		{
+			"custom invalid params, negative max retry delay period",
+			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "0.5", 1000, 24*21*time.Hour, []string{}, []string{}, time.Hour, consumerId, false, 0, "2", -time.Hour, time.Hour, nil, nil), false,
+		},
+		{
+			"custom valid params, client expiry warnings disabled",
+			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "0.5", 1000, 24*21*time.Hour, []string{}, []string{}, time.Hour, consumerId, false, 0, "1", 0, 0, nil, nil), true,
+		},
+		{
+			"custom invalid params, negative client expiry warning threshold",
+			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "0.5", 1000, 24*21*time.Hour, []string{}, []string{}, time.Hour, consumerId, false, 0, "1", 0, -time.Hour, nil, nil), false,
+		},
+		{
+			"custom valid params, denom redistribution fractions",
+			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "0.5", 1000, 24*21*time.Hour, []string{}, []string{}, time.Hour, consumerId, false, 0, "1", 0, time.Hour,
+				[]ccvtypes.DenomRedistributionFraction{{Denom: "untrn", Fraction: "1"}, {Denom: "uatom", Fraction: "0"}}, nil), true,
+		},
+		{
+			"custom invalid params, invalid redistribution fraction denom",
+			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "0.5", 1000, 24*21*time.Hour, []string{}, []string{}, time.Hour, consumerId, false, 0, "1", 0, time.Hour,
+				[]ccvtypes.DenomRedistributionFraction{{Denom: "u", Fraction: "1"}}, nil), false,
+		},
+		{
+			"custom invalid params, duplicate redistribution fraction denom",
+			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "0.5", 1000, 24*21*time.Hour, []string{}, []string{}, time.Hour, consumerId, false, 0, "1", 0, time.Hour,
+				[]ccvtypes.DenomRedistributionFraction{{Denom: "untrn", Fraction: "1"}, {Denom: "untrn", Fraction: "0.5"}}, nil), false,
+		},
+		{
+			"custom invalid params, redistribution fraction greater than 1",
+			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "0.5", 1000, 24*21*time.Hour, []string{}, []string{}, time.Hour, consumerId, false, 0, "1", 0, time.Hour,
+				[]ccvtypes.DenomRedistributionFraction{{Denom: "untrn", Fraction: "1.5"}}, nil), false,
+		},
+		{
+			"custom valid params, reward splits",
+			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "0.5", 1000, 24*21*time.Hour, []string{}, []string{}, time.Hour, consumerId, false, 0, "1", 0, time.Hour, nil,
+				[]ccvtypes.RewardSplit{{Address: "cosmos19pe9pg5dv9k5fzgzmsrgnw9rl9asf7ddwhu7lm", Fraction: "0.5"}, {Address: "cosmos1dkas8mu4kyhl5jrh4nzvm65qz588hy9qcz08la", Fraction: "0.5"}}), true,
+		},
+		{
+			"custom invalid params, empty reward split address",
+			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "0.5", 1000, 24*21*time.Hour, []string{}, []string{}, time.Hour, consumerId, false, 0, "1", 0, time.Hour, nil,
+				[]ccvtypes.RewardSplit{{Address: " ", Fraction: "0.5"}}), false,
+		},
+		{
+			"custom invalid params, duplicate reward split address",
+			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "0.5", 1000, 24*21*time.Hour, []string{}, []string{}, time.Hour, consumerId, false, 0, "1", 0, time.Hour, nil,
+				[]ccvtypes.RewardSplit{{Address: "cosmos19pe9pg5dv9k5fzgzmsrgnw9rl9asf7ddwhu7lm", Fraction: "0.1"}, {Address: "cosmos19pe9pg5dv9k5fzgzmsrgnw9rl9asf7ddwhu7lm", Fraction: "0.1"}}), false,
+		},
+		{
+			"custom invalid params, reward split fractions greater than 1",
+			ccvtypes.NewParams(true, 5, "", "", 5, 1005, "0.5", 1000, 24*21*time.Hour, []string{}, []string{}, time.Hour, consumerId, false, 0, "1", 0, time.Hour, nil,
+				[]ccvtypes.RewardSplit{{Address: "cosmos19pe9pg5dv9k5fzgzmsrgnw9rl9asf7ddwhu7lm", Fraction: "0.6"}, {Address: "cosmos1dkas8mu4kyhl5jrh4nzvm65qz588hy9qcz08la", Fraction: "0.6"}}), false,
 		},
 	}
 
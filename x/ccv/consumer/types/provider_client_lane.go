@@ -0,0 +1,41 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+
+	ibctmtypes "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
+)
+
+// ProviderClientLaneTxPrefix prefixes the header of the provider chain that the proposer injects
+// as the first tx of a block to update the client to the provider when it is about to expire
+var ProviderClientLaneTxPrefix = []byte("ccv-provider-client-header:")
+
+// IsProviderClientLaneTx returns true if the tx bytes are a header of the provider chain injected by the proposer
+func IsProviderClientLaneTx(tx []byte) bool {
+	return bytes.HasPrefix(tx, ProviderClientLaneTxPrefix)
+}
+
+// EncodeProviderClientLaneTx returns the tx bytes that inject the header of the provider chain in a block
+func EncodeProviderClientLaneTx(header *ibctmtypes.Header) ([]byte, error) {
+	if header == nil {
+		return nil, fmt.Errorf("header cannot be nil")
+	}
+	bz, err := header.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	return append(append([]byte{}, ProviderClientLaneTxPrefix...), bz...), nil
+}
+
+// DecodeProviderClientLaneTx returns the header of the provider chain injected in a block by the tx bytes
+func DecodeProviderClientLaneTx(tx []byte) (*ibctmtypes.Header, error) {
+	if !IsProviderClientLaneTx(tx) {
+		return nil, fmt.Errorf("not a provider client lane tx")
+	}
+	header := &ibctmtypes.Header{}
+	if err := header.Unmarshal(tx[len(ProviderClientLaneTxPrefix):]); err != nil {
+		return nil, fmt.Errorf("cannot unmarshal provider header: %w", err)
+	}
+	return header, nil
+}
@@ -0,0 +1,24 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// Validate performs a stateless validation of the switch to a different provider chain
+func (ps ProviderSwitch) Validate() error {
+	if strings.TrimSpace(ps.ProviderId) == "" {
+		return errorsmod.Wrap(ErrInvalidProviderSwitch, "provider id cannot be empty")
+	}
+	if ps.SwitchHeight <= 0 {
+		return errorsmod.Wrapf(ErrInvalidProviderSwitch, "switch height must be positive: %d", ps.SwitchHeight)
+	}
+	if !ps.Genesis.Params.Enabled {
+		return errorsmod.Wrap(ErrInvalidProviderSwitch, "the consumer genesis state of the new provider chain must be enabled")
+	}
+	if err := ps.Genesis.Validate(); err != nil {
+		return errorsmod.Wrapf(ErrInvalidProviderSwitch, "invalid consumer genesis state of the new provider chain: %s", err.Error())
+	}
+	return nil
+}
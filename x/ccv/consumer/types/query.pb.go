@@ -6,23 +6,29 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	types1 "github.com/cometbft/cometbft/abci/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	types "github.com/cosmos/interchain-security/v7/x/ccv/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -472,6 +478,97 @@ func (m *QueryThrottleStateResponse) GetPacketDataQueue() []types.ConsumerPacket
 	return nil
 }
 
+type QuerySlashRetryDelayRequest struct {
+}
+
+func (m *QuerySlashRetryDelayRequest) Reset()         { *m = QuerySlashRetryDelayRequest{} }
+func (m *QuerySlashRetryDelayRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySlashRetryDelayRequest) ProtoMessage()    {}
+func (*QuerySlashRetryDelayRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f627751d3cc10225, []int{9}
+}
+func (m *QuerySlashRetryDelayRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySlashRetryDelayRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySlashRetryDelayRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySlashRetryDelayRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySlashRetryDelayRequest.Merge(m, src)
+}
+func (m *QuerySlashRetryDelayRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySlashRetryDelayRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySlashRetryDelayRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySlashRetryDelayRequest proto.InternalMessageInfo
+
+type QuerySlashRetryDelayResponse struct {
+	// the slash record; not set if there is no slash packet awaiting a reply or a retry
+	SlashRecord *SlashRecord `protobuf:"bytes,1,opt,name=slash_record,json=slashRecord,proto3" json:"slash_record,omitempty"`
+	// the remaining delay until the slash packet can be resent;
+	// zero if the slash packet can be resent or if there is no slash packet to resend
+	RemainingDelay time.Duration `protobuf:"bytes,2,opt,name=remaining_delay,json=remainingDelay,proto3,stdduration" json:"remaining_delay"`
+}
+
+func (m *QuerySlashRetryDelayResponse) Reset()         { *m = QuerySlashRetryDelayResponse{} }
+func (m *QuerySlashRetryDelayResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySlashRetryDelayResponse) ProtoMessage()    {}
+func (*QuerySlashRetryDelayResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f627751d3cc10225, []int{10}
+}
+func (m *QuerySlashRetryDelayResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySlashRetryDelayResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySlashRetryDelayResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySlashRetryDelayResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySlashRetryDelayResponse.Merge(m, src)
+}
+func (m *QuerySlashRetryDelayResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySlashRetryDelayResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySlashRetryDelayResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySlashRetryDelayResponse proto.InternalMessageInfo
+
+func (m *QuerySlashRetryDelayResponse) GetSlashRecord() *SlashRecord {
+	if m != nil {
+		return m.SlashRecord
+	}
+	return nil
+}
+
+func (m *QuerySlashRetryDelayResponse) GetRemainingDelay() time.Duration {
+	if m != nil {
+		return m.RemainingDelay
+	}
+	return 0
+}
+
 type ChainInfo struct {
 	ChainID      string `protobuf:"bytes,1,opt,name=chainID,proto3" json:"chainID,omitempty"`
 	ClientID     string `protobuf:"bytes,2,opt,name=clientID,proto3" json:"clientID,omitempty"`
@@ -483,7 +580,7 @@ func (m *ChainInfo) Reset()         { *m = ChainInfo{} }
 func (m *ChainInfo) String() string { return proto.CompactTextString(m) }
 func (*ChainInfo) ProtoMessage()    {}
 func (*ChainInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f627751d3cc10225, []int{9}
+	return fileDescriptor_f627751d3cc10225, []int{11}
 }
 func (m *ChainInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -540,813 +637,4406 @@ func (m *ChainInfo) GetChannelID() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*NextFeeDistributionEstimate)(nil), "interchain_security.ccv.consumer.v1.NextFeeDistributionEstimate")
-	proto.RegisterType((*QueryNextFeeDistributionEstimateRequest)(nil), "interchain_security.ccv.consumer.v1.QueryNextFeeDistributionEstimateRequest")
-	proto.RegisterType((*QueryNextFeeDistributionEstimateResponse)(nil), "interchain_security.ccv.consumer.v1.QueryNextFeeDistributionEstimateResponse")
-	proto.RegisterType((*QueryParamsRequest)(nil), "interchain_security.ccv.consumer.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "interchain_security.ccv.consumer.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryProviderInfoRequest)(nil), "interchain_security.ccv.consumer.v1.QueryProviderInfoRequest")
-	proto.RegisterType((*QueryProviderInfoResponse)(nil), "interchain_security.ccv.consumer.v1.QueryProviderInfoResponse")
-	proto.RegisterType((*QueryThrottleStateRequest)(nil), "interchain_security.ccv.consumer.v1.QueryThrottleStateRequest")
-	proto.RegisterType((*QueryThrottleStateResponse)(nil), "interchain_security.ccv.consumer.v1.QueryThrottleStateResponse")
-	proto.RegisterType((*ChainInfo)(nil), "interchain_security.ccv.consumer.v1.ChainInfo")
+type QueryNearTimeoutPacketsRequest struct {
+	// the packets that time out within this duration from the current block time are returned
+	Within time.Duration `protobuf:"bytes,1,opt,name=within,proto3,stdduration" json:"within"`
 }
 
-func init() {
-	proto.RegisterFile("interchain_security/ccv/consumer/v1/query.proto", fileDescriptor_f627751d3cc10225)
+func (m *QueryNearTimeoutPacketsRequest) Reset()         { *m = QueryNearTimeoutPacketsRequest{} }
+func (m *QueryNearTimeoutPacketsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNearTimeoutPacketsRequest) ProtoMessage()    {}
+func (*QueryNearTimeoutPacketsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f627751d3cc10225, []int{12}
 }
-
-var fileDescriptor_f627751d3cc10225 = []byte{
-	// 826 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4f, 0x6f, 0xe3, 0x44,
-	0x14, 0x8f, 0xd3, 0x36, 0xbb, 0x99, 0x2e, 0x42, 0x3b, 0x04, 0xc9, 0x78, 0x57, 0xa1, 0x32, 0x20,
-	0xc2, 0x4a, 0xb1, 0x93, 0xec, 0x21, 0xcb, 0x61, 0x59, 0xb4, 0x1b, 0xaa, 0x46, 0x02, 0xd4, 0xba,
-	0x95, 0x10, 0x5c, 0xcc, 0x74, 0x32, 0x4d, 0x2c, 0x12, 0x8f, 0x3b, 0x33, 0x36, 0xed, 0x0d, 0xc1,
-	0x1d, 0x21, 0xf1, 0x4d, 0xf8, 0x02, 0x5c, 0x2b, 0x71, 0xa0, 0x12, 0x07, 0xe0, 0x82, 0x50, 0xcb,
-	0x87, 0xe0, 0x88, 0x66, 0x3c, 0x4e, 0x9d, 0x36, 0x4d, 0xdc, 0xb2, 0x37, 0xcf, 0xfb, 0xf3, 0x7b,
-	0xbf, 0xdf, 0x9b, 0x79, 0x2f, 0x01, 0x6e, 0x10, 0x0a, 0xc2, 0xf0, 0x08, 0x05, 0xa1, 0xcf, 0x09,
-	0x8e, 0x59, 0x20, 0x8e, 0x5d, 0x8c, 0x13, 0x17, 0xd3, 0x90, 0xc7, 0x13, 0xc2, 0xdc, 0xa4, 0xed,
-	0x1e, 0xc6, 0x84, 0x1d, 0x3b, 0x11, 0xa3, 0x82, 0xc2, 0xb7, 0xe6, 0x24, 0x38, 0x18, 0x27, 0x4e,
-	0x96, 0xe0, 0x24, 0x6d, 0xab, 0x75, 0x1d, 0x6a, 0xd2, 0x76, 0xf9, 0x08, 0x31, 0x32, 0xf0, 0xa7,
-	0xe1, 0x0a, 0xd6, 0xaa, 0x0d, 0xe9, 0x90, 0xaa, 0x4f, 0x57, 0x7e, 0x69, 0xeb, 0xc3, 0x21, 0xa5,
-	0xc3, 0x31, 0x71, 0x51, 0x14, 0xb8, 0x28, 0x0c, 0xa9, 0x40, 0x22, 0xa0, 0x21, 0xd7, 0xde, 0x4e,
-	0x11, 0xee, 0x97, 0xea, 0xbc, 0xb3, 0x80, 0xd9, 0xd7, 0x01, 0x23, 0x69, 0x98, 0xfd, 0x7d, 0x19,
-	0x3c, 0xf8, 0x94, 0x1c, 0x89, 0x4d, 0x42, 0x7a, 0x01, 0x17, 0x2c, 0xd8, 0x8f, 0x65, 0xe5, 0x8f,
-	0xb8, 0x08, 0x26, 0x48, 0x10, 0xf8, 0x36, 0x78, 0x05, 0xc7, 0x8c, 0x91, 0x50, 0x6c, 0x91, 0x60,
-	0x38, 0x12, 0xa6, 0xb1, 0x61, 0x34, 0x56, 0xbc, 0x59, 0x23, 0xac, 0x03, 0x30, 0x46, 0x3c, 0x0b,
-	0x29, 0xab, 0x90, 0x9c, 0x45, 0xfa, 0x43, 0x72, 0x94, 0xf9, 0x57, 0x52, 0xff, 0x85, 0x05, 0x3e,
-	0x06, 0xaf, 0x0f, 0x72, 0xd5, 0xfd, 0x03, 0x86, 0xb0, 0xfc, 0x30, 0x57, 0x37, 0x8c, 0x46, 0xd5,
-	0xab, 0xe5, 0x9d, 0x9b, 0xda, 0x07, 0x6b, 0x60, 0x4d, 0x50, 0x81, 0xc6, 0xe6, 0x9a, 0x0a, 0x4a,
-	0x0f, 0xb2, 0x94, 0xa0, 0xdb, 0x8c, 0x26, 0xc1, 0x80, 0x30, 0xb3, 0xa2, 0x5c, 0x39, 0x4b, 0xea,
-	0x7f, 0xa1, 0x7b, 0x65, 0xde, 0xc9, 0xfc, 0x99, 0xc5, 0x7e, 0x0f, 0xbc, 0xbb, 0x23, 0x5f, 0xc1,
-	0x82, 0xa6, 0x78, 0xe4, 0x30, 0x26, 0x5c, 0xd8, 0xdf, 0x18, 0xa0, 0xb1, 0x3c, 0x96, 0x47, 0x34,
-	0xe4, 0x04, 0xee, 0x81, 0xd5, 0x01, 0x12, 0x48, 0xf5, 0x6f, 0xbd, 0xf3, 0xa1, 0x53, 0xe0, 0x75,
-	0x39, 0x8b, 0x70, 0x15, 0x9a, 0x5d, 0x03, 0x50, 0x31, 0xd8, 0x46, 0x0c, 0x4d, 0x78, 0x46, 0xcc,
-	0x07, 0xaf, 0xcd, 0x58, 0x35, 0x85, 0x2d, 0x50, 0x89, 0x94, 0x45, 0x93, 0x78, 0x74, 0x2d, 0x89,
-	0xa4, 0xed, 0x64, 0x0d, 0x49, 0x31, 0x9e, 0xaf, 0x9e, 0xfc, 0xf5, 0x66, 0xc9, 0xd3, 0xf9, 0xb6,
-	0x05, 0xcc, 0xb4, 0x80, 0xee, 0x6a, 0x3f, 0x3c, 0xa0, 0x59, 0xf1, 0x9f, 0x0d, 0xf0, 0xc6, 0x1c,
-	0xa7, 0xe6, 0xb0, 0x0d, 0xee, 0x66, 0x0a, 0x35, 0x0b, 0xa7, 0x50, 0x2b, 0x5e, 0x48, 0xb7, 0x44,
-	0xd2, 0x4c, 0xa6, 0x28, 0x12, 0x31, 0xca, 0xae, 0xbb, 0xfc, 0x7f, 0x10, 0x33, 0x14, 0xfb, 0x81,
-	0x16, 0xb0, 0x37, 0x62, 0x54, 0x88, 0x31, 0xd9, 0x15, 0xb9, 0x4b, 0xff, 0xd3, 0x00, 0xd6, 0x3c,
-	0xaf, 0xd6, 0xf7, 0x39, 0xb8, 0xc7, 0xc7, 0x88, 0x8f, 0x7c, 0x46, 0x30, 0x65, 0x03, 0xad, 0xb1,
-	0x55, 0x88, 0xd1, 0xae, 0x4c, 0xf4, 0x54, 0x9e, 0xe2, 0x64, 0x78, 0xeb, 0xfc, 0xc2, 0x04, 0xbf,
-	0x04, 0xf7, 0x23, 0x84, 0xbf, 0x22, 0xc2, 0x97, 0x57, 0xef, 0x1f, 0xc6, 0x24, 0x26, 0x66, 0x79,
-	0x63, 0x65, 0xa1, 0xe2, 0x99, 0x9b, 0x94, 0xc9, 0x3d, 0x24, 0x90, 0x56, 0xfc, 0x6a, 0x34, 0xb5,
-	0xec, 0x48, 0x30, 0xfb, 0x3b, 0x03, 0x54, 0xa7, 0x6d, 0x81, 0x26, 0xb8, 0xa3, 0x00, 0xfb, 0x3d,
-	0xa5, 0xa2, 0xea, 0x65, 0x47, 0x68, 0x81, 0xbb, 0x78, 0x1c, 0x90, 0x50, 0xf4, 0x7b, 0xaa, 0xe5,
-	0x55, 0x6f, 0x7a, 0x86, 0x36, 0xb8, 0x87, 0x69, 0x18, 0x12, 0x35, 0xa3, 0xfd, 0x9e, 0x1a, 0xf6,
-	0xaa, 0x37, 0x63, 0x83, 0x0f, 0x41, 0x15, 0x8f, 0x50, 0x18, 0x92, 0x71, 0xbf, 0xa7, 0x47, 0xfc,
-	0xc2, 0xd0, 0xf9, 0xbd, 0x02, 0xd6, 0x54, 0x87, 0xe1, 0xbf, 0x86, 0x7e, 0x67, 0x73, 0x06, 0x01,
-	0x7e, 0x5c, 0xa8, 0xa7, 0x05, 0x67, 0xd9, 0xfa, 0xe4, 0x25, 0xa1, 0xa5, 0xcf, 0xc0, 0x7e, 0xf6,
-	0xed, 0x6f, 0xff, 0xfc, 0x58, 0x7e, 0x1f, 0x76, 0x97, 0xff, 0xec, 0xc8, 0x35, 0xd8, 0x3c, 0x20,
-	0xa4, 0x99, 0x5f, 0x72, 0xf0, 0x27, 0x03, 0xac, 0xe7, 0x66, 0x18, 0x76, 0x8b, 0xf3, 0x9b, 0xd9,
-	0x05, 0xd6, 0x93, 0x9b, 0x27, 0x6a, 0x0d, 0x2d, 0xa5, 0xe1, 0x11, 0x6c, 0x2c, 0xd7, 0x90, 0xae,
-	0x05, 0xf8, 0x8b, 0x01, 0xee, 0x5f, 0x19, 0x7d, 0xf8, 0xf4, 0x06, 0x0c, 0xae, 0xee, 0x13, 0xeb,
-	0x83, 0xdb, 0xa6, 0x6b, 0x19, 0x5d, 0x25, 0xa3, 0x0d, 0xdd, 0x02, 0x32, 0x74, 0x7e, 0x33, 0x90,
-	0xbc, 0x7f, 0x35, 0xf4, 0x72, 0x9d, 0x99, 0x74, 0x78, 0x03, 0x3e, 0xf3, 0x16, 0x88, 0xf5, 0xec,
-	0xd6, 0xf9, 0x5a, 0xd0, 0x13, 0x25, 0xa8, 0x03, 0x5b, 0xcb, 0x05, 0x09, 0x0d, 0xe0, 0x73, 0x89,
-	0xf0, 0xfc, 0xb3, 0x93, 0xb3, 0xba, 0x71, 0x7a, 0x56, 0x37, 0xfe, 0x3e, 0xab, 0x1b, 0x3f, 0x9c,
-	0xd7, 0x4b, 0xa7, 0xe7, 0xf5, 0xd2, 0x1f, 0xe7, 0xf5, 0xd2, 0x17, 0x4f, 0x87, 0x81, 0x18, 0xc5,
-	0xfb, 0x0e, 0xa6, 0x13, 0x17, 0x53, 0x3e, 0xa1, 0x3c, 0x07, 0xde, 0x9c, 0x82, 0x27, 0x5d, 0xf7,
-	0xe8, 0x52, 0x85, 0xe3, 0x88, 0xf0, 0xfd, 0x8a, 0xfa, 0x33, 0xf1, 0xf8, 0xbf, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x2e, 0x98, 0xd9, 0x58, 0x65, 0x09, 0x00, 0x00,
+func (m *QueryNearTimeoutPacketsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNearTimeoutPacketsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNearTimeoutPacketsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNearTimeoutPacketsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNearTimeoutPacketsRequest.Merge(m, src)
+}
+func (m *QueryNearTimeoutPacketsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNearTimeoutPacketsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNearTimeoutPacketsRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryNearTimeoutPacketsRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// ConsumerGenesis queries the genesis state needed to start a consumer chain
-	// whose proposal has been accepted
-	QueryNextFeeDistribution(ctx context.Context, in *QueryNextFeeDistributionEstimateRequest, opts ...grpc.CallOption) (*QueryNextFeeDistributionEstimateResponse, error)
-	// QueryParams queries the ccv/consumer module parameters.
-	QueryParams(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	QueryProviderInfo(ctx context.Context, in *QueryProviderInfoRequest, opts ...grpc.CallOption) (*QueryProviderInfoResponse, error)
-	// QueryThrottleState returns on-chain state relevant to throttled consumer packets
-	QueryThrottleState(ctx context.Context, in *QueryThrottleStateRequest, opts ...grpc.CallOption) (*QueryThrottleStateResponse, error)
+func (m *QueryNearTimeoutPacketsRequest) GetWithin() time.Duration {
+	if m != nil {
+		return m.Within
+	}
+	return 0
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+type QueryNearTimeoutPacketsResponse struct {
+	Packets []PacketTimeout `protobuf:"bytes,1,rep,name=packets,proto3" json:"packets"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryNearTimeoutPacketsResponse) Reset()         { *m = QueryNearTimeoutPacketsResponse{} }
+func (m *QueryNearTimeoutPacketsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNearTimeoutPacketsResponse) ProtoMessage()    {}
+func (*QueryNearTimeoutPacketsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f627751d3cc10225, []int{13}
+}
+func (m *QueryNearTimeoutPacketsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNearTimeoutPacketsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNearTimeoutPacketsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNearTimeoutPacketsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNearTimeoutPacketsResponse.Merge(m, src)
+}
+func (m *QueryNearTimeoutPacketsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNearTimeoutPacketsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNearTimeoutPacketsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) QueryNextFeeDistribution(ctx context.Context, in *QueryNextFeeDistributionEstimateRequest, opts ...grpc.CallOption) (*QueryNextFeeDistributionEstimateResponse, error) {
-	out := new(QueryNextFeeDistributionEstimateResponse)
-	err := c.cc.Invoke(ctx, "/interchain_security.ccv.consumer.v1.Query/QueryNextFeeDistribution", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryNearTimeoutPacketsResponse proto.InternalMessageInfo
+
+func (m *QueryNearTimeoutPacketsResponse) GetPackets() []PacketTimeout {
+	if m != nil {
+		return m.Packets
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) QueryParams(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/interchain_security.ccv.consumer.v1.Query/QueryParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+// PacketTimeout describes a packet sent to the provider chain that is not yet acknowledged
+type PacketTimeout struct {
+	ChannelId        string    `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Sequence         uint64    `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	TimeoutTimestamp time.Time `protobuf:"bytes,3,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3,stdtime" json:"timeout_timestamp"`
+}
+
+func (m *PacketTimeout) Reset()         { *m = PacketTimeout{} }
+func (m *PacketTimeout) String() string { return proto.CompactTextString(m) }
+func (*PacketTimeout) ProtoMessage()    {}
+func (*PacketTimeout) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f627751d3cc10225, []int{14}
+}
+func (m *PacketTimeout) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PacketTimeout) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PacketTimeout.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *PacketTimeout) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PacketTimeout.Merge(m, src)
+}
+func (m *PacketTimeout) XXX_Size() int {
+	return m.Size()
+}
+func (m *PacketTimeout) XXX_DiscardUnknown() {
+	xxx_messageInfo_PacketTimeout.DiscardUnknown(m)
 }
 
-func (c *queryClient) QueryProviderInfo(ctx context.Context, in *QueryProviderInfoRequest, opts ...grpc.CallOption) (*QueryProviderInfoResponse, error) {
-	out := new(QueryProviderInfoResponse)
-	err := c.cc.Invoke(ctx, "/interchain_security.ccv.consumer.v1.Query/QueryProviderInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_PacketTimeout proto.InternalMessageInfo
+
+func (m *PacketTimeout) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) QueryThrottleState(ctx context.Context, in *QueryThrottleStateRequest, opts ...grpc.CallOption) (*QueryThrottleStateResponse, error) {
-	out := new(QueryThrottleStateResponse)
-	err := c.cc.Invoke(ctx, "/interchain_security.ccv.consumer.v1.Query/QueryThrottleState", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *PacketTimeout) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
 	}
-	return out, nil
+	return 0
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// ConsumerGenesis queries the genesis state needed to start a consumer chain
-	// whose proposal has been accepted
-	QueryNextFeeDistribution(context.Context, *QueryNextFeeDistributionEstimateRequest) (*QueryNextFeeDistributionEstimateResponse, error)
-	// QueryParams queries the ccv/consumer module parameters.
-	QueryParams(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	QueryProviderInfo(context.Context, *QueryProviderInfoRequest) (*QueryProviderInfoResponse, error)
-	// QueryThrottleState returns on-chain state relevant to throttled consumer packets
-	QueryThrottleState(context.Context, *QueryThrottleStateRequest) (*QueryThrottleStateResponse, error)
+func (m *PacketTimeout) GetTimeoutTimestamp() time.Time {
+	if m != nil {
+		return m.TimeoutTimestamp
+	}
+	return time.Time{}
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+type QueryProviderClientExpiryRequest struct {
 }
 
-func (*UnimplementedQueryServer) QueryNextFeeDistribution(ctx context.Context, req *QueryNextFeeDistributionEstimateRequest) (*QueryNextFeeDistributionEstimateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryNextFeeDistribution not implemented")
+func (m *QueryProviderClientExpiryRequest) Reset()         { *m = QueryProviderClientExpiryRequest{} }
+func (m *QueryProviderClientExpiryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProviderClientExpiryRequest) ProtoMessage()    {}
+func (*QueryProviderClientExpiryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f627751d3cc10225, []int{15}
 }
-func (*UnimplementedQueryServer) QueryParams(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryParams not implemented")
+func (m *QueryProviderClientExpiryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) QueryProviderInfo(ctx context.Context, req *QueryProviderInfoRequest) (*QueryProviderInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryProviderInfo not implemented")
+func (m *QueryProviderClientExpiryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProviderClientExpiryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) QueryThrottleState(ctx context.Context, req *QueryThrottleStateRequest) (*QueryThrottleStateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryThrottleState not implemented")
+func (m *QueryProviderClientExpiryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProviderClientExpiryRequest.Merge(m, src)
+}
+func (m *QueryProviderClientExpiryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProviderClientExpiryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProviderClientExpiryRequest.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+var xxx_messageInfo_QueryProviderClientExpiryRequest proto.InternalMessageInfo
+
+type QueryProviderClientExpiryResponse struct {
+	ClientId string `protobuf:"bytes,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// the time at which the client expires if it is not updated, i.e.,
+	// the end of the trusting period of its latest consensus state
+	ExpiryTime time.Time `protobuf:"bytes,2,opt,name=expiry_time,json=expiryTime,proto3,stdtime" json:"expiry_time"`
+	// the duration from the current block time until the client expires;
+	// negative if the client is expired
+	TimeUntilExpiry time.Duration `protobuf:"bytes,3,opt,name=time_until_expiry,json=timeUntilExpiry,proto3,stdduration" json:"time_until_expiry"`
 }
 
-func _Query_QueryNextFeeDistribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryNextFeeDistributionEstimateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryNextFeeDistribution(ctx, in)
+func (m *QueryProviderClientExpiryResponse) Reset()         { *m = QueryProviderClientExpiryResponse{} }
+func (m *QueryProviderClientExpiryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProviderClientExpiryResponse) ProtoMessage()    {}
+func (*QueryProviderClientExpiryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f627751d3cc10225, []int{16}
+}
+func (m *QueryProviderClientExpiryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProviderClientExpiryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProviderClientExpiryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/interchain_security.ccv.consumer.v1.Query/QueryNextFeeDistribution",
+}
+func (m *QueryProviderClientExpiryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProviderClientExpiryResponse.Merge(m, src)
+}
+func (m *QueryProviderClientExpiryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProviderClientExpiryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProviderClientExpiryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProviderClientExpiryResponse proto.InternalMessageInfo
+
+func (m *QueryProviderClientExpiryResponse) GetClientId() string {
+	if m != nil {
+		return m.ClientId
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryNextFeeDistribution(ctx, req.(*QueryNextFeeDistributionEstimateRequest))
+	return ""
+}
+
+func (m *QueryProviderClientExpiryResponse) GetExpiryTime() time.Time {
+	if m != nil {
+		return m.ExpiryTime
 	}
-	return interceptor(ctx, in, info, handler)
+	return time.Time{}
 }
 
-func _Query_QueryParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *QueryProviderClientExpiryResponse) GetTimeUntilExpiry() time.Duration {
+	if m != nil {
+		return m.TimeUntilExpiry
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/interchain_security.ccv.consumer.v1.Query/QueryParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryParams(ctx, req.(*QueryParamsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_QueryProviderInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryProviderInfoRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryProviderInfo(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/interchain_security.ccv.consumer.v1.Query/QueryProviderInfo",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryProviderInfo(ctx, req.(*QueryProviderInfoRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryProviderSwitchRequest struct {
 }
 
-func _Query_QueryThrottleState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryThrottleStateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryThrottleState(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/interchain_security.ccv.consumer.v1.Query/QueryThrottleState",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryThrottleState(ctx, req.(*QueryThrottleStateRequest))
+func (m *QueryProviderSwitchRequest) Reset()         { *m = QueryProviderSwitchRequest{} }
+func (m *QueryProviderSwitchRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProviderSwitchRequest) ProtoMessage()    {}
+func (*QueryProviderSwitchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f627751d3cc10225, []int{17}
+}
+func (m *QueryProviderSwitchRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProviderSwitchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProviderSwitchRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryProviderSwitchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProviderSwitchRequest.Merge(m, src)
+}
+func (m *QueryProviderSwitchRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProviderSwitchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProviderSwitchRequest.DiscardUnknown(m)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "interchain_security.ccv.consumer.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "QueryNextFeeDistribution",
-			Handler:    _Query_QueryNextFeeDistribution_Handler,
-		},
-		{
-			MethodName: "QueryParams",
-			Handler:    _Query_QueryParams_Handler,
-		},
-		{
-			MethodName: "QueryProviderInfo",
-			Handler:    _Query_QueryProviderInfo_Handler,
-		},
-		{
-			MethodName: "QueryThrottleState",
-			Handler:    _Query_QueryThrottleState_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "interchain_security/ccv/consumer/v1/query.proto",
+var xxx_messageInfo_QueryProviderSwitchRequest proto.InternalMessageInfo
+
+type QueryProviderSwitchResponse struct {
+	// the identifier of the provider chain that secures the consumer chain
+	ActiveProviderId string `protobuf:"bytes,1,opt,name=active_provider_id,json=activeProviderId,proto3" json:"active_provider_id,omitempty"`
+	// the identifier of the provider chain that secured the consumer chain
+	// before the last switch, empty if the consumer chain never switched
+	PreviousProviderId string `protobuf:"bytes,2,opt,name=previous_provider_id,json=previousProviderId,proto3" json:"previous_provider_id,omitempty"`
+	// the pending switch to a different provider chain, nil if none is scheduled
+	PendingSwitch *ProviderSwitch `protobuf:"bytes,3,opt,name=pending_switch,json=pendingSwitch,proto3" json:"pending_switch,omitempty"`
 }
 
-func (m *NextFeeDistributionEstimate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryProviderSwitchResponse) Reset()         { *m = QueryProviderSwitchResponse{} }
+func (m *QueryProviderSwitchResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProviderSwitchResponse) ProtoMessage()    {}
+func (*QueryProviderSwitchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f627751d3cc10225, []int{18}
+}
+func (m *QueryProviderSwitchResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProviderSwitchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProviderSwitchResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *NextFeeDistributionEstimate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryProviderSwitchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProviderSwitchResponse.Merge(m, src)
+}
+func (m *QueryProviderSwitchResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProviderSwitchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProviderSwitchResponse.DiscardUnknown(m)
 }
 
-func (m *NextFeeDistributionEstimate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ToConsumer) > 0 {
-		i -= len(m.ToConsumer)
-		copy(dAtA[i:], m.ToConsumer)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ToConsumer)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.ToProvider) > 0 {
-		i -= len(m.ToProvider)
-		copy(dAtA[i:], m.ToProvider)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ToProvider)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.Total) > 0 {
-		i -= len(m.Total)
-		copy(dAtA[i:], m.Total)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Total)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.DistributionFraction) > 0 {
-		i -= len(m.DistributionFraction)
-		copy(dAtA[i:], m.DistributionFraction)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DistributionFraction)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.NextHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.NextHeight))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.LastHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.LastHeight))
-		i--
-		dAtA[i] = 0x10
+var xxx_messageInfo_QueryProviderSwitchResponse proto.InternalMessageInfo
+
+func (m *QueryProviderSwitchResponse) GetActiveProviderId() string {
+	if m != nil {
+		return m.ActiveProviderId
 	}
-	if m.CurrentHeight != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentHeight))
-		i--
-		dAtA[i] = 0x8
+	return ""
+}
+
+func (m *QueryProviderSwitchResponse) GetPreviousProviderId() string {
+	if m != nil {
+		return m.PreviousProviderId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryNextFeeDistributionEstimateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryProviderSwitchResponse) GetPendingSwitch() *ProviderSwitch {
+	if m != nil {
+		return m.PendingSwitch
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryNextFeeDistributionEstimateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryPendingPacketRequest struct {
+	// the position of the packet in the pending packets queue, starting from zero for the next packet to be sent
+	Index uint64 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
 }
 
-func (m *QueryNextFeeDistributionEstimateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryPendingPacketRequest) Reset()         { *m = QueryPendingPacketRequest{} }
+func (m *QueryPendingPacketRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingPacketRequest) ProtoMessage()    {}
+func (*QueryPendingPacketRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f627751d3cc10225, []int{19}
+}
+func (m *QueryPendingPacketRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingPacketRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingPacketRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingPacketRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingPacketRequest.Merge(m, src)
+}
+func (m *QueryPendingPacketRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingPacketRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingPacketRequest.DiscardUnknown(m)
 }
 
-func (m *QueryNextFeeDistributionEstimateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPendingPacketRequest proto.InternalMessageInfo
+
+func (m *QueryPendingPacketRequest) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryNextFeeDistributionEstimateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryPendingPacketResponse struct {
+	// the number of packets in the pending packets queue
+	NumPendingPackets uint64 `protobuf:"varint,1,opt,name=num_pending_packets,json=numPendingPackets,proto3" json:"num_pending_packets,omitempty"`
+	// the pending packet
+	Packet types.ConsumerPacketData `protobuf:"bytes,2,opt,name=packet,proto3" json:"packet"`
+	// the consensus address of the validator of a slash packet; empty for other packets
+	ValidatorConsAddress string `protobuf:"bytes,3,opt,name=validator_cons_address,json=validatorConsAddress,proto3" json:"validator_cons_address,omitempty"`
+	// the moniker of the validator of a slash packet, if the validator is known
+	// to the staking module of the consumer chain; empty otherwise
+	ValidatorMoniker string `protobuf:"bytes,4,opt,name=validator_moniker,json=validatorMoniker,proto3" json:"validator_moniker,omitempty"`
 }
 
-func (m *QueryNextFeeDistributionEstimateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Data != nil {
-		{
-			size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryPendingPacketResponse) Reset()         { *m = QueryPendingPacketResponse{} }
+func (m *QueryPendingPacketResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingPacketResponse) ProtoMessage()    {}
+func (*QueryPendingPacketResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f627751d3cc10225, []int{20}
+}
+func (m *QueryPendingPacketResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingPacketResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingPacketResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryPendingPacketResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingPacketResponse.Merge(m, src)
+}
+func (m *QueryPendingPacketResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingPacketResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingPacketResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPendingPacketResponse proto.InternalMessageInfo
+
+func (m *QueryPendingPacketResponse) GetNumPendingPackets() uint64 {
+	if m != nil {
+		return m.NumPendingPackets
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryPendingPacketResponse) GetPacket() types.ConsumerPacketData {
+	if m != nil {
+		return m.Packet
+	}
+	return types.ConsumerPacketData{}
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryPendingPacketResponse) GetValidatorConsAddress() string {
+	if m != nil {
+		return m.ValidatorConsAddress
+	}
+	return ""
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryPendingPacketResponse) GetValidatorMoniker() string {
+	if m != nil {
+		return m.ValidatorMoniker
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryModuleVersionInfoRequest struct {
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryModuleVersionInfoRequest) Reset()         { *m = QueryModuleVersionInfoRequest{} }
+func (m *QueryModuleVersionInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryModuleVersionInfoRequest) ProtoMessage()    {}
+func (*QueryModuleVersionInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f627751d3cc10225, []int{21}
+}
+func (m *QueryModuleVersionInfoRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryModuleVersionInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryModuleVersionInfoRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryProviderInfoRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryModuleVersionInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryModuleVersionInfoRequest.Merge(m, src)
+}
+func (m *QueryModuleVersionInfoRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryModuleVersionInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryModuleVersionInfoRequest.DiscardUnknown(m)
 }
 
-func (m *QueryProviderInfoRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryModuleVersionInfoRequest proto.InternalMessageInfo
+
+type QueryModuleVersionInfoResponse struct {
+	VersionInfo types.ModuleVersionInfo `protobuf:"bytes,1,opt,name=version_info,json=versionInfo,proto3" json:"version_info"`
 }
 
-func (m *QueryProviderInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryModuleVersionInfoResponse) Reset()         { *m = QueryModuleVersionInfoResponse{} }
+func (m *QueryModuleVersionInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryModuleVersionInfoResponse) ProtoMessage()    {}
+func (*QueryModuleVersionInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f627751d3cc10225, []int{22}
+}
+func (m *QueryModuleVersionInfoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryModuleVersionInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryModuleVersionInfoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryModuleVersionInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryModuleVersionInfoResponse.Merge(m, src)
+}
+func (m *QueryModuleVersionInfoResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryModuleVersionInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryModuleVersionInfoResponse.DiscardUnknown(m)
 }
 
-func (m *QueryProviderInfoResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryModuleVersionInfoResponse proto.InternalMessageInfo
+
+func (m *QueryModuleVersionInfoResponse) GetVersionInfo() types.ModuleVersionInfo {
+	if m != nil {
+		return m.VersionInfo
 	}
-	return dAtA[:n], nil
+	return types.ModuleVersionInfo{}
 }
 
-func (m *QueryProviderInfoResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryChangeoverRehearsalRequest struct {
+	// the initial valset of the consumer chain, i.e., the initial_val_set of the provider info
+	// in the consumer genesis state obtained from the provider chain
+	InitialValSet []types1.ValidatorUpdate `protobuf:"bytes,1,rep,name=initial_val_set,json=initialValSet,proto3" json:"initial_val_set"`
 }
 
-func (m *QueryProviderInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Provider.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryChangeoverRehearsalRequest) Reset()         { *m = QueryChangeoverRehearsalRequest{} }
+func (m *QueryChangeoverRehearsalRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryChangeoverRehearsalRequest) ProtoMessage()    {}
+func (*QueryChangeoverRehearsalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f627751d3cc10225, []int{23}
+}
+func (m *QueryChangeoverRehearsalRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChangeoverRehearsalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChangeoverRehearsalRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.Consumer.MarshalToSizedBuffer(dAtA[:i])
+}
+func (m *QueryChangeoverRehearsalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChangeoverRehearsalRequest.Merge(m, src)
+}
+func (m *QueryChangeoverRehearsalRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChangeoverRehearsalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChangeoverRehearsalRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryChangeoverRehearsalRequest proto.InternalMessageInfo
+
+func (m *QueryChangeoverRehearsalRequest) GetInitialValSet() []types1.ValidatorUpdate {
+	if m != nil {
+		return m.InitialValSet
+	}
+	return nil
+}
+
+type QueryChangeoverRehearsalResponse struct {
+	Rehearsal ChangeoverRehearsal `protobuf:"bytes,1,opt,name=rehearsal,proto3" json:"rehearsal"`
+}
+
+func (m *QueryChangeoverRehearsalResponse) Reset()         { *m = QueryChangeoverRehearsalResponse{} }
+func (m *QueryChangeoverRehearsalResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryChangeoverRehearsalResponse) ProtoMessage()    {}
+func (*QueryChangeoverRehearsalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f627751d3cc10225, []int{24}
+}
+func (m *QueryChangeoverRehearsalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChangeoverRehearsalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChangeoverRehearsalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryChangeoverRehearsalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChangeoverRehearsalResponse.Merge(m, src)
+}
+func (m *QueryChangeoverRehearsalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChangeoverRehearsalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChangeoverRehearsalResponse.DiscardUnknown(m)
 }
 
-func (m *QueryThrottleStateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryChangeoverRehearsalResponse proto.InternalMessageInfo
+
+func (m *QueryChangeoverRehearsalResponse) GetRehearsal() ChangeoverRehearsal {
+	if m != nil {
+		return m.Rehearsal
 	}
-	return dAtA[:n], nil
+	return ChangeoverRehearsal{}
 }
 
-func (m *QueryThrottleStateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QuerySlashRecordRequest struct {
 }
 
-func (m *QueryThrottleStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QuerySlashRecordRequest) Reset()         { *m = QuerySlashRecordRequest{} }
+func (m *QuerySlashRecordRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySlashRecordRequest) ProtoMessage()    {}
+func (*QuerySlashRecordRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f627751d3cc10225, []int{25}
 }
-
-func (m *QueryThrottleStateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuerySlashRecordRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySlashRecordRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySlashRecordRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QuerySlashRecordRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySlashRecordRequest.Merge(m, src)
+}
+func (m *QuerySlashRecordRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySlashRecordRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySlashRecordRequest.DiscardUnknown(m)
 }
 
-func (m *QueryThrottleStateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QuerySlashRecordRequest proto.InternalMessageInfo
+
+type QuerySlashRecordResponse struct {
+	// whether a slash packet was sent and is awaiting a reply or a retry
+	Found bool `protobuf:"varint,1,opt,name=found,proto3" json:"found,omitempty"`
+	// whether the slash packet is in flight, i.e., awaiting a reply from the provider
+	InFlight bool `protobuf:"varint,2,opt,name=in_flight,json=inFlight,proto3" json:"in_flight,omitempty"`
+	// the time the slash packet was last sent
+	SendTime time.Time `protobuf:"bytes,3,opt,name=send_time,json=sendTime,proto3,stdtime" json:"send_time"`
+	// the number of times the slash packet was bounced by the provider
+	Bounces uint32 `protobuf:"varint,4,opt,name=bounces,proto3" json:"bounces,omitempty"`
+	// the time from which the bounced slash packet can be resent; not set if the slash packet is in flight
+	NextRetryTime *time.Time `protobuf:"bytes,5,opt,name=next_retry_time,json=nextRetryTime,proto3,stdtime" json:"next_retry_time,omitempty"`
+	// the slash packet at the head of the pending packets queue
+	SlashPacket *types.SlashPacketData `protobuf:"bytes,6,opt,name=slash_packet,json=slashPacket,proto3" json:"slash_packet,omitempty"`
+	// the consensus address of the validator of the slash packet
+	ValidatorConsAddress string `protobuf:"bytes,7,opt,name=validator_cons_address,json=validatorConsAddress,proto3" json:"validator_cons_address,omitempty"`
 }
 
-func (m *QueryThrottleStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PacketDataQueue) > 0 {
-		for iNdEx := len(m.PacketDataQueue) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PacketDataQueue[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+func (m *QuerySlashRecordResponse) Reset()         { *m = QuerySlashRecordResponse{} }
+func (m *QuerySlashRecordResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySlashRecordResponse) ProtoMessage()    {}
+func (*QuerySlashRecordResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f627751d3cc10225, []int{26}
+}
+func (m *QuerySlashRecordResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySlashRecordResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySlashRecordResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.SlashRecord != nil {
-		{
-			size, err := m.SlashRecord.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+}
+func (m *QuerySlashRecordResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySlashRecordResponse.Merge(m, src)
+}
+func (m *QuerySlashRecordResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySlashRecordResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySlashRecordResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySlashRecordResponse proto.InternalMessageInfo
+
+func (m *QuerySlashRecordResponse) GetFound() bool {
+	if m != nil {
+		return m.Found
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *ChainInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuerySlashRecordResponse) GetInFlight() bool {
+	if m != nil {
+		return m.InFlight
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *ChainInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySlashRecordResponse) GetSendTime() time.Time {
+	if m != nil {
+		return m.SendTime
+	}
+	return time.Time{}
 }
 
-func (m *ChainInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ChannelID) > 0 {
-		i -= len(m.ChannelID)
-		copy(dAtA[i:], m.ChannelID)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelID)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.ConnectionID) > 0 {
-		i -= len(m.ConnectionID)
-		copy(dAtA[i:], m.ConnectionID)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConnectionID)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ClientID) > 0 {
-		i -= len(m.ClientID)
-		copy(dAtA[i:], m.ClientID)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientID)))
-		i--
-		dAtA[i] = 0x12
+func (m *QuerySlashRecordResponse) GetBounces() uint32 {
+	if m != nil {
+		return m.Bounces
 	}
-	if len(m.ChainID) > 0 {
-		i -= len(m.ChainID)
-		copy(dAtA[i:], m.ChainID)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainID)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *QuerySlashRecordResponse) GetNextRetryTime() *time.Time {
+	if m != nil {
+		return m.NextRetryTime
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QuerySlashRecordResponse) GetSlashPacket() *types.SlashPacketData {
+	if m != nil {
+		return m.SlashPacket
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *NextFeeDistributionEstimate) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *QuerySlashRecordResponse) GetValidatorConsAddress() string {
+	if m != nil {
+		return m.ValidatorConsAddress
 	}
-	var l int
-	_ = l
-	if m.CurrentHeight != 0 {
-		n += 1 + sovQuery(uint64(m.CurrentHeight))
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*NextFeeDistributionEstimate)(nil), "interchain_security.ccv.consumer.v1.NextFeeDistributionEstimate")
+	proto.RegisterType((*QueryNextFeeDistributionEstimateRequest)(nil), "interchain_security.ccv.consumer.v1.QueryNextFeeDistributionEstimateRequest")
+	proto.RegisterType((*QueryNextFeeDistributionEstimateResponse)(nil), "interchain_security.ccv.consumer.v1.QueryNextFeeDistributionEstimateResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "interchain_security.ccv.consumer.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "interchain_security.ccv.consumer.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryProviderInfoRequest)(nil), "interchain_security.ccv.consumer.v1.QueryProviderInfoRequest")
+	proto.RegisterType((*QueryProviderInfoResponse)(nil), "interchain_security.ccv.consumer.v1.QueryProviderInfoResponse")
+	proto.RegisterType((*QueryThrottleStateRequest)(nil), "interchain_security.ccv.consumer.v1.QueryThrottleStateRequest")
+	proto.RegisterType((*QueryThrottleStateResponse)(nil), "interchain_security.ccv.consumer.v1.QueryThrottleStateResponse")
+	proto.RegisterType((*QuerySlashRetryDelayRequest)(nil), "interchain_security.ccv.consumer.v1.QuerySlashRetryDelayRequest")
+	proto.RegisterType((*QuerySlashRetryDelayResponse)(nil), "interchain_security.ccv.consumer.v1.QuerySlashRetryDelayResponse")
+	proto.RegisterType((*ChainInfo)(nil), "interchain_security.ccv.consumer.v1.ChainInfo")
+	proto.RegisterType((*QueryNearTimeoutPacketsRequest)(nil), "interchain_security.ccv.consumer.v1.QueryNearTimeoutPacketsRequest")
+	proto.RegisterType((*QueryNearTimeoutPacketsResponse)(nil), "interchain_security.ccv.consumer.v1.QueryNearTimeoutPacketsResponse")
+	proto.RegisterType((*PacketTimeout)(nil), "interchain_security.ccv.consumer.v1.PacketTimeout")
+	proto.RegisterType((*QueryProviderClientExpiryRequest)(nil), "interchain_security.ccv.consumer.v1.QueryProviderClientExpiryRequest")
+	proto.RegisterType((*QueryProviderClientExpiryResponse)(nil), "interchain_security.ccv.consumer.v1.QueryProviderClientExpiryResponse")
+	proto.RegisterType((*QueryProviderSwitchRequest)(nil), "interchain_security.ccv.consumer.v1.QueryProviderSwitchRequest")
+	proto.RegisterType((*QueryProviderSwitchResponse)(nil), "interchain_security.ccv.consumer.v1.QueryProviderSwitchResponse")
+	proto.RegisterType((*QueryPendingPacketRequest)(nil), "interchain_security.ccv.consumer.v1.QueryPendingPacketRequest")
+	proto.RegisterType((*QueryPendingPacketResponse)(nil), "interchain_security.ccv.consumer.v1.QueryPendingPacketResponse")
+	proto.RegisterType((*QueryModuleVersionInfoRequest)(nil), "interchain_security.ccv.consumer.v1.QueryModuleVersionInfoRequest")
+	proto.RegisterType((*QueryModuleVersionInfoResponse)(nil), "interchain_security.ccv.consumer.v1.QueryModuleVersionInfoResponse")
+	proto.RegisterType((*QueryChangeoverRehearsalRequest)(nil), "interchain_security.ccv.consumer.v1.QueryChangeoverRehearsalRequest")
+	proto.RegisterType((*QueryChangeoverRehearsalResponse)(nil), "interchain_security.ccv.consumer.v1.QueryChangeoverRehearsalResponse")
+	proto.RegisterType((*QuerySlashRecordRequest)(nil), "interchain_security.ccv.consumer.v1.QuerySlashRecordRequest")
+	proto.RegisterType((*QuerySlashRecordResponse)(nil), "interchain_security.ccv.consumer.v1.QuerySlashRecordResponse")
+}
+
+func init() {
+	proto.RegisterFile("interchain_security/ccv/consumer/v1/query.proto", fileDescriptor_f627751d3cc10225)
+}
+
+var fileDescriptor_f627751d3cc10225 = []byte{
+	// 1854 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0x4f, 0x6f, 0x1c, 0xb7,
+	0x15, 0xf7, 0xe8, 0x9f, 0xb5, 0x54, 0x14, 0x5b, 0xcc, 0x36, 0x59, 0x8f, 0xec, 0x95, 0x3a, 0x6d,
+	0x51, 0xd5, 0xa9, 0x66, 0xf5, 0xa7, 0x89, 0x94, 0xb8, 0x8e, 0xff, 0x68, 0x65, 0x44, 0x80, 0x9d,
+	0xda, 0x63, 0xc5, 0x45, 0x83, 0x16, 0x53, 0x6a, 0x86, 0xd2, 0x12, 0xd9, 0xe5, 0xac, 0x39, 0x9c,
+	0xb5, 0x84, 0xa2, 0x40, 0xd1, 0xf6, 0x5c, 0x04, 0xe8, 0xa5, 0x97, 0x7e, 0x89, 0x7e, 0x81, 0x1e,
+	0x13, 0xa0, 0x87, 0x06, 0xcd, 0xa1, 0xee, 0xa5, 0x0d, 0xec, 0x9c, 0x8a, 0x7e, 0x80, 0x5e, 0x0a,
+	0x14, 0xe4, 0x3c, 0xce, 0xee, 0x48, 0xbb, 0xab, 0x59, 0xa9, 0xbd, 0x0d, 0xf9, 0xf8, 0x7e, 0x7c,
+	0xbf, 0x47, 0xf2, 0xf1, 0xc7, 0x5d, 0x54, 0x63, 0x5c, 0x52, 0x11, 0x34, 0x08, 0xe3, 0x7e, 0x4c,
+	0x83, 0x44, 0x30, 0x79, 0x54, 0x0b, 0x82, 0x4e, 0x2d, 0x88, 0x78, 0x9c, 0xb4, 0xa8, 0xa8, 0x75,
+	0x56, 0x6b, 0x4f, 0x13, 0x2a, 0x8e, 0xdc, 0xb6, 0x88, 0x64, 0x84, 0xbf, 0xd1, 0xc7, 0xc1, 0x0d,
+	0x82, 0x8e, 0x6b, 0x1c, 0xdc, 0xce, 0xaa, 0xbd, 0x32, 0x08, 0xb5, 0xb3, 0x5a, 0x8b, 0x1b, 0x44,
+	0xd0, 0xd0, 0xcf, 0x86, 0x6b, 0x58, 0xbb, 0x7c, 0x10, 0x1d, 0x44, 0xfa, 0xb3, 0xa6, 0xbe, 0xa0,
+	0xf7, 0xea, 0x41, 0x14, 0x1d, 0x34, 0x69, 0x8d, 0xb4, 0x59, 0x8d, 0x70, 0x1e, 0x49, 0x22, 0x59,
+	0xc4, 0x63, 0xb0, 0x56, 0xc1, 0xaa, 0x5b, 0x7b, 0xc9, 0x7e, 0x2d, 0x4c, 0x84, 0x1e, 0x00, 0xf6,
+	0x85, 0xe3, 0x76, 0xc9, 0x5a, 0x34, 0x96, 0xa4, 0xd5, 0x86, 0x01, 0x6b, 0x45, 0xc8, 0x1f, 0x0b,
+	0xf4, 0x5b, 0x43, 0xa8, 0x3d, 0x63, 0x82, 0xc2, 0xb0, 0xa5, 0x21, 0xc3, 0x3a, 0x54, 0xc4, 0xdd,
+	0x28, 0xe7, 0x25, 0xe5, 0x21, 0x15, 0x2d, 0xc6, 0x65, 0x8d, 0xec, 0x05, 0xac, 0x26, 0x8f, 0xda,
+	0x14, 0x28, 0x3a, 0xbf, 0x19, 0x43, 0xf3, 0x1f, 0xd0, 0x43, 0x79, 0x8f, 0xd2, 0x3a, 0x8b, 0xa5,
+	0x60, 0x7b, 0x89, 0x22, 0xb8, 0x1d, 0x4b, 0xd6, 0x22, 0x92, 0xe2, 0x6f, 0xa2, 0xd9, 0x20, 0x11,
+	0x82, 0x72, 0xf9, 0x3e, 0x65, 0x07, 0x0d, 0x59, 0xb1, 0x16, 0xad, 0xa5, 0x71, 0x2f, 0xdf, 0x89,
+	0xab, 0x08, 0x35, 0x49, 0x6c, 0x86, 0x8c, 0xe9, 0x21, 0x3d, 0x3d, 0xca, 0xce, 0xe9, 0xa1, 0xb1,
+	0x8f, 0xa7, 0xf6, 0x6e, 0x0f, 0x5e, 0x47, 0x5f, 0x0b, 0x7b, 0x66, 0xf7, 0xf7, 0x05, 0x09, 0xd4,
+	0x47, 0x65, 0x62, 0xd1, 0x5a, 0x2a, 0x79, 0xe5, 0x5e, 0xe3, 0x3d, 0xb0, 0xe1, 0x32, 0x9a, 0x94,
+	0x91, 0x24, 0xcd, 0xca, 0xa4, 0x1e, 0x94, 0x36, 0xd4, 0x54, 0x32, 0x7a, 0x28, 0xa2, 0x0e, 0x0b,
+	0xa9, 0xa8, 0x4c, 0x69, 0x53, 0x4f, 0x4f, 0x6a, 0xdf, 0x82, 0x94, 0x57, 0x2e, 0x1a, 0xbb, 0xe9,
+	0x71, 0xbe, 0x83, 0xbe, 0xfd, 0x48, 0xed, 0xc6, 0x21, 0x49, 0xf1, 0xe8, 0xd3, 0x84, 0xc6, 0xd2,
+	0xf9, 0x85, 0x85, 0x96, 0x4e, 0x1f, 0x1b, 0xb7, 0x23, 0x1e, 0x53, 0xbc, 0x8b, 0x26, 0x42, 0x22,
+	0x89, 0xce, 0xdf, 0xcc, 0xda, 0x6d, 0xb7, 0xc0, 0x2e, 0x77, 0x87, 0xe1, 0x6a, 0x34, 0xa7, 0x8c,
+	0xb0, 0x8e, 0xe0, 0x21, 0x11, 0xa4, 0x15, 0x9b, 0xc0, 0x7c, 0xf4, 0x5a, 0xae, 0x17, 0x42, 0x78,
+	0x1f, 0x4d, 0xb5, 0x75, 0x0f, 0x04, 0x71, 0x7d, 0x60, 0x10, 0x9d, 0x55, 0xd7, 0x24, 0x24, 0xc5,
+	0xb8, 0x3b, 0xf1, 0xd9, 0xdf, 0x17, 0x2e, 0x78, 0xe0, 0xef, 0xd8, 0xa8, 0x92, 0x4e, 0x00, 0x59,
+	0xdd, 0xe1, 0xfb, 0x91, 0x99, 0xfc, 0x8f, 0x16, 0xba, 0xd2, 0xc7, 0x08, 0x31, 0x3c, 0x44, 0xd3,
+	0x86, 0x21, 0x44, 0xe1, 0x16, 0x4a, 0xc5, 0x96, 0x32, 0x2b, 0x24, 0x88, 0x24, 0x43, 0x51, 0x88,
+	0x6d, 0xb3, 0xdc, 0x63, 0xe7, 0x41, 0x34, 0x28, 0xce, 0x3c, 0x10, 0xd8, 0x6d, 0x88, 0x48, 0xca,
+	0x26, 0x7d, 0x2c, 0x7b, 0x16, 0xfd, 0x6f, 0x16, 0xb2, 0xfb, 0x59, 0x81, 0xdf, 0x8f, 0xd0, 0x2b,
+	0x71, 0x93, 0xc4, 0x0d, 0x5f, 0xd0, 0x20, 0x12, 0x21, 0x70, 0x5c, 0x29, 0x14, 0xd1, 0x63, 0xe5,
+	0xe8, 0x69, 0x3f, 0x1d, 0x93, 0xe5, 0xcd, 0xc4, 0xdd, 0x2e, 0xfc, 0x53, 0x34, 0xd7, 0x26, 0xc1,
+	0xc7, 0x54, 0xfa, 0x6a, 0xe9, 0xfd, 0xa7, 0x09, 0x4d, 0x68, 0x65, 0x6c, 0x71, 0x7c, 0x28, 0xe3,
+	0xdc, 0x4a, 0x2a, 0xe7, 0x3a, 0x91, 0x04, 0x18, 0x5f, 0x6a, 0x67, 0x3d, 0x8f, 0x14, 0x98, 0x73,
+	0x0d, 0xcd, 0x6b, 0x6a, 0x10, 0x88, 0x14, 0x47, 0x75, 0xda, 0x24, 0x47, 0x86, 0xfa, 0xa7, 0x16,
+	0xba, 0xda, 0xdf, 0xfe, 0xff, 0x27, 0x7f, 0x1f, 0x5d, 0x12, 0xb4, 0x45, 0x18, 0x67, 0xfc, 0xc0,
+	0x0f, 0xd5, 0xac, 0xb0, 0xd8, 0x57, 0xdc, 0xb4, 0x08, 0xbb, 0xa6, 0x08, 0xbb, 0x75, 0x28, 0xd2,
+	0x77, 0xa7, 0x15, 0xcb, 0xdf, 0xfd, 0x63, 0xc1, 0xf2, 0x5e, 0xcd, 0x7c, 0x75, 0xc0, 0xce, 0xaf,
+	0x2c, 0x54, 0xca, 0xd6, 0x1f, 0x57, 0xd0, 0x45, 0x1d, 0xdc, 0x4e, 0x5d, 0x47, 0x5c, 0xf2, 0x4c,
+	0x13, 0xdb, 0x68, 0x3a, 0x68, 0x32, 0xca, 0xe5, 0x4e, 0x5d, 0x4f, 0x57, 0xf2, 0xb2, 0x36, 0x76,
+	0xd0, 0x2b, 0x41, 0xc4, 0x39, 0xd5, 0xc5, 0x68, 0xa7, 0xae, 0xab, 0x5a, 0xc9, 0xcb, 0xf5, 0xe1,
+	0xab, 0xa8, 0x14, 0x34, 0x08, 0xe7, 0xb4, 0xb9, 0x53, 0x87, 0x5a, 0xd6, 0xed, 0x70, 0x7e, 0x82,
+	0xaa, 0x50, 0x3e, 0x88, 0xd8, 0x65, 0x2d, 0x1a, 0x25, 0x32, 0x5d, 0x23, 0x73, 0x90, 0xf1, 0x0d,
+	0x34, 0xf5, 0x8c, 0xc9, 0x06, 0xe3, 0x15, 0xab, 0x38, 0x59, 0x70, 0x71, 0x12, 0xb4, 0x30, 0x10,
+	0x1e, 0x16, 0xcc, 0x43, 0x17, 0xd3, 0x3d, 0xa0, 0x4a, 0x82, 0xda, 0x48, 0x6b, 0x85, 0xd6, 0x2a,
+	0x85, 0x01, 0x4c, 0xd8, 0x4c, 0x06, 0xc8, 0xf9, 0xbd, 0x85, 0x66, 0x73, 0x03, 0xf0, 0x35, 0x84,
+	0x80, 0xb4, 0xcf, 0xc2, 0x8a, 0x95, 0x4f, 0x43, 0xa8, 0x92, 0x1c, 0x2b, 0xbe, 0x3c, 0xa0, 0x3a,
+	0xc9, 0x13, 0x5e, 0xd6, 0xc6, 0x8f, 0xd0, 0x9c, 0x4c, 0x51, 0xfc, 0xec, 0x6e, 0xd5, 0x99, 0x9e,
+	0x59, 0xb3, 0x4f, 0xe4, 0x62, 0xd7, 0x8c, 0x48, 0x93, 0xf1, 0x89, 0x4a, 0xc6, 0x65, 0x70, 0xcf,
+	0x6c, 0x8e, 0x83, 0x16, 0x73, 0xe5, 0x69, 0x4b, 0x2f, 0xe8, 0xf6, 0x61, 0x9b, 0x89, 0x6c, 0xa7,
+	0x3f, 0xb7, 0xd0, 0xd7, 0x87, 0x0c, 0x82, 0xec, 0xcd, 0xa3, 0x52, 0xba, 0x1b, 0xba, 0xb4, 0xcc,
+	0xf6, 0x08, 0xf1, 0x36, 0x9a, 0xa1, 0x7a, 0xb8, 0x0e, 0xbc, 0x32, 0x36, 0x42, 0xcc, 0x28, 0x75,
+	0x54, 0x26, 0xfc, 0x83, 0x34, 0x01, 0x7e, 0xc2, 0x25, 0x6b, 0xfa, 0xa9, 0xa1, 0x32, 0x5e, 0x7c,
+	0x33, 0x5c, 0x52, 0xde, 0x1f, 0x2a, 0xe7, 0x34, 0x78, 0xe7, 0x2a, 0xb2, 0x73, 0xcc, 0x1e, 0x3f,
+	0x63, 0x32, 0x68, 0xf4, 0x54, 0xb7, 0xf9, 0xbe, 0x66, 0xa0, 0xfc, 0x5d, 0x84, 0xd5, 0xed, 0xdb,
+	0xa1, 0xbe, 0xa9, 0x96, 0x5d, 0xee, 0x97, 0x53, 0x4b, 0x56, 0xf6, 0x43, 0xbc, 0x82, 0xca, 0x6d,
+	0x41, 0x3b, 0x2c, 0x4a, 0xe2, 0xdc, 0xf8, 0xf4, 0x28, 0x61, 0x63, 0xeb, 0xf1, 0xf8, 0x08, 0xbd,
+	0xda, 0xa6, 0x3c, 0x54, 0x87, 0x3c, 0xd6, 0x33, 0x03, 0xd7, 0xf5, 0x62, 0xfb, 0x32, 0x1f, 0xf4,
+	0x2c, 0x40, 0xa5, 0x4d, 0x67, 0xd5, 0xdc, 0x4b, 0x69, 0x6f, 0xba, 0x47, 0xcd, 0x49, 0x2b, 0xa3,
+	0x49, 0xc6, 0x43, 0x7a, 0xa8, 0xb9, 0x4c, 0x78, 0x69, 0xc3, 0xf9, 0x8f, 0x29, 0xf6, 0xc7, 0x7c,
+	0x20, 0x1b, 0x2e, 0x7a, 0x8d, 0x27, 0x2d, 0xdf, 0x44, 0xdc, 0x3d, 0x4a, 0x0a, 0x62, 0x8e, 0x27,
+	0xad, 0x9c, 0x5b, 0x8c, 0xef, 0xab, 0x0b, 0x58, 0x7d, 0x9e, 0x7a, 0x51, 0x0d, 0x2b, 0xdb, 0x80,
+	0x81, 0xbf, 0x87, 0x5e, 0xef, 0x90, 0x26, 0x0b, 0x89, 0x8c, 0x84, 0x56, 0xbb, 0x3e, 0x09, 0x43,
+	0x41, 0xe3, 0x18, 0x4a, 0x51, 0x39, 0xb3, 0x2a, 0xa8, 0x3b, 0xa9, 0x0d, 0xbf, 0x89, 0xe6, 0xba,
+	0x5e, 0xad, 0x88, 0xb3, 0x8f, 0xa9, 0x80, 0xd2, 0x74, 0x39, 0x33, 0x3c, 0x48, 0xfb, 0x9d, 0x05,
+	0x74, 0x4d, 0xd3, 0x7f, 0x10, 0x85, 0x49, 0x93, 0x3e, 0x49, 0x65, 0x65, 0xef, 0x65, 0x7f, 0x88,
+	0xaa, 0x83, 0x06, 0x40, 0x8e, 0x9e, 0xa0, 0x57, 0x40, 0x8e, 0xfa, 0x8c, 0xef, 0x47, 0x50, 0xc8,
+	0x96, 0x87, 0x31, 0x3f, 0x01, 0x06, 0xc4, 0x67, 0x3a, 0xdd, 0x2e, 0xe7, 0x29, 0x54, 0xb7, 0xad,
+	0x06, 0xe1, 0x07, 0x34, 0xea, 0x50, 0xe1, 0xd1, 0x06, 0x25, 0x22, 0x26, 0x4d, 0xb3, 0xa6, 0x1f,
+	0xa0, 0x4b, 0x8c, 0x33, 0xc9, 0x48, 0xd3, 0xef, 0x90, 0xa6, 0x1f, 0x53, 0x09, 0x55, 0x6e, 0xd1,
+	0xed, 0x4a, 0x62, 0x57, 0x49, 0x62, 0xf7, 0x89, 0x61, 0xfe, 0x61, 0x3b, 0x24, 0x92, 0xc2, 0x84,
+	0xb3, 0xe0, 0xfe, 0x84, 0x34, 0x1f, 0x53, 0xad, 0xf7, 0x16, 0x07, 0xcf, 0x09, 0x7c, 0x7f, 0x8c,
+	0x4a, 0xc2, 0x74, 0x02, 0xd9, 0xcd, 0xa2, 0x7a, 0xe4, 0x38, 0x28, 0x84, 0xd1, 0x05, 0x74, 0xae,
+	0xa0, 0x37, 0x7a, 0x6f, 0x60, 0x75, 0x35, 0x9a, 0xa5, 0xf8, 0xf5, 0x38, 0xaa, 0x9c, 0xb4, 0x41,
+	0x54, 0x65, 0x34, 0xb9, 0x1f, 0x25, 0x3c, 0x3d, 0xaa, 0xd3, 0x5e, 0xda, 0x50, 0x05, 0x8c, 0x71,
+	0x7f, 0xbf, 0x99, 0xa9, 0xf6, 0x69, 0x6f, 0x9a, 0xf1, 0x7b, 0xba, 0x8d, 0xef, 0xa0, 0x52, 0x4c,
+	0x79, 0x98, 0x96, 0xaf, 0x51, 0x4a, 0xee, 0xb4, 0x72, 0x53, 0x06, 0x75, 0xb1, 0xee, 0x45, 0x09,
+	0x0f, 0x68, 0xac, 0x77, 0xd8, 0xac, 0x67, 0x9a, 0xea, 0x3a, 0x57, 0xf2, 0xdf, 0x17, 0x54, 0x9a,
+	0x0a, 0x39, 0x59, 0x68, 0x0a, 0x4b, 0x4f, 0x31, 0xab, 0x9c, 0xb5, 0x00, 0xd1, 0xf3, 0xec, 0x1a,
+	0xdd, 0x01, 0xa7, 0x6b, 0x4a, 0x43, 0xbd, 0x39, 0x6c, 0x8f, 0xe9, 0x24, 0x1d, 0x3b, 0x5a, 0x46,
+	0x72, 0x3c, 0x3c, 0xed, 0x7c, 0x5d, 0x1c, 0x7c, 0xbe, 0xd6, 0xfe, 0x52, 0x46, 0x93, 0x7a, 0x19,
+	0xf0, 0xbf, 0x2d, 0x58, 0x90, 0x3e, 0x32, 0x1e, 0xdf, 0x2f, 0xb4, 0x27, 0x0a, 0xbe, 0x44, 0xec,
+	0x07, 0xff, 0x23, 0xb4, 0x74, 0xb7, 0x38, 0xb7, 0x7e, 0xf9, 0xc5, 0x57, 0xbf, 0x1d, 0x7b, 0x07,
+	0x6f, 0x9c, 0xfe, 0x78, 0x57, 0x0b, 0xb1, 0xbc, 0x4f, 0xe9, 0x72, 0xef, 0x13, 0x0d, 0xff, 0xc1,
+	0x42, 0x33, 0x3d, 0x2f, 0x10, 0xbc, 0x51, 0x3c, 0xbe, 0xdc, 0x4b, 0xc6, 0xde, 0x1c, 0xdd, 0x11,
+	0x38, 0xac, 0x68, 0x0e, 0xd7, 0xf1, 0xd2, 0xe9, 0x1c, 0xd2, 0x47, 0x0d, 0xfe, 0x93, 0x85, 0xe6,
+	0x4e, 0x3c, 0x5c, 0xf0, 0xcd, 0x11, 0x22, 0x38, 0xf9, 0x1a, 0xb2, 0xdf, 0x3b, 0xab, 0x3b, 0xd0,
+	0xd8, 0xd0, 0x34, 0x56, 0x71, 0xad, 0x00, 0x0d, 0xf0, 0x5f, 0x56, 0x75, 0x16, 0xff, 0xd9, 0x42,
+	0xf8, 0xe4, 0x3b, 0x05, 0x8f, 0x10, 0x4f, 0xbf, 0xe7, 0x8f, 0x7d, 0xeb, 0xcc, 0xfe, 0x40, 0x68,
+	0x53, 0x13, 0x5a, 0xc3, 0x2b, 0xa7, 0x13, 0x92, 0x00, 0xe0, 0xc7, 0x3a, 0xf4, 0xe7, 0x16, 0x2a,
+	0xf7, 0x7b, 0x7e, 0xe0, 0xdb, 0xc5, 0x63, 0xea, 0xff, 0xb2, 0xb1, 0xef, 0x9c, 0x03, 0x01, 0x78,
+	0xdd, 0xd0, 0xbc, 0xde, 0xc2, 0xeb, 0xa7, 0xf3, 0x32, 0x6f, 0x24, 0x55, 0xfa, 0xf4, 0x53, 0x06,
+	0x7f, 0x65, 0xa1, 0x37, 0x06, 0x68, 0x75, 0xbc, 0x35, 0xca, 0xd9, 0x1e, 0xf0, 0x90, 0xb0, 0xeb,
+	0xe7, 0x03, 0x01, 0x8e, 0xef, 0x69, 0x8e, 0x9b, 0xf8, 0xed, 0x22, 0x75, 0x81, 0x08, 0xdf, 0x48,
+	0x77, 0x10, 0x46, 0xf8, 0x5f, 0xc7, 0x7f, 0x1a, 0xe8, 0x95, 0xd5, 0x78, 0x7b, 0xf4, 0xa3, 0xd2,
+	0x47, 0xbb, 0xdb, 0xf7, 0xce, 0x0b, 0x03, 0x64, 0x6f, 0x6b, 0xb2, 0xef, 0xe2, 0xcd, 0xe2, 0x27,
+	0xcf, 0x87, 0xe7, 0x40, 0x2a, 0xd3, 0xf1, 0x17, 0x96, 0xf9, 0x1d, 0x26, 0xa7, 0x4b, 0xf1, 0xad,
+	0xd1, 0x23, 0xcc, 0xa9, 0x74, 0xfb, 0xf6, 0xd9, 0x01, 0x80, 0xdc, 0x3b, 0x9a, 0xdc, 0x3a, 0x5e,
+	0x1d, 0x81, 0x5c, 0x2a, 0xc8, 0xf1, 0x5f, 0x4d, 0x61, 0xc9, 0x89, 0xdb, 0x51, 0x0a, 0x4b, 0x3f,
+	0x01, 0x6e, 0xdf, 0x3a, 0xb3, 0xff, 0x19, 0xd6, 0x2b, 0x27, 0xd8, 0x6b, 0x3f, 0xd3, 0x62, 0xff,
+	0xe7, 0xf8, 0x4b, 0x0b, 0xbd, 0xde, 0x5f, 0xcd, 0xe2, 0xbb, 0xc5, 0xa3, 0x1b, 0xa4, 0x95, 0xed,
+	0xad, 0x73, 0x61, 0x00, 0xcb, 0x9b, 0x9a, 0xe5, 0x06, 0x7e, 0xeb, 0x74, 0x96, 0x2d, 0x0d, 0xe2,
+	0xf7, 0xaa, 0x6f, 0xfc, 0x4f, 0xa3, 0x49, 0xfa, 0xa8, 0x4d, 0x3c, 0x42, 0x91, 0x18, 0xac, 0xba,
+	0xed, 0xed, 0x73, 0xa2, 0x00, 0xd1, 0x3b, 0x9a, 0xe8, 0x8d, 0x77, 0xad, 0xeb, 0x4e, 0x81, 0x72,
+	0x13, 0x64, 0x48, 0x7e, 0x26, 0x96, 0xf1, 0xa7, 0x16, 0xba, 0x7c, 0x5c, 0x11, 0xe3, 0xef, 0x8f,
+	0x5c, 0xea, 0x7b, 0x44, 0xb6, 0x7d, 0xf3, 0x8c, 0xde, 0x40, 0xea, 0x6d, 0x4d, 0x6a, 0x05, 0xbb,
+	0xc5, 0x2f, 0x09, 0xfd, 0x83, 0xd8, 0x0f, 0x3f, 0x7b, 0x51, 0xb5, 0x3e, 0x7f, 0x51, 0xb5, 0xbe,
+	0x7c, 0x51, 0xb5, 0x3e, 0x79, 0x59, 0xbd, 0xf0, 0xf9, 0xcb, 0xea, 0x85, 0xe7, 0x2f, 0xab, 0x17,
+	0x3e, 0xba, 0x79, 0xc0, 0x64, 0x23, 0xd9, 0x73, 0x83, 0xa8, 0x55, 0x0b, 0xa2, 0xb8, 0x15, 0xc5,
+	0x3d, 0xd0, 0xcb, 0x19, 0x74, 0x67, 0xa3, 0x76, 0x98, 0xc7, 0xd7, 0x7f, 0x02, 0xec, 0x4d, 0x69,
+	0x99, 0xbd, 0xfe, 0xdf, 0x01, 0x00, 0xde, 0x86, 0xa4, 0xa0, 0xa6, 0x19, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// ConsumerGenesis queries the genesis state needed to start a consumer chain
+	// whose proposal has been accepted
+	QueryNextFeeDistribution(ctx context.Context, in *QueryNextFeeDistributionEstimateRequest, opts ...grpc.CallOption) (*QueryNextFeeDistributionEstimateResponse, error)
+	// QueryParams queries the ccv/consumer module parameters.
+	QueryParams(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	QueryProviderInfo(ctx context.Context, in *QueryProviderInfoRequest, opts ...grpc.CallOption) (*QueryProviderInfoResponse, error)
+	// QueryThrottleState returns on-chain state relevant to throttled consumer packets
+	QueryThrottleState(ctx context.Context, in *QueryThrottleStateRequest, opts ...grpc.CallOption) (*QueryThrottleStateResponse, error)
+	// QuerySlashRetryDelay returns the remaining delay until the bounced slash packet
+	// at the head of the pending packets queue can be resent
+	QuerySlashRetryDelay(ctx context.Context, in *QuerySlashRetryDelayRequest, opts ...grpc.CallOption) (*QuerySlashRetryDelayResponse, error)
+	// QueryNearTimeoutPackets returns the packets sent to the provider chain
+	// that are not yet acknowledged and that time out within the given duration
+	QueryNearTimeoutPackets(ctx context.Context, in *QueryNearTimeoutPacketsRequest, opts ...grpc.CallOption) (*QueryNearTimeoutPacketsResponse, error)
+	// QueryProviderClientExpiry returns the time at which the client to the provider
+	// chain expires if it is not updated
+	QueryProviderClientExpiry(ctx context.Context, in *QueryProviderClientExpiryRequest, opts ...grpc.CallOption) (*QueryProviderClientExpiryResponse, error)
+	// QueryProviderSwitch returns the identifiers of the active and previous provider chains
+	// together with the pending switch to a different provider chain, if any
+	QueryProviderSwitch(ctx context.Context, in *QueryProviderSwitchRequest, opts ...grpc.CallOption) (*QueryProviderSwitchResponse, error)
+	// QueryPendingPacket returns the packet at the given position of the pending packets queue,
+	// with the validator of a slash packet resolved
+	QueryPendingPacket(ctx context.Context, in *QueryPendingPacketRequest, opts ...grpc.CallOption) (*QueryPendingPacketResponse, error)
+	// QueryModuleVersionInfo returns the version of the consumer module
+	// and the features enabled on the consumer chain
+	QueryModuleVersionInfo(ctx context.Context, in *QueryModuleVersionInfoRequest, opts ...grpc.CallOption) (*QueryModuleVersionInfoResponse, error)
+	// QueryChangeoverRehearsal rehearses the standalone to consumer changeover with the given initial valset,
+	// i.e., it returns the validator updates and the voting power changes that the changeover would produce,
+	// without handing over the control of the validator set from the standalone staking module
+	QueryChangeoverRehearsal(ctx context.Context, in *QueryChangeoverRehearsalRequest, opts ...grpc.CallOption) (*QueryChangeoverRehearsalResponse, error)
+	// QuerySlashRecord returns the state of the slash packet at the head of the pending packets queue,
+	// i.e., whether it is in flight, when it was sent, how many times it was bounced by the provider,
+	// and when it is retried
+	QuerySlashRecord(ctx context.Context, in *QuerySlashRecordRequest, opts ...grpc.CallOption) (*QuerySlashRecordResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) QueryNextFeeDistribution(ctx context.Context, in *QueryNextFeeDistributionEstimateRequest, opts ...grpc.CallOption) (*QueryNextFeeDistributionEstimateResponse, error) {
+	out := new(QueryNextFeeDistributionEstimateResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.consumer.v1.Query/QueryNextFeeDistribution", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.LastHeight != 0 {
-		n += 1 + sovQuery(uint64(m.LastHeight))
+	return out, nil
+}
+
+func (c *queryClient) QueryParams(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.consumer.v1.Query/QueryParams", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.NextHeight != 0 {
-		n += 1 + sovQuery(uint64(m.NextHeight))
+	return out, nil
+}
+
+func (c *queryClient) QueryProviderInfo(ctx context.Context, in *QueryProviderInfoRequest, opts ...grpc.CallOption) (*QueryProviderInfoResponse, error) {
+	out := new(QueryProviderInfoResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.consumer.v1.Query/QueryProviderInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.DistributionFraction)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) QueryThrottleState(ctx context.Context, in *QueryThrottleStateRequest, opts ...grpc.CallOption) (*QueryThrottleStateResponse, error) {
+	out := new(QueryThrottleStateResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.consumer.v1.Query/QueryThrottleState", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Total)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) QuerySlashRetryDelay(ctx context.Context, in *QuerySlashRetryDelayRequest, opts ...grpc.CallOption) (*QuerySlashRetryDelayResponse, error) {
+	out := new(QuerySlashRetryDelayResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.consumer.v1.Query/QuerySlashRetryDelay", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.ToProvider)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) QueryNearTimeoutPackets(ctx context.Context, in *QueryNearTimeoutPacketsRequest, opts ...grpc.CallOption) (*QueryNearTimeoutPacketsResponse, error) {
+	out := new(QueryNearTimeoutPacketsResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.consumer.v1.Query/QueryNearTimeoutPackets", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.ToConsumer)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) QueryProviderClientExpiry(ctx context.Context, in *QueryProviderClientExpiryRequest, opts ...grpc.CallOption) (*QueryProviderClientExpiryResponse, error) {
+	out := new(QueryProviderClientExpiryResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.consumer.v1.Query/QueryProviderClientExpiry", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryNextFeeDistributionEstimateRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) QueryProviderSwitch(ctx context.Context, in *QueryProviderSwitchRequest, opts ...grpc.CallOption) (*QueryProviderSwitchResponse, error) {
+	out := new(QueryProviderSwitchResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.consumer.v1.Query/QueryProviderSwitch", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *QueryNextFeeDistributionEstimateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) QueryPendingPacket(ctx context.Context, in *QueryPendingPacketRequest, opts ...grpc.CallOption) (*QueryPendingPacketResponse, error) {
+	out := new(QueryPendingPacketResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.consumer.v1.Query/QueryPendingPacket", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Data != nil {
-		l = m.Data.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) QueryModuleVersionInfo(ctx context.Context, in *QueryModuleVersionInfoRequest, opts ...grpc.CallOption) (*QueryModuleVersionInfoResponse, error) {
+	out := new(QueryModuleVersionInfoResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.consumer.v1.Query/QueryModuleVersionInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) QueryChangeoverRehearsal(ctx context.Context, in *QueryChangeoverRehearsalRequest, opts ...grpc.CallOption) (*QueryChangeoverRehearsalResponse, error) {
+	out := new(QueryChangeoverRehearsalResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.consumer.v1.Query/QueryChangeoverRehearsal", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) QuerySlashRecord(ctx context.Context, in *QuerySlashRecordRequest, opts ...grpc.CallOption) (*QuerySlashRecordResponse, error) {
+	out := new(QuerySlashRecordResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.consumer.v1.Query/QuerySlashRecord", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryProviderInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// ConsumerGenesis queries the genesis state needed to start a consumer chain
+	// whose proposal has been accepted
+	QueryNextFeeDistribution(context.Context, *QueryNextFeeDistributionEstimateRequest) (*QueryNextFeeDistributionEstimateResponse, error)
+	// QueryParams queries the ccv/consumer module parameters.
+	QueryParams(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	QueryProviderInfo(context.Context, *QueryProviderInfoRequest) (*QueryProviderInfoResponse, error)
+	// QueryThrottleState returns on-chain state relevant to throttled consumer packets
+	QueryThrottleState(context.Context, *QueryThrottleStateRequest) (*QueryThrottleStateResponse, error)
+	// QuerySlashRetryDelay returns the remaining delay until the bounced slash packet
+	// at the head of the pending packets queue can be resent
+	QuerySlashRetryDelay(context.Context, *QuerySlashRetryDelayRequest) (*QuerySlashRetryDelayResponse, error)
+	// QueryNearTimeoutPackets returns the packets sent to the provider chain
+	// that are not yet acknowledged and that time out within the given duration
+	QueryNearTimeoutPackets(context.Context, *QueryNearTimeoutPacketsRequest) (*QueryNearTimeoutPacketsResponse, error)
+	// QueryProviderClientExpiry returns the time at which the client to the provider
+	// chain expires if it is not updated
+	QueryProviderClientExpiry(context.Context, *QueryProviderClientExpiryRequest) (*QueryProviderClientExpiryResponse, error)
+	// QueryProviderSwitch returns the identifiers of the active and previous provider chains
+	// together with the pending switch to a different provider chain, if any
+	QueryProviderSwitch(context.Context, *QueryProviderSwitchRequest) (*QueryProviderSwitchResponse, error)
+	// QueryPendingPacket returns the packet at the given position of the pending packets queue,
+	// with the validator of a slash packet resolved
+	QueryPendingPacket(context.Context, *QueryPendingPacketRequest) (*QueryPendingPacketResponse, error)
+	// QueryModuleVersionInfo returns the version of the consumer module
+	// and the features enabled on the consumer chain
+	QueryModuleVersionInfo(context.Context, *QueryModuleVersionInfoRequest) (*QueryModuleVersionInfoResponse, error)
+	// QueryChangeoverRehearsal rehearses the standalone to consumer changeover with the given initial valset,
+	// i.e., it returns the validator updates and the voting power changes that the changeover would produce,
+	// without handing over the control of the validator set from the standalone staking module
+	QueryChangeoverRehearsal(context.Context, *QueryChangeoverRehearsalRequest) (*QueryChangeoverRehearsalResponse, error)
+	// QuerySlashRecord returns the state of the slash packet at the head of the pending packets queue,
+	// i.e., whether it is in flight, when it was sent, how many times it was bounced by the provider,
+	// and when it is retried
+	QuerySlashRecord(context.Context, *QuerySlashRecordRequest) (*QuerySlashRecordResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) QueryNextFeeDistribution(ctx context.Context, req *QueryNextFeeDistributionEstimateRequest) (*QueryNextFeeDistributionEstimateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryNextFeeDistribution not implemented")
+}
+func (*UnimplementedQueryServer) QueryParams(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryParams not implemented")
+}
+func (*UnimplementedQueryServer) QueryProviderInfo(ctx context.Context, req *QueryProviderInfoRequest) (*QueryProviderInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryProviderInfo not implemented")
+}
+func (*UnimplementedQueryServer) QueryThrottleState(ctx context.Context, req *QueryThrottleStateRequest) (*QueryThrottleStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryThrottleState not implemented")
+}
+func (*UnimplementedQueryServer) QuerySlashRetryDelay(ctx context.Context, req *QuerySlashRetryDelayRequest) (*QuerySlashRetryDelayResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuerySlashRetryDelay not implemented")
+}
+func (*UnimplementedQueryServer) QueryNearTimeoutPackets(ctx context.Context, req *QueryNearTimeoutPacketsRequest) (*QueryNearTimeoutPacketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryNearTimeoutPackets not implemented")
+}
+func (*UnimplementedQueryServer) QueryProviderClientExpiry(ctx context.Context, req *QueryProviderClientExpiryRequest) (*QueryProviderClientExpiryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryProviderClientExpiry not implemented")
+}
+func (*UnimplementedQueryServer) QueryProviderSwitch(ctx context.Context, req *QueryProviderSwitchRequest) (*QueryProviderSwitchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryProviderSwitch not implemented")
+}
+func (*UnimplementedQueryServer) QueryPendingPacket(ctx context.Context, req *QueryPendingPacketRequest) (*QueryPendingPacketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryPendingPacket not implemented")
+}
+func (*UnimplementedQueryServer) QueryModuleVersionInfo(ctx context.Context, req *QueryModuleVersionInfoRequest) (*QueryModuleVersionInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryModuleVersionInfo not implemented")
+}
+func (*UnimplementedQueryServer) QueryChangeoverRehearsal(ctx context.Context, req *QueryChangeoverRehearsalRequest) (*QueryChangeoverRehearsalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryChangeoverRehearsal not implemented")
+}
+func (*UnimplementedQueryServer) QuerySlashRecord(ctx context.Context, req *QuerySlashRecordRequest) (*QuerySlashRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuerySlashRecord not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_QueryNextFeeDistribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNextFeeDistributionEstimateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryNextFeeDistribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.consumer.v1.Query/QueryNextFeeDistribution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryNextFeeDistribution(ctx, req.(*QueryNextFeeDistributionEstimateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.consumer.v1.Query/QueryParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryParams(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryProviderInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProviderInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryProviderInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.consumer.v1.Query/QueryProviderInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryProviderInfo(ctx, req.(*QueryProviderInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryThrottleState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryThrottleStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryThrottleState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.consumer.v1.Query/QueryThrottleState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryThrottleState(ctx, req.(*QueryThrottleStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QuerySlashRetryDelay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySlashRetryDelayRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QuerySlashRetryDelay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.consumer.v1.Query/QuerySlashRetryDelay",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QuerySlashRetryDelay(ctx, req.(*QuerySlashRetryDelayRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryNearTimeoutPackets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNearTimeoutPacketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryNearTimeoutPackets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.consumer.v1.Query/QueryNearTimeoutPackets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryNearTimeoutPackets(ctx, req.(*QueryNearTimeoutPacketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryProviderClientExpiry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProviderClientExpiryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryProviderClientExpiry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.consumer.v1.Query/QueryProviderClientExpiry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryProviderClientExpiry(ctx, req.(*QueryProviderClientExpiryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryProviderSwitch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProviderSwitchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryProviderSwitch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.consumer.v1.Query/QueryProviderSwitch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryProviderSwitch(ctx, req.(*QueryProviderSwitchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryPendingPacket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingPacketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryPendingPacket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.consumer.v1.Query/QueryPendingPacket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryPendingPacket(ctx, req.(*QueryPendingPacketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryModuleVersionInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryModuleVersionInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryModuleVersionInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.consumer.v1.Query/QueryModuleVersionInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryModuleVersionInfo(ctx, req.(*QueryModuleVersionInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryChangeoverRehearsal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryChangeoverRehearsalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryChangeoverRehearsal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.consumer.v1.Query/QueryChangeoverRehearsal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryChangeoverRehearsal(ctx, req.(*QueryChangeoverRehearsalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QuerySlashRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySlashRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QuerySlashRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.consumer.v1.Query/QuerySlashRecord",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QuerySlashRecord(ctx, req.(*QuerySlashRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "interchain_security.ccv.consumer.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "QueryNextFeeDistribution",
+			Handler:    _Query_QueryNextFeeDistribution_Handler,
+		},
+		{
+			MethodName: "QueryParams",
+			Handler:    _Query_QueryParams_Handler,
+		},
+		{
+			MethodName: "QueryProviderInfo",
+			Handler:    _Query_QueryProviderInfo_Handler,
+		},
+		{
+			MethodName: "QueryThrottleState",
+			Handler:    _Query_QueryThrottleState_Handler,
+		},
+		{
+			MethodName: "QuerySlashRetryDelay",
+			Handler:    _Query_QuerySlashRetryDelay_Handler,
+		},
+		{
+			MethodName: "QueryNearTimeoutPackets",
+			Handler:    _Query_QueryNearTimeoutPackets_Handler,
+		},
+		{
+			MethodName: "QueryProviderClientExpiry",
+			Handler:    _Query_QueryProviderClientExpiry_Handler,
+		},
+		{
+			MethodName: "QueryProviderSwitch",
+			Handler:    _Query_QueryProviderSwitch_Handler,
+		},
+		{
+			MethodName: "QueryPendingPacket",
+			Handler:    _Query_QueryPendingPacket_Handler,
+		},
+		{
+			MethodName: "QueryModuleVersionInfo",
+			Handler:    _Query_QueryModuleVersionInfo_Handler,
+		},
+		{
+			MethodName: "QueryChangeoverRehearsal",
+			Handler:    _Query_QueryChangeoverRehearsal_Handler,
+		},
+		{
+			MethodName: "QuerySlashRecord",
+			Handler:    _Query_QuerySlashRecord_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "interchain_security/ccv/consumer/v1/query.proto",
+}
+
+func (m *NextFeeDistributionEstimate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NextFeeDistributionEstimate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NextFeeDistributionEstimate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ToConsumer) > 0 {
+		i -= len(m.ToConsumer)
+		copy(dAtA[i:], m.ToConsumer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ToConsumer)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.ToProvider) > 0 {
+		i -= len(m.ToProvider)
+		copy(dAtA[i:], m.ToProvider)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ToProvider)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Total) > 0 {
+		i -= len(m.Total)
+		copy(dAtA[i:], m.Total)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Total)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.DistributionFraction) > 0 {
+		i -= len(m.DistributionFraction)
+		copy(dAtA[i:], m.DistributionFraction)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DistributionFraction)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.NextHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NextHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.LastHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.CurrentHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNextFeeDistributionEstimateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNextFeeDistributionEstimateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNextFeeDistributionEstimateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNextFeeDistributionEstimateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNextFeeDistributionEstimateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNextFeeDistributionEstimateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Data != nil {
+		{
+			size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProviderInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProviderInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProviderInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProviderInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProviderInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProviderInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Provider.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Consumer.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryThrottleStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryThrottleStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryThrottleStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryThrottleStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryThrottleStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryThrottleStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PacketDataQueue) > 0 {
+		for iNdEx := len(m.PacketDataQueue) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PacketDataQueue[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.SlashRecord != nil {
+		{
+			size, err := m.SlashRecord.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySlashRetryDelayRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySlashRetryDelayRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySlashRetryDelayRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySlashRetryDelayResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySlashRetryDelayResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySlashRetryDelayResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.RemainingDelay, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RemainingDelay):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintQuery(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x12
+	if m.SlashRecord != nil {
+		{
+			size, err := m.SlashRecord.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChainInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChainInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChainInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelID) > 0 {
+		i -= len(m.ChannelID)
+		copy(dAtA[i:], m.ChannelID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelID)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ConnectionID) > 0 {
+		i -= len(m.ConnectionID)
+		copy(dAtA[i:], m.ConnectionID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConnectionID)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ClientID) > 0 {
+		i -= len(m.ClientID)
+		copy(dAtA[i:], m.ClientID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientID)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainID) > 0 {
+		i -= len(m.ChainID)
+		copy(dAtA[i:], m.ChainID)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainID)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNearTimeoutPacketsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNearTimeoutPacketsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNearTimeoutPacketsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Within, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Within):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintQuery(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNearTimeoutPacketsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNearTimeoutPacketsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNearTimeoutPacketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Packets) > 0 {
+		for iNdEx := len(m.Packets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Packets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PacketTimeout) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PacketTimeout) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PacketTimeout) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.TimeoutTimestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.TimeoutTimestamp):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintQuery(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x1a
+	if m.Sequence != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProviderClientExpiryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProviderClientExpiryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProviderClientExpiryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProviderClientExpiryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProviderClientExpiryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProviderClientExpiryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n10, err10 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TimeUntilExpiry, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TimeUntilExpiry):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintQuery(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0x1a
+	n11, err11 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExpiryTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpiryTime):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintQuery(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0x12
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProviderSwitchRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProviderSwitchRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProviderSwitchRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProviderSwitchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProviderSwitchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProviderSwitchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PendingSwitch != nil {
+		{
+			size, err := m.PendingSwitch.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PreviousProviderId) > 0 {
+		i -= len(m.PreviousProviderId)
+		copy(dAtA[i:], m.PreviousProviderId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PreviousProviderId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ActiveProviderId) > 0 {
+		i -= len(m.ActiveProviderId)
+		copy(dAtA[i:], m.ActiveProviderId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ActiveProviderId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingPacketRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingPacketRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingPacketRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Index != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingPacketResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingPacketResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingPacketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorMoniker) > 0 {
+		i -= len(m.ValidatorMoniker)
+		copy(dAtA[i:], m.ValidatorMoniker)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorMoniker)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ValidatorConsAddress) > 0 {
+		i -= len(m.ValidatorConsAddress)
+		copy(dAtA[i:], m.ValidatorConsAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorConsAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Packet.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.NumPendingPackets != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumPendingPackets))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryModuleVersionInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryModuleVersionInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryModuleVersionInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryModuleVersionInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryModuleVersionInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryModuleVersionInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.VersionInfo.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChangeoverRehearsalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChangeoverRehearsalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChangeoverRehearsalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.InitialValSet) > 0 {
+		for iNdEx := len(m.InitialValSet) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InitialValSet[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChangeoverRehearsalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChangeoverRehearsalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChangeoverRehearsalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Rehearsal.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySlashRecordRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySlashRecordRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySlashRecordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySlashRecordResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySlashRecordResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySlashRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorConsAddress) > 0 {
+		i -= len(m.ValidatorConsAddress)
+		copy(dAtA[i:], m.ValidatorConsAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorConsAddress)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.SlashPacket != nil {
+		{
+			size, err := m.SlashPacket.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.NextRetryTime != nil {
+		n17, err17 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.NextRetryTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.NextRetryTime):])
+		if err17 != nil {
+			return 0, err17
+		}
+		i -= n17
+		i = encodeVarintQuery(dAtA, i, uint64(n17))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Bounces != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Bounces))
+		i--
+		dAtA[i] = 0x20
+	}
+	n18, err18 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.SendTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SendTime):])
+	if err18 != nil {
+		return 0, err18
+	}
+	i -= n18
+	i = encodeVarintQuery(dAtA, i, uint64(n18))
+	i--
+	dAtA[i] = 0x1a
+	if m.InFlight {
+		i--
+		if m.InFlight {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Found {
+		i--
+		if m.Found {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *NextFeeDistributionEstimate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CurrentHeight != 0 {
+		n += 1 + sovQuery(uint64(m.CurrentHeight))
+	}
+	if m.LastHeight != 0 {
+		n += 1 + sovQuery(uint64(m.LastHeight))
+	}
+	if m.NextHeight != 0 {
+		n += 1 + sovQuery(uint64(m.NextHeight))
+	}
+	l = len(m.DistributionFraction)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Total)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ToProvider)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ToConsumer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNextFeeDistributionEstimateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryNextFeeDistributionEstimateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Data != nil {
+		l = m.Data.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryProviderInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryProviderInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Consumer.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Provider.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryThrottleStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryThrottleStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SlashRecord != nil {
+		l = m.SlashRecord.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.PacketDataQueue) > 0 {
+		for _, e := range m.PacketDataQueue {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySlashRetryDelayRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QuerySlashRetryDelayResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SlashRecord != nil {
+		l = m.SlashRecord.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RemainingDelay)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *ChainInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ClientID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ConnectionID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelID)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNearTimeoutPacketsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Within)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryNearTimeoutPacketsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Packets) > 0 {
+		for _, e := range m.Packets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PacketTimeout) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovQuery(uint64(m.Sequence))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.TimeoutTimestamp)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryProviderClientExpiryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryProviderClientExpiryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpiryTime)
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TimeUntilExpiry)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryProviderSwitchRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryProviderSwitchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ActiveProviderId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.PreviousProviderId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PendingSwitch != nil {
+		l = m.PendingSwitch.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingPacketRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovQuery(uint64(m.Index))
+	}
+	return n
+}
+
+func (m *QueryPendingPacketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NumPendingPackets != 0 {
+		n += 1 + sovQuery(uint64(m.NumPendingPackets))
+	}
+	l = m.Packet.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.ValidatorConsAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ValidatorMoniker)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryModuleVersionInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryModuleVersionInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.VersionInfo.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryChangeoverRehearsalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.InitialValSet) > 0 {
+		for _, e := range m.InitialValSet {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryChangeoverRehearsalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Rehearsal.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySlashRecordRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QuerySlashRecordResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Found {
+		n += 2
+	}
+	if m.InFlight {
+		n += 2
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SendTime)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Bounces != 0 {
+		n += 1 + sovQuery(uint64(m.Bounces))
+	}
+	if m.NextRetryTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.NextRetryTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.SlashPacket != nil {
+		l = m.SlashPacket.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ValidatorConsAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *NextFeeDistributionEstimate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NextFeeDistributionEstimate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NextFeeDistributionEstimate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentHeight", wireType)
+			}
+			m.CurrentHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastHeight", wireType)
+			}
+			m.LastHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextHeight", wireType)
+			}
+			m.NextHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DistributionFraction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Total = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToProvider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToProvider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToConsumer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToConsumer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNextFeeDistributionEstimateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNextFeeDistributionEstimateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNextFeeDistributionEstimateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNextFeeDistributionEstimateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNextFeeDistributionEstimateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNextFeeDistributionEstimateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Data == nil {
+				m.Data = &NextFeeDistributionEstimate{}
+			}
+			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProviderInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProviderInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProviderInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProviderInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProviderInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProviderInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Consumer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Consumer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Provider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryThrottleStateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryThrottleStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryThrottleStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryThrottleStateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryThrottleStateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryThrottleStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashRecord", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SlashRecord == nil {
+				m.SlashRecord = &SlashRecord{}
+			}
+			if err := m.SlashRecord.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketDataQueue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketDataQueue = append(m.PacketDataQueue, types.ConsumerPacketData{})
+			if err := m.PacketDataQueue[len(m.PacketDataQueue)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySlashRetryDelayRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySlashRetryDelayRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySlashRetryDelayRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySlashRetryDelayResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySlashRetryDelayResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySlashRetryDelayResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashRecord", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SlashRecord == nil {
+				m.SlashRecord = &SlashRecord{}
+			}
+			if err := m.SlashRecord.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingDelay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.RemainingDelay, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChainInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChainInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChainInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNearTimeoutPacketsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNearTimeoutPacketsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNearTimeoutPacketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Within", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Within, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNearTimeoutPacketsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNearTimeoutPacketsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNearTimeoutPacketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Packets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Packets = append(m.Packets, PacketTimeout{})
+			if err := m.Packets[len(m.Packets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryProviderInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Consumer.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.Provider.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
-
-func (m *QueryThrottleStateRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PacketTimeout) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PacketTimeout: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PacketTimeout: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.TimeoutTimestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryThrottleStateResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.SlashRecord != nil {
-		l = m.SlashRecord.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	if len(m.PacketDataQueue) > 0 {
-		for _, e := range m.PacketDataQueue {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+func (m *QueryProviderClientExpiryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProviderClientExpiryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProviderClientExpiryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *ChainInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ChainID)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.ClientID)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.ConnectionID)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.ChannelID)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *NextFeeDistributionEstimate) Unmarshal(dAtA []byte) error {
+func (m *QueryProviderClientExpiryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1369,17 +5059,17 @@ func (m *NextFeeDistributionEstimate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NextFeeDistributionEstimate: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProviderClientExpiryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NextFeeDistributionEstimate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProviderClientExpiryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
 			}
-			m.CurrentHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1389,16 +5079,29 @@ func (m *NextFeeDistributionEstimate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CurrentHeight |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTime", wireType)
 			}
-			m.LastHeight = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1408,35 +5111,30 @@ func (m *NextFeeDistributionEstimate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LastHeight |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextHeight", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			m.NextHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NextHeight |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-		case 4:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ExpiryTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DistributionFraction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeUntilExpiry", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1446,27 +5144,128 @@ func (m *NextFeeDistributionEstimate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DistributionFraction = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.TimeUntilExpiry, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProviderSwitchRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProviderSwitchRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProviderSwitchRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProviderSwitchResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProviderSwitchResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProviderSwitchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveProviderId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1494,11 +5293,11 @@ func (m *NextFeeDistributionEstimate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Total = string(dAtA[iNdEx:postIndex])
+			m.ActiveProviderId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToProvider", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousProviderId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1526,13 +5325,13 @@ func (m *NextFeeDistributionEstimate) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ToProvider = string(dAtA[iNdEx:postIndex])
+			m.PreviousProviderId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToConsumer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingSwitch", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1542,23 +5341,27 @@ func (m *NextFeeDistributionEstimate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ToConsumer = string(dAtA[iNdEx:postIndex])
+			if m.PendingSwitch == nil {
+				m.PendingSwitch = &ProviderSwitch{}
+			}
+			if err := m.PendingSwitch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1581,7 +5384,7 @@ func (m *NextFeeDistributionEstimate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryNextFeeDistributionEstimateRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingPacketRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1604,12 +5407,31 @@ func (m *QueryNextFeeDistributionEstimateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNextFeeDistributionEstimateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingPacketRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNextFeeDistributionEstimateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingPacketRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1631,7 +5453,7 @@ func (m *QueryNextFeeDistributionEstimateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryNextFeeDistributionEstimateResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingPacketResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1654,17 +5476,101 @@ func (m *QueryNextFeeDistributionEstimateResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNextFeeDistributionEstimateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingPacketResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNextFeeDistributionEstimateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingPacketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumPendingPackets", wireType)
+			}
+			m.NumPendingPackets = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumPendingPackets |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Packet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorConsAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorConsAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorMoniker", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1674,27 +5580,23 @@ func (m *QueryNextFeeDistributionEstimateResponse) Unmarshal(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Data == nil {
-				m.Data = &NextFeeDistributionEstimate{}
-			}
-			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorMoniker = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1717,7 +5619,7 @@ func (m *QueryNextFeeDistributionEstimateResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryModuleVersionInfoRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1740,10 +5642,10 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryModuleVersionInfoRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryModuleVersionInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1767,7 +5669,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryModuleVersionInfoResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1790,15 +5692,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryModuleVersionInfoResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryModuleVersionInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1825,7 +5727,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.VersionInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1850,7 +5752,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProviderInfoRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryChangeoverRehearsalRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1873,12 +5775,46 @@ func (m *QueryProviderInfoRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProviderInfoRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryChangeoverRehearsalRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProviderInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryChangeoverRehearsalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialValSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InitialValSet = append(m.InitialValSet, types1.ValidatorUpdate{})
+			if err := m.InitialValSet[len(m.InitialValSet)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1900,7 +5836,7 @@ func (m *QueryProviderInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProviderInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryChangeoverRehearsalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1923,48 +5859,15 @@ func (m *QueryProviderInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProviderInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryChangeoverRehearsalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProviderInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryChangeoverRehearsalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Consumer", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Consumer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rehearsal", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1991,7 +5894,7 @@ func (m *QueryProviderInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Provider.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Rehearsal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2016,7 +5919,7 @@ func (m *QueryProviderInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryThrottleStateRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySlashRecordRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2039,10 +5942,10 @@ func (m *QueryThrottleStateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryThrottleStateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySlashRecordRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryThrottleStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySlashRecordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2066,7 +5969,7 @@ func (m *QueryThrottleStateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryThrottleStateResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySlashRecordResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2089,17 +5992,17 @@ func (m *QueryThrottleStateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryThrottleStateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySlashRecordResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryThrottleStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySlashRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashRecord", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Found", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2109,31 +6012,35 @@ func (m *QueryThrottleStateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.SlashRecord == nil {
-				m.SlashRecord = &SlashRecord{}
+			m.Found = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InFlight", wireType)
 			}
-			if err := m.SlashRecord.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 2:
+			m.InFlight = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketDataQueue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SendTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2160,66 +6067,15 @@ func (m *QueryThrottleStateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PacketDataQueue = append(m.PacketDataQueue, types.ConsumerPacketData{})
-			if err := m.PacketDataQueue[len(m.PacketDataQueue)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.SendTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ChainInfo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ChainInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChainInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bounces", wireType)
 			}
-			var stringLen uint64
+			m.Bounces = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2229,29 +6085,16 @@ func (m *ChainInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Bounces |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ChainID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextRetryTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2261,29 +6104,33 @@ func (m *ChainInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientID = string(dAtA[iNdEx:postIndex])
+			if m.NextRetryTime == nil {
+				m.NextRetryTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.NextRetryTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashPacket", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2293,27 +6140,31 @@ func (m *ChainInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConnectionID = string(dAtA[iNdEx:postIndex])
+			if m.SlashPacket == nil {
+				m.SlashPacket = &types.SlashPacketData{}
+			}
+			if err := m.SlashPacket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorConsAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2341,7 +6192,7 @@ func (m *ChainInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelID = string(dAtA[iNdEx:postIndex])
+			m.ValidatorConsAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
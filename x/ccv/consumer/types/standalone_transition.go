@@ -0,0 +1,40 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate performs a stateless validation of the transition to a standalone chain
+func (st StandaloneTransition) Validate() error {
+	if st.TransitionHeight <= 0 {
+		return errorsmod.Wrapf(ErrInvalidStandaloneTransition, "transition height must be positive: %d", st.TransitionHeight)
+	}
+	seen := make(map[string]bool, len(st.Delegations))
+	for _, delegation := range st.Delegations {
+		if err := delegation.Validate(); err != nil {
+			return err
+		}
+		if seen[delegation.ConsensusAddress] {
+			return errorsmod.Wrapf(ErrInvalidStandaloneTransition,
+				"duplicate delegation for validator %s", delegation.ConsensusAddress)
+		}
+		seen[delegation.ConsensusAddress] = true
+	}
+	return nil
+}
+
+// Validate performs a stateless validation of the delegation seeding the staking module of the standalone chain
+func (sd StandaloneDelegation) Validate() error {
+	if _, err := sdk.ConsAddressFromBech32(sd.ConsensusAddress); err != nil {
+		return errorsmod.Wrapf(ErrInvalidStandaloneTransition, "invalid consensus address %s: %s", sd.ConsensusAddress, err.Error())
+	}
+	if _, err := sdk.AccAddressFromBech32(sd.DelegatorAddress); err != nil {
+		return errorsmod.Wrapf(ErrInvalidStandaloneTransition, "invalid delegator address %s: %s", sd.DelegatorAddress, err.Error())
+	}
+	if !sd.Amount.IsNil() && sd.Amount.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidStandaloneTransition, "delegation amount cannot be negative: %s", sd.Amount)
+	}
+	return nil
+}
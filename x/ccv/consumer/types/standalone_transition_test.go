@@ -0,0 +1,82 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/interchain-security/v7/testutil/crypto"
+	"github.com/cosmos/interchain-security/v7/x/ccv/consumer/types"
+)
+
+func TestStandaloneTransitionValidate(t *testing.T) {
+	val := crypto.NewCryptoIdentityFromIntSeed(1)
+	delegation := types.StandaloneDelegation{
+		ConsensusAddress: val.SDKValConsAddress().String(),
+		DelegatorAddress: sdk.AccAddress(val.SDKValOpAddress()).String(),
+		Amount:           math.NewInt(100),
+	}
+
+	testCases := []struct {
+		name       string
+		transition types.StandaloneTransition
+		expPass    bool
+	}{
+		{
+			"valid transition",
+			types.StandaloneTransition{TransitionHeight: 10, Delegations: []types.StandaloneDelegation{delegation}},
+			true,
+		},
+		{
+			"valid transition with the default amount",
+			types.StandaloneTransition{TransitionHeight: 10, Delegations: []types.StandaloneDelegation{
+				{ConsensusAddress: delegation.ConsensusAddress, DelegatorAddress: delegation.DelegatorAddress},
+			}},
+			true,
+		},
+		{
+			"invalid transition height",
+			types.StandaloneTransition{TransitionHeight: 0, Delegations: []types.StandaloneDelegation{delegation}},
+			false,
+		},
+		{
+			"invalid consensus address",
+			types.StandaloneTransition{TransitionHeight: 10, Delegations: []types.StandaloneDelegation{
+				{ConsensusAddress: "invalid", DelegatorAddress: delegation.DelegatorAddress, Amount: math.NewInt(100)},
+			}},
+			false,
+		},
+		{
+			"invalid delegator address",
+			types.StandaloneTransition{TransitionHeight: 10, Delegations: []types.StandaloneDelegation{
+				{ConsensusAddress: delegation.ConsensusAddress, DelegatorAddress: "invalid", Amount: math.NewInt(100)},
+			}},
+			false,
+		},
+		{
+			"negative amount",
+			types.StandaloneTransition{TransitionHeight: 10, Delegations: []types.StandaloneDelegation{
+				{ConsensusAddress: delegation.ConsensusAddress, DelegatorAddress: delegation.DelegatorAddress, Amount: math.NewInt(-1)},
+			}},
+			false,
+		},
+		{
+			"duplicate validator",
+			types.StandaloneTransition{TransitionHeight: 10, Delegations: []types.StandaloneDelegation{delegation, delegation}},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.transition.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
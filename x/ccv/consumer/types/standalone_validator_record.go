@@ -0,0 +1,31 @@
+package types
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate performs a stateless validation of the record of a tombstoned or jailed standalone validator
+func (r StandaloneValidatorRecord) Validate() error {
+	if _, err := sdk.ConsAddressFromBech32(r.ConsensusAddress); err != nil {
+		return errorsmod.Wrapf(ErrInvalidStandaloneValidatorRecord, "invalid consensus address %s: %s", r.ConsensusAddress, err.Error())
+	}
+	if !r.Tombstoned && r.JailedUntil.IsZero() {
+		return errorsmod.Wrapf(ErrInvalidStandaloneValidatorRecord,
+			"validator %s is neither tombstoned nor jailed", r.ConsensusAddress)
+	}
+	if r.DeferredUpdate != nil && (r.Tombstoned || r.DeferredUpdate.Power < 1) {
+		return errorsmod.Wrapf(ErrInvalidStandaloneValidatorRecord,
+			"invalid deferred update of validator %s", r.ConsensusAddress)
+	}
+	return nil
+}
+
+// ExcludesValidatorAt returns whether the standalone validator cannot be part of the consumer validator set at time `t`,
+// i.e., whether it was tombstoned or it is still jailed at time `t`
+func (r StandaloneValidatorRecord) ExcludesValidatorAt(t time.Time) bool {
+	return r.Tombstoned || t.Before(r.JailedUntil)
+}
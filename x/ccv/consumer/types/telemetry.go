@@ -0,0 +1,40 @@
+package types
+
+import (
+	"strings"
+
+	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// MetricNamespace is the namespace of the telemetry metrics of the pending packets queue of the consumer module,
+// e.g., the queue length is reported by the `ccv_consumer_pending_packets` gauge
+const MetricNamespace = "ccv_consumer"
+
+const (
+	// MetricPendingPackets is the gauge of the number of packets in the pending packets queue
+	MetricPendingPackets = "pending_packets"
+	// MetricPacketsQueued counts the packets appended to the pending packets queue, per packet type
+	MetricPacketsQueued = "packets_queued"
+	// MetricPacketsSent counts the packets sent to the provider chain, per packet type
+	MetricPacketsSent = "packets_sent"
+	// MetricPendingPacketsDeleted counts the packets deleted from the pending packets queue once sent
+	MetricPendingPacketsDeleted = "pending_packets_deleted"
+	// MetricPacketSendFailures counts the failed attempts to send a pending packet, per reason
+	MetricPacketSendFailures = "packet_send_failures"
+	// MetricSlashPacketBounces counts the slash packets bounced by the provider chain
+	MetricSlashPacketBounces = "slash_packet_bounces"
+	// MetricSlashRetryDelay is the gauge of the delay in seconds before the last bounced slash packet is retried
+	MetricSlashRetryDelay = "slash_retry_delay_seconds"
+)
+
+const (
+	// SendFailureReasonClientExpired is the reason of the send failures due to the provider client being expired
+	SendFailureReasonClientExpired = "client_expired"
+	// SendFailureReasonOther is the reason of all the other send failures
+	SendFailureReasonOther = "other"
+)
+
+// PacketTypeMetricKey returns the metric key suffix of a packet type, e.g., `slash` for slash packets
+func PacketTypeMetricKey(packetType ccv.ConsumerPacketDataType) string {
+	return strings.ToLower(strings.TrimPrefix(packetType.String(), "CONSUMER_PACKET_TYPE_"))
+}
@@ -9,3 +9,12 @@ func NewSlashRecord(sendTime time.Time, waitingOnReply bool) (record SlashRecord
 		WaitingOnReply: true,
 	}
 }
+
+// Bounces returns the number of times the slash packet was bounced by the provider,
+// i.e., the number of times it was sent, excluding the send awaiting a reply
+func (r SlashRecord) Bounces() uint32 {
+	if r.WaitingOnReply && r.Attempts > 0 {
+		return r.Attempts - 1
+	}
+	return r.Attempts
+}
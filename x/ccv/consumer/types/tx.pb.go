@@ -12,6 +12,7 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	_07_tendermint "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
 	types "github.com/cosmos/interchain-security/v7/x/ccv/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
@@ -124,230 +125,2103 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgUpdateParams)(nil), "interchain_security.ccv.consumer.v1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "interchain_security.ccv.consumer.v1.MsgUpdateParamsResponse")
+// MsgRetrySlashPacket defines the message used to resend the bounced slash packet
+// at the head of the pending packets queue once the retry delay period has elapsed,
+// instead of waiting for the next EndBlock. Any account can submit it.
+type MsgRetrySlashPacket struct {
+	// the address of the account submitting the message
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("interchain_security/ccv/consumer/v1/tx.proto", fileDescriptor_9d7049279494b73f)
+func (m *MsgRetrySlashPacket) Reset()         { *m = MsgRetrySlashPacket{} }
+func (m *MsgRetrySlashPacket) String() string { return proto.CompactTextString(m) }
+func (*MsgRetrySlashPacket) ProtoMessage()    {}
+func (*MsgRetrySlashPacket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d7049279494b73f, []int{2}
 }
-
-var fileDescriptor_9d7049279494b73f = []byte{
-	// 402 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0xc9, 0xcc, 0x2b, 0x49,
-	0x2d, 0x4a, 0xce, 0x48, 0xcc, 0xcc, 0x8b, 0x2f, 0x4e, 0x4d, 0x2e, 0x2d, 0xca, 0x2c, 0xa9, 0xd4,
-	0x4f, 0x4e, 0x2e, 0xd3, 0x4f, 0xce, 0xcf, 0x2b, 0x2e, 0xcd, 0x4d, 0x2d, 0xd2, 0x2f, 0x33, 0xd4,
-	0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x52, 0xc6, 0xa2, 0x5a, 0x2f, 0x39, 0xb9,
-	0x4c, 0x0f, 0xa6, 0x5a, 0xaf, 0xcc, 0x50, 0x4a, 0x30, 0x31, 0x37, 0x33, 0x2f, 0x5f, 0x1f, 0x4c,
-	0x42, 0xf4, 0x49, 0xc9, 0xa4, 0xe7, 0xe7, 0xa7, 0xe7, 0xa4, 0xea, 0x27, 0x16, 0x64, 0xea, 0x27,
-	0xe6, 0xe5, 0xe5, 0x97, 0x24, 0x96, 0x64, 0xe6, 0xe7, 0x15, 0x43, 0x65, 0x45, 0xd2, 0xf3, 0xd3,
-	0xf3, 0xc1, 0x4c, 0x7d, 0x10, 0x0b, 0x2a, 0x2a, 0x99, 0x9c, 0x5f, 0x9c, 0x9b, 0x5f, 0x1c, 0x0f,
-	0x91, 0x80, 0x70, 0xa0, 0x52, 0xe2, 0x10, 0x9e, 0x7e, 0x6e, 0x71, 0x3a, 0xc8, 0x79, 0xb9, 0xc5,
-	0xe9, 0x50, 0x09, 0x03, 0x5c, 0xbe, 0x29, 0x33, 0xd4, 0x2f, 0xce, 0x48, 0x2c, 0x4a, 0x4d, 0x89,
-	0x87, 0xbb, 0x14, 0xac, 0x43, 0x69, 0x31, 0x23, 0x17, 0xbf, 0x6f, 0x71, 0x7a, 0x68, 0x41, 0x4a,
-	0x62, 0x49, 0x6a, 0x40, 0x62, 0x51, 0x62, 0x6e, 0xb1, 0x90, 0x19, 0x17, 0x67, 0x62, 0x69, 0x49,
-	0x46, 0x3e, 0x48, 0xb7, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xa7, 0x93, 0xc4, 0xa5, 0x2d, 0xba, 0x22,
-	0x50, 0x37, 0x38, 0xa6, 0xa4, 0x14, 0xa5, 0x16, 0x17, 0x07, 0x97, 0x14, 0x65, 0xe6, 0xa5, 0x07,
-	0x21, 0x94, 0x0a, 0x79, 0x70, 0xb1, 0x15, 0x80, 0x4d, 0x90, 0x60, 0x52, 0x60, 0xd4, 0xe0, 0x36,
-	0xd2, 0xd2, 0xc3, 0x15, 0x5c, 0x65, 0x86, 0x7a, 0xce, 0x50, 0x77, 0x40, 0xec, 0x74, 0x62, 0x39,
-	0x71, 0x4f, 0x9e, 0x21, 0x08, 0xaa, 0xdf, 0x8a, 0xaf, 0xe9, 0xf9, 0x06, 0x2d, 0x84, 0xc9, 0x4a,
-	0x92, 0x5c, 0xe2, 0x68, 0x8e, 0x0c, 0x4a, 0x2d, 0x2e, 0xc8, 0xcf, 0x2b, 0x4e, 0x35, 0x9a, 0xc8,
-	0xc8, 0xc5, 0xec, 0x5b, 0x9c, 0x2e, 0xd4, 0xc4, 0xc8, 0xc5, 0x83, 0xe2, 0x0b, 0x13, 0x3d, 0x22,
-	0x22, 0x4b, 0x0f, 0xcd, 0x58, 0x29, 0x1b, 0x72, 0x74, 0xc1, 0x1c, 0x23, 0xc5, 0xda, 0xf0, 0x7c,
-	0x83, 0x16, 0xa3, 0x53, 0xf8, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24,
-	0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0xd9,
-	0xa6, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0x42, 0xa3, 0x54, 0x1f, 0x61, 0x9f,
-	0x2e, 0x3c, 0xca, 0xca, 0xcc, 0xf5, 0x2b, 0x50, 0x53, 0x61, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12,
-	0x1b, 0x38, 0xd2, 0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0xd4, 0x75, 0x15, 0xe9, 0xb6, 0x02,
-	0x00, 0x00,
+func (m *MsgRetrySlashPacket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRetrySlashPacket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRetrySlashPacket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRetrySlashPacket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRetrySlashPacket.Merge(m, src)
+}
+func (m *MsgRetrySlashPacket) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRetrySlashPacket) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRetrySlashPacket.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_MsgRetrySlashPacket proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *MsgRetrySlashPacket) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+// MsgRetrySlashPacketResponse defines response type for MsgRetrySlashPacket messages
+type MsgRetrySlashPacketResponse struct {
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgRetrySlashPacketResponse) Reset()         { *m = MsgRetrySlashPacketResponse{} }
+func (m *MsgRetrySlashPacketResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRetrySlashPacketResponse) ProtoMessage()    {}
+func (*MsgRetrySlashPacketResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d7049279494b73f, []int{3}
+}
+func (m *MsgRetrySlashPacketResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRetrySlashPacketResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRetrySlashPacketResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRetrySlashPacketResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRetrySlashPacketResponse.Merge(m, src)
+}
+func (m *MsgRetrySlashPacketResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRetrySlashPacketResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRetrySlashPacketResponse.DiscardUnknown(m)
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+var xxx_messageInfo_MsgRetrySlashPacketResponse proto.InternalMessageInfo
+
+// MsgScheduleProviderSwitch defines the message used to schedule the switch of the consumer chain
+// to a different provider chain. At the switch height, the consumer chain stops processing the
+// packets of the current provider chain and starts being validated by the initial validator set
+// in the consumer genesis state issued by the new provider chain. Scheduling a switch replaces
+// the pending switch, if any.
+type MsgScheduleProviderSwitch struct {
+	// signer is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// the switch to the new provider chain
+	Switch ProviderSwitch `protobuf:"bytes,2,opt,name=switch,proto3" json:"switch"`
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/interchain_security.ccv.consumer.v1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgScheduleProviderSwitch) Reset()         { *m = MsgScheduleProviderSwitch{} }
+func (m *MsgScheduleProviderSwitch) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleProviderSwitch) ProtoMessage()    {}
+func (*MsgScheduleProviderSwitch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d7049279494b73f, []int{4}
+}
+func (m *MsgScheduleProviderSwitch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleProviderSwitch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleProviderSwitch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgScheduleProviderSwitch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleProviderSwitch.Merge(m, src)
+}
+func (m *MsgScheduleProviderSwitch) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleProviderSwitch) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleProviderSwitch.DiscardUnknown(m)
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+var xxx_messageInfo_MsgScheduleProviderSwitch proto.InternalMessageInfo
+
+func (m *MsgScheduleProviderSwitch) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgScheduleProviderSwitch) GetSwitch() ProviderSwitch {
+	if m != nil {
+		return m.Switch
+	}
+	return ProviderSwitch{}
 }
 
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+// MsgScheduleProviderSwitchResponse defines response type for MsgScheduleProviderSwitch messages
+type MsgScheduleProviderSwitchResponse struct {
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *MsgScheduleProviderSwitchResponse) Reset()         { *m = MsgScheduleProviderSwitchResponse{} }
+func (m *MsgScheduleProviderSwitchResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleProviderSwitchResponse) ProtoMessage()    {}
+func (*MsgScheduleProviderSwitchResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d7049279494b73f, []int{5}
+}
+func (m *MsgScheduleProviderSwitchResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleProviderSwitchResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleProviderSwitchResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgScheduleProviderSwitchResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleProviderSwitchResponse.Merge(m, src)
+}
+func (m *MsgScheduleProviderSwitchResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleProviderSwitchResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleProviderSwitchResponse.DiscardUnknown(m)
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_MsgScheduleProviderSwitchResponse proto.InternalMessageInfo
+
+// MsgInitiateConsumerShutdown defines the message used to shut down the consumer chain
+// from the consumer side. The consumer chain queues a final VSCMatured packet and a
+// ConsumerShutdown packet after the pending packets. Once the provider chain acknowledges
+// the ConsumerShutdown packet, and thus stops the consumer chain, the CCV channel is closed.
+type MsgInitiateConsumerShutdown struct {
+	// signer is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+}
+
+func (m *MsgInitiateConsumerShutdown) Reset()         { *m = MsgInitiateConsumerShutdown{} }
+func (m *MsgInitiateConsumerShutdown) String() string { return proto.CompactTextString(m) }
+func (*MsgInitiateConsumerShutdown) ProtoMessage()    {}
+func (*MsgInitiateConsumerShutdown) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d7049279494b73f, []int{6}
+}
+func (m *MsgInitiateConsumerShutdown) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgInitiateConsumerShutdown) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgInitiateConsumerShutdown.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
+}
+func (m *MsgInitiateConsumerShutdown) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgInitiateConsumerShutdown.Merge(m, src)
+}
+func (m *MsgInitiateConsumerShutdown) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgInitiateConsumerShutdown) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgInitiateConsumerShutdown.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgInitiateConsumerShutdown proto.InternalMessageInfo
+
+func (m *MsgInitiateConsumerShutdown) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/interchain_security.ccv.consumer.v1.Msg/UpdateParams",
+	return ""
+}
+
+// MsgInitiateConsumerShutdownResponse defines response type for MsgInitiateConsumerShutdown messages
+type MsgInitiateConsumerShutdownResponse struct {
+}
+
+func (m *MsgInitiateConsumerShutdownResponse) Reset()         { *m = MsgInitiateConsumerShutdownResponse{} }
+func (m *MsgInitiateConsumerShutdownResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgInitiateConsumerShutdownResponse) ProtoMessage()    {}
+func (*MsgInitiateConsumerShutdownResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d7049279494b73f, []int{7}
+}
+func (m *MsgInitiateConsumerShutdownResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgInitiateConsumerShutdownResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgInitiateConsumerShutdownResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+}
+func (m *MsgInitiateConsumerShutdownResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgInitiateConsumerShutdownResponse.Merge(m, src)
+}
+func (m *MsgInitiateConsumerShutdownResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgInitiateConsumerShutdownResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgInitiateConsumerShutdownResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgInitiateConsumerShutdownResponse proto.InternalMessageInfo
+
+// MsgScheduleStandaloneTransition defines the message used to schedule the transition of the
+// consumer chain to a standalone chain. At the transition height, the staking module of the
+// consumer chain is seeded from the final CCV validator set and the given delegations,
+// it becomes responsible for the validator set, and the consumer chain initiates its shutdown
+// (see MsgInitiateConsumerShutdown). Scheduling a transition replaces the pending transition, if any.
+type MsgScheduleStandaloneTransition struct {
+	// signer is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// the transition to a standalone chain
+	Transition StandaloneTransition `protobuf:"bytes,2,opt,name=transition,proto3" json:"transition"`
+}
+
+func (m *MsgScheduleStandaloneTransition) Reset()         { *m = MsgScheduleStandaloneTransition{} }
+func (m *MsgScheduleStandaloneTransition) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleStandaloneTransition) ProtoMessage()    {}
+func (*MsgScheduleStandaloneTransition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d7049279494b73f, []int{8}
+}
+func (m *MsgScheduleStandaloneTransition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleStandaloneTransition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleStandaloneTransition.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgScheduleStandaloneTransition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleStandaloneTransition.Merge(m, src)
+}
+func (m *MsgScheduleStandaloneTransition) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleStandaloneTransition) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleStandaloneTransition.DiscardUnknown(m)
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "interchain_security.ccv.consumer.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "interchain_security/ccv/consumer/v1/tx.proto",
+var xxx_messageInfo_MsgScheduleStandaloneTransition proto.InternalMessageInfo
+
+func (m *MsgScheduleStandaloneTransition) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgScheduleStandaloneTransition) GetTransition() StandaloneTransition {
+	if m != nil {
+		return m.Transition
 	}
-	return dAtA[:n], nil
+	return StandaloneTransition{}
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgScheduleStandaloneTransitionResponse defines response type for MsgScheduleStandaloneTransition messages
+type MsgScheduleStandaloneTransitionResponse struct {
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgScheduleStandaloneTransitionResponse) Reset() {
+	*m = MsgScheduleStandaloneTransitionResponse{}
+}
+func (m *MsgScheduleStandaloneTransitionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleStandaloneTransitionResponse) ProtoMessage()    {}
+func (*MsgScheduleStandaloneTransitionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d7049279494b73f, []int{9}
+}
+func (m *MsgScheduleStandaloneTransitionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleStandaloneTransitionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleStandaloneTransitionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgScheduleStandaloneTransitionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleStandaloneTransitionResponse.Merge(m, src)
+}
+func (m *MsgScheduleStandaloneTransitionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleStandaloneTransitionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleStandaloneTransitionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgScheduleStandaloneTransitionResponse proto.InternalMessageInfo
+
+// MsgReportMisbehaviour defines the message used to report a light client attack on the consumer chain,
+// i.e., two conflicting headers of the consumer chain. The consumer chain constructs the misbehaviour
+// for the client to the consumer chain on the provider chain and sends it to the provider chain in a
+// ConsumerMisbehaviour packet, so that the Byzantine validators are punished without the reporter
+// needing an account on the provider chain. Any account can submit it.
+type MsgReportMisbehaviour struct {
+	// the address of the account submitting the message
+	Submitter string `protobuf:"bytes,1,opt,name=submitter,proto3" json:"submitter,omitempty"`
+	// the conflicting headers of the consumer chain
+	Header_1 *_07_tendermint.Header `protobuf:"bytes,2,opt,name=header_1,json=header1,proto3" json:"header_1,omitempty"`
+	Header_2 *_07_tendermint.Header `protobuf:"bytes,3,opt,name=header_2,json=header2,proto3" json:"header_2,omitempty"`
+}
+
+func (m *MsgReportMisbehaviour) Reset()         { *m = MsgReportMisbehaviour{} }
+func (m *MsgReportMisbehaviour) String() string { return proto.CompactTextString(m) }
+func (*MsgReportMisbehaviour) ProtoMessage()    {}
+func (*MsgReportMisbehaviour) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d7049279494b73f, []int{10}
+}
+func (m *MsgReportMisbehaviour) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReportMisbehaviour) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReportMisbehaviour.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReportMisbehaviour) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReportMisbehaviour.Merge(m, src)
+}
+func (m *MsgReportMisbehaviour) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReportMisbehaviour) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReportMisbehaviour.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReportMisbehaviour proto.InternalMessageInfo
+
+func (m *MsgReportMisbehaviour) GetSubmitter() string {
+	if m != nil {
+		return m.Submitter
+	}
+	return ""
+}
+
+func (m *MsgReportMisbehaviour) GetHeader_1() *_07_tendermint.Header {
+	if m != nil {
+		return m.Header_1
+	}
+	return nil
+}
+
+func (m *MsgReportMisbehaviour) GetHeader_2() *_07_tendermint.Header {
+	if m != nil {
+		return m.Header_2
+	}
+	return nil
+}
+
+// MsgReportMisbehaviourResponse defines response type for MsgReportMisbehaviour messages
+type MsgReportMisbehaviourResponse struct {
+}
+
+func (m *MsgReportMisbehaviourResponse) Reset()         { *m = MsgReportMisbehaviourResponse{} }
+func (m *MsgReportMisbehaviourResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReportMisbehaviourResponse) ProtoMessage()    {}
+func (*MsgReportMisbehaviourResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d7049279494b73f, []int{11}
+}
+func (m *MsgReportMisbehaviourResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReportMisbehaviourResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReportMisbehaviourResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReportMisbehaviourResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReportMisbehaviourResponse.Merge(m, src)
+}
+func (m *MsgReportMisbehaviourResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReportMisbehaviourResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReportMisbehaviourResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReportMisbehaviourResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgUpdateParams)(nil), "interchain_security.ccv.consumer.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "interchain_security.ccv.consumer.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgRetrySlashPacket)(nil), "interchain_security.ccv.consumer.v1.MsgRetrySlashPacket")
+	proto.RegisterType((*MsgRetrySlashPacketResponse)(nil), "interchain_security.ccv.consumer.v1.MsgRetrySlashPacketResponse")
+	proto.RegisterType((*MsgScheduleProviderSwitch)(nil), "interchain_security.ccv.consumer.v1.MsgScheduleProviderSwitch")
+	proto.RegisterType((*MsgScheduleProviderSwitchResponse)(nil), "interchain_security.ccv.consumer.v1.MsgScheduleProviderSwitchResponse")
+	proto.RegisterType((*MsgInitiateConsumerShutdown)(nil), "interchain_security.ccv.consumer.v1.MsgInitiateConsumerShutdown")
+	proto.RegisterType((*MsgInitiateConsumerShutdownResponse)(nil), "interchain_security.ccv.consumer.v1.MsgInitiateConsumerShutdownResponse")
+	proto.RegisterType((*MsgScheduleStandaloneTransition)(nil), "interchain_security.ccv.consumer.v1.MsgScheduleStandaloneTransition")
+	proto.RegisterType((*MsgScheduleStandaloneTransitionResponse)(nil), "interchain_security.ccv.consumer.v1.MsgScheduleStandaloneTransitionResponse")
+	proto.RegisterType((*MsgReportMisbehaviour)(nil), "interchain_security.ccv.consumer.v1.MsgReportMisbehaviour")
+	proto.RegisterType((*MsgReportMisbehaviourResponse)(nil), "interchain_security.ccv.consumer.v1.MsgReportMisbehaviourResponse")
+}
+
+func init() {
+	proto.RegisterFile("interchain_security/ccv/consumer/v1/tx.proto", fileDescriptor_9d7049279494b73f)
+}
+
+var fileDescriptor_9d7049279494b73f = []byte{
+	// 796 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4d, 0x4f, 0x1b, 0x47,
+	0x18, 0xf6, 0x94, 0xd6, 0x94, 0xa1, 0xea, 0xc7, 0x96, 0x16, 0xb3, 0x05, 0x43, 0x8d, 0xda, 0x52,
+	0x54, 0x76, 0xb1, 0xa9, 0xfa, 0x81, 0x92, 0x28, 0x38, 0x51, 0x44, 0xa4, 0x58, 0x22, 0x36, 0x51,
+	0xa4, 0x5c, 0xac, 0xf1, 0xee, 0x68, 0x77, 0x14, 0xef, 0x8c, 0x35, 0x33, 0xbb, 0xc0, 0x2d, 0xe2,
+	0x07, 0x44, 0x39, 0xe4, 0x07, 0x44, 0x8a, 0x94, 0x33, 0x8a, 0xa2, 0x1c, 0xf2, 0x0b, 0x50, 0x4e,
+	0x28, 0xa7, 0x9c, 0xa2, 0x08, 0x14, 0xf1, 0x37, 0x22, 0xef, 0x8e, 0xd7, 0xdf, 0xb0, 0x31, 0xb9,
+	0xa0, 0x1d, 0xe6, 0x7d, 0x9e, 0xf7, 0x79, 0x9e, 0x19, 0xbf, 0x1a, 0xf8, 0x17, 0xa1, 0x12, 0x73,
+	0xcb, 0x45, 0x84, 0x56, 0x05, 0xb6, 0x7c, 0x4e, 0xe4, 0x9e, 0x69, 0x59, 0x81, 0x69, 0x31, 0x2a,
+	0x7c, 0x0f, 0x73, 0x33, 0xc8, 0x9b, 0x72, 0xd7, 0x68, 0x70, 0x26, 0x99, 0xb6, 0x38, 0xa0, 0xda,
+	0xb0, 0xac, 0xc0, 0x68, 0x55, 0x1b, 0x41, 0x5e, 0xff, 0x01, 0x79, 0x84, 0x32, 0x33, 0xfc, 0x1b,
+	0xe1, 0xf4, 0x59, 0x87, 0x31, 0xa7, 0x8e, 0x4d, 0xd4, 0x20, 0x26, 0xa2, 0x94, 0x49, 0x24, 0x09,
+	0xa3, 0x42, 0xed, 0x4e, 0x39, 0xcc, 0x61, 0xe1, 0xa7, 0xd9, 0xfc, 0x52, 0xff, 0x9d, 0xb1, 0x98,
+	0xf0, 0x98, 0xa8, 0x46, 0x1b, 0xd1, 0x42, 0x6d, 0x4d, 0x47, 0x2b, 0xd3, 0x13, 0x4e, 0x53, 0x9e,
+	0x27, 0x1c, 0xb5, 0xb1, 0x3a, 0xcc, 0x4d, 0x90, 0x37, 0x85, 0x8b, 0x38, 0xb6, 0xab, 0xb1, 0xd2,
+	0x08, 0x61, 0x92, 0x9a, 0x65, 0xd6, 0x89, 0xe3, 0x4a, 0xab, 0x4e, 0x30, 0x95, 0xc2, 0x94, 0x98,
+	0xda, 0x98, 0x7b, 0x84, 0xca, 0xd0, 0x7a, 0xbc, 0x52, 0x80, 0x42, 0x92, 0xc0, 0xba, 0x9b, 0xe4,
+	0x9e, 0x02, 0xf8, 0x5d, 0x49, 0x38, 0x77, 0x1a, 0x36, 0x92, 0x78, 0x0b, 0x71, 0xe4, 0x09, 0xed,
+	0x1f, 0x38, 0x81, 0x7c, 0xe9, 0xb2, 0x26, 0x3e, 0x03, 0x16, 0xc0, 0xd2, 0x44, 0x31, 0xf3, 0xe6,
+	0xc5, 0xca, 0x94, 0x32, 0xba, 0x61, 0xdb, 0x1c, 0x0b, 0x51, 0x91, 0x9c, 0x50, 0xa7, 0xdc, 0x2e,
+	0xd5, 0x36, 0x61, 0xba, 0x11, 0x32, 0x64, 0xbe, 0x58, 0x00, 0x4b, 0x93, 0x85, 0x65, 0x63, 0xd8,
+	0x99, 0x04, 0x79, 0xe3, 0x9a, 0xd2, 0x11, 0xf5, 0x2c, 0x7e, 0x79, 0xf8, 0x6e, 0x3e, 0x55, 0x56,
+	0xf8, 0xf5, 0x6f, 0xf7, 0x4f, 0x0f, 0x96, 0xdb, 0xcc, 0xb9, 0x19, 0x38, 0xdd, 0x23, 0xb2, 0x8c,
+	0x45, 0x83, 0x51, 0x81, 0x73, 0xdb, 0xf0, 0xc7, 0x92, 0x70, 0xca, 0x58, 0xf2, 0xbd, 0x4a, 0x1d,
+	0x09, 0x77, 0x0b, 0x59, 0xf7, 0xb1, 0xd4, 0x56, 0x61, 0x5a, 0x10, 0x87, 0x62, 0x7e, 0xae, 0x01,
+	0x55, 0xb7, 0x3e, 0xd9, 0xec, 0xa9, 0x16, 0xb9, 0x39, 0xf8, 0xcb, 0x00, 0xd6, 0xb8, 0xe9, 0x4b,
+	0x00, 0x67, 0x4a, 0xc2, 0xa9, 0x58, 0x2e, 0xb6, 0xfd, 0x3a, 0xde, 0xe2, 0x2c, 0x20, 0x36, 0xe6,
+	0x95, 0x1d, 0x22, 0x2d, 0x77, 0xe4, 0xfc, 0x6e, 0xc3, 0xb4, 0x08, 0x19, 0x54, 0x7e, 0x6b, 0x46,
+	0x82, 0x3b, 0x6d, 0x74, 0x37, 0x6f, 0x05, 0x19, 0x11, 0xf5, 0x05, 0xb9, 0x08, 0x7f, 0x1d, 0xaa,
+	0x3b, 0x76, 0x87, 0x43, 0xf3, 0x37, 0x29, 0x91, 0x04, 0x49, 0xdc, 0x3a, 0xa8, 0x8a, 0xeb, 0x4b,
+	0x9b, 0xed, 0xd0, 0x51, 0xed, 0xf5, 0x69, 0xf9, 0x0d, 0x2e, 0x9e, 0xd1, 0x26, 0x56, 0xf3, 0x1a,
+	0xc0, 0xf9, 0x0e, 0xcd, 0x15, 0x89, 0xa8, 0x8d, 0xea, 0x8c, 0xe2, 0x6d, 0x8e, 0xa8, 0x20, 0xcd,
+	0x5f, 0xeb, 0xc8, 0x89, 0x57, 0x21, 0x94, 0x31, 0x8b, 0x4a, 0xfd, 0xff, 0x44, 0xa9, 0x0f, 0x92,
+	0xa1, 0xb2, 0xef, 0xa0, 0xec, 0xf3, 0xfc, 0x27, 0xfc, 0xe3, 0x1c, 0x2f, 0xb1, 0xef, 0x0f, 0x00,
+	0xfe, 0x14, 0xde, 0xc1, 0x06, 0xe3, 0xb2, 0x44, 0x44, 0x0d, 0xbb, 0x28, 0x20, 0xcc, 0xe7, 0x4d,
+	0xb7, 0xc2, 0xaf, 0x79, 0x44, 0xca, 0x04, 0xd7, 0xbb, 0x5d, 0xaa, 0x6d, 0xc0, 0xaf, 0x5d, 0x8c,
+	0x6c, 0xcc, 0xab, 0x79, 0xe5, 0xf5, 0x77, 0x83, 0xd4, 0x2c, 0xa3, 0x73, 0xc6, 0x18, 0x1d, 0x53,
+	0x25, 0xc8, 0x1b, 0x9b, 0x61, 0x7d, 0x79, 0x3c, 0xc2, 0xe5, 0x3b, 0x28, 0x0a, 0x99, 0xb1, 0x51,
+	0x28, 0x0a, 0x2a, 0x92, 0x58, 0x55, 0x6e, 0x1e, 0xce, 0x0d, 0xb4, 0xd9, 0x0a, 0xa2, 0xf0, 0x6a,
+	0x1c, 0x8e, 0x95, 0x84, 0xa3, 0xed, 0x03, 0xf8, 0x4d, 0xd7, 0x9c, 0xfa, 0x3b, 0xd1, 0x49, 0xf5,
+	0x0c, 0x0e, 0xfd, 0xd2, 0x28, 0xa8, 0x96, 0x18, 0xed, 0x21, 0x80, 0xdf, 0xf7, 0x0d, 0x9b, 0xff,
+	0x92, 0x52, 0xf6, 0x22, 0xf5, 0xab, 0xa3, 0x22, 0x63, 0x41, 0x4f, 0x00, 0xfc, 0x79, 0xc8, 0x1c,
+	0xba, 0x92, 0x94, 0x7c, 0x30, 0x5e, 0xbf, 0x71, 0x31, 0x7c, 0x2c, 0xf1, 0x19, 0x80, 0x99, 0xa1,
+	0xd3, 0x24, 0x71, 0x02, 0xc3, 0x18, 0xf4, 0xcd, 0x8b, 0x32, 0xc4, 0x42, 0x9f, 0x03, 0x38, 0x7b,
+	0xe6, 0x9c, 0xb9, 0xfe, 0xa9, 0x89, 0x0c, 0x62, 0xd1, 0x6f, 0x7d, 0x0e, 0x96, 0x58, 0xf4, 0x63,
+	0x00, 0xb5, 0x01, 0x43, 0x62, 0x3d, 0xf9, 0xcd, 0xea, 0xc5, 0xea, 0xc5, 0xd1, 0xb1, 0x2d, 0x59,
+	0xfa, 0x57, 0x0f, 0x4e, 0x0f, 0x96, 0x41, 0xf1, 0xee, 0xe1, 0x71, 0x16, 0x1c, 0x1d, 0x67, 0xc1,
+	0xfb, 0xe3, 0x2c, 0x78, 0x74, 0x92, 0x4d, 0x1d, 0x9d, 0x64, 0x53, 0x6f, 0x4f, 0xb2, 0xa9, 0x7b,
+	0x97, 0x1d, 0x22, 0x5d, 0xbf, 0x66, 0x58, 0xcc, 0x53, 0x4f, 0x28, 0xb3, 0xdd, 0x75, 0x25, 0x7e,
+	0xbf, 0x04, 0xff, 0x9a, 0xbb, 0xdd, 0x8f, 0x18, 0xb9, 0xd7, 0xc0, 0xa2, 0x96, 0x0e, 0xdf, 0x2f,
+	0x6b, 0x1f, 0x07, 0x00, 0x46, 0x4b, 0x05, 0xa0, 0x26, 0x0a, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	RetrySlashPacket(ctx context.Context, in *MsgRetrySlashPacket, opts ...grpc.CallOption) (*MsgRetrySlashPacketResponse, error)
+	ScheduleProviderSwitch(ctx context.Context, in *MsgScheduleProviderSwitch, opts ...grpc.CallOption) (*MsgScheduleProviderSwitchResponse, error)
+	InitiateConsumerShutdown(ctx context.Context, in *MsgInitiateConsumerShutdown, opts ...grpc.CallOption) (*MsgInitiateConsumerShutdownResponse, error)
+	ScheduleStandaloneTransition(ctx context.Context, in *MsgScheduleStandaloneTransition, opts ...grpc.CallOption) (*MsgScheduleStandaloneTransitionResponse, error)
+	ReportMisbehaviour(ctx context.Context, in *MsgReportMisbehaviour, opts ...grpc.CallOption) (*MsgReportMisbehaviourResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.consumer.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RetrySlashPacket(ctx context.Context, in *MsgRetrySlashPacket, opts ...grpc.CallOption) (*MsgRetrySlashPacketResponse, error) {
+	out := new(MsgRetrySlashPacketResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.consumer.v1.Msg/RetrySlashPacket", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ScheduleProviderSwitch(ctx context.Context, in *MsgScheduleProviderSwitch, opts ...grpc.CallOption) (*MsgScheduleProviderSwitchResponse, error) {
+	out := new(MsgScheduleProviderSwitchResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.consumer.v1.Msg/ScheduleProviderSwitch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) InitiateConsumerShutdown(ctx context.Context, in *MsgInitiateConsumerShutdown, opts ...grpc.CallOption) (*MsgInitiateConsumerShutdownResponse, error) {
+	out := new(MsgInitiateConsumerShutdownResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.consumer.v1.Msg/InitiateConsumerShutdown", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ScheduleStandaloneTransition(ctx context.Context, in *MsgScheduleStandaloneTransition, opts ...grpc.CallOption) (*MsgScheduleStandaloneTransitionResponse, error) {
+	out := new(MsgScheduleStandaloneTransitionResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.consumer.v1.Msg/ScheduleStandaloneTransition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ReportMisbehaviour(ctx context.Context, in *MsgReportMisbehaviour, opts ...grpc.CallOption) (*MsgReportMisbehaviourResponse, error) {
+	out := new(MsgReportMisbehaviourResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.consumer.v1.Msg/ReportMisbehaviour", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	RetrySlashPacket(context.Context, *MsgRetrySlashPacket) (*MsgRetrySlashPacketResponse, error)
+	ScheduleProviderSwitch(context.Context, *MsgScheduleProviderSwitch) (*MsgScheduleProviderSwitchResponse, error)
+	InitiateConsumerShutdown(context.Context, *MsgInitiateConsumerShutdown) (*MsgInitiateConsumerShutdownResponse, error)
+	ScheduleStandaloneTransition(context.Context, *MsgScheduleStandaloneTransition) (*MsgScheduleStandaloneTransitionResponse, error)
+	ReportMisbehaviour(context.Context, *MsgReportMisbehaviour) (*MsgReportMisbehaviourResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) RetrySlashPacket(ctx context.Context, req *MsgRetrySlashPacket) (*MsgRetrySlashPacketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetrySlashPacket not implemented")
+}
+func (*UnimplementedMsgServer) ScheduleProviderSwitch(ctx context.Context, req *MsgScheduleProviderSwitch) (*MsgScheduleProviderSwitchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleProviderSwitch not implemented")
+}
+func (*UnimplementedMsgServer) InitiateConsumerShutdown(ctx context.Context, req *MsgInitiateConsumerShutdown) (*MsgInitiateConsumerShutdownResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InitiateConsumerShutdown not implemented")
+}
+func (*UnimplementedMsgServer) ScheduleStandaloneTransition(ctx context.Context, req *MsgScheduleStandaloneTransition) (*MsgScheduleStandaloneTransitionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleStandaloneTransition not implemented")
+}
+func (*UnimplementedMsgServer) ReportMisbehaviour(ctx context.Context, req *MsgReportMisbehaviour) (*MsgReportMisbehaviourResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportMisbehaviour not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.consumer.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RetrySlashPacket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRetrySlashPacket)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RetrySlashPacket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.consumer.v1.Msg/RetrySlashPacket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RetrySlashPacket(ctx, req.(*MsgRetrySlashPacket))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ScheduleProviderSwitch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleProviderSwitch)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleProviderSwitch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.consumer.v1.Msg/ScheduleProviderSwitch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleProviderSwitch(ctx, req.(*MsgScheduleProviderSwitch))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_InitiateConsumerShutdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgInitiateConsumerShutdown)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).InitiateConsumerShutdown(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.consumer.v1.Msg/InitiateConsumerShutdown",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).InitiateConsumerShutdown(ctx, req.(*MsgInitiateConsumerShutdown))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ScheduleStandaloneTransition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleStandaloneTransition)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleStandaloneTransition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.consumer.v1.Msg/ScheduleStandaloneTransition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleStandaloneTransition(ctx, req.(*MsgScheduleStandaloneTransition))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReportMisbehaviour_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReportMisbehaviour)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReportMisbehaviour(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.consumer.v1.Msg/ReportMisbehaviour",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReportMisbehaviour(ctx, req.(*MsgReportMisbehaviour))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "interchain_security.ccv.consumer.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "RetrySlashPacket",
+			Handler:    _Msg_RetrySlashPacket_Handler,
+		},
+		{
+			MethodName: "ScheduleProviderSwitch",
+			Handler:    _Msg_ScheduleProviderSwitch_Handler,
+		},
+		{
+			MethodName: "InitiateConsumerShutdown",
+			Handler:    _Msg_InitiateConsumerShutdown_Handler,
+		},
+		{
+			MethodName: "ScheduleStandaloneTransition",
+			Handler:    _Msg_ScheduleStandaloneTransition_Handler,
+		},
+		{
+			MethodName: "ReportMisbehaviour",
+			Handler:    _Msg_ReportMisbehaviour_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "interchain_security/ccv/consumer/v1/tx.proto",
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRetrySlashPacket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRetrySlashPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRetrySlashPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRetrySlashPacketResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRetrySlashPacketResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRetrySlashPacketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgScheduleProviderSwitch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleProviderSwitch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleProviderSwitch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Switch.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgScheduleProviderSwitchResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleProviderSwitchResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleProviderSwitchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgInitiateConsumerShutdown) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgInitiateConsumerShutdown) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInitiateConsumerShutdown) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgInitiateConsumerShutdownResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgInitiateConsumerShutdownResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInitiateConsumerShutdownResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgScheduleStandaloneTransition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleStandaloneTransition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleStandaloneTransition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Transition.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgScheduleStandaloneTransitionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleStandaloneTransitionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleStandaloneTransitionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReportMisbehaviour) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReportMisbehaviour) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReportMisbehaviour) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Header_2 != nil {
+		{
+			size, err := m.Header_2.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Header_1 != nil {
+		{
+			size, err := m.Header_1.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Submitter) > 0 {
+		i -= len(m.Submitter)
+		copy(dAtA[i:], m.Submitter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Submitter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReportMisbehaviourResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReportMisbehaviourResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReportMisbehaviourResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRetrySlashPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRetrySlashPacketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgScheduleProviderSwitch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Switch.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgScheduleProviderSwitchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgInitiateConsumerShutdown) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgInitiateConsumerShutdownResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgScheduleStandaloneTransition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Transition.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgScheduleStandaloneTransitionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgReportMisbehaviour) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Submitter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Header_1 != nil {
+		l = m.Header_1.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Header_2 != nil {
+		l = m.Header_2.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgReportMisbehaviourResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRetrySlashPacket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRetrySlashPacket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRetrySlashPacket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRetrySlashPacketResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRetrySlashPacketResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRetrySlashPacketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgScheduleProviderSwitch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgScheduleProviderSwitch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgScheduleProviderSwitch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Switch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Switch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgScheduleProviderSwitchResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgScheduleProviderSwitchResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgScheduleProviderSwitchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgInitiateConsumerShutdown) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInitiateConsumerShutdown: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInitiateConsumerShutdown: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgInitiateConsumerShutdownResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgInitiateConsumerShutdownResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgInitiateConsumerShutdownResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return len(dAtA) - i, nil
-}
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return nil
 }
+func (m *MsgScheduleStandaloneTransition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgScheduleStandaloneTransition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgScheduleStandaloneTransition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transition", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Transition.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgScheduleStandaloneTransitionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgScheduleStandaloneTransitionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgScheduleStandaloneTransitionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgReportMisbehaviour) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -370,15 +2244,15 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReportMisbehaviour: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReportMisbehaviour: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -406,11 +2280,11 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Submitter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Header_1", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -437,7 +2311,46 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Header_1 == nil {
+				m.Header_1 = &_07_tendermint.Header{}
+			}
+			if err := m.Header_1.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Header_2", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Header_2 == nil {
+				m.Header_2 = &_07_tendermint.Header{}
+			}
+			if err := m.Header_2.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -462,7 +2375,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgReportMisbehaviourResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -485,10 +2398,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReportMisbehaviourResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReportMisbehaviourResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -41,6 +41,14 @@ type AppModule struct {
 	keeper     keeper.Keeper
 	accKeeper  types.AccountKeeper
 	bankKeeper types.BankKeeper
+
+	// consumerKeeper is used to determine whether the consumer chain transitioned to a standalone chain
+	consumerKeeper ConsumerKeeper
+}
+
+// ConsumerKeeper defines the expected interface of the ccv consumer keeper
+type ConsumerKeeper interface {
+	IsStandaloneChain(ctx sdk.Context) bool
 }
 
 // NewAppModule creates a new AppModule object using the native x/staking module
@@ -55,6 +63,13 @@ func NewAppModule(cdc codec.Codec, keeper *keeper.Keeper, ak types.AccountKeeper
 	}
 }
 
+// WithConsumerKeeper returns a copy of the AppModule that returns the validator updates
+// of the underlying x/staking module once the consumer chain transitioned to a standalone chain.
+func (am AppModule) WithConsumerKeeper(ck ConsumerKeeper) AppModule {
+	am.consumerKeeper = ck
+	return am
+}
+
 // InitGenesis delegates the InitGenesis call to the underlying x/staking module,
 // however, it returns no validator updates as validators are tracked via the
 // consumer chain's x/cvv/consumer module and so this module is not responsible
@@ -82,7 +97,15 @@ func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, data json.
 // The ccv consumer Endblocker is ordered to run before the staking Endblocker,
 // so if PreCCV is true during one block, the ccv consumer Enblocker will return the proper validator updates,
 // the PreCCV flag will be toggled to false, and no validator updates should be returned by this method.
+//
+// Once the consumer chain transitioned to a standalone chain (see WithConsumerKeeper),
+// the validator updates of the underlying x/staking module are returned.
+// The ccv consumer Endblocker returns the initial validator set of the standalone chain
+// in the block of the transition, and then no longer returns validator updates.
 func (am AppModule) EndBlock(ctx context.Context) ([]abci.ValidatorUpdate, error) {
+	if am.consumerKeeper != nil && am.consumerKeeper.IsStandaloneChain(sdk.UnwrapSDKContext(ctx)) {
+		return am.keeper.BlockValidatorUpdates(ctx)
+	}
 	_, _ = am.keeper.BlockValidatorUpdates(ctx)
 	return []abci.ValidatorUpdate{}, nil
 }
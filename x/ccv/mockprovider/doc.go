@@ -0,0 +1,21 @@
+/*
+Package mockprovider implements a lightweight mock of the provider side of the
+CCV protocol, meant for developing consumer chains without running a full
+provider chain.
+
+The module binds the provider port and implements just enough of the protocol:
+
+  - it accepts the CCV channel handshake initiated by a consumer chain;
+  - every epoch, it sends a VSCPacket with the changes of a static validator set
+    read from a JSON file;
+  - it handles the slash packets sent by the consumer chain by jailing the
+    validator, i.e., removing it from the validator set for a number of blocks,
+    and acknowledging the slash in the next VSCPacket.
+
+It also serves the `QueryConsumerGenesis` query of the provider module, so that
+the genesis of the consumer chain can be obtained the same way as on a real
+provider chain.
+
+The module MUST NOT be used in production.
+*/
+package mockprovider
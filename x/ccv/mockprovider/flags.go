@@ -0,0 +1,46 @@
+package mockprovider
+
+import (
+	"path/filepath"
+
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+)
+
+const (
+	FlagValsetFile     = "mockprovider.valset-file"
+	FlagBlocksPerEpoch = "mockprovider.blocks-per-epoch"
+	FlagJailBlocks     = "mockprovider.jail-blocks"
+
+	// DefaultValsetFile is the default path of the validator set file, relative to the node home
+	DefaultValsetFile = "config/mock_valset.json"
+)
+
+// AddModuleInitFlags adds the mock provider flags to the start command
+func AddModuleInitFlags(startCmd *cobra.Command) {
+	startCmd.Flags().String(FlagValsetFile, "", "JSON file with the validator set of the consumer chain (default <home>/"+DefaultValsetFile+")")
+	startCmd.Flags().Int64(FlagBlocksPerEpoch, DefaultBlocksPerEpoch, "Number of blocks between two VSCPackets sent to the consumer chain")
+	startCmd.Flags().Int64(FlagJailBlocks, DefaultJailBlocks, "Number of blocks a validator slashed for downtime is removed from the validator set")
+}
+
+// ConfigFromAppOptions returns the mock provider config set through the app options
+func ConfigFromAppOptions(appOpts servertypes.AppOptions) Config {
+	config := Config{
+		ValsetFile:     cast.ToString(appOpts.Get(FlagValsetFile)),
+		BlocksPerEpoch: cast.ToInt64(appOpts.Get(FlagBlocksPerEpoch)),
+		JailBlocks:     DefaultJailBlocks,
+	}
+	if config.ValsetFile == "" {
+		config.ValsetFile = filepath.Join(cast.ToString(appOpts.Get(flags.FlagHome)), DefaultValsetFile)
+	}
+	if config.BlocksPerEpoch == 0 {
+		config.BlocksPerEpoch = DefaultBlocksPerEpoch
+	}
+	if jailBlocks := appOpts.Get(FlagJailBlocks); jailBlocks != nil {
+		config.JailBlocks = cast.ToInt64(jailBlocks)
+	}
+	return config
+}
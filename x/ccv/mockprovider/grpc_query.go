@@ -0,0 +1,42 @@
+package mockprovider
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+	ccvtypes "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+var _ providertypes.QueryServer = &queryServer{}
+
+// queryServer serves the subset of the provider queries needed
+// to start a consumer chain against the mock provider
+type queryServer struct {
+	providertypes.UnimplementedQueryServer
+	keeper Keeper
+}
+
+// QueryConsumerGenesis returns the genesis state of the consumer chain
+// with the given consumer id
+func (q *queryServer) QueryConsumerGenesis(c context.Context, req *providertypes.QueryConsumerGenesisRequest) (*providertypes.QueryConsumerGenesisResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if err := ccvtypes.ValidateConsumerId(req.ConsumerId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	gen, err := q.keeper.MakeConsumerGenesis(ctx, req.ConsumerId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &providertypes.QueryConsumerGenesisResponse{GenesisState: gen}, nil
+}
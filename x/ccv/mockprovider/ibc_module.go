@@ -0,0 +1,200 @@
+package mockprovider
+
+import (
+	"fmt"
+
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// OnChanOpenInit implements the IBCModule interface
+func (am AppModule) OnChanOpenInit(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID string,
+	channelID string,
+	counterparty channeltypes.Counterparty,
+	version string,
+) (string, error) {
+	return version, errorsmod.Wrap(ccv.ErrInvalidChannelFlow, "channel handshake must be initiated by consumer chain")
+}
+
+// OnChanOpenTry implements the IBCModule interface
+func (am AppModule) OnChanOpenTry(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID,
+	channelID string,
+	counterparty channeltypes.Counterparty,
+	counterpartyVersion string,
+) (metadata string, err error) {
+	if order != channeltypes.ORDERED {
+		return "", errorsmod.Wrapf(channeltypes.ErrInvalidChannelOrdering, "expected %s channel, got %s ", channeltypes.ORDERED, order)
+	}
+	if portID != ccv.ProviderPortID {
+		return "", errorsmod.Wrapf(porttypes.ErrInvalidPort, "invalid port: %s, expected %s", portID, ccv.ProviderPortID)
+	}
+	if counterparty.PortId != ccv.ConsumerPortID {
+		return "", errorsmod.Wrapf(porttypes.ErrInvalidPort,
+			"invalid counterparty port: %s, expected %s", counterparty.PortId, ccv.ConsumerPortID)
+	}
+	if counterpartyVersion != ccv.Version {
+		return "", errorsmod.Wrapf(
+			ccv.ErrInvalidVersion, "invalid counterparty version: got: %s, expected %s",
+			counterpartyVersion, ccv.Version)
+	}
+
+	// the mock provider serves a single consumer chain
+	if channelId, found := am.keeper.GetChannelId(ctx); found {
+		return "", errorsmod.Wrapf(ccv.ErrDuplicateChannel, "CCV channel already established: %s", channelId)
+	}
+
+	md := ccv.HandshakeMetadata{
+		ProviderFeePoolAddr: authtypes.NewModuleAddress(ModuleName).String(),
+		Version:             ccv.Version,
+	}
+	mdBz, err := (&md).Marshal()
+	if err != nil {
+		return "", errorsmod.Wrapf(ccv.ErrInvalidHandshakeMetadata,
+			"error marshalling ibc-try metadata: %v", err)
+	}
+	return string(mdBz), nil
+}
+
+// OnChanOpenAck implements the IBCModule interface
+func (am AppModule) OnChanOpenAck(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	counterpartyChannelID string,
+	counterpartyVersion string,
+) error {
+	return errorsmod.Wrap(ccv.ErrInvalidChannelFlow, "channel handshake must be initiated by consumer chain")
+}
+
+// OnChanOpenConfirm implements the IBCModule interface
+func (am AppModule) OnChanOpenConfirm(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	if channelId, found := am.keeper.GetChannelId(ctx); found {
+		return errorsmod.Wrapf(ccv.ErrDuplicateChannel, "CCV channel already established: %s", channelId)
+	}
+	am.keeper.SetChannelId(ctx, channelID)
+	am.keeper.Logger(ctx).Info("CCV channel established", "channelID", channelID)
+	return nil
+}
+
+// OnChanCloseInit implements the IBCModule interface
+func (am AppModule) OnChanCloseInit(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	// Disallow user-initiated channel closing for provider channels
+	return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "user cannot close channel")
+}
+
+// OnChanCloseConfirm implements the IBCModule interface
+func (am AppModule) OnChanCloseConfirm(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) error {
+	if channelId, found := am.keeper.GetChannelId(ctx); found && channelId == channelID {
+		am.keeper.DeleteChannelId(ctx)
+	}
+	return nil
+}
+
+// OnRecvPacket implements the IBCModule interface
+func (am AppModule) OnRecvPacket(
+	ctx sdk.Context,
+	_ string,
+	packet channeltypes.Packet,
+	_ sdk.AccAddress,
+) ibcexported.Acknowledgement {
+	logger := am.keeper.Logger(ctx)
+
+	consumerPacket, err := ccv.UnmarshalConsumerPacketData(packet.GetData())
+	if err != nil {
+		err = errorsmod.Wrapf(sdkerrors.ErrInvalidType, "cannot unmarshal ConsumerPacket data")
+		logger.Error(fmt.Sprintf("%s sequence %d", err.Error(), packet.Sequence))
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	ackResult := ccv.V1Result
+	switch consumerPacket.Type {
+	case ccv.VscMaturedPacket:
+		// ignore VSCMaturedPacket
+	case ccv.ConsumerShutdownPacket:
+		// ignore ConsumerShutdownPacket, as there is no consumer chain to stop
+	case ccv.ConsumerMisbehaviourPacket:
+		// ignore ConsumerMisbehaviourPacket, as there is no client to the consumer chain
+	case ccv.ConsumerHeartbeatPacket:
+		// ignore ConsumerHeartbeatPacket, as there are no key assignments
+	case ccv.ConsumerDoubleVotePacket:
+		// ignore ConsumerDoubleVotePacket, as double-signing is ignored as for slash packets
+	case ccv.SlashPacket:
+		ackResult, err = am.keeper.OnRecvSlashPacket(ctx, *consumerPacket.GetSlashPacketData())
+	default:
+		err = fmt.Errorf("invalid consumer packet type: %q", consumerPacket.Type)
+	}
+	if err != nil {
+		logger.Error(fmt.Sprintf("%s sequence %d", err.Error(), packet.Sequence))
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	return channeltypes.NewResultAcknowledgement(ackResult)
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface
+func (am AppModule) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	_ string,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	_ sdk.AccAddress,
+) error {
+	var ack channeltypes.Acknowledgement
+	if err := ccv.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal provider packet acknowledgement: %v", err)
+	}
+
+	// the consumer chain is not removed on error acknowledgements, as the provider would do;
+	// the error is only logged to help debugging the consumer chain
+	if resp, ok := ack.Response.(*channeltypes.Acknowledgement_Error); ok {
+		am.keeper.Logger(ctx).Error("received error acknowledgement for VSCPacket",
+			"sequence", packet.Sequence,
+			"error", resp.Error,
+		)
+	}
+
+	return nil
+}
+
+// OnTimeoutPacket implements the IBCModule interface
+func (am AppModule) OnTimeoutPacket(
+	ctx sdk.Context,
+	_ string,
+	packet channeltypes.Packet,
+	_ sdk.AccAddress,
+) error {
+	am.keeper.Logger(ctx).Error("VSCPacket timed out, the CCV channel is closed",
+		"sequence", packet.Sequence,
+	)
+	am.keeper.DeleteChannelId(ctx)
+	return nil
+}
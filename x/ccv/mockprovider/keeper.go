@@ -0,0 +1,430 @@
+package mockprovider
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v10/modules/core/23-commitment/types"
+	ibctmtypes "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+const (
+	// ModuleName defines the name of the mock provider module
+	ModuleName = "mockprovider"
+
+	// StoreKey is the store key string of the mock provider module
+	StoreKey = ModuleName
+
+	// DefaultBlocksPerEpoch is the default number of blocks between two VSCPackets
+	DefaultBlocksPerEpoch = int64(10)
+
+	// DefaultJailBlocks is the default number of blocks a validator is removed
+	// from the validator set after a downtime slash packet
+	DefaultJailBlocks = int64(100)
+)
+
+const (
+	// ChannelIdKeyName is the key name of the ID of the CCV channel
+	ChannelIdKeyName byte = iota
+
+	// ValidatorSetUpdateIdKeyName is the key name of the ID of the last sent VSCPacket
+	ValidatorSetUpdateIdKeyName
+
+	// ValidatorKeyName is the key prefix of the validators of the last sent validator set
+	ValidatorKeyName
+
+	// SlashAckKeyName is the key prefix of the slash acknowledgements to send in the next VSCPacket
+	SlashAckKeyName
+
+	// JailedValidatorKeyName is the key prefix of the height until which a validator is jailed
+	JailedValidatorKeyName
+)
+
+// Config is the configuration of the mock provider
+type Config struct {
+	// ValsetFile is the path of the JSON file with the validator set of the consumer chain
+	ValsetFile string
+	// BlocksPerEpoch is the number of blocks between two VSCPackets
+	BlocksPerEpoch int64
+	// JailBlocks is the number of blocks a validator is removed from the validator set
+	// after a downtime slash packet
+	JailBlocks int64
+}
+
+// Keeper defines the mock provider keeper
+type Keeper struct {
+	storeKey      storetypes.StoreKey
+	cdc           codec.Codec
+	channelKeeper ccv.ChannelKeeper
+	stakingKeeper ccv.StakingKeeper
+	config        Config
+}
+
+// NewKeeper creates a new mock provider keeper
+func NewKeeper(
+	cdc codec.Codec, key storetypes.StoreKey, channelKeeper ccv.ChannelKeeper,
+	stakingKeeper ccv.StakingKeeper, config Config,
+) Keeper {
+	if config.BlocksPerEpoch <= 0 {
+		panic(fmt.Sprintf("invalid blocks per epoch: %d", config.BlocksPerEpoch))
+	}
+	if config.JailBlocks < 0 {
+		panic(fmt.Sprintf("invalid jail blocks: %d", config.JailBlocks))
+	}
+
+	return Keeper{
+		storeKey:      key,
+		cdc:           cdc,
+		channelKeeper: channelKeeper,
+		stakingKeeper: stakingKeeper,
+		config:        config,
+	}
+}
+
+// Logger returns a module-specific logger
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+ModuleName)
+}
+
+// SetChannelId sets the ID of the CCV channel
+func (k Keeper) SetChannelId(ctx sdk.Context, channelId string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set([]byte{ChannelIdKeyName}, []byte(channelId))
+}
+
+// GetChannelId returns the ID of the CCV channel, if it is established
+func (k Keeper) GetChannelId(ctx sdk.Context) (string, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get([]byte{ChannelIdKeyName})
+	if bz == nil {
+		return "", false
+	}
+	return string(bz), true
+}
+
+// DeleteChannelId deletes the ID of the CCV channel
+func (k Keeper) DeleteChannelId(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete([]byte{ChannelIdKeyName})
+}
+
+// SetValidatorSetUpdateId sets the ID of the last sent VSCPacket
+func (k Keeper) SetValidatorSetUpdateId(ctx sdk.Context, valUpdateID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, valUpdateID)
+	store.Set([]byte{ValidatorSetUpdateIdKeyName}, bz)
+}
+
+// GetValidatorSetUpdateId returns the ID of the last sent VSCPacket
+func (k Keeper) GetValidatorSetUpdateId(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get([]byte{ValidatorSetUpdateIdKeyName})
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetValidator stores a validator of the last sent validator set
+func (k Keeper) SetValidator(ctx sdk.Context, consAddr sdk.ConsAddress, validator abci.ValidatorUpdate) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := validator.Marshal()
+	if err != nil {
+		// An error here would indicate something is very wrong,
+		// the validator update is assumed to be correctly serialized
+		panic(fmt.Errorf("failed to marshal validator update: %w", err))
+	}
+	store.Set(append([]byte{ValidatorKeyName}, consAddr...), bz)
+}
+
+// DeleteValidator deletes a validator of the last sent validator set
+func (k Keeper) DeleteValidator(ctx sdk.Context, consAddr sdk.ConsAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(append([]byte{ValidatorKeyName}, consAddr...))
+}
+
+// GetAllValidators returns the validators of the last sent validator set,
+// ordered by consensus address
+func (k Keeper) GetAllValidators(ctx sdk.Context) []abci.ValidatorUpdate {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{ValidatorKeyName})
+	defer iterator.Close()
+
+	validators := []abci.ValidatorUpdate{}
+	for ; iterator.Valid(); iterator.Next() {
+		var validator abci.ValidatorUpdate
+		if err := validator.Unmarshal(iterator.Value()); err != nil {
+			// An error here would indicate something is very wrong,
+			// the validator update is assumed to be correctly serialized in SetValidator
+			panic(fmt.Errorf("failed to unmarshal validator update: %w", err))
+		}
+		validators = append(validators, validator)
+	}
+	return validators
+}
+
+// AppendSlashAck adds a slash acknowledgement to send in the next VSCPacket
+func (k Keeper) AppendSlashAck(ctx sdk.Context, consAddr sdk.ConsAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(append([]byte{SlashAckKeyName}, consAddr...), []byte{})
+}
+
+// ConsumeSlashAcks returns and deletes the slash acknowledgements to send in the next VSCPacket
+func (k Keeper) ConsumeSlashAcks(ctx sdk.Context) []string {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{SlashAckKeyName})
+
+	keys := [][]byte{}
+	acks := []string{}
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+		acks = append(acks, sdk.ConsAddress(iterator.Key()[1:]).String())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	return acks
+}
+
+// SetJailedUntil sets the height until which a validator is removed from the validator set
+func (k Keeper) SetJailedUntil(ctx sdk.Context, consAddr sdk.ConsAddress, height int64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, uint64(height))
+	store.Set(append([]byte{JailedValidatorKeyName}, consAddr...), bz)
+}
+
+// IsJailed returns true if the validator is removed from the validator set at the current height.
+// The record of a validator whose jailing expired is deleted.
+func (k Keeper) IsJailed(ctx sdk.Context, consAddr sdk.ConsAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	key := append([]byte{JailedValidatorKeyName}, consAddr...)
+	bz := store.Get(key)
+	if bz == nil {
+		return false
+	}
+	if int64(binary.BigEndian.Uint64(bz)) <= ctx.BlockHeight() {
+		store.Delete(key)
+		return false
+	}
+	return true
+}
+
+// ComputeValidatorUpdates returns the changes between the last sent validator set and `validators`,
+// without the jailed validators, and stores the resulting validator set as the last sent one
+func (k Keeper) ComputeValidatorUpdates(ctx sdk.Context, validators []abci.ValidatorUpdate) ([]abci.ValidatorUpdate, error) {
+	nextValidators := map[string]abci.ValidatorUpdate{}
+	nextConsAddrs := []sdk.ConsAddress{}
+	for _, validator := range validators {
+		consAddr, err := ConsAddrFromValidatorUpdate(validator)
+		if err != nil {
+			return nil, err
+		}
+		if k.IsJailed(ctx, consAddr) {
+			continue
+		}
+		nextValidators[consAddr.String()] = validator
+		nextConsAddrs = append(nextConsAddrs, consAddr)
+	}
+
+	updates := []abci.ValidatorUpdate{}
+	lastPowers := map[string]int64{}
+	for _, validator := range k.GetAllValidators(ctx) {
+		consAddr, err := ConsAddrFromValidatorUpdate(validator)
+		if err != nil {
+			return nil, err
+		}
+		lastPowers[consAddr.String()] = validator.Power
+		if _, found := nextValidators[consAddr.String()]; !found {
+			// the validator is removed from the validator set
+			updates = append(updates, abci.ValidatorUpdate{PubKey: validator.PubKey, Power: 0})
+			k.DeleteValidator(ctx, consAddr)
+		}
+	}
+
+	for _, consAddr := range nextConsAddrs {
+		validator := nextValidators[consAddr.String()]
+		if lastPower, found := lastPowers[consAddr.String()]; !found || lastPower != validator.Power {
+			updates = append(updates, validator)
+			k.SetValidator(ctx, consAddr, validator)
+		}
+	}
+
+	return updates, nil
+}
+
+// SendValidatorSetChange sends to the consumer chain a VSCPacket with the changes of the validator set
+// read from the validator set file and the pending slash acknowledgements. No packet is sent if there
+// are no changes to send.
+func (k Keeper) SendValidatorSetChange(ctx sdk.Context, channelId string) error {
+	validators, err := LoadValidatorSet(k.cdc, k.config.ValsetFile)
+	if err != nil {
+		return err
+	}
+
+	// the state is only updated if the VSCPacket is sent
+	cacheCtx, writeCache := ctx.CacheContext()
+	valUpdates, err := k.ComputeValidatorUpdates(cacheCtx, validators)
+	if err != nil {
+		return err
+	}
+	slashAcks := k.ConsumeSlashAcks(cacheCtx)
+	if len(valUpdates) == 0 && len(slashAcks) == 0 {
+		return nil
+	}
+
+	valUpdateID := k.GetValidatorSetUpdateId(cacheCtx) + 1
+	data := ccv.NewValidatorSetChangePacketData(ccv.NewValsetDiff(valUpdates...), valUpdateID, slashAcks)
+	if _, _, err := ccv.SendIBCPacket(
+		cacheCtx,
+		k.channelKeeper,
+		channelId,
+		ccv.ProviderPortID,
+		data.GetBytes(),
+		ccv.DefaultCCVTimeoutPeriod,
+	); err != nil {
+		return fmt.Errorf("sending VSCPacket: %w", err)
+	}
+	k.SetValidatorSetUpdateId(cacheCtx, valUpdateID)
+	writeCache()
+
+	k.Logger(ctx).Info("VSCPacket sent",
+		"vscID", valUpdateID,
+		"len updates", len(valUpdates),
+		"len slash acks", len(slashAcks),
+	)
+	return nil
+}
+
+// EndBlock sends a VSCPacket to the consumer chain at the end of every epoch
+func (k Keeper) EndBlock(ctx sdk.Context) {
+	if ctx.BlockHeight()%k.config.BlocksPerEpoch != 0 {
+		return
+	}
+
+	channelId, found := k.GetChannelId(ctx)
+	if !found {
+		return
+	}
+
+	// do not halt the mock provider chain, e.g., if the validator set file is being edited
+	if err := k.SendValidatorSetChange(ctx, channelId); err != nil {
+		k.Logger(ctx).Error("cannot send VSCPacket", "error", err.Error())
+	}
+}
+
+// OnRecvSlashPacket handles a slash packet sent by the consumer chain. A validator slashed for downtime
+// is removed from the validator set for `JailBlocks` blocks. Slash packets for double signing are ignored,
+// as on the provider chain.
+func (k Keeper) OnRecvSlashPacket(ctx sdk.Context, data ccv.SlashPacketData) (ccv.PacketAckResult, error) {
+	if err := data.Validate(); err != nil {
+		return nil, err
+	}
+
+	consAddr := sdk.ConsAddress(data.Validator.Address)
+	if data.Infraction == stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN {
+		k.Logger(ctx).Info("SlashPacket received for double-signing, ignoring it",
+			"consumer cons addr", consAddr.String(),
+			"vscID", data.ValsetUpdateId,
+		)
+		return ccv.V1Result, nil
+	}
+
+	jailedUntil := ctx.BlockHeight() + k.config.JailBlocks
+	k.SetJailedUntil(ctx, consAddr, jailedUntil)
+	k.AppendSlashAck(ctx, consAddr)
+
+	k.Logger(ctx).Info("SlashPacket received for downtime, validator removed from the validator set",
+		"consumer cons addr", consAddr.String(),
+		"vscID", data.ValsetUpdateId,
+		"jailed until height", jailedUntil,
+	)
+
+	return ccv.SlashPacketHandledResult, nil
+}
+
+// MakeConsumerGenesis returns the genesis state of a consumer chain validated by
+// the validators of the validator set file that are not jailed
+func (k Keeper) MakeConsumerGenesis(ctx sdk.Context, consumerId string) (ccv.ConsumerGenesisState, error) {
+	validators, err := LoadValidatorSet(k.cdc, k.config.ValsetFile)
+	if err != nil {
+		return ccv.ConsumerGenesisState{}, err
+	}
+	initialValSet := []abci.ValidatorUpdate{}
+	for _, validator := range validators {
+		consAddr, err := ConsAddrFromValidatorUpdate(validator)
+		if err != nil {
+			return ccv.ConsumerGenesisState{}, err
+		}
+		if !k.IsJailed(ctx, consAddr) {
+			initialValSet = append(initialValSet, validator)
+		}
+	}
+
+	unbondingPeriod, err := k.stakingKeeper.UnbondingTime(ctx)
+	if err != nil {
+		return ccv.ConsumerGenesisState{}, fmt.Errorf("getting unbonding time: %w", err)
+	}
+	trustPeriod, err := ccv.CalculateTrustPeriod(unbondingPeriod, providertypes.DefaultTrustingPeriodFraction)
+	if err != nil {
+		return ccv.ConsumerGenesisState{}, fmt.Errorf("calculating trusting period: %w", err)
+	}
+
+	height := clienttypes.GetSelfHeight(ctx)
+	clientState := providertypes.DefaultTemplateClient()
+	clientState.ChainId = ctx.ChainID()
+	clientState.LatestHeight = height
+	clientState.TrustingPeriod = trustPeriod
+	clientState.UnbondingPeriod = unbondingPeriod
+
+	histInfo, err := k.stakingKeeper.GetHistoricalInfo(ctx, int64(height.RevisionHeight))
+	if err != nil {
+		return ccv.ConsumerGenesisState{}, fmt.Errorf("getting historical info at height %d: %w", height.RevisionHeight, err)
+	}
+	consState := &ibctmtypes.ConsensusState{
+		Timestamp:          histInfo.Header.Time,
+		Root:               commitmenttypes.NewMerkleRoot(histInfo.Header.GetAppHash()),
+		NextValidatorsHash: histInfo.Header.NextValidatorsHash,
+	}
+
+	params := ccv.NewParams(
+		true,
+		ccv.DefaultBlocksPerDistributionTransmission,
+		"",
+		"",
+		ccv.DefaultCCVTimeoutPeriod,
+		ccv.DefaultTransferTimeoutPeriod,
+		ccv.DefaultConsumerRedistributeFrac,
+		ccv.DefaultHistoricalEntries,
+		ccv.DefaultConsumerUnbondingPeriod,
+		[]string{},
+		[]string{},
+		ccv.DefaultRetryDelayPeriod,
+		consumerId,
+		false,
+		ccv.DefaultMaxValidatorUpdatesPerBlock,
+		ccv.DefaultRetryDelayMultiplier,
+		ccv.DefaultMaxRetryDelayPeriod,
+		ccv.DefaultClientExpiryWarningThreshold,
+		nil,
+		nil,
+	)
+
+	return *ccv.NewInitialConsumerGenesisState(clientState, consState, initialValSet, false, "", params), nil
+}
@@ -0,0 +1,108 @@
+package mockprovider_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	cryptotestutil "github.com/cosmos/interchain-security/v7/testutil/crypto"
+	"github.com/cosmos/interchain-security/v7/x/ccv/mockprovider"
+	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+func setupKeeper(t *testing.T, jailBlocks int64) (mockprovider.Keeper, sdk.Context) {
+	t.Helper()
+	key := storetypes.NewKVStoreKey(mockprovider.StoreKey)
+	ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test"))
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	k := mockprovider.NewKeeper(cdc, key, nil, nil, mockprovider.Config{
+		BlocksPerEpoch: mockprovider.DefaultBlocksPerEpoch,
+		JailBlocks:     jailBlocks,
+	})
+	return k, ctx
+}
+
+func TestComputeValidatorUpdates(t *testing.T) {
+	k, ctx := setupKeeper(t, mockprovider.DefaultJailBlocks)
+
+	val1 := abci.ValidatorUpdate{PubKey: cryptotestutil.NewCryptoIdentityFromIntSeed(1).TMProtoCryptoPublicKey(), Power: 100}
+	val2 := abci.ValidatorUpdate{PubKey: cryptotestutil.NewCryptoIdentityFromIntSeed(2).TMProtoCryptoPublicKey(), Power: 50}
+	val3 := abci.ValidatorUpdate{PubKey: cryptotestutil.NewCryptoIdentityFromIntSeed(3).TMProtoCryptoPublicKey(), Power: 10}
+
+	// the first validator set is sent in full
+	updates, err := k.ComputeValidatorUpdates(ctx, []abci.ValidatorUpdate{val1, val2})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []abci.ValidatorUpdate{val1, val2}, updates)
+
+	// no changes
+	updates, err = k.ComputeValidatorUpdates(ctx, []abci.ValidatorUpdate{val1, val2})
+	require.NoError(t, err)
+	require.Empty(t, updates)
+
+	// val1 changes power, val2 is removed, and val3 is added
+	val1Updated := abci.ValidatorUpdate{PubKey: val1.PubKey, Power: 200}
+	updates, err = k.ComputeValidatorUpdates(ctx, []abci.ValidatorUpdate{val1Updated, val3})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []abci.ValidatorUpdate{
+		val1Updated,
+		{PubKey: val2.PubKey, Power: 0},
+		val3,
+	}, updates)
+	require.ElementsMatch(t, []abci.ValidatorUpdate{val1Updated, val3}, k.GetAllValidators(ctx))
+}
+
+func TestOnRecvSlashPacket(t *testing.T) {
+	k, ctx := setupKeeper(t, 10)
+	ctx = ctx.WithBlockHeight(5)
+
+	identity := cryptotestutil.NewCryptoIdentityFromIntSeed(1)
+	val := abci.ValidatorUpdate{PubKey: identity.TMProtoCryptoPublicKey(), Power: 100}
+	consAddr := identity.SDKValConsAddress()
+
+	_, err := k.ComputeValidatorUpdates(ctx, []abci.ValidatorUpdate{val})
+	require.NoError(t, err)
+
+	// double-signing slash packets are ignored
+	result, err := k.OnRecvSlashPacket(ctx, ccv.SlashPacketData{
+		Validator:      abci.Validator{Address: consAddr, Power: 100},
+		ValsetUpdateId: 1,
+		Infraction:     stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN,
+	})
+	require.NoError(t, err)
+	require.Equal(t, ccv.V1Result, result)
+	require.False(t, k.IsJailed(ctx, consAddr))
+	require.Empty(t, k.ConsumeSlashAcks(ctx))
+
+	// downtime slash packets remove the validator from the validator set
+	result, err = k.OnRecvSlashPacket(ctx, ccv.SlashPacketData{
+		Validator:      abci.Validator{Address: consAddr, Power: 100},
+		ValsetUpdateId: 1,
+		Infraction:     stakingtypes.Infraction_INFRACTION_DOWNTIME,
+	})
+	require.NoError(t, err)
+	require.Equal(t, ccv.SlashPacketHandledResult, result)
+	require.True(t, k.IsJailed(ctx, consAddr))
+	require.Equal(t, []string{consAddr.String()}, k.ConsumeSlashAcks(ctx))
+	require.Empty(t, k.ConsumeSlashAcks(ctx))
+
+	updates, err := k.ComputeValidatorUpdates(ctx, []abci.ValidatorUpdate{val})
+	require.NoError(t, err)
+	require.Equal(t, []abci.ValidatorUpdate{{PubKey: val.PubKey, Power: 0}}, updates)
+
+	// the validator is added back once the jailing expires
+	ctx = ctx.WithBlockHeight(15)
+	require.False(t, k.IsJailed(ctx, consAddr))
+	updates, err = k.ComputeValidatorUpdates(ctx, []abci.ValidatorUpdate{val})
+	require.NoError(t, err)
+	require.Equal(t, []abci.ValidatorUpdate{val}, updates)
+}
@@ -0,0 +1,78 @@
+package mockprovider
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+)
+
+// LoadValidatorSet reads the validator set from the JSON file `path`. The file contains
+// a list of validator updates in the format of the `initial_val_set` of the consumer genesis, e.g.,
+//
+//	[{"pub_key": {"ed25519": "Ui5Gf1+mtWUdH8u3xlmzdKID+F3PK0sfXZ73GZ6q6is="}, "power": "100"}]
+func LoadValidatorSet(cdc codec.JSONCodec, path string) ([]abci.ValidatorUpdate, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading validator set file: %w", err)
+	}
+
+	var rawValidators []json.RawMessage
+	if err := json.Unmarshal(bz, &rawValidators); err != nil {
+		return nil, fmt.Errorf("parsing validator set file %s: %w", path, err)
+	}
+
+	validators := make([]abci.ValidatorUpdate, 0, len(rawValidators))
+	for i, rawValidator := range rawValidators {
+		var validator abci.ValidatorUpdate
+		if err := cdc.UnmarshalJSON(rawValidator, &validator); err != nil {
+			return nil, fmt.Errorf("parsing validator %d in %s: %w", i, path, err)
+		}
+		validators = append(validators, validator)
+	}
+
+	if err := ValidateValidatorSet(validators); err != nil {
+		return nil, fmt.Errorf("invalid validator set in %s: %w", path, err)
+	}
+
+	return validators, nil
+}
+
+// ValidateValidatorSet checks that the validator set is not empty, that all the validators
+// have a positive power, and that no validator appears twice
+func ValidateValidatorSet(validators []abci.ValidatorUpdate) error {
+	if len(validators) == 0 {
+		return fmt.Errorf("empty validator set")
+	}
+
+	seen := map[string]struct{}{}
+	for i, validator := range validators {
+		if validator.Power <= 0 {
+			return fmt.Errorf("validator %d has non-positive power %d", i, validator.Power)
+		}
+		consAddr, err := ConsAddrFromValidatorUpdate(validator)
+		if err != nil {
+			return fmt.Errorf("validator %d: %w", i, err)
+		}
+		if _, found := seen[consAddr.String()]; found {
+			return fmt.Errorf("duplicate validator %s", consAddr)
+		}
+		seen[consAddr.String()] = struct{}{}
+	}
+
+	return nil
+}
+
+// ConsAddrFromValidatorUpdate returns the consensus address of the validator of a validator update
+func ConsAddrFromValidatorUpdate(validator abci.ValidatorUpdate) (sdk.ConsAddress, error) {
+	pubKey, err := cryptocodec.FromCmtProtoPublicKey(validator.PubKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid public key: %w", err)
+	}
+	return sdk.ConsAddress(pubKey.Address()), nil
+}
@@ -0,0 +1,85 @@
+package mockprovider_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	cryptotestutil "github.com/cosmos/interchain-security/v7/testutil/crypto"
+	"github.com/cosmos/interchain-security/v7/x/ccv/mockprovider"
+)
+
+func TestLoadValidatorSet(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	val1 := abci.ValidatorUpdate{PubKey: cryptotestutil.NewCryptoIdentityFromIntSeed(1).TMProtoCryptoPublicKey(), Power: 100}
+	val2 := abci.ValidatorUpdate{PubKey: cryptotestutil.NewCryptoIdentityFromIntSeed(2).TMProtoCryptoPublicKey(), Power: 50}
+
+	marshal := func(vals ...abci.ValidatorUpdate) string {
+		bz := "["
+		for i, val := range vals {
+			if i > 0 {
+				bz += ","
+			}
+			bz += string(cdc.MustMarshalJSON(&val))
+		}
+		return bz + "]"
+	}
+
+	testCases := []struct {
+		name     string
+		content  string
+		expected []abci.ValidatorUpdate
+		expError bool
+	}{
+		{
+			name:     "valid validator set",
+			content:  marshal(val1, val2),
+			expected: []abci.ValidatorUpdate{val1, val2},
+		},
+		{
+			name:     "empty validator set",
+			content:  "[]",
+			expError: true,
+		},
+		{
+			name:     "not a list",
+			content:  marshal(val1)[1 : len(marshal(val1))-1],
+			expError: true,
+		},
+		{
+			name:     "duplicate validator",
+			content:  marshal(val1, val1),
+			expError: true,
+		},
+		{
+			name:     "non-positive power",
+			content:  marshal(abci.ValidatorUpdate{PubKey: val1.PubKey, Power: 0}),
+			expError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "mock_valset.json")
+			require.NoError(t, os.WriteFile(path, []byte(tc.content), 0o600))
+
+			validators, err := mockprovider.LoadValidatorSet(cdc, path)
+			if tc.expError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, validators)
+		})
+	}
+
+	_, err := mockprovider.LoadValidatorSet(cdc, filepath.Join(t.TempDir(), "missing.json"))
+	require.Error(t, err)
+}
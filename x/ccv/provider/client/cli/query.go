@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -12,9 +13,19 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
 
+	abci "github.com/cometbft/cometbft/abci/types"
+
 	"github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
 )
 
+const (
+	// FlagConsumerId is the id of the consumer chain
+	FlagConsumerId = "consumer-id"
+	// FlagCurrentValSet replaces the initial validator set of a consumer genesis state
+	// with the validator set of the consumer chain at the queried height
+	FlagCurrentValSet = "current-valset"
+)
+
 // NewQueryCmd returns a root CLI command handler for all x/ccv/provider query commands.
 func NewQueryCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -41,16 +52,53 @@ func NewQueryCmd() *cobra.Command {
 	cmd.AddCommand(CmdConsumerIdFromClientId())
 	cmd.AddCommand(CmdConsumerChain())
 	cmd.AddCommand(CmdConsumerGenesisTime())
+	cmd.AddCommand(CmdNearTimeoutPackets())
+	cmd.AddCommand(CmdLaunchCapacity())
+	cmd.AddCommand(CmdPowerShapingPipeline())
+	cmd.AddCommand(CmdConsumerValidatorPowerProjection())
+	cmd.AddCommand(CmdModuleAccountsSummary())
+	cmd.AddCommand(CmdConsumerLaunchFailure())
+	cmd.AddCommand(CmdConsumerHashCommitment())
+	cmd.AddCommand(CmdConsumerCreatorAllowlist())
+	cmd.AddCommand(CmdConsumerPacketStats())
+	cmd.AddCommand(CmdConsumerClientUpgradePlans())
+	cmd.AddCommand(CmdConsumerProviderSwitches())
+	cmd.AddCommand(CmdConsumerClientExpiries())
+	cmd.AddCommand(CmdThrottleQueueState())
+	cmd.AddCommand(CmdValidatorCCVSummary())
+	cmd.AddCommand(CmdLastVSCPacket())
+	cmd.AddCommand(CmdConsumerThrottleState())
+	cmd.AddCommand(CmdPendingInfractionParameterUpdates())
+	cmd.AddCommand(CmdStaleKeyAssignments())
+	cmd.AddCommand(CmdConsumerUpdateHistory())
+	cmd.AddCommand(CmdValidatorOptInHistory())
+	cmd.AddCommand(CmdModuleVersionInfo())
+	cmd.AddCommand(CmdConsumerRewardEstimate())
 	return cmd
 }
 
-// NewQuerySubspaceParamsCmd returns a CLI command handler for querying subspace
-// parameters managed by the x/params module.
+// CmdConsumerGenesis returns the genesis state of a consumer chain, optionally at a past height of the provider chain
+// and with the validator set the consumer chain has at that height, e.g., to relaunch the chain from a past snapshot
 func CmdConsumerGenesis() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "consumer-genesis [consumer-id]",
 		Short: "Query for consumer chain genesis state by consumer id",
-		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the genesis state of a launched consumer chain. The consumer id is given either as argument or with --%s.
+With --%s, the genesis state is queried from the state of the provider chain at the given height
+(the node has to keep the state of that height, i.e., it must not be pruned).
+With --%s, the initial validator set of the genesis state is replaced by the validator set of the consumer chain
+at that height, i.e., with the consumer keys assigned by the validators and their voting powers on the consumer chain,
+e.g., to relaunch the consumer chain from a snapshot at that height.
+Example:
+$ %s query provider consumer-genesis 0
+$ %s query provider consumer-genesis --%s 0 --%s 1200000 --%s
+`,
+				FlagConsumerId, flags.FlagHeight, FlagCurrentValSet,
+				version.AppName, version.AppName, FlagConsumerId, flags.FlagHeight, FlagCurrentValSet,
+			),
+		),
+		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
@@ -58,28 +106,79 @@ func CmdConsumerGenesis() *cobra.Command {
 			}
 			queryClient := types.NewQueryClient(clientCtx)
 
-			req := types.QueryConsumerGenesisRequest{ConsumerId: args[0]}
+			consumerId, err := cmd.Flags().GetString(FlagConsumerId)
+			if err != nil {
+				return err
+			}
+			switch {
+			case len(args) == 1 && consumerId != "" && args[0] != consumerId:
+				return fmt.Errorf("consumer id given both as argument (%s) and with --%s (%s)", args[0], FlagConsumerId, consumerId)
+			case len(args) == 1:
+				consumerId = args[0]
+			case consumerId == "":
+				return fmt.Errorf("consumer id has to be given either as argument or with --%s", FlagConsumerId)
+			}
+
+			req := types.QueryConsumerGenesisRequest{ConsumerId: consumerId}
 			res, err := queryClient.QueryConsumerGenesis(cmd.Context(), &req)
+			if err != nil {
+				if clientCtx.Height > 0 {
+					return fmt.Errorf("cannot get the genesis state of consumer chain %s at height %d, "+
+						"e.g., the chain is not launched at this height: %w", consumerId, clientCtx.Height, err)
+				}
+				return err
+			}
+			genesisState := res.GenesisState
+
+			currentValSet, err := cmd.Flags().GetBool(FlagCurrentValSet)
 			if err != nil {
 				return err
 			}
+			if currentValSet {
+				valsRes, err := queryClient.QueryConsumerValidators(cmd.Context(), &types.QueryConsumerValidatorsRequest{ConsumerId: consumerId})
+				if err != nil {
+					return err
+				}
+				genesisState.Provider.InitialValSet = consumerValidatorsToValidatorUpdates(valsRes.Validators)
+				if len(genesisState.Provider.InitialValSet) == 0 {
+					return fmt.Errorf("consumer chain %s has no validators", consumerId)
+				}
+			}
 
-			return clientCtx.PrintProto(&res.GenesisState)
+			return clientCtx.PrintProto(&genesisState)
 		},
 	}
 
+	cmd.Flags().String(FlagConsumerId, "", "Id of the consumer chain, if not given as argument")
+	cmd.Flags().Bool(FlagCurrentValSet, false, "Replace the initial validator set with the validator set of the consumer chain at the queried height")
 	flags.AddQueryFlagsToCmd(cmd)
 
 	return cmd
 }
 
+// consumerValidatorsToValidatorUpdates returns the validator updates setting the consumer keys
+// of the validators of a consumer chain to their voting powers on the consumer chain
+func consumerValidatorsToValidatorUpdates(validators []*types.QueryConsumerValidatorsValidator) []abci.ValidatorUpdate {
+	updates := []abci.ValidatorUpdate{}
+	for _, validator := range validators {
+		if validator.ConsumerKey == nil || validator.ConsumerPower <= 0 {
+			continue
+		}
+		updates = append(updates, abci.ValidatorUpdate{
+			PubKey: *validator.ConsumerKey,
+			Power:  validator.ConsumerPower,
+		})
+	}
+	return updates
+}
+
 func CmdConsumerChains() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "list-consumer-chains [phase]",
 		Short: "Query consumer chains for provider chain.",
 		Long: `Query consumer chains for provider chain. An optional
 		integer parameter can be passed for phase filtering of consumer chains,
-		(Registered=1|Initialized=2|Launched=3|Stopped=4|Deleted=5).`,
+		(Registered=1|Initialized=2|Launched=3|Stopped=4|Deleted=5|Paused=6).`,
 		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			clientCtx, err := client.GetClientQueryContext(cmd)
@@ -612,3 +711,805 @@ func CmdConsumerGenesisTime() *cobra.Command {
 
 	return cmd
 }
+
+func CmdNearTimeoutPackets() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "near-timeout-packets [duration]",
+		Short: "Query the unacknowledged VSC packets that time out within the given duration",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the VSC packets sent to consumer chains that are not yet acknowledged
+and that time out within the given duration from the latest block time, including the packets that already timed out.
+Example:
+$ %s query provider near-timeout-packets 30m
+`, version.AppName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			within, err := time.ParseDuration(args[0])
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryNearTimeoutPacketsRequest{Within: within}
+			res, err := queryClient.QueryNearTimeoutPackets(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdLaunchCapacity() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "launch-capacity",
+		Short: "Query the number of launched consumer chains and the number of consumer chains that can still be launched",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryLaunchCapacityRequest{}
+			res, err := queryClient.QueryLaunchCapacity(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdPowerShapingPipeline() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "power-shaping-pipeline [consumer-id]",
+		Short: "Query the power shaping steps applied the last time the validator set of a given consumer chain was computed",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the power shaping steps applied, in evaluation order, the last time the validator set
+of a given consumer chain was computed, together with the validators added and removed by each step.
+Example:
+$ %s query provider power-shaping-pipeline 3
+`, version.AppName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryPowerShapingPipelineRequest{ConsumerId: args[0]}
+			res, err := queryClient.QueryPowerShapingPipeline(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdConsumerValidatorPowerProjection() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consumer-validator-power-projection [consumer-id]",
+		Short: "Query the validator set that would be sent to a given consumer chain in the next VSCPacket",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the validator set that would be sent to a given launched consumer chain in the next VSCPacket,
+after applying the power shaping parameters, together with the power shaping steps applied to compute it.
+The projected validator set is not committed, i.e., it can be used to preview the effect of power shaping changes
+before the next epoch.
+Example:
+$ %s query provider consumer-validator-power-projection 3
+`, version.AppName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryConsumerValidatorPowerProjectionRequest{ConsumerId: args[0]}
+			res, err := queryClient.QueryConsumerValidatorPowerProjection(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdModuleAccountsSummary() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "module-accounts-summary [limit]",
+		Short: "Query the balances of the CCV module accounts and the most recent transfers of funds in and out of them",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the balances of the CCV module accounts and the most recent transfers of funds
+in and out of them, most recent first. If limit is provided, at most limit transfers are returned.
+Example:
+$ %s query provider module-accounts-summary
+$ %s query provider module-accounts-summary 10
+`, version.AppName, version.AppName),
+		),
+		Args: cobra.RangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryModuleAccountsSummaryRequest{}
+			if len(args) == 1 {
+				limit, err := strconv.ParseUint(args[0], 10, 32)
+				if err != nil {
+					return err
+				}
+				req.Limit = uint32(limit)
+			}
+			res, err := queryClient.QueryModuleAccountsSummary(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdConsumerLaunchFailure() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consumer-launch-failure [consumer-id]",
+		Short: "Query the last failed launch of a given consumer chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the last failed launch of a given consumer chain, together with whether
+the launch can be retried with the retry-launch transaction.
+Example:
+$ %s query provider consumer-launch-failure 3
+`, version.AppName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryConsumerLaunchFailureRequest{ConsumerId: args[0]}
+			res, err := queryClient.QueryConsumerLaunchFailure(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdConsumerHashCommitment() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consumer-hash-commitment [consumer-id]",
+		Short: "Query the genesis and binary hashes of a given consumer chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the genesis and binary hashes of a given consumer chain, together with the hashes
+committed at registration, whether they were updated since and whether the owner attested them.
+Example:
+$ %s query provider consumer-hash-commitment 3
+`, version.AppName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryConsumerHashCommitmentRequest{ConsumerId: args[0]}
+			res, err := queryClient.QueryConsumerHashCommitment(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdConsumerCreatorAllowlist() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consumer-creator-allowlist",
+		Short: "Query the addresses allowed to create consumer chains",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the addresses allowed to create consumer chains if the RestrictConsumerCreation param is set.
+Example:
+$ %s query provider consumer-creator-allowlist
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryConsumerCreatorAllowlistRequest{}
+			res, err := queryClient.QueryConsumerCreatorAllowlist(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdConsumerPacketStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consumer-packet-stats [consumer-id]",
+		Short: "Query the number of CCV packets exchanged with a given consumer chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the number of VSC packets sent to a given consumer chain, as well as the number of
+VSCMatured packets, slash packets and error acknowledgements received from it.
+Example:
+$ %s query provider consumer-packet-stats 3
+`, version.AppName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryConsumerPacketStatsRequest{ConsumerId: args[0]}
+			res, err := queryClient.QueryConsumerPacketStats(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdValidatorCCVSummary() *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+	cmd := &cobra.Command{
+		Use:   "validator-ccv-summary [validator-address]",
+		Short: "Query a summary of the staking and CCV state of a given validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the staking state, the outstanding rewards, and the consumer chains a given validator
+is opted in to, validating, or has to validate, together with the assigned consumer keys and commission rates.
+Example:
+$ %s query provider validator-ccv-summary %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`, version.AppName, bech32PrefixValAddr),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryValidatorCCVSummaryRequest{ValidatorAddress: args[0]}
+			res, err := queryClient.QueryValidatorCCVSummary(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdConsumerClientUpgradePlans() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consumer-client-upgrade-plans",
+		Short: "Query the pending upgrades of the consumer clients",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the client upgrades registered by the owners of the consumer chains
+that are not yet verified, i.e., whose consumer clients are not yet updated past the upgrade height.
+Example:
+$ %s query provider consumer-client-upgrade-plans
+`, version.AppName),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryConsumerClientUpgradePlansRequest{}
+			res, err := queryClient.QueryConsumerClientUpgradePlans(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdConsumerProviderSwitches() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consumer-provider-switches",
+		Short: "Query the switches of the consumer chains to different provider chains",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the switches to different provider chains registered by the owners of the consumer chains,
+together with the phases of the consumer chains, i.e., stopped once the consumer chains switched.
+Example:
+$ %s query provider consumer-provider-switches
+`, version.AppName),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryConsumerProviderSwitchesRequest{}
+			res, err := queryClient.QueryConsumerProviderSwitches(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdConsumerClientExpiries() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consumer-client-expiries",
+		Short: "Query the expiry times of the consumer clients",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the times at which the clients of the launched consumer chains expire
+if they are not updated, i.e., the ends of the trusting periods of their latest consensus states.
+Example:
+$ %s query provider consumer-client-expiries
+`, version.AppName),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryConsumerClientExpiriesRequest{}
+			res, err := queryClient.QueryConsumerClientExpiries(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdThrottleQueueState() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "throttle-queue-state",
+		Short: "Query the slash meter and the slash packets pending a retry",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the state of the slash meter together with the slash packets that were bounced
+because the slash meter was negative and are pending a retry from their consumer chains,
+with the time at which they were first received.
+Example:
+$ %s query provider throttle-queue-state
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryThrottleQueueStateRequest{}
+			res, err := queryClient.QueryThrottleQueueState(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdLastVSCPacket() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "last-vsc [consumer-id]",
+		Short: "Query the last VSC packet sent to a given consumer chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the last VSC packet sent to a given consumer chain, i.e., the CCV channel and sequence
+of the packet, the time at which it was sent and its data. The validator updates and slash acknowledgements
+of the packet are decoded into the consensus addresses of the validators on the consumer and the provider chain,
+together with their monikers.
+Example:
+$ %s query provider last-vsc 3
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryLastVSCPacketRequest{ConsumerId: args[0]}
+			res, err := queryClient.QueryLastVSCPacket(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdConsumerThrottleState() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consumer-throttle-state [consumer-id]",
+		Short: "Query the throttling parameters and the slash meter of a given consumer chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the throttling parameters of a given consumer chain and the state of the slash meter
+throttling its slash packets, i.e., either the slash meter of the consumer chain or the global slash meter.
+Example:
+$ %s query provider consumer-throttle-state 3
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryConsumerThrottleStateRequest{ConsumerId: args[0]}
+			res, err := queryClient.QueryConsumerThrottleState(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdPendingInfractionParameterUpdates() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-infraction-parameter-updates [consumer-id]",
+		Short: "Query the queued updates of the infraction parameters of consumer chains",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the queued updates of the infraction parameters of consumer chains, i.e., the current
+and the pending infraction parameters of each consumer chain and the time at which the pending ones take effect,
+ordered by that time. If a consumer id is provided, only the update of that consumer chain is returned.
+Example:
+$ %s query provider pending-infraction-parameter-updates
+$ %s query provider pending-infraction-parameter-updates 3
+`,
+				version.AppName, version.AppName,
+			),
+		),
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryPendingInfractionParameterUpdatesRequest{}
+			if len(args) == 1 {
+				req.ConsumerId = args[0]
+			}
+			res, err := queryClient.QueryPendingInfractionParameterUpdates(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdStaleKeyAssignments() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stale-key-assignments [consumer-id]",
+		Short: "Query the assigned consumer keys of a given consumer chain that were never observed in its heartbeats",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the assigned consumer keys used in the validator set of a given consumer chain
+that were never observed in the heartbeats of the consumer chain for the StaleKeyAssignmentEpochs param,
+e.g., because the validators assigned a key that is not used by any of their nodes.
+Example:
+$ %s query provider stale-key-assignments 3
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryStaleKeyAssignmentsRequest{ConsumerId: args[0]}
+			res, err := queryClient.QueryStaleKeyAssignments(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdConsumerUpdateHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "consumer-update-history [consumer-id]",
+		Short: "Query the most recent accepted updates of a given consumer chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the most recent accepted MsgUpdateConsumer messages of a given consumer chain, oldest first,
+together with their submitter, their height and time, and the fields they changed with the old and new values.
+Example:
+$ %s query provider consumer-update-history 3
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryConsumerUpdateHistoryRequest{ConsumerId: args[0], Pagination: pageReq}
+			res, err := queryClient.QueryConsumerUpdateHistory(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "consumer updates")
+
+	return cmd
+}
+
+func CmdValidatorOptInHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validator-opt-in-history [provider-validator-address]",
+		Short: "Query the most recent opt-ins and opt-outs of a given validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns, per consumer chain, the most recent opt-ins and opt-outs of a given validator, oldest first,
+together with their height and time, the start height of the epoch in which they took effect,
+and whether the validator was automatically opted in because it belongs to the Top N validators.
+Example:
+$ %s query provider validator-opt-in-history cosmosvalcons1k33rh8aahm8knmfafunjhgtl4yhdlzgq0lhwqe
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryValidatorOptInHistoryRequest{ProviderAddress: args[0]}
+			res, err := queryClient.QueryValidatorOptInHistory(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdModuleVersionInfo() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "module-version-info",
+		Short: "Query the version of the provider module and the features enabled on the provider chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the semantic version of Interchain Security, the consensus version of the provider module,
+the CCV version, and the features enabled on the provider chain.
+Example:
+$ %s query provider module-version-info
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryModuleVersionInfoRequest{}
+			res, err := queryClient.QueryModuleVersionInfo(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdConsumerRewardEstimate() *cobra.Command {
+	bech32PrefixConsAddr := sdk.GetConfig().GetBech32ConsensusAddrPrefix()
+	cmd := &cobra.Command{
+		Use:   "consumer-reward-estimate [consumer-id] [provider-validator-address]",
+		Short: "Query the estimated annual ICS rewards of a validator for a consumer chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Returns the estimated annual ICS rewards of a validator for a consumer chain.
+The rewards received from the consumer chain since the oldest retained fund flow record are extrapolated to a year,
+and are split based on the community tax, the power share of the validator on the consumer chain, and its commission rate.
+Example:
+$ %s query provider consumer-reward-estimate 3 %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, bech32PrefixConsAddr,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			addr, err := sdk.ConsAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.QueryConsumerRewardEstimate(cmd.Context(),
+				&types.QueryConsumerRewardEstimateRequest{
+					ConsumerId:      args[0],
+					ProviderAddress: addr.String(),
+				})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,74 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmprotocrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+
+	"github.com/cosmos/interchain-security/v7/testutil/crypto"
+	"github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+)
+
+func TestConsumerValidatorsToValidatorUpdates(t *testing.T) {
+	keys := []tmprotocrypto.PublicKey{}
+	for i := 0; i < 3; i++ {
+		keys = append(keys, crypto.NewCryptoIdentityFromIntSeed(i).TMProtoCryptoPublicKey())
+	}
+	secp256k1Key := tmprotocrypto.PublicKey{Sum: &tmprotocrypto.PublicKey_Secp256K1{Secp256K1: make([]byte, 33)}}
+
+	testCases := []struct {
+		name       string
+		validators []*types.QueryConsumerValidatorsValidator
+		expected   []abci.ValidatorUpdate
+	}{
+		{
+			"no validators",
+			nil,
+			[]abci.ValidatorUpdate{},
+		},
+		{
+			"consumer keys and consumer powers of the validators, in order",
+			[]*types.QueryConsumerValidatorsValidator{
+				{ConsumerKey: &keys[1], ConsumerPower: 20},
+				{ConsumerKey: &keys[0], ConsumerPower: 10},
+				{ConsumerKey: &secp256k1Key, ConsumerPower: 30},
+			},
+			[]abci.ValidatorUpdate{
+				{PubKey: keys[1], Power: 20},
+				{PubKey: keys[0], Power: 10},
+				{PubKey: secp256k1Key, Power: 30},
+			},
+		},
+		{
+			"the deprecated power is ignored",
+			[]*types.QueryConsumerValidatorsValidator{
+				{ConsumerKey: &keys[0], Power: 100, ConsumerPower: 10},
+				{ConsumerKey: &keys[1], Power: 100},
+			},
+			[]abci.ValidatorUpdate{
+				{PubKey: keys[0], Power: 10},
+			},
+		},
+		{
+			"validators without consumer power or consumer key are skipped",
+			[]*types.QueryConsumerValidatorsValidator{
+				{ConsumerKey: &keys[0], ConsumerPower: 0},
+				{ConsumerKey: &keys[1], ConsumerPower: -1},
+				{ConsumerKey: nil, ConsumerPower: 10},
+				{ConsumerKey: &keys[2], ConsumerPower: 1},
+			},
+			[]abci.ValidatorUpdate{
+				{PubKey: keys[2], Power: 1},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, consumerValidatorsToValidatorUpdates(tc.validators))
+		})
+	}
+}
@@ -1,11 +1,17 @@
 package cli
 
 import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
+	"time"
 
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
 	ibctmtypes "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
 	"github.com/spf13/cobra"
 
@@ -16,13 +22,36 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	"github.com/cosmos/cosmos-sdk/version"
 
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 
+	providerclient "github.com/cosmos/interchain-security/v7/x/ccv/provider/client"
 	"github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
 )
 
+const (
+	// FlagActivationHeight is the height at which a scheduled key assignment is applied
+	FlagActivationHeight = "activation-height"
+	// FlagKeyPossessionProof is the base64-encoded proof that the validator controls the assigned consumer key
+	FlagKeyPossessionProof = "key-possession-proof"
+	// FlagInitParamsProfile is the profile providing the initialization parameters not set in the create-consumer file
+	FlagInitParamsProfile = "init-params-profile"
+)
+
+// KeyPossessionSigningRequest is the signing request file to be signed by a consumer key, e.g., by a remote signer,
+// to prove that the provider validator assigning the key controls it
+type KeyPossessionSigningRequest struct {
+	ProviderChainId string `json:"provider_chain_id"`
+	ConsumerId      string `json:"consumer_id"`
+	ProviderAddr    string `json:"provider_addr"`
+	ConsumerKey     string `json:"consumer_key"`
+	// SignBytes are the bytes to sign, i.e., the marshaled KeyPossessionSignDoc prefixed with
+	// the key possession domain tag and the provider chain id, encoded in base64
+	SignBytes []byte `json:"sign_bytes"`
+}
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -42,6 +71,16 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(NewOptInCmd())
 	cmd.AddCommand(NewOptOutCmd())
 	cmd.AddCommand(NewSetConsumerCommissionRateCmd())
+	cmd.AddCommand(NewSetConsumerInitialConsensusStateCmd())
+	cmd.AddCommand(NewSetTopNBudgetCmd())
+	cmd.AddCommand(NewRetryLaunchCmd())
+	cmd.AddCommand(NewChangeConsumerChainIdCmd())
+	cmd.AddCommand(NewRegisterConsumerClientUpgradeCmd())
+	cmd.AddCommand(NewRegisterConsumerProviderSwitchCmd())
+	cmd.AddCommand(NewAttestConsumerHashesCmd())
+	cmd.AddCommand(NewSignKeyAssignmentCmd())
+	cmd.AddCommand(NewKeyPossessionSigningRequestCmd())
+	cmd.AddCommand(NewSignKeyPossessionCmd())
 
 	return cmd
 }
@@ -50,7 +89,18 @@ func NewAssignConsumerKeyCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "assign-consensus-key [consumer-id] [consumer-pubkey]",
 		Short: "assign a consensus public key to use for a consumer chain",
-		Args:  cobra.ExactArgs(2),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Assign a consensus public key to use for a consumer chain.
+The key assignment takes effect at the next epoch, unless it is scheduled with the --%s flag,
+in which case it is applied at the given height and takes effect at the next epoch after that height.
+With the --%s flag, the provider chain rejects the key assignment unless the consumer key signed
+the key possession signing request of the key assignment (see the key-possession-signing-request command),
+which prevents assigning a key that the validator does not control, e.g., a wrong key of a remote signer.
+
+Example:
+%s tx provider assign-consensus-key [consumer-id] '{"@type":"/cosmos.crypto.ed25519.PubKey","key":"..."}' --%s 1000000
+			`, FlagActivationHeight, FlagKeyPossessionProof, version.AppName, FlagActivationHeight)),
+		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -70,6 +120,25 @@ func NewAssignConsumerKeyCmd() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			msg.ActivationHeight, err = cmd.Flags().GetInt64(FlagActivationHeight)
+			if err != nil {
+				return err
+			}
+
+			proof, err := cmd.Flags().GetBytesBase64(FlagKeyPossessionProof)
+			if err != nil {
+				return err
+			}
+			if len(proof) != 0 {
+				msgWithProof := types.NewMsgAssignConsumerKeyWithProof(msg.ConsumerId, providerValAddr.Bytes(), msg.ConsumerKey, submitter, proof)
+				msgWithProof.ActivationHeight = msg.ActivationHeight
+				if err := msgWithProof.ValidateBasic(); err != nil {
+					return err
+				}
+
+				return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msgWithProof)
+			}
+
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -79,6 +148,8 @@ func NewAssignConsumerKeyCmd() *cobra.Command {
 	}
 
 	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().Int64(FlagActivationHeight, 0, "Height at which the key assignment is applied (default: applied immediately)")
+	cmd.Flags().BytesBase64(FlagKeyPossessionProof, nil, "Base64-encoded signature of the consumer key over the key possession signing request")
 
 	_ = cmd.MarkFlagRequired(flags.FlagFrom)
 
@@ -246,7 +317,11 @@ where create_consumer.json has the following structure:
     "blocks_per_distribution_transmission": 1000,
     "historical_entries": 10000,
     "distribution_transmission_channel": "",
-    "connection_id": ""
+    "connection_id": "",
+    "trust_level": {
+     "numerator": 1,
+     "denominator": 3
+    }
   },
   "power_shaping_parameters": {
     "top_N": 0,
@@ -256,7 +331,8 @@ where create_consumer.json has the following structure:
     "denylist": ["cosmosvalcons..."],
     "min_stake": 0,
     "allow_inactive_vals": false,
-    "prioritylist": ["cosmosvalcons..."]
+    "prioritylist": ["cosmosvalcons..."],
+    "prioritylist_weights": [{"provider_address": "cosmosvalcons...", "weight": 2}]
   },
   "infraction_parameters":{
    "double_sign":{
@@ -272,13 +348,34 @@ where create_consumer.json has the following structure:
   },
   "allowlisted_reward_denoms": {
     "denoms": ["ibc/...", "ibc/..."]
-  }
+  },
+  "throttling_parameters": {
+    "slash_meter_replenish_fraction": "0.01",
+    "slash_meter_replenish_period": 3600000000000
+  },
+  "reward_denom_hint": "untrn",
+  "key_assignments": [
+    {
+      "provider_addr": "cosmosvaloper...",
+      "consumer_key": "{\"@type\":\"/cosmos.crypto.ed25519.PubKey\",\"key\":\"...\"}",
+      "signature": "..."
+    }
+  ]
 }
 
 Note that both 'chain_id' and 'metadata' are mandatory;
 and 'initialization_parameters', 'power_shaping_parameters' and 'allowlisted_reward_denoms' are optional. 
 The parameters not provided are set to their zero value. 
-`, version.AppName)),
+Instead of 'spawn_time', the chain can be launched at a provider block height by setting 'spawn_height'.
+The optional 'key_assignments' are assigned to the validators when the chain is created; each of them
+needs to be signed by the validator using the 'sign-key-assignment' command.
+The optional 'throttling_parameters' give the chain a slash meter of its own, which throttles its slash packets
+instead of the global slash meter; they can only be set if the signer is the gov module.
+The optional 'reward_denom_hint' is the base denom of the native token the chain sends rewards in;
+the IBC denom of these rewards on the provider is allowlisted for this chain once the first rewards are received.
+With the --%s flag, the 'initialization_parameters' not provided are instead set to the values of the given
+profile (%s), e.g., 'testnet-fast' or 'devnet-instant' to get a short unbonding period.
+`, version.AppName, FlagInitParamsProfile, strings.Join(types.InitializationParametersProfiles(), ", "))),
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -299,6 +396,18 @@ The parameters not provided are set to their zero value.
 				return err
 			}
 			consCreate := types.MsgCreateConsumer{}
+			profile, err := cmd.Flags().GetString(FlagInitParamsProfile)
+			if err != nil {
+				return err
+			}
+			if profile != "" {
+				// the initialization parameters in the file are unmarshalled over the ones of the profile
+				initializationParameters, err := types.ConsumerInitializationParametersForProfile(types.InitializationParametersProfile(profile))
+				if err != nil {
+					return err
+				}
+				consCreate.InitializationParameters = &initializationParameters
+			}
 			if err = json.Unmarshal(consCreateJson, &consCreate); err != nil {
 				return fmt.Errorf("consumer data unmarshalling failed: %w", err)
 			}
@@ -308,6 +417,9 @@ The parameters not provided are set to their zero value.
 			if err != nil {
 				return err
 			}
+			msg.KeyAssignments = consCreate.KeyAssignments
+			msg.RewardDenomHint = consCreate.RewardDenomHint
+			msg.ThrottlingParameters = consCreate.ThrottlingParameters
 			if err = msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -317,6 +429,8 @@ The parameters not provided are set to their zero value.
 	}
 
 	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(FlagInitParamsProfile, "", fmt.Sprintf("Profile of the initialization parameters not provided (%s)",
+		strings.Join(types.InitializationParametersProfiles(), ", ")))
 
 	_ = cmd.MarkFlagRequired(flags.FlagFrom)
 
@@ -358,7 +472,11 @@ where update_consumer.json has the following structure:
     "blocks_per_distribution_transmission": 1000,
     "historical_entries": 10000,
     "distribution_transmission_channel": "",
-	"connection_id": ""
+	"connection_id": "",
+	"trust_level": {
+	 "numerator": 1,
+	 "denominator": 3
+	}
    },
    "power_shaping_parameters": {
     "top_N": 0,
@@ -368,7 +486,8 @@ where update_consumer.json has the following structure:
     "denylist": ["cosmosvalcons..."],
     "min_stake": 0,
     "allow_inactive_vals": false,
-    "prioritylist": ["cosmosvalcons..."]
+    "prioritylist": ["cosmosvalcons..."],
+    "prioritylist_weights": [{"provider_address": "cosmosvalcons...", "weight": 2}]
    },
   "infraction_parameters":{
    "double_sign":{
@@ -384,6 +503,10 @@ where update_consumer.json has the following structure:
   },
   "allowlisted_reward_denoms": {
     "denoms": ["ibc/...", "ibc/..."]
+  },
+  "throttling_parameters": {
+    "slash_meter_replenish_fraction": "0.01",
+    "slash_meter_replenish_period": 3600000000000
   }
   "new_chain_id": "newConsumer-1", // is optional and can be empty (i.e., "new_chain_id": "")
 }
@@ -428,6 +551,7 @@ If one of the fields is missing, it will be set to its zero value.
 			if err != nil {
 				return err
 			}
+			msg.ThrottlingParameters = consUpdate.ThrottlingParameters
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -619,3 +743,527 @@ func NewSetConsumerCommissionRateCmd() *cobra.Command {
 
 	return cmd
 }
+
+func NewSetConsumerInitialConsensusStateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-consumer-initial-consensus-state [consumer-id] [genesis-hash] [consensus-state]",
+		Short: "set the initial consensus state of the client the provider creates for a consumer chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Sets the initial consensus state of the client that the provider creates for a consumer chain
+at spawn time, instead of the provider deriving it. Note that only the owner of the chain can set it and only
+before the chain launches.
+The genesis hash is hex encoded and must match the genesis hash in the initialization parameters of the chain.
+The consensus state type definition can be found in ibc-go/proto/ibc/lightclients/tendermint/v1/tendermint.proto.
+Its next validators hash must match the hash of the initial validator set of the consumer chain, otherwise
+the chain does not launch.
+
+Example:
+%s tx provider set-consumer-initial-consensus-state [consumer-id] [genesis-hash] [path/to/consensus_state.json]
+`, version.AppName)),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			txf = txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			owner := clientCtx.GetFromAddress().String()
+			consumerId := args[0]
+
+			genesisHash, err := hex.DecodeString(args[1])
+			if err != nil {
+				return fmt.Errorf("genesis hash decoding failed: %s", err)
+			}
+
+			consensusStateJson, err := os.ReadFile(args[2])
+			if err != nil {
+				return err
+			}
+
+			cdc := codec.NewProtoCodec(clientCtx.InterfaceRegistry)
+
+			consensusState := ibctmtypes.ConsensusState{}
+			if err := cdc.UnmarshalJSON(consensusStateJson, &consensusState); err != nil {
+				return fmt.Errorf("consensus state unmarshalling failed: %s", err)
+			}
+
+			msg, err := types.NewMsgSetConsumerInitialConsensusState(owner, consumerId, genesisHash, consensusState)
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
+
+func NewSetTopNBudgetCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-top-n-budget [max-top-n-consumers]",
+		Short: "set the maximal number of Top N consumer chains the validator is automatically opted in to",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Note that the "max-top-n-consumers" argument cannot be lower than the min_top_n_budget param.
+			Setting it to 0 removes the budget.
+			Example:
+			%s set-top-n-budget 5`,
+				version.AppName),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			txf = txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			providerValAddr := clientCtx.GetFromAddress()
+
+			maxTopNConsumers, err := strconv.ParseUint(args[0], 10, 32)
+			if err != nil {
+				return err
+			}
+			submitter := clientCtx.GetFromAddress().String()
+			msg := types.NewMsgSetTopNBudget(uint32(maxTopNConsumers), sdk.ValAddress(providerValAddr), submitter)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
+
+func NewRetryLaunchCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "retry-launch [consumer-id]",
+		Short: "retry the launch of a consumer chain whose consumer client could not be created",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Schedules the launch of an initialized consumer chain whose consumer client could not be created
+at spawn time, e.g., after updating its initialization parameters. Note that only the owner of the chain can retry its launch.
+Example:
+%s tx provider retry-launch [consumer-id]
+`, version.AppName)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			txf = txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			owner := clientCtx.GetFromAddress().String()
+			msg := types.NewMsgRetryLaunch(owner, args[0])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
+
+func NewChangeConsumerChainIdCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "change-consumer-chain-id [consumer-id] [new-chain-id]",
+		Short: "schedule the change of the chain id of a launched consumer chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Schedules the change of the chain id of a launched consumer chain, e.g., for a hard fork of the chain.
+The change is applied once the consumer client is upgraded to the new chain id, e.g., through an IBC client upgrade
+or a client recovery proposal. The consumer id, key assignments, and reward attribution of the chain are preserved.
+Scheduling the current chain id cancels a pending change. Note that only the owner of the chain can change its chain id.
+Example:
+%s tx provider change-consumer-chain-id [consumer-id] [new-chain-id]
+`, version.AppName)),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			txf = txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			owner := clientCtx.GetFromAddress().String()
+			msg := types.NewMsgChangeConsumerChainId(owner, args[0], args[1])
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
+
+func NewRegisterConsumerClientUpgradeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-consumer-client-upgrade [consumer-id] [upgrade-height] [new-chain-id] [unbonding-period]",
+		Short: "register the client upgrade scheduled by a launched consumer chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Registers the IBC client upgrade scheduled by a launched consumer chain, e.g., through its upgrade plan.
+The upgrade height is given as {revision-number}-{revision-height} and must be in the current revision of the chain.
+Once a relayer upgrades the consumer client and updates it past the upgrade height, the provider verifies the upgraded
+client against the registered chain id and unbonding period and, if the chain id changes, applies the new chain id.
+The unbonding period is optional; if omitted, it is not verified. Note that only the owner of the chain can register
+a client upgrade.
+Example:
+%s tx provider register-consumer-client-upgrade [consumer-id] 1-1000 [new-chain-id] 504h
+`, version.AppName)),
+		Args: cobra.RangeArgs(3, 4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			txf = txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			upgradeHeight, err := clienttypes.ParseHeight(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid upgrade height: %w", err)
+			}
+
+			var unbondingPeriod time.Duration
+			if len(args) == 4 {
+				unbondingPeriod, err = time.ParseDuration(args[3])
+				if err != nil {
+					return fmt.Errorf("invalid unbonding period: %w", err)
+				}
+			}
+
+			owner := clientCtx.GetFromAddress().String()
+			msg := types.NewMsgRegisterConsumerClientUpgrade(owner, args[0], types.ConsumerClientUpgradePlan{
+				UpgradeHeight:   upgradeHeight,
+				NewChainId:      args[2],
+				UnbondingPeriod: unbondingPeriod,
+			})
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
+
+func NewRegisterConsumerProviderSwitchCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-consumer-provider-switch [consumer-id] [switch-height] [new-provider-chain-id]",
+		Short: "register the switch of a launched consumer chain to a different provider chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Registers the switch of a launched consumer chain to a different provider chain, i.e., the switch scheduled
+by the consumer chain through its governance. The switch height is given as {revision-number}-{revision-height} and must
+be in the current revision of the chain. Once the consumer client reaches the switch height, or once the consumer chain
+rejects a VSC packet, the consumer chain is stopped and its state is removed after the unbonding period. Note that only
+the owner of the chain can register a provider switch.
+Example:
+%s tx provider register-consumer-provider-switch [consumer-id] 1-1000 [new-provider-chain-id]
+`, version.AppName)),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			txf = txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			switchHeight, err := clienttypes.ParseHeight(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid switch height: %w", err)
+			}
+
+			owner := clientCtx.GetFromAddress().String()
+			msg := types.NewMsgRegisterConsumerProviderSwitch(owner, args[0], types.ConsumerProviderSwitch{
+				SwitchHeight:       switchHeight,
+				NewProviderChainId: args[2],
+			})
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
+
+func NewAttestConsumerHashesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "attest-consumer-hashes [consumer-id] [genesis-hash] [binary-hash]",
+		Short: "attest the final genesis and binary hashes of a consumer chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Attests that the hex-encoded genesis and binary hashes, which must match the ones in the initialization
+parameters of the consumer chain, are final. Updating any of the hashes afterwards invalidates the attestation, and
+the hashes can no longer be attested once the chain is launched. Note that only the owner of the chain can attest its hashes.
+Example:
+%s tx provider attest-consumer-hashes [consumer-id] [genesis-hash] [binary-hash]
+`, version.AppName)),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			txf = txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			owner := clientCtx.GetFromAddress().String()
+
+			genesisHash, err := hex.DecodeString(args[1])
+			if err != nil {
+				return fmt.Errorf("genesis hash decoding failed: %s", err)
+			}
+
+			binaryHash, err := hex.DecodeString(args[2])
+			if err != nil {
+				return fmt.Errorf("binary hash decoding failed: %s", err)
+			}
+
+			msg := types.NewMsgAttestConsumerHashes(owner, args[0], genesisHash, binaryHash)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
+
+func NewSignKeyAssignmentCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign-key-assignment [chain-id] [owner] [consumer-pubkey]",
+		Short: "sign a consumer key assignment to be included in the creation of a consumer chain",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Signs, with the operator account of a validator, the assignment of a consumer key for a consumer chain
+that is not yet created. The signed key assignment is printed and can be included by the owner in the 'key_assignments'
+of the create-consumer message. The signature is only valid for the given chain id and owner.
+Note that the operator account needs to have its public key on chain, i.e., it must have signed at least one transaction.
+Example:
+%s tx provider sign-key-assignment [chain-id] [owner] [consumer-pubkey] --from [operator-key]
+
+where [consumer-pubkey] has the following format: {"@type":"/cosmos.crypto.ed25519.PubKey","key":"..."}
+`, version.AppName)),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			providerValAddr := sdk.ValAddress(clientCtx.GetFromAddress())
+			signBytes, err := types.KeyAssignmentSignBytes(args[0], args[1], providerValAddr.String(), args[2])
+			if err != nil {
+				return err
+			}
+
+			signature, _, err := clientCtx.Keyring.SignByAddress(clientCtx.GetFromAddress(), signBytes, signing.SignMode_SIGN_MODE_DIRECT)
+			if err != nil {
+				return err
+			}
+
+			keyAssignment := types.SignedKeyAssignment{
+				ProviderAddr: providerValAddr.String(),
+				ConsumerKey:  args[2],
+				Signature:    signature,
+			}
+			if err := types.ValidateSignedKeyAssignments([]types.SignedKeyAssignment{keyAssignment}); err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&keyAssignment)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
+
+func NewKeyPossessionSigningRequestCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "key-possession-signing-request [consumer-id] [consumer-pubkey]",
+		Short: "create the signing request that proves the possession of a consumer key",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Creates the signing request of the assignment of a consumer key by the validator with the operator account --from.
+The sign bytes of the printed request need to be signed by the consumer key, e.g., by a remote signer or with the
+sign-key-possession command, and the signature provided to the assign-consensus-key command with the --%s flag.
+Example:
+%s tx provider key-possession-signing-request [consumer-id] [consumer-pubkey] --from [operator-key] > request.json
+
+where [consumer-pubkey] has the following format: {"@type":"/cosmos.crypto.ed25519.PubKey","key":"..."}
+`, FlagKeyPossessionProof, version.AppName)),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if clientCtx.ChainID == "" {
+				return fmt.Errorf("the provider chain id must be set with the --%s flag", flags.FlagChainID)
+			}
+			providerValAddr := sdk.ValAddress(clientCtx.GetFromAddress())
+			if _, _, err := types.ParseConsumerKeyFromJson(args[1]); err != nil {
+				return err
+			}
+			signBytes, err := types.KeyPossessionSignBytes(clientCtx.ChainID, args[0], providerValAddr.String())
+			if err != nil {
+				return err
+			}
+
+			request, err := json.MarshalIndent(KeyPossessionSigningRequest{
+				ProviderChainId: clientCtx.ChainID,
+				ConsumerId:      args[0],
+				ProviderAddr:    providerValAddr.String(),
+				ConsumerKey:     args[1],
+				SignBytes:       signBytes,
+			}, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintString(string(request) + "\n")
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
+
+func NewSignKeyPossessionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign-key-possession [signing-request-file] [priv-validator-key-file]",
+		Short: "sign a key possession signing request with a local consumer key",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Signs a key possession signing request with the consumer key stored in a CometBFT priv_validator_key.json file
+and prints the base64-encoded signature, which is provided to the assign-consensus-key command with the --%s flag.
+Validators using a remote signer need to sign the sign bytes of the request with the remote signer instead.
+Example:
+%s tx provider sign-key-possession request.json ~/.consumer/config/priv_validator_key.json
+`, FlagKeyPossessionProof, version.AppName)),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			requestJson, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			var request KeyPossessionSigningRequest
+			if err := json.Unmarshal(requestJson, &request); err != nil {
+				return fmt.Errorf("signing request unmarshalling failed: %s", err)
+			}
+
+			signBytes, err := types.KeyPossessionSignBytes(request.ProviderChainId, request.ConsumerId, request.ProviderAddr)
+			if err != nil {
+				return err
+			}
+			if !bytes.Equal(signBytes, request.SignBytes) {
+				return fmt.Errorf("the sign bytes of the signing request do not match its provider chain id, consumer id and provider address")
+			}
+			providerValAddr, err := sdk.ValAddressFromBech32(request.ProviderAddr)
+			if err != nil {
+				return err
+			}
+
+			consumerKey, signature, err := providerclient.KeyPossessionProofFromPrivValidatorKeyFile(args[1], request.ProviderChainId, request.ConsumerId, providerValAddr)
+			if err != nil {
+				return err
+			}
+			if consumerKey != request.ConsumerKey {
+				requestKey, err := providerclient.ParseConsumerKey(request.ConsumerKey)
+				if err != nil {
+					return err
+				}
+				if fileKey, err := providerclient.ParseConsumerKey(consumerKey); err != nil || !requestKey.Equals(fileKey) {
+					return fmt.Errorf("the key in %s is not the consumer key of the signing request", args[1])
+				}
+			}
+
+			return clientCtx.PrintString(base64.StdEncoding.EncodeToString(signature) + "\n")
+		},
+	}
+
+	return cmd
+}
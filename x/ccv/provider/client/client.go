@@ -0,0 +1,185 @@
+package client
+
+import (
+	"context"
+
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+
+	"cosmossdk.io/math"
+
+	sdkquery "github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+	ccvtypes "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// Client wraps the gRPC query client of the provider module with typed helpers,
+// so that tools do not need to build the requests and unpack the responses themselves.
+// The underlying query client is embedded and can be used for the queries without a helper.
+type Client struct {
+	types.QueryClient
+}
+
+// NewClient returns a Client that sends the provider queries over `conn`,
+// e.g., a `client.Context` or a `*grpc.ClientConn`
+func NewClient(conn gogogrpc.ClientConn) Client {
+	return Client{QueryClient: types.NewQueryClient(conn)}
+}
+
+// Params returns the parameters of the provider module
+func (c Client) Params(ctx context.Context) (types.Params, error) {
+	res, err := c.QueryParams(ctx, &types.QueryParamsRequest{})
+	if err != nil {
+		return types.Params{}, err
+	}
+	return res.Params, nil
+}
+
+// ConsumerChain returns the consumer chain with `consumerId`
+func (c Client) ConsumerChain(ctx context.Context, consumerId string) (*types.QueryConsumerChainResponse, error) {
+	return c.QueryConsumerChain(ctx, &types.QueryConsumerChainRequest{ConsumerId: consumerId})
+}
+
+// ConsumerChains returns all the consumer chains in the given `phase`, following the pagination
+// of the query. If `phase` is CONSUMER_PHASE_UNSPECIFIED, the consumer chains in all phases are returned.
+func (c Client) ConsumerChains(ctx context.Context, phase types.ConsumerPhase) ([]*types.Chain, error) {
+	var chains []*types.Chain
+	pagination := &sdkquery.PageRequest{}
+	for {
+		res, err := c.QueryConsumerChains(ctx, &types.QueryConsumerChainsRequest{
+			Phase:      phase,
+			Pagination: pagination,
+		})
+		if err != nil {
+			return nil, err
+		}
+		chains = append(chains, res.Chains...)
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return chains, nil
+		}
+		pagination = &sdkquery.PageRequest{Key: res.Pagination.NextKey}
+	}
+}
+
+// ConsumerGenesis returns the genesis state of the launched consumer chain with `consumerId`
+func (c Client) ConsumerGenesis(ctx context.Context, consumerId string) (ccvtypes.ConsumerGenesisState, error) {
+	res, err := c.QueryConsumerGenesis(ctx, &types.QueryConsumerGenesisRequest{ConsumerId: consumerId})
+	if err != nil {
+		return ccvtypes.ConsumerGenesisState{}, err
+	}
+	return res.GenesisState, nil
+}
+
+// ConsumerIdFromClientId returns the id of the consumer chain tracked by the provider client with `clientId`
+func (c Client) ConsumerIdFromClientId(ctx context.Context, clientId string) (string, error) {
+	res, err := c.QueryConsumerIdFromClientId(ctx, &types.QueryConsumerIdFromClientIdRequest{ClientId: clientId})
+	if err != nil {
+		return "", err
+	}
+	return res.ConsumerId, nil
+}
+
+// ValidatorConsumerAddr returns the consensus address that the validator with the provider consensus
+// address `providerAddr` uses on the consumer chain with `consumerId`
+func (c Client) ValidatorConsumerAddr(ctx context.Context, consumerId, providerAddr string) (string, error) {
+	res, err := c.QueryValidatorConsumerAddr(ctx, &types.QueryValidatorConsumerAddrRequest{
+		ConsumerId:      consumerId,
+		ProviderAddress: providerAddr,
+	})
+	if err != nil {
+		return "", err
+	}
+	return res.ConsumerAddress, nil
+}
+
+// ValidatorProviderAddr returns the provider consensus address of the validator that uses
+// the consensus address `consumerAddr` on the consumer chain with `consumerId`
+func (c Client) ValidatorProviderAddr(ctx context.Context, consumerId, consumerAddr string) (string, error) {
+	res, err := c.QueryValidatorProviderAddr(ctx, &types.QueryValidatorProviderAddrRequest{
+		ConsumerId:      consumerId,
+		ConsumerAddress: consumerAddr,
+	})
+	if err != nil {
+		return "", err
+	}
+	return res.ProviderAddress, nil
+}
+
+// ConsumerValidators returns the validator set of the consumer chain with `consumerId`
+func (c Client) ConsumerValidators(ctx context.Context, consumerId string) ([]*types.QueryConsumerValidatorsValidator, error) {
+	res, err := c.QueryConsumerValidators(ctx, &types.QueryConsumerValidatorsRequest{ConsumerId: consumerId})
+	if err != nil {
+		return nil, err
+	}
+	return res.Validators, nil
+}
+
+// OptedInValidators returns the provider consensus addresses of the validators
+// that are opted in to the consumer chain with `consumerId`
+func (c Client) OptedInValidators(ctx context.Context, consumerId string) ([]string, error) {
+	res, err := c.QueryConsumerChainOptedInValidators(ctx, &types.QueryConsumerChainOptedInValidatorsRequest{ConsumerId: consumerId})
+	if err != nil {
+		return nil, err
+	}
+	return res.ValidatorsProviderAddresses, nil
+}
+
+// ConsumerChainsValidatorHasToValidate returns the ids of the consumer chains that the validator
+// with the provider consensus address `providerAddr` has to validate
+func (c Client) ConsumerChainsValidatorHasToValidate(ctx context.Context, providerAddr string) ([]string, error) {
+	res, err := c.QueryConsumerChainsValidatorHasToValidate(ctx, &types.QueryConsumerChainsValidatorHasToValidateRequest{
+		ProviderAddress: providerAddr,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.ConsumerIds, nil
+}
+
+// ValidatorConsumerCommissionRate returns the commission rate that the validator with the provider
+// consensus address `providerAddr` charges on the consumer chain with `consumerId`
+func (c Client) ValidatorConsumerCommissionRate(ctx context.Context, consumerId, providerAddr string) (math.LegacyDec, error) {
+	res, err := c.QueryValidatorConsumerCommissionRate(ctx, &types.QueryValidatorConsumerCommissionRateRequest{
+		ConsumerId:      consumerId,
+		ProviderAddress: providerAddr,
+	})
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+	return res.Rate, nil
+}
+
+// ValidatorCCVSummary returns a summary of the staking and CCV state of the validator with the operator address `valAddr`
+func (c Client) ValidatorCCVSummary(ctx context.Context, valAddr string) (*types.QueryValidatorCCVSummaryResponse, error) {
+	return c.QueryValidatorCCVSummary(ctx, &types.QueryValidatorCCVSummaryRequest{ValidatorAddress: valAddr})
+}
+
+// ConsumerPacketStats returns the number of CCV packets exchanged with the consumer chain with `consumerId`
+func (c Client) ConsumerPacketStats(ctx context.Context, consumerId string) (types.ConsumerPacketStats, error) {
+	res, err := c.QueryConsumerPacketStats(ctx, &types.QueryConsumerPacketStatsRequest{ConsumerId: consumerId})
+	if err != nil {
+		return types.ConsumerPacketStats{}, err
+	}
+	return res.Stats, nil
+}
+
+// ModuleVersionInfo returns the version of the provider module and the features enabled on the provider chain
+func (c Client) ModuleVersionInfo(ctx context.Context) (ccvtypes.ModuleVersionInfo, error) {
+	res, err := c.QueryModuleVersionInfo(ctx, &types.QueryModuleVersionInfoRequest{})
+	if err != nil {
+		return ccvtypes.ModuleVersionInfo{}, err
+	}
+	return res.VersionInfo, nil
+}
+
+// ConsumerRewardEstimate returns the estimated annual ICS rewards of a validator for a consumer chain
+func (c Client) ConsumerRewardEstimate(ctx context.Context, consumerId, providerAddr string) (types.ConsumerRewardEstimate, error) {
+	res, err := c.QueryConsumerRewardEstimate(ctx, &types.QueryConsumerRewardEstimateRequest{
+		ConsumerId:      consumerId,
+		ProviderAddress: providerAddr,
+	})
+	if err != nil {
+		return types.ConsumerRewardEstimate{}, err
+	}
+	return res.Estimate, nil
+}
@@ -0,0 +1,206 @@
+package client
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	cmtcrypto "github.com/cometbft/cometbft/crypto"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+	"github.com/cometbft/cometbft/privval"
+
+	"cosmossdk.io/math"
+
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+)
+
+// ed25519PubKeyType is the only type of consumer keys accepted by the provider module
+const ed25519PubKeyType = "/cosmos.crypto.ed25519.PubKey"
+
+// ConsumerKeyFromPubKey encodes `pubKey` in the JSON format expected by MsgAssignConsumerKey and MsgOptIn,
+// i.e., the format output by `interchain-security-cd tendermint show-validator`
+func ConsumerKeyFromPubKey(pubKey cryptotypes.PubKey) (string, error) {
+	if _, ok := pubKey.(*ed25519.PubKey); !ok {
+		return "", fmt.Errorf("unsupported consumer key type %T, expected: %s", pubKey, ed25519PubKeyType)
+	}
+	bz, err := json.Marshal(struct {
+		Type string `json:"@type"`
+		Key  string `json:"key"`
+	}{
+		Type: ed25519PubKeyType,
+		Key:  base64.StdEncoding.EncodeToString(pubKey.Bytes()),
+	})
+	if err != nil {
+		return "", err
+	}
+	return string(bz), nil
+}
+
+// ConsumerKeyFromCmtPubKey encodes the CometBFT public key `pubKey` in the JSON format expected by
+// MsgAssignConsumerKey and MsgOptIn
+func ConsumerKeyFromCmtPubKey(pubKey cmtcrypto.PubKey) (string, error) {
+	sdkPubKey, err := cryptocodec.FromCmtPubKeyInterface(pubKey)
+	if err != nil {
+		return "", err
+	}
+	return ConsumerKeyFromPubKey(sdkPubKey)
+}
+
+// ConsumerKeyFromPrivValidatorKeyFile reads the public key from the CometBFT private validator key file
+// at `path` (i.e., `priv_validator_key.json`) and encodes it in the JSON format expected by
+// MsgAssignConsumerKey and MsgOptIn
+func ConsumerKeyFromPrivValidatorKeyFile(path string) (string, error) {
+	pvKey, err := readPrivValidatorKeyFile(path)
+	if err != nil {
+		return "", err
+	}
+	return ConsumerKeyFromCmtPubKey(pvKey.PubKey)
+}
+
+// KeyPossessionProofFromPrivValidatorKeyFile signs, with the private key in the CometBFT private validator key file
+// at `path`, the key possession sign bytes of the assignment of the key to the validator with operator address `valAddr`
+// on the consumer chain with `consumerId` of the provider chain with `providerChainId`.
+// It returns the consumer key and the proof of its possession.
+func KeyPossessionProofFromPrivValidatorKeyFile(path, providerChainId, consumerId string, valAddr sdk.ValAddress) (string, []byte, error) {
+	pvKey, err := readPrivValidatorKeyFile(path)
+	if err != nil {
+		return "", nil, err
+	}
+	if pvKey.PrivKey == nil {
+		return "", nil, fmt.Errorf("private validator key file %s does not contain a private key", path)
+	}
+	consumerKey, err := ConsumerKeyFromCmtPubKey(pvKey.PubKey)
+	if err != nil {
+		return "", nil, err
+	}
+	signBytes, err := types.KeyPossessionSignBytes(providerChainId, consumerId, valAddr.String())
+	if err != nil {
+		return "", nil, err
+	}
+	proof, err := pvKey.PrivKey.Sign(signBytes)
+	if err != nil {
+		return "", nil, err
+	}
+	return consumerKey, proof, nil
+}
+
+// readPrivValidatorKeyFile reads the CometBFT private validator key file at `path`
+func readPrivValidatorKeyFile(path string) (privval.FilePVKey, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return privval.FilePVKey{}, err
+	}
+	var pvKey privval.FilePVKey
+	if err := cmtjson.Unmarshal(bz, &pvKey); err != nil {
+		return privval.FilePVKey{}, fmt.Errorf("failed to parse private validator key file %s: %w", path, err)
+	}
+	if pvKey.PubKey == nil {
+		return privval.FilePVKey{}, fmt.Errorf("private validator key file %s does not contain a public key", path)
+	}
+	return pvKey, nil
+}
+
+// ParseConsumerKey parses a consumer key in the JSON format expected by MsgAssignConsumerKey and MsgOptIn
+func ParseConsumerKey(consumerKey string) (cryptotypes.PubKey, error) {
+	pkType, keyStr, err := types.ParseConsumerKeyFromJson(consumerKey)
+	if err != nil {
+		return nil, err
+	}
+	if pkType != ed25519PubKeyType {
+		return nil, fmt.Errorf("unsupported consumer key type %s, expected: %s", pkType, ed25519PubKeyType)
+	}
+	bz, err := base64.StdEncoding.DecodeString(keyStr)
+	if err != nil {
+		return nil, err
+	}
+	if len(bz) != ed25519.PubKeySize {
+		return nil, fmt.Errorf("invalid ed25519 public key size: expected %d, got %d", ed25519.PubKeySize, len(bz))
+	}
+	return &ed25519.PubKey{Key: bz}, nil
+}
+
+// NewMsgAssignConsumerKey returns a validated MsgAssignConsumerKey that assigns `consumerKey`
+// to the validator with operator address `valAddr` on the consumer chain with `consumerId`.
+// The message is signed by the account of the validator operator.
+func NewMsgAssignConsumerKey(consumerId string, valAddr sdk.ValAddress, consumerKey cryptotypes.PubKey) (*types.MsgAssignConsumerKey, error) {
+	key, err := ConsumerKeyFromPubKey(consumerKey)
+	if err != nil {
+		return nil, err
+	}
+	msg, err := types.NewMsgAssignConsumerKey(consumerId, valAddr, key, sdk.AccAddress(valAddr).String())
+	if err != nil {
+		return nil, err
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// NewMsgAssignConsumerKeyWithProof returns a validated MsgAssignConsumerKeyWithProof that assigns `consumerKey`
+// to the validator with operator address `valAddr` on the consumer chain with `consumerId`, where `proof` is the
+// signature of `consumerKey` over the KeyPossessionSignDoc of the key assignment.
+// The message is signed by the account of the validator operator.
+func NewMsgAssignConsumerKeyWithProof(consumerId string, valAddr sdk.ValAddress, consumerKey cryptotypes.PubKey, proof []byte) (*types.MsgAssignConsumerKeyWithProof, error) {
+	key, err := ConsumerKeyFromPubKey(consumerKey)
+	if err != nil {
+		return nil, err
+	}
+	msg := types.NewMsgAssignConsumerKeyWithProof(consumerId, valAddr, key, sdk.AccAddress(valAddr).String(), proof)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// NewMsgOptIn returns a validated MsgOptIn that opts in the validator with operator address `valAddr`
+// to the consumer chain with `consumerId`. If `consumerKey` is nil, no consumer key is assigned.
+// The message is signed by the account of the validator operator.
+func NewMsgOptIn(consumerId string, valAddr sdk.ValAddress, consumerKey cryptotypes.PubKey) (*types.MsgOptIn, error) {
+	var key string
+	if consumerKey != nil {
+		var err error
+		if key, err = ConsumerKeyFromPubKey(consumerKey); err != nil {
+			return nil, err
+		}
+	}
+	msg, err := types.NewMsgOptIn(consumerId, valAddr, key, sdk.AccAddress(valAddr).String())
+	if err != nil {
+		return nil, err
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// NewMsgOptOut returns a validated MsgOptOut that opts out the validator with operator address `valAddr`
+// from the consumer chain with `consumerId`.
+// The message is signed by the account of the validator operator.
+func NewMsgOptOut(consumerId string, valAddr sdk.ValAddress) (*types.MsgOptOut, error) {
+	msg, err := types.NewMsgOptOut(consumerId, valAddr, sdk.AccAddress(valAddr).String())
+	if err != nil {
+		return nil, err
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// NewMsgSetConsumerCommissionRate returns a validated MsgSetConsumerCommissionRate that sets the commission
+// rate of the validator with operator address `valAddr` on the consumer chain with `consumerId` to `rate`.
+// The message is signed by the account of the validator operator.
+func NewMsgSetConsumerCommissionRate(consumerId string, valAddr sdk.ValAddress, rate math.LegacyDec) (*types.MsgSetConsumerCommissionRate, error) {
+	msg := types.NewMsgSetConsumerCommissionRate(consumerId, rate, valAddr, sdk.AccAddress(valAddr).String())
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
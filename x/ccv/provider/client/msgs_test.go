@@ -0,0 +1,91 @@
+package client_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cometbft/cometbft/privval"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/provider/client"
+	"github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+)
+
+func TestConsumerKeyEncoding(t *testing.T) {
+	pubKey := ed25519.GenPrivKey().PubKey()
+
+	consumerKey, err := client.ConsumerKeyFromPubKey(pubKey)
+	require.NoError(t, err)
+	pkType, _, err := types.ParseConsumerKeyFromJson(consumerKey)
+	require.NoError(t, err)
+	require.Equal(t, "/cosmos.crypto.ed25519.PubKey", pkType)
+
+	parsed, err := client.ParseConsumerKey(consumerKey)
+	require.NoError(t, err)
+	require.True(t, pubKey.Equals(parsed))
+
+	// only ed25519 keys are supported
+	_, err = client.ConsumerKeyFromPubKey(secp256k1.GenPrivKey().PubKey())
+	require.Error(t, err)
+	_, err = client.ParseConsumerKey(`{"@type":"/cosmos.crypto.secp256k1.PubKey","key":"Ui5Gf1+mtWUdH8u3xlmzdKID+F3PK0sfXZ73GZ6q6is="}`)
+	require.Error(t, err)
+	_, err = client.ParseConsumerKey(`{"@type":"/cosmos.crypto.ed25519.PubKey","key":"AAAA"}`)
+	require.Error(t, err)
+	_, err = client.ParseConsumerKey("invalid")
+	require.Error(t, err)
+}
+
+func TestConsumerKeyFromPrivValidatorKeyFile(t *testing.T) {
+	dir := t.TempDir()
+	keyFile := filepath.Join(dir, "priv_validator_key.json")
+	pv := privval.GenFilePV(keyFile, filepath.Join(dir, "priv_validator_state.json"))
+	pv.Save()
+
+	consumerKey, err := client.ConsumerKeyFromPrivValidatorKeyFile(keyFile)
+	require.NoError(t, err)
+	expectedConsumerKey, err := client.ConsumerKeyFromCmtPubKey(pv.Key.PubKey)
+	require.NoError(t, err)
+	require.Equal(t, expectedConsumerKey, consumerKey)
+
+	parsed, err := client.ParseConsumerKey(consumerKey)
+	require.NoError(t, err)
+	require.Equal(t, pv.Key.PubKey.Bytes(), parsed.Bytes())
+
+	_, err = client.ConsumerKeyFromPrivValidatorKeyFile(filepath.Join(dir, "missing.json"))
+	require.Error(t, err)
+}
+
+func TestKeyPossessionProofFromPrivValidatorKeyFile(t *testing.T) {
+	dir := t.TempDir()
+	keyFile := filepath.Join(dir, "priv_validator_key.json")
+	pv := privval.GenFilePV(keyFile, filepath.Join(dir, "priv_validator_state.json"))
+	pv.Save()
+	valAddr := sdk.ValAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	consumerKey, proof, err := client.KeyPossessionProofFromPrivValidatorKeyFile(keyFile, "provider", "0", valAddr)
+	require.NoError(t, err)
+	parsed, err := client.ParseConsumerKey(consumerKey)
+	require.NoError(t, err)
+
+	// the proof is a signature of the consumer key over the sign doc of the key assignment
+	signBytes, err := types.KeyPossessionSignBytes("provider", "0", valAddr.String())
+	require.NoError(t, err)
+	require.True(t, parsed.VerifySignature(signBytes, proof))
+
+	// the proof is bound to the provider chain
+	signBytes, err = types.KeyPossessionSignBytes("other-provider", "0", valAddr.String())
+	require.NoError(t, err)
+	require.False(t, parsed.VerifySignature(signBytes, proof))
+
+	msg, err := client.NewMsgAssignConsumerKeyWithProof("0", valAddr, parsed, proof)
+	require.NoError(t, err)
+	require.Equal(t, consumerKey, msg.ConsumerKey)
+
+	_, _, err = client.KeyPossessionProofFromPrivValidatorKeyFile(filepath.Join(dir, "missing.json"), "provider", "0", valAddr)
+	require.Error(t, err)
+}
@@ -0,0 +1,156 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+	ccvtypes "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// OperationsRoute is the route of the API server returning the balance operations performed by the provider module
+// in the block at the `height` query parameter (see OperationsHandler)
+const OperationsRoute = "/interchain_security/ccv/provider/operations"
+
+// Types of the balance operations performed by the provider module
+const (
+	// OperationTypeConsumerRewards credits the rewards received from a consumer chain to the consumer rewards pool
+	OperationTypeConsumerRewards = "ccv_consumer_rewards"
+	// OperationTypeValidatorRewards moves the rewards of a consumer chain allocated to the validators
+	// from the consumer rewards pool to the distribution module account
+	OperationTypeValidatorRewards = "ccv_validator_rewards"
+	// OperationTypeCommunityPoolRewards moves the rewards of a consumer chain allocated to the community pool
+	// from the consumer rewards pool to the distribution module account
+	OperationTypeCommunityPoolRewards = "ccv_community_pool_rewards"
+	// OperationTypeConsumerInfractionSlash debits the tokens slashed for an infraction committed on a consumer chain
+	// from the stake of the validator
+	OperationTypeConsumerInfractionSlash = "ccv_consumer_infraction_slash"
+)
+
+// Operation is a change of the balance of an account caused by the provider module, in the form
+// used by Rosetta and similar data APIs, i.e., a signed amount of a single denom for a single account
+type Operation struct {
+	// the type of the operation, i.e., one of the OperationType constants
+	Type string `json:"type"`
+	// the id of the consumer chain the operation is related to
+	ConsumerId string `json:"consumer_id"`
+	// the address of the account whose balance changed; for slashes, the operator address of the validator
+	Account string `json:"account"`
+	Denom   string `json:"denom"`
+	// the change of the balance, negative for debits
+	Amount math.Int `json:"amount"`
+}
+
+// OperationsFromEvents extracts the balance operations performed by the provider module from the ABCI events
+// of a block or of a transaction, in the order of the events. The bank events of the same block or transaction
+// contain the same transfers, but do not indicate the consumer chain they relate to.
+// The tokens slashed for consumer infractions are burned from the staking pools and denominated in `bondDenom`.
+func OperationsFromEvents(events []abci.Event, bondDenom string) ([]Operation, error) {
+	rewardsPool := authtypes.NewModuleAddress(types.ConsumerRewardsPool).String()
+	distribution := authtypes.NewModuleAddress(distrtypes.ModuleName).String()
+
+	operations := []Operation{}
+	for _, event := range events {
+		attrs := make(map[string]string, len(event.Attributes))
+		for _, attr := range event.Attributes {
+			attrs[attr.Key] = attr.Value
+		}
+		if attrs[sdk.AttributeKeyModule] != types.ModuleName {
+			continue
+		}
+		consumerId := attrs[types.AttributeConsumerId]
+
+		switch event.Type {
+		case types.EventTypeUpdateConsumer:
+			// rewards received from a consumer chain are reported in an update_consumer event
+			amountStr, found := attrs[types.AttributeRewardAmount]
+			if !found {
+				continue
+			}
+			amount, ok := math.NewIntFromString(amountStr)
+			if !ok {
+				return nil, fmt.Errorf("invalid %s attribute in %s event: %s", types.AttributeRewardAmount, event.Type, amountStr)
+			}
+			operations = append(operations, Operation{
+				Type:       OperationTypeConsumerRewards,
+				ConsumerId: consumerId,
+				Account:    rewardsPool,
+				Denom:      attrs[types.AttributeRewardDenom],
+				Amount:     amount,
+			})
+		case types.EventTypeDistributedRewards:
+			for _, allocation := range []struct {
+				attribute     string
+				operationType string
+			}{
+				{types.AttributeRewardDistributed, OperationTypeValidatorRewards},
+				{types.AttributeRewardCommunityPool, OperationTypeCommunityPoolRewards},
+			} {
+				coins, err := sdk.ParseCoinsNormalized(attrs[allocation.attribute])
+				if err != nil {
+					return nil, fmt.Errorf("invalid %s attribute in %s event: %w", allocation.attribute, event.Type, err)
+				}
+				for _, coin := range coins {
+					operations = append(operations,
+						Operation{
+							Type:       allocation.operationType,
+							ConsumerId: consumerId,
+							Account:    rewardsPool,
+							Denom:      coin.Denom,
+							Amount:     coin.Amount.Neg(),
+						},
+						Operation{
+							Type:       allocation.operationType,
+							ConsumerId: consumerId,
+							Account:    distribution,
+							Denom:      coin.Denom,
+							Amount:     coin.Amount,
+						},
+					)
+				}
+			}
+		case types.EventTypeSlashConsumerInfraction:
+			amountStr := attrs[types.AttributeSlashedTokens]
+			amount, ok := math.NewIntFromString(amountStr)
+			if !ok {
+				return nil, fmt.Errorf("invalid %s attribute in %s event: %s", types.AttributeSlashedTokens, event.Type, amountStr)
+			}
+			if amount.IsZero() {
+				continue
+			}
+			operations = append(operations, Operation{
+				Type:       OperationTypeConsumerInfractionSlash,
+				ConsumerId: consumerId,
+				Account:    attrs[types.AttributeProviderValidatorAddress],
+				Denom:      bondDenom,
+				Amount:     amount.Neg(),
+			})
+		}
+	}
+
+	return operations, nil
+}
+
+// OperationsHandler returns the handler of OperationsRoute, which extracts the balance operations performed by
+// the provider module from the events of a block queried through `clientCtx`
+func OperationsHandler(clientCtx client.Context) http.HandlerFunc {
+	return ccvtypes.NewBlockOperationsHandler(clientCtx,
+		func(ctx context.Context, clientCtx client.Context, events []abci.Event) ([]Operation, error) {
+			res, err := stakingtypes.NewQueryClient(clientCtx).Params(ctx, &stakingtypes.QueryParamsRequest{})
+			if err != nil {
+				return nil, err
+			}
+			return OperationsFromEvents(events, res.Params.BondDenom)
+		})
+}
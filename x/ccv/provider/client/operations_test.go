@@ -0,0 +1,78 @@
+package client_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/provider/client"
+	"github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+)
+
+func TestOperationsFromEvents(t *testing.T) {
+	rewardsPool := authtypes.NewModuleAddress(types.ConsumerRewardsPool).String()
+	distribution := authtypes.NewModuleAddress(distrtypes.ModuleName).String()
+	valoper := "cosmosvaloper1dkas8mu4kyhl5jrh4nzvm65qz588hy9qakmjnw"
+
+	events := sdk.Events{
+		// rewards received from consumer chain 0
+		sdk.NewEvent(types.EventTypeUpdateConsumer,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeConsumerId, "0"),
+			sdk.NewAttribute(types.AttributeRewardDenom, "ibc/rewards"),
+			sdk.NewAttribute(types.AttributeRewardAmount, "100"),
+			sdk.NewAttribute(types.AttributeRewardDistribution, "scheduled"),
+		),
+		// update of consumer chain 1 unrelated to rewards
+		sdk.NewEvent(types.EventTypeUpdateConsumer,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeConsumerId, "1"),
+		),
+		// event of another module
+		sdk.NewEvent(types.EventTypeDistributedRewards,
+			sdk.NewAttribute(sdk.AttributeKeyModule, "other"),
+			sdk.NewAttribute(types.AttributeRewardDistributed, "1000stake"),
+		),
+		// rewards of consumer chain 0 distributed
+		sdk.NewEvent(types.EventTypeDistributedRewards,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeConsumerId, "0"),
+			sdk.NewAttribute(types.AttributeRewardTotal, "100.500000000000000000ibc/rewards"),
+			sdk.NewAttribute(types.AttributeRewardDistributed, "90ibc/rewards"),
+			sdk.NewAttribute(types.AttributeRewardCommunityPool, "10ibc/rewards"),
+		),
+		// validator slashed for a double vote on consumer chain 1
+		sdk.NewEvent(types.EventTypeSlashConsumerInfraction,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeConsumerId, "1"),
+			sdk.NewAttribute(types.AttributeProviderValidatorAddress, valoper),
+			sdk.NewAttribute(types.AttributeSlashedTokens, "500"),
+		),
+	}
+
+	operations, err := client.OperationsFromEvents(events.ToABCIEvents(), "stake")
+	require.NoError(t, err)
+	require.Equal(t, []client.Operation{
+		{Type: client.OperationTypeConsumerRewards, ConsumerId: "0", Account: rewardsPool, Denom: "ibc/rewards", Amount: math.NewInt(100)},
+		{Type: client.OperationTypeValidatorRewards, ConsumerId: "0", Account: rewardsPool, Denom: "ibc/rewards", Amount: math.NewInt(-90)},
+		{Type: client.OperationTypeValidatorRewards, ConsumerId: "0", Account: distribution, Denom: "ibc/rewards", Amount: math.NewInt(90)},
+		{Type: client.OperationTypeCommunityPoolRewards, ConsumerId: "0", Account: rewardsPool, Denom: "ibc/rewards", Amount: math.NewInt(-10)},
+		{Type: client.OperationTypeCommunityPoolRewards, ConsumerId: "0", Account: distribution, Denom: "ibc/rewards", Amount: math.NewInt(10)},
+		{Type: client.OperationTypeConsumerInfractionSlash, ConsumerId: "1", Account: valoper, Denom: "stake", Amount: math.NewInt(-500)},
+	}, operations)
+
+	// invalid amounts are reported
+	_, err = client.OperationsFromEvents(sdk.Events{
+		sdk.NewEvent(types.EventTypeSlashConsumerInfraction,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeSlashedTokens, "invalid"),
+		),
+	}.ToABCIEvents(), "stake")
+	require.Error(t, err)
+}
@@ -193,6 +193,22 @@ func (im IBCMiddleware) OnRecvPacket(
 
 		coinAmt, _ := math.NewIntFromString(data.Amount)
 		coinDenom := GetProviderDenom(data.Denom, packet)
+		allowlisted, err := im.keeper.ApplyRewardDenomHint(ctx, consumerId, data.Denom, coinDenom)
+		if err != nil {
+			logger.Error(
+				"cannot allowlist the reward denom hint of the consumer chain",
+				"consumerId", consumerId,
+				"denom", coinDenom,
+				"error", err.Error(),
+			)
+		} else if allowlisted {
+			logger.Info(
+				"allowlisted reward denom from the consumer chain's reward denom hint",
+				"consumerId", consumerId,
+				"chainId", chainId,
+				"denom", coinDenom,
+			)
+		}
 		logger.Info(
 			"received ICS rewards from consumer chain",
 			"consumerId", consumerId,
@@ -243,6 +259,8 @@ func (im IBCMiddleware) OnRecvPacket(
 			)
 			return ack
 		}
+		im.keeper.AppendFundFlowRecord(ctx, types.FUND_FLOW_TYPE_CONSUMER_REWARDS, types.ConsumerRewardsPool, consumerId,
+			sdk.Coins{{Denom: coinDenom, Amount: coinAmt}})
 
 		logger.Info(
 			"scheduled ICS rewards to be distributed",
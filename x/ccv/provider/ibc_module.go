@@ -1,7 +1,6 @@
 package provider
 
 import (
-	"errors"
 	"fmt"
 	"strconv"
 
@@ -82,6 +81,10 @@ func (am AppModule) OnChanOpenTry(
 		ProviderFeePoolAddr: am.keeper.GetConsumerRewardsPoolAddressStr(ctx),
 		Version:             ccv.Version,
 	}
+	// if the consumer chain already has a CCV channel, then this channel is a migration channel
+	if previousChannelID, found := am.keeper.GetEstablishedChannelForConnection(ctx, connectionHops[0]); found {
+		md.PreviousChannelId = previousChannelID
+	}
 	mdBz, err := (&md).Marshal()
 	if err != nil {
 		return "", errorsmod.Wrapf(ccv.ErrInvalidHandshakeMetadata,
@@ -188,18 +191,64 @@ func (am AppModule) OnRecvPacket(
 		var err error
 		switch consumerPacket.Type {
 		case ccv.VscMaturedPacket:
-			// ignore VSCMaturedPacket
+			// ignore VSCMaturedPacket, but record its receipt
+			am.keeper.RecordVSCMaturedPacketReceived(ctx, packet.DestinationChannel)
 			err = nil
 		case ccv.SlashPacket:
 			// handle SlashPacket
 			var ackResult ccv.PacketAckResult
 			data := *consumerPacket.GetSlashPacketData()
-			ackResult, err = am.keeper.OnRecvSlashPacket(ctx, packet, data)
+			err = ccv.RunWithRecovery(ctx, providertypes.ModuleName, func(ctx sdk.Context) (err error) {
+				ackResult, err = am.keeper.OnRecvSlashPacket(ctx, packet, data)
+				return err
+			})
+			err = ccv.HandleUnexpectedState(ctx, providertypes.ModuleName, err)
 			if err == nil {
 				ack = channeltypes.NewResultAcknowledgement(ackResult)
-				logger.Info("successfully handled SlashPacket", "sequence", packet.Sequence)
+				am.keeper.SubsystemLogger(ctx, ccv.LogSubsystemSlash).Info("successfully handled SlashPacket", "sequence", packet.Sequence)
+				eventAttributes = append(eventAttributes, sdk.NewAttribute(ccv.AttributeValSetUpdateID, strconv.Itoa(int(data.ValsetUpdateId))))
+			}
+		case ccv.ConsumerShutdownPacket:
+			// handle ConsumerShutdownPacket
+			data := *consumerPacket.GetConsumerShutdownPacketData()
+			err = ccv.RunWithRecovery(ctx, providertypes.ModuleName, func(ctx sdk.Context) error {
+				return am.keeper.OnRecvConsumerShutdownPacket(ctx, packet, data)
+			})
+			err = ccv.HandleUnexpectedState(ctx, providertypes.ModuleName, err)
+			if err == nil {
+				logger.Info("successfully handled ConsumerShutdownPacket", "sequence", packet.Sequence)
 				eventAttributes = append(eventAttributes, sdk.NewAttribute(ccv.AttributeValSetUpdateID, strconv.Itoa(int(data.ValsetUpdateId))))
 			}
+		case ccv.ConsumerMisbehaviourPacket:
+			// handle ConsumerMisbehaviourPacket
+			data := *consumerPacket.GetConsumerMisbehaviourPacketData()
+			err = ccv.RunWithRecovery(ctx, providertypes.ModuleName, func(ctx sdk.Context) error {
+				return am.keeper.OnRecvConsumerMisbehaviourPacket(ctx, packet, data)
+			})
+			err = ccv.HandleUnexpectedState(ctx, providertypes.ModuleName, err)
+			if err == nil {
+				logger.Info("successfully handled ConsumerMisbehaviourPacket", "sequence", packet.Sequence)
+			}
+		case ccv.ConsumerHeartbeatPacket:
+			// handle ConsumerHeartbeatPacket
+			data := *consumerPacket.GetConsumerHeartbeatPacketData()
+			err = ccv.RunWithRecovery(ctx, providertypes.ModuleName, func(ctx sdk.Context) error {
+				return am.keeper.OnRecvConsumerHeartbeatPacket(ctx, packet, data)
+			})
+			err = ccv.HandleUnexpectedState(ctx, providertypes.ModuleName, err)
+			if err == nil {
+				logger.Info("successfully handled ConsumerHeartbeatPacket", "sequence", packet.Sequence)
+			}
+		case ccv.ConsumerDoubleVotePacket:
+			// handle ConsumerDoubleVotePacket
+			data := *consumerPacket.GetConsumerDoubleVotePacketData()
+			err = ccv.RunWithRecovery(ctx, providertypes.ModuleName, func(ctx sdk.Context) error {
+				return am.keeper.OnRecvConsumerDoubleVotePacket(ctx, packet, data)
+			})
+			err = ccv.HandleUnexpectedState(ctx, providertypes.ModuleName, err)
+			if err == nil {
+				logger.Info("successfully handled ConsumerDoubleVotePacket", "sequence", packet.Sequence)
+			}
 		default:
 			err = fmt.Errorf("invalid consumer packet type: %q", consumerPacket.Type)
 		}
@@ -226,35 +275,14 @@ func (am AppModule) OnRecvPacket(
 	return ack
 }
 
+// UnmarshalConsumerPacket decodes the data of a packet received from a consumer chain
 func UnmarshalConsumerPacket(packet channeltypes.Packet) (consumerPacket ccv.ConsumerPacketData, err error) {
-	return UnmarshalConsumerPacketData(packet.GetData())
+	return ccv.UnmarshalConsumerPacketData(packet.GetData())
 }
 
+// UnmarshalConsumerPacketData decodes consumer packet data, see ccv.UnmarshalConsumerPacketData
 func UnmarshalConsumerPacketData(packetData []byte) (consumerPacket ccv.ConsumerPacketData, err error) {
-	// First try unmarshaling into ccv.ConsumerPacketData type
-	if err := ccv.ModuleCdc.UnmarshalJSON(packetData, &consumerPacket); err != nil {
-		// If failed, packet should be a v1 slash packet, retry for ConsumerPacketDataV1 packet type
-		var v1Packet ccv.ConsumerPacketDataV1
-		errV1 := ccv.ModuleCdc.UnmarshalJSON(packetData, &v1Packet)
-		if errV1 != nil {
-			// If neither worked, return error
-			return ccv.ConsumerPacketData{}, errV1
-		}
-
-		// VSC matured packets should not be unmarshaled as v1 packets
-		if v1Packet.Type == ccv.VscMaturedPacket {
-			return ccv.ConsumerPacketData{}, errors.New("VSC matured packets should be correctly unmarshaled")
-		}
-
-		// Convert from v1 packet type
-		consumerPacket = ccv.ConsumerPacketData{
-			Type: v1Packet.Type,
-			Data: &ccv.ConsumerPacketData_SlashPacketData{
-				SlashPacketData: v1Packet.GetSlashPacketData().FromV1(),
-			},
-		}
-	}
-	return consumerPacket, nil
+	return ccv.UnmarshalConsumerPacketData(packetData)
 }
 
 // OnAcknowledgementPacket implements the IBCModule interface
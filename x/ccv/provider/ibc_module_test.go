@@ -136,15 +136,13 @@ func TestOnChanOpenTry(t *testing.T) {
 		moduleAcct.BaseAccount.Address = authtypes.NewModuleAddress(providertypes.ConsumerRewardsPool).String()
 
 		// Number of calls is not asserted, since not all code paths are hit for failures
-		gomock.InOrder(
-			mocks.MockConnectionKeeper.EXPECT().GetConnection(ctx, "connectionIDToConsumer").Return(
-				conntypes.ConnectionEnd{ClientId: "clientIdToConsumer"}, true,
-			).AnyTimes(),
-			mocks.MockClientKeeper.EXPECT().GetClientState(ctx, "clientIdToConsumer").Return(
-				&ibctmtypes.ClientState{ChainId: "consumerChainID"}, true,
-			).AnyTimes(),
-			mocks.MockAccountKeeper.EXPECT().GetModuleAccount(ctx, providertypes.ConsumerRewardsPool).Return(&moduleAcct).AnyTimes(),
-		)
+		mocks.MockConnectionKeeper.EXPECT().GetConnection(ctx, "connectionIDToConsumer").Return(
+			conntypes.ConnectionEnd{ClientId: "clientIdToConsumer"}, true,
+		).AnyTimes()
+		mocks.MockClientKeeper.EXPECT().GetClientState(ctx, "clientIdToConsumer").Return(
+			&ibctmtypes.ClientState{ChainId: "consumerChainID"}, true,
+		).AnyTimes()
+		mocks.MockAccountKeeper.EXPECT().GetModuleAccount(ctx, providertypes.ConsumerRewardsPool).Return(&moduleAcct).AnyTimes()
 
 		tc.mutateParams(&params, &providerKeeper)
 
@@ -173,6 +171,63 @@ func TestOnChanOpenTry(t *testing.T) {
 	}
 }
 
+// TestOnChanOpenTryMigrationChannel tests that the provider accepts a second CCV channel
+// opened on a different connection and advertises the channel it replaces in the handshake metadata.
+func TestOnChanOpenTryMigrationChannel(t *testing.T) {
+	keeperParams := testkeeper.NewInMemKeeperParams(t)
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, keeperParams)
+	defer ctrl.Finish()
+	providerModule := provider.NewAppModule(&providerKeeper, *keeperParams.ParamsSubspace, keeperParams.StoreKey)
+
+	providerKeeper.SetPort(ctx, ccv.ProviderPortID)
+	providerKeeper.SetConsumerClientId(ctx, "consumerId", "clientIdToConsumer")
+	providerKeeper.SetConsumerPhase(ctx, "consumerId", providertypes.CONSUMER_PHASE_LAUNCHED)
+	providerKeeper.SetConsumerIdToChannelId(ctx, "consumerId", "existingChannelID")
+
+	moduleAcct := authtypes.ModuleAccount{BaseAccount: &authtypes.BaseAccount{}}
+	moduleAcct.BaseAccount.Address = authtypes.NewModuleAddress(providertypes.ConsumerRewardsPool).String()
+
+	mocks.MockConnectionKeeper.EXPECT().GetConnection(ctx, "newConnectionIDToConsumer").Return(
+		conntypes.ConnectionEnd{ClientId: "clientIdToConsumer"}, true,
+	).AnyTimes()
+	mocks.MockClientKeeper.EXPECT().GetClientState(ctx, "clientIdToConsumer").Return(
+		&ibctmtypes.ClientState{ChainId: "consumerChainID"}, true,
+	).AnyTimes()
+	mocks.MockAccountKeeper.EXPECT().GetModuleAccount(ctx, providertypes.ConsumerRewardsPool).Return(&moduleAcct).AnyTimes()
+	mocks.MockChannelKeeper.EXPECT().GetChannel(ctx, ccv.ProviderPortID, "existingChannelID").Return(
+		channeltypes.Channel{State: channeltypes.OPEN, ConnectionHops: []string{"connectionIDToConsumer"}}, true,
+	).AnyTimes()
+
+	metadata, err := providerModule.OnChanOpenTry(
+		ctx,
+		channeltypes.ORDERED,
+		[]string{"newConnectionIDToConsumer"},
+		ccv.ProviderPortID,
+		"providerChannelID",
+		channeltypes.NewCounterparty(ccv.ConsumerPortID, "consumerChannelID"),
+		ccv.Version,
+	)
+	require.NoError(t, err)
+	md := &ccv.HandshakeMetadata{}
+	require.NoError(t, md.Unmarshal([]byte(metadata)))
+	require.Equal(t, "existingChannelID", md.PreviousChannelId)
+
+	// a migration channel cannot be opened on the connection of the existing channel
+	mocks.MockConnectionKeeper.EXPECT().GetConnection(ctx, "connectionIDToConsumer").Return(
+		conntypes.ConnectionEnd{ClientId: "clientIdToConsumer"}, true,
+	).AnyTimes()
+	_, err = providerModule.OnChanOpenTry(
+		ctx,
+		channeltypes.ORDERED,
+		[]string{"connectionIDToConsumer"},
+		ccv.ProviderPortID,
+		"providerChannelID",
+		channeltypes.NewCounterparty(ccv.ConsumerPortID, "consumerChannelID"),
+		ccv.Version,
+	)
+	require.ErrorIs(t, err, ccv.ErrDuplicateChannel)
+}
+
 // TestOnChanOpenAck tests the provider's OnChanOpenAck method against spec.
 //
 // See: https://github.com/cosmos/ibc/blob/main/spec/app/ics-028-cross-chain-validation/methods.md#ccv-pcf-coack1
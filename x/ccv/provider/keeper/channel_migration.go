@@ -0,0 +1,164 @@
+package keeper
+
+import (
+	"fmt"
+
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// A consumer chain can open a second CCV channel, i.e., a migration channel, on a different connection
+// built on top of the same client (e.g., after the client was recovered), or on any connection built on top
+// of the same client once the established CCV channel was closed by a timeout. Once the migration channel is
+// established, the provider stops sending VSC packets on the previous CCV channel and waits for all the
+// packets sent on it to be acknowledged. Then, the provider closes the previous channel and switches
+// the traffic to the migration channel.
+
+// GetConsumerIdToPreviousChannelId returns the CCV channel of the given consumer id that was replaced by
+// a migration channel and is not yet closed
+func (k Keeper) GetConsumerIdToPreviousChannelId(ctx sdk.Context, consumerId string) (string, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ConsumerIdToPreviousChannelIdKey(consumerId))
+	if bz == nil {
+		return "", false
+	}
+	return string(bz), true
+}
+
+// SetConsumerIdToPreviousChannelId sets the CCV channel of the given consumer id that was replaced by a migration channel
+func (k Keeper) SetConsumerIdToPreviousChannelId(ctx sdk.Context, consumerId, channelId string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ConsumerIdToPreviousChannelIdKey(consumerId), []byte(channelId))
+}
+
+// DeleteConsumerIdToPreviousChannelId deletes the CCV channel of the given consumer id that was replaced by a migration channel
+func (k Keeper) DeleteConsumerIdToPreviousChannelId(ctx sdk.Context, consumerId string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ConsumerIdToPreviousChannelIdKey(consumerId))
+}
+
+// GetEstablishedChannelForConnection returns the established CCV channel of the consumer chain whose client
+// underlies the given connection, if any
+func (k Keeper) GetEstablishedChannelForConnection(ctx sdk.Context, connectionID string) (string, bool) {
+	clientId, _, err := k.getUnderlyingClient(ctx, connectionID)
+	if err != nil {
+		return "", false
+	}
+	consumerId, found := k.GetClientIdToConsumerId(ctx, clientId)
+	if !found {
+		return "", false
+	}
+	return k.GetConsumerIdToChannelId(ctx, consumerId)
+}
+
+// ValidateChannelMigration validates that a channel opened on the given connection can replace
+// the established CCV channel of a consumer chain
+func (k Keeper) ValidateChannelMigration(ctx sdk.Context, consumerId, channelId, connectionId string) error {
+	if !k.IsConsumerLaunched(ctx, consumerId) {
+		return errorsmod.Wrapf(types.ErrInvalidPhase,
+			"cannot migrate the CCV channel of a consumer chain that is not launched but in phase %s", k.GetConsumerPhase(ctx, consumerId))
+	}
+
+	if previousChannelId, found := k.GetConsumerIdToPreviousChannelId(ctx, consumerId); found {
+		return errorsmod.Wrapf(ccv.ErrDuplicateChannel,
+			"the migration from CCV channel %s is still in progress", previousChannelId)
+	}
+
+	channel, found := k.channelKeeper.GetChannel(ctx, ccv.ProviderPortID, channelId)
+	if !found {
+		return errorsmod.Wrapf(channeltypes.ErrChannelNotFound, "channel not found for channel ID: %s", channelId)
+	}
+	// a channel that timed out can be replaced by a channel on the same connection
+	if channel.State == channeltypes.CLOSED {
+		return nil
+	}
+	if len(channel.ConnectionHops) == 1 && channel.ConnectionHops[0] == connectionId {
+		return errorsmod.Wrapf(ccv.ErrDuplicateChannel,
+			"migration channel must be opened on a different connection than %s", connectionId)
+	}
+
+	return nil
+}
+
+// IsChannelDrained returns true if all the packets sent by the provider on the given channel were acknowledged,
+// or if the channel is closed
+func (k Keeper) IsChannelDrained(ctx sdk.Context, channelId string) bool {
+	channel, found := k.channelKeeper.GetChannel(ctx, ccv.ProviderPortID, channelId)
+	if !found || channel.State == channeltypes.CLOSED {
+		return true
+	}
+	nextSequenceSend, found := k.channelKeeper.GetNextSequenceSend(ctx, ccv.ProviderPortID, channelId)
+	if !found {
+		return true
+	}
+	nextSequenceAck, found := k.channelKeeper.GetNextSequenceAck(ctx, ccv.ProviderPortID, channelId)
+	if !found {
+		return false
+	}
+	return nextSequenceAck >= nextSequenceSend
+}
+
+// CompleteChannelMigration closes the CCV channel that was replaced by a migration channel
+// and removes its mappings
+func (k Keeper) CompleteChannelMigration(ctx sdk.Context, consumerId, previousChannelId string) {
+	channel, found := k.channelKeeper.GetChannel(ctx, ccv.ProviderPortID, previousChannelId)
+	if found && channel.State != channeltypes.CLOSED {
+		if err := k.chanCloseInit(ctx, previousChannelId); err != nil {
+			k.Logger(ctx).Error("previous CCV channel could not be closed",
+				"consumerId", consumerId,
+				"channelID", previousChannelId,
+				"error", err.Error(),
+			)
+		}
+	}
+	k.DeleteChannelIdToConsumerId(ctx, previousChannelId)
+	k.DeleteConsumerIdToPreviousChannelId(ctx, consumerId)
+	k.DeleteAllVSCPacketTimeouts(ctx, previousChannelId)
+	k.DeleteAllUnackedVSCPackets(ctx, previousChannelId)
+	k.DeleteConsumerChannelRecoveryTime(ctx, consumerId)
+
+	channelId, _ := k.GetConsumerIdToChannelId(ctx, consumerId)
+	k.Logger(ctx).Info("CCV channel migration completed",
+		"consumerId", consumerId,
+		"previous channelID", previousChannelId,
+		"channelID", channelId,
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeChannelMigrationCompleted,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeConsumerId, consumerId),
+			sdk.NewAttribute(types.AttributePreviousChannelId, previousChannelId),
+			sdk.NewAttribute(channeltypes.AttributeKeyChannelID, channelId),
+		),
+	)
+}
+
+// startChannelMigration switches the CCV channel of a consumer chain to the given migration channel.
+// The previous channel is closed once all the packets sent on it are acknowledged.
+func (k Keeper) startChannelMigration(ctx sdk.Context, consumerId, previousChannelId, channelId string) {
+	k.SetConsumerIdToChannelId(ctx, consumerId, channelId)
+	k.SetChannelToConsumerId(ctx, channelId, consumerId)
+	k.SetConsumerIdToPreviousChannelId(ctx, consumerId, previousChannelId)
+
+	k.Logger(ctx).Info(fmt.Sprintf("CCV channel migration started from %s to %s", previousChannelId, channelId),
+		"consumerId", consumerId,
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeChannelMigrationStarted,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeConsumerId, consumerId),
+			sdk.NewAttribute(types.AttributePreviousChannelId, previousChannelId),
+			sdk.NewAttribute(channeltypes.AttributeKeyChannelID, channelId),
+		),
+	)
+}
@@ -0,0 +1,106 @@
+package keeper_test
+
+import (
+	"testing"
+
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
+	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// TestConsumerIdToPreviousChannelId tests the getter, setter, and deletion methods of the previous CCV channel
+func TestConsumerIdToPreviousChannelId(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	_, found := providerKeeper.GetConsumerIdToPreviousChannelId(ctx, CONSUMER_ID)
+	require.False(t, found)
+
+	providerKeeper.SetConsumerIdToPreviousChannelId(ctx, CONSUMER_ID, "channel-0")
+	channelId, found := providerKeeper.GetConsumerIdToPreviousChannelId(ctx, CONSUMER_ID)
+	require.True(t, found)
+	require.Equal(t, "channel-0", channelId)
+
+	providerKeeper.DeleteConsumerIdToPreviousChannelId(ctx, CONSUMER_ID)
+	_, found = providerKeeper.GetConsumerIdToPreviousChannelId(ctx, CONSUMER_ID)
+	require.False(t, found)
+}
+
+// TestValidateChannelMigration tests the validation of a migration channel
+func TestValidateChannelMigration(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	mocks.MockChannelKeeper.EXPECT().GetChannel(ctx, ccv.ProviderPortID, "channel-0").Return(
+		channeltypes.Channel{State: channeltypes.OPEN, ConnectionHops: []string{"connection-0"}}, true,
+	).AnyTimes()
+
+	// the consumer chain is not launched
+	providerKeeper.SetConsumerPhase(ctx, CONSUMER_ID, providertypes.CONSUMER_PHASE_INITIALIZED)
+	require.ErrorIs(t, providerKeeper.ValidateChannelMigration(ctx, CONSUMER_ID, "channel-0", "connection-1"), providertypes.ErrInvalidPhase)
+
+	// the migration channel uses the same connection as the existing channel
+	providerKeeper.SetConsumerPhase(ctx, CONSUMER_ID, providertypes.CONSUMER_PHASE_LAUNCHED)
+	require.ErrorIs(t, providerKeeper.ValidateChannelMigration(ctx, CONSUMER_ID, "channel-0", "connection-0"), ccv.ErrDuplicateChannel)
+
+	// valid migration channel
+	require.NoError(t, providerKeeper.ValidateChannelMigration(ctx, CONSUMER_ID, "channel-0", "connection-1"))
+
+	// a migration is already in progress
+	providerKeeper.SetConsumerIdToPreviousChannelId(ctx, CONSUMER_ID, "channel-2")
+	require.ErrorIs(t, providerKeeper.ValidateChannelMigration(ctx, CONSUMER_ID, "channel-0", "connection-1"), ccv.ErrDuplicateChannel)
+}
+
+// TestSendVSCPacketsDuringChannelMigration tests that VSC packets are held until all the packets
+// sent on the previous CCV channel are acknowledged, and that the previous channel is then closed
+func TestSendVSCPacketsDuringChannelMigration(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+
+	providerKeeper.SetConsumerClientId(ctx, CONSUMER_ID, "clientID")
+	providerKeeper.SetConsumerPhase(ctx, CONSUMER_ID, providertypes.CONSUMER_PHASE_LAUNCHED)
+	providerKeeper.SetConsumerIdToChannelId(ctx, CONSUMER_ID, "channel-1")
+	providerKeeper.SetChannelToConsumerId(ctx, "channel-1", CONSUMER_ID)
+	providerKeeper.SetChannelToConsumerId(ctx, "channel-0", CONSUMER_ID)
+	providerKeeper.SetConsumerIdToPreviousChannelId(ctx, CONSUMER_ID, "channel-0")
+	providerKeeper.AppendPendingVSCPackets(ctx, CONSUMER_ID, ccv.ValidatorSetChangePacketData{ValsetUpdateId: 1})
+
+	openChannel := channeltypes.Channel{State: channeltypes.OPEN, ConnectionHops: []string{"connection-0"}}
+
+	// not all the packets sent on the previous channel are acknowledged
+	gomock.InOrder(
+		mocks.MockChannelKeeper.EXPECT().GetChannel(ctx, ccv.ProviderPortID, "channel-0").Return(openChannel, true).Times(1),
+		mocks.MockChannelKeeper.EXPECT().GetNextSequenceSend(ctx, ccv.ProviderPortID, "channel-0").Return(uint64(5), true).Times(1),
+		mocks.MockChannelKeeper.EXPECT().GetNextSequenceAck(ctx, ccv.ProviderPortID, "channel-0").Return(uint64(4), true).Times(1),
+	)
+	require.NoError(t, providerKeeper.SendVSCPackets(ctx))
+	require.Len(t, providerKeeper.GetPendingVSCPackets(ctx, CONSUMER_ID), 1)
+	previousChannelId, found := providerKeeper.GetConsumerIdToPreviousChannelId(ctx, CONSUMER_ID)
+	require.True(t, found)
+	require.Equal(t, "channel-0", previousChannelId)
+
+	// all the packets sent on the previous channel are acknowledged
+	gomock.InOrder(
+		mocks.MockChannelKeeper.EXPECT().GetChannel(ctx, ccv.ProviderPortID, "channel-0").Return(openChannel, true).Times(1),
+		mocks.MockChannelKeeper.EXPECT().GetNextSequenceSend(ctx, ccv.ProviderPortID, "channel-0").Return(uint64(5), true).Times(1),
+		mocks.MockChannelKeeper.EXPECT().GetNextSequenceAck(ctx, ccv.ProviderPortID, "channel-0").Return(uint64(5), true).Times(1),
+		mocks.MockChannelKeeper.EXPECT().GetChannel(ctx, ccv.ProviderPortID, "channel-0").Return(openChannel, true).Times(1),
+		mocks.MockChannelKeeper.EXPECT().ChanCloseInit(ctx, ccv.ProviderPortID, "channel-0").Return(nil).Times(1),
+		mocks.MockChannelKeeper.EXPECT().GetChannel(ctx, ccv.ProviderPortID, "channel-1").Return(openChannel, true).Times(1),
+		mocks.MockChannelKeeper.EXPECT().SendPacket(ctx, ccv.ProviderPortID, "channel-1", gomock.Any(), gomock.Any(), gomock.Any()).Return(uint64(1), nil).Times(1),
+	)
+	require.NoError(t, providerKeeper.SendVSCPackets(ctx))
+	require.Empty(t, providerKeeper.GetPendingVSCPackets(ctx, CONSUMER_ID))
+	_, found = providerKeeper.GetConsumerIdToPreviousChannelId(ctx, CONSUMER_ID)
+	require.False(t, found)
+	_, found = providerKeeper.GetChannelIdToConsumerId(ctx, "channel-0")
+	require.False(t, found)
+	channelId, found := providerKeeper.GetConsumerIdToChannelId(ctx, CONSUMER_ID)
+	require.True(t, found)
+	require.Equal(t, "channel-1", channelId)
+}
@@ -0,0 +1,247 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// As CCV channels are ordered, the timeout of a single VSC packet closes the CCV channel. Instead of removing
+// the consumer chain, the provider stages the reestablishment of the channel: the VSC packets that were not
+// received by the consumer chain are queued again and the mapping of the closed channel is preserved until
+// a new CCV channel replaces it through the channel migration flow. As the closed channel cannot be used anymore,
+// the new channel can be opened on the same connection.
+
+// SetUnackedVSCPacket sets the data of a VSC packet sent on the given channel that is not yet acknowledged
+func (k Keeper) SetUnackedVSCPacket(ctx sdk.Context, channelId string, sequence uint64, data ccv.ValidatorSetChangePacketData) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := data.Marshal()
+	if err != nil {
+		// An error here would indicate something is very wrong,
+		// the packet data was just sent and should be able to be marshaled.
+		panic(fmt.Errorf("cannot marshal VSC packet data: %w", err))
+	}
+	store.Set(types.ChannelIdToUnackedVSCPacketKey(channelId, sequence), bz)
+}
+
+// GetUnackedVSCPacket returns the data of a VSC packet sent on the given channel that is not yet acknowledged
+func (k Keeper) GetUnackedVSCPacket(ctx sdk.Context, channelId string, sequence uint64) (ccv.ValidatorSetChangePacketData, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ChannelIdToUnackedVSCPacketKey(channelId, sequence))
+	if bz == nil {
+		return ccv.ValidatorSetChangePacketData{}, false
+	}
+	var data ccv.ValidatorSetChangePacketData
+	if err := data.Unmarshal(bz); err != nil {
+		// An error here would indicate something is very wrong,
+		// the packet data is assumed to be correctly serialized in SetUnackedVSCPacket.
+		panic(fmt.Errorf("cannot unmarshal VSC packet data: %w", err))
+	}
+	return data, true
+}
+
+// DeleteUnackedVSCPacket deletes the data of a VSC packet sent on the given channel
+func (k Keeper) DeleteUnackedVSCPacket(ctx sdk.Context, channelId string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ChannelIdToUnackedVSCPacketKey(channelId, sequence))
+}
+
+// DeleteAllUnackedVSCPackets deletes the data of all the VSC packets sent on the given channel
+func (k Keeper) DeleteAllUnackedVSCPackets(ctx sdk.Context, channelId string) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.ChannelIdToUnackedVSCPacketKeyPrefix(channelId))
+	defer iterator.Close()
+
+	var keysToDel [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keysToDel = append(keysToDel, iterator.Key())
+	}
+	for _, key := range keysToDel {
+		store.Delete(key)
+	}
+}
+
+// GetConsumerChannelRecoveryTime returns the time at which the reestablishment of the CCV channel
+// of the given consumer id was staged
+func (k Keeper) GetConsumerChannelRecoveryTime(ctx sdk.Context, consumerId string) (time.Time, bool) {
+	store := ctx.KVStore(k.storeKey)
+	buf := store.Get(types.ConsumerIdToChannelRecoveryTimeKey(consumerId))
+	if buf == nil {
+		return time.Time{}, false
+	}
+	var recoveryTime time.Time
+	if err := recoveryTime.UnmarshalBinary(buf); err != nil {
+		panic(fmt.Errorf("failed to unmarshal channel recovery time for consumer id (%s): %w", consumerId, err))
+	}
+	return recoveryTime, true
+}
+
+// SetConsumerChannelRecoveryTime sets the time at which the reestablishment of the CCV channel
+// of the given consumer id was staged
+func (k Keeper) SetConsumerChannelRecoveryTime(ctx sdk.Context, consumerId string, recoveryTime time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	buf, err := recoveryTime.MarshalBinary()
+	if err != nil {
+		panic(fmt.Errorf("failed to marshal channel recovery time (%+v) for consumer id (%s): %w", recoveryTime, consumerId, err))
+	}
+	store.Set(types.ConsumerIdToChannelRecoveryTimeKey(consumerId), buf)
+}
+
+// DeleteConsumerChannelRecoveryTime deletes the time at which the reestablishment of the CCV channel
+// of the given consumer id was staged
+func (k Keeper) DeleteConsumerChannelRecoveryTime(ctx sdk.Context, consumerId string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ConsumerIdToChannelRecoveryTimeKey(consumerId))
+}
+
+// requeueUnackedVSCPackets queues again, ahead of the pending VSC packets, the VSC packets sent on the given
+// channel starting with the given sequence, i.e., the packets that were not received by the consumer chain
+// before the channel was closed. The packets sent on the channel are then forgotten, as the acknowledgements
+// of the packets received by the consumer chain cannot be relayed on a closed channel.
+func (k Keeper) requeueUnackedVSCPackets(ctx sdk.Context, consumerId, channelId string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.ChannelIdToUnackedVSCPacketKeyPrefix(channelId)
+	iterator := storetypes.KVStorePrefixIterator(store, prefix)
+
+	requeuedPackets := []ccv.ValidatorSetChangePacketData{}
+	for ; iterator.Valid(); iterator.Next() {
+		if sdk.BigEndianToUint64(iterator.Key()[len(prefix):]) < sequence {
+			continue
+		}
+		var data ccv.ValidatorSetChangePacketData
+		if err := data.Unmarshal(iterator.Value()); err != nil {
+			// An error here would indicate something is very wrong,
+			// the packet data is assumed to be correctly serialized in SetUnackedVSCPacket.
+			panic(fmt.Errorf("cannot unmarshal VSC packet data: %w", err))
+		}
+		requeuedPackets = append(requeuedPackets, data)
+	}
+	iterator.Close()
+
+	k.DeleteAllUnackedVSCPackets(ctx, channelId)
+	k.DeleteAllVSCPacketTimeouts(ctx, channelId)
+
+	if len(requeuedPackets) == 0 {
+		return
+	}
+	pendingPackets := k.GetPendingVSCPackets(ctx, consumerId)
+	k.DeletePendingVSCPackets(ctx, consumerId)
+	k.AppendPendingVSCPackets(ctx, consumerId, append(requeuedPackets, pendingPackets...)...)
+
+	k.Logger(ctx).Info("VSC packets queued again",
+		"consumerId", consumerId,
+		"channelID", channelId,
+		"count", len(requeuedPackets),
+	)
+}
+
+// StageChannelRecovery handles the timeout of the VSC packet with the given sequence on a CCV channel
+// of a launched consumer chain. The VSC packets that were not received by the consumer chain are queued again.
+// If the channel is the established CCV channel of the consumer chain, the provider holds the VSC packets
+// until a new CCV channel replaces the closed one.
+func (k Keeper) StageChannelRecovery(ctx sdk.Context, consumerId, channelId string, sequence uint64) {
+	k.requeueUnackedVSCPackets(ctx, consumerId, channelId, sequence)
+
+	if establishedChannelId, found := k.GetConsumerIdToChannelId(ctx, consumerId); !found || establishedChannelId != channelId {
+		// the packet timed out on the previous channel of an ongoing channel migration;
+		// the migration completes as the previous channel is closed
+		return
+	}
+	if _, found := k.GetConsumerChannelRecoveryTime(ctx, consumerId); found {
+		// the recovery was already staged by the timeout of a previous packet
+		return
+	}
+	k.SetConsumerChannelRecoveryTime(ctx, consumerId, ctx.BlockTime())
+
+	k.Logger(ctx).Info("CCV channel timed out, waiting for a new CCV channel",
+		"consumerId", consumerId,
+		"channelID", channelId,
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeChannelRecoveryStaged,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeConsumerId, consumerId),
+			sdk.NewAttribute(channeltypes.AttributeKeyChannelID, channelId),
+			sdk.NewAttribute(ccv.AttributePacketSequence, fmt.Sprintf("%d", sequence)),
+		),
+	)
+}
+
+// BeginBlockRemoveUnreachableConsumers stops and schedules the removal of the launched consumer chains
+// whose CCV channel is closed, or whose client is expired, for longer than the MaxChannelClosedDuration param.
+// Otherwise, such consumer chains would never be removed, although the provider cannot reach them anymore.
+func (k Keeper) BeginBlockRemoveUnreachableConsumers(ctx sdk.Context) {
+	maxChannelClosedDuration := k.GetMaxChannelClosedDuration(ctx)
+	if maxChannelClosedDuration == 0 {
+		return
+	}
+
+	for _, consumerId := range k.GetAllConsumersWithIBCClients(ctx) {
+		if !k.IsConsumerLaunched(ctx, consumerId) {
+			continue
+		}
+		reason, unreachableSince, found := k.getConsumerUnreachableSince(ctx, consumerId)
+		if !found || ctx.BlockTime().Sub(unreachableSince) < maxChannelClosedDuration {
+			continue
+		}
+
+		phase := k.GetConsumerPhase(ctx, consumerId)
+		cachedCtx, writeFn := ctx.CacheContext()
+		if err := k.StopAndPrepareForConsumerRemoval(cachedCtx, consumerId); err != nil {
+			k.Logger(ctx).Error("cannot stop unreachable consumer chain",
+				"consumerId", consumerId,
+				"error", err.Error(),
+			)
+			continue
+		}
+		writeFn()
+
+		chainId, _ := k.GetConsumerChainId(ctx, consumerId)
+		removalTime, _ := k.GetConsumerRemovalTime(ctx, consumerId)
+		k.Logger(ctx).Info("stopped unreachable consumer chain",
+			"consumerId", consumerId,
+			"chainId", chainId,
+			"reason", reason,
+			"unreachableSince", unreachableSince,
+		)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeAutoRemoveConsumer,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				sdk.NewAttribute(types.AttributeConsumerId, consumerId),
+				sdk.NewAttribute(types.AttributeConsumerChainId, chainId),
+				sdk.NewAttribute(types.AttributeConsumerPhase, phase.String()),
+				sdk.NewAttribute(types.AttributeRemovalReason, reason),
+				sdk.NewAttribute(types.AttributeUnreachableSince, unreachableSince.UTC().Format(time.RFC3339)),
+				sdk.NewAttribute(types.AttributeConsumerRemovalTime, removalTime.String()),
+			),
+		)
+	}
+}
+
+// getConsumerUnreachableSince returns the time since which the provider cannot reach the consumer chain
+// with `consumerId`, i.e., the earliest of the time at which its CCV channel was closed and the time at which
+// its client expired, together with the corresponding AutoRemovalReason. It returns false if the consumer chain is reachable.
+func (k Keeper) getConsumerUnreachableSince(ctx sdk.Context, consumerId string) (string, time.Time, bool) {
+	reason, unreachableSince, found := "", time.Time{}, false
+	if closedSince, closed := k.GetConsumerChannelRecoveryTime(ctx, consumerId); closed {
+		reason, unreachableSince, found = types.AutoRemovalReasonChannelClosed, closedSince, true
+	}
+	if expiry, recorded := k.GetConsumerClientExpiry(ctx, consumerId); recorded && !expiry.After(ctx.BlockTime()) {
+		if !found || expiry.Before(unreachableSince) {
+			reason, unreachableSince, found = types.AutoRemovalReasonClientExpired, expiry, true
+		}
+	}
+	return reason, unreachableSince, found
+}
@@ -0,0 +1,172 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
+	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// TestUnackedVSCPackets tests the getter, setter, and deletion methods of the unacknowledged VSC packets
+func TestUnackedVSCPackets(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	_, found := providerKeeper.GetUnackedVSCPacket(ctx, "channel-0", 1)
+	require.False(t, found)
+
+	providerKeeper.SetUnackedVSCPacket(ctx, "channel-0", 1, ccv.ValidatorSetChangePacketData{ValsetUpdateId: 1})
+	providerKeeper.SetUnackedVSCPacket(ctx, "channel-0", 2, ccv.ValidatorSetChangePacketData{ValsetUpdateId: 2})
+	providerKeeper.SetUnackedVSCPacket(ctx, "channel-1", 1, ccv.ValidatorSetChangePacketData{ValsetUpdateId: 3})
+
+	data, found := providerKeeper.GetUnackedVSCPacket(ctx, "channel-0", 2)
+	require.True(t, found)
+	require.Equal(t, uint64(2), data.ValsetUpdateId)
+
+	providerKeeper.DeleteUnackedVSCPacket(ctx, "channel-0", 2)
+	_, found = providerKeeper.GetUnackedVSCPacket(ctx, "channel-0", 2)
+	require.False(t, found)
+
+	providerKeeper.DeleteAllUnackedVSCPackets(ctx, "channel-0")
+	_, found = providerKeeper.GetUnackedVSCPacket(ctx, "channel-0", 1)
+	require.False(t, found)
+	_, found = providerKeeper.GetUnackedVSCPacket(ctx, "channel-1", 1)
+	require.True(t, found)
+}
+
+// TestOnTimeoutPacketStagesChannelRecovery tests that the timeout of a VSC packet sent to a launched chain
+// queues again the packets not received by the consumer chain and holds them until a new channel is established
+func TestOnTimeoutPacketStagesChannelRecovery(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+	ctx = ctx.WithBlockTime(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	providerKeeper.SetConsumerClientId(ctx, CONSUMER_ID, "clientID")
+	providerKeeper.SetConsumerPhase(ctx, CONSUMER_ID, providertypes.CONSUMER_PHASE_LAUNCHED)
+	providerKeeper.SetConsumerIdToChannelId(ctx, CONSUMER_ID, "channel-0")
+	providerKeeper.SetChannelToConsumerId(ctx, "channel-0", CONSUMER_ID)
+
+	// the packet with sequence 1 was received by the consumer chain, while the packets
+	// with sequences 2 and 3 were not; the packet with valset update id 4 is not yet sent
+	for seq := uint64(1); seq <= 3; seq++ {
+		providerKeeper.SetUnackedVSCPacket(ctx, "channel-0", seq, ccv.ValidatorSetChangePacketData{ValsetUpdateId: seq})
+		providerKeeper.SetVSCPacketTimeout(ctx, "channel-0", seq, uint64(ctx.BlockTime().UnixNano()))
+	}
+	providerKeeper.AppendPendingVSCPackets(ctx, CONSUMER_ID, ccv.ValidatorSetChangePacketData{ValsetUpdateId: 4})
+
+	packet := channeltypes.Packet{SourceChannel: "channel-0", Sequence: 2}
+	require.NoError(t, providerKeeper.OnTimeoutPacket(ctx, packet))
+
+	// the consumer chain is not stopped and the mapping of the channel is preserved
+	require.Equal(t, providertypes.CONSUMER_PHASE_LAUNCHED, providerKeeper.GetConsumerPhase(ctx, CONSUMER_ID))
+	channelId, found := providerKeeper.GetConsumerIdToChannelId(ctx, CONSUMER_ID)
+	require.True(t, found)
+	require.Equal(t, "channel-0", channelId)
+	recoveryTime, found := providerKeeper.GetConsumerChannelRecoveryTime(ctx, CONSUMER_ID)
+	require.True(t, found)
+	require.Equal(t, ctx.BlockTime(), recoveryTime)
+
+	// the packets not received by the consumer chain are queued again in order
+	pendingPackets := providerKeeper.GetPendingVSCPackets(ctx, CONSUMER_ID)
+	require.Len(t, pendingPackets, 3)
+	for i, packet := range pendingPackets {
+		require.Equal(t, uint64(i+2), packet.ValsetUpdateId)
+	}
+	_, found = providerKeeper.GetUnackedVSCPacket(ctx, "channel-0", 1)
+	require.False(t, found)
+	_, _, found = providerKeeper.GetOldestVSCPacketTimeout(ctx, "channel-0")
+	require.False(t, found)
+
+	// the timeout of the following packets does not queue them again
+	packet.Sequence = 3
+	require.NoError(t, providerKeeper.OnTimeoutPacket(ctx, packet))
+	require.Len(t, providerKeeper.GetPendingVSCPackets(ctx, CONSUMER_ID), 3)
+
+	// the VSC packets are held until a new channel is established
+	require.NoError(t, providerKeeper.SendVSCPackets(ctx))
+	require.Len(t, providerKeeper.GetPendingVSCPackets(ctx, CONSUMER_ID), 3)
+
+	// the closed channel can be replaced by a channel on the same connection
+	mocks.MockChannelKeeper.EXPECT().GetChannel(ctx, ccv.ProviderPortID, "channel-0").Return(
+		channeltypes.Channel{State: channeltypes.CLOSED, ConnectionHops: []string{"connection-0"}}, true,
+	).AnyTimes()
+	require.NoError(t, providerKeeper.ValidateChannelMigration(ctx, CONSUMER_ID, "channel-0", "connection-0"))
+
+	// the recovery completes once the new channel replaces the closed one
+	providerKeeper.SetConsumerIdToChannelId(ctx, CONSUMER_ID, "channel-1")
+	providerKeeper.SetChannelToConsumerId(ctx, "channel-1", CONSUMER_ID)
+	providerKeeper.SetConsumerIdToPreviousChannelId(ctx, CONSUMER_ID, "channel-0")
+	providerKeeper.CompleteChannelMigration(ctx, CONSUMER_ID, "channel-0")
+	_, found = providerKeeper.GetConsumerChannelRecoveryTime(ctx, CONSUMER_ID)
+	require.False(t, found)
+}
+
+// TestBeginBlockRemoveUnreachableConsumers tests that the launched consumer chains whose channel is closed,
+// or whose client is expired, for longer than the MaxChannelClosedDuration param are stopped
+func TestBeginBlockRemoveUnreachableConsumers(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	blockTime := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(blockTime)
+	params := providertypes.DefaultParams()
+	params.MaxChannelClosedDuration = 24 * time.Hour
+	providerKeeper.SetParams(ctx, params)
+
+	// consumer "0" is reachable, the channel of consumer "1" is closed for too long,
+	// the client of consumer "2" is expired for too long, and the channel of consumer "3"
+	// is closed but not for long enough
+	for i := 0; i < 4; i++ {
+		consumerId := fmt.Sprintf("%d", i)
+		providerKeeper.SetConsumerClientId(ctx, consumerId, fmt.Sprintf("clientID-%d", i))
+		providerKeeper.SetConsumerChainId(ctx, consumerId, fmt.Sprintf("chain-%d", i))
+		providerKeeper.SetConsumerPhase(ctx, consumerId, providertypes.CONSUMER_PHASE_LAUNCHED)
+	}
+	providerKeeper.SetConsumerChannelRecoveryTime(ctx, "1", blockTime.Add(-25*time.Hour))
+	providerKeeper.SetConsumerClientExpiry(ctx, "2", blockTime.Add(-24*time.Hour))
+	providerKeeper.SetConsumerChannelRecoveryTime(ctx, "3", blockTime.Add(-time.Hour))
+
+	unbondingTime := 21 * 24 * time.Hour
+	mocks.MockStakingKeeper.EXPECT().UnbondingTime(gomock.Any()).Return(unbondingTime, nil).Times(2)
+
+	providerKeeper.BeginBlockRemoveUnreachableConsumers(ctx)
+
+	for consumerId, expectedPhase := range map[string]providertypes.ConsumerPhase{
+		"0": providertypes.CONSUMER_PHASE_LAUNCHED,
+		"1": providertypes.CONSUMER_PHASE_STOPPED,
+		"2": providertypes.CONSUMER_PHASE_STOPPED,
+		"3": providertypes.CONSUMER_PHASE_LAUNCHED,
+	} {
+		require.Equal(t, expectedPhase, providerKeeper.GetConsumerPhase(ctx, consumerId), consumerId)
+	}
+	removalTime, err := providerKeeper.GetConsumerRemovalTime(ctx, "1")
+	require.NoError(t, err)
+	require.Equal(t, blockTime.Add(unbondingTime), removalTime)
+
+	// an event is emitted for every removed consumer chain, together with its removal reason
+	reasons := []string{}
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != providertypes.EventTypeAutoRemoveConsumer {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if attr.Key == providertypes.AttributeRemovalReason {
+				reasons = append(reasons, attr.Value)
+			}
+		}
+	}
+	require.Equal(t, []string{providertypes.AutoRemovalReasonChannelClosed, providertypes.AutoRemovalReasonClientExpired}, reasons)
+
+	// no consumer chain is removed when the MaxChannelClosedDuration param is zero
+	params.MaxChannelClosedDuration = 0
+	providerKeeper.SetParams(ctx, params)
+	providerKeeper.BeginBlockRemoveUnreachableConsumers(ctx.WithBlockTime(blockTime.Add(48 * time.Hour)))
+	require.Equal(t, providertypes.CONSUMER_PHASE_LAUNCHED, providerKeeper.GetConsumerPhase(ctx, "3"))
+}
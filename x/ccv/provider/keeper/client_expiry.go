@@ -0,0 +1,134 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// GetConsumerClientExpiry returns the time at which the client of the consumer chain with `consumerId` expires
+func (k Keeper) GetConsumerClientExpiry(ctx sdk.Context, consumerId string) (time.Time, bool) {
+	store := ctx.KVStore(k.storeKey)
+	buf := store.Get(types.ConsumerIdToClientExpiryKey(consumerId))
+	if buf == nil {
+		return time.Time{}, false
+	}
+	var expiry time.Time
+	if err := expiry.UnmarshalBinary(buf); err != nil {
+		panic(fmt.Errorf("failed to unmarshal client expiry for consumer id (%s): %w", consumerId, err))
+	}
+	return expiry, true
+}
+
+// SetConsumerClientExpiry sets the time at which the client of the consumer chain with `consumerId` expires
+func (k Keeper) SetConsumerClientExpiry(ctx sdk.Context, consumerId string, expiry time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	buf, err := expiry.MarshalBinary()
+	if err != nil {
+		panic(fmt.Errorf("failed to marshal client expiry (%+v) for consumer id (%s): %w", expiry, consumerId, err))
+	}
+	store.Set(types.ConsumerIdToClientExpiryKey(consumerId), buf)
+}
+
+// DeleteConsumerClientExpiry deletes the time at which the client of the consumer chain with `consumerId` expires
+func (k Keeper) DeleteConsumerClientExpiry(ctx sdk.Context, consumerId string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ConsumerIdToClientExpiryKey(consumerId))
+}
+
+// IsConsumerClientExpiryWarned returns whether the provider warned that the client of the consumer chain
+// with `consumerId` is about to expire, i.e., since the client expiry last crossed the ClientExpiryWarningThreshold param
+func (k Keeper) IsConsumerClientExpiryWarned(ctx sdk.Context, consumerId string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.ConsumerIdToClientExpiryWarnedKey(consumerId))
+}
+
+// SetConsumerClientExpiryWarned records that the provider warned that the client of the consumer chain
+// with `consumerId` is about to expire
+func (k Keeper) SetConsumerClientExpiryWarned(ctx sdk.Context, consumerId string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ConsumerIdToClientExpiryWarnedKey(consumerId), []byte{})
+}
+
+// DeleteConsumerClientExpiryWarned deletes the record that the provider warned that the client
+// of the consumer chain with `consumerId` is about to expire
+func (k Keeper) DeleteConsumerClientExpiryWarned(ctx sdk.Context, consumerId string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ConsumerIdToClientExpiryWarnedKey(consumerId))
+}
+
+// GetAllConsumersWithClientExpiry returns the consumer ids of all the consumer chains with a recorded client expiry
+func (k Keeper) GetAllConsumersWithClientExpiry(ctx sdk.Context) []string {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.ConsumerIdToClientExpiryKeyPrefix()
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{prefix})
+	defer iterator.Close()
+
+	consumerIds := []string{}
+	for ; iterator.Valid(); iterator.Next() {
+		consumerId, err := types.ParseStringIdWithLenKey(prefix, iterator.Key())
+		if err != nil {
+			// An error here would indicate something is very wrong,
+			// the key is assumed to be correctly serialized in SetConsumerClientExpiry.
+			panic(fmt.Errorf("failed to parse client expiry key: %w", err))
+		}
+		consumerIds = append(consumerIds, consumerId)
+	}
+	return consumerIds
+}
+
+// BeginBlockUpdateConsumerClientExpiries records the expiry times of the clients of the launched
+// consumer chains, which change whenever the clients are updated, and warns about the clients
+// that expire in less than the ClientExpiryWarningThreshold param. A client is warned about once
+// every time its expiry crosses the threshold, i.e., until it is updated and no longer about to expire.
+func (k Keeper) BeginBlockUpdateConsumerClientExpiries(ctx sdk.Context) {
+	threshold := k.GetClientExpiryWarningThreshold(ctx)
+	for _, consumerId := range k.GetAllConsumersWithIBCClients(ctx) {
+		if !k.IsConsumerLaunched(ctx, consumerId) {
+			continue
+		}
+		clientId, _ := k.GetConsumerClientId(ctx, consumerId)
+		expiry, err := ccv.GetClientExpiry(ctx, k.clientKeeper, clientId)
+		if err != nil {
+			k.Logger(ctx).Error("cannot get consumer client expiry",
+				"consumerId", consumerId,
+				"clientId", clientId,
+				"error", err.Error(),
+			)
+			continue
+		}
+		if previousExpiry, found := k.GetConsumerClientExpiry(ctx, consumerId); !found || !previousExpiry.Equal(expiry) {
+			k.SetConsumerClientExpiry(ctx, consumerId, expiry)
+		}
+
+		timeUntilExpiry := expiry.Sub(ctx.BlockTime())
+		telemetry.SetGauge(float32(timeUntilExpiry.Seconds()),
+			types.ModuleName, "consumer_client_time_until_expiry_seconds", consumerId)
+
+		warned := k.IsConsumerClientExpiryWarned(ctx, consumerId)
+		if !ccv.IsNearClientExpiry(ctx.BlockTime(), expiry, threshold) {
+			if warned {
+				k.DeleteConsumerClientExpiryWarned(ctx, consumerId)
+			}
+		} else if !warned {
+			k.SetConsumerClientExpiryWarned(ctx, consumerId)
+			k.Logger(ctx).Error("consumer client is about to expire",
+				"consumerId", consumerId,
+				"clientId", clientId,
+				"expiry", expiry,
+				"timeUntilExpiry", timeUntilExpiry,
+			)
+
+			event := ccv.NewClientExpiryWarningEvent(types.ModuleName, clientId, ctx.BlockTime(), expiry)
+			event = event.AppendAttributes(sdk.NewAttribute(types.AttributeConsumerId, consumerId))
+			ctx.EventManager().EmitEvent(event)
+		}
+	}
+}
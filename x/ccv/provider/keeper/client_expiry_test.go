@@ -0,0 +1,107 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	ibctmtypes "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
+	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// TestConsumerClientExpiry tests that the expiry times of the clients of the launched consumer chains
+// are recorded and that warning events are emitted for the clients that are about to expire
+func TestConsumerClientExpiry(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	params := providertypes.DefaultParams()
+	params.ClientExpiryWarningThreshold = 24 * time.Hour
+	providerKeeper.SetParams(ctx, params)
+
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(now)
+
+	// a launched consumer chain and a stopped consumer chain
+	providerKeeper.SetConsumerChainId(ctx, "0", "chain-0")
+	providerKeeper.SetConsumerPhase(ctx, "0", providertypes.CONSUMER_PHASE_LAUNCHED)
+	providerKeeper.SetConsumerClientId(ctx, "0", "clientId")
+	providerKeeper.SetConsumerPhase(ctx, "1", providertypes.CONSUMER_PHASE_STOPPED)
+	providerKeeper.SetConsumerClientId(ctx, "1", "stoppedClientId")
+
+	clientState := &ibctmtypes.ClientState{
+		ChainId:        "chain-0",
+		LatestHeight:   clienttypes.NewHeight(0, 100),
+		TrustingPeriod: 10 * 24 * time.Hour,
+	}
+	consensusState := &ibctmtypes.ConsensusState{Timestamp: now.Add(-time.Hour)}
+	mocks.MockClientKeeper.EXPECT().GetClientState(gomock.Any(), "clientId").Return(clientState, true).AnyTimes()
+	mocks.MockClientKeeper.EXPECT().GetClientConsensusState(gomock.Any(), "clientId", gomock.Any()).DoAndReturn(
+		func(sdk.Context, string, interface{}) (*ibctmtypes.ConsensusState, bool) { return consensusState, true },
+	).AnyTimes()
+
+	// the client expires at the end of the trusting period of its latest consensus state
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	providerKeeper.BeginBlockUpdateConsumerClientExpiries(ctx)
+	expiry, found := providerKeeper.GetConsumerClientExpiry(ctx, "0")
+	require.True(t, found)
+	require.Equal(t, now.Add(10*24*time.Hour-time.Hour), expiry)
+	require.Equal(t, []string{"0"}, providerKeeper.GetAllConsumersWithClientExpiry(ctx))
+	require.Empty(t, ctx.EventManager().Events())
+
+	resp, err := providerKeeper.QueryConsumerClientExpiries(ctx, &providertypes.QueryConsumerClientExpiriesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []providertypes.ConsumerClientExpiry{{
+		ConsumerId:      "0",
+		ChainId:         "chain-0",
+		ClientId:        "clientId",
+		ExpiryTime:      expiry,
+		TimeUntilExpiry: 10*24*time.Hour - time.Hour,
+	}}, resp.Expiries)
+
+	// a warning is emitted once the client expires in less than the threshold
+	ctx = ctx.WithBlockTime(now.Add(9 * 24 * time.Hour)).WithEventManager(sdk.NewEventManager())
+	providerKeeper.BeginBlockUpdateConsumerClientExpiries(ctx)
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, ccv.EventTypeClientExpiryWarning, events[0].Type)
+	consumerIdAttr, found := events[0].GetAttribute(providertypes.AttributeConsumerId)
+	require.True(t, found)
+	require.Equal(t, "0", consumerIdAttr.Value)
+	require.True(t, providerKeeper.IsConsumerClientExpiryWarned(ctx, "0"))
+
+	// the warning is not emitted again while the client is about to expire
+	ctx = ctx.WithBlockTime(now.Add(9*24*time.Hour + time.Hour)).WithEventManager(sdk.NewEventManager())
+	providerKeeper.BeginBlockUpdateConsumerClientExpiries(ctx)
+	require.Empty(t, ctx.EventManager().Events())
+
+	// the expiry is updated when the client is updated
+	consensusState = &ibctmtypes.ConsensusState{Timestamp: now.Add(9 * 24 * time.Hour)}
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	providerKeeper.BeginBlockUpdateConsumerClientExpiries(ctx)
+	expiry, _ = providerKeeper.GetConsumerClientExpiry(ctx, "0")
+	require.Equal(t, now.Add(19*24*time.Hour), expiry)
+	require.Empty(t, ctx.EventManager().Events())
+	require.False(t, providerKeeper.IsConsumerClientExpiryWarned(ctx, "0"))
+
+	// a warning is emitted again once the updated client expires in less than the threshold
+	ctx = ctx.WithBlockTime(now.Add(18*24*time.Hour + time.Hour)).WithEventManager(sdk.NewEventManager())
+	providerKeeper.BeginBlockUpdateConsumerClientExpiries(ctx)
+	require.Len(t, ctx.EventManager().Events(), 1)
+
+	// no warnings are emitted if the threshold is zero
+	params.ClientExpiryWarningThreshold = 0
+	providerKeeper.SetParams(ctx, params)
+	ctx = ctx.WithBlockTime(now.Add(20 * 24 * time.Hour)).WithEventManager(sdk.NewEventManager())
+	providerKeeper.BeginBlockUpdateConsumerClientExpiries(ctx)
+	require.Empty(t, ctx.EventManager().Events())
+
+	providerKeeper.DeleteConsumerClientExpiry(ctx, "0")
+	require.Empty(t, providerKeeper.GetAllConsumersWithClientExpiry(ctx))
+}
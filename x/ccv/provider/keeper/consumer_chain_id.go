@@ -0,0 +1,150 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+)
+
+// GetConsumerPendingChainId returns the chain id the consumer chain with `consumerId` is scheduled
+// to change to once its client is upgraded
+func (k Keeper) GetConsumerPendingChainId(ctx sdk.Context, consumerId string) (string, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ConsumerIdToPendingChainIdKey(consumerId))
+	if bz == nil {
+		return "", false
+	}
+	return string(bz), true
+}
+
+// SetConsumerPendingChainId sets the chain id the consumer chain with `consumerId` is scheduled
+// to change to once its client is upgraded
+func (k Keeper) SetConsumerPendingChainId(ctx sdk.Context, consumerId, chainId string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ConsumerIdToPendingChainIdKey(consumerId), []byte(chainId))
+}
+
+// DeleteConsumerPendingChainId deletes the pending chain id of the consumer chain with `consumerId`
+func (k Keeper) DeleteConsumerPendingChainId(ctx sdk.Context, consumerId string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ConsumerIdToPendingChainIdKey(consumerId))
+}
+
+// GetAllConsumersWithPendingChainId returns the consumer ids of all the consumer chains with a pending chain id
+func (k Keeper) GetAllConsumersWithPendingChainId(ctx sdk.Context) []string {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.ConsumerIdToPendingChainIdKeyPrefix()
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{prefix})
+	defer iterator.Close()
+
+	consumerIds := []string{}
+	for ; iterator.Valid(); iterator.Next() {
+		consumerId, err := types.ParseStringIdWithLenKey(prefix, iterator.Key())
+		if err != nil {
+			// An error here would indicate something is very wrong,
+			// the key is assumed to be correctly serialized in SetConsumerPendingChainId.
+			panic(fmt.Errorf("failed to parse pending chain id key: %w", err))
+		}
+		consumerIds = append(consumerIds, consumerId)
+	}
+	return consumerIds
+}
+
+// ScheduleConsumerChainIdChange schedules the change of the chain id of the launched consumer chain
+// with `consumerId` to `newChainId`, e.g., for a hard fork of the consumer chain. The change is applied
+// once the consumer client is upgraded to `newChainId` (see BeginBlockChangeConsumerChainIds).
+// Scheduling the current chain id cancels a pending change.
+func (k Keeper) ScheduleConsumerChainIdChange(ctx sdk.Context, consumerId, newChainId string) error {
+	if !k.IsConsumerLaunched(ctx, consumerId) {
+		return errorsmod.Wrapf(types.ErrInvalidPhase,
+			"cannot change the chain id of a consumer chain that is not launched: %s", k.GetConsumerPhase(ctx, consumerId))
+	}
+
+	chainId, err := k.GetConsumerChainId(ctx, consumerId)
+	if err != nil {
+		return err
+	}
+
+	if newChainId == chainId {
+		k.DeleteConsumerPendingChainId(ctx, consumerId)
+		return nil
+	}
+
+	k.SetConsumerPendingChainId(ctx, consumerId, newChainId)
+
+	// the consumer client might have already been upgraded
+	return k.applyConsumerChainIdChange(ctx, consumerId)
+}
+
+// BeginBlockChangeConsumerChainIds applies the pending chain id changes of the consumer chains
+// whose clients were upgraded to the new chain id, e.g., through an IBC client upgrade or
+// a client recovery proposal
+func (k Keeper) BeginBlockChangeConsumerChainIds(ctx sdk.Context) {
+	for _, consumerId := range k.GetAllConsumersWithPendingChainId(ctx) {
+		if err := k.applyConsumerChainIdChange(ctx, consumerId); err != nil {
+			k.Logger(ctx).Error("cannot change consumer chain id",
+				"consumerId", consumerId,
+				"error", err.Error(),
+			)
+		}
+	}
+}
+
+// applyConsumerChainIdChange changes the chain id of the consumer chain with `consumerId` to its pending
+// chain id if the consumer client was upgraded to it. Since the state of the consumer chain is indexed by
+// its consumer id, the key assignments, opted-in validators, and reward attribution are preserved.
+func (k Keeper) applyConsumerChainIdChange(ctx sdk.Context, consumerId string) error {
+	pendingChainId, found := k.GetConsumerPendingChainId(ctx, consumerId)
+	if !found {
+		return nil
+	}
+
+	if !k.IsConsumerLaunched(ctx, consumerId) {
+		// the consumer chain was stopped before its client was upgraded
+		k.DeleteConsumerPendingChainId(ctx, consumerId)
+		return nil
+	}
+
+	_, tmClient, err := k.getConsumerTendermintClient(ctx, consumerId)
+	if err != nil {
+		return err
+	}
+	if tmClient.ChainId != pendingChainId {
+		// the consumer client is not yet upgraded
+		return nil
+	}
+
+	previousChainId, err := k.GetConsumerChainId(ctx, consumerId)
+	if err != nil {
+		return err
+	}
+
+	k.SetConsumerChainId(ctx, consumerId, pendingChainId)
+	k.DeleteConsumerPendingChainId(ctx, consumerId)
+
+	// the evidence of the previous chain cannot be verified against the new chain id
+	k.SetEquivocationEvidenceMinHeight(ctx, consumerId, tmClient.LatestHeight.RevisionHeight)
+
+	k.Logger(ctx).Info("consumer chain id changed",
+		"consumerId", consumerId,
+		"previousChainId", previousChainId,
+		"chainId", pendingChainId,
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeConsumerChainIdChanged,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeConsumerId, consumerId),
+			sdk.NewAttribute(types.AttributePreviousConsumerChainId, previousChainId),
+			sdk.NewAttribute(types.AttributeConsumerChainId, pendingChainId),
+		),
+	)
+
+	return nil
+}
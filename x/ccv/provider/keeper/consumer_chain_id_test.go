@@ -0,0 +1,70 @@
+package keeper_test
+
+import (
+	"testing"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	ibctmtypes "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
+	"github.com/stretchr/testify/require"
+
+	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
+	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+)
+
+// TestChangeConsumerChainId tests that the chain id of a launched consumer chain is changed
+// only once its client is upgraded to the new chain id
+func TestChangeConsumerChainId(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	consumerId := "0"
+	providerKeeper.SetConsumerChainId(ctx, consumerId, "chain-1")
+	providerKeeper.SetConsumerPhase(ctx, consumerId, providertypes.CONSUMER_PHASE_INITIALIZED)
+
+	// the chain id of a chain that is not launched cannot be changed
+	err := providerKeeper.ScheduleConsumerChainIdChange(ctx, consumerId, "chain-2")
+	require.ErrorIs(t, err, providertypes.ErrInvalidPhase)
+
+	providerKeeper.SetConsumerPhase(ctx, consumerId, providertypes.CONSUMER_PHASE_LAUNCHED)
+	providerKeeper.SetConsumerClientId(ctx, consumerId, "clientId")
+	providerKeeper.SetEquivocationEvidenceMinHeight(ctx, consumerId, 5)
+
+	// the consumer client is not yet upgraded
+	clientState := &ibctmtypes.ClientState{ChainId: "chain-1", LatestHeight: clienttypes.NewHeight(1, 100)}
+	mocks.MockClientKeeper.EXPECT().GetClientState(ctx, "clientId").Return(clientState, true).Times(2)
+	require.NoError(t, providerKeeper.ScheduleConsumerChainIdChange(ctx, consumerId, "chain-2"))
+	pendingChainId, found := providerKeeper.GetConsumerPendingChainId(ctx, consumerId)
+	require.True(t, found)
+	require.Equal(t, "chain-2", pendingChainId)
+	providerKeeper.BeginBlockChangeConsumerChainIds(ctx)
+	chainId, err := providerKeeper.GetConsumerChainId(ctx, consumerId)
+	require.NoError(t, err)
+	require.Equal(t, "chain-1", chainId)
+
+	// the consumer client is upgraded to the new chain id
+	upgradedClientState := &ibctmtypes.ClientState{ChainId: "chain-2", LatestHeight: clienttypes.NewHeight(2, 1)}
+	mocks.MockClientKeeper.EXPECT().GetClientState(ctx, "clientId").Return(upgradedClientState, true).Times(1)
+	providerKeeper.BeginBlockChangeConsumerChainIds(ctx)
+	chainId, err = providerKeeper.GetConsumerChainId(ctx, consumerId)
+	require.NoError(t, err)
+	require.Equal(t, "chain-2", chainId)
+	_, found = providerKeeper.GetConsumerPendingChainId(ctx, consumerId)
+	require.False(t, found)
+	require.Equal(t, uint64(1), providerKeeper.GetEquivocationEvidenceMinHeight(ctx, consumerId))
+	clientId, found := providerKeeper.GetConsumerClientId(ctx, consumerId)
+	require.True(t, found)
+	require.Equal(t, "clientId", clientId)
+
+	// scheduling the current chain id cancels a pending change
+	mocks.MockClientKeeper.EXPECT().GetClientState(ctx, "clientId").Return(upgradedClientState, true).Times(1)
+	require.NoError(t, providerKeeper.ScheduleConsumerChainIdChange(ctx, consumerId, "chain-3"))
+	require.NoError(t, providerKeeper.ScheduleConsumerChainIdChange(ctx, consumerId, "chain-2"))
+	require.Empty(t, providerKeeper.GetAllConsumersWithPendingChainId(ctx))
+
+	// the pending chain id of a stopped chain is deleted
+	mocks.MockClientKeeper.EXPECT().GetClientState(ctx, "clientId").Return(upgradedClientState, true).Times(1)
+	require.NoError(t, providerKeeper.ScheduleConsumerChainIdChange(ctx, consumerId, "chain-3"))
+	providerKeeper.SetConsumerPhase(ctx, consumerId, providertypes.CONSUMER_PHASE_STOPPED)
+	providerKeeper.BeginBlockChangeConsumerChainIds(ctx)
+	require.Empty(t, providerKeeper.GetAllConsumersWithPendingChainId(ctx))
+}
@@ -0,0 +1,147 @@
+package keeper_test
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	ibchost "github.com/cosmos/ibc-go/v10/modules/core/exported"
+	ibctmtypes "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
+	providerkeeper "github.com/cosmos/interchain-security/v7/x/ccv/provider/keeper"
+	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+)
+
+const stubWasmClientType = "08-wasm"
+
+// stubWasmChecksum prefixes the states of the stub wasm clients, as the checksum of the wasm light client code
+var stubWasmChecksum = []byte("checksum")
+
+// stubWasmClientState is a stub of the 08-wasm client state, which wraps the Tendermint client state
+type stubWasmClientState struct {
+	Data []byte
+}
+
+func (cs *stubWasmClientState) Reset()         { *cs = stubWasmClientState{} }
+func (cs *stubWasmClientState) String() string { return fmt.Sprintf("%X", cs.Data) }
+func (*stubWasmClientState) ProtoMessage()     {}
+func (*stubWasmClientState) ClientType() string {
+	return stubWasmClientType
+}
+func (*stubWasmClientState) Validate() error { return nil }
+
+// stubWasmConsumerClientFactory creates stub 08-wasm CCV clients
+type stubWasmConsumerClientFactory struct{}
+
+func (stubWasmConsumerClientFactory) ClientType() string {
+	return stubWasmClientType
+}
+
+func (stubWasmConsumerClientFactory) MarshalInitialStates(clientState *ibctmtypes.ClientState, consensusState *ibctmtypes.ConsensusState) (
+	clientStateBz, consensusStateBz []byte, err error,
+) {
+	clientStateBz, consensusStateBz, err = providertypes.TendermintConsumerClientFactory{}.MarshalInitialStates(clientState, consensusState)
+	if err != nil {
+		return nil, nil, err
+	}
+	return append(stubWasmChecksum, clientStateBz...), append(stubWasmChecksum, consensusStateBz...), nil
+}
+
+func (stubWasmConsumerClientFactory) TendermintClientState(clientState ibchost.ClientState) (*ibctmtypes.ClientState, error) {
+	wasmClient, ok := clientState.(*stubWasmClientState)
+	if !ok {
+		return nil, fmt.Errorf("invalid client type: %s", clientState.ClientType())
+	}
+	var tmClient ibctmtypes.ClientState
+	if err := tmClient.Unmarshal(wasmClient.Data); err != nil {
+		return nil, err
+	}
+	return &tmClient, nil
+}
+
+// TestConsumerClientFactories tests that the CCV clients of the consumer chains are created and tracked
+// with the configured consumer client factory, both for the default 07-tendermint factory and for a stub 08-wasm factory
+func TestConsumerClientFactories(t *testing.T) {
+	testCases := []struct {
+		name    string
+		factory providertypes.ConsumerClientFactory
+		// decodeClientState decodes the client state created with the factory, as the light client module would do
+		decodeClientState func(t *testing.T, clientStateBz []byte) ibchost.ClientState
+	}{
+		{
+			name:    "07-tendermint",
+			factory: providertypes.TendermintConsumerClientFactory{},
+			decodeClientState: func(t *testing.T, clientStateBz []byte) ibchost.ClientState {
+				t.Helper()
+				var clientState ibctmtypes.ClientState
+				require.NoError(t, clientState.Unmarshal(clientStateBz))
+				return &clientState
+			},
+		},
+		{
+			name:    "stub 08-wasm",
+			factory: stubWasmConsumerClientFactory{},
+			decodeClientState: func(t *testing.T, clientStateBz []byte) ibchost.ClientState {
+				t.Helper()
+				require.True(t, bytes.HasPrefix(clientStateBz, stubWasmChecksum))
+				return &stubWasmClientState{Data: clientStateBz[len(stubWasmChecksum):]}
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			keeperParams := testkeeper.NewInMemKeeperParams(t)
+			keeperParams.ProviderOptions = []providerkeeper.Option{providerkeeper.WithConsumerClientFactory(tc.factory)}
+			providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, keeperParams)
+			defer ctrl.Finish()
+			providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+
+			providerKeeper.SetConsumerPhase(ctx, CONSUMER_ID, providertypes.CONSUMER_PHASE_INITIALIZED)
+			providerKeeper.SetConsumerChainId(ctx, CONSUMER_ID, CONSUMER_CHAIN_ID)
+			initializationParameters := testkeeper.GetTestInitializationParameters(providertypes.InitParamsProfileMainnetConservative)
+			require.NoError(t, providerKeeper.SetConsumerInitializationParameters(ctx, CONSUMER_ID, initializationParameters))
+
+			// the client is created with the client type of the factory
+			var clientState ibchost.ClientState
+			mocks.MockClientKeeper.EXPECT().CreateClient(gomock.Any(), tc.factory.ClientType(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ sdk.Context, _ string, clientStateBz, _ []byte) (string, error) {
+					clientState = tc.decodeClientState(t, clientStateBz)
+					return "clientID", nil
+				}).Times(1)
+			require.NoError(t, providerKeeper.CreateConsumerClient(ctx, CONSUMER_ID, []byte{}))
+			require.Equal(t, tc.factory.ClientType(), clientState.ClientType())
+
+			// the wrapped Tendermint client state is derived from the initialization parameters
+			tmClient, err := tc.factory.TendermintClientState(clientState)
+			require.NoError(t, err)
+			require.Equal(t, CONSUMER_CHAIN_ID, tmClient.ChainId)
+			require.Equal(t, initializationParameters.InitialHeight, tmClient.LatestHeight)
+			require.Equal(t, initializationParameters.UnbondingPeriod, tmClient.UnbondingPeriod)
+
+			// the launched consumer chain is tracked through the wrapped Tendermint client state
+			providerKeeper.SetConsumerPhase(ctx, CONSUMER_ID, providertypes.CONSUMER_PHASE_LAUNCHED)
+			mocks.MockClientKeeper.EXPECT().GetClientState(gomock.Any(), "clientID").Return(clientState, true).AnyTimes()
+			err = providerKeeper.RegisterConsumerClientUpgrade(ctx, CONSUMER_ID, providertypes.ConsumerClientUpgradePlan{
+				UpgradeHeight: clienttypes.NewHeight(tmClient.LatestHeight.RevisionNumber, tmClient.LatestHeight.RevisionHeight+10),
+				NewChainId:    CONSUMER_CHAIN_ID,
+			})
+			require.NoError(t, err)
+		})
+	}
+}
+
+// TestTendermintConsumerClientFactory tests that the default consumer client factory rejects clients of other types
+func TestTendermintConsumerClientFactory(t *testing.T) {
+	factory := providertypes.TendermintConsumerClientFactory{}
+	require.Equal(t, ibchost.Tendermint, factory.ClientType())
+
+	_, err := factory.TendermintClientState(&stubWasmClientState{})
+	require.ErrorIs(t, err, clienttypes.ErrInvalidClientType)
+}
@@ -0,0 +1,303 @@
+package keeper
+
+import (
+	"fmt"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v10/modules/core/23-commitment/types"
+	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
+	ibctmtypes "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// GetConsumerClientUpgradePlan returns the pending client upgrade plan of the consumer chain with `consumerId`
+func (k Keeper) GetConsumerClientUpgradePlan(ctx sdk.Context, consumerId string) (types.ConsumerClientUpgradePlan, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ConsumerIdToClientUpgradePlanKey(consumerId))
+	if bz == nil {
+		return types.ConsumerClientUpgradePlan{}, false
+	}
+	var plan types.ConsumerClientUpgradePlan
+	if err := plan.Unmarshal(bz); err != nil {
+		// An error here would indicate something is very wrong,
+		// the plan is assumed to be correctly serialized in SetConsumerClientUpgradePlan.
+		panic(fmt.Errorf("failed to unmarshal client upgrade plan for consumer id (%s): %w", consumerId, err))
+	}
+	return plan, true
+}
+
+// SetConsumerClientUpgradePlan sets the pending client upgrade plan of the consumer chain with `consumerId`
+func (k Keeper) SetConsumerClientUpgradePlan(ctx sdk.Context, consumerId string, plan types.ConsumerClientUpgradePlan) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := plan.Marshal()
+	if err != nil {
+		// An error here would indicate something is very wrong,
+		// the plan is obtained from a validated message.
+		panic(fmt.Errorf("failed to marshal client upgrade plan for consumer id (%s): %w", consumerId, err))
+	}
+	store.Set(types.ConsumerIdToClientUpgradePlanKey(consumerId), bz)
+}
+
+// DeleteConsumerClientUpgradePlan deletes the pending client upgrade plan of the consumer chain with `consumerId`
+func (k Keeper) DeleteConsumerClientUpgradePlan(ctx sdk.Context, consumerId string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ConsumerIdToClientUpgradePlanKey(consumerId))
+}
+
+// GetAllConsumersWithClientUpgradePlan returns the consumer ids of all the consumer chains with a pending client upgrade plan
+func (k Keeper) GetAllConsumersWithClientUpgradePlan(ctx sdk.Context) []string {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.ConsumerIdToClientUpgradePlanKeyPrefix()
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{prefix})
+	defer iterator.Close()
+
+	consumerIds := []string{}
+	for ; iterator.Valid(); iterator.Next() {
+		consumerId, err := types.ParseStringIdWithLenKey(prefix, iterator.Key())
+		if err != nil {
+			// An error here would indicate something is very wrong,
+			// the key is assumed to be correctly serialized in SetConsumerClientUpgradePlan.
+			panic(fmt.Errorf("failed to parse client upgrade plan key: %w", err))
+		}
+		consumerIds = append(consumerIds, consumerId)
+	}
+	return consumerIds
+}
+
+// RegisterConsumerClientUpgrade registers the client upgrade scheduled by the launched consumer chain
+// with `consumerId`. The upgrade height must be in the current revision of the consumer chain and
+// after the latest height of the consumer client. If the upgrade changes the chain id of the consumer
+// chain, the change is scheduled as well (see ScheduleConsumerChainIdChange).
+func (k Keeper) RegisterConsumerClientUpgrade(ctx sdk.Context, consumerId string, plan types.ConsumerClientUpgradePlan) error {
+	if !k.IsConsumerLaunched(ctx, consumerId) {
+		return errorsmod.Wrapf(types.ErrInvalidPhase,
+			"cannot register a client upgrade for a consumer chain that is not launched: %s", k.GetConsumerPhase(ctx, consumerId))
+	}
+
+	_, tmClient, err := k.getConsumerTendermintClient(ctx, consumerId)
+	if err != nil {
+		return err
+	}
+	if plan.UpgradeHeight.RevisionNumber != tmClient.LatestHeight.RevisionNumber {
+		return errorsmod.Wrapf(types.ErrInvalidConsumerClientUpgradePlan,
+			"upgrade height (%s) is not in the current revision of the consumer chain (%d)",
+			plan.UpgradeHeight, tmClient.LatestHeight.RevisionNumber)
+	}
+	if !plan.UpgradeHeight.GT(tmClient.LatestHeight) {
+		return errorsmod.Wrapf(types.ErrInvalidConsumerClientUpgradePlan,
+			"upgrade height (%s) is not after the latest height of the consumer client (%s)",
+			plan.UpgradeHeight, tmClient.LatestHeight)
+	}
+
+	if err := k.ScheduleConsumerChainIdChange(ctx, consumerId, plan.NewChainId); err != nil {
+		return err
+	}
+
+	k.SetConsumerClientUpgradePlan(ctx, consumerId, plan)
+
+	return nil
+}
+
+// BeginBlockVerifyConsumerClientUpgrades applies or verifies the pending client upgrade plans
+// of the consumer clients that reached the upgrade heights of these plans
+func (k Keeper) BeginBlockVerifyConsumerClientUpgrades(ctx sdk.Context) {
+	for _, consumerId := range k.GetAllConsumersWithClientUpgradePlan(ctx) {
+		if err := k.verifyConsumerClientUpgrade(ctx, consumerId); err != nil {
+			k.Logger(ctx).Error("consumer client upgrade rejected",
+				"consumerId", consumerId,
+				"error", err.Error(),
+			)
+		}
+	}
+}
+
+// verifyConsumerClientUpgrade handles the pending client upgrade plan of the consumer chain with `consumerId`
+// once the client of the consumer chain reached the upgrade height:
+//   - if the client is at the upgrade height, i.e., the last height of the consumer chain before the upgrade,
+//     the provider applies the upgrade to the client;
+//   - if the client is past the upgrade height, i.e., a relayer already upgraded the client, the provider
+//     verifies the upgraded client against the plan and rejects the upgrade if it does not match the plan.
+//
+// The plan is removed once it is handled. An error is returned if the upgrade is rejected.
+func (k Keeper) verifyConsumerClientUpgrade(ctx sdk.Context, consumerId string) error {
+	plan, found := k.GetConsumerClientUpgradePlan(ctx, consumerId)
+	if !found {
+		return nil
+	}
+
+	if !k.IsConsumerLaunched(ctx, consumerId) {
+		// the consumer chain was stopped before its client was upgraded
+		k.DeleteConsumerClientUpgradePlan(ctx, consumerId)
+		return nil
+	}
+
+	clientId, tmClient, err := k.getConsumerTendermintClient(ctx, consumerId)
+	if err != nil {
+		return err
+	}
+	if tmClient.LatestHeight.LT(plan.UpgradeHeight) {
+		// the consumer client did not reach the upgrade height yet
+		return nil
+	}
+
+	k.DeleteConsumerClientUpgradePlan(ctx, consumerId)
+
+	if tmClient.LatestHeight.EQ(plan.UpgradeHeight) {
+		if err := k.applyConsumerClientUpgrade(ctx, clientId, tmClient, plan); err != nil {
+			return k.rejectConsumerClientUpgrade(ctx, consumerId, plan, err.Error(), false)
+		}
+	} else {
+		var mismatch string
+		switch {
+		case tmClient.ChainId != plan.NewChainId:
+			mismatch = fmt.Sprintf("chain id: expected %s, got %s", plan.NewChainId, tmClient.ChainId)
+		case plan.UnbondingPeriod != 0 && tmClient.UnbondingPeriod != plan.UnbondingPeriod:
+			mismatch = fmt.Sprintf("unbonding period: expected %s, got %s", plan.UnbondingPeriod, tmClient.UnbondingPeriod)
+		}
+		if mismatch != "" {
+			// the client was upgraded by a relayer in a way that does not match the plan, unless
+			// the consumer chain kept producing blocks with the same chain id past the upgrade height
+			chainId, err := k.GetConsumerChainId(ctx, consumerId)
+			if err != nil {
+				return err
+			}
+			upgraded := tmClient.LatestHeight.RevisionNumber != plan.UpgradeHeight.RevisionNumber ||
+				tmClient.ChainId != chainId
+			return k.rejectConsumerClientUpgrade(ctx, consumerId, plan, mismatch, upgraded)
+		}
+	}
+
+	k.Logger(ctx).Info("consumer client upgraded",
+		"consumerId", consumerId,
+		"clientId", clientId,
+		"chainId", plan.NewChainId,
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeConsumerClientUpgraded,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeConsumerId, consumerId),
+			sdk.NewAttribute(types.AttributeConsumerChainId, plan.NewChainId),
+			sdk.NewAttribute(types.AttributeUpgradeHeight, plan.UpgradeHeight.String()),
+		),
+	)
+
+	return nil
+}
+
+// applyConsumerClientUpgrade upgrades the client with `clientId`, which is at the upgrade height of `plan`,
+// as an IBC client upgrade would do, i.e., the upgraded client tracks the consumer chain with the new chain id
+// from the height following the upgrade height and trusts the validator set of the last block before the upgrade
+func (k Keeper) applyConsumerClientUpgrade(ctx sdk.Context, clientId string, tmClient *ibctmtypes.ClientState,
+	plan types.ConsumerClientUpgradePlan,
+) error {
+	if clientType := k.consumerClientFactory.ClientType(); clientType != ibcexported.Tendermint {
+		return fmt.Errorf("cannot upgrade a client of type %s", clientType)
+	}
+
+	consensusState, found := k.clientKeeper.GetClientConsensusState(ctx, clientId, tmClient.LatestHeight)
+	if !found {
+		return fmt.Errorf("cannot find the consensus state of client %s at the upgrade height (%s)", clientId, tmClient.LatestHeight)
+	}
+	tmConsensusState, ok := consensusState.(*ibctmtypes.ConsensusState)
+	if !ok {
+		return fmt.Errorf("invalid consensus state type: %T", consensusState)
+	}
+
+	upgradedHeight := clienttypes.NewHeight(clienttypes.ParseChainID(plan.NewChainId), plan.UpgradeHeight.RevisionHeight+1)
+	if !upgradedHeight.GT(tmClient.LatestHeight) {
+		return fmt.Errorf("upgraded height (%s) is not after the upgrade height (%s)", upgradedHeight, tmClient.LatestHeight)
+	}
+	unbondingPeriod := tmClient.UnbondingPeriod
+	if plan.UnbondingPeriod != 0 {
+		unbondingPeriod = plan.UnbondingPeriod
+	}
+	trustingPeriod := tmClient.TrustingPeriod
+	if trustingPeriod >= unbondingPeriod {
+		var err error
+		trustingPeriod, err = ccv.CalculateTrustPeriod(unbondingPeriod, k.GetTrustingPeriodFraction(ctx))
+		if err != nil {
+			return err
+		}
+	}
+
+	upgradedClient := ibctmtypes.NewClientState(plan.NewChainId, tmClient.TrustLevel, trustingPeriod, unbondingPeriod,
+		tmClient.MaxClockDrift, upgradedHeight, tmClient.ProofSpecs, tmClient.UpgradePath)
+	if err := upgradedClient.Validate(); err != nil {
+		return fmt.Errorf("invalid upgraded client: %w", err)
+	}
+	upgradedConsensusState := ibctmtypes.NewConsensusState(tmConsensusState.Timestamp,
+		commitmenttypes.NewMerkleRoot([]byte(ibctmtypes.SentinelRoot)), tmConsensusState.NextValidatorsHash)
+
+	k.clientKeeper.SetClientState(ctx, clientId, upgradedClient)
+	k.clientKeeper.SetClientConsensusState(ctx, clientId, upgradedHeight, upgradedConsensusState)
+	clientStore := k.clientKeeper.ClientStore(ctx, clientId)
+	ibctmtypes.SetProcessedTime(clientStore, upgradedHeight, uint64(ctx.BlockTime().UnixNano()))
+	ibctmtypes.SetProcessedHeight(clientStore, upgradedHeight, clienttypes.GetSelfHeight(ctx))
+	ibctmtypes.SetIterationKey(clientStore, upgradedHeight)
+
+	return nil
+}
+
+// rejectConsumerClientUpgrade rejects the client upgrade `plan` of the consumer chain with `consumerId`.
+// The chain id change scheduled with the plan is cancelled and, if the client was already upgraded
+// (i.e., `upgraded` is true), the client is frozen, so that an upgraded client that does not match
+// the plan cannot be used. A frozen client can only be recovered through a governance proposal.
+func (k Keeper) rejectConsumerClientUpgrade(ctx sdk.Context, consumerId string, plan types.ConsumerClientUpgradePlan,
+	mismatch string, upgraded bool,
+) error {
+	if pendingChainId, found := k.GetConsumerPendingChainId(ctx, consumerId); found && pendingChainId == plan.NewChainId {
+		k.DeleteConsumerPendingChainId(ctx, consumerId)
+	}
+
+	if upgraded {
+		clientId, tmClient, err := k.getConsumerTendermintClient(ctx, consumerId)
+		if err != nil {
+			return err
+		}
+		if clientType := k.consumerClientFactory.ClientType(); clientType != ibcexported.Tendermint {
+			return fmt.Errorf("cannot freeze client %s of type %s: %s", clientId, clientType, mismatch)
+		}
+		frozenClient := *tmClient
+		frozenClient.FrozenHeight = ibctmtypes.FrozenHeight
+		k.clientKeeper.SetClientState(ctx, clientId, &frozenClient)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeConsumerClientUpgradeMismatch,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeConsumerId, consumerId),
+			sdk.NewAttribute(types.AttributeUpgradeHeight, plan.UpgradeHeight.String()),
+			sdk.NewAttribute(types.AttributeUpgradeMismatch, mismatch),
+		),
+	)
+
+	return errorsmod.Wrapf(types.ErrInvalidConsumerClientUpgrade, "consumer id %s: %s", consumerId, mismatch)
+}
+
+// getConsumerTendermintClient returns the client id and the Tendermint client state
+// of the client of the consumer chain with `consumerId`
+func (k Keeper) getConsumerTendermintClient(ctx sdk.Context, consumerId string) (string, *ibctmtypes.ClientState, error) {
+	clientId, found := k.GetConsumerClientId(ctx, consumerId)
+	if !found {
+		return "", nil, fmt.Errorf("cannot find client for consumer chain %s", consumerId)
+	}
+	clientState, found := k.clientKeeper.GetClientState(ctx, clientId)
+	if !found {
+		return "", nil, fmt.Errorf("cannot find client state for client %s", clientId)
+	}
+	tmClient, err := k.consumerClientFactory.TendermintClientState(clientState)
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid client %s: %w", clientId, err)
+	}
+	return clientId, tmClient, nil
+}
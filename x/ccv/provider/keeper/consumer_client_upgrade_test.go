@@ -0,0 +1,187 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v10/modules/core/23-commitment/types"
+	"github.com/cosmos/ibc-go/v10/modules/core/exported"
+	ibctmtypes "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/store/prefix"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
+	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+)
+
+// TestConsumerClientUpgrade tests that a registered consumer client upgrade is applied once the
+// consumer client reaches the upgrade height, and verified if a relayer already upgraded the client
+func TestConsumerClientUpgrade(t *testing.T) {
+	keeperParams := testkeeper.NewInMemKeeperParams(t)
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, keeperParams)
+	defer ctrl.Finish()
+
+	consumerId := "0"
+	providerKeeper.SetConsumerChainId(ctx, consumerId, "chain-1")
+	providerKeeper.SetConsumerPhase(ctx, consumerId, providertypes.CONSUMER_PHASE_LAUNCHED)
+	providerKeeper.SetConsumerClientId(ctx, consumerId, "clientId")
+
+	clientState := ibctmtypes.NewClientState("chain-1", ibctmtypes.DefaultTrustLevel, 40*time.Minute, time.Hour,
+		10*time.Second, clienttypes.NewHeight(1, 100), commitmenttypes.GetSDKSpecs(), []string{"upgrade", "upgradedIBCState"})
+	mocks.MockClientKeeper.EXPECT().GetClientState(gomock.Any(), "clientId").DoAndReturn(
+		func(sdk.Context, string) (exported.ClientState, bool) { return clientState, true },
+	).AnyTimes()
+	mocks.MockClientKeeper.EXPECT().ClientStore(gomock.Any(), "clientId").Return(
+		prefix.NewStore(ctx.KVStore(keeperParams.StoreKey), []byte("clientId")),
+	).AnyTimes()
+
+	plan := providertypes.ConsumerClientUpgradePlan{
+		UpgradeHeight:   clienttypes.NewHeight(1, 200),
+		NewChainId:      "chain-2",
+		UnbondingPeriod: 2 * time.Hour,
+	}
+
+	// the upgrade height must be after the latest height of the consumer client
+	invalidPlan := plan
+	invalidPlan.UpgradeHeight = clienttypes.NewHeight(1, 100)
+	err := providerKeeper.RegisterConsumerClientUpgrade(ctx, consumerId, invalidPlan)
+	require.ErrorIs(t, err, providertypes.ErrInvalidConsumerClientUpgradePlan)
+
+	// the upgrade height must be in the current revision of the consumer chain
+	invalidPlan.UpgradeHeight = clienttypes.NewHeight(2, 200)
+	err = providerKeeper.RegisterConsumerClientUpgrade(ctx, consumerId, invalidPlan)
+	require.ErrorIs(t, err, providertypes.ErrInvalidConsumerClientUpgradePlan)
+	require.Empty(t, providerKeeper.GetAllConsumersWithClientUpgradePlan(ctx))
+
+	// registering the upgrade schedules the change of the chain id
+	require.NoError(t, providerKeeper.RegisterConsumerClientUpgrade(ctx, consumerId, plan))
+	registeredPlan, found := providerKeeper.GetConsumerClientUpgradePlan(ctx, consumerId)
+	require.True(t, found)
+	require.Equal(t, plan, registeredPlan)
+	pendingChainId, found := providerKeeper.GetConsumerPendingChainId(ctx, consumerId)
+	require.True(t, found)
+	require.Equal(t, "chain-2", pendingChainId)
+
+	resp, err := providerKeeper.QueryConsumerClientUpgradePlans(ctx, &providertypes.QueryConsumerClientUpgradePlansRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []providertypes.ConsumerClientUpgrade{
+		{ConsumerId: consumerId, ChainId: "chain-1", ClientId: "clientId", Plan: plan},
+	}, resp.Upgrades)
+
+	// the consumer client did not reach the upgrade height yet
+	clientState.LatestHeight = clienttypes.NewHeight(1, 150)
+	providerKeeper.BeginBlockVerifyConsumerClientUpgrades(ctx)
+	_, found = providerKeeper.GetConsumerClientUpgradePlan(ctx, consumerId)
+	require.True(t, found)
+
+	// the consumer client is upgraded by the provider once it reaches the upgrade height
+	clientState.LatestHeight = clienttypes.NewHeight(1, 200)
+	consensusState := ibctmtypes.NewConsensusState(time.Unix(1000, 0).UTC(), commitmenttypes.NewMerkleRoot([]byte("root")), []byte("next_validators_hash"))
+	upgradedHeight := clienttypes.NewHeight(2, 201)
+	gomock.InOrder(
+		mocks.MockClientKeeper.EXPECT().GetClientConsensusState(gomock.Any(), "clientId", clientState.LatestHeight).Return(consensusState, true),
+		mocks.MockClientKeeper.EXPECT().SetClientState(gomock.Any(), "clientId", gomock.Any()).Do(
+			func(_ sdk.Context, _ string, upgradedClient exported.ClientState) {
+				clientState = upgradedClient.(*ibctmtypes.ClientState)
+			}),
+		mocks.MockClientKeeper.EXPECT().SetClientConsensusState(gomock.Any(), "clientId", upgradedHeight,
+			ibctmtypes.NewConsensusState(consensusState.Timestamp, commitmenttypes.NewMerkleRoot([]byte(ibctmtypes.SentinelRoot)), consensusState.NextValidatorsHash)),
+	)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	providerKeeper.BeginBlockVerifyConsumerClientUpgrades(ctx)
+	providerKeeper.BeginBlockChangeConsumerChainIds(ctx)
+	_, found = providerKeeper.GetConsumerClientUpgradePlan(ctx, consumerId)
+	require.False(t, found)
+	require.Equal(t, providertypes.EventTypeConsumerClientUpgraded, ctx.EventManager().Events()[0].Type)
+	require.Equal(t, "chain-2", clientState.ChainId)
+	require.Equal(t, upgradedHeight, clientState.LatestHeight)
+	require.Equal(t, 2*time.Hour, clientState.UnbondingPeriod)
+	require.Equal(t, 40*time.Minute, clientState.TrustingPeriod)
+	chainId, err := providerKeeper.GetConsumerChainId(ctx, consumerId)
+	require.NoError(t, err)
+	require.Equal(t, "chain-2", chainId)
+
+	// a consumer client already upgraded by a relayer as planned is accepted
+	plan = providertypes.ConsumerClientUpgradePlan{
+		UpgradeHeight: clienttypes.NewHeight(2, 300),
+		NewChainId:    "chain-3",
+	}
+	require.NoError(t, providerKeeper.RegisterConsumerClientUpgrade(ctx, consumerId, plan))
+	relayerUpgradedClient := *clientState
+	relayerUpgradedClient.ChainId = "chain-3"
+	relayerUpgradedClient.LatestHeight = clienttypes.NewHeight(3, 1)
+	clientState = &relayerUpgradedClient
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	providerKeeper.BeginBlockVerifyConsumerClientUpgrades(ctx)
+	providerKeeper.BeginBlockChangeConsumerChainIds(ctx)
+	_, found = providerKeeper.GetConsumerClientUpgradePlan(ctx, consumerId)
+	require.False(t, found)
+	require.Equal(t, providertypes.EventTypeConsumerClientUpgraded, ctx.EventManager().Events()[0].Type)
+	chainId, err = providerKeeper.GetConsumerChainId(ctx, consumerId)
+	require.NoError(t, err)
+	require.Equal(t, "chain-3", chainId)
+
+	// a consumer client upgraded by a relayer in a way that does not match the plan is frozen
+	plan = providertypes.ConsumerClientUpgradePlan{
+		UpgradeHeight: clienttypes.NewHeight(3, 100),
+		NewChainId:    "chain-4",
+	}
+	require.NoError(t, providerKeeper.RegisterConsumerClientUpgrade(ctx, consumerId, plan))
+	relayerUpgradedClient = *clientState
+	relayerUpgradedClient.ChainId = "chain-5"
+	relayerUpgradedClient.LatestHeight = clienttypes.NewHeight(5, 1)
+	clientState = &relayerUpgradedClient
+	mocks.MockClientKeeper.EXPECT().SetClientState(gomock.Any(), "clientId", gomock.Any()).Do(
+		func(_ sdk.Context, _ string, frozenClient exported.ClientState) {
+			require.Equal(t, ibctmtypes.FrozenHeight, frozenClient.(*ibctmtypes.ClientState).FrozenHeight)
+			require.Equal(t, "chain-5", frozenClient.(*ibctmtypes.ClientState).ChainId)
+		})
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	providerKeeper.BeginBlockVerifyConsumerClientUpgrades(ctx)
+	_, found = providerKeeper.GetConsumerClientUpgradePlan(ctx, consumerId)
+	require.False(t, found)
+	_, found = providerKeeper.GetConsumerPendingChainId(ctx, consumerId)
+	require.False(t, found)
+	require.Equal(t, providertypes.EventTypeConsumerClientUpgradeMismatch, ctx.EventManager().Events()[0].Type)
+
+	// a consumer chain that did not upgrade at the upgrade height has its plan rejected, but its client is not frozen
+	clientState = &relayerUpgradedClient
+	clientState.ChainId = "chain-3"
+	clientState.LatestHeight = clienttypes.NewHeight(3, 101)
+	plan.UpgradeHeight = clienttypes.NewHeight(3, 200)
+	require.NoError(t, providerKeeper.RegisterConsumerClientUpgrade(ctx, consumerId, plan))
+	clientState.LatestHeight = clienttypes.NewHeight(3, 201)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	providerKeeper.BeginBlockVerifyConsumerClientUpgrades(ctx)
+	require.Equal(t, providertypes.EventTypeConsumerClientUpgradeMismatch, ctx.EventManager().Events()[0].Type)
+	_, found = providerKeeper.GetConsumerClientUpgradePlan(ctx, consumerId)
+	require.False(t, found)
+	_, found = providerKeeper.GetConsumerPendingChainId(ctx, consumerId)
+	require.False(t, found)
+
+	// an upgrade that cannot be applied is rejected, e.g., because the revision of the new chain id is lower
+	plan = providertypes.ConsumerClientUpgradePlan{
+		UpgradeHeight: clienttypes.NewHeight(3, 300),
+		NewChainId:    "chain-2",
+	}
+	require.NoError(t, providerKeeper.RegisterConsumerClientUpgrade(ctx, consumerId, plan))
+	clientState.LatestHeight = clienttypes.NewHeight(3, 300)
+	mocks.MockClientKeeper.EXPECT().GetClientConsensusState(gomock.Any(), "clientId", clientState.LatestHeight).Return(consensusState, true)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	providerKeeper.BeginBlockVerifyConsumerClientUpgrades(ctx)
+	require.Equal(t, providertypes.EventTypeConsumerClientUpgradeMismatch, ctx.EventManager().Events()[0].Type)
+	_, found = providerKeeper.GetConsumerClientUpgradePlan(ctx, consumerId)
+	require.False(t, found)
+
+	// the client upgrade plan of a stopped chain is deleted
+	plan.UpgradeHeight = clienttypes.NewHeight(3, 400)
+	require.NoError(t, providerKeeper.RegisterConsumerClientUpgrade(ctx, consumerId, plan))
+	providerKeeper.SetConsumerPhase(ctx, consumerId, providertypes.CONSUMER_PHASE_STOPPED)
+	providerKeeper.BeginBlockVerifyConsumerClientUpgrades(ctx)
+	require.Empty(t, providerKeeper.GetAllConsumersWithClientUpgradePlan(ctx))
+}
@@ -17,6 +17,7 @@ import (
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	tmtypes "github.com/cometbft/cometbft/types"
 
 	"github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
@@ -80,7 +81,7 @@ func (k Keeper) HandleConsumerDoubleVoting(
 		return err
 	}
 
-	if err = k.SlashValidator(ctx, providerAddr, infractionParams.DoubleSign); err != nil {
+	if err = k.SlashValidator(ctx, consumerId, providerAddr, infractionParams.DoubleSign); err != nil {
 		return err
 	}
 	if err = k.JailAndTombstoneValidator(ctx, providerAddr, infractionParams.DoubleSign); err != nil {
@@ -97,6 +98,28 @@ func (k Keeper) HandleConsumerDoubleVoting(
 	return nil
 }
 
+// EmitSubmitConsumerDoubleVotingEvent emits the event of a double voting evidence that was handled successfully,
+// either submitted in a MsgSubmitConsumerDoubleVoting or forwarded by the consumer chain in a ConsumerDoubleVote packet.
+// The attributes identify the origin of the evidence.
+func (k Keeper) EmitSubmitConsumerDoubleVotingEvent(
+	ctx sdk.Context,
+	consumerId, chainId string,
+	evidence *tmproto.DuplicateVoteEvidence,
+	attributes ...sdk.Attribute,
+) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			ccvtypes.EventTypeSubmitConsumerDoubleVoting,
+			append([]sdk.Attribute{
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				sdk.NewAttribute(types.AttributeConsumerId, consumerId),
+				sdk.NewAttribute(types.AttributeConsumerChainId, chainId),
+				sdk.NewAttribute(ccvtypes.AttributeConsumerDoubleVoting, evidence.String()),
+			}, attributes...)...,
+		),
+	)
+}
+
 // VerifyDoubleVotingEvidence verifies a double voting evidence
 // for a given chain id and a validator public key
 func (k Keeper) VerifyDoubleVotingEvidence(
@@ -203,7 +226,7 @@ func (k Keeper) HandleConsumerMisbehaviour(ctx sdk.Context, consumerId string, m
 			consumerId,
 			types.NewConsumerConsAddress(sdk.ConsAddress(v.Address.Bytes())),
 		)
-		err := k.SlashValidator(ctx, providerAddr, infractionParams.DoubleSign)
+		err := k.SlashValidator(ctx, consumerId, providerAddr, infractionParams.DoubleSign)
 		if err != nil {
 			logger.Error("failed to slash validator: %s", err)
 			continue
@@ -233,6 +256,26 @@ func (k Keeper) HandleConsumerMisbehaviour(ctx sdk.Context, consumerId string, m
 	return nil
 }
 
+// EmitSubmitConsumerMisbehaviourEvent emits the event of a consumer misbehaviour that was handled successfully,
+// either submitted in a MsgSubmitConsumerMisbehaviour or reported by the consumer chain in a ConsumerMisbehaviour packet.
+// The attributes identify the origin of the misbehaviour.
+func (k Keeper) EmitSubmitConsumerMisbehaviourEvent(ctx sdk.Context, consumerId string, misbehaviour ibctmtypes.Misbehaviour, attributes ...sdk.Attribute) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			ccvtypes.EventTypeSubmitConsumerMisbehaviour,
+			append([]sdk.Attribute{
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				sdk.NewAttribute(types.AttributeConsumerId, consumerId),
+				sdk.NewAttribute(types.AttributeConsumerChainId, misbehaviour.Header1.Header.ChainID),
+				sdk.NewAttribute(ccvtypes.AttributeConsumerMisbehaviour, misbehaviour.String()),
+				sdk.NewAttribute(ccvtypes.AttributeMisbehaviourClientId, misbehaviour.ClientId),
+				sdk.NewAttribute(ccvtypes.AttributeMisbehaviourHeight1, misbehaviour.Header1.GetHeight().String()),
+				sdk.NewAttribute(ccvtypes.AttributeMisbehaviourHeight2, misbehaviour.Header2.GetHeight().String()),
+			}, attributes...)...,
+		),
+	)
+}
+
 // GetByzantineValidators returns the validators that signed both headers.
 // If the misbehavior is an equivocation light client attack, then these
 // validators are the Byzantine validators.
@@ -492,8 +535,8 @@ func (k Keeper) ComputePowerToSlash(ctx sdk.Context, validator stakingtypes.Vali
 	return power + undelegationsAndRedelegationsInPower
 }
 
-// SlashValidator slashes validator with given provider Address
-func (k Keeper) SlashValidator(ctx sdk.Context, providerAddr types.ProviderConsAddress, slashingParams *types.SlashJailParameters) error {
+// SlashValidator slashes validator with given provider Address for an infraction committed on the consumer chain with `consumerId`
+func (k Keeper) SlashValidator(ctx sdk.Context, consumerId string, providerAddr types.ProviderConsAddress, slashingParams *types.SlashJailParameters) error {
 	validator, err := k.stakingKeeper.GetValidatorByConsAddr(ctx, providerAddr.ToSdkConsAddr())
 	if err != nil && errors.Is(err, stakingtypes.ErrNoValidatorFound) {
 		return errorsmod.Wrapf(slashingtypes.ErrNoValidatorForAddress, "provider consensus address: %s", providerAddr.String())
@@ -535,8 +578,24 @@ func (k Keeper) SlashValidator(ctx sdk.Context, providerAddr types.ProviderConsA
 		return err
 	}
 
-	_, err = k.stakingKeeper.SlashWithInfractionReason(ctx, consAdrr, 0, totalPower, slashingParams.SlashFraction, stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN)
-	return err
+	slashedTokens, err := k.stakingKeeper.SlashWithInfractionReason(ctx, consAdrr, 0, totalPower, slashingParams.SlashFraction, stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN)
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSlashConsumerInfraction,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeConsumerId, consumerId),
+			sdk.NewAttribute(types.AttributeProviderValidatorAddress, validator.GetOperator()),
+			sdk.NewAttribute(ccvtypes.AttributeValidatorAddress, providerAddr.String()),
+			sdk.NewAttribute(ccvtypes.AttributeInfractionType, stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN.String()),
+			sdk.NewAttribute(types.AttributeSlashedTokens, slashedTokens.String()),
+		),
+	)
+
+	return nil
 }
 
 //
@@ -739,7 +739,20 @@ func TestSlashValidator(t *testing.T) {
 	}
 
 	gomock.InOrder(expectedCalls...)
-	keeper.SlashValidator(ctx, providerAddr, getTestInfractionParameters().DoubleSign)
+	err = keeper.SlashValidator(ctx, "0", providerAddr, getTestInfractionParameters().DoubleSign)
+	require.NoError(t, err)
+
+	// the slashed tokens are reported together with the consumer chain of the infraction
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, types.EventTypeSlashConsumerInfraction, events[0].Type)
+	attrs := map[string]string{}
+	for _, attr := range events[0].Attributes {
+		attrs[attr.Key] = attr.Value
+	}
+	require.Equal(t, "0", attrs[types.AttributeConsumerId])
+	require.Equal(t, validator.GetOperator(), attrs[types.AttributeProviderValidatorAddress])
+	require.Equal(t, math.NewInt(expectedSlashPower).String(), attrs[types.AttributeSlashedTokens])
 }
 
 // TestSlashValidatorDoesNotSlashIfValidatorIsUnbonded asserts that `SlashValidator` does not call
@@ -766,7 +779,7 @@ func TestSlashValidatorDoesNotSlashIfValidatorIsUnbonded(t *testing.T) {
 	}
 
 	gomock.InOrder(expectedCalls...)
-	keeper.SlashValidator(ctx, providerAddr, getTestInfractionParameters().DoubleSign)
+	keeper.SlashValidator(ctx, "0", providerAddr, getTestInfractionParameters().DoubleSign)
 }
 
 func TestEquivocationEvidenceMinHeightCRUD(t *testing.T) {
@@ -1,17 +1,20 @@
 package keeper
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
+	"slices"
 	"time"
 
 	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
 	conntypes "github.com/cosmos/ibc-go/v10/modules/core/03-connection/types"
 	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
 	commitmenttypes "github.com/cosmos/ibc-go/v10/modules/core/23-commitment/types"
-	ibchost "github.com/cosmos/ibc-go/v10/modules/core/exported"
 	ibctmtypes "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
 
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -36,50 +39,81 @@ func (k Keeper) PrepareConsumerForLaunch(ctx sdk.Context, consumerId string, pre
 			return err
 		}
 	}
+	if spawnTime.IsZero() {
+		// the chain is launched at a spawn height instead
+		return nil
+	}
 	return k.AppendConsumerToBeLaunched(ctx, consumerId, spawnTime)
 }
 
+// PrepareConsumerForLaunchAtHeight prepares to move the launch of a consumer chain from the previous spawn height
+// to spawn height. Both the previous spawn height and the spawn height can be zero if the chain was not, or is no longer,
+// set for launch at a spawn height.
+func (k Keeper) PrepareConsumerForLaunchAtHeight(ctx sdk.Context, consumerId string, previousSpawnHeight, spawnHeight uint64) error {
+	if previousSpawnHeight != 0 {
+		err := k.RemoveConsumerToBeLaunchedAtHeight(ctx, consumerId, previousSpawnHeight)
+		if err != nil {
+			return err
+		}
+	}
+	if spawnHeight == 0 {
+		return nil
+	}
+	return k.AppendConsumerToBeLaunchedAtHeight(ctx, consumerId, spawnHeight)
+}
+
 // InitializeConsumer tries to move a consumer with `consumerId` to the initialized phase.
-// If successful, it returns the spawn time and true.
-func (k Keeper) InitializeConsumer(ctx sdk.Context, consumerId string) (time.Time, bool) {
+// If successful, it returns the spawn time, the spawn height and true. Exactly one of
+// the spawn time and the spawn height is set.
+func (k Keeper) InitializeConsumer(ctx sdk.Context, consumerId string) (time.Time, uint64, bool) {
 	// a chain needs to be in the registered or initialized phase
 	phase := k.GetConsumerPhase(ctx, consumerId)
 	if phase != types.CONSUMER_PHASE_REGISTERED && phase != types.CONSUMER_PHASE_INITIALIZED {
-		return time.Time{}, false
+		return time.Time{}, 0, false
 	}
 
 	initializationParameters, err := k.GetConsumerInitializationParameters(ctx, consumerId)
 	if err != nil {
-		return time.Time{}, false
+		return time.Time{}, 0, false
 	}
 
-	// the spawn time needs to be positive
-	if initializationParameters.SpawnTime.IsZero() {
-		return time.Time{}, false
+	// either the spawn time or the spawn height needs to be positive
+	if initializationParameters.SpawnTime.IsZero() && initializationParameters.SpawnHeight == 0 {
+		return time.Time{}, 0, false
 	}
 
 	k.SetConsumerPhase(ctx, consumerId, types.CONSUMER_PHASE_INITIALIZED)
 
-	return initializationParameters.SpawnTime, true
+	return initializationParameters.SpawnTime, initializationParameters.SpawnHeight, true
 }
 
-// BeginBlockLaunchConsumers launches initialized consumers chains for which the spawn time has passed
+// BeginBlockLaunchConsumers launches initialized consumers chains for which the spawn time or the spawn height has passed
 func (k Keeper) BeginBlockLaunchConsumers(ctx sdk.Context) error {
 	bondedValidators := []stakingtypes.Validator{}
 	activeValidators := []stakingtypes.Validator{}
+	launchedConsumers := uint64(0)
 
+	limit := 200
 	consumerIds, err := k.ConsumeIdsFromTimeQueue(
 		ctx,
 		types.SpawnTimeToConsumerIdsKeyPrefix(),
 		k.GetConsumersToBeLaunched,
 		k.DeleteAllConsumersToBeLaunched,
 		k.AppendConsumerToBeLaunched,
-		200,
+		limit,
 	)
 	if err != nil {
 		return errorsmod.Wrapf(ccv.ErrInvalidConsumerState, "getting consumers ready to laumch: %s", err.Error())
 	}
+	consumerIdsAtHeight, err := k.ConsumeIdsFromSpawnHeightQueue(ctx, limit-len(consumerIds))
+	if err != nil {
+		return errorsmod.Wrapf(ccv.ErrInvalidConsumerState, "getting consumers ready to launch at spawn height: %s", err.Error())
+	}
+	consumerIds = append(consumerIds, consumerIdsAtHeight...)
 	if len(consumerIds) > 0 {
+		// count the launched consumer chains only if consumer chains are to be launched
+		launchedConsumers = k.GetLaunchedConsumersCount(ctx)
+
 		// get the bonded validators from the staking module
 		bondedValidators, err = k.GetLastBondedValidators(ctx)
 		if err != nil {
@@ -93,35 +127,198 @@ func (k Keeper) BeginBlockLaunchConsumers(ctx sdk.Context) error {
 	}
 
 	for _, consumerId := range consumerIds {
-		cachedCtx, writeFn := ctx.CacheContext()
-		err = k.LaunchConsumer(cachedCtx, bondedValidators, activeValidators, consumerId)
+		capped, err := k.IsConsumerLaunchCapped(ctx, consumerId, launchedConsumers)
+		if err != nil {
+			return errorsmod.Wrapf(ccv.ErrInvalidConsumerState,
+				"checking launch capacity, consumerId(%s): %s", consumerId, err.Error())
+		}
+		if capped {
+			k.Logger(ctx).Info("consumer launch blocked by the cap on launched consumers",
+				"consumerId", consumerId,
+				"launchedConsumers", launchedConsumers,
+			)
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeConsumerLaunchBlocked,
+					sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+					sdk.NewAttribute(types.AttributeConsumerId, consumerId),
+					sdk.NewAttribute(types.AttributeLaunchedConsumers, fmt.Sprintf("%d", launchedConsumers)),
+					sdk.NewAttribute(types.AttributeMaxLaunchedConsumers, fmt.Sprintf("%d", k.GetMaxLaunchedConsumers(ctx))),
+				),
+			)
+			if err := k.resetConsumerLaunch(ctx, consumerId); err != nil {
+				return err
+			}
+			continue
+		}
+
+		err = k.LaunchConsumer(ctx, bondedValidators, activeValidators, consumerId)
 		if err != nil {
 			ctx.Logger().Error("could not launch chain",
 				"consumerId", consumerId,
 				"error", err)
 
-			// reset spawn time to zero so that owner can try again later
-			initializationRecord, err := k.GetConsumerInitializationParameters(ctx, consumerId)
-			if err != nil {
-				return errorsmod.Wrapf(ccv.ErrInvalidConsumerState,
-					"getting initialization parameters, consumerId(%s): %s", consumerId, err.Error())
+			if err := k.handleFailedConsumerLaunch(ctx, consumerId, err); err != nil {
+				return err
 			}
-			initializationRecord.SpawnTime = time.Time{}
-			err = k.SetConsumerInitializationParameters(ctx, consumerId, initializationRecord)
-			if err != nil {
-				return fmt.Errorf("setting consumer initialization parameters, consumerId(%s): %w", consumerId, err)
-			}
-			// also set the phase to registered
-			k.SetConsumerPhase(ctx, consumerId, types.CONSUMER_PHASE_REGISTERED)
-
 			continue
 		}
 
-		writeFn()
+		launchedConsumers++
+	}
+	return nil
+}
+
+// handleFailedConsumerLaunch records the failed launch of a consumer chain. If the consumer client
+// could not be created, the chain remains in the initialized phase, so that the owner can retry the launch
+// with MsgRetryLaunch. Otherwise, the chain is moved back to the registered phase.
+func (k Keeper) handleFailedConsumerLaunch(ctx sdk.Context, consumerId string, launchErr error) error {
+	retriable := errors.Is(launchErr, types.ErrConsumerClientCreation)
+	err := k.SetConsumerLaunchFailure(ctx, consumerId, types.ConsumerLaunchFailure{
+		Error:     launchErr.Error(),
+		Height:    ctx.BlockHeight(),
+		Time:      ctx.BlockTime(),
+		Retriable: retriable,
+	})
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeConsumerLaunchFailed,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeConsumerId, consumerId),
+			sdk.NewAttribute(types.AttributeLaunchError, launchErr.Error()),
+			sdk.NewAttribute(types.AttributeLaunchRetriable, fmt.Sprintf("%t", retriable)),
+		),
+	)
+
+	if retriable {
+		return nil
+	}
+	return k.resetConsumerLaunch(ctx, consumerId)
+}
+
+// RetryConsumerLaunch schedules the launch of an initialized consumer chain whose
+// consumer client could not be created at spawn time
+func (k Keeper) RetryConsumerLaunch(ctx sdk.Context, consumerId string) error {
+	phase := k.GetConsumerPhase(ctx, consumerId)
+	if phase != types.CONSUMER_PHASE_INITIALIZED {
+		return errorsmod.Wrapf(types.ErrInvalidPhase,
+			"cannot retry the launch of a chain that is not in the initialized phase: %s", phase)
+	}
+
+	if !k.IsConsumerLaunchRetriable(ctx, consumerId) {
+		return errorsmod.Wrapf(types.ErrConsumerLaunchNotRetriable,
+			"no failed consumer client creation recorded, consumerId(%s)", consumerId)
+	}
+
+	initializationParameters, err := k.GetConsumerInitializationParameters(ctx, consumerId)
+	if err != nil {
+		return errorsmod.Wrapf(ccv.ErrInvalidConsumerState,
+			"getting initialization parameters, consumerId(%s): %s", consumerId, err.Error())
+	}
+
+	// the spawn time or the spawn height has passed, so the chain is launched in the next block
+	if initializationParameters.SpawnHeight != 0 {
+		if err := k.AppendConsumerToBeLaunchedAtHeight(ctx, consumerId, initializationParameters.SpawnHeight); err != nil {
+			return fmt.Errorf("appending consumer to be launched at height, consumerId(%s): %w", consumerId, err)
+		}
+	} else if err := k.AppendConsumerToBeLaunched(ctx, consumerId, initializationParameters.SpawnTime); err != nil {
+		return fmt.Errorf("appending consumer to be launched, consumerId(%s): %w", consumerId, err)
+	}
+	k.DeleteConsumerLaunchFailure(ctx, consumerId)
+
+	return nil
+}
+
+// GetConsumerLaunchFailure returns the last failed launch of the consumer chain with `consumerId`
+func (k Keeper) GetConsumerLaunchFailure(ctx sdk.Context, consumerId string) (types.ConsumerLaunchFailure, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ConsumerIdToLaunchFailureKey(consumerId))
+	if bz == nil {
+		return types.ConsumerLaunchFailure{}, false
+	}
+	var failure types.ConsumerLaunchFailure
+	if err := failure.Unmarshal(bz); err != nil {
+		// An error here would indicate something is very wrong,
+		// the failure is assumed to be correctly serialized in SetConsumerLaunchFailure.
+		panic(fmt.Errorf("failed to unmarshal launch failure for consumer id (%s): %w", consumerId, err))
+	}
+	return failure, true
+}
+
+// SetConsumerLaunchFailure sets the last failed launch of the consumer chain with `consumerId`
+func (k Keeper) SetConsumerLaunchFailure(ctx sdk.Context, consumerId string, failure types.ConsumerLaunchFailure) error {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := failure.Marshal()
+	if err != nil {
+		return fmt.Errorf("failed to marshal launch failure (%+v) for consumer id (%s): %w", failure, consumerId, err)
 	}
+	store.Set(types.ConsumerIdToLaunchFailureKey(consumerId), bz)
 	return nil
 }
 
+// DeleteConsumerLaunchFailure deletes the last failed launch of the consumer chain with `consumerId`
+func (k Keeper) DeleteConsumerLaunchFailure(ctx sdk.Context, consumerId string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ConsumerIdToLaunchFailureKey(consumerId))
+}
+
+// IsConsumerLaunchRetriable returns true if the last launch of the consumer chain with `consumerId`
+// failed because the consumer client could not be created. Such a chain is not scheduled for launch
+// until its owner retries the launch.
+func (k Keeper) IsConsumerLaunchRetriable(ctx sdk.Context, consumerId string) bool {
+	failure, found := k.GetConsumerLaunchFailure(ctx, consumerId)
+	return found && failure.Retriable
+}
+
+// resetConsumerLaunch resets the spawn time and the spawn height of a consumer chain that could not be launched
+// to zero and moves the chain back to the registered phase, so that the owner can try again later
+func (k Keeper) resetConsumerLaunch(ctx sdk.Context, consumerId string) error {
+	initializationRecord, err := k.GetConsumerInitializationParameters(ctx, consumerId)
+	if err != nil {
+		return errorsmod.Wrapf(ccv.ErrInvalidConsumerState,
+			"getting initialization parameters, consumerId(%s): %s", consumerId, err.Error())
+	}
+	initializationRecord.SpawnTime = time.Time{}
+	initializationRecord.SpawnHeight = 0
+	err = k.SetConsumerInitializationParameters(ctx, consumerId, initializationRecord)
+	if err != nil {
+		return fmt.Errorf("setting consumer initialization parameters, consumerId(%s): %w", consumerId, err)
+	}
+	// also set the phase to registered
+	k.SetConsumerPhase(ctx, consumerId, types.CONSUMER_PHASE_REGISTERED)
+	return nil
+}
+
+// GetLaunchedConsumersCount returns the number of consumer chains in the launched (or paused) phase
+func (k Keeper) GetLaunchedConsumersCount(ctx sdk.Context) uint64 {
+	count := uint64(0)
+	for _, consumerId := range k.GetAllConsumerIds(ctx) {
+		if k.IsConsumerLaunched(ctx, consumerId) {
+			count++
+		}
+	}
+	return count
+}
+
+// IsConsumerLaunchCapped returns true if the consumer chain with the given consumer id cannot be launched
+// because the number of launched consumer chains reached the MaxLaunchedConsumers param.
+// The cap does not apply to the consumer chains owned by the governance module.
+func (k Keeper) IsConsumerLaunchCapped(ctx sdk.Context, consumerId string, launchedConsumers uint64) (bool, error) {
+	maxLaunchedConsumers := k.GetMaxLaunchedConsumers(ctx)
+	if maxLaunchedConsumers == 0 || launchedConsumers < maxLaunchedConsumers {
+		return false, nil
+	}
+	ownerAddress, err := k.GetConsumerOwnerAddress(ctx, consumerId)
+	if err != nil {
+		return false, err
+	}
+	return ownerAddress != k.GetAuthority(), nil
+}
+
 // ConsumeIdsFromTimeQueue returns from a time queue the consumer ids for which the associated time passed.
 // The number of ids return is limited to 'limit'. The ids returned are removed from the time queue.
 func (k Keeper) ConsumeIdsFromTimeQueue(
@@ -192,6 +389,66 @@ func (k Keeper) ConsumeIdsFromTimeQueue(
 	return result, nil
 }
 
+// ConsumeIdsFromSpawnHeightQueue returns the ids of the consumer chains for which the spawn height was reached.
+// The number of ids return is limited to 'limit'. The ids returned are removed from the spawn height queue.
+func (k Keeper) ConsumeIdsFromSpawnHeightQueue(ctx sdk.Context, limit int) ([]string, error) {
+	store := ctx.KVStore(k.storeKey)
+
+	result := []string{}
+	nextTime := []string{}
+	heightsToDelete := []uint64{}
+
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{types.SpawnHeightToConsumerIdsKeyPrefix()})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		if len(result) >= limit {
+			break
+		}
+		height := sdk.BigEndianToUint64(iterator.Key()[1:])
+		if height > uint64(ctx.BlockHeight()) {
+			break
+		}
+
+		consumerIds, err := k.GetConsumersToBeLaunchedAtHeight(ctx, height)
+		if err != nil {
+			return result,
+				fmt.Errorf("getting consumers ids, height(%d): %w", height, err)
+		}
+
+		heightsToDelete = append(heightsToDelete, height)
+
+		availableSlots := limit - len(result)
+		if availableSlots >= len(consumerIds.Ids) {
+			// consumer all the ids
+			result = append(result, consumerIds.Ids...)
+		} else {
+			// consume only availableSlots
+			result = append(result, consumerIds.Ids[:availableSlots]...)
+			// and leave the others for next time
+			nextTime = consumerIds.Ids[availableSlots:]
+			break
+		}
+	}
+
+	// remove consumers to prevent handling them twice
+	for i, height := range heightsToDelete {
+		k.DeleteAllConsumersToBeLaunchedAtHeight(ctx, height)
+		if i == len(heightsToDelete)-1 {
+			// for the last height consumed, store back the ids for later
+			for _, consumerId := range nextTime {
+				err := k.AppendConsumerToBeLaunchedAtHeight(ctx, consumerId, height)
+				if err != nil {
+					return result,
+						fmt.Errorf("failed to append consumer id, consumerId(%s), height(%d): %w",
+							consumerId, height, err)
+				}
+			}
+		}
+	}
+
+	return result, nil
+}
+
 // HasActiveConsumerValidator checks whether at least one active validator is opted in to chain with `consumerId`
 func (k Keeper) HasActiveConsumerValidator(ctx sdk.Context, consumerId string, activeValidators []stakingtypes.Validator) (bool, error) {
 	currentValidatorSet, err := k.GetConsumerValSet(ctx, consumerId)
@@ -228,9 +485,22 @@ func (k Keeper) LaunchConsumer(
 	bondedValidators []stakingtypes.Validator,
 	activeValidators []stakingtypes.Validator,
 	consumerId string,
+) error {
+	// the chain is launched atomically, i.e., a failure leaves neither a genesis state nor a consumer client behind
+	return k.executeAtomically(ctx, func(ctx sdk.Context) error {
+		return k.launchConsumer(ctx, bondedValidators, activeValidators, consumerId)
+	})
+}
+
+// launchConsumer contains the steps of LaunchConsumer
+func (k Keeper) launchConsumer(
+	ctx sdk.Context,
+	bondedValidators []stakingtypes.Validator,
+	activeValidators []stakingtypes.Validator,
+	consumerId string,
 ) error {
 	// compute consumer initial validator set
-	initialValUpdates, err := k.ComputeConsumerNextValSet(ctx, bondedValidators, activeValidators, consumerId, []types.ConsensusValidator{})
+	initialValUpdates, err := k.ComputeConsumerNextValSet(ctx, bondedValidators, activeValidators, consumerId, []types.ConsensusValidator{}, nil)
 	if err != nil {
 		return fmt.Errorf("computing consumer next validator set, consumerId(%s): %w", consumerId, err)
 	}
@@ -247,6 +517,11 @@ func (k Keeper) LaunchConsumer(
 		return fmt.Errorf("cannot launch consumer with no active consumer validator, consumerId(%s)", consumerId)
 	}
 
+	// a Top N chain cannot launch if the validators that exhausted their Top N budget leave the top N uncovered
+	if err := k.CheckTopNCoverage(ctx, consumerId, activeValidators); err != nil {
+		return fmt.Errorf("cannot launch consumer that is not covered by the top N: %w", err)
+	}
+
 	// create consumer genesis
 	genesisState, err := k.MakeConsumerGenesis(ctx, consumerId, initialValUpdates)
 	if err != nil {
@@ -267,10 +542,11 @@ func (k Keeper) LaunchConsumer(
 	// create the consumer client and the genesis
 	err = k.CreateConsumerClient(ctx, consumerId, valsetHash)
 	if err != nil {
-		return fmt.Errorf("crating consumer client, consumerId(%s): %w", consumerId, err)
+		return errorsmod.Wrapf(types.ErrConsumerClientCreation, "consumerId(%s): %s", consumerId, err.Error())
 	}
 
 	k.SetConsumerPhase(ctx, consumerId, types.CONSUMER_PHASE_LAUNCHED)
+	k.DeleteConsumerLaunchFailure(ctx, consumerId)
 
 	k.Logger(ctx).Info("consumer successfully launched",
 		"consumerId", consumerId,
@@ -278,6 +554,8 @@ func (k Keeper) LaunchConsumer(
 		"valsetHash", string(valsetHash),
 	)
 
+	k.afterConsumerLaunched(ctx, consumerId)
+
 	return nil
 }
 
@@ -327,6 +605,20 @@ func (k Keeper) CreateConsumerClient(
 	clientState.TrustingPeriod = trustPeriod
 	clientState.UnbondingPeriod = consumerUnbondingPeriod
 
+	// Consumers can opt for a stricter trust level than the one of the template client
+	if initializationRecord.TrustLevel != nil {
+		trustLevel := *initializationRecord.TrustLevel
+		// compare the fractions by cross-multiplying, i.e., a/b < c/d iff a*d < c*b,
+		// as both denominators are positive
+		if math.NewIntFromUint64(trustLevel.Numerator).Mul(math.NewIntFromUint64(clientState.TrustLevel.Denominator)).LT(
+			math.NewIntFromUint64(clientState.TrustLevel.Numerator).Mul(math.NewIntFromUint64(trustLevel.Denominator))) {
+			return errorsmod.Wrapf(types.ErrInvalidConsumerInitializationParameters,
+				"trust level (%s) cannot be lower than the trust level of the template client (%s)",
+				trustLevel.ToTendermint(), clientState.TrustLevel.ToTendermint())
+		}
+		clientState.TrustLevel = trustLevel
+	}
+
 	// Create consensus state
 	consensusState := ibctmtypes.NewConsensusState(
 		ctx.BlockTime(),
@@ -334,17 +626,34 @@ func (k Keeper) CreateConsumerClient(
 		valsetHash,
 	)
 
-	clientStateBytes, err := clientState.Marshal()
-	if err != nil {
-		return err
+	// Use the initial consensus state provided by the owner (if any) instead of deriving it,
+	// as long as it commits to the declared genesis hash and to the initial validator set
+	if initialConsensusState, found := k.GetConsumerInitialConsensusState(ctx, consumerId); found {
+		if !bytes.Equal(initialConsensusState.GenesisHash, initializationRecord.GenesisHash) {
+			return errorsmod.Wrapf(types.ErrInvalidConsumerInitialConsensusState,
+				"genesis hash (%X) does not match the genesis hash in the initialization parameters (%X)",
+				initialConsensusState.GenesisHash, initializationRecord.GenesisHash)
+		}
+		if !bytes.Equal(initialConsensusState.ConsensusState.NextValidatorsHash, valsetHash) {
+			return errorsmod.Wrapf(types.ErrInvalidConsumerInitialConsensusState,
+				"next validators hash (%X) does not match the hash of the initial validator set (%X)",
+				initialConsensusState.ConsensusState.NextValidatorsHash, valsetHash)
+		}
+		if initialConsensusState.ConsensusState.Timestamp.After(ctx.BlockTime()) {
+			return errorsmod.Wrapf(types.ErrInvalidConsumerInitialConsensusState,
+				"timestamp (%s) cannot be after the current block time (%s)",
+				initialConsensusState.ConsensusState.Timestamp, ctx.BlockTime())
+		}
+		consensusState = &initialConsensusState.ConsensusState
 	}
-	consensusStateBytes, err := consensusState.Marshal()
+
+	// the client and consensus states are wrapped into the states of the configured client type, e.g., 08-wasm
+	clientStateBytes, consensusStateBytes, err := k.consumerClientFactory.MarshalInitialStates(clientState, consensusState)
 	if err != nil {
 		return err
 	}
 
-	// this means the client must be tendermint
-	clientID, err := k.clientKeeper.CreateClient(ctx, ibchost.Tendermint, clientStateBytes, consensusStateBytes)
+	clientID, err := k.clientKeeper.CreateClient(ctx, k.consumerClientFactory.ClientType(), clientStateBytes, consensusStateBytes)
 	if err != nil {
 		return err
 	}
@@ -364,6 +673,7 @@ func (k Keeper) CreateConsumerClient(
 			sdk.NewAttribute(clienttypes.AttributeKeyClientID, clientID),
 			sdk.NewAttribute(types.AttributeInitialHeight, initializationRecord.InitialHeight.String()),
 			sdk.NewAttribute(types.AttributeTrustingPeriod, clientState.TrustingPeriod.String()),
+			sdk.NewAttribute(types.AttributeTrustLevel, clientState.TrustLevel.ToTendermint().String()),
 			sdk.NewAttribute(types.AttributeUnbondingPeriod, clientState.UnbondingPeriod.String()),
 			sdk.NewAttribute(types.AttributeValsetHash, string(valsetHash)),
 		),
@@ -400,6 +710,13 @@ func (k Keeper) MakeConsumerGenesis(
 		[]string{},
 		ccv.DefaultRetryDelayPeriod,
 		consumerId,
+		false,
+		ccv.DefaultMaxValidatorUpdatesPerBlock,
+		ccv.DefaultRetryDelayMultiplier,
+		ccv.DefaultMaxRetryDelayPeriod,
+		ccv.DefaultClientExpiryWarningThreshold,
+		nil,
+		nil,
 	)
 
 	var clientState *ibctmtypes.ClientState = nil
@@ -459,12 +776,9 @@ func (k Keeper) MakeConsumerGenesis(
 				clientId, initializationRecord.ConnectionId,
 			)
 		}
-		tmClient, ok := clientState.(*ibctmtypes.ClientState)
-		if !ok {
-			return gen, errorsmod.Wrapf(clienttypes.ErrInvalidClientType,
-				"invalid client type. expected %s, got %s",
-				ibchost.Tendermint, clientState.ClientType(),
-			)
+		tmClient, err := k.consumerClientFactory.TendermintClientState(clientState)
+		if err != nil {
+			return gen, err
 		}
 		consumerChainId, err := k.GetConsumerChainId(ctx, consumerId)
 		if err != nil {
@@ -527,6 +841,14 @@ func (k Keeper) getSelfConsensusState(ctx sdk.Context, height clienttypes.Height
 // StopAndPrepareForConsumerRemoval sets the phase of the chain to stopped and prepares to get the state of the
 // chain removed after unbonding period elapses
 func (k Keeper) StopAndPrepareForConsumerRemoval(ctx sdk.Context, consumerId string) error {
+	// the chain is stopped atomically, i.e., it cannot end up stopped without being scheduled for removal
+	return k.executeAtomically(ctx, func(ctx sdk.Context) error {
+		return k.stopAndPrepareForConsumerRemoval(ctx, consumerId)
+	})
+}
+
+// stopAndPrepareForConsumerRemoval contains the steps of StopAndPrepareForConsumerRemoval
+func (k Keeper) stopAndPrepareForConsumerRemoval(ctx sdk.Context, consumerId string) error {
 	// The phase of the chain is immediately set to stopped, albeit its state is removed later (see below).
 	// Setting the phase here helps in not considering this chain when we look at launched chains (e.g., in `QueueVSCPackets)
 	k.SetConsumerPhase(ctx, consumerId, types.CONSUMER_PHASE_STOPPED)
@@ -548,6 +870,101 @@ func (k Keeper) StopAndPrepareForConsumerRemoval(ctx sdk.Context, consumerId str
 	return nil
 }
 
+// PauseConsumer moves the launched consumer chain with `consumerId` to the paused phase.
+// The provider keeps queueing the VSC packets of a paused chain, but it does not send them
+// until the chain is resumed (see ResumeConsumer).
+func (k Keeper) PauseConsumer(ctx sdk.Context, consumerId string) error {
+	if phase := k.GetConsumerPhase(ctx, consumerId); phase != types.CONSUMER_PHASE_LAUNCHED {
+		return errorsmod.Wrapf(types.ErrInvalidPhase,
+			"cannot pause a consumer chain that is not in the launched phase: %s", phase)
+	}
+
+	k.SetConsumerPhase(ctx, consumerId, types.CONSUMER_PHASE_PAUSED)
+	return nil
+}
+
+// ResumeConsumer moves the paused consumer chain with `consumerId` back to the launched phase.
+// The VSC packets queued while the chain was paused are sent in the next EndBlock.
+func (k Keeper) ResumeConsumer(ctx sdk.Context, consumerId string) error {
+	if phase := k.GetConsumerPhase(ctx, consumerId); phase != types.CONSUMER_PHASE_PAUSED {
+		return errorsmod.Wrapf(types.ErrInvalidPhase,
+			"cannot resume a consumer chain that is not in the paused phase: %s", phase)
+	}
+
+	k.SetConsumerPhase(ctx, consumerId, types.CONSUMER_PHASE_LAUNCHED)
+	return nil
+}
+
+// ForceStopConsumer stops the consumer chain with `consumerId` without the consent of its owner and schedules
+// its removal. A launched chain stops receiving VSC packets immediately, while a chain that is not yet launched
+// is removed from the launch queues. A launched Top N chain can only be stopped if `supermajority` is set.
+func (k Keeper) ForceStopConsumer(ctx sdk.Context, consumerId string, supermajority bool) error {
+	// a chain that cannot be stopped remains in the launch queues
+	return k.executeAtomically(ctx, func(ctx sdk.Context) error {
+		return k.forceStopConsumer(ctx, consumerId, supermajority)
+	})
+}
+
+// forceStopConsumer contains the steps of ForceStopConsumer
+func (k Keeper) forceStopConsumer(ctx sdk.Context, consumerId string, supermajority bool) error {
+	phase := k.GetConsumerPhase(ctx, consumerId)
+	switch phase {
+	case types.CONSUMER_PHASE_REGISTERED:
+	case types.CONSUMER_PHASE_INITIALIZED:
+		initializationParameters, err := k.GetConsumerInitializationParameters(ctx, consumerId)
+		if err != nil {
+			return errorsmod.Wrapf(ccv.ErrInvalidConsumerState,
+				"getting initialization parameters, consumerId(%s): %s", consumerId, err.Error())
+		}
+		if err := k.removeConsumerFromLaunchQueues(ctx, consumerId, initializationParameters); err != nil {
+			return err
+		}
+	case types.CONSUMER_PHASE_LAUNCHED, types.CONSUMER_PHASE_PAUSED:
+		powerShapingParameters, err := k.GetConsumerPowerShapingParameters(ctx, consumerId)
+		if err != nil {
+			return errorsmod.Wrapf(ccv.ErrInvalidConsumerState,
+				"getting power shaping parameters, consumerId(%s): %s", consumerId, err.Error())
+		}
+		if powerShapingParameters.Top_N > 0 && !supermajority {
+			return errorsmod.Wrapf(types.ErrUnauthorized,
+				"cannot force remove the launched Top N chain with consumer id %s without the supermajority flag", consumerId)
+		}
+	default:
+		return errorsmod.Wrapf(types.ErrInvalidPhase,
+			"cannot force remove a chain that is already stopped or deleted: %s", phase)
+	}
+
+	return k.StopAndPrepareForConsumerRemoval(ctx, consumerId)
+}
+
+// removeConsumerFromLaunchQueues removes an initialized consumer chain from the spawn time and spawn height queues.
+// Note that the chain might not be in any of the queues, e.g., if its consumer client could not be created.
+func (k Keeper) removeConsumerFromLaunchQueues(ctx sdk.Context, consumerId string, initializationParameters types.ConsumerInitializationParameters) error {
+	if spawnTime := initializationParameters.SpawnTime; !spawnTime.IsZero() {
+		consumerIds, err := k.GetConsumersToBeLaunched(ctx, spawnTime)
+		if err != nil {
+			return err
+		}
+		if slices.Contains(consumerIds.Ids, consumerId) {
+			if err := k.RemoveConsumerToBeLaunched(ctx, consumerId, spawnTime); err != nil {
+				return err
+			}
+		}
+	}
+	if spawnHeight := initializationParameters.SpawnHeight; spawnHeight != 0 {
+		consumerIds, err := k.GetConsumersToBeLaunchedAtHeight(ctx, spawnHeight)
+		if err != nil {
+			return err
+		}
+		if slices.Contains(consumerIds.Ids, consumerId) {
+			if err := k.RemoveConsumerToBeLaunchedAtHeight(ctx, consumerId, spawnHeight); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 // BeginBlockRemoveConsumers removes stopped consumer chain for which the removal time has passed
 func (k Keeper) BeginBlockRemoveConsumers(ctx sdk.Context) error {
 	consumerIds, err := k.ConsumeIdsFromTimeQueue(
@@ -562,23 +979,27 @@ func (k Keeper) BeginBlockRemoveConsumers(ctx sdk.Context) error {
 		return errorsmod.Wrapf(ccv.ErrInvalidConsumerState, "getting consumers ready to stop: %s", err.Error())
 	}
 	for _, consumerId := range consumerIds {
-		// delete consumer chain in a cached context to abort deletion in case of errors
-		cachedCtx, writeFn := ctx.CacheContext()
-		err = k.DeleteConsumerChain(cachedCtx, consumerId)
+		// the deletion is aborted in case of errors (see DeleteConsumerChain)
+		err = k.DeleteConsumerChain(ctx, consumerId)
 		if err != nil {
 			k.Logger(ctx).Error("consumer chain could not be removed",
 				"consumerId", consumerId,
 				"error", err.Error())
-			continue
 		}
-
-		writeFn()
 	}
 	return nil
 }
 
-// DeleteConsumerChain cleans up the state of the given consumer chain
-func (k Keeper) DeleteConsumerChain(ctx sdk.Context, consumerId string) (err error) {
+// DeleteConsumerChain cleans up the state of the given consumer chain.
+// The state is cleaned up atomically, i.e., a failure leaves the state of the chain untouched.
+func (k Keeper) DeleteConsumerChain(ctx sdk.Context, consumerId string) error {
+	return k.executeAtomically(ctx, func(ctx sdk.Context) error {
+		return k.deleteConsumerChain(ctx, consumerId)
+	})
+}
+
+// deleteConsumerChain contains the steps of DeleteConsumerChain
+func (k Keeper) deleteConsumerChain(ctx sdk.Context, consumerId string) (err error) {
 	phase := k.GetConsumerPhase(ctx, consumerId)
 	if phase != types.CONSUMER_PHASE_STOPPED {
 		return fmt.Errorf("cannot delete non-stopped chain: %s", consumerId)
@@ -589,8 +1010,22 @@ func (k Keeper) DeleteConsumerChain(ctx sdk.Context, consumerId string) (err err
 	k.DeleteConsumerGenesis(ctx, consumerId)
 	// Note: this call panics if the key assignment state is invalid
 	k.DeleteKeyAssignments(ctx, consumerId)
+	k.DeleteScheduledKeyAssignments(ctx, consumerId)
+	k.DeleteKeyAssignmentObservations(ctx, consumerId)
 	k.DeleteMinimumPowerInTopN(ctx, consumerId)
+	k.DeleteConsumerPowerShapingPipeline(ctx, consumerId)
 	k.DeleteEquivocationEvidenceMinHeight(ctx, consumerId)
+	k.DeleteConsumerInitialConsensusState(ctx, consumerId)
+	k.DeleteRewardDenomHint(ctx, consumerId)
+	k.DeleteConsumerPacketStats(ctx, consumerId)
+	k.DeleteConsumerPendingChainId(ctx, consumerId)
+	k.DeleteConsumerClientUpgradePlan(ctx, consumerId)
+	k.DeleteConsumerProviderSwitch(ctx, consumerId)
+	k.DeleteConsumerClientExpiry(ctx, consumerId)
+	k.DeleteConsumerClientExpiryWarned(ctx, consumerId)
+	k.DeleteBouncedSlashPacket(ctx, consumerId)
+	k.DeleteLastVSCPacket(ctx, consumerId)
+	k.DeleteConsumerSlashMeter(ctx, consumerId)
 
 	// close channel and delete the mappings between chain ID and channel ID
 	if channelID, found := k.GetConsumerIdToChannelId(ctx, consumerId); found {
@@ -609,6 +1044,26 @@ func (k Keeper) DeleteConsumerChain(ctx sdk.Context, consumerId string) (err err
 		}
 		k.DeleteConsumerIdToChannelId(ctx, consumerId)
 		k.DeleteChannelIdToConsumerId(ctx, channelID)
+		k.DeleteAllVSCPacketTimeouts(ctx, channelID)
+		k.DeleteAllUnackedVSCPackets(ctx, channelID)
+	}
+	// close the previous CCV channel in case a channel migration is in progress
+	if previousChannelID, found := k.GetConsumerIdToPreviousChannelId(ctx, consumerId); found {
+		channel, found := k.channelKeeper.GetChannel(ctx, ccv.ProviderPortID, previousChannelID)
+		if found && channel.State != channeltypes.CLOSED {
+			err := k.chanCloseInit(ctx, previousChannelID)
+			if err != nil {
+				k.Logger(ctx).Error("previous channel to consumer chain could not be closed",
+					"consumerId", consumerId,
+					"channelID", previousChannelID,
+					"error", err.Error(),
+				)
+			}
+		}
+		k.DeleteChannelIdToConsumerId(ctx, previousChannelID)
+		k.DeleteConsumerIdToPreviousChannelId(ctx, consumerId)
+		k.DeleteAllVSCPacketTimeouts(ctx, previousChannelID)
+		k.DeleteAllUnackedVSCPackets(ctx, previousChannelID)
 	}
 
 	// delete consumer commission rate
@@ -620,6 +1075,7 @@ func (k Keeper) DeleteConsumerChain(ctx sdk.Context, consumerId string) (err err
 	k.DeleteInitChainHeight(ctx, consumerId)
 	k.DeleteSlashAcks(ctx, consumerId)
 	k.DeletePendingVSCPackets(ctx, consumerId)
+	k.DeleteConsumerChannelRecoveryTime(ctx, consumerId)
 
 	k.DeleteAllowlist(ctx, consumerId)
 	k.DeleteDenylist(ctx, consumerId)
@@ -641,6 +1097,8 @@ func (k Keeper) DeleteConsumerChain(ctx sdk.Context, consumerId string) (err err
 	k.SetConsumerPhase(ctx, consumerId, types.CONSUMER_PHASE_DELETED)
 	k.Logger(ctx).Info("consumer chain deleted from provider", "consumerId", consumerId)
 
+	k.afterConsumerRemoved(ctx, consumerId)
+
 	return nil
 }
 
@@ -681,8 +1139,27 @@ func (k Keeper) DeleteConsumerRemovalTime(ctx sdk.Context, consumerId string) {
 
 // getConsumerIdsBasedOnTime returns all the consumer ids stored under this specific `key(time)`
 func (k Keeper) getConsumerIdsBasedOnTime(ctx sdk.Context, key func(time.Time) []byte, time time.Time) (types.ConsumerIds, error) {
+	return k.getConsumerIdsUnderKey(ctx, key(time))
+}
+
+// appendConsumerIdOnTime appends the consumer id on all the other consumer ids under `key(time)`
+func (k Keeper) appendConsumerIdOnTime(ctx sdk.Context, consumerId string, key func(time.Time) []byte, time time.Time) error {
+	return k.appendConsumerIdUnderKey(ctx, consumerId, key(time))
+}
+
+// removeConsumerIdFromTime removes consumer id stored under `key(time)`
+func (k Keeper) removeConsumerIdFromTime(ctx sdk.Context, consumerId string, key func(time.Time) []byte, time time.Time) error {
+	err := k.removeConsumerIdUnderKey(ctx, consumerId, key(time))
+	if err != nil {
+		return fmt.Errorf("time %s: %w", time.String(), err)
+	}
+	return nil
+}
+
+// getConsumerIdsUnderKey returns all the consumer ids stored under `key`
+func (k Keeper) getConsumerIdsUnderKey(ctx sdk.Context, key []byte) (types.ConsumerIds, error) {
 	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(key(time))
+	bz := store.Get(key)
 	if bz == nil {
 		return types.ConsumerIds{}, nil
 	}
@@ -695,11 +1172,11 @@ func (k Keeper) getConsumerIdsBasedOnTime(ctx sdk.Context, key func(time.Time) [
 	return consumerIds, nil
 }
 
-// appendConsumerIdOnTime appends the consumer id on all the other consumer ids under `key(time)`
-func (k Keeper) appendConsumerIdOnTime(ctx sdk.Context, consumerId string, key func(time.Time) []byte, time time.Time) error {
+// appendConsumerIdUnderKey appends the consumer id on all the other consumer ids under `key`
+func (k Keeper) appendConsumerIdUnderKey(ctx sdk.Context, consumerId string, key []byte) error {
 	store := ctx.KVStore(k.storeKey)
 
-	consumers, err := k.getConsumerIdsBasedOnTime(ctx, key, time)
+	consumers, err := k.getConsumerIdsUnderKey(ctx, key)
 	if err != nil {
 		return err
 	}
@@ -713,21 +1190,21 @@ func (k Keeper) appendConsumerIdOnTime(ctx sdk.Context, consumerId string, key f
 		return err
 	}
 
-	store.Set(key(time), bz)
+	store.Set(key, bz)
 	return nil
 }
 
-// removeConsumerIdFromTime removes consumer id stored under `key(time)`
-func (k Keeper) removeConsumerIdFromTime(ctx sdk.Context, consumerId string, key func(time.Time) []byte, time time.Time) error {
+// removeConsumerIdUnderKey removes consumer id stored under `key`
+func (k Keeper) removeConsumerIdUnderKey(ctx sdk.Context, consumerId string, key []byte) error {
 	store := ctx.KVStore(k.storeKey)
 
-	consumers, err := k.getConsumerIdsBasedOnTime(ctx, key, time)
+	consumers, err := k.getConsumerIdsUnderKey(ctx, key)
 	if err != nil {
 		return err
 	}
 
 	if len(consumers.Ids) == 0 {
-		return fmt.Errorf("no consumer ids found for this time: %s", time.String())
+		return fmt.Errorf("no consumer ids found")
 	}
 
 	// find the index of the consumer we want to remove
@@ -744,7 +1221,7 @@ func (k Keeper) removeConsumerIdFromTime(ctx sdk.Context, consumerId string, key
 	}
 
 	if len(consumers.Ids) == 1 {
-		store.Delete(key(time))
+		store.Delete(key)
 		return nil
 	}
 
@@ -757,7 +1234,7 @@ func (k Keeper) removeConsumerIdFromTime(ctx sdk.Context, consumerId string, key
 		return err
 	}
 
-	store.Set(key(time), bz)
+	store.Set(key, bz)
 	return nil
 }
 
@@ -782,6 +1259,31 @@ func (k Keeper) DeleteAllConsumersToBeLaunched(ctx sdk.Context, spawnTime time.T
 	store.Delete(types.SpawnTimeToConsumerIdsKey(spawnTime))
 }
 
+// GetConsumersToBeLaunchedAtHeight returns all the consumer ids of chains stored under this spawn height
+func (k Keeper) GetConsumersToBeLaunchedAtHeight(ctx sdk.Context, spawnHeight uint64) (types.ConsumerIds, error) {
+	return k.getConsumerIdsUnderKey(ctx, types.SpawnHeightToConsumerIdsKey(spawnHeight))
+}
+
+// AppendConsumerToBeLaunchedAtHeight appends the provider consumer id for the given spawn height
+func (k Keeper) AppendConsumerToBeLaunchedAtHeight(ctx sdk.Context, consumerId string, spawnHeight uint64) error {
+	return k.appendConsumerIdUnderKey(ctx, consumerId, types.SpawnHeightToConsumerIdsKey(spawnHeight))
+}
+
+// RemoveConsumerToBeLaunchedAtHeight removes consumer id from if stored for this specific spawn height
+func (k Keeper) RemoveConsumerToBeLaunchedAtHeight(ctx sdk.Context, consumerId string, spawnHeight uint64) error {
+	err := k.removeConsumerIdUnderKey(ctx, consumerId, types.SpawnHeightToConsumerIdsKey(spawnHeight))
+	if err != nil {
+		return fmt.Errorf("height %d: %w", spawnHeight, err)
+	}
+	return nil
+}
+
+// DeleteAllConsumersToBeLaunchedAtHeight deletes all consumer to be launched at this specific spawn height
+func (k Keeper) DeleteAllConsumersToBeLaunchedAtHeight(ctx sdk.Context, spawnHeight uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.SpawnHeightToConsumerIdsKey(spawnHeight))
+}
+
 // GetConsumersToBeRemoved returns all the consumer ids of chains stored under this removal time
 func (k Keeper) GetConsumersToBeRemoved(ctx sdk.Context, removalTime time.Time) (types.ConsumerIds, error) {
 	return k.getConsumerIdsBasedOnTime(ctx, types.RemovalTimeToConsumerIdsKey, removalTime)
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v10/modules/core/23-commitment/types"
 	ibctmtypes "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
 	ibctesting "github.com/cosmos/ibc-go/v10/testing"
 	_go "github.com/cosmos/ics23/go"
@@ -20,6 +21,7 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/tmhash"
 	tmprotocrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
 
 	cryptotestutil "github.com/cosmos/interchain-security/v7/testutil/crypto"
@@ -62,6 +64,7 @@ func TestInitializeConsumer(t *testing.T) {
 	testCases := []struct {
 		name           string
 		spawnTime      time.Time
+		spawnHeight    uint64
 		setup          func(*providerkeeper.Keeper, sdk.Context, time.Time)
 		expInitialized bool
 	}{
@@ -79,6 +82,20 @@ func TestInitializeConsumer(t *testing.T) {
 			},
 			expInitialized: true,
 		},
+		{
+			name:        "valid: spawn height",
+			spawnHeight: 100,
+			setup: func(pk *providerkeeper.Keeper, ctx sdk.Context, spawnTime time.Time) {
+				pk.SetConsumerPhase(ctx, consumerId, providertypes.CONSUMER_PHASE_REGISTERED)
+				pk.SetConsumerChainId(ctx, consumerId, chainId)
+				err := pk.SetConsumerInitializationParameters(ctx, consumerId,
+					providertypes.ConsumerInitializationParameters{
+						SpawnHeight: 100,
+					})
+				require.NoError(t, err)
+			},
+			expInitialized: true,
+		},
 		{
 			name:      "invalid: no phase",
 			spawnTime: now,
@@ -130,10 +147,11 @@ func TestInitializeConsumer(t *testing.T) {
 
 		tc.setup(&pk, ctx, tc.spawnTime)
 
-		spawnTime, initialized := pk.InitializeConsumer(ctx, consumerId)
+		spawnTime, spawnHeight, initialized := pk.InitializeConsumer(ctx, consumerId)
 		require.Equal(t, tc.expInitialized, initialized, tc.name)
 		if initialized {
 			require.Equal(t, tc.spawnTime, spawnTime, tc.name)
+			require.Equal(t, tc.spawnHeight, spawnHeight, tc.name)
 			require.Equal(t, providertypes.CONSUMER_PHASE_INITIALIZED, pk.GetConsumerPhase(ctx, consumerId))
 		}
 	}
@@ -334,6 +352,162 @@ func TestBeginBlockLaunchConsumers(t *testing.T) {
 	require.Equal(t, providertypes.CONSUMER_PHASE_REGISTERED, phase)
 	_, found = providerKeeper.GetConsumerGenesis(ctx, "4")
 	require.False(t, found)
+	// the failed launch is recorded, but it cannot be retried
+	failure, found := providerKeeper.GetConsumerLaunchFailure(ctx, "4")
+	require.True(t, found)
+	require.False(t, failure.Retriable)
+	require.False(t, providerKeeper.IsConsumerLaunchRetriable(ctx, "4"))
+	_, found = providerKeeper.GetConsumerLaunchFailure(ctx, "0")
+	require.False(t, found)
+}
+
+// TestBeginBlockLaunchConsumersClientCreationFailure tests that a consumer chain whose client
+// cannot be created at spawn time remains initialized until its owner retries the launch
+func TestBeginBlockLaunchConsumersClientCreationFailure(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(now).WithBlockHeight(10)
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+
+	consumerId := providerKeeper.FetchAndIncrementConsumerId(ctx)
+	providerKeeper.SetConsumerChainId(ctx, consumerId, "chain0")
+	providerKeeper.SetConsumerOwnerAddress(ctx, consumerId, "owner")
+	initializationParameters := testkeeper.GetTestInitializationParameters(providertypes.InitParamsProfileMainnetConservative)
+	initializationParameters.SpawnTime = now.Add(-time.Hour)
+	err := providerKeeper.SetConsumerInitializationParameters(ctx, consumerId, initializationParameters)
+	require.NoError(t, err)
+	err = providerKeeper.SetConsumerPowerShapingParameters(ctx, consumerId, testkeeper.GetTestPowerShapingParameters())
+	require.NoError(t, err)
+	providerKeeper.SetConsumerPhase(ctx, consumerId, providertypes.CONSUMER_PHASE_INITIALIZED)
+	err = providerKeeper.AppendConsumerToBeLaunched(ctx, consumerId, initializationParameters.SpawnTime)
+	require.NoError(t, err)
+
+	validator := cryptotestutil.NewCryptoIdentityFromIntSeed(0).SDKStakingValidator()
+	consAddr, _ := validator.GetConsAddr()
+	testkeeper.SetupMocksForLastBondedValidatorsExpectation(mocks.MockStakingKeeper, 1, []stakingtypes.Validator{validator}, -1)
+	valAddr, _ := sdk.ValAddressFromBech32(validator.GetOperator())
+	mocks.MockStakingKeeper.EXPECT().GetLastValidatorPower(gomock.Any(), valAddr).Return(int64(1), nil).AnyTimes()
+	providerKeeper.SetOptedIn(ctx, consumerId, providertypes.NewProviderConsAddress(consAddr))
+
+	gomock.InOrder(append(
+		testkeeper.GetMocksForMakeConsumerGenesis(ctx, &mocks, time.Hour, 10),
+		mocks.MockClientKeeper.EXPECT().CreateClient(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return("", fmt.Errorf("invalid consensus state")).Times(1),
+	)...)
+
+	err = providerKeeper.BeginBlockLaunchConsumers(ctx)
+	require.NoError(t, err)
+
+	// the chain remains initialized, but it is not scheduled for launch
+	require.Equal(t, providertypes.CONSUMER_PHASE_INITIALIZED, providerKeeper.GetConsumerPhase(ctx, consumerId))
+	consumerIds, err := providerKeeper.GetConsumersToBeLaunched(ctx, initializationParameters.SpawnTime)
+	require.NoError(t, err)
+	require.Empty(t, consumerIds.Ids)
+	_, found := providerKeeper.GetConsumerGenesis(ctx, consumerId)
+	require.False(t, found)
+
+	failure, found := providerKeeper.GetConsumerLaunchFailure(ctx, consumerId)
+	require.True(t, found)
+	require.True(t, failure.Retriable)
+	require.Equal(t, int64(10), failure.Height)
+	require.Equal(t, now, failure.Time)
+	require.Contains(t, failure.Error, "invalid consensus state")
+
+	res, err := providerKeeper.QueryConsumerLaunchFailure(ctx, &providertypes.QueryConsumerLaunchFailureRequest{ConsumerId: consumerId})
+	require.NoError(t, err)
+	require.Equal(t, failure, res.Failure)
+
+	failed := false
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == providertypes.EventTypeConsumerLaunchFailed {
+			failed = true
+		}
+	}
+	require.True(t, failed)
+
+	// retrying the launch schedules the chain for launch
+	err = providerKeeper.RetryConsumerLaunch(ctx, consumerId)
+	require.NoError(t, err)
+	consumerIds, err = providerKeeper.GetConsumersToBeLaunched(ctx, initializationParameters.SpawnTime)
+	require.NoError(t, err)
+	require.Equal(t, []string{consumerId}, consumerIds.Ids)
+	_, found = providerKeeper.GetConsumerLaunchFailure(ctx, consumerId)
+	require.False(t, found)
+
+	// the launch cannot be retried without a new failure
+	err = providerKeeper.RetryConsumerLaunch(ctx, consumerId)
+	require.ErrorIs(t, err, providertypes.ErrConsumerLaunchNotRetriable)
+	_, err = providerKeeper.QueryConsumerLaunchFailure(ctx, &providertypes.QueryConsumerLaunchFailureRequest{ConsumerId: consumerId})
+	require.Error(t, err)
+}
+
+// TestBeginBlockLaunchConsumersCapped tests that a consumer chain is not launched
+// once the number of launched consumer chains reaches the MaxLaunchedConsumers param
+func TestBeginBlockLaunchConsumersCapped(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(now)
+
+	params := providertypes.DefaultParams()
+	params.MaxLaunchedConsumers = 2
+	providerKeeper.SetParams(ctx, params)
+
+	// two consumer chains are already launched
+	providerKeeper.FetchAndIncrementConsumerId(ctx)
+	providerKeeper.FetchAndIncrementConsumerId(ctx)
+	providerKeeper.SetConsumerPhase(ctx, "0", providertypes.CONSUMER_PHASE_LAUNCHED)
+	providerKeeper.SetConsumerPhase(ctx, "1", providertypes.CONSUMER_PHASE_LAUNCHED)
+
+	consumerId := providerKeeper.FetchAndIncrementConsumerId(ctx)
+	providerKeeper.SetConsumerChainId(ctx, consumerId, "chain2")
+	providerKeeper.SetConsumerOwnerAddress(ctx, consumerId, "owner")
+	initializationParameters := testkeeper.GetTestInitializationParameters(providertypes.InitParamsProfileMainnetConservative)
+	initializationParameters.SpawnTime = now.Add(-time.Hour)
+	err := providerKeeper.SetConsumerInitializationParameters(ctx, consumerId, initializationParameters)
+	require.NoError(t, err)
+	providerKeeper.SetConsumerPhase(ctx, consumerId, providertypes.CONSUMER_PHASE_INITIALIZED)
+	err = providerKeeper.AppendConsumerToBeLaunched(ctx, consumerId, initializationParameters.SpawnTime)
+	require.NoError(t, err)
+
+	validator := cryptotestutil.NewCryptoIdentityFromIntSeed(0).SDKStakingValidator()
+	testkeeper.SetupMocksForLastBondedValidatorsExpectation(mocks.MockStakingKeeper, 1, []stakingtypes.Validator{validator}, -1)
+
+	err = providerKeeper.BeginBlockLaunchConsumers(ctx)
+	require.NoError(t, err)
+
+	// the consumer chain was not launched and its spawn time was reset
+	require.Equal(t, providertypes.CONSUMER_PHASE_REGISTERED, providerKeeper.GetConsumerPhase(ctx, consumerId))
+	initializationParameters, err = providerKeeper.GetConsumerInitializationParameters(ctx, consumerId)
+	require.NoError(t, err)
+	require.True(t, initializationParameters.SpawnTime.IsZero())
+	require.Equal(t, uint64(2), providerKeeper.GetLaunchedConsumersCount(ctx))
+
+	blocked := false
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == providertypes.EventTypeConsumerLaunchBlocked {
+			blocked = true
+		}
+	}
+	require.True(t, blocked)
+
+	// the cap does not apply to the consumer chains owned by the governance module
+	capped, err := providerKeeper.IsConsumerLaunchCapped(ctx, consumerId, 2)
+	require.NoError(t, err)
+	require.True(t, capped)
+	providerKeeper.SetConsumerOwnerAddress(ctx, consumerId, providerKeeper.GetAuthority())
+	capped, err = providerKeeper.IsConsumerLaunchCapped(ctx, consumerId, 2)
+	require.NoError(t, err)
+	require.False(t, capped)
+
+	// zero means no cap
+	params.MaxLaunchedConsumers = 0
+	providerKeeper.SetParams(ctx, params)
+	providerKeeper.SetConsumerOwnerAddress(ctx, consumerId, "owner")
+	capped, err = providerKeeper.IsConsumerLaunchCapped(ctx, consumerId, 2)
+	require.NoError(t, err)
+	require.False(t, capped)
 }
 
 func TestConsumeIdsFromTimeQueue(t *testing.T) {
@@ -581,7 +755,7 @@ func TestCreateConsumerClient(t *testing.T) {
 
 		// Call method with same arbitrary values as defined above in mock expectations.
 		providerKeeper.SetConsumerChainId(ctx, CONSUMER_ID, CONSUMER_CHAIN_ID)
-		err := providerKeeper.SetConsumerInitializationParameters(ctx, CONSUMER_ID, testkeeper.GetTestInitializationParameters())
+		err := providerKeeper.SetConsumerInitializationParameters(ctx, CONSUMER_ID, testkeeper.GetTestInitializationParameters(providertypes.InitParamsProfileMainnetConservative))
 		require.NoError(t, err)
 
 		err = providerKeeper.CreateConsumerClient(ctx, CONSUMER_ID, []byte{})
@@ -599,6 +773,195 @@ func TestCreateConsumerClient(t *testing.T) {
 	}
 }
 
+// TestCreateConsumerClientWithTrustLevel tests that the trust level from the initialization
+// parameters is used when creating the consumer client
+func TestCreateConsumerClientWithTrustLevel(t *testing.T) {
+	testCases := []struct {
+		name                string
+		trustLevel          *ibctmtypes.Fraction
+		expClientCreated    bool
+		expClientTrustLevel ibctmtypes.Fraction
+	}{
+		{
+			name:                "trust level not set, template trust level is used",
+			trustLevel:          nil,
+			expClientCreated:    true,
+			expClientTrustLevel: ibctmtypes.DefaultTrustLevel,
+		},
+		{
+			name:                "trust level stricter than the template trust level",
+			trustLevel:          &ibctmtypes.Fraction{Numerator: 2, Denominator: 3},
+			expClientCreated:    true,
+			expClientTrustLevel: ibctmtypes.Fraction{Numerator: 2, Denominator: 3},
+		},
+		{
+			name:             "trust level lower than the template trust level",
+			trustLevel:       &ibctmtypes.Fraction{Numerator: 1, Denominator: 3},
+			expClientCreated: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			keeperParams := testkeeper.NewInMemKeeperParams(t)
+			providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, keeperParams)
+			defer ctrl.Finish()
+
+			params := providertypes.DefaultParams()
+			if !tc.expClientCreated {
+				// raise the trust level of the template client above the requested one
+				params.TemplateClient.TrustLevel = ibctmtypes.Fraction{Numerator: 1, Denominator: 2}
+			}
+			providerKeeper.SetParams(ctx, params)
+
+			initializationParameters := testkeeper.GetTestInitializationParameters(providertypes.InitParamsProfileMainnetConservative)
+			initializationParameters.TrustLevel = tc.trustLevel
+
+			providerKeeper.SetConsumerPhase(ctx, CONSUMER_ID, providertypes.CONSUMER_PHASE_INITIALIZED)
+			providerKeeper.SetConsumerChainId(ctx, CONSUMER_ID, CONSUMER_CHAIN_ID)
+			err := providerKeeper.SetConsumerInitializationParameters(ctx, CONSUMER_ID, initializationParameters)
+			require.NoError(t, err)
+
+			if tc.expClientCreated {
+				mocks.MockClientKeeper.EXPECT().CreateClient(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ sdk.Context, _ string, clientStateBytes, _ []byte) (string, error) {
+						var clientState ibctmtypes.ClientState
+						require.NoError(t, clientState.Unmarshal(clientStateBytes))
+						require.Equal(t, tc.expClientTrustLevel, clientState.TrustLevel)
+						return "clientID", nil
+					}).Times(1)
+			} else {
+				mocks.MockClientKeeper.EXPECT().CreateClient(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			}
+
+			err = providerKeeper.CreateConsumerClient(ctx, CONSUMER_ID, []byte{})
+			if !tc.expClientCreated {
+				require.ErrorIs(t, err, providertypes.ErrInvalidConsumerInitializationParameters)
+				return
+			}
+			require.NoError(t, err)
+
+			// the trust level of the created client is part of the emitted event
+			found := false
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type != providertypes.EventTypeConsumerClientCreated {
+					continue
+				}
+				attr, ok := event.GetAttribute(providertypes.AttributeTrustLevel)
+				require.True(t, ok)
+				require.Equal(t, tc.expClientTrustLevel.ToTendermint().String(), attr.Value)
+				found = true
+			}
+			require.True(t, found)
+		})
+	}
+}
+
+func TestCreateConsumerClientWithInitialConsensusState(t *testing.T) {
+	blockTime := time.Now().UTC()
+	valsetHash := tmhash.Sum([]byte("valset"))
+	validInitialConsensusState := providertypes.ConsumerInitialConsensusState{
+		GenesisHash: []byte("gen_hash"),
+		ConsensusState: *ibctmtypes.NewConsensusState(
+			blockTime.Add(-time.Hour),
+			commitmenttypes.NewMerkleRoot([]byte("app_hash")),
+			valsetHash,
+		),
+	}
+
+	testCases := []struct {
+		name                  string
+		setup                 func(*providertypes.ConsumerInitialConsensusState)
+		hasConsensusState     bool
+		expClientCreated      bool
+		expConsensusTimestamp time.Time
+		expConsensusRoot      []byte
+	}{
+		{
+			name:                  "no initial consensus state, the consensus state is derived",
+			setup:                 func(*providertypes.ConsumerInitialConsensusState) {},
+			hasConsensusState:     false,
+			expClientCreated:      true,
+			expConsensusTimestamp: blockTime,
+			expConsensusRoot:      []byte(ibctmtypes.SentinelRoot),
+		},
+		{
+			name:                  "initial consensus state is used",
+			setup:                 func(*providertypes.ConsumerInitialConsensusState) {},
+			hasConsensusState:     true,
+			expClientCreated:      true,
+			expConsensusTimestamp: blockTime.Add(-time.Hour),
+			expConsensusRoot:      []byte("app_hash"),
+		},
+		{
+			name: "genesis hash does not match the initialization parameters",
+			setup: func(cs *providertypes.ConsumerInitialConsensusState) {
+				cs.GenesisHash = []byte("other_gen_hash")
+			},
+			hasConsensusState: true,
+			expClientCreated:  false,
+		},
+		{
+			name: "next validators hash does not match the initial validator set",
+			setup: func(cs *providertypes.ConsumerInitialConsensusState) {
+				cs.ConsensusState.NextValidatorsHash = tmhash.Sum([]byte("other_valset"))
+			},
+			hasConsensusState: true,
+			expClientCreated:  false,
+		},
+		{
+			name: "timestamp after the block time",
+			setup: func(cs *providertypes.ConsumerInitialConsensusState) {
+				cs.ConsensusState.Timestamp = blockTime.Add(time.Hour)
+			},
+			hasConsensusState: true,
+			expClientCreated:  false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			keeperParams := testkeeper.NewInMemKeeperParams(t)
+			providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, keeperParams)
+			defer ctrl.Finish()
+			ctx = ctx.WithBlockTime(blockTime)
+
+			providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+			providerKeeper.SetConsumerPhase(ctx, CONSUMER_ID, providertypes.CONSUMER_PHASE_INITIALIZED)
+			providerKeeper.SetConsumerChainId(ctx, CONSUMER_ID, CONSUMER_CHAIN_ID)
+			err := providerKeeper.SetConsumerInitializationParameters(ctx, CONSUMER_ID, testkeeper.GetTestInitializationParameters(providertypes.InitParamsProfileMainnetConservative))
+			require.NoError(t, err)
+
+			if tc.hasConsensusState {
+				initialConsensusState := validInitialConsensusState
+				tc.setup(&initialConsensusState)
+				providerKeeper.SetConsumerInitialConsensusState(ctx, CONSUMER_ID, initialConsensusState)
+			}
+
+			if tc.expClientCreated {
+				mocks.MockClientKeeper.EXPECT().CreateClient(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ sdk.Context, _ string, _, consensusStateBytes []byte) (string, error) {
+						var consensusState ibctmtypes.ConsensusState
+						require.NoError(t, consensusState.Unmarshal(consensusStateBytes))
+						require.Equal(t, tc.expConsensusTimestamp, consensusState.Timestamp)
+						require.Equal(t, tc.expConsensusRoot, consensusState.Root.GetHash())
+						require.Equal(t, valsetHash, consensusState.NextValidatorsHash.Bytes())
+						return "clientID", nil
+					}).Times(1)
+			} else {
+				mocks.MockClientKeeper.EXPECT().CreateClient(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			}
+
+			err = providerKeeper.CreateConsumerClient(ctx, CONSUMER_ID, valsetHash)
+			if !tc.expClientCreated {
+				require.ErrorIs(t, err, providertypes.ErrInvalidConsumerInitialConsensusState)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
 // TestMakeConsumerGenesis tests the MakeConsumerGenesis keeper method.
 // An expected genesis state is hardcoded in json, unmarshaled, and compared
 // against an actual consumer genesis state constructed by a provider keeper.
@@ -724,7 +1087,9 @@ func TestMakeConsumerGenesis(t *testing.T) {
 			"reward_denoms": [],
 			"provider_reward_denoms": [],
 			"retry_delay_period": %d,
-			"consumer_id": "%s"
+			"consumer_id": "%s",
+			"retry_delay_multiplier": "1",
+			"client_expiry_warning_threshold": %d
 		},
 		"new_chain": true,
 		"provider" : {
@@ -796,6 +1161,7 @@ func TestMakeConsumerGenesis(t *testing.T) {
 		consumerUnbondingPeriod.Nanoseconds(),
 		ccvtypes.DefaultRetryDelayPeriod.Nanoseconds(),
 		CONSUMER_ID,
+		ccvtypes.DefaultClientExpiryWarningThreshold.Nanoseconds(),
 		providerChainId,
 		trustingPeriod.Nanoseconds(),
 		providerUnbondingPeriod.Nanoseconds(),
@@ -862,7 +1228,7 @@ func TestBeginBlockStopConsumers(t *testing.T) {
 	//
 	for i, consumerId := range consumerIds {
 		// Setup a valid consumer chain for each consumerId
-		initializationRecord := testkeeper.GetTestInitializationParameters()
+		initializationRecord := testkeeper.GetTestInitializationParameters(providertypes.InitParamsProfileMainnetConservative)
 		initializationRecord.InitialHeight = clienttypes.NewHeight(0, 3)
 		registrationRecord := testkeeper.GetTestConsumerMetadata()
 
@@ -964,6 +1330,81 @@ func TestStopConsumerChain(t *testing.T) {
 	}
 }
 
+// TestLaunchConsumerAtomicity tests that a consumer launch failing at any step leaves no partial state
+func TestLaunchConsumerAtomicity(t *testing.T) {
+	for _, failingStep := range []string{"genesis", "client"} {
+		t.Run(failingStep, func(t *testing.T) {
+			providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+			defer ctrl.Finish()
+			ctx = ctx.WithBlockHeight(10)
+			providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+
+			consumerId := providerKeeper.FetchAndIncrementConsumerId(ctx)
+			providerKeeper.SetConsumerChainId(ctx, consumerId, "chain0")
+			err := providerKeeper.SetConsumerInitializationParameters(ctx, consumerId,
+				testkeeper.GetTestInitializationParameters(providertypes.InitParamsProfileMainnetConservative))
+			require.NoError(t, err)
+			err = providerKeeper.SetConsumerPowerShapingParameters(ctx, consumerId, testkeeper.GetTestPowerShapingParameters())
+			require.NoError(t, err)
+			providerKeeper.SetConsumerPhase(ctx, consumerId, providertypes.CONSUMER_PHASE_INITIALIZED)
+
+			validator := cryptotestutil.NewCryptoIdentityFromIntSeed(0).SDKStakingValidator()
+			consAddr, _ := validator.GetConsAddr()
+			valAddr, _ := sdk.ValAddressFromBech32(validator.GetOperator())
+			mocks.MockStakingKeeper.EXPECT().GetLastValidatorPower(gomock.Any(), valAddr).Return(int64(1), nil).AnyTimes()
+			providerKeeper.SetOptedIn(ctx, consumerId, providertypes.NewProviderConsAddress(consAddr))
+
+			if failingStep == "genesis" {
+				mocks.MockStakingKeeper.EXPECT().UnbondingTime(gomock.Any()).Return(time.Duration(0), fmt.Errorf("unbonding time failure"))
+			} else {
+				gomock.InOrder(append(
+					testkeeper.GetMocksForMakeConsumerGenesis(ctx, &mocks, time.Hour, 10),
+					mocks.MockClientKeeper.EXPECT().CreateClient(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+						Return("", fmt.Errorf("invalid consensus state")),
+				)...)
+			}
+
+			validators := []stakingtypes.Validator{validator}
+			err = providerKeeper.LaunchConsumer(ctx, validators, validators, consumerId)
+			require.Error(t, err)
+
+			// neither the initial validator set nor the genesis state are stored
+			require.Equal(t, providertypes.CONSUMER_PHASE_INITIALIZED, providerKeeper.GetConsumerPhase(ctx, consumerId))
+			valSet, err := providerKeeper.GetConsumerValSet(ctx, consumerId)
+			require.NoError(t, err)
+			require.Empty(t, valSet)
+			_, found := providerKeeper.GetConsumerGenesis(ctx, consumerId)
+			require.False(t, found)
+			_, found = providerKeeper.GetConsumerClientId(ctx, consumerId)
+			require.False(t, found)
+		})
+	}
+}
+
+// TestStopConsumerAtomicity tests that a consumer chain that cannot be scheduled for removal is not stopped
+func TestStopConsumerAtomicity(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	mocks.MockStakingKeeper.EXPECT().UnbondingTime(gomock.Any()).Return(time.Duration(0), fmt.Errorf("unbonding time failure")).AnyTimes()
+
+	providerKeeper.SetConsumerPhase(ctx, "0", providertypes.CONSUMER_PHASE_LAUNCHED)
+	require.Error(t, providerKeeper.StopAndPrepareForConsumerRemoval(ctx, "0"))
+	require.Equal(t, providertypes.CONSUMER_PHASE_LAUNCHED, providerKeeper.GetConsumerPhase(ctx, "0"))
+
+	// an initialized chain that cannot be stopped remains in the launch queue
+	initializationParameters := testkeeper.GetTestInitializationParameters(providertypes.InitParamsProfileMainnetConservative)
+	providerKeeper.SetConsumerChainId(ctx, "1", "chain1")
+	providerKeeper.SetConsumerPhase(ctx, "1", providertypes.CONSUMER_PHASE_INITIALIZED)
+	require.NoError(t, providerKeeper.SetConsumerInitializationParameters(ctx, "1", initializationParameters))
+	require.NoError(t, providerKeeper.AppendConsumerToBeLaunched(ctx, "1", initializationParameters.SpawnTime))
+
+	require.Error(t, providerKeeper.ForceStopConsumer(ctx, "1", false))
+	require.Equal(t, providertypes.CONSUMER_PHASE_INITIALIZED, providerKeeper.GetConsumerPhase(ctx, "1"))
+	consumerIds, err := providerKeeper.GetConsumersToBeLaunched(ctx, initializationParameters.SpawnTime)
+	require.NoError(t, err)
+	require.Equal(t, []string{"1"}, consumerIds.Ids)
+}
+
 //
 // Setters and Getters
 //
@@ -1053,6 +1494,80 @@ func TestConsumersToBeLaunched(t *testing.T) {
 	require.Equal(t, []string{"consumerId5"}, consumers.Ids)
 }
 
+// TestConsumersToBeLaunchedAtHeight tests `AppendConsumerToBeLaunchedAtHeight`, `GetConsumersToBeLaunchedAtHeight`,
+// and `RemoveConsumerToBeLaunchedAtHeight`
+func TestConsumersToBeLaunchedAtHeight(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	err := providerKeeper.AppendConsumerToBeLaunchedAtHeight(ctx, "consumerId1", 100)
+	require.NoError(t, err)
+	err = providerKeeper.AppendConsumerToBeLaunchedAtHeight(ctx, "consumerId2", 100)
+	require.NoError(t, err)
+	err = providerKeeper.AppendConsumerToBeLaunchedAtHeight(ctx, "consumerId3", 101)
+	require.NoError(t, err)
+	consumers, err := providerKeeper.GetConsumersToBeLaunchedAtHeight(ctx, 100)
+	require.NoError(t, err)
+	require.Equal(t, []string{"consumerId1", "consumerId2"}, consumers.Ids)
+
+	err = providerKeeper.RemoveConsumerToBeLaunchedAtHeight(ctx, "consumerId1", 100)
+	require.NoError(t, err)
+	consumers, err = providerKeeper.GetConsumersToBeLaunchedAtHeight(ctx, 100)
+	require.NoError(t, err)
+	require.Equal(t, []string{"consumerId2"}, consumers.Ids)
+
+	// cannot remove a consumer that is not stored under this spawn height
+	err = providerKeeper.RemoveConsumerToBeLaunchedAtHeight(ctx, "consumerId3", 100)
+	require.Error(t, err)
+
+	// moving the launch from a spawn height to another spawn height
+	err = providerKeeper.PrepareConsumerForLaunchAtHeight(ctx, "consumerId3", 101, 102)
+	require.NoError(t, err)
+	consumers, err = providerKeeper.GetConsumersToBeLaunchedAtHeight(ctx, 101)
+	require.NoError(t, err)
+	require.Empty(t, consumers.Ids)
+	consumers, err = providerKeeper.GetConsumersToBeLaunchedAtHeight(ctx, 102)
+	require.NoError(t, err)
+	require.Equal(t, []string{"consumerId3"}, consumers.Ids)
+
+	// moving the launch from a spawn height to a spawn time
+	err = providerKeeper.PrepareConsumerForLaunchAtHeight(ctx, "consumerId3", 102, 0)
+	require.NoError(t, err)
+	consumers, err = providerKeeper.GetConsumersToBeLaunchedAtHeight(ctx, 102)
+	require.NoError(t, err)
+	require.Empty(t, consumers.Ids)
+}
+
+// TestConsumeIdsFromSpawnHeightQueue tests that only the consumers with a reached spawn height are returned
+// and that the number of returned consumers is limited
+func TestConsumeIdsFromSpawnHeightQueue(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	ctx = ctx.WithBlockHeight(10)
+
+	require.NoError(t, providerKeeper.AppendConsumerToBeLaunchedAtHeight(ctx, "0", 5))
+	require.NoError(t, providerKeeper.AppendConsumerToBeLaunchedAtHeight(ctx, "1", 10))
+	require.NoError(t, providerKeeper.AppendConsumerToBeLaunchedAtHeight(ctx, "2", 10))
+	require.NoError(t, providerKeeper.AppendConsumerToBeLaunchedAtHeight(ctx, "3", 11))
+
+	consumerIds, err := providerKeeper.ConsumeIdsFromSpawnHeightQueue(ctx, 2)
+	require.NoError(t, err)
+	require.Equal(t, []string{"0", "1"}, consumerIds)
+
+	consumerIds, err = providerKeeper.ConsumeIdsFromSpawnHeightQueue(ctx, 2)
+	require.NoError(t, err)
+	require.Equal(t, []string{"2"}, consumerIds)
+
+	consumerIds, err = providerKeeper.ConsumeIdsFromSpawnHeightQueue(ctx, 2)
+	require.NoError(t, err)
+	require.Empty(t, consumerIds)
+
+	consumerIds, err = providerKeeper.ConsumeIdsFromSpawnHeightQueue(ctx.WithBlockHeight(11), 2)
+	require.NoError(t, err)
+	require.Equal(t, []string{"3"}, consumerIds)
+}
+
 // TestConsumersToBeRemoved tests `AppendConsumerToBeRemoved`, `GetConsumersToBeRemoved`, and `RemoveConsumerToBeRemoved`
 func TestConsumersToBeRemoved(t *testing.T) {
 	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
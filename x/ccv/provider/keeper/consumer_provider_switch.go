@@ -0,0 +1,169 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+)
+
+// A launched consumer chain switches to a different provider chain through its own governance
+// (see MsgScheduleProviderSwitch of the consumer module). The owner of the consumer chain registers
+// the switch on the provider chain, so that the provider chain stops the consumer chain once the
+// consumer client reaches the switch height, instead of stopping it as a failed consumer chain
+// once the consumer chain rejects its VSC packets with error acknowledgements.
+
+// GetConsumerProviderSwitch returns the provider switch registered for the consumer chain with `consumerId`
+func (k Keeper) GetConsumerProviderSwitch(ctx sdk.Context, consumerId string) (types.ConsumerProviderSwitch, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ConsumerIdToProviderSwitchKey(consumerId))
+	if bz == nil {
+		return types.ConsumerProviderSwitch{}, false
+	}
+	var providerSwitch types.ConsumerProviderSwitch
+	if err := providerSwitch.Unmarshal(bz); err != nil {
+		// An error here would indicate something is very wrong,
+		// the provider switch is assumed to be correctly serialized in SetConsumerProviderSwitch.
+		panic(fmt.Errorf("failed to unmarshal provider switch for consumer id (%s): %w", consumerId, err))
+	}
+	return providerSwitch, true
+}
+
+// SetConsumerProviderSwitch sets the provider switch registered for the consumer chain with `consumerId`
+func (k Keeper) SetConsumerProviderSwitch(ctx sdk.Context, consumerId string, providerSwitch types.ConsumerProviderSwitch) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := providerSwitch.Marshal()
+	if err != nil {
+		// An error here would indicate something is very wrong,
+		// the provider switch is obtained from a validated message.
+		panic(fmt.Errorf("failed to marshal provider switch for consumer id (%s): %w", consumerId, err))
+	}
+	store.Set(types.ConsumerIdToProviderSwitchKey(consumerId), bz)
+}
+
+// DeleteConsumerProviderSwitch deletes the provider switch registered for the consumer chain with `consumerId`
+func (k Keeper) DeleteConsumerProviderSwitch(ctx sdk.Context, consumerId string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ConsumerIdToProviderSwitchKey(consumerId))
+}
+
+// GetAllConsumersWithProviderSwitch returns the consumer ids of all the consumer chains with a registered provider switch
+func (k Keeper) GetAllConsumersWithProviderSwitch(ctx sdk.Context) []string {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.ConsumerIdToProviderSwitchKeyPrefix()
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{prefix})
+	defer iterator.Close()
+
+	consumerIds := []string{}
+	for ; iterator.Valid(); iterator.Next() {
+		consumerId, err := types.ParseStringIdWithLenKey(prefix, iterator.Key())
+		if err != nil {
+			// An error here would indicate something is very wrong,
+			// the key is assumed to be correctly serialized in SetConsumerProviderSwitch.
+			panic(fmt.Errorf("failed to parse provider switch key: %w", err))
+		}
+		consumerIds = append(consumerIds, consumerId)
+	}
+	return consumerIds
+}
+
+// RegisterConsumerProviderSwitch registers the switch of the launched consumer chain with `consumerId`
+// to a different provider chain. The switch height must be in the current revision of the consumer chain
+// and after the latest height of the consumer client. Registering a switch replaces the registered one, if any.
+func (k Keeper) RegisterConsumerProviderSwitch(ctx sdk.Context, consumerId string, providerSwitch types.ConsumerProviderSwitch) error {
+	if !k.IsConsumerLaunched(ctx, consumerId) {
+		return errorsmod.Wrapf(types.ErrInvalidPhase,
+			"cannot register a provider switch for a consumer chain that is not launched: %s", k.GetConsumerPhase(ctx, consumerId))
+	}
+
+	_, tmClient, err := k.getConsumerTendermintClient(ctx, consumerId)
+	if err != nil {
+		return err
+	}
+	if providerSwitch.SwitchHeight.RevisionNumber != tmClient.LatestHeight.RevisionNumber {
+		return errorsmod.Wrapf(types.ErrInvalidConsumerProviderSwitch,
+			"switch height (%s) is not in the current revision of the consumer chain (%d)",
+			providerSwitch.SwitchHeight, tmClient.LatestHeight.RevisionNumber)
+	}
+	if !providerSwitch.SwitchHeight.GT(tmClient.LatestHeight) {
+		return errorsmod.Wrapf(types.ErrInvalidConsumerProviderSwitch,
+			"switch height (%s) is not after the latest height of the consumer client (%s)",
+			providerSwitch.SwitchHeight, tmClient.LatestHeight)
+	}
+
+	k.SetConsumerProviderSwitch(ctx, consumerId, providerSwitch)
+
+	return nil
+}
+
+// BeginBlockConsumerProviderSwitches stops the launched consumer chains whose clients
+// reached the switch heights of their registered provider switches
+func (k Keeper) BeginBlockConsumerProviderSwitches(ctx sdk.Context) {
+	for _, consumerId := range k.GetAllConsumersWithProviderSwitch(ctx) {
+		if !k.IsConsumerLaunched(ctx, consumerId) {
+			// the consumer chain already switched or was stopped otherwise
+			continue
+		}
+		providerSwitch, _ := k.GetConsumerProviderSwitch(ctx, consumerId)
+		_, tmClient, err := k.getConsumerTendermintClient(ctx, consumerId)
+		if err != nil {
+			k.Logger(ctx).Error("cannot verify provider switch",
+				"consumerId", consumerId,
+				"error", err.Error(),
+			)
+			continue
+		}
+		if tmClient.LatestHeight.LT(providerSwitch.SwitchHeight) {
+			// the consumer client did not reach the switch height yet
+			continue
+		}
+		if err := k.CompleteConsumerProviderSwitch(ctx, consumerId); err != nil {
+			k.Logger(ctx).Error("cannot complete provider switch",
+				"consumerId", consumerId,
+				"error", err.Error(),
+			)
+		}
+	}
+}
+
+// CompleteConsumerProviderSwitch stops the launched consumer chain with `consumerId` that switched
+// to the new provider chain of its registered provider switch. The consumer chain is stopped as if
+// its owner removed it, i.e., its state is removed once the unbonding period elapses, so that the
+// validators remain slashable for the infractions committed before the switch. The registered
+// switch is kept until the consumer chain is removed.
+func (k Keeper) CompleteConsumerProviderSwitch(ctx sdk.Context, consumerId string) error {
+	providerSwitch, found := k.GetConsumerProviderSwitch(ctx, consumerId)
+	if !found {
+		return errorsmod.Wrapf(types.ErrInvalidConsumerProviderSwitch, "no provider switch registered for consumer id %s", consumerId)
+	}
+	if !k.IsConsumerLaunched(ctx, consumerId) {
+		return errorsmod.Wrapf(types.ErrInvalidPhase,
+			"cannot complete the provider switch of a consumer chain that is not launched: %s", k.GetConsumerPhase(ctx, consumerId))
+	}
+
+	if err := k.StopAndPrepareForConsumerRemoval(ctx, consumerId); err != nil {
+		return err
+	}
+
+	k.Logger(ctx).Info("consumer chain switched provider chains",
+		"consumerId", consumerId,
+		"switchHeight", providerSwitch.SwitchHeight.String(),
+		"newProviderChainId", providerSwitch.NewProviderChainId,
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeConsumerProviderSwitched,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeConsumerId, consumerId),
+			sdk.NewAttribute(types.AttributeSwitchHeight, providerSwitch.SwitchHeight.String()),
+			sdk.NewAttribute(types.AttributeNewProviderChainId, providerSwitch.NewProviderChainId),
+		),
+	)
+
+	return nil
+}
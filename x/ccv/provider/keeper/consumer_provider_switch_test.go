@@ -0,0 +1,126 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v10/modules/core/23-commitment/types"
+	"github.com/cosmos/ibc-go/v10/modules/core/exported"
+	ibctmtypes "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
+	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+)
+
+// TestConsumerProviderSwitch tests that a consumer chain with a registered provider switch is stopped
+// once its client reaches the switch height
+func TestConsumerProviderSwitch(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	unbondingTime := time.Hour
+	mocks.MockStakingKeeper.EXPECT().UnbondingTime(gomock.Any()).Return(unbondingTime, nil).AnyTimes()
+
+	consumerId := "0"
+	providerKeeper.SetConsumerChainId(ctx, consumerId, "chain-1")
+	providerKeeper.SetConsumerClientId(ctx, consumerId, "clientId")
+
+	clientState := ibctmtypes.NewClientState("chain-1", ibctmtypes.DefaultTrustLevel, 40*time.Minute, time.Hour,
+		10*time.Second, clienttypes.NewHeight(1, 100), commitmenttypes.GetSDKSpecs(), []string{"upgrade", "upgradedIBCState"})
+	mocks.MockClientKeeper.EXPECT().GetClientState(gomock.Any(), "clientId").DoAndReturn(
+		func(sdk.Context, string) (exported.ClientState, bool) { return clientState, true },
+	).AnyTimes()
+
+	providerSwitch := providertypes.ConsumerProviderSwitch{
+		SwitchHeight:       clienttypes.NewHeight(1, 200),
+		NewProviderChainId: "provider-2",
+	}
+
+	// the consumer chain must be launched
+	providerKeeper.SetConsumerPhase(ctx, consumerId, providertypes.CONSUMER_PHASE_INITIALIZED)
+	err := providerKeeper.RegisterConsumerProviderSwitch(ctx, consumerId, providerSwitch)
+	require.ErrorIs(t, err, providertypes.ErrInvalidPhase)
+	providerKeeper.SetConsumerPhase(ctx, consumerId, providertypes.CONSUMER_PHASE_LAUNCHED)
+
+	// the switch height must be after the latest height of the consumer client
+	invalidSwitch := providerSwitch
+	invalidSwitch.SwitchHeight = clienttypes.NewHeight(1, 100)
+	err = providerKeeper.RegisterConsumerProviderSwitch(ctx, consumerId, invalidSwitch)
+	require.ErrorIs(t, err, providertypes.ErrInvalidConsumerProviderSwitch)
+
+	// the switch height must be in the current revision of the consumer chain
+	invalidSwitch.SwitchHeight = clienttypes.NewHeight(2, 200)
+	err = providerKeeper.RegisterConsumerProviderSwitch(ctx, consumerId, invalidSwitch)
+	require.ErrorIs(t, err, providertypes.ErrInvalidConsumerProviderSwitch)
+	require.Empty(t, providerKeeper.GetAllConsumersWithProviderSwitch(ctx))
+
+	require.NoError(t, providerKeeper.RegisterConsumerProviderSwitch(ctx, consumerId, providerSwitch))
+	registeredSwitch, found := providerKeeper.GetConsumerProviderSwitch(ctx, consumerId)
+	require.True(t, found)
+	require.Equal(t, providerSwitch, registeredSwitch)
+
+	// the consumer client did not reach the switch height yet
+	clientState.LatestHeight = clienttypes.NewHeight(1, 199)
+	providerKeeper.BeginBlockConsumerProviderSwitches(ctx)
+	require.Equal(t, providertypes.CONSUMER_PHASE_LAUNCHED, providerKeeper.GetConsumerPhase(ctx, consumerId))
+
+	// the consumer chain is stopped once the consumer client reaches the switch height
+	clientState.LatestHeight = clienttypes.NewHeight(1, 200)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	providerKeeper.BeginBlockConsumerProviderSwitches(ctx)
+	require.Equal(t, providertypes.CONSUMER_PHASE_STOPPED, providerKeeper.GetConsumerPhase(ctx, consumerId))
+	removalTime, err := providerKeeper.GetConsumerRemovalTime(ctx, consumerId)
+	require.NoError(t, err)
+	require.Equal(t, ctx.BlockTime().Add(unbondingTime), removalTime)
+	require.Len(t, ctx.EventManager().Events(), 1)
+	require.Equal(t, providertypes.EventTypeConsumerProviderSwitched, ctx.EventManager().Events()[0].Type)
+
+	// the registered switch is kept until the consumer chain is removed
+	resp, err := providerKeeper.QueryConsumerProviderSwitches(ctx, &providertypes.QueryConsumerProviderSwitchesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []providertypes.RegisteredProviderSwitch{
+		{ConsumerId: consumerId, ChainId: "chain-1", Phase: providertypes.CONSUMER_PHASE_STOPPED, Switch: providerSwitch},
+	}, resp.Switches)
+
+	// a stopped consumer chain is not stopped again
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	providerKeeper.BeginBlockConsumerProviderSwitches(ctx)
+	require.Empty(t, ctx.EventManager().Events())
+}
+
+// TestConsumerProviderSwitchOnErrorAck tests that the error acknowledgements of a consumer chain
+// with a registered provider switch complete the switch instead of failing the consumer chain
+func TestConsumerProviderSwitchOnErrorAck(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	unbondingTime := time.Hour
+	mocks.MockStakingKeeper.EXPECT().UnbondingTime(gomock.Any()).Return(unbondingTime, nil).Times(1)
+
+	consumerId := "0"
+	providerKeeper.SetConsumerChainId(ctx, consumerId, "chain-1")
+	providerKeeper.SetConsumerPhase(ctx, consumerId, providertypes.CONSUMER_PHASE_LAUNCHED)
+	providerKeeper.SetChannelToConsumerId(ctx, "channelId", consumerId)
+	providerKeeper.SetConsumerProviderSwitch(ctx, consumerId, providertypes.ConsumerProviderSwitch{
+		SwitchHeight:       clienttypes.NewHeight(1, 200),
+		NewProviderChainId: "provider-2",
+	})
+
+	packet := channeltypes.Packet{SourceChannel: "channelId"}
+	ackError := channeltypes.NewErrorAcknowledgement(providertypes.ErrInvalidConsumerProviderSwitch)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(t, providerKeeper.OnAcknowledgementPacket(ctx, packet, ackError))
+	require.Equal(t, providertypes.CONSUMER_PHASE_STOPPED, providerKeeper.GetConsumerPhase(ctx, consumerId))
+	require.Zero(t, providerKeeper.GetConsumerPacketStats(ctx, consumerId).ErrorAcksReceived)
+	require.Equal(t, providertypes.EventTypeConsumerProviderSwitched, ctx.EventManager().Events()[0].Type)
+
+	// the error acknowledgements received after the switch are ignored
+	require.NoError(t, providerKeeper.OnAcknowledgementPacket(ctx, packet, ackError))
+	require.Zero(t, providerKeeper.GetConsumerPacketStats(ctx, consumerId).ErrorAcksReceived)
+}
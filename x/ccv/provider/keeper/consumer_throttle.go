@@ -0,0 +1,236 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+	ccvtypes "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// A consumer chain with a slash meter replenish fraction in its throttling parameters has a slash meter of its own,
+// which throttles its slash packets instead of the global slash meter. The slash meter of a consumer chain follows
+// the same rules as the global slash meter (see CheckForSlashMeterReplenishment), with the allowance and the replenish
+// period given by the throttling parameters of the consumer chain. Thus, a consumer chain flooding the provider
+// with slash packets cannot starve the slash packets of the consumer chains throttled by other slash meters.
+
+// GetConsumerThrottlingParameters returns the throttling parameters associated with this consumer id
+func (k Keeper) GetConsumerThrottlingParameters(ctx sdk.Context, consumerId string) (types.ThrottlingParameters, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ConsumerIdToThrottlingParametersKey(consumerId))
+	if bz == nil {
+		return types.ThrottlingParameters{}, errorsmod.Wrapf(ccvtypes.ErrStoreKeyNotFound,
+			"GetConsumerThrottlingParameters, consumerId(%s)", consumerId)
+	}
+	var parameters types.ThrottlingParameters
+	if err := parameters.Unmarshal(bz); err != nil {
+		return types.ThrottlingParameters{}, errorsmod.Wrapf(ccvtypes.ErrStoreUnmarshal,
+			"GetConsumerThrottlingParameters, consumerId(%s): %s", consumerId, err.Error())
+	}
+	return parameters, nil
+}
+
+// SetConsumerThrottlingParameters sets the throttling parameters associated with this consumer id.
+// Note that it also initializes the slash meter of the consumer chain if the parameters give the chain
+// a slash meter of its own, or deletes it if the chain falls back to the global slash meter.
+func (k Keeper) SetConsumerThrottlingParameters(ctx sdk.Context, consumerId string, parameters types.ThrottlingParameters) error {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := parameters.Marshal()
+	if err != nil {
+		return fmt.Errorf("failed to marshal throttling parameters (%+v) for consumer id (%s): %w", parameters, consumerId, err)
+	}
+	store.Set(types.ConsumerIdToThrottlingParametersKey(consumerId), bz)
+
+	switch {
+	case parameters.SlashMeterReplenishFraction == "":
+		k.DeleteConsumerSlashMeter(ctx, consumerId)
+	case !k.HasConsumerSlashMeter(ctx, consumerId):
+		k.InitializeConsumerSlashMeter(ctx, consumerId)
+	}
+	// otherwise, the slash meter keeps its value, which is capped
+	// by the updated allowance in CheckForConsumerSlashMeterReplenishment
+
+	return nil
+}
+
+// InitializeConsumerSlashMeter initializes the slash meter of the consumer chain with `consumerId` to its allowance,
+// and sets its replenish time candidate to one replenish period from current block time
+func (k Keeper) InitializeConsumerSlashMeter(ctx sdk.Context, consumerId string) {
+	k.SetConsumerSlashMeter(ctx, consumerId, k.GetConsumerSlashMeterAllowance(ctx, consumerId))
+	k.SetConsumerSlashMeterReplenishTimeCandidate(ctx, consumerId)
+}
+
+// CheckForConsumerSlashMeterReplenishments checks if the slash meters of the consumer chains should be replenished,
+// and if so, replenishes them
+func (k Keeper) CheckForConsumerSlashMeterReplenishments(ctx sdk.Context) {
+	for _, consumerId := range k.GetAllConsumersWithSlashMeter(ctx) {
+		k.CheckForConsumerSlashMeterReplenishment(ctx, consumerId)
+	}
+}
+
+// CheckForConsumerSlashMeterReplenishment checks if the slash meter of the consumer chain with `consumerId`
+// should be replenished, and if so, replenishes it
+func (k Keeper) CheckForConsumerSlashMeterReplenishment(ctx sdk.Context, consumerId string) {
+	meter, found := k.GetConsumerSlashMeter(ctx, consumerId)
+	if !found {
+		return
+	}
+	allowance := k.GetConsumerSlashMeterAllowance(ctx, consumerId)
+
+	// Replenish slash meter if current time is equal to or after the current replenish candidate time.
+	if !ctx.BlockTime().UTC().Before(k.GetConsumerSlashMeterReplenishTimeCandidate(ctx, consumerId)) {
+		// Replenish meter up to allowance for this block.
+		meter = meter.Add(allowance)
+		if meter.GT(allowance) {
+			meter = allowance
+		}
+		k.SetConsumerSlashMeter(ctx, consumerId, meter)
+		k.SetConsumerSlashMeterReplenishTimeCandidate(ctx, consumerId)
+	}
+
+	// If slash meter is full, or more than full considering updated allowance/total power,
+	// update the replenish time candidate and ensure the meter is not greater than the allowance.
+	if meter.GTE(allowance) {
+		k.SetConsumerSlashMeterReplenishTimeCandidate(ctx, consumerId)
+		k.SetConsumerSlashMeter(ctx, consumerId, allowance)
+	}
+}
+
+// GetConsumerSlashMeterAllowance returns the allowance of the slash meter of the consumer chain with `consumerId`,
+// i.e., the amount of voting power units added to the meter for a replenishment that would happen this block.
+// If the consumer chain has no slash meter of its own, the allowance of the global slash meter is returned.
+func (k Keeper) GetConsumerSlashMeterAllowance(ctx sdk.Context, consumerId string) math.Int {
+	parameters, err := k.GetConsumerThrottlingParameters(ctx, consumerId)
+	if err != nil || parameters.SlashMeterReplenishFraction == "" {
+		return k.GetSlashMeterAllowance(ctx)
+	}
+	return k.computeSlashMeterAllowance(ctx, parameters.SlashMeterReplenishFraction)
+}
+
+// getConsumerSlashMeterReplenishPeriod returns the replenish period of the slash meter
+// of the consumer chain with `consumerId`
+func (k Keeper) getConsumerSlashMeterReplenishPeriod(ctx sdk.Context, consumerId string) time.Duration {
+	parameters, err := k.GetConsumerThrottlingParameters(ctx, consumerId)
+	if err != nil || parameters.SlashMeterReplenishPeriod == 0 {
+		return k.GetSlashMeterReplenishPeriod(ctx)
+	}
+	return parameters.SlashMeterReplenishPeriod
+}
+
+// HasConsumerSlashMeter returns whether the consumer chain with `consumerId` has a slash meter of its own
+func (k Keeper) HasConsumerSlashMeter(ctx sdk.Context, consumerId string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.ConsumerIdToSlashMeterKey(consumerId))
+}
+
+// GetConsumerSlashMeter returns the slash meter of the consumer chain with `consumerId`,
+// and whether the consumer chain has a slash meter of its own
+func (k Keeper) GetConsumerSlashMeter(ctx sdk.Context, consumerId string) (math.Int, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ConsumerIdToSlashMeterKey(consumerId))
+	if bz == nil {
+		return math.ZeroInt(), false
+	}
+	value := math.ZeroInt()
+	if err := value.Unmarshal(bz); err != nil {
+		// We should have obtained value bytes that were serialized in SetConsumerSlashMeter,
+		// so an error here would indicate something is very wrong.
+		panic(fmt.Errorf("failed to unmarshal slash meter for consumer id (%s): %w", consumerId, err))
+	}
+	return value, true
+}
+
+// SetConsumerSlashMeter sets the slash meter of the consumer chain with `consumerId` to the given signed int value
+func (k Keeper) SetConsumerSlashMeter(ctx sdk.Context, consumerId string, value math.Int) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ConsumerIdToSlashMeterKey(consumerId), mustMarshalSlashMeter(value))
+}
+
+// DeleteConsumerSlashMeter deletes the slash meter of the consumer chain with `consumerId`
+// together with its replenish time candidate
+func (k Keeper) DeleteConsumerSlashMeter(ctx sdk.Context, consumerId string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ConsumerIdToSlashMeterKey(consumerId))
+	store.Delete(types.ConsumerIdToSlashMeterReplenishTimeCandidateKey(consumerId))
+}
+
+// GetConsumerSlashMeterReplenishTimeCandidate returns the next UTC time the slash meter
+// of the consumer chain with `consumerId` could potentially be replenished
+func (k Keeper) GetConsumerSlashMeterReplenishTimeCandidate(ctx sdk.Context, consumerId string) time.Time {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ConsumerIdToSlashMeterReplenishTimeCandidateKey(consumerId))
+	if bz == nil {
+		// The replenish time candidate is set together with the slash meter in InitializeConsumerSlashMeter,
+		// so nil bytes would indicate something is very wrong.
+		panic(fmt.Sprintf("slash meter replenish time candidate not set for consumer id (%s)", consumerId))
+	}
+	ts, err := sdk.ParseTimeBytes(bz)
+	if err != nil {
+		// We should have obtained value bytes that were serialized in SetConsumerSlashMeterReplenishTimeCandidate,
+		// so an error here would indicate something is very wrong.
+		panic(fmt.Errorf("failed to parse slash meter replenish time candidate for consumer id (%s): %w", consumerId, err))
+	}
+	return ts.UTC()
+}
+
+// SetConsumerSlashMeterReplenishTimeCandidate sets the next time the slash meter of the consumer chain with `consumerId`
+// may be replenished to the current block time + the slash meter replenish period of the consumer chain
+func (k Keeper) SetConsumerSlashMeterReplenishTimeCandidate(ctx sdk.Context, consumerId string) {
+	store := ctx.KVStore(k.storeKey)
+	timeToStore := ctx.BlockTime().UTC().Add(k.getConsumerSlashMeterReplenishPeriod(ctx, consumerId))
+	store.Set(types.ConsumerIdToSlashMeterReplenishTimeCandidateKey(consumerId), sdk.FormatTimeBytes(timeToStore))
+}
+
+// GetAllConsumersWithSlashMeter returns the consumer ids of all the consumer chains with a slash meter of their own
+func (k Keeper) GetAllConsumersWithSlashMeter(ctx sdk.Context) []string {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{types.ConsumerIdToSlashMeterKeyPrefix()})
+	defer iterator.Close()
+
+	consumerIds := []string{}
+	for ; iterator.Valid(); iterator.Next() {
+		consumerId, err := types.ParseStringIdWithLenKey(types.ConsumerIdToSlashMeterKeyPrefix(), iterator.Key())
+		if err != nil {
+			// An error here would indicate something is very wrong,
+			// the key is assumed to be correctly serialized in SetConsumerSlashMeter.
+			panic(fmt.Errorf("failed to parse consumer slash meter key: %w", err))
+		}
+		consumerIds = append(consumerIds, consumerId)
+	}
+	return consumerIds
+}
+
+// getThrottlingSlashMeter returns the slash meter throttling the slash packets of the consumer chain
+// with `consumerId`, i.e., either its own slash meter or the global slash meter, and whether it is the global one
+func (k Keeper) getThrottlingSlashMeter(ctx sdk.Context, consumerId string) (math.Int, bool) {
+	if meter, found := k.GetConsumerSlashMeter(ctx, consumerId); found {
+		return meter, false
+	}
+	return k.GetSlashMeter(ctx), true
+}
+
+// setThrottlingSlashMeter sets the slash meter throttling the slash packets of the consumer chain with `consumerId`
+func (k Keeper) setThrottlingSlashMeter(ctx sdk.Context, consumerId string, value math.Int) {
+	if k.HasConsumerSlashMeter(ctx, consumerId) {
+		k.SetConsumerSlashMeter(ctx, consumerId, value)
+		return
+	}
+	k.SetSlashMeter(ctx, value)
+}
+
+// validateThrottlingParametersOwner checks that a consumer chain owned by `owner` can have the throttling
+// parameters `parameters`. A slash meter of its own bounds the voting power a consumer chain can jail
+// independently of the other consumer chains, so only chains owned by the gov module can have one.
+func (k Keeper) validateThrottlingParametersOwner(owner string, parameters types.ThrottlingParameters) error {
+	if parameters.SlashMeterReplenishFraction != "" && owner != k.GetAuthority() {
+		return errorsmod.Wrap(types.ErrInvalidConsumerThrottlingParameters,
+			"only a consumer chain owned by the gov module can have a slash meter of its own")
+	}
+	return nil
+}
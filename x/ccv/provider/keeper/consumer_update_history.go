@@ -0,0 +1,200 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"fmt"
+	"reflect"
+	"strings"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+)
+
+// consumerField is the name and the value of a field of a consumer chain that can be updated with MsgUpdateConsumer
+type consumerField struct {
+	name  string
+	value string
+}
+
+// AppendConsumerUpdateRecord records an accepted update of the consumer chain with `consumerId` submitted by `submitter`
+// that changed the fields `changes`. Only the most recent MaxConsumerUpdateRecords records of a consumer chain are retained in state.
+func (k Keeper) AppendConsumerUpdateRecord(
+	ctx sdk.Context,
+	consumerId string,
+	submitter string,
+	changes []types.ConsumerFieldChange,
+) {
+	record := types.ConsumerUpdateRecord{
+		Submitter: submitter,
+		Height:    ctx.BlockHeight(),
+		Time:      ctx.BlockTime(),
+		Changes:   changes,
+	}
+	bz, err := record.Marshal()
+	if err != nil {
+		// An error here would indicate something is very wrong,
+		// the record is assumed to be correctly constructed above.
+		panic(fmt.Errorf("failed to marshal consumer update record for consumer id (%s): %w", consumerId, err))
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	seq := k.getConsumerUpdateRecordSeq(ctx, consumerId)
+	store.Set(types.ConsumerUpdateRecordKey(consumerId, seq), bz)
+	store.Set(types.ConsumerUpdateRecordSeqKey(consumerId), sdk.Uint64ToBigEndian(seq+1))
+
+	// prune the oldest record
+	if seq >= types.MaxConsumerUpdateRecords {
+		store.Delete(types.ConsumerUpdateRecordKey(consumerId, seq-types.MaxConsumerUpdateRecords))
+	}
+}
+
+// GetConsumerUpdateRecords returns the most recent accepted updates of the consumer chain with `consumerId`, oldest first
+func (k Keeper) GetConsumerUpdateRecords(ctx sdk.Context, consumerId string) []types.ConsumerUpdateRecord {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.ConsumerUpdateRecordKeyPrefix(consumerId))
+	defer iterator.Close()
+
+	records := []types.ConsumerUpdateRecord{}
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.ConsumerUpdateRecord
+		if err := record.Unmarshal(iterator.Value()); err != nil {
+			// An error here would indicate something is very wrong,
+			// the records are assumed to be correctly serialized in AppendConsumerUpdateRecord.
+			panic(fmt.Errorf("failed to unmarshal consumer update record for consumer id (%s): %w", consumerId, err))
+		}
+		records = append(records, record)
+	}
+	return records
+}
+
+// getConsumerUpdateRecordSeq returns the sequence number of the next accepted update of the consumer chain with `consumerId`
+func (k Keeper) getConsumerUpdateRecordSeq(ctx sdk.Context, consumerId string) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ConsumerUpdateRecordSeqKey(consumerId))
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// getConsumerFields returns the fields of the consumer chain with `consumerId` that can be updated with MsgUpdateConsumer.
+// The fields of the parameters are flattened, e.g., the Top N of the power shaping parameters is returned
+// as the "power_shaping_parameters.top_N" field. The fields that are not set are returned with an empty value.
+func (k Keeper) getConsumerFields(ctx sdk.Context, consumerId string) []consumerField {
+	chainId, _ := k.GetConsumerChainId(ctx, consumerId)
+	ownerAddress, _ := k.GetConsumerOwnerAddress(ctx, consumerId)
+	metadata, _ := k.GetConsumerMetadata(ctx, consumerId)
+	initializationParameters, _ := k.GetConsumerInitializationParameters(ctx, consumerId)
+	powerShapingParameters, _ := k.GetConsumerPowerShapingParameters(ctx, consumerId)
+	infractionParameters, _ := k.GetInfractionParameters(ctx, consumerId)
+	throttlingParameters, _ := k.GetConsumerThrottlingParameters(ctx, consumerId)
+	allowlistedRewardDenoms, _ := k.GetAllowlistedRewardDenoms(ctx, consumerId)
+
+	fields := []consumerField{
+		{name: "chain_id", value: chainId},
+		{name: "owner_address", value: ownerAddress},
+	}
+	fields = appendConsumerFields(fields, "metadata", reflect.ValueOf(metadata))
+	fields = appendConsumerFields(fields, "initialization_parameters", reflect.ValueOf(initializationParameters))
+	fields = appendConsumerFields(fields, "power_shaping_parameters", reflect.ValueOf(powerShapingParameters))
+	fields = appendConsumerFields(fields, "infraction_parameters", reflect.ValueOf(infractionParameters))
+	// the infraction parameters of launched chains are queued until the unbonding period elapses
+	if k.HasQueuedInfractionParameters(ctx, consumerId) {
+		queuedInfractionParameters, _ := k.GetQueuedInfractionParameters(ctx, consumerId)
+		fields = appendConsumerFields(fields, "queued_infraction_parameters", reflect.ValueOf(queuedInfractionParameters))
+	}
+	fields = appendConsumerFields(fields, "throttling_parameters", reflect.ValueOf(throttlingParameters))
+	fields = append(fields, consumerField{name: "allowlisted_reward_denoms", value: strings.Join(allowlistedRewardDenoms, ",")})
+
+	return fields
+}
+
+// appendConsumerFields appends to `fields` the value `v` of the field with `name`, or,
+// if `v` is a protobuf message, the values of its fields prefixed by `name`
+func appendConsumerFields(fields []consumerField, name string, v reflect.Value) []consumerField {
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return append(fields, consumerField{name: name})
+		}
+		if isProtoStruct(v.Elem()) {
+			v = v.Elem()
+		}
+	}
+
+	if !isProtoStruct(v) {
+		return append(fields, consumerField{name: name, value: formatConsumerFieldValue(v.Interface())})
+	}
+
+	for i := 0; i < v.NumField(); i++ {
+		fieldName, found := protoFieldName(v.Type().Field(i))
+		if !found {
+			continue
+		}
+		fields = appendConsumerFields(fields, name+"."+fieldName, v.Field(i))
+	}
+	return fields
+}
+
+// isProtoStruct returns whether `v` is a struct generated from a protobuf message
+func isProtoStruct(v reflect.Value) bool {
+	if v.Kind() != reflect.Struct {
+		return false
+	}
+	for i := 0; i < v.NumField(); i++ {
+		if _, found := protoFieldName(v.Type().Field(i)); found {
+			return true
+		}
+	}
+	return false
+}
+
+// protoFieldName returns the protobuf name of the struct field `field`, if any
+func protoFieldName(field reflect.StructField) (string, bool) {
+	for _, part := range strings.Split(field.Tag.Get("protobuf"), ",") {
+		if name, found := strings.CutPrefix(part, "name="); found {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+// formatConsumerFieldValue returns the string representation of the value of a consumer field
+func formatConsumerFieldValue(value interface{}) string {
+	switch value := value.(type) {
+	case []byte:
+		return hex.EncodeToString(value)
+	case fmt.Stringer:
+		return value.String()
+	default:
+		return fmt.Sprint(value)
+	}
+}
+
+// diffConsumerFields returns the changes from the consumer fields `oldFields` to the consumer fields `newFields`
+func diffConsumerFields(oldFields, newFields []consumerField) []types.ConsumerFieldChange {
+	oldValues := map[string]string{}
+	for _, field := range oldFields {
+		oldValues[field.name] = field.value
+	}
+	newValues := map[string]string{}
+	for _, field := range newFields {
+		newValues[field.name] = field.value
+	}
+
+	changes := []types.ConsumerFieldChange{}
+	for _, field := range newFields {
+		if oldValue := oldValues[field.name]; oldValue != field.value {
+			changes = append(changes, types.ConsumerFieldChange{Field: field.name, OldValue: oldValue, NewValue: field.value})
+		}
+	}
+	// the fields that are not set anymore, e.g., the queued infraction parameters
+	for _, field := range oldFields {
+		if _, found := newValues[field.name]; !found && field.value != "" {
+			changes = append(changes, types.ConsumerFieldChange{Field: field.name, OldValue: field.value})
+		}
+	}
+	return changes
+}
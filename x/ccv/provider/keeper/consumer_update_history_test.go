@@ -0,0 +1,116 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
+	providerkeeper "github.com/cosmos/interchain-security/v7/x/ccv/provider/keeper"
+	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+)
+
+// TestConsumerUpdateHistory tests that the accepted updates of a consumer chain are recorded
+// with the fields they changed and that they can be queried
+func TestConsumerUpdateHistory(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	mocks.MockSlashingKeeper.EXPECT().DowntimeJailDuration(gomock.Any()).Return(time.Second*600, nil).AnyTimes()
+	mocks.MockSlashingKeeper.EXPECT().SlashFractionDoubleSign(gomock.Any()).Return(math.LegacyNewDec(0), nil).AnyTimes()
+	mocks.MockAccountKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec("cosmos")).AnyTimes()
+
+	msgServer := providerkeeper.NewMsgServerImpl(&providerKeeper)
+	createConsumerResponse, err := msgServer.CreateConsumer(ctx,
+		&providertypes.MsgCreateConsumer{
+			Submitter: "submitter", ChainId: "chainId-1",
+			Metadata: providertypes.ConsumerMetadata{
+				Name:        "name",
+				Description: "description",
+				Metadata:    "metadata",
+			},
+		})
+	require.NoError(t, err)
+	consumerId := createConsumerResponse.ConsumerId
+	require.Empty(t, providerKeeper.GetConsumerUpdateRecords(ctx, consumerId))
+
+	// update the metadata and the power shaping parameters
+	ctx = ctx.WithBlockHeight(10)
+	powerShapingParameters := providertypes.PowerShapingParameters{ValidatorsPowerCap: 30, ValidatorSetCap: 20}
+	_, err = msgServer.UpdateConsumer(ctx,
+		&providertypes.MsgUpdateConsumer{
+			Owner: "submitter", ConsumerId: consumerId,
+			Metadata: &providertypes.ConsumerMetadata{
+				Name:        "new name",
+				Description: "description",
+				Metadata:    "metadata",
+			},
+			PowerShapingParameters: &powerShapingParameters,
+		})
+	require.NoError(t, err)
+
+	// an update without changes is recorded as well
+	ctx = ctx.WithBlockHeight(11)
+	_, err = msgServer.UpdateConsumer(ctx,
+		&providertypes.MsgUpdateConsumer{Owner: "submitter", ConsumerId: consumerId})
+	require.NoError(t, err)
+
+	// a rejected update is not recorded
+	_, err = msgServer.UpdateConsumer(ctx,
+		&providertypes.MsgUpdateConsumer{Owner: "wrong owner", ConsumerId: consumerId})
+	require.Error(t, err)
+
+	records := providerKeeper.GetConsumerUpdateRecords(ctx, consumerId)
+	require.Len(t, records, 2)
+	require.Equal(t, "submitter", records[0].Submitter)
+	require.Equal(t, int64(10), records[0].Height)
+	require.Equal(t, []providertypes.ConsumerFieldChange{
+		{Field: "metadata.name", OldValue: "name", NewValue: "new name"},
+		{Field: "power_shaping_parameters.validators_power_cap", OldValue: "0", NewValue: "30"},
+		{Field: "power_shaping_parameters.validator_set_cap", OldValue: "0", NewValue: "20"},
+	}, records[0].Changes)
+	require.Equal(t, int64(11), records[1].Height)
+	require.Empty(t, records[1].Changes)
+
+	// the records are paginated, oldest first
+	res, err := providerKeeper.QueryConsumerUpdateHistory(ctx, &providertypes.QueryConsumerUpdateHistoryRequest{
+		ConsumerId: consumerId,
+		Pagination: &query.PageRequest{Limit: 1},
+	})
+	require.NoError(t, err)
+	require.Equal(t, records[:1], res.Records)
+	res, err = providerKeeper.QueryConsumerUpdateHistory(ctx, &providertypes.QueryConsumerUpdateHistoryRequest{
+		ConsumerId: consumerId,
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	require.NoError(t, err)
+	require.Equal(t, records[1:], res.Records)
+
+	_, err = providerKeeper.QueryConsumerUpdateHistory(ctx, &providertypes.QueryConsumerUpdateHistoryRequest{ConsumerId: "invalid"})
+	require.Error(t, err)
+}
+
+// TestConsumerUpdateRecordsPruning tests that only the most recent MaxConsumerUpdateRecords
+// updates of a consumer chain are retained
+func TestConsumerUpdateRecordsPruning(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	for i := 0; i < providertypes.MaxConsumerUpdateRecords+5; i++ {
+		providerKeeper.AppendConsumerUpdateRecord(ctx.WithBlockHeight(int64(i)), "0", "owner", nil)
+	}
+	providerKeeper.AppendConsumerUpdateRecord(ctx, "1", "owner", nil)
+
+	records := providerKeeper.GetConsumerUpdateRecords(ctx, "0")
+	require.Len(t, records, providertypes.MaxConsumerUpdateRecords)
+	require.Equal(t, int64(5), records[0].Height)
+	require.Equal(t, int64(providertypes.MaxConsumerUpdateRecords+4), records[len(records)-1].Height)
+	require.Len(t, providerKeeper.GetConsumerUpdateRecords(ctx, "1"), 1)
+}
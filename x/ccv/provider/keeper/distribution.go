@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"slices"
 
 	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
 
@@ -9,15 +10,19 @@ import (
 	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
 )
 
 // BeginBlockRD executes BeginBlock logic for the Reward Distribution sub-protocol.
 func (k Keeper) BeginBlockRD(ctx sdk.Context) {
+	defer ccv.MeasureBlockStage(types.ModuleName, telemetry.Now(), telemetry.MetricKeyBeginBlocker, ccv.BlockStageRewards)
+
 	// TODO this is Tendermint-dependent
 	// ref https://github.com/cosmos/cosmos-sdk/issues/3095
 	if ctx.BlockHeight() > 1 {
@@ -106,6 +111,52 @@ func (k Keeper) UpdateAllowlistedRewardDenoms(ctx sdk.Context, consumerId string
 	return k.SetAllowlistedRewardDenoms(ctx, consumerId, rewardDenoms)
 }
 
+// GetRewardDenomHint returns the base denom that the consumer chain with `consumerId` declared to send rewards in
+func (k Keeper) GetRewardDenomHint(ctx sdk.Context, consumerId string) (string, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ConsumerIdToRewardDenomHintKey(consumerId))
+	if bz == nil {
+		return "", false
+	}
+	return string(bz), true
+}
+
+// SetRewardDenomHint sets the base denom that the consumer chain with `consumerId` declared to send rewards in
+func (k Keeper) SetRewardDenomHint(ctx sdk.Context, consumerId, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ConsumerIdToRewardDenomHintKey(consumerId), []byte(denom))
+}
+
+// DeleteRewardDenomHint deletes the reward denom hint of the consumer chain with `consumerId`
+func (k Keeper) DeleteRewardDenomHint(ctx sdk.Context, consumerId string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ConsumerIdToRewardDenomHintKey(consumerId))
+}
+
+// ApplyRewardDenomHint allowlists `providerDenom` for the consumer chain with `consumerId` if the chain
+// declared `denom` as its reward denom hint. The IBC denom of the rewards on the provider depends on the transfer
+// channel and hence is not known when the chain is created. Returns true if `providerDenom` was allowlisted.
+func (k Keeper) ApplyRewardDenomHint(ctx sdk.Context, consumerId, denom, providerDenom string) (bool, error) {
+	hint, found := k.GetRewardDenomHint(ctx, consumerId)
+	if !found || hint != denom || providerDenom == denom {
+		return false, nil
+	}
+
+	allowlistedDenoms, err := k.GetAllowlistedRewardDenoms(ctx, consumerId)
+	if err != nil {
+		return false, err
+	}
+	if slices.Contains(allowlistedDenoms, providerDenom) {
+		return false, nil
+	}
+	if len(allowlistedDenoms) >= types.MaxAllowlistedRewardDenomsPerChain {
+		return false, errorsmod.Wrapf(types.ErrInvalidAllowlistedRewardDenoms,
+			"cannot allowlist more than %d denoms", types.MaxAllowlistedRewardDenomsPerChain)
+	}
+
+	return true, k.SetAllowlistedRewardDenoms(ctx, consumerId, append(allowlistedDenoms, providerDenom))
+}
+
 // GetConsumerRewardsAllocationByDenom returns the consumer rewards allocation for the given consumer id and denom
 func (k Keeper) GetConsumerRewardsAllocationByDenom(ctx sdk.Context, consumerId, denom string) (types.ConsumerRewardsAllocation, error) {
 	store := ctx.KVStore(k.storeKey)
@@ -161,8 +212,21 @@ func (k Keeper) AllocateConsumerRewards(ctx sdk.Context, consumerId string, allo
 				"chainId", chainId,
 				"error", err.Error(),
 			)
+		} else {
+			k.AppendFundFlowRecord(ctx, types.FUND_FLOW_TYPE_COMMUNITY_POOL, types.ConsumerRewardsPool, consumerId, rewardsToSend)
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeDistributedRewards,
+					sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+					sdk.NewAttribute(types.AttributeConsumerId, consumerId),
+					sdk.NewAttribute(types.AttributeConsumerChainId, chainId),
+					sdk.NewAttribute(types.AttributeRewardTotal, alloc.Rewards.String()),
+					sdk.NewAttribute(types.AttributeRewardDistributed, sdk.NewCoins().String()),
+					sdk.NewAttribute(types.AttributeRewardCommunityPool, rewardsToSend.String()),
+				),
+			)
 		}
-		k.Logger(ctx).Info(
+		k.SubsystemLogger(ctx, ccv.LogSubsystemRewards).Info(
 			"allocated ICS rewards to community pool",
 			"consumerId", consumerId,
 			"chainId", chainId,
@@ -209,6 +273,7 @@ func (k Keeper) AllocateConsumerRewards(ctx sdk.Context, consumerId string, allo
 		)
 		return types.ConsumerRewardsAllocation{}, err
 	}
+	k.AppendFundFlowRecord(ctx, types.FUND_FLOW_TYPE_VALIDATOR_REWARDS, types.ConsumerRewardsPool, consumerId, validatorsRewardsTrunc)
 
 	// allocate tokens to consumer validators
 	if err := k.AllocateTokensToConsumerValidators(
@@ -237,11 +302,12 @@ func (k Keeper) AllocateConsumerRewards(ctx sdk.Context, consumerId string, allo
 		)
 		return types.ConsumerRewardsAllocation{}, err
 	}
+	k.AppendFundFlowRecord(ctx, types.FUND_FLOW_TYPE_COMMUNITY_POOL, types.ConsumerRewardsPool, consumerId, remainingRewards)
 
 	// set consumer allocations to the remaining rewards decimals
 	alloc.Rewards = validatorsRewardsChange.Add(remainingChanges...)
 
-	k.Logger(ctx).Info(
+	k.SubsystemLogger(ctx, ccv.LogSubsystemRewards).Info(
 		"distributed ICS rewards successfully",
 		"consumerId", consumerId,
 		"chainId", chainId,
@@ -336,7 +402,7 @@ func (k Keeper) AllocateTokens(ctx sdk.Context) {
 // IsEligibleForConsumerRewards returns `true` if the validator with `consumerValidatorHeight` has been a consumer
 // validator for a long period of time and hence is eligible to receive rewards, and false otherwise
 func (k Keeper) IsEligibleForConsumerRewards(ctx sdk.Context, consumerValidatorHeight int64) bool {
-	numberOfBlocksToStartReceivingRewards := k.GetNumberOfEpochsToStartReceivingRewards(ctx) * k.GetBlocksPerEpoch(ctx)
+	numberOfBlocksToStartReceivingRewards := k.GetNumberOfEpochsToStartReceivingRewards(ctx) * k.GetEpochLengthInBlocks(ctx)
 
 	// a validator is eligible for rewards if it has been a consumer validator for `NumberOfEpochsToStartReceivingRewards` epochs
 	return (ctx.BlockHeight() - consumerValidatorHeight) >= numberOfBlocksToStartReceivingRewards
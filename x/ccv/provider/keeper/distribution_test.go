@@ -373,6 +373,59 @@ func TestAllowlistedRewardDenoms(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestRewardDenomHint(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	consumerId := "0"
+	ibcDenom := "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+
+	_, found := providerKeeper.GetRewardDenomHint(ctx, consumerId)
+	require.False(t, found)
+
+	// without a hint, nothing is allowlisted
+	allowlisted, err := providerKeeper.ApplyRewardDenomHint(ctx, consumerId, "untrn", ibcDenom)
+	require.NoError(t, err)
+	require.False(t, allowlisted)
+
+	providerKeeper.SetRewardDenomHint(ctx, consumerId, "untrn")
+	hint, found := providerKeeper.GetRewardDenomHint(ctx, consumerId)
+	require.True(t, found)
+	require.Equal(t, "untrn", hint)
+
+	// a denom that does not match the hint is not allowlisted
+	allowlisted, err = providerKeeper.ApplyRewardDenomHint(ctx, consumerId, "uatom", ibcDenom)
+	require.NoError(t, err)
+	require.False(t, allowlisted)
+
+	// a denom that matches the hint is allowlisted only for this consumer chain
+	allowlisted, err = providerKeeper.ApplyRewardDenomHint(ctx, consumerId, "untrn", ibcDenom)
+	require.NoError(t, err)
+	require.True(t, allowlisted)
+	denoms, err := providerKeeper.GetAllowlistedRewardDenoms(ctx, consumerId)
+	require.NoError(t, err)
+	require.Equal(t, []string{ibcDenom}, denoms)
+	require.Empty(t, providerKeeper.GetAllConsumerRewardDenoms(ctx))
+	denoms, err = providerKeeper.GetAllowlistedRewardDenoms(ctx, "1")
+	require.NoError(t, err)
+	require.Empty(t, denoms)
+
+	// applying the hint again does not allowlist the denom twice
+	allowlisted, err = providerKeeper.ApplyRewardDenomHint(ctx, consumerId, "untrn", ibcDenom)
+	require.NoError(t, err)
+	require.False(t, allowlisted)
+
+	// the hint cannot exceed the maximum number of allowlisted denoms
+	err = providerKeeper.SetAllowlistedRewardDenoms(ctx, consumerId, []string{"ibc/1", "ibc/2", "ibc/3"})
+	require.NoError(t, err)
+	_, err = providerKeeper.ApplyRewardDenomHint(ctx, consumerId, "untrn", ibcDenom)
+	require.Error(t, err)
+
+	providerKeeper.DeleteRewardDenomHint(ctx, consumerId)
+	_, found = providerKeeper.GetRewardDenomHint(ctx, consumerId)
+	require.False(t, found)
+}
+
 // TestConsumerRewardsAllocationByDenom tests the `*ConsumerRewardsAllocationByDenom* methods
 func TestConsumerRewardsAllocationByDenom(t *testing.T) {
 	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
@@ -0,0 +1,139 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+)
+
+// GetEpochInfo returns the current epoch of the provider chain when the epochs are expressed in time
+func (k Keeper) GetEpochInfo(ctx sdk.Context) (types.EpochInfo, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.EpochInfoKey())
+	if bz == nil {
+		return types.EpochInfo{}, false
+	}
+	var info types.EpochInfo
+	if err := info.Unmarshal(bz); err != nil {
+		// An error here would indicate something is very wrong,
+		// the epoch info is assumed to be correctly serialized in SetEpochInfo.
+		panic(fmt.Errorf("failed to unmarshal epoch info: %w", err))
+	}
+	return info, true
+}
+
+// SetEpochInfo sets the current epoch of the provider chain when the epochs are expressed in time
+func (k Keeper) SetEpochInfo(ctx sdk.Context, info types.EpochInfo) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := info.Marshal()
+	if err != nil {
+		// An error here would indicate something is very wrong,
+		// the epoch info is created in EndBlockUpdateEpoch.
+		panic(fmt.Errorf("failed to marshal epoch info: %w", err))
+	}
+	store.Set(types.EpochInfoKey(), bz)
+}
+
+// DeleteEpochInfo deletes the current epoch of the provider chain
+func (k Keeper) DeleteEpochInfo(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.EpochInfoKey())
+}
+
+// epochBoundary returns the most recent epoch boundary at `blockTime`, i.e.,
+// the most recent multiple of `epochDuration`
+func epochBoundary(blockTime time.Time, epochDuration time.Duration) time.Time {
+	return blockTime.UTC().Truncate(epochDuration)
+}
+
+// IsEpochStart returns true if the current block is the first block of an epoch.
+// If the epochs are expressed in blocks, an epoch starts every BlocksPerEpoch blocks.
+// If the epochs are expressed in time, an epoch starts in the first block whose time
+// passes an epoch boundary, i.e., a multiple of EpochDuration.
+func (k Keeper) IsEpochStart(ctx sdk.Context) bool {
+	epochDuration := k.GetEpochDuration(ctx)
+	if epochDuration == 0 {
+		return ctx.BlockHeight()%k.GetBlocksPerEpoch(ctx) == 0
+	}
+
+	info, found := k.GetEpochInfo(ctx)
+	if !found || info.StartHeight == ctx.BlockHeight() {
+		return true
+	}
+	return epochBoundary(ctx.BlockTime(), epochDuration).After(info.StartTime)
+}
+
+// GetEpochLengthInBlocks returns the number of blocks of an epoch, i.e., the BlocksPerEpoch param if the epochs
+// are expressed in blocks, or the number of blocks of the previous epoch if the epochs are expressed in time.
+// Note that DefaultBlocksPerEpoch is returned until the first epoch expressed in time is complete.
+func (k Keeper) GetEpochLengthInBlocks(ctx sdk.Context) int64 {
+	if k.GetEpochDuration(ctx) == 0 {
+		return k.GetBlocksPerEpoch(ctx)
+	}
+
+	info, found := k.GetEpochInfo(ctx)
+	if !found || info.PreviousEpochBlocks <= 0 {
+		return types.DefaultBlocksPerEpoch
+	}
+	return info.PreviousEpochBlocks
+}
+
+// estimateBlocksUntilNextEpoch returns the estimated number of blocks until the next epoch starts
+// when the epochs are expressed in time, assuming that the blocks of the current epoch are produced
+// at the average block time of the previous epoch
+func (k Keeper) estimateBlocksUntilNextEpoch(ctx sdk.Context) int64 {
+	if k.IsEpochStart(ctx) {
+		return 0
+	}
+
+	// the epoch info is found, otherwise the current block would be the first block of an epoch
+	info, _ := k.GetEpochInfo(ctx)
+	epochDuration := k.GetEpochDuration(ctx)
+	timeUntilNextEpoch := info.StartTime.Add(epochDuration).Sub(ctx.BlockTime())
+
+	averageBlockTime := epochDuration / time.Duration(k.GetEpochLengthInBlocks(ctx))
+	if averageBlockTime <= 0 {
+		averageBlockTime = 1
+	}
+	blocks := int64((timeUntilNextEpoch + averageBlockTime - 1) / averageBlockTime)
+	if blocks < 1 {
+		return 1
+	}
+	return blocks
+}
+
+// EndBlockUpdateEpoch records the start of a new epoch if the epochs are expressed in time and the current block
+// is the first block whose time passes an epoch boundary. If the epochs are expressed in blocks,
+// the epoch info of the previous epochs expressed in time is deleted.
+func (k Keeper) EndBlockUpdateEpoch(ctx sdk.Context) {
+	epochDuration := k.GetEpochDuration(ctx)
+	info, found := k.GetEpochInfo(ctx)
+	if epochDuration == 0 {
+		if found {
+			k.DeleteEpochInfo(ctx)
+		}
+		return
+	}
+
+	if found && (info.StartHeight == ctx.BlockHeight() || !k.IsEpochStart(ctx)) {
+		return
+	}
+
+	newInfo := types.EpochInfo{
+		StartHeight: ctx.BlockHeight(),
+		StartTime:   epochBoundary(ctx.BlockTime(), epochDuration),
+	}
+	if found {
+		newInfo.PreviousEpochBlocks = ctx.BlockHeight() - info.StartHeight
+	}
+	k.SetEpochInfo(ctx, newInfo)
+
+	k.Logger(ctx).Info("new epoch started",
+		"height", newInfo.StartHeight,
+		"epoch boundary", newInfo.StartTime,
+		"previous epoch blocks", newInfo.PreviousEpochBlocks,
+	)
+}
@@ -0,0 +1,111 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
+	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+)
+
+// TestBlockBasedEpochs tests that an epoch starts every BlocksPerEpoch blocks if the epochs are expressed in blocks
+func TestBlockBasedEpochs(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	params := providertypes.DefaultParams()
+	params.BlocksPerEpoch = 10
+	providerKeeper.SetParams(ctx, params)
+
+	require.True(t, providerKeeper.IsEpochStart(ctx.WithBlockHeight(20)))
+	require.Equal(t, int64(0), providerKeeper.BlocksUntilNextEpoch(ctx.WithBlockHeight(20)))
+	require.False(t, providerKeeper.IsEpochStart(ctx.WithBlockHeight(23)))
+	require.Equal(t, int64(7), providerKeeper.BlocksUntilNextEpoch(ctx.WithBlockHeight(23)))
+	require.Equal(t, int64(10), providerKeeper.GetEpochLengthInBlocks(ctx))
+
+	// no epoch info is recorded for epochs expressed in blocks
+	providerKeeper.EndBlockUpdateEpoch(ctx.WithBlockHeight(20))
+	_, found := providerKeeper.GetEpochInfo(ctx)
+	require.False(t, found)
+}
+
+// TestTimeBasedEpochs tests that an epoch starts in the first block whose time passes
+// a multiple of EpochDuration if the epochs are expressed in time
+func TestTimeBasedEpochs(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	params := providertypes.DefaultParams()
+	params.BlocksPerEpoch = 0
+	params.EpochDuration = time.Minute
+	providerKeeper.SetParams(ctx, params)
+
+	// the first block is the first block of an epoch
+	start := time.Date(2024, 1, 1, 0, 0, 50, 0, time.UTC)
+	ctx = ctx.WithBlockHeight(1).WithBlockTime(start)
+	require.True(t, providerKeeper.IsEpochStart(ctx))
+	require.Equal(t, int64(0), providerKeeper.BlocksUntilNextEpoch(ctx))
+	providerKeeper.EndBlockUpdateEpoch(ctx)
+	info, found := providerKeeper.GetEpochInfo(ctx)
+	require.True(t, found)
+	require.Equal(t, providertypes.EpochInfo{
+		StartHeight: 1,
+		StartTime:   time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+	}, info)
+	// the current block is still the first block of the epoch after the epoch is recorded
+	require.True(t, providerKeeper.IsEpochStart(ctx))
+
+	// the blocks before the next epoch boundary belong to the same epoch,
+	// regardless of how many blocks are produced
+	for height := int64(2); height < 100; height++ {
+		ctx = ctx.WithBlockHeight(height).WithBlockTime(start.Add(time.Duration(height) * 100 * time.Millisecond))
+		require.False(t, providerKeeper.IsEpochStart(ctx))
+		require.Positive(t, providerKeeper.BlocksUntilNextEpoch(ctx))
+		providerKeeper.EndBlockUpdateEpoch(ctx)
+	}
+	info, _ = providerKeeper.GetEpochInfo(ctx)
+	require.Equal(t, int64(1), info.StartHeight)
+	// no epoch is complete yet
+	require.Equal(t, providertypes.DefaultBlocksPerEpoch, providerKeeper.GetEpochLengthInBlocks(ctx))
+
+	// the first block that passes the epoch boundary starts a new epoch
+	ctx = ctx.WithBlockHeight(100).WithBlockTime(time.Date(2024, 1, 1, 0, 1, 1, 0, time.UTC))
+	require.True(t, providerKeeper.IsEpochStart(ctx))
+	providerKeeper.EndBlockUpdateEpoch(ctx)
+	info, _ = providerKeeper.GetEpochInfo(ctx)
+	require.Equal(t, providertypes.EpochInfo{
+		StartHeight:         100,
+		StartTime:           time.Date(2024, 1, 1, 0, 1, 0, 0, time.UTC),
+		PreviousEpochBlocks: 99,
+	}, info)
+	require.Equal(t, int64(99), providerKeeper.GetEpochLengthInBlocks(ctx))
+
+	// the number of blocks until the next epoch is estimated from the length of the previous epoch,
+	// i.e., 99 blocks per minute
+	ctx = ctx.WithBlockHeight(101).WithBlockTime(time.Date(2024, 1, 1, 0, 1, 30, 0, time.UTC))
+	require.False(t, providerKeeper.IsEpochStart(ctx))
+	require.Equal(t, int64(50), providerKeeper.BlocksUntilNextEpoch(ctx))
+
+	// a block that skips several epoch boundaries starts a single new epoch
+	ctx = ctx.WithBlockHeight(102).WithBlockTime(time.Date(2024, 1, 1, 0, 5, 30, 0, time.UTC))
+	require.True(t, providerKeeper.IsEpochStart(ctx))
+	providerKeeper.EndBlockUpdateEpoch(ctx)
+	info, _ = providerKeeper.GetEpochInfo(ctx)
+	require.Equal(t, providertypes.EpochInfo{
+		StartHeight:         102,
+		StartTime:           time.Date(2024, 1, 1, 0, 5, 0, 0, time.UTC),
+		PreviousEpochBlocks: 2,
+	}, info)
+	ctx = ctx.WithBlockHeight(103).WithBlockTime(time.Date(2024, 1, 1, 0, 5, 40, 0, time.UTC))
+	require.False(t, providerKeeper.IsEpochStart(ctx))
+
+	// switching back to epochs expressed in blocks deletes the epoch info
+	params.BlocksPerEpoch = 10
+	params.EpochDuration = 0
+	providerKeeper.SetParams(ctx, params)
+	providerKeeper.EndBlockUpdateEpoch(ctx)
+	_, found = providerKeeper.GetEpochInfo(ctx)
+	require.False(t, found)
+}
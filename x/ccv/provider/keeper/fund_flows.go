@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+)
+
+// AppendFundFlowRecord records a transfer of funds in or out of a CCV module account.
+// Only the most recent MaxFundFlowRecords records are retained in state.
+func (k Keeper) AppendFundFlowRecord(
+	ctx sdk.Context,
+	flowType types.FundFlowType,
+	moduleAccount string,
+	consumerId string,
+	amount sdk.Coins,
+) {
+	if amount.IsZero() {
+		return
+	}
+
+	record := types.FundFlowRecord{
+		Type:          flowType,
+		ModuleAccount: moduleAccount,
+		ConsumerId:    consumerId,
+		Amount:        amount,
+		Height:        ctx.BlockHeight(),
+		Time:          ctx.BlockTime(),
+	}
+	bz, err := record.Marshal()
+	if err != nil {
+		// An error here would indicate something is very wrong,
+		// the record is assumed to be correctly constructed above.
+		panic(fmt.Errorf("failed to marshal fund flow record: %w", err))
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	seq := k.getFundFlowRecordSeq(ctx)
+	store.Set(types.FundFlowRecordKey(seq), bz)
+	store.Set(types.FundFlowRecordSeqKey(), sdk.Uint64ToBigEndian(seq+1))
+
+	// prune the oldest record
+	if seq >= types.MaxFundFlowRecords {
+		store.Delete(types.FundFlowRecordKey(seq - types.MaxFundFlowRecords))
+	}
+}
+
+// GetRecentFundFlowRecords returns up to limit of the most recent transfers of funds
+// in and out of the CCV module accounts, most recent first. A zero limit returns all
+// the retained records.
+func (k Keeper) GetRecentFundFlowRecords(ctx sdk.Context, limit uint32) []types.FundFlowRecord {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStoreReversePrefixIterator(store, []byte{types.FundFlowRecordKeyPrefix()})
+	defer iterator.Close()
+
+	records := []types.FundFlowRecord{}
+	for ; iterator.Valid(); iterator.Next() {
+		if limit != 0 && uint32(len(records)) >= limit {
+			break
+		}
+		var record types.FundFlowRecord
+		if err := record.Unmarshal(iterator.Value()); err != nil {
+			// An error here would indicate something is very wrong,
+			// the records are assumed to be correctly serialized in AppendFundFlowRecord.
+			panic(fmt.Errorf("failed to unmarshal fund flow record: %w", err))
+		}
+		records = append(records, record)
+	}
+
+	return records
+}
+
+// getFundFlowRecordSeq returns the sequence number of the next fund flow record
+func (k Keeper) getFundFlowRecordSeq(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.FundFlowRecordSeqKey())
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
@@ -0,0 +1,53 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
+	"github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+)
+
+// TestFundFlowRecords tests that the fund flow records are returned most recent first
+// and that only the most recent MaxFundFlowRecords records are retained
+func TestFundFlowRecords(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	require.Empty(t, providerKeeper.GetRecentFundFlowRecords(ctx, 0))
+
+	// zero amounts are not recorded
+	providerKeeper.AppendFundFlowRecord(ctx, types.FUND_FLOW_TYPE_CONSUMER_REWARDS, types.ConsumerRewardsPool, "0", sdk.NewCoins())
+	require.Empty(t, providerKeeper.GetRecentFundFlowRecords(ctx, 0))
+
+	now := time.Now().UTC()
+	for i := 1; i <= types.MaxFundFlowRecords+5; i++ {
+		ctx = ctx.WithBlockHeight(int64(i)).WithBlockTime(now.Add(time.Duration(i) * time.Second))
+		providerKeeper.AppendFundFlowRecord(ctx, types.FUND_FLOW_TYPE_CONSUMER_REWARDS, types.ConsumerRewardsPool, "0",
+			sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(int64(i)))))
+	}
+
+	records := providerKeeper.GetRecentFundFlowRecords(ctx, 0)
+	require.Len(t, records, types.MaxFundFlowRecords)
+	require.Equal(t, types.FundFlowRecord{
+		Type:          types.FUND_FLOW_TYPE_CONSUMER_REWARDS,
+		ModuleAccount: types.ConsumerRewardsPool,
+		ConsumerId:    "0",
+		Amount:        sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(types.MaxFundFlowRecords+5))),
+		Height:        types.MaxFundFlowRecords + 5,
+		Time:          now.Add((types.MaxFundFlowRecords + 5) * time.Second),
+	}, records[0])
+	// the oldest retained record is the 6th one
+	require.Equal(t, int64(6), records[len(records)-1].Height)
+
+	records = providerKeeper.GetRecentFundFlowRecords(ctx, 3)
+	require.Len(t, records, 3)
+	require.Equal(t, []int64{types.MaxFundFlowRecords + 5, types.MaxFundFlowRecords + 4, types.MaxFundFlowRecords + 3},
+		[]int64{records[0].Height, records[1].Height, records[2].Height})
+}
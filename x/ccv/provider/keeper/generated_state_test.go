@@ -0,0 +1,96 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
+	providerkeeper "github.com/cosmos/interchain-security/v7/x/ccv/provider/keeper"
+	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+)
+
+// TestGenerateProviderState tests that the generated provider state is reproducible,
+// satisfies the provider invariants, and can be exported into a valid genesis state
+func TestGenerateProviderState(t *testing.T) {
+	cfg := testkeeper.ProviderStateConfig{
+		Seed:              7,
+		NumValidators:     20,
+		NumConsumers:      4,
+		NumKeyAssignments: 30,
+		NumQueuedPackets:  10,
+	}
+
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	state := testkeeper.GenerateProviderState(t, ctx, providerKeeper, cfg)
+
+	require.Len(t, state.Validators, cfg.NumValidators)
+	require.Len(t, state.ConsumerIds, cfg.NumConsumers)
+	require.Equal(t, int64(1), state.Version)
+
+	consensusValidators, err := providerKeeper.GetLastProviderConsensusValSet(ctx)
+	require.NoError(t, err)
+	require.Len(t, consensusValidators, cfg.NumValidators)
+	require.Len(t, providerKeeper.GetAllValidatorConsumerPubKeys(ctx, nil), cfg.NumKeyAssignments)
+	require.Len(t, providerKeeper.GetAllValidatorsByConsumerAddr(ctx, nil), cfg.NumKeyAssignments)
+	numQueuedPackets := 0
+	for _, consumerId := range state.ConsumerIds {
+		require.Equal(t, providertypes.CONSUMER_PHASE_LAUNCHED, providerKeeper.GetConsumerPhase(ctx, consumerId))
+		numQueuedPackets += len(providerKeeper.GetPendingVSCPackets(ctx, consumerId))
+	}
+	require.Equal(t, cfg.NumQueuedPackets, numQueuedPackets)
+
+	// the provider invariants hold
+	_, broken := providerkeeper.MaxProviderConsensusValidatorsInvariant(&providerKeeper)(ctx)
+	require.False(t, broken)
+	for _, invariant := range []sdk.Invariant{
+		providerkeeper.LaunchedConsumersInvariant(providerKeeper),
+		providerkeeper.ConsumerValidatorSetsInvariant(providerKeeper),
+		providerkeeper.ConsumerAddrsToPruneInvariant(providerKeeper),
+	} {
+		msg, broken := invariant(ctx)
+		require.False(t, broken, msg)
+	}
+
+	// the state can be exported into a valid genesis state
+	genState := providerKeeper.ExportGenesis(ctx)
+	require.NoError(t, genState.Validate())
+	require.Len(t, genState.ConsumerStates, cfg.NumConsumers)
+
+	// the same config results in the same state
+	otherProviderKeeper, otherCtx, otherCtrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer otherCtrl.Finish()
+	testkeeper.GenerateProviderState(t, otherCtx, otherProviderKeeper, cfg)
+	require.Equal(t, genState, otherProviderKeeper.ExportGenesis(otherCtx))
+
+	// a different seed results in a different state
+	cfg.Seed++
+	otherProviderKeeper, otherCtx, otherCtrl, _ = testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer otherCtrl.Finish()
+	testkeeper.GenerateProviderState(t, otherCtx, otherProviderKeeper, cfg)
+	require.NotEqual(t, genState, otherProviderKeeper.ExportGenesis(otherCtx))
+}
+
+// BenchmarkExportGenesis benchmarks the export of a large provider state
+func BenchmarkExportGenesis(b *testing.B) {
+	ctrl := gomock.NewController(b)
+	defer ctrl.Finish()
+	params := testkeeper.NewInMemKeeperParams(b)
+	providerKeeper, ctx := testkeeper.NewInMemProviderKeeper(params, testkeeper.NewMockedKeepers(ctrl)), params.Ctx
+	testkeeper.GenerateProviderState(b, ctx, providerKeeper, testkeeper.ProviderStateConfig{
+		Seed:              1,
+		NumValidators:     180,
+		NumConsumers:      20,
+		NumKeyAssignments: 1000,
+		NumQueuedPackets:  200,
+	})
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		providerKeeper.ExportGenesis(ctx)
+	}
+}
@@ -60,6 +60,32 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) []abc
 		}
 	}
 
+	for _, item := range genState.PreLaunchKeyAssignments {
+		if err := k.SetPreLaunchKeyAssignment(ctx, item); err != nil {
+			// An error here would indicate something is very wrong,
+			// the pre-launch key assignments are validated in GenesisState.Validate().
+			panic(fmt.Errorf("pre-launch key assignment could not be persisted: %w", err))
+		}
+	}
+
+	for _, item := range genState.ScheduledKeyAssignments {
+		if err := k.SetScheduledKeyAssignment(ctx, item); err != nil {
+			// An error here would indicate something is very wrong,
+			// the scheduled key assignments are validated in GenesisState.Validate().
+			panic(fmt.Errorf("scheduled key assignment could not be persisted: %w", err))
+		}
+	}
+
+	for _, address := range genState.ConsumerCreatorAllowlist {
+		addr, err := k.accountKeeper.AddressCodec().StringToBytes(address)
+		if err != nil {
+			// An error here would indicate something is very wrong,
+			// the consumer creator allowlist is validated in GenesisState.Validate().
+			panic(fmt.Errorf("consumer creator could not be allowlisted: %w", err))
+		}
+		k.SetConsumerCreatorAllowlisted(ctx, addr)
+	}
+
 	k.SetParams(ctx, genState.Params)
 	k.InitializeSlashMeter(ctx)
 
@@ -156,7 +182,7 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	params := k.GetParams(ctx)
 
 	// TODO (PERMISSIONLESS)
-	return types.NewGenesisState(
+	genState := types.NewGenesisState(
 		k.GetValidatorSetUpdateId(ctx),
 		k.GetAllValsetUpdateBlockHeights(ctx),
 		consumerStates,
@@ -165,4 +191,15 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		k.GetAllValidatorsByConsumerAddr(ctx, nil),
 		consumerAddrsToPrune,
 	)
+	genState.PreLaunchKeyAssignments = k.GetAllPreLaunchKeyAssignments(ctx, nil)
+	genState.ScheduledKeyAssignments = k.GetAllScheduledKeyAssignments(ctx)
+	for _, addr := range k.GetConsumerCreatorAllowlist(ctx) {
+		address, err := k.accountKeeper.AddressCodec().BytesToString(addr)
+		if err != nil {
+			panic(fmt.Errorf("consumer creator address could not be encoded: %w", err))
+		}
+		genState.ConsumerCreatorAllowlist = append(genState.ConsumerCreatorAllowlist, address)
+	}
+
+	return genState
 }
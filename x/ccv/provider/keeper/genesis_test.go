@@ -9,6 +9,7 @@ import (
 
 	"cosmossdk.io/math"
 
+	"github.com/cosmos/cosmos-sdk/codec/address"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
@@ -27,6 +28,7 @@ func TestInitAndExportGenesis(t *testing.T) {
 	oneHourFromNow := time.Now().UTC().Add(time.Hour)
 	initHeight, vscID := uint64(5), uint64(1)
 	params := providertypes.DefaultParams()
+	params.RestrictConsumerCreation = true
 
 	// create validator keys and addresses for key assignment
 	providerCryptoId := crypto.NewCryptoIdentityFromIntSeed(7896)
@@ -87,10 +89,20 @@ func TestInitAndExportGenesis(t *testing.T) {
 			},
 		},
 	)
+	provGenesis.PreLaunchKeyAssignments = []providertypes.PreLaunchKeyAssignment{
+		{
+			ChainId:      "c2",
+			ProviderAddr: providerCryptoId.SDKValOpAddressString(),
+			ConsumerKey:  "{\"@type\":\"/cosmos.crypto.ed25519.PubKey\",\"key\":\"Ui5Gf1+mtWUdH8u3xlmzdKID+F3PK0sfXZ73GZ6q6is=\"}",
+		},
+	}
+	consumerCreator := sdk.AccAddress([]byte("creator"))
+	provGenesis.ConsumerCreatorAllowlist = []string{consumerCreator.String()}
 
 	// Instantiate in-mem provider keeper with mocks
 	pk, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
 	defer ctrl.Finish()
+	mocks.MockAccountKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec("cosmos")).AnyTimes()
 
 	gomock.InOrder(
 		mocks.MockStakingKeeper.EXPECT().GetLastTotalPower(
@@ -156,6 +168,11 @@ func TestInitAndExportGenesis(t *testing.T) {
 	expectedAddrList := providertypes.AddressList{Addresses: [][]byte{consumerConsAddr.ToSdkConsAddr()}}
 	require.Equal(t, expectedAddrList, addrs)
 
+	require.Equal(t, provGenesis.PreLaunchKeyAssignments, pk.GetAllPreLaunchKeyAssignments(ctx, nil))
+	require.Equal(t, []sdk.AccAddress{consumerCreator}, pk.GetConsumerCreatorAllowlist(ctx))
+	require.True(t, pk.CanCreateConsumer(ctx, consumerCreator.String()))
+	require.False(t, pk.CanCreateConsumer(ctx, sdk.AccAddress([]byte("submitter")).String()))
+
 	// check provider chain's consumer chain states
 	assertConsumerChainStates(t, ctx, pk, provGenesis.ConsumerStates...)
 
@@ -14,6 +14,7 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/store/prefix"
 
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 
@@ -113,19 +114,30 @@ func (k Keeper) GetConsumerChain(ctx sdk.Context, consumerId string) (types.Chai
 	allowlist := k.GetAllowList(ctx, consumerId)
 	strAllowlist := make([]string, len(allowlist))
 	for i, addr := range allowlist {
-		strAllowlist[i] = addr.String()
+		if strAllowlist[i], err = k.ConsensusAddressCodec().BytesToString(addr.ToSdkConsAddr()); err != nil {
+			return types.Chain{}, err
+		}
 	}
 
 	denylist := k.GetDenyList(ctx, consumerId)
 	strDenylist := make([]string, len(denylist))
 	for i, addr := range denylist {
-		strDenylist[i] = addr.String()
+		if strDenylist[i], err = k.ConsensusAddressCodec().BytesToString(addr.ToSdkConsAddr()); err != nil {
+			return types.Chain{}, err
+		}
 	}
 
 	prioritylist := k.GetPriorityList(ctx, consumerId)
 	strPrioritylist := make([]string, len(prioritylist))
 	for i, addr := range prioritylist {
-		strPrioritylist[i] = addr.String()
+		if strPrioritylist[i], err = k.ConsensusAddressCodec().BytesToString(addr.ToSdkConsAddr()); err != nil {
+			return types.Chain{}, err
+		}
+	}
+
+	prioritylistWeights, err := k.GetPrioritylistWeights(ctx, consumerId)
+	if err != nil {
+		return types.Chain{}, err
 	}
 
 	metadata, err := k.GetConsumerMetadata(ctx, consumerId)
@@ -155,6 +167,8 @@ func (k Keeper) GetConsumerChain(ctx sdk.Context, consumerId string) (types.Chai
 		AllowlistedRewardDenoms: &types.AllowlistedRewardDenoms{Denoms: allowlistedRewardDenoms},
 		Prioritylist:            strPrioritylist,
 		InfractionParameters:    &infractionParameters,
+		Top_NWeightedEpochs:     powerShapingParameters.Top_NWeightedEpochs,
+		PrioritylistWeights:     prioritylistWeights,
 	}, nil
 }
 
@@ -170,7 +184,7 @@ func (k Keeper) QueryValidatorConsumerAddr(goCtx context.Context, req *types.Que
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	providerAddrTmp, err := sdk.ConsAddressFromBech32(req.ProviderAddress)
+	providerAddrTmp, err := k.ConsensusAddressCodec().StringToBytes(req.ProviderAddress)
 	if err != nil {
 		return nil, err
 	}
@@ -185,9 +199,13 @@ func (k Keeper) QueryValidatorConsumerAddr(goCtx context.Context, req *types.Que
 	if err != nil {
 		return nil, err
 	}
+	consumerAddrStr, err := k.ConsensusAddressCodec().BytesToString(consumerAddr)
+	if err != nil {
+		return nil, err
+	}
 
 	return &types.QueryValidatorConsumerAddrResponse{
-		ConsumerAddress: consumerAddr.String(),
+		ConsumerAddress: consumerAddrStr,
 	}, nil
 }
 
@@ -198,7 +216,7 @@ func (k Keeper) QueryValidatorProviderAddr(goCtx context.Context, req *types.Que
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	consumerAddrTmp, err := sdk.ConsAddressFromBech32(req.ConsumerAddress)
+	consumerAddrTmp, err := k.ConsensusAddressCodec().StringToBytes(req.ConsumerAddress)
 	if err != nil {
 		return nil, err
 	}
@@ -209,8 +227,13 @@ func (k Keeper) QueryValidatorProviderAddr(goCtx context.Context, req *types.Que
 		return &types.QueryValidatorProviderAddrResponse{}, nil
 	}
 
+	providerAddrStr, err := k.ConsensusAddressCodec().BytesToString(providerAddr.ToSdkConsAddr())
+	if err != nil {
+		return nil, err
+	}
+
 	return &types.QueryValidatorProviderAddrResponse{
-		ProviderAddress: providerAddr.String(),
+		ProviderAddress: providerAddrStr,
 	}, nil
 }
 
@@ -232,6 +255,23 @@ func (k Keeper) QueryThrottleState(goCtx context.Context, req *types.QueryThrott
 	}, nil
 }
 
+// QueryThrottleQueueState returns the state of the slash meter together with
+// the bounced slash packets that are pending a retry
+func (k Keeper) QueryThrottleQueueState(goCtx context.Context, req *types.QueryThrottleQueueStateRequest) (*types.QueryThrottleQueueStateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryThrottleQueueStateResponse{
+		SlashMeter:             k.GetSlashMeter(ctx).Int64(),
+		SlashMeterAllowance:    k.GetSlashMeterAllowance(ctx).Int64(),
+		NextReplenishCandidate: k.GetSlashMeterReplenishTimeCandidate(ctx), // always UTC
+		PendingSlashPackets:    k.GetAllBouncedSlashPackets(ctx),
+	}, nil
+}
+
 func (k Keeper) QueryRegisteredConsumerRewardDenoms(goCtx context.Context, req *types.QueryRegisteredConsumerRewardDenomsRequest) (*types.QueryRegisteredConsumerRewardDenomsResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
@@ -269,9 +309,17 @@ func (k Keeper) QueryAllPairsValConsAddrByConsumer(
 		if err != nil {
 			return nil, err
 		}
+		providerAddrStr, err := k.ConsensusAddressCodec().BytesToString(data.ProviderAddr)
+		if err != nil {
+			return nil, err
+		}
+		consumerAddrStr, err := k.ConsensusAddressCodec().BytesToString(consumerAddr)
+		if err != nil {
+			return nil, err
+		}
 		pairValConAddrs = append(pairValConAddrs, &types.PairValConAddrProviderAndConsumer{
-			ProviderAddress: sdk.ConsAddress(data.ProviderAddr).String(),
-			ConsumerAddress: consumerAddr.String(),
+			ProviderAddress: providerAddrStr,
+			ConsumerAddress: consumerAddrStr,
 			ConsumerKey:     data.ConsumerKey,
 		})
 	}
@@ -312,7 +360,11 @@ func (k Keeper) QueryConsumerChainOptedInValidators(goCtx context.Context, req *
 	}
 
 	for _, v := range k.GetAllOptedIn(ctx, consumerId) {
-		optedInVals = append(optedInVals, v.ToSdkConsAddr().String())
+		addr, err := k.ConsensusAddressCodec().BytesToString(v.ToSdkConsAddr())
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		optedInVals = append(optedInVals, addr)
 	}
 
 	return &types.QueryConsumerChainOptedInValidatorsResponse{
@@ -345,7 +397,7 @@ func (k Keeper) QueryConsumerValidators(goCtx context.Context, req *types.QueryC
 	var err error
 
 	// if the consumer launched, the consumer valset has been persisted
-	if phase == types.CONSUMER_PHASE_LAUNCHED {
+	if k.IsConsumerLaunched(ctx, consumerId) {
 		consumerValSet, err = k.GetConsumerValSet(ctx, consumerId)
 		if err != nil {
 			return nil, status.Error(codes.Internal, err.Error())
@@ -368,7 +420,7 @@ func (k Keeper) QueryConsumerValidators(goCtx context.Context, req *types.QueryC
 				return nil, status.Error(codes.Internal, fmt.Sprintf("failed to get active validators: %s", err))
 			}
 
-			minPower, err = k.ComputeMinPowerInTopN(ctx, activeValidators, powerShapingParameters.Top_N)
+			minPower, err = k.ComputeMinPowerInWeightedTopN(ctx, activeValidators, powerShapingParameters.Top_N, powerShapingParameters.Top_NWeightedEpochs)
 			if err != nil {
 				return nil, status.Error(codes.Internal, fmt.Sprintf("failed to compute min power to opt in for chain %s: %s", consumerId, err))
 			}
@@ -411,8 +463,13 @@ func (k Keeper) QueryConsumerValidators(goCtx context.Context, req *types.QueryC
 			consumerRate = providerVal.Commission.Rate
 		}
 
+		providerAddrStr, err := k.ConsensusAddressCodec().BytesToString(consAddr)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
 		validators = append(validators, &types.QueryConsumerValidatorsValidator{
-			ProviderAddress:         sdk.ConsAddress(consumerVal.ProviderConsAddr).String(),
+			ProviderAddress:         providerAddrStr,
 			ConsumerKey:             consumerVal.PublicKey,
 			ConsumerPower:           consumerVal.Power,
 			ConsumerCommissionRate:  consumerRate,
@@ -442,7 +499,7 @@ func (k Keeper) QueryConsumerChainsValidatorHasToValidate(goCtx context.Context,
 		return nil, status.Error(codes.InvalidArgument, "empty provider address")
 	}
 
-	consAddr, err := sdk.ConsAddressFromBech32(req.ProviderAddress)
+	consAddr, err := k.ConsensusAddressCodec().StringToBytes(req.ProviderAddress)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid provider address")
 	}
@@ -498,7 +555,7 @@ func (k Keeper) hasToValidate(
 	if powerShapingParameters.Top_N > 0 {
 		// compute the minimum power to opt-in since the one in the state is stale
 		// Note that the effective min power will be computed at the end of the epoch
-		minPowerToOptIn, err = k.ComputeMinPowerInTopN(ctx, activeValidators, powerShapingParameters.Top_N)
+		minPowerToOptIn, err = k.ComputeMinPowerInWeightedTopN(ctx, activeValidators, powerShapingParameters.Top_N, powerShapingParameters.Top_NWeightedEpochs)
 		if err != nil {
 			return false, err
 		}
@@ -524,6 +581,106 @@ func (k Keeper) hasToValidate(
 	return false, nil
 }
 
+// QueryValidatorCCVSummary returns the staking and CCV data of a provider validator
+func (k Keeper) QueryValidatorCCVSummary(goCtx context.Context, req *types.QueryValidatorCCVSummaryRequest) (*types.QueryValidatorCCVSummaryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	valAddr, err := k.ValidatorAddressCodec().StringToBytes(req.ValidatorAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid validator address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	validator, err := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("unknown validator: %s", req.ValidatorAddress))
+	}
+	consAddrBz, err := validator.GetConsAddr()
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	consAddr := sdk.ConsAddress(consAddrBz)
+	provAddr := types.NewProviderConsAddress(consAddr)
+	providerAddrStr, err := k.ConsensusAddressCodec().BytesToString(consAddr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	power, err := k.stakingKeeper.GetLastValidatorPower(ctx, valAddr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	outstandingRewards, err := k.distributionKeeper.GetValidatorOutstandingRewardsCoins(ctx, valAddr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	consumers := []types.ValidatorConsumerSummary{}
+	for _, consumerId := range k.GetAllActiveConsumerIds(ctx) {
+		phase := k.GetConsumerPhase(ctx, consumerId)
+		optedIn := k.IsOptedIn(ctx, consumerId, provAddr)
+		consumerValidator := k.IsConsumerValidator(ctx, consumerId, provAddr)
+		hasToValidate := false
+		if k.IsConsumerLaunched(ctx, consumerId) {
+			hasToValidate, err = k.hasToValidate(ctx, provAddr, consumerId)
+			if err != nil {
+				return nil, status.Error(codes.Internal, fmt.Sprintf("cannot check if validator has to validate consumer chain %s: %s", consumerId, err))
+			}
+		}
+		if !optedIn && !consumerValidator && !hasToValidate {
+			continue
+		}
+
+		chainId, err := k.GetConsumerChainId(ctx, consumerId)
+		if err != nil {
+			return nil, status.Error(codes.Internal, fmt.Sprintf("cannot retrieve chain id for consumer id: %s", consumerId))
+		}
+
+		consumerAddress := ""
+		if consumerKey, found := k.GetValidatorConsumerPubKey(ctx, consumerId, provAddr); found {
+			consumerAddr, err := ccvtypes.TMCryptoPublicKeyToConsAddr(consumerKey)
+			if err != nil {
+				return nil, status.Error(codes.Internal, err.Error())
+			}
+			if consumerAddress, err = k.ConsensusAddressCodec().BytesToString(consumerAddr); err != nil {
+				return nil, status.Error(codes.Internal, err.Error())
+			}
+		}
+
+		commissionRate, found := k.GetConsumerCommissionRate(ctx, consumerId, provAddr)
+		if !found {
+			commissionRate = validator.Commission.Rate
+		}
+
+		consumers = append(consumers, types.ValidatorConsumerSummary{
+			ConsumerId:        consumerId,
+			ChainId:           chainId,
+			Phase:             phase,
+			OptedIn:           optedIn,
+			ConsumerValidator: consumerValidator,
+			HasToValidate:     hasToValidate,
+			ConsumerAddress:   consumerAddress,
+			CommissionRate:    commissionRate,
+		})
+	}
+
+	return &types.QueryValidatorCCVSummaryResponse{
+		ValidatorAddress:         req.ValidatorAddress,
+		ProviderAddress:          providerAddrStr,
+		Status:                   validator.Status,
+		Tokens:                   validator.Tokens,
+		Power:                    power,
+		Jailed:                   validator.Jailed,
+		Tombstoned:               k.slashingKeeper.IsTombstoned(ctx, consAddr),
+		ConsumerDoubleSignLogged: k.GetSlashLog(ctx, provAddr),
+		OutstandingRewards:       outstandingRewards,
+		Consumers:                consumers,
+	}, nil
+}
+
 // QueryValidatorConsumerCommissionRate returns the commission rate a given
 // validator charges on a given consumer chain
 func (k Keeper) QueryValidatorConsumerCommissionRate(goCtx context.Context, req *types.QueryValidatorConsumerCommissionRateRequest) (*types.QueryValidatorConsumerCommissionRateResponse, error) {
@@ -536,7 +693,7 @@ func (k Keeper) QueryValidatorConsumerCommissionRate(goCtx context.Context, req
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	consAddr, err := sdk.ConsAddressFromBech32(req.ProviderAddress)
+	consAddr, err := k.ConsensusAddressCodec().StringToBytes(req.ProviderAddress)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid provider address")
 	}
@@ -557,7 +714,7 @@ func (k Keeper) QueryValidatorConsumerCommissionRate(goCtx context.Context, req
 	} else {
 		v, err := k.stakingKeeper.GetValidatorByConsAddr(ctx, consAddr)
 		if err != nil {
-			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("unknown validator: %s", consAddr.String()))
+			return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("unknown validator: %s", req.ProviderAddress))
 		}
 		res.Rate = v.Commission.Rate
 	}
@@ -632,16 +789,24 @@ func (k Keeper) QueryConsumerChain(goCtx context.Context, req *types.QueryConsum
 	// That's why we do not check if the client id is found.
 	clientId, _ := k.GetConsumerClientId(ctx, consumerId)
 
+	pendingChainId, _ := k.GetConsumerPendingChainId(ctx, consumerId)
+
+	// without throttling parameters, the slash packets of the consumer chain are throttled by the global slash meter
+	throttlingParams, _ := k.GetConsumerThrottlingParameters(ctx, consumerId)
+
 	return &types.QueryConsumerChainResponse{
-		ChainId:              chainId,
-		ConsumerId:           consumerId,
-		OwnerAddress:         ownerAddress,
-		Phase:                phase.String(),
-		Metadata:             metadata,
-		InitParams:           &initParams,
-		PowerShapingParams:   &powerParams,
-		InfractionParameters: &infractionParams,
-		ClientId:             clientId,
+		ChainId:               chainId,
+		ConsumerId:            consumerId,
+		OwnerAddress:          ownerAddress,
+		Phase:                 phase.String(),
+		Metadata:              metadata,
+		InitParams:            &initParams,
+		PowerShapingParams:    &powerParams,
+		InfractionParameters:  &infractionParams,
+		ClientId:              clientId,
+		PendingChainId:        pendingChainId,
+		ThrottlingParameters:  &throttlingParams,
+		ExpectedInitialHeight: types.ExpectedInitialHeight(initParams.InitialHeight, chainId),
 	}, nil
 }
 
@@ -703,3 +868,564 @@ func (k Keeper) QueryConsumerGenesisTime(goCtx context.Context, req *types.Query
 		GenesisTime: time.Unix(0, int64(cs.GetTimestamp())), // nolint:staticcheck
 	}, nil
 }
+
+// QueryNearTimeoutPackets returns the VSC packets sent to consumer chains that are not yet acknowledged
+// and that time out within the given duration, including the packets that already timed out
+func (k Keeper) QueryNearTimeoutPackets(goCtx context.Context, req *types.QueryNearTimeoutPacketsRequest) (*types.QueryNearTimeoutPacketsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	if req.Within < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid duration: %s", req.Within)
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	deadline := ctx.BlockTime().Add(req.Within)
+	packets := []types.PacketTimeout{}
+	for _, channelToConsumer := range k.GetAllChannelToConsumers(ctx) {
+		for _, packet := range k.GetAllVSCPacketTimeouts(ctx, channelToConsumer.ChannelId) {
+			if packet.TimeoutTimestamp.After(deadline) {
+				continue
+			}
+			packet.ConsumerId = channelToConsumer.ConsumerId
+			packets = append(packets, packet)
+		}
+	}
+
+	return &types.QueryNearTimeoutPacketsResponse{Packets: packets}, nil
+}
+
+// QueryLaunchCapacity returns the number of launched consumer chains and,
+// if the number of launched consumer chains is capped, the number of consumer chains that can still be launched
+func (k Keeper) QueryLaunchCapacity(goCtx context.Context, req *types.QueryLaunchCapacityRequest) (*types.QueryLaunchCapacityResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	maxLaunchedConsumers := k.GetMaxLaunchedConsumers(ctx)
+	launchedConsumers := k.GetLaunchedConsumersCount(ctx)
+	remainingCapacity := uint64(0)
+	if launchedConsumers < maxLaunchedConsumers {
+		remainingCapacity = maxLaunchedConsumers - launchedConsumers
+	}
+
+	return &types.QueryLaunchCapacityResponse{
+		MaxLaunchedConsumers: maxLaunchedConsumers,
+		LaunchedConsumers:    launchedConsumers,
+		RemainingCapacity:    remainingCapacity,
+	}, nil
+}
+
+// QueryPowerShapingPipeline returns the power shaping steps applied, in evaluation order,
+// the last time the validator set of the given consumer chain was computed
+func (k Keeper) QueryPowerShapingPipeline(goCtx context.Context, req *types.QueryPowerShapingPipelineRequest) (*types.QueryPowerShapingPipelineResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	consumerId := req.ConsumerId
+	if err := ccvtypes.ValidateConsumerId(consumerId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	pipeline, found := k.GetConsumerPowerShapingPipeline(ctx, consumerId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no validator set computed for consumer chain: %s", consumerId)
+	}
+
+	return &types.QueryPowerShapingPipelineResponse{Pipeline: pipeline}, nil
+}
+
+// QueryConsumerValidatorPowerProjection returns the validator set that would be sent to a launched consumer chain
+// in the next VSCPacket, after applying the power shaping parameters, without committing it
+func (k Keeper) QueryConsumerValidatorPowerProjection(goCtx context.Context, req *types.QueryConsumerValidatorPowerProjectionRequest) (*types.QueryConsumerValidatorPowerProjectionResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	consumerId := req.ConsumerId
+	if err := ccvtypes.ValidateConsumerId(consumerId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.IsConsumerLaunched(ctx, consumerId) {
+		return nil, status.Errorf(codes.InvalidArgument, "consumer chain %s is not launched: %s", consumerId, k.GetConsumerPhase(ctx, consumerId))
+	}
+
+	currentValSet, err := k.GetConsumerValSet(ctx, consumerId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	currentPowers := make(map[string]int64, len(currentValSet))
+	for _, val := range currentValSet {
+		currentPowers[string(val.ProviderConsAddr)] = val.Power
+	}
+
+	nextValSet, pipeline, err := k.ProjectConsumerNextValSet(ctx, consumerId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, fmt.Sprintf("failed to project the next validator set for chain %s: %s", consumerId, err))
+	}
+
+	validators := []types.ProjectedConsumerValidator{}
+	for _, val := range nextValSet {
+		providerAddr, err := k.ConsensusAddressCodec().BytesToString(val.ProviderConsAddr)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		validators = append(validators, types.ProjectedConsumerValidator{
+			ProviderAddress: providerAddr,
+			ConsumerKey:     val.PublicKey,
+			Power:           val.Power,
+			CurrentPower:    currentPowers[string(val.ProviderConsAddr)],
+		})
+	}
+
+	removed := diffConsAddrs(k.consensusValidatorsConsAddrs(currentValSet), k.consensusValidatorsConsAddrs(nextValSet))
+
+	return &types.QueryConsumerValidatorPowerProjectionResponse{
+		Validators:               validators,
+		Pipeline:                 pipeline,
+		RemovedProviderAddresses: removed,
+	}, nil
+}
+
+// QueryModuleAccountsSummary returns the balances of the CCV module accounts
+// and the most recent transfers of funds in and out of them
+func (k Keeper) QueryModuleAccountsSummary(goCtx context.Context, req *types.QueryModuleAccountsSummaryRequest) (*types.QueryModuleAccountsSummaryResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	accounts := []types.ModuleAccountBalance{
+		{
+			Name:    types.ConsumerRewardsPool,
+			Address: k.GetConsumerRewardsPoolAddressStr(ctx),
+			Balance: k.GetConsumerRewardsPool(ctx),
+		},
+	}
+
+	flows := k.GetRecentFundFlowRecords(ctx, req.Limit)
+	totalInflow, totalOutflow := sdk.NewCoins(), sdk.NewCoins()
+	for _, flow := range flows {
+		if flow.Type.IsInflow() {
+			totalInflow = totalInflow.Add(flow.Amount...)
+		} else {
+			totalOutflow = totalOutflow.Add(flow.Amount...)
+		}
+	}
+
+	return &types.QueryModuleAccountsSummaryResponse{
+		Accounts:     accounts,
+		Flows:        flows,
+		TotalInflow:  totalInflow,
+		TotalOutflow: totalOutflow,
+	}, nil
+}
+
+// QueryConsumerLaunchFailure returns the last failed launch of the given consumer chain
+func (k Keeper) QueryConsumerLaunchFailure(goCtx context.Context, req *types.QueryConsumerLaunchFailureRequest) (*types.QueryConsumerLaunchFailureResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	consumerId := req.ConsumerId
+	if err := ccvtypes.ValidateConsumerId(consumerId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	failure, found := k.GetConsumerLaunchFailure(ctx, consumerId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no failed launch for consumer chain: %s", consumerId)
+	}
+
+	return &types.QueryConsumerLaunchFailureResponse{Failure: failure}, nil
+}
+
+// QueryConsumerHashCommitment returns the genesis and binary hashes of a consumer chain,
+// whether they were updated since registration and whether the owner attested them
+func (k Keeper) QueryConsumerHashCommitment(goCtx context.Context, req *types.QueryConsumerHashCommitmentRequest) (*types.QueryConsumerHashCommitmentResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	consumerId := req.ConsumerId
+	if err := ccvtypes.ValidateConsumerId(consumerId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	initializationParameters, err := k.GetConsumerInitializationParameters(ctx, consumerId)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "cannot retrieve initialization parameters for consumer id: %s", consumerId)
+	}
+
+	commitment, _ := k.GetConsumerHashCommitment(ctx, consumerId)
+
+	return &types.QueryConsumerHashCommitmentResponse{
+		GenesisHash:              initializationParameters.GenesisHash,
+		BinaryHash:               initializationParameters.BinaryHash,
+		UpdatedSinceRegistration: commitment.UpdateCount > 0,
+		Locked:                   !k.IsConsumerPrelaunched(ctx, consumerId),
+		Commitment:               commitment,
+	}, nil
+}
+
+// QueryConsumerCreatorAllowlist returns the addresses allowed to create consumer chains
+func (k Keeper) QueryConsumerCreatorAllowlist(goCtx context.Context, req *types.QueryConsumerCreatorAllowlistRequest) (*types.QueryConsumerCreatorAllowlistResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addresses := []string{}
+	for _, addr := range k.GetConsumerCreatorAllowlist(ctx) {
+		addresses = append(addresses, addr.String())
+	}
+
+	return &types.QueryConsumerCreatorAllowlistResponse{
+		Addresses: addresses,
+	}, nil
+}
+
+// QueryConsumerPacketStats returns the number of CCV packets exchanged with a consumer chain
+func (k Keeper) QueryConsumerPacketStats(goCtx context.Context, req *types.QueryConsumerPacketStatsRequest) (*types.QueryConsumerPacketStatsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	consumerId := req.ConsumerId
+	if err := ccvtypes.ValidateConsumerId(consumerId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := k.GetConsumerChainId(ctx, consumerId); err != nil {
+		return nil, status.Errorf(codes.NotFound, "unknown consumer id: %s", consumerId)
+	}
+
+	return &types.QueryConsumerPacketStatsResponse{
+		Stats: k.GetConsumerPacketStats(ctx, consumerId),
+	}, nil
+}
+
+// QueryConsumerClientUpgradePlans returns the pending upgrades of the consumer clients
+func (k Keeper) QueryConsumerClientUpgradePlans(goCtx context.Context, req *types.QueryConsumerClientUpgradePlansRequest) (*types.QueryConsumerClientUpgradePlansResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	upgrades := []types.ConsumerClientUpgrade{}
+	for _, consumerId := range k.GetAllConsumersWithClientUpgradePlan(ctx) {
+		plan, _ := k.GetConsumerClientUpgradePlan(ctx, consumerId)
+		chainId, err := k.GetConsumerChainId(ctx, consumerId)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "cannot get chain id of consumer %s: %s", consumerId, err.Error())
+		}
+		clientId, _ := k.GetConsumerClientId(ctx, consumerId)
+		upgrades = append(upgrades, types.ConsumerClientUpgrade{
+			ConsumerId: consumerId,
+			ChainId:    chainId,
+			ClientId:   clientId,
+			Plan:       plan,
+		})
+	}
+
+	return &types.QueryConsumerClientUpgradePlansResponse{Upgrades: upgrades}, nil
+}
+
+// QueryConsumerProviderSwitches returns the switches of the consumer chains to different provider chains
+func (k Keeper) QueryConsumerProviderSwitches(goCtx context.Context, req *types.QueryConsumerProviderSwitchesRequest) (*types.QueryConsumerProviderSwitchesResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	switches := []types.RegisteredProviderSwitch{}
+	for _, consumerId := range k.GetAllConsumersWithProviderSwitch(ctx) {
+		providerSwitch, _ := k.GetConsumerProviderSwitch(ctx, consumerId)
+		chainId, err := k.GetConsumerChainId(ctx, consumerId)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "cannot get chain id of consumer %s: %s", consumerId, err.Error())
+		}
+		switches = append(switches, types.RegisteredProviderSwitch{
+			ConsumerId: consumerId,
+			ChainId:    chainId,
+			Phase:      k.GetConsumerPhase(ctx, consumerId),
+			Switch:     providerSwitch,
+		})
+	}
+
+	return &types.QueryConsumerProviderSwitchesResponse{Switches: switches}, nil
+}
+
+// QueryConsumerClientExpiries returns the times at which the clients of the launched consumer chains expire
+func (k Keeper) QueryConsumerClientExpiries(goCtx context.Context, req *types.QueryConsumerClientExpiriesRequest) (*types.QueryConsumerClientExpiriesResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	expiries := []types.ConsumerClientExpiry{}
+	for _, consumerId := range k.GetAllConsumersWithClientExpiry(ctx) {
+		expiry, _ := k.GetConsumerClientExpiry(ctx, consumerId)
+		chainId, err := k.GetConsumerChainId(ctx, consumerId)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "cannot get chain id of consumer %s: %s", consumerId, err.Error())
+		}
+		clientId, _ := k.GetConsumerClientId(ctx, consumerId)
+		expiries = append(expiries, types.ConsumerClientExpiry{
+			ConsumerId:      consumerId,
+			ChainId:         chainId,
+			ClientId:        clientId,
+			ExpiryTime:      expiry,
+			TimeUntilExpiry: expiry.Sub(ctx.BlockTime()),
+		})
+	}
+
+	return &types.QueryConsumerClientExpiriesResponse{Expiries: expiries}, nil
+}
+
+// QueryLastVSCPacket returns the last VSC packet sent to a consumer chain,
+// with the validators of its validator updates and slash acknowledgements resolved
+func (k Keeper) QueryLastVSCPacket(goCtx context.Context, req *types.QueryLastVSCPacketRequest) (*types.QueryLastVSCPacketResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	consumerId := req.ConsumerId
+	if err := ccvtypes.ValidateConsumerId(consumerId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	packet, found := k.GetLastVSCPacket(ctx, consumerId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "no VSC packet sent to consumer %s", consumerId)
+	}
+
+	validatorUpdates := []types.VSCPacketValidator{}
+	for _, update := range packet.Data.ValidatorUpdates {
+		pubKey, err := cryptocodec.FromCmtProtoPublicKey(update.PubKey)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "cannot decode the public key of a validator update: %s", err.Error())
+		}
+		consumerAddr := types.NewConsumerConsAddress(sdk.ConsAddress(pubKey.Address()))
+		validatorUpdates = append(validatorUpdates, k.resolveVSCPacketValidator(ctx, consumerId, consumerAddr, update.Power))
+	}
+
+	slashAcks := []types.VSCPacketValidator{}
+	for _, slashAck := range packet.Data.SlashAcks {
+		consAddr, err := k.ConsensusAddressCodec().StringToBytes(slashAck)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "cannot decode the slash acknowledgement %s: %s", slashAck, err.Error())
+		}
+		slashAcks = append(slashAcks, k.resolveVSCPacketValidator(ctx, consumerId, types.NewConsumerConsAddress(consAddr), 0))
+	}
+
+	return &types.QueryLastVSCPacketResponse{
+		Packet:           packet,
+		ValidatorUpdates: validatorUpdates,
+		SlashAcks:        slashAcks,
+	}, nil
+}
+
+// resolveVSCPacketValidator returns the validator with the consensus address `consumerAddr`
+// on the consumer chain with `consumerId`, as referred to by a VSC packet
+func (k Keeper) resolveVSCPacketValidator(
+	ctx sdk.Context,
+	consumerId string,
+	consumerAddr types.ConsumerConsAddress,
+	power int64,
+) types.VSCPacketValidator {
+	providerAddr := k.GetProviderAddrFromConsumerAddr(ctx, consumerId, consumerAddr)
+	validator := types.VSCPacketValidator{
+		ConsumerConsAddress: consumerAddr.String(),
+		ProviderConsAddress: providerAddr.String(),
+		Power:               power,
+	}
+	if providerVal, err := k.stakingKeeper.GetValidatorByConsAddr(ctx, providerAddr.ToSdkConsAddr()); err == nil {
+		validator.Moniker = providerVal.GetMoniker()
+	}
+	return validator
+}
+
+// QueryConsumerThrottleState returns the throttling parameters of a consumer chain
+// and the state of the slash meter throttling its slash packets
+func (k Keeper) QueryConsumerThrottleState(goCtx context.Context, req *types.QueryConsumerThrottleStateRequest) (*types.QueryConsumerThrottleStateResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	consumerId := req.ConsumerId
+	if err := ccvtypes.ValidateConsumerId(consumerId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	throttlingParams, err := k.GetConsumerThrottlingParameters(ctx, consumerId)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "cannot retrieve throttling parameters for consumer id: %s", consumerId)
+	}
+
+	meter, globalMeter := k.getThrottlingSlashMeter(ctx, consumerId)
+	candidate := k.GetSlashMeterReplenishTimeCandidate(ctx) // always UTC
+	if !globalMeter {
+		candidate = k.GetConsumerSlashMeterReplenishTimeCandidate(ctx, consumerId)
+	}
+
+	return &types.QueryConsumerThrottleStateResponse{
+		ThrottlingParameters:   throttlingParams,
+		GlobalSlashMeter:       globalMeter,
+		SlashMeter:             meter.Int64(),
+		SlashMeterAllowance:    k.GetConsumerSlashMeterAllowance(ctx, consumerId).Int64(),
+		NextReplenishCandidate: candidate,
+	}, nil
+}
+
+// QueryPendingInfractionParameterUpdates returns the infraction parameter updates
+// that are queued for consumer chains, ordered by the time at which they are applied
+func (k Keeper) QueryPendingInfractionParameterUpdates(goCtx context.Context, req *types.QueryPendingInfractionParameterUpdatesRequest) (*types.QueryPendingInfractionParameterUpdatesResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if req.ConsumerId == "" {
+		updates, err := k.GetAllPendingInfractionParametersUpdates(ctx)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		return &types.QueryPendingInfractionParameterUpdatesResponse{Updates: updates}, nil
+	}
+
+	if err := ccvtypes.ValidateConsumerId(req.ConsumerId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	update, found, err := k.GetPendingInfractionParametersUpdate(ctx, req.ConsumerId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	updates := []types.PendingInfractionParametersUpdate{}
+	if found {
+		updates = append(updates, update)
+	}
+	return &types.QueryPendingInfractionParameterUpdatesResponse{Updates: updates}, nil
+}
+
+// QueryStaleKeyAssignments returns the assigned consumer keys of a consumer chain
+// that were not observed in any heartbeat of the consumer chain for the StaleKeyAssignmentEpochs param
+func (k Keeper) QueryStaleKeyAssignments(goCtx context.Context, req *types.QueryStaleKeyAssignmentsRequest) (*types.QueryStaleKeyAssignmentsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	consumerId := req.ConsumerId
+	if err := ccvtypes.ValidateConsumerId(consumerId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryStaleKeyAssignmentsResponse{
+		StaleKeyAssignments: k.GetStaleKeyAssignments(ctx, consumerId),
+	}, nil
+}
+
+// QueryConsumerUpdateHistory returns the most recent accepted updates of a consumer chain,
+// oldest first, together with the fields changed by every update
+func (k Keeper) QueryConsumerUpdateHistory(goCtx context.Context, req *types.QueryConsumerUpdateHistoryRequest) (*types.QueryConsumerUpdateHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	consumerId := req.ConsumerId
+	if err := ccvtypes.ValidateConsumerId(consumerId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	records := []types.ConsumerUpdateRecord{}
+	recordStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ConsumerUpdateRecordKeyPrefix(consumerId))
+	pageRes, err := query.Paginate(recordStore, req.Pagination, func(_, value []byte) error {
+		var record types.ConsumerUpdateRecord
+		if err := record.Unmarshal(value); err != nil {
+			return err
+		}
+		records = append(records, record)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryConsumerUpdateHistoryResponse{Records: records, Pagination: pageRes}, nil
+}
+
+// QueryValidatorOptInHistory returns, per consumer chain, the most recent opt-ins and opt-outs of a validator, oldest first
+func (k Keeper) QueryValidatorOptInHistory(goCtx context.Context, req *types.QueryValidatorOptInHistoryRequest) (*types.QueryValidatorOptInHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	providerAddrTmp, err := k.ConsensusAddressCodec().StringToBytes(req.ProviderAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	providerAddr := types.NewProviderConsAddress(providerAddrTmp)
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryValidatorOptInHistoryResponse{
+		Histories: k.GetValidatorOptInHistories(ctx, providerAddr),
+	}, nil
+}
+
+// QueryModuleVersionInfo returns the version of the provider module and the features enabled on the provider chain
+func (k Keeper) QueryModuleVersionInfo(goCtx context.Context, req *types.QueryModuleVersionInfoRequest) (*types.QueryModuleVersionInfoResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	versionInfo, err := k.GetModuleVersionInfo(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryModuleVersionInfoResponse{VersionInfo: versionInfo}, nil
+}
+
+// QueryConsumerRewardEstimate returns the estimated annual ICS rewards of a validator for a consumer chain
+func (k Keeper) QueryConsumerRewardEstimate(goCtx context.Context, req *types.QueryConsumerRewardEstimateRequest) (*types.QueryConsumerRewardEstimateResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	consumerId := req.ConsumerId
+	if err := ccvtypes.ValidateConsumerId(consumerId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	consAddr, err := k.ConsensusAddressCodec().StringToBytes(req.ProviderAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid provider address")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.IsConsumerActive(ctx, consumerId) {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("unknown consumer chain: %s", consumerId))
+	}
+
+	estimate, err := k.EstimateConsumerRewards(ctx, consumerId, types.NewProviderConsAddress(consAddr))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.QueryConsumerRewardEstimateResponse{Estimate: estimate}, nil
+}
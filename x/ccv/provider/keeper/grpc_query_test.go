@@ -18,8 +18,10 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkquery "github.com/cosmos/cosmos-sdk/types/query"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
+	abci "github.com/cometbft/cometbft/abci/types"
 	"github.com/cometbft/cometbft/proto/tendermint/crypto"
 
 	cryptotestutil "github.com/cosmos/interchain-security/v7/testutil/crypto"
@@ -370,6 +372,105 @@ func TestQueryConsumerChainsValidatorHasToValidate(t *testing.T) {
 	require.Equal(t, expectedChains, res.ConsumerIds)
 }
 
+func TestQueryValidatorCCVSummary(t *testing.T) {
+	pk, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	val := createStakingValidator(ctx, mocks, 1, 1)
+	val.Tokens = math.NewInt(1000)
+	val.Commission.Rate = math.LegacyNewDecWithPrec(5, 2)
+	valAddr, err := sdk.ValAddressFromBech32(val.OperatorAddress)
+	require.NoError(t, err)
+	valConsAddr, _ := val.GetConsAddr()
+	providerAddr := types.NewProviderConsAddress(valConsAddr)
+	mocks.MockStakingKeeper.EXPECT().GetValidator(ctx, valAddr).Return(val, nil).AnyTimes()
+	mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(ctx, valConsAddr).Return(val, nil).AnyTimes()
+	testkeeper.SetupMocksForLastBondedValidatorsExpectation(mocks.MockStakingKeeper, 1, []stakingtypes.Validator{val}, -1) // -1 to allow the calls "AnyTimes"
+	mocks.MockSlashingKeeper.EXPECT().DowntimeJailDuration(ctx).Return(time.Second*600, nil).AnyTimes()
+	mocks.MockSlashingKeeper.EXPECT().SlashFractionDoubleSign(ctx).Return(math.LegacyNewDec(0), nil).AnyTimes()
+	mocks.MockSlashingKeeper.EXPECT().IsTombstoned(ctx, sdk.ConsAddress(valConsAddr)).Return(false).AnyTimes()
+	rewards := sdk.NewDecCoins(sdk.NewDecCoin("stake", math.NewInt(10)))
+	mocks.MockDistributionKeeper.EXPECT().GetValidatorOutstandingRewardsCoins(ctx, valAddr).Return(rewards, nil).AnyTimes()
+
+	_, err = pk.QueryValidatorCCVSummary(ctx, nil)
+	require.Error(t, err)
+	_, err = pk.QueryValidatorCCVSummary(ctx, &types.QueryValidatorCCVSummaryRequest{ValidatorAddress: "invalid"})
+	require.Error(t, err)
+
+	msgServer := keeper.NewMsgServerImpl(&pk)
+
+	// set up some launched consumer chains
+	consumerIds := make([]string, 3)
+	for i := range consumerIds {
+		revisionNumber := i + 1
+		chainID := "consumer-" + strconv.Itoa(revisionNumber)
+		initializationParameters := types.DefaultConsumerInitializationParameters()
+		initializationParameters.InitialHeight.RevisionNumber = uint64(revisionNumber)
+		resp, err := msgServer.CreateConsumer(ctx, &types.MsgCreateConsumer{
+			ChainId:                  chainID,
+			Metadata:                 types.ConsumerMetadata{Name: chainID},
+			InitializationParameters: &initializationParameters,
+		})
+		require.NoError(t, err)
+		pk.SetConsumerPhase(ctx, resp.ConsumerId, types.CONSUMER_PHASE_LAUNCHED)
+		consumerIds[i] = resp.ConsumerId
+	}
+
+	// `providerAddr` is a consumer validator with an assigned key on the first consumer chain
+	consumerKey := cryptotestutil.NewCryptoIdentityFromIntSeed(2).TMProtoCryptoPublicKey()
+	pk.SetValidatorConsumerPubKey(ctx, consumerIds[0], providerAddr, consumerKey)
+	err = pk.SetConsumerValidator(ctx, consumerIds[0], types.ConsensusValidator{
+		ProviderConsAddr: providerAddr.ToSdkConsAddr(),
+		Power:            1,
+		PublicKey:        &consumerKey,
+	})
+	require.NoError(t, err)
+	// and opted in on the third consumer chain with a custom commission rate
+	pk.SetOptedIn(ctx, consumerIds[2], providerAddr)
+	err = pk.SetConsumerCommissionRate(ctx, consumerIds[2], providerAddr, math.LegacyNewDecWithPrec(1, 1))
+	require.NoError(t, err)
+
+	params := pk.GetParams(ctx)
+	params.MaxProviderConsensusValidators = 3
+	pk.SetParams(ctx, params)
+
+	pk.SetSlashLog(ctx, providerAddr)
+
+	res, err := pk.QueryValidatorCCVSummary(ctx, &types.QueryValidatorCCVSummaryRequest{ValidatorAddress: val.OperatorAddress})
+	require.NoError(t, err)
+
+	consumerAddr, err := ccvtypes.TMCryptoPublicKeyToConsAddr(consumerKey)
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryValidatorCCVSummaryResponse{
+		ValidatorAddress:         val.OperatorAddress,
+		ProviderAddress:          providerAddr.String(),
+		Status:                   stakingtypes.Bonded,
+		Tokens:                   math.NewInt(1000),
+		Power:                    1,
+		ConsumerDoubleSignLogged: true,
+		OutstandingRewards:       rewards,
+		Consumers: []types.ValidatorConsumerSummary{
+			{
+				ConsumerId:        consumerIds[0],
+				ChainId:           "consumer-1",
+				Phase:             types.CONSUMER_PHASE_LAUNCHED,
+				ConsumerValidator: true,
+				HasToValidate:     true,
+				ConsumerAddress:   consumerAddr.String(),
+				CommissionRate:    math.LegacyNewDecWithPrec(5, 2),
+			},
+			{
+				ConsumerId:     consumerIds[2],
+				ChainId:        "consumer-3",
+				Phase:          types.CONSUMER_PHASE_LAUNCHED,
+				OptedIn:        true,
+				HasToValidate:  true,
+				CommissionRate: math.LegacyNewDecWithPrec(1, 1),
+			},
+		},
+	}, res)
+}
+
 func TestQueryValidatorConsumerCommissionRate(t *testing.T) {
 	consumerId := "0"
 
@@ -609,6 +710,7 @@ func TestQueryConsumerChain(t *testing.T) {
 		PowerShapingParams:   &types.PowerShapingParameters{},
 		InfractionParameters: getTestInfractionParameters(),
 		ClientId:             clientId,
+		ThrottlingParameters: &types.ThrottlingParameters{},
 	}
 
 	// expect no error when neither the consumer init and power shaping params are set
@@ -931,3 +1033,337 @@ func TestQueryConsumerGenesisTime(t *testing.T) {
 		})
 	}
 }
+
+func TestQueryLaunchCapacity(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	params := types.DefaultParams()
+	providerKeeper.SetParams(ctx, params)
+
+	_, err := providerKeeper.QueryLaunchCapacity(ctx, nil)
+	require.Error(t, err)
+
+	for i := 0; i < 3; i++ {
+		consumerId := providerKeeper.FetchAndIncrementConsumerId(ctx)
+		providerKeeper.SetConsumerPhase(ctx, consumerId, types.CONSUMER_PHASE_LAUNCHED)
+	}
+	providerKeeper.SetConsumerPhase(ctx, providerKeeper.FetchAndIncrementConsumerId(ctx), types.CONSUMER_PHASE_STOPPED)
+
+	// the number of launched consumer chains is not capped
+	res, err := providerKeeper.QueryLaunchCapacity(ctx, &types.QueryLaunchCapacityRequest{})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryLaunchCapacityResponse{MaxLaunchedConsumers: 0, LaunchedConsumers: 3, RemainingCapacity: 0}, res)
+
+	params.MaxLaunchedConsumers = 5
+	providerKeeper.SetParams(ctx, params)
+	res, err = providerKeeper.QueryLaunchCapacity(ctx, &types.QueryLaunchCapacityRequest{})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryLaunchCapacityResponse{MaxLaunchedConsumers: 5, LaunchedConsumers: 3, RemainingCapacity: 2}, res)
+
+	// the cap was lowered below the number of launched consumer chains
+	params.MaxLaunchedConsumers = 2
+	providerKeeper.SetParams(ctx, params)
+	res, err = providerKeeper.QueryLaunchCapacity(ctx, &types.QueryLaunchCapacityRequest{})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryLaunchCapacityResponse{MaxLaunchedConsumers: 2, LaunchedConsumers: 3, RemainingCapacity: 0}, res)
+}
+
+func TestQueryModuleAccountsSummary(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	_, err := providerKeeper.QueryModuleAccountsSummary(ctx, nil)
+	require.Error(t, err)
+
+	poolAcc := authtypes.NewEmptyModuleAccount(types.ConsumerRewardsPool)
+	poolBalance := sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(10)))
+	mocks.MockAccountKeeper.EXPECT().GetModuleAccount(ctx, types.ConsumerRewardsPool).Return(poolAcc).AnyTimes()
+	mocks.MockBankKeeper.EXPECT().GetAllBalances(ctx, poolAcc.GetAddress()).Return(poolBalance).AnyTimes()
+
+	providerKeeper.AppendFundFlowRecord(ctx, types.FUND_FLOW_TYPE_CONSUMER_REWARDS, types.ConsumerRewardsPool, "0",
+		sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(100))))
+	providerKeeper.AppendFundFlowRecord(ctx, types.FUND_FLOW_TYPE_VALIDATOR_REWARDS, types.ConsumerRewardsPool, "0",
+		sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(80))))
+	providerKeeper.AppendFundFlowRecord(ctx, types.FUND_FLOW_TYPE_COMMUNITY_POOL, types.ConsumerRewardsPool, "0",
+		sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(10))))
+
+	res, err := providerKeeper.QueryModuleAccountsSummary(ctx, &types.QueryModuleAccountsSummaryRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.ModuleAccountBalance{
+		{Name: types.ConsumerRewardsPool, Address: poolAcc.GetAddress().String(), Balance: poolBalance},
+	}, res.Accounts)
+	require.Len(t, res.Flows, 3)
+	require.Equal(t, types.FUND_FLOW_TYPE_COMMUNITY_POOL, res.Flows[0].Type)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(100))), res.TotalInflow)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(90))), res.TotalOutflow)
+
+	// only the most recent outflow is summed
+	res, err = providerKeeper.QueryModuleAccountsSummary(ctx, &types.QueryModuleAccountsSummaryRequest{Limit: 1})
+	require.NoError(t, err)
+	require.Len(t, res.Flows, 1)
+	require.True(t, res.TotalInflow.IsZero())
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("stake", math.NewInt(10))), res.TotalOutflow)
+}
+
+func TestQueryConsumerHashCommitment(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	consumerId := "0"
+
+	_, err := providerKeeper.QueryConsumerHashCommitment(ctx, nil)
+	require.Error(t, err)
+
+	// no initialization parameters
+	_, err = providerKeeper.QueryConsumerHashCommitment(ctx, &types.QueryConsumerHashCommitmentRequest{ConsumerId: consumerId})
+	require.Error(t, err)
+
+	providerKeeper.SetConsumerChainId(ctx, consumerId, "chain")
+	initializationParameters := testkeeper.GetTestInitializationParameters(types.InitParamsProfileMainnetConservative)
+	err = providerKeeper.SetConsumerInitializationParameters(ctx, consumerId, initializationParameters)
+	require.NoError(t, err)
+	err = providerKeeper.RegisterConsumerHashes(ctx, consumerId,
+		initializationParameters.GenesisHash, initializationParameters.BinaryHash)
+	require.NoError(t, err)
+	providerKeeper.SetConsumerPhase(ctx, consumerId, types.CONSUMER_PHASE_REGISTERED)
+
+	res, err := providerKeeper.QueryConsumerHashCommitment(ctx, &types.QueryConsumerHashCommitmentRequest{ConsumerId: consumerId})
+	require.NoError(t, err)
+	require.Equal(t, initializationParameters.GenesisHash, res.GenesisHash)
+	require.Equal(t, initializationParameters.BinaryHash, res.BinaryHash)
+	require.False(t, res.UpdatedSinceRegistration)
+	require.False(t, res.Locked)
+
+	updatedInitializationParameters := initializationParameters
+	updatedInitializationParameters.GenesisHash = []byte("new_genesis_hash")
+	err = providerKeeper.UpdateConsumerHashCommitment(ctx, consumerId, initializationParameters, updatedInitializationParameters)
+	require.NoError(t, err)
+	err = providerKeeper.SetConsumerInitializationParameters(ctx, consumerId, updatedInitializationParameters)
+	require.NoError(t, err)
+	providerKeeper.SetConsumerPhase(ctx, consumerId, types.CONSUMER_PHASE_LAUNCHED)
+
+	res, err = providerKeeper.QueryConsumerHashCommitment(ctx, &types.QueryConsumerHashCommitmentRequest{ConsumerId: consumerId})
+	require.NoError(t, err)
+	require.Equal(t, []byte("new_genesis_hash"), res.GenesisHash)
+	require.Equal(t, initializationParameters.GenesisHash, res.Commitment.RegisteredGenesisHash)
+	require.True(t, res.UpdatedSinceRegistration)
+	require.True(t, res.Locked)
+}
+
+func TestQueryConsumerCreatorAllowlist(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	_, err := providerKeeper.QueryConsumerCreatorAllowlist(ctx, nil)
+	require.Error(t, err)
+
+	res, err := providerKeeper.QueryConsumerCreatorAllowlist(ctx, &types.QueryConsumerCreatorAllowlistRequest{})
+	require.NoError(t, err)
+	require.Empty(t, res.Addresses)
+
+	creator := sdk.AccAddress([]byte("creator"))
+	providerKeeper.SetConsumerCreatorAllowlisted(ctx, creator)
+	res, err = providerKeeper.QueryConsumerCreatorAllowlist(ctx, &types.QueryConsumerCreatorAllowlistRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []string{creator.String()}, res.Addresses)
+}
+
+func TestQueryConsumerPacketStats(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	consumerId := "0"
+
+	_, err := providerKeeper.QueryConsumerPacketStats(ctx, nil)
+	require.Error(t, err)
+
+	// unknown consumer chain
+	_, err = providerKeeper.QueryConsumerPacketStats(ctx, &types.QueryConsumerPacketStatsRequest{ConsumerId: consumerId})
+	require.Error(t, err)
+
+	providerKeeper.SetConsumerChainId(ctx, consumerId, "chain")
+	res, err := providerKeeper.QueryConsumerPacketStats(ctx, &types.QueryConsumerPacketStatsRequest{ConsumerId: consumerId})
+	require.NoError(t, err)
+	require.Equal(t, types.ConsumerPacketStats{}, res.Stats)
+
+	stats := types.ConsumerPacketStats{VscPacketsSent: 3, ErrorAcksReceived: 1}
+	providerKeeper.SetConsumerPacketStats(ctx, consumerId, stats)
+	res, err = providerKeeper.QueryConsumerPacketStats(ctx, &types.QueryConsumerPacketStatsRequest{ConsumerId: consumerId})
+	require.NoError(t, err)
+	require.Equal(t, stats, res.Stats)
+}
+
+// TestQueryLastVSCPacket tests that the last VSC packet sent to a consumer chain is returned
+// with its validators resolved through the key assignments and the staking module
+func TestQueryLastVSCPacket(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	consumerId := "0"
+
+	_, err := providerKeeper.QueryLastVSCPacket(ctx, nil)
+	require.Error(t, err)
+
+	// no VSC packet was sent
+	_, err = providerKeeper.QueryLastVSCPacket(ctx, &types.QueryLastVSCPacketRequest{ConsumerId: consumerId})
+	require.Error(t, err)
+
+	// the first validator assigned a consumer key, the second one validates with its provider key
+	assignedVal := cryptotestutil.NewCryptoIdentityFromIntSeed(1)
+	consumerKey := cryptotestutil.NewCryptoIdentityFromIntSeed(2)
+	otherVal := cryptotestutil.NewCryptoIdentityFromIntSeed(3)
+	providerKeeper.SetValidatorByConsumerAddr(ctx, consumerId, consumerKey.ConsumerConsAddress(), assignedVal.ProviderConsAddress())
+
+	assignedStakingVal := assignedVal.SDKStakingValidator()
+	assignedStakingVal.Description.Moniker = "assigned"
+	mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(ctx, assignedVal.SDKValConsAddress()).
+		Return(assignedStakingVal, nil).AnyTimes()
+	mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(ctx, otherVal.SDKValConsAddress()).
+		Return(stakingtypes.Validator{}, stakingtypes.ErrNoValidatorFound).AnyTimes()
+
+	packet := types.SentVSCPacket{
+		ChannelId: "channel-0",
+		Sequence:  4,
+		SendTime:  time.Unix(1000, 0).UTC(),
+		Data: ccvtypes.NewValidatorSetChangePacketData(
+			ccvtypes.NewValsetDiff([]abci.ValidatorUpdate{
+				{PubKey: consumerKey.TMProtoCryptoPublicKey(), Power: 10},
+				{PubKey: otherVal.TMProtoCryptoPublicKey(), Power: 0},
+			}...),
+			7,
+			[]string{otherVal.SDKValConsAddress().String()},
+		),
+	}
+	providerKeeper.SetLastVSCPacket(ctx, consumerId, packet)
+
+	res, err := providerKeeper.QueryLastVSCPacket(ctx, &types.QueryLastVSCPacketRequest{ConsumerId: consumerId})
+	require.NoError(t, err)
+	require.Equal(t, packet, res.Packet)
+	require.Equal(t, []types.VSCPacketValidator{
+		{
+			ConsumerConsAddress: consumerKey.SDKValConsAddress().String(),
+			ProviderConsAddress: assignedVal.SDKValConsAddress().String(),
+			Moniker:             "assigned",
+			Power:               10,
+		},
+		{
+			ConsumerConsAddress: otherVal.SDKValConsAddress().String(),
+			ProviderConsAddress: otherVal.SDKValConsAddress().String(),
+			Power:               0,
+		},
+	}, res.ValidatorUpdates)
+	require.Equal(t, []types.VSCPacketValidator{
+		{
+			ConsumerConsAddress: otherVal.SDKValConsAddress().String(),
+			ProviderConsAddress: otherVal.SDKValConsAddress().String(),
+		},
+	}, res.SlashAcks)
+}
+
+// TestQueryConsumerValidatorPowerProjection tests that the projected validator set of a consumer chain
+// is returned without committing any state
+func TestQueryConsumerValidatorPowerProjection(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, types.DefaultParams())
+
+	consumerId := "0"
+
+	_, err := providerKeeper.QueryConsumerValidatorPowerProjection(ctx, nil)
+	require.Error(t, err)
+
+	// the consumer chain is not launched
+	providerKeeper.SetConsumerPhase(ctx, consumerId, types.CONSUMER_PHASE_INITIALIZED)
+	_, err = providerKeeper.QueryConsumerValidatorPowerProjection(ctx, &types.QueryConsumerValidatorPowerProjectionRequest{ConsumerId: consumerId})
+	require.Error(t, err)
+	providerKeeper.SetConsumerPhase(ctx, consumerId, types.CONSUMER_PHASE_LAUNCHED)
+
+	// validators A, B, and C with powers 1, 2, and 3 are opted in
+	validators, consAddrs := createStakingValidatorsAndMocks(ctx, mocks, 1, 2, 3)
+	for i, val := range validators {
+		// the projection is computed on a cache context
+		mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(gomock.Any(), consAddrs[i].Address).Return(val, nil).AnyTimes()
+		valAddr, err := sdk.ValAddressFromBech32(val.OperatorAddress)
+		require.NoError(t, err)
+		mocks.MockStakingKeeper.EXPECT().GetLastValidatorPower(gomock.Any(), valAddr).Return(int64(i+1), nil).AnyTimes()
+		providerKeeper.SetOptedIn(ctx, consumerId, consAddrs[i])
+	}
+	testkeeper.SetupMocksForLastBondedValidatorsExpectation(mocks.MockStakingKeeper, 180, validators, -1)
+
+	// validators A and C validate the chain, and validator C is denylisted
+	currentValSet := []types.ConsensusValidator{}
+	for _, i := range []int{0, 2} {
+		val, err := providerKeeper.CreateConsumerValidator(ctx, consumerId, validators[i])
+		require.NoError(t, err)
+		currentValSet = append(currentValSet, val)
+	}
+	require.NoError(t, providerKeeper.SetConsumerValSet(ctx, consumerId, currentValSet))
+	err = providerKeeper.SetConsumerPowerShapingParameters(ctx, consumerId, types.PowerShapingParameters{
+		Denylist: []string{consAddrs[2].ToSdkConsAddr().String()},
+	})
+	require.NoError(t, err)
+
+	res, err := providerKeeper.QueryConsumerValidatorPowerProjection(ctx, &types.QueryConsumerValidatorPowerProjectionRequest{ConsumerId: consumerId})
+	require.NoError(t, err)
+
+	// validator B joins and validator C leaves
+	expectedValidators := map[string]int64{
+		consAddrs[0].ToSdkConsAddr().String(): 1,
+		consAddrs[1].ToSdkConsAddr().String(): 2,
+	}
+	require.Len(t, res.Validators, len(expectedValidators))
+	for _, val := range res.Validators {
+		require.Equal(t, expectedValidators[val.ProviderAddress], val.Power)
+		if val.ProviderAddress == consAddrs[0].ToSdkConsAddr().String() {
+			require.Equal(t, int64(1), val.CurrentPower)
+		} else {
+			require.Zero(t, val.CurrentPower)
+		}
+	}
+	require.Equal(t, []string{consAddrs[2].ToSdkConsAddr().String()}, res.RemovedProviderAddresses)
+	require.NotEmpty(t, res.Pipeline.Steps)
+
+	// no state is committed
+	consumerValSet, err := providerKeeper.GetConsumerValSet(ctx, consumerId)
+	require.NoError(t, err)
+	require.Len(t, consumerValSet, 2)
+	_, found := providerKeeper.GetConsumerPowerShapingPipeline(ctx, consumerId)
+	require.False(t, found)
+}
+
+func TestQueryModuleVersionInfo(t *testing.T) {
+	keeperParams := testkeeper.NewInMemKeeperParams(t)
+	keeperParams.ProviderOptions = []keeper.Option{keeper.WithFeatureFlags("custom-feature")}
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, keeperParams)
+	defer ctrl.Finish()
+
+	params := types.DefaultParams()
+	params.MaxProviderConsensusValidators = 180
+	params.ImmediateValidatorUpdates = true
+	providerKeeper.SetParams(ctx, params)
+
+	// the provider chain has inactive validators if it has more bonded than consensus validators
+	mocks.MockStakingKeeper.EXPECT().MaxValidators(gomock.Any()).Return(uint32(200), nil)
+
+	res, err := providerKeeper.QueryModuleVersionInfo(ctx, &types.QueryModuleVersionInfoRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(types.ConsensusVersion), res.VersionInfo.ConsensusVersion)
+	require.Equal(t, ccvtypes.Version, res.VersionInfo.CcvVersion)
+	require.NotEmpty(t, res.VersionInfo.IcsVersion)
+	require.Equal(t, []string{
+		keeper.FeatureConsumerDoubleVotePackets,
+		"custom-feature",
+		keeper.FeatureImmediateValidatorUpdates,
+		keeper.FeatureInactiveValidators,
+		keeper.FeaturePartialSetSecurity,
+		keeper.FeaturePerConsumerSlashMeters,
+		keeper.FeaturePermissionlessConsumers,
+	}, res.VersionInfo.EnabledFeatures)
+
+	// without inactive validators
+	mocks.MockStakingKeeper.EXPECT().MaxValidators(gomock.Any()).Return(uint32(180), nil)
+
+	res, err = providerKeeper.QueryModuleVersionInfo(ctx, &types.QueryModuleVersionInfoRequest{})
+	require.NoError(t, err)
+	require.NotContains(t, res.VersionInfo.EnabledFeatures, keeper.FeatureInactiveValidators)
+}
@@ -0,0 +1,101 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// GetConsumerHashCommitment returns the record of the genesis and binary hashes of the consumer chain with `consumerId`
+func (k Keeper) GetConsumerHashCommitment(ctx sdk.Context, consumerId string) (types.ConsumerHashCommitment, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ConsumerIdToHashCommitmentKey(consumerId))
+	if bz == nil {
+		return types.ConsumerHashCommitment{}, false
+	}
+	var commitment types.ConsumerHashCommitment
+	if err := commitment.Unmarshal(bz); err != nil {
+		// An error here would indicate something is very wrong,
+		// the commitment is assumed to be correctly serialized in SetConsumerHashCommitment.
+		panic(fmt.Errorf("failed to unmarshal hash commitment for consumer id (%s): %w", consumerId, err))
+	}
+	return commitment, true
+}
+
+// SetConsumerHashCommitment sets the record of the genesis and binary hashes of the consumer chain with `consumerId`
+func (k Keeper) SetConsumerHashCommitment(ctx sdk.Context, consumerId string, commitment types.ConsumerHashCommitment) error {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := commitment.Marshal()
+	if err != nil {
+		return fmt.Errorf("failed to marshal hash commitment (%+v) for consumer id (%s): %w", commitment, consumerId, err)
+	}
+	store.Set(types.ConsumerIdToHashCommitmentKey(consumerId), bz)
+	return nil
+}
+
+// RegisterConsumerHashes records the genesis and binary hashes of a newly registered consumer chain
+func (k Keeper) RegisterConsumerHashes(ctx sdk.Context, consumerId string, genesisHash, binaryHash []byte) error {
+	return k.SetConsumerHashCommitment(ctx, consumerId, types.ConsumerHashCommitment{
+		RegisteredGenesisHash: genesisHash,
+		RegisteredBinaryHash:  binaryHash,
+	})
+}
+
+// UpdateConsumerHashCommitment records an update of the genesis or binary hash of the consumer chain with `consumerId`.
+// Updating any of the hashes invalidates a previous attestation by the owner.
+func (k Keeper) UpdateConsumerHashCommitment(
+	ctx sdk.Context,
+	consumerId string,
+	previous, current types.ConsumerInitializationParameters,
+) error {
+	if bytes.Equal(previous.GenesisHash, current.GenesisHash) && bytes.Equal(previous.BinaryHash, current.BinaryHash) {
+		return nil
+	}
+
+	// consumer chains registered before hash commitments were recorded do not have a record
+	commitment, _ := k.GetConsumerHashCommitment(ctx, consumerId)
+	commitment.UpdateCount++
+	commitment.LastUpdateHeight = ctx.BlockHeight()
+	commitment.Attested = false
+	commitment.AttestationHeight = 0
+
+	return k.SetConsumerHashCommitment(ctx, consumerId, commitment)
+}
+
+// AttestConsumerHashes records that the owner of the consumer chain with `consumerId` attested
+// the given genesis and binary hashes as final. The hashes must match the ones in the initialization
+// parameters of the chain and are locked once the chain is launched.
+func (k Keeper) AttestConsumerHashes(ctx sdk.Context, consumerId string, genesisHash, binaryHash []byte) error {
+	if !k.IsConsumerPrelaunched(ctx, consumerId) {
+		return errorsmod.Wrapf(types.ErrInvalidPhase,
+			"cannot attest the hashes of a chain that is not in the registered or initialized phase: %s",
+			k.GetConsumerPhase(ctx, consumerId))
+	}
+
+	initializationParameters, err := k.GetConsumerInitializationParameters(ctx, consumerId)
+	if err != nil {
+		return errorsmod.Wrapf(ccv.ErrInvalidConsumerState,
+			"getting initialization parameters, consumerId(%s): %s", consumerId, err.Error())
+	}
+
+	if !bytes.Equal(genesisHash, initializationParameters.GenesisHash) {
+		return errorsmod.Wrapf(types.ErrConsumerHashMismatch,
+			"genesis hash: expected %X, got %X", initializationParameters.GenesisHash, genesisHash)
+	}
+	if !bytes.Equal(binaryHash, initializationParameters.BinaryHash) {
+		return errorsmod.Wrapf(types.ErrConsumerHashMismatch,
+			"binary hash: expected %X, got %X", initializationParameters.BinaryHash, binaryHash)
+	}
+
+	commitment, _ := k.GetConsumerHashCommitment(ctx, consumerId)
+	commitment.Attested = true
+	commitment.AttestationHeight = ctx.BlockHeight()
+
+	return k.SetConsumerHashCommitment(ctx, consumerId, commitment)
+}
@@ -78,7 +78,11 @@ func (h Hooks) AfterDelegationModified(_ context.Context, _ sdk.AccAddress, _ sd
 	return nil
 }
 
-func (h Hooks) BeforeValidatorSlashed(_ context.Context, _ sdk.ValAddress, _ math.LegacyDec) error {
+func (h Hooks) BeforeValidatorSlashed(goCtx context.Context, _ sdk.ValAddress, _ math.LegacyDec) error {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// the slashing decreases the power of the validator
+	h.k.requestImmediateValidatorUpdates(ctx)
 	return nil
 }
 
@@ -86,11 +90,51 @@ func (h Hooks) BeforeValidatorModified(_ context.Context, _ sdk.ValAddress) erro
 	return nil
 }
 
-func (h Hooks) AfterValidatorBonded(_ context.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
+func (h Hooks) AfterValidatorBonded(goCtx context.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// the validator joins the provider validator set, e.g., after being unjailed
+	h.k.requestImmediateValidatorUpdates(ctx)
 	return nil
 }
 
-func (h Hooks) AfterValidatorBeginUnbonding(_ context.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
+func (h Hooks) AfterValidatorBeginUnbonding(goCtx context.Context, valConsAddr sdk.ConsAddress, _ sdk.ValAddress) error {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if h.k.GetImmediateDowntimeJailing(ctx) && !h.k.slashingKeeper.IsTombstoned(ctx, valConsAddr) {
+		jailed, err := h.k.stakingKeeper.IsValidatorJailed(ctx, valConsAddr)
+		if err != nil {
+			return err
+		}
+		if jailed {
+			// the validator is jailed for downtime and is removed from the consumer chains at the end of the block
+			h.k.SetDowntimeJailedValidator(ctx, providertypes.NewProviderConsAddress(valConsAddr))
+		}
+	}
+
+	if !h.k.GetImmediateValidatorUpdates(ctx) {
+		return nil
+	}
+
+	// a tombstoned validator can never validate again and hence it is opted out from all the consumer chains
+	if h.k.slashingKeeper.IsTombstoned(ctx, valConsAddr) {
+		providerAddr := providertypes.NewProviderConsAddress(valConsAddr)
+		for _, consumerId := range h.k.GetAllActiveConsumerIds(ctx) {
+			if h.k.IsOptedIn(ctx, consumerId, providerAddr) {
+				// the opt-out takes effect immediately
+				h.k.appendValidatorOptInRecord(ctx, providerAddr, providertypes.ValidatorOptInRecord{
+					ConsumerId:       consumerId,
+					Height:           ctx.BlockHeight(),
+					Time:             ctx.BlockTime(),
+					EpochStartHeight: ctx.BlockHeight(),
+				})
+			}
+			h.k.DeleteOptedIn(ctx, consumerId, providerAddr)
+		}
+	}
+
+	// the validator leaves the provider validator set, e.g., after being jailed or tombstoned
+	h.k.requestImmediateValidatorUpdates(ctx)
 	return nil
 }
 
@@ -102,6 +146,16 @@ func (h Hooks) BeforeTokenizeShareRecordRemoved(_ context.Context, _ uint64) err
 	return nil
 }
 
+// requestImmediateValidatorUpdates requests, if the ImmediateValidatorUpdates param is set,
+// that the changes of the provider validator set are sent to the consumer chains
+// at the end of the current block instead of at the end of the epoch
+func (k Keeper) requestImmediateValidatorUpdates(ctx sdk.Context) {
+	if !k.GetImmediateValidatorUpdates(ctx) {
+		return
+	}
+	k.SetImmediateValidatorUpdates(ctx)
+}
+
 //
 // gov hooks
 //
@@ -125,3 +179,59 @@ func (h Hooks) AfterProposalVote(ctx context.Context, proposalID uint64, voterAd
 func (h Hooks) AfterProposalFailedMinDeposit(ctx context.Context, proposalID uint64) error {
 	return nil
 }
+
+//
+// provider hooks
+//
+
+// afterConsumerLaunched calls the AfterConsumerLaunched provider hook, if set
+func (k Keeper) afterConsumerLaunched(ctx sdk.Context, consumerId string) {
+	if k.hooks == nil {
+		return
+	}
+	// a failing hook does not leave partial state behind
+	if err := k.executeAtomically(ctx, func(ctx sdk.Context) error {
+		return k.hooks.AfterConsumerLaunched(ctx, consumerId)
+	}); err != nil {
+		k.Logger(ctx).Error("AfterConsumerLaunched hook failed", "consumerId", consumerId, "error", err.Error())
+	}
+}
+
+// afterConsumerRemoved calls the AfterConsumerRemoved provider hook, if set
+func (k Keeper) afterConsumerRemoved(ctx sdk.Context, consumerId string) {
+	if k.hooks == nil {
+		return
+	}
+	// a failing hook does not leave partial state behind
+	if err := k.executeAtomically(ctx, func(ctx sdk.Context) error {
+		return k.hooks.AfterConsumerRemoved(ctx, consumerId)
+	}); err != nil {
+		k.Logger(ctx).Error("AfterConsumerRemoved hook failed", "consumerId", consumerId, "error", err.Error())
+	}
+}
+
+// afterValidatorOptedIn calls the AfterValidatorOptedIn provider hook, if set
+func (k Keeper) afterValidatorOptedIn(ctx sdk.Context, consumerId string, providerAddr providertypes.ProviderConsAddress) error {
+	if k.hooks == nil {
+		return nil
+	}
+	return k.hooks.AfterValidatorOptedIn(ctx, consumerId, providerAddr)
+}
+
+// afterValidatorOptedOut calls the AfterValidatorOptedOut provider hook, if set
+func (k Keeper) afterValidatorOptedOut(ctx sdk.Context, consumerId string, providerAddr providertypes.ProviderConsAddress) error {
+	if k.hooks == nil {
+		return nil
+	}
+	return k.hooks.AfterValidatorOptedOut(ctx, consumerId, providerAddr)
+}
+
+// afterVSCSent calls the AfterVSCSent provider hook, if set
+func (k Keeper) afterVSCSent(ctx sdk.Context, consumerId string, valsetUpdateId uint64) {
+	if k.hooks == nil {
+		return
+	}
+	if err := k.hooks.AfterVSCSent(ctx, consumerId, valsetUpdateId); err != nil {
+		k.Logger(ctx).Error("AfterVSCSent hook failed", "consumerId", consumerId, "vscID", valsetUpdateId, "error", err.Error())
+	}
+}
@@ -1,9 +1,17 @@
 package keeper_test
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -11,6 +19,7 @@ import (
 	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
 	providerkeeper "github.com/cosmos/interchain-security/v7/x/ccv/provider/keeper"
 	"github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
 )
 
 func TestValidatorConsensusKeyInUse(t *testing.T) {
@@ -90,3 +99,189 @@ func TestValidatorConsensusKeyInUse(t *testing.T) {
 		})
 	}
 }
+
+// TestImmediateValidatorUpdates tests that the staking hooks request immediate validator updates
+// only if the ImmediateValidatorUpdates param is set, and that tombstoned validators are opted out
+func TestImmediateValidatorUpdates(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	hooks := providerKeeper.Hooks()
+
+	consumerId := providerKeeper.FetchAndIncrementConsumerId(ctx)
+	providerKeeper.SetConsumerPhase(ctx, consumerId, types.CONSUMER_PHASE_LAUNCHED)
+	validator := cryptotestutil.NewCryptoIdentityFromIntSeed(0)
+	providerAddr := validator.ProviderConsAddress()
+	providerKeeper.SetOptedIn(ctx, consumerId, providerAddr)
+
+	// the hooks are no-ops if the param is not set
+	params := types.DefaultParams()
+	providerKeeper.SetParams(ctx, params)
+	require.NoError(t, hooks.BeforeValidatorSlashed(ctx, validator.SDKValOpAddress(), math.LegacyNewDecWithPrec(1, 2)))
+	require.NoError(t, hooks.AfterValidatorBonded(ctx, validator.SDKValConsAddress(), validator.SDKValOpAddress()))
+	require.NoError(t, hooks.AfterValidatorBeginUnbonding(ctx, validator.SDKValConsAddress(), validator.SDKValOpAddress()))
+	require.False(t, providerKeeper.HasImmediateValidatorUpdates(ctx))
+	require.True(t, providerKeeper.IsOptedIn(ctx, consumerId, providerAddr))
+
+	params.ImmediateValidatorUpdates = true
+	providerKeeper.SetParams(ctx, params)
+
+	require.NoError(t, hooks.BeforeValidatorSlashed(ctx, validator.SDKValOpAddress(), math.LegacyNewDecWithPrec(1, 2)))
+	require.True(t, providerKeeper.HasImmediateValidatorUpdates(ctx))
+	providerKeeper.DeleteImmediateValidatorUpdates(ctx)
+
+	require.NoError(t, hooks.AfterValidatorBonded(ctx, validator.SDKValConsAddress(), validator.SDKValOpAddress()))
+	require.True(t, providerKeeper.HasImmediateValidatorUpdates(ctx))
+	providerKeeper.DeleteImmediateValidatorUpdates(ctx)
+
+	// a jailed validator remains opted in
+	mocks.MockSlashingKeeper.EXPECT().IsTombstoned(ctx, validator.SDKValConsAddress()).Return(false)
+	require.NoError(t, hooks.AfterValidatorBeginUnbonding(ctx, validator.SDKValConsAddress(), validator.SDKValOpAddress()))
+	require.True(t, providerKeeper.HasImmediateValidatorUpdates(ctx))
+	require.True(t, providerKeeper.IsOptedIn(ctx, consumerId, providerAddr))
+	providerKeeper.DeleteImmediateValidatorUpdates(ctx)
+
+	// a tombstoned validator is opted out
+	mocks.MockSlashingKeeper.EXPECT().IsTombstoned(ctx, validator.SDKValConsAddress()).Return(true)
+	require.NoError(t, hooks.AfterValidatorBeginUnbonding(ctx, validator.SDKValConsAddress(), validator.SDKValOpAddress()))
+	require.True(t, providerKeeper.HasImmediateValidatorUpdates(ctx))
+	require.False(t, providerKeeper.IsOptedIn(ctx, consumerId, providerAddr))
+}
+
+func TestImmediateDowntimeJailing(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	hooks := providerKeeper.Hooks()
+
+	validator := cryptotestutil.NewCryptoIdentityFromIntSeed(0)
+	providerAddr := validator.ProviderConsAddress()
+
+	// the hook is a no-op if the param is not set
+	params := types.DefaultParams()
+	providerKeeper.SetParams(ctx, params)
+	require.NoError(t, hooks.AfterValidatorBeginUnbonding(ctx, validator.SDKValConsAddress(), validator.SDKValOpAddress()))
+	require.Empty(t, providerKeeper.GetAllDowntimeJailedValidators(ctx))
+
+	params.ImmediateDowntimeJailing = true
+	providerKeeper.SetParams(ctx, params)
+
+	// a validator that is unbonding without being jailed is not recorded
+	mocks.MockSlashingKeeper.EXPECT().IsTombstoned(ctx, validator.SDKValConsAddress()).Return(false)
+	mocks.MockStakingKeeper.EXPECT().IsValidatorJailed(ctx, validator.SDKValConsAddress()).Return(false, nil)
+	require.NoError(t, hooks.AfterValidatorBeginUnbonding(ctx, validator.SDKValConsAddress(), validator.SDKValOpAddress()))
+	require.Empty(t, providerKeeper.GetAllDowntimeJailedValidators(ctx))
+
+	// a tombstoned validator is not recorded
+	mocks.MockSlashingKeeper.EXPECT().IsTombstoned(ctx, validator.SDKValConsAddress()).Return(true)
+	require.NoError(t, hooks.AfterValidatorBeginUnbonding(ctx, validator.SDKValConsAddress(), validator.SDKValOpAddress()))
+	require.Empty(t, providerKeeper.GetAllDowntimeJailedValidators(ctx))
+
+	// a validator jailed for downtime is recorded
+	mocks.MockSlashingKeeper.EXPECT().IsTombstoned(ctx, validator.SDKValConsAddress()).Return(false)
+	mocks.MockStakingKeeper.EXPECT().IsValidatorJailed(ctx, validator.SDKValConsAddress()).Return(true, nil)
+	require.NoError(t, hooks.AfterValidatorBeginUnbonding(ctx, validator.SDKValConsAddress(), validator.SDKValOpAddress()))
+	require.Equal(t, []types.ProviderConsAddress{providerAddr}, providerKeeper.GetAllDowntimeJailedValidators(ctx))
+}
+
+// recordingProviderHooks records the calls of the provider hooks
+type recordingProviderHooks struct {
+	calls []string
+	err   error
+}
+
+func (h *recordingProviderHooks) AfterConsumerLaunched(_ context.Context, consumerId string) error {
+	h.calls = append(h.calls, "launched "+consumerId)
+	return h.err
+}
+
+func (h *recordingProviderHooks) AfterConsumerRemoved(_ context.Context, consumerId string) error {
+	h.calls = append(h.calls, "removed "+consumerId)
+	return h.err
+}
+
+func (h *recordingProviderHooks) AfterValidatorOptedIn(_ context.Context, consumerId string, _ types.ProviderConsAddress) error {
+	h.calls = append(h.calls, "opted in "+consumerId)
+	return h.err
+}
+
+func (h *recordingProviderHooks) AfterValidatorOptedOut(_ context.Context, consumerId string, _ types.ProviderConsAddress) error {
+	h.calls = append(h.calls, "opted out "+consumerId)
+	return h.err
+}
+
+func (h *recordingProviderHooks) AfterVSCSent(_ context.Context, consumerId string, valsetUpdateId uint64) error {
+	h.calls = append(h.calls, fmt.Sprintf("vsc %d sent %s", valsetUpdateId, consumerId))
+	return h.err
+}
+
+// TestProviderHooks tests that the provider hooks are called on the consumer lifecycle events
+func TestProviderHooks(t *testing.T) {
+	packetSender := func(_ sdk.Context, _ ccv.ChannelKeeper, _, _ string, _ []byte, _ time.Duration) (uint64, uint64, error) {
+		return 1, 100, nil
+	}
+	keeperParams := testkeeper.NewInMemKeeperParams(t)
+	keeperParams.ProviderOptions = []providerkeeper.Option{providerkeeper.WithPacketSender(packetSender)}
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, keeperParams)
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, types.DefaultParams())
+
+	hooks := &recordingProviderHooks{}
+	otherHooks := &recordingProviderHooks{}
+	providerKeeper.SetHooks(types.NewMultiProviderHooks(hooks, otherHooks))
+	require.Panics(t, func() { providerKeeper.SetHooks(hooks) })
+
+	consumerId := "0"
+	providerAddr := types.NewProviderConsAddress([]byte("providerAddr"))
+	providerKeeper.SetConsumerPhase(ctx, consumerId, types.CONSUMER_PHASE_LAUNCHED)
+	require.NoError(t, providerKeeper.SetConsumerPowerShapingParameters(ctx, consumerId, types.PowerShapingParameters{}))
+
+	require.NoError(t, providerKeeper.HandleOptIn(ctx, consumerId, providerAddr, ""))
+	require.NoError(t, providerKeeper.HandleOptOut(ctx, consumerId, providerAddr))
+
+	providerKeeper.AppendPendingVSCPackets(ctx, consumerId, ccv.ValidatorSetChangePacketData{ValsetUpdateId: 7})
+	require.NoError(t, providerKeeper.SendVSCPacketsToChain(ctx, consumerId, "channelId"))
+
+	mocks.MockChannelKeeper.EXPECT().GetChannel(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	providerKeeper.SetConsumerPhase(ctx, consumerId, types.CONSUMER_PHASE_STOPPED)
+	require.NoError(t, providerKeeper.DeleteConsumerChain(ctx, consumerId))
+
+	expectedCalls := []string{"opted in 0", "opted out 0", "vsc 7 sent 0", "removed 0"}
+	require.Equal(t, expectedCalls, hooks.calls)
+	require.Equal(t, expectedCalls, otherHooks.calls)
+
+	// the errors of the hooks called in message handlers are returned
+	hooks.err = errors.New("hook failed")
+	providerKeeper.SetConsumerPhase(ctx, "1", types.CONSUMER_PHASE_LAUNCHED)
+	require.ErrorContains(t, providerKeeper.HandleOptIn(ctx, "1", providerAddr, ""), "hook failed")
+}
+
+// storeWritingProviderHooks writes to the provider store before returning the error of its AfterConsumerRemoved hook
+type storeWritingProviderHooks struct {
+	recordingProviderHooks
+	storeKey *storetypes.KVStoreKey
+}
+
+func (h *storeWritingProviderHooks) AfterConsumerRemoved(ctx context.Context, consumerId string) error {
+	sdk.UnwrapSDKContext(ctx).KVStore(h.storeKey).Set([]byte("removed "+consumerId), []byte{1})
+	return h.recordingProviderHooks.AfterConsumerRemoved(ctx, consumerId)
+}
+
+// TestProviderHooksAtomicity tests that a failing provider hook leaves no partial state,
+// without preventing the deletion of the consumer chain
+func TestProviderHooksAtomicity(t *testing.T) {
+	keeperParams := testkeeper.NewInMemKeeperParams(t)
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, keeperParams)
+	defer ctrl.Finish()
+
+	hooks := &storeWritingProviderHooks{storeKey: keeperParams.StoreKey}
+	providerKeeper.SetHooks(hooks)
+
+	providerKeeper.SetConsumerPhase(ctx, "0", types.CONSUMER_PHASE_STOPPED)
+	require.NoError(t, providerKeeper.DeleteConsumerChain(ctx, "0"))
+	require.True(t, ctx.KVStore(keeperParams.StoreKey).Has([]byte("removed 0")))
+
+	hooks.err = errors.New("hook failed")
+	providerKeeper.SetConsumerPhase(ctx, "1", types.CONSUMER_PHASE_STOPPED)
+	require.NoError(t, providerKeeper.DeleteConsumerChain(ctx, "1"))
+	require.Equal(t, types.CONSUMER_PHASE_DELETED, providerKeeper.GetConsumerPhase(ctx, "1"))
+	require.False(t, ctx.KVStore(keeperParams.StoreKey).Has([]byte("removed 1")))
+}
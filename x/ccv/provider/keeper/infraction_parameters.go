@@ -105,40 +105,35 @@ func (k Keeper) RemoveFromInfractionUpdateSchedule(ctx sdk.Context, consumerId s
 	return k.removeConsumerIdFromTime(ctx, consumerId, types.InfractionScheduledTimeToConsumerIdsKey, updateTime)
 }
 
-// GetConsumerInfractionUpdateTime gets the time when the consumer's infraction parameters are scheduled for update
+// GetConsumerInfractionUpdateTime returns the time at which the queued infraction parameters of this consumer id are applied
 func (k Keeper) GetConsumerInfractionUpdateTime(ctx sdk.Context, consumerId string) (time.Time, error) {
 	store := ctx.KVStore(k.storeKey)
+	buf := store.Get(types.ConsumerIdToInfractionUpdateTimeKey(consumerId))
+	if buf == nil {
+		return time.Time{}, fmt.Errorf("failed to retrieve infraction update time for consumer id (%s)", consumerId)
+	}
+	var updateTime time.Time
+	if err := updateTime.UnmarshalBinary(buf); err != nil {
+		return updateTime, fmt.Errorf("failed to unmarshal infraction update time for consumer id (%s): %w", consumerId, err)
+	}
+	return updateTime, nil
+}
 
-	iterator := storetypes.KVStorePrefixIterator(store, []byte{types.InfractionScheduledTimeToConsumerIdsKeyPrefix()})
-	defer iterator.Close()
-
-	for ; iterator.Valid(); iterator.Next() {
-		// parse the scheduled time from the key
-		ts, err := types.ParseTime(types.InfractionScheduledTimeToConsumerIdsKeyPrefix(), iterator.Key())
-		if err != nil {
-			return time.Time{}, fmt.Errorf("failed to parse scheduled time: %w", err)
-		}
-
-		// get consumer ids associated with the scheduled time
-		consumerIds, err := k.GetFromInfractionUpdateSchedule(ctx, ts)
-		if err != nil {
-			return time.Time{}, fmt.Errorf("failed to get record from time queue: %w", err)
-		}
-
-		// check if the target consumer id is in the list
-		for _, id := range consumerIds.Ids {
-			if id == consumerId {
-				// remove the consumer id from the schedule and return
-				if err := k.RemoveFromInfractionUpdateSchedule(ctx, consumerId, ts); err != nil {
-					return time.Time{}, fmt.Errorf("failed to remove consumer id from time queue: %w", err)
-				}
-				return ts, nil
-			}
-		}
+// SetConsumerInfractionUpdateTime sets the time at which the queued infraction parameters of this consumer id are applied
+func (k Keeper) SetConsumerInfractionUpdateTime(ctx sdk.Context, consumerId string, updateTime time.Time) error {
+	store := ctx.KVStore(k.storeKey)
+	buf, err := updateTime.MarshalBinary()
+	if err != nil {
+		return fmt.Errorf("failed to marshal infraction update time (%+v) for consumer id (%s): %w", updateTime, consumerId, err)
 	}
+	store.Set(types.ConsumerIdToInfractionUpdateTimeKey(consumerId), buf)
+	return nil
+}
 
-	// consumer id was not found
-	return time.Time{}, fmt.Errorf("consumer id %s not found in scheduled time queue", consumerId)
+// DeleteConsumerInfractionUpdateTime deletes the time at which the queued infraction parameters of this consumer id are applied
+func (k Keeper) DeleteConsumerInfractionUpdateTime(ctx sdk.Context, consumerId string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ConsumerIdToInfractionUpdateTimeKey(consumerId))
 }
 
 // DeleteAllConsumersFromInfractionUpdateSchedule deletes all consumer ids that should update infraction parameter at this specific update time
@@ -147,21 +142,27 @@ func (k Keeper) DeleteAllConsumersFromInfractionUpdateSchedule(ctx sdk.Context,
 	store.Delete(types.InfractionScheduledTimeToConsumerIdsKey(updateTime))
 }
 
-// RemoveConsumerInfractionQueuedData consumer intraction data from both queued parameters and time queue stores
+// RemoveConsumerInfractionQueuedData removes the queued infraction parameters of this consumer id,
+// together with their update time and their entry in the time queue
 func (k Keeper) RemoveConsumerInfractionQueuedData(ctx sdk.Context, consumerId string) {
-	if k.HasQueuedInfractionParameters(ctx, consumerId) {
-		// delete queued parameters
-		k.DeleteQueuedInfractionParameters(ctx, consumerId)
+	if !k.HasQueuedInfractionParameters(ctx, consumerId) {
+		return
+	}
+	// delete queued parameters
+	k.DeleteQueuedInfractionParameters(ctx, consumerId)
 
-		scheduledTime, err := k.GetConsumerInfractionUpdateTime(ctx, consumerId)
-		if err != nil {
-			return
-		}
-		// delete consumer id from time queue
-		err = k.RemoveFromInfractionUpdateSchedule(ctx, consumerId, scheduledTime)
-		if err != nil {
-			return
-		}
+	scheduledTime, err := k.GetConsumerInfractionUpdateTime(ctx, consumerId)
+	if err != nil {
+		return
+	}
+	k.DeleteConsumerInfractionUpdateTime(ctx, consumerId)
+	// delete consumer id from time queue
+	if err := k.RemoveFromInfractionUpdateSchedule(ctx, consumerId, scheduledTime); err != nil {
+		k.Logger(ctx).Error("cannot remove consumer id from the infraction update schedule",
+			"consumerId", consumerId,
+			"updateTime", scheduledTime,
+			"error", err.Error(),
+		)
 	}
 }
 
@@ -201,14 +202,86 @@ func (k Keeper) UpdateQueuedInfractionParams(ctx sdk.Context, consumerId string,
 	}
 
 	updateTime := ctx.BlockTime().Add(unbondingPeriod)
+	if err := k.SetConsumerInfractionUpdateTime(ctx, consumerId, updateTime); err != nil {
+		return err
+	}
 	err = k.AddToInfractionUpdateSchedule(ctx, consumerId, updateTime)
 	if err != nil {
 		return err
 	}
 
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeQueueInfractionParameters,
+			append([]sdk.Attribute{
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				sdk.NewAttribute(types.AttributeConsumerId, consumerId),
+				sdk.NewAttribute(types.AttributeInfractionUpdateTime, updateTime.String()),
+			}, infractionParametersAttributes(newInfractionParams)...)...,
+		),
+	)
+
 	return nil
 }
 
+// GetPendingInfractionParametersUpdate returns the queued update of the infraction parameters of this consumer id
+// and whether there is such an update
+func (k Keeper) GetPendingInfractionParametersUpdate(ctx sdk.Context, consumerId string) (types.PendingInfractionParametersUpdate, bool, error) {
+	if !k.HasQueuedInfractionParameters(ctx, consumerId) {
+		return types.PendingInfractionParametersUpdate{}, false, nil
+	}
+	currentParams, err := k.GetInfractionParameters(ctx, consumerId)
+	if err != nil {
+		return types.PendingInfractionParametersUpdate{}, false, err
+	}
+	pendingParams, err := k.GetQueuedInfractionParameters(ctx, consumerId)
+	if err != nil {
+		return types.PendingInfractionParametersUpdate{}, false, err
+	}
+	updateTime, err := k.GetConsumerInfractionUpdateTime(ctx, consumerId)
+	if err != nil {
+		return types.PendingInfractionParametersUpdate{}, false, err
+	}
+	return types.PendingInfractionParametersUpdate{
+		ConsumerId:        consumerId,
+		CurrentParameters: currentParams,
+		PendingParameters: pendingParams,
+		UpdateTime:        updateTime,
+	}, true, nil
+}
+
+// GetAllPendingInfractionParametersUpdates returns the queued updates of the infraction parameters of all the
+// consumer chains in the order in which they are applied, i.e., by update time and, for the same update time,
+// in the order in which they were queued
+func (k Keeper) GetAllPendingInfractionParametersUpdates(ctx sdk.Context) ([]types.PendingInfractionParametersUpdate, error) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{types.InfractionScheduledTimeToConsumerIdsKeyPrefix()})
+	defer iterator.Close()
+
+	updates := []types.PendingInfractionParametersUpdate{}
+	for ; iterator.Valid(); iterator.Next() {
+		ts, err := types.ParseTime(types.InfractionScheduledTimeToConsumerIdsKeyPrefix(), iterator.Key())
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse scheduled time: %w", err)
+		}
+		consumerIds, err := k.GetFromInfractionUpdateSchedule(ctx, ts)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get record from time queue: %w", err)
+		}
+		for _, consumerId := range consumerIds.Ids {
+			update, found, err := k.GetPendingInfractionParametersUpdate(ctx, consumerId)
+			if err != nil {
+				return nil, err
+			}
+			if found {
+				updates = append(updates, update)
+			}
+		}
+	}
+
+	return updates, nil
+}
+
 // BeginBlockUpdateInfractionParameters updates infraction parameters for consumer chain for which the update time has passed
 func (k Keeper) BeginBlockUpdateInfractionParameters(ctx sdk.Context) error {
 	consumerIds, err := k.ConsumeIdsFromTimeQueue(
@@ -227,7 +300,13 @@ func (k Keeper) BeginBlockUpdateInfractionParameters(ctx sdk.Context) error {
 		// get queued consumer infraction parameters that needs to be applied
 		queuedInfractionParams, err := k.GetQueuedInfractionParameters(ctx, consumerId)
 		if err != nil {
-			return err
+			// the queued parameters were removed together with the consumer chain
+			k.Logger(ctx).Error("cannot get queued infraction parameters",
+				"consumerId", consumerId,
+				"error", err.Error(),
+			)
+			k.DeleteConsumerInfractionUpdateTime(ctx, consumerId)
+			continue
 		}
 
 		// update consumer infraction parameters
@@ -237,11 +316,39 @@ func (k Keeper) BeginBlockUpdateInfractionParameters(ctx sdk.Context) error {
 		}
 
 		k.DeleteQueuedInfractionParameters(ctx, consumerId)
+		k.DeleteConsumerInfractionUpdateTime(ctx, consumerId)
+
+		k.Logger(ctx).Info("infraction parameters updated",
+			"consumerId", consumerId,
+			"infractionParameters", queuedInfractionParams.String(),
+		)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeUpdateInfractionParameters,
+				append([]sdk.Attribute{
+					sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+					sdk.NewAttribute(types.AttributeConsumerId, consumerId),
+				}, infractionParametersAttributes(queuedInfractionParams)...)...,
+			),
+		)
 	}
 
 	return nil
 }
 
+// infractionParametersAttributes returns the event attributes of the slash fractions in `params`
+func infractionParametersAttributes(params types.InfractionParameters) []sdk.Attribute {
+	attributes := []sdk.Attribute{}
+	if params.DoubleSign != nil {
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeDoubleSignSlashFraction, params.DoubleSign.SlashFraction.String()))
+	}
+	if params.Downtime != nil {
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeDowntimeSlashFraction, params.Downtime.SlashFraction.String()))
+	}
+	return attributes
+}
+
 func compareInfractionParameters(param1, param2 types.InfractionParameters) bool {
 	// Compare both DoubleSign and Downtime parameters
 	return compareSlashJailParameters(param1.DoubleSign, param2.DoubleSign) &&
@@ -9,6 +9,8 @@ import (
 
 	"cosmossdk.io/math"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
 	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
 )
@@ -216,3 +218,69 @@ func TestBeginBlockUpdateInfractionParameters_MixedTiming(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, params4, oldInfractionParams)
 }
+
+// TestPendingInfractionParameterUpdates tests that the queued infraction parameter updates can be queried
+// together with the time at which they take effect, and that they are applied at that time
+func TestPendingInfractionParameterUpdates(t *testing.T) {
+	k, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	unbondingTime := time.Hour
+	mocks.MockStakingKeeper.EXPECT().UnbondingTime(gomock.Any()).Return(unbondingTime, nil).AnyTimes()
+	ctx = ctx.WithBlockTime(time.Unix(1000, 0).UTC())
+
+	currentParams := testkeeper.GetTestInfractionParameters()
+	newParams := testkeeper.GetTestInfractionParameters()
+	newParams.DoubleSign = &providertypes.SlashJailParameters{
+		JailDuration:  1200 * time.Second,
+		SlashFraction: math.LegacyNewDecWithPrec(5, 1),
+	}
+	for _, consumerId := range []string{"0", "1", "2"} {
+		require.NoError(t, k.SetInfractionParameters(ctx, consumerId, currentParams))
+	}
+
+	// the updates are returned in the order in which they are applied
+	require.NoError(t, k.UpdateQueuedInfractionParams(ctx.WithBlockTime(ctx.BlockTime().Add(time.Minute)), "0", newParams))
+	require.NoError(t, k.UpdateQueuedInfractionParams(ctx, "1", newParams))
+	require.NoError(t, k.UpdateQueuedInfractionParams(ctx, "2", newParams))
+	res, err := k.QueryPendingInfractionParameterUpdates(ctx, &providertypes.QueryPendingInfractionParameterUpdatesRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Updates, 3)
+	for i, consumerId := range []string{"1", "2", "0"} {
+		require.Equal(t, consumerId, res.Updates[i].ConsumerId)
+		require.Equal(t, currentParams, res.Updates[i].CurrentParameters)
+		require.Equal(t, newParams, res.Updates[i].PendingParameters)
+	}
+	require.Equal(t, ctx.BlockTime().Add(unbondingTime), res.Updates[0].UpdateTime)
+	require.Equal(t, ctx.BlockTime().Add(time.Minute+unbondingTime), res.Updates[2].UpdateTime)
+
+	// reverting the update of a consumer chain cancels it
+	require.NoError(t, k.UpdateQueuedInfractionParams(ctx, "2", currentParams))
+	res, err = k.QueryPendingInfractionParameterUpdates(ctx, &providertypes.QueryPendingInfractionParameterUpdatesRequest{ConsumerId: "2"})
+	require.NoError(t, err)
+	require.Empty(t, res.Updates)
+	_, err = k.GetConsumerInfractionUpdateTime(ctx, "2")
+	require.Error(t, err)
+
+	// the update of a removed consumer chain is dropped
+	k.RemoveConsumerInfractionQueuedData(ctx, "0")
+	res, err = k.QueryPendingInfractionParameterUpdates(ctx, &providertypes.QueryPendingInfractionParameterUpdatesRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Updates, 1)
+	require.Equal(t, "1", res.Updates[0].ConsumerId)
+
+	// the update is applied once its update time is reached
+	ctx = ctx.WithBlockTime(res.Updates[0].UpdateTime).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, k.BeginBlockUpdateInfractionParameters(ctx))
+	params, err := k.GetInfractionParameters(ctx, "1")
+	require.NoError(t, err)
+	require.Equal(t, newParams, params)
+	res, err = k.QueryPendingInfractionParameterUpdates(ctx, &providertypes.QueryPendingInfractionParameterUpdatesRequest{})
+	require.NoError(t, err)
+	require.Empty(t, res.Updates)
+	require.Len(t, ctx.EventManager().Events(), 1)
+	require.Equal(t, providertypes.EventTypeUpdateInfractionParameters, ctx.EventManager().Events()[0].Type)
+
+	_, err = k.QueryPendingInfractionParameterUpdates(ctx, &providertypes.QueryPendingInfractionParameterUpdatesRequest{ConsumerId: "invalid"})
+	require.Error(t, err)
+}
@@ -16,6 +16,15 @@ func RegisterInvariants(ir sdk.InvariantRegistry, k *Keeper) {
 
 	ir.RegisterRoute(types.ModuleName, "staking-keeper-equivalence",
 		StakingKeeperEquivalenceInvariant(*k))
+
+	ir.RegisterRoute(types.ModuleName, "launched-consumers",
+		LaunchedConsumersInvariant(*k))
+
+	ir.RegisterRoute(types.ModuleName, "consumer-validator-sets",
+		ConsumerValidatorSetsInvariant(*k))
+
+	ir.RegisterRoute(types.ModuleName, "consumer-addrs-to-prune",
+		ConsumerAddrsToPruneInvariant(*k))
 }
 
 // MaxProviderConsensusValidatorsInvariant checks that the number of provider consensus validators
@@ -147,3 +156,118 @@ func StakingKeeperEquivalenceInvariant(k Keeper) sdk.Invariant {
 		return "", false
 	}
 }
+
+// LaunchedConsumersInvariant checks that every launched (or paused) consumer chain has an IBC client
+// and that the client and CCV channel mappings of every launched consumer chain point back to it.
+// Note that a launched consumer chain has no CCV channel until the channel handshake completes,
+// but it cannot have sent VSC packets without a CCV channel.
+func LaunchedConsumersInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		for _, consumerId := range k.GetAllConsumerIds(ctx) {
+			if !k.IsConsumerLaunched(ctx, consumerId) {
+				continue
+			}
+
+			clientId, found := k.GetConsumerClientId(ctx, consumerId)
+			if !found {
+				return sdk.FormatInvariant(types.ModuleName, "launched-consumers",
+					fmt.Sprintf("launched consumer chain %s has no client id", consumerId)), true
+			}
+			if clientConsumerId, found := k.GetClientIdToConsumerId(ctx, clientId); !found || clientConsumerId != consumerId {
+				return sdk.FormatInvariant(types.ModuleName, "launched-consumers",
+					fmt.Sprintf("client id %s of launched consumer chain %s is mapped to consumer id %s",
+						clientId, consumerId, clientConsumerId)), true
+			}
+
+			channelId, found := k.GetConsumerIdToChannelId(ctx, consumerId)
+			if !found {
+				if _, sent := k.GetLastVSCPacket(ctx, consumerId); sent {
+					return sdk.FormatInvariant(types.ModuleName, "launched-consumers",
+						fmt.Sprintf("launched consumer chain %s was sent VSC packets but has no channel id", consumerId)), true
+				}
+				continue
+			}
+			if channelConsumerId, found := k.GetChannelIdToConsumerId(ctx, channelId); !found || channelConsumerId != consumerId {
+				return sdk.FormatInvariant(types.ModuleName, "launched-consumers",
+					fmt.Sprintf("channel id %s of launched consumer chain %s is mapped to consumer id %s",
+						channelId, consumerId, channelConsumerId)), true
+			}
+		}
+
+		return "", false
+	}
+}
+
+// ConsumerValidatorSetsInvariant checks that the validator set of every launched consumer chain without
+// pending VSC packets matches the last VSC packet sent to the chain, i.e., that every validator updated
+// in the last VSC packet has in the consumer validator set the power it was sent with. As a result,
+// the total power of the consumer validators matches the total power sent to the consumer chain.
+func ConsumerValidatorSetsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		for _, consumerId := range k.GetAllConsumerIds(ctx) {
+			if !k.IsConsumerLaunched(ctx, consumerId) {
+				continue
+			}
+			// the consumer validator set is ahead of the last sent VSC packet while there are pending VSC packets
+			if len(k.GetPendingVSCPackets(ctx, consumerId)) > 0 {
+				continue
+			}
+			lastVSCPacket, found := k.GetLastVSCPacket(ctx, consumerId)
+			if !found {
+				continue
+			}
+
+			consumerValSet, err := k.GetConsumerValSet(ctx, consumerId)
+			if err != nil {
+				return sdk.FormatInvariant(types.ModuleName, "consumer-validator-sets",
+					fmt.Sprintf("error getting validator set of consumer chain %s: %v", consumerId, err)), true
+			}
+
+			for _, update := range lastVSCPacket.Data.ValidatorUpdates {
+				// a validator removed by the VSC packet is no longer in the consumer validator set
+				consumerPower := int64(0)
+				for _, validator := range consumerValSet {
+					if validator.PublicKey.Equal(update.PubKey) {
+						consumerPower = validator.Power
+						break
+					}
+				}
+				if consumerPower != update.Power {
+					return sdk.FormatInvariant(types.ModuleName, "consumer-validator-sets",
+						fmt.Sprintf("validator %s of consumer chain %s has power %d, but was sent with power %d in VSC packet %d",
+							update.PubKey.String(), consumerId, consumerPower, update.Power, lastVSCPacket.Data.ValsetUpdateId)), true
+				}
+			}
+		}
+
+		return "", false
+	}
+}
+
+// ConsumerAddrsToPruneInvariant checks that there are no orphaned consumer addresses to prune,
+// i.e., that every consumer address to prune belongs to a consumer chain that is not deleted
+// and is still mapped to the provider address of the validator that assigned it
+func ConsumerAddrsToPruneInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		for _, consumerId := range k.GetAllConsumersWithConsumerAddrsToPrune(ctx) {
+			phase := k.GetConsumerPhase(ctx, consumerId)
+			if phase == types.CONSUMER_PHASE_UNSPECIFIED || phase == types.CONSUMER_PHASE_DELETED {
+				return sdk.FormatInvariant(types.ModuleName, "consumer-addrs-to-prune",
+					fmt.Sprintf("consumer chain %s in phase %s has consumer addresses to prune", consumerId, phase)), true
+			}
+
+			for _, consumerAddrsToPrune := range k.GetAllConsumerAddrsToPrune(ctx, consumerId) {
+				for _, addr := range consumerAddrsToPrune.ConsumerAddrs.Addresses {
+					consumerAddr := types.NewConsumerConsAddress(addr)
+					if _, found := k.GetValidatorByConsumerAddr(ctx, consumerId, consumerAddr); !found {
+						return sdk.FormatInvariant(types.ModuleName, "consumer-addrs-to-prune",
+							fmt.Sprintf("consumer address %s of consumer chain %s to prune at %s is not mapped to any validator",
+								consumerAddr, consumerId, consumerAddrsToPrune.PruneTs)), true
+					}
+				}
+			}
+		}
+
+		return "", false
+	}
+}
@@ -0,0 +1,123 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	cryptotestutil "github.com/cosmos/interchain-security/v7/testutil/crypto"
+	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
+	providerkeeper "github.com/cosmos/interchain-security/v7/x/ccv/provider/keeper"
+	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// TestLaunchedConsumersInvariant tests that the invariant is broken by launched consumer chains
+// without a client or with inconsistent client and channel mappings
+func TestLaunchedConsumersInvariant(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	invariant := providerkeeper.LaunchedConsumersInvariant(providerKeeper)
+
+	consumerId := providerKeeper.FetchAndIncrementConsumerId(ctx)
+	providerKeeper.SetConsumerPhase(ctx, consumerId, providertypes.CONSUMER_PHASE_LAUNCHED)
+	_, broken := invariant(ctx)
+	require.True(t, broken)
+
+	// a launched chain without a CCV channel is fine until it is sent VSC packets
+	providerKeeper.SetConsumerClientId(ctx, consumerId, "clientId")
+	_, broken = invariant(ctx)
+	require.False(t, broken)
+	providerKeeper.SetLastVSCPacket(ctx, consumerId, providertypes.SentVSCPacket{ChannelId: "channelId"})
+	_, broken = invariant(ctx)
+	require.True(t, broken)
+
+	providerKeeper.SetConsumerIdToChannelId(ctx, consumerId, "channelId")
+	providerKeeper.SetChannelToConsumerId(ctx, "channelId", "otherConsumerId")
+	_, broken = invariant(ctx)
+	require.True(t, broken)
+
+	providerKeeper.SetChannelToConsumerId(ctx, "channelId", consumerId)
+	_, broken = invariant(ctx)
+	require.False(t, broken)
+}
+
+// TestConsumerValidatorSetsInvariant tests that the invariant is broken by consumer validator sets
+// that do not match the last VSC packet sent to the consumer chains
+func TestConsumerValidatorSetsInvariant(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	invariant := providerkeeper.ConsumerValidatorSetsInvariant(providerKeeper)
+
+	consumerId := providerKeeper.FetchAndIncrementConsumerId(ctx)
+	providerKeeper.SetConsumerPhase(ctx, consumerId, providertypes.CONSUMER_PHASE_LAUNCHED)
+
+	validatorA := cryptotestutil.NewCryptoIdentityFromIntSeed(1)
+	validatorB := cryptotestutil.NewCryptoIdentityFromIntSeed(2)
+	pubKeyA := validatorA.TMProtoCryptoPublicKey()
+	require.NoError(t, providerKeeper.SetConsumerValSet(ctx, consumerId, []providertypes.ConsensusValidator{
+		{ProviderConsAddr: validatorA.SDKValConsAddress(), Power: 10, PublicKey: &pubKeyA},
+	}))
+
+	// validator A was updated to power 10 and validator B was removed
+	packet := ccv.NewValidatorSetChangePacketData(ccv.NewValsetDiff([]abci.ValidatorUpdate{
+		{PubKey: pubKeyA, Power: 10},
+		{PubKey: validatorB.TMProtoCryptoPublicKey(), Power: 0},
+	}...), 1, nil)
+	providerKeeper.SetLastVSCPacket(ctx, consumerId, providertypes.SentVSCPacket{SendTime: time.Now(), Data: packet})
+	_, broken := invariant(ctx)
+	require.False(t, broken)
+
+	// the consumer validator set is ahead of the last sent VSC packet
+	require.NoError(t, providerKeeper.SetConsumerValSet(ctx, consumerId, []providertypes.ConsensusValidator{
+		{ProviderConsAddr: validatorA.SDKValConsAddress(), Power: 20, PublicKey: &pubKeyA},
+	}))
+	providerKeeper.AppendPendingVSCPackets(ctx, consumerId, ccv.NewValidatorSetChangePacketData(
+		ccv.NewValsetDiff([]abci.ValidatorUpdate{{PubKey: pubKeyA, Power: 20}}...), 2, nil))
+	_, broken = invariant(ctx)
+	require.False(t, broken)
+
+	// the consumer validator set changed without a VSC packet
+	providerKeeper.DeletePendingVSCPackets(ctx, consumerId)
+	_, broken = invariant(ctx)
+	require.True(t, broken)
+}
+
+// TestConsumerAddrsToPruneInvariant tests that the invariant is broken by orphaned consumer addresses to prune
+func TestConsumerAddrsToPruneInvariant(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	invariant := providerkeeper.ConsumerAddrsToPruneInvariant(providerKeeper)
+
+	consumerId := providerKeeper.FetchAndIncrementConsumerId(ctx)
+	providerKeeper.SetConsumerPhase(ctx, consumerId, providertypes.CONSUMER_PHASE_LAUNCHED)
+	validator := cryptotestutil.NewCryptoIdentityFromIntSeed(1)
+	consumerAddr := cryptotestutil.NewCryptoIdentityFromIntSeed(2).ConsumerConsAddress()
+
+	// the consumer address to prune is not mapped to any validator
+	providerKeeper.AppendConsumerAddrsToPrune(ctx, consumerId, time.Now(), consumerAddr)
+	_, broken := invariant(ctx)
+	require.True(t, broken)
+
+	providerKeeper.SetValidatorByConsumerAddr(ctx, consumerId, consumerAddr, validator.ProviderConsAddress())
+	_, broken = invariant(ctx)
+	require.False(t, broken)
+
+	// the consumer chain was deleted without deleting its consumer addresses to prune
+	providerKeeper.SetConsumerPhase(ctx, consumerId, providertypes.CONSUMER_PHASE_DELETED)
+	_, broken = invariant(ctx)
+	require.True(t, broken)
+
+	// the consumer addresses to prune of a consumer id that was never used
+	providerKeeper.DeleteKeyAssignments(ctx, consumerId)
+	providerKeeper.AppendConsumerAddrsToPrune(ctx, "100", time.Now(), consumerAddr)
+	require.Equal(t, []string{"100"}, providerKeeper.GetAllConsumersWithConsumerAddrsToPrune(ctx))
+	_, broken = invariant(ctx)
+	require.True(t, broken)
+}
@@ -41,8 +41,8 @@ type Keeper struct {
 	connectionKeeper   ccv.ConnectionKeeper
 	accountKeeper      ccv.AccountKeeper
 	clientKeeper       ccv.ClientKeeper
-	stakingKeeper      ccv.StakingKeeper
-	slashingKeeper     ccv.SlashingKeeper
+	stakingKeeper      ccv.ProviderStakingKeeper
+	slashingKeeper     ccv.ProviderSlashingKeeper
 	distributionKeeper ccv.DistributionKeeper
 	bankKeeper         ccv.BankKeeper
 	govKeeper          govkeeper.Keeper
@@ -50,20 +50,37 @@ type Keeper struct {
 
 	validatorAddressCodec addresscodec.Codec
 	consensusAddressCodec addresscodec.Codec
+
+	subsystemLoggers *ccv.SubsystemLoggers
+
+	// the subscribers of the StreamValidatorSetChanges query, see vsc_stream.go
+	vscPacketStream *vscPacketStream
+
+	// hooks are explicitly set after the constructor, see SetHooks
+	hooks types.ProviderHooks
+
+	// set with the constructor options, see options.go
+	packetSender          ccv.PacketSender
+	consumerClientFactory types.ConsumerClientFactory
+	powerShapers          []types.PowerShaperRegistration
+	logger                log.Logger
+	features              map[string]bool
 }
 
-// NewKeeper creates a new provider Keeper instance
+// NewKeeper creates a new provider Keeper instance.
+// The options customize the keeper, e.g., WithPacketSender or WithLogger.
 func NewKeeper(
 	cdc codec.BinaryCodec, key storetypes.StoreKey, paramSpace paramtypes.Subspace,
 	channelKeeper ccv.ChannelKeeper,
 	connectionKeeper ccv.ConnectionKeeper, clientKeeper ccv.ClientKeeper,
-	stakingKeeper ccv.StakingKeeper, slashingKeeper ccv.SlashingKeeper,
+	stakingKeeper ccv.ProviderStakingKeeper, slashingKeeper ccv.ProviderSlashingKeeper,
 	accountKeeper ccv.AccountKeeper,
 	distributionKeeper ccv.DistributionKeeper, bankKeeper ccv.BankKeeper,
 	govKeeper govkeeper.Keeper,
 	authority string,
 	validatorAddressCodec, consensusAddressCodec addresscodec.Codec,
 	feeCollectorName string,
+	opts ...Option,
 ) Keeper {
 	k := Keeper{
 		cdc:                   cdc,
@@ -81,6 +98,13 @@ func NewKeeper(
 		validatorAddressCodec: validatorAddressCodec,
 		consensusAddressCodec: consensusAddressCodec,
 		govKeeper:             govKeeper,
+		subsystemLoggers:      ccv.NewSubsystemLoggers(ccv.DefaultLogConfig()),
+		packetSender:          ccv.SendIBCPacket,
+		consumerClientFactory: types.TendermintConsumerClientFactory{},
+		vscPacketStream:       newVSCPacketStream(),
+	}
+	for _, opt := range opts {
+		opt(&k)
 	}
 
 	k.mustValidateFields()
@@ -102,17 +126,30 @@ func (k Keeper) ConsensusAddressCodec() addresscodec.Codec {
 	return k.consensusAddressCodec
 }
 
+// GetAccountKeeper returns the account keeper, e.g., to look up the signers of simulated txs.
+func (k Keeper) GetAccountKeeper() ccv.AccountKeeper {
+	return k.accountKeeper
+}
+
+// GetBankKeeper returns the bank keeper, e.g., to pay the fees of simulated txs.
+func (k Keeper) GetBankKeeper() ccv.BankKeeper {
+	return k.bankKeeper
+}
+
 // Validates that the provider keeper is initialized with non-zero and
 // non-nil values for all its fields. Otherwise this method will panic.
 func (k Keeper) mustValidateFields() {
 	// Ensures no fields are missed in this validation
-	if reflect.ValueOf(k).NumField() != 15 {
-		panic(fmt.Sprintf("number of fields in provider keeper is not 15 - have %d", reflect.ValueOf(k).NumField()))
+	if reflect.ValueOf(k).NumField() != 23 {
+		panic(fmt.Sprintf("number of fields in provider keeper is not 23 - have %d", reflect.ValueOf(k).NumField()))
 	}
 
 	if k.validatorAddressCodec == nil || k.consensusAddressCodec == nil {
 		panic("validator and/or consensus address codec are nil")
 	}
+	if err := ccv.ValidateAddressCodecs(k.validatorAddressCodec, k.consensusAddressCodec); err != nil {
+		panic(fmt.Sprintf("invalid address codecs: %s", err))
+	}
 
 	ccv.PanicIfZeroOrNil(k.cdc, "cdc")                                     // 1
 	ccv.PanicIfZeroOrNil(k.storeKey, "storeKey")                           // 2
@@ -128,19 +165,72 @@ func (k Keeper) mustValidateFields() {
 	ccv.PanicIfZeroOrNil(k.authority, "authority")                         // 14
 	ccv.PanicIfZeroOrNil(k.validatorAddressCodec, "validatorAddressCodec") // 15
 	ccv.PanicIfZeroOrNil(k.consensusAddressCodec, "consensusAddressCodec") // 16
+	ccv.PanicIfZeroOrNil(k.subsystemLoggers, "subsystemLoggers")           // 18
+	ccv.PanicIfZeroOrNil(k.packetSender, "packetSender")                   // 19
+	ccv.PanicIfZeroOrNil(k.vscPacketStream, "vscPacketStream")             // 20
+	// the default consumer client factory is an empty struct, i.e., a zero value
+	if k.consumerClientFactory == nil { // 21
+		panic("zero or nil value for consumerClientFactory")
+	}
 
 	// this can be nil in tests
 	// ccv.PanicIfZeroOrNil(k.govKeeper, "govKeeper")                         // 17
+
+	// hooks are explicitly set after the constructor,
+	// logger, features, and power shapers are optionally set with the constructor options
 }
 
 func (k *Keeper) SetGovKeeper(govKeeper govkeeper.Keeper) {
 	k.govKeeper = govKeeper
 }
 
+// SetHooks sets the provider hooks, e.g., types.NewMultiProviderHooks(...).
+// Note that the hooks must be set before the keeper is passed to the modules.
+func (k *Keeper) SetHooks(ph types.ProviderHooks) *Keeper {
+	if k.hooks != nil {
+		// This should never happen as SetHooks is expected
+		// to be called only once in app.go
+		panic("cannot set provider hooks twice")
+	}
+
+	k.hooks = ph
+
+	return k
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx context.Context) log.Logger {
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	return sdkCtx.Logger().With("module", "x/"+ibchost.ModuleName+"-"+types.ModuleName)
+	logger := k.logger
+	if logger == nil {
+		logger = sdk.UnwrapSDKContext(ctx).Logger()
+	}
+	return logger.With("module", "x/"+ibchost.ModuleName+"-"+types.ModuleName)
+}
+
+// SubsystemLogger returns a module-specific logger for the logs of a high-frequency
+// subsystem, filtered and sampled according to the CCV logging config
+func (k Keeper) SubsystemLogger(ctx context.Context, subsystem ccv.LogSubsystem) log.Logger {
+	return k.subsystemLoggers.Logger(k.Logger(ctx), subsystem)
+}
+
+// SetLogConfig sets the CCV logging config, e.g., from the app options
+func (k *Keeper) SetLogConfig(config ccv.LogConfig) {
+	if k.subsystemLoggers == nil {
+		k.subsystemLoggers = ccv.NewSubsystemLoggers(config)
+		return
+	}
+	k.subsystemLoggers.SetConfig(config)
+}
+
+// executeAtomically runs `operation` in a cached context and writes its state changes and events
+// only if it succeeds, so that a multi-step operation that fails halfway leaves no partial state
+func (k Keeper) executeAtomically(ctx sdk.Context, operation func(ctx sdk.Context) error) error {
+	cachedCtx, writeFn := ctx.CacheContext()
+	if err := operation(cachedCtx); err != nil {
+		return err
+	}
+	writeFn()
+	return nil
 }
 
 // GetPort returns the portID for the CCV module. Used in ExportGenesis
@@ -285,7 +375,7 @@ func (k Keeper) DeleteConsumerGenesis(ctx sdk.Context, consumerId string) {
 // is the expected consumer chain.
 func (k Keeper) VerifyConsumerChain(ctx sdk.Context, channelID string, connectionHops []string) error {
 	if len(connectionHops) != 1 {
-		return errorsmod.Wrap(channeltypes.ErrTooManyConnectionHops, "must have direct connection to provider chain")
+		return errorsmod.Wrap(channeltypes.ErrTooManyConnectionHops, "must have direct connection to consumer chain")
 	}
 	connectionID := connectionHops[0]
 	clientId, _, err := k.getUnderlyingClient(ctx, connectionID)
@@ -305,9 +395,12 @@ func (k Keeper) VerifyConsumerChain(ctx sdk.Context, channelID string, connectio
 		return errorsmod.Wrapf(types.ErrInvalidConsumerClient, "CCV channel must be built on top of CCV client. expected %s, got %s", ccvClientId, clientId)
 	}
 
-	// Verify that there isn't already a CCV channel for the consumer chain
+	// Verify that there isn't already a CCV channel for the consumer chain,
+	// unless the channel is a migration channel
 	if prevChannel, ok := k.GetConsumerIdToChannelId(ctx, consumerId); ok {
-		return errorsmod.Wrapf(ccv.ErrDuplicateChannel, "CCV channel with ID: %s already created for consumer chain %s", prevChannel, consumerId)
+		if err := k.ValidateChannelMigration(ctx, consumerId, prevChannel, connectionID); err != nil {
+			return errorsmod.Wrapf(ccv.ErrDuplicateChannel, "CCV channel with ID: %s already created for consumer chain %s: %s", prevChannel, consumerId, err.Error())
+		}
 	}
 	return nil
 }
@@ -316,7 +409,7 @@ func (k Keeper) VerifyConsumerChain(ctx sdk.Context, channelID string, connectio
 // set by a different channel, and then sets the consumer chain mappings
 // in keeper, and set the channel status to validating.
 // If there is already a CCV channel between the provider and consumer
-// chain then close the channel, so that another channel can be made.
+// chain, then the channel is a migration channel that replaces it.
 //
 // SetConsumerChain is called by OnChanOpenConfirm.
 func (k Keeper) SetConsumerChain(ctx sdk.Context, channelID string) error {
@@ -336,10 +429,15 @@ func (k Keeper) SetConsumerChain(ctx sdk.Context, channelID string) error {
 	if !found {
 		return errorsmod.Wrapf(types.ErrNoConsumerId, "cannot find a consumer chain associated for this client: %s", clientID)
 	}
-	// Verify that there isn't already a CCV channel for the consumer chain
+	// Verify that there isn't already a CCV channel for the consumer chain,
+	// unless the channel is a migration channel
 	chainID := tmClient.ChainId
 	if prevChannelID, ok := k.GetConsumerIdToChannelId(ctx, consumerId); ok {
-		return errorsmod.Wrapf(ccv.ErrDuplicateChannel, "CCV channel with ID: %s already created for consumer chain with id %s", prevChannelID, consumerId)
+		if err := k.ValidateChannelMigration(ctx, consumerId, prevChannelID, connectionID); err != nil {
+			return errorsmod.Wrapf(ccv.ErrDuplicateChannel, "CCV channel with ID: %s already created for consumer chain with id %s: %s", prevChannelID, consumerId, err.Error())
+		}
+		k.startChannelMigration(ctx, consumerId, prevChannelID, channelID)
+		return nil
 	}
 
 	// the CCV channel is established:
@@ -379,10 +477,9 @@ func (k Keeper) getUnderlyingClient(ctx sdk.Context, connectionID string) (
 		return "", nil, errorsmod.Wrapf(clienttypes.ErrClientNotFound,
 			"client not found for client ID: %s", conn.ClientId)
 	}
-	tmClient, ok = clientState.(*ibctmtypes.ClientState)
-	if !ok {
-		return "", nil, errorsmod.Wrapf(clienttypes.ErrInvalidClientType,
-			"invalid client type. expected %s, got %s", ibchost.Tendermint, clientState.ClientType())
+	tmClient, err = k.consumerClientFactory.TendermintClientState(clientState)
+	if err != nil {
+		return "", nil, err
 	}
 	return clientID, tmClient, nil
 }
@@ -6,11 +6,14 @@ import (
 	"testing"
 
 	ibctesting "github.com/cosmos/ibc-go/v10/testing"
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
 	"cosmossdk.io/math"
 
+	"github.com/cosmos/cosmos-sdk/codec/address"
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	tmprotocrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
@@ -372,3 +375,76 @@ func TestConsumerClientId(t *testing.T) {
 	_, found = providerKeeper.GetClientIdToConsumerId(ctx, clientIds[1])
 	require.False(t, found)
 }
+
+// TestKeeperWithCustomAddressPrefixes tests that the provider keeper parses and formats
+// validator and consensus addresses with the address codecs it is instantiated with
+func TestKeeperWithCustomAddressPrefixes(t *testing.T) {
+	valCodec := address.NewBech32Codec("neutronvaloper")
+	consCodec := address.NewBech32Codec("neutronvalcons")
+
+	params := testkeeper.NewInMemKeeperParams(t)
+	params.ValidatorAddressCodec = valCodec
+	params.ConsensusAddressCodec = consCodec
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, params)
+	defer ctrl.Finish()
+
+	consumerId := "0"
+	providerAddr := providertypes.NewProviderConsAddress(cryptotestutil.NewCryptoIdentityFromIntSeed(1).SDKValConsAddress())
+	providerAddrStr, err := consCodec.BytesToString(providerAddr.ToSdkConsAddr())
+	require.NoError(t, err)
+	consumerKey := cryptotestutil.NewCryptoIdentityFromIntSeed(2).TMProtoCryptoPublicKey()
+	consumerAddr, err := ccv.TMCryptoPublicKeyToConsAddr(consumerKey)
+	require.NoError(t, err)
+	consumerAddrStr, err := consCodec.BytesToString(consumerAddr)
+	require.NoError(t, err)
+
+	providerKeeper.SetValidatorConsumerPubKey(ctx, consumerId, providerAddr, consumerKey)
+	providerKeeper.SetValidatorByConsumerAddr(ctx, consumerId, providertypes.NewConsumerConsAddress(consumerAddr), providerAddr)
+
+	consumerAddrRes, err := providerKeeper.QueryValidatorConsumerAddr(ctx, &providertypes.QueryValidatorConsumerAddrRequest{
+		ConsumerId:      consumerId,
+		ProviderAddress: providerAddrStr,
+	})
+	require.NoError(t, err)
+	require.Equal(t, consumerAddrStr, consumerAddrRes.ConsumerAddress)
+
+	providerAddrRes, err := providerKeeper.QueryValidatorProviderAddr(ctx, &providertypes.QueryValidatorProviderAddrRequest{
+		ConsumerId:      consumerId,
+		ConsumerAddress: consumerAddrStr,
+	})
+	require.NoError(t, err)
+	require.Equal(t, providerAddrStr, providerAddrRes.ProviderAddress)
+
+	pairsRes, err := providerKeeper.QueryAllPairsValConsAddrByConsumer(ctx, &providertypes.QueryAllPairsValConsAddrByConsumerRequest{ConsumerId: consumerId})
+	require.NoError(t, err)
+	require.Len(t, pairsRes.PairValConAddr, 1)
+	require.Equal(t, providerAddrStr, pairsRes.PairValConAddr[0].ProviderAddress)
+	require.Equal(t, consumerAddrStr, pairsRes.PairValConAddr[0].ConsumerAddress)
+
+	// addresses with the default prefix are rejected
+	_, err = providerKeeper.QueryValidatorConsumerAddr(ctx, &providertypes.QueryValidatorConsumerAddrRequest{
+		ConsumerId:      consumerId,
+		ProviderAddress: sdk.ConsAddress(providerAddr.ToSdkConsAddr()).String(),
+	})
+	require.Error(t, err)
+
+	// power shaping lists are parsed with the consensus address codec
+	providerKeeper.UpdateAllowlist(ctx, consumerId, []string{providerAddrStr, sdk.ConsAddress(consumerAddr).String()})
+	require.Equal(t, []providertypes.ProviderConsAddress{providerAddr}, providerKeeper.GetAllowList(ctx, consumerId))
+}
+
+// TestKeeperPanicsOnInvalidAddressCodecs tests that the provider keeper cannot be instantiated
+// with address codecs that cannot tell validator and consensus addresses apart
+func TestKeeperPanicsOnInvalidAddressCodecs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mocks := testkeeper.NewMockedKeepers(ctrl)
+
+	params := testkeeper.NewInMemKeeperParams(t)
+	params.ConsensusAddressCodec = params.ValidatorAddressCodec
+	require.Panics(t, func() { testkeeper.NewInMemProviderKeeper(params, mocks) })
+
+	params = testkeeper.NewInMemKeeperParams(t)
+	params.ValidatorAddressCodec = address.NewBech32Codec("")
+	require.Panics(t, func() { testkeeper.NewInMemProviderKeeper(params, mocks) })
+}
@@ -3,11 +3,13 @@ package keeper
 import (
 	"encoding/base64"
 	"fmt"
+	"strconv"
 	"time"
 
 	errorsmod "cosmossdk.io/errors"
 	storetypes "cosmossdk.io/store/types"
 
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
@@ -388,6 +390,30 @@ func (k Keeper) GetAllConsumerAddrsToPrune(ctx sdk.Context, consumerId string) (
 	return consumerAddrsToPrune
 }
 
+// GetAllConsumersWithConsumerAddrsToPrune returns the consumer ids of all the consumer chains
+// with consumer addresses to prune, including the consumer ids that no longer exist
+func (k Keeper) GetAllConsumersWithConsumerAddrsToPrune(ctx sdk.Context) []string {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.ConsumerAddrsToPruneV2KeyPrefix()
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{prefix})
+	defer iterator.Close()
+
+	consumerIds := []string{}
+	for ; iterator.Valid(); iterator.Next() {
+		consumerId, err := types.ParseStringIdWithLenKey(prefix, iterator.Key())
+		if err != nil {
+			// An error here would indicate something is very wrong,
+			// store keys are assumed to be correctly serialized in AppendConsumerAddrsToPrune.
+			panic(fmt.Errorf("failed to parse consumer addresses to prune key: %w", err))
+		}
+		// the keys are sorted by consumer id and then by timestamp
+		if len(consumerIds) == 0 || consumerIds[len(consumerIds)-1] != consumerId {
+			consumerIds = append(consumerIds, consumerId)
+		}
+	}
+	return consumerIds
+}
+
 // DeleteConsumerAddrsToPrune deletes the list of consumer addresses mapped to a timestamp
 func (k Keeper) DeleteConsumerAddrsToPrune(ctx sdk.Context, consumerId string, pruneTs time.Time) {
 	store := ctx.KVStore(k.storeKey)
@@ -459,8 +485,7 @@ func (k Keeper) AssignConsumerKey(
 		oldConsumerAddr := types.NewConsumerConsAddress(oldConsumerAddrTmp)
 
 		// check whether the consumer chain has already launched (i.e., a client to the consumer was already created)
-		phase := k.GetConsumerPhase(ctx, consumerId)
-		if phase == types.CONSUMER_PHASE_LAUNCHED {
+		if k.IsConsumerLaunched(ctx, consumerId) {
 			// mark the old consumer address as prunable once UnbondingPeriod elapses;
 			// note: this state is removed on EndBlock
 			unbondingPeriod, err := k.stakingKeeper.UnbondingTime(ctx)
@@ -493,6 +518,40 @@ func (k Keeper) AssignConsumerKey(
 	return nil
 }
 
+// RemoveConsumerKey removes the consumer key assigned by the validator `providerAddr` on the consumer chain
+// `consumerId`, so that the validator uses its provider key on the chain. As for a key replaced by a new
+// assignment, the old consumer address of a launched chain is only pruned once it can no longer be referenced
+// in slash packets. It returns false if the validator has not assigned a consumer key on the chain.
+func (k Keeper) RemoveConsumerKey(ctx sdk.Context, consumerId string, providerAddr types.ProviderConsAddress) (bool, error) {
+	consumerKey, found := k.GetValidatorConsumerPubKey(ctx, consumerId, providerAddr)
+	if !found {
+		return false, nil
+	}
+	consumerAddrTmp, err := ccvtypes.TMCryptoPublicKeyToConsAddr(consumerKey)
+	if err != nil {
+		return false, err
+	}
+	consumerAddr := types.NewConsumerConsAddress(consumerAddrTmp)
+
+	if k.IsConsumerLaunched(ctx, consumerId) {
+		unbondingPeriod, err := k.stakingKeeper.UnbondingTime(ctx)
+		if err != nil {
+			return false, err
+		}
+		k.AppendConsumerAddrsToPrune(
+			ctx,
+			consumerId,
+			ctx.BlockTime().Add(unbondingPeriod),
+			consumerAddr,
+		)
+	} else {
+		k.DeleteValidatorByConsumerAddr(ctx, consumerId, consumerAddr)
+	}
+	k.DeleteValidatorConsumerPubKey(ctx, consumerId, providerAddr)
+
+	return true, nil
+}
+
 // GetProviderAddrFromConsumerAddr returns the consensus address of a validator with
 // consAddr set as the consensus address on a consumer chain
 func (k Keeper) GetProviderAddrFromConsumerAddr(
@@ -575,3 +634,355 @@ func (k Keeper) ValidatorConsensusKeyInUse(ctx sdk.Context, valAddr sdk.ValAddre
 	}
 	return false
 }
+
+// SetPreLaunchKeyAssignment stores the pre-agreed key assignment of a validator for a consumer chain
+// that is not yet created. The assignment is applied once a consumer chain with the same chain id is created.
+func (k Keeper) SetPreLaunchKeyAssignment(ctx sdk.Context, keyAssignment types.PreLaunchKeyAssignment) error {
+	valAddr, err := k.ValidatorAddressCodec().StringToBytes(keyAssignment.ProviderAddr)
+	if err != nil {
+		return err
+	}
+	bz, err := keyAssignment.Marshal()
+	if err != nil {
+		return fmt.Errorf("failed to marshal pre-launch key assignment (%+v): %w", keyAssignment, err)
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ChainIdToPreLaunchKeyAssignmentKey(keyAssignment.ChainId, valAddr), bz)
+	return nil
+}
+
+// GetAllPreLaunchKeyAssignments gets all the pre-agreed key assignments for the consumer chain with `chainId`,
+// or for all the consumer chains that are not yet created if `chainId` is nil.
+func (k Keeper) GetAllPreLaunchKeyAssignments(ctx sdk.Context, chainId *string) (keyAssignments []types.PreLaunchKeyAssignment) {
+	store := ctx.KVStore(k.storeKey)
+	var prefix []byte
+	keyPrefix := types.ChainIdToPreLaunchKeyAssignmentKeyPrefix()
+	if chainId == nil {
+		prefix = []byte{keyPrefix}
+	} else {
+		prefix = types.StringIdWithLenKey(keyPrefix, *chainId)
+	}
+	iterator := storetypes.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var keyAssignment types.PreLaunchKeyAssignment
+		if err := keyAssignment.Unmarshal(iterator.Value()); err != nil {
+			// An error here would indicate something is very wrong,
+			// the key assignment is assumed to be correctly serialized in SetPreLaunchKeyAssignment.
+			panic(fmt.Errorf("failed to unmarshal pre-launch key assignment: %w", err))
+		}
+		keyAssignments = append(keyAssignments, keyAssignment)
+	}
+
+	return keyAssignments
+}
+
+// DeletePreLaunchKeyAssignments deletes all the pre-agreed key assignments for the consumer chain with `chainId`
+func (k Keeper) DeletePreLaunchKeyAssignments(ctx sdk.Context, chainId string) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store,
+		types.StringIdWithLenKey(types.ChainIdToPreLaunchKeyAssignmentKeyPrefix(), chainId))
+
+	var keysToDel [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keysToDel = append(keysToDel, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keysToDel {
+		store.Delete(key)
+	}
+}
+
+// ApplyPreLaunchKeyAssignments assigns the consumer keys pre-agreed in the provider genesis to the newly
+// created consumer chain with `consumerId`. As the validators might have changed since genesis,
+// assignments that cannot be applied are skipped. The pre-agreed assignments are only applied once,
+// i.e., to the first consumer chain created with `chainId`.
+func (k Keeper) ApplyPreLaunchKeyAssignments(ctx sdk.Context, consumerId, chainId string) {
+	for _, keyAssignment := range k.GetAllPreLaunchKeyAssignments(ctx, &chainId) {
+		if err := k.assignConsumerKeyFromJson(ctx, consumerId, keyAssignment.ProviderAddr, keyAssignment.ConsumerKey); err != nil {
+			k.Logger(ctx).Error("cannot apply pre-launch key assignment",
+				"consumerId", consumerId,
+				"chainId", chainId,
+				"validator operator addr", keyAssignment.ProviderAddr,
+				"error", err.Error(),
+			)
+		}
+	}
+	k.DeletePreLaunchKeyAssignments(ctx, chainId)
+}
+
+// AssignSignedConsumerKeys assigns the consumer keys provided by the owner of the newly created consumer
+// chain with `consumerId`. Every key assignment needs to be signed by the operator account of the validator,
+// otherwise no key is assigned and an error is returned.
+func (k Keeper) AssignSignedConsumerKeys(
+	ctx sdk.Context,
+	consumerId, chainId, owner string,
+	keyAssignments []types.SignedKeyAssignment,
+) error {
+	for _, keyAssignment := range keyAssignments {
+		if err := k.VerifyKeyAssignmentSignature(ctx, chainId, owner, keyAssignment); err != nil {
+			return err
+		}
+		if err := k.assignConsumerKeyFromJson(ctx, consumerId, keyAssignment.ProviderAddr, keyAssignment.ConsumerKey); err != nil {
+			return errorsmod.Wrapf(err, "cannot assign consumer key of validator (%s)", keyAssignment.ProviderAddr)
+		}
+	}
+	return nil
+}
+
+// VerifyKeyAssignmentSignature verifies that `keyAssignment` is signed by the operator account of the validator
+func (k Keeper) VerifyKeyAssignmentSignature(ctx sdk.Context, chainId, owner string, keyAssignment types.SignedKeyAssignment) error {
+	valAddr, err := k.ValidatorAddressCodec().StringToBytes(keyAssignment.ProviderAddr)
+	if err != nil {
+		return err
+	}
+
+	account := k.accountKeeper.GetAccount(ctx, sdk.AccAddress(valAddr))
+	if account == nil || account.GetPubKey() == nil {
+		return errorsmod.Wrapf(types.ErrInvalidKeyAssignmentSignature,
+			"no public key found for the operator account of validator (%s)", keyAssignment.ProviderAddr)
+	}
+
+	signBytes, err := types.KeyAssignmentSignBytes(chainId, owner, keyAssignment.ProviderAddr, keyAssignment.ConsumerKey)
+	if err != nil {
+		return err
+	}
+	if !account.GetPubKey().VerifySignature(signBytes, keyAssignment.Signature) {
+		return errorsmod.Wrapf(types.ErrInvalidKeyAssignmentSignature,
+			"signature verification failed for validator (%s)", keyAssignment.ProviderAddr)
+	}
+
+	return nil
+}
+
+// VerifyKeyPossessionProof verifies that `proof` is a signature of `consumerKey` over the key possession sign bytes
+// of this provider chain, of the consumer chain with `consumerId` and of the validator with operator address `providerAddr`,
+// i.e., that the validator assigning `consumerKey` controls it
+func (k Keeper) VerifyKeyPossessionProof(ctx sdk.Context, consumerId, providerAddr string, consumerKey tmprotocrypto.PublicKey, proof []byte) error {
+	pubKey, err := cryptocodec.FromCmtProtoPublicKey(consumerKey)
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidKeyPossessionProof, "cannot decode consumer key: %s", err.Error())
+	}
+
+	signBytes, err := types.KeyPossessionSignBytes(ctx.ChainID(), consumerId, providerAddr)
+	if err != nil {
+		return err
+	}
+	if !pubKey.VerifySignature(signBytes, proof) {
+		return errorsmod.Wrapf(types.ErrInvalidKeyPossessionProof,
+			"signature verification failed for validator (%s) on consumer chain (%s)", providerAddr, consumerId)
+	}
+
+	return nil
+}
+
+// assignConsumerKeyFromJson assigns the JSON-encoded `consumerKey` to the validator with operator address `providerAddr`
+func (k Keeper) assignConsumerKeyFromJson(ctx sdk.Context, consumerId, providerAddr, consumerKey string) error {
+	valAddr, err := k.ValidatorAddressCodec().StringToBytes(providerAddr)
+	if err != nil {
+		return err
+	}
+	validator, err := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+	consumerTMPublicKey, err := k.ParseConsumerKey(consumerKey)
+	if err != nil {
+		return err
+	}
+	return k.AssignConsumerKey(ctx, consumerId, validator, consumerTMPublicKey)
+}
+
+// ScheduleKeyAssignment stores a consumer key assignment that is applied in the BeginBlock of its activation height.
+// The activation height is at most MaxKeyAssignmentActivationDistance blocks after the current height and a validator
+// can schedule at most MaxScheduledKeyAssignmentsPerValidator key assignments for a consumer chain.
+// The key assignment is checked against the current state, so that it is rejected early if it cannot be applied,
+// but it might still fail at the activation height if the state changes in the meantime.
+func (k Keeper) ScheduleKeyAssignment(ctx sdk.Context, keyAssignment types.ScheduledKeyAssignment) error {
+	if keyAssignment.ActivationHeight <= ctx.BlockHeight() {
+		return errorsmod.Wrapf(types.ErrInvalidActivationHeight,
+			"activation height (%d) must be greater than the current height (%d)", keyAssignment.ActivationHeight, ctx.BlockHeight())
+	}
+	if keyAssignment.ActivationHeight-ctx.BlockHeight() > types.MaxKeyAssignmentActivationDistance {
+		return errorsmod.Wrapf(types.ErrInvalidActivationHeight,
+			"activation height (%d) must be at most %d blocks after the current height (%d)",
+			keyAssignment.ActivationHeight, types.MaxKeyAssignmentActivationDistance, ctx.BlockHeight())
+	}
+
+	valAddr, err := k.ValidatorAddressCodec().StringToBytes(keyAssignment.ProviderAddr)
+	if err != nil {
+		return err
+	}
+	// a key assignment scheduled at the same activation height is replaced and does not count towards the limit
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has(types.ActivationHeightToKeyAssignmentKey(uint64(keyAssignment.ActivationHeight), keyAssignment.ConsumerId, valAddr)) &&
+		k.getNumScheduledKeyAssignments(ctx, keyAssignment.ConsumerId, valAddr) >= types.MaxScheduledKeyAssignmentsPerValidator {
+		return errorsmod.Wrapf(types.ErrTooManyScheduledKeyAssignments,
+			"validator %s already scheduled %d key assignments for consumer id %s",
+			keyAssignment.ProviderAddr, types.MaxScheduledKeyAssignmentsPerValidator, keyAssignment.ConsumerId)
+	}
+
+	cachedCtx, _ := ctx.CacheContext()
+	if err := k.assignConsumerKeyFromJson(cachedCtx, keyAssignment.ConsumerId, keyAssignment.ProviderAddr, keyAssignment.ConsumerKey); err != nil {
+		return err
+	}
+
+	return k.SetScheduledKeyAssignment(ctx, keyAssignment)
+}
+
+// SetScheduledKeyAssignment stores a consumer key assignment scheduled by a validator, indexed by its activation height
+// and by its consumer chain and validator. A key assignment scheduled by the same validator for the same consumer chain
+// and activation height is replaced.
+func (k Keeper) SetScheduledKeyAssignment(ctx sdk.Context, keyAssignment types.ScheduledKeyAssignment) error {
+	valAddr, err := k.ValidatorAddressCodec().StringToBytes(keyAssignment.ProviderAddr)
+	if err != nil {
+		return err
+	}
+	bz, err := keyAssignment.Marshal()
+	if err != nil {
+		return fmt.Errorf("failed to marshal scheduled key assignment (%+v): %w", keyAssignment, err)
+	}
+	store := ctx.KVStore(k.storeKey)
+	activationHeight := uint64(keyAssignment.ActivationHeight)
+	store.Set(types.ActivationHeightToKeyAssignmentKey(activationHeight, keyAssignment.ConsumerId, valAddr), bz)
+	store.Set(types.ConsumerIdToScheduledKeyAssignmentKey(keyAssignment.ConsumerId, valAddr, activationHeight), []byte{})
+	return nil
+}
+
+// getNumScheduledKeyAssignments returns the number of key assignments scheduled by the validator with `valAddr`
+// for the consumer chain with `consumerId`
+func (k Keeper) getNumScheduledKeyAssignments(ctx sdk.Context, consumerId string, valAddr sdk.ValAddress) int {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.ConsumerIdAndValToScheduledKeyAssignmentKeyPrefix(consumerId, valAddr))
+	defer iterator.Close()
+
+	num := 0
+	for ; iterator.Valid(); iterator.Next() {
+		num++
+	}
+	return num
+}
+
+// GetAllScheduledKeyAssignments gets all the consumer key assignments scheduled by validators,
+// ordered by activation height
+func (k Keeper) GetAllScheduledKeyAssignments(ctx sdk.Context) (keyAssignments []types.ScheduledKeyAssignment) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{types.ActivationHeightToKeyAssignmentKeyPrefix()})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		keyAssignments = append(keyAssignments, mustUnmarshalScheduledKeyAssignment(iterator.Value()))
+	}
+
+	return keyAssignments
+}
+
+// DeleteScheduledKeyAssignments deletes all the consumer key assignments scheduled for the consumer chain with `consumerId`
+func (k Keeper) DeleteScheduledKeyAssignments(ctx sdk.Context, consumerId string) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store,
+		types.StringIdWithLenKey(types.ConsumerIdToScheduledKeyAssignmentKeyPrefix(), consumerId))
+
+	var keysToDel [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		_, valAddr, activationHeight, err := types.ParseConsumerIdToScheduledKeyAssignmentKey(iterator.Key())
+		if err != nil {
+			// An error here would indicate something is very wrong,
+			// the key is assumed to be correctly serialized in SetScheduledKeyAssignment.
+			panic(fmt.Errorf("failed to parse scheduled key assignment key: %w", err))
+		}
+		keysToDel = append(keysToDel,
+			iterator.Key(),
+			types.ActivationHeightToKeyAssignmentKey(activationHeight, consumerId, valAddr),
+		)
+	}
+	iterator.Close()
+
+	for _, key := range keysToDel {
+		store.Delete(key)
+	}
+}
+
+// BeginBlockApplyScheduledKeyAssignments applies the consumer key assignments whose activation height was reached.
+// As the state might have changed since the key assignments were scheduled, the key assignments that cannot be
+// applied are skipped. The key assignments are removed from the queue in either case.
+func (k Keeper) BeginBlockApplyScheduledKeyAssignments(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{types.ActivationHeightToKeyAssignmentKeyPrefix()})
+
+	var (
+		keysToDel      [][]byte
+		keyAssignments []types.ScheduledKeyAssignment
+	)
+	for ; iterator.Valid(); iterator.Next() {
+		activationHeight := sdk.BigEndianToUint64(iterator.Key()[1:9])
+		if activationHeight > uint64(ctx.BlockHeight()) {
+			break
+		}
+		keyAssignment := mustUnmarshalScheduledKeyAssignment(iterator.Value())
+		// the validator address is the suffix of the key, after the length-prefixed consumer id
+		valAddr := sdk.ValAddress(iterator.Key()[1+8+8+len(keyAssignment.ConsumerId):])
+		keysToDel = append(keysToDel,
+			iterator.Key(),
+			types.ConsumerIdToScheduledKeyAssignmentKey(keyAssignment.ConsumerId, valAddr, activationHeight),
+		)
+		keyAssignments = append(keyAssignments, keyAssignment)
+	}
+	iterator.Close()
+
+	for _, key := range keysToDel {
+		store.Delete(key)
+	}
+
+	for _, keyAssignment := range keyAssignments {
+		cachedCtx, writeFn := ctx.CacheContext()
+		err := k.assignConsumerKeyFromJson(cachedCtx, keyAssignment.ConsumerId, keyAssignment.ProviderAddr, keyAssignment.ConsumerKey)
+		if err != nil {
+			k.Logger(ctx).Error("cannot apply scheduled key assignment",
+				"consumerId", keyAssignment.ConsumerId,
+				"validator operator addr", keyAssignment.ProviderAddr,
+				"activation height", keyAssignment.ActivationHeight,
+				"error", err.Error(),
+			)
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeScheduledKeyAssignmentFailed,
+					sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+					sdk.NewAttribute(types.AttributeConsumerId, keyAssignment.ConsumerId),
+					sdk.NewAttribute(types.AttributeProviderValidatorAddress, keyAssignment.ProviderAddr),
+					sdk.NewAttribute(types.AttributeConsumerConsensusPubKey, keyAssignment.ConsumerKey),
+					sdk.NewAttribute(types.AttributeActivationHeight, strconv.FormatInt(keyAssignment.ActivationHeight, 10)),
+					sdk.NewAttribute(types.AttributeKeyAssignmentError, err.Error()),
+				),
+			)
+			continue
+		}
+		writeFn()
+
+		k.Logger(ctx).Info("scheduled key assignment applied",
+			"consumerId", keyAssignment.ConsumerId,
+			"validator operator addr", keyAssignment.ProviderAddr,
+			"consumer public key", keyAssignment.ConsumerKey,
+		)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeAssignConsumerKey,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				sdk.NewAttribute(types.AttributeConsumerId, keyAssignment.ConsumerId),
+				sdk.NewAttribute(types.AttributeProviderValidatorAddress, keyAssignment.ProviderAddr),
+				sdk.NewAttribute(types.AttributeConsumerConsensusPubKey, keyAssignment.ConsumerKey),
+				sdk.NewAttribute(types.AttributeActivationHeight, strconv.FormatInt(keyAssignment.ActivationHeight, 10)),
+			),
+		)
+	}
+}
+
+func mustUnmarshalScheduledKeyAssignment(bz []byte) types.ScheduledKeyAssignment {
+	var keyAssignment types.ScheduledKeyAssignment
+	if err := keyAssignment.Unmarshal(bz); err != nil {
+		// An error here would indicate something is very wrong,
+		// the key assignment is assumed to be correctly serialized in SetScheduledKeyAssignment.
+		panic(fmt.Errorf("failed to unmarshal scheduled key assignment: %w", err))
+	}
+	return keyAssignment
+}
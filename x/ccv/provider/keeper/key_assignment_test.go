@@ -2,6 +2,8 @@ package keeper_test
 
 import (
 	"bytes"
+	"encoding/base64"
+	"fmt"
 	"math/rand"
 	"sort"
 	"testing"
@@ -12,6 +14,7 @@ import (
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -965,3 +968,285 @@ func TestSimulatedAssignmentsAndUpdateApplication(t *testing.T) {
 		runRandomExecution()
 	}
 }
+
+// consumerKeyJson returns the consensus public key of `id` in the JSON format used by key assignments
+func consumerKeyJson(id *cryptotestutil.CryptoIdentity) string {
+	return fmt.Sprintf(`{"@type":"/cosmos.crypto.ed25519.PubKey","key":"%s"}`,
+		base64.StdEncoding.EncodeToString(id.ConsensusSDKPubKey().Bytes()))
+}
+
+func TestPreLaunchKeyAssignmentsCRUD(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	ids := cryptotestutil.GenMultipleCryptoIds(3, 0)
+	keyAssignments := []types.PreLaunchKeyAssignment{
+		{ChainId: "chain-1", ProviderAddr: ids[0].SDKValOpAddressString(), ConsumerKey: consumerKeyJson(ids[1])},
+		{ChainId: "chain-1", ProviderAddr: ids[1].SDKValOpAddressString(), ConsumerKey: consumerKeyJson(ids[2])},
+		{ChainId: "chain-2", ProviderAddr: ids[0].SDKValOpAddressString(), ConsumerKey: consumerKeyJson(ids[2])},
+	}
+	for _, keyAssignment := range keyAssignments {
+		require.NoError(t, providerKeeper.SetPreLaunchKeyAssignment(ctx, keyAssignment))
+	}
+
+	require.ElementsMatch(t, keyAssignments, providerKeeper.GetAllPreLaunchKeyAssignments(ctx, nil))
+	chainId := "chain-1"
+	require.ElementsMatch(t, keyAssignments[:2], providerKeeper.GetAllPreLaunchKeyAssignments(ctx, &chainId))
+
+	providerKeeper.DeletePreLaunchKeyAssignments(ctx, chainId)
+	require.Empty(t, providerKeeper.GetAllPreLaunchKeyAssignments(ctx, &chainId))
+	require.Equal(t, keyAssignments[2:], providerKeeper.GetAllPreLaunchKeyAssignments(ctx, nil))
+
+	// an invalid provider address cannot be stored
+	require.Error(t, providerKeeper.SetPreLaunchKeyAssignment(ctx,
+		types.PreLaunchKeyAssignment{ChainId: "chain-1", ProviderAddr: "invalid", ConsumerKey: consumerKeyJson(ids[1])}))
+}
+
+func TestApplyPreLaunchKeyAssignments(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	consumerId := "0"
+	chainId := "chain-1"
+	providerIds := cryptotestutil.GenMultipleCryptoIds(2, 0)
+	consumerIds := cryptotestutil.GenMultipleCryptoIds(2, 10)
+
+	providerKeeper.SetConsumerPhase(ctx, consumerId, types.CONSUMER_PHASE_REGISTERED)
+
+	for i := range providerIds {
+		require.NoError(t, providerKeeper.SetPreLaunchKeyAssignment(ctx, types.PreLaunchKeyAssignment{
+			ChainId:      chainId,
+			ProviderAddr: providerIds[i].SDKValOpAddressString(),
+			ConsumerKey:  consumerKeyJson(consumerIds[i]),
+		}))
+	}
+	// a key assignment for a different chain is not applied
+	require.NoError(t, providerKeeper.SetPreLaunchKeyAssignment(ctx, types.PreLaunchKeyAssignment{
+		ChainId:      "chain-2",
+		ProviderAddr: providerIds[0].SDKValOpAddressString(),
+		ConsumerKey:  consumerKeyJson(consumerIds[1]),
+	}))
+
+	// the first validator exists, while the second one was removed since genesis
+	mocks.MockStakingKeeper.EXPECT().GetValidator(ctx, providerIds[0].SDKValOpAddress()).
+		Return(providerIds[0].SDKStakingValidator(), nil).AnyTimes()
+	mocks.MockStakingKeeper.EXPECT().GetValidator(ctx, providerIds[1].SDKValOpAddress()).
+		Return(stakingtypes.Validator{}, stakingtypes.ErrNoValidatorFound).AnyTimes()
+	mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(ctx, consumerIds[0].SDKValConsAddress()).
+		Return(stakingtypes.Validator{}, stakingtypes.ErrNoValidatorFound).AnyTimes()
+
+	providerKeeper.ApplyPreLaunchKeyAssignments(ctx, consumerId, chainId)
+
+	consumerKey, found := providerKeeper.GetValidatorConsumerPubKey(ctx, consumerId, providerIds[0].ProviderConsAddress())
+	require.True(t, found)
+	require.Equal(t, consumerIds[0].TMProtoCryptoPublicKey(), consumerKey)
+	_, found = providerKeeper.GetValidatorConsumerPubKey(ctx, consumerId, providerIds[1].ProviderConsAddress())
+	require.False(t, found)
+
+	// the applied key assignments are removed, the ones of other chains are kept
+	require.Empty(t, providerKeeper.GetAllPreLaunchKeyAssignments(ctx, &chainId))
+	require.Len(t, providerKeeper.GetAllPreLaunchKeyAssignments(ctx, nil), 1)
+}
+
+func TestAssignSignedConsumerKeys(t *testing.T) {
+	consumerId := "0"
+	chainId := "chain-1"
+	owner := "owner"
+	providerId := cryptotestutil.NewCryptoIdentityFromIntSeed(0)
+	consumerKey := consumerKeyJson(cryptotestutil.NewCryptoIdentityFromIntSeed(10))
+
+	sign := func(chainId, owner string) []byte {
+		signBytes, err := types.KeyAssignmentSignBytes(chainId, owner, providerId.SDKValOpAddressString(), consumerKey)
+		require.NoError(t, err)
+		signature, err := providerId.SignWithOperatorKey(signBytes)
+		require.NoError(t, err)
+		return signature
+	}
+	operatorAccount := authtypes.NewBaseAccount(sdk.AccAddress(providerId.SDKValOpAddress()), providerId.OperatorSDKPubKey(), 0, 0)
+
+	testCases := []struct {
+		name      string
+		signature []byte
+		account   sdk.AccountI
+		expErr    error
+	}{
+		{
+			"valid signature",
+			sign(chainId, owner),
+			operatorAccount,
+			nil,
+		},
+		{
+			"signature for a different chain id",
+			sign("chain-2", owner),
+			operatorAccount,
+			types.ErrInvalidKeyAssignmentSignature,
+		},
+		{
+			"signature for a different owner",
+			sign(chainId, "other-owner"),
+			operatorAccount,
+			types.ErrInvalidKeyAssignmentSignature,
+		},
+		{
+			"operator account without a public key",
+			sign(chainId, owner),
+			authtypes.NewBaseAccountWithAddress(sdk.AccAddress(providerId.SDKValOpAddress())),
+			types.ErrInvalidKeyAssignmentSignature,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+			defer ctrl.Finish()
+
+			providerKeeper.SetConsumerPhase(ctx, consumerId, types.CONSUMER_PHASE_REGISTERED)
+
+			mocks.MockAccountKeeper.EXPECT().GetAccount(ctx, sdk.AccAddress(providerId.SDKValOpAddress())).Return(tc.account)
+			if tc.expErr == nil {
+				mocks.MockStakingKeeper.EXPECT().GetValidator(ctx, providerId.SDKValOpAddress()).
+					Return(providerId.SDKStakingValidator(), nil)
+				mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(ctx, gomock.Any()).
+					Return(stakingtypes.Validator{}, stakingtypes.ErrNoValidatorFound)
+			}
+
+			err := providerKeeper.AssignSignedConsumerKeys(ctx, consumerId, chainId, owner, []types.SignedKeyAssignment{
+				{ProviderAddr: providerId.SDKValOpAddressString(), ConsumerKey: consumerKey, Signature: tc.signature},
+			})
+			_, found := providerKeeper.GetValidatorConsumerPubKey(ctx, consumerId, providerId.ProviderConsAddress())
+			if tc.expErr == nil {
+				require.NoError(t, err)
+				require.True(t, found)
+			} else {
+				require.ErrorIs(t, err, tc.expErr)
+				require.False(t, found)
+			}
+		})
+	}
+}
+
+func TestScheduledKeyAssignmentsCRUD(t *testing.T) {
+	keeperParams := testkeeper.NewInMemKeeperParams(t)
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, keeperParams)
+	defer ctrl.Finish()
+
+	ids := cryptotestutil.GenMultipleCryptoIds(3, 0)
+	keyAssignments := []types.ScheduledKeyAssignment{
+		{ConsumerId: "0", ProviderAddr: ids[0].SDKValOpAddressString(), ConsumerKey: consumerKeyJson(ids[1]), ActivationHeight: 5},
+		{ConsumerId: "1", ProviderAddr: ids[0].SDKValOpAddressString(), ConsumerKey: consumerKeyJson(ids[2]), ActivationHeight: 10},
+		{ConsumerId: "0", ProviderAddr: ids[1].SDKValOpAddressString(), ConsumerKey: consumerKeyJson(ids[2]), ActivationHeight: 20},
+	}
+	for _, keyAssignment := range keyAssignments {
+		require.NoError(t, providerKeeper.SetScheduledKeyAssignment(ctx, keyAssignment))
+	}
+
+	// the key assignments are ordered by activation height
+	require.Equal(t, keyAssignments, providerKeeper.GetAllScheduledKeyAssignments(ctx))
+
+	// the key assignments are indexed by consumer chain and validator
+	store := ctx.KVStore(keeperParams.StoreKey)
+	require.True(t, store.Has(types.ConsumerIdToScheduledKeyAssignmentKey("0", ids[1].SDKValOpAddress(), 20)))
+
+	providerKeeper.DeleteScheduledKeyAssignments(ctx, "0")
+	require.Equal(t, keyAssignments[1:2], providerKeeper.GetAllScheduledKeyAssignments(ctx))
+	require.False(t, store.Has(types.ConsumerIdToScheduledKeyAssignmentKey("0", ids[0].SDKValOpAddress(), 5)))
+	require.False(t, store.Has(types.ConsumerIdToScheduledKeyAssignmentKey("0", ids[1].SDKValOpAddress(), 20)))
+	require.True(t, store.Has(types.ConsumerIdToScheduledKeyAssignmentKey("1", ids[0].SDKValOpAddress(), 10)))
+
+	// an invalid provider address cannot be stored
+	require.Error(t, providerKeeper.SetScheduledKeyAssignment(ctx,
+		types.ScheduledKeyAssignment{ConsumerId: "0", ProviderAddr: "invalid", ConsumerKey: consumerKeyJson(ids[1]), ActivationHeight: 5}))
+}
+
+func TestScheduleAndApplyKeyAssignments(t *testing.T) {
+	keeperParams := testkeeper.NewInMemKeeperParams(t)
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, keeperParams)
+	defer ctrl.Finish()
+	ctx = ctx.WithBlockHeight(10)
+
+	consumerId := "0"
+	providerIds := cryptotestutil.GenMultipleCryptoIds(2, 0)
+	consumerIds := cryptotestutil.GenMultipleCryptoIds(3, 10)
+
+	providerKeeper.SetConsumerPhase(ctx, consumerId, types.CONSUMER_PHASE_LAUNCHED)
+
+	// the first validator exists, while the second one is removed before its key assignment is applied
+	mocks.MockStakingKeeper.EXPECT().GetValidator(gomock.Any(), providerIds[0].SDKValOpAddress()).
+		Return(providerIds[0].SDKStakingValidator(), nil).AnyTimes()
+	mocks.MockStakingKeeper.EXPECT().GetValidator(gomock.Any(), providerIds[1].SDKValOpAddress()).
+		Return(stakingtypes.Validator{}, stakingtypes.ErrNoValidatorFound).AnyTimes()
+	mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(gomock.Any(), gomock.Any()).
+		Return(stakingtypes.Validator{}, stakingtypes.ErrNoValidatorFound).AnyTimes()
+	mocks.MockStakingKeeper.EXPECT().UnbondingTime(gomock.Any()).Return(time.Hour, nil).AnyTimes()
+
+	keyAssignment := types.ScheduledKeyAssignment{
+		ConsumerId:       consumerId,
+		ProviderAddr:     providerIds[0].SDKValOpAddressString(),
+		ConsumerKey:      consumerKeyJson(consumerIds[0]),
+		ActivationHeight: 10,
+	}
+
+	// the activation height must be in the future
+	err := providerKeeper.ScheduleKeyAssignment(ctx, keyAssignment)
+	require.ErrorIs(t, err, types.ErrInvalidActivationHeight)
+
+	// the activation height must be at most MaxKeyAssignmentActivationDistance blocks in the future
+	keyAssignment.ActivationHeight = ctx.BlockHeight() + types.MaxKeyAssignmentActivationDistance + 1
+	err = providerKeeper.ScheduleKeyAssignment(ctx, keyAssignment)
+	require.ErrorIs(t, err, types.ErrInvalidActivationHeight)
+
+	// a key assignment that cannot be applied is rejected
+	err = providerKeeper.ScheduleKeyAssignment(ctx, types.ScheduledKeyAssignment{
+		ConsumerId:       consumerId,
+		ProviderAddr:     providerIds[1].SDKValOpAddressString(),
+		ConsumerKey:      consumerKeyJson(consumerIds[1]),
+		ActivationHeight: 11,
+	})
+	require.ErrorIs(t, err, stakingtypes.ErrNoValidatorFound)
+
+	// the key assignment is stored, but not applied
+	keyAssignment.ActivationHeight = 12
+	require.NoError(t, providerKeeper.ScheduleKeyAssignment(ctx, keyAssignment))
+	_, found := providerKeeper.GetValidatorConsumerPubKey(ctx, consumerId, providerIds[0].ProviderConsAddress())
+	require.False(t, found)
+
+	// the key assignment of a removed validator is skipped at its activation height
+	require.NoError(t, providerKeeper.SetScheduledKeyAssignment(ctx, types.ScheduledKeyAssignment{
+		ConsumerId:       consumerId,
+		ProviderAddr:     providerIds[1].SDKValOpAddressString(),
+		ConsumerKey:      consumerKeyJson(consumerIds[1]),
+		ActivationHeight: 11,
+	}))
+	ctx = ctx.WithBlockHeight(11)
+	providerKeeper.BeginBlockApplyScheduledKeyAssignments(ctx)
+	_, found = providerKeeper.GetValidatorConsumerPubKey(ctx, consumerId, providerIds[1].ProviderConsAddress())
+	require.False(t, found)
+	require.Equal(t, []types.ScheduledKeyAssignment{keyAssignment}, providerKeeper.GetAllScheduledKeyAssignments(ctx))
+
+	// the key assignment is applied at its activation height
+	ctx = ctx.WithBlockHeight(12)
+	providerKeeper.BeginBlockApplyScheduledKeyAssignments(ctx)
+	consumerKey, found := providerKeeper.GetValidatorConsumerPubKey(ctx, consumerId, providerIds[0].ProviderConsAddress())
+	require.True(t, found)
+	require.Equal(t, consumerIds[0].TMProtoCryptoPublicKey(), consumerKey)
+	require.Empty(t, providerKeeper.GetAllScheduledKeyAssignments(ctx))
+	require.False(t, ctx.KVStore(keeperParams.StoreKey).Has(
+		types.ConsumerIdToScheduledKeyAssignmentKey(consumerId, providerIds[0].SDKValOpAddress(), 12)))
+
+	// a validator can schedule at most MaxScheduledKeyAssignmentsPerValidator key assignments for a consumer chain
+	keyAssignment.ConsumerKey = consumerKeyJson(consumerIds[2])
+	for i := 1; i <= types.MaxScheduledKeyAssignmentsPerValidator; i++ {
+		keyAssignment.ActivationHeight = ctx.BlockHeight() + int64(i)
+		require.NoError(t, providerKeeper.ScheduleKeyAssignment(ctx, keyAssignment))
+	}
+	keyAssignment.ActivationHeight = ctx.BlockHeight() + types.MaxScheduledKeyAssignmentsPerValidator + 1
+	err = providerKeeper.ScheduleKeyAssignment(ctx, keyAssignment)
+	require.ErrorIs(t, err, types.ErrTooManyScheduledKeyAssignments)
+
+	// a key assignment scheduled at the same activation height is replaced
+	keyAssignment.ActivationHeight = ctx.BlockHeight() + 1
+	keyAssignment.ConsumerKey = consumerKeyJson(consumerIds[1])
+	require.NoError(t, providerKeeper.ScheduleKeyAssignment(ctx, keyAssignment))
+	require.Len(t, providerKeeper.GetAllScheduledKeyAssignments(ctx), types.MaxScheduledKeyAssignmentsPerValidator)
+}
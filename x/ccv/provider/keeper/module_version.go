@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// The built-in features of the provider module reported by QueryModuleVersionInfo,
+// in addition to the features enabled with WithFeatureFlags
+const (
+	// FeaturePermissionlessConsumers is the creation of consumer chains with MsgCreateConsumer,
+	// i.e., without a governance proposal
+	FeaturePermissionlessConsumers = "permissionless-consumers"
+	// FeaturePartialSetSecurity is the validation of consumer chains by a subset of the provider validators,
+	// i.e., Top N and opt-in consumer chains
+	FeaturePartialSetSecurity = "partial-set-security"
+	// FeaturePerConsumerSlashMeters is the throttling of the slash packets of a consumer chain
+	// by a slash meter of its own
+	FeaturePerConsumerSlashMeters = "per-consumer-slash-meters"
+	// FeatureConsumerDoubleVotePackets is the handling of the double voting evidence
+	// forwarded by consumer chains in ConsumerDoubleVote packets
+	FeatureConsumerDoubleVotePackets = "consumer-double-vote-packets"
+	// FeatureInactiveValidators is enabled if the provider chain has inactive validators, i.e.,
+	// bonded validators that are not in the consensus validator set of the provider chain,
+	// but that can validate consumer chains
+	FeatureInactiveValidators = "inactive-validators"
+	// FeatureImmediateValidatorUpdates is enabled by the ImmediateValidatorUpdates param
+	FeatureImmediateValidatorUpdates = "immediate-validator-updates"
+	// FeatureImmediateDowntimeJailing is enabled by the ImmediateDowntimeJailing param
+	FeatureImmediateDowntimeJailing = "immediate-downtime-jailing"
+	// FeatureTimeBasedEpochs is enabled by the EpochDuration param
+	FeatureTimeBasedEpochs = "time-based-epochs"
+)
+
+// GetEnabledFeatures returns the features enabled on the provider chain, i.e., the built-in features,
+// the features enabled by the module params, and the features enabled with WithFeatureFlags
+func (k Keeper) GetEnabledFeatures(ctx sdk.Context) ([]string, error) {
+	features := []string{
+		FeaturePermissionlessConsumers,
+		FeaturePartialSetSecurity,
+		FeaturePerConsumerSlashMeters,
+		FeatureConsumerDoubleVotePackets,
+	}
+
+	maxValidators, err := k.stakingKeeper.MaxValidators(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if k.GetMaxProviderConsensusValidators(ctx) < int64(maxValidators) {
+		features = append(features, FeatureInactiveValidators)
+	}
+	if k.GetImmediateValidatorUpdates(ctx) {
+		features = append(features, FeatureImmediateValidatorUpdates)
+	}
+	if k.GetImmediateDowntimeJailing(ctx) {
+		features = append(features, FeatureImmediateDowntimeJailing)
+	}
+	if k.GetEpochDuration(ctx) > 0 {
+		features = append(features, FeatureTimeBasedEpochs)
+	}
+
+	for feature, enabled := range k.features {
+		if enabled {
+			features = append(features, feature)
+		}
+	}
+
+	return features, nil
+}
+
+// GetModuleVersionInfo returns the version of the provider module and the features enabled on the provider chain
+func (k Keeper) GetModuleVersionInfo(ctx sdk.Context) (ccv.ModuleVersionInfo, error) {
+	features, err := k.GetEnabledFeatures(ctx)
+	if err != nil {
+		return ccv.ModuleVersionInfo{}, err
+	}
+	return ccv.NewModuleVersionInfo(types.ConsensusVersion, features), nil
+}
@@ -1,8 +1,10 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -52,7 +54,7 @@ func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParam
 func (k msgServer) AssignConsumerKey(goCtx context.Context, msg *types.MsgAssignConsumerKey) (*types.MsgAssignConsumerKeyResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	providerValidatorAddr, err := sdk.ValAddressFromBech32(msg.ProviderAddr)
+	providerValidatorAddr, err := k.ValidatorAddressCodec().StringToBytes(msg.ProviderAddr)
 	if err != nil {
 		return nil, err
 	}
@@ -70,6 +72,39 @@ func (k msgServer) AssignConsumerKey(goCtx context.Context, msg *types.MsgAssign
 		return nil, err
 	}
 
+	if msg.ActivationHeight != 0 {
+		// the key assignment is applied in the BeginBlock of the activation height
+		if err := k.Keeper.ScheduleKeyAssignment(ctx, types.ScheduledKeyAssignment{
+			ConsumerId:       msg.ConsumerId,
+			ProviderAddr:     msg.ProviderAddr,
+			ConsumerKey:      msg.ConsumerKey,
+			ActivationHeight: msg.ActivationHeight,
+		}); err != nil {
+			return nil, err
+		}
+
+		k.Logger(ctx).Info("validator scheduled consumer key assignment",
+			"consumerId", msg.ConsumerId,
+			"validator operator addr", msg.ProviderAddr,
+			"consumer public key", msg.ConsumerKey,
+			"activation height", msg.ActivationHeight,
+		)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeScheduleConsumerKeyAssignment,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				sdk.NewAttribute(types.AttributeConsumerId, msg.ConsumerId),
+				sdk.NewAttribute(types.AttributeProviderValidatorAddress, msg.ProviderAddr),
+				sdk.NewAttribute(types.AttributeConsumerConsensusPubKey, msg.ConsumerKey),
+				sdk.NewAttribute(types.AttributeActivationHeight, strconv.FormatInt(msg.ActivationHeight, 10)),
+				sdk.NewAttribute(types.AttributeSubmitterAddress, msg.Signer),
+			),
+		)
+
+		return &types.MsgAssignConsumerKeyResponse{}, nil
+	}
+
 	if err := k.Keeper.AssignConsumerKey(ctx, msg.ConsumerId, validator, consumerTMPublicKey); err != nil {
 		return nil, err
 	}
@@ -101,6 +136,50 @@ func (k msgServer) AssignConsumerKey(goCtx context.Context, msg *types.MsgAssign
 	return &types.MsgAssignConsumerKeyResponse{}, nil
 }
 
+// AssignConsumerKeyWithProof defines a method to assign a consensus key on a consumer chain
+// for a given validator on the provider, provided that the validator proves that it controls the key
+func (k msgServer) AssignConsumerKeyWithProof(goCtx context.Context, msg *types.MsgAssignConsumerKeyWithProof) (*types.MsgAssignConsumerKeyWithProofResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	consumerTMPublicKey, err := k.ParseConsumerKey(msg.ConsumerKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.VerifyKeyPossessionProof(ctx, msg.ConsumerId, msg.ProviderAddr, consumerTMPublicKey, msg.KeyPossessionProof); err != nil {
+		return nil, err
+	}
+
+	if _, err := k.AssignConsumerKey(goCtx, msg.ToMsgAssignConsumerKey()); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAssignConsumerKeyWithProofResponse{}, nil
+}
+
+// ChangeConsumerCreatorAllowlist defines a rpc handler method for MsgChangeConsumerCreatorAllowlist
+func (k msgServer) ChangeConsumerCreatorAllowlist(goCtx context.Context, msg *types.MsgChangeConsumerCreatorAllowlist) (*types.MsgChangeConsumerCreatorAllowlistResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	eventAttributes, err := k.Keeper.ChangeConsumerCreatorAllowlist(ctx, msg.AddressesToAdd, msg.AddressesToRemove)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidMsgChangeConsumerCreatorAllowlist, "%s", err.Error())
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeChangeConsumerCreatorAllowlist,
+			eventAttributes...,
+		),
+	)
+
+	return &types.MsgChangeConsumerCreatorAllowlistResponse{}, nil
+}
+
 // ChangeRewardDenoms defines a rpc handler method for MsgChangeRewardDenoms
 func (k msgServer) ChangeRewardDenoms(goCtx context.Context, msg *types.MsgChangeRewardDenoms) (*types.MsgChangeRewardDenomsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -127,19 +206,8 @@ func (k msgServer) SubmitConsumerMisbehaviour(goCtx context.Context, msg *types.
 		return nil, err
 	}
 
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			ccvtypes.EventTypeSubmitConsumerMisbehaviour,
-			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
-			sdk.NewAttribute(types.AttributeConsumerId, msg.ConsumerId),
-			sdk.NewAttribute(types.AttributeConsumerChainId, msg.Misbehaviour.Header1.Header.ChainID),
-			sdk.NewAttribute(ccvtypes.AttributeConsumerMisbehaviour, msg.Misbehaviour.String()),
-			sdk.NewAttribute(ccvtypes.AttributeMisbehaviourClientId, msg.Misbehaviour.ClientId),
-			sdk.NewAttribute(ccvtypes.AttributeMisbehaviourHeight1, msg.Misbehaviour.Header1.GetHeight().String()),
-			sdk.NewAttribute(ccvtypes.AttributeMisbehaviourHeight2, msg.Misbehaviour.Header2.GetHeight().String()),
-			sdk.NewAttribute(types.AttributeSubmitterAddress, msg.Submitter),
-		),
-	)
+	k.EmitSubmitConsumerMisbehaviourEvent(ctx, msg.ConsumerId, *msg.Misbehaviour,
+		sdk.NewAttribute(types.AttributeSubmitterAddress, msg.Submitter))
 
 	return &types.MsgSubmitConsumerMisbehaviourResponse{}, nil
 }
@@ -181,16 +249,8 @@ func (k msgServer) SubmitConsumerDoubleVoting(goCtx context.Context, msg *types.
 		return nil, err
 	}
 
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			ccvtypes.EventTypeSubmitConsumerDoubleVoting,
-			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
-			sdk.NewAttribute(types.AttributeConsumerId, msg.ConsumerId),
-			sdk.NewAttribute(types.AttributeConsumerChainId, msg.InfractionBlockHeader.Header.ChainID),
-			sdk.NewAttribute(ccvtypes.AttributeConsumerDoubleVoting, msg.DuplicateVoteEvidence.String()),
-			sdk.NewAttribute(types.AttributeSubmitterAddress, msg.Submitter),
-		),
-	)
+	k.EmitSubmitConsumerDoubleVotingEvent(ctx, consumerId, msg.InfractionBlockHeader.Header.ChainID, msg.DuplicateVoteEvidence,
+		sdk.NewAttribute(types.AttributeSubmitterAddress, msg.Submitter))
 
 	return &types.MsgSubmitConsumerDoubleVotingResponse{}, nil
 }
@@ -198,7 +258,7 @@ func (k msgServer) SubmitConsumerDoubleVoting(goCtx context.Context, msg *types.
 func (k msgServer) OptIn(goCtx context.Context, msg *types.MsgOptIn) (*types.MsgOptInResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	valAddress, err := sdk.ValAddressFromBech32(msg.ProviderAddr)
+	valAddress, err := k.ValidatorAddressCodec().StringToBytes(msg.ProviderAddr)
 	if err != nil {
 		return nil, err
 	}
@@ -250,7 +310,7 @@ func (k msgServer) OptIn(goCtx context.Context, msg *types.MsgOptIn) (*types.Msg
 func (k msgServer) OptOut(goCtx context.Context, msg *types.MsgOptOut) (*types.MsgOptOutResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	valAddress, err := sdk.ValAddressFromBech32(msg.ProviderAddr)
+	valAddress, err := k.ValidatorAddressCodec().StringToBytes(msg.ProviderAddr)
 	if err != nil {
 		return nil, err
 	}
@@ -300,7 +360,7 @@ func (k msgServer) OptOut(goCtx context.Context, msg *types.MsgOptOut) (*types.M
 func (k msgServer) SetConsumerCommissionRate(goCtx context.Context, msg *types.MsgSetConsumerCommissionRate) (*types.MsgSetConsumerCommissionRateResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	providerValidatorAddr, err := sdk.ValAddressFromBech32(msg.ProviderAddr)
+	providerValidatorAddr, err := k.ValidatorAddressCodec().StringToBytes(msg.ProviderAddr)
 	if err != nil {
 		return nil, err
 	}
@@ -352,6 +412,11 @@ func (k msgServer) CreateConsumer(goCtx context.Context, msg *types.MsgCreateCon
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	resp := types.MsgCreateConsumerResponse{}
 
+	if !k.Keeper.CanCreateConsumer(ctx, msg.Submitter) {
+		return &resp, errorsmod.Wrapf(types.ErrConsumerCreatorNotAllowlisted,
+			"submitter (%s) is not in the allowlist of consumer creators", msg.Submitter)
+	}
+
 	// initialize an empty slice to store event attributes
 	eventAttributes := []sdk.Attribute{}
 
@@ -381,11 +446,19 @@ func (k msgServer) CreateConsumer(goCtx context.Context, msg *types.MsgCreateCon
 	initializationParameters := types.DefaultConsumerInitializationParameters() // default params
 	if msg.InitializationParameters != nil {
 		initializationParameters = *msg.InitializationParameters
+	} else {
+		// the default initial height is corrected to the revision number of the chain id
+		initializationParameters.InitialHeight = types.ExpectedInitialHeight(initializationParameters.InitialHeight, msg.ChainId)
 	}
 	if err := k.Keeper.SetConsumerInitializationParameters(ctx, consumerId, initializationParameters); err != nil {
 		return &resp, errorsmod.Wrapf(types.ErrInvalidConsumerInitializationParameters,
 			"cannot set consumer initialization parameters: %s", err.Error())
 	}
+	if err := k.Keeper.RegisterConsumerHashes(ctx, consumerId,
+		initializationParameters.GenesisHash, initializationParameters.BinaryHash); err != nil {
+		return &resp, errorsmod.Wrapf(ccvtypes.ErrInvalidConsumerState,
+			"cannot record consumer hashes: %s", err.Error())
+	}
 
 	// power-shaping parameters are optional and hence could be nil;
 	// in that case, set the default
@@ -425,15 +498,41 @@ func (k msgServer) CreateConsumer(goCtx context.Context, msg *types.MsgCreateCon
 			"cannot set consumer infraction parameters: %s", err.Error())
 	}
 
-	if spawnTime, initialized := k.Keeper.InitializeConsumer(ctx, consumerId); initialized {
+	// throttling parameters are optional and hence could be nil;
+	// in that case, the slash packets of the chain are throttled by the global slash meter
+	throttlingParameters := types.ThrottlingParameters{}
+	if msg.ThrottlingParameters != nil {
+		throttlingParameters = *msg.ThrottlingParameters
+	}
+	if err := k.Keeper.validateThrottlingParametersOwner(msg.Submitter, throttlingParameters); err != nil {
+		return &resp, err
+	}
+	if err := k.Keeper.SetConsumerThrottlingParameters(ctx, consumerId, throttlingParameters); err != nil {
+		return &resp, errorsmod.Wrapf(types.ErrInvalidConsumerThrottlingParameters,
+			"cannot set consumer throttling parameters: %s", err.Error())
+	}
+
+	// apply the key assignments agreed on in the provider genesis and
+	// the validator-signed key assignments provided by the owner
+	k.Keeper.ApplyPreLaunchKeyAssignments(ctx, consumerId, msg.ChainId)
+	if err := k.Keeper.AssignSignedConsumerKeys(ctx, consumerId, msg.ChainId, msg.Submitter, msg.KeyAssignments); err != nil {
+		return &resp, err
+	}
+
+	if spawnTime, spawnHeight, initialized := k.Keeper.InitializeConsumer(ctx, consumerId); initialized {
 		if err := k.Keeper.PrepareConsumerForLaunch(ctx, consumerId, time.Time{}, spawnTime); err != nil {
 			return &resp, errorsmod.Wrapf(ccvtypes.ErrInvalidConsumerState,
 				"prepare consumer for launch, consumerId(%s), spawnTime(%s): %s", consumerId, spawnTime, err.Error())
 		}
+		if err := k.Keeper.PrepareConsumerForLaunchAtHeight(ctx, consumerId, 0, spawnHeight); err != nil {
+			return &resp, errorsmod.Wrapf(ccvtypes.ErrInvalidConsumerState,
+				"prepare consumer for launch, consumerId(%s), spawnHeight(%d): %s", consumerId, spawnHeight, err.Error())
+		}
 
-		// add SpawnTime event attribute
+		// add SpawnTime and SpawnHeight event attributes
 		eventAttributes = append(eventAttributes,
-			sdk.NewAttribute(types.AttributeConsumerSpawnTime, initializationParameters.SpawnTime.String()))
+			sdk.NewAttribute(types.AttributeConsumerSpawnTime, initializationParameters.SpawnTime.String()),
+			sdk.NewAttribute(types.AttributeConsumerSpawnHeight, fmt.Sprintf("%d", initializationParameters.SpawnHeight)))
 	}
 
 	if msg.AllowlistedRewardDenoms != nil {
@@ -444,6 +543,10 @@ func (k msgServer) CreateConsumer(goCtx context.Context, msg *types.MsgCreateCon
 		}
 	}
 
+	if msg.RewardDenomHint != "" {
+		k.SetRewardDenomHint(ctx, consumerId, msg.RewardDenomHint)
+	}
+
 	// add Phase event attribute
 	phase := k.GetConsumerPhase(ctx, consumerId)
 	eventAttributes = append(eventAttributes, sdk.NewAttribute(types.AttributeConsumerPhase, phase.String()))
@@ -454,6 +557,7 @@ func (k msgServer) CreateConsumer(goCtx context.Context, msg *types.MsgCreateCon
 		"owner", msg.Submitter,
 		"phase", phase,
 		"spawn time", initializationParameters.SpawnTime,
+		"spawn height", initializationParameters.SpawnHeight,
 	)
 
 	ctx.EventManager().EmitEvent(
@@ -491,11 +595,15 @@ func (k msgServer) UpdateConsumer(goCtx context.Context, msg *types.MsgUpdateCon
 		return &resp, errorsmod.Wrapf(types.ErrUnauthorized, "expected owner address %s, got %s", ownerAddress, msg.Owner)
 	}
 
+	// the fields of the consumer chain before the update, used to record the fields changed by the update
+	previousFields := k.Keeper.getConsumerFields(ctx, consumerId)
+
 	chainId, err := k.GetConsumerChainId(ctx, consumerId)
 	if err != nil {
 		return &resp, errorsmod.Wrapf(ccvtypes.ErrInvalidConsumerState, "cannot get consumer chain ID: %s", err.Error())
 	}
 
+	chainIdUpdated := false
 	// We only validate and use `NewChainId` if it is not empty (because `NewChainId` is an optional argument)
 	// or `NewChainId` is different from the current chain id of the consumer chain.
 	if strings.TrimSpace(msg.NewChainId) != "" && msg.NewChainId != chainId {
@@ -506,8 +614,10 @@ func (k msgServer) UpdateConsumer(goCtx context.Context, msg *types.MsgUpdateCon
 		if k.IsConsumerPrelaunched(ctx, consumerId) {
 			chainId = msg.NewChainId
 			k.SetConsumerChainId(ctx, consumerId, chainId)
+			chainIdUpdated = true
 		} else {
-			// the chain id cannot be updated if the chain is NOT in a prelaunched (i.e., registered or initialized) phase
+			// the chain id cannot be updated if the chain is NOT in a prelaunched (i.e., registered or initialized) phase;
+			// the chain id of a launched chain can be changed with MsgChangeConsumerChainId
 			return &resp, errorsmod.Wrapf(types.ErrInvalidPhase, "cannot update chain id of a non-prelaunched chain: %s", k.GetConsumerPhase(ctx, consumerId))
 		}
 	}
@@ -520,6 +630,17 @@ func (k msgServer) UpdateConsumer(goCtx context.Context, msg *types.MsgUpdateCon
 		sdk.NewAttribute(types.AttributeSubmitterAddress, msg.Owner),
 	}...)
 
+	// without new initialization parameters, the revision number of the current initial height is
+	// corrected to the new chain id, so that the consumer client can be created at spawn time
+	if chainIdUpdated && msg.InitializationParameters == nil {
+		initialHeight, err := k.CorrectConsumerInitialHeight(ctx, consumerId)
+		if err != nil {
+			return &resp, errorsmod.Wrapf(ccvtypes.ErrInvalidConsumerState,
+				"cannot correct consumer initial height: %s", err.Error())
+		}
+		eventAttributes = append(eventAttributes, sdk.NewAttribute(types.AttributeInitialHeight, initialHeight.String()))
+	}
+
 	// The new owner address can be empty, in which case the consumer chain does not change its owner.
 	// However, if the new owner address is not empty, we verify that it's a valid account address.
 	if strings.TrimSpace(msg.NewOwnerAddress) != "" {
@@ -547,6 +668,11 @@ func (k msgServer) UpdateConsumer(goCtx context.Context, msg *types.MsgUpdateCon
 			"cannot get consumer initialized parameters, consumerId(%s): %s", consumerId, err.Error())
 	}
 	previousSpawnTime := previousInitializationParameters.SpawnTime
+	previousSpawnHeight := previousInitializationParameters.SpawnHeight
+
+	// a chain whose consumer client could not be created at spawn time is not scheduled for launch
+	// until its owner retries the launch with MsgRetryLaunch
+	launchRetriable := k.Keeper.IsConsumerLaunchRetriable(ctx, consumerId)
 
 	if msg.InitializationParameters != nil {
 		if !k.IsConsumerPrelaunched(ctx, consumerId) {
@@ -556,26 +682,40 @@ func (k msgServer) UpdateConsumer(goCtx context.Context, msg *types.MsgUpdateCon
 		}
 
 		phase := k.GetConsumerPhase(ctx, consumerId)
-		if msg.InitializationParameters.SpawnTime.IsZero() {
+		if msg.InitializationParameters.SpawnTime.IsZero() && msg.InitializationParameters.SpawnHeight == 0 {
 			if phase == types.CONSUMER_PHASE_INITIALIZED {
-				// chain was previously ready to launch at `previousSpawnTime` so we remove the
-				// consumer from getting launched and move it back to the Registered phase
-				err = k.RemoveConsumerToBeLaunched(ctx, consumerId, previousSpawnTime)
-				if err != nil {
-					return &resp, errorsmod.Wrapf(types.ErrInvalidMsgUpdateConsumer,
-						"cannot remove the consumer from being launched: %s", err.Error())
+				// chain was previously ready to launch at `previousSpawnTime` or `previousSpawnHeight` so we
+				// remove the consumer from getting launched and move it back to the Registered phase
+				if !launchRetriable {
+					if !previousSpawnTime.IsZero() {
+						err = k.RemoveConsumerToBeLaunched(ctx, consumerId, previousSpawnTime)
+					} else {
+						err = k.RemoveConsumerToBeLaunchedAtHeight(ctx, consumerId, previousSpawnHeight)
+					}
+					if err != nil {
+						return &resp, errorsmod.Wrapf(types.ErrInvalidMsgUpdateConsumer,
+							"cannot remove the consumer from being launched: %s", err.Error())
+					}
 				}
+				k.DeleteConsumerLaunchFailure(ctx, consumerId)
+				launchRetriable = false
 				k.SetConsumerPhase(ctx, consumerId, types.CONSUMER_PHASE_REGISTERED)
 			}
 		}
-		// add SpawnTime event attribute
+		// add SpawnTime and SpawnHeight event attributes
 		eventAttributes = append(eventAttributes,
-			sdk.NewAttribute(types.AttributeConsumerSpawnTime, msg.InitializationParameters.SpawnTime.String()))
+			sdk.NewAttribute(types.AttributeConsumerSpawnTime, msg.InitializationParameters.SpawnTime.String()),
+			sdk.NewAttribute(types.AttributeConsumerSpawnHeight, fmt.Sprintf("%d", msg.InitializationParameters.SpawnHeight)))
 
 		if err = k.Keeper.SetConsumerInitializationParameters(ctx, msg.ConsumerId, *msg.InitializationParameters); err != nil {
 			return &resp, errorsmod.Wrapf(types.ErrInvalidConsumerInitializationParameters,
 				"cannot set consumer initialization parameters: %s", err.Error())
 		}
+		if err = k.Keeper.UpdateConsumerHashCommitment(ctx, consumerId,
+			previousInitializationParameters, *msg.InitializationParameters); err != nil {
+			return &resp, errorsmod.Wrapf(ccvtypes.ErrInvalidConsumerState,
+				"cannot record consumer hashes update: %s", err.Error())
+		}
 	}
 
 	if msg.PowerShapingParameters != nil {
@@ -643,6 +783,14 @@ func (k msgServer) UpdateConsumer(goCtx context.Context, msg *types.MsgUpdateCon
 
 	}
 
+	if msg.ThrottlingParameters != nil {
+		// the throttling parameters apply immediately, since they do not affect how the validators are slashed
+		if err = k.Keeper.SetConsumerThrottlingParameters(ctx, consumerId, *msg.ThrottlingParameters); err != nil {
+			return &resp, errorsmod.Wrapf(types.ErrInvalidConsumerThrottlingParameters,
+				"cannot set throttling parameters: %s", err.Error())
+		}
+	}
+
 	// A Top N cannot change its owner address to something different from the gov module if the chain
 	// remains a Top N chain.
 	currentOwnerAddress, err := k.Keeper.GetConsumerOwnerAddress(ctx, consumerId)
@@ -660,12 +808,26 @@ func (k msgServer) UpdateConsumer(goCtx context.Context, msg *types.MsgUpdateCon
 			"a move to a new owner address that is not the gov module can only be done if `Top N` is set to 0")
 	}
 
-	if spawnTime, initialized := k.Keeper.InitializeConsumer(ctx, consumerId); initialized {
+	// Likewise, a consumer chain with a slash meter of its own must remain owned by the gov module.
+	currentThrottlingParameters, err := k.Keeper.GetConsumerThrottlingParameters(ctx, consumerId)
+	if err != nil {
+		return &resp, errorsmod.Wrapf(ccvtypes.ErrInvalidConsumerState, "cannot retrieve throttling parameters: %s", err.Error())
+	}
+	if err := k.Keeper.validateThrottlingParametersOwner(currentOwnerAddress, currentThrottlingParameters); err != nil {
+		return &resp, err
+	}
+
+	if spawnTime, spawnHeight, initialized := k.Keeper.InitializeConsumer(ctx, consumerId); initialized && !launchRetriable {
 		if err := k.Keeper.PrepareConsumerForLaunch(ctx, consumerId, previousSpawnTime, spawnTime); err != nil {
 			return &resp, errorsmod.Wrapf(ccvtypes.ErrInvalidConsumerState,
 				"prepare consumer for launch, consumerId(%s), previousSpawnTime(%s), spawnTime(%s): %s",
 				consumerId, previousSpawnTime, spawnTime, err.Error())
 		}
+		if err := k.Keeper.PrepareConsumerForLaunchAtHeight(ctx, consumerId, previousSpawnHeight, spawnHeight); err != nil {
+			return &resp, errorsmod.Wrapf(ccvtypes.ErrInvalidConsumerState,
+				"prepare consumer for launch, consumerId(%s), previousSpawnHeight(%d), spawnHeight(%d): %s",
+				consumerId, previousSpawnHeight, spawnHeight, err.Error())
+		}
 	}
 
 	if msg.AllowlistedRewardDenoms != nil {
@@ -675,6 +837,10 @@ func (k msgServer) UpdateConsumer(goCtx context.Context, msg *types.MsgUpdateCon
 		}
 	}
 
+	// record the update together with the fields it changed
+	k.Keeper.AppendConsumerUpdateRecord(ctx, consumerId, msg.Owner,
+		diffConsumerFields(previousFields, k.Keeper.getConsumerFields(ctx, consumerId)))
+
 	// add Owner event attribute
 	eventAttributes = append(eventAttributes, sdk.NewAttribute(types.AttributeConsumerOwner, currentOwnerAddress))
 
@@ -722,7 +888,7 @@ func (k msgServer) RemoveConsumer(goCtx context.Context, msg *types.MsgRemoveCon
 	}
 
 	phase := k.Keeper.GetConsumerPhase(ctx, consumerId)
-	if phase != types.CONSUMER_PHASE_LAUNCHED {
+	if phase != types.CONSUMER_PHASE_LAUNCHED && phase != types.CONSUMER_PHASE_PAUSED {
 		return &resp, errorsmod.Wrapf(types.ErrInvalidPhase,
 			"chain with consumer id: %s has to be in its launched phase", consumerId)
 	}
@@ -747,3 +913,515 @@ func (k msgServer) RemoveConsumer(goCtx context.Context, msg *types.MsgRemoveCon
 
 	return &resp, err
 }
+
+// ForceRemoveConsumer defines an RPC handler method for MsgForceRemoveConsumer
+func (k msgServer) ForceRemoveConsumer(goCtx context.Context, msg *types.MsgForceRemoveConsumer) (*types.MsgForceRemoveConsumerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	consumerId := msg.ConsumerId
+	chainId, err := k.GetConsumerChainId(ctx, consumerId)
+	if err != nil {
+		return nil, errorsmod.Wrapf(ccvtypes.ErrInvalidConsumerState, "cannot get consumer chain ID: %s", err.Error())
+	}
+
+	phase := k.Keeper.GetConsumerPhase(ctx, consumerId)
+	if err := k.Keeper.ForceStopConsumer(ctx, consumerId, msg.Supermajority); err != nil {
+		return nil, err
+	}
+
+	removalTime, err := k.Keeper.GetConsumerRemovalTime(ctx, consumerId)
+	if err != nil {
+		return nil, errorsmod.Wrapf(ccvtypes.ErrInvalidConsumerState, "cannot get removal time: %s", err.Error())
+	}
+
+	k.Logger(ctx).Info("force stopped consumer",
+		"consumerId", consumerId,
+		"chainId", chainId,
+		"phase", phase,
+		"reason", msg.Reason,
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeForceRemoveConsumer,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeConsumerId, consumerId),
+			sdk.NewAttribute(types.AttributeConsumerChainId, chainId),
+			sdk.NewAttribute(types.AttributeConsumerPhase, phase.String()),
+			sdk.NewAttribute(types.AttributeRemovalReason, msg.Reason),
+			sdk.NewAttribute(types.AttributeConsumerRemovalTime, removalTime.String()),
+		),
+	)
+
+	return &types.MsgForceRemoveConsumerResponse{}, nil
+}
+
+// SetConsumerInitialConsensusState defines an RPC handler method for MsgSetConsumerInitialConsensusState
+func (k msgServer) SetConsumerInitialConsensusState(goCtx context.Context, msg *types.MsgSetConsumerInitialConsensusState) (*types.MsgSetConsumerInitialConsensusStateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	resp := types.MsgSetConsumerInitialConsensusStateResponse{}
+
+	consumerId := msg.ConsumerId
+	ownerAddress, err := k.Keeper.GetConsumerOwnerAddress(ctx, consumerId)
+	if err != nil {
+		return &resp, errorsmod.Wrapf(types.ErrNoOwnerAddress, "cannot retrieve owner address %s", ownerAddress)
+	}
+
+	if msg.Owner != ownerAddress {
+		return &resp, errorsmod.Wrapf(types.ErrUnauthorized, "expected owner address %s, got %s", ownerAddress, msg.Owner)
+	}
+
+	if !k.Keeper.IsConsumerPrelaunched(ctx, consumerId) {
+		return &resp, errorsmod.Wrapf(types.ErrInvalidPhase,
+			"the initial consensus state can only be set before the chain launches (consumer id: %s)", consumerId)
+	}
+
+	initializationParameters, err := k.Keeper.GetConsumerInitializationParameters(ctx, consumerId)
+	if err != nil {
+		return &resp, errorsmod.Wrapf(ccvtypes.ErrInvalidConsumerState,
+			"cannot get consumer initialization parameters: %s", err.Error())
+	}
+
+	if initializationParameters.ConnectionId != "" {
+		return &resp, errorsmod.Wrapf(types.ErrInvalidConsumerInitialConsensusState,
+			"consumer chain with consumer id %s launches on top of connection %s and no client is created",
+			consumerId, initializationParameters.ConnectionId)
+	}
+
+	if !bytes.Equal(msg.InitialConsensusState.GenesisHash, initializationParameters.GenesisHash) {
+		return &resp, errorsmod.Wrapf(types.ErrInvalidConsumerInitialConsensusState,
+			"genesis hash (%X) does not match the genesis hash in the initialization parameters (%X)",
+			msg.InitialConsensusState.GenesisHash, initializationParameters.GenesisHash)
+	}
+
+	k.Keeper.SetConsumerInitialConsensusState(ctx, consumerId, msg.InitialConsensusState)
+
+	k.Logger(ctx).Info("set consumer initial consensus state",
+		"consumerId", consumerId,
+		"genesisHash", fmt.Sprintf("%X", msg.InitialConsensusState.GenesisHash),
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetConsumerInitialConsensusState,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeConsumerId, consumerId),
+			sdk.NewAttribute(types.AttributeGenesisHash, fmt.Sprintf("%X", msg.InitialConsensusState.GenesisHash)),
+			sdk.NewAttribute(types.AttributeValsetHash, fmt.Sprintf("%X", msg.InitialConsensusState.ConsensusState.NextValidatorsHash)),
+			sdk.NewAttribute(types.AttributeSubmitterAddress, msg.Owner),
+		),
+	)
+
+	return &resp, nil
+}
+
+// SendEmergencyValsetUpdate defines an RPC handler method for MsgSendEmergencyValsetUpdate
+func (k msgServer) SendEmergencyValsetUpdate(goCtx context.Context, msg *types.MsgSendEmergencyValsetUpdate) (*types.MsgSendEmergencyValsetUpdateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	valUpdateID, err := k.Keeper.SendEmergencyValsetUpdate(ctx, msg.ConsumerId)
+	if err != nil {
+		return nil, err
+	}
+
+	k.Logger(ctx).Info("emergency validator set update sent",
+		"consumerId", msg.ConsumerId,
+		"vscID", valUpdateID,
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSendEmergencyValsetUpdate,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeConsumerId, msg.ConsumerId),
+			sdk.NewAttribute(ccvtypes.AttributeValSetUpdateID, strconv.FormatUint(valUpdateID, 10)),
+		),
+	)
+
+	return &types.MsgSendEmergencyValsetUpdateResponse{ValsetUpdateId: valUpdateID}, nil
+}
+
+// SetTopNBudget defines an RPC handler method for MsgSetTopNBudget
+func (k msgServer) SetTopNBudget(goCtx context.Context, msg *types.MsgSetTopNBudget) (*types.MsgSetTopNBudgetResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	providerValidatorAddr, err := k.ValidatorAddressCodec().StringToBytes(msg.ProviderAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	// validator must already be registered
+	validator, err := k.stakingKeeper.GetValidator(ctx, providerValidatorAddr)
+	if err != nil {
+		return nil, stakingtypes.ErrNoValidatorFound
+	}
+
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.HandleSetTopNBudget(ctx, types.NewProviderConsAddress(consAddr), msg.MaxTopNConsumers); err != nil {
+		return nil, err
+	}
+
+	k.Logger(ctx).Info("validator set Top N budget",
+		"validator operator addr", msg.ProviderAddr,
+		"budget", msg.MaxTopNConsumers,
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetTopNBudget,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeProviderValidatorAddress, msg.ProviderAddr),
+			sdk.NewAttribute(types.AttributeTopNBudget, fmt.Sprintf("%d", msg.MaxTopNConsumers)),
+			sdk.NewAttribute(types.AttributeSubmitterAddress, msg.Signer),
+		),
+	)
+
+	return &types.MsgSetTopNBudgetResponse{}, nil
+}
+
+// RetryLaunch defines an RPC handler method for MsgRetryLaunch
+func (k msgServer) RetryLaunch(goCtx context.Context, msg *types.MsgRetryLaunch) (*types.MsgRetryLaunchResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	resp := types.MsgRetryLaunchResponse{}
+
+	consumerId := msg.ConsumerId
+	ownerAddress, err := k.Keeper.GetConsumerOwnerAddress(ctx, consumerId)
+	if err != nil {
+		return &resp, errorsmod.Wrapf(types.ErrNoOwnerAddress, "cannot retrieve owner address %s", ownerAddress)
+	}
+
+	if msg.Owner != ownerAddress {
+		return &resp, errorsmod.Wrapf(types.ErrUnauthorized, "expected owner address %s, got %s", ownerAddress, msg.Owner)
+	}
+
+	if err := k.Keeper.RetryConsumerLaunch(ctx, consumerId); err != nil {
+		return &resp, err
+	}
+
+	k.Logger(ctx).Info("retry consumer launch",
+		"consumerId", consumerId,
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRetryConsumerLaunch,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeConsumerId, consumerId),
+			sdk.NewAttribute(types.AttributeSubmitterAddress, msg.Owner),
+		),
+	)
+
+	return &resp, nil
+}
+
+// AttestConsumerHashes defines an RPC handler method for MsgAttestConsumerHashes
+func (k msgServer) AttestConsumerHashes(goCtx context.Context, msg *types.MsgAttestConsumerHashes) (*types.MsgAttestConsumerHashesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	resp := types.MsgAttestConsumerHashesResponse{}
+
+	consumerId := msg.ConsumerId
+	ownerAddress, err := k.Keeper.GetConsumerOwnerAddress(ctx, consumerId)
+	if err != nil {
+		return &resp, errorsmod.Wrapf(types.ErrNoOwnerAddress, "cannot retrieve owner address %s", ownerAddress)
+	}
+
+	if msg.Owner != ownerAddress {
+		return &resp, errorsmod.Wrapf(types.ErrUnauthorized, "expected owner address %s, got %s", ownerAddress, msg.Owner)
+	}
+
+	if err := k.Keeper.AttestConsumerHashes(ctx, consumerId, msg.GenesisHash, msg.BinaryHash); err != nil {
+		return &resp, err
+	}
+
+	k.Logger(ctx).Info("attested consumer hashes",
+		"consumerId", consumerId,
+		"genesisHash", fmt.Sprintf("%X", msg.GenesisHash),
+		"binaryHash", fmt.Sprintf("%X", msg.BinaryHash),
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAttestConsumerHashes,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeConsumerId, consumerId),
+			sdk.NewAttribute(types.AttributeGenesisHash, fmt.Sprintf("%X", msg.GenesisHash)),
+			sdk.NewAttribute(types.AttributeBinaryHash, fmt.Sprintf("%X", msg.BinaryHash)),
+			sdk.NewAttribute(types.AttributeSubmitterAddress, msg.Owner),
+		),
+	)
+
+	return &resp, nil
+}
+
+// ChangeConsumerChainId defines an RPC handler method for MsgChangeConsumerChainId
+func (k msgServer) ChangeConsumerChainId(goCtx context.Context, msg *types.MsgChangeConsumerChainId) (*types.MsgChangeConsumerChainIdResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	resp := types.MsgChangeConsumerChainIdResponse{}
+
+	consumerId := msg.ConsumerId
+	ownerAddress, err := k.Keeper.GetConsumerOwnerAddress(ctx, consumerId)
+	if err != nil {
+		return &resp, errorsmod.Wrapf(types.ErrNoOwnerAddress, "cannot retrieve owner address %s", ownerAddress)
+	}
+
+	if msg.Owner != ownerAddress {
+		return &resp, errorsmod.Wrapf(types.ErrUnauthorized, "expected owner address %s, got %s", ownerAddress, msg.Owner)
+	}
+
+	chainId, err := k.GetConsumerChainId(ctx, consumerId)
+	if err != nil {
+		return &resp, errorsmod.Wrapf(ccvtypes.ErrInvalidConsumerState, "cannot get consumer chain ID: %s", err.Error())
+	}
+
+	if err := k.Keeper.ScheduleConsumerChainIdChange(ctx, consumerId, msg.NewChainId); err != nil {
+		return &resp, err
+	}
+
+	k.Logger(ctx).Info("scheduled consumer chain id change",
+		"consumerId", consumerId,
+		"chainId", chainId,
+		"newChainId", msg.NewChainId,
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeChangeConsumerChainId,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeConsumerId, consumerId),
+			sdk.NewAttribute(types.AttributeConsumerChainId, chainId),
+			sdk.NewAttribute(types.AttributeNewConsumerChainId, msg.NewChainId),
+			sdk.NewAttribute(types.AttributeSubmitterAddress, msg.Owner),
+		),
+	)
+
+	return &resp, nil
+}
+
+// EjectConsumerValidator defines an RPC handler method for MsgEjectConsumerValidator
+func (k msgServer) EjectConsumerValidator(goCtx context.Context, msg *types.MsgEjectConsumerValidator) (*types.MsgEjectConsumerValidatorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	consumerId := msg.ConsumerId
+	chainId, err := k.GetConsumerChainId(ctx, consumerId)
+	if err != nil {
+		return nil, errorsmod.Wrapf(ccvtypes.ErrInvalidConsumerState, "cannot get consumer chain ID: %s", err.Error())
+	}
+
+	consAddr, err := k.ConsensusAddressCodec().StringToBytes(msg.ProviderConsAddr)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidMsgEjectConsumerValidator, "ProviderConsAddr: %s", err.Error())
+	}
+	providerAddr := types.NewProviderConsAddress(consAddr)
+
+	keyPruned, err := k.Keeper.EjectConsumerValidator(ctx, consumerId, providerAddr, msg.PruneConsumerKey)
+	if err != nil {
+		return nil, err
+	}
+
+	k.Logger(ctx).Info("ejected validator from consumer",
+		"consumerId", consumerId,
+		"chainId", chainId,
+		"providerConsAddr", msg.ProviderConsAddr,
+		"consumerKeyPruned", keyPruned,
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeEjectConsumerValidator,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeConsumerId, consumerId),
+			sdk.NewAttribute(types.AttributeConsumerChainId, chainId),
+			sdk.NewAttribute(types.AttributeProviderConsensusAddress, msg.ProviderConsAddr),
+			sdk.NewAttribute(types.AttributeConsumerKeyPruned, strconv.FormatBool(keyPruned)),
+		),
+	)
+
+	return &types.MsgEjectConsumerValidatorResponse{}, nil
+}
+
+// PauseConsumer defines an RPC handler method for MsgPauseConsumer
+func (k msgServer) PauseConsumer(goCtx context.Context, msg *types.MsgPauseConsumer) (*types.MsgPauseConsumerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	consumerId := msg.ConsumerId
+	chainId, err := k.GetConsumerChainId(ctx, consumerId)
+	if err != nil {
+		return nil, errorsmod.Wrapf(ccvtypes.ErrInvalidConsumerState, "cannot get consumer chain ID: %s", err.Error())
+	}
+
+	if err := k.Keeper.PauseConsumer(ctx, consumerId); err != nil {
+		return nil, err
+	}
+
+	k.Logger(ctx).Info("paused consumer",
+		"consumerId", consumerId,
+		"chainId", chainId,
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypePauseConsumer,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeConsumerId, consumerId),
+			sdk.NewAttribute(types.AttributeConsumerChainId, chainId),
+		),
+	)
+
+	return &types.MsgPauseConsumerResponse{}, nil
+}
+
+// ResumeConsumer defines an RPC handler method for MsgResumeConsumer
+func (k msgServer) ResumeConsumer(goCtx context.Context, msg *types.MsgResumeConsumer) (*types.MsgResumeConsumerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.GetAuthority() != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Authority)
+	}
+
+	consumerId := msg.ConsumerId
+	chainId, err := k.GetConsumerChainId(ctx, consumerId)
+	if err != nil {
+		return nil, errorsmod.Wrapf(ccvtypes.ErrInvalidConsumerState, "cannot get consumer chain ID: %s", err.Error())
+	}
+
+	if err := k.Keeper.ResumeConsumer(ctx, consumerId); err != nil {
+		return nil, err
+	}
+
+	k.Logger(ctx).Info("resumed consumer",
+		"consumerId", consumerId,
+		"chainId", chainId,
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeResumeConsumer,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeConsumerId, consumerId),
+			sdk.NewAttribute(types.AttributeConsumerChainId, chainId),
+		),
+	)
+
+	return &types.MsgResumeConsumerResponse{}, nil
+}
+
+// RegisterConsumerClientUpgrade defines an RPC handler method for MsgRegisterConsumerClientUpgrade
+func (k msgServer) RegisterConsumerClientUpgrade(goCtx context.Context, msg *types.MsgRegisterConsumerClientUpgrade) (*types.MsgRegisterConsumerClientUpgradeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	resp := types.MsgRegisterConsumerClientUpgradeResponse{}
+
+	consumerId := msg.ConsumerId
+	ownerAddress, err := k.Keeper.GetConsumerOwnerAddress(ctx, consumerId)
+	if err != nil {
+		return &resp, errorsmod.Wrapf(types.ErrNoOwnerAddress, "cannot retrieve owner address %s", ownerAddress)
+	}
+
+	if msg.Owner != ownerAddress {
+		return &resp, errorsmod.Wrapf(types.ErrUnauthorized, "expected owner address %s, got %s", ownerAddress, msg.Owner)
+	}
+
+	if err := k.Keeper.RegisterConsumerClientUpgrade(ctx, consumerId, msg.Plan); err != nil {
+		return &resp, err
+	}
+
+	k.Logger(ctx).Info("registered consumer client upgrade",
+		"consumerId", consumerId,
+		"upgradeHeight", msg.Plan.UpgradeHeight.String(),
+		"newChainId", msg.Plan.NewChainId,
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRegisterConsumerClientUpgrade,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeConsumerId, consumerId),
+			sdk.NewAttribute(types.AttributeUpgradeHeight, msg.Plan.UpgradeHeight.String()),
+			sdk.NewAttribute(types.AttributeNewConsumerChainId, msg.Plan.NewChainId),
+			sdk.NewAttribute(types.AttributeUnbondingPeriod, msg.Plan.UnbondingPeriod.String()),
+			sdk.NewAttribute(types.AttributeSubmitterAddress, msg.Owner),
+		),
+	)
+
+	return &resp, nil
+}
+
+// CreateConsumers defines an RPC handler method for MsgCreateConsumers.
+// The consumer chains are created in order and the message fails if any of them cannot be created.
+func (k msgServer) CreateConsumers(goCtx context.Context, msg *types.MsgCreateConsumers) (*types.MsgCreateConsumersResponse, error) {
+	resp := types.MsgCreateConsumersResponse{}
+
+	for i, consumer := range msg.Consumers {
+		createResp, err := k.CreateConsumer(goCtx, consumer.ToMsgCreateConsumer(msg.Submitter))
+		if err != nil {
+			return &types.MsgCreateConsumersResponse{}, errorsmod.Wrapf(err, "cannot create consumer %d (%s)", i, consumer.ChainId)
+		}
+		resp.ConsumerIds = append(resp.ConsumerIds, createResp.ConsumerId)
+	}
+
+	return &resp, nil
+}
+
+// RegisterConsumerProviderSwitch defines an RPC handler method for MsgRegisterConsumerProviderSwitch
+func (k msgServer) RegisterConsumerProviderSwitch(goCtx context.Context, msg *types.MsgRegisterConsumerProviderSwitch) (*types.MsgRegisterConsumerProviderSwitchResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	resp := types.MsgRegisterConsumerProviderSwitchResponse{}
+
+	consumerId := msg.ConsumerId
+	ownerAddress, err := k.Keeper.GetConsumerOwnerAddress(ctx, consumerId)
+	if err != nil {
+		return &resp, errorsmod.Wrapf(types.ErrNoOwnerAddress, "cannot retrieve owner address %s", ownerAddress)
+	}
+
+	if msg.Owner != ownerAddress {
+		return &resp, errorsmod.Wrapf(types.ErrUnauthorized, "expected owner address %s, got %s", ownerAddress, msg.Owner)
+	}
+
+	if err := k.Keeper.RegisterConsumerProviderSwitch(ctx, consumerId, msg.Switch); err != nil {
+		return &resp, err
+	}
+
+	k.Logger(ctx).Info("registered consumer provider switch",
+		"consumerId", consumerId,
+		"switchHeight", msg.Switch.SwitchHeight.String(),
+		"newProviderChainId", msg.Switch.NewProviderChainId,
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRegisterConsumerProviderSwitch,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeConsumerId, consumerId),
+			sdk.NewAttribute(types.AttributeSwitchHeight, msg.Switch.SwitchHeight.String()),
+			sdk.NewAttribute(types.AttributeNewProviderChainId, msg.Switch.NewProviderChainId),
+			sdk.NewAttribute(types.AttributeSubmitterAddress, msg.Owner),
+		),
+	)
+
+	return &resp, nil
+}
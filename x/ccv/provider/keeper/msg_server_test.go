@@ -5,13 +5,21 @@ import (
 	"time"
 
 	"github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v10/modules/core/23-commitment/types"
+	ibctmtypes "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
 	"cosmossdk.io/math"
 
 	"github.com/cosmos/cosmos-sdk/codec/address"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
+	"github.com/cometbft/cometbft/crypto/tmhash"
+
+	cryptotestutil "github.com/cosmos/interchain-security/v7/testutil/crypto"
 	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
 	providerkeeper "github.com/cosmos/interchain-security/v7/x/ccv/provider/keeper"
 	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
@@ -75,6 +83,287 @@ func TestCreateConsumer(t *testing.T) {
 	require.Equal(t, providertypes.CONSUMER_PHASE_REGISTERED, phase)
 }
 
+// TestCreateConsumerWithThrottlingParameters tests that only a consumer chain owned by the gov module
+// can be created with a slash meter of its own
+func TestCreateConsumerWithThrottlingParameters(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+
+	mocks.MockSlashingKeeper.EXPECT().DowntimeJailDuration(gomock.Any()).Return(time.Second*600, nil).AnyTimes()
+	mocks.MockSlashingKeeper.EXPECT().SlashFractionDoubleSign(gomock.Any()).Return(math.LegacyNewDec(0), nil).AnyTimes()
+	mocks.MockStakingKeeper.EXPECT().GetLastTotalPower(gomock.Any()).Return(math.NewInt(1000), nil).AnyTimes()
+	mocks.MockAccountKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec("cosmos")).AnyTimes()
+
+	msgServer := providerkeeper.NewMsgServerImpl(&providerKeeper)
+	throttlingParameters := providertypes.ThrottlingParameters{SlashMeterReplenishFraction: "0.01"}
+
+	_, err := msgServer.CreateConsumer(ctx,
+		&providertypes.MsgCreateConsumer{
+			Submitter: "submitter", ChainId: "chainId", Metadata: providertypes.ConsumerMetadata{Name: "name"},
+			InitializationParameters: &providertypes.ConsumerInitializationParameters{},
+			ThrottlingParameters:     &throttlingParameters,
+		})
+	require.ErrorIs(t, err, providertypes.ErrInvalidConsumerThrottlingParameters)
+
+	response, err := msgServer.CreateConsumer(ctx,
+		&providertypes.MsgCreateConsumer{
+			Submitter: providerKeeper.GetAuthority(), ChainId: "chainId", Metadata: providertypes.ConsumerMetadata{Name: "name"},
+			InitializationParameters: &providertypes.ConsumerInitializationParameters{},
+			ThrottlingParameters:     &throttlingParameters,
+		})
+	require.NoError(t, err)
+	actualThrottlingParameters, err := providerKeeper.GetConsumerThrottlingParameters(ctx, response.ConsumerId)
+	require.NoError(t, err)
+	require.Equal(t, throttlingParameters, actualThrottlingParameters)
+	meter, found := providerKeeper.GetConsumerSlashMeter(ctx, response.ConsumerId)
+	require.True(t, found)
+	require.Equal(t, math.NewInt(10), meter)
+
+	// the consumer chain cannot move to an owner other than the gov module while it has a slash meter of its own
+	_, err = msgServer.UpdateConsumer(ctx,
+		&providertypes.MsgUpdateConsumer{
+			Owner: providerKeeper.GetAuthority(), ConsumerId: response.ConsumerId,
+			NewOwnerAddress: "cosmos1dkas8mu4kyhl5jrh4nzvm65qz588hy9qcz08la",
+		})
+	require.ErrorIs(t, err, providertypes.ErrInvalidConsumerThrottlingParameters)
+}
+
+func TestCreateConsumerWithKeyAssignments(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	mocks.MockSlashingKeeper.EXPECT().DowntimeJailDuration(gomock.Any()).Return(time.Second*600, nil).AnyTimes()
+	mocks.MockSlashingKeeper.EXPECT().SlashFractionDoubleSign(gomock.Any()).Return(math.LegacyNewDec(0), nil).AnyTimes()
+	mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(gomock.Any(), gomock.Any()).
+		Return(stakingtypes.Validator{}, stakingtypes.ErrNoValidatorFound).AnyTimes()
+
+	msgServer := providerkeeper.NewMsgServerImpl(&providerKeeper)
+
+	providerIds := cryptotestutil.GenMultipleCryptoIds(2, 0)
+	consumerIds := cryptotestutil.GenMultipleCryptoIds(2, 10)
+	for _, id := range providerIds {
+		mocks.MockStakingKeeper.EXPECT().GetValidator(gomock.Any(), id.SDKValOpAddress()).
+			Return(id.SDKStakingValidator(), nil).AnyTimes()
+		mocks.MockAccountKeeper.EXPECT().GetAccount(gomock.Any(), sdk.AccAddress(id.SDKValOpAddress())).
+			Return(authtypes.NewBaseAccount(sdk.AccAddress(id.SDKValOpAddress()), id.OperatorSDKPubKey(), 0, 0)).AnyTimes()
+	}
+
+	// the first validator agreed on its key in the provider genesis
+	require.NoError(t, providerKeeper.SetPreLaunchKeyAssignment(ctx, providertypes.PreLaunchKeyAssignment{
+		ChainId:      "chainId",
+		ProviderAddr: providerIds[0].SDKValOpAddressString(),
+		ConsumerKey:  consumerKeyJson(consumerIds[0]),
+	}))
+
+	// the second validator signed its key assignment for the owner
+	signBytes, err := providertypes.KeyAssignmentSignBytes("chainId", "submitter",
+		providerIds[1].SDKValOpAddressString(), consumerKeyJson(consumerIds[1]))
+	require.NoError(t, err)
+	signature, err := providerIds[1].SignWithOperatorKey(signBytes)
+	require.NoError(t, err)
+	keyAssignments := []providertypes.SignedKeyAssignment{{
+		ProviderAddr: providerIds[1].SDKValOpAddressString(),
+		ConsumerKey:  consumerKeyJson(consumerIds[1]),
+		Signature:    signature,
+	}}
+
+	// the signature is bound to the owner; the state changes of the failed tx are discarded
+	cachedCtx, _ := ctx.CacheContext()
+	_, err = msgServer.CreateConsumer(cachedCtx,
+		&providertypes.MsgCreateConsumer{
+			Submitter: "submitter2", ChainId: "chainId",
+			Metadata:                 providertypes.ConsumerMetadata{Name: "name", Description: "description"},
+			InitializationParameters: &providertypes.ConsumerInitializationParameters{},
+			KeyAssignments:           keyAssignments,
+		})
+	require.ErrorIs(t, err, providertypes.ErrInvalidKeyAssignmentSignature)
+
+	response, err := msgServer.CreateConsumer(ctx,
+		&providertypes.MsgCreateConsumer{
+			Submitter: "submitter", ChainId: "chainId",
+			Metadata:                 providertypes.ConsumerMetadata{Name: "name", Description: "description"},
+			InitializationParameters: &providertypes.ConsumerInitializationParameters{},
+			KeyAssignments:           keyAssignments,
+		})
+	require.NoError(t, err)
+
+	for i, id := range providerIds {
+		consumerKey, found := providerKeeper.GetValidatorConsumerPubKey(ctx, response.ConsumerId, id.ProviderConsAddress())
+		require.True(t, found)
+		require.Equal(t, consumerIds[i].TMProtoCryptoPublicKey(), consumerKey)
+	}
+	require.Empty(t, providerKeeper.GetAllPreLaunchKeyAssignments(ctx, nil))
+}
+
+func TestAssignConsumerKeyWithProof(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	consumerId := "0"
+	providerKeeper.SetConsumerChainId(ctx, consumerId, "chainId")
+	providerKeeper.SetConsumerPhase(ctx, consumerId, providertypes.CONSUMER_PHASE_INITIALIZED)
+
+	providerId := cryptotestutil.NewCryptoIdentityFromIntSeed(0)
+	consumerIds := cryptotestutil.GenMultipleCryptoIds(2, 10)
+	mocks.MockStakingKeeper.EXPECT().GetValidator(gomock.Any(), providerId.SDKValOpAddress()).
+		Return(providerId.SDKStakingValidator(), nil).AnyTimes()
+	mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(gomock.Any(), gomock.Any()).
+		Return(stakingtypes.Validator{}, stakingtypes.ErrNoValidatorFound).AnyTimes()
+
+	msgServer := providerkeeper.NewMsgServerImpl(&providerKeeper)
+
+	ctx = ctx.WithChainID("provider")
+	signBytes, err := providertypes.KeyPossessionSignBytes(ctx.ChainID(), consumerId, providerId.SDKValOpAddressString())
+	require.NoError(t, err)
+	proof, err := consumerIds[0].SignWithConsensusKey(signBytes)
+	require.NoError(t, err)
+	msg := providertypes.NewMsgAssignConsumerKeyWithProof(consumerId, providerId.SDKValOpAddress(),
+		consumerKeyJson(consumerIds[0]), sdk.AccAddress(providerId.SDKValOpAddress()).String(), proof)
+	require.NoError(t, msg.ValidateBasic())
+
+	// the proof is bound to the consumer key
+	_, err = msgServer.AssignConsumerKeyWithProof(ctx, providertypes.NewMsgAssignConsumerKeyWithProof(consumerId,
+		providerId.SDKValOpAddress(), consumerKeyJson(consumerIds[1]), msg.Signer, proof))
+	require.ErrorIs(t, err, providertypes.ErrInvalidKeyPossessionProof)
+
+	// the proof is bound to the consumer chain
+	_, err = msgServer.AssignConsumerKeyWithProof(ctx, providertypes.NewMsgAssignConsumerKeyWithProof("1",
+		providerId.SDKValOpAddress(), msg.ConsumerKey, msg.Signer, proof))
+	require.ErrorIs(t, err, providertypes.ErrInvalidKeyPossessionProof)
+
+	// the proof is bound to the provider chain
+	_, err = msgServer.AssignConsumerKeyWithProof(ctx.WithChainID("other-provider"), msg)
+	require.ErrorIs(t, err, providertypes.ErrInvalidKeyPossessionProof)
+	_, found := providerKeeper.GetValidatorConsumerPubKey(ctx, consumerId, providerId.ProviderConsAddress())
+	require.False(t, found)
+
+	_, err = msgServer.AssignConsumerKeyWithProof(ctx, msg)
+	require.NoError(t, err)
+	consumerKey, found := providerKeeper.GetValidatorConsumerPubKey(ctx, consumerId, providerId.ProviderConsAddress())
+	require.True(t, found)
+	require.Equal(t, consumerIds[0].TMProtoCryptoPublicKey(), consumerKey)
+}
+
+func TestCreateConsumerWithCreatorAllowlist(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	mocks.MockAccountKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec("cosmos")).AnyTimes()
+
+	mocks.MockSlashingKeeper.EXPECT().DowntimeJailDuration(gomock.Any()).Return(time.Second*600, nil).AnyTimes()
+	mocks.MockSlashingKeeper.EXPECT().SlashFractionDoubleSign(gomock.Any()).Return(math.LegacyNewDec(0), nil).AnyTimes()
+
+	msgServer := providerkeeper.NewMsgServerImpl(&providerKeeper)
+
+	params := providertypes.DefaultParams()
+	params.RestrictConsumerCreation = true
+	providerKeeper.SetParams(ctx, params)
+
+	allowlisted := sdk.AccAddress([]byte("allowlisted")).String()
+	notAllowlisted := sdk.AccAddress([]byte("notAllowlisted")).String()
+
+	// only the governance account can change the allowlist
+	_, err := msgServer.ChangeConsumerCreatorAllowlist(ctx, &providertypes.MsgChangeConsumerCreatorAllowlist{
+		AddressesToAdd: []string{allowlisted},
+		Authority:      notAllowlisted,
+	})
+	require.ErrorIs(t, err, providertypes.ErrUnauthorized)
+
+	_, err = msgServer.ChangeConsumerCreatorAllowlist(ctx, &providertypes.MsgChangeConsumerCreatorAllowlist{
+		AddressesToAdd: []string{allowlisted},
+		Authority:      providerKeeper.GetAuthority(),
+	})
+	require.NoError(t, err)
+
+	createConsumer := func(submitter string) error {
+		_, err := msgServer.CreateConsumer(ctx,
+			&providertypes.MsgCreateConsumer{
+				Submitter: submitter, ChainId: "chainId",
+				Metadata:                 providertypes.ConsumerMetadata{Name: "name", Description: "description"},
+				InitializationParameters: &providertypes.ConsumerInitializationParameters{},
+			})
+		return err
+	}
+	require.ErrorIs(t, createConsumer(notAllowlisted), providertypes.ErrConsumerCreatorNotAllowlisted)
+	require.NoError(t, createConsumer(allowlisted))
+
+	// once the allowlist is empty, only the governance account can create a consumer chain
+	_, err = msgServer.ChangeConsumerCreatorAllowlist(ctx, &providertypes.MsgChangeConsumerCreatorAllowlist{
+		AddressesToRemove: []string{allowlisted},
+		Authority:         providerKeeper.GetAuthority(),
+	})
+	require.NoError(t, err)
+	require.ErrorIs(t, createConsumer(allowlisted), providertypes.ErrConsumerCreatorNotAllowlisted)
+	require.NoError(t, createConsumer(providerKeeper.GetAuthority()))
+
+	// anyone can create a consumer chain once consumer creation is not restricted
+	params.RestrictConsumerCreation = false
+	providerKeeper.SetParams(ctx, params)
+	require.NoError(t, createConsumer(notAllowlisted))
+}
+
+func TestCreateConsumers(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	mocks.MockSlashingKeeper.EXPECT().DowntimeJailDuration(gomock.Any()).Return(time.Second*600, nil).AnyTimes()
+	mocks.MockSlashingKeeper.EXPECT().SlashFractionDoubleSign(gomock.Any()).Return(math.LegacyNewDec(0), nil).AnyTimes()
+
+	msgServer := providerkeeper.NewMsgServerImpl(&providerKeeper)
+
+	// create a first consumer chain so that the consumer ids do not start at zero
+	_, err := msgServer.CreateConsumer(ctx,
+		&providertypes.MsgCreateConsumer{
+			Submitter: "submitter", ChainId: "chain-1",
+			Metadata: providertypes.ConsumerMetadata{Name: "name", Description: "description"},
+		})
+	require.NoError(t, err)
+
+	authority := providerKeeper.GetAuthority()
+	response, err := msgServer.CreateConsumers(ctx, providertypes.NewMsgCreateConsumers(authority,
+		[]providertypes.ConsumerCreation{
+			{
+				ChainId:  "testnet-1",
+				Metadata: providertypes.ConsumerMetadata{Name: "testnet 1", Description: "description"},
+			},
+			{
+				ChainId:                "devnet-1",
+				Metadata:               providertypes.ConsumerMetadata{Name: "devnet 1", Description: "description"},
+				PowerShapingParameters: &providertypes.PowerShapingParameters{ValidatorSetCap: 10},
+				RewardDenomHint:        "udevnet",
+			},
+		}))
+	require.NoError(t, err)
+	require.Equal(t, []string{"1", "2"}, response.ConsumerIds)
+
+	for i, consumerId := range response.ConsumerIds {
+		chainId, err := providerKeeper.GetConsumerChainId(ctx, consumerId)
+		require.NoError(t, err)
+		require.Equal(t, []string{"testnet-1", "devnet-1"}[i], chainId)
+		ownerAddress, err := providerKeeper.GetConsumerOwnerAddress(ctx, consumerId)
+		require.NoError(t, err)
+		require.Equal(t, authority, ownerAddress)
+		require.Equal(t, providertypes.CONSUMER_PHASE_REGISTERED, providerKeeper.GetConsumerPhase(ctx, consumerId))
+	}
+	powerShapingParameters, err := providerKeeper.GetConsumerPowerShapingParameters(ctx, "2")
+	require.NoError(t, err)
+	require.Equal(t, uint32(10), powerShapingParameters.ValidatorSetCap)
+	denom, found := providerKeeper.GetRewardDenomHint(ctx, "2")
+	require.True(t, found)
+	require.Equal(t, "udevnet", denom)
+
+	// a consumer chain that cannot be created fails the message
+	_, err = msgServer.CreateConsumers(ctx, providertypes.NewMsgCreateConsumers(authority,
+		[]providertypes.ConsumerCreation{
+			{
+				ChainId:                "testnet-1",
+				Metadata:               providertypes.ConsumerMetadata{Name: "testnet 1", Description: "description"},
+				PowerShapingParameters: &providertypes.PowerShapingParameters{Top_N: 50},
+			},
+		}))
+	require.ErrorIs(t, err, providertypes.ErrCannotCreateTopNChain)
+}
+
 func TestUpdateConsumer(t *testing.T) {
 	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
 	defer ctrl.Finish()
@@ -154,7 +443,7 @@ func TestUpdateConsumer(t *testing.T) {
 		Metadata:    "metadata2",
 	}
 
-	expectedInitializationParameters := testkeeper.GetTestInitializationParameters()
+	expectedInitializationParameters := testkeeper.GetTestInitializationParameters(providertypes.InitParamsProfileMainnetConservative)
 	expectedInitializationParameters.InitialHeight.RevisionNumber = 1
 	expectedPowerShapingParameters := testkeeper.GetTestPowerShapingParameters()
 	expectedInfractionParameters := providertypes.InfractionParameters{
@@ -245,6 +534,47 @@ func TestUpdateConsumer(t *testing.T) {
 		Ids: []string{consumerId},
 	}, consumerIds)
 
+	// re-update (replace spawnTime with spawnHeight) and verify that the chain is to be launched at the spawn height
+	expectedInitializationParameters.SpawnTime = time.Time{}
+	expectedInitializationParameters.SpawnHeight = 100
+	_, err = msgServer.UpdateConsumer(ctx,
+		&providertypes.MsgUpdateConsumer{
+			Owner: expectedOwnerAddress, ConsumerId: consumerId,
+			InitializationParameters: &expectedInitializationParameters,
+		})
+	require.NoError(t, err)
+	require.Equal(t, providertypes.CONSUMER_PHASE_INITIALIZED, providerKeeper.GetConsumerPhase(ctx, consumerId))
+
+	consumerIds, err = providerKeeper.GetConsumersToBeLaunched(ctx, updatedSpawnTime)
+	require.NoError(t, err)
+	require.Empty(t, consumerIds)
+
+	consumerIds, err = providerKeeper.GetConsumersToBeLaunchedAtHeight(ctx, 100)
+	require.NoError(t, err)
+	require.Equal(t, providertypes.ConsumerIds{
+		Ids: []string{consumerId},
+	}, consumerIds)
+
+	// re-update (replace spawnHeight with spawnTime) and verify that the chain is to be launched at the spawn time
+	expectedInitializationParameters.SpawnTime = updatedSpawnTime
+	expectedInitializationParameters.SpawnHeight = 0
+	_, err = msgServer.UpdateConsumer(ctx,
+		&providertypes.MsgUpdateConsumer{
+			Owner: expectedOwnerAddress, ConsumerId: consumerId,
+			InitializationParameters: &expectedInitializationParameters,
+		})
+	require.NoError(t, err)
+
+	consumerIds, err = providerKeeper.GetConsumersToBeLaunchedAtHeight(ctx, 100)
+	require.NoError(t, err)
+	require.Empty(t, consumerIds)
+
+	consumerIds, err = providerKeeper.GetConsumersToBeLaunched(ctx, updatedSpawnTime)
+	require.NoError(t, err)
+	require.Equal(t, providertypes.ConsumerIds{
+		Ids: []string{consumerId},
+	}, consumerIds)
+
 	// assert that we CANNOT update the initialization parameters of a launched chain
 	providerKeeper.SetConsumerPhase(ctx, consumerId, providertypes.CONSUMER_PHASE_LAUNCHED)
 	_, err = msgServer.UpdateConsumer(ctx,
@@ -382,3 +712,493 @@ func TestUpdateConsumer(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, expectedInitializationParameters, actualInitializationParameters)
 }
+
+// TestConsumerInitialHeightCorrection tests that the default initial height and the initial height
+// of a chain whose chain id is updated are corrected to the revision number of the chain id,
+// while a mismatching initial height provided by the owner is rejected
+func TestConsumerInitialHeightCorrection(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	mocks.MockSlashingKeeper.EXPECT().DowntimeJailDuration(gomock.Any()).Return(time.Second*600, nil).AnyTimes()
+	mocks.MockSlashingKeeper.EXPECT().SlashFractionDoubleSign(gomock.Any()).Return(math.LegacyNewDec(0), nil).AnyTimes()
+
+	msgServer := providerkeeper.NewMsgServerImpl(&providerKeeper)
+
+	// the default initial height is corrected to the revision number of the chain id
+	response, err := msgServer.CreateConsumer(ctx,
+		&providertypes.MsgCreateConsumer{
+			Submitter: "submitter", ChainId: "chainId", Metadata: providertypes.ConsumerMetadata{Name: "name"},
+		})
+	require.NoError(t, err)
+	consumerId := response.ConsumerId
+	initializationParameters, err := providerKeeper.GetConsumerInitializationParameters(ctx, consumerId)
+	require.NoError(t, err)
+	require.Equal(t, types.NewHeight(0, 1), initializationParameters.InitialHeight)
+
+	// a mismatching initial height is rejected
+	initializationParameters.InitialHeight = types.NewHeight(2, 10)
+	_, err = msgServer.UpdateConsumer(ctx,
+		&providertypes.MsgUpdateConsumer{
+			Owner: "submitter", ConsumerId: consumerId, InitializationParameters: &initializationParameters,
+		})
+	require.ErrorIs(t, err, providertypes.ErrInvalidConsumerInitializationParameters)
+
+	// the initial height is validated against the new chain id
+	_, err = msgServer.UpdateConsumer(ctx,
+		&providertypes.MsgUpdateConsumer{
+			Owner: "submitter", ConsumerId: consumerId, NewChainId: "chainId-2", InitializationParameters: &initializationParameters,
+		})
+	require.NoError(t, err)
+
+	// without new initialization parameters, the initial height is corrected to the new chain id
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err = msgServer.UpdateConsumer(ctx,
+		&providertypes.MsgUpdateConsumer{
+			Owner: "submitter", ConsumerId: consumerId, NewChainId: "chainId-3",
+		})
+	require.NoError(t, err)
+	initializationParameters, err = providerKeeper.GetConsumerInitializationParameters(ctx, consumerId)
+	require.NoError(t, err)
+	require.Equal(t, types.NewHeight(3, 10), initializationParameters.InitialHeight)
+	events := ctx.EventManager().Events()
+	require.NotEmpty(t, events)
+	initialHeightAttr, found := events[len(events)-1].GetAttribute(providertypes.AttributeInitialHeight)
+	require.True(t, found)
+	require.Equal(t, "3-10", initialHeightAttr.Value)
+
+	res, err := providerKeeper.QueryConsumerChain(ctx, &providertypes.QueryConsumerChainRequest{ConsumerId: consumerId})
+	require.NoError(t, err)
+	require.Equal(t, types.NewHeight(3, 10), res.ExpectedInitialHeight)
+}
+
+func TestSetConsumerInitialConsensusState(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	mocks.MockSlashingKeeper.EXPECT().DowntimeJailDuration(gomock.Any()).Return(time.Second*600, nil).AnyTimes()
+	mocks.MockSlashingKeeper.EXPECT().SlashFractionDoubleSign(gomock.Any()).Return(math.LegacyNewDec(0), nil).AnyTimes()
+
+	msgServer := providerkeeper.NewMsgServerImpl(&providerKeeper)
+
+	initializationParameters := testkeeper.GetTestInitializationParameters(providertypes.InitParamsProfileMainnetConservative)
+	createConsumerResponse, err := msgServer.CreateConsumer(ctx,
+		&providertypes.MsgCreateConsumer{
+			Submitter: "submitter", ChainId: "chainId",
+			Metadata: providertypes.ConsumerMetadata{
+				Name:        "name",
+				Description: "description",
+			},
+			InitializationParameters: &initializationParameters,
+		})
+	require.NoError(t, err)
+	consumerId := createConsumerResponse.ConsumerId
+
+	initialConsensusState := providertypes.ConsumerInitialConsensusState{
+		GenesisHash: initializationParameters.GenesisHash,
+		ConsensusState: *ibctmtypes.NewConsensusState(
+			time.Now().UTC(),
+			commitmenttypes.NewMerkleRoot([]byte("app_hash")),
+			tmhash.Sum([]byte("valset")),
+		),
+	}
+
+	// only the owner can set the initial consensus state
+	_, err = msgServer.SetConsumerInitialConsensusState(ctx,
+		&providertypes.MsgSetConsumerInitialConsensusState{
+			Owner: "wrong owner", ConsumerId: consumerId, InitialConsensusState: initialConsensusState,
+		})
+	require.ErrorIs(t, err, providertypes.ErrUnauthorized)
+
+	// the genesis hash has to match the one of the initialization parameters
+	mismatchedConsensusState := initialConsensusState
+	mismatchedConsensusState.GenesisHash = []byte("other_gen_hash")
+	_, err = msgServer.SetConsumerInitialConsensusState(ctx,
+		&providertypes.MsgSetConsumerInitialConsensusState{
+			Owner: "submitter", ConsumerId: consumerId, InitialConsensusState: mismatchedConsensusState,
+		})
+	require.ErrorIs(t, err, providertypes.ErrInvalidConsumerInitialConsensusState)
+	_, found := providerKeeper.GetConsumerInitialConsensusState(ctx, consumerId)
+	require.False(t, found)
+
+	_, err = msgServer.SetConsumerInitialConsensusState(ctx,
+		&providertypes.MsgSetConsumerInitialConsensusState{
+			Owner: "submitter", ConsumerId: consumerId, InitialConsensusState: initialConsensusState,
+		})
+	require.NoError(t, err)
+	actualConsensusState, found := providerKeeper.GetConsumerInitialConsensusState(ctx, consumerId)
+	require.True(t, found)
+	require.Equal(t, initialConsensusState.GenesisHash, actualConsensusState.GenesisHash)
+	require.Equal(t, initialConsensusState.ConsensusState.NextValidatorsHash, actualConsensusState.ConsensusState.NextValidatorsHash)
+
+	// the initial consensus state cannot be set after the chain launches
+	providerKeeper.SetConsumerPhase(ctx, consumerId, providertypes.CONSUMER_PHASE_LAUNCHED)
+	_, err = msgServer.SetConsumerInitialConsensusState(ctx,
+		&providertypes.MsgSetConsumerInitialConsensusState{
+			Owner: "submitter", ConsumerId: consumerId, InitialConsensusState: initialConsensusState,
+		})
+	require.ErrorIs(t, err, providertypes.ErrInvalidPhase)
+}
+
+func TestRetryLaunch(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	mocks.MockSlashingKeeper.EXPECT().DowntimeJailDuration(gomock.Any()).Return(time.Second*600, nil).AnyTimes()
+	mocks.MockSlashingKeeper.EXPECT().SlashFractionDoubleSign(gomock.Any()).Return(math.LegacyNewDec(0), nil).AnyTimes()
+
+	msgServer := providerkeeper.NewMsgServerImpl(&providerKeeper)
+
+	initializationParameters := testkeeper.GetTestInitializationParameters(providertypes.InitParamsProfileMainnetConservative)
+	createConsumerResponse, err := msgServer.CreateConsumer(ctx,
+		&providertypes.MsgCreateConsumer{
+			Submitter: "submitter", ChainId: "chainId",
+			Metadata: providertypes.ConsumerMetadata{
+				Name:        "name",
+				Description: "description",
+			},
+			InitializationParameters: &initializationParameters,
+		})
+	require.NoError(t, err)
+	consumerId := createConsumerResponse.ConsumerId
+	require.Equal(t, providertypes.CONSUMER_PHASE_INITIALIZED, providerKeeper.GetConsumerPhase(ctx, consumerId))
+
+	// the launch can only be retried after the consumer client could not be created
+	_, err = msgServer.RetryLaunch(ctx, &providertypes.MsgRetryLaunch{Owner: "submitter", ConsumerId: consumerId})
+	require.ErrorIs(t, err, providertypes.ErrConsumerLaunchNotRetriable)
+
+	// the consumer client could not be created at spawn time
+	err = providerKeeper.RemoveConsumerToBeLaunched(ctx, consumerId, initializationParameters.SpawnTime)
+	require.NoError(t, err)
+	err = providerKeeper.SetConsumerLaunchFailure(ctx, consumerId, providertypes.ConsumerLaunchFailure{
+		Error: "cannot create consumer client", Retriable: true,
+	})
+	require.NoError(t, err)
+
+	// updating the chain does not schedule its launch
+	newSpawnTime := initializationParameters.SpawnTime.Add(time.Hour)
+	initializationParameters.SpawnTime = newSpawnTime
+	_, err = msgServer.UpdateConsumer(ctx,
+		&providertypes.MsgUpdateConsumer{
+			Owner: "submitter", ConsumerId: consumerId,
+			InitializationParameters: &initializationParameters,
+		})
+	require.NoError(t, err)
+	require.Equal(t, providertypes.CONSUMER_PHASE_INITIALIZED, providerKeeper.GetConsumerPhase(ctx, consumerId))
+	consumerIds, err := providerKeeper.GetConsumersToBeLaunched(ctx, newSpawnTime)
+	require.NoError(t, err)
+	require.Empty(t, consumerIds.Ids)
+
+	// only the owner can retry the launch
+	_, err = msgServer.RetryLaunch(ctx, &providertypes.MsgRetryLaunch{Owner: "wrong owner", ConsumerId: consumerId})
+	require.ErrorIs(t, err, providertypes.ErrUnauthorized)
+
+	_, err = msgServer.RetryLaunch(ctx, &providertypes.MsgRetryLaunch{Owner: "submitter", ConsumerId: consumerId})
+	require.NoError(t, err)
+	consumerIds, err = providerKeeper.GetConsumersToBeLaunched(ctx, newSpawnTime)
+	require.NoError(t, err)
+	require.Equal(t, []string{consumerId}, consumerIds.Ids)
+	require.False(t, providerKeeper.IsConsumerLaunchRetriable(ctx, consumerId))
+
+	// a chain moved back to the registered phase cannot be retried
+	err = providerKeeper.SetConsumerLaunchFailure(ctx, consumerId, providertypes.ConsumerLaunchFailure{
+		Error: "cannot create consumer client", Retriable: true,
+	})
+	require.NoError(t, err)
+	err = providerKeeper.RemoveConsumerToBeLaunched(ctx, consumerId, newSpawnTime)
+	require.NoError(t, err)
+	initializationParameters.SpawnTime = time.Time{}
+	_, err = msgServer.UpdateConsumer(ctx,
+		&providertypes.MsgUpdateConsumer{
+			Owner: "submitter", ConsumerId: consumerId,
+			InitializationParameters: &initializationParameters,
+		})
+	require.NoError(t, err)
+	require.Equal(t, providertypes.CONSUMER_PHASE_REGISTERED, providerKeeper.GetConsumerPhase(ctx, consumerId))
+	_, err = msgServer.RetryLaunch(ctx, &providertypes.MsgRetryLaunch{Owner: "submitter", ConsumerId: consumerId})
+	require.ErrorIs(t, err, providertypes.ErrInvalidPhase)
+}
+
+func TestAttestConsumerHashes(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	mocks.MockSlashingKeeper.EXPECT().DowntimeJailDuration(gomock.Any()).Return(time.Second*600, nil).AnyTimes()
+	mocks.MockSlashingKeeper.EXPECT().SlashFractionDoubleSign(gomock.Any()).Return(math.LegacyNewDec(0), nil).AnyTimes()
+
+	msgServer := providerkeeper.NewMsgServerImpl(&providerKeeper)
+
+	initializationParameters := testkeeper.GetTestInitializationParameters(providertypes.InitParamsProfileMainnetConservative)
+	registeredGenesisHash := initializationParameters.GenesisHash
+	registeredBinaryHash := initializationParameters.BinaryHash
+	createConsumerResponse, err := msgServer.CreateConsumer(ctx,
+		&providertypes.MsgCreateConsumer{
+			Submitter: "submitter", ChainId: "chainId",
+			Metadata: providertypes.ConsumerMetadata{
+				Name:        "name",
+				Description: "description",
+			},
+			InitializationParameters: &initializationParameters,
+		})
+	require.NoError(t, err)
+	consumerId := createConsumerResponse.ConsumerId
+
+	// the hashes are recorded at registration
+	commitment, found := providerKeeper.GetConsumerHashCommitment(ctx, consumerId)
+	require.True(t, found)
+	require.Equal(t, providertypes.ConsumerHashCommitment{
+		RegisteredGenesisHash: registeredGenesisHash,
+		RegisteredBinaryHash:  registeredBinaryHash,
+	}, commitment)
+
+	// only the owner can attest the hashes
+	_, err = msgServer.AttestConsumerHashes(ctx, providertypes.NewMsgAttestConsumerHashes(
+		"wrong owner", consumerId, registeredGenesisHash, registeredBinaryHash))
+	require.ErrorIs(t, err, providertypes.ErrUnauthorized)
+
+	// the attested hashes must match the ones of the chain
+	_, err = msgServer.AttestConsumerHashes(ctx, providertypes.NewMsgAttestConsumerHashes(
+		"submitter", consumerId, []byte("wrong"), registeredBinaryHash))
+	require.ErrorIs(t, err, providertypes.ErrConsumerHashMismatch)
+
+	ctx = ctx.WithBlockHeight(10)
+	_, err = msgServer.AttestConsumerHashes(ctx, providertypes.NewMsgAttestConsumerHashes(
+		"submitter", consumerId, registeredGenesisHash, registeredBinaryHash))
+	require.NoError(t, err)
+	commitment, _ = providerKeeper.GetConsumerHashCommitment(ctx, consumerId)
+	require.True(t, commitment.Attested)
+	require.Equal(t, int64(10), commitment.AttestationHeight)
+	require.Zero(t, commitment.UpdateCount)
+
+	// updating the initialization parameters without changing the hashes keeps the attestation
+	initializationParameters.SpawnTime = initializationParameters.SpawnTime.Add(time.Hour)
+	_, err = msgServer.UpdateConsumer(ctx,
+		&providertypes.MsgUpdateConsumer{
+			Owner: "submitter", ConsumerId: consumerId,
+			InitializationParameters: &initializationParameters,
+		})
+	require.NoError(t, err)
+	commitment, _ = providerKeeper.GetConsumerHashCommitment(ctx, consumerId)
+	require.True(t, commitment.Attested)
+
+	// updating a hash invalidates the attestation
+	ctx = ctx.WithBlockHeight(11)
+	initializationParameters.BinaryHash = []byte("new_binary_hash")
+	_, err = msgServer.UpdateConsumer(ctx,
+		&providertypes.MsgUpdateConsumer{
+			Owner: "submitter", ConsumerId: consumerId,
+			InitializationParameters: &initializationParameters,
+		})
+	require.NoError(t, err)
+	commitment, _ = providerKeeper.GetConsumerHashCommitment(ctx, consumerId)
+	require.Equal(t, providertypes.ConsumerHashCommitment{
+		RegisteredGenesisHash: registeredGenesisHash,
+		RegisteredBinaryHash:  registeredBinaryHash,
+		UpdateCount:           1,
+		LastUpdateHeight:      11,
+	}, commitment)
+
+	// the hashes are locked once the chain is launched
+	providerKeeper.SetConsumerPhase(ctx, consumerId, providertypes.CONSUMER_PHASE_LAUNCHED)
+	_, err = msgServer.AttestConsumerHashes(ctx, providertypes.NewMsgAttestConsumerHashes(
+		"submitter", consumerId, registeredGenesisHash, initializationParameters.BinaryHash))
+	require.ErrorIs(t, err, providertypes.ErrInvalidPhase)
+}
+
+func TestForceRemoveConsumer(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	unbondingTime := 21 * 24 * time.Hour
+	mocks.MockStakingKeeper.EXPECT().UnbondingTime(gomock.Any()).Return(unbondingTime, nil).AnyTimes()
+
+	msgServer := providerkeeper.NewMsgServerImpl(&providerKeeper)
+
+	forceRemove := func(consumerId string, supermajority bool) error {
+		_, err := msgServer.ForceRemoveConsumer(ctx, &providertypes.MsgForceRemoveConsumer{
+			ConsumerId:    consumerId,
+			Reason:        "abandoned",
+			Supermajority: supermajority,
+			Authority:     providerKeeper.GetAuthority(),
+		})
+		return err
+	}
+
+	// registered chain
+	providerKeeper.SetConsumerChainId(ctx, "0", "chain0")
+	providerKeeper.SetConsumerPhase(ctx, "0", providertypes.CONSUMER_PHASE_REGISTERED)
+
+	// only the governance account can force remove a chain
+	_, err := msgServer.ForceRemoveConsumer(ctx, &providertypes.MsgForceRemoveConsumer{
+		ConsumerId: "0",
+		Reason:     "abandoned",
+		Authority:  "owner",
+	})
+	require.ErrorIs(t, err, providertypes.ErrUnauthorized)
+
+	require.NoError(t, forceRemove("0", false))
+	require.Equal(t, providertypes.CONSUMER_PHASE_STOPPED, providerKeeper.GetConsumerPhase(ctx, "0"))
+	removalTime, err := providerKeeper.GetConsumerRemovalTime(ctx, "0")
+	require.NoError(t, err)
+	require.Equal(t, ctx.BlockTime().Add(unbondingTime), removalTime)
+
+	// a stopped chain cannot be force removed again
+	require.ErrorIs(t, forceRemove("0", false), providertypes.ErrInvalidPhase)
+
+	// initialized chain is removed from the launch queue
+	initializationParameters := testkeeper.GetTestInitializationParameters(providertypes.InitParamsProfileMainnetConservative)
+	providerKeeper.SetConsumerChainId(ctx, "1", "chain1")
+	providerKeeper.SetConsumerPhase(ctx, "1", providertypes.CONSUMER_PHASE_INITIALIZED)
+	require.NoError(t, providerKeeper.SetConsumerInitializationParameters(ctx, "1", initializationParameters))
+	require.NoError(t, providerKeeper.AppendConsumerToBeLaunched(ctx, "1", initializationParameters.SpawnTime))
+
+	require.NoError(t, forceRemove("1", false))
+	require.Equal(t, providertypes.CONSUMER_PHASE_STOPPED, providerKeeper.GetConsumerPhase(ctx, "1"))
+	consumerIds, err := providerKeeper.GetConsumersToBeLaunched(ctx, initializationParameters.SpawnTime)
+	require.NoError(t, err)
+	require.Empty(t, consumerIds.Ids)
+
+	// launched Top N chain requires the supermajority flag
+	providerKeeper.SetConsumerChainId(ctx, "2", "chain2")
+	providerKeeper.SetConsumerPhase(ctx, "2", providertypes.CONSUMER_PHASE_LAUNCHED)
+	require.NoError(t, providerKeeper.SetConsumerPowerShapingParameters(ctx, "2", providertypes.PowerShapingParameters{Top_N: 95}))
+
+	require.ErrorIs(t, forceRemove("2", false), providertypes.ErrUnauthorized)
+	require.Equal(t, providertypes.CONSUMER_PHASE_LAUNCHED, providerKeeper.GetConsumerPhase(ctx, "2"))
+	require.NoError(t, forceRemove("2", true))
+	require.Equal(t, providertypes.CONSUMER_PHASE_STOPPED, providerKeeper.GetConsumerPhase(ctx, "2"))
+
+	// launched opt-in chain does not require the supermajority flag
+	providerKeeper.SetConsumerChainId(ctx, "3", "chain3")
+	providerKeeper.SetConsumerPhase(ctx, "3", providertypes.CONSUMER_PHASE_LAUNCHED)
+	require.NoError(t, providerKeeper.SetConsumerPowerShapingParameters(ctx, "3", providertypes.PowerShapingParameters{Top_N: 0}))
+	require.NoError(t, forceRemove("3", false))
+	require.Equal(t, providertypes.CONSUMER_PHASE_STOPPED, providerKeeper.GetConsumerPhase(ctx, "3"))
+}
+
+func TestPauseAndResumeConsumer(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	msgServer := providerkeeper.NewMsgServerImpl(&providerKeeper)
+
+	pause := func(consumerId string) error {
+		_, err := msgServer.PauseConsumer(ctx, &providertypes.MsgPauseConsumer{
+			ConsumerId: consumerId,
+			Authority:  providerKeeper.GetAuthority(),
+		})
+		return err
+	}
+	resume := func(consumerId string) error {
+		_, err := msgServer.ResumeConsumer(ctx, &providertypes.MsgResumeConsumer{
+			ConsumerId: consumerId,
+			Authority:  providerKeeper.GetAuthority(),
+		})
+		return err
+	}
+
+	require.Equal(t, "0", providerKeeper.FetchAndIncrementConsumerId(ctx))
+	providerKeeper.SetConsumerChainId(ctx, "0", "chain0")
+	providerKeeper.SetConsumerPhase(ctx, "0", providertypes.CONSUMER_PHASE_INITIALIZED)
+
+	// only launched chains can be paused
+	require.ErrorIs(t, pause("0"), providertypes.ErrInvalidPhase)
+
+	// only the governance account can pause or resume a chain
+	providerKeeper.SetConsumerPhase(ctx, "0", providertypes.CONSUMER_PHASE_LAUNCHED)
+	_, err := msgServer.PauseConsumer(ctx, &providertypes.MsgPauseConsumer{ConsumerId: "0", Authority: "owner"})
+	require.ErrorIs(t, err, providertypes.ErrUnauthorized)
+
+	require.NoError(t, pause("0"))
+	require.Equal(t, providertypes.CONSUMER_PHASE_PAUSED, providerKeeper.GetConsumerPhase(ctx, "0"))
+	require.True(t, providerKeeper.IsConsumerLaunched(ctx, "0"))
+	require.True(t, providerKeeper.IsConsumerActive(ctx, "0"))
+	require.Equal(t, uint64(1), providerKeeper.GetLaunchedConsumersCount(ctx))
+	require.ErrorIs(t, pause("0"), providertypes.ErrInvalidPhase)
+
+	_, err = msgServer.ResumeConsumer(ctx, &providertypes.MsgResumeConsumer{ConsumerId: "0", Authority: "owner"})
+	require.ErrorIs(t, err, providertypes.ErrUnauthorized)
+
+	require.NoError(t, resume("0"))
+	require.Equal(t, providertypes.CONSUMER_PHASE_LAUNCHED, providerKeeper.GetConsumerPhase(ctx, "0"))
+	require.ErrorIs(t, resume("0"), providertypes.ErrInvalidPhase)
+}
+
+func TestEjectConsumerValidator(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	unbondingTime := 21 * 24 * time.Hour
+	mocks.MockStakingKeeper.EXPECT().UnbondingTime(gomock.Any()).Return(unbondingTime, nil).AnyTimes()
+
+	msgServer := providerkeeper.NewMsgServerImpl(&providerKeeper)
+
+	providerId := cryptotestutil.NewCryptoIdentityFromIntSeed(0)
+	consumerId := cryptotestutil.NewCryptoIdentityFromIntSeed(1)
+	providerAddr := providerId.ProviderConsAddress()
+	providerAddrStr := providerId.SDKValConsAddress().String()
+
+	eject := func(chainId string, pruneConsumerKey bool) error {
+		_, err := msgServer.EjectConsumerValidator(ctx, &providertypes.MsgEjectConsumerValidator{
+			ConsumerId:       chainId,
+			ProviderConsAddr: providerAddrStr,
+			PruneConsumerKey: pruneConsumerKey,
+			Authority:        providerKeeper.GetAuthority(),
+		})
+		return err
+	}
+
+	// launched chain on which the validator assigned a consumer key
+	providerKeeper.SetConsumerChainId(ctx, "0", "chain0")
+	providerKeeper.SetConsumerPhase(ctx, "0", providertypes.CONSUMER_PHASE_LAUNCHED)
+	require.NoError(t, providerKeeper.SetConsumerPowerShapingParameters(ctx, "0", providertypes.PowerShapingParameters{}))
+	providerKeeper.SetValidatorConsumerPubKey(ctx, "0", providerAddr, consumerId.TMProtoCryptoPublicKey())
+	providerKeeper.SetValidatorByConsumerAddr(ctx, "0", consumerId.ConsumerConsAddress(), providerAddr)
+
+	// only the governance account can eject a validator
+	_, err := msgServer.EjectConsumerValidator(ctx, &providertypes.MsgEjectConsumerValidator{
+		ConsumerId:       "0",
+		ProviderConsAddr: providerAddrStr,
+		Authority:        "owner",
+	})
+	require.ErrorIs(t, err, providertypes.ErrUnauthorized)
+	require.False(t, providerKeeper.IsDenylisted(ctx, "0", providerAddr))
+
+	// the validator is denylisted, but keeps its consumer key
+	require.NoError(t, eject("0", false))
+	require.True(t, providerKeeper.IsDenylisted(ctx, "0", providerAddr))
+	powerShapingParameters, err := providerKeeper.GetConsumerPowerShapingParameters(ctx, "0")
+	require.NoError(t, err)
+	require.Equal(t, []string{providerAddrStr}, powerShapingParameters.Denylist)
+	_, found := providerKeeper.GetValidatorConsumerPubKey(ctx, "0", providerAddr)
+	require.True(t, found)
+
+	// ejecting the validator again prunes its consumer key without denylisting it twice;
+	// the consumer address can still be referenced in slash packets until the unbonding period elapses
+	require.NoError(t, eject("0", true))
+	powerShapingParameters, err = providerKeeper.GetConsumerPowerShapingParameters(ctx, "0")
+	require.NoError(t, err)
+	require.Equal(t, []string{providerAddrStr}, powerShapingParameters.Denylist)
+	_, found = providerKeeper.GetValidatorConsumerPubKey(ctx, "0", providerAddr)
+	require.False(t, found)
+	require.Equal(t, providerAddr, providerKeeper.GetProviderAddrFromConsumerAddr(ctx, "0", consumerId.ConsumerConsAddress()))
+	addrsToPrune := providerKeeper.GetConsumerAddrsToPrune(ctx, "0", ctx.BlockTime().Add(unbondingTime))
+	require.Equal(t, [][]byte{consumerId.SDKValConsAddress()}, addrsToPrune.Addresses)
+
+	// the consumer address of a chain that has not launched yet is removed right away
+	providerKeeper.SetConsumerChainId(ctx, "1", "chain1")
+	providerKeeper.SetConsumerPhase(ctx, "1", providertypes.CONSUMER_PHASE_INITIALIZED)
+	require.NoError(t, providerKeeper.SetConsumerPowerShapingParameters(ctx, "1", providertypes.PowerShapingParameters{}))
+	providerKeeper.SetValidatorConsumerPubKey(ctx, "1", providerAddr, consumerId.TMProtoCryptoPublicKey())
+	providerKeeper.SetValidatorByConsumerAddr(ctx, "1", consumerId.ConsumerConsAddress(), providerAddr)
+
+	require.NoError(t, eject("1", true))
+	require.True(t, providerKeeper.IsDenylisted(ctx, "1", providerAddr))
+	_, found = providerKeeper.GetValidatorByConsumerAddr(ctx, "1", consumerId.ConsumerConsAddress())
+	require.False(t, found)
+
+	// a validator cannot be ejected from a stopped chain
+	providerKeeper.SetConsumerChainId(ctx, "2", "chain2")
+	providerKeeper.SetConsumerPhase(ctx, "2", providertypes.CONSUMER_PHASE_STOPPED)
+	require.ErrorIs(t, eject("2", false), providertypes.ErrInvalidPhase)
+}
@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// Option customizes the provider keeper at construction, so that integrators can
+// extend its behavior without changes to the positional arguments of NewKeeper
+type Option func(*Keeper)
+
+// WithPacketSender sets the function used to send the CCV packets to the consumer chains
+func WithPacketSender(packetSender ccv.PacketSender) Option {
+	return func(k *Keeper) {
+		k.packetSender = packetSender
+	}
+}
+
+// WithConsumerClientFactory sets the factory of the CCV clients of the consumer chains, which otherwise
+// are 07-tendermint clients, e.g., to track the consumer chains with 08-wasm clients
+func WithConsumerClientFactory(factory types.ConsumerClientFactory) Option {
+	return func(k *Keeper) {
+		k.consumerClientFactory = factory
+	}
+}
+
+// WithPowerShapers inserts custom power shaping steps between the built-in power shaping steps
+// applied to compute the validator sets of the consumer chains. It panics if the registrations are invalid,
+// see ValidatePowerShaperRegistrations.
+func WithPowerShapers(registrations ...types.PowerShaperRegistration) Option {
+	return func(k *Keeper) {
+		powerShapers := append(append([]types.PowerShaperRegistration{}, k.powerShapers...), registrations...)
+		if err := types.ValidatePowerShaperRegistrations(powerShapers); err != nil {
+			panic(fmt.Errorf("invalid power shapers: %w", err))
+		}
+		k.powerShapers = powerShapers
+	}
+}
+
+// WithLogger sets the logger of the module, which otherwise logs with the logger of the context
+func WithLogger(logger log.Logger) Option {
+	return func(k *Keeper) {
+		k.logger = logger
+	}
+}
+
+// WithFeatureFlags enables the given features, which can be queried with IsFeatureEnabled
+func WithFeatureFlags(features ...string) Option {
+	return func(k *Keeper) {
+		if k.features == nil {
+			k.features = map[string]bool{}
+		}
+		for _, feature := range features {
+			k.features[feature] = true
+		}
+	}
+}
+
+// IsFeatureEnabled returns true if the feature was enabled with WithFeatureFlags
+func (k Keeper) IsFeatureEnabled(feature string) bool {
+	return k.features[feature]
+}
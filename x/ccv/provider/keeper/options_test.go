@@ -0,0 +1,76 @@
+package keeper_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
+	providerkeeper "github.com/cosmos/interchain-security/v7/x/ccv/provider/keeper"
+	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// TestPacketSenderOption tests that the VSC packets are sent by the packet sender passed to the constructor
+func TestPacketSenderOption(t *testing.T) {
+	sentPackets := [][]byte{}
+	packetSender := func(_ sdk.Context, _ ccv.ChannelKeeper, sourceChannelID, sourcePortID string,
+		packetData []byte, _ time.Duration,
+	) (uint64, uint64, error) {
+		require.Equal(t, "CCVChannelID", sourceChannelID)
+		require.Equal(t, ccv.ProviderPortID, sourcePortID)
+		sentPackets = append(sentPackets, packetData)
+		return uint64(len(sentPackets)), 100, nil
+	}
+
+	keeperParams := testkeeper.NewInMemKeeperParams(t)
+	keeperParams.ProviderOptions = []providerkeeper.Option{providerkeeper.WithPacketSender(packetSender)}
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, keeperParams)
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+
+	providerKeeper.AppendPendingVSCPackets(ctx, CONSUMER_ID, []ccv.ValidatorSetChangePacketData{{ValsetUpdateId: 1}, {ValsetUpdateId: 2}}...)
+	err := providerKeeper.SendVSCPacketsToChain(ctx, CONSUMER_ID, "CCVChannelID")
+	require.NoError(t, err)
+
+	// the packets are sent without calling the channel keeper
+	require.Len(t, sentPackets, 2)
+	require.Empty(t, providerKeeper.GetPendingVSCPackets(ctx, CONSUMER_ID))
+
+	// the last sent packet is recorded
+	lastPacket, found := providerKeeper.GetLastVSCPacket(ctx, CONSUMER_ID)
+	require.True(t, found)
+	require.Equal(t, "CCVChannelID", lastPacket.ChannelId)
+	require.Equal(t, uint64(2), lastPacket.Sequence)
+	require.Equal(t, uint64(2), lastPacket.Data.ValsetUpdateId)
+}
+
+// TestLoggerAndFeatureFlagsOptions tests the logger and feature flags passed to the constructor
+func TestLoggerAndFeatureFlagsOptions(t *testing.T) {
+	var buf bytes.Buffer
+	keeperParams := testkeeper.NewInMemKeeperParams(t)
+	keeperParams.ProviderOptions = []providerkeeper.Option{
+		providerkeeper.WithLogger(log.NewLogger(&buf)),
+		providerkeeper.WithFeatureFlags("feature-a"),
+	}
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, keeperParams)
+	defer ctrl.Finish()
+
+	providerKeeper.Logger(ctx).Info("custom logger")
+	require.Contains(t, buf.String(), "custom logger")
+
+	require.True(t, providerKeeper.IsFeatureEnabled("feature-a"))
+	require.False(t, providerKeeper.IsFeatureEnabled("feature-b"))
+
+	// the keeper cannot be instantiated without a packet sender
+	mocks := testkeeper.NewMockedKeepers(gomock.NewController(t))
+	keeperParams.ProviderOptions = []providerkeeper.Option{providerkeeper.WithPacketSender(nil)}
+	require.Panics(t, func() { testkeeper.NewInMemProviderKeeper(keeperParams, mocks) })
+}
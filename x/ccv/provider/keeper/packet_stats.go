@@ -0,0 +1,93 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+)
+
+// GetConsumerPacketStats returns the number of CCV packets exchanged with the consumer chain with `consumerId`
+func (k Keeper) GetConsumerPacketStats(ctx sdk.Context, consumerId string) types.ConsumerPacketStats {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ConsumerIdToPacketStatsKey(consumerId))
+	if bz == nil {
+		return types.ConsumerPacketStats{}
+	}
+	var stats types.ConsumerPacketStats
+	if err := stats.Unmarshal(bz); err != nil {
+		// An error here would indicate something is very wrong,
+		// the stats are assumed to be correctly serialized in SetConsumerPacketStats.
+		panic(fmt.Errorf("failed to unmarshal packet stats for consumer id (%s): %w", consumerId, err))
+	}
+	return stats
+}
+
+// SetConsumerPacketStats sets the number of CCV packets exchanged with the consumer chain with `consumerId`
+func (k Keeper) SetConsumerPacketStats(ctx sdk.Context, consumerId string, stats types.ConsumerPacketStats) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := stats.Marshal()
+	if err != nil {
+		// An error here would indicate something is very wrong,
+		// the stats are obtained from GetConsumerPacketStats.
+		panic(fmt.Errorf("failed to marshal packet stats (%+v) for consumer id (%s): %w", stats, consumerId, err))
+	}
+	store.Set(types.ConsumerIdToPacketStatsKey(consumerId), bz)
+}
+
+// DeleteConsumerPacketStats deletes the packet statistics of the consumer chain with `consumerId`
+func (k Keeper) DeleteConsumerPacketStats(ctx sdk.Context, consumerId string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ConsumerIdToPacketStatsKey(consumerId))
+}
+
+// updateConsumerPacketStats applies `update` to the packet statistics of the consumer chain with `consumerId`
+func (k Keeper) updateConsumerPacketStats(ctx sdk.Context, consumerId string, update func(*types.ConsumerPacketStats)) {
+	stats := k.GetConsumerPacketStats(ctx, consumerId)
+	update(&stats)
+	k.SetConsumerPacketStats(ctx, consumerId, stats)
+}
+
+// RecordVSCMaturedPacketReceived records that a VSCMatured packet was received on the CCV channel `channelId`
+func (k Keeper) RecordVSCMaturedPacketReceived(ctx sdk.Context, channelId string) {
+	if consumerId, found := k.GetChannelIdToConsumerId(ctx, channelId); found {
+		k.updateConsumerPacketStats(ctx, consumerId, func(stats *types.ConsumerPacketStats) {
+			stats.VscMaturedPacketsReceived++
+		})
+	}
+}
+
+// GetLastVSCPacket returns the last VSC packet sent to the consumer chain with `consumerId`
+func (k Keeper) GetLastVSCPacket(ctx sdk.Context, consumerId string) (types.SentVSCPacket, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ConsumerIdToLastVSCPacketKey(consumerId))
+	if bz == nil {
+		return types.SentVSCPacket{}, false
+	}
+	var packet types.SentVSCPacket
+	if err := packet.Unmarshal(bz); err != nil {
+		// An error here would indicate something is very wrong,
+		// the packet is assumed to be correctly serialized in SetLastVSCPacket.
+		panic(fmt.Errorf("failed to unmarshal last VSC packet for consumer id (%s): %w", consumerId, err))
+	}
+	return packet, true
+}
+
+// SetLastVSCPacket sets the last VSC packet sent to the consumer chain with `consumerId`
+func (k Keeper) SetLastVSCPacket(ctx sdk.Context, consumerId string, packet types.SentVSCPacket) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := packet.Marshal()
+	if err != nil {
+		// An error here would indicate something is very wrong,
+		// the packet data is obtained from the pending VSC packets.
+		panic(fmt.Errorf("failed to marshal last VSC packet (%+v) for consumer id (%s): %w", packet, consumerId, err))
+	}
+	store.Set(types.ConsumerIdToLastVSCPacketKey(consumerId), bz)
+}
+
+// DeleteLastVSCPacket deletes the last VSC packet sent to the consumer chain with `consumerId`
+func (k Keeper) DeleteLastVSCPacket(ctx sdk.Context, consumerId string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ConsumerIdToLastVSCPacketKey(consumerId))
+}
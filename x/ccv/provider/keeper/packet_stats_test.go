@@ -0,0 +1,40 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
+	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+)
+
+// TestConsumerPacketStats tests the getter, setter, and deletion of the consumer packet statistics
+func TestConsumerPacketStats(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	consumerId := "0"
+	require.Equal(t, providertypes.ConsumerPacketStats{}, providerKeeper.GetConsumerPacketStats(ctx, consumerId))
+
+	stats := providertypes.ConsumerPacketStats{
+		VscPacketsSent:       5,
+		SlashPacketsReceived: 2,
+		SlashPacketsHandled:  1,
+		SlashPacketsBounced:  1,
+	}
+	providerKeeper.SetConsumerPacketStats(ctx, consumerId, stats)
+	require.Equal(t, stats, providerKeeper.GetConsumerPacketStats(ctx, consumerId))
+
+	// VSCMatured packets are recorded only if received on a CCV channel
+	providerKeeper.RecordVSCMaturedPacketReceived(ctx, "channel-0")
+	require.Equal(t, stats, providerKeeper.GetConsumerPacketStats(ctx, consumerId))
+
+	providerKeeper.SetChannelToConsumerId(ctx, "channel-0", consumerId)
+	providerKeeper.RecordVSCMaturedPacketReceived(ctx, "channel-0")
+	stats.VscMaturedPacketsReceived = 1
+	require.Equal(t, stats, providerKeeper.GetConsumerPacketStats(ctx, consumerId))
+
+	providerKeeper.DeleteConsumerPacketStats(ctx, consumerId)
+	require.Equal(t, providertypes.ConsumerPacketStats{}, providerKeeper.GetConsumerPacketStats(ctx, consumerId))
+}
@@ -53,12 +53,20 @@ func (k Keeper) GetConsumerRewardDenomRegistrationFee(ctx sdk.Context) sdk.Coin
 	return params.ConsumerRewardDenomRegistrationFee
 }
 
-// GetBlocksPerEpoch returns the number of blocks that constitute an epoch
+// GetBlocksPerEpoch returns the number of blocks that constitute an epoch,
+// or zero if the epochs are expressed in time (see GetEpochDuration)
 func (k Keeper) GetBlocksPerEpoch(ctx sdk.Context) int64 {
 	params := k.GetParams(ctx)
 	return params.BlocksPerEpoch
 }
 
+// GetEpochDuration returns the duration of an epoch,
+// or zero if the epochs are expressed in blocks (see GetBlocksPerEpoch)
+func (k Keeper) GetEpochDuration(ctx sdk.Context) time.Duration {
+	params := k.GetParams(ctx)
+	return params.EpochDuration
+}
+
 // GetNumberOfEpochsToStartReceivingRewards returns the number of epochs needed by a validator to continuously validate
 // to start receiving rewards
 func (k Keeper) GetNumberOfEpochsToStartReceivingRewards(ctx sdk.Context) int64 {
@@ -73,6 +81,72 @@ func (k Keeper) GetMaxProviderConsensusValidators(ctx sdk.Context) int64 {
 	return params.MaxProviderConsensusValidators
 }
 
+// GetMaxLaunchedConsumers returns the maximum number of launched consumer chains
+func (k Keeper) GetMaxLaunchedConsumers(ctx sdk.Context) uint64 {
+	params := k.GetParams(ctx)
+	return params.MaxLaunchedConsumers
+}
+
+// GetMinTopNBudget returns the minimal number of Top N consumer chains that a validator
+// can limit its automatic opt-in to
+func (k Keeper) GetMinTopNBudget(ctx sdk.Context) uint32 {
+	params := k.GetParams(ctx)
+	return params.MinTopNBudget
+}
+
+// GetImmediateValidatorUpdates returns whether the changes of the provider validator set caused by
+// the jailing, tombstoning, slashing, or bonding of validators are sent before the end of the epoch
+func (k Keeper) GetImmediateValidatorUpdates(ctx sdk.Context) bool {
+	params := k.GetParams(ctx)
+	return params.ImmediateValidatorUpdates
+}
+
+// GetImmediateDowntimeJailing returns whether the validators jailed for downtime
+// are removed from the consumer chains before the end of the epoch
+func (k Keeper) GetImmediateDowntimeJailing(ctx sdk.Context) bool {
+	params := k.GetParams(ctx)
+	return params.ImmediateDowntimeJailing
+}
+
+// GetClientExpiryWarningThreshold returns the duration before the expiry of a consumer client
+// from which the provider emits warning events
+func (k Keeper) GetClientExpiryWarningThreshold(ctx sdk.Context) time.Duration {
+	params := k.GetParams(ctx)
+	return params.ClientExpiryWarningThreshold
+}
+
+// GetMaxValidatorUpdatesPerPacket returns the maximum number of validator updates sent in one VSC packet
+func (k Keeper) GetMaxValidatorUpdatesPerPacket(ctx sdk.Context) uint64 {
+	params := k.GetParams(ctx)
+	return params.MaxValidatorUpdatesPerPacket
+}
+
+// GetStaleKeyAssignmentEpochs returns the number of epochs after which an unobserved assigned consumer key is stale
+func (k Keeper) GetStaleKeyAssignmentEpochs(ctx sdk.Context) int64 {
+	params := k.GetParams(ctx)
+	return params.StaleKeyAssignmentEpochs
+}
+
+// GetValidatorFeeExemptionsPerEpoch returns the number of key assignment and opt-in/opt-out messages per epoch
+// for which a bonded validator does not pay fees
+func (k Keeper) GetValidatorFeeExemptionsPerEpoch(ctx sdk.Context) uint64 {
+	params := k.GetParams(ctx)
+	return params.ValidatorFeeExemptionsPerEpoch
+}
+
+// GetMaxChannelClosedDuration returns the duration after which a launched consumer chain
+// whose CCV channel is closed or whose client is expired is removed
+func (k Keeper) GetMaxChannelClosedDuration(ctx sdk.Context) time.Duration {
+	params := k.GetParams(ctx)
+	return params.MaxChannelClosedDuration
+}
+
+// GetRestrictConsumerCreation returns true if only the allowlisted addresses can create consumer chains
+func (k Keeper) GetRestrictConsumerCreation(ctx sdk.Context) bool {
+	params := k.GetParams(ctx)
+	return params.RestrictConsumerCreation
+}
+
 // GetParams returns the paramset for the provider module
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 	store := ctx.KVStore(k.storeKey)
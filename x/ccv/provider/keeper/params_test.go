@@ -51,6 +51,17 @@ func TestParams(t *testing.T) {
 		600,
 		24,
 		10,
+		5,
+		4,
+		true,
+		true,
+		24*time.Hour,
+		100,
+		12,
+		5,
+		30*24*time.Hour,
+		0,
+		true,
 	)
 	providerKeeper.SetParams(ctx, newParams)
 	params = providerKeeper.GetParams(ctx)
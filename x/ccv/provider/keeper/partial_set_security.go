@@ -22,6 +22,9 @@ func (k Keeper) HandleOptIn(ctx sdk.Context, consumerId string, providerAddr typ
 			"cannot opt in to a consumer chain that is not in the registered, initialized, or launched phase: %s", consumerId)
 	}
 
+	if !k.IsOptedIn(ctx, consumerId, providerAddr) {
+		k.AppendValidatorOptInRecord(ctx, consumerId, providerAddr, true, false)
+	}
 	k.SetOptedIn(ctx, consumerId, providerAddr)
 
 	if consumerKey != "" {
@@ -41,7 +44,7 @@ func (k Keeper) HandleOptIn(ctx sdk.Context, consumerId string, providerAddr typ
 		}
 	}
 
-	return nil
+	return k.afterValidatorOptedIn(ctx, consumerId, providerAddr)
 }
 
 // HandleOptOut prepares validator `providerAddr` to opt out from running `consumerId`.
@@ -54,7 +57,7 @@ func (k Keeper) HandleOptOut(ctx sdk.Context, consumerId string, providerAddr ty
 			"opting out of an unknown consumer chain, consumerId(%s)", consumerId,
 		)
 	}
-	if phase != types.CONSUMER_PHASE_LAUNCHED {
+	if !k.IsConsumerLaunched(ctx, consumerId) {
 		// A validator can only opt out from a running chain
 		return errorsmod.Wrapf(
 			types.ErrInvalidPhase,
@@ -74,7 +77,7 @@ func (k Keeper) HandleOptOut(ctx sdk.Context, consumerId string, providerAddr ty
 		if err != nil {
 			return err
 		}
-		valAddr, err := sdk.ValAddressFromBech32(validator.GetOperator())
+		valAddr, err := k.ValidatorAddressCodec().StringToBytes(validator.GetOperator())
 		if err != nil {
 			return err
 		}
@@ -82,6 +85,7 @@ func (k Keeper) HandleOptOut(ctx sdk.Context, consumerId string, providerAddr ty
 		if err != nil {
 			return err
 		}
+		power = k.GetTopNPower(ctx, providerAddr, power, powerShapingParameters.Top_NWeightedEpochs)
 		minPowerInTopN, found := k.GetMinimumPowerInTopN(ctx, consumerId)
 		if !found {
 			return errorsmod.Wrapf(
@@ -89,7 +93,13 @@ func (k Keeper) HandleOptOut(ctx sdk.Context, consumerId string, providerAddr ty
 				"Could not find minimum power in top N for chain with consumer id: %s", consumerId)
 		}
 
-		if power >= minPowerInTopN {
+		exceedsTopNBudget, err := k.ExceedsTopNBudget(ctx, providerAddr)
+		if err != nil {
+			return err
+		}
+
+		// unless the validator is opted in to more Top N chains than its Top N budget
+		if power >= minPowerInTopN && !exceedsTopNBudget {
 			return errorsmod.Wrapf(
 				types.ErrCannotOptOutFromTopN,
 				"validator with power (%d) cannot opt out from Top N chain with consumer id (%s) because all validators"+
@@ -97,17 +107,24 @@ func (k Keeper) HandleOptOut(ctx sdk.Context, consumerId string, providerAddr ty
 		}
 	}
 
+	if k.IsOptedIn(ctx, consumerId, providerAddr) {
+		k.AppendValidatorOptInRecord(ctx, consumerId, providerAddr, false, false)
+	}
 	k.DeleteOptedIn(ctx, consumerId, providerAddr)
 
-	return nil
+	return k.afterValidatorOptedOut(ctx, consumerId, providerAddr)
 }
 
-// OptInTopNValidators opts in to `consumerId` all the `bondedValidators` that have at least `minPowerToOptIn` power
+// OptInTopNValidators opts in to `consumerId` all the `bondedValidators` that have at least `minPowerToOptIn` power,
+// averaged over the last `topNWeightedEpochs` epochs (see GetTopNPower). The `topNConsumersCounts` of the validators
+// that are opted in are incremented, unless nil (see HasTopNBudget).
 func (k Keeper) OptInTopNValidators(
 	ctx sdk.Context,
 	consumerId string,
 	bondedValidators []stakingtypes.Validator,
 	minPowerToOptIn int64,
+	topNWeightedEpochs uint32,
+	topNConsumersCounts TopNConsumersCounts,
 ) error {
 	for _, val := range bondedValidators {
 		// log the validator
@@ -116,7 +133,7 @@ func (k Keeper) OptInTopNValidators(
 			"validator", val.GetOperator(),
 		)
 
-		valAddr, err := sdk.ValAddressFromBech32(val.GetOperator())
+		valAddr, err := k.ValidatorAddressCodec().StringToBytes(val.GetOperator())
 		if err != nil {
 			return fmt.Errorf("converting operator address to validator address, consumerId(%s), validator(%s): %w",
 				consumerId, val.GetOperator(), err)
@@ -126,26 +143,188 @@ func (k Keeper) OptInTopNValidators(
 			return fmt.Errorf("getting validator power, consumerId(%s), validator(%s): %w",
 				consumerId, val.GetOperator(), err)
 		}
-		if power >= minPowerToOptIn {
-			consAddr, err := val.GetConsAddr()
+		consAddr, err := val.GetConsAddr()
+		if err != nil {
+			return fmt.Errorf("getting validator consensus address, consumerId(%s), validator(%s): %w",
+				consumerId, val.GetOperator(), err)
+		}
+		providerAddr := types.NewProviderConsAddress(consAddr)
+		if k.GetTopNPower(ctx, providerAddr, power, topNWeightedEpochs) >= minPowerToOptIn {
+			hasTopNBudget, err := k.HasTopNBudget(ctx, consumerId, providerAddr, topNConsumersCounts)
 			if err != nil {
-				return fmt.Errorf("getting validator consensus address, consumerId(%s), validator(%s): %w",
+				return fmt.Errorf("checking Top N budget, consumerId(%s), validator(%s): %w",
 					consumerId, val.GetOperator(), err)
 			}
+			if !hasTopNBudget {
+				k.Logger(ctx).Debug("Not opting in validator that exhausted its Top N budget",
+					"consumerId", consumerId,
+					"validator", val.GetOperator(),
+				)
+				continue
+			}
 
 			k.Logger(ctx).Debug("Opting in validator", "consumerId", consumerId, "validator", val.GetOperator())
 
 			// if validator is already opted in, it gets overwritten
-			k.SetOptedIn(ctx, consumerId, types.NewProviderConsAddress(consAddr))
+			if !k.IsOptedIn(ctx, consumerId, providerAddr) {
+				k.AppendValidatorOptInRecord(ctx, consumerId, providerAddr, true, true)
+				if topNConsumersCounts != nil {
+					topNConsumersCounts[providerAddr.String()]++
+				}
+			}
+			k.SetOptedIn(ctx, consumerId, providerAddr)
 		} // else validators that do not belong to the top N validators but were opted in, remain opted in
 	}
 	return nil
 }
 
+// HandleSetTopNBudget sets the maximal number of Top N consumer chains that validator `providerAddr`
+// is automatically opted in to. A zero budget removes the budget of the validator.
+func (k Keeper) HandleSetTopNBudget(ctx sdk.Context, providerAddr types.ProviderConsAddress, budget uint32) error {
+	if budget == 0 {
+		k.DeleteValidatorTopNBudget(ctx, providerAddr)
+		return nil
+	}
+
+	minTopNBudget := k.GetMinTopNBudget(ctx)
+	if budget < minTopNBudget {
+		return errorsmod.Wrapf(
+			types.ErrInvalidTopNBudget,
+			"Top N budget (%d) cannot be lower than the minimal Top N budget (%d)", budget, minTopNBudget)
+	}
+
+	k.SetValidatorTopNBudget(ctx, providerAddr, budget)
+
+	return nil
+}
+
+// GetOptedInTopNConsumersCount returns the number of launched Top N consumer chains
+// that validator `providerAddr` is opted in to
+func (k Keeper) GetOptedInTopNConsumersCount(ctx sdk.Context, providerAddr types.ProviderConsAddress) (uint32, error) {
+	count := uint32(0)
+	for _, consumerId := range k.GetAllConsumerIds(ctx) {
+		if !k.IsConsumerLaunched(ctx, consumerId) || !k.IsOptedIn(ctx, consumerId, providerAddr) {
+			continue
+		}
+		powerShapingParameters, err := k.GetConsumerPowerShapingParameters(ctx, consumerId)
+		if err != nil {
+			return 0, fmt.Errorf("getting power shaping parameters, consumerId(%s): %w", consumerId, err)
+		}
+		if powerShapingParameters.Top_N > 0 {
+			count++
+		}
+	}
+	return count, nil
+}
+
+// TopNConsumersCounts is the number of launched Top N consumer chains that every validator is opted in to,
+// indexed by the provider consensus address of the validator
+type TopNConsumersCounts map[string]uint32
+
+// GetTopNConsumersCounts returns the number of launched Top N consumer chains that every validator is opted in to.
+// It iterates once over the opted-in validators of every Top N consumer chain, so that it can be computed once
+// per epoch instead of calling GetOptedInTopNConsumersCount for every validator and consumer chain.
+func (k Keeper) GetTopNConsumersCounts(ctx sdk.Context) (TopNConsumersCounts, error) {
+	counts := TopNConsumersCounts{}
+	for _, consumerId := range k.GetAllConsumerIds(ctx) {
+		if !k.IsConsumerLaunched(ctx, consumerId) {
+			continue
+		}
+		powerShapingParameters, err := k.GetConsumerPowerShapingParameters(ctx, consumerId)
+		if err != nil {
+			return nil, fmt.Errorf("getting power shaping parameters, consumerId(%s): %w", consumerId, err)
+		}
+		if powerShapingParameters.Top_N == 0 {
+			continue
+		}
+		for _, providerAddr := range k.GetAllOptedIn(ctx, consumerId) {
+			counts[providerAddr.String()]++
+		}
+	}
+	return counts, nil
+}
+
+// getEffectiveTopNBudget returns the Top N budget of a validator, which cannot be lower than the MinTopNBudget param
+func (k Keeper) getEffectiveTopNBudget(ctx sdk.Context, budget uint32) uint32 {
+	minTopNBudget := k.GetMinTopNBudget(ctx)
+	if budget < minTopNBudget {
+		return minTopNBudget
+	}
+	return budget
+}
+
+// HasTopNBudget returns true if validator `providerAddr` can be automatically opted in to the Top N chain
+// with `consumerId`, i.e., if the validator did not set a Top N budget, if the validator is already opted in
+// to the chain, or if the validator is opted in to fewer Top N consumer chains than its Top N budget.
+// The number of Top N consumer chains the validator is opted in to is taken from `topNConsumersCounts`,
+// if not nil, i.e., if precomputed with GetTopNConsumersCounts.
+func (k Keeper) HasTopNBudget(
+	ctx sdk.Context,
+	consumerId string,
+	providerAddr types.ProviderConsAddress,
+	topNConsumersCounts TopNConsumersCounts,
+) (bool, error) {
+	budget, found := k.GetValidatorTopNBudget(ctx, providerAddr)
+	if !found || k.IsOptedIn(ctx, consumerId, providerAddr) {
+		return true, nil
+	}
+
+	var count uint32
+	if topNConsumersCounts != nil {
+		count = topNConsumersCounts[providerAddr.String()]
+	} else {
+		var err error
+		count, err = k.GetOptedInTopNConsumersCount(ctx, providerAddr)
+		if err != nil {
+			return false, err
+		}
+	}
+	return count < k.getEffectiveTopNBudget(ctx, budget), nil
+}
+
+// ExceedsTopNBudget returns true if validator `providerAddr` is opted in to more Top N consumer chains
+// than its Top N budget
+func (k Keeper) ExceedsTopNBudget(ctx sdk.Context, providerAddr types.ProviderConsAddress) (bool, error) {
+	budget, found := k.GetValidatorTopNBudget(ctx, providerAddr)
+	if !found {
+		return false, nil
+	}
+
+	count, err := k.GetOptedInTopNConsumersCount(ctx, providerAddr)
+	if err != nil {
+		return false, err
+	}
+	return count > k.getEffectiveTopNBudget(ctx, budget), nil
+}
+
 //
 // Setters and getters
 //
 
+// SetValidatorTopNBudget sets the maximal number of Top N consumer chains
+// that validator `providerAddr` is automatically opted in to
+func (k Keeper) SetValidatorTopNBudget(ctx sdk.Context, providerAddr types.ProviderConsAddress, budget uint32) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ValidatorTopNBudgetKey(providerAddr), sdk.Uint64ToBigEndian(uint64(budget)))
+}
+
+// GetValidatorTopNBudget returns the maximal number of Top N consumer chains
+// that validator `providerAddr` is automatically opted in to
+func (k Keeper) GetValidatorTopNBudget(ctx sdk.Context, providerAddr types.ProviderConsAddress) (uint32, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ValidatorTopNBudgetKey(providerAddr))
+	if bz == nil {
+		return 0, false
+	}
+	return uint32(sdk.BigEndianToUint64(bz)), true
+}
+
+// DeleteValidatorTopNBudget deletes the Top N budget of validator `providerAddr`
+func (k Keeper) DeleteValidatorTopNBudget(ctx sdk.Context, providerAddr types.ProviderConsAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ValidatorTopNBudgetKey(providerAddr))
+}
+
 func (k Keeper) SetOptedIn(
 	ctx sdk.Context,
 	consumerId string,
@@ -14,6 +14,7 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
+	providerkeeper "github.com/cosmos/interchain-security/v7/x/ccv/provider/keeper"
 	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
 	ccvtypes "github.com/cosmos/interchain-security/v7/x/ccv/types"
 )
@@ -21,6 +22,7 @@ import (
 func TestHandleOptIn(t *testing.T) {
 	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
 	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
 
 	providerAddr := providertypes.NewProviderConsAddress([]byte("providerAddr"))
 
@@ -42,6 +44,7 @@ func TestHandleOptIn(t *testing.T) {
 func TestHandleOptInWithConsumerKey(t *testing.T) {
 	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
 	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
 
 	// generate a consensus public key for the provider
 	providerConsPubKey := ed25519.GenPrivKeyFromSecret([]byte{1}).PubKey()
@@ -92,6 +95,7 @@ func TestHandleOptInWithConsumerKey(t *testing.T) {
 func TestHandleOptOut(t *testing.T) {
 	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
 	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
 
 	consumerId := CONSUMER_ID
 
@@ -116,6 +120,7 @@ func TestHandleOptOut(t *testing.T) {
 func TestHandleOptOutFromTopNChain(t *testing.T) {
 	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
 	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
 
 	consumerId := CONSUMER_ID
 
@@ -175,6 +180,7 @@ func TestHandleOptOutFromTopNChain(t *testing.T) {
 func TestOptInTopNValidators(t *testing.T) {
 	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
 	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
 
 	// create 4 validators with powers 1, 2, 3, and 1 respectively
 	valA := createStakingValidator(ctx, mocks, 1, 1)
@@ -187,7 +193,7 @@ func TestOptInTopNValidators(t *testing.T) {
 	valDConsAddr, _ := valD.GetConsAddr()
 
 	// Start Test 1: opt in all validators with power >= 0
-	err := providerKeeper.OptInTopNValidators(ctx, CONSUMER_ID, []stakingtypes.Validator{valA, valB, valC, valD}, 0)
+	err := providerKeeper.OptInTopNValidators(ctx, CONSUMER_ID, []stakingtypes.Validator{valA, valB, valC, valD}, 0, 0, nil)
 	require.NoError(t, err)
 	expectedOptedInValidators := []providertypes.ProviderConsAddress{
 		providertypes.NewProviderConsAddress(valAConsAddr),
@@ -217,7 +223,7 @@ func TestOptInTopNValidators(t *testing.T) {
 	// Start Test 2: opt in all validators with power >= 1
 	// We expect the same `expectedOptedInValidators` as when we opted in all validators with power >= 0 because the
 	// validators with the smallest power have power == 1
-	err = providerKeeper.OptInTopNValidators(ctx, CONSUMER_ID, []stakingtypes.Validator{valA, valB, valC, valD}, 0)
+	err = providerKeeper.OptInTopNValidators(ctx, CONSUMER_ID, []stakingtypes.Validator{valA, valB, valC, valD}, 0, 0, nil)
 	require.NoError(t, err)
 	actualOptedInValidators = providerKeeper.GetAllOptedIn(ctx, CONSUMER_ID)
 	sortUpdates(actualOptedInValidators)
@@ -229,7 +235,7 @@ func TestOptInTopNValidators(t *testing.T) {
 	providerKeeper.DeleteOptedIn(ctx, CONSUMER_ID, providertypes.NewProviderConsAddress(valDConsAddr))
 
 	// Start Test 3: opt in all validators with power >= 2 and hence we do not expect to opt in validator A
-	err = providerKeeper.OptInTopNValidators(ctx, CONSUMER_ID, []stakingtypes.Validator{valA, valB, valC, valD}, 2)
+	err = providerKeeper.OptInTopNValidators(ctx, CONSUMER_ID, []stakingtypes.Validator{valA, valB, valC, valD}, 2, 0, nil)
 	require.NoError(t, err)
 	expectedOptedInValidators = []providertypes.ProviderConsAddress{
 		providertypes.NewProviderConsAddress(valBConsAddr),
@@ -249,7 +255,7 @@ func TestOptInTopNValidators(t *testing.T) {
 	providerKeeper.DeleteOptedIn(ctx, CONSUMER_ID, providertypes.NewProviderConsAddress(valDConsAddr))
 
 	// Start Test 4: opt in all validators with power >= 4 and hence we do not expect any opted-in validators
-	err = providerKeeper.OptInTopNValidators(ctx, CONSUMER_ID, []stakingtypes.Validator{valA, valB, valC, valD}, 4)
+	err = providerKeeper.OptInTopNValidators(ctx, CONSUMER_ID, []stakingtypes.Validator{valA, valB, valC, valD}, 4, 0, nil)
 	require.NoError(t, err)
 	require.Empty(t, providerKeeper.GetAllOptedIn(ctx, CONSUMER_ID))
 }
@@ -303,3 +309,124 @@ func TestOptedIn(t *testing.T) {
 	require.False(t, providerKeeper.IsOptedIn(ctx, CONSUMER_ID, optedInValidator1))
 	require.False(t, providerKeeper.IsOptedIn(ctx, CONSUMER_ID, optedInValidator2))
 }
+
+// TestTopNBudget tests that validators are not automatically opted in to more Top N chains than their Top N budget
+func TestTopNBudget(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	params := providertypes.DefaultParams()
+	params.MinTopNBudget = 2
+	providerKeeper.SetParams(ctx, params)
+
+	valA := createStakingValidator(ctx, mocks, 1, 1)
+	valAConsAddr, _ := valA.GetConsAddr()
+	providerAddr := providertypes.NewProviderConsAddress(valAConsAddr)
+
+	// a budget lower than the minimal Top N budget is rejected
+	require.ErrorIs(t, providerKeeper.HandleSetTopNBudget(ctx, providerAddr, 1), providertypes.ErrInvalidTopNBudget)
+	_, found := providerKeeper.GetValidatorTopNBudget(ctx, providerAddr)
+	require.False(t, found)
+	require.NoError(t, providerKeeper.HandleSetTopNBudget(ctx, providerAddr, 2))
+	budget, found := providerKeeper.GetValidatorTopNBudget(ctx, providerAddr)
+	require.True(t, found)
+	require.Equal(t, uint32(2), budget)
+
+	// launch three Top N chains
+	consumerIds := []string{}
+	for i := 0; i < 3; i++ {
+		consumerId := providerKeeper.FetchAndIncrementConsumerId(ctx)
+		providerKeeper.SetConsumerPhase(ctx, consumerId, providertypes.CONSUMER_PHASE_LAUNCHED)
+		err := providerKeeper.SetConsumerPowerShapingParameters(ctx, consumerId, providertypes.PowerShapingParameters{
+			Top_N: 100,
+		})
+		require.NoError(t, err)
+		consumerIds = append(consumerIds, consumerId)
+	}
+
+	// the validator is automatically opted in to the first two chains only
+	for _, consumerId := range consumerIds {
+		err := providerKeeper.OptInTopNValidators(ctx, consumerId, []stakingtypes.Validator{valA}, 0, 0, nil)
+		require.NoError(t, err)
+	}
+	require.True(t, providerKeeper.IsOptedIn(ctx, consumerIds[0], providerAddr))
+	require.True(t, providerKeeper.IsOptedIn(ctx, consumerIds[1], providerAddr))
+	require.False(t, providerKeeper.IsOptedIn(ctx, consumerIds[2], providerAddr))
+	count, err := providerKeeper.GetOptedInTopNConsumersCount(ctx, providerAddr)
+	require.NoError(t, err)
+	require.Equal(t, uint32(2), count)
+
+	// the validator keeps validating the chains it is already opted in to
+	hasTopNBudget, err := providerKeeper.HasTopNBudget(ctx, consumerIds[0], providerAddr, nil)
+	require.NoError(t, err)
+	require.True(t, hasTopNBudget)
+	hasTopNBudget, err = providerKeeper.HasTopNBudget(ctx, consumerIds[2], providerAddr, nil)
+	require.NoError(t, err)
+	require.False(t, hasTopNBudget)
+	exceedsTopNBudget, err := providerKeeper.ExceedsTopNBudget(ctx, providerAddr)
+	require.NoError(t, err)
+	require.False(t, exceedsTopNBudget)
+
+	// the counts computed once per epoch give the same result
+	topNConsumersCounts, err := providerKeeper.GetTopNConsumersCounts(ctx)
+	require.NoError(t, err)
+	require.Equal(t, providerkeeper.TopNConsumersCounts{providerAddr.String(): 2}, topNConsumersCounts)
+	hasTopNBudget, err = providerKeeper.HasTopNBudget(ctx, consumerIds[0], providerAddr, topNConsumersCounts)
+	require.NoError(t, err)
+	require.True(t, hasTopNBudget)
+	hasTopNBudget, err = providerKeeper.HasTopNBudget(ctx, consumerIds[2], providerAddr, topNConsumersCounts)
+	require.NoError(t, err)
+	require.False(t, hasTopNBudget)
+
+	// a validator that opts in to a third chain exceeds its budget
+	providerKeeper.SetOptedIn(ctx, consumerIds[2], providerAddr)
+	exceedsTopNBudget, err = providerKeeper.ExceedsTopNBudget(ctx, providerAddr)
+	require.NoError(t, err)
+	require.True(t, exceedsTopNBudget)
+
+	// removing the budget lets the validator be automatically opted in again
+	providerKeeper.DeleteOptedIn(ctx, consumerIds[2], providerAddr)
+	require.NoError(t, providerKeeper.HandleSetTopNBudget(ctx, providerAddr, 0))
+	_, found = providerKeeper.GetValidatorTopNBudget(ctx, providerAddr)
+	require.False(t, found)
+	hasTopNBudget, err = providerKeeper.HasTopNBudget(ctx, consumerIds[2], providerAddr, nil)
+	require.NoError(t, err)
+	require.True(t, hasTopNBudget)
+}
+
+// TestHandleOptOutFromTopNChainExceedingTopNBudget tests that a validator that is opted in to more Top N chains
+// than its Top N budget can opt out from a Top N chain
+func TestHandleOptOutFromTopNChainExceedingTopNBudget(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	params := providertypes.DefaultParams()
+	params.MinTopNBudget = 1
+	providerKeeper.SetParams(ctx, params)
+
+	valA := createStakingValidator(ctx, mocks, 1, 1)
+	valAConsAddr, _ := valA.GetConsAddr()
+	mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(ctx, valAConsAddr).Return(valA, nil).AnyTimes()
+	providerAddr := providertypes.NewProviderConsAddress(valAConsAddr)
+
+	consumerIds := []string{}
+	for i := 0; i < 2; i++ {
+		consumerId := providerKeeper.FetchAndIncrementConsumerId(ctx)
+		providerKeeper.SetConsumerPhase(ctx, consumerId, providertypes.CONSUMER_PHASE_LAUNCHED)
+		err := providerKeeper.SetConsumerPowerShapingParameters(ctx, consumerId, providertypes.PowerShapingParameters{
+			Top_N: 100,
+		})
+		require.NoError(t, err)
+		providerKeeper.SetMinimumPowerInTopN(ctx, consumerId, 1)
+		providerKeeper.SetOptedIn(ctx, consumerId, providerAddr)
+		consumerIds = append(consumerIds, consumerId)
+	}
+
+	// without a budget, the validator cannot opt out
+	require.ErrorIs(t, providerKeeper.HandleOptOut(ctx, consumerIds[0], providerAddr), providertypes.ErrCannotOptOutFromTopN)
+
+	// with a budget of one chain, the validator can opt out from one chain only
+	require.NoError(t, providerKeeper.HandleSetTopNBudget(ctx, providerAddr, 1))
+	require.NoError(t, providerKeeper.HandleOptOut(ctx, consumerIds[0], providerAddr))
+	require.ErrorIs(t, providerKeeper.HandleOptOut(ctx, consumerIds[1], providerAddr), providertypes.ErrCannotOptOutFromTopN)
+}
@@ -5,6 +5,10 @@ import (
 	"fmt"
 	"strconv"
 
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+
+	storetypes "cosmossdk.io/store/types"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
@@ -146,12 +150,64 @@ func (k Keeper) SetConsumerInitializationParameters(ctx sdk.Context, consumerId
 	return nil
 }
 
+// CorrectConsumerInitialHeight sets the revision number of the initial height in the initialization parameters
+// associated with this consumer id to the revision number of its chain id, and returns the corrected initial height
+func (k Keeper) CorrectConsumerInitialHeight(ctx sdk.Context, consumerId string) (clienttypes.Height, error) {
+	parameters, err := k.GetConsumerInitializationParameters(ctx, consumerId)
+	if err != nil {
+		return clienttypes.Height{}, err
+	}
+	chainId, err := k.GetConsumerChainId(ctx, consumerId)
+	if err != nil {
+		return clienttypes.Height{}, fmt.Errorf("failed to get consumer chain ID for consumer id (%s): %w", consumerId, err)
+	}
+	parameters.InitialHeight = types.ExpectedInitialHeight(parameters.InitialHeight, chainId)
+	if err := k.SetConsumerInitializationParameters(ctx, consumerId, parameters); err != nil {
+		return clienttypes.Height{}, err
+	}
+	return parameters.InitialHeight, nil
+}
+
 // DeleteConsumerInitializationParameters deletes the initialization parameters associated with this consumer id
 func (k Keeper) DeleteConsumerInitializationParameters(ctx sdk.Context, consumerId string) {
 	store := ctx.KVStore(k.storeKey)
 	store.Delete(types.ConsumerIdToInitializationParametersKey(consumerId))
 }
 
+// GetConsumerInitialConsensusState returns the owner-provided initial consensus state associated with this consumer id
+func (k Keeper) GetConsumerInitialConsensusState(ctx sdk.Context, consumerId string) (types.ConsumerInitialConsensusState, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ConsumerIdToInitialConsensusStateKey(consumerId))
+	if bz == nil {
+		return types.ConsumerInitialConsensusState{}, false
+	}
+	var initialConsensusState types.ConsumerInitialConsensusState
+	if err := initialConsensusState.Unmarshal(bz); err != nil {
+		// An error here would indicate something is very wrong,
+		// the initial consensus state is assumed to be correctly serialized in SetConsumerInitialConsensusState.
+		panic(fmt.Errorf("failed to unmarshal initial consensus state for consumer id (%s): %w", consumerId, err))
+	}
+	return initialConsensusState, true
+}
+
+// SetConsumerInitialConsensusState sets the owner-provided initial consensus state associated with this consumer id
+func (k Keeper) SetConsumerInitialConsensusState(ctx sdk.Context, consumerId string, initialConsensusState types.ConsumerInitialConsensusState) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := initialConsensusState.Marshal()
+	if err != nil {
+		// An error here would indicate something is very wrong,
+		// the initial consensus state is obtained from a validated message.
+		panic(fmt.Errorf("failed to marshal initial consensus state for consumer id (%s): %w", consumerId, err))
+	}
+	store.Set(types.ConsumerIdToInitialConsensusStateKey(consumerId), bz)
+}
+
+// DeleteConsumerInitialConsensusState deletes the owner-provided initial consensus state associated with this consumer id
+func (k Keeper) DeleteConsumerInitialConsensusState(ctx sdk.Context, consumerId string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ConsumerIdToInitialConsensusStateKey(consumerId))
+}
+
 // GetConsumerPhase returns the phase associated with this consumer id
 func (k Keeper) GetConsumerPhase(ctx sdk.Context, consumerId string) types.ConsumerPhase {
 	store := ctx.KVStore(k.storeKey)
@@ -184,10 +240,106 @@ func (k Keeper) IsConsumerPrelaunched(ctx sdk.Context, consumerId string) bool {
 		phase == types.CONSUMER_PHASE_INITIALIZED
 }
 
-// IsConsumerActive checks if a consumer chain is either registered, initialized, or launched.
+// IsConsumerLaunched checks if a consumer chain is launched, i.e., either in the launched or the paused phase.
+func (k Keeper) IsConsumerLaunched(ctx sdk.Context, consumerId string) bool {
+	phase := k.GetConsumerPhase(ctx, consumerId)
+	return phase == types.CONSUMER_PHASE_LAUNCHED ||
+		phase == types.CONSUMER_PHASE_PAUSED
+}
+
+// IsConsumerActive checks if a consumer chain is either registered, initialized, or launched (including paused).
 func (k Keeper) IsConsumerActive(ctx sdk.Context, consumerId string) bool {
 	phase := k.GetConsumerPhase(ctx, consumerId)
 	return phase == types.CONSUMER_PHASE_REGISTERED ||
 		phase == types.CONSUMER_PHASE_INITIALIZED ||
-		phase == types.CONSUMER_PHASE_LAUNCHED
+		phase == types.CONSUMER_PHASE_LAUNCHED ||
+		phase == types.CONSUMER_PHASE_PAUSED
+}
+
+// SetConsumerCreatorAllowlisted adds `addr` to the addresses allowed to create consumer chains
+func (k Keeper) SetConsumerCreatorAllowlisted(ctx sdk.Context, addr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ConsumerCreatorAllowlistKey(addr), []byte{})
+}
+
+// IsConsumerCreatorAllowlisted checks whether `addr` is in the addresses allowed to create consumer chains
+func (k Keeper) IsConsumerCreatorAllowlisted(ctx sdk.Context, addr sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.ConsumerCreatorAllowlistKey(addr))
+}
+
+// DeleteConsumerCreatorAllowlisted removes `addr` from the addresses allowed to create consumer chains
+func (k Keeper) DeleteConsumerCreatorAllowlisted(ctx sdk.Context, addr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ConsumerCreatorAllowlistKey(addr))
+}
+
+// GetConsumerCreatorAllowlist returns the addresses allowed to create consumer chains
+func (k Keeper) GetConsumerCreatorAllowlist(ctx sdk.Context) (addresses []sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.ConsumerCreatorAllowlistKeyPrefix())
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		addresses = append(addresses, sdk.AccAddress(iterator.Key()[1:]))
+	}
+
+	return addresses
+}
+
+// CanCreateConsumer returns true if `submitter` is allowed to create consumer chains, i.e.,
+// either consumer creation is not restricted (see the RestrictConsumerCreation param),
+// or `submitter` is the gov module, or the allowlist of consumer creators contains `submitter`
+func (k Keeper) CanCreateConsumer(ctx sdk.Context, submitter string) bool {
+	if !k.GetRestrictConsumerCreation(ctx) || submitter == k.GetAuthority() {
+		return true
+	}
+
+	addr, err := k.accountKeeper.AddressCodec().StringToBytes(submitter)
+	if err != nil {
+		return false
+	}
+	return k.IsConsumerCreatorAllowlisted(ctx, addr)
+}
+
+// ChangeConsumerCreatorAllowlist adds and removes addresses from the allowlist of consumer creators
+// and returns the event attributes of the changes
+func (k Keeper) ChangeConsumerCreatorAllowlist(ctx sdk.Context, addressesToAdd, addressesToRemove []string) ([]sdk.Attribute, error) {
+	// initialize an empty slice to store event attributes
+	eventAttributes := []sdk.Attribute{}
+
+	for _, addressToAdd := range addressesToAdd {
+		addr, err := k.accountKeeper.AddressCodec().StringToBytes(addressToAdd)
+		if err != nil {
+			return nil, err
+		}
+		// Log error and move on if one of the addresses is already allowlisted
+		if k.IsConsumerCreatorAllowlisted(ctx, addr) {
+			k.Logger(ctx).Error("ChangeConsumerCreatorAllowlist: address already allowlisted",
+				"addressToAdd", addressToAdd,
+			)
+			continue
+		}
+		k.SetConsumerCreatorAllowlisted(ctx, addr)
+
+		eventAttributes = append(eventAttributes, sdk.NewAttribute(types.AttributeAddConsumerCreator, addressToAdd))
+	}
+
+	for _, addressToRemove := range addressesToRemove {
+		addr, err := k.accountKeeper.AddressCodec().StringToBytes(addressToRemove)
+		if err != nil {
+			return nil, err
+		}
+		// Log error and move on if one of the addresses is not allowlisted
+		if !k.IsConsumerCreatorAllowlisted(ctx, addr) {
+			k.Logger(ctx).Error("ChangeConsumerCreatorAllowlist: address not allowlisted",
+				"addressToRemove", addressToRemove,
+			)
+			continue
+		}
+		k.DeleteConsumerCreatorAllowlisted(ctx, addr)
+
+		eventAttributes = append(eventAttributes, sdk.NewAttribute(types.AttributeRemoveConsumerCreator, addressToRemove))
+	}
+
+	return eventAttributes, nil
 }
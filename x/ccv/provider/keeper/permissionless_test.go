@@ -7,6 +7,9 @@ import (
 	"github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
 	"github.com/stretchr/testify/require"
 
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
 	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
 )
@@ -218,3 +221,71 @@ func TestIsConsumerPrelaunched(t *testing.T) {
 	providerKeeper.SetConsumerPhase(ctx, CONSUMER_ID, providertypes.CONSUMER_PHASE_DELETED)
 	require.False(t, providerKeeper.IsConsumerPrelaunched(ctx, CONSUMER_ID))
 }
+
+// TestConsumerCreatorAllowlist tests the getter, setter, and deletion of the consumer creator allowlist methods
+func TestConsumerCreatorAllowlist(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	mocks.MockAccountKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec("cosmos")).AnyTimes()
+
+	creator1 := sdk.AccAddress([]byte("creator1"))
+	creator2 := sdk.AccAddress([]byte("creator2"))
+
+	// anyone can create a consumer chain if consumer creation is not restricted
+	require.Empty(t, providerKeeper.GetConsumerCreatorAllowlist(ctx))
+	require.True(t, providerKeeper.CanCreateConsumer(ctx, creator1.String()))
+	require.True(t, providerKeeper.CanCreateConsumer(ctx, "submitter"))
+
+	// only the gov module can create a consumer chain if consumer creation is restricted and the allowlist is empty
+	params := providertypes.DefaultParams()
+	params.RestrictConsumerCreation = true
+	providerKeeper.SetParams(ctx, params)
+	require.False(t, providerKeeper.CanCreateConsumer(ctx, creator1.String()))
+	require.True(t, providerKeeper.CanCreateConsumer(ctx, providerKeeper.GetAuthority()))
+
+	providerKeeper.SetConsumerCreatorAllowlisted(ctx, creator1)
+	require.True(t, providerKeeper.IsConsumerCreatorAllowlisted(ctx, creator1))
+	require.False(t, providerKeeper.IsConsumerCreatorAllowlisted(ctx, creator2))
+	require.Equal(t, []sdk.AccAddress{creator1}, providerKeeper.GetConsumerCreatorAllowlist(ctx))
+	require.True(t, providerKeeper.CanCreateConsumer(ctx, creator1.String()))
+	require.False(t, providerKeeper.CanCreateConsumer(ctx, creator2.String()))
+	require.False(t, providerKeeper.CanCreateConsumer(ctx, "submitter"))
+	require.True(t, providerKeeper.CanCreateConsumer(ctx, providerKeeper.GetAuthority()))
+
+	providerKeeper.DeleteConsumerCreatorAllowlisted(ctx, creator1)
+	require.Empty(t, providerKeeper.GetConsumerCreatorAllowlist(ctx))
+	require.False(t, providerKeeper.CanCreateConsumer(ctx, creator1.String()))
+
+	// the allowlist is ignored if consumer creation is not restricted
+	params.RestrictConsumerCreation = false
+	providerKeeper.SetParams(ctx, params)
+	require.True(t, providerKeeper.CanCreateConsumer(ctx, creator2.String()))
+}
+
+func TestChangeConsumerCreatorAllowlist(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	mocks.MockAccountKeeper.EXPECT().AddressCodec().Return(address.NewBech32Codec("cosmos")).AnyTimes()
+
+	creator1 := sdk.AccAddress([]byte("creator1")).String()
+	creator2 := sdk.AccAddress([]byte("creator2")).String()
+
+	attributes, err := providerKeeper.ChangeConsumerCreatorAllowlist(ctx, []string{creator1, creator2}, nil)
+	require.NoError(t, err)
+	require.Len(t, attributes, 2)
+	require.Len(t, providerKeeper.GetConsumerCreatorAllowlist(ctx), 2)
+
+	// adding an already allowlisted address and removing a non-allowlisted address are no-ops
+	attributes, err = providerKeeper.ChangeConsumerCreatorAllowlist(ctx, []string{creator1},
+		[]string{sdk.AccAddress([]byte("creator3")).String()})
+	require.NoError(t, err)
+	require.Empty(t, attributes)
+
+	attributes, err = providerKeeper.ChangeConsumerCreatorAllowlist(ctx, nil, []string{creator1})
+	require.NoError(t, err)
+	require.Equal(t, []sdk.Attribute{sdk.NewAttribute(providertypes.AttributeRemoveConsumerCreator, creator1)}, attributes)
+	require.Equal(t, []sdk.AccAddress{sdk.AccAddress([]byte("creator2"))}, providerKeeper.GetConsumerCreatorAllowlist(ctx))
+
+	_, err = providerKeeper.ChangeConsumerCreatorAllowlist(ctx, []string{"invalid"}, nil)
+	require.Error(t, err)
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"bytes"
 	"encoding/binary"
 	"errors"
 	"fmt"
@@ -20,6 +21,18 @@ import (
 // ComputeMinPowerInTopN returns the minimum power needed for a validator (from the bonded validators)
 // to belong to the `topN`% of validators for a Top N chain.
 func (k Keeper) ComputeMinPowerInTopN(ctx sdk.Context, bondedValidators []stakingtypes.Validator, topN uint32) (int64, error) {
+	return k.ComputeMinPowerInWeightedTopN(ctx, bondedValidators, topN, 0)
+}
+
+// ComputeMinPowerInWeightedTopN returns the minimum power needed for a validator (from the bonded validators)
+// to belong to the `topN`% of validators for a Top N chain, where the power of a validator is averaged over
+// the last `topNWeightedEpochs` epochs (see GetTopNPower).
+func (k Keeper) ComputeMinPowerInWeightedTopN(
+	ctx sdk.Context,
+	bondedValidators []stakingtypes.Validator,
+	topN uint32,
+	topNWeightedEpochs uint32,
+) (int64, error) {
 	if topN == 0 || topN > 100 {
 		// Note that Top N chains have a lower limit on `topN`, namely that topN cannot be less than 50.
 		// However, we can envision that this method could be used for other (future) reasons where this might not
@@ -32,7 +45,7 @@ func (k Keeper) ComputeMinPowerInTopN(ctx sdk.Context, bondedValidators []stakin
 	var powers []int64
 
 	for _, val := range bondedValidators {
-		valAddr, err := sdk.ValAddressFromBech32(val.GetOperator())
+		valAddr, err := k.ValidatorAddressCodec().StringToBytes(val.GetOperator())
 		if err != nil {
 			return 0, err
 		}
@@ -40,6 +53,13 @@ func (k Keeper) ComputeMinPowerInTopN(ctx sdk.Context, bondedValidators []stakin
 		if err != nil {
 			return 0, err
 		}
+		if topNWeightedEpochs > 0 {
+			consAddr, err := val.GetConsAddr()
+			if err != nil {
+				return 0, err
+			}
+			power = k.GetTopNPower(ctx, types.NewProviderConsAddress(consAddr), power, topNWeightedEpochs)
+		}
 		powers = append(powers, power)
 		totalPower = totalPower.Add(math.LegacyNewDec(power))
 	}
@@ -63,6 +83,46 @@ func (k Keeper) ComputeMinPowerInTopN(ctx sdk.Context, bondedValidators []stakin
 	return 0, fmt.Errorf("should never reach this point with topN (%d), totalPower (%d), and powerSum (%d)", topN, totalPower, powerSum)
 }
 
+// ComputeOptedInTopNPower returns the percentage of the power of the `activeValidators` that is held by the validators
+// opted in to `consumerId`, where the power of a validator is averaged over the last `topNWeightedEpochs` epochs
+// (see GetTopNPower). For a Top N chain, the percentage is lower than Top N if validators that belong to the top N
+// exhausted their Top N budget (see HasTopNBudget) and were therefore not automatically opted in.
+func (k Keeper) ComputeOptedInTopNPower(
+	ctx sdk.Context,
+	consumerId string,
+	activeValidators []stakingtypes.Validator,
+	topNWeightedEpochs uint32,
+) (math.LegacyDec, error) {
+	totalPower := math.LegacyZeroDec()
+	optedInPower := math.LegacyZeroDec()
+	for _, val := range activeValidators {
+		valAddr, err := k.ValidatorAddressCodec().StringToBytes(val.GetOperator())
+		if err != nil {
+			return math.LegacyZeroDec(), err
+		}
+		power, err := k.stakingKeeper.GetLastValidatorPower(ctx, valAddr)
+		if err != nil {
+			return math.LegacyZeroDec(), err
+		}
+		consAddr, err := val.GetConsAddr()
+		if err != nil {
+			return math.LegacyZeroDec(), err
+		}
+		providerAddr := types.NewProviderConsAddress(consAddr)
+		power = k.GetTopNPower(ctx, providerAddr, power, topNWeightedEpochs)
+
+		totalPower = totalPower.Add(math.LegacyNewDec(power))
+		if k.IsOptedIn(ctx, consumerId, providerAddr) {
+			optedInPower = optedInPower.Add(math.LegacyNewDec(power))
+		}
+	}
+
+	if totalPower.IsZero() {
+		return math.LegacyZeroDec(), nil
+	}
+	return optedInPower.MulInt64(100).Quo(totalPower), nil
+}
+
 // UpdateMinimumPowerInTopN populates the minimum power in Top N for the consumer chain with this consumer id
 func (k Keeper) UpdateMinimumPowerInTopN(ctx sdk.Context, consumerId string, oldTopN, newTopN uint32) error {
 	// if the top N changes, we need to update the new minimum power in top N
@@ -73,7 +133,9 @@ func (k Keeper) UpdateMinimumPowerInTopN(ctx sdk.Context, consumerId string, old
 			if err != nil {
 				return err
 			}
-			minPower, err := k.ComputeMinPowerInTopN(ctx, bondedValidators, newTopN)
+			// the power shaping parameters are not found only if the chain is not a weighted Top N chain
+			powerShapingParameters, _ := k.GetConsumerPowerShapingParameters(ctx, consumerId)
+			minPower, err := k.ComputeMinPowerInWeightedTopN(ctx, bondedValidators, newTopN, powerShapingParameters.Top_NWeightedEpochs)
 			if err != nil {
 				return err
 			}
@@ -226,24 +288,33 @@ func NoMoreThanPercentOfTheSum(validators []types.ConsensusValidator, percent ui
 }
 
 // CanValidateChain returns true if the validator `providerAddr` is opted-in to chain with `consumerId` and the allowlist
-// and denylist do not prevent the validator from validating the chain.
+// and denylist do not prevent the validator from validating the chain. For a Top N chain, a validator with enough power
+// is automatically opted-in, unless the validator exhausted its Top N budget (see HasTopNBudget).
 func (k Keeper) CanValidateChain(
 	ctx sdk.Context,
 	consumerId string,
 	providerAddr types.ProviderConsAddress,
-	topN uint32,
+	powerShapingParameters types.PowerShapingParameters,
 	minPowerToOptIn int64,
+	topNConsumersCounts TopNConsumersCounts,
 ) (bool, error) {
 	// check if the validator is already opted-in
 	optedIn := k.IsOptedIn(ctx, consumerId, providerAddr)
 
 	// check if the validator is automatically opted-in for a topN chain
-	if !optedIn && topN > 0 {
+	if !optedIn && powerShapingParameters.Top_N > 0 {
 		var err error
-		optedIn, err = k.HasMinPower(ctx, providerAddr, minPowerToOptIn)
+		optedIn, err = k.HasMinPower(ctx, providerAddr, minPowerToOptIn, powerShapingParameters.Top_NWeightedEpochs)
 		if err != nil {
 			return false, err
 		}
+		// validators that exhausted their Top N budget are not automatically opted-in
+		if optedIn {
+			optedIn, err = k.HasTopNBudget(ctx, consumerId, providerAddr, topNConsumersCounts)
+			if err != nil {
+				return false, err
+			}
+		}
 	}
 
 	// only consider opted-in validators
@@ -276,14 +347,15 @@ func (k Keeper) FulfillsMinStake(
 	return validator.GetBondedTokens().GTE(math.NewIntFromUint64(minStake)), nil
 }
 
-// HasMinPower returns true if the `providerAddr` voting power is GTE than the given minimum power
-func (k Keeper) HasMinPower(ctx sdk.Context, providerAddr types.ProviderConsAddress, minPower int64) (bool, error) {
+// HasMinPower returns true if the `providerAddr` voting power, averaged over the last `topNWeightedEpochs` epochs
+// (see GetTopNPower), is GTE than the given minimum power
+func (k Keeper) HasMinPower(ctx sdk.Context, providerAddr types.ProviderConsAddress, minPower int64, topNWeightedEpochs uint32) (bool, error) {
 	val, err := k.stakingKeeper.GetValidatorByConsAddr(ctx, providerAddr.Address)
 	if err != nil {
 		return false, err
 	}
 
-	valAddr, err := sdk.ValAddressFromBech32(val.GetOperator())
+	valAddr, err := k.ValidatorAddressCodec().StringToBytes(val.GetOperator())
 	if err != nil {
 		return false, err
 	}
@@ -293,7 +365,7 @@ func (k Keeper) HasMinPower(ctx sdk.Context, providerAddr types.ProviderConsAddr
 		return false, err
 	}
 
-	return power >= minPower, nil
+	return k.GetTopNPower(ctx, providerAddr, power, topNWeightedEpochs) >= minPower, nil
 }
 
 //
@@ -317,7 +389,7 @@ func (k Keeper) GetConsumerPowerShapingParameters(ctx sdk.Context, consumerId st
 }
 
 // SetConsumerPowerShapingParameters sets the power-shaping parameters associated with this consumer id.
-// Note that it also updates the allowlist and denylist indexes if they are different
+// Note that it also updates the allowlist, denylist, and prioritylist indexes if they are different
 func (k Keeper) SetConsumerPowerShapingParameters(ctx sdk.Context, consumerId string, parameters types.PowerShapingParameters) error {
 	store := ctx.KVStore(k.storeKey)
 	bz, err := parameters.Marshal()
@@ -341,8 +413,9 @@ func (k Keeper) SetConsumerPowerShapingParameters(ctx sdk.Context, consumerId st
 	if !equalStringSlices(oldParameters.Denylist, parameters.Denylist) {
 		k.UpdateDenylist(ctx, consumerId, parameters.Denylist)
 	}
-	if !equalStringSlices(oldParameters.Prioritylist, parameters.Prioritylist) {
-		k.UpdatePrioritylist(ctx, consumerId, parameters.Prioritylist)
+	if !equalStringSlices(oldParameters.Prioritylist, parameters.Prioritylist) ||
+		!equalPrioritylistWeights(oldParameters.PrioritylistWeights, parameters.PrioritylistWeights) {
+		k.UpdatePrioritylist(ctx, consumerId, parameters.Prioritylist, parameters.PrioritylistWeights)
 	}
 
 	return nil
@@ -361,6 +434,19 @@ func equalStringSlices(a, b []string) bool {
 	return true
 }
 
+// equalPrioritylistWeights returns true if two slices of prioritylist weights are equal
+func equalPrioritylistWeights(a, b []types.PrioritylistWeight) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i, v := range a {
+		if v != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
 // SetAllowlist allowlists validator with `providerAddr` address on chain `consumerId`
 func (k Keeper) SetAllowlist(
 	ctx sdk.Context,
@@ -428,7 +514,7 @@ func (k Keeper) IsAllowlistEmpty(ctx sdk.Context, consumerId string) bool {
 func (k Keeper) UpdateAllowlist(ctx sdk.Context, consumerId string, allowlist []string) {
 	k.DeleteAllowlist(ctx, consumerId)
 	for _, address := range allowlist {
-		consAddr, err := sdk.ConsAddressFromBech32(address)
+		consAddr, err := k.ConsensusAddressCodec().StringToBytes(address)
 		if err != nil {
 			continue
 		}
@@ -504,7 +590,7 @@ func (k Keeper) IsDenylistEmpty(ctx sdk.Context, consumerId string) bool {
 func (k Keeper) UpdateDenylist(ctx sdk.Context, consumerId string, denylist []string) {
 	k.DeleteDenylist(ctx, consumerId)
 	for _, address := range denylist {
-		consAddr, err := sdk.ConsAddressFromBech32(address)
+		consAddr, err := k.ConsensusAddressCodec().StringToBytes(address)
 		if err != nil {
 			continue
 		}
@@ -552,7 +638,47 @@ func (k Keeper) DeleteMinimumPowerInTopN(
 	store.Delete(types.MinimumPowerInTopNKey(consumerId))
 }
 
+// GetConsumerPowerShapingPipeline returns the power shaping steps applied the last time
+// the validator set of the consumer chain with `consumerId` was computed
+func (k Keeper) GetConsumerPowerShapingPipeline(ctx sdk.Context, consumerId string) (types.PowerShapingPipeline, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ConsumerIdToPowerShapingPipelineKey(consumerId))
+	if bz == nil {
+		return types.PowerShapingPipeline{}, false
+	}
+	var pipeline types.PowerShapingPipeline
+	if err := pipeline.Unmarshal(bz); err != nil {
+		// An error here would indicate something is very wrong,
+		// the pipeline is assumed to be correctly serialized in SetConsumerPowerShapingPipeline.
+		panic(fmt.Errorf("failed to unmarshal power shaping pipeline for consumer id (%s): %w", consumerId, err))
+	}
+	return pipeline, true
+}
+
+// SetConsumerPowerShapingPipeline sets the power shaping steps applied the last time
+// the validator set of the consumer chain with `consumerId` was computed
+func (k Keeper) SetConsumerPowerShapingPipeline(ctx sdk.Context, consumerId string, pipeline types.PowerShapingPipeline) error {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := pipeline.Marshal()
+	if err != nil {
+		return fmt.Errorf("failed to marshal power shaping pipeline (%+v) for consumer id (%s): %w", pipeline, consumerId, err)
+	}
+	store.Set(types.ConsumerIdToPowerShapingPipelineKey(consumerId), bz)
+	return nil
+}
+
+// DeleteConsumerPowerShapingPipeline deletes the power shaping steps applied the last time
+// the validator set of the consumer chain with `consumerId` was computed
+func (k Keeper) DeleteConsumerPowerShapingPipeline(ctx sdk.Context, consumerId string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ConsumerIdToPowerShapingPipelineKey(consumerId))
+}
+
+// defaultPrioritylistWeight is the weight of the validators prioritylisted without a weight
+const defaultPrioritylistWeight = 1
+
 // SetPrioritylist prioritylists validator with `providerAddr` address on chain `consumerId`
+// with the default weight of 1
 func (k Keeper) SetPrioritylist(
 	ctx sdk.Context,
 	consumerId string,
@@ -562,6 +688,62 @@ func (k Keeper) SetPrioritylist(
 	store.Set(types.PrioritylistKey(consumerId, providerAddr), []byte{})
 }
 
+// SetPrioritylistWeight prioritylists validator with `providerAddr` address on chain `consumerId`
+// with the given weight
+func (k Keeper) SetPrioritylistWeight(
+	ctx sdk.Context,
+	consumerId string,
+	providerAddr types.ProviderConsAddress,
+	weight uint32,
+) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.PrioritylistKey(consumerId, providerAddr), sdk.Uint64ToBigEndian(uint64(weight)))
+}
+
+// GetPrioritylistWeight returns the weight of validator with `providerAddr` address in the prioritylist
+// of chain `consumerId`, i.e., 1 if the validator is prioritylisted without a weight,
+// and false if the validator is not prioritylisted
+func (k Keeper) GetPrioritylistWeight(
+	ctx sdk.Context,
+	consumerId string,
+	providerAddr types.ProviderConsAddress,
+) (uint32, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.PrioritylistKey(consumerId, providerAddr))
+	if bz == nil {
+		return 0, false
+	}
+	if len(bz) == 0 {
+		return defaultPrioritylistWeight, true
+	}
+	return uint32(sdk.BigEndianToUint64(bz)), true
+}
+
+// GetPrioritylistWeights returns the weights set for the prioritylisted validators of chain `consumerId`
+func (k Keeper) GetPrioritylistWeights(ctx sdk.Context, consumerId string) ([]types.PrioritylistWeight, error) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.StringIdWithLenKey(types.PrioritylistKeyPrefix(), consumerId)
+	iterator := storetypes.KVStorePrefixIterator(store, key)
+	defer iterator.Close()
+
+	var weights []types.PrioritylistWeight
+	for ; iterator.Valid(); iterator.Next() {
+		if len(iterator.Value()) == 0 {
+			continue
+		}
+		providerAddr, err := k.ConsensusAddressCodec().BytesToString(iterator.Key()[len(key):])
+		if err != nil {
+			return nil, err
+		}
+		weights = append(weights, types.PrioritylistWeight{
+			ProviderAddress: providerAddr,
+			Weight:          uint32(sdk.BigEndianToUint64(iterator.Value())),
+		})
+	}
+
+	return weights, nil
+}
+
 // GetPriorityList returns all prioritylisted validators
 func (k Keeper) GetPriorityList(
 	ctx sdk.Context,
@@ -614,30 +796,43 @@ func (k Keeper) IsPrioritylistEmpty(ctx sdk.Context, consumerId string) bool {
 	return !iterator.Valid()
 }
 
-// UpdatePrioritylist populates the prioritylist store for the consumer chain with this consumer id
-func (k Keeper) UpdatePrioritylist(ctx sdk.Context, consumerId string, prioritylist []string) {
+// UpdatePrioritylist populates the prioritylist store for the consumer chain with this consumer id,
+// with the weights of the prioritylisted validators, if any
+func (k Keeper) UpdatePrioritylist(ctx sdk.Context, consumerId string, prioritylist []string, weights []types.PrioritylistWeight) {
 	k.DeletePrioritylist(ctx, consumerId)
+
+	weightByAddress := map[string]uint32{}
+	for _, weight := range weights {
+		weightByAddress[weight.ProviderAddress] = weight.Weight
+	}
 	for _, address := range prioritylist {
-		consAddr, err := sdk.ConsAddressFromBech32(address)
+		consAddr, err := k.ConsensusAddressCodec().StringToBytes(address)
 		if err != nil {
 			continue
 		}
 
-		k.SetPrioritylist(ctx, consumerId, types.NewProviderConsAddress(consAddr))
+		if weight, found := weightByAddress[address]; found {
+			k.SetPrioritylistWeight(ctx, consumerId, types.NewProviderConsAddress(consAddr), weight)
+		} else {
+			k.SetPrioritylist(ctx, consumerId, types.NewProviderConsAddress(consAddr))
+		}
 	}
 }
 
 // PartitionBasedOnPriorityList filters the priority list to include only validators that can validate the chain
-// and splits the validators into priority and non-priority sets.
+// and splits the validators into priority and non-priority sets. The priority validators are sorted by decreasing
+// weight and, for the same weight, by decreasing power, with ties broken by provider consensus address.
 func (k Keeper) PartitionBasedOnPriorityList(ctx sdk.Context, consumerId string, nextValidators []types.ConsensusValidator) ([]types.ConsensusValidator, []types.ConsensusValidator) {
 	priorityValidators := make([]types.ConsensusValidator, 0)
 	nonPriorityValidators := make([]types.ConsensusValidator, 0)
+	weights := map[string]uint32{}
 
 	// Form priorityValidators
 	for _, validator := range nextValidators {
 		addr := types.NewProviderConsAddress(validator.ProviderConsAddr)
-		if k.IsPrioritylisted(ctx, consumerId, addr) {
+		if weight, found := k.GetPrioritylistWeight(ctx, consumerId, addr); found {
 			priorityValidators = append(priorityValidators, validator)
+			weights[string(validator.ProviderConsAddr)] = weight
 		} else {
 			// Add remaining validators to nonPriorityValidators
 			nonPriorityValidators = append(nonPriorityValidators, validator)
@@ -645,7 +840,15 @@ func (k Keeper) PartitionBasedOnPriorityList(ctx sdk.Context, consumerId string,
 	}
 
 	sort.Slice(priorityValidators, func(i, j int) bool {
-		return priorityValidators[i].Power > priorityValidators[j].Power
+		weightI := weights[string(priorityValidators[i].ProviderConsAddr)]
+		weightJ := weights[string(priorityValidators[j].ProviderConsAddr)]
+		if weightI != weightJ {
+			return weightI > weightJ
+		}
+		if priorityValidators[i].Power != priorityValidators[j].Power {
+			return priorityValidators[i].Power > priorityValidators[j].Power
+		}
+		return bytes.Compare(priorityValidators[i].ProviderConsAddr, priorityValidators[j].ProviderConsAddr) < 0
 	})
 
 	sort.Slice(nonPriorityValidators, func(i, j int) bool {
@@ -654,3 +857,39 @@ func (k Keeper) PartitionBasedOnPriorityList(ctx sdk.Context, consumerId string,
 
 	return priorityValidators, nonPriorityValidators
 }
+
+// EjectConsumerValidator adds the validator `providerAddr` to the denylist of the consumer chain `consumerId`,
+// so that the validator is removed from the validator set of the chain at the next validator set update.
+// If `pruneConsumerKey` is set, the consumer key assigned by the validator on the chain is also removed.
+// It returns whether a consumer key was removed.
+func (k Keeper) EjectConsumerValidator(
+	ctx sdk.Context,
+	consumerId string,
+	providerAddr types.ProviderConsAddress,
+	pruneConsumerKey bool,
+) (bool, error) {
+	if !k.IsConsumerActive(ctx, consumerId) {
+		return false, errorsmod.Wrapf(types.ErrInvalidPhase,
+			"cannot eject a validator from a consumer chain that is not in the registered, initialized, or launched phase: %s", consumerId)
+	}
+
+	if !k.IsDenylisted(ctx, consumerId, providerAddr) {
+		powerShapingParameters, err := k.GetConsumerPowerShapingParameters(ctx, consumerId)
+		if err != nil {
+			return false, errorsmod.Wrapf(ccvtypes.ErrInvalidConsumerState, "cannot get power shaping parameters: %s", err.Error())
+		}
+		providerAddrStr, err := k.ConsensusAddressCodec().BytesToString(providerAddr.ToSdkConsAddr())
+		if err != nil {
+			return false, err
+		}
+		powerShapingParameters.Denylist = append(powerShapingParameters.Denylist, providerAddrStr)
+		if err := k.SetConsumerPowerShapingParameters(ctx, consumerId, powerShapingParameters); err != nil {
+			return false, errorsmod.Wrapf(ccvtypes.ErrInvalidConsumerState, "cannot set power shaping parameters: %s", err.Error())
+		}
+	}
+
+	if !pruneConsumerKey {
+		return false, nil
+	}
+	return k.RemoveConsumerKey(ctx, consumerId, providerAddr)
+}
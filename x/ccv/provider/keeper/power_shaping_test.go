@@ -102,7 +102,7 @@ func TestHasMinPower(t *testing.T) {
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			tc.expectation(consAddr, mocks)
-			hasMinPower, err := pk.HasMinPower(ctx, providerAddr, minPower)
+			hasMinPower, err := pk.HasMinPower(ctx, providerAddr, minPower, 0)
 			if tc.expError {
 				require.Error(t, err)
 			} else {
@@ -181,6 +181,46 @@ func TestComputeMinPowerInTopN(t *testing.T) {
 	require.Error(t, err)
 }
 
+// TestCheckTopNCoverage checks that a Top N chain is not covered if the opted-in validators hold less than Top N
+// percent of the power of the active validators, e.g., because Top N validators exhausted their Top N budget
+func TestCheckTopNCoverage(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	consumerId := "0"
+
+	// total power of 25 (= 10 + 6 + 5 + 3 + 1)
+	activeValidators := []stakingtypes.Validator{
+		createStakingValidator(ctx, mocks, 10, 1),
+		createStakingValidator(ctx, mocks, 6, 2),
+		createStakingValidator(ctx, mocks, 5, 3),
+		createStakingValidator(ctx, mocks, 3, 4),
+		createStakingValidator(ctx, mocks, 1, 5),
+	}
+	for _, val := range activeValidators[:2] {
+		consAddr, err := val.GetConsAddr()
+		require.NoError(t, err)
+		providerKeeper.SetOptedIn(ctx, consumerId, providertypes.NewProviderConsAddress(consAddr))
+	}
+
+	optedInPower, err := providerKeeper.ComputeOptedInTopNPower(ctx, consumerId, activeValidators, 0)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDec(64), optedInPower)
+
+	// an opt-in chain is always covered
+	err = providerKeeper.SetConsumerPowerShapingParameters(ctx, consumerId, providertypes.PowerShapingParameters{})
+	require.NoError(t, err)
+	require.NoError(t, providerKeeper.CheckTopNCoverage(ctx, consumerId, activeValidators))
+
+	err = providerKeeper.SetConsumerPowerShapingParameters(ctx, consumerId, providertypes.PowerShapingParameters{Top_N: 64})
+	require.NoError(t, err)
+	require.NoError(t, providerKeeper.CheckTopNCoverage(ctx, consumerId, activeValidators))
+
+	err = providerKeeper.SetConsumerPowerShapingParameters(ctx, consumerId, providertypes.PowerShapingParameters{Top_N: 80})
+	require.NoError(t, err)
+	require.ErrorIs(t, providerKeeper.CheckTopNCoverage(ctx, consumerId, activeValidators), providertypes.ErrTopNShortfall)
+}
+
 // TestCanValidateChain returns true if `validator` is opted in, in `consumerId.
 func TestCanValidateChain(t *testing.T) {
 	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
@@ -195,7 +235,7 @@ func TestCanValidateChain(t *testing.T) {
 	// with no allowlist or denylist, the validator has to be opted in, in order to consider it
 	powerShapingParameters, err := providerKeeper.GetConsumerPowerShapingParameters(ctx, consumerID)
 	require.Error(t, err)
-	canValidateChain, err := providerKeeper.CanValidateChain(ctx, consumerID, providerAddr, powerShapingParameters.Top_N, 0)
+	canValidateChain, err := providerKeeper.CanValidateChain(ctx, consumerID, providerAddr, powerShapingParameters, 0, nil)
 	require.NoError(t, err)
 	require.False(t, canValidateChain)
 
@@ -206,17 +246,17 @@ func TestCanValidateChain(t *testing.T) {
 	powerShapingParameters, err = providerKeeper.GetConsumerPowerShapingParameters(ctx, consumerID)
 	require.NoError(t, err)
 	// validator's power is LT the min power
-	canValidateChain, err = providerKeeper.CanValidateChain(ctx, consumerID, providerAddr, powerShapingParameters.Top_N, 2)
+	canValidateChain, err = providerKeeper.CanValidateChain(ctx, consumerID, providerAddr, powerShapingParameters, 2, nil)
 	require.NoError(t, err)
 	require.False(t, canValidateChain)
 	// validator's power is GTE the min power
-	canValidateChain, err = providerKeeper.CanValidateChain(ctx, consumerID, providerAddr, powerShapingParameters.Top_N, 1)
+	canValidateChain, err = providerKeeper.CanValidateChain(ctx, consumerID, providerAddr, powerShapingParameters, 1, nil)
 	require.NoError(t, err)
 	require.True(t, canValidateChain)
 
 	// when validator is opted-in it can validate regardless of its min power
 	providerKeeper.SetOptedIn(ctx, consumerID, providertypes.NewProviderConsAddress(consAddr))
-	canValidateChain, err = providerKeeper.CanValidateChain(ctx, consumerID, providerAddr, powerShapingParameters.Top_N, 2)
+	canValidateChain, err = providerKeeper.CanValidateChain(ctx, consumerID, providerAddr, powerShapingParameters, 2, nil)
 	require.NoError(t, err)
 	require.True(t, canValidateChain)
 
@@ -225,7 +265,7 @@ func TestCanValidateChain(t *testing.T) {
 	require.NoError(t, err)
 	powerShapingParameters, err = providerKeeper.GetConsumerPowerShapingParameters(ctx, consumerID)
 	require.NoError(t, err)
-	canValidateChain, err = providerKeeper.CanValidateChain(ctx, consumerID, providerAddr, powerShapingParameters.Top_N, 2)
+	canValidateChain, err = providerKeeper.CanValidateChain(ctx, consumerID, providerAddr, powerShapingParameters, 2, nil)
 	require.NoError(t, err)
 	require.True(t, canValidateChain)
 
@@ -233,22 +273,22 @@ func TestCanValidateChain(t *testing.T) {
 	validatorA := createStakingValidator(ctx, mocks, 1, 2)
 	consAddrA, _ := validatorA.GetConsAddr()
 	providerKeeper.SetAllowlist(ctx, consumerID, providertypes.NewProviderConsAddress(consAddrA))
-	canValidateChain, err = providerKeeper.CanValidateChain(ctx, consumerID, providerAddr, powerShapingParameters.Top_N, 1)
+	canValidateChain, err = providerKeeper.CanValidateChain(ctx, consumerID, providerAddr, powerShapingParameters, 1, nil)
 	require.NoError(t, err)
 	require.False(t, canValidateChain)
 	providerKeeper.SetAllowlist(ctx, consumerID, providertypes.NewProviderConsAddress(consAddr))
-	canValidateChain, err = providerKeeper.CanValidateChain(ctx, consumerID, providerAddr, powerShapingParameters.Top_N, 1)
+	canValidateChain, err = providerKeeper.CanValidateChain(ctx, consumerID, providerAddr, powerShapingParameters, 1, nil)
 	require.NoError(t, err)
 	require.True(t, canValidateChain)
 
 	// create a denylist but do not add validator `providerAddr` to it
 	providerKeeper.SetDenylist(ctx, consumerID, providertypes.NewProviderConsAddress(consAddrA))
-	canValidateChain, err = providerKeeper.CanValidateChain(ctx, consumerID, providerAddr, powerShapingParameters.Top_N, 1)
+	canValidateChain, err = providerKeeper.CanValidateChain(ctx, consumerID, providerAddr, powerShapingParameters, 1, nil)
 	require.NoError(t, err)
 	require.True(t, canValidateChain)
 	// add validator `providerAddr` to the denylist
 	providerKeeper.SetDenylist(ctx, consumerID, providertypes.NewProviderConsAddress(consAddr))
-	canValidateChain, err = providerKeeper.CanValidateChain(ctx, consumerID, providerAddr, powerShapingParameters.Top_N, 1)
+	canValidateChain, err = providerKeeper.CanValidateChain(ctx, consumerID, providerAddr, powerShapingParameters, 1, nil)
 	require.NoError(t, err)
 	require.False(t, canValidateChain)
 }
@@ -355,6 +395,42 @@ func TestCapValidatorSet(t *testing.T) {
 			},
 			expectedValidators: []providertypes.ConsensusValidator{validatorA},
 		},
+		{
+			name: "ValidatorSetCap = 3, with weighted priority list",
+			powerShapingParameters: providertypes.PowerShapingParameters{
+				ValidatorSetCap: 3,
+				Prioritylist:    []string{valAddrA, valAddrB, valAddrC},
+				PrioritylistWeights: []providertypes.PrioritylistWeight{
+					{ProviderAddress: valAddrA, Weight: 3},
+					{ProviderAddress: valAddrC, Weight: 2},
+				},
+			},
+			expectedValidators: []providertypes.ConsensusValidator{validatorA, validatorC, validatorB},
+		},
+		{
+			name: "ValidatorSetCap = 2, with only the weights of the priority list updated",
+			powerShapingParameters: providertypes.PowerShapingParameters{
+				ValidatorSetCap: 2,
+				Prioritylist:    []string{valAddrA, valAddrB, valAddrC},
+				PrioritylistWeights: []providertypes.PrioritylistWeight{
+					{ProviderAddress: valAddrB, Weight: 5},
+				},
+			},
+			expectedValidators: []providertypes.ConsensusValidator{validatorB, validatorC},
+		},
+		{
+			name: "ValidatorSetCap = 3, with equal weights in the priority list",
+			powerShapingParameters: providertypes.PowerShapingParameters{
+				ValidatorSetCap: 3,
+				Prioritylist:    []string{valAddrA, valAddrB, valAddrD},
+				PrioritylistWeights: []providertypes.PrioritylistWeight{
+					{ProviderAddress: valAddrA, Weight: 2},
+					{ProviderAddress: valAddrB, Weight: 2},
+				},
+			},
+			// validators with the same weight are considered by decreasing power
+			expectedValidators: []providertypes.ConsensusValidator{validatorB, validatorA, validatorD},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -1118,11 +1194,34 @@ func TestUpdatePrioritylist(t *testing.T) {
 	providerConsAddr2 := valAddrB
 	consAddr2, _ := sdk.ConsAddressFromBech32(providerConsAddr2)
 
-	providerKeeper.UpdatePrioritylist(ctx, consumerId, []string{providerConsAddr1, providerConsAddr2})
+	providerKeeper.UpdatePrioritylist(ctx, consumerId, []string{providerConsAddr1, providerConsAddr2}, nil)
 
 	expectedPrioritylist := []providertypes.ProviderConsAddress{
 		providertypes.NewProviderConsAddress(consAddr1),
 		providertypes.NewProviderConsAddress(consAddr2),
 	}
 	require.Equal(t, expectedPrioritylist, providerKeeper.GetPriorityList(ctx, consumerId))
+
+	weights, err := providerKeeper.GetPrioritylistWeights(ctx, consumerId)
+	require.NoError(t, err)
+	require.Empty(t, weights)
+
+	// prioritylist validator 2 with a weight
+	weight := providertypes.PrioritylistWeight{ProviderAddress: providerConsAddr2, Weight: 3}
+	providerKeeper.UpdatePrioritylist(ctx, consumerId, []string{providerConsAddr1, providerConsAddr2}, []providertypes.PrioritylistWeight{weight})
+	require.Equal(t, expectedPrioritylist, providerKeeper.GetPriorityList(ctx, consumerId))
+
+	weight1, found := providerKeeper.GetPrioritylistWeight(ctx, consumerId, providertypes.NewProviderConsAddress(consAddr1))
+	require.True(t, found)
+	require.Equal(t, uint32(1), weight1)
+	weight2, found := providerKeeper.GetPrioritylistWeight(ctx, consumerId, providertypes.NewProviderConsAddress(consAddr2))
+	require.True(t, found)
+	require.Equal(t, uint32(3), weight2)
+	weights, err = providerKeeper.GetPrioritylistWeights(ctx, consumerId)
+	require.NoError(t, err)
+	require.Equal(t, []providertypes.PrioritylistWeight{weight}, weights)
+
+	providerKeeper.DeletePrioritylist(ctx, consumerId)
+	_, found = providerKeeper.GetPrioritylistWeight(ctx, consumerId, providertypes.NewProviderConsAddress(consAddr2))
+	require.False(t, found)
 }
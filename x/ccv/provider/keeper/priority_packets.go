@@ -0,0 +1,104 @@
+package keeper
+
+import (
+	"time"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// SetVSCPacketTimeout sets the timeout timestamp of a VSC packet sent on the given channel
+// that is not yet acknowledged
+func (k Keeper) SetVSCPacketTimeout(ctx sdk.Context, channelId string, sequence, timeoutTimestamp uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ChannelIdToVSCPacketTimeoutKey(channelId, sequence), sdk.Uint64ToBigEndian(timeoutTimestamp))
+}
+
+// GetVSCPacketTimeout returns the timeout timestamp of a VSC packet sent on the given channel
+// that is not yet acknowledged
+func (k Keeper) GetVSCPacketTimeout(ctx sdk.Context, channelId string, sequence uint64) (uint64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ChannelIdToVSCPacketTimeoutKey(channelId, sequence))
+	if bz == nil {
+		return 0, false
+	}
+	return sdk.BigEndianToUint64(bz), true
+}
+
+// DeleteVSCPacketTimeout deletes the timeout timestamp of a VSC packet sent on the given channel
+func (k Keeper) DeleteVSCPacketTimeout(ctx sdk.Context, channelId string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ChannelIdToVSCPacketTimeoutKey(channelId, sequence))
+}
+
+// DeleteAllVSCPacketTimeouts deletes the timeout timestamps of all the VSC packets sent on the given channel
+func (k Keeper) DeleteAllVSCPacketTimeouts(ctx sdk.Context, channelId string) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.ChannelIdToVSCPacketTimeoutKeyPrefix(channelId))
+	defer iterator.Close()
+
+	var keysToDel [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keysToDel = append(keysToDel, iterator.Key())
+	}
+	for _, key := range keysToDel {
+		store.Delete(key)
+	}
+}
+
+// GetOldestVSCPacketTimeout returns the sequence and the timeout timestamp of the oldest VSC packet
+// sent on the given channel that is not yet acknowledged. As CCV channels are ordered,
+// this packet is the first to time out.
+func (k Keeper) GetOldestVSCPacketTimeout(ctx sdk.Context, channelId string) (sequence, timeoutTimestamp uint64, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.ChannelIdToVSCPacketTimeoutKeyPrefix(channelId)
+	iterator := storetypes.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return 0, 0, false
+	}
+	sequence = sdk.BigEndianToUint64(iterator.Key()[len(prefix):])
+	timeoutTimestamp = sdk.BigEndianToUint64(iterator.Value())
+	return sequence, timeoutTimestamp, true
+}
+
+// GetAllVSCPacketTimeouts returns the timeout timestamps of all the VSC packets sent on the given channel
+// that are not yet acknowledged, ordered by sequence
+func (k Keeper) GetAllVSCPacketTimeouts(ctx sdk.Context, channelId string) []types.PacketTimeout {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.ChannelIdToVSCPacketTimeoutKeyPrefix(channelId)
+	iterator := storetypes.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	packetTimeouts := []types.PacketTimeout{}
+	for ; iterator.Valid(); iterator.Next() {
+		packetTimeouts = append(packetTimeouts, types.PacketTimeout{
+			ChannelId:        channelId,
+			Sequence:         sdk.BigEndianToUint64(iterator.Key()[len(prefix):]),
+			TimeoutTimestamp: time.Unix(0, int64(sdk.BigEndianToUint64(iterator.Value()))).UTC(),
+		})
+	}
+	return packetTimeouts
+}
+
+// EmitNearTimeoutVSCPacketEvents emits an event for every CCV channel whose oldest unacknowledged
+// VSC packet is close to its timeout, so that relayers can prioritize the relaying of this packet
+func (k Keeper) EmitNearTimeoutVSCPacketEvents(ctx sdk.Context) {
+	timeoutPeriod := k.GetCCVTimeoutPeriod(ctx)
+	for _, channelToConsumer := range k.GetAllChannelToConsumers(ctx) {
+		sequence, timeoutTimestamp, found := k.GetOldestVSCPacketTimeout(ctx, channelToConsumer.ChannelId)
+		if !found || !ccv.IsNearTimeout(ctx.BlockTime(), timeoutTimestamp, timeoutPeriod) {
+			continue
+		}
+		ctx.EventManager().EmitEvent(ccv.NewPriorityPacketEvent(
+			types.ModuleName, ccv.ProviderPortID, channelToConsumer.ChannelId,
+			ccv.PacketTypeVSC, ccv.PriorityReasonNearTimeout,
+			sequence, timeoutTimestamp,
+		))
+	}
+}
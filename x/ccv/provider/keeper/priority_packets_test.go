@@ -0,0 +1,110 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
+	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// TestVSCPacketTimeouts tests the getter, setter, and deletion methods of the VSC packet timeouts
+func TestVSCPacketTimeouts(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	_, _, found := providerKeeper.GetOldestVSCPacketTimeout(ctx, "channel-0")
+	require.False(t, found)
+
+	providerKeeper.SetVSCPacketTimeout(ctx, "channel-0", 3, 300)
+	providerKeeper.SetVSCPacketTimeout(ctx, "channel-0", 2, 200)
+	providerKeeper.SetVSCPacketTimeout(ctx, "channel-1", 1, 100)
+
+	timeout, found := providerKeeper.GetVSCPacketTimeout(ctx, "channel-0", 3)
+	require.True(t, found)
+	require.Equal(t, uint64(300), timeout)
+
+	sequence, timeout, found := providerKeeper.GetOldestVSCPacketTimeout(ctx, "channel-0")
+	require.True(t, found)
+	require.Equal(t, uint64(2), sequence)
+	require.Equal(t, uint64(200), timeout)
+
+	providerKeeper.DeleteVSCPacketTimeout(ctx, "channel-0", 2)
+	sequence, _, found = providerKeeper.GetOldestVSCPacketTimeout(ctx, "channel-0")
+	require.True(t, found)
+	require.Equal(t, uint64(3), sequence)
+
+	providerKeeper.DeleteAllVSCPacketTimeouts(ctx, "channel-0")
+	_, _, found = providerKeeper.GetOldestVSCPacketTimeout(ctx, "channel-0")
+	require.False(t, found)
+	_, found = providerKeeper.GetVSCPacketTimeout(ctx, "channel-1", 1)
+	require.True(t, found)
+}
+
+// TestEmitNearTimeoutVSCPacketEvents tests that a priority event is emitted only for the channels
+// whose oldest unacknowledged VSC packet is close to its timeout
+func TestEmitNearTimeoutVSCPacketEvents(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+	ctx = ctx.WithBlockTime(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	timeoutPeriod := providerKeeper.GetCCVTimeoutPeriod(ctx)
+
+	providerKeeper.SetChannelToConsumerId(ctx, "channel-0", "0")
+	providerKeeper.SetChannelToConsumerId(ctx, "channel-1", "1")
+
+	// the packet on channel-0 was sent a long time ago
+	nearTimeout := uint64(ctx.BlockTime().Add(time.Hour).UnixNano())
+	providerKeeper.SetVSCPacketTimeout(ctx, "channel-0", 5, nearTimeout)
+	// the packet on channel-1 was just sent
+	providerKeeper.SetVSCPacketTimeout(ctx, "channel-1", 1, uint64(ctx.BlockTime().Add(timeoutPeriod).UnixNano()))
+
+	providerKeeper.EmitNearTimeoutVSCPacketEvents(ctx)
+
+	var events []map[string]string
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != ccv.EventTypePriorityPacket {
+			continue
+		}
+		attributes := map[string]string{}
+		for _, attr := range event.Attributes {
+			attributes[attr.Key] = attr.Value
+		}
+		events = append(events, attributes)
+	}
+	require.Len(t, events, 1)
+	require.Equal(t, "channel-0", events[0][channeltypes.AttributeKeyChannelID])
+	require.Equal(t, "5", events[0][ccv.AttributePacketSequence])
+	require.Equal(t, ccv.PacketTypeVSC, events[0][ccv.AttributePacketType])
+	require.Equal(t, ccv.PriorityReasonNearTimeout, events[0][ccv.AttributePriorityReason])
+}
+
+// TestQueryNearTimeoutPackets tests that only the VSC packets that time out within the given duration are returned
+func TestQueryNearTimeoutPackets(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(now)
+
+	_, err := providerKeeper.QueryNearTimeoutPackets(ctx, nil)
+	require.Error(t, err)
+	_, err = providerKeeper.QueryNearTimeoutPackets(ctx, &providertypes.QueryNearTimeoutPacketsRequest{Within: -time.Minute})
+	require.Error(t, err)
+
+	providerKeeper.SetChannelToConsumerId(ctx, "channel-0", "0")
+	providerKeeper.SetChannelToConsumerId(ctx, "channel-1", "1")
+	providerKeeper.SetVSCPacketTimeout(ctx, "channel-0", 3, uint64(now.Add(-time.Minute).UnixNano()))
+	providerKeeper.SetVSCPacketTimeout(ctx, "channel-0", 4, uint64(now.Add(time.Hour).UnixNano()))
+	providerKeeper.SetVSCPacketTimeout(ctx, "channel-1", 1, uint64(now.Add(20*time.Minute).UnixNano()))
+
+	res, err := providerKeeper.QueryNearTimeoutPackets(ctx, &providertypes.QueryNearTimeoutPacketsRequest{Within: 30 * time.Minute})
+	require.NoError(t, err)
+	require.Equal(t, []providertypes.PacketTimeout{
+		{ConsumerId: "0", ChannelId: "channel-0", Sequence: 3, TimeoutTimestamp: now.Add(-time.Minute)},
+		{ConsumerId: "1", ChannelId: "channel-1", Sequence: 1, TimeoutTimestamp: now.Add(20 * time.Minute)},
+	}, res.Packets)
+}
@@ -9,11 +9,15 @@ import (
 	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
 
 	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
 
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+	tmtypes "github.com/cometbft/cometbft/types"
 
 	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
 	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
@@ -21,6 +25,9 @@ import (
 
 // OnAcknowledgementPacket handles acknowledgments for sent VSC packets
 func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, ack channeltypes.Acknowledgement) error {
+	k.DeleteVSCPacketTimeout(ctx, packet.SourceChannel, packet.Sequence)
+	k.DeleteUnackedVSCPacket(ctx, packet.SourceChannel, packet.Sequence)
+
 	if err := ack.GetError(); err != "" {
 		// The VSC packet data could not be successfully decoded.
 		// This should never happen.
@@ -30,6 +37,17 @@ func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Pac
 			"error", err,
 		)
 		if consumerId, ok := k.GetChannelIdToConsumerId(ctx, packet.SourceChannel); ok {
+			// a consumer chain that switched to a different provider chain rejects the VSC packets
+			// sent after the switch, which completes the registered switch instead of failing the chain
+			if _, found := k.GetConsumerProviderSwitch(ctx, consumerId); found {
+				if !k.IsConsumerLaunched(ctx, consumerId) {
+					return nil
+				}
+				return k.CompleteConsumerProviderSwitch(ctx, consumerId)
+			}
+			k.updateConsumerPacketStats(ctx, consumerId, func(stats *providertypes.ConsumerPacketStats) {
+				stats.ErrorAcksReceived++
+			})
 			return k.StopAndPrepareForConsumerRemoval(ctx, consumerId)
 		}
 		return errorsmod.Wrapf(providertypes.ErrUnknownConsumerChannelId, "recv ErrorAcknowledgement on unknown channel %s", packet.SourceChannel)
@@ -38,7 +56,7 @@ func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Pac
 }
 
 // OnTimeoutPacket aborts the transaction if no chain exists for the destination channel,
-// otherwise it stops the chain
+// otherwise it stages the reestablishment of the CCV channel of a launched chain and stops any other chain
 func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet) error {
 	consumerId, found := k.GetChannelIdToConsumerId(ctx, packet.SourceChannel)
 	if !found {
@@ -49,38 +67,201 @@ func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet) err
 			packet.SourceChannel,
 		)
 	}
+	if k.IsConsumerLaunched(ctx, consumerId) {
+		k.Logger(ctx).Info("packet timeout, staging the reestablishment of the CCV channel:", "consumerId", consumerId)
+		k.StageChannelRecovery(ctx, consumerId, packet.SourceChannel, packet.Sequence)
+		return nil
+	}
 	k.Logger(ctx).Info("packet timeout, deleting the consumer:", "consumerId", consumerId)
 	return k.StopAndPrepareForConsumerRemoval(ctx, consumerId)
 }
 
 // EndBlockVSU contains the EndBlock logic needed for
-// the Validator Set Update sub-protocol
+// the Validator Set Update sub-protocol. Its stages, i.e., ComputeProviderValidatorUpdates, RemoveDowntimeJailedValidators,
+// QueueVSCPackets, and SendVSCPackets, can also be called individually, e.g., in tests.
 func (k Keeper) EndBlockVSU(ctx sdk.Context) ([]abci.ValidatorUpdate, error) {
+	// drop the VSC packets staged for the stream by a previous execution of the block
+	k.vscPacketStream.resetStaged(ctx.BlockHeight())
+
 	// logic to update the provider consensus validator set.
-	valUpdates, err := k.ProviderValidatorUpdates(ctx)
+	start := telemetry.Now()
+	valUpdates, err := k.ComputeProviderValidatorUpdates(ctx)
+	ccv.MeasureBlockStage(providertypes.ModuleName, start, telemetry.MetricKeyEndBlocker, ccv.BlockStageProviderValidatorUpdates)
 	if err != nil {
-		return []abci.ValidatorUpdate{}, fmt.Errorf("computing the provider consensus validator set: %w", err)
+		return []abci.ValidatorUpdate{}, err
+	}
+
+	// remove the validators jailed for downtime from the consumer chains
+	removedJailedValidators, err := k.RemoveDowntimeJailedValidators(ctx)
+	if err != nil {
+		return []abci.ValidatorUpdate{}, fmt.Errorf("removing validators jailed for downtime: %w", err)
+	}
+
+	// record the voting powers of the provider validators at the beginning of every epoch,
+	// before the validator sets of the weighted Top N consumer chains are computed
+	if k.IsEpochStart(ctx) {
+		if err := k.RecordValidatorPowers(ctx); err != nil {
+			return []abci.ValidatorUpdate{}, fmt.Errorf("recording validator powers: %w", err)
+		}
 	}
 
-	if k.BlocksUntilNextEpoch(ctx) == 0 {
-		// only queue and send VSCPackets at the boundaries of an epoch
+	if k.ShouldSendValidatorUpdates(ctx) {
+		// only queue and send VSCPackets at the boundaries of an epoch,
+		// unless the changes of the provider validator set must be sent immediately
+		k.DeleteImmediateValidatorUpdates(ctx)
 
 		// collect validator updates
-		if err := k.QueueVSCPackets(ctx); err != nil {
+		start = telemetry.Now()
+		err := k.QueueVSCPackets(ctx)
+		ccv.MeasureBlockStage(providertypes.ModuleName, start, telemetry.MetricKeyEndBlocker, ccv.BlockStageQueueVSCPackets)
+		if err != nil {
 			return []abci.ValidatorUpdate{}, fmt.Errorf("queueing consumer validator updates: %w", err)
 		}
 
 		// try sending VSC packets to all registered consumer chains;
 		// if the CCV channel is not established for a consumer chain,
 		// the updates will remain queued until the channel is established
-		if err := k.SendVSCPackets(ctx); err != nil {
+		if err := k.sendVSCPacketsMeasured(ctx); err != nil {
+			return []abci.ValidatorUpdate{}, fmt.Errorf("sending consumer validator updates: %w", err)
+		}
+	} else if removedJailedValidators {
+		// send the removals of the validators jailed for downtime without waiting for the next epoch
+		if err := k.sendVSCPacketsMeasured(ctx); err != nil {
 			return []abci.ValidatorUpdate{}, fmt.Errorf("sending consumer validator updates: %w", err)
 		}
 	}
 
+	// record the start of a new epoch if the epochs are expressed in time
+	k.EndBlockUpdateEpoch(ctx)
+
+	// hint relayers to prioritize the VSC packets that are close to their timeout
+	k.EmitNearTimeoutVSCPacketEvents(ctx)
+
+	return valUpdates, nil
+}
+
+// sendVSCPacketsMeasured calls SendVSCPackets and reports its duration as an EndBlock stage
+func (k Keeper) sendVSCPacketsMeasured(ctx sdk.Context) error {
+	defer ccv.MeasureBlockStage(providertypes.ModuleName, telemetry.Now(), telemetry.MetricKeyEndBlocker, ccv.BlockStageSendPackets)
+	return k.SendVSCPackets(ctx)
+}
+
+// ComputeProviderValidatorUpdates computes the changes of the provider consensus validator set,
+// which are returned to CometBFT at the end of the block. Note that the validator sets
+// of the consumer chains are computed when the VSC packets are queued (see QueueVSCPackets).
+func (k Keeper) ComputeProviderValidatorUpdates(ctx sdk.Context) ([]abci.ValidatorUpdate, error) {
+	valUpdates, err := k.ProviderValidatorUpdates(ctx)
+	if err != nil {
+		return []abci.ValidatorUpdate{}, fmt.Errorf("computing the provider consensus validator set: %w", err)
+	}
 	return valUpdates, nil
 }
 
+// ShouldSendValidatorUpdates returns true if the validator updates are queued and sent to the consumer chains
+// at the end of the current block, i.e., at the boundaries of an epoch or if immediate validator updates are requested
+func (k Keeper) ShouldSendValidatorUpdates(ctx sdk.Context) bool {
+	return k.IsEpochStart(ctx) || k.HasImmediateValidatorUpdates(ctx)
+}
+
+// SetImmediateValidatorUpdates records that the changes of the provider validator set
+// must be sent to the consumer chains at the end of the current block
+func (k Keeper) SetImmediateValidatorUpdates(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(providertypes.ImmediateValidatorUpdatesKey(), []byte{})
+}
+
+// HasImmediateValidatorUpdates returns true if the changes of the provider validator set
+// must be sent to the consumer chains at the end of the current block
+func (k Keeper) HasImmediateValidatorUpdates(ctx sdk.Context) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(providertypes.ImmediateValidatorUpdatesKey())
+}
+
+// DeleteImmediateValidatorUpdates deletes the record that the changes of the provider validator set
+// must be sent to the consumer chains at the end of the current block
+func (k Keeper) DeleteImmediateValidatorUpdates(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(providertypes.ImmediateValidatorUpdatesKey())
+}
+
+// SetDowntimeJailedValidator records that the validator `providerAddr` was jailed for downtime
+// and must be removed from the consumer chains at the end of the current block
+func (k Keeper) SetDowntimeJailedValidator(ctx sdk.Context, providerAddr providertypes.ProviderConsAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(providertypes.DowntimeJailedValidatorKey(providerAddr), []byte{})
+}
+
+// GetAllDowntimeJailedValidators returns the validators jailed for downtime
+// that must be removed from the consumer chains at the end of the current block
+func (k Keeper) GetAllDowntimeJailedValidators(ctx sdk.Context) []providertypes.ProviderConsAddress {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{providertypes.DowntimeJailedValidatorKeyPrefix()})
+	defer iterator.Close()
+
+	providerAddrs := []providertypes.ProviderConsAddress{}
+	for ; iterator.Valid(); iterator.Next() {
+		providerAddrs = append(providerAddrs, providertypes.NewProviderConsAddress(iterator.Key()[1:]))
+	}
+	return providerAddrs
+}
+
+// DeleteDowntimeJailedValidator deletes the record that the validator `providerAddr` was jailed for downtime
+func (k Keeper) DeleteDowntimeJailedValidator(ctx sdk.Context, providerAddr providertypes.ProviderConsAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(providertypes.DowntimeJailedValidatorKey(providerAddr))
+}
+
+// RemoveDowntimeJailedValidators removes the validators jailed for downtime in the current block from the validator sets
+// of the launched consumer chains and queues, for every consumer chain, a VSC packet that zeroes their power.
+// It returns true if any VSC packet was queued.
+func (k Keeper) RemoveDowntimeJailedValidators(ctx sdk.Context) (bool, error) {
+	jailedValidators := k.GetAllDowntimeJailedValidators(ctx)
+	if len(jailedValidators) == 0 {
+		return false, nil
+	}
+
+	valUpdateID := k.GetValidatorSetUpdateId(ctx)
+	queued := false
+	for _, consumerId := range k.GetAllConsumersWithIBCClients(ctx) {
+		if !k.IsConsumerLaunched(ctx, consumerId) {
+			// only queue VSCPackets to launched (or paused) chains
+			continue
+		}
+
+		valUpdates := []abci.ValidatorUpdate{}
+		for _, providerAddr := range jailedValidators {
+			consumerValidator, found := k.GetConsumerValidator(ctx, consumerId, providerAddr)
+			if !found {
+				continue
+			}
+			valUpdates = append(valUpdates, abci.ValidatorUpdate{PubKey: *consumerValidator.PublicKey, Power: 0})
+			k.DeleteConsumerValidator(ctx, consumerId, providerAddr)
+		}
+
+		if len(valUpdates) != 0 {
+			packet := ccv.NewValidatorSetChangePacketData(ccv.NewValsetDiff(valUpdates...), valUpdateID, k.ConsumeSlashAcks(ctx, consumerId))
+			k.AppendPendingVSCPackets(ctx, consumerId, packet)
+			k.publishVSCPacket(ctx, consumerId, packet)
+			k.Logger(ctx).Info("VSCPacket removing validators jailed for downtime enqueued:",
+				"consumerId", consumerId,
+				"vscID", valUpdateID,
+				"len updates", len(valUpdates),
+			)
+			queued = true
+		}
+	}
+
+	for _, providerAddr := range jailedValidators {
+		k.DeleteDowntimeJailedValidator(ctx, providerAddr)
+	}
+
+	if queued {
+		k.IncrementValidatorSetUpdateId(ctx)
+	}
+
+	return queued, nil
+}
+
 // ProviderValidatorUpdates returns changes in the provider consensus validator set
 // from the last block to the current one.
 // It retrieves the bonded validators from the staking module and creates a `ConsumerValidator` object for each validator.
@@ -128,7 +309,12 @@ func (k Keeper) ProviderValidatorUpdates(ctx sdk.Context) ([]abci.ValidatorUpdat
 // BlocksUntilNextEpoch returns the number of blocks until the next epoch starts
 // Returns 0 if VSCPackets are sent in the current block,
 // which is done in the first block of each epoch.
+// If the epochs are expressed in time, the number of blocks is an estimate (see estimateBlocksUntilNextEpoch).
 func (k Keeper) BlocksUntilNextEpoch(ctx sdk.Context) int64 {
+	if k.GetEpochDuration(ctx) > 0 {
+		return k.estimateBlocksUntilNextEpoch(ctx)
+	}
+
 	blocksSinceEpochStart := ctx.BlockHeight() % k.GetBlocksPerEpoch(ctx)
 
 	if blocksSinceEpochStart == 0 {
@@ -147,15 +333,39 @@ func (k Keeper) BlocksUntilNextEpoch(ctx sdk.Context) int64 {
 func (k Keeper) SendVSCPackets(ctx sdk.Context) error {
 	for _, consumerId := range k.GetAllConsumersWithIBCClients(ctx) {
 		if k.GetConsumerPhase(ctx, consumerId) != providertypes.CONSUMER_PHASE_LAUNCHED {
-			// only send VSCPackets to launched chains
+			// only send VSCPackets to launched chains;
+			// the VSCPackets of paused chains remain pending until the chains are resumed
 			continue
 		}
 
-		// check if CCV channel is established and send
-		if channelID, found := k.GetConsumerIdToChannelId(ctx, consumerId); found {
-			if err := k.SendVSCPacketsToChain(ctx, consumerId, channelID); err != nil {
-				return fmt.Errorf("sending VSCPacket to consumer, consumerId(%s): %w", consumerId, err)
-			}
+		if err := k.sendVSCPacketsToConsumer(ctx, consumerId); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// sendVSCPacketsToConsumer sends the queued VSC packets to the launched consumer chain `consumerId`
+// if its CCV channel is established and not held
+func (k Keeper) sendVSCPacketsToConsumer(ctx sdk.Context, consumerId string) error {
+	// hold the VSC packets until all the packets sent on the previous CCV channel
+	// are acknowledged, so that the consumer receives them in order
+	if previousChannelID, found := k.GetConsumerIdToPreviousChannelId(ctx, consumerId); found {
+		if !k.IsChannelDrained(ctx, previousChannelID) {
+			return nil
+		}
+		k.CompleteChannelMigration(ctx, consumerId, previousChannelID)
+	}
+
+	// hold the VSC packets until a new CCV channel replaces the channel that timed out
+	if _, found := k.GetConsumerChannelRecoveryTime(ctx, consumerId); found {
+		return nil
+	}
+
+	// check if CCV channel is established and send
+	if channelID, found := k.GetConsumerIdToChannelId(ctx, consumerId); found {
+		if err := k.SendVSCPacketsToChain(ctx, consumerId, channelID); err != nil {
+			return fmt.Errorf("sending VSCPacket to consumer, consumerId(%s): %w", consumerId, err)
 		}
 	}
 	return nil
@@ -166,7 +376,7 @@ func (k Keeper) SendVSCPacketsToChain(ctx sdk.Context, consumerId, channelId str
 	pendingPackets := k.GetPendingVSCPackets(ctx, consumerId)
 	for _, data := range pendingPackets {
 		// send packet over IBC
-		err := ccv.SendIBCPacket(
+		sequence, timeoutTimestamp, err := k.packetSender(
 			ctx,
 			k.channelKeeper,
 			channelId,          // source channel id
@@ -195,6 +405,20 @@ func (k Keeper) SendVSCPacketsToChain(ctx sdk.Context, consumerId, channelId str
 			}
 			return nil
 		}
+		k.SetVSCPacketTimeout(ctx, channelId, sequence, timeoutTimestamp)
+		k.SetUnackedVSCPacket(ctx, channelId, sequence, data)
+		k.SetLastVSCPacket(ctx, consumerId, providertypes.SentVSCPacket{
+			ChannelId: channelId,
+			Sequence:  sequence,
+			SendTime:  ctx.BlockTime(),
+			Data:      data,
+		})
+		k.afterVSCSent(ctx, consumerId, data.ValsetUpdateId)
+	}
+	if len(pendingPackets) > 0 {
+		k.updateConsumerPacketStats(ctx, consumerId, func(stats *providertypes.ConsumerPacketStats) {
+			stats.VscPacketsSent += uint64(len(pendingPackets))
+		})
 	}
 	k.DeletePendingVSCPackets(ctx, consumerId)
 
@@ -220,9 +444,19 @@ func (k Keeper) QueueVSCPackets(ctx sdk.Context) error {
 		return fmt.Errorf("getting provider active validators: %w", err)
 	}
 
+	// the number of Top N consumer chains that every validator is opted in to, computed once per epoch
+	topNConsumersCounts, err := k.GetTopNConsumersCounts(ctx)
+	if err != nil {
+		return fmt.Errorf("getting Top N consumers counts: %w", err)
+	}
+
+	// the number of VSC ids used in this epoch, i.e., the maximal number of packets queued for a consumer chain
+	numVSCIDs := uint64(1)
+	maxUpdatesPerPacket := k.GetMaxValidatorUpdatesPerPacket(ctx)
+
 	for _, consumerId := range k.GetAllConsumersWithIBCClients(ctx) {
-		if k.GetConsumerPhase(ctx, consumerId) != providertypes.CONSUMER_PHASE_LAUNCHED {
-			// only queue VSCPackets to launched chains
+		if !k.IsConsumerLaunched(ctx, consumerId) {
+			// only queue VSCPackets to launched (or paused) chains
 			continue
 		}
 
@@ -232,31 +466,105 @@ func (k Keeper) QueueVSCPackets(ctx sdk.Context) error {
 		}
 
 		// compute consumer next validator set
-		valUpdates, err := k.ComputeConsumerNextValSet(ctx, bondedValidators, activeValidators, consumerId, currentValSet)
+		valUpdates, err := k.ComputeConsumerNextValSet(ctx, bondedValidators, activeValidators, consumerId, currentValSet, topNConsumersCounts)
 		if err != nil {
 			return fmt.Errorf("computing consumer next validator set, consumerId(%s): %w", consumerId, err)
 		}
 
+		// drop the zero-delta updates and merge the repeated updates of a validator
+		valsetDiff := ccv.NewValsetDiff(CompressValidatorUpdates(currentValSet, valUpdates)...)
+
 		// check whether there are changes in the validator set
-		if len(valUpdates) != 0 {
-			// construct validator set change packet data
-			packet := ccv.NewValidatorSetChangePacketData(valUpdates, valUpdateID, k.ConsumeSlashAcks(ctx, consumerId))
-			k.AppendPendingVSCPackets(ctx, consumerId, packet)
-			k.Logger(ctx).Info("VSCPacket enqueued:",
-				"consumerId", consumerId,
-				"vscID", valUpdateID,
-				"len updates", len(valUpdates),
-			)
+		if valsetDiff.Len() != 0 {
+			// split the validator updates into sequential packets, each with its own VSC id;
+			// the slash acks are sent with the first packet
+			batches := valsetDiff.Split(maxUpdatesPerPacket)
+			slashAcks := k.ConsumeSlashAcks(ctx, consumerId)
+			for i, batch := range batches {
+				vscID := valUpdateID + uint64(i)
+				// construct validator set change packet data
+				packet := ccv.NewValidatorSetChangePacketData(batch, vscID, slashAcks)
+				k.AppendPendingVSCPackets(ctx, consumerId, packet)
+				k.publishVSCPacket(ctx, consumerId, packet)
+				k.SubsystemLogger(ctx, ccv.LogSubsystemVSC).Info("VSCPacket enqueued:",
+					"consumerId", consumerId,
+					"vscID", vscID,
+					"len updates", batch.Len(),
+				)
+				slashAcks = nil
+			}
+			numVSCIDs = max(numVSCIDs, uint64(len(batches)))
 		}
 	}
 
-	k.IncrementValidatorSetUpdateId(ctx)
+	// the additional VSC ids used by split packets are mapped to the same
+	// block height as the VSC id of the epoch, so that they can be referenced in slash packets
+	if height, found := k.GetValsetUpdateBlockHeight(ctx, valUpdateID); found {
+		for vscID := valUpdateID + 1; vscID < valUpdateID+numVSCIDs; vscID++ {
+			k.SetValsetUpdateBlockHeight(ctx, vscID, height)
+		}
+	}
+	k.SetValidatorSetUpdateId(ctx, valUpdateID+numVSCIDs)
 
 	return nil
 }
 
+// SendEmergencyValsetUpdate computes the next validator set of the launched consumer chain `consumerId`
+// and sends it in a VSC packet without waiting for the next epoch. The VSC packet is sent even if
+// the validator set did not change, so that the consumer chain receives a new validator set update ID.
+// It returns the validator set update ID of the sent VSC packet.
+func (k Keeper) SendEmergencyValsetUpdate(ctx sdk.Context, consumerId string) (uint64, error) {
+	phase := k.GetConsumerPhase(ctx, consumerId)
+	if phase != providertypes.CONSUMER_PHASE_LAUNCHED {
+		return 0, errorsmod.Wrapf(providertypes.ErrInvalidPhase,
+			"cannot send an emergency validator set update to chain %s in phase %s", consumerId, phase)
+	}
+
+	if _, found := k.GetConsumerIdToChannelId(ctx, consumerId); !found {
+		return 0, errorsmod.Wrapf(ccv.ErrChannelNotFound, "CCV channel of chain %s", consumerId)
+	}
+
+	bondedValidators, err := k.GetLastBondedValidators(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("getting bonded validators: %w", err)
+	}
+
+	activeValidators, err := k.GetLastProviderConsensusActiveValidators(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("getting provider active validators: %w", err)
+	}
+
+	currentValSet, err := k.GetConsumerValSet(ctx, consumerId)
+	if err != nil {
+		return 0, fmt.Errorf("getting consumer current validator set, consumerId(%s): %w", consumerId, err)
+	}
+
+	valUpdates, err := k.ComputeConsumerNextValSet(ctx, bondedValidators, activeValidators, consumerId, currentValSet, nil)
+	if err != nil {
+		return 0, fmt.Errorf("computing consumer next validator set, consumerId(%s): %w", consumerId, err)
+	}
+
+	valUpdateID := k.GetValidatorSetUpdateId(ctx)
+	packet := ccv.NewValidatorSetChangePacketData(ccv.NewValsetDiff(valUpdates...), valUpdateID, k.ConsumeSlashAcks(ctx, consumerId))
+	k.AppendPendingVSCPackets(ctx, consumerId, packet)
+	k.IncrementValidatorSetUpdateId(ctx)
+
+	if err := k.sendVSCPacketsToConsumer(ctx, consumerId); err != nil {
+		return 0, err
+	}
+
+	return valUpdateID, nil
+}
+
 // BeginBlockCIS contains the BeginBlock logic needed for the Consumer Initiated Slashing sub-protocol.
 func (k Keeper) BeginBlockCIS(ctx sdk.Context) {
+	defer ccv.MeasureBlockStage(providertypes.ModuleName, telemetry.Now(), telemetry.MetricKeyBeginBlocker, ccv.BlockStageThrottling)
+	k.ReplenishMeter(ctx)
+}
+
+// ReplenishMeter replenishes the global slash meter and the slash meters of the consumer chains
+// that have a slash meter of their own, if their replenish periods elapsed.
+func (k Keeper) ReplenishMeter(ctx sdk.Context) {
 	// Replenish slash meter if necessary. This ensures the meter value is replenished before handling any slash packets,
 	// and ensures the meter value is not greater than the allowance (max value) for the block.
 	//
@@ -268,16 +576,26 @@ func (k Keeper) BeginBlockCIS(ctx sdk.Context) {
 	// - Marshaling and/or store corruption errors.
 	// - Setting invalid slash meter values (see SetSlashMeter).
 	k.CheckForSlashMeterReplenishment(ctx)
+	// Likewise for the slash meters of the consumer chains that have a slash meter of their own.
+	k.CheckForConsumerSlashMeterReplenishments(ctx)
 }
 
 // EndBlockCIS contains the EndBlock logic needed for
 // the Consumer Initiated Slashing sub-protocol
 func (k Keeper) EndBlockCIS(ctx sdk.Context) {
+	defer ccv.MeasureBlockStage(providertypes.ModuleName, telemetry.Now(), telemetry.MetricKeyEndBlocker, ccv.BlockStageMapVSCIdAndPruneKeys)
+	k.MapVSCIdAndPruneKeyAssignments(ctx)
+}
+
+// MapVSCIdAndPruneKeyAssignments maps the current validator set update id to the height of the next block,
+// which is used to map the infraction heights of slash packets, and prunes the consumer addresses
+// of the key assignments that can no longer be referenced in slash packets
+func (k Keeper) MapVSCIdAndPruneKeyAssignments(ctx sdk.Context) {
 	// set the ValsetUpdateBlockHeight
 	blockHeight := uint64(ctx.BlockHeight()) + 1
 	valUpdateID := k.GetValidatorSetUpdateId(ctx)
 	k.SetValsetUpdateBlockHeight(ctx, valUpdateID, blockHeight)
-	k.Logger(ctx).Debug("vscID was mapped to block height", "vscID", valUpdateID, "height", blockHeight)
+	k.SubsystemLogger(ctx, ccv.LogSubsystemVSC).Debug("vscID was mapped to block height", "vscID", valUpdateID, "height", blockHeight)
 
 	// prune previous consumer validator addresses that are no longer needed
 	for _, consumerId := range k.GetAllConsumersWithIBCClients(ctx) {
@@ -292,7 +610,7 @@ func (k Keeper) OnRecvSlashPacket(
 	packet channeltypes.Packet,
 	data ccv.SlashPacketData,
 ) (ccv.PacketAckResult, error) {
-	// check that the channel is established, panic if not
+	// check that the channel is established
 	consumerId, found := k.GetChannelIdToConsumerId(ctx, packet.DestinationChannel)
 	if !found {
 		// SlashPacket packet was sent on a channel different than any of the established CCV channels;
@@ -300,8 +618,11 @@ func (k Keeper) OnRecvSlashPacket(
 		k.Logger(ctx).Error("SlashPacket received on unknown channel",
 			"channelID", packet.DestinationChannel,
 		)
-		panic(fmt.Errorf("SlashPacket received on unknown channel %s", packet.DestinationChannel))
+		return nil, errorsmod.Wrapf(ccv.ErrUnknownChannel, "SlashPacket received on unknown channel %s", packet.DestinationChannel)
 	}
+	k.updateConsumerPacketStats(ctx, consumerId, func(stats *providertypes.ConsumerPacketStats) {
+		stats.SlashPacketsReceived++
+	})
 
 	// validate packet data upon receiving
 	if err := data.Validate(); err != nil {
@@ -329,7 +650,7 @@ func (k Keeper) OnRecvSlashPacket(
 		infractionHeight, _ := k.getMappedInfractionHeight(ctx, consumerId, data.ValsetUpdateId)
 
 		k.SetSlashLog(ctx, providerConsAddr)
-		k.Logger(ctx).Info("SlashPacket received for double-signing",
+		k.SubsystemLogger(ctx, ccv.LogSubsystemSlash).Info("SlashPacket received for double-signing",
 			"consumerId", consumerId,
 			"consumer cons addr", consumerConsAddr.String(),
 			"provider cons addr", providerConsAddr.String(),
@@ -343,7 +664,7 @@ func (k Keeper) OnRecvSlashPacket(
 	}
 
 	// check that the chain is launched
-	if k.GetConsumerPhase(ctx, consumerId) != providertypes.CONSUMER_PHASE_LAUNCHED {
+	if !k.IsConsumerLaunched(ctx, consumerId) {
 		k.Logger(ctx).Info("cannot jail validator on a chain that is not currently launched",
 			"consumerId", consumerId,
 			"phase", k.GetConsumerPhase(ctx, consumerId),
@@ -352,6 +673,7 @@ func (k Keeper) OnRecvSlashPacket(
 
 		// drop packet but return a slash ack
 		k.AppendSlashAck(ctx, consumerId, consumerConsAddr.String())
+		k.DeleteBouncedSlashPacket(ctx, consumerId)
 
 		return ccv.SlashPacketHandledResult, nil
 	}
@@ -365,31 +687,42 @@ func (k Keeper) OnRecvSlashPacket(
 
 		// drop packet but return a slash ack so that the consumer can send another slash packet
 		k.AppendSlashAck(ctx, consumerId, consumerConsAddr.String())
+		k.DeleteBouncedSlashPacket(ctx, consumerId)
 
 		return ccv.SlashPacketHandledResult, nil
 	}
 
-	meter := k.GetSlashMeter(ctx)
+	// the slash packet is throttled by the slash meter of the consumer chain, if any, or by the global slash meter
+	meter, globalMeter := k.getThrottlingSlashMeter(ctx, consumerId)
 	// Return bounce ack if meter is negative in value
 	if meter.IsNegative() {
-		k.Logger(ctx).Info("SlashPacket received, but meter is negative. Packet will be bounced",
+		k.SubsystemLogger(ctx, ccv.LogSubsystemSlash).Info("SlashPacket received, but meter is negative. Packet will be bounced",
 			"consumerId", consumerId,
+			"global meter", globalMeter,
 			"consumer cons addr", consumerConsAddr.String(),
 			"provider cons addr", providerConsAddr.String(),
 			"vscID", data.ValsetUpdateId,
 			"infractionType", data.Infraction,
 		)
+		k.updateConsumerPacketStats(ctx, consumerId, func(stats *providertypes.ConsumerPacketStats) {
+			stats.SlashPacketsBounced++
+		})
+		k.recordBouncedSlashPacket(ctx, consumerId, consumerConsAddr, providerConsAddr, data.ValsetUpdateId)
 		return ccv.SlashPacketBouncedResult, nil
 	}
 
 	// Subtract voting power that will be jailed/tombstoned from the slash meter,
 	// BEFORE handling slash packet.
 	meter = meter.Sub(k.GetEffectiveValPower(ctx, providerConsAddr))
-	k.SetSlashMeter(ctx, meter)
+	k.setThrottlingSlashMeter(ctx, consumerId, meter)
 
 	k.HandleSlashPacket(ctx, consumerId, data)
+	k.DeleteBouncedSlashPacket(ctx, consumerId)
+	k.updateConsumerPacketStats(ctx, consumerId, func(stats *providertypes.ConsumerPacketStats) {
+		stats.SlashPacketsHandled++
+	})
 
-	k.Logger(ctx).Info("slash packet received and handled",
+	k.SubsystemLogger(ctx, ccv.LogSubsystemSlash).Info("slash packet received and handled",
 		"consumerId", consumerId,
 		"consumer cons addr", consumerConsAddr.String(),
 		"provider cons addr", providerConsAddr.String(),
@@ -401,6 +734,205 @@ func (k Keeper) OnRecvSlashPacket(
 	return ccv.SlashPacketHandledResult, nil
 }
 
+// OnRecvConsumerShutdownPacket stops the consumer chain that initiated its shutdown, without
+// requiring a MsgRemoveConsumer. The result ack leads the consumer chain to close the CCV channel.
+func (k Keeper) OnRecvConsumerShutdownPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	data ccv.ConsumerShutdownPacketData,
+) error {
+	consumerId, found := k.GetChannelIdToConsumerId(ctx, packet.DestinationChannel)
+	if !found {
+		// ConsumerShutdown packet was sent on a channel different than any of the established CCV channels;
+		// this should never happen
+		k.Logger(ctx).Error("ConsumerShutdownPacket received on unknown channel",
+			"channelID", packet.DestinationChannel,
+		)
+		return errorsmod.Wrapf(ccv.ErrUnknownChannel, "ConsumerShutdownPacket received on unknown channel %s", packet.DestinationChannel)
+	}
+
+	// validate packet data upon receiving
+	if err := data.Validate(); err != nil {
+		return errorsmod.Wrapf(err, "error validating ConsumerShutdownPacket data")
+	}
+
+	phase := k.GetConsumerPhase(ctx, consumerId)
+	if phase != providertypes.CONSUMER_PHASE_LAUNCHED && phase != providertypes.CONSUMER_PHASE_PAUSED {
+		// the consumer chain is already stopped, e.g., by a MsgRemoveConsumer;
+		// acknowledge the packet so that the consumer chain closes the CCV channel
+		k.Logger(ctx).Info("ConsumerShutdownPacket received for a consumer chain that is not running",
+			"consumerId", consumerId,
+			"phase", phase,
+		)
+		return nil
+	}
+
+	if err := k.StopAndPrepareForConsumerRemoval(ctx, consumerId); err != nil {
+		return err
+	}
+
+	k.Logger(ctx).Info("stopped consumer on consumer shutdown",
+		"consumerId", consumerId,
+		"vscID", data.ValsetUpdateId,
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			providertypes.EventTypeConsumerShutdown,
+			sdk.NewAttribute(sdk.AttributeKeyModule, providertypes.ModuleName),
+			sdk.NewAttribute(providertypes.AttributeConsumerId, consumerId),
+			sdk.NewAttribute(providertypes.AttributeConsumerPhase, phase.String()),
+			sdk.NewAttribute(ccv.AttributeValSetUpdateID, strconv.FormatUint(data.ValsetUpdateId, 10)),
+		),
+	)
+
+	return nil
+}
+
+// OnRecvConsumerMisbehaviourPacket handles the misbehaviour reported by a consumer chain in the same way as
+// a MsgSubmitConsumerMisbehaviour, i.e., it slashes, jails, and tombstones the Byzantine validators.
+// As misbehaviour reports are not essential to the CCV protocol, a misbehaviour that is rejected, e.g., because
+// the Byzantine validators are already tombstoned, results in a result ack and a consumer_misbehaviour_rejected event.
+func (k Keeper) OnRecvConsumerMisbehaviourPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	data ccv.ConsumerMisbehaviourPacketData,
+) error {
+	consumerId, found := k.GetChannelIdToConsumerId(ctx, packet.DestinationChannel)
+	if !found {
+		// ConsumerMisbehaviour packet was sent on a channel different than any of the established CCV channels;
+		// this should never happen
+		k.Logger(ctx).Error("ConsumerMisbehaviourPacket received on unknown channel",
+			"channelID", packet.DestinationChannel,
+		)
+		return errorsmod.Wrapf(ccv.ErrUnknownChannel, "ConsumerMisbehaviourPacket received on unknown channel %s", packet.DestinationChannel)
+	}
+
+	// validate packet data upon receiving
+	if err := data.Validate(); err != nil {
+		return errorsmod.Wrapf(err, "error validating ConsumerMisbehaviourPacket data")
+	}
+	misbehaviour := *data.Misbehaviour
+
+	// the Byzantine validators are punished atomically, i.e., either all or none of them
+	cacheCtx, writeCache := ctx.CacheContext()
+	if err := k.HandleConsumerMisbehaviour(cacheCtx, consumerId, misbehaviour); err != nil {
+		k.Logger(ctx).Info("misbehaviour reported by consumer rejected",
+			"consumerId", consumerId,
+			"error", err,
+		)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				providertypes.EventTypeConsumerMisbehaviourRejected,
+				sdk.NewAttribute(sdk.AttributeKeyModule, providertypes.ModuleName),
+				sdk.NewAttribute(providertypes.AttributeConsumerId, consumerId),
+				sdk.NewAttribute(ccv.AttributeMisbehaviourClientId, misbehaviour.ClientId),
+				sdk.NewAttribute(ccv.AttributeMisbehaviourHeight1, misbehaviour.Header1.GetHeight().String()),
+				sdk.NewAttribute(ccv.AttributeMisbehaviourHeight2, misbehaviour.Header2.GetHeight().String()),
+				sdk.NewAttribute(providertypes.AttributeMisbehaviourError, err.Error()),
+			),
+		)
+		return nil
+	}
+	writeCache()
+
+	k.EmitSubmitConsumerMisbehaviourEvent(ctx, consumerId, misbehaviour,
+		sdk.NewAttribute(channeltypes.AttributeKeyChannelID, packet.DestinationChannel))
+
+	return nil
+}
+
+// OnRecvConsumerDoubleVotePacket handles the double voting evidence forwarded by a consumer chain in the same way as
+// a MsgSubmitConsumerDoubleVoting, i.e., it slashes, jails, and tombstones the malicious validator, using the consensus
+// public key of the validator sent by the consumer chain to verify the evidence.
+// As forwarded evidence is not essential to the CCV protocol, an evidence that is rejected, e.g., because it is too old
+// or the validator is already tombstoned, results in a result ack and a consumer_double_vote_rejected event.
+func (k Keeper) OnRecvConsumerDoubleVotePacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	data ccv.ConsumerDoubleVotePacketData,
+) error {
+	consumerId, found := k.GetChannelIdToConsumerId(ctx, packet.DestinationChannel)
+	if !found {
+		// ConsumerDoubleVote packet was sent on a channel different than any of the established CCV channels;
+		// this should never happen
+		k.Logger(ctx).Error("ConsumerDoubleVotePacket received on unknown channel",
+			"channelID", packet.DestinationChannel,
+		)
+		return errorsmod.Wrapf(ccv.ErrUnknownChannel, "ConsumerDoubleVotePacket received on unknown channel %s", packet.DestinationChannel)
+	}
+
+	// validate packet data upon receiving
+	if err := data.Validate(); err != nil {
+		return errorsmod.Wrapf(err, "error validating ConsumerDoubleVotePacket data")
+	}
+	evidence, err := tmtypes.DuplicateVoteEvidenceFromProto(data.DuplicateVoteEvidence)
+	if err != nil {
+		return errorsmod.Wrapf(ccv.ErrInvalidPacketData, "invalid double voting evidence: %s", err.Error())
+	}
+	pubKey, err := cryptocodec.FromCmtProtoPublicKey(*data.ValidatorPubKey)
+	if err != nil {
+		return errorsmod.Wrapf(ccv.ErrInvalidPacketData, "invalid validator public key: %s", err.Error())
+	}
+
+	// the malicious validator is slashed, jailed, and tombstoned atomically
+	err = k.executeAtomically(ctx, func(ctx sdk.Context) error {
+		return k.HandleConsumerDoubleVoting(ctx, consumerId, evidence, pubKey)
+	})
+	if err != nil {
+		k.Logger(ctx).Info("double voting evidence forwarded by consumer rejected",
+			"consumerId", consumerId,
+			"error", err,
+		)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				providertypes.EventTypeConsumerDoubleVoteRejected,
+				sdk.NewAttribute(sdk.AttributeKeyModule, providertypes.ModuleName),
+				sdk.NewAttribute(providertypes.AttributeConsumerId, consumerId),
+				sdk.NewAttribute(ccv.AttributeValidatorAddress, sdk.ConsAddress(evidence.VoteA.ValidatorAddress).String()),
+				sdk.NewAttribute(ccv.AttributeInfractionHeight, strconv.FormatInt(evidence.Height(), 10)),
+				sdk.NewAttribute(providertypes.AttributeDoubleVotingError, err.Error()),
+			),
+		)
+		return nil
+	}
+
+	chainId, err := k.GetConsumerChainId(ctx, consumerId)
+	if err != nil {
+		return err
+	}
+	k.EmitSubmitConsumerDoubleVotingEvent(ctx, consumerId, chainId, data.DuplicateVoteEvidence,
+		sdk.NewAttribute(channeltypes.AttributeKeyChannelID, packet.DestinationChannel))
+
+	return nil
+}
+
+// OnRecvConsumerHeartbeatPacket records that the assigned consumer keys of the validators
+// that signed blocks on the consumer chain were observed (see RecordConsumerHeartbeat)
+func (k Keeper) OnRecvConsumerHeartbeatPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	data ccv.ConsumerHeartbeatPacketData,
+) error {
+	consumerId, found := k.GetChannelIdToConsumerId(ctx, packet.DestinationChannel)
+	if !found {
+		// ConsumerHeartbeat packet was sent on a channel different than any of the established CCV channels;
+		// this should never happen
+		k.Logger(ctx).Error("ConsumerHeartbeatPacket received on unknown channel",
+			"channelID", packet.DestinationChannel,
+		)
+		return errorsmod.Wrapf(ccv.ErrUnknownChannel, "ConsumerHeartbeatPacket received on unknown channel %s", packet.DestinationChannel)
+	}
+
+	// validate packet data upon receiving
+	if err := data.Validate(); err != nil {
+		return errorsmod.Wrapf(err, "error validating ConsumerHeartbeatPacket data")
+	}
+
+	k.RecordConsumerHeartbeat(ctx, consumerId, data.ValidatorAddresses)
+	return nil
+}
+
 // ValidateSlashPacket validates a recv slash packet before it is
 // handled or persisted in store. An error is returned if the packet is invalid,
 // and an error ack should be relayed to the sender.
@@ -424,7 +956,7 @@ func (k Keeper) HandleSlashPacket(ctx sdk.Context, consumerId string, data ccv.S
 	// Obtain provider chain consensus address using the consumer chain consensus address
 	providerConsAddr := k.GetProviderAddrFromConsumerAddr(ctx, consumerId, consumerConsAddr)
 
-	k.Logger(ctx).Debug("HandleSlashPacket",
+	k.SubsystemLogger(ctx, ccv.LogSubsystemSlash).Debug("HandleSlashPacket",
 		"consumerId", consumerId,
 		"consumer cons addr", consumerConsAddr.String(),
 		"provider cons addr", providerConsAddr.String(),
@@ -484,28 +1016,18 @@ func (k Keeper) HandleSlashPacket(ctx sdk.Context, consumerId string, data ccv.S
 	}
 
 	if !validator.IsJailed() {
-		// slash validator
-		_, err = k.stakingKeeper.SlashWithInfractionReason(ctx, providerConsAddr.ToSdkConsAddr(), int64(infractionHeight),
-			data.Validator.Power, infractionParams.Downtime.SlashFraction, stakingtypes.Infraction_INFRACTION_DOWNTIME)
-		if err != nil {
-			k.Logger(ctx).Error("failed to slash validator", providerConsAddr.ToSdkConsAddr().String(), "err", err.Error())
-			return
-		}
-
-		// jail validator
-		err := k.stakingKeeper.Jail(ctx, providerConsAddr.ToSdkConsAddr())
+		// the validator is slashed and jailed atomically, i.e., a failure to jail the validator reverts the slashing
+		err = k.executeAtomically(ctx, func(ctx sdk.Context) error {
+			return k.slashAndJailForDowntime(ctx, providerConsAddr, int64(infractionHeight), data.Validator.Power, infractionParams.Downtime)
+		})
 		if err != nil {
-			k.Logger(ctx).Error("failed to jail validator", providerConsAddr.ToSdkConsAddr().String(), "err", err.Error())
+			k.Logger(ctx).Error("failed to slash and jail validator",
+				"provider cons addr", providerConsAddr.String(),
+				"err", err.Error(),
+			)
 			return
 		}
 		k.Logger(ctx).Info("HandleSlashPacket - validator jailed", "provider cons addr", providerConsAddr.String())
-
-		jailEndTime := ctx.BlockTime().Add(infractionParams.Downtime.JailDuration)
-		err = k.slashingKeeper.JailUntil(ctx, providerConsAddr.ToSdkConsAddr(), jailEndTime)
-		if err != nil {
-			k.Logger(ctx).Error("failed to set jail duration", "err", err.Error())
-			return
-		}
 	}
 
 	ctx.EventManager().EmitEvent(
@@ -520,6 +1042,33 @@ func (k Keeper) HandleSlashPacket(ctx sdk.Context, consumerId string, data ccv.S
 	)
 }
 
+// slashAndJailForDowntime slashes the validator `providerConsAddr` for a downtime infraction on a consumer chain
+// and jails it for the downtime jail duration of the chain
+func (k Keeper) slashAndJailForDowntime(
+	ctx sdk.Context,
+	providerConsAddr providertypes.ProviderConsAddress,
+	infractionHeight int64,
+	power int64,
+	downtimeParams *providertypes.SlashJailParameters,
+) error {
+	_, err := k.stakingKeeper.SlashWithInfractionReason(ctx, providerConsAddr.ToSdkConsAddr(), infractionHeight,
+		power, downtimeParams.SlashFraction, stakingtypes.Infraction_INFRACTION_DOWNTIME)
+	if err != nil {
+		return fmt.Errorf("slashing validator: %w", err)
+	}
+
+	if err := k.stakingKeeper.Jail(ctx, providerConsAddr.ToSdkConsAddr()); err != nil {
+		return fmt.Errorf("jailing validator: %w", err)
+	}
+
+	jailEndTime := ctx.BlockTime().Add(downtimeParams.JailDuration)
+	if err := k.slashingKeeper.JailUntil(ctx, providerConsAddr.ToSdkConsAddr(), jailEndTime); err != nil {
+		return fmt.Errorf("setting jail duration: %w", err)
+	}
+
+	return nil
+}
+
 // getMappedInfractionHeight gets the infraction height mapped from val set ID for the given consumer id
 func (k Keeper) getMappedInfractionHeight(ctx sdk.Context,
 	consumerId string, valsetUpdateID uint64,
@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"context"
+	"fmt"
 	"sort"
 	"strings"
 	"testing"
@@ -8,6 +10,7 @@ import (
 
 	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	ibctmtypes "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
 	ibctesting "github.com/cosmos/ibc-go/v10/testing"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
@@ -19,6 +22,8 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+	cryptoenc "github.com/cometbft/cometbft/crypto/encoding"
+	cmttypes "github.com/cometbft/cometbft/types"
 
 	cryptotestutil "github.com/cosmos/interchain-security/v7/testutil/crypto"
 	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
@@ -196,6 +201,27 @@ func TestOnRecvDowntimeSlashPacket(t *testing.T) {
 	require.Equal(t, ccv.SlashPacketBouncedResult, ackResult)
 	require.NoError(t, err)
 
+	// Require the bounced slash packets are pending a retry, with the first receive time kept across retries
+	firstReceiveTime := ctx.BlockTime()
+	ctx = ctx.WithBlockTime(firstReceiveTime.Add(time.Hour))
+	ackResult, err = executeOnRecvSlashPacket(t, &providerKeeper, ctx, channelId1, 3, packetData)
+	require.Equal(t, ccv.SlashPacketBouncedResult, ackResult)
+	require.NoError(t, err)
+	consumerConsAddr := providertypes.NewConsumerConsAddress(packetData.Validator.Address)
+	providerConsAddr := providertypes.NewProviderConsAddress(packetData.Validator.Address)
+	bouncedPacket, found := providerKeeper.GetBouncedSlashPacket(ctx, consumerId1)
+	require.True(t, found)
+	require.Equal(t, providertypes.BouncedSlashPacket{
+		ConsumerId:          consumerId1,
+		ConsumerConsAddress: consumerConsAddr.String(),
+		ProviderConsAddress: providerConsAddr.String(),
+		ValsetUpdateId:      packetData.ValsetUpdateId,
+		FirstReceiveTime:    firstReceiveTime,
+		LastReceiveTime:     firstReceiveTime.Add(time.Hour),
+		Bounces:             2,
+	}, bouncedPacket)
+	require.Len(t, providerKeeper.GetAllBouncedSlashPackets(ctx), 2)
+
 	// Now set slash meter to positive value and assert slash packet handled result is returned
 	providerKeeper.SetSlashMeter(ctx, math.NewInt(5))
 
@@ -231,6 +257,87 @@ func TestOnRecvDowntimeSlashPacket(t *testing.T) {
 
 	// Require slash meter was decremented appropriately, 5-2=3
 	require.Equal(t, int64(3), providerKeeper.GetSlashMeter(ctx).Int64())
+
+	// Require the handled slash packet is no longer pending a retry
+	mocks.MockStakingKeeper.EXPECT().GetLastTotalPower(gomock.Any()).Return(math.NewInt(100), nil)
+	providerKeeper.SetSlashMeterReplenishTimeCandidate(ctx)
+	resp, err := providerKeeper.QueryThrottleQueueState(ctx, &providertypes.QueryThrottleQueueStateRequest{})
+	require.NoError(t, err)
+	require.Equal(t, int64(3), resp.SlashMeter)
+	require.Equal(t, []providertypes.BouncedSlashPacket{bouncedPacket}, resp.PendingSlashPackets)
+
+	// Require the packet statistics were recorded
+	require.Equal(t, providertypes.ConsumerPacketStats{
+		SlashPacketsReceived: 2,
+		SlashPacketsHandled:  1,
+		SlashPacketsBounced:  1,
+	}, providerKeeper.GetConsumerPacketStats(ctx, consumerId0))
+	require.Equal(t, providertypes.ConsumerPacketStats{
+		SlashPacketsReceived: 2,
+		SlashPacketsBounced:  2,
+	}, providerKeeper.GetConsumerPacketStats(ctx, consumerId1))
+}
+
+// TestOnRecvSlashPacketConsumerSlashMeter tests that the slash packets of a consumer chain with a slash meter
+// of its own are handled even if the global slash meter is exhausted by the slash packets of other consumer chains
+func TestOnRecvSlashPacketConsumerSlashMeter(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+
+	// consumer chain 0 has a slash meter of its own, while consumer chain 1 shares the global slash meter
+	mocks.MockStakingKeeper.EXPECT().GetLastTotalPower(gomock.Any()).Return(math.NewInt(100), nil).AnyTimes()
+	for i, consumerId := range []string{"0", "1"} {
+		providerKeeper.SetChannelToConsumerId(ctx, fmt.Sprintf("channel-%d", i), consumerId)
+		providerKeeper.SetConsumerPhase(ctx, consumerId, providertypes.CONSUMER_PHASE_LAUNCHED)
+		err := providerKeeper.SetInfractionParameters(ctx, consumerId, *getTestInfractionParameters())
+		require.NoError(t, err)
+	}
+	err := providerKeeper.SetConsumerThrottlingParameters(ctx, "0", providertypes.ThrottlingParameters{SlashMeterReplenishFraction: "0.05"})
+	require.NoError(t, err)
+	err = providerKeeper.SetConsumerThrottlingParameters(ctx, "1", providertypes.ThrottlingParameters{})
+	require.NoError(t, err)
+
+	packetData := testkeeper.GetNewSlashPacketData()
+	packetData.Infraction = stakingtypes.Infraction_INFRACTION_DOWNTIME
+	providerKeeper.SetValsetUpdateBlockHeight(ctx, packetData.ValsetUpdateId, uint64(15))
+	for _, consumerId := range []string{"0", "1"} {
+		err := providerKeeper.SetConsumerValidator(ctx, consumerId, providertypes.ConsensusValidator{
+			ProviderConsAddr: packetData.Validator.Address,
+		})
+		require.NoError(t, err)
+	}
+
+	// the global slash meter is exhausted, so the slash packets of consumer chain 1 are bounced
+	providerKeeper.SetSlashMeter(ctx, math.NewInt(-5))
+	ackResult, err := executeOnRecvSlashPacket(t, &providerKeeper, ctx, "channel-1", 1, packetData)
+	require.NoError(t, err)
+	require.Equal(t, ccv.SlashPacketBouncedResult, ackResult)
+
+	// the slash packets of consumer chain 0 are throttled by its own slash meter and hence handled
+	providerAddr := providertypes.NewProviderConsAddress(packetData.Validator.Address)
+	valAddr := sdk.ValAddress(packetData.Validator.Address).String()
+	calls := []*gomock.Call{
+		mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(ctx, providerAddr.ToSdkConsAddr()).
+			Return(stakingtypes.Validator{OperatorAddress: valAddr}, nil).Times(1),
+		mocks.MockStakingKeeper.EXPECT().GetLastValidatorPower(ctx, gomock.Any()).
+			Return(int64(2), nil).Times(1),
+	}
+	calls = append(calls,
+		testkeeper.GetMocksForHandleSlashPacket(
+			ctx, mocks, providerAddr, stakingtypes.Validator{Jailed: false, OperatorAddress: valAddr}, true)...,
+	)
+	gomock.InOrder(calls...)
+
+	ackResult, err = executeOnRecvSlashPacket(t, &providerKeeper, ctx, "channel-0", 1, packetData)
+	require.NoError(t, err)
+	require.Equal(t, ccv.SlashPacketHandledResult, ackResult)
+
+	// only the slash meter of consumer chain 0 is decremented, 5-2=3
+	meter, found := providerKeeper.GetConsumerSlashMeter(ctx, "0")
+	require.True(t, found)
+	require.Equal(t, int64(3), meter.Int64())
+	require.Equal(t, int64(-5), providerKeeper.GetSlashMeter(ctx).Int64())
 }
 
 // TestOnRecvDoubleSignSlashPacket tests the OnRecvSlashPacket method specifically for double-sign slash packets.
@@ -508,6 +615,58 @@ func TestHandleSlashPacket(t *testing.T) {
 	}
 }
 
+// TestHandleSlashPacketAtomicity tests that a validator is not left slashed when it cannot be jailed
+func TestHandleSlashPacketAtomicity(t *testing.T) {
+	chainId := "consumer-id"
+	providerConsAddr := cryptotestutil.NewCryptoIdentityFromIntSeed(7842334).ProviderConsAddress()
+	consumerConsAddr := cryptotestutil.NewCryptoIdentityFromIntSeed(784987634).ConsumerConsAddress()
+	valOperAddr := cryptotestutil.NewCryptoIdentityFromIntSeed(7842334).SDKValOpAddressString()
+	// the state written by the staking module when slashing the validator
+	slashedKey := []byte("slashed")
+
+	for _, failingStep := range []string{"none", "jail", "jail until"} {
+		t.Run(failingStep, func(t *testing.T) {
+			keeperParams := testkeeper.NewInMemKeeperParams(t)
+			providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, keeperParams)
+			defer ctrl.Finish()
+
+			var jailErr, jailUntilErr error
+			switch failingStep {
+			case "jail":
+				jailErr = fmt.Errorf("jail failure")
+			case "jail until":
+				jailUntilErr = fmt.Errorf("jail until failure")
+			}
+
+			mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(ctx, providerConsAddr.ToSdkConsAddr()).
+				Return(stakingtypes.Validator{Jailed: false, OperatorAddress: valOperAddr}, nil)
+			mocks.MockSlashingKeeper.EXPECT().IsTombstoned(ctx, providerConsAddr.ToSdkConsAddr()).Return(false)
+			mocks.MockStakingKeeper.EXPECT().SlashWithInfractionReason(gomock.Any(), providerConsAddr.ToSdkConsAddr(),
+				gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(func(ctx context.Context, _ sdk.ConsAddress, _, _ int64, _ math.LegacyDec, _ stakingtypes.Infraction) (math.Int, error) {
+					sdk.UnwrapSDKContext(ctx).KVStore(keeperParams.StoreKey).Set(slashedKey, []byte{1})
+					return math.NewInt(10), nil
+				})
+			mocks.MockStakingKeeper.EXPECT().Jail(gomock.Any(), providerConsAddr.ToSdkConsAddr()).Return(jailErr)
+			if jailErr == nil {
+				mocks.MockSlashingKeeper.EXPECT().JailUntil(gomock.Any(), providerConsAddr.ToSdkConsAddr(), gomock.Any()).Return(jailUntilErr)
+			}
+
+			providerKeeper.SetInitChainHeight(ctx, chainId, 5)
+			providerKeeper.SetValidatorByConsumerAddr(ctx, chainId, consumerConsAddr, providerConsAddr)
+			require.NoError(t, providerKeeper.SetInfractionParameters(ctx, chainId, *getTestInfractionParameters()))
+
+			providerKeeper.HandleSlashPacket(ctx, chainId, *ccv.NewSlashPacketData(
+				abci.Validator{Address: consumerConsAddr.ToSdkConsAddr()}, 0, stakingtypes.Infraction_INFRACTION_DOWNTIME))
+
+			// the slashing is only written if the validator is also jailed
+			require.Equal(t, failingStep == "none", ctx.KVStore(keeperParams.StoreKey).Has(slashedKey))
+			// the slash packet is acknowledged in any case
+			require.Len(t, providerKeeper.GetSlashAcks(ctx, chainId), 1)
+		})
+	}
+}
+
 // TestSendVSCPacketsToChainFailure tests the SendVSCPacketsToChain method failing
 func TestSendVSCPacketsToChainFailure(t *testing.T) {
 	// Keeper setup
@@ -560,6 +719,21 @@ func TestSendVSCPacketsToChainFailure(t *testing.T) {
 	require.Equal(t, providertypes.CONSUMER_PHASE_DELETED, providerKeeper.GetConsumerPhase(ctx, CONSUMER_ID))
 }
 
+// TestSendVSCPacketsToPausedChain tests that the VSC packets of a paused chain remain pending
+func TestSendVSCPacketsToPausedChain(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	providerKeeper.SetConsumerClientId(ctx, CONSUMER_ID, "clientID")
+	providerKeeper.SetConsumerIdToChannelId(ctx, CONSUMER_ID, "channelID")
+	providerKeeper.SetConsumerPhase(ctx, CONSUMER_ID, providertypes.CONSUMER_PHASE_PAUSED)
+	providerKeeper.AppendPendingVSCPackets(ctx, CONSUMER_ID, []ccv.ValidatorSetChangePacketData{{}, {}}...)
+
+	// no packet is sent, i.e., the channel keeper is not called
+	require.NoError(t, providerKeeper.SendVSCPackets(ctx))
+	require.Len(t, providerKeeper.GetPendingVSCPackets(ctx, CONSUMER_ID), 2)
+}
+
 // TestOnTimeoutPacketWithNoChainFound tests the `OnTimeoutPacket` method fails when no chain is found
 func TestOnTimeoutPacketWithNoChainFound(t *testing.T) {
 	// Keeper setup
@@ -575,7 +749,7 @@ func TestOnTimeoutPacketWithNoChainFound(t *testing.T) {
 	require.True(t, strings.Contains(err.Error(), channeltypes.ErrInvalidChannel.Error()))
 }
 
-// TestOnTimeoutPacketStopsChain tests that the chain is stopped in case of a timeout
+// TestOnTimeoutPacketStopsChain tests that a chain that is not launched is stopped in case of a timeout
 func TestOnTimeoutPacketStopsChain(t *testing.T) {
 	// Keeper setup
 	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
@@ -608,6 +782,160 @@ func TestOnTimeoutPacketStopsChain(t *testing.T) {
 	testkeeper.TestProviderStateIsCleanedAfterConsumerChainIsDeleted(t, ctx, providerKeeper, CONSUMER_ID, "channelID", false)
 }
 
+// TestOnRecvConsumerShutdownPacket tests that a consumer chain that initiated its shutdown is stopped
+func TestOnRecvConsumerShutdownPacket(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	unbondingTime := 123 * time.Second
+	mocks.MockStakingKeeper.EXPECT().UnbondingTime(gomock.Any()).Return(unbondingTime, nil).Times(1)
+
+	data := *ccv.NewConsumerShutdownPacketData(7)
+	packet := channeltypes.Packet{DestinationChannel: "channelID"}
+
+	// the packet is received on an unknown channel
+	err := providerKeeper.OnRecvConsumerShutdownPacket(ctx, packet, data)
+	require.ErrorIs(t, err, ccv.ErrUnknownChannel)
+
+	providerKeeper.SetChannelToConsumerId(ctx, "channelID", CONSUMER_ID)
+	providerKeeper.SetConsumerPhase(ctx, CONSUMER_ID, providertypes.CONSUMER_PHASE_LAUNCHED)
+
+	// invalid packet data
+	err = providerKeeper.OnRecvConsumerShutdownPacket(ctx, packet, *ccv.NewConsumerShutdownPacketData(0))
+	require.ErrorIs(t, err, ccv.ErrInvalidPacketData)
+	require.Equal(t, providertypes.CONSUMER_PHASE_LAUNCHED, providerKeeper.GetConsumerPhase(ctx, CONSUMER_ID))
+
+	// the launched chain is stopped and removed once the unbonding period elapses
+	err = providerKeeper.OnRecvConsumerShutdownPacket(ctx, packet, data)
+	require.NoError(t, err)
+	require.Equal(t, providertypes.CONSUMER_PHASE_STOPPED, providerKeeper.GetConsumerPhase(ctx, CONSUMER_ID))
+	removalTime, err := providerKeeper.GetConsumerRemovalTime(ctx, CONSUMER_ID)
+	require.NoError(t, err)
+	require.Equal(t, ctx.BlockTime().Add(unbondingTime), removalTime)
+
+	// a packet received for a stopped chain is acknowledged without error
+	err = providerKeeper.OnRecvConsumerShutdownPacket(ctx, packet, data)
+	require.NoError(t, err)
+}
+
+// TestOnRecvConsumerMisbehaviourPacket tests that a misbehaviour reported by a consumer chain
+// that cannot be verified is rejected without an error ack
+func TestOnRecvConsumerMisbehaviourPacket(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	privVal := cmttypes.NewMockPV()
+	pubKey, err := privVal.GetPubKey()
+	require.NoError(t, err)
+	valSet := cmttypes.NewValidatorSet([]*cmttypes.Validator{cmttypes.NewValidator(pubKey, 10)})
+	signers := map[string]cmttypes.PrivValidator{pubKey.Address().String(): privVal}
+	misbehaviour := ibctmtypes.NewMisbehaviour("07-tendermint-0",
+		cryptotestutil.MakeAndSignHeader("consumer-1", 5, ctx.BlockTime(), []byte("app_hash_1"), valSet, signers),
+		cryptotestutil.MakeAndSignHeader("consumer-1", 5, ctx.BlockTime(), []byte("app_hash_2"), valSet, signers),
+	)
+	data := *ccv.NewConsumerMisbehaviourPacketData(misbehaviour)
+	packet := channeltypes.Packet{DestinationChannel: "channelID"}
+
+	// the packet is received on an unknown channel
+	err = providerKeeper.OnRecvConsumerMisbehaviourPacket(ctx, packet, data)
+	require.ErrorIs(t, err, ccv.ErrUnknownChannel)
+
+	providerKeeper.SetChannelToConsumerId(ctx, "channelID", CONSUMER_ID)
+	providerKeeper.SetConsumerPhase(ctx, CONSUMER_ID, providertypes.CONSUMER_PHASE_LAUNCHED)
+	providerKeeper.SetConsumerChainId(ctx, CONSUMER_ID, "consumer-1")
+	providerKeeper.SetConsumerClientId(ctx, CONSUMER_ID, "07-tendermint-1")
+
+	// invalid packet data
+	err = providerKeeper.OnRecvConsumerMisbehaviourPacket(ctx, packet, *ccv.NewConsumerMisbehaviourPacketData(nil))
+	require.ErrorIs(t, err, ccv.ErrInvalidPacketData)
+
+	// the misbehaviour for a different client than the client to the consumer chain is rejected
+	err = providerKeeper.OnRecvConsumerMisbehaviourPacket(ctx, packet, data)
+	require.NoError(t, err)
+	found := false
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == providertypes.EventTypeConsumerMisbehaviourRejected {
+			found = true
+		}
+	}
+	require.True(t, found)
+}
+
+// TestOnRecvConsumerDoubleVotePacket tests that a double voting evidence forwarded by a consumer chain
+// that cannot be verified is rejected without an error ack
+func TestOnRecvConsumerDoubleVotePacket(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	privVal := cmttypes.NewMockPV()
+	pubKey, err := privVal.GetPubKey()
+	require.NoError(t, err)
+	valSet := cmttypes.NewValidatorSet([]*cmttypes.Validator{cmttypes.NewValidator(pubKey, 10)})
+	tmPubKey, err := cryptoenc.PubKeyToProto(pubKey)
+	require.NoError(t, err)
+	// the evidence is signed for a different chain than the consumer chain
+	evidence := cryptotestutil.MakeDuplicateVoteEvidence(5, ctx.BlockTime(), valSet, privVal, "other-1")
+	data := *ccv.NewConsumerDoubleVotePacketData(evidence.ToProto(), &tmPubKey)
+	packet := channeltypes.Packet{DestinationChannel: "channelID"}
+
+	// the packet is received on an unknown channel
+	err = providerKeeper.OnRecvConsumerDoubleVotePacket(ctx, packet, data)
+	require.ErrorIs(t, err, ccv.ErrUnknownChannel)
+
+	providerKeeper.SetChannelToConsumerId(ctx, "channelID", CONSUMER_ID)
+	providerKeeper.SetConsumerPhase(ctx, CONSUMER_ID, providertypes.CONSUMER_PHASE_LAUNCHED)
+	providerKeeper.SetConsumerChainId(ctx, CONSUMER_ID, "consumer-1")
+	providerKeeper.SetConsumerClientId(ctx, CONSUMER_ID, "07-tendermint-1")
+
+	// invalid packet data
+	err = providerKeeper.OnRecvConsumerDoubleVotePacket(ctx, packet, *ccv.NewConsumerDoubleVotePacketData(evidence.ToProto(), nil))
+	require.ErrorIs(t, err, ccv.ErrInvalidPacketData)
+
+	// the evidence that cannot be verified is rejected
+	err = providerKeeper.OnRecvConsumerDoubleVotePacket(ctx, packet, data)
+	require.NoError(t, err)
+	found := false
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == providertypes.EventTypeConsumerDoubleVoteRejected {
+			found = true
+		}
+	}
+	require.True(t, found)
+}
+
+// TestOnRecvConsumerHeartbeatPacket tests that the assigned consumer keys reported
+// in a heartbeat of a consumer chain are recorded as observed
+func TestOnRecvConsumerHeartbeatPacket(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	params := providertypes.DefaultParams()
+	params.StaleKeyAssignmentEpochs = 1
+	providerKeeper.SetParams(ctx, params)
+	ctx = ctx.WithBlockHeight(42)
+
+	validator := cryptotestutil.NewCryptoIdentityFromIntSeed(0)
+	consumerIdentity := cryptotestutil.NewCryptoIdentityFromIntSeed(1)
+	data := *ccv.NewConsumerHeartbeatPacketData([][]byte{consumerIdentity.SDKValConsAddress()})
+	packet := channeltypes.Packet{DestinationChannel: "channelID"}
+
+	// the packet is received on an unknown channel
+	err := providerKeeper.OnRecvConsumerHeartbeatPacket(ctx, packet, data)
+	require.ErrorIs(t, err, ccv.ErrUnknownChannel)
+
+	providerKeeper.SetChannelToConsumerId(ctx, "channelID", CONSUMER_ID)
+	providerKeeper.SetValidatorByConsumerAddr(ctx, CONSUMER_ID, consumerIdentity.ConsumerConsAddress(), validator.ProviderConsAddress())
+
+	// invalid packet data
+	err = providerKeeper.OnRecvConsumerHeartbeatPacket(ctx, packet, *ccv.NewConsumerHeartbeatPacketData([][]byte{{}}))
+	require.ErrorIs(t, err, ccv.ErrInvalidPacketData)
+
+	err = providerKeeper.OnRecvConsumerHeartbeatPacket(ctx, packet, data)
+	require.NoError(t, err)
+	observation, found := providerKeeper.GetKeyAssignmentObservation(ctx, CONSUMER_ID, consumerIdentity.ConsumerConsAddress())
+	require.True(t, found)
+	require.Equal(t, int64(42), observation.LastObservedHeight)
+}
+
 // TestOnAcknowledgementPacketWithNoAckError tests `OnAcknowledgementPacket` when the underlying ack contains no error
 func TestOnAcknowledgementPacketWithNoAckError(t *testing.T) {
 	// Keeper setup
@@ -650,6 +978,7 @@ func TestOnAcknowledgementPacketWithAckError(t *testing.T) {
 
 	err = providerKeeper.OnAcknowledgementPacket(ctx, packet, ackError)
 	require.NoError(t, err)
+	require.Equal(t, uint64(1), providerKeeper.GetConsumerPacketStats(ctx, CONSUMER_ID).ErrorAcksReceived)
 
 	// increase the block time by `unbondingTime` so the chain actually gets deleted
 	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(unbondingTime))
@@ -724,6 +1053,162 @@ func TestEndBlockVSU(t *testing.T) {
 	_, err = providerKeeper.EndBlockVSU(ctx)
 	require.NoError(t, err)
 	require.Equal(t, 1, len(providerKeeper.GetPendingVSCPackets(ctx, consumerId)))
+
+	// with block height of 16 and immediate validator updates requested, we expect the validator updates
+	// to be computed before the end of the epoch
+	valsetUpdateId := providerKeeper.GetValidatorSetUpdateId(ctx)
+	providerKeeper.SetImmediateValidatorUpdates(ctx)
+	ctx = ctx.WithBlockHeight(16)
+	_, err = providerKeeper.EndBlockVSU(ctx)
+	require.NoError(t, err)
+	require.False(t, providerKeeper.HasImmediateValidatorUpdates(ctx))
+	require.Equal(t, valsetUpdateId+1, providerKeeper.GetValidatorSetUpdateId(ctx))
+}
+
+// TestEndBlockStages tests that the stages of the provider EndBlock can be called individually
+func TestEndBlockStages(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	// 10 blocks constitute an epoch
+	params := providertypes.DefaultParams()
+	params.BlocksPerEpoch = 10
+	providerKeeper.SetParams(ctx, params)
+
+	// the validator updates are sent only at the boundaries of an epoch
+	require.False(t, providerKeeper.ShouldSendValidatorUpdates(ctx.WithBlockHeight(5)))
+	require.True(t, providerKeeper.ShouldSendValidatorUpdates(ctx.WithBlockHeight(10)))
+
+	// unless immediate validator updates are requested
+	providerKeeper.SetImmediateValidatorUpdates(ctx)
+	require.True(t, providerKeeper.ShouldSendValidatorUpdates(ctx.WithBlockHeight(5)))
+
+	// the current validator set update id is mapped to the height of the next block
+	ctx = ctx.WithBlockHeight(7)
+	valsetUpdateId := providerKeeper.GetValidatorSetUpdateId(ctx)
+	providerKeeper.MapVSCIdAndPruneKeyAssignments(ctx)
+	height, found := providerKeeper.GetValsetUpdateBlockHeight(ctx, valsetUpdateId)
+	require.True(t, found)
+	require.Equal(t, uint64(8), height)
+}
+
+// TestSendEmergencyValsetUpdate tests that the next validator set of a consumer chain
+// is sent without waiting for the next epoch
+func TestSendEmergencyValsetUpdate(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+
+	validators := []stakingtypes.Validator{
+		createStakingValidator(ctx, mocks, 20, 2),
+		createStakingValidator(ctx, mocks, 10, 1),
+	}
+	testkeeper.SetupMocksForLastBondedValidatorsExpectation(mocks.MockStakingKeeper, 5, validators, -1)
+	mocks.MockStakingKeeper.EXPECT().GetBondedValidatorsByPower(gomock.Any()).Return(validators, nil).AnyTimes()
+
+	consumerId := "0"
+	providerKeeper.SetConsumerClientId(ctx, consumerId, "clientId")
+	err := providerKeeper.SetConsumerPowerShapingParameters(ctx, consumerId, providertypes.PowerShapingParameters{Top_N: 100})
+	require.NoError(t, err)
+
+	// the consumer chain must be launched
+	providerKeeper.SetConsumerPhase(ctx, consumerId, providertypes.CONSUMER_PHASE_INITIALIZED)
+	_, err = providerKeeper.SendEmergencyValsetUpdate(ctx, consumerId)
+	require.ErrorIs(t, err, providertypes.ErrInvalidPhase)
+
+	// the CCV channel must be established
+	providerKeeper.SetConsumerPhase(ctx, consumerId, providertypes.CONSUMER_PHASE_LAUNCHED)
+	_, err = providerKeeper.SendEmergencyValsetUpdate(ctx, consumerId)
+	require.ErrorIs(t, err, ccv.ErrChannelNotFound)
+
+	// the VSC packet is queued, but not sent, while the CCV channel is being recovered
+	providerKeeper.SetConsumerIdToChannelId(ctx, consumerId, "channelID")
+	providerKeeper.SetConsumerChannelRecoveryTime(ctx, consumerId, ctx.BlockTime())
+	valUpdateID := providerKeeper.GetValidatorSetUpdateId(ctx)
+	sentValUpdateID, err := providerKeeper.SendEmergencyValsetUpdate(ctx, consumerId)
+	require.NoError(t, err)
+	require.Equal(t, valUpdateID, sentValUpdateID)
+	require.Equal(t, valUpdateID+1, providerKeeper.GetValidatorSetUpdateId(ctx))
+	pendingPackets := providerKeeper.GetPendingVSCPackets(ctx, consumerId)
+	require.Len(t, pendingPackets, 1)
+	require.Len(t, pendingPackets[0].ValidatorUpdates, 2)
+
+	// the VSC packet is sent even if the validator set did not change
+	providerKeeper.DeleteConsumerChannelRecoveryTime(ctx, consumerId)
+	gomock.InOrder(
+		mocks.MockChannelKeeper.EXPECT().GetChannel(ctx, ccv.ProviderPortID, "channelID").Return(channeltypes.Channel{}, true),
+		mocks.MockChannelKeeper.EXPECT().SendPacket(ctx, ccv.ProviderPortID, "channelID", gomock.Any(), gomock.Any(), gomock.Any()).Return(uint64(1), nil),
+		mocks.MockChannelKeeper.EXPECT().GetChannel(ctx, ccv.ProviderPortID, "channelID").Return(channeltypes.Channel{}, true),
+		mocks.MockChannelKeeper.EXPECT().SendPacket(ctx, ccv.ProviderPortID, "channelID", gomock.Any(), gomock.Any(), gomock.Any()).Return(uint64(2), nil),
+	)
+	sentValUpdateID, err = providerKeeper.SendEmergencyValsetUpdate(ctx, consumerId)
+	require.NoError(t, err)
+	require.Equal(t, valUpdateID+1, sentValUpdateID)
+	require.Empty(t, providerKeeper.GetPendingVSCPackets(ctx, consumerId))
+	sentPacket, found := providerKeeper.GetUnackedVSCPacket(ctx, "channelID", 2)
+	require.True(t, found)
+	require.Equal(t, valUpdateID+1, sentPacket.ValsetUpdateId)
+	require.Empty(t, sentPacket.ValidatorUpdates)
+}
+
+// TestRemoveDowntimeJailedValidators tests that the validators jailed for downtime are removed
+// from the validator sets of the launched consumer chains they validate
+func TestRemoveDowntimeJailedValidators(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	// nothing is queued if no validator was jailed for downtime
+	queued, err := providerKeeper.RemoveDowntimeJailedValidators(ctx)
+	require.NoError(t, err)
+	require.False(t, queued)
+
+	launchedConsumerId := "0"
+	providerKeeper.SetConsumerClientId(ctx, launchedConsumerId, "clientId0")
+	providerKeeper.SetConsumerPhase(ctx, launchedConsumerId, providertypes.CONSUMER_PHASE_LAUNCHED)
+	stoppedConsumerId := "1"
+	providerKeeper.SetConsumerClientId(ctx, stoppedConsumerId, "clientId1")
+	providerKeeper.SetConsumerPhase(ctx, stoppedConsumerId, providertypes.CONSUMER_PHASE_STOPPED)
+
+	jailedValidator := cryptotestutil.NewCryptoIdentityFromIntSeed(0)
+	jailedPubKey := jailedValidator.TMProtoCryptoPublicKey()
+	otherValidator := cryptotestutil.NewCryptoIdentityFromIntSeed(1)
+	otherPubKey := otherValidator.TMProtoCryptoPublicKey()
+	for _, consumerId := range []string{launchedConsumerId, stoppedConsumerId} {
+		err := providerKeeper.SetConsumerValidator(ctx, consumerId, providertypes.ConsensusValidator{
+			ProviderConsAddr: jailedValidator.SDKValConsAddress(),
+			Power:            1,
+			PublicKey:        &jailedPubKey,
+		})
+		require.NoError(t, err)
+		err = providerKeeper.SetConsumerValidator(ctx, consumerId, providertypes.ConsensusValidator{
+			ProviderConsAddr: otherValidator.SDKValConsAddress(),
+			Power:            2,
+			PublicKey:        &otherPubKey,
+		})
+		require.NoError(t, err)
+	}
+
+	providerKeeper.SetDowntimeJailedValidator(ctx, jailedValidator.ProviderConsAddress())
+	valUpdateID := providerKeeper.GetValidatorSetUpdateId(ctx)
+	queued, err = providerKeeper.RemoveDowntimeJailedValidators(ctx)
+	require.NoError(t, err)
+	require.True(t, queued)
+	require.Empty(t, providerKeeper.GetAllDowntimeJailedValidators(ctx))
+	require.Equal(t, valUpdateID+1, providerKeeper.GetValidatorSetUpdateId(ctx))
+
+	// only the launched consumer chain receives a VSC packet removing the jailed validator
+	pendingPackets := providerKeeper.GetPendingVSCPackets(ctx, launchedConsumerId)
+	require.Len(t, pendingPackets, 1)
+	require.Equal(t, valUpdateID, pendingPackets[0].ValsetUpdateId)
+	require.Equal(t, []abci.ValidatorUpdate{{PubKey: jailedPubKey, Power: 0}}, pendingPackets[0].ValidatorUpdates)
+	_, found := providerKeeper.GetConsumerValidator(ctx, launchedConsumerId, jailedValidator.ProviderConsAddress())
+	require.False(t, found)
+	_, found = providerKeeper.GetConsumerValidator(ctx, launchedConsumerId, otherValidator.ProviderConsAddress())
+	require.True(t, found)
+
+	require.Empty(t, providerKeeper.GetPendingVSCPackets(ctx, stoppedConsumerId))
+	_, found = providerKeeper.GetConsumerValidator(ctx, stoppedConsumerId, jailedValidator.ProviderConsAddress())
+	require.True(t, found)
 }
 
 // TestProviderValidatorUpdates tests that the provider validator updates are correctly calculated,
@@ -800,6 +1285,7 @@ func TestProviderValidatorUpdates(t *testing.T) {
 func TestQueueVSCPacketsWithPowerCapping(t *testing.T) {
 	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
 	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
 
 	providerKeeper.SetValidatorSetUpdateId(ctx, 1)
 
@@ -855,7 +1341,7 @@ func TestQueueVSCPacketsWithPowerCapping(t *testing.T) {
 	actualQueuedVSCPackets := providerKeeper.GetPendingVSCPackets(ctx, CONSUMER_ID)
 	expectedQueuedVSCPackets := []ccv.ValidatorSetChangePacketData{
 		ccv.NewValidatorSetChangePacketData(
-			[]abci.ValidatorUpdate{
+			ccv.NewValsetDiff([]abci.ValidatorUpdate{
 				// validator D is not here because it was denylisted
 				// powers have changed because of power capping
 				{
@@ -874,7 +1360,7 @@ func TestQueueVSCPacketsWithPowerCapping(t *testing.T) {
 					PubKey: valAPubKey,
 					Power:  4,
 				},
-			},
+			}...),
 			1,
 			nil),
 	}
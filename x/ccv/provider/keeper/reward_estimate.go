@@ -0,0 +1,115 @@
+package keeper
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+)
+
+// rewardEstimatePeriod is the period of time the ICS rewards are estimated for
+const rewardEstimatePeriod = 365 * 24 * time.Hour
+
+// minRewardEstimateWindow is the minimum period of time over which the inflow of rewards
+// must be observed for the ICS rewards to be estimated
+const minRewardEstimateWindow = 24 * time.Hour
+
+// GetConsumerRewardsInflow returns the ICS rewards received by the consumer rewards pool
+// from the given consumer chain, together with the period of time over which they were received,
+// i.e., since the oldest retained fund flow record. The amount of the oldest record is excluded,
+// as it was received before the start of the window.
+func (k Keeper) GetConsumerRewardsInflow(ctx sdk.Context, consumerId string) (sdk.Coins, time.Duration) {
+	records := k.GetRecentFundFlowRecords(ctx, 0)
+	if len(records) == 0 {
+		return sdk.NewCoins(), 0
+	}
+
+	// the records are returned most recent first
+	oldest := len(records) - 1
+	inflow := sdk.NewCoins()
+	for _, record := range records[:oldest] {
+		if record.ConsumerId == consumerId && record.Type == types.FUND_FLOW_TYPE_CONSUMER_REWARDS {
+			inflow = inflow.Add(record.Amount...)
+		}
+	}
+
+	window := ctx.BlockTime().Sub(records[oldest].Time)
+	if window < 0 {
+		window = 0
+	}
+	return inflow, window
+}
+
+// EstimateConsumerRewards returns the estimated annual ICS rewards of a validator for the given consumer chain.
+// The rewards received from the consumer chain over the observation window are extrapolated to a year,
+// and are then split the same way as in AllocateConsumerRewards, i.e., the community tax is deducted, the
+// remainder is allocated based on the power share of the validator, and the commission of the validator
+// is deducted from the rewards of its delegators. No rewards are estimated if the observation window
+// is shorter than minRewardEstimateWindow.
+//
+// Note that the power share of a validator that is not yet eligible for rewards is computed as if it was eligible.
+func (k Keeper) EstimateConsumerRewards(
+	ctx sdk.Context,
+	consumerId string,
+	providerAddr types.ProviderConsAddress,
+) (types.ConsumerRewardEstimate, error) {
+	val, err := k.stakingKeeper.GetValidatorByConsAddr(ctx, providerAddr.ToSdkConsAddr())
+	if err != nil {
+		return types.ConsumerRewardEstimate{}, errorsmod.Wrapf(err, "unknown validator: %s", providerAddr.String())
+	}
+
+	communityTax, err := k.distributionKeeper.GetCommunityTax(ctx)
+	if err != nil {
+		return types.ConsumerRewardEstimate{}, errorsmod.Wrap(err, "cannot get community tax")
+	}
+
+	estimate := types.ConsumerRewardEstimate{
+		ConsumerId:             consumerId,
+		ProviderAddress:        providerAddr.String(),
+		AnnualConsumerRewards:  sdk.NewDecCoins(),
+		PowerShare:             math.LegacyZeroDec(),
+		CommissionRate:         val.Commission.Rate,
+		AnnualValidatorRewards: sdk.NewDecCoins(),
+		AnnualCommission:       sdk.NewDecCoins(),
+		AnnualDelegatorRewards: sdk.NewDecCoins(),
+		DelegatorRewardRate:    sdk.NewDecCoins(),
+	}
+	if rate, found := k.GetConsumerCommissionRate(ctx, consumerId, providerAddr); found {
+		estimate.CommissionRate = rate
+	}
+
+	inflow, window := k.GetConsumerRewardsInflow(ctx, consumerId)
+	estimate.ObservationWindow = window
+	if window >= minRewardEstimateWindow {
+		periods := math.LegacyNewDec(int64(rewardEstimatePeriod)).QuoInt64(int64(window))
+		estimate.AnnualConsumerRewards = sdk.NewDecCoinsFromCoins(inflow...).MulDecTruncate(periods)
+	}
+
+	consumerVal, found := k.GetConsumerValidator(ctx, consumerId, providerAddr)
+	if !found || consumerVal.Power <= 0 {
+		// the validator does not receive rewards from the consumer chain
+		return estimate, nil
+	}
+	estimate.Eligible = k.IsEligibleForConsumerRewards(ctx, consumerVal.JoinHeight)
+
+	// the total power only includes the power of the eligible validators
+	totalPower := k.ComputeConsumerTotalVotingPower(ctx, consumerId)
+	if !estimate.Eligible {
+		totalPower += consumerVal.Power
+	}
+	estimate.PowerShare = math.LegacyNewDec(consumerVal.Power).QuoInt64(totalPower)
+
+	validatorsRewards := estimate.AnnualConsumerRewards.MulDecTruncate(math.LegacyOneDec().Sub(communityTax))
+	estimate.AnnualValidatorRewards = validatorsRewards.MulDecTruncate(estimate.PowerShare)
+	estimate.AnnualCommission = estimate.AnnualValidatorRewards.MulDecTruncate(estimate.CommissionRate)
+	estimate.AnnualDelegatorRewards = estimate.AnnualValidatorRewards.Sub(estimate.AnnualCommission)
+	if val.Tokens.IsPositive() {
+		estimate.DelegatorRewardRate = estimate.AnnualDelegatorRewards.QuoDecTruncate(math.LegacyNewDecFromInt(val.Tokens))
+	}
+
+	return estimate, nil
+}
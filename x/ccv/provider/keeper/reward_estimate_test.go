@@ -0,0 +1,123 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
+	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+)
+
+// TestEstimateConsumerRewards tests that the rewards received from a consumer chain are extrapolated to a year
+// and split based on the community tax, the power share of a validator and its commission rate
+func TestEstimateConsumerRewards(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	params := providertypes.DefaultParams()
+	params.BlocksPerEpoch = 1
+	params.NumberOfEpochsToStartReceivingRewards = 10
+	providerKeeper.SetParams(ctx, params)
+
+	consumerId := "0"
+
+	// validators A and B are eligible for rewards, validator C joined the consumer validator set recently,
+	// and validator D is not a consumer validator
+	powers := []int64{30, 10, 60, 20}
+	joinHeights := []int64{0, 0, 95, 0}
+	providerAddrs := []providertypes.ProviderConsAddress{}
+	for i, power := range powers {
+		val := createStakingValidator(ctx, mocks, power, i)
+		val.Tokens = math.NewInt(1000 * int64(i+1))
+		val.Commission.Rate = math.LegacyMustNewDecFromStr("0.05")
+		consAddr, err := val.GetConsAddr()
+		require.NoError(t, err)
+		providerAddr := providertypes.NewProviderConsAddress(consAddr)
+		providerAddrs = append(providerAddrs, providerAddr)
+		mocks.MockStakingKeeper.EXPECT().GetValidatorByConsAddr(gomock.Any(), consAddr).Return(val, nil).AnyTimes()
+
+		if i < 3 {
+			require.NoError(t, providerKeeper.SetConsumerValidator(ctx, consumerId, providertypes.ConsensusValidator{
+				ProviderConsAddr: consAddr,
+				Power:            power,
+				JoinHeight:       joinHeights[i],
+			}))
+		}
+	}
+	mocks.MockDistributionKeeper.EXPECT().GetCommunityTax(gomock.Any()).Return(math.LegacyMustNewDecFromStr("0.02"), nil).AnyTimes()
+
+	// validator A charges a custom commission rate on the consumer chain
+	require.NoError(t, providerKeeper.SetConsumerCommissionRate(ctx, consumerId, providerAddrs[0], math.LegacyMustNewDecFromStr("0.1")))
+
+	// no rewards are received yet
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockHeight(100).WithBlockTime(start)
+	estimate, err := providerKeeper.EstimateConsumerRewards(ctx, consumerId, providerAddrs[0])
+	require.NoError(t, err)
+	require.Equal(t, time.Duration(0), estimate.ObservationWindow)
+	require.True(t, estimate.AnnualConsumerRewards.IsZero())
+	require.True(t, estimate.AnnualValidatorRewards.IsZero())
+	require.Equal(t, math.LegacyMustNewDecFromStr("0.75"), estimate.PowerShare)
+	require.True(t, estimate.Eligible)
+
+	// the oldest record starts the observation window and its amount, received before the window, is excluded;
+	// no rewards are estimated while the observation window is shorter than a day
+	providerKeeper.AppendFundFlowRecord(ctx, providertypes.FUND_FLOW_TYPE_CONSUMER_REWARDS, providertypes.ConsumerRewardsPool, consumerId,
+		sdk.NewCoins(sdk.NewInt64Coin("stake", 50)))
+	ctx = ctx.WithBlockTime(start.Add(time.Hour))
+	estimate, err = providerKeeper.EstimateConsumerRewards(ctx, consumerId, providerAddrs[0])
+	require.NoError(t, err)
+	require.Equal(t, time.Hour, estimate.ObservationWindow)
+	require.True(t, estimate.AnnualConsumerRewards.IsZero())
+	require.True(t, estimate.AnnualValidatorRewards.IsZero())
+
+	// 100stake are received from the consumer chain over 73 days, i.e., a fifth of a year;
+	// the rewards of other consumer chains are ignored
+	ctx = ctx.WithBlockTime(start.Add(10 * 24 * time.Hour))
+	providerKeeper.AppendFundFlowRecord(ctx, providertypes.FUND_FLOW_TYPE_CONSUMER_REWARDS, providertypes.ConsumerRewardsPool, consumerId,
+		sdk.NewCoins(sdk.NewInt64Coin("stake", 100)))
+	providerKeeper.AppendFundFlowRecord(ctx, providertypes.FUND_FLOW_TYPE_CONSUMER_REWARDS, providertypes.ConsumerRewardsPool, "1",
+		sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)))
+	ctx = ctx.WithBlockTime(start.Add(73 * 24 * time.Hour))
+
+	estimate, err = providerKeeper.EstimateConsumerRewards(ctx, consumerId, providerAddrs[0])
+	require.NoError(t, err)
+	require.Equal(t, providertypes.ConsumerRewardEstimate{
+		ConsumerId:             consumerId,
+		ProviderAddress:        providerAddrs[0].String(),
+		ObservationWindow:      73 * 24 * time.Hour,
+		AnnualConsumerRewards:  sdk.NewDecCoins(sdk.NewInt64DecCoin("stake", 500)),
+		PowerShare:             math.LegacyMustNewDecFromStr("0.75"),
+		CommissionRate:         math.LegacyMustNewDecFromStr("0.1"),
+		AnnualValidatorRewards: sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", math.LegacyMustNewDecFromStr("367.5"))),
+		AnnualCommission:       sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", math.LegacyMustNewDecFromStr("36.75"))),
+		AnnualDelegatorRewards: sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", math.LegacyMustNewDecFromStr("330.75"))),
+		DelegatorRewardRate:    sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", math.LegacyMustNewDecFromStr("0.33075"))),
+		Eligible:               true,
+	}, estimate)
+
+	// the power share of a validator that is not yet eligible is computed as if it was eligible
+	estimate, err = providerKeeper.EstimateConsumerRewards(ctx, consumerId, providerAddrs[2])
+	require.NoError(t, err)
+	require.False(t, estimate.Eligible)
+	require.Equal(t, math.LegacyMustNewDecFromStr("0.6"), estimate.PowerShare)
+	require.Equal(t, math.LegacyMustNewDecFromStr("0.05"), estimate.CommissionRate)
+	require.Equal(t, sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", math.LegacyMustNewDecFromStr("294"))), estimate.AnnualValidatorRewards)
+	require.Equal(t, sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", math.LegacyMustNewDecFromStr("279.3"))), estimate.AnnualDelegatorRewards)
+	require.Equal(t, sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", math.LegacyMustNewDecFromStr("0.0931"))), estimate.DelegatorRewardRate)
+
+	// a validator that is not a consumer validator receives no rewards
+	estimate, err = providerKeeper.EstimateConsumerRewards(ctx, consumerId, providerAddrs[3])
+	require.NoError(t, err)
+	require.False(t, estimate.Eligible)
+	require.True(t, estimate.PowerShare.IsZero())
+	require.Equal(t, sdk.NewDecCoins(sdk.NewInt64DecCoin("stake", 500)), estimate.AnnualConsumerRewards)
+	require.True(t, estimate.AnnualValidatorRewards.IsZero())
+}
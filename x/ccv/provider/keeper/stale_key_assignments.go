@@ -0,0 +1,235 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// GetKeyAssignmentObservation returns whether the assigned consumer key with consensus address `consumerAddr`
+// was observed in the heartbeats of the consumer chain with `consumerId`
+func (k Keeper) GetKeyAssignmentObservation(
+	ctx sdk.Context,
+	consumerId string,
+	consumerAddr types.ConsumerConsAddress,
+) (types.KeyAssignmentObservation, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyAssignmentObservationKey(consumerId, consumerAddr))
+	if bz == nil {
+		return types.KeyAssignmentObservation{}, false
+	}
+	var observation types.KeyAssignmentObservation
+	if err := observation.Unmarshal(bz); err != nil {
+		// An error here would indicate something is very wrong,
+		// the observation is assumed to be correctly serialized in SetKeyAssignmentObservation.
+		panic(fmt.Errorf("failed to unmarshal key assignment observation for consumer id (%s): %w", consumerId, err))
+	}
+	return observation, true
+}
+
+// SetKeyAssignmentObservation sets whether the assigned consumer key with consensus address `consumerAddr`
+// was observed in the heartbeats of the consumer chain with `consumerId`
+func (k Keeper) SetKeyAssignmentObservation(
+	ctx sdk.Context,
+	consumerId string,
+	consumerAddr types.ConsumerConsAddress,
+	observation types.KeyAssignmentObservation,
+) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := observation.Marshal()
+	if err != nil {
+		// An error here would indicate something is very wrong,
+		// the observation is obtained from the store or created in this file.
+		panic(fmt.Errorf("failed to marshal key assignment observation for consumer id (%s): %w", consumerId, err))
+	}
+	store.Set(types.KeyAssignmentObservationKey(consumerId, consumerAddr), bz)
+}
+
+// DeleteKeyAssignmentObservation deletes the observation of the assigned consumer key with consensus address `consumerAddr`
+func (k Keeper) DeleteKeyAssignmentObservation(ctx sdk.Context, consumerId string, consumerAddr types.ConsumerConsAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyAssignmentObservationKey(consumerId, consumerAddr))
+}
+
+// GetAllKeyAssignmentObservations returns the consensus addresses of the tracked assigned consumer keys
+// of the consumer chain with `consumerId` and their observations, ordered by consensus address
+func (k Keeper) GetAllKeyAssignmentObservations(
+	ctx sdk.Context,
+	consumerId string,
+) (consumerAddrs []types.ConsumerConsAddress, observations []types.KeyAssignmentObservation) {
+	store := ctx.KVStore(k.storeKey)
+	prefix := types.KeyAssignmentObservationKeyPrefix()
+	iterator := storetypes.KVStorePrefixIterator(store, types.StringIdWithLenKey(prefix, consumerId))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		_, consumerAddr, err := types.ParseStringIdAndConsAddrKey(prefix, iterator.Key())
+		if err != nil {
+			// An error here would indicate something is very wrong,
+			// store keys are assumed to be correctly serialized in SetKeyAssignmentObservation.
+			panic(fmt.Errorf("failed to parse consumer address: %w", err))
+		}
+		var observation types.KeyAssignmentObservation
+		if err := observation.Unmarshal(iterator.Value()); err != nil {
+			// An error here would indicate something is very wrong,
+			// the observation is assumed to be correctly serialized in SetKeyAssignmentObservation.
+			panic(fmt.Errorf("failed to unmarshal key assignment observation for consumer id (%s): %w", consumerId, err))
+		}
+		consumerAddrs = append(consumerAddrs, types.NewConsumerConsAddress(consumerAddr))
+		observations = append(observations, observation)
+	}
+	return consumerAddrs, observations
+}
+
+// DeleteKeyAssignmentObservations deletes the observations of all the assigned consumer keys of the consumer chain with `consumerId`
+func (k Keeper) DeleteKeyAssignmentObservations(ctx sdk.Context, consumerId string) {
+	consumerAddrs, _ := k.GetAllKeyAssignmentObservations(ctx, consumerId)
+	for _, consumerAddr := range consumerAddrs {
+		k.DeleteKeyAssignmentObservation(ctx, consumerId, consumerAddr)
+	}
+}
+
+// isStaleKeyAssignment returns whether an assigned consumer key with the given observation is stale,
+// i.e., it was never observed in a heartbeat although it was tracked for the StaleKeyAssignmentEpochs param
+func (k Keeper) isStaleKeyAssignment(ctx sdk.Context, observation types.KeyAssignmentObservation) bool {
+	epochs := k.GetStaleKeyAssignmentEpochs(ctx)
+	if epochs <= 0 || observation.LastObservedHeight != 0 {
+		return false
+	}
+	return ctx.BlockHeight()-observation.TrackedSinceHeight >= epochs*k.GetEpochLengthInBlocks(ctx)
+}
+
+// RecordConsumerHeartbeat records that the assigned consumer keys with the consensus addresses
+// `validatorAddresses` were observed in a heartbeat of the consumer chain with `consumerId`.
+// The addresses that do not belong to assigned consumer keys are ignored.
+func (k Keeper) RecordConsumerHeartbeat(ctx sdk.Context, consumerId string, validatorAddresses [][]byte) {
+	if k.GetStaleKeyAssignmentEpochs(ctx) <= 0 {
+		return
+	}
+	for _, addr := range validatorAddresses {
+		consumerAddr := types.NewConsumerConsAddress(addr)
+		if _, found := k.GetValidatorByConsumerAddr(ctx, consumerId, consumerAddr); !found {
+			continue
+		}
+		observation, found := k.GetKeyAssignmentObservation(ctx, consumerId, consumerAddr)
+		if !found {
+			observation.TrackedSinceHeight = ctx.BlockHeight()
+		}
+		observation.LastObservedHeight = ctx.BlockHeight()
+		k.SetKeyAssignmentObservation(ctx, consumerId, consumerAddr, observation)
+	}
+}
+
+// GetStaleKeyAssignments returns the stale assigned consumer keys of the consumer chain with `consumerId`,
+// i.e., the keys used in its validator set that were never observed in its heartbeats for the StaleKeyAssignmentEpochs param
+func (k Keeper) GetStaleKeyAssignments(ctx sdk.Context, consumerId string) []types.StaleKeyAssignment {
+	staleKeyAssignments := []types.StaleKeyAssignment{}
+	consumerAddrs, observations := k.GetAllKeyAssignmentObservations(ctx, consumerId)
+	for i, observation := range observations {
+		if !k.isStaleKeyAssignment(ctx, observation) {
+			continue
+		}
+		providerAddr, found := k.GetValidatorByConsumerAddr(ctx, consumerId, consumerAddrs[i])
+		if !found {
+			continue
+		}
+		staleKeyAssignments = append(staleKeyAssignments, types.StaleKeyAssignment{
+			ProviderAddress:    providerAddr.String(),
+			ConsumerAddress:    consumerAddrs[i].String(),
+			TrackedSinceHeight: observation.TrackedSinceHeight,
+		})
+	}
+	return staleKeyAssignments
+}
+
+// BeginBlockCheckStaleKeyAssignments tracks, at the beginning of every epoch, the assigned consumer keys used in the
+// validator sets of the launched consumer chains and emits a warning event for every key that is stale, i.e., that was
+// never observed in a heartbeat of its consumer chain for the StaleKeyAssignmentEpochs param. This allows operators
+// to notice mis-assigned keys before the validators are jailed for downtime.
+func (k Keeper) BeginBlockCheckStaleKeyAssignments(ctx sdk.Context) {
+	if k.GetStaleKeyAssignmentEpochs(ctx) <= 0 || !k.IsEpochStart(ctx) {
+		return
+	}
+
+	for _, consumerId := range k.GetAllConsumersWithIBCClients(ctx) {
+		if !k.IsConsumerLaunched(ctx, consumerId) {
+			continue
+		}
+		if err := k.checkStaleKeyAssignments(ctx, consumerId); err != nil {
+			k.Logger(ctx).Error("cannot check stale key assignments",
+				"consumerId", consumerId,
+				"error", err.Error(),
+			)
+		}
+	}
+}
+
+// checkStaleKeyAssignments tracks the assigned consumer keys used in the validator set of the consumer chain
+// with `consumerId`, stops tracking the keys that are not used anymore, and warns about the stale keys
+func (k Keeper) checkStaleKeyAssignments(ctx sdk.Context, consumerId string) error {
+	valSet, err := k.GetConsumerValSet(ctx, consumerId)
+	if err != nil {
+		return err
+	}
+
+	// the consumer keys used in the validator set of the consumer chain that were assigned by the validators
+	inUse := map[string]types.ProviderConsAddress{}
+	var consumerAddrs []types.ConsumerConsAddress
+	for _, validator := range valSet {
+		providerAddr := types.NewProviderConsAddress(validator.ProviderConsAddr)
+		if _, found := k.GetValidatorConsumerPubKey(ctx, consumerId, providerAddr); !found {
+			continue
+		}
+		addr, err := ccv.TMCryptoPublicKeyToConsAddr(*validator.PublicKey)
+		if err != nil {
+			return err
+		}
+		consumerAddr := types.NewConsumerConsAddress(addr)
+		inUse[consumerAddr.String()] = providerAddr
+		consumerAddrs = append(consumerAddrs, consumerAddr)
+	}
+
+	// stop tracking the keys that were replaced or whose validators left the validator set
+	trackedAddrs, _ := k.GetAllKeyAssignmentObservations(ctx, consumerId)
+	for _, consumerAddr := range trackedAddrs {
+		if _, found := inUse[consumerAddr.String()]; !found {
+			k.DeleteKeyAssignmentObservation(ctx, consumerId, consumerAddr)
+		}
+	}
+
+	for _, consumerAddr := range consumerAddrs {
+		observation, found := k.GetKeyAssignmentObservation(ctx, consumerId, consumerAddr)
+		if !found {
+			observation = types.KeyAssignmentObservation{TrackedSinceHeight: ctx.BlockHeight()}
+			k.SetKeyAssignmentObservation(ctx, consumerId, consumerAddr, observation)
+		}
+		if !k.isStaleKeyAssignment(ctx, observation) {
+			continue
+		}
+
+		providerAddr := inUse[consumerAddr.String()]
+		k.Logger(ctx).Error("assigned consumer key was never observed in a heartbeat of the consumer chain",
+			"consumerId", consumerId,
+			"providerAddr", providerAddr.String(),
+			"consumerAddr", consumerAddr.String(),
+			"trackedSinceHeight", observation.TrackedSinceHeight,
+		)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeStaleKeyAssignment,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				sdk.NewAttribute(types.AttributeConsumerId, consumerId),
+				sdk.NewAttribute(types.AttributeProviderConsensusAddress, providerAddr.String()),
+				sdk.NewAttribute(types.AttributeConsumerConsensusAddress, consumerAddr.String()),
+				sdk.NewAttribute(types.AttributeTrackedSinceHeight, strconv.FormatInt(observation.TrackedSinceHeight, 10)),
+			),
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,123 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	cryptotestutil "github.com/cosmos/interchain-security/v7/testutil/crypto"
+	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
+	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+)
+
+// TestStaleKeyAssignments tests that the assigned consumer keys that are used in the validator set
+// of a consumer chain, but never observed in its heartbeats, are reported as stale
+func TestStaleKeyAssignments(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	params := providertypes.DefaultParams()
+	params.BlocksPerEpoch = 10
+	params.StaleKeyAssignmentEpochs = 2
+	providerKeeper.SetParams(ctx, params)
+
+	consumerId := "0"
+	providerKeeper.SetConsumerPhase(ctx, consumerId, providertypes.CONSUMER_PHASE_LAUNCHED)
+	providerKeeper.SetConsumerClientId(ctx, consumerId, "clientId")
+
+	// validators 0 and 1 assigned consumer keys, validator 2 uses its provider key
+	var valSet []providertypes.ConsensusValidator
+	var consumerIdentities []*cryptotestutil.CryptoIdentity
+	for i := 0; i < 3; i++ {
+		validator := cryptotestutil.NewCryptoIdentityFromIntSeed(i)
+		pubKey := validator.TMProtoCryptoPublicKey()
+		if i < 2 {
+			consumerIdentity := cryptotestutil.NewCryptoIdentityFromIntSeed(100 + i)
+			pubKey = consumerIdentity.TMProtoCryptoPublicKey()
+			providerKeeper.SetValidatorConsumerPubKey(ctx, consumerId, validator.ProviderConsAddress(), pubKey)
+			providerKeeper.SetValidatorByConsumerAddr(ctx, consumerId, consumerIdentity.ConsumerConsAddress(), validator.ProviderConsAddress())
+			consumerIdentities = append(consumerIdentities, consumerIdentity)
+		}
+		valSet = append(valSet, providertypes.ConsensusValidator{
+			ProviderConsAddr: validator.SDKValConsAddress(),
+			Power:            1,
+			PublicKey:        &pubKey,
+		})
+	}
+	require.NoError(t, providerKeeper.SetConsumerValSet(ctx, consumerId, valSet))
+
+	// the assigned keys are tracked from the beginning of the next epoch
+	ctx = ctx.WithBlockHeight(10).WithEventManager(sdk.NewEventManager())
+	providerKeeper.BeginBlockCheckStaleKeyAssignments(ctx)
+	consumerAddrs, observations := providerKeeper.GetAllKeyAssignmentObservations(ctx, consumerId)
+	require.Len(t, consumerAddrs, 2)
+	for _, observation := range observations {
+		require.Equal(t, providertypes.KeyAssignmentObservation{TrackedSinceHeight: 10}, observation)
+	}
+	require.Empty(t, ctx.EventManager().Events())
+
+	// only the addresses of assigned keys are recorded from heartbeats
+	ctx = ctx.WithBlockHeight(15)
+	providerKeeper.RecordConsumerHeartbeat(ctx, consumerId, [][]byte{
+		consumerIdentities[0].SDKValConsAddress(),
+		cryptotestutil.NewCryptoIdentityFromIntSeed(2).SDKValConsAddress(),
+		cryptotestutil.NewCryptoIdentityFromIntSeed(200).SDKValConsAddress(),
+	})
+	observation, found := providerKeeper.GetKeyAssignmentObservation(ctx, consumerId, consumerIdentities[0].ConsumerConsAddress())
+	require.True(t, found)
+	require.Equal(t, providertypes.KeyAssignmentObservation{TrackedSinceHeight: 10, LastObservedHeight: 15}, observation)
+	consumerAddrs, _ = providerKeeper.GetAllKeyAssignmentObservations(ctx, consumerId)
+	require.Len(t, consumerAddrs, 2)
+
+	// the key of validator 1 is stale after two epochs
+	ctx = ctx.WithBlockHeight(25)
+	require.Empty(t, providerKeeper.GetStaleKeyAssignments(ctx, consumerId))
+	ctx = ctx.WithBlockHeight(30).WithEventManager(sdk.NewEventManager())
+	providerKeeper.BeginBlockCheckStaleKeyAssignments(ctx)
+	providerAddr := cryptotestutil.NewCryptoIdentityFromIntSeed(1).ProviderConsAddress()
+	consumerAddr := consumerIdentities[1].ConsumerConsAddress()
+	expected := []providertypes.StaleKeyAssignment{{
+		ProviderAddress:    providerAddr.String(),
+		ConsumerAddress:    consumerAddr.String(),
+		TrackedSinceHeight: 10,
+	}}
+	require.Equal(t, expected, providerKeeper.GetStaleKeyAssignments(ctx, consumerId))
+	resp, err := providerKeeper.QueryStaleKeyAssignments(ctx, &providertypes.QueryStaleKeyAssignmentsRequest{ConsumerId: consumerId})
+	require.NoError(t, err)
+	require.Equal(t, expected, resp.StaleKeyAssignments)
+
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, providertypes.EventTypeStaleKeyAssignment, events[0].Type)
+	consumerAddrAttr, found := events[0].GetAttribute(providertypes.AttributeConsumerConsensusAddress)
+	require.True(t, found)
+	require.Equal(t, expected[0].ConsumerAddress, consumerAddrAttr.Value)
+
+	// the warning is repeated in every epoch
+	ctx = ctx.WithBlockHeight(40).WithEventManager(sdk.NewEventManager())
+	providerKeeper.BeginBlockCheckStaleKeyAssignments(ctx)
+	require.Len(t, ctx.EventManager().Events(), 1)
+
+	// a key that is not used anymore is not tracked anymore
+	require.NoError(t, providerKeeper.SetConsumerValSet(ctx, consumerId, []providertypes.ConsensusValidator{valSet[0], valSet[2]}))
+	ctx = ctx.WithBlockHeight(50).WithEventManager(sdk.NewEventManager())
+	providerKeeper.BeginBlockCheckStaleKeyAssignments(ctx)
+	require.Empty(t, ctx.EventManager().Events())
+	require.Empty(t, providerKeeper.GetStaleKeyAssignments(ctx, consumerId))
+	consumerAddrs, _ = providerKeeper.GetAllKeyAssignmentObservations(ctx, consumerId)
+	require.Equal(t, []providertypes.ConsumerConsAddress{consumerIdentities[0].ConsumerConsAddress()}, consumerAddrs)
+
+	// no key is stale if the detection is disabled
+	params.StaleKeyAssignmentEpochs = 0
+	providerKeeper.SetParams(ctx, params)
+	require.NoError(t, providerKeeper.SetConsumerValSet(ctx, consumerId, valSet))
+	providerKeeper.SetKeyAssignmentObservation(ctx, consumerId, consumerIdentities[1].ConsumerConsAddress(),
+		providertypes.KeyAssignmentObservation{TrackedSinceHeight: 10})
+	require.Empty(t, providerKeeper.GetStaleKeyAssignments(ctx, consumerId))
+
+	// the observations are deleted with the consumer chain
+	providerKeeper.DeleteKeyAssignmentObservations(ctx, consumerId)
+	consumerAddrs, _ = providerKeeper.GetAllKeyAssignmentObservations(ctx, consumerId)
+	require.Empty(t, consumerAddrs)
+}
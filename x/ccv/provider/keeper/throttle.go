@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
 
 	sdktypes "github.com/cosmos/cosmos-sdk/types"
 
@@ -104,7 +105,12 @@ func (k Keeper) ReplenishSlashMeter(ctx sdktypes.Context) {
 // The slash meter must be less than or equal to the allowance for this block, before any slash
 // packet handling logic can be executed.
 func (k Keeper) GetSlashMeterAllowance(ctx sdktypes.Context) math.Int {
-	strFrac := k.GetSlashMeterReplenishFraction(ctx)
+	return k.computeSlashMeterAllowance(ctx, k.GetSlashMeterReplenishFraction(ctx))
+}
+
+// computeSlashMeterAllowance returns the allowance of a slash meter whose replenish fraction is `strFrac`,
+// considering the current total voting power
+func (k Keeper) computeSlashMeterAllowance(ctx sdktypes.Context, strFrac string) math.Int {
 	// MustNewDecFromStr should not panic, since the (string representation) of the slash meter replenish fraction
 	// is validated in ValidateGenesis and anytime the param is mutated.
 	decFrac := math.LegacyMustNewDecFromStr(strFrac)
@@ -152,6 +158,13 @@ func (k Keeper) GetSlashMeter(ctx sdktypes.Context) math.Int {
 //
 // Note: the value of this int should always be in the range of tendermint's [-MaxTotalVotingPower, MaxTotalVotingPower]
 func (k Keeper) SetSlashMeter(ctx sdktypes.Context, value math.Int) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(providertypes.SlashMeterKey(), mustMarshalSlashMeter(value))
+}
+
+// mustMarshalSlashMeter returns the bytes of the slash meter value `value`,
+// panicking if the value breaks the invariants of a slash meter
+func mustMarshalSlashMeter(value math.Int) []byte {
 	// TODO: remove these invariant panics once https://github.com/cosmos/interchain-security/issues/534 is solved.
 
 	// The following panics are included since they are invariants for slash meter value.
@@ -166,13 +179,12 @@ func (k Keeper) SetSlashMeter(ctx sdktypes.Context, value math.Int) {
 	if value.LT(math.NewInt(-tmtypes.MaxTotalVotingPower)) {
 		panic("slash meter value cannot be less than negative tendermint's MaxTotalVotingPower")
 	}
-	store := ctx.KVStore(k.storeKey)
 	bz, err := value.Marshal()
 	if err != nil {
 		// A returned error for marshaling an int would indicate something is very wrong.
 		panic(fmt.Sprintf("failed to marshal slash meter: %v", err))
 	}
-	store.Set(providertypes.SlashMeterKey(), bz)
+	return bz
 }
 
 // GetSlashMeterReplenishTimeCandidate returns the next UTC time the slash meter could potentially be replenished.
@@ -206,3 +218,81 @@ func (k Keeper) SetSlashMeterReplenishTimeCandidate(ctx sdktypes.Context) {
 	timeToStore := ctx.BlockTime().UTC().Add(k.GetSlashMeterReplenishPeriod(ctx))
 	store.Set(providertypes.SlashMeterReplenishTimeCandidateKey(), sdktypes.FormatTimeBytes(timeToStore))
 }
+
+// GetBouncedSlashPacket returns the slash packet of the consumer chain with `consumerId`
+// that was bounced and is pending a retry
+func (k Keeper) GetBouncedSlashPacket(ctx sdktypes.Context, consumerId string) (providertypes.BouncedSlashPacket, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(providertypes.ConsumerIdToBouncedSlashPacketKey(consumerId))
+	if bz == nil {
+		return providertypes.BouncedSlashPacket{}, false
+	}
+	var packet providertypes.BouncedSlashPacket
+	if err := packet.Unmarshal(bz); err != nil {
+		// An error here would indicate something is very wrong,
+		// the packet is assumed to be correctly serialized in SetBouncedSlashPacket.
+		panic(fmt.Errorf("failed to unmarshal bounced slash packet for consumer id (%s): %w", consumerId, err))
+	}
+	return packet, true
+}
+
+// SetBouncedSlashPacket sets the slash packet of the consumer chain with `consumerId`
+// that was bounced and is pending a retry
+func (k Keeper) SetBouncedSlashPacket(ctx sdktypes.Context, consumerId string, packet providertypes.BouncedSlashPacket) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := packet.Marshal()
+	if err != nil {
+		// An error here would indicate something is very wrong,
+		// the packet is obtained from a validated slash packet.
+		panic(fmt.Errorf("failed to marshal bounced slash packet for consumer id (%s): %w", consumerId, err))
+	}
+	store.Set(providertypes.ConsumerIdToBouncedSlashPacketKey(consumerId), bz)
+}
+
+// DeleteBouncedSlashPacket deletes the bounced slash packet of the consumer chain with `consumerId`
+func (k Keeper) DeleteBouncedSlashPacket(ctx sdktypes.Context, consumerId string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(providertypes.ConsumerIdToBouncedSlashPacketKey(consumerId))
+}
+
+// GetAllBouncedSlashPackets returns the bounced slash packets pending a retry of all the consumer chains
+func (k Keeper) GetAllBouncedSlashPackets(ctx sdktypes.Context) []providertypes.BouncedSlashPacket {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{providertypes.ConsumerIdToBouncedSlashPacketKeyPrefix()})
+	defer iterator.Close()
+
+	packets := []providertypes.BouncedSlashPacket{}
+	for ; iterator.Valid(); iterator.Next() {
+		var packet providertypes.BouncedSlashPacket
+		if err := packet.Unmarshal(iterator.Value()); err != nil {
+			// An error here would indicate something is very wrong,
+			// the packet is assumed to be correctly serialized in SetBouncedSlashPacket.
+			panic(fmt.Errorf("failed to unmarshal bounced slash packet: %w", err))
+		}
+		packets = append(packets, packet)
+	}
+	return packets
+}
+
+// recordBouncedSlashPacket records the slash packet of the consumer chain with `consumerId` that was bounced.
+// Since the consumer chain retries a bounced slash packet before sending other packets, a consumer chain
+// has at most one bounced slash packet, whose first receive time is kept across the retries.
+func (k Keeper) recordBouncedSlashPacket(ctx sdktypes.Context, consumerId string,
+	consumerConsAddr providertypes.ConsumerConsAddress, providerConsAddr providertypes.ProviderConsAddress, valsetUpdateId uint64,
+) {
+	packet := providertypes.BouncedSlashPacket{
+		ConsumerId:          consumerId,
+		ConsumerConsAddress: consumerConsAddr.String(),
+		ProviderConsAddress: providerConsAddr.String(),
+		ValsetUpdateId:      valsetUpdateId,
+		FirstReceiveTime:    ctx.BlockTime(),
+		LastReceiveTime:     ctx.BlockTime(),
+		Bounces:             1,
+	}
+	if prev, found := k.GetBouncedSlashPacket(ctx, consumerId); found &&
+		prev.ConsumerConsAddress == packet.ConsumerConsAddress && prev.ValsetUpdateId == valsetUpdateId {
+		packet.FirstReceiveTime = prev.FirstReceiveTime
+		packet.Bounces = prev.Bounces + 1
+	}
+	k.SetBouncedSlashPacket(ctx, consumerId, packet)
+}
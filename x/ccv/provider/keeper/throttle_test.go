@@ -536,3 +536,72 @@ func TestSlashMeterReplenishTimeCandidate(t *testing.T) {
 		require.Equal(t, tc.blockTime.Add(tc.replenishPeriod).UTC(), gotTime)
 	}
 }
+
+// TestConsumerSlashMeterReplenishment tests that a consumer chain with a slash meter replenish fraction
+// has a slash meter of its own, replenished independently of the global slash meter
+func TestConsumerSlashMeterReplenishment(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(
+		t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	now := time.Now().UTC()
+	ctx = ctx.WithBlockTime(now)
+	providerKeeper.SetParams(ctx, providertypes.DefaultParams())
+	mocks.MockStakingKeeper.EXPECT().GetLastTotalPower(gomock.Any()).Return(math.NewInt(1000), nil).AnyTimes()
+	providerKeeper.InitializeSlashMeter(ctx)
+
+	// a consumer chain without a slash meter replenish fraction shares the global slash meter
+	err := providerKeeper.SetConsumerThrottlingParameters(ctx, "0", providertypes.ThrottlingParameters{})
+	require.NoError(t, err)
+	require.False(t, providerKeeper.HasConsumerSlashMeter(ctx, "0"))
+	require.Equal(t, providerKeeper.GetSlashMeterAllowance(ctx), providerKeeper.GetConsumerSlashMeterAllowance(ctx, "0"))
+
+	// a consumer chain with a slash meter replenish fraction has a slash meter of its own
+	err = providerKeeper.SetConsumerThrottlingParameters(ctx, "1", providertypes.ThrottlingParameters{
+		SlashMeterReplenishFraction: "0.01",
+		SlashMeterReplenishPeriod:   time.Minute,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{"1"}, providerKeeper.GetAllConsumersWithSlashMeter(ctx))
+	meter, found := providerKeeper.GetConsumerSlashMeter(ctx, "1")
+	require.True(t, found)
+	require.Equal(t, math.NewInt(10), meter)
+	require.Equal(t, now.Add(time.Minute), providerKeeper.GetConsumerSlashMeterReplenishTimeCandidate(ctx, "1"))
+
+	// decrementing the slash meter of the consumer chain leaves the global slash meter unchanged
+	providerKeeper.SetConsumerSlashMeter(ctx, "1", math.NewInt(-15))
+	require.Equal(t, providerKeeper.GetSlashMeterAllowance(ctx), providerKeeper.GetSlashMeter(ctx))
+
+	// the slash meter of the consumer chain is replenished after its own replenish period,
+	// also when replenishing all the slash meters through ReplenishMeter
+	ctx = ctx.WithBlockTime(now.Add(2 * time.Minute))
+	providerKeeper.ReplenishMeter(ctx)
+	meter, _ = providerKeeper.GetConsumerSlashMeter(ctx, "1")
+	require.Equal(t, math.NewInt(-5), meter)
+	require.Equal(t, ctx.BlockTime().Add(time.Minute), providerKeeper.GetConsumerSlashMeterReplenishTimeCandidate(ctx, "1"))
+
+	// the slash meter is capped at its allowance
+	ctx = ctx.WithBlockTime(now.Add(10 * time.Minute))
+	providerKeeper.CheckForConsumerSlashMeterReplenishments(ctx)
+	ctx = ctx.WithBlockTime(now.Add(20 * time.Minute))
+	providerKeeper.CheckForConsumerSlashMeterReplenishments(ctx)
+	meter, _ = providerKeeper.GetConsumerSlashMeter(ctx, "1")
+	require.Equal(t, math.NewInt(10), meter)
+
+	// the throttling state of the consumer chain can be queried
+	resp, err := providerKeeper.QueryConsumerThrottleState(ctx, &providertypes.QueryConsumerThrottleStateRequest{ConsumerId: "1"})
+	require.NoError(t, err)
+	require.False(t, resp.GlobalSlashMeter)
+	require.Equal(t, int64(10), resp.SlashMeter)
+	require.Equal(t, int64(10), resp.SlashMeterAllowance)
+
+	// the consumer chain falls back to the global slash meter once the replenish fraction is removed
+	err = providerKeeper.SetConsumerThrottlingParameters(ctx, "1", providertypes.ThrottlingParameters{})
+	require.NoError(t, err)
+	require.False(t, providerKeeper.HasConsumerSlashMeter(ctx, "1"))
+	require.Empty(t, providerKeeper.GetAllConsumersWithSlashMeter(ctx))
+	resp, err = providerKeeper.QueryConsumerThrottleState(ctx, &providertypes.QueryConsumerThrottleStateRequest{ConsumerId: "1"})
+	require.NoError(t, err)
+	require.True(t, resp.GlobalSlashMeter)
+	require.Equal(t, providerKeeper.GetSlashMeter(ctx).Int64(), resp.SlashMeter)
+}
@@ -0,0 +1,99 @@
+package keeper
+
+import (
+	"bytes"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+)
+
+// GetValidatorFeeExemptionUsage returns the number of fee-exempt messages of the provider validator
+// with operator address `valAddr` in the current epoch
+func (k Keeper) GetValidatorFeeExemptionUsage(ctx sdk.Context, valAddr sdk.ValAddress) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ValidatorFeeExemptionUsageKey(valAddr))
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetValidatorFeeExemptionUsage sets the number of fee-exempt messages of the provider validator
+// with operator address `valAddr` in the current epoch
+func (k Keeper) SetValidatorFeeExemptionUsage(ctx sdk.Context, valAddr sdk.ValAddress, usage uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.ValidatorFeeExemptionUsageKey(valAddr), sdk.Uint64ToBigEndian(usage))
+}
+
+// DeleteAllValidatorFeeExemptionUsages deletes the number of fee-exempt messages of all the provider validators
+func (k Keeper) DeleteAllValidatorFeeExemptionUsages(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{types.ValidatorFeeExemptionUsageKeyPrefix()})
+
+	var keysToDel [][]byte
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		keysToDel = append(keysToDel, iterator.Key())
+	}
+	for _, delKey := range keysToDel {
+		store.Delete(delKey)
+	}
+}
+
+// ConsumeValidatorFeeExemption returns whether the messages `msgs` of a transaction whose fees are paid by
+// `feePayer` are exempt from fees and, if so, counts them against the per-epoch quota of the validator.
+// The messages are exempt if all of them are MsgAssignConsumerKey, MsgAssignConsumerKeyWithProof, MsgOptIn,
+// or MsgOptOut messages of the same bonded validator, the fees are paid by the operator account of the validator,
+// and the validator has not exceeded the ValidatorFeeExemptionsPerEpoch param in the current epoch.
+func (k Keeper) ConsumeValidatorFeeExemption(ctx sdk.Context, feePayer sdk.AccAddress, msgs []sdk.Msg) bool {
+	quota := k.GetValidatorFeeExemptionsPerEpoch(ctx)
+	if quota == 0 || len(msgs) == 0 {
+		return false
+	}
+
+	for _, msg := range msgs {
+		var providerAddr string
+		switch msg := msg.(type) {
+		case *types.MsgAssignConsumerKey:
+			providerAddr = msg.ProviderAddr
+		case *types.MsgAssignConsumerKeyWithProof:
+			providerAddr = msg.ProviderAddr
+		case *types.MsgOptIn:
+			providerAddr = msg.ProviderAddr
+		case *types.MsgOptOut:
+			providerAddr = msg.ProviderAddr
+		default:
+			return false
+		}
+		valAddr, err := k.ValidatorAddressCodec().StringToBytes(providerAddr)
+		if err != nil || !bytes.Equal(valAddr, feePayer) {
+			return false
+		}
+	}
+
+	valAddr := sdk.ValAddress(feePayer)
+	validator, err := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if err != nil || !validator.IsBonded() {
+		return false
+	}
+
+	usage := k.GetValidatorFeeExemptionUsage(ctx, valAddr)
+	if usage+uint64(len(msgs)) > quota {
+		return false
+	}
+	k.SetValidatorFeeExemptionUsage(ctx, valAddr, usage+uint64(len(msgs)))
+
+	return true
+}
+
+// BeginBlockResetValidatorFeeExemptions resets, at the beginning of every epoch,
+// the number of fee-exempt messages of all the provider validators
+func (k Keeper) BeginBlockResetValidatorFeeExemptions(ctx sdk.Context) {
+	if !k.IsEpochStart(ctx) {
+		return
+	}
+	k.DeleteAllValidatorFeeExemptionUsages(ctx)
+}
@@ -0,0 +1,74 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	cryptotestutil "github.com/cosmos/interchain-security/v7/testutil/crypto"
+	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
+	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+)
+
+// TestConsumeValidatorFeeExemption tests that bonded validators are exempt from fees for their
+// key assignment and opt-in messages up to the per-epoch quota
+func TestConsumeValidatorFeeExemption(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	params := providertypes.DefaultParams()
+	params.BlocksPerEpoch = 10
+	params.ValidatorFeeExemptionsPerEpoch = 3
+	providerKeeper.SetParams(ctx, params)
+
+	bonded := cryptotestutil.NewCryptoIdentityFromIntSeed(0)
+	bondedVal := bonded.SDKStakingValidator()
+	bondedVal.Status = stakingtypes.Bonded
+	mocks.MockStakingKeeper.EXPECT().GetValidator(gomock.Any(), bonded.SDKValOpAddress()).Return(bondedVal, nil).AnyTimes()
+
+	unbonded := cryptotestutil.NewCryptoIdentityFromIntSeed(1)
+	unbondedVal := unbonded.SDKStakingValidator()
+	unbondedVal.Status = stakingtypes.Unbonded
+	mocks.MockStakingKeeper.EXPECT().GetValidator(gomock.Any(), unbonded.SDKValOpAddress()).Return(unbondedVal, nil).AnyTimes()
+
+	bondedPayer := sdk.AccAddress(bonded.SDKValOpAddress())
+	optIn := &providertypes.MsgOptIn{ProviderAddr: bonded.SDKValOpAddressString(), ConsumerId: "0"}
+	optOut := &providertypes.MsgOptOut{ProviderAddr: bonded.SDKValOpAddressString(), ConsumerId: "0"}
+	assignKey := &providertypes.MsgAssignConsumerKey{ProviderAddr: bonded.SDKValOpAddressString(), ConsumerId: "0"}
+
+	// messages that are not exempt do not consume the quota
+	require.False(t, providerKeeper.ConsumeValidatorFeeExemption(ctx, bondedPayer, []sdk.Msg{optIn, &banktypes.MsgSend{}}))
+	require.False(t, providerKeeper.ConsumeValidatorFeeExemption(ctx, sdk.AccAddress(unbonded.SDKValOpAddress()), []sdk.Msg{optIn}))
+	unbondedOptIn := &providertypes.MsgOptIn{ProviderAddr: unbonded.SDKValOpAddressString(), ConsumerId: "0"}
+	require.False(t, providerKeeper.ConsumeValidatorFeeExemption(ctx, sdk.AccAddress(unbonded.SDKValOpAddress()), []sdk.Msg{unbondedOptIn}))
+	require.Zero(t, providerKeeper.GetValidatorFeeExemptionUsage(ctx, bonded.SDKValOpAddress()))
+	require.Zero(t, providerKeeper.GetValidatorFeeExemptionUsage(ctx, unbonded.SDKValOpAddress()))
+
+	// the bonded validator is exempt up to the quota
+	require.True(t, providerKeeper.ConsumeValidatorFeeExemption(ctx, bondedPayer, []sdk.Msg{optIn, assignKey}))
+	require.Equal(t, uint64(2), providerKeeper.GetValidatorFeeExemptionUsage(ctx, bonded.SDKValOpAddress()))
+	require.False(t, providerKeeper.ConsumeValidatorFeeExemption(ctx, bondedPayer, []sdk.Msg{optIn, optOut}))
+	require.True(t, providerKeeper.ConsumeValidatorFeeExemption(ctx, bondedPayer, []sdk.Msg{optOut}))
+	require.False(t, providerKeeper.ConsumeValidatorFeeExemption(ctx, bondedPayer, []sdk.Msg{optIn}))
+	require.Equal(t, uint64(3), providerKeeper.GetValidatorFeeExemptionUsage(ctx, bonded.SDKValOpAddress()))
+
+	// the quota is not reset in the middle of an epoch
+	ctx = ctx.WithBlockHeight(15)
+	providerKeeper.BeginBlockResetValidatorFeeExemptions(ctx)
+	require.False(t, providerKeeper.ConsumeValidatorFeeExemption(ctx, bondedPayer, []sdk.Msg{optIn}))
+
+	// the quota is reset at the beginning of the next epoch
+	ctx = ctx.WithBlockHeight(20)
+	providerKeeper.BeginBlockResetValidatorFeeExemptions(ctx)
+	require.Zero(t, providerKeeper.GetValidatorFeeExemptionUsage(ctx, bonded.SDKValOpAddress()))
+	require.True(t, providerKeeper.ConsumeValidatorFeeExemption(ctx, bondedPayer, []sdk.Msg{optIn}))
+
+	// no messages are exempt if the quota is zero
+	params.ValidatorFeeExemptionsPerEpoch = 0
+	providerKeeper.SetParams(ctx, params)
+	require.False(t, providerKeeper.ConsumeValidatorFeeExemption(ctx, bondedPayer, []sdk.Msg{optOut}))
+}
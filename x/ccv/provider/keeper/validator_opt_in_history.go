@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+)
+
+// AppendValidatorOptInRecord records that the validator with `providerAddr` opted in to (`optedIn` is true)
+// or opted out from (`optedIn` is false) the consumer chain with `consumerId`, where `forcedByTopN` is true
+// if the validator was automatically opted in because it belongs to the Top N validators.
+// The opt-in or opt-out takes effect at the start of the next epoch (or of the current epoch
+// if the current block is the first block of an epoch).
+func (k Keeper) AppendValidatorOptInRecord(
+	ctx sdk.Context,
+	consumerId string,
+	providerAddr types.ProviderConsAddress,
+	optedIn bool,
+	forcedByTopN bool,
+) {
+	k.appendValidatorOptInRecord(ctx, providerAddr, types.ValidatorOptInRecord{
+		ConsumerId:       consumerId,
+		OptedIn:          optedIn,
+		ForcedByTopN:     forcedByTopN,
+		Height:           ctx.BlockHeight(),
+		Time:             ctx.BlockTime(),
+		EpochStartHeight: ctx.BlockHeight() + k.BlocksUntilNextEpoch(ctx),
+	})
+}
+
+// appendValidatorOptInRecord stores `record` as the most recent opt-in or opt-out of the validator with `providerAddr`
+// on the consumer chain of the record. Only the most recent MaxValidatorOptInRecords records of a validator
+// on a consumer chain are retained in state.
+func (k Keeper) appendValidatorOptInRecord(ctx sdk.Context, providerAddr types.ProviderConsAddress, record types.ValidatorOptInRecord) {
+	bz, err := record.Marshal()
+	if err != nil {
+		// An error here would indicate something is very wrong,
+		// the record is assumed to be correctly constructed by the caller.
+		panic(fmt.Errorf("failed to marshal validator opt-in record for consumer id (%s) and validator (%s): %w",
+			record.ConsumerId, providerAddr.String(), err))
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	seq := k.getValidatorOptInRecordSeq(ctx, providerAddr, record.ConsumerId)
+	store.Set(types.ValidatorOptInRecordKey(providerAddr, record.ConsumerId, seq), bz)
+	store.Set(types.ValidatorOptInRecordSeqKey(providerAddr, record.ConsumerId), sdk.Uint64ToBigEndian(seq+1))
+
+	// prune the oldest record
+	if seq >= types.MaxValidatorOptInRecords {
+		store.Delete(types.ValidatorOptInRecordKey(providerAddr, record.ConsumerId, seq-types.MaxValidatorOptInRecords))
+	}
+}
+
+// GetValidatorOptInHistories returns, per consumer chain, the most recent opt-ins and opt-outs
+// of the validator with `providerAddr`, oldest first
+func (k Keeper) GetValidatorOptInHistories(ctx sdk.Context, providerAddr types.ProviderConsAddress) []types.ValidatorConsumerOptInHistory {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.ValidatorOptInRecordKeyPrefix(providerAddr))
+	defer iterator.Close()
+
+	histories := []types.ValidatorConsumerOptInHistory{}
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.ValidatorOptInRecord
+		if err := record.Unmarshal(iterator.Value()); err != nil {
+			// An error here would indicate something is very wrong,
+			// the records are assumed to be correctly serialized in appendValidatorOptInRecord.
+			panic(fmt.Errorf("failed to unmarshal validator opt-in record for validator (%s): %w", providerAddr.String(), err))
+		}
+		// the records of a consumer chain are contiguous, as the keys are prefixed by the consumer id
+		if len(histories) == 0 || histories[len(histories)-1].ConsumerId != record.ConsumerId {
+			histories = append(histories, types.ValidatorConsumerOptInHistory{ConsumerId: record.ConsumerId})
+		}
+		histories[len(histories)-1].Records = append(histories[len(histories)-1].Records, record)
+	}
+	return histories
+}
+
+// getValidatorOptInRecordSeq returns the sequence number of the next opt-in or opt-out of the validator
+// with `providerAddr` on the consumer chain with `consumerId`
+func (k Keeper) getValidatorOptInRecordSeq(ctx sdk.Context, providerAddr types.ProviderConsAddress, consumerId string) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ValidatorOptInRecordSeqKey(providerAddr, consumerId))
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
@@ -0,0 +1,97 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
+	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+)
+
+// TestValidatorOptInHistory tests that the opt-ins and opt-outs of a validator are recorded
+// per consumer chain and that they can be queried
+func TestValidatorOptInHistory(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	blockTime := time.Unix(1000, 0).UTC()
+	// the validator power is queried when the Top N validators are opted in, at height 20
+	val := createStakingValidator(ctx.WithBlockHeight(20).WithBlockTime(blockTime.Add(time.Minute)), mocks, 1, 1)
+	consAddr, _ := val.GetConsAddr()
+	providerAddr := providertypes.NewProviderConsAddress(consAddr)
+	require.Empty(t, providerKeeper.GetValidatorOptInHistories(ctx, providerAddr))
+
+	for _, consumerId := range []string{"0", "1"} {
+		providerKeeper.SetConsumerPhase(ctx, consumerId, providertypes.CONSUMER_PHASE_LAUNCHED)
+		err := providerKeeper.SetConsumerPowerShapingParameters(ctx, consumerId, providertypes.PowerShapingParameters{})
+		require.NoError(t, err)
+	}
+
+	params := providerKeeper.GetParams(ctx)
+	params.BlocksPerEpoch = 10
+	providerKeeper.SetParams(ctx, params)
+
+	// the validator opts in to consumer chain "1" in the middle of an epoch
+	ctx = ctx.WithBlockHeight(13).WithBlockTime(blockTime)
+	require.NoError(t, providerKeeper.HandleOptIn(ctx, "1", providerAddr, ""))
+	// opting in again is not recorded
+	require.NoError(t, providerKeeper.HandleOptIn(ctx, "1", providerAddr, ""))
+
+	// the validator is forced in to consumer chain "0" at the start of an epoch
+	ctx = ctx.WithBlockHeight(20).WithBlockTime(blockTime.Add(time.Minute))
+	require.NoError(t, providerKeeper.OptInTopNValidators(ctx, "0", []stakingtypes.Validator{val}, 0, 0, nil))
+	// the validators that are already opted in are not recorded
+	require.NoError(t, providerKeeper.OptInTopNValidators(ctx, "1", []stakingtypes.Validator{val}, 0, 0, nil))
+
+	// the validator opts out from both consumer chains
+	ctx = ctx.WithBlockHeight(25).WithBlockTime(blockTime.Add(2 * time.Minute))
+	require.NoError(t, providerKeeper.HandleOptOut(ctx, "0", providerAddr))
+	require.NoError(t, providerKeeper.HandleOptOut(ctx, "1", providerAddr))
+	// opting out again is not recorded
+	require.NoError(t, providerKeeper.HandleOptOut(ctx, "1", providerAddr))
+
+	expectedHistories := []providertypes.ValidatorConsumerOptInHistory{
+		{
+			ConsumerId: "0",
+			Records: []providertypes.ValidatorOptInRecord{
+				{ConsumerId: "0", OptedIn: true, ForcedByTopN: true, Height: 20, Time: blockTime.Add(time.Minute), EpochStartHeight: 20},
+				{ConsumerId: "0", OptedIn: false, Height: 25, Time: blockTime.Add(2 * time.Minute), EpochStartHeight: 30},
+			},
+		},
+		{
+			ConsumerId: "1",
+			Records: []providertypes.ValidatorOptInRecord{
+				{ConsumerId: "1", OptedIn: true, Height: 13, Time: blockTime, EpochStartHeight: 20},
+				{ConsumerId: "1", OptedIn: false, Height: 25, Time: blockTime.Add(2 * time.Minute), EpochStartHeight: 30},
+			},
+		},
+	}
+	require.Equal(t, expectedHistories, providerKeeper.GetValidatorOptInHistories(ctx, providerAddr))
+
+	// the records of other validators are not returned
+	otherProviderAddr := providertypes.NewProviderConsAddress([]byte("otherProviderAddr"))
+	require.Empty(t, providerKeeper.GetValidatorOptInHistories(ctx, otherProviderAddr))
+
+	// only the most recent records are retained
+	for i := 0; i < providertypes.MaxValidatorOptInRecords; i++ {
+		providerKeeper.AppendValidatorOptInRecord(ctx, "1", providerAddr, i%2 == 0, false)
+	}
+	histories := providerKeeper.GetValidatorOptInHistories(ctx, providerAddr)
+	require.Equal(t, expectedHistories[0], histories[0])
+	require.Len(t, histories[1].Records, providertypes.MaxValidatorOptInRecords)
+	require.True(t, histories[1].Records[0].OptedIn)
+
+	// query the history
+	res, err := providerKeeper.QueryValidatorOptInHistory(ctx, &providertypes.QueryValidatorOptInHistoryRequest{
+		ProviderAddress: providerAddr.String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, histories, res.Histories)
+
+	_, err = providerKeeper.QueryValidatorOptInHistory(ctx, &providertypes.QueryValidatorOptInHistoryRequest{ProviderAddress: "invalid"})
+	require.Error(t, err)
+}
@@ -0,0 +1,167 @@
+package keeper
+
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+)
+
+// GetValidatorPowerHistory returns the voting powers of the provider validator with `providerAddr`
+// at the beginning of the last epochs, in chronological order
+func (k Keeper) GetValidatorPowerHistory(ctx sdk.Context, providerAddr types.ProviderConsAddress) (types.ValidatorPowerHistory, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ValidatorPowerHistoryKey(providerAddr))
+	if bz == nil {
+		return types.ValidatorPowerHistory{}, false
+	}
+	var history types.ValidatorPowerHistory
+	if err := history.Unmarshal(bz); err != nil {
+		// An error here would indicate something is very wrong,
+		// the history is assumed to be correctly serialized in SetValidatorPowerHistory.
+		panic(fmt.Errorf("failed to unmarshal validator power history for validator (%s): %w", providerAddr.String(), err))
+	}
+	return history, true
+}
+
+// SetValidatorPowerHistory sets the voting powers of the provider validator with `providerAddr`
+// at the beginning of the last epochs, in chronological order
+func (k Keeper) SetValidatorPowerHistory(ctx sdk.Context, providerAddr types.ProviderConsAddress, history types.ValidatorPowerHistory) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := history.Marshal()
+	if err != nil {
+		// An error here would indicate something is very wrong,
+		// the history is obtained from the store or created in RecordValidatorPowers.
+		panic(fmt.Errorf("failed to marshal validator power history for validator (%s): %w", providerAddr.String(), err))
+	}
+	store.Set(types.ValidatorPowerHistoryKey(providerAddr), bz)
+}
+
+// DeleteValidatorPowerHistory deletes the voting power history of the provider validator with `providerAddr`
+func (k Keeper) DeleteValidatorPowerHistory(ctx sdk.Context, providerAddr types.ProviderConsAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.ValidatorPowerHistoryKey(providerAddr))
+}
+
+// GetAllValidatorPowerHistories returns the consensus addresses of the provider validators
+// with a voting power history and their histories, ordered by consensus address
+func (k Keeper) GetAllValidatorPowerHistories(ctx sdk.Context) (providerAddrs []types.ProviderConsAddress, histories []types.ValidatorPowerHistory) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{types.ValidatorPowerHistoryKeyPrefix()})
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var history types.ValidatorPowerHistory
+		if err := history.Unmarshal(iterator.Value()); err != nil {
+			// An error here would indicate something is very wrong,
+			// the history is assumed to be correctly serialized in SetValidatorPowerHistory.
+			panic(fmt.Errorf("failed to unmarshal validator power history: %w", err))
+		}
+		providerAddrs = append(providerAddrs, types.NewProviderConsAddress(iterator.Key()[1:]))
+		histories = append(histories, history)
+	}
+	return providerAddrs, histories
+}
+
+// RecordValidatorPowers appends the current voting powers of the active provider validators to their voting power
+// histories, and a zero voting power to the histories of the validators that are not active anymore.
+// Only the last MaxTopNWeightedEpochs voting powers are kept and the histories with only zero voting powers are deleted.
+// It is called at the beginning of every epoch, before the validator sets of the consumer chains are computed.
+func (k Keeper) RecordValidatorPowers(ctx sdk.Context) error {
+	activeValidators, err := k.GetLastProviderConsensusActiveValidators(ctx)
+	if err != nil {
+		return fmt.Errorf("getting provider active validators: %w", err)
+	}
+
+	powers := map[string]int64{}
+	var activeAddrs []types.ProviderConsAddress
+	for _, val := range activeValidators {
+		valAddr, err := k.ValidatorAddressCodec().StringToBytes(val.GetOperator())
+		if err != nil {
+			return err
+		}
+		power, err := k.stakingKeeper.GetLastValidatorPower(ctx, valAddr)
+		if err != nil {
+			return err
+		}
+		consAddr, err := val.GetConsAddr()
+		if err != nil {
+			return err
+		}
+		providerAddr := types.NewProviderConsAddress(consAddr)
+		powers[providerAddr.String()] = power
+		activeAddrs = append(activeAddrs, providerAddr)
+	}
+
+	// the validators that are not active anymore have zero voting power in this epoch
+	providerAddrs, histories := k.GetAllValidatorPowerHistories(ctx)
+	for i, providerAddr := range providerAddrs {
+		if _, found := powers[providerAddr.String()]; found {
+			continue
+		}
+		history := appendValidatorPower(histories[i], 0)
+		if isZeroValidatorPowerHistory(history) {
+			k.DeleteValidatorPowerHistory(ctx, providerAddr)
+		} else {
+			k.SetValidatorPowerHistory(ctx, providerAddr, history)
+		}
+	}
+
+	for _, providerAddr := range activeAddrs {
+		history, _ := k.GetValidatorPowerHistory(ctx, providerAddr)
+		k.SetValidatorPowerHistory(ctx, providerAddr, appendValidatorPower(history, powers[providerAddr.String()]))
+	}
+
+	return nil
+}
+
+// appendValidatorPower appends `power` to `history` and keeps only the last MaxTopNWeightedEpochs voting powers
+func appendValidatorPower(history types.ValidatorPowerHistory, power int64) types.ValidatorPowerHistory {
+	powers := append(history.Powers, power)
+	if len(powers) > types.MaxTopNWeightedEpochs {
+		powers = powers[len(powers)-types.MaxTopNWeightedEpochs:]
+	}
+	return types.ValidatorPowerHistory{Powers: powers}
+}
+
+// isZeroValidatorPowerHistory returns whether all the voting powers in `history` are zero
+func isZeroValidatorPowerHistory(history types.ValidatorPowerHistory) bool {
+	for _, power := range history.Powers {
+		if power != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// GetTopNPower returns the voting power that decides whether the provider validator with `providerAddr` and the
+// last voting power `lastPower` belongs to the Top N validators of a Top N chain with `topNWeightedEpochs`, i.e.,
+// the average of its voting powers at the beginning of the last `topNWeightedEpochs` epochs if `topNWeightedEpochs`
+// is not zero, or `lastPower` otherwise. A validator without recorded voting powers, e.g., before the first epoch,
+// is assumed to have had `lastPower` in all the epochs.
+func (k Keeper) GetTopNPower(
+	ctx sdk.Context,
+	providerAddr types.ProviderConsAddress,
+	lastPower int64,
+	topNWeightedEpochs uint32,
+) int64 {
+	if topNWeightedEpochs == 0 {
+		return lastPower
+	}
+	history, found := k.GetValidatorPowerHistory(ctx, providerAddr)
+	if !found || len(history.Powers) == 0 {
+		return lastPower
+	}
+
+	powers := history.Powers
+	if len(powers) > int(topNWeightedEpochs) {
+		powers = powers[len(powers)-int(topNWeightedEpochs):]
+	}
+	sum := int64(0)
+	for _, power := range powers {
+		sum += power
+	}
+	return sum / int64(len(powers))
+}
@@ -0,0 +1,116 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
+	"github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+)
+
+// TestRecordValidatorPowers tests that the voting powers of the active validators are recorded at every epoch,
+// that inactive validators are recorded with zero voting power, and that the histories are pruned
+func TestRecordValidatorPowers(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, types.DefaultParams())
+
+	valA := createStakingValidator(ctx, mocks, 10, 1)
+	valB := createStakingValidator(ctx, mocks, 20, 2)
+	consAddrA, _ := valA.GetConsAddr()
+	consAddrB, _ := valB.GetConsAddr()
+	providerAddrA := types.NewProviderConsAddress(consAddrA)
+	providerAddrB := types.NewProviderConsAddress(consAddrB)
+
+	// both validators are active
+	mocks.MockStakingKeeper.EXPECT().GetBondedValidatorsByPower(gomock.Any()).Return([]stakingtypes.Validator{valA, valB}, nil).Times(1)
+	require.NoError(t, providerKeeper.RecordValidatorPowers(ctx))
+
+	history, found := providerKeeper.GetValidatorPowerHistory(ctx, providerAddrA)
+	require.True(t, found)
+	require.Equal(t, []int64{10}, history.Powers)
+	history, found = providerKeeper.GetValidatorPowerHistory(ctx, providerAddrB)
+	require.True(t, found)
+	require.Equal(t, []int64{20}, history.Powers)
+
+	// validator B is not active anymore and is recorded with zero voting power
+	mocks.MockStakingKeeper.EXPECT().GetBondedValidatorsByPower(gomock.Any()).Return([]stakingtypes.Validator{valA}, nil).Times(1)
+	require.NoError(t, providerKeeper.RecordValidatorPowers(ctx))
+
+	history, _ = providerKeeper.GetValidatorPowerHistory(ctx, providerAddrA)
+	require.Equal(t, []int64{10, 10}, history.Powers)
+	history, _ = providerKeeper.GetValidatorPowerHistory(ctx, providerAddrB)
+	require.Equal(t, []int64{20, 0}, history.Powers)
+
+	// only the last MaxTopNWeightedEpochs voting powers are kept and the histories
+	// of validators that have been inactive during all these epochs are deleted
+	mocks.MockStakingKeeper.EXPECT().GetBondedValidatorsByPower(gomock.Any()).Return([]stakingtypes.Validator{valA}, nil).Times(types.MaxTopNWeightedEpochs)
+	for i := 0; i < types.MaxTopNWeightedEpochs; i++ {
+		require.NoError(t, providerKeeper.RecordValidatorPowers(ctx))
+	}
+
+	history, _ = providerKeeper.GetValidatorPowerHistory(ctx, providerAddrA)
+	require.Len(t, history.Powers, types.MaxTopNWeightedEpochs)
+	_, found = providerKeeper.GetValidatorPowerHistory(ctx, providerAddrB)
+	require.False(t, found)
+
+	providerAddrs, _ := providerKeeper.GetAllValidatorPowerHistories(ctx)
+	require.Equal(t, []types.ProviderConsAddress{providerAddrA}, providerAddrs)
+}
+
+// TestGetTopNPower tests that the Top N voting power of a validator is the average of its voting powers
+// during the last `topNWeightedEpochs` epochs
+func TestGetTopNPower(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	providerAddr := types.NewProviderConsAddress([]byte("providerAddr"))
+
+	// without a history, the last voting power is used
+	require.Equal(t, int64(7), providerKeeper.GetTopNPower(ctx, providerAddr, 7, 3))
+
+	providerKeeper.SetValidatorPowerHistory(ctx, providerAddr, types.ValidatorPowerHistory{Powers: []int64{100, 0, 10, 20, 30}})
+
+	// without weighted epochs, the last voting power is used
+	require.Equal(t, int64(7), providerKeeper.GetTopNPower(ctx, providerAddr, 7, 0))
+	// only the last `topNWeightedEpochs` voting powers are averaged
+	require.Equal(t, int64(30), providerKeeper.GetTopNPower(ctx, providerAddr, 7, 1))
+	require.Equal(t, int64(20), providerKeeper.GetTopNPower(ctx, providerAddr, 7, 3))
+	require.Equal(t, int64(15), providerKeeper.GetTopNPower(ctx, providerAddr, 7, 4))
+	// all the recorded voting powers are averaged if there are fewer than `topNWeightedEpochs`
+	require.Equal(t, int64(32), providerKeeper.GetTopNPower(ctx, providerAddr, 7, 10))
+}
+
+// TestComputeMinPowerInWeightedTopN tests that the minimum power in the Top N is computed over
+// the averaged voting powers of the validators
+func TestComputeMinPowerInWeightedTopN(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	// validator A recently increased its power from 1 to 10, validator B has had power 5 all along
+	valA := createStakingValidator(ctx, mocks, 10, 1)
+	valB := createStakingValidator(ctx, mocks, 5, 2)
+	consAddrA, _ := valA.GetConsAddr()
+	consAddrB, _ := valB.GetConsAddr()
+	providerKeeper.SetValidatorPowerHistory(ctx, types.NewProviderConsAddress(consAddrA), types.ValidatorPowerHistory{Powers: []int64{1, 1, 10}})
+	providerKeeper.SetValidatorPowerHistory(ctx, types.NewProviderConsAddress(consAddrB), types.ValidatorPowerHistory{Powers: []int64{5, 5, 5}})
+
+	bondedValidators := []stakingtypes.Validator{valA, valB}
+
+	// with the last voting powers, validator A alone makes up 66% of the power
+	m, err := providerKeeper.ComputeMinPowerInWeightedTopN(ctx, bondedValidators, 60, 0)
+	require.NoError(t, err)
+	require.Equal(t, int64(10), m)
+
+	// with the averaged voting powers (4 and 5), validator B makes up 55% of the power
+	m, err = providerKeeper.ComputeMinPowerInWeightedTopN(ctx, bondedValidators, 55, 3)
+	require.NoError(t, err)
+	require.Equal(t, int64(5), m)
+	m, err = providerKeeper.ComputeMinPowerInWeightedTopN(ctx, bondedValidators, 60, 3)
+	require.NoError(t, err)
+	require.Equal(t, int64(4), m)
+}
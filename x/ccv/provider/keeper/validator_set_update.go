@@ -1,10 +1,12 @@
 package keeper
 
 import (
+	"errors"
 	"fmt"
 	"sort"
 
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -126,7 +128,7 @@ func DiffValidators(
 
 // CreateConsumerValidator creates a consumer validator for `consumerId` from the given staking `validator`
 func (k Keeper) CreateConsumerValidator(ctx sdk.Context, consumerId string, validator stakingtypes.Validator) (types.ConsensusValidator, error) {
-	valAddr, err := sdk.ValAddressFromBech32(validator.GetOperator())
+	valAddr, err := k.ValidatorAddressCodec().StringToBytes(validator.GetOperator())
 	if err != nil {
 		return types.ConsensusValidator{}, err
 	}
@@ -203,6 +205,22 @@ func (k Keeper) ComputeNextValidators(
 	powerShapingParameters types.PowerShapingParameters,
 	minPowerToOptIn int64,
 ) ([]types.ConsensusValidator, error) {
+	nextValidators, _, err := k.computeNextValidatorsAndSteps(ctx, consumerId, bondedValidators, powerShapingParameters, minPowerToOptIn, nil)
+	return nextValidators, err
+}
+
+// computeNextValidatorsAndSteps computes the validators for the upcoming epoch based on the currently `bondedValidators`
+// and returns the power shaping steps applied to compute them, in evaluation order.
+func (k Keeper) computeNextValidatorsAndSteps(
+	ctx sdk.Context,
+	consumerId string,
+	bondedValidators []stakingtypes.Validator,
+	powerShapingParameters types.PowerShapingParameters,
+	minPowerToOptIn int64,
+	topNConsumersCounts TopNConsumersCounts,
+) ([]types.ConsensusValidator, []types.PowerShapingStep, error) {
+	steps := []types.PowerShapingStep{}
+
 	// sort the bonded validators by number of staked tokens in descending order
 	sort.Slice(bondedValidators, func(i, j int) bool {
 		return bondedValidators[i].GetBondedTokens().GT(bondedValidators[j].GetBondedTokens())
@@ -213,14 +231,20 @@ func (k Keeper) ComputeNextValidators(
 	if !powerShapingParameters.AllowInactiveVals {
 		// only leave the first MaxProviderConsensusValidators bonded validators
 		maxProviderConsensusVals := k.GetMaxProviderConsensusValidators(ctx)
+		var inactiveValidators []stakingtypes.Validator
 		if len(bondedValidators) > int(maxProviderConsensusVals) {
+			inactiveValidators = bondedValidators[maxProviderConsensusVals:]
 			bondedValidators = bondedValidators[:maxProviderConsensusVals]
 		}
+		steps = append(steps, types.PowerShapingStep{
+			Name:          types.PowerShapingStepActiveValidators,
+			ValidatorsOut: k.stakingValidatorsConsAddrs(inactiveValidators),
+		})
 	}
 
 	nextValidators, err := k.FilterValidators(ctx, consumerId, bondedValidators,
 		func(providerAddr types.ProviderConsAddress) (bool, error) {
-			canValidateChain, err := k.CanValidateChain(ctx, consumerId, providerAddr, powerShapingParameters.Top_N, minPowerToOptIn)
+			canValidateChain, err := k.CanValidateChain(ctx, consumerId, providerAddr, powerShapingParameters, minPowerToOptIn, topNConsumersCounts)
 			if err != nil {
 				return false, err
 			}
@@ -231,16 +255,144 @@ func (k Keeper) ComputeNextValidators(
 			return canValidateChain && fulfillsMinStake, nil
 		})
 	if err != nil {
-		return []types.ConsensusValidator{}, err
+		return []types.ConsensusValidator{}, []types.PowerShapingStep{}, err
+	}
+	steps = append(steps, types.PowerShapingStep{
+		Name:          types.PowerShapingStepEligibility,
+		ValidatorsOut: diffConsAddrs(k.stakingValidatorsConsAddrs(bondedValidators), k.consensusValidatorsConsAddrs(nextValidators)),
+	})
+	nextValidators, steps, err = k.applyPowerShapers(ctx, consumerId, types.PowerShapingStepEligibility, powerShapingParameters, nextValidators, steps)
+	if err != nil {
+		return []types.ConsensusValidator{}, []types.PowerShapingStep{}, err
 	}
 
 	priorityValidators, nonPriorityValidators := k.PartitionBasedOnPriorityList(ctx, consumerId, nextValidators)
+	if !k.IsPrioritylistEmpty(ctx, consumerId) {
+		// the prioritylist only changes the order in which validators are considered by the validator set cap
+		steps = append(steps, types.PowerShapingStep{Name: types.PowerShapingStepPrioritylist})
+	}
+	nextValidators = append(priorityValidators, nonPriorityValidators...)
+	nextValidators, steps, err = k.applyPowerShapers(ctx, consumerId, types.PowerShapingStepPrioritylist, powerShapingParameters, nextValidators, steps)
+	if err != nil {
+		return []types.ConsensusValidator{}, []types.PowerShapingStep{}, err
+	}
 
-	nextValidators = k.CapValidatorSet(ctx, powerShapingParameters, append(priorityValidators, nonPriorityValidators...))
+	cappedValidators := k.CapValidatorSet(ctx, powerShapingParameters, nextValidators)
+	if powerShapingParameters.Top_N == 0 && powerShapingParameters.ValidatorSetCap > 0 {
+		steps = append(steps, types.PowerShapingStep{
+			Name:          types.PowerShapingStepValidatorSetCap,
+			ValidatorsOut: diffConsAddrs(k.consensusValidatorsConsAddrs(nextValidators), k.consensusValidatorsConsAddrs(cappedValidators)),
+		})
+	}
+	cappedValidators, steps, err = k.applyPowerShapers(ctx, consumerId, types.PowerShapingStepValidatorSetCap, powerShapingParameters, cappedValidators, steps)
+	if err != nil {
+		return []types.ConsensusValidator{}, []types.PowerShapingStep{}, err
+	}
 
-	nextValidators = k.CapValidatorsPower(ctx, powerShapingParameters.ValidatorsPowerCap, nextValidators)
+	nextValidators = k.CapValidatorsPower(ctx, powerShapingParameters.ValidatorsPowerCap, cappedValidators)
+	if powerShapingParameters.ValidatorsPowerCap > 0 {
+		// the validators power cap only changes the powers of the validators
+		steps = append(steps, types.PowerShapingStep{Name: types.PowerShapingStepValidatorsPowerCap})
+	}
+	nextValidators, steps, err = k.applyPowerShapers(ctx, consumerId, types.PowerShapingStepValidatorsPowerCap, powerShapingParameters, nextValidators, steps)
+	if err != nil {
+		return []types.ConsensusValidator{}, []types.PowerShapingStep{}, err
+	}
 
-	return nextValidators, nil
+	return nextValidators, steps, nil
+}
+
+// applyPowerShapers applies to `validators` the power shapers inserted after the built-in power shaping step `after`,
+// in registration order, and appends them to the power shaping steps `steps`
+func (k Keeper) applyPowerShapers(
+	ctx sdk.Context,
+	consumerId string,
+	after string,
+	powerShapingParameters types.PowerShapingParameters,
+	validators []types.ConsensusValidator,
+	steps []types.PowerShapingStep,
+) ([]types.ConsensusValidator, []types.PowerShapingStep, error) {
+	for _, registration := range k.powerShapers {
+		if registration.After != after {
+			continue
+		}
+		name := registration.Shaper.Name()
+		shapedValidators, err := registration.Shaper.ShapePower(ctx, consumerId, powerShapingParameters, validators)
+		if err != nil {
+			return nil, nil, fmt.Errorf("applying power shaper %s: %w", name, err)
+		}
+
+		consAddrs := k.consensusValidatorsConsAddrs(validators)
+		shapedConsAddrs := k.consensusValidatorsConsAddrs(shapedValidators)
+		if validatorsIn := diffConsAddrs(shapedConsAddrs, consAddrs); len(validatorsIn) > 0 {
+			return nil, nil, fmt.Errorf("power shaper %s added validators: %v", name, validatorsIn)
+		}
+		steps = append(steps, types.PowerShapingStep{
+			Name:          name,
+			ValidatorsOut: diffConsAddrs(consAddrs, shapedConsAddrs),
+		})
+		validators = shapedValidators
+	}
+	return validators, steps, nil
+}
+
+// stakingValidatorsConsAddrs returns the consensus addresses of the given staking validators
+func (k Keeper) stakingValidatorsConsAddrs(validators []stakingtypes.Validator) []string {
+	consAddrs := []string{}
+	for _, val := range validators {
+		consAddr, err := val.GetConsAddr()
+		if err != nil {
+			continue
+		}
+		consAddrStr, err := k.ConsensusAddressCodec().BytesToString(consAddr)
+		if err != nil {
+			continue
+		}
+		consAddrs = append(consAddrs, consAddrStr)
+	}
+	return consAddrs
+}
+
+// consensusValidatorsConsAddrs returns the consensus addresses on the provider of the given consensus validators
+func (k Keeper) consensusValidatorsConsAddrs(validators []types.ConsensusValidator) []string {
+	consAddrs := []string{}
+	for _, val := range validators {
+		consAddr, err := k.ConsensusAddressCodec().BytesToString(val.ProviderConsAddr)
+		if err != nil {
+			continue
+		}
+		consAddrs = append(consAddrs, consAddr)
+	}
+	return consAddrs
+}
+
+// providerConsAddrsToStrings returns the given consensus addresses on the provider as strings
+func (k Keeper) providerConsAddrsToStrings(providerAddrs []types.ProviderConsAddress) []string {
+	consAddrs := []string{}
+	for _, providerAddr := range providerAddrs {
+		consAddr, err := k.ConsensusAddressCodec().BytesToString(providerAddr.ToSdkConsAddr())
+		if err != nil {
+			continue
+		}
+		consAddrs = append(consAddrs, consAddr)
+	}
+	return consAddrs
+}
+
+// diffConsAddrs returns the consensus addresses in `consAddrs` that are not in `otherConsAddrs`
+func diffConsAddrs(consAddrs, otherConsAddrs []string) []string {
+	isOtherConsAddr := make(map[string]bool, len(otherConsAddrs))
+	for _, consAddr := range otherConsAddrs {
+		isOtherConsAddr[consAddr] = true
+	}
+
+	diff := []string{}
+	for _, consAddr := range consAddrs {
+		if !isOtherConsAddr[consAddr] {
+			diff = append(diff, consAddr)
+		}
+	}
+	return diff
 }
 
 // GetLastBondedValidators iterates the last validator powers in the staking module
@@ -264,6 +416,8 @@ func (k Keeper) GetLastProviderConsensusActiveValidators(ctx sdk.Context) ([]sta
 // the validator updates to be sent to the consumer chain.
 // For TopN consumer chains, it automatically opts in all validators that
 // belong to the top N of the active validators.
+// The `topNConsumersCounts` are shared by the consumer chains whose validator sets are computed
+// in the same epoch, so that they are computed only once; if nil, they are computed when needed.
 //
 // TODO add unit test for ComputeConsumerNextValSet
 func (k Keeper) ComputeConsumerNextValSet(
@@ -272,6 +426,7 @@ func (k Keeper) ComputeConsumerNextValSet(
 	activeValidators []stakingtypes.Validator,
 	consumerId string,
 	currentConsumerValSet []types.ConsensusValidator,
+	topNConsumersCounts TopNConsumersCounts,
 ) ([]abci.ValidatorUpdate, error) {
 	powerShapingParameters, err := k.GetConsumerPowerShapingParameters(ctx, consumerId)
 	if err != nil {
@@ -279,9 +434,10 @@ func (k Keeper) ComputeConsumerNextValSet(
 			errorsmod.Wrapf(ccv.ErrInvalidConsumerState, "getting power shaping parameters: %s", err.Error())
 	}
 
+	steps := []types.PowerShapingStep{}
 	minPower := int64(0)
 	if powerShapingParameters.Top_N > 0 {
-		minPower, err = k.ComputeMinPowerInTopN(ctx, activeValidators, powerShapingParameters.Top_N)
+		minPower, err = k.ComputeMinPowerInWeightedTopN(ctx, activeValidators, powerShapingParameters.Top_N, powerShapingParameters.Top_NWeightedEpochs)
 		if err != nil {
 			return []abci.ValidatorUpdate{},
 				fmt.Errorf("computing min power to opt in, consumerId(%s): %w", consumerId, err)
@@ -290,17 +446,40 @@ func (k Keeper) ComputeConsumerNextValSet(
 		// set the minimal power of validators in the top N in the store
 		k.SetMinimumPowerInTopN(ctx, consumerId, minPower)
 
+		if topNConsumersCounts == nil {
+			topNConsumersCounts, err = k.GetTopNConsumersCounts(ctx)
+			if err != nil {
+				return []abci.ValidatorUpdate{},
+					fmt.Errorf("getting Top N consumers counts, consumerId(%s): %w", consumerId, err)
+			}
+		}
+
 		// in a Top-N chain, we automatically opt in all validators that belong to the top N
 		// of the active validators
-		err = k.OptInTopNValidators(ctx, consumerId, activeValidators, minPower)
+		optedInValidators := k.GetAllOptedIn(ctx, consumerId)
+		err = k.OptInTopNValidators(ctx, consumerId, activeValidators, minPower, powerShapingParameters.Top_NWeightedEpochs, topNConsumersCounts)
 		if err != nil {
 			return []abci.ValidatorUpdate{},
 				fmt.Errorf("opting in topN validators, consumerId(%s), minPower(%d): %w", consumerId, minPower, err)
 		}
+		// the validators that exhausted their Top N budget are not opted in, which can leave the top N uncovered
+		if err := k.CheckTopNCoverage(ctx, consumerId, activeValidators); err != nil {
+			if !errors.Is(err, types.ErrTopNShortfall) {
+				return []abci.ValidatorUpdate{}, err
+			}
+			k.emitTopNShortfall(ctx, consumerId, err)
+		}
+		steps = append(steps, types.PowerShapingStep{
+			Name: types.PowerShapingStepTopNOptIn,
+			ValidatorsIn: diffConsAddrs(
+				k.providerConsAddrsToStrings(k.GetAllOptedIn(ctx, consumerId)),
+				k.providerConsAddrsToStrings(optedInValidators),
+			),
+		})
 	}
 
 	// need to use the bondedValidators, not activeValidators, here since the chain might be opt-in and allow inactive vals
-	nextValidators, err := k.ComputeNextValidators(ctx, consumerId, bondedValidators, powerShapingParameters, minPower)
+	nextValidators, nextSteps, err := k.computeNextValidatorsAndSteps(ctx, consumerId, bondedValidators, powerShapingParameters, minPower, topNConsumersCounts)
 	if err != nil {
 		return []abci.ValidatorUpdate{},
 			fmt.Errorf("computing next validators, consumerId(%s), minPower(%d): %w", consumerId, minPower, err)
@@ -312,8 +491,92 @@ func (k Keeper) ComputeConsumerNextValSet(
 			fmt.Errorf("setting consumer validator set, consumerId(%s): %w", consumerId, err)
 	}
 
+	// store the power shaping steps to explain the computed validator set
+	err = k.SetConsumerPowerShapingPipeline(ctx, consumerId, types.PowerShapingPipeline{
+		Height: ctx.BlockHeight(),
+		Steps:  append(steps, nextSteps...),
+	})
+	if err != nil {
+		return []abci.ValidatorUpdate{},
+			fmt.Errorf("setting power shaping pipeline, consumerId(%s): %w", consumerId, err)
+	}
+
 	// get the initial updates with the latest set consumer public keys
 	valUpdates := DiffValidators(currentConsumerValSet, nextValidators)
 
 	return valUpdates, nil
 }
+
+// CheckTopNCoverage returns an ErrTopNShortfall error if the validators opted in to the Top N chain `consumerId`
+// hold less than Top N percent of the power of the `activeValidators`, i.e., if validators that belong to the top N
+// were not automatically opted in because they exhausted their Top N budget
+func (k Keeper) CheckTopNCoverage(ctx sdk.Context, consumerId string, activeValidators []stakingtypes.Validator) error {
+	powerShapingParameters, err := k.GetConsumerPowerShapingParameters(ctx, consumerId)
+	if err != nil {
+		return errorsmod.Wrapf(ccv.ErrInvalidConsumerState, "getting power shaping parameters: %s", err.Error())
+	}
+	if powerShapingParameters.Top_N == 0 {
+		return nil
+	}
+
+	optedInPower, err := k.ComputeOptedInTopNPower(ctx, consumerId, activeValidators, powerShapingParameters.Top_NWeightedEpochs)
+	if err != nil {
+		return fmt.Errorf("computing opted-in Top N power, consumerId(%s): %w", consumerId, err)
+	}
+	if optedInPower.LT(math.LegacyNewDec(int64(powerShapingParameters.Top_N))) {
+		return errorsmod.Wrapf(types.ErrTopNShortfall,
+			"consumerId(%s): opted-in validators hold %s%% of the power, Top N is %d%%",
+			consumerId, optedInPower.String(), powerShapingParameters.Top_N)
+	}
+	return nil
+}
+
+// emitTopNShortfall emits an event explaining that the validators opted in to the Top N chain `consumerId`
+// do not cover the top N (see CheckTopNCoverage)
+func (k Keeper) emitTopNShortfall(ctx sdk.Context, consumerId string, shortfall error) {
+	k.Logger(ctx).Info("opted-in validators do not cover the top N",
+		"consumerId", consumerId,
+		"error", shortfall,
+	)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTopNShortfall,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeConsumerId, consumerId),
+			sdk.NewAttribute(types.AttributeTopNShortfall, shortfall.Error()),
+		),
+	)
+}
+
+// ProjectConsumerNextValSet returns the validator set that would be sent to the consumer chain `consumerId`
+// in the next VSC packet, together with the power shaping steps applied to compute it.
+// As computing the next validator set opts in the Top N validators and stores the computed validator set,
+// the computation is done on a cache context that is discarded, i.e., no state is committed.
+func (k Keeper) ProjectConsumerNextValSet(ctx sdk.Context, consumerId string) ([]types.ConsensusValidator, types.PowerShapingPipeline, error) {
+	cachedCtx, _ := ctx.CacheContext()
+
+	bondedValidators, err := k.GetLastBondedValidators(cachedCtx)
+	if err != nil {
+		return nil, types.PowerShapingPipeline{}, fmt.Errorf("getting bonded validators: %w", err)
+	}
+	activeValidators, err := k.GetLastProviderConsensusActiveValidators(cachedCtx)
+	if err != nil {
+		return nil, types.PowerShapingPipeline{}, fmt.Errorf("getting provider active validators: %w", err)
+	}
+	currentValSet, err := k.GetConsumerValSet(cachedCtx, consumerId)
+	if err != nil {
+		return nil, types.PowerShapingPipeline{}, fmt.Errorf("getting consumer current validator set: %w", err)
+	}
+
+	if _, err := k.ComputeConsumerNextValSet(cachedCtx, bondedValidators, activeValidators, consumerId, currentValSet, nil); err != nil {
+		return nil, types.PowerShapingPipeline{}, err
+	}
+
+	nextValSet, err := k.GetConsumerValSet(cachedCtx, consumerId)
+	if err != nil {
+		return nil, types.PowerShapingPipeline{}, fmt.Errorf("getting consumer next validator set: %w", err)
+	}
+	pipeline, _ := k.GetConsumerPowerShapingPipeline(cachedCtx, consumerId)
+
+	return nextValSet, pipeline, nil
+}
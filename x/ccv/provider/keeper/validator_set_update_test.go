@@ -495,3 +495,227 @@ func TestCreateConsumerValidator(t *testing.T) {
 	require.Equal(t, expectedConsumerValidatorB, actualConsumerValidatorB)
 	require.NoError(t, err)
 }
+
+// TestComputeConsumerNextValSetPowerShapingPipeline tests that the power shaping steps applied to compute
+// the validator set of a consumer chain are stored and can be queried
+func TestComputeConsumerNextValSetPowerShapingPipeline(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, types.DefaultParams())
+	ctx = ctx.WithBlockHeight(10)
+
+	_, err := providerKeeper.QueryPowerShapingPipeline(ctx, &types.QueryPowerShapingPipelineRequest{ConsumerId: CONSUMER_ID})
+	require.Error(t, err)
+
+	// validators A, B, and C with powers 1, 2, and 3 are opted in
+	validators, consAddrs := createStakingValidatorsAndMocks(ctx, mocks, 1, 2, 3)
+	for _, consAddr := range consAddrs {
+		providerKeeper.SetOptedIn(ctx, CONSUMER_ID, consAddr)
+	}
+
+	// validator C is denylisted and only one validator can validate the chain
+	err = providerKeeper.SetConsumerPowerShapingParameters(ctx, CONSUMER_ID, types.PowerShapingParameters{
+		ValidatorSetCap:    1,
+		ValidatorsPowerCap: 50,
+		Denylist:           []string{consAddrs[2].ToSdkConsAddr().String()},
+	})
+	require.NoError(t, err)
+
+	_, err = providerKeeper.ComputeConsumerNextValSet(ctx, validators, validators, CONSUMER_ID, []types.ConsensusValidator{}, nil)
+	require.NoError(t, err)
+	consumerValSet, err := providerKeeper.GetConsumerValSet(ctx, CONSUMER_ID)
+	require.NoError(t, err)
+	require.Len(t, consumerValSet, 1)
+	require.Equal(t, consAddrs[1].ToSdkConsAddr().Bytes(), consumerValSet[0].ProviderConsAddr)
+
+	res, err := providerKeeper.QueryPowerShapingPipeline(ctx, &types.QueryPowerShapingPipelineRequest{ConsumerId: CONSUMER_ID})
+	require.NoError(t, err)
+	require.Equal(t, types.PowerShapingPipeline{
+		Height: 10,
+		Steps: []types.PowerShapingStep{
+			{
+				Name: types.PowerShapingStepActiveValidators,
+			},
+			{
+				Name:          types.PowerShapingStepEligibility,
+				ValidatorsOut: []string{consAddrs[2].ToSdkConsAddr().String()},
+			},
+			{
+				Name:          types.PowerShapingStepValidatorSetCap,
+				ValidatorsOut: []string{consAddrs[0].ToSdkConsAddr().String()},
+			},
+			{
+				Name: types.PowerShapingStepValidatorsPowerCap,
+			},
+		},
+	}, res.Pipeline)
+
+	// the pipeline is deleted together with the consumer chain
+	providerKeeper.DeleteConsumerPowerShapingPipeline(ctx, CONSUMER_ID)
+	_, found := providerKeeper.GetConsumerPowerShapingPipeline(ctx, CONSUMER_ID)
+	require.False(t, found)
+}
+
+// testPowerShaper is a power shaper that applies `shape` to the validators of the consumer chains
+type testPowerShaper struct {
+	name  string
+	shape func(validators []types.ConsensusValidator) []types.ConsensusValidator
+}
+
+func (s testPowerShaper) Name() string {
+	return s.name
+}
+
+func (s testPowerShaper) ShapePower(_ sdk.Context, _ string, _ types.PowerShapingParameters,
+	validators []types.ConsensusValidator,
+) ([]types.ConsensusValidator, error) {
+	return s.shape(validators), nil
+}
+
+// TestComputeConsumerNextValSetPowerShapers tests that the custom power shapers are applied
+// right after the built-in steps they are inserted after and that they are listed in the power shaping pipeline
+func TestComputeConsumerNextValSetPowerShapers(t *testing.T) {
+	var consAddrs []types.ProviderConsAddress
+	// removes the validator A, e.g., because it is in the same region as validator B
+	geoDiversity := testPowerShaper{name: "geo_diversity", shape: func(validators []types.ConsensusValidator) []types.ConsensusValidator {
+		shaped := []types.ConsensusValidator{}
+		for _, val := range validators {
+			if !bytes.Equal(val.ProviderConsAddr, consAddrs[0].ToSdkConsAddr()) {
+				shaped = append(shaped, val)
+			}
+		}
+		return shaped
+	}}
+	// doubles the power of the validators
+	doublePower := testPowerShaper{name: "double_power", shape: func(validators []types.ConsensusValidator) []types.ConsensusValidator {
+		shaped := []types.ConsensusValidator{}
+		for _, val := range validators {
+			val.Power *= 2
+			shaped = append(shaped, val)
+		}
+		return shaped
+	}}
+
+	keeperParams := testkeeper.NewInMemKeeperParams(t)
+	keeperParams.ProviderOptions = []keeper.Option{keeper.WithPowerShapers(
+		types.PowerShaperRegistration{After: types.PowerShapingStepValidatorsPowerCap, Shaper: doublePower},
+		types.PowerShaperRegistration{After: types.PowerShapingStepEligibility, Shaper: geoDiversity},
+	)}
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, keeperParams)
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, types.DefaultParams())
+	ctx = ctx.WithBlockHeight(10)
+
+	// validators A, B, and C with powers 1, 2, and 3 are opted in
+	var validators []stakingtypes.Validator
+	validators, consAddrs = createStakingValidatorsAndMocks(ctx, mocks, 1, 2, 3)
+	for _, consAddr := range consAddrs {
+		providerKeeper.SetOptedIn(ctx, CONSUMER_ID, consAddr)
+	}
+	err := providerKeeper.SetConsumerPowerShapingParameters(ctx, CONSUMER_ID, types.PowerShapingParameters{})
+	require.NoError(t, err)
+
+	_, err = providerKeeper.ComputeConsumerNextValSet(ctx, validators, validators, CONSUMER_ID, []types.ConsensusValidator{}, nil)
+	require.NoError(t, err)
+	consumerValSet, err := providerKeeper.GetConsumerValSet(ctx, CONSUMER_ID)
+	require.NoError(t, err)
+	require.Len(t, consumerValSet, 2)
+	for _, val := range consumerValSet {
+		require.NotEqual(t, consAddrs[0].ToSdkConsAddr().Bytes(), val.ProviderConsAddr)
+		require.Equal(t, int64(0), val.Power%2)
+	}
+
+	pipeline, found := providerKeeper.GetConsumerPowerShapingPipeline(ctx, CONSUMER_ID)
+	require.True(t, found)
+	require.Equal(t, []types.PowerShapingStep{
+		{Name: types.PowerShapingStepActiveValidators},
+		{Name: types.PowerShapingStepEligibility},
+		{Name: "geo_diversity", ValidatorsOut: []string{consAddrs[0].ToSdkConsAddr().String()}},
+		{Name: "double_power"},
+	}, pipeline.Steps)
+
+	// a power shaper cannot add validators
+	addValidator := testPowerShaper{name: "add_validator", shape: func(validators []types.ConsensusValidator) []types.ConsensusValidator {
+		return append(validators, types.ConsensusValidator{ProviderConsAddr: []byte("providerConsAddr")})
+	}}
+	keeperParams.ProviderOptions = []keeper.Option{keeper.WithPowerShapers(
+		types.PowerShaperRegistration{After: types.PowerShapingStepValidatorSetCap, Shaper: addValidator},
+	)}
+	providerKeeper, ctx, ctrl, mocks = testkeeper.GetProviderKeeperAndCtx(t, keeperParams)
+	defer ctrl.Finish()
+	providerKeeper.SetParams(ctx, types.DefaultParams())
+	validators, _ = createStakingValidatorsAndMocks(ctx, mocks, 1)
+	_, err = providerKeeper.ComputeNextValidators(ctx, CONSUMER_ID, validators, types.PowerShapingParameters{}, 0)
+	require.ErrorContains(t, err, "power shaper add_validator added validators")
+}
+
+func TestValidatePowerShaperRegistrations(t *testing.T) {
+	noop := func(validators []types.ConsensusValidator) []types.ConsensusValidator { return validators }
+
+	testCases := []struct {
+		name          string
+		registrations []types.PowerShaperRegistration
+		expPass       bool
+	}{
+		{
+			"no power shapers",
+			nil,
+			true,
+		},
+		{
+			"power shapers after every anchor",
+			[]types.PowerShaperRegistration{
+				{After: types.PowerShapingStepEligibility, Shaper: testPowerShaper{name: "a", shape: noop}},
+				{After: types.PowerShapingStepPrioritylist, Shaper: testPowerShaper{name: "b", shape: noop}},
+				{After: types.PowerShapingStepValidatorSetCap, Shaper: testPowerShaper{name: "c", shape: noop}},
+				{After: types.PowerShapingStepValidatorsPowerCap, Shaper: testPowerShaper{name: "d", shape: noop}},
+			},
+			true,
+		},
+		{
+			"power shaper after a step that does not operate on the consumer validators",
+			[]types.PowerShaperRegistration{{After: types.PowerShapingStepTopNOptIn, Shaper: testPowerShaper{name: "a", shape: noop}}},
+			false,
+		},
+		{
+			"power shaper after an unknown step",
+			[]types.PowerShaperRegistration{{After: "unknown", Shaper: testPowerShaper{name: "a", shape: noop}}},
+			false,
+		},
+		{
+			"nil power shaper",
+			[]types.PowerShaperRegistration{{After: types.PowerShapingStepEligibility}},
+			false,
+		},
+		{
+			"empty name",
+			[]types.PowerShaperRegistration{{After: types.PowerShapingStepEligibility, Shaper: testPowerShaper{shape: noop}}},
+			false,
+		},
+		{
+			"duplicate names",
+			[]types.PowerShaperRegistration{
+				{After: types.PowerShapingStepEligibility, Shaper: testPowerShaper{name: "a", shape: noop}},
+				{After: types.PowerShapingStepValidatorSetCap, Shaper: testPowerShaper{name: "a", shape: noop}},
+			},
+			false,
+		},
+		{
+			"name of a built-in step",
+			[]types.PowerShaperRegistration{
+				{After: types.PowerShapingStepEligibility, Shaper: testPowerShaper{name: types.PowerShapingStepValidatorSetCap, shape: noop}},
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := types.ValidatePowerShaperRegistrations(tc.registrations)
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+			require.Panics(t, func() { keeper.WithPowerShapers(tc.registrations...)(&keeper.Keeper{}) }, tc.name)
+		}
+	}
+}
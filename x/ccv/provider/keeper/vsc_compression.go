@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+)
+
+// CompressValidatorUpdates returns the validator updates of an epoch without the updates that do not change
+// the consumer validator set `currentValidators`, i.e., the zero-delta updates, and with a single update
+// per validator, i.e., the last update of every validator. The validator updates that add or increase
+// the power of validators are ordered before the updates that remove validators, so that the consumer
+// validator set never shrinks before it grows when the updates are split into multiple VSC packets.
+func CompressValidatorUpdates(
+	currentValidators []types.ConsensusValidator,
+	valUpdates []abci.ValidatorUpdate,
+) []abci.ValidatorUpdate {
+	currentPowers := make(map[string]int64, len(currentValidators))
+	for _, val := range currentValidators {
+		currentPowers[val.PublicKey.String()] = val.Power
+	}
+
+	// keep the last update of every validator, in the order of the first updates
+	lastUpdates := make(map[string]abci.ValidatorUpdate, len(valUpdates))
+	var keys []string
+	for _, update := range valUpdates {
+		key := update.PubKey.String()
+		if _, found := lastUpdates[key]; !found {
+			keys = append(keys, key)
+		}
+		lastUpdates[key] = update
+	}
+
+	compressed := []abci.ValidatorUpdate{}
+	removals := []abci.ValidatorUpdate{}
+	for _, key := range keys {
+		update := lastUpdates[key]
+		// a validator not in the consumer validator set has zero power
+		if update.Power == currentPowers[key] {
+			continue
+		}
+		if update.Power == 0 {
+			removals = append(removals, update)
+		} else {
+			compressed = append(compressed, update)
+		}
+	}
+
+	return append(compressed, removals...)
+}
@@ -0,0 +1,106 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	cryptotestutil "github.com/cosmos/interchain-security/v7/testutil/crypto"
+	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
+	"github.com/cosmos/interchain-security/v7/x/ccv/provider/keeper"
+	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+)
+
+// TestCompressValidatorUpdates tests that the zero-delta updates are dropped, the repeated updates
+// of a validator are merged, and the removals are ordered after the other updates
+func TestCompressValidatorUpdates(t *testing.T) {
+	pubKeys := make([]abci.ValidatorUpdate, 5)
+	for i := range pubKeys {
+		pubKeys[i] = abci.ValidatorUpdate{PubKey: cryptotestutil.NewCryptoIdentityFromIntSeed(i).TMProtoCryptoPublicKey()}
+	}
+	update := func(i int, power int64) abci.ValidatorUpdate {
+		return abci.ValidatorUpdate{PubKey: pubKeys[i].PubKey, Power: power}
+	}
+	consensusValidator := func(i int, power int64) providertypes.ConsensusValidator {
+		return providertypes.ConsensusValidator{PublicKey: &pubKeys[i].PubKey, Power: power}
+	}
+
+	// validators 0, 1, and 2 validate the consumer chain
+	currentValidators := []providertypes.ConsensusValidator{
+		consensusValidator(0, 10),
+		consensusValidator(1, 20),
+		consensusValidator(2, 30),
+	}
+
+	valUpdates := []abci.ValidatorUpdate{
+		update(0, 0),  // validator 0 is removed
+		update(1, 20), // zero-delta update of validator 1
+		update(2, 40), // validator 2 changes its power twice
+		update(3, 50), // validator 3 is added
+		update(2, 35),
+		update(4, 0), // zero-delta removal of validator 4 that does not validate the consumer chain
+	}
+
+	require.Equal(t, []abci.ValidatorUpdate{
+		update(2, 35),
+		update(3, 50),
+		update(0, 0),
+	}, keeper.CompressValidatorUpdates(currentValidators, valUpdates))
+
+	// only zero-delta updates
+	require.Empty(t, keeper.CompressValidatorUpdates(currentValidators, []abci.ValidatorUpdate{update(1, 20), update(4, 0)}))
+}
+
+// TestQueueVSCPacketsWithMaxValidatorUpdatesPerPacket tests that the validator updates of an epoch
+// are split into sequential VSC packets with consecutive VSC ids
+func TestQueueVSCPacketsWithMaxValidatorUpdatesPerPacket(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	params := providertypes.DefaultParams()
+	params.MaxValidatorUpdatesPerPacket = 2
+	providerKeeper.SetParams(ctx, params)
+
+	// mock 3 bonded validators
+	validators, consAddrs := createStakingValidatorsAndMocks(ctx, mocks, 1, 2, 3)
+	testkeeper.SetupMocksForLastBondedValidatorsExpectation(mocks.MockStakingKeeper, 3, validators, -1)
+
+	// the three validators opt in on a launched consumer chain
+	providerKeeper.SetConsumerClientId(ctx, CONSUMER_ID, "clientID")
+	providerKeeper.SetConsumerPhase(ctx, CONSUMER_ID, providertypes.CONSUMER_PHASE_LAUNCHED)
+	err := providerKeeper.SetConsumerPowerShapingParameters(ctx, CONSUMER_ID, providertypes.PowerShapingParameters{})
+	require.NoError(t, err)
+	for _, consAddr := range consAddrs {
+		providerKeeper.SetOptedIn(ctx, CONSUMER_ID, consAddr)
+	}
+	providerKeeper.SetSlashAcks(ctx, CONSUMER_ID, []string{"slashAck"})
+
+	// the VSC id of the epoch is mapped to a block height
+	providerKeeper.SetValidatorSetUpdateId(ctx, 5)
+	providerKeeper.SetValsetUpdateBlockHeight(ctx, 5, 100)
+
+	err = providerKeeper.QueueVSCPackets(ctx)
+	require.NoError(t, err)
+
+	pending := providerKeeper.GetPendingVSCPackets(ctx, CONSUMER_ID)
+	require.Len(t, pending, 2)
+	require.Len(t, pending[0].ValidatorUpdates, 2)
+	require.Equal(t, uint64(5), pending[0].ValsetUpdateId)
+	require.Equal(t, []string{"slashAck"}, pending[0].SlashAcks)
+	require.Len(t, pending[1].ValidatorUpdates, 1)
+	require.Equal(t, uint64(6), pending[1].ValsetUpdateId)
+	require.Empty(t, pending[1].SlashAcks)
+
+	// the VSC id of the additional packet is mapped to the same block height
+	height, found := providerKeeper.GetValsetUpdateBlockHeight(ctx, 6)
+	require.True(t, found)
+	require.Equal(t, uint64(100), height)
+	require.Equal(t, uint64(7), providerKeeper.GetValidatorSetUpdateId(ctx))
+
+	// the consumer validator set contains the three validators
+	consumerValSet, err := providerKeeper.GetConsumerValSet(ctx, CONSUMER_ID)
+	require.NoError(t, err)
+	require.Len(t, consumerValSet, 3)
+}
@@ -0,0 +1,197 @@
+package keeper
+
+import (
+	"context"
+	"sync"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// vscSubscriptionBufferSize is the number of VSC packets buffered for a subscriber of the
+// StreamValidatorSetChanges query. A subscriber that falls further behind is disconnected,
+// as publishing the VSC packets must never block the EndBlock of the provider module.
+const vscSubscriptionBufferSize = 256
+
+// vscSubscription is a subscription to the VSC packets queued for a consumer chain
+type vscSubscription struct {
+	consumerId string
+	packets    chan *types.StreamValidatorSetChangesResponse
+}
+
+// stagedVSCPacket is a VSC packet queued for a consumer chain in the block being executed
+type stagedVSCPacket struct {
+	consumerId string
+	packet     ccv.ValidatorSetChangePacketData
+}
+
+// vscPacketStream fans the VSC packets queued in EndBlock out to the subscribers of the
+// StreamValidatorSetChanges query. The packets are staged while the block is executed and
+// are only published once the block is committed, so that the packets queued by an execution
+// that is not committed, e.g., an aborted optimistic execution, are never streamed.
+// It holds no consensus state and is shared by all the copies of the keeper, which is why
+// the keeper refers to it by pointer.
+type vscPacketStream struct {
+	mu            sync.Mutex
+	nextId        uint64
+	subscriptions map[uint64]*vscSubscription
+	stagedHeight  int64
+	staged        []stagedVSCPacket
+}
+
+func newVSCPacketStream() *vscPacketStream {
+	return &vscPacketStream{subscriptions: map[uint64]*vscSubscription{}}
+}
+
+// subscribe registers a subscription to the VSC packets queued for consumerId.
+// It returns the channel of the subscription, which is closed if the subscriber falls behind,
+// and a function that cancels the subscription.
+func (s *vscPacketStream) subscribe(consumerId string) (<-chan *types.StreamValidatorSetChangesResponse, func()) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := s.nextId
+	s.nextId++
+	sub := &vscSubscription{
+		consumerId: consumerId,
+		packets:    make(chan *types.StreamValidatorSetChangesResponse, vscSubscriptionBufferSize),
+	}
+	s.subscriptions[id] = sub
+
+	return sub.packets, func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		if _, found := s.subscriptions[id]; found {
+			delete(s.subscriptions, id)
+			close(sub.packets)
+		}
+	}
+}
+
+// resetStaged drops the VSC packets staged by a previous execution of a block,
+// i.e., of a block at a different height or of a previous execution of the block at height
+func (s *vscPacketStream) resetStaged(height int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.stagedHeight = height
+	s.staged = nil
+}
+
+// stage stages a VSC packet queued for consumerId in the block at height until the block is committed
+func (s *vscPacketStream) stage(consumerId string, height int64, packet ccv.ValidatorSetChangePacketData) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.stagedHeight != height {
+		// the packets staged at a different height were never committed
+		s.stagedHeight = height
+		s.staged = nil
+	}
+	s.staged = append(s.staged, stagedVSCPacket{consumerId: consumerId, packet: packet})
+}
+
+// publishStaged publishes the VSC packets staged in the block at height, which was just committed
+func (s *vscPacketStream) publishStaged(height int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.stagedHeight == height {
+		for _, staged := range s.staged {
+			s.publish(staged.consumerId, height, staged.packet)
+		}
+	}
+	s.staged = nil
+}
+
+// publish sends a VSC packet to the subscribers of consumerId without blocking,
+// i.e., the subscribers whose buffers are full are disconnected.
+// The caller must hold the lock of the stream.
+func (s *vscPacketStream) publish(consumerId string, height int64, packet ccv.ValidatorSetChangePacketData) {
+	for id, sub := range s.subscriptions {
+		if sub.consumerId != consumerId {
+			continue
+		}
+		select {
+		case sub.packets <- &types.StreamValidatorSetChangesResponse{
+			ConsumerId: consumerId,
+			Height:     height,
+			Packet:     packet,
+		}:
+		default:
+			delete(s.subscriptions, id)
+			close(sub.packets)
+		}
+	}
+}
+
+// publishVSCPacket stages a VSC packet queued for consumerId in the EndBlock of the current block,
+// so that the subscribers of the StreamValidatorSetChanges query are notified once the block is committed
+// (see VSCPacketStreamListener)
+func (k Keeper) publishVSCPacket(ctx sdk.Context, consumerId string, packet ccv.ValidatorSetChangePacketData) {
+	k.vscPacketStream.stage(consumerId, ctx.BlockHeight(), packet)
+}
+
+// vscPacketStreamListener is the ABCI listener publishing the VSC packets staged in a block once it is committed
+type vscPacketStreamListener struct {
+	stream *vscPacketStream
+}
+
+var _ storetypes.ABCIListener = vscPacketStreamListener{}
+
+// ListenFinalizeBlock implements storetypes.ABCIListener
+func (l vscPacketStreamListener) ListenFinalizeBlock(context.Context, abci.RequestFinalizeBlock, abci.ResponseFinalizeBlock) error {
+	return nil
+}
+
+// ListenCommit implements storetypes.ABCIListener
+func (l vscPacketStreamListener) ListenCommit(ctx context.Context, _ abci.ResponseCommit, _ []*storetypes.StoreKVPair) error {
+	l.stream.publishStaged(sdk.UnwrapSDKContext(ctx).BlockHeight())
+	return nil
+}
+
+// VSCPacketStreamListener returns the ABCI listener that publishes the VSC packets queued in a block
+// to the subscribers of the StreamValidatorSetChanges query once the block is committed.
+// The listener must be added to the streaming manager of the app, otherwise no VSC packets are streamed.
+func (k Keeper) VSCPacketStreamListener() storetypes.ABCIListener {
+	return vscPacketStreamListener{stream: k.vscPacketStream}
+}
+
+// StreamValidatorSetChanges streams the VSC packets queued for a consumer chain once the blocks in which they are queued are committed.
+// The stream ends with a ResourceExhausted error if the subscriber falls behind the queued VSC packets.
+func (k Keeper) StreamValidatorSetChanges(req *types.StreamValidatorSetChangesRequest, stream types.Query_StreamValidatorSetChangesServer) error {
+	if req == nil {
+		return status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if err := ccv.ValidateConsumerId(req.ConsumerId); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	packets, unsubscribe := k.vscPacketStream.subscribe(req.ConsumerId)
+	defer unsubscribe()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case packet, ok := <-packets:
+			if !ok {
+				return status.Errorf(codes.ResourceExhausted,
+					"subscriber fell more than %d VSC packets behind", vscSubscriptionBufferSize)
+			}
+			if err := stream.Send(packet); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,135 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	cryptotestutil "github.com/cosmos/interchain-security/v7/testutil/crypto"
+	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
+	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+)
+
+// mockVSCPacketStream is the server side of a StreamValidatorSetChanges stream
+type mockVSCPacketStream struct {
+	grpc.ServerStream
+	ctx        context.Context
+	subscribed chan struct{}
+	responses  chan *providertypes.StreamValidatorSetChangesResponse
+}
+
+func newMockVSCPacketStream(ctx context.Context) *mockVSCPacketStream {
+	return &mockVSCPacketStream{
+		ctx:        ctx,
+		subscribed: make(chan struct{}),
+		responses:  make(chan *providertypes.StreamValidatorSetChangesResponse, 1),
+	}
+}
+
+// Context is first called once the subscription is registered
+func (s *mockVSCPacketStream) Context() context.Context {
+	select {
+	case <-s.subscribed:
+	default:
+		close(s.subscribed)
+	}
+	return s.ctx
+}
+
+func (s *mockVSCPacketStream) Send(resp *providertypes.StreamValidatorSetChangesResponse) error {
+	s.responses <- resp
+	return nil
+}
+
+// TestStreamValidatorSetChanges tests that the VSC packets queued for a consumer chain
+// are streamed to the subscribers of the consumer chain
+func TestStreamValidatorSetChanges(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+	ctx = ctx.WithBlockHeight(42)
+
+	// an invalid consumer id is rejected
+	err := providerKeeper.StreamValidatorSetChanges(
+		&providertypes.StreamValidatorSetChangesRequest{ConsumerId: "invalid"},
+		newMockVSCPacketStream(context.Background()))
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	consumerId := "0"
+	providerKeeper.SetConsumerClientId(ctx, consumerId, "clientId")
+	providerKeeper.SetConsumerPhase(ctx, consumerId, providertypes.CONSUMER_PHASE_LAUNCHED)
+	jailedValidator := cryptotestutil.NewCryptoIdentityFromIntSeed(0)
+	jailedPubKey := jailedValidator.TMProtoCryptoPublicKey()
+	err = providerKeeper.SetConsumerValidator(ctx, consumerId, providertypes.ConsensusValidator{
+		ProviderConsAddr: jailedValidator.SDKValConsAddress(),
+		Power:            1,
+		PublicKey:        &jailedPubKey,
+	})
+	require.NoError(t, err)
+	providerKeeper.SetDowntimeJailedValidator(ctx, jailedValidator.ProviderConsAddress())
+
+	streamCtx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream := newMockVSCPacketStream(streamCtx)
+	done := make(chan error)
+	go func() {
+		done <- providerKeeper.StreamValidatorSetChanges(&providertypes.StreamValidatorSetChangesRequest{ConsumerId: consumerId}, stream)
+	}()
+	<-stream.subscribed
+
+	// queue a VSC packet removing the validator jailed for downtime
+	valUpdateID := providerKeeper.GetValidatorSetUpdateId(ctx)
+	queued, err := providerKeeper.RemoveDowntimeJailedValidators(ctx)
+	require.NoError(t, err)
+	require.True(t, queued)
+
+	// the VSC packet is not streamed before the block is committed
+	select {
+	case <-stream.responses:
+		t.Fatal("the queued VSC packet was streamed before the block was committed")
+	case <-time.After(100 * time.Millisecond):
+	}
+	require.NoError(t, providerKeeper.VSCPacketStreamListener().ListenCommit(ctx, abci.ResponseCommit{}, nil))
+
+	select {
+	case resp := <-stream.responses:
+		require.Equal(t, consumerId, resp.ConsumerId)
+		require.Equal(t, int64(42), resp.Height)
+		require.Equal(t, valUpdateID, resp.Packet.ValsetUpdateId)
+		require.Equal(t, []abci.ValidatorUpdate{{PubKey: jailedPubKey, Power: 0}}, resp.Packet.ValidatorUpdates)
+	case <-time.After(5 * time.Second):
+		t.Fatal("the queued VSC packet was not streamed")
+	}
+
+	// the VSC packets queued in a block that is not committed are not streamed
+	providerKeeper.SetDowntimeJailedValidator(ctx, jailedValidator.ProviderConsAddress())
+	require.NoError(t, providerKeeper.SetConsumerValidator(ctx, consumerId, providertypes.ConsensusValidator{
+		ProviderConsAddr: jailedValidator.SDKValConsAddress(),
+		Power:            1,
+		PublicKey:        &jailedPubKey,
+	}))
+	queued, err = providerKeeper.RemoveDowntimeJailedValidators(ctx.WithBlockHeight(43))
+	require.NoError(t, err)
+	require.True(t, queued)
+	require.NoError(t, providerKeeper.VSCPacketStreamListener().ListenCommit(ctx.WithBlockHeight(44), abci.ResponseCommit{}, nil))
+	select {
+	case <-stream.responses:
+		t.Fatal("a VSC packet queued in a block that was not committed was streamed")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	// the stream ends when the subscriber disconnects
+	cancel()
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("the stream did not end")
+	}
+}
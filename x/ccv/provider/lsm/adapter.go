@@ -0,0 +1,235 @@
+// Package lsm adapts the staking and slashing keepers of the liquid staking module (LSM) fork of the
+// staking module to the keepers expected by the provider module (see ccvtypes.ProviderStakingKeeper
+// and ccvtypes.ProviderSlashingKeeper).
+//
+// The LSM fork keeps the API of the v0.47 staking and slashing keepers: the keepers take an sdk.Context,
+// the validator and historical info lookups report whether the entry is found instead of returning an error,
+// and the getters, the iterators, and the jailing and slashing methods do not return errors.
+package lsm
+
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	ccvtypes "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// StakingKeeper defines the methods of the staking keeper of the LSM fork used by the provider module
+type StakingKeeper interface {
+	UnbondingCanComplete(ctx sdk.Context, id uint64) error
+	UnbondingTime(ctx sdk.Context) time.Duration
+	GetValidatorByConsAddr(ctx sdk.Context, consAddr sdk.ConsAddress) (validator stakingtypes.Validator, found bool)
+	GetLastValidatorPower(ctx sdk.Context, operator sdk.ValAddress) int64
+	Jail(ctx sdk.Context, consAddr sdk.ConsAddress)
+	Slash(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeight, power int64, slashFactor math.LegacyDec) math.Int
+	SlashWithInfractionReason(ctx sdk.Context, consAddr sdk.ConsAddress, infractionHeight, power int64, slashFactor math.LegacyDec, infraction stakingtypes.Infraction) math.Int
+	SlashUnbondingDelegation(ctx sdk.Context, unbondingDelegation stakingtypes.UnbondingDelegation, infractionHeight int64, slashFactor math.LegacyDec) math.Int
+	SlashRedelegation(ctx sdk.Context, srcValidator stakingtypes.Validator, redelegation stakingtypes.Redelegation, infractionHeight int64, slashFactor math.LegacyDec) math.Int
+	GetValidator(ctx sdk.Context, addr sdk.ValAddress) (validator stakingtypes.Validator, found bool)
+	PowerReduction(ctx sdk.Context) math.Int
+	MaxValidators(ctx sdk.Context) uint32
+	GetLastTotalPower(ctx sdk.Context) math.Int
+	BondDenom(ctx sdk.Context) string
+	GetUnbondingDelegationsFromValidator(ctx sdk.Context, valAddr sdk.ValAddress) []stakingtypes.UnbondingDelegation
+	GetRedelegationsFromSrcValidator(ctx sdk.Context, valAddr sdk.ValAddress) []stakingtypes.Redelegation
+	MinCommissionRate(ctx sdk.Context) math.LegacyDec
+	GetBondedValidatorsByPower(ctx sdk.Context) []stakingtypes.Validator
+	IterateDelegations(ctx sdk.Context, delegator sdk.AccAddress, fn func(index int64, delegation stakingtypes.DelegationI) (stop bool))
+	IterateBondedValidatorsByPower(ctx sdk.Context, fn func(index int64, validator stakingtypes.ValidatorI) (stop bool))
+	StakingTokenSupply(ctx sdk.Context) math.Int
+	BondedRatio(ctx sdk.Context) math.LegacyDec
+	TotalBondedTokens(ctx sdk.Context) math.Int
+	GetHistoricalInfo(ctx sdk.Context, height int64) (historicalInfo stakingtypes.HistoricalInfo, found bool)
+}
+
+// SlashingKeeper defines the methods of the slashing keeper of the LSM fork used by the provider module
+type SlashingKeeper interface {
+	JailUntil(ctx sdk.Context, consAddr sdk.ConsAddress, jailTime time.Time)
+	DowntimeJailDuration(ctx sdk.Context) time.Duration
+	SlashFractionDoubleSign(ctx sdk.Context) math.LegacyDec
+	Tombstone(ctx sdk.Context, consAddr sdk.ConsAddress)
+	IsTombstoned(ctx sdk.Context, consAddr sdk.ConsAddress) bool
+}
+
+var (
+	_ ccvtypes.ProviderStakingKeeper  = StakingKeeperAdapter{}
+	_ ccvtypes.ProviderSlashingKeeper = SlashingKeeperAdapter{}
+)
+
+// StakingKeeperAdapter adapts the staking keeper of the LSM fork to the staking keeper expected by the provider module
+type StakingKeeperAdapter struct {
+	keeper StakingKeeper
+}
+
+// NewStakingKeeperAdapter returns the StakingKeeperAdapter of the staking keeper `keeper` of the LSM fork
+func NewStakingKeeperAdapter(keeper StakingKeeper) StakingKeeperAdapter {
+	return StakingKeeperAdapter{keeper: keeper}
+}
+
+func (a StakingKeeperAdapter) UnbondingCanComplete(ctx context.Context, id uint64) error {
+	return a.keeper.UnbondingCanComplete(sdk.UnwrapSDKContext(ctx), id)
+}
+
+func (a StakingKeeperAdapter) UnbondingTime(ctx context.Context) (time.Duration, error) {
+	return a.keeper.UnbondingTime(sdk.UnwrapSDKContext(ctx)), nil
+}
+
+func (a StakingKeeperAdapter) GetValidatorByConsAddr(ctx context.Context, consAddr sdk.ConsAddress) (stakingtypes.Validator, error) {
+	validator, found := a.keeper.GetValidatorByConsAddr(sdk.UnwrapSDKContext(ctx), consAddr)
+	if !found {
+		return stakingtypes.Validator{}, stakingtypes.ErrNoValidatorFound
+	}
+	return validator, nil
+}
+
+func (a StakingKeeperAdapter) GetLastValidatorPower(ctx context.Context, operator sdk.ValAddress) (int64, error) {
+	return a.keeper.GetLastValidatorPower(sdk.UnwrapSDKContext(ctx), operator), nil
+}
+
+func (a StakingKeeperAdapter) Jail(ctx context.Context, consAddr sdk.ConsAddress) error {
+	a.keeper.Jail(sdk.UnwrapSDKContext(ctx), consAddr)
+	return nil
+}
+
+func (a StakingKeeperAdapter) Slash(ctx context.Context, consAddr sdk.ConsAddress, infractionHeight, power int64, slashFactor math.LegacyDec) (math.Int, error) {
+	return a.keeper.Slash(sdk.UnwrapSDKContext(ctx), consAddr, infractionHeight, power, slashFactor), nil
+}
+
+func (a StakingKeeperAdapter) SlashWithInfractionReason(ctx context.Context, consAddr sdk.ConsAddress, infractionHeight, power int64,
+	slashFactor math.LegacyDec, infraction stakingtypes.Infraction,
+) (math.Int, error) {
+	return a.keeper.SlashWithInfractionReason(sdk.UnwrapSDKContext(ctx), consAddr, infractionHeight, power, slashFactor, infraction), nil
+}
+
+func (a StakingKeeperAdapter) SlashUnbondingDelegation(ctx context.Context, unbondingDelegation stakingtypes.UnbondingDelegation,
+	infractionHeight int64, slashFactor math.LegacyDec,
+) (math.Int, error) {
+	return a.keeper.SlashUnbondingDelegation(sdk.UnwrapSDKContext(ctx), unbondingDelegation, infractionHeight, slashFactor), nil
+}
+
+func (a StakingKeeperAdapter) SlashRedelegation(ctx context.Context, srcValidator stakingtypes.Validator, redelegation stakingtypes.Redelegation,
+	infractionHeight int64, slashFactor math.LegacyDec,
+) (math.Int, error) {
+	return a.keeper.SlashRedelegation(sdk.UnwrapSDKContext(ctx), srcValidator, redelegation, infractionHeight, slashFactor), nil
+}
+
+func (a StakingKeeperAdapter) GetValidator(ctx context.Context, addr sdk.ValAddress) (stakingtypes.Validator, error) {
+	validator, found := a.keeper.GetValidator(sdk.UnwrapSDKContext(ctx), addr)
+	if !found {
+		return stakingtypes.Validator{}, stakingtypes.ErrNoValidatorFound
+	}
+	return validator, nil
+}
+
+func (a StakingKeeperAdapter) PowerReduction(ctx context.Context) math.Int {
+	return a.keeper.PowerReduction(sdk.UnwrapSDKContext(ctx))
+}
+
+// IsValidatorJailed returns whether the validator with consensus address `addr` is jailed;
+// the staking keeper of the LSM fork does not provide it, so it is derived from the validator
+func (a StakingKeeperAdapter) IsValidatorJailed(ctx context.Context, addr sdk.ConsAddress) (bool, error) {
+	validator, err := a.GetValidatorByConsAddr(ctx, addr)
+	if err != nil {
+		return false, err
+	}
+	return validator.IsJailed(), nil
+}
+
+func (a StakingKeeperAdapter) MaxValidators(ctx context.Context) (uint32, error) {
+	return a.keeper.MaxValidators(sdk.UnwrapSDKContext(ctx)), nil
+}
+
+func (a StakingKeeperAdapter) GetLastTotalPower(ctx context.Context) (math.Int, error) {
+	return a.keeper.GetLastTotalPower(sdk.UnwrapSDKContext(ctx)), nil
+}
+
+func (a StakingKeeperAdapter) BondDenom(ctx context.Context) (string, error) {
+	return a.keeper.BondDenom(sdk.UnwrapSDKContext(ctx)), nil
+}
+
+func (a StakingKeeperAdapter) GetUnbondingDelegationsFromValidator(ctx context.Context, valAddr sdk.ValAddress) ([]stakingtypes.UnbondingDelegation, error) {
+	return a.keeper.GetUnbondingDelegationsFromValidator(sdk.UnwrapSDKContext(ctx), valAddr), nil
+}
+
+func (a StakingKeeperAdapter) GetRedelegationsFromSrcValidator(ctx context.Context, valAddr sdk.ValAddress) ([]stakingtypes.Redelegation, error) {
+	return a.keeper.GetRedelegationsFromSrcValidator(sdk.UnwrapSDKContext(ctx), valAddr), nil
+}
+
+func (a StakingKeeperAdapter) MinCommissionRate(ctx context.Context) (math.LegacyDec, error) {
+	return a.keeper.MinCommissionRate(sdk.UnwrapSDKContext(ctx)), nil
+}
+
+func (a StakingKeeperAdapter) GetBondedValidatorsByPower(ctx context.Context) ([]stakingtypes.Validator, error) {
+	return a.keeper.GetBondedValidatorsByPower(sdk.UnwrapSDKContext(ctx)), nil
+}
+
+func (a StakingKeeperAdapter) IterateDelegations(ctx context.Context, delegator sdk.AccAddress,
+	fn func(index int64, delegation stakingtypes.DelegationI) (stop bool),
+) error {
+	a.keeper.IterateDelegations(sdk.UnwrapSDKContext(ctx), delegator, fn)
+	return nil
+}
+
+func (a StakingKeeperAdapter) IterateBondedValidatorsByPower(ctx context.Context,
+	fn func(index int64, validator stakingtypes.ValidatorI) (stop bool),
+) error {
+	a.keeper.IterateBondedValidatorsByPower(sdk.UnwrapSDKContext(ctx), fn)
+	return nil
+}
+
+func (a StakingKeeperAdapter) StakingTokenSupply(ctx context.Context) (math.Int, error) {
+	return a.keeper.StakingTokenSupply(sdk.UnwrapSDKContext(ctx)), nil
+}
+
+func (a StakingKeeperAdapter) BondedRatio(ctx context.Context) (math.LegacyDec, error) {
+	return a.keeper.BondedRatio(sdk.UnwrapSDKContext(ctx)), nil
+}
+
+func (a StakingKeeperAdapter) TotalBondedTokens(ctx context.Context) (math.Int, error) {
+	return a.keeper.TotalBondedTokens(sdk.UnwrapSDKContext(ctx)), nil
+}
+
+func (a StakingKeeperAdapter) GetHistoricalInfo(ctx context.Context, height int64) (stakingtypes.HistoricalInfo, error) {
+	historicalInfo, found := a.keeper.GetHistoricalInfo(sdk.UnwrapSDKContext(ctx), height)
+	if !found {
+		return stakingtypes.HistoricalInfo{}, stakingtypes.ErrNoHistoricalInfo
+	}
+	return historicalInfo, nil
+}
+
+// SlashingKeeperAdapter adapts the slashing keeper of the LSM fork to the slashing keeper expected by the provider module
+type SlashingKeeperAdapter struct {
+	keeper SlashingKeeper
+}
+
+// NewSlashingKeeperAdapter returns the SlashingKeeperAdapter of the slashing keeper `keeper` of the LSM fork
+func NewSlashingKeeperAdapter(keeper SlashingKeeper) SlashingKeeperAdapter {
+	return SlashingKeeperAdapter{keeper: keeper}
+}
+
+func (a SlashingKeeperAdapter) JailUntil(ctx context.Context, consAddr sdk.ConsAddress, jailTime time.Time) error {
+	a.keeper.JailUntil(sdk.UnwrapSDKContext(ctx), consAddr, jailTime)
+	return nil
+}
+
+func (a SlashingKeeperAdapter) DowntimeJailDuration(ctx context.Context) (time.Duration, error) {
+	return a.keeper.DowntimeJailDuration(sdk.UnwrapSDKContext(ctx)), nil
+}
+
+func (a SlashingKeeperAdapter) SlashFractionDoubleSign(ctx context.Context) (math.LegacyDec, error) {
+	return a.keeper.SlashFractionDoubleSign(sdk.UnwrapSDKContext(ctx)), nil
+}
+
+func (a SlashingKeeperAdapter) Tombstone(ctx context.Context, consAddr sdk.ConsAddress) error {
+	a.keeper.Tombstone(sdk.UnwrapSDKContext(ctx), consAddr)
+	return nil
+}
+
+func (a SlashingKeeperAdapter) IsTombstoned(ctx context.Context, consAddr sdk.ConsAddress) bool {
+	return a.keeper.IsTombstoned(sdk.UnwrapSDKContext(ctx), consAddr)
+}
@@ -0,0 +1,90 @@
+package lsm_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
+	"github.com/cosmos/interchain-security/v7/x/ccv/provider/lsm"
+)
+
+// fakeStakingKeeper is a staking keeper of the LSM fork with a single validator
+type fakeStakingKeeper struct {
+	lsm.StakingKeeper
+	validator stakingtypes.Validator
+	consAddr  sdk.ConsAddress
+	jailed    []sdk.ConsAddress
+}
+
+func (k *fakeStakingKeeper) GetValidatorByConsAddr(_ sdk.Context, consAddr sdk.ConsAddress) (stakingtypes.Validator, bool) {
+	return k.validator, consAddr.Equals(k.consAddr)
+}
+
+func (k *fakeStakingKeeper) GetValidator(_ sdk.Context, addr sdk.ValAddress) (stakingtypes.Validator, bool) {
+	return k.validator, addr.String() == k.validator.OperatorAddress
+}
+
+func (k *fakeStakingKeeper) GetHistoricalInfo(sdk.Context, int64) (stakingtypes.HistoricalInfo, bool) {
+	return stakingtypes.HistoricalInfo{}, false
+}
+
+func (k *fakeStakingKeeper) Jail(_ sdk.Context, consAddr sdk.ConsAddress) {
+	k.jailed = append(k.jailed, consAddr)
+	k.validator.Jailed = true
+}
+
+func (k *fakeStakingKeeper) SlashWithInfractionReason(_ sdk.Context, _ sdk.ConsAddress, _, _ int64, slashFactor math.LegacyDec,
+	_ stakingtypes.Infraction,
+) math.Int {
+	return slashFactor.MulInt(k.validator.Tokens).TruncateInt()
+}
+
+func TestStakingKeeperAdapter(t *testing.T) {
+	_, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	valAddr := sdk.ValAddress([]byte("validator"))
+	consAddr := sdk.ConsAddress([]byte("consensus"))
+	keeper := &fakeStakingKeeper{
+		validator: stakingtypes.Validator{OperatorAddress: valAddr.String(), Tokens: math.NewInt(1000)},
+		consAddr:  consAddr,
+	}
+	adapter := lsm.NewStakingKeeperAdapter(keeper)
+
+	// the validators that are not found are reported with the errors of the SDK staking keeper
+	validator, err := adapter.GetValidator(ctx, valAddr)
+	require.NoError(t, err)
+	require.Equal(t, keeper.validator, validator)
+	_, err = adapter.GetValidator(ctx, sdk.ValAddress([]byte("unknown")))
+	require.ErrorIs(t, err, stakingtypes.ErrNoValidatorFound)
+	validator, err = adapter.GetValidatorByConsAddr(ctx, consAddr)
+	require.NoError(t, err)
+	require.Equal(t, keeper.validator, validator)
+	_, err = adapter.GetValidatorByConsAddr(ctx, sdk.ConsAddress([]byte("unknown")))
+	require.ErrorIs(t, err, stakingtypes.ErrNoValidatorFound)
+	_, err = adapter.GetHistoricalInfo(ctx, 1)
+	require.ErrorIs(t, err, stakingtypes.ErrNoHistoricalInfo)
+
+	// whether a validator is jailed is derived from the validator
+	jailed, err := adapter.IsValidatorJailed(ctx, consAddr)
+	require.NoError(t, err)
+	require.False(t, jailed)
+	require.NoError(t, adapter.Jail(ctx, consAddr))
+	require.Equal(t, []sdk.ConsAddress{consAddr}, keeper.jailed)
+	jailed, err = adapter.IsValidatorJailed(ctx, consAddr)
+	require.NoError(t, err)
+	require.True(t, jailed)
+	_, err = adapter.IsValidatorJailed(ctx, sdk.ConsAddress([]byte("unknown")))
+	require.ErrorIs(t, err, stakingtypes.ErrNoValidatorFound)
+
+	burned, err := adapter.SlashWithInfractionReason(ctx, consAddr, 1, 1, math.LegacyNewDecWithPrec(5, 2),
+		stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(50), burned)
+}
@@ -9,8 +9,13 @@ import (
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
 	providerkeeper "github.com/cosmos/interchain-security/v7/x/ccv/provider/keeper"
+	v10 "github.com/cosmos/interchain-security/v7/x/ccv/provider/migrations/v10"
+	v11 "github.com/cosmos/interchain-security/v7/x/ccv/provider/migrations/v11"
+	v12 "github.com/cosmos/interchain-security/v7/x/ccv/provider/migrations/v12"
+	v13 "github.com/cosmos/interchain-security/v7/x/ccv/provider/migrations/v13"
 	v7 "github.com/cosmos/interchain-security/v7/x/ccv/provider/migrations/v7"
 	v8 "github.com/cosmos/interchain-security/v7/x/ccv/provider/migrations/v8"
+	v9 "github.com/cosmos/interchain-security/v7/x/ccv/provider/migrations/v9"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -96,3 +101,33 @@ func (m Migrator) Migrate7to8(ctx sdktypes.Context) error {
 
 	return nil
 }
+
+// Migrate8to9 migrates x/ccvprovider state from consensus version 8 to 9.
+// The migration consists of initializing the MinTopNBudget param.
+func (m Migrator) Migrate8to9(ctx sdktypes.Context) error {
+	return v9.MigrateMinTopNBudget(ctx, m.providerKeeper)
+}
+
+// Migrate9to10 migrates x/ccvprovider state from consensus version 9 to 10.
+// The migration consists of setting the default throttling parameters for all the existing consumer chains.
+func (m Migrator) Migrate9to10(ctx sdktypes.Context) error {
+	return v10.MigrateConsumerThrottlingParameters(ctx, m.providerKeeper)
+}
+
+// Migrate10to11 migrates x/ccvprovider state from consensus version 10 to 11.
+// The migration consists of storing the update times of the queued infraction parameters of the consumer chains.
+func (m Migrator) Migrate10to11(ctx sdktypes.Context) error {
+	return v11.MigrateInfractionUpdateTimes(ctx, ctx.KVStore(m.storeKey), m.providerKeeper)
+}
+
+// Migrate11to12 migrates x/ccvprovider state from consensus version 11 to 12.
+// The migration consists of initializing the EpochDuration param, i.e., the epochs remain expressed in blocks.
+func (m Migrator) Migrate11to12(ctx sdktypes.Context) error {
+	return v12.MigrateEpochDuration(ctx, m.providerKeeper)
+}
+
+// Migrate12to13 migrates x/ccvprovider state from consensus version 12 to 13.
+// The migration consists of initializing the ClientExpiryWarningThreshold param.
+func (m Migrator) Migrate12to13(ctx sdktypes.Context) error {
+	return v13.MigrateClientExpiryWarningThreshold(ctx, m.providerKeeper)
+}
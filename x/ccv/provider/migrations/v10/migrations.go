@@ -0,0 +1,23 @@
+package v10
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	providerkeeper "github.com/cosmos/interchain-security/v7/x/ccv/provider/keeper"
+	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+)
+
+// MigrateConsumerThrottlingParameters sets the default throttling parameters for all the existing consumer chains,
+// i.e., their slash packets remain throttled by the global slash meter
+func MigrateConsumerThrottlingParameters(ctx sdk.Context, pk providerkeeper.Keeper) error {
+	for _, consumerId := range pk.GetAllConsumerIds(ctx) {
+		if _, err := pk.GetConsumerThrottlingParameters(ctx, consumerId); err == nil {
+			continue
+		}
+		if err := pk.SetConsumerThrottlingParameters(ctx, consumerId, providertypes.ThrottlingParameters{}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
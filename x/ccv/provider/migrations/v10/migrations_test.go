@@ -0,0 +1,38 @@
+package v10
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	testutil "github.com/cosmos/interchain-security/v7/testutil/keeper"
+	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+)
+
+func TestMigrateConsumerThrottlingParameters(t *testing.T) {
+	pk, ctx, ctrl, _ := testutil.GetProviderKeeperAndCtx(t, testutil.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	// three consumer chains exist before the migration
+	for i := 0; i < 3; i++ {
+		pk.FetchAndIncrementConsumerId(ctx)
+	}
+	// the throttling parameters of a consumer chain that are already set are left unchanged
+	existing := providertypes.ThrottlingParameters{SlashMeterReplenishPeriod: time.Hour}
+	err := pk.SetConsumerThrottlingParameters(ctx, "1", existing)
+	require.NoError(t, err)
+
+	err = MigrateConsumerThrottlingParameters(ctx, pk)
+	require.NoError(t, err)
+
+	for _, consumerId := range []string{"0", "2"} {
+		params, err := pk.GetConsumerThrottlingParameters(ctx, consumerId)
+		require.NoError(t, err)
+		require.Equal(t, providertypes.ThrottlingParameters{}, params)
+		require.False(t, pk.HasConsumerSlashMeter(ctx, consumerId))
+	}
+	params, err := pk.GetConsumerThrottlingParameters(ctx, "1")
+	require.NoError(t, err)
+	require.Equal(t, existing, params)
+}
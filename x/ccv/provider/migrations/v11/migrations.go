@@ -0,0 +1,35 @@
+package v11
+
+import (
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	providerkeeper "github.com/cosmos/interchain-security/v7/x/ccv/provider/keeper"
+	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+)
+
+// MigrateInfractionUpdateTimes stores the time at which the queued infraction parameters of every consumer chain
+// are applied, i.e., the time under which the consumer chain is scheduled in the infraction update schedule
+func MigrateInfractionUpdateTimes(ctx sdk.Context, store storetypes.KVStore, pk providerkeeper.Keeper) error {
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{providertypes.InfractionScheduledTimeToConsumerIdsKeyPrefix()})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		ts, err := providertypes.ParseTime(providertypes.InfractionScheduledTimeToConsumerIdsKeyPrefix(), iterator.Key())
+		if err != nil {
+			return err
+		}
+		consumerIds, err := pk.GetFromInfractionUpdateSchedule(ctx, ts)
+		if err != nil {
+			return err
+		}
+		for _, consumerId := range consumerIds.Ids {
+			if err := pk.SetConsumerInfractionUpdateTime(ctx, consumerId, ts); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package v11
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	testutil "github.com/cosmos/interchain-security/v7/testutil/keeper"
+)
+
+func TestMigrateInfractionUpdateTimes(t *testing.T) {
+	inMemParams := testutil.NewInMemKeeperParams(t)
+	pk, ctx, ctrl, _ := testutil.GetProviderKeeperAndCtx(t, inMemParams)
+	defer ctrl.Finish()
+
+	// the infraction parameters of three consumer chains are queued before the migration
+	consumerIds := []string{"0", "1", "2"}
+	updateTimes := []time.Time{time.Unix(2000, 0).UTC(), time.Unix(1000, 0).UTC(), time.Unix(2000, 0).UTC()}
+	for i, consumerId := range consumerIds {
+		require.NoError(t, pk.SetInfractionParameters(ctx, consumerId, testutil.GetTestInfractionParameters()))
+		require.NoError(t, pk.SetQueuedInfractionParameters(ctx, consumerId, testutil.GetTestInfractionParameters()))
+		require.NoError(t, pk.AddToInfractionUpdateSchedule(ctx, consumerId, updateTimes[i]))
+	}
+
+	err := MigrateInfractionUpdateTimes(ctx, ctx.KVStore(inMemParams.StoreKey), pk)
+	require.NoError(t, err)
+
+	for i, consumerId := range consumerIds {
+		updateTime, err := pk.GetConsumerInfractionUpdateTime(ctx, consumerId)
+		require.NoError(t, err)
+		require.Equal(t, updateTimes[i], updateTime)
+	}
+	_, err = pk.GetConsumerInfractionUpdateTime(ctx, "3")
+	require.Error(t, err)
+
+	// the pending updates are ordered by the time at which they are applied
+	updates, err := pk.GetAllPendingInfractionParametersUpdates(ctx)
+	require.NoError(t, err)
+	require.Len(t, updates, 3)
+	for i, consumerId := range []string{"1", "0", "2"} {
+		require.Equal(t, consumerId, updates[i].ConsumerId)
+	}
+}
@@ -0,0 +1,24 @@
+package v12
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	providerkeeper "github.com/cosmos/interchain-security/v7/x/ccv/provider/keeper"
+	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+)
+
+// MigrateEpochDuration initializes the EpochDuration param to its default value, i.e., the epochs of the
+// provider chain remain expressed in blocks, and checks that the epoch length params are mutually exclusive
+func MigrateEpochDuration(ctx sdk.Context, pk providerkeeper.Keeper) error {
+	params := pk.GetParams(ctx)
+	params.EpochDuration = providertypes.DefaultEpochDuration
+	if params.BlocksPerEpoch <= 0 {
+		params.BlocksPerEpoch = providertypes.DefaultBlocksPerEpoch
+	}
+	if err := providertypes.ValidateEpochLength(params.BlocksPerEpoch, params.EpochDuration); err != nil {
+		return err
+	}
+	pk.SetParams(ctx, params)
+
+	return nil
+}
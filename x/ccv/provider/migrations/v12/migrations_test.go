@@ -0,0 +1,38 @@
+package v12
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	testutil "github.com/cosmos/interchain-security/v7/testutil/keeper"
+	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+)
+
+func TestMigrateEpochDuration(t *testing.T) {
+	inMemParams := testutil.NewInMemKeeperParams(t)
+	pk, ctx, ctrl, _ := testutil.GetProviderKeeperAndCtx(t, inMemParams)
+	defer ctrl.Finish()
+
+	// the params before the migration express the epochs in blocks
+	params := providertypes.DefaultParams()
+	params.BlocksPerEpoch = 100
+	params.EpochDuration = 0
+	pk.SetParams(ctx, params)
+
+	require.NoError(t, MigrateEpochDuration(ctx, pk))
+	params = pk.GetParams(ctx)
+	require.Equal(t, int64(100), params.BlocksPerEpoch)
+	require.Equal(t, time.Duration(0), params.EpochDuration)
+	require.NoError(t, params.Validate())
+
+	// an unset number of blocks per epoch is initialized to its default value
+	params.BlocksPerEpoch = 0
+	pk.SetParams(ctx, params)
+
+	require.NoError(t, MigrateEpochDuration(ctx, pk))
+	params = pk.GetParams(ctx)
+	require.Equal(t, providertypes.DefaultBlocksPerEpoch, params.BlocksPerEpoch)
+	require.NoError(t, params.Validate())
+}
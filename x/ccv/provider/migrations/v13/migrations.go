@@ -0,0 +1,23 @@
+package v13
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	providerkeeper "github.com/cosmos/interchain-security/v7/x/ccv/provider/keeper"
+	ccvtypes "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// MigrateClientExpiryWarningThreshold initializes the ClientExpiryWarningThreshold param to its default value,
+// unless the param is already set
+func MigrateClientExpiryWarningThreshold(ctx sdk.Context, pk providerkeeper.Keeper) error {
+	params := pk.GetParams(ctx)
+	if params.ClientExpiryWarningThreshold == 0 {
+		params.ClientExpiryWarningThreshold = ccvtypes.DefaultClientExpiryWarningThreshold
+	}
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	pk.SetParams(ctx, params)
+
+	return nil
+}
@@ -0,0 +1,35 @@
+package v13
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	testutil "github.com/cosmos/interchain-security/v7/testutil/keeper"
+	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+	ccvtypes "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+func TestMigrateClientExpiryWarningThreshold(t *testing.T) {
+	inMemParams := testutil.NewInMemKeeperParams(t)
+	pk, ctx, ctrl, _ := testutil.GetProviderKeeperAndCtx(t, inMemParams)
+	defer ctrl.Finish()
+
+	// the params before the migration do not contain the ClientExpiryWarningThreshold param
+	params := providertypes.DefaultParams()
+	params.ClientExpiryWarningThreshold = 0
+	pk.SetParams(ctx, params)
+
+	require.NoError(t, MigrateClientExpiryWarningThreshold(ctx, pk))
+	params = pk.GetParams(ctx)
+	require.Equal(t, ccvtypes.DefaultClientExpiryWarningThreshold, params.ClientExpiryWarningThreshold)
+	require.NoError(t, params.Validate())
+
+	// an already set ClientExpiryWarningThreshold param is kept
+	params.ClientExpiryWarningThreshold = time.Hour
+	pk.SetParams(ctx, params)
+
+	require.NoError(t, MigrateClientExpiryWarningThreshold(ctx, pk))
+	require.Equal(t, time.Hour, pk.GetParams(ctx).ClientExpiryWarningThreshold)
+}
@@ -82,5 +82,16 @@ func GetParamsLegacy(ctx sdk.Context, paramspace ccvtypes.LegacyParamSubspace) t
 		getNumberOfEpochsToStartReceivingRewards(ctx, paramspace),
 		// this parameter is new so it doesn't need to be migrated, just initialized
 		types.DefaultMaxProviderConsensusValidators,
+		types.DefaultMaxLaunchedConsumers,
+		types.DefaultMinTopNBudget,
+		types.DefaultImmediateValidatorUpdates,
+		types.DefaultImmediateDowntimeJailing,
+		ccvtypes.DefaultClientExpiryWarningThreshold,
+		types.DefaultMaxValidatorUpdatesPerPacket,
+		types.DefaultStaleKeyAssignmentEpochs,
+		types.DefaultValidatorFeeExemptionsPerEpoch,
+		types.DefaultMaxChannelClosedDuration,
+		types.DefaultEpochDuration,
+		types.DefaultRestrictConsumerCreation,
 	)
 }
@@ -0,0 +1,23 @@
+package v9
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	providerkeeper "github.com/cosmos/interchain-security/v7/x/ccv/provider/keeper"
+	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+)
+
+// MigrateMinTopNBudget initializes the MinTopNBudget param to its default value,
+// unless the param is already set
+func MigrateMinTopNBudget(ctx sdk.Context, pk providerkeeper.Keeper) error {
+	params := pk.GetParams(ctx)
+	if params.MinTopNBudget == 0 {
+		params.MinTopNBudget = providertypes.DefaultMinTopNBudget
+	}
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	pk.SetParams(ctx, params)
+
+	return nil
+}
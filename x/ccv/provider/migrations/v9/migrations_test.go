@@ -0,0 +1,34 @@
+package v9
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	testutil "github.com/cosmos/interchain-security/v7/testutil/keeper"
+	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+)
+
+func TestMigrateMinTopNBudget(t *testing.T) {
+	inMemParams := testutil.NewInMemKeeperParams(t)
+	pk, ctx, ctrl, _ := testutil.GetProviderKeeperAndCtx(t, inMemParams)
+	defer ctrl.Finish()
+
+	// the params before the migration do not contain the MinTopNBudget param
+	params := providertypes.DefaultParams()
+	params.MinTopNBudget = 0
+	pk.SetParams(ctx, params)
+	require.Error(t, pk.GetParams(ctx).Validate())
+
+	require.NoError(t, MigrateMinTopNBudget(ctx, pk))
+	params = pk.GetParams(ctx)
+	require.Equal(t, providertypes.DefaultMinTopNBudget, params.MinTopNBudget)
+	require.NoError(t, params.Validate())
+
+	// an already set MinTopNBudget param is kept
+	params.MinTopNBudget = 5
+	pk.SetParams(ctx, params)
+
+	require.NoError(t, MigrateMinTopNBudget(ctx, pk))
+	require.Equal(t, uint32(5), pk.GetParams(ctx).MinTopNBudget)
+}
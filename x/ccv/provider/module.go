@@ -147,6 +147,21 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	if err := cfg.RegisterMigration(providertypes.ModuleName, 7, migrator.Migrate7to8); err != nil {
 		panic(fmt.Sprintf("failed to register migrator for %s: %s -- from 7 -> 8", providertypes.ModuleName, err))
 	}
+	if err := cfg.RegisterMigration(providertypes.ModuleName, 8, migrator.Migrate8to9); err != nil {
+		panic(fmt.Sprintf("failed to register migrator for %s: %s -- from 8 -> 9", providertypes.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(providertypes.ModuleName, 9, migrator.Migrate9to10); err != nil {
+		panic(fmt.Sprintf("failed to register migrator for %s: %s -- from 9 -> 10", providertypes.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(providertypes.ModuleName, 10, migrator.Migrate10to11); err != nil {
+		panic(fmt.Sprintf("failed to register migrator for %s: %s -- from 10 -> 11", providertypes.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(providertypes.ModuleName, 11, migrator.Migrate11to12); err != nil {
+		panic(fmt.Sprintf("failed to register migrator for %s: %s -- from 11 -> 12", providertypes.ModuleName, err))
+	}
+	if err := cfg.RegisterMigration(providertypes.ModuleName, 12, migrator.Migrate12to13); err != nil {
+		panic(fmt.Sprintf("failed to register migrator for %s: %s -- from 12 -> 13", providertypes.ModuleName, err))
+	}
 }
 
 // InitGenesis performs genesis initialization for the provider module. It returns validator updates
@@ -168,7 +183,7 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 }
 
 // ConsensusVersion implements AppModule/ConsensusVersion.
-func (AppModule) ConsensusVersion() uint64 { return 8 }
+func (AppModule) ConsensusVersion() uint64 { return providertypes.ConsensusVersion }
 
 // BeginBlock implements the AppModule interface
 func (am AppModule) BeginBlock(ctx context.Context) error {
@@ -186,6 +201,22 @@ func (am AppModule) BeginBlock(ctx context.Context) error {
 	if err := am.keeper.BeginBlockUpdateInfractionParameters(sdkCtx); err != nil {
 		return err
 	}
+	// Apply the consumer key assignments whose activation height was reached
+	am.keeper.BeginBlockApplyScheduledKeyAssignments(sdkCtx)
+	// Verify the consumer clients that were upgraded against their registered upgrade plans
+	am.keeper.BeginBlockVerifyConsumerClientUpgrades(sdkCtx)
+	// Stop the consumer chains whose clients reached the switch heights of their registered provider switches
+	am.keeper.BeginBlockConsumerProviderSwitches(sdkCtx)
+	// Change the chain ids of consumer chains whose clients were upgraded to their pending chain ids
+	am.keeper.BeginBlockChangeConsumerChainIds(sdkCtx)
+	// Record the expiry times of the consumer clients and warn about the clients that are about to expire
+	am.keeper.BeginBlockUpdateConsumerClientExpiries(sdkCtx)
+	// Stop and schedule the removal of the consumer chains whose channel is closed or whose client is expired for too long
+	am.keeper.BeginBlockRemoveUnreachableConsumers(sdkCtx)
+	// Warn about the assigned consumer keys that were never observed in the heartbeats of the consumer chains
+	am.keeper.BeginBlockCheckStaleKeyAssignments(sdkCtx)
+	// Reset the fee exemptions of the validators at the beginning of every epoch
+	am.keeper.BeginBlockResetValidatorFeeExemptions(sdkCtx)
 	// Check for replenishing slash meter before any slash packets are processed for this block
 	am.keeper.BeginBlockCIS(sdkCtx)
 	// BeginBlock logic needed for the  Reward Distribution sub-protocol
@@ -217,6 +248,8 @@ func (am AppModule) RegisterStoreDecoder(sdr simtypes.StoreDecoderRegistry) {
 }
 
 // WeightedOperations returns the all the provider module operations with their respective weights.
-func (am AppModule) WeightedOperations(_ module.SimulationState) []simtypes.WeightedOperation {
-	return nil
+func (am AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
+	return simulation.WeightedOperations(
+		simState.AppParams, simState.TxConfig, am.keeper.GetAccountKeeper(), am.keeper.GetBankKeeper(), am.keeper,
+	)
 }
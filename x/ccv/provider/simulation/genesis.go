@@ -5,7 +5,10 @@ import (
 	"fmt"
 	"math/rand"
 
+	"cosmossdk.io/math"
+
 	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 
 	"github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
 )
@@ -13,7 +16,13 @@ import (
 // Simulation parameter constants
 const (
 	// only includes params that make sense even with a single
-	maxProviderConsensusValidators = "max_provider_consensus_validators"
+	maxProviderConsensusValidators        = "max_provider_consensus_validators"
+	blocksPerEpoch                        = "blocks_per_epoch"
+	numberOfEpochsToStartReceivingRewards = "number_of_epochs_to_start_receiving_rewards"
+	slashMeterReplenishFraction           = "slash_meter_replenish_fraction"
+	maxLaunchedConsumers                  = "max_launched_consumers"
+	restrictConsumerCreation              = "restrict_consumer_creation"
+	consumerCreatorAllowlist              = "consumer_creator_allowlist"
 )
 
 // genMaxProviderConsensusValidators returns randomized maxProviderConsensusValidators
@@ -21,20 +30,74 @@ func genMaxProviderConsensusValidators(r *rand.Rand) int64 {
 	return int64(r.Intn(250) + 1)
 }
 
-// RandomizedGenState generates a random GenesisState for staking
+// genBlocksPerEpoch returns randomized blocksPerEpoch; epochs are kept short,
+// so that validator set changes are sent to the consumer chains during simulations
+func genBlocksPerEpoch(r *rand.Rand) int64 {
+	return int64(r.Intn(20) + 1)
+}
+
+// genNumberOfEpochsToStartReceivingRewards returns randomized numberOfEpochsToStartReceivingRewards
+func genNumberOfEpochsToStartReceivingRewards(r *rand.Rand) int64 {
+	return int64(r.Intn(10) + 1)
+}
+
+// genSlashMeterReplenishFraction returns randomized slashMeterReplenishFraction
+func genSlashMeterReplenishFraction(r *rand.Rand) string {
+	return math.LegacyNewDecWithPrec(int64(r.Intn(100)+1), 2).String()
+}
+
+// genMaxLaunchedConsumers returns randomized maxLaunchedConsumers, where 0 means no limit
+func genMaxLaunchedConsumers(r *rand.Rand) uint64 {
+	return uint64(r.Intn(5))
+}
+
+// genRestrictConsumerCreation returns randomized restrictConsumerCreation
+func genRestrictConsumerCreation(r *rand.Rand) bool {
+	return r.Intn(2) == 0
+}
+
+// genConsumerCreatorAllowlist returns a randomized allowlist of consumer creators
+// consisting of at least one of the simulation accounts
+func genConsumerCreatorAllowlist(r *rand.Rand, accs []simtypes.Account) []string {
+	allowlist := []string{}
+	for _, i := range r.Perm(len(accs))[:r.Intn(len(accs))+1] {
+		allowlist = append(allowlist, accs[i].Address.String())
+	}
+	return allowlist
+}
+
+// RandomizedGenState generates a random GenesisState for provider
 func RandomizedGenState(simState *module.SimulationState) {
 	// params
 	var (
-		maxProviderConsensusVals int64
+		maxProviderConsensusVals  int64
+		epochBlocks               int64
+		epochsToReceiveRewards    int64
+		slashMeterReplenishFrac   string
+		maxLaunchedConsumerChains uint64
+		restrictCreation          bool
+		creatorAllowlist          []string
 	)
 
 	simState.AppParams.GetOrGenerate(maxProviderConsensusValidators, &maxProviderConsensusVals, simState.Rand, func(r *rand.Rand) { maxProviderConsensusVals = genMaxProviderConsensusValidators(r) })
+	simState.AppParams.GetOrGenerate(blocksPerEpoch, &epochBlocks, simState.Rand, func(r *rand.Rand) { epochBlocks = genBlocksPerEpoch(r) })
+	simState.AppParams.GetOrGenerate(numberOfEpochsToStartReceivingRewards, &epochsToReceiveRewards, simState.Rand, func(r *rand.Rand) { epochsToReceiveRewards = genNumberOfEpochsToStartReceivingRewards(r) })
+	simState.AppParams.GetOrGenerate(slashMeterReplenishFraction, &slashMeterReplenishFrac, simState.Rand, func(r *rand.Rand) { slashMeterReplenishFrac = genSlashMeterReplenishFraction(r) })
+	simState.AppParams.GetOrGenerate(maxLaunchedConsumers, &maxLaunchedConsumerChains, simState.Rand, func(r *rand.Rand) { maxLaunchedConsumerChains = genMaxLaunchedConsumers(r) })
+	simState.AppParams.GetOrGenerate(restrictConsumerCreation, &restrictCreation, simState.Rand, func(r *rand.Rand) { restrictCreation = genRestrictConsumerCreation(r) })
+	simState.AppParams.GetOrGenerate(consumerCreatorAllowlist, &creatorAllowlist, simState.Rand, func(r *rand.Rand) { creatorAllowlist = genConsumerCreatorAllowlist(r, simState.Accounts) })
 
 	providerParams := types.DefaultParams()
 	providerParams.MaxProviderConsensusValidators = maxProviderConsensusVals
+	providerParams.BlocksPerEpoch = epochBlocks
+	providerParams.NumberOfEpochsToStartReceivingRewards = epochsToReceiveRewards
+	providerParams.SlashMeterReplenishFraction = slashMeterReplenishFrac
+	providerParams.MaxLaunchedConsumers = maxLaunchedConsumerChains
+	providerParams.RestrictConsumerCreation = restrictCreation
 
 	providerGenesis := types.DefaultGenesisState()
 	providerGenesis.Params = providerParams
+	providerGenesis.ConsumerCreatorAllowlist = creatorAllowlist
 
 	bz, err := json.MarshalIndent(&providerGenesis.Params, "", " ")
 	if err != nil {
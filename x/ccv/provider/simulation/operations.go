@@ -0,0 +1,584 @@
+package simulation
+
+import (
+	"encoding/base64"
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+
+	"github.com/cometbft/cometbft/crypto/ed25519"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/provider/keeper"
+	"github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+	ccvtypes "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// Simulation operation weights constants
+const (
+	DefaultWeightMsgCreateConsumer            int = 20
+	DefaultWeightMsgUpdateConsumer            int = 20
+	DefaultWeightMsgRemoveConsumer            int = 5
+	DefaultWeightMsgOptIn                     int = 50
+	DefaultWeightMsgOptOut                    int = 20
+	DefaultWeightMsgAssignConsumerKey         int = 30
+	DefaultWeightMsgSetConsumerCommissionRate int = 30
+
+	OpWeightMsgCreateConsumer            = "op_weight_msg_create_consumer"
+	OpWeightMsgUpdateConsumer            = "op_weight_msg_update_consumer"
+	OpWeightMsgRemoveConsumer            = "op_weight_msg_remove_consumer"
+	OpWeightMsgOptIn                     = "op_weight_msg_opt_in"
+	OpWeightMsgOptOut                    = "op_weight_msg_opt_out"
+	OpWeightMsgAssignConsumerKey         = "op_weight_msg_assign_consumer_key"
+	OpWeightMsgSetConsumerCommissionRate = "op_weight_msg_set_consumer_commission_rate"
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams,
+	txGen client.TxConfig,
+	ak simulation.AccountKeeper,
+	bk simulation.BankKeeper,
+	k *keeper.Keeper,
+) simulation.WeightedOperations {
+	var (
+		weightMsgCreateConsumer            int
+		weightMsgUpdateConsumer            int
+		weightMsgRemoveConsumer            int
+		weightMsgOptIn                     int
+		weightMsgOptOut                    int
+		weightMsgAssignConsumerKey         int
+		weightMsgSetConsumerCommissionRate int
+	)
+
+	appParams.GetOrGenerate(OpWeightMsgCreateConsumer, &weightMsgCreateConsumer, nil, func(_ *rand.Rand) {
+		weightMsgCreateConsumer = DefaultWeightMsgCreateConsumer
+	})
+
+	appParams.GetOrGenerate(OpWeightMsgUpdateConsumer, &weightMsgUpdateConsumer, nil, func(_ *rand.Rand) {
+		weightMsgUpdateConsumer = DefaultWeightMsgUpdateConsumer
+	})
+
+	appParams.GetOrGenerate(OpWeightMsgRemoveConsumer, &weightMsgRemoveConsumer, nil, func(_ *rand.Rand) {
+		weightMsgRemoveConsumer = DefaultWeightMsgRemoveConsumer
+	})
+
+	appParams.GetOrGenerate(OpWeightMsgOptIn, &weightMsgOptIn, nil, func(_ *rand.Rand) {
+		weightMsgOptIn = DefaultWeightMsgOptIn
+	})
+
+	appParams.GetOrGenerate(OpWeightMsgOptOut, &weightMsgOptOut, nil, func(_ *rand.Rand) {
+		weightMsgOptOut = DefaultWeightMsgOptOut
+	})
+
+	appParams.GetOrGenerate(OpWeightMsgAssignConsumerKey, &weightMsgAssignConsumerKey, nil, func(_ *rand.Rand) {
+		weightMsgAssignConsumerKey = DefaultWeightMsgAssignConsumerKey
+	})
+
+	appParams.GetOrGenerate(OpWeightMsgSetConsumerCommissionRate, &weightMsgSetConsumerCommissionRate, nil, func(_ *rand.Rand) {
+		weightMsgSetConsumerCommissionRate = DefaultWeightMsgSetConsumerCommissionRate
+	})
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgCreateConsumer,
+			SimulateMsgCreateConsumer(txGen, ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgUpdateConsumer,
+			SimulateMsgUpdateConsumer(txGen, ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgRemoveConsumer,
+			SimulateMsgRemoveConsumer(txGen, ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgOptIn,
+			SimulateMsgOptIn(txGen, ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgOptOut,
+			SimulateMsgOptOut(txGen, ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgAssignConsumerKey,
+			SimulateMsgAssignConsumerKey(txGen, ak, bk, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgSetConsumerCommissionRate,
+			SimulateMsgSetConsumerCommissionRate(txGen, ak, bk, k),
+		),
+	}
+}
+
+// SimulateMsgCreateConsumer generates a MsgCreateConsumer with random values, submitted by
+// a simulation account that is allowed to create consumer chains and that can pay the fees.
+// Half of the consumer chains are created with a spawn time, so that they get launched.
+func SimulateMsgCreateConsumer(
+	txGen client.TxConfig,
+	ak simulation.AccountKeeper,
+	bk simulation.BankKeeper,
+	k *keeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgCreateConsumer{})
+
+		simAccount, found := randomConsumerCreator(r, ctx, k, bk, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no funded simulation account is allowed to create consumers"), nil, nil
+		}
+
+		initializationParameters, err := types.ConsumerInitializationParametersForProfile(types.InitParamsProfileDevnetInstant)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to get initialization parameters"), nil, err
+		}
+		if r.Intn(2) == 0 {
+			initializationParameters.SpawnTime = randomSpawnTime(r, ctx)
+		}
+
+		msg, err := types.NewMsgCreateConsumer(
+			simAccount.Address.String(),
+			// the revision number of the chain id matches the default initial height
+			fmt.Sprintf("%s-1", strings.ToLower(simtypes.RandStringOfLength(r, 10))),
+			randomConsumerMetadata(r),
+			&initializationParameters,
+			nil,
+			nil,
+			nil,
+		)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to create MsgCreateConsumer"), nil, err
+		}
+
+		return deliverTx(r, app, ctx, txGen, ak, bk, simAccount, msg)
+	}
+}
+
+// SimulateMsgUpdateConsumer generates a MsgUpdateConsumer with random metadata for a consumer chain
+// owned by one of the simulation accounts. Consumer chains that are not yet launched get a spawn time.
+func SimulateMsgUpdateConsumer(
+	txGen client.TxConfig,
+	ak simulation.AccountKeeper,
+	bk simulation.BankKeeper,
+	k *keeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgUpdateConsumer{})
+
+		consumerId, owner, found := randomOwnedConsumer(r, ctx, k, accs, func(phase types.ConsumerPhase) bool {
+			return phase == types.CONSUMER_PHASE_REGISTERED ||
+				phase == types.CONSUMER_PHASE_INITIALIZED ||
+				phase == types.CONSUMER_PHASE_LAUNCHED
+		})
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no active consumer owned by a simulation account"), nil, nil
+		}
+
+		metadata := randomConsumerMetadata(r)
+		var initializationParameters *types.ConsumerInitializationParameters
+		if k.GetConsumerPhase(ctx, consumerId) != types.CONSUMER_PHASE_LAUNCHED {
+			params, err := k.GetConsumerInitializationParameters(ctx, consumerId)
+			if err != nil {
+				return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to get initialization parameters"), nil, err
+			}
+			params.SpawnTime = randomSpawnTime(r, ctx)
+			initializationParameters = &params
+		}
+
+		msg, err := types.NewMsgUpdateConsumer(
+			owner.Address.String(),
+			consumerId,
+			owner.Address.String(),
+			&metadata,
+			initializationParameters,
+			nil,
+			nil,
+			"",
+			nil,
+		)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to create MsgUpdateConsumer"), nil, err
+		}
+
+		return deliverTx(r, app, ctx, txGen, ak, bk, owner, msg)
+	}
+}
+
+// SimulateMsgRemoveConsumer generates a MsgRemoveConsumer for a launched consumer chain
+// owned by one of the simulation accounts
+func SimulateMsgRemoveConsumer(
+	txGen client.TxConfig,
+	ak simulation.AccountKeeper,
+	bk simulation.BankKeeper,
+	k *keeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgRemoveConsumer{})
+
+		consumerId, owner, found := randomOwnedConsumer(r, ctx, k, accs, func(phase types.ConsumerPhase) bool {
+			return phase == types.CONSUMER_PHASE_LAUNCHED
+		})
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no launched consumer owned by a simulation account"), nil, nil
+		}
+
+		msg, err := types.NewMsgRemoveConsumer(owner.Address.String(), consumerId)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to create MsgRemoveConsumer"), nil, err
+		}
+
+		return deliverTx(r, app, ctx, txGen, ak, bk, owner, msg)
+	}
+}
+
+// SimulateMsgOptIn generates a MsgOptIn of a bonded validator to an active consumer chain
+// it is not yet opted in to
+func SimulateMsgOptIn(
+	txGen client.TxConfig,
+	ak simulation.AccountKeeper,
+	bk simulation.BankKeeper,
+	k *keeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgOptIn{})
+
+		consumerId, found := randomActiveConsumer(r, ctx, k)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no active consumer"), nil, nil
+		}
+
+		validator, operator, providerAddr, found := randomValidator(r, ctx, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no bonded validator operated by a simulation account"), nil, nil
+		}
+		if k.IsOptedIn(ctx, consumerId, providerAddr) {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "validator is already opted in"), nil, nil
+		}
+
+		msg, err := types.NewMsgOptIn(consumerId, sdk.ValAddress(operator.Address), "", operator.Address.String())
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, fmt.Sprintf("unable to create MsgOptIn for %s", validator.GetOperator())), nil, err
+		}
+
+		return deliverTx(r, app, ctx, txGen, ak, bk, operator, msg)
+	}
+}
+
+// SimulateMsgOptOut generates a MsgOptOut of a bonded validator from a launched consumer chain it is opted in to
+func SimulateMsgOptOut(
+	txGen client.TxConfig,
+	ak simulation.AccountKeeper,
+	bk simulation.BankKeeper,
+	k *keeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgOptOut{})
+
+		consumerId, validator, operator, found := randomOptedInValidator(r, ctx, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no bonded validator opted in to a launched consumer"), nil, nil
+		}
+
+		msg, err := types.NewMsgOptOut(consumerId, sdk.ValAddress(operator.Address), operator.Address.String())
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, fmt.Sprintf("unable to create MsgOptOut for %s", validator.GetOperator())), nil, err
+		}
+
+		return deliverTx(r, app, ctx, txGen, ak, bk, operator, msg)
+	}
+}
+
+// SimulateMsgAssignConsumerKey generates a MsgAssignConsumerKey with a random ed25519 key
+// for a bonded validator on an active consumer chain
+func SimulateMsgAssignConsumerKey(
+	txGen client.TxConfig,
+	ak simulation.AccountKeeper,
+	bk simulation.BankKeeper,
+	k *keeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgAssignConsumerKey{})
+
+		consumerId, found := randomActiveConsumer(r, ctx, k)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no active consumer"), nil, nil
+		}
+
+		_, operator, _, found := randomValidator(r, ctx, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no bonded validator operated by a simulation account"), nil, nil
+		}
+
+		msg, err := types.NewMsgAssignConsumerKey(consumerId, sdk.ValAddress(operator.Address),
+			randomConsumerKey(r), operator.Address.String())
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to create MsgAssignConsumerKey"), nil, err
+		}
+
+		return deliverTx(r, app, ctx, txGen, ak, bk, operator, msg)
+	}
+}
+
+// SimulateMsgSetConsumerCommissionRate generates a MsgSetConsumerCommissionRate with a random rate
+// for a bonded validator on an active consumer chain
+func SimulateMsgSetConsumerCommissionRate(
+	txGen client.TxConfig,
+	ak simulation.AccountKeeper,
+	bk simulation.BankKeeper,
+	k *keeper.Keeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgSetConsumerCommissionRate{})
+
+		consumerId, found := randomActiveConsumer(r, ctx, k)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no active consumer"), nil, nil
+		}
+
+		_, operator, _, found := randomValidator(r, ctx, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no bonded validator operated by a simulation account"), nil, nil
+		}
+
+		msg := types.NewMsgSetConsumerCommissionRate(consumerId, simtypes.RandomDecAmount(r, math.LegacyOneDec()),
+			sdk.ValAddress(operator.Address), operator.Address.String())
+
+		return deliverTx(r, app, ctx, txGen, ak, bk, operator, msg)
+	}
+}
+
+// deliverTx signs and delivers a tx with `msg` on behalf of `simAccount`, which pays random fees.
+// The preconditions checked by the operations do not cover all the checks of the msg server,
+// e.g., a validator cannot opt out from a Top N chain, so a msg rejected by the module with
+// one of its registered errors results in a no-op. Any other error fails the simulation.
+func deliverTx(
+	r *rand.Rand,
+	app *baseapp.BaseApp,
+	ctx sdk.Context,
+	txGen client.TxConfig,
+	ak simulation.AccountKeeper,
+	bk simulation.BankKeeper,
+	simAccount simtypes.Account,
+	msg sdk.Msg,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	txCtx := simulation.OperationInput{
+		R:               r,
+		App:             app,
+		TxGen:           txGen,
+		Cdc:             nil,
+		Msg:             msg,
+		Context:         ctx,
+		SimAccount:      simAccount,
+		AccountKeeper:   ak,
+		Bankkeeper:      bk,
+		ModuleName:      types.ModuleName,
+		CoinsSpentInMsg: sdk.NewCoins(),
+	}
+
+	opMsg, futureOps, err := simulation.GenAndDeliverTxWithRandFees(txCtx)
+	if err != nil {
+		if isModuleError(err) {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), err.Error()), nil, nil
+		}
+		return opMsg, nil, err
+	}
+	return opMsg, futureOps, nil
+}
+
+// isModuleError returns true if `err` is one of the errors registered by the provider or the CCV module,
+// i.e., if the msg was rejected by the provider module
+func isModuleError(err error) bool {
+	codespace, _, _ := errorsmod.ABCIInfo(err, false)
+	return codespace == types.ModuleName || codespace == ccvtypes.ModuleName
+}
+
+// randomConsumerCreator returns a random simulation account that is allowed to create consumer chains
+// and that has spendable coins to pay the fees
+func randomConsumerCreator(
+	r *rand.Rand,
+	ctx sdk.Context,
+	k *keeper.Keeper,
+	bk simulation.BankKeeper,
+	accs []simtypes.Account,
+) (simtypes.Account, bool) {
+	creators := []simtypes.Account{}
+	for _, acc := range accs {
+		if k.CanCreateConsumer(ctx, acc.Address.String()) && !bk.SpendableCoins(ctx, acc.Address).IsZero() {
+			creators = append(creators, acc)
+		}
+	}
+	if len(creators) == 0 {
+		return simtypes.Account{}, false
+	}
+	return creators[r.Intn(len(creators))], true
+}
+
+// randomActiveConsumer returns a random consumer chain in the registered, initialized, or launched phase
+func randomActiveConsumer(r *rand.Rand, ctx sdk.Context, k *keeper.Keeper) (string, bool) {
+	consumerIds := k.GetAllActiveConsumerIds(ctx)
+	if len(consumerIds) == 0 {
+		return "", false
+	}
+	return consumerIds[r.Intn(len(consumerIds))], true
+}
+
+// randomOwnedConsumer returns a random consumer chain with a phase accepted by `acceptPhase`
+// together with the simulation account that owns it
+func randomOwnedConsumer(
+	r *rand.Rand,
+	ctx sdk.Context,
+	k *keeper.Keeper,
+	accs []simtypes.Account,
+	acceptPhase func(types.ConsumerPhase) bool,
+) (string, simtypes.Account, bool) {
+	var (
+		consumerIds []string
+		owners      []simtypes.Account
+	)
+	for _, consumerId := range k.GetAllConsumerIds(ctx) {
+		if !acceptPhase(k.GetConsumerPhase(ctx, consumerId)) {
+			continue
+		}
+		ownerAddress, err := k.GetConsumerOwnerAddress(ctx, consumerId)
+		if err != nil {
+			continue
+		}
+		owner, err := sdk.AccAddressFromBech32(ownerAddress)
+		if err != nil {
+			continue
+		}
+		if account, found := simtypes.FindAccount(accs, owner); found {
+			consumerIds = append(consumerIds, consumerId)
+			owners = append(owners, account)
+		}
+	}
+	if len(consumerIds) == 0 {
+		return "", simtypes.Account{}, false
+	}
+	i := r.Intn(len(consumerIds))
+	return consumerIds[i], owners[i], true
+}
+
+// randomValidator returns a random bonded validator operated by one of the simulation accounts,
+// together with the operator account and the validator's consensus address
+func randomValidator(
+	r *rand.Rand,
+	ctx sdk.Context,
+	k *keeper.Keeper,
+	accs []simtypes.Account,
+) (stakingtypes.Validator, simtypes.Account, types.ProviderConsAddress, bool) {
+	validators, err := k.GetLastBondedValidators(ctx)
+	if err != nil || len(validators) == 0 {
+		return stakingtypes.Validator{}, simtypes.Account{}, types.ProviderConsAddress{}, false
+	}
+
+	validator := validators[r.Intn(len(validators))]
+	valAddr, err := k.ValidatorAddressCodec().StringToBytes(validator.GetOperator())
+	if err != nil {
+		return stakingtypes.Validator{}, simtypes.Account{}, types.ProviderConsAddress{}, false
+	}
+	operator, found := simtypes.FindAccount(accs, sdk.AccAddress(valAddr))
+	if !found {
+		return stakingtypes.Validator{}, simtypes.Account{}, types.ProviderConsAddress{}, false
+	}
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return stakingtypes.Validator{}, simtypes.Account{}, types.ProviderConsAddress{}, false
+	}
+
+	return validator, operator, types.NewProviderConsAddress(consAddr), true
+}
+
+// randomOptedInValidator returns a random launched consumer chain together with a random bonded validator
+// that is opted in to it and that is operated by one of the simulation accounts
+func randomOptedInValidator(
+	r *rand.Rand,
+	ctx sdk.Context,
+	k *keeper.Keeper,
+	accs []simtypes.Account,
+) (string, stakingtypes.Validator, simtypes.Account, bool) {
+	validators, err := k.GetLastBondedValidators(ctx)
+	if err != nil {
+		return "", stakingtypes.Validator{}, simtypes.Account{}, false
+	}
+
+	type optedInValidator struct {
+		consumerId string
+		validator  stakingtypes.Validator
+		operator   simtypes.Account
+	}
+	candidates := []optedInValidator{}
+	for _, consumerId := range k.GetAllActiveConsumerIds(ctx) {
+		if k.GetConsumerPhase(ctx, consumerId) != types.CONSUMER_PHASE_LAUNCHED {
+			continue
+		}
+		for _, validator := range validators {
+			valAddr, err := k.ValidatorAddressCodec().StringToBytes(validator.GetOperator())
+			if err != nil {
+				continue
+			}
+			operator, found := simtypes.FindAccount(accs, sdk.AccAddress(valAddr))
+			if !found {
+				continue
+			}
+			consAddr, err := validator.GetConsAddr()
+			if err != nil {
+				continue
+			}
+			if k.IsOptedIn(ctx, consumerId, types.NewProviderConsAddress(consAddr)) {
+				candidates = append(candidates, optedInValidator{consumerId, validator, operator})
+			}
+		}
+	}
+	if len(candidates) == 0 {
+		return "", stakingtypes.Validator{}, simtypes.Account{}, false
+	}
+	c := candidates[r.Intn(len(candidates))]
+	return c.consumerId, c.validator, c.operator, true
+}
+
+// randomConsumerMetadata returns consumer metadata with random values
+func randomConsumerMetadata(r *rand.Rand) types.ConsumerMetadata {
+	return types.ConsumerMetadata{
+		Name:        simtypes.RandStringOfLength(r, 10),
+		Description: simtypes.RandStringOfLength(r, 50),
+		Metadata:    simtypes.RandStringOfLength(r, 20),
+	}
+}
+
+// randomSpawnTime returns a spawn time within the next hour of the current block time
+func randomSpawnTime(r *rand.Rand, ctx sdk.Context) time.Time {
+	return ctx.BlockTime().Add(time.Duration(r.Intn(3600)) * time.Second)
+}
+
+// randomConsumerKey returns a random ed25519 consumer key in JSON format
+func randomConsumerKey(r *rand.Rand) string {
+	seed := make([]byte, ed25519.SeedSize)
+	r.Read(seed)
+	pubKey := ed25519.GenPrivKeyFromSecret(seed).PubKey()
+	return fmt.Sprintf(`{"@type":"/cosmos.crypto.ed25519.PubKey","key":"%s"}`, base64.StdEncoding.EncodeToString(pubKey.Bytes()))
+}
@@ -0,0 +1,83 @@
+package simulation_test
+
+import (
+	"io"
+	"math/rand"
+	"testing"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	providerapp "github.com/cosmos/interchain-security/v7/app/provider"
+	providersim "github.com/cosmos/interchain-security/v7/x/ccv/provider/simulation"
+	"github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+)
+
+// TestWeightedOperations runs a simulation of the provider app with the provider operations only
+// and checks that every operation is successfully delivered at least once, i.e., that the
+// operations change the CCV state instead of only resulting in no-ops
+func TestWeightedOperations(t *testing.T) {
+	app := providerapp.New(log.NewNopLogger(), dbm.NewMemDB(), nil, true,
+		simtestutil.AppOptionsMap{flags.FlagHome: t.TempDir()}, baseapp.SetChainID("provi"))
+	encoding := providerapp.MakeTestEncodingConfig()
+
+	// count the successfully delivered msgs per msg type
+	delivered := map[string]int{}
+	weightedOps := providersim.WeightedOperations(make(simtypes.AppParams), encoding.TxConfig,
+		app.AccountKeeper, app.BankKeeper, &app.ProviderKeeper)
+	ops := make(simulation.WeightedOperations, 0, len(weightedOps))
+	for _, weightedOp := range weightedOps {
+		op := weightedOp.Op()
+		ops = append(ops, simulation.NewWeightedOperation(weightedOp.Weight(),
+			func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+			) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+				opMsg, futureOps, err := op(r, app, ctx, accs, chainID)
+				if opMsg.OK {
+					delivered[opMsg.Name]++
+				}
+				return opMsg, futureOps, err
+			}))
+	}
+
+	config := simtypes.Config{
+		Seed:               7,
+		InitialBlockHeight: 1,
+		NumBlocks:          30,
+		BlockSize:          30,
+		ChainID:            "provi",
+		Commit:             true,
+	}
+	_, _, err := simulation.SimulateFromSeed(
+		t,
+		io.Discard,
+		app.BaseApp,
+		simtestutil.AppStateFn(app.AppCodec(), app.SimulationManager(), providerapp.NewDefaultGenesisState(app.AppCodec())),
+		simtypes.RandomAccounts,
+		ops,
+		providerapp.ComputeBankBlockedAddrs(app),
+		config,
+		app.AppCodec(),
+	)
+	require.NoError(t, err)
+
+	for _, msg := range []sdk.Msg{
+		&types.MsgCreateConsumer{},
+		&types.MsgUpdateConsumer{},
+		&types.MsgRemoveConsumer{},
+		&types.MsgOptIn{},
+		&types.MsgOptOut{},
+		&types.MsgAssignConsumerKey{},
+		&types.MsgSetConsumerCommissionRate{},
+	} {
+		require.Positive(t, delivered[sdk.MsgTypeURL(msg)], "%s was never delivered: %v", sdk.MsgTypeURL(msg), delivered)
+	}
+}
@@ -0,0 +1,57 @@
+package types
+
+import (
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	ibchost "github.com/cosmos/ibc-go/v10/modules/core/exported"
+	ibctmtypes "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// ConsumerClientFactory abstracts the light client type of the CCV clients of the consumer chains,
+// so that the consumer chains can be tracked by light client modules that wrap the Tendermint light client,
+// e.g., the 08-wasm module. The provider derives the Tendermint client and consensus states of a consumer
+// chain from its initialization parameters and the factory turns them into the states of its client type.
+type ConsumerClientFactory interface {
+	// ClientType returns the type of the CCV clients, e.g., 07-tendermint or 08-wasm
+	ClientType() string
+	// MarshalInitialStates returns the client state and the consensus state used to create the CCV client
+	// of a consumer chain from its Tendermint client state and consensus state
+	MarshalInitialStates(clientState *ibctmtypes.ClientState, consensusState *ibctmtypes.ConsensusState) (
+		clientStateBz, consensusStateBz []byte, err error)
+	// TendermintClientState returns the Tendermint client state wrapped by the client state of a CCV client,
+	// e.g., to read the chain id of the consumer chain or the unbonding period of its client
+	TendermintClientState(clientState ibchost.ClientState) (*ibctmtypes.ClientState, error)
+}
+
+var _ ConsumerClientFactory = TendermintConsumerClientFactory{}
+
+// TendermintConsumerClientFactory is the default ConsumerClientFactory, which creates 07-tendermint CCV clients
+type TendermintConsumerClientFactory struct{}
+
+func (TendermintConsumerClientFactory) ClientType() string {
+	return ibchost.Tendermint
+}
+
+func (TendermintConsumerClientFactory) MarshalInitialStates(clientState *ibctmtypes.ClientState, consensusState *ibctmtypes.ConsensusState) (
+	clientStateBz, consensusStateBz []byte, err error,
+) {
+	clientStateBz, err = clientState.Marshal()
+	if err != nil {
+		return nil, nil, err
+	}
+	consensusStateBz, err = consensusState.Marshal()
+	if err != nil {
+		return nil, nil, err
+	}
+	return clientStateBz, consensusStateBz, nil
+}
+
+func (TendermintConsumerClientFactory) TendermintClientState(clientState ibchost.ClientState) (*ibctmtypes.ClientState, error) {
+	tmClient, ok := clientState.(*ibctmtypes.ClientState)
+	if !ok {
+		return nil, errorsmod.Wrapf(clienttypes.ErrInvalidClientType,
+			"invalid client type. expected %s, got %s", ibchost.Tendermint, clientState.ClientType())
+	}
+	return tmClient, nil
+}
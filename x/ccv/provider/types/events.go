@@ -2,24 +2,61 @@ package types
 
 // Provider events
 const (
-	EventTypeConsumerClientCreated     = "consumer_client_created"
-	EventTypeAssignConsumerKey         = "assign_consumer_key"
-	EventTypeChangeConsumerRewardDenom = "change_consumer_reward_denom"
-	EventTypeExecuteConsumerChainSlash = "execute_consumer_chain_slash"
-	EventTypeSetConsumerCommissionRate = "set_consumer_commission_rate"
-	EventTypeOptIn                     = "opt_in"
-	EventTypeOptOut                    = "opt_out"
-	EventTypeCreateConsumer            = "create_consumer"
-	EventTypeUpdateConsumer            = "update_consumer"
-	EventTypeRemoveConsumer            = "remove_consumer"
-	EventTypeReceivedRewards           = "received_ics_rewards"
-	EventTypeDistributedRewards        = "distributed_ics_rewards"
+	EventTypeConsumerClientCreated            = "consumer_client_created"
+	EventTypeAssignConsumerKey                = "assign_consumer_key"
+	EventTypeChangeConsumerRewardDenom        = "change_consumer_reward_denom"
+	EventTypeExecuteConsumerChainSlash        = "execute_consumer_chain_slash"
+	EventTypeSetConsumerCommissionRate        = "set_consumer_commission_rate"
+	EventTypeOptIn                            = "opt_in"
+	EventTypeOptOut                           = "opt_out"
+	EventTypeCreateConsumer                   = "create_consumer"
+	EventTypeUpdateConsumer                   = "update_consumer"
+	EventTypeRemoveConsumer                   = "remove_consumer"
+	EventTypeReceivedRewards                  = "received_ics_rewards"
+	EventTypeDistributedRewards               = "distributed_ics_rewards"
+	EventTypeSetConsumerInitialConsensusState = "set_consumer_initial_consensus_state"
+	EventTypeChannelMigrationStarted          = "ccv_channel_migration_started"
+	EventTypeChannelMigrationCompleted        = "ccv_channel_migration_completed"
+	EventTypeChannelRecoveryStaged            = "ccv_channel_recovery_staged"
+	EventTypeConsumerLaunchBlocked            = "consumer_launch_blocked"
+	EventTypeSetTopNBudget                    = "set_top_n_budget"
+	EventTypeSendEmergencyValsetUpdate        = "send_emergency_valset_update"
+	EventTypeConsumerLaunchFailed             = "consumer_launch_failed"
+	EventTypeRetryConsumerLaunch              = "retry_consumer_launch"
+	EventTypeAttestConsumerHashes             = "attest_consumer_hashes"
+	EventTypeChangeConsumerCreatorAllowlist   = "change_consumer_creator_allowlist"
+	EventTypeForceRemoveConsumer              = "force_remove_consumer"
+	EventTypeSlashConsumerInfraction          = "slash_consumer_infraction"
+	EventTypeChangeConsumerChainId            = "change_consumer_chain_id"
+	EventTypeConsumerChainIdChanged           = "consumer_chain_id_changed"
+	EventTypePauseConsumer                    = "pause_consumer"
+	EventTypeResumeConsumer                   = "resume_consumer"
+	EventTypeRegisterConsumerClientUpgrade    = "register_consumer_client_upgrade"
+	EventTypeConsumerClientUpgraded           = "consumer_client_upgraded"
+	EventTypeConsumerClientUpgradeMismatch    = "consumer_client_upgrade_mismatch"
+	EventTypeConsumerShutdown                 = "consumer_shutdown"
+	EventTypeScheduleConsumerKeyAssignment    = "schedule_consumer_key_assignment"
+	EventTypeScheduledKeyAssignmentFailed     = "scheduled_key_assignment_failed"
+	EventTypeConsumerMisbehaviourRejected     = "consumer_misbehaviour_rejected"
+	EventTypeConsumerDoubleVoteRejected       = "consumer_double_vote_rejected"
+	EventTypeQueueInfractionParameters        = "queue_infraction_parameters"
+	EventTypeUpdateInfractionParameters       = "update_infraction_parameters"
+	EventTypeStaleKeyAssignment               = "stale_key_assignment"
+	EventTypeAutoRemoveConsumer               = "auto_remove_consumer"
+	EventTypeEjectConsumerValidator           = "eject_consumer_validator"
+	EventTypeRegisterConsumerProviderSwitch   = "register_consumer_provider_switch"
+	EventTypeConsumerProviderSwitched         = "consumer_provider_switched"
+	EventTypeTopNShortfall                    = "top_n_shortfall"
 
 	AttributeInfractionHeight          = "infraction_height"
 	AttributeInitialHeight             = "initial_height"
 	AttributeTrustingPeriod            = "trusting_period"
+	AttributeTrustLevel                = "trust_level"
 	AttributeUnbondingPeriod           = "unbonding_period"
 	AttributeValsetHash                = "valset_hash"
+	AttributeGenesisHash               = "genesis_hash"
+	AttributeBinaryHash                = "binary_hash"
+	AttributePreviousChannelId         = "previous_channel_id"
 	AttributeProviderValidatorAddress  = "provider_validator_address"
 	AttributeConsumerConsensusPubKey   = "consumer_consensus_pub_key"
 	AttributeAddConsumerRewardDenom    = "add_consumer_reward_denom"
@@ -31,6 +68,7 @@ const (
 	AttributeConsumerName              = "consumer_name"
 	AttributeConsumerOwner             = "consumer_owner"
 	AttributeConsumerSpawnTime         = "consumer_spawn_time"
+	AttributeConsumerSpawnHeight       = "consumer_spawn_height"
 	AttributeConsumerPhase             = "consumer_phase"
 	AttributeConsumerTopN              = "consumer_topn"
 	AttributeRewardDenom               = "reward_denom"
@@ -39,4 +77,39 @@ const (
 	AttributeRewardTotal               = "total_rewards"
 	AttributeRewardDistributed         = "distributed_rewards"
 	AttributeRewardCommunityPool       = "community_pool_rewards"
+	AttributeLaunchedConsumers         = "launched_consumers"
+	AttributeMaxLaunchedConsumers      = "max_launched_consumers"
+	AttributeTopNBudget                = "top_n_budget"
+	AttributeLaunchError               = "launch_error"
+	AttributeLaunchRetriable           = "launch_retriable"
+	AttributeAddConsumerCreator        = "add_consumer_creator"
+	AttributeRemoveConsumerCreator     = "remove_consumer_creator"
+	AttributeRemovalReason             = "removal_reason"
+	AttributeConsumerRemovalTime       = "consumer_removal_time"
+	AttributeSlashedTokens             = "slashed_tokens"
+	AttributeNewConsumerChainId        = "new_consumer_chain_id"
+	AttributePreviousConsumerChainId   = "previous_consumer_chain_id"
+	AttributeUpgradeHeight             = "upgrade_height"
+	AttributeUpgradeMismatch           = "upgrade_mismatch"
+	AttributeActivationHeight          = "activation_height"
+	AttributeKeyAssignmentError        = "key_assignment_error"
+	AttributeMisbehaviourError         = "misbehaviour_error"
+	AttributeDoubleVotingError         = "double_voting_error"
+	AttributeInfractionUpdateTime      = "infraction_update_time"
+	AttributeDoubleSignSlashFraction   = "double_sign_slash_fraction"
+	AttributeDowntimeSlashFraction     = "downtime_slash_fraction"
+	AttributeProviderConsensusAddress  = "provider_consensus_address"
+	AttributeConsumerConsensusAddress  = "consumer_consensus_address"
+	AttributeTrackedSinceHeight        = "tracked_since_height"
+	AttributeUnreachableSince          = "unreachable_since"
+	AttributeConsumerKeyPruned         = "consumer_key_pruned"
+	AttributeSwitchHeight              = "switch_height"
+	AttributeNewProviderChainId        = "new_provider_chain_id"
+	AttributeTopNShortfall             = "top_n_shortfall"
+)
+
+// Reasons of the automatic removals of launched consumer chains, see the MaxChannelClosedDuration param
+const (
+	AutoRemovalReasonChannelClosed = "channel_closed"
+	AutoRemovalReasonClientExpired = "client_expired"
 )
@@ -70,6 +70,82 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
+	if err := validatePreLaunchKeyAssignments(gs.PreLaunchKeyAssignments); err != nil {
+		return errorsmod.Wrap(ccv.ErrInvalidGenesis, err.Error())
+	}
+
+	if err := validateScheduledKeyAssignments(gs.ScheduledKeyAssignments); err != nil {
+		return errorsmod.Wrap(ccv.ErrInvalidGenesis, err.Error())
+	}
+
+	if err := validateConsumerCreatorAllowlist(gs.ConsumerCreatorAllowlist); err != nil {
+		return errorsmod.Wrap(ccv.ErrInvalidGenesis, err.Error())
+	}
+
+	return nil
+}
+
+// validatePreLaunchKeyAssignments validates the pre-agreed key assignments of consumer chains that are not yet created
+func validatePreLaunchKeyAssignments(keyAssignments []PreLaunchKeyAssignment) error {
+	type chainAndValidator struct {
+		chainId      string
+		providerAddr string
+	}
+	seen := map[chainAndValidator]struct{}{}
+	for _, keyAssignment := range keyAssignments {
+		if err := ValidateChainId("ChainId", keyAssignment.ChainId); err != nil {
+			return fmt.Errorf("invalid pre-launch key assignment: %s", err.Error())
+		}
+		if _, err := sdk.ValAddressFromBech32(keyAssignment.ProviderAddr); err != nil {
+			return fmt.Errorf("invalid pre-launch key assignment: invalid ValAddress (%s)", keyAssignment.ProviderAddr)
+		}
+		key := chainAndValidator{keyAssignment.ChainId, keyAssignment.ProviderAddr}
+		if _, found := seen[key]; found {
+			return fmt.Errorf("duplicate pre-launch key assignment for validator (%s) on chain (%s)",
+				keyAssignment.ProviderAddr, keyAssignment.ChainId)
+		}
+		seen[key] = struct{}{}
+		if _, _, err := ParseConsumerKeyFromJson(keyAssignment.ConsumerKey); err != nil {
+			return fmt.Errorf("invalid pre-launch key assignment: invalid consumer key for validator (%s): %s",
+				keyAssignment.ProviderAddr, err.Error())
+		}
+	}
+	return nil
+}
+
+// validateScheduledKeyAssignments validates the consumer key assignments scheduled by validators
+func validateScheduledKeyAssignments(keyAssignments []ScheduledKeyAssignment) error {
+	for _, keyAssignment := range keyAssignments {
+		if err := ccv.ValidateConsumerId(keyAssignment.ConsumerId); err != nil {
+			return fmt.Errorf("invalid scheduled key assignment: %s", err.Error())
+		}
+		if _, err := sdk.ValAddressFromBech32(keyAssignment.ProviderAddr); err != nil {
+			return fmt.Errorf("invalid scheduled key assignment: invalid ValAddress (%s)", keyAssignment.ProviderAddr)
+		}
+		if keyAssignment.ActivationHeight <= 0 {
+			return fmt.Errorf("invalid scheduled key assignment: non-positive activation height (%d) for validator (%s)",
+				keyAssignment.ActivationHeight, keyAssignment.ProviderAddr)
+		}
+		if _, _, err := ParseConsumerKeyFromJson(keyAssignment.ConsumerKey); err != nil {
+			return fmt.Errorf("invalid scheduled key assignment: invalid consumer key for validator (%s): %s",
+				keyAssignment.ProviderAddr, err.Error())
+		}
+	}
+	return nil
+}
+
+// validateConsumerCreatorAllowlist validates the addresses allowed to create consumer chains
+func validateConsumerCreatorAllowlist(addresses []string) error {
+	seen := map[string]struct{}{}
+	for _, address := range addresses {
+		if _, err := sdk.AccAddressFromBech32(address); err != nil {
+			return fmt.Errorf("invalid consumer creator address (%s): %s", address, err.Error())
+		}
+		if _, found := seen[address]; found {
+			return fmt.Errorf("duplicate consumer creator address (%s)", address)
+		}
+		seen[address] = struct{}{}
+	}
 	return nil
 }
 
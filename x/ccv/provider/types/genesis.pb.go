@@ -39,6 +39,13 @@ type GenesisState struct {
 	ValidatorsByConsumerAddr []ValidatorByConsumerAddr `protobuf:"bytes,10,rep,name=validators_by_consumer_addr,json=validatorsByConsumerAddr,proto3" json:"validators_by_consumer_addr"`
 	// empty for a new chain
 	ConsumerAddrsToPruneV2 []ConsumerAddrsToPruneV2 `protobuf:"bytes,14,rep,name=consumer_addrs_to_prune_v2,json=consumerAddrsToPruneV2,proto3" json:"consumer_addrs_to_prune_v2"`
+	// consumer key assignments for consumer chains that are not yet created;
+	// they are applied when a consumer chain with the same chain id is created
+	PreLaunchKeyAssignments []PreLaunchKeyAssignment `protobuf:"bytes,15,rep,name=pre_launch_key_assignments,json=preLaunchKeyAssignments,proto3" json:"pre_launch_key_assignments"`
+	// consumer key assignments scheduled by validators that are not yet applied
+	ScheduledKeyAssignments []ScheduledKeyAssignment `protobuf:"bytes,16,rep,name=scheduled_key_assignments,json=scheduledKeyAssignments,proto3" json:"scheduled_key_assignments"`
+	// the addresses allowed to create consumer chains if consumer creation is restricted
+	ConsumerCreatorAllowlist []string `protobuf:"bytes,17,rep,name=consumer_creator_allowlist,json=consumerCreatorAllowlist,proto3" json:"consumer_creator_allowlist,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -123,6 +130,27 @@ func (m *GenesisState) GetConsumerAddrsToPruneV2() []ConsumerAddrsToPruneV2 {
 	return nil
 }
 
+func (m *GenesisState) GetPreLaunchKeyAssignments() []PreLaunchKeyAssignment {
+	if m != nil {
+		return m.PreLaunchKeyAssignments
+	}
+	return nil
+}
+
+func (m *GenesisState) GetScheduledKeyAssignments() []ScheduledKeyAssignment {
+	if m != nil {
+		return m.ScheduledKeyAssignments
+	}
+	return nil
+}
+
+func (m *GenesisState) GetConsumerCreatorAllowlist() []string {
+	if m != nil {
+		return m.ConsumerCreatorAllowlist
+	}
+	return nil
+}
+
 // The provider CCV module's knowledge of consumer state.
 //
 // Note this type is only used internally to the provider CCV module.
@@ -299,54 +327,60 @@ func init() {
 }
 
 var fileDescriptor_48411d9c7900d48e = []byte{
-	// 750 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcd, 0x6e, 0xda, 0x4a,
-	0x14, 0xc6, 0xc1, 0x80, 0x99, 0x04, 0xae, 0x65, 0x45, 0xc8, 0x37, 0xd1, 0x25, 0x88, 0xab, 0x48,
-	0x48, 0xf7, 0x5e, 0x1c, 0xb8, 0x8b, 0x56, 0xfd, 0x59, 0x84, 0x44, 0x6a, 0x70, 0x37, 0x88, 0xa4,
-	0xa9, 0x94, 0x8d, 0x35, 0xcc, 0x8c, 0xf0, 0x08, 0xb0, 0x2d, 0xcf, 0xe0, 0x14, 0x55, 0x95, 0xda,
-	0x37, 0xe8, 0x9b, 0xf4, 0x35, 0xb2, 0xcc, 0xb2, 0xab, 0xa8, 0x4a, 0xfa, 0x04, 0x7d, 0x82, 0xca,
-	0xe3, 0x81, 0x40, 0x4a, 0x22, 0xd2, 0x9d, 0x7d, 0xbe, 0xf9, 0xce, 0xf9, 0xce, 0x2f, 0x68, 0x50,
-	0x8f, 0x93, 0x10, 0xb9, 0x90, 0x7a, 0x0e, 0x23, 0x68, 0x1c, 0x52, 0x3e, 0xb1, 0x10, 0x8a, 0xac,
-	0x20, 0xf4, 0x23, 0x8a, 0x49, 0x68, 0x45, 0x0d, 0xab, 0x4f, 0x3c, 0xc2, 0x28, 0xab, 0x07, 0xa1,
-	0xcf, 0x7d, 0xe3, 0xef, 0x25, 0x94, 0x3a, 0x42, 0x51, 0x7d, 0x4a, 0xa9, 0x47, 0x8d, 0xad, 0xcd,
-	0xbe, 0xdf, 0xf7, 0xc5, 0x7b, 0x2b, 0xfe, 0x4a, 0xa8, 0x5b, 0x7b, 0xf7, 0x45, 0x8b, 0x1a, 0x16,
-	0x73, 0x61, 0x48, 0xb0, 0x83, 0x7c, 0x8f, 0x8d, 0x47, 0x24, 0x94, 0x8c, 0xdd, 0x07, 0x18, 0xe7,
-	0x34, 0x24, 0xf2, 0x59, 0x73, 0x95, 0x34, 0x66, 0xfa, 0x04, 0xa7, 0xfa, 0x25, 0x0b, 0x36, 0x5e,
-	0x25, 0x99, 0x1d, 0x73, 0xc8, 0x89, 0x51, 0x03, 0x7a, 0x04, 0x87, 0x8c, 0x70, 0x67, 0x1c, 0x60,
-	0xc8, 0x89, 0x43, 0xb1, 0xa9, 0x54, 0x94, 0x9a, 0xda, 0x2d, 0x26, 0xf6, 0x37, 0xc2, 0xdc, 0xc6,
-	0xc6, 0x7b, 0xf0, 0xc7, 0x54, 0xa7, 0xc3, 0x62, 0x2e, 0x33, 0xd7, 0x2a, 0xe9, 0xda, 0x7a, 0xb3,
-	0x59, 0x5f, 0xa1, 0x38, 0xf5, 0x03, 0xc9, 0x15, 0x61, 0x5b, 0xe5, 0x8b, 0xab, 0x9d, 0xd4, 0x8f,
-	0xab, 0x9d, 0xd2, 0x04, 0x8e, 0x86, 0xcf, 0xaa, 0x77, 0x1c, 0x57, 0xbb, 0x45, 0x34, 0xff, 0x9c,
-	0x19, 0x1f, 0xc0, 0xd6, 0x5d, 0x99, 0x0e, 0xf7, 0x1d, 0x97, 0xd0, 0xbe, 0xcb, 0xcd, 0x8c, 0xd0,
-	0xf1, 0x7c, 0x25, 0x1d, 0xa7, 0x0b, 0x59, 0x9d, 0xf8, 0x47, 0xc2, 0x45, 0x4b, 0x8d, 0x05, 0x75,
-	0x4b, 0xd1, 0x52, 0xd4, 0x68, 0x83, 0x6c, 0x00, 0x43, 0x38, 0x62, 0xa6, 0x56, 0x51, 0x6a, 0xeb,
-	0xcd, 0x7f, 0x56, 0x0a, 0xd5, 0x11, 0x14, 0xe9, 0x5a, 0x3a, 0x30, 0x3e, 0x2a, 0x22, 0x15, 0x8a,
-	0x21, 0xf7, 0xc3, 0x59, 0xe7, 0x9d, 0x60, 0xdc, 0x1b, 0x90, 0x09, 0x33, 0xf3, 0x22, 0x95, 0x17,
-	0xab, 0xa6, 0x92, 0xb8, 0x99, 0xd6, 0xb6, 0x33, 0xee, 0xbd, 0x26, 0x13, 0x19, 0xd0, 0x8c, 0x96,
-	0xc0, 0x71, 0x0c, 0xe3, 0x93, 0x02, 0xb6, 0x67, 0x20, 0x73, 0x7a, 0x93, 0x5b, 0x19, 0x10, 0xe3,
-	0xd0, 0x04, 0xbf, 0xa3, 0xa1, 0x35, 0x99, 0x86, 0xd9, 0xc7, 0x38, 0xfc, 0x45, 0x03, 0x5b, 0xc4,
-	0xe3, 0x86, 0x2e, 0x04, 0x65, 0x71, 0x3b, 0x83, 0x70, 0xec, 0x11, 0x27, 0x6a, 0x9a, 0xc5, 0x47,
-	0x34, 0x74, 0xde, 0x2d, 0x3b, 0xf1, 0x3b, 0xb1, 0x8f, 0xd3, 0xe6, 0xb4, 0xa1, 0x68, 0x29, 0x6a,
-	0xab, 0x5a, 0x5a, 0x57, 0x6d, 0x55, 0x53, 0xf5, 0x8c, 0xad, 0x6a, 0x59, 0x3d, 0x67, 0xab, 0x5a,
-	0x4e, 0xd7, 0x6c, 0x55, 0x5b, 0xd7, 0x37, 0x6c, 0x55, 0xdb, 0xd0, 0x0b, 0xb6, 0xaa, 0x15, 0xf4,
-	0x62, 0xf5, 0x7b, 0x1a, 0x14, 0x16, 0x66, 0xd7, 0xf8, 0x13, 0x68, 0x89, 0x24, 0xb9, 0x2a, 0xf9,
-	0x6e, 0x4e, 0xfc, 0xb7, 0xb1, 0xf1, 0x17, 0x00, 0xc8, 0x85, 0x9e, 0x47, 0x86, 0x31, 0xb8, 0x26,
-	0xc0, 0xbc, 0xb4, 0xb4, 0xb1, 0xb1, 0x0d, 0xf2, 0x68, 0x48, 0x89, 0xc7, 0x63, 0x34, 0x2d, 0x50,
-	0x2d, 0x31, 0xb4, 0xb1, 0xb1, 0x0b, 0x8a, 0xd4, 0xa3, 0x9c, 0xc2, 0xe1, 0x74, 0xac, 0x55, 0xb1,
-	0x87, 0x05, 0x69, 0x95, 0xa3, 0x08, 0x81, 0x3e, 0x2b, 0x9c, 0xbc, 0x51, 0x66, 0x46, 0x0c, 0xe5,
-	0xde, 0xbd, 0xe5, 0x9a, 0xab, 0xd2, 0xfc, 0xf2, 0xcb, 0x1a, 0xcd, 0xd6, 0x5a, 0x62, 0x06, 0x07,
-	0xa5, 0x80, 0x78, 0x98, 0x7a, 0x7d, 0x47, 0x2e, 0x5d, 0x9c, 0x42, 0x9f, 0x30, 0x33, 0x2b, 0xfa,
-	0xf2, 0xf4, 0xa1, 0x40, 0xb3, 0x81, 0x38, 0x26, 0xfc, 0x40, 0xd0, 0x3a, 0x10, 0x0d, 0x08, 0x3f,
-	0x84, 0x1c, 0xca, 0x80, 0x9b, 0xd2, 0x7b, 0xb2, 0x8a, 0xc9, 0x23, 0x66, 0xfc, 0x0b, 0x0c, 0x36,
-	0x84, 0xcc, 0x75, 0xb0, 0x7f, 0xee, 0x71, 0x3a, 0x22, 0x0e, 0x44, 0x03, 0x33, 0x57, 0x49, 0xd7,
-	0xf2, 0x5d, 0x5d, 0x20, 0x87, 0x12, 0xd8, 0x47, 0x03, 0xe3, 0x08, 0x64, 0x02, 0x17, 0x32, 0x62,
-	0xe6, 0x2b, 0x4a, 0xad, 0xf8, 0xc8, 0x1b, 0xd4, 0x89, 0x99, 0xdd, 0xc4, 0x81, 0xad, 0x6a, 0x9a,
-	0x9e, 0xaf, 0x9e, 0x81, 0xd2, 0xf2, 0xcb, 0xf0, 0x88, 0x0b, 0x59, 0x02, 0x59, 0xd9, 0xb9, 0x35,
-	0x81, 0xcb, 0xbf, 0xd6, 0xdb, 0x8b, 0xeb, 0xb2, 0x72, 0x79, 0x5d, 0x56, 0xbe, 0x5d, 0x97, 0x95,
-	0xcf, 0x37, 0xe5, 0xd4, 0xe5, 0x4d, 0x39, 0xf5, 0xf5, 0xa6, 0x9c, 0x3a, 0x7b, 0xd9, 0xa7, 0xdc,
-	0x1d, 0xf7, 0xea, 0xc8, 0x1f, 0x59, 0xc8, 0x67, 0x23, 0x9f, 0x59, 0xb7, 0x79, 0xfc, 0x37, 0x3b,
-	0xea, 0xd1, 0x13, 0xeb, 0xdd, 0xe2, 0x65, 0xe7, 0x93, 0x80, 0xb0, 0x5e, 0x56, 0x1c, 0xf5, 0xff,
-	0x7f, 0x06, 0x00, 0x00, 0xff, 0xff, 0x0e, 0xd9, 0x94, 0x40, 0xd1, 0x06, 0x00, 0x00,
+	// 843 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x41, 0x6f, 0xdb, 0x36,
+	0x14, 0x8e, 0x62, 0xc5, 0x91, 0x99, 0xc4, 0xd1, 0x88, 0xc2, 0x53, 0x53, 0xcc, 0x35, 0x3c, 0x14,
+	0x30, 0xb0, 0xcd, 0x6a, 0xbc, 0xc3, 0x86, 0xad, 0x3b, 0x24, 0x29, 0xb0, 0xda, 0xdd, 0xc1, 0x70,
+	0xba, 0x0e, 0xe8, 0x45, 0xa0, 0x49, 0xc2, 0x22, 0x2c, 0x4b, 0x02, 0x49, 0x29, 0x13, 0x86, 0x0d,
+	0xdb, 0x3f, 0xd8, 0xcf, 0xea, 0xb1, 0xc7, 0x9d, 0x8a, 0x21, 0xd9, 0x71, 0xa7, 0xfd, 0x82, 0x41,
+	0x14, 0xe5, 0xda, 0xad, 0x1a, 0xd8, 0xbd, 0x49, 0xfc, 0xde, 0xf7, 0x7d, 0xef, 0xf1, 0x91, 0x8f,
+	0xe0, 0x94, 0x85, 0x92, 0x72, 0xec, 0x23, 0x16, 0x7a, 0x82, 0xe2, 0x84, 0x33, 0x99, 0xb9, 0x18,
+	0xa7, 0x6e, 0xcc, 0xa3, 0x94, 0x11, 0xca, 0xdd, 0xf4, 0xd4, 0x9d, 0xd1, 0x90, 0x0a, 0x26, 0xfa,
+	0x31, 0x8f, 0x64, 0x04, 0x3f, 0xad, 0xa0, 0xf4, 0x31, 0x4e, 0xfb, 0x25, 0xa5, 0x9f, 0x9e, 0x9e,
+	0xdc, 0x99, 0x45, 0xb3, 0x48, 0xc5, 0xbb, 0xf9, 0x57, 0x41, 0x3d, 0x79, 0xf8, 0x3e, 0xb7, 0xf4,
+	0xd4, 0x15, 0x3e, 0xe2, 0x94, 0x78, 0x38, 0x0a, 0x45, 0xb2, 0xa0, 0x5c, 0x33, 0x1e, 0xdc, 0xc2,
+	0xb8, 0x62, 0x9c, 0xea, 0xb0, 0xc1, 0x26, 0x65, 0x2c, 0xf3, 0x53, 0x9c, 0xee, 0xbf, 0x16, 0x38,
+	0xfc, 0xbe, 0xa8, 0xec, 0x52, 0x22, 0x49, 0x61, 0x0f, 0xd8, 0x29, 0x0a, 0x04, 0x95, 0x5e, 0x12,
+	0x13, 0x24, 0xa9, 0xc7, 0x88, 0x63, 0x74, 0x8c, 0x9e, 0x39, 0x69, 0x16, 0xeb, 0x3f, 0xaa, 0xe5,
+	0x21, 0x81, 0xbf, 0x80, 0xe3, 0x32, 0x4f, 0x4f, 0xe4, 0x5c, 0xe1, 0xec, 0x76, 0x6a, 0xbd, 0x83,
+	0xc1, 0xa0, 0xbf, 0xc1, 0xe6, 0xf4, 0x2f, 0x34, 0x57, 0xd9, 0x9e, 0xb7, 0x5f, 0xbe, 0xbe, 0xbf,
+	0xf3, 0xdf, 0xeb, 0xfb, 0xad, 0x0c, 0x2d, 0x82, 0x6f, 0xba, 0x6f, 0x09, 0x77, 0x27, 0x4d, 0xbc,
+	0x1a, 0x2e, 0xe0, 0xaf, 0xe0, 0xe4, 0xed, 0x34, 0x3d, 0x19, 0x79, 0x3e, 0x65, 0x33, 0x5f, 0x3a,
+	0x7b, 0x2a, 0x8f, 0x6f, 0x37, 0xca, 0xe3, 0xf9, 0x5a, 0x55, 0xcf, 0xa2, 0x27, 0x4a, 0xe2, 0xdc,
+	0xcc, 0x13, 0x9a, 0xb4, 0xd2, 0x4a, 0x14, 0x0e, 0x41, 0x3d, 0x46, 0x1c, 0x2d, 0x84, 0x63, 0x75,
+	0x8c, 0xde, 0xc1, 0xe0, 0xb3, 0x8d, 0xac, 0xc6, 0x8a, 0xa2, 0xa5, 0xb5, 0x00, 0xfc, 0xdd, 0x50,
+	0xa5, 0x30, 0x82, 0x64, 0xc4, 0x97, 0x9d, 0xf7, 0xe2, 0x64, 0x3a, 0xa7, 0x99, 0x70, 0x1a, 0xaa,
+	0x94, 0x47, 0x9b, 0x96, 0x52, 0xc8, 0x94, 0x7b, 0x3b, 0x4e, 0xa6, 0x4f, 0x69, 0xa6, 0x0d, 0x9d,
+	0xb4, 0x02, 0xce, 0x3d, 0xe0, 0x1f, 0x06, 0xb8, 0xb7, 0x04, 0x85, 0x37, 0xcd, 0xde, 0xa4, 0x81,
+	0x08, 0xe1, 0x0e, 0xf8, 0x90, 0x1c, 0xce, 0xb3, 0xd2, 0xe6, 0x8c, 0x10, 0xfe, 0x4e, 0x0e, 0x62,
+	0x1d, 0xcf, 0x1b, 0xba, 0x66, 0x2a, 0xf2, 0x76, 0xc6, 0x3c, 0x09, 0xa9, 0x97, 0x0e, 0x9c, 0xe6,
+	0x16, 0x0d, 0x5d, 0x95, 0x15, 0xcf, 0xa2, 0x71, 0xae, 0xf1, 0x7c, 0x50, 0x36, 0x14, 0x57, 0xa2,
+	0xf0, 0x37, 0x70, 0x12, 0x73, 0xea, 0x05, 0x28, 0x09, 0xb1, 0xef, 0xcd, 0x69, 0xe6, 0x21, 0x21,
+	0xd8, 0x2c, 0x5c, 0xd0, 0x50, 0x0a, 0xe7, 0x78, 0x0b, 0xfb, 0x31, 0xa7, 0x3f, 0x28, 0x95, 0xa7,
+	0x34, 0x3b, 0x5b, 0x6a, 0x68, 0xfb, 0x8f, 0xe3, 0x4a, 0x34, 0x3f, 0xcf, 0x77, 0x05, 0xf6, 0x29,
+	0x49, 0x02, 0x4a, 0xde, 0xb1, 0xb7, 0xb7, 0xb0, 0xbf, 0x2c, 0x55, 0x2a, 0xed, 0x45, 0x25, 0x2a,
+	0xe0, 0xa3, 0x95, 0xdd, 0xc7, 0x9c, 0xaa, 0xa3, 0x88, 0x82, 0x20, 0xba, 0x0a, 0x98, 0x90, 0xce,
+	0x47, 0x9d, 0x5a, 0xaf, 0x31, 0x71, 0xca, 0x88, 0x8b, 0x22, 0xe0, 0xac, 0xc4, 0x47, 0xa6, 0x55,
+	0xb3, 0xcd, 0x91, 0x69, 0x99, 0xf6, 0xde, 0xc8, 0xb4, 0xea, 0xf6, 0xfe, 0xc8, 0xb4, 0xf6, 0x6d,
+	0x6b, 0x64, 0x5a, 0x07, 0xf6, 0xe1, 0xc8, 0xb4, 0x0e, 0xed, 0xa3, 0x91, 0x69, 0x1d, 0xd9, 0xcd,
+	0xee, 0x3f, 0x35, 0x70, 0xb4, 0x76, 0xf1, 0xe1, 0x5d, 0x60, 0x15, 0x15, 0xe9, 0x39, 0xd3, 0x98,
+	0xec, 0xab, 0xff, 0x21, 0x81, 0x9f, 0x00, 0x80, 0x7d, 0x14, 0x86, 0x34, 0xc8, 0xc1, 0x5d, 0x05,
+	0x36, 0xf4, 0xca, 0x90, 0xc0, 0x7b, 0xa0, 0x81, 0x03, 0x46, 0x43, 0x99, 0xa3, 0x35, 0x85, 0x5a,
+	0xc5, 0xc2, 0x90, 0xc0, 0x07, 0xa0, 0xc9, 0x42, 0x26, 0x19, 0x0a, 0xca, 0x99, 0x60, 0xaa, 0x21,
+	0x76, 0xa4, 0x57, 0xf5, 0x3d, 0x46, 0xc0, 0x5e, 0xd6, 0xad, 0x07, 0xbc, 0xb3, 0xa7, 0x6e, 0xf4,
+	0xc3, 0xf7, 0xee, 0xf6, 0xca, 0x11, 0x5b, 0x9d, 0x9c, 0x7a, 0x8b, 0x8f, 0xf1, 0x3a, 0x06, 0x25,
+	0x68, 0xc5, 0x34, 0x24, 0x2c, 0x9c, 0x79, 0x7a, 0x62, 0xe5, 0x25, 0xcc, 0xa8, 0x70, 0xea, 0xaa,
+	0xad, 0x5f, 0xdf, 0x66, 0xb4, 0xbc, 0x4d, 0x97, 0x54, 0x5e, 0x28, 0xda, 0x18, 0xe1, 0x39, 0x95,
+	0x8f, 0x91, 0x44, 0xda, 0xf0, 0x8e, 0x56, 0x2f, 0xe6, 0x58, 0x11, 0x24, 0xe0, 0xe7, 0x00, 0x8a,
+	0x00, 0x09, 0xdf, 0x23, 0xd1, 0x55, 0x28, 0xd9, 0x82, 0x7a, 0x08, 0xcf, 0x9d, 0x7d, 0xd5, 0x48,
+	0x5b, 0x21, 0x8f, 0x35, 0x70, 0x86, 0xe7, 0xf0, 0x09, 0xd8, 0x8b, 0x7d, 0x24, 0xa8, 0xd3, 0xe8,
+	0x18, 0xbd, 0xe6, 0x96, 0x03, 0x7c, 0x9c, 0x33, 0x27, 0x85, 0xc0, 0xc8, 0xb4, 0x2c, 0xbb, 0xd1,
+	0x7d, 0x01, 0x5a, 0xd5, 0x63, 0x75, 0x8b, 0xe7, 0xa5, 0x05, 0xea, 0xba, 0x73, 0xbb, 0x0a, 0xd7,
+	0x7f, 0xe7, 0x3f, 0xbd, 0xbc, 0x6e, 0x1b, 0xaf, 0xae, 0xdb, 0xc6, 0xdf, 0xd7, 0x6d, 0xe3, 0xcf,
+	0x9b, 0xf6, 0xce, 0xab, 0x9b, 0xf6, 0xce, 0x5f, 0x37, 0xed, 0x9d, 0x17, 0xdf, 0xcd, 0x98, 0xf4,
+	0x93, 0x69, 0x1f, 0x47, 0x0b, 0x17, 0x47, 0x62, 0x11, 0x09, 0xf7, 0x4d, 0x1d, 0x5f, 0x2c, 0x5f,
+	0xc4, 0xf4, 0x2b, 0xf7, 0xe7, 0xf5, 0x67, 0x51, 0x66, 0x31, 0x15, 0xd3, 0xba, 0x7a, 0x11, 0xbf,
+	0xfc, 0x7f, 0x00, 0x8c, 0x0b, 0x2d, 0x89, 0x0e, 0x08, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -369,6 +403,47 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ConsumerCreatorAllowlist) > 0 {
+		for iNdEx := len(m.ConsumerCreatorAllowlist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ConsumerCreatorAllowlist[iNdEx])
+			copy(dAtA[i:], m.ConsumerCreatorAllowlist[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.ConsumerCreatorAllowlist[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.ScheduledKeyAssignments) > 0 {
+		for iNdEx := len(m.ScheduledKeyAssignments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ScheduledKeyAssignments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.PreLaunchKeyAssignments) > 0 {
+		for iNdEx := len(m.PreLaunchKeyAssignments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PreLaunchKeyAssignments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
 	if len(m.ConsumerAddrsToPruneV2) > 0 {
 		for iNdEx := len(m.ConsumerAddrsToPruneV2) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -629,6 +704,24 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.PreLaunchKeyAssignments) > 0 {
+		for _, e := range m.PreLaunchKeyAssignments {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ScheduledKeyAssignments) > 0 {
+		for _, e := range m.ScheduledKeyAssignments {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ConsumerCreatorAllowlist) > 0 {
+		for _, s := range m.ConsumerCreatorAllowlist {
+			l = len(s)
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -945,6 +1038,106 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreLaunchKeyAssignments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreLaunchKeyAssignments = append(m.PreLaunchKeyAssignments, PreLaunchKeyAssignment{})
+			if err := m.PreLaunchKeyAssignments[len(m.PreLaunchKeyAssignments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledKeyAssignments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ScheduledKeyAssignments = append(m.ScheduledKeyAssignments, ScheduledKeyAssignment{})
+			if err := m.ScheduledKeyAssignments[len(m.ScheduledKeyAssignments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerCreatorAllowlist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerCreatorAllowlist = append(m.ConsumerCreatorAllowlist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
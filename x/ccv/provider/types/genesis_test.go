@@ -66,7 +66,7 @@ func TestValidateGenesisState(t *testing.T) {
 				nil,
 				[]types.ConsumerState{{ChainId: "chainid-1", ChannelId: "channelid", ClientId: "client-id", ConsumerGenesis: getInitialConsumerGenesis(t, "chainid-1", false)}},
 				types.NewParams(types.DefaultTemplateClient(),
-					types.DefaultTrustingPeriodFraction, time.Hour, time.Hour, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 600, 24, 180),
+					types.DefaultTrustingPeriodFraction, time.Hour, time.Hour, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 600, 24, 180, 0, 3, false, false, 24*time.Hour, 0, 0, 0, 0, 0, false),
 				nil,
 				nil,
 				nil,
@@ -110,7 +110,7 @@ func TestValidateGenesisState(t *testing.T) {
 					ccv.DefaultCCVTimeoutPeriod,
 					types.DefaultSlashMeterReplenishPeriod,
 					types.DefaultSlashMeterReplenishFraction,
-					sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 600, 24, 180),
+					sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 600, 24, 180, 0, 3, false, false, 24*time.Hour, 0, 0, 0, 0, 0, false),
 				nil,
 				nil,
 				nil,
@@ -128,7 +128,7 @@ func TestValidateGenesisState(t *testing.T) {
 					0, // 0 ccv timeout here
 					types.DefaultSlashMeterReplenishPeriod,
 					types.DefaultSlashMeterReplenishFraction,
-					sdk.Coin{Denom: "stake", Amount: math.NewInt(1000000)}, 600, 24, 180),
+					sdk.Coin{Denom: "stake", Amount: math.NewInt(1000000)}, 600, 24, 180, 0, 3, false, false, 24*time.Hour, 0, 0, 0, 0, 0, false),
 				nil,
 				nil,
 				nil,
@@ -146,7 +146,7 @@ func TestValidateGenesisState(t *testing.T) {
 					ccv.DefaultCCVTimeoutPeriod,
 					0, // 0 slash meter replenish period here
 					types.DefaultSlashMeterReplenishFraction,
-					sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 600, 24, 180),
+					sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 600, 24, 180, 0, 3, false, false, 24*time.Hour, 0, 0, 0, 0, 0, false),
 				nil,
 				nil,
 				nil,
@@ -164,7 +164,7 @@ func TestValidateGenesisState(t *testing.T) {
 					ccv.DefaultCCVTimeoutPeriod,
 					types.DefaultSlashMeterReplenishPeriod,
 					"1.15",
-					sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 600, 24, 180),
+					sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 600, 24, 180, 0, 3, false, false, 24*time.Hour, 0, 0, 0, 0, 0, false),
 				nil,
 				nil,
 				nil,
@@ -272,7 +272,7 @@ func TestValidateGenesisState(t *testing.T) {
 				nil,
 				[]types.ConsumerState{{ChainId: "chainid-1", ChannelId: "channelid", ClientId: "client-id", ConsumerGenesis: getInitialConsumerGenesis(t, "chainid-1", false)}},
 				types.NewParams(types.DefaultTemplateClient(),
-					types.DefaultTrustingPeriodFraction, time.Hour, time.Hour, "0.1", sdk.Coin{Denom: "st", Amount: math.NewInt(10000000)}, 600, 24, 180),
+					types.DefaultTrustingPeriodFraction, time.Hour, time.Hour, "0.1", sdk.Coin{Denom: "st", Amount: math.NewInt(10000000)}, 600, 24, 180, 0, 3, false, false, 24*time.Hour, 0, 0, 0, 0, 0, false),
 				nil,
 				nil,
 				nil,
@@ -286,7 +286,7 @@ func TestValidateGenesisState(t *testing.T) {
 				nil,
 				[]types.ConsumerState{{ChainId: "chainid-1", ChannelId: "channelid", ClientId: "client-id", ConsumerGenesis: getInitialConsumerGenesis(t, "chainid-1", false)}},
 				types.NewParams(types.DefaultTemplateClient(),
-					types.DefaultTrustingPeriodFraction, time.Hour, time.Hour, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(-1000000)}, 600, 24, 180),
+					types.DefaultTrustingPeriodFraction, time.Hour, time.Hour, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(-1000000)}, 600, 24, 180, 0, 3, false, false, 24*time.Hour, 0, 0, 0, 0, 0, false),
 				nil,
 				nil,
 				nil,
@@ -308,6 +308,154 @@ func TestValidateGenesisState(t *testing.T) {
 	}
 }
 
+// Tests validation of the pre-launch key assignments within a provider genesis state
+func TestValidateGenesisStatePreLaunchKeyAssignments(t *testing.T) {
+	valOpAddr1 := crypto.NewCryptoIdentityFromIntSeed(1).SDKValOpAddress().String()
+	valOpAddr2 := crypto.NewCryptoIdentityFromIntSeed(2).SDKValOpAddress().String()
+	consumerKey := "{\"@type\":\"/cosmos.crypto.ed25519.PubKey\",\"key\":\"e3BehnEIlGUAnJYn9V8gBXuMh4tXO8xxlxyXD1APGyk=\"}"
+
+	testCases := []struct {
+		name           string
+		keyAssignments []types.PreLaunchKeyAssignment
+		expPass        bool
+	}{
+		{
+			"valid pre-launch key assignments",
+			[]types.PreLaunchKeyAssignment{
+				{ChainId: "chainid-1", ProviderAddr: valOpAddr1, ConsumerKey: consumerKey},
+				{ChainId: "chainid-1", ProviderAddr: valOpAddr2, ConsumerKey: consumerKey},
+				{ChainId: "chainid-2", ProviderAddr: valOpAddr1, ConsumerKey: consumerKey},
+			},
+			true,
+		},
+		{
+			"invalid chain id",
+			[]types.PreLaunchKeyAssignment{
+				{ChainId: " ", ProviderAddr: valOpAddr1, ConsumerKey: consumerKey},
+			},
+			false,
+		},
+		{
+			"invalid provider address",
+			[]types.PreLaunchKeyAssignment{
+				{ChainId: "chainid-1", ProviderAddr: "cosmosvaloper1invalid", ConsumerKey: consumerKey},
+			},
+			false,
+		},
+		{
+			"duplicate key assignment",
+			[]types.PreLaunchKeyAssignment{
+				{ChainId: "chainid-1", ProviderAddr: valOpAddr1, ConsumerKey: consumerKey},
+				{ChainId: "chainid-1", ProviderAddr: valOpAddr1, ConsumerKey: consumerKey},
+			},
+			false,
+		},
+		{
+			"invalid consumer key",
+			[]types.PreLaunchKeyAssignment{
+				{ChainId: "chainid-1", ProviderAddr: valOpAddr1, ConsumerKey: "key"},
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			genState := types.DefaultGenesisState()
+			genState.PreLaunchKeyAssignments = tc.keyAssignments
+			err := genState.Validate()
+
+			if tc.expPass {
+				require.NoError(t, err, "test case: %s must pass", tc.name)
+			} else {
+				require.Error(t, err, "test case: %s must fail", tc.name)
+			}
+		})
+	}
+}
+
+// Tests validation of the scheduled key assignments within a provider genesis state
+func TestValidateGenesisStateScheduledKeyAssignments(t *testing.T) {
+	valOpAddr := crypto.NewCryptoIdentityFromIntSeed(1).SDKValOpAddress().String()
+	consumerKey := "{\"@type\":\"/cosmos.crypto.ed25519.PubKey\",\"key\":\"e3BehnEIlGUAnJYn9V8gBXuMh4tXO8xxlxyXD1APGyk=\"}"
+
+	testCases := []struct {
+		name          string
+		keyAssignment types.ScheduledKeyAssignment
+		expPass       bool
+	}{
+		{
+			"valid scheduled key assignment",
+			types.ScheduledKeyAssignment{ConsumerId: "0", ProviderAddr: valOpAddr, ConsumerKey: consumerKey, ActivationHeight: 10},
+			true,
+		},
+		{
+			"invalid consumer id",
+			types.ScheduledKeyAssignment{ConsumerId: "chain", ProviderAddr: valOpAddr, ConsumerKey: consumerKey, ActivationHeight: 10},
+			false,
+		},
+		{
+			"invalid provider address",
+			types.ScheduledKeyAssignment{ConsumerId: "0", ProviderAddr: "cosmosvaloper1invalid", ConsumerKey: consumerKey, ActivationHeight: 10},
+			false,
+		},
+		{
+			"zero activation height",
+			types.ScheduledKeyAssignment{ConsumerId: "0", ProviderAddr: valOpAddr, ConsumerKey: consumerKey},
+			false,
+		},
+		{
+			"invalid consumer key",
+			types.ScheduledKeyAssignment{ConsumerId: "0", ProviderAddr: valOpAddr, ConsumerKey: "key", ActivationHeight: 10},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			genState := types.DefaultGenesisState()
+			genState.ScheduledKeyAssignments = []types.ScheduledKeyAssignment{tc.keyAssignment}
+			err := genState.Validate()
+
+			if tc.expPass {
+				require.NoError(t, err, "test case: %s must pass", tc.name)
+			} else {
+				require.Error(t, err, "test case: %s must fail", tc.name)
+			}
+		})
+	}
+}
+
+// Tests validation of the consumer creator allowlist within a provider genesis state
+func TestValidateGenesisStateConsumerCreatorAllowlist(t *testing.T) {
+	creator := sdk.AccAddress([]byte("creator")).String()
+
+	testCases := []struct {
+		name      string
+		allowlist []string
+		expPass   bool
+	}{
+		{"empty allowlist", nil, true},
+		{"valid allowlist", []string{creator, sdk.AccAddress([]byte("creator2")).String()}, true},
+		{"invalid address", []string{"cosmos1invalid"}, false},
+		{"duplicate address", []string{creator, creator}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			genState := types.DefaultGenesisState()
+			genState.ConsumerCreatorAllowlist = tc.allowlist
+			err := genState.Validate()
+
+			if tc.expPass {
+				require.NoError(t, err, "test case: %s must pass", tc.name)
+			} else {
+				require.Error(t, err, "test case: %s must fail", tc.name)
+			}
+		})
+	}
+}
+
 func getInitialConsumerGenesis(t *testing.T, chainID string, preCCV bool) ccv.ConsumerGenesisState {
 	t.Helper()
 	// generate validator public key
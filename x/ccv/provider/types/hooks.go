@@ -0,0 +1,77 @@
+package types
+
+import (
+	"context"
+)
+
+// ProviderHooks event hooks for the lifecycle of consumer chains, enabling other modules
+// (e.g., liquid staking or restaking modules) to react to consumer chain events.
+// Note that the errors returned by the hooks called in BeginBlock or EndBlock are logged,
+// while the errors returned by the hooks called in message handlers fail the messages.
+type ProviderHooks interface {
+	// AfterConsumerLaunched is called after the consumer chain with `consumerId` is launched
+	AfterConsumerLaunched(ctx context.Context, consumerId string) error
+	// AfterConsumerRemoved is called after the state of the stopped consumer chain with `consumerId` is deleted
+	AfterConsumerRemoved(ctx context.Context, consumerId string) error
+	// AfterValidatorOptedIn is called after the validator `providerAddr` opts in to the consumer chain with `consumerId`
+	AfterValidatorOptedIn(ctx context.Context, consumerId string, providerAddr ProviderConsAddress) error
+	// AfterValidatorOptedOut is called after the validator `providerAddr` opts out from the consumer chain with `consumerId`
+	AfterValidatorOptedOut(ctx context.Context, consumerId string, providerAddr ProviderConsAddress) error
+	// AfterVSCSent is called after a VSC packet with `valsetUpdateId` is sent to the consumer chain with `consumerId`
+	AfterVSCSent(ctx context.Context, consumerId string, valsetUpdateId uint64) error
+}
+
+var _ ProviderHooks = MultiProviderHooks{}
+
+// MultiProviderHooks combines multiple provider hooks, all hook functions are run in array sequence
+type MultiProviderHooks []ProviderHooks
+
+// NewMultiProviderHooks returns the combination of the given provider hooks
+func NewMultiProviderHooks(hooks ...ProviderHooks) MultiProviderHooks {
+	return hooks
+}
+
+func (h MultiProviderHooks) AfterConsumerLaunched(ctx context.Context, consumerId string) error {
+	for i := range h {
+		if err := h[i].AfterConsumerLaunched(ctx, consumerId); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiProviderHooks) AfterConsumerRemoved(ctx context.Context, consumerId string) error {
+	for i := range h {
+		if err := h[i].AfterConsumerRemoved(ctx, consumerId); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiProviderHooks) AfterValidatorOptedIn(ctx context.Context, consumerId string, providerAddr ProviderConsAddress) error {
+	for i := range h {
+		if err := h[i].AfterValidatorOptedIn(ctx, consumerId, providerAddr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiProviderHooks) AfterValidatorOptedOut(ctx context.Context, consumerId string, providerAddr ProviderConsAddress) error {
+	for i := range h {
+		if err := h[i].AfterValidatorOptedOut(ctx, consumerId, providerAddr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiProviderHooks) AfterVSCSent(ctx context.Context, consumerId string, valsetUpdateId uint64) error {
+	for i := range h {
+		if err := h[i].AfterVSCSent(ctx, consumerId, valsetUpdateId); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 
 	ccvtypes "github.com/cosmos/interchain-security/v7/x/ccv/types"
 )
@@ -27,6 +28,10 @@ const (
 	// QuerierRoute is the querier route for IBC transfer
 	QuerierRoute = ModuleName
 
+	// ConsensusVersion is the consensus version of the module, which is incremented
+	// with every migration of the module state
+	ConsensusVersion = 13
+
 	// Default validator set update ID
 	DefaultValsetUpdateID = 1
 
@@ -37,6 +42,26 @@ const (
 	// a consumer chain can allowlist
 	MaxAllowlistedRewardDenomsPerChain = 3
 
+	// MaxFundFlowRecords corresponds to the maximum number of transfers of funds
+	// in and out of the CCV module accounts retained in state
+	MaxFundFlowRecords = 100
+
+	// MaxConsumerUpdateRecords corresponds to the maximum number of accepted updates
+	// of a consumer chain retained in state
+	MaxConsumerUpdateRecords = 100
+
+	// MaxValidatorOptInRecords corresponds to the maximum number of opt-ins and opt-outs
+	// of a validator on a consumer chain retained in state
+	MaxValidatorOptInRecords = 100
+
+	// MaxKeyAssignmentActivationDistance corresponds to the maximum number of blocks
+	// between the current height and the activation height of a scheduled key assignment
+	MaxKeyAssignmentActivationDistance = 100000
+
+	// MaxScheduledKeyAssignmentsPerValidator corresponds to the maximum number of key assignments
+	// a validator can schedule for a consumer chain
+	MaxScheduledKeyAssignmentsPerValidator = 5
+
 	// Names for the store keys.
 	// Used for storing the byte prefixes in the constant map.
 	// See getKeyPrefixes().
@@ -162,6 +187,84 @@ const (
 	ConsumerIdToQueuedInfractionParametersKeyName = "ConsumerIdToQueuedInfractionParametersKeyName"
 
 	InfractionScheduledTimeToConsumerIdsKeyName = "InfractionScheduledTimeToConsumerIdsKeyName"
+
+	ConsumerIdToInitialConsensusStateKeyName = "ConsumerIdToInitialConsensusStateKeyName"
+
+	ConsumerIdToPreviousChannelIdKeyName = "ConsumerIdToPreviousChannelIdKeyName"
+
+	ChannelIdToVSCPacketTimeoutKeyName = "ChannelIdToVSCPacketTimeoutKeyName"
+
+	ChannelIdToUnackedVSCPacketKeyName = "ChannelIdToUnackedVSCPacketKeyName"
+
+	ConsumerIdToChannelRecoveryTimeKeyName = "ConsumerIdToChannelRecoveryTimeKeyName"
+
+	ValidatorTopNBudgetKeyName = "ValidatorTopNBudgetKeyName"
+
+	ConsumerIdToPowerShapingPipelineKeyName = "ConsumerIdToPowerShapingPipelineKeyName"
+
+	ImmediateValidatorUpdatesKeyName = "ImmediateValidatorUpdatesKeyName"
+
+	DowntimeJailedValidatorKeyName = "DowntimeJailedValidatorKeyName"
+
+	FundFlowRecordKeyName = "FundFlowRecordKeyName"
+
+	FundFlowRecordSeqKeyName = "FundFlowRecordSeqKeyName"
+
+	ConsumerIdToLaunchFailureKeyName = "ConsumerIdToLaunchFailureKeyName"
+
+	SpawnHeightToConsumerIdsKeyName = "SpawnHeightToConsumerIdsKeyName"
+
+	ConsumerIdToHashCommitmentKeyName = "ConsumerIdToHashCommitmentKeyName"
+
+	ChainIdToPreLaunchKeyAssignmentKeyName = "ChainIdToPreLaunchKeyAssignmentKeyName"
+
+	ConsumerCreatorAllowlistKeyName = "ConsumerCreatorAllowlistKeyName"
+
+	ConsumerIdToRewardDenomHintKeyName = "ConsumerIdToRewardDenomHintKeyName"
+
+	ConsumerIdToPacketStatsKeyName = "ConsumerIdToPacketStatsKeyName"
+
+	ConsumerIdToPendingChainIdKeyName = "ConsumerIdToPendingChainIdKeyName"
+
+	ConsumerIdToClientUpgradePlanKeyName = "ConsumerIdToClientUpgradePlanKeyName"
+
+	ConsumerIdToClientExpiryKeyName = "ConsumerIdToClientExpiryKeyName"
+
+	ConsumerIdToBouncedSlashPacketKeyName = "ConsumerIdToBouncedSlashPacketKeyName"
+
+	ConsumerIdToLastVSCPacketKeyName = "ConsumerIdToLastVSCPacketKeyName"
+
+	ConsumerIdToThrottlingParametersKeyName = "ConsumerIdToThrottlingParametersKeyName"
+
+	ConsumerIdToSlashMeterKeyName = "ConsumerIdToSlashMeterKeyName"
+
+	ConsumerIdToSlashMeterReplenishTimeCandidateKeyName = "ConsumerIdToSlashMeterReplenishTimeCandidateKeyName"
+
+	ActivationHeightToKeyAssignmentKeyName = "ActivationHeightToKeyAssignmentKeyName"
+
+	ConsumerIdToInfractionUpdateTimeKeyName = "ConsumerIdToInfractionUpdateTimeKeyName"
+
+	KeyAssignmentObservationKeyName = "KeyAssignmentObservationKeyName"
+
+	ValidatorPowerHistoryKeyName = "ValidatorPowerHistoryKeyName"
+
+	ValidatorFeeExemptionUsageKeyName = "ValidatorFeeExemptionUsageKeyName"
+
+	ConsumerUpdateRecordKeyName = "ConsumerUpdateRecordKeyName"
+
+	ConsumerUpdateRecordSeqKeyName = "ConsumerUpdateRecordSeqKeyName"
+
+	ValidatorOptInRecordKeyName = "ValidatorOptInRecordKeyName"
+
+	ValidatorOptInRecordSeqKeyName = "ValidatorOptInRecordSeqKeyName"
+
+	EpochInfoKeyName = "EpochInfoKeyName"
+
+	ConsumerIdToClientExpiryWarnedKeyName = "ConsumerIdToClientExpiryWarnedKeyName"
+
+	ConsumerIdToProviderSwitchKeyName = "ConsumerIdToProviderSwitchKeyName"
+
+	ConsumerIdToScheduledKeyAssignmentKeyName = "ConsumerIdToScheduledKeyAssignmentKeyName"
 )
 
 // getKeyPrefixes returns a constant map of all the byte prefixes for existing keys
@@ -412,6 +515,151 @@ func getKeyPrefixes() map[string]byte {
 		// InfractionScheduledTimeToConsumerIdsKeyName is the key for storing time when the infraction parameters will be updated for the specific consumer
 		InfractionScheduledTimeToConsumerIdsKeyName: 59,
 
+		// ConsumerIdToInitialConsensusStateKeyName is the key for storing the owner-provided initial consensus state
+		// of the client that the provider creates for a consumer chain
+		ConsumerIdToInitialConsensusStateKeyName: 60,
+
+		// ConsumerIdToPreviousChannelIdKeyName is the key for storing the CCV channel of a consumer chain
+		// that was replaced by a migration channel and that is not yet closed
+		ConsumerIdToPreviousChannelIdKeyName: 61,
+
+		// ChannelIdToVSCPacketTimeoutKeyName is the key for storing the timeout timestamps
+		// of the VSC packets sent on a CCV channel that are not yet acknowledged
+		ChannelIdToVSCPacketTimeoutKeyName: 62,
+
+		// ChannelIdToUnackedVSCPacketKeyName is the key for storing the data of the VSC packets
+		// sent on a CCV channel that are not yet acknowledged
+		ChannelIdToUnackedVSCPacketKeyName: 63,
+
+		// ConsumerIdToChannelRecoveryTimeKeyName is the key for storing the time at which the CCV channel
+		// of a consumer chain timed out and the reestablishment of the channel was staged
+		ConsumerIdToChannelRecoveryTimeKeyName: 64,
+
+		// ValidatorTopNBudgetKeyName is the key for storing the maximal number of Top N consumer chains
+		// a validator is automatically opted in to
+		ValidatorTopNBudgetKeyName: 65,
+
+		// ConsumerIdToPowerShapingPipelineKeyName is the key for storing the power shaping steps applied
+		// the last time the validator set of a consumer chain was computed
+		ConsumerIdToPowerShapingPipelineKeyName: 66,
+
+		// ImmediateValidatorUpdatesKeyName is the key for storing whether changes of the provider validator set
+		// must be sent to the consumer chains before the end of the epoch
+		ImmediateValidatorUpdatesKeyName: 67,
+
+		// DowntimeJailedValidatorKeyName is the key for storing the validators jailed for downtime
+		// that must be removed from the consumer chains at the end of the current block
+		DowntimeJailedValidatorKeyName: 68,
+
+		// FundFlowRecordKeyName is the key for storing the most recent transfers of funds
+		// in and out of the CCV module accounts
+		FundFlowRecordKeyName: 69,
+
+		// FundFlowRecordSeqKeyName is the key for storing the sequence number of the next fund flow record
+		FundFlowRecordSeqKeyName: 70,
+
+		// ConsumerIdToLaunchFailureKeyName is the key for storing the last failed launch of a consumer chain
+		ConsumerIdToLaunchFailureKeyName: 71,
+
+		// SpawnHeightToConsumerIdsKeyName is the key for storing pending initialized consumers that are to be launched
+		// at a spawn height instead of a spawn time
+		SpawnHeightToConsumerIdsKeyName: 72,
+
+		// ConsumerIdToHashCommitmentKeyName is the key for storing the record of the genesis and binary hashes
+		// of a consumer chain committed at registration
+		ConsumerIdToHashCommitmentKeyName: 73,
+
+		// ChainIdToPreLaunchKeyAssignmentKeyName is the key for storing the pre-agreed key assignments
+		// of a validator for a consumer chain that is not yet created
+		ChainIdToPreLaunchKeyAssignmentKeyName: 74,
+
+		// ConsumerCreatorAllowlistKeyName is the key for storing the addresses allowed to create consumer chains
+		ConsumerCreatorAllowlistKeyName: 75,
+
+		// ConsumerIdToRewardDenomHintKeyName is the key for storing the reward denom hint of the given consumer id
+		ConsumerIdToRewardDenomHintKeyName: 76,
+
+		// ConsumerIdToPacketStatsKeyName is the key for storing the packet statistics of the given consumer id
+		ConsumerIdToPacketStatsKeyName: 77,
+
+		// ConsumerIdToPendingChainIdKeyName is the key for storing the chain id the consumer chain with the given
+		// consumer id is scheduled to change to once its client is upgraded
+		ConsumerIdToPendingChainIdKeyName: 78,
+
+		// ConsumerIdToClientUpgradePlanKeyName is the key for storing the pending upgrade of the client
+		// of the given consumer id
+		ConsumerIdToClientUpgradePlanKeyName: 79,
+
+		// ConsumerIdToClientExpiryKeyName is the key for storing the time at which the client
+		// of the given consumer id expires, i.e., the end of the trusting period of its latest consensus state
+		ConsumerIdToClientExpiryKeyName: 80,
+
+		// ConsumerIdToBouncedSlashPacketKeyName is the key for storing the slash packet of the given consumer id
+		// that was bounced because the slash meter was negative and is pending a retry
+		ConsumerIdToBouncedSlashPacketKeyName: 81,
+
+		// ConsumerIdToLastVSCPacketKeyName is the key for storing the last VSC packet sent to the given consumer id
+		ConsumerIdToLastVSCPacketKeyName: 82,
+
+		// ConsumerIdToThrottlingParametersKeyName is the key for storing the throttling parameters of the given consumer id
+		ConsumerIdToThrottlingParametersKeyName: 83,
+
+		// ConsumerIdToSlashMeterKeyName is the key for storing the slash meter of the given consumer id,
+		// which throttles its slash packets instead of the global slash meter
+		ConsumerIdToSlashMeterKeyName: 84,
+
+		// ConsumerIdToSlashMeterReplenishTimeCandidateKeyName is the key for storing the next time
+		// the slash meter of the given consumer id could be replenished
+		ConsumerIdToSlashMeterReplenishTimeCandidateKeyName: 85,
+
+		// ActivationHeightToKeyAssignmentKeyName is the key for storing the consumer key assignments
+		// scheduled by validators, indexed by their activation heights
+		ActivationHeightToKeyAssignmentKeyName: 86,
+
+		// ConsumerIdToInfractionUpdateTimeKeyName is the key for storing the time at which
+		// the queued infraction parameters of the given consumer id are applied
+		ConsumerIdToInfractionUpdateTimeKeyName: 87,
+
+		// KeyAssignmentObservationKeyName is the key for storing whether the assigned consumer keys
+		// used in the validator sets of consumer chains were observed in the heartbeats of the consumer chains
+		KeyAssignmentObservationKeyName: 88,
+
+		// ValidatorPowerHistoryKeyName is the key for storing the voting powers of the provider validators
+		// at the beginning of the last epochs, used by weighted Top N chains
+		ValidatorPowerHistoryKeyName: 89,
+
+		// ValidatorFeeExemptionUsageKeyName is the key for storing the number of fee-exempt messages
+		// of the provider validators in the current epoch
+		ValidatorFeeExemptionUsageKeyName: 90,
+
+		// ConsumerUpdateRecordKeyName is the key for storing the most recent accepted updates of consumer chains
+		ConsumerUpdateRecordKeyName: 91,
+
+		// ConsumerUpdateRecordSeqKeyName is the key for storing the sequence number of the next update of consumer chains
+		ConsumerUpdateRecordSeqKeyName: 92,
+
+		// ValidatorOptInRecordKeyName is the key for storing the most recent opt-ins and opt-outs of validators
+		ValidatorOptInRecordKeyName: 93,
+
+		// ValidatorOptInRecordSeqKeyName is the key for storing the sequence number of the next opt-in or opt-out
+		// of validators on consumer chains
+		ValidatorOptInRecordSeqKeyName: 94,
+
+		// EpochInfoKeyName is the key for storing the current epoch when the epochs are expressed in time
+		EpochInfoKeyName: 95,
+
+		// ConsumerIdToClientExpiryWarnedKeyName is the key for storing the flag marking whether the provider
+		// warned that the client of the given consumer id is about to expire
+		ConsumerIdToClientExpiryWarnedKeyName: 96,
+
+		// ConsumerIdToProviderSwitchKeyName is the key for storing the switch of the given consumer id
+		// to a different provider chain
+		ConsumerIdToProviderSwitchKeyName: 97,
+
+		// ConsumerIdToScheduledKeyAssignmentKeyName is the key for indexing the consumer key assignments
+		// scheduled by validators by the given consumer id and validator
+		ConsumerIdToScheduledKeyAssignmentKeyName: 98,
+
 		// NOTE: DO NOT ADD NEW BYTE PREFIXES HERE WITHOUT ADDING THEM TO TestPreserveBytePrefix() IN keys_test.go
 	}
 }
@@ -850,6 +1098,423 @@ func InfractionScheduledTimeToConsumerIdsKey(updateTime time.Time) []byte {
 	)
 }
 
+// ConsumerIdToInitialConsensusStateKeyPrefix returns the key prefix for storing the owner-provided initial consensus states
+func ConsumerIdToInitialConsensusStateKeyPrefix() byte {
+	return mustGetKeyPrefix(ConsumerIdToInitialConsensusStateKeyName)
+}
+
+// ConsumerIdToInitialConsensusStateKey returns the key used to store the owner-provided initial consensus state of this consumer id
+func ConsumerIdToInitialConsensusStateKey(consumerId string) []byte {
+	return StringIdWithLenKey(ConsumerIdToInitialConsensusStateKeyPrefix(), consumerId)
+}
+
+// ConsumerIdToPreviousChannelIdKey returns the key used to store the CCV channel of this consumer id
+// that was replaced by a migration channel
+func ConsumerIdToPreviousChannelIdKey(consumerId string) []byte {
+	return StringIdWithLenKey(mustGetKeyPrefix(ConsumerIdToPreviousChannelIdKeyName), consumerId)
+}
+
+// ChannelIdToVSCPacketTimeoutKeyPrefix returns the key prefix for storing the timeout timestamps
+// of the unacknowledged VSC packets sent on a CCV channel
+func ChannelIdToVSCPacketTimeoutKeyPrefix(channelId string) []byte {
+	return StringIdWithLenKey(mustGetKeyPrefix(ChannelIdToVSCPacketTimeoutKeyName), channelId)
+}
+
+// ChannelIdToVSCPacketTimeoutKey returns the key used to store the timeout timestamp
+// of the unacknowledged VSC packet with the given sequence sent on a CCV channel
+func ChannelIdToVSCPacketTimeoutKey(channelId string, sequence uint64) []byte {
+	return ccvtypes.AppendMany(ChannelIdToVSCPacketTimeoutKeyPrefix(channelId), sdk.Uint64ToBigEndian(sequence))
+}
+
+// ChannelIdToUnackedVSCPacketKeyPrefix returns the key prefix for storing the data
+// of the unacknowledged VSC packets sent on a CCV channel
+func ChannelIdToUnackedVSCPacketKeyPrefix(channelId string) []byte {
+	return StringIdWithLenKey(mustGetKeyPrefix(ChannelIdToUnackedVSCPacketKeyName), channelId)
+}
+
+// ChannelIdToUnackedVSCPacketKey returns the key used to store the data
+// of the unacknowledged VSC packet with the given sequence sent on a CCV channel
+func ChannelIdToUnackedVSCPacketKey(channelId string, sequence uint64) []byte {
+	return ccvtypes.AppendMany(ChannelIdToUnackedVSCPacketKeyPrefix(channelId), sdk.Uint64ToBigEndian(sequence))
+}
+
+// ConsumerIdToChannelRecoveryTimeKey returns the key used to store the time at which
+// the reestablishment of the CCV channel of this consumer id was staged
+func ConsumerIdToChannelRecoveryTimeKey(consumerId string) []byte {
+	return StringIdWithLenKey(mustGetKeyPrefix(ConsumerIdToChannelRecoveryTimeKeyName), consumerId)
+}
+
+// ValidatorTopNBudgetKey returns the key used to store the maximal number of Top N consumer chains
+// the validator with the given provider address is automatically opted in to
+func ValidatorTopNBudgetKey(providerAddr ProviderConsAddress) []byte {
+	return append([]byte{mustGetKeyPrefix(ValidatorTopNBudgetKeyName)}, providerAddr.ToSdkConsAddr().Bytes()...)
+}
+
+// ConsumerIdToPowerShapingPipelineKey returns the key used to store the power shaping steps applied
+// the last time the validator set of the consumer chain with the given consumer id was computed
+func ConsumerIdToPowerShapingPipelineKey(consumerId string) []byte {
+	return StringIdWithLenKey(mustGetKeyPrefix(ConsumerIdToPowerShapingPipelineKeyName), consumerId)
+}
+
+// ImmediateValidatorUpdatesKey returns the key used to store whether changes of the provider validator set
+// must be sent to the consumer chains before the end of the epoch
+func ImmediateValidatorUpdatesKey() []byte {
+	return []byte{mustGetKeyPrefix(ImmediateValidatorUpdatesKeyName)}
+}
+
+// DowntimeJailedValidatorKeyPrefix returns the key prefix used to store the validators jailed for downtime
+// that must be removed from the consumer chains at the end of the current block
+func DowntimeJailedValidatorKeyPrefix() byte {
+	return mustGetKeyPrefix(DowntimeJailedValidatorKeyName)
+}
+
+// DowntimeJailedValidatorKey returns the key used to store a validator jailed for downtime
+// that must be removed from the consumer chains at the end of the current block
+func DowntimeJailedValidatorKey(providerAddr ProviderConsAddress) []byte {
+	return append([]byte{DowntimeJailedValidatorKeyPrefix()}, providerAddr.ToSdkConsAddr().Bytes()...)
+}
+
+// FundFlowRecordKeyPrefix returns the key prefix used to store the most recent transfers of funds
+// in and out of the CCV module accounts
+func FundFlowRecordKeyPrefix() byte {
+	return mustGetKeyPrefix(FundFlowRecordKeyName)
+}
+
+// FundFlowRecordKey returns the key used to store the fund flow record with the given sequence number
+func FundFlowRecordKey(seq uint64) []byte {
+	return append([]byte{FundFlowRecordKeyPrefix()}, sdk.Uint64ToBigEndian(seq)...)
+}
+
+// FundFlowRecordSeqKey returns the key used to store the sequence number of the next fund flow record
+func FundFlowRecordSeqKey() []byte {
+	return []byte{mustGetKeyPrefix(FundFlowRecordSeqKeyName)}
+}
+
+// ConsumerIdToLaunchFailureKey returns the key used to store the last failed launch
+// of the consumer chain with the given consumer id
+func ConsumerIdToLaunchFailureKey(consumerId string) []byte {
+	return StringIdWithLenKey(mustGetKeyPrefix(ConsumerIdToLaunchFailureKeyName), consumerId)
+}
+
+// SpawnHeightToConsumerIdsKeyPrefix returns the key prefix for storing pending chains that are to be launched
+// at a spawn height
+func SpawnHeightToConsumerIdsKeyPrefix() byte {
+	return mustGetKeyPrefix(SpawnHeightToConsumerIdsKeyName)
+}
+
+// SpawnHeightToConsumerIdsKey returns the key used to store the ids of the consumer chains
+// that are to be launched at the given spawn height
+func SpawnHeightToConsumerIdsKey(spawnHeight uint64) []byte {
+	return append([]byte{SpawnHeightToConsumerIdsKeyPrefix()}, sdk.Uint64ToBigEndian(spawnHeight)...)
+}
+
+// ConsumerIdToHashCommitmentKey returns the key used to store the record of the genesis
+// and binary hashes of the consumer chain with the given consumer id
+func ConsumerIdToHashCommitmentKey(consumerId string) []byte {
+	return StringIdWithLenKey(mustGetKeyPrefix(ConsumerIdToHashCommitmentKeyName), consumerId)
+}
+
+// ChainIdToPreLaunchKeyAssignmentKeyPrefix returns the key prefix for storing the pre-agreed
+// key assignments of consumer chains that are not yet created
+func ChainIdToPreLaunchKeyAssignmentKeyPrefix() byte {
+	return mustGetKeyPrefix(ChainIdToPreLaunchKeyAssignmentKeyName)
+}
+
+// ChainIdToPreLaunchKeyAssignmentKey returns the key used to store the pre-agreed key assignment
+// of the validator with `valAddr` for the consumer chain with `chainId`
+func ChainIdToPreLaunchKeyAssignmentKey(chainId string, valAddr sdk.ValAddress) []byte {
+	return ccvtypes.AppendMany(
+		StringIdWithLenKey(ChainIdToPreLaunchKeyAssignmentKeyPrefix(), chainId),
+		valAddr,
+	)
+}
+
+// ConsumerCreatorAllowlistKeyPrefix returns the key prefix for storing the addresses allowed to create consumer chains
+func ConsumerCreatorAllowlistKeyPrefix() []byte {
+	return []byte{mustGetKeyPrefix(ConsumerCreatorAllowlistKeyName)}
+}
+
+// ConsumerCreatorAllowlistKey returns the key used to store whether `addr` is allowed to create consumer chains
+func ConsumerCreatorAllowlistKey(addr sdk.AccAddress) []byte {
+	return append(ConsumerCreatorAllowlistKeyPrefix(), addr...)
+}
+
+// ConsumerIdToRewardDenomHintKeyPrefix returns the key prefix for storing the reward denom hint of a consumer chain
+func ConsumerIdToRewardDenomHintKeyPrefix() byte {
+	return mustGetKeyPrefix(ConsumerIdToRewardDenomHintKeyName)
+}
+
+// ConsumerIdToRewardDenomHintKey returns the key used to store the reward denom hint of the consumer chain with `consumerId`
+func ConsumerIdToRewardDenomHintKey(consumerId string) []byte {
+	return StringIdWithLenKey(ConsumerIdToRewardDenomHintKeyPrefix(), consumerId)
+}
+
+// ConsumerIdToPacketStatsKeyPrefix returns the key prefix for storing the packet statistics of a consumer chain
+func ConsumerIdToPacketStatsKeyPrefix() byte {
+	return mustGetKeyPrefix(ConsumerIdToPacketStatsKeyName)
+}
+
+// ConsumerIdToPacketStatsKey returns the key used to store the packet statistics of the consumer chain with `consumerId`
+func ConsumerIdToPacketStatsKey(consumerId string) []byte {
+	return StringIdWithLenKey(ConsumerIdToPacketStatsKeyPrefix(), consumerId)
+}
+
+// ConsumerIdToPendingChainIdKeyPrefix returns the key prefix for storing the pending chain id of a consumer chain
+func ConsumerIdToPendingChainIdKeyPrefix() byte {
+	return mustGetKeyPrefix(ConsumerIdToPendingChainIdKeyName)
+}
+
+// ConsumerIdToPendingChainIdKey returns the key used to store the pending chain id of the consumer chain with `consumerId`
+func ConsumerIdToPendingChainIdKey(consumerId string) []byte {
+	return StringIdWithLenKey(ConsumerIdToPendingChainIdKeyPrefix(), consumerId)
+}
+
+// ConsumerIdToClientUpgradePlanKeyPrefix returns the key prefix for storing the client upgrade plan of a consumer chain
+func ConsumerIdToClientUpgradePlanKeyPrefix() byte {
+	return mustGetKeyPrefix(ConsumerIdToClientUpgradePlanKeyName)
+}
+
+// ConsumerIdToClientUpgradePlanKey returns the key used to store the client upgrade plan of the consumer chain with `consumerId`
+func ConsumerIdToClientUpgradePlanKey(consumerId string) []byte {
+	return StringIdWithLenKey(ConsumerIdToClientUpgradePlanKeyPrefix(), consumerId)
+}
+
+// ConsumerIdToClientExpiryKeyPrefix returns the key prefix for storing the client expiry time of a consumer chain
+func ConsumerIdToClientExpiryKeyPrefix() byte {
+	return mustGetKeyPrefix(ConsumerIdToClientExpiryKeyName)
+}
+
+// ConsumerIdToClientExpiryKey returns the key used to store the client expiry time of the consumer chain with `consumerId`
+func ConsumerIdToClientExpiryKey(consumerId string) []byte {
+	return StringIdWithLenKey(ConsumerIdToClientExpiryKeyPrefix(), consumerId)
+}
+
+// ConsumerIdToBouncedSlashPacketKeyPrefix returns the key prefix for storing the bounced slash packet of a consumer chain
+func ConsumerIdToBouncedSlashPacketKeyPrefix() byte {
+	return mustGetKeyPrefix(ConsumerIdToBouncedSlashPacketKeyName)
+}
+
+// ConsumerIdToBouncedSlashPacketKey returns the key used to store the bounced slash packet of the consumer chain with `consumerId`
+func ConsumerIdToBouncedSlashPacketKey(consumerId string) []byte {
+	return StringIdWithLenKey(ConsumerIdToBouncedSlashPacketKeyPrefix(), consumerId)
+}
+
+// ConsumerIdToLastVSCPacketKeyPrefix returns the key prefix for storing the last VSC packet sent to a consumer chain
+func ConsumerIdToLastVSCPacketKeyPrefix() byte {
+	return mustGetKeyPrefix(ConsumerIdToLastVSCPacketKeyName)
+}
+
+// ConsumerIdToLastVSCPacketKey returns the key used to store the last VSC packet sent to the consumer chain with `consumerId`
+func ConsumerIdToLastVSCPacketKey(consumerId string) []byte {
+	return StringIdWithLenKey(ConsumerIdToLastVSCPacketKeyPrefix(), consumerId)
+}
+
+// ConsumerIdToThrottlingParametersKeyPrefix returns the key prefix for storing the throttling parameters of a consumer chain
+func ConsumerIdToThrottlingParametersKeyPrefix() byte {
+	return mustGetKeyPrefix(ConsumerIdToThrottlingParametersKeyName)
+}
+
+// ConsumerIdToThrottlingParametersKey returns the key used to store the throttling parameters of the consumer chain with `consumerId`
+func ConsumerIdToThrottlingParametersKey(consumerId string) []byte {
+	return StringIdWithLenKey(ConsumerIdToThrottlingParametersKeyPrefix(), consumerId)
+}
+
+// ConsumerIdToSlashMeterKeyPrefix returns the key prefix for storing the slash meter of a consumer chain
+func ConsumerIdToSlashMeterKeyPrefix() byte {
+	return mustGetKeyPrefix(ConsumerIdToSlashMeterKeyName)
+}
+
+// ConsumerIdToSlashMeterKey returns the key used to store the slash meter of the consumer chain with `consumerId`
+func ConsumerIdToSlashMeterKey(consumerId string) []byte {
+	return StringIdWithLenKey(ConsumerIdToSlashMeterKeyPrefix(), consumerId)
+}
+
+// ConsumerIdToSlashMeterReplenishTimeCandidateKeyPrefix returns the key prefix for storing
+// the slash meter replenish time candidate of a consumer chain
+func ConsumerIdToSlashMeterReplenishTimeCandidateKeyPrefix() byte {
+	return mustGetKeyPrefix(ConsumerIdToSlashMeterReplenishTimeCandidateKeyName)
+}
+
+// ConsumerIdToSlashMeterReplenishTimeCandidateKey returns the key used to store the slash meter
+// replenish time candidate of the consumer chain with `consumerId`
+func ConsumerIdToSlashMeterReplenishTimeCandidateKey(consumerId string) []byte {
+	return StringIdWithLenKey(ConsumerIdToSlashMeterReplenishTimeCandidateKeyPrefix(), consumerId)
+}
+
+// ActivationHeightToKeyAssignmentKeyPrefix returns the key prefix for storing the consumer key assignments
+// scheduled by validators
+func ActivationHeightToKeyAssignmentKeyPrefix() byte {
+	return mustGetKeyPrefix(ActivationHeightToKeyAssignmentKeyName)
+}
+
+// ActivationHeightToKeyAssignmentKey returns the key used to store the consumer key assignment of the
+// validator with `valAddr` for the consumer chain with `consumerId` that is scheduled at `activationHeight`
+func ActivationHeightToKeyAssignmentKey(activationHeight uint64, consumerId string, valAddr sdk.ValAddress) []byte {
+	return ccvtypes.AppendMany(
+		[]byte{ActivationHeightToKeyAssignmentKeyPrefix()},
+		sdk.Uint64ToBigEndian(activationHeight),
+		sdk.Uint64ToBigEndian(uint64(len(consumerId))),
+		[]byte(consumerId),
+		valAddr,
+	)
+}
+
+// ConsumerIdToInfractionUpdateTimeKeyPrefix returns the key prefix for storing the times at which
+// the queued infraction parameters of consumer chains are applied
+func ConsumerIdToInfractionUpdateTimeKeyPrefix() byte {
+	return mustGetKeyPrefix(ConsumerIdToInfractionUpdateTimeKeyName)
+}
+
+// ConsumerIdToInfractionUpdateTimeKey returns the key used to store the time at which
+// the queued infraction parameters of the consumer chain with `consumerId` are applied
+func ConsumerIdToInfractionUpdateTimeKey(consumerId string) []byte {
+	return StringIdWithLenKey(ConsumerIdToInfractionUpdateTimeKeyPrefix(), consumerId)
+}
+
+// KeyAssignmentObservationKeyPrefix returns the key prefix for storing the observations of assigned consumer keys
+func KeyAssignmentObservationKeyPrefix() byte {
+	return mustGetKeyPrefix(KeyAssignmentObservationKeyName)
+}
+
+// KeyAssignmentObservationKey returns the key used to store whether the assigned consumer key with
+// consensus address `addr` was observed in the heartbeats of the consumer chain with `consumerId`
+func KeyAssignmentObservationKey(consumerId string, addr ConsumerConsAddress) []byte {
+	return StringIdAndConsAddrKey(KeyAssignmentObservationKeyPrefix(), consumerId, addr.ToSdkConsAddr())
+}
+
+// ValidatorPowerHistoryKeyPrefix returns the key prefix for storing the voting power histories of the provider validators
+func ValidatorPowerHistoryKeyPrefix() byte {
+	return mustGetKeyPrefix(ValidatorPowerHistoryKeyName)
+}
+
+// ValidatorPowerHistoryKey returns the key used to store the voting power history of the provider validator
+// with consensus address `addr`
+func ValidatorPowerHistoryKey(addr ProviderConsAddress) []byte {
+	return append([]byte{ValidatorPowerHistoryKeyPrefix()}, addr.ToSdkConsAddr().Bytes()...)
+}
+
+// ValidatorFeeExemptionUsageKeyPrefix returns the key prefix for storing the number of fee-exempt messages
+// of the provider validators in the current epoch
+func ValidatorFeeExemptionUsageKeyPrefix() byte {
+	return mustGetKeyPrefix(ValidatorFeeExemptionUsageKeyName)
+}
+
+// ValidatorFeeExemptionUsageKey returns the key used to store the number of fee-exempt messages
+// of the provider validator with operator address `valAddr` in the current epoch
+func ValidatorFeeExemptionUsageKey(valAddr sdk.ValAddress) []byte {
+	return append([]byte{ValidatorFeeExemptionUsageKeyPrefix()}, valAddr.Bytes()...)
+}
+
+// ConsumerUpdateRecordKeyPrefix returns the key prefix for storing the most recent accepted updates
+// of the consumer chain with `consumerId`
+func ConsumerUpdateRecordKeyPrefix(consumerId string) []byte {
+	return StringIdWithLenKey(mustGetKeyPrefix(ConsumerUpdateRecordKeyName), consumerId)
+}
+
+// ConsumerUpdateRecordKey returns the key used to store the accepted update with sequence number `seq`
+// of the consumer chain with `consumerId`
+func ConsumerUpdateRecordKey(consumerId string, seq uint64) []byte {
+	return ccvtypes.AppendMany(ConsumerUpdateRecordKeyPrefix(consumerId), sdk.Uint64ToBigEndian(seq))
+}
+
+// ConsumerUpdateRecordSeqKey returns the key used to store the sequence number of the next accepted update
+// of the consumer chain with `consumerId`
+func ConsumerUpdateRecordSeqKey(consumerId string) []byte {
+	return StringIdWithLenKey(mustGetKeyPrefix(ConsumerUpdateRecordSeqKeyName), consumerId)
+}
+
+// ValidatorOptInRecordKeyPrefix returns the key prefix for storing the most recent opt-ins and opt-outs
+// of the validator with consensus address `providerAddr`
+func ValidatorOptInRecordKeyPrefix(providerAddr ProviderConsAddress) []byte {
+	return ccvtypes.AppendMany(
+		[]byte{mustGetKeyPrefix(ValidatorOptInRecordKeyName)},
+		address.MustLengthPrefix(providerAddr.ToSdkConsAddr()),
+	)
+}
+
+// ValidatorOptInRecordKey returns the key used to store the opt-in or opt-out with sequence number `seq`
+// of the validator with consensus address `providerAddr` on the consumer chain with `consumerId`
+func ValidatorOptInRecordKey(providerAddr ProviderConsAddress, consumerId string, seq uint64) []byte {
+	return ccvtypes.AppendMany(
+		ValidatorOptInRecordKeyPrefix(providerAddr),
+		sdk.Uint64ToBigEndian(uint64(len(consumerId))),
+		[]byte(consumerId),
+		sdk.Uint64ToBigEndian(seq),
+	)
+}
+
+// ValidatorOptInRecordSeqKey returns the key used to store the sequence number of the next opt-in or opt-out
+// of the validator with consensus address `providerAddr` on the consumer chain with `consumerId`
+func ValidatorOptInRecordSeqKey(providerAddr ProviderConsAddress, consumerId string) []byte {
+	return StringIdAndConsAddrKey(mustGetKeyPrefix(ValidatorOptInRecordSeqKeyName), consumerId, providerAddr.ToSdkConsAddr())
+}
+
+// EpochInfoKey returns the key used to store the current epoch when the epochs are expressed in time
+func EpochInfoKey() []byte {
+	return []byte{mustGetKeyPrefix(EpochInfoKeyName)}
+}
+
+// ConsumerIdToClientExpiryWarnedKey returns the key used to store whether the provider warned
+// that the client of the consumer chain with `consumerId` is about to expire
+func ConsumerIdToClientExpiryWarnedKey(consumerId string) []byte {
+	return StringIdWithLenKey(mustGetKeyPrefix(ConsumerIdToClientExpiryWarnedKeyName), consumerId)
+}
+
+// ConsumerIdToProviderSwitchKeyPrefix returns the key prefix for storing the provider switch of a consumer chain
+func ConsumerIdToProviderSwitchKeyPrefix() byte {
+	return mustGetKeyPrefix(ConsumerIdToProviderSwitchKeyName)
+}
+
+// ConsumerIdToProviderSwitchKey returns the key used to store the switch of the consumer chain
+// with `consumerId` to a different provider chain
+func ConsumerIdToProviderSwitchKey(consumerId string) []byte {
+	return StringIdWithLenKey(ConsumerIdToProviderSwitchKeyPrefix(), consumerId)
+}
+
+// ConsumerIdToScheduledKeyAssignmentKeyPrefix returns the key prefix for indexing the consumer key assignments
+// scheduled by validators by consumer chain and validator
+func ConsumerIdToScheduledKeyAssignmentKeyPrefix() byte {
+	return mustGetKeyPrefix(ConsumerIdToScheduledKeyAssignmentKeyName)
+}
+
+// ConsumerIdAndValToScheduledKeyAssignmentKeyPrefix returns the key prefix for indexing the consumer key
+// assignments scheduled by the validator with `valAddr` for the consumer chain with `consumerId`
+func ConsumerIdAndValToScheduledKeyAssignmentKeyPrefix(consumerId string, valAddr sdk.ValAddress) []byte {
+	return ccvtypes.AppendMany(
+		StringIdWithLenKey(ConsumerIdToScheduledKeyAssignmentKeyPrefix(), consumerId),
+		address.MustLengthPrefix(valAddr),
+	)
+}
+
+// ConsumerIdToScheduledKeyAssignmentKey returns the key used to index the consumer key assignment of the
+// validator with `valAddr` for the consumer chain with `consumerId` that is scheduled at `activationHeight`
+func ConsumerIdToScheduledKeyAssignmentKey(consumerId string, valAddr sdk.ValAddress, activationHeight uint64) []byte {
+	return ccvtypes.AppendMany(
+		ConsumerIdAndValToScheduledKeyAssignmentKeyPrefix(consumerId, valAddr),
+		sdk.Uint64ToBigEndian(activationHeight),
+	)
+}
+
+// ParseConsumerIdToScheduledKeyAssignmentKey returns the consumer id, the validator address and
+// the activation height of a ConsumerIdToScheduledKeyAssignment key
+func ParseConsumerIdToScheduledKeyAssignmentKey(bz []byte) (string, sdk.ValAddress, uint64, error) {
+	consumerId, err := ParseStringIdWithLenKey(ConsumerIdToScheduledKeyAssignmentKeyPrefix(), bz)
+	if err != nil {
+		return "", nil, 0, err
+	}
+	valAddrLenIdx := 1 + 8 + len(consumerId)
+	if len(bz) < valAddrLenIdx+1 {
+		return "", nil, 0, fmt.Errorf("invalid scheduled key assignment key length: %d", len(bz))
+	}
+	valAddrL := int(bz[valAddrLenIdx])
+	if len(bz) != valAddrLenIdx+1+valAddrL+8 {
+		return "", nil, 0, fmt.Errorf("invalid scheduled key assignment key length: %d", len(bz))
+	}
+	valAddr := sdk.ValAddress(bz[valAddrLenIdx+1 : valAddrLenIdx+1+valAddrL])
+	activationHeight := sdk.BigEndianToUint64(bz[valAddrLenIdx+1+valAddrL:])
+	return consumerId, valAddr, activationHeight, nil
+}
+
 // NOTE: DO	NOT ADD FULLY DEFINED KEY FUNCTIONS WITHOUT ADDING THEM TO getAllFullyDefinedKeys() IN keys_test.go
 
 //
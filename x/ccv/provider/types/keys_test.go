@@ -1,6 +1,7 @@
 package types_test
 
 import (
+	"bytes"
 	"strings"
 	"testing"
 	"time"
@@ -155,6 +156,84 @@ func TestPreserveBytePrefix(t *testing.T) {
 	i++
 	require.Equal(t, byte(59), providertypes.InfractionScheduledTimeToConsumerIdsKeyPrefix())
 	i++
+	require.Equal(t, byte(60), providertypes.ConsumerIdToInitialConsensusStateKeyPrefix())
+	i++
+	require.Equal(t, byte(61), providertypes.ConsumerIdToPreviousChannelIdKey("13")[0])
+	i++
+	require.Equal(t, byte(62), providertypes.ChannelIdToVSCPacketTimeoutKey("channel-0", 1)[0])
+	i++
+	require.Equal(t, byte(63), providertypes.ChannelIdToUnackedVSCPacketKey("channel-0", 1)[0])
+	i++
+	require.Equal(t, byte(64), providertypes.ConsumerIdToChannelRecoveryTimeKey("13")[0])
+	i++
+	require.Equal(t, byte(65), providertypes.ValidatorTopNBudgetKey(providertypes.NewProviderConsAddress([]byte{0x05}))[0])
+	i++
+	require.Equal(t, byte(66), providertypes.ConsumerIdToPowerShapingPipelineKey("13")[0])
+	i++
+	require.Equal(t, byte(67), providertypes.ImmediateValidatorUpdatesKey()[0])
+	i++
+	require.Equal(t, byte(68), providertypes.DowntimeJailedValidatorKey(providertypes.NewProviderConsAddress([]byte{0x05}))[0])
+	i++
+	require.Equal(t, byte(69), providertypes.FundFlowRecordKey(1)[0])
+	i++
+	require.Equal(t, byte(70), providertypes.FundFlowRecordSeqKey()[0])
+	i++
+	require.Equal(t, byte(71), providertypes.ConsumerIdToLaunchFailureKey("13")[0])
+	i++
+	require.Equal(t, byte(72), providertypes.SpawnHeightToConsumerIdsKey(100)[0])
+	i++
+	require.Equal(t, byte(73), providertypes.ConsumerIdToHashCommitmentKey("13")[0])
+	i++
+	require.Equal(t, byte(74), providertypes.ChainIdToPreLaunchKeyAssignmentKey("chain", sdk.ValAddress([]byte{0x05}))[0])
+	i++
+	require.Equal(t, byte(75), providertypes.ConsumerCreatorAllowlistKey(sdk.AccAddress([]byte{0x05}))[0])
+	i++
+	require.Equal(t, byte(76), providertypes.ConsumerIdToRewardDenomHintKey("13")[0])
+	i++
+	require.Equal(t, byte(77), providertypes.ConsumerIdToPacketStatsKey("13")[0])
+	i++
+	require.Equal(t, byte(78), providertypes.ConsumerIdToPendingChainIdKey("13")[0])
+	i++
+	require.Equal(t, byte(79), providertypes.ConsumerIdToClientUpgradePlanKey("13")[0])
+	i++
+	require.Equal(t, byte(80), providertypes.ConsumerIdToClientExpiryKey("13")[0])
+	i++
+	require.Equal(t, byte(81), providertypes.ConsumerIdToBouncedSlashPacketKey("13")[0])
+	i++
+	require.Equal(t, byte(82), providertypes.ConsumerIdToLastVSCPacketKey("13")[0])
+	i++
+	require.Equal(t, byte(83), providertypes.ConsumerIdToThrottlingParametersKey("13")[0])
+	i++
+	require.Equal(t, byte(84), providertypes.ConsumerIdToSlashMeterKey("13")[0])
+	i++
+	require.Equal(t, byte(85), providertypes.ConsumerIdToSlashMeterReplenishTimeCandidateKey("13")[0])
+	i++
+	require.Equal(t, byte(86), providertypes.ActivationHeightToKeyAssignmentKey(100, "13", sdk.ValAddress([]byte{0x05}))[0])
+	i++
+	require.Equal(t, byte(87), providertypes.ConsumerIdToInfractionUpdateTimeKey("13")[0])
+	i++
+	require.Equal(t, byte(88), providertypes.KeyAssignmentObservationKey("13", providertypes.NewConsumerConsAddress([]byte{0x05}))[0])
+	i++
+	require.Equal(t, byte(89), providertypes.ValidatorPowerHistoryKey(providertypes.NewProviderConsAddress([]byte{0x05}))[0])
+	i++
+	require.Equal(t, byte(90), providertypes.ValidatorFeeExemptionUsageKey(sdk.ValAddress([]byte{0x05}))[0])
+	i++
+	require.Equal(t, byte(91), providertypes.ConsumerUpdateRecordKey("13", 1)[0])
+	i++
+	require.Equal(t, byte(92), providertypes.ConsumerUpdateRecordSeqKey("13")[0])
+	i++
+	require.Equal(t, byte(93), providertypes.ValidatorOptInRecordKey(providertypes.NewProviderConsAddress([]byte{0x05}), "13", 1)[0])
+	i++
+	require.Equal(t, byte(94), providertypes.ValidatorOptInRecordSeqKey(providertypes.NewProviderConsAddress([]byte{0x05}), "13")[0])
+	i++
+	require.Equal(t, byte(95), providertypes.EpochInfoKey()[0])
+	i++
+	require.Equal(t, byte(96), providertypes.ConsumerIdToClientExpiryWarnedKey("13")[0])
+	i++
+	require.Equal(t, byte(97), providertypes.ConsumerIdToProviderSwitchKey("13")[0])
+	i++
+	require.Equal(t, byte(98), providertypes.ConsumerIdToScheduledKeyAssignmentKey("13", sdk.ValAddress([]byte{0x05}), 100)[0])
+	i++
 
 	prefixes := providertypes.GetAllKeyPrefixes()
 	require.Equal(t, len(prefixes), i)
@@ -228,6 +307,45 @@ func getAllFullyDefinedKeys() [][]byte {
 		providertypes.ConsumerIdToInfractionParametersKey("13"),
 		providertypes.ConsumerIdToQueuedInfractionParametersKey("13"),
 		providertypes.InfractionScheduledTimeToConsumerIdsKey(time.Time{}),
+		providertypes.ConsumerIdToInitialConsensusStateKey("13"),
+		providertypes.ConsumerIdToPreviousChannelIdKey("13"),
+		providertypes.ChannelIdToVSCPacketTimeoutKey("channel-0", 1),
+		providertypes.ChannelIdToUnackedVSCPacketKey("channel-0", 1),
+		providertypes.ConsumerIdToChannelRecoveryTimeKey("13"),
+		providertypes.ValidatorTopNBudgetKey(providertypes.NewProviderConsAddress([]byte{0x05})),
+		providertypes.ConsumerIdToPowerShapingPipelineKey("13"),
+		providertypes.ImmediateValidatorUpdatesKey(),
+		providertypes.DowntimeJailedValidatorKey(providertypes.NewProviderConsAddress([]byte{0x05})),
+		providertypes.FundFlowRecordKey(1),
+		providertypes.FundFlowRecordSeqKey(),
+		providertypes.ConsumerIdToLaunchFailureKey("13"),
+		providertypes.SpawnHeightToConsumerIdsKey(100),
+		providertypes.ConsumerIdToHashCommitmentKey("13"),
+		providertypes.ChainIdToPreLaunchKeyAssignmentKey("chain", sdk.ValAddress([]byte{0x05})),
+		providertypes.ConsumerCreatorAllowlistKey(sdk.AccAddress([]byte{0x05})),
+		providertypes.ConsumerIdToRewardDenomHintKey("13"),
+		providertypes.ConsumerIdToPacketStatsKey("13"),
+		providertypes.ConsumerIdToPendingChainIdKey("13"),
+		providertypes.ConsumerIdToClientUpgradePlanKey("13"),
+		providertypes.ConsumerIdToClientExpiryKey("13"),
+		providertypes.ConsumerIdToBouncedSlashPacketKey("13"),
+		providertypes.ConsumerIdToLastVSCPacketKey("13"),
+		providertypes.ConsumerIdToThrottlingParametersKey("13"),
+		providertypes.ConsumerIdToSlashMeterKey("13"),
+		providertypes.ConsumerIdToSlashMeterReplenishTimeCandidateKey("13"),
+		providertypes.ActivationHeightToKeyAssignmentKey(100, "13", sdk.ValAddress([]byte{0x05})),
+		providertypes.ConsumerIdToInfractionUpdateTimeKey("13"),
+		providertypes.KeyAssignmentObservationKey("13", providertypes.NewConsumerConsAddress([]byte{0x05})),
+		providertypes.ValidatorPowerHistoryKey(providertypes.NewProviderConsAddress([]byte{0x05})),
+		providertypes.ValidatorFeeExemptionUsageKey(sdk.ValAddress([]byte{0x05})),
+		providertypes.ConsumerUpdateRecordKey("13", 1),
+		providertypes.ConsumerUpdateRecordSeqKey("13"),
+		providertypes.ValidatorOptInRecordKey(providertypes.NewProviderConsAddress([]byte{0x05}), "13", 1),
+		providertypes.ValidatorOptInRecordSeqKey(providertypes.NewProviderConsAddress([]byte{0x05}), "13"),
+		providertypes.EpochInfoKey(),
+		providertypes.ConsumerIdToClientExpiryWarnedKey("13"),
+		providertypes.ConsumerIdToProviderSwitchKey("13"),
+		providertypes.ConsumerIdToScheduledKeyAssignmentKey("13", sdk.ValAddress([]byte{0x05}), 100),
 	}
 }
 
@@ -316,6 +434,29 @@ func TestStringIdAndConsAddrAndParse(t *testing.T) {
 	}
 }
 
+// Tests the construction and parsing of ConsumerIdToScheduledKeyAssignment keys
+func TestConsumerIdToScheduledKeyAssignmentKeyAndParse(t *testing.T) {
+	tests := []struct {
+		consumerId       string
+		valAddr          sdk.ValAddress
+		activationHeight uint64
+	}{
+		{consumerId: "1", valAddr: sdk.ValAddress([]byte{0x05}), activationHeight: 1},
+		{consumerId: "23", valAddr: cryptoutil.NewCryptoIdentityFromIntSeed(1).SDKValOpAddress(), activationHeight: 100},
+		{consumerId: "456", valAddr: sdk.ValAddress(make([]byte, 32)), activationHeight: 100000},
+	}
+
+	for _, test := range tests {
+		key := providertypes.ConsumerIdToScheduledKeyAssignmentKey(test.consumerId, test.valAddr, test.activationHeight)
+		require.True(t, bytes.HasPrefix(key, providertypes.ConsumerIdAndValToScheduledKeyAssignmentKeyPrefix(test.consumerId, test.valAddr)))
+		consumerId, valAddr, activationHeight, err := providertypes.ParseConsumerIdToScheduledKeyAssignmentKey(key)
+		require.NoError(t, err)
+		require.Equal(t, test.consumerId, consumerId)
+		require.Equal(t, test.valAddr, valAddr)
+		require.Equal(t, test.activationHeight, activationHeight)
+	}
+}
+
 // Test key packing functions with the format <prefix><stringID>
 func TestKeysWithPrefixAndId(t *testing.T) {
 	funcs := []func(string) []byte{
@@ -14,6 +14,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/cometbft/cometbft/light"
 	tmtypes "github.com/cometbft/cometbft/proto/tendermint/types"
 	cmttypes "github.com/cometbft/cometbft/types"
 
@@ -31,10 +32,23 @@ const (
 	MaxHashLength = 64
 	// MaxValidatorCount defines the maximum number of validators
 	MaxValidatorCount = 1000
+	// MaxSignatureLength defines the maximum length of a signature
+	MaxSignatureLength = 128
+	// MaxReasonLength defines the maximum length of the reason for force removing a consumer chain
+	MaxReasonLength = 255
+	// MaxConsumerCreations defines the maximum number of consumer chains created by a single MsgCreateConsumers
+	MaxConsumerCreations = 20
+	// MaxTopNWeightedEpochs defines the maximum number of epochs over which the voting powers
+	// of the validators are averaged to compute the Top N validators
+	MaxTopNWeightedEpochs = 30
+	// KeyPossessionSignDomain is the domain tag that prefixes the sign bytes of a key possession proof,
+	// so that the signature cannot be replayed in a different context
+	KeyPossessionSignDomain = "interchain-security/provider/key-possession"
 )
 
 var (
 	_ sdk.Msg = (*MsgAssignConsumerKey)(nil)
+	_ sdk.Msg = (*MsgAssignConsumerKeyWithProof)(nil)
 	_ sdk.Msg = (*MsgChangeRewardDenoms)(nil)
 	_ sdk.Msg = (*MsgSubmitConsumerMisbehaviour)(nil)
 	_ sdk.Msg = (*MsgSubmitConsumerDoubleVoting)(nil)
@@ -44,8 +58,23 @@ var (
 	_ sdk.Msg = (*MsgOptIn)(nil)
 	_ sdk.Msg = (*MsgOptOut)(nil)
 	_ sdk.Msg = (*MsgSetConsumerCommissionRate)(nil)
+	_ sdk.Msg = (*MsgSetConsumerInitialConsensusState)(nil)
+	_ sdk.Msg = (*MsgSetTopNBudget)(nil)
+	_ sdk.Msg = (*MsgSendEmergencyValsetUpdate)(nil)
+	_ sdk.Msg = (*MsgRetryLaunch)(nil)
+	_ sdk.Msg = (*MsgAttestConsumerHashes)(nil)
+	_ sdk.Msg = (*MsgChangeConsumerCreatorAllowlist)(nil)
+	_ sdk.Msg = (*MsgForceRemoveConsumer)(nil)
+	_ sdk.Msg = (*MsgChangeConsumerChainId)(nil)
+	_ sdk.Msg = (*MsgPauseConsumer)(nil)
+	_ sdk.Msg = (*MsgResumeConsumer)(nil)
+	_ sdk.Msg = (*MsgRegisterConsumerClientUpgrade)(nil)
+	_ sdk.Msg = (*MsgCreateConsumers)(nil)
+	_ sdk.Msg = (*MsgEjectConsumerValidator)(nil)
+	_ sdk.Msg = (*MsgRegisterConsumerProviderSwitch)(nil)
 
 	_ sdk.HasValidateBasic = (*MsgAssignConsumerKey)(nil)
+	_ sdk.HasValidateBasic = (*MsgAssignConsumerKeyWithProof)(nil)
 	_ sdk.HasValidateBasic = (*MsgChangeRewardDenoms)(nil)
 	_ sdk.HasValidateBasic = (*MsgSubmitConsumerMisbehaviour)(nil)
 	_ sdk.HasValidateBasic = (*MsgSubmitConsumerDoubleVoting)(nil)
@@ -55,6 +84,20 @@ var (
 	_ sdk.HasValidateBasic = (*MsgOptIn)(nil)
 	_ sdk.HasValidateBasic = (*MsgOptOut)(nil)
 	_ sdk.HasValidateBasic = (*MsgSetConsumerCommissionRate)(nil)
+	_ sdk.HasValidateBasic = (*MsgSetConsumerInitialConsensusState)(nil)
+	_ sdk.HasValidateBasic = (*MsgSetTopNBudget)(nil)
+	_ sdk.HasValidateBasic = (*MsgSendEmergencyValsetUpdate)(nil)
+	_ sdk.HasValidateBasic = (*MsgRetryLaunch)(nil)
+	_ sdk.HasValidateBasic = (*MsgAttestConsumerHashes)(nil)
+	_ sdk.HasValidateBasic = (*MsgChangeConsumerCreatorAllowlist)(nil)
+	_ sdk.HasValidateBasic = (*MsgForceRemoveConsumer)(nil)
+	_ sdk.HasValidateBasic = (*MsgChangeConsumerChainId)(nil)
+	_ sdk.HasValidateBasic = (*MsgPauseConsumer)(nil)
+	_ sdk.HasValidateBasic = (*MsgResumeConsumer)(nil)
+	_ sdk.HasValidateBasic = (*MsgRegisterConsumerClientUpgrade)(nil)
+	_ sdk.HasValidateBasic = (*MsgCreateConsumers)(nil)
+	_ sdk.HasValidateBasic = (*MsgEjectConsumerValidator)(nil)
+	_ sdk.HasValidateBasic = (*MsgRegisterConsumerProviderSwitch)(nil)
 )
 
 // NewMsgAssignConsumerKey creates a new MsgAssignConsumerKey instance.
@@ -91,6 +134,51 @@ func (msg MsgAssignConsumerKey) ValidateBasic() error {
 		return errorsmod.Wrapf(ErrInvalidMsgAssignConsumerKey, "ConsumerKey: %s", err.Error())
 	}
 
+	if msg.ActivationHeight < 0 {
+		return errorsmod.Wrapf(ErrInvalidMsgAssignConsumerKey, "ActivationHeight cannot be negative: %d", msg.ActivationHeight)
+	}
+
+	return nil
+}
+
+// NewMsgAssignConsumerKeyWithProof creates a new MsgAssignConsumerKeyWithProof instance.
+func NewMsgAssignConsumerKeyWithProof(consumerId string, providerValidatorAddress sdk.ValAddress,
+	consumerConsensusPubKey, signer string, keyPossessionProof []byte,
+) *MsgAssignConsumerKeyWithProof {
+	return &MsgAssignConsumerKeyWithProof{
+		ConsumerId:         consumerId,
+		ProviderAddr:       providerValidatorAddress.String(),
+		ConsumerKey:        consumerConsensusPubKey,
+		Signer:             signer,
+		KeyPossessionProof: keyPossessionProof,
+	}
+}
+
+// ToMsgAssignConsumerKey returns the key assignment without the proof of possession of the consumer key
+func (msg MsgAssignConsumerKeyWithProof) ToMsgAssignConsumerKey() *MsgAssignConsumerKey {
+	return &MsgAssignConsumerKey{
+		ProviderAddr:     msg.ProviderAddr,
+		ConsumerKey:      msg.ConsumerKey,
+		Signer:           msg.Signer,
+		ConsumerId:       msg.ConsumerId,
+		ActivationHeight: msg.ActivationHeight,
+	}
+}
+
+// ValidateBasic implements the sdk.HasValidateBasic interface.
+func (msg MsgAssignConsumerKeyWithProof) ValidateBasic() error {
+	if err := msg.ToMsgAssignConsumerKey().ValidateBasic(); err != nil {
+		return err
+	}
+
+	if len(msg.KeyPossessionProof) == 0 {
+		return errorsmod.Wrapf(ErrInvalidMsgAssignConsumerKey, "KeyPossessionProof cannot be empty")
+	}
+	if len(msg.KeyPossessionProof) > MaxSignatureLength {
+		return errorsmod.Wrapf(ErrInvalidMsgAssignConsumerKey, "KeyPossessionProof is too long; got: %d, max: %d",
+			len(msg.KeyPossessionProof), MaxSignatureLength)
+	}
+
 	return nil
 }
 
@@ -334,6 +422,9 @@ func (msg MsgCreateConsumer) ValidateBasic() error {
 		if err := ValidateInitializationParameters(*msg.InitializationParameters); err != nil {
 			return errorsmod.Wrapf(ErrInvalidMsgCreateConsumer, "InitializationParameters: %s", err.Error())
 		}
+		if err := ValidateInitialHeight(msg.InitializationParameters.InitialHeight, msg.ChainId); err != nil {
+			return errorsmod.Wrapf(ErrInvalidMsgCreateConsumer, "InitialHeight: %s", err.Error())
+		}
 	}
 
 	if msg.PowerShapingParameters != nil {
@@ -352,15 +443,76 @@ func (msg MsgCreateConsumer) ValidateBasic() error {
 		}
 	}
 
+	if msg.ThrottlingParameters != nil {
+		if err := ValidateThrottlingParameters(*msg.ThrottlingParameters); err != nil {
+			return errorsmod.Wrapf(ErrInvalidMsgCreateConsumer, "ThrottlingParameters: %s", err.Error())
+		}
+	}
+
 	if msg.AllowlistedRewardDenoms != nil {
 		if err := ValidateAllowlistedRewardDenoms(*msg.AllowlistedRewardDenoms); err != nil {
 			return errorsmod.Wrapf(ErrInvalidMsgCreateConsumer, "AllowlistedRewardDenoms: %s", err.Error())
 		}
 	}
 
+	if err := ValidateSignedKeyAssignments(msg.KeyAssignments); err != nil {
+		return errorsmod.Wrapf(ErrInvalidMsgCreateConsumer, "KeyAssignments: %s", err.Error())
+	}
+
+	if msg.RewardDenomHint != "" {
+		if err := ValidateRewardDenomHint(msg.RewardDenomHint); err != nil {
+			return errorsmod.Wrapf(ErrInvalidMsgCreateConsumer, "RewardDenomHint: %s", err.Error())
+		}
+	}
+
+	return nil
+}
+
+// NewMsgCreateConsumers creates a new MsgCreateConsumers instance
+func NewMsgCreateConsumers(submitter string, consumers []ConsumerCreation) *MsgCreateConsumers {
+	return &MsgCreateConsumers{
+		Submitter: submitter,
+		Consumers: consumers,
+	}
+}
+
+// ValidateBasic implements the sdk.HasValidateBasic interface.
+func (msg MsgCreateConsumers) ValidateBasic() error {
+	if len(msg.Consumers) == 0 {
+		return errorsmod.Wrap(ErrInvalidMsgCreateConsumers, "Consumers cannot be empty")
+	}
+
+	if len(msg.Consumers) > MaxConsumerCreations {
+		return errorsmod.Wrapf(ErrInvalidMsgCreateConsumers, "too many consumers; got: %d, max: %d",
+			len(msg.Consumers), MaxConsumerCreations)
+	}
+
+	for i, consumer := range msg.Consumers {
+		if err := consumer.ToMsgCreateConsumer(msg.Submitter).ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(ErrInvalidMsgCreateConsumers, "Consumers[%d]: %s", i, err.Error())
+		}
+	}
+
 	return nil
 }
 
+// ToMsgCreateConsumer returns the MsgCreateConsumer that creates the consumer chain
+// on behalf of `submitter`
+func (c ConsumerCreation) ToMsgCreateConsumer(submitter string) *MsgCreateConsumer {
+	return &MsgCreateConsumer{
+		Submitter:                submitter,
+		ChainId:                  c.ChainId,
+		Metadata:                 c.Metadata,
+		InitializationParameters: c.InitializationParameters,
+		PowerShapingParameters:   c.PowerShapingParameters,
+		AllowlistedRewardDenoms:  c.AllowlistedRewardDenoms,
+		InfractionParameters:     c.InfractionParameters,
+		KeyAssignments:           c.KeyAssignments,
+		RewardDenomHint:          c.RewardDenomHint,
+		ThrottlingParameters:     c.ThrottlingParameters,
+	}
+}
+
 // NewMsgUpdateConsumer creates a new MsgUpdateConsumer instance
 func NewMsgUpdateConsumer(owner, consumerId, ownerAddress string, metadata *ConsumerMetadata,
 	initializationParameters *ConsumerInitializationParameters, powerShapingParameters *PowerShapingParameters,
@@ -397,6 +549,12 @@ func (msg MsgUpdateConsumer) ValidateBasic() error {
 		if err := ValidateInitializationParameters(*msg.InitializationParameters); err != nil {
 			return errorsmod.Wrapf(ErrInvalidMsgUpdateConsumer, "InitializationParameters: %s", err.Error())
 		}
+		// without a new chain id, the initial height is validated against the current chain id when handling the message
+		if strings.TrimSpace(msg.NewChainId) != "" {
+			if err := ValidateInitialHeight(msg.InitializationParameters.InitialHeight, msg.NewChainId); err != nil {
+				return errorsmod.Wrapf(ErrInvalidMsgUpdateConsumer, "InitialHeight: %s", err.Error())
+			}
+		}
 	}
 
 	if msg.PowerShapingParameters != nil {
@@ -411,6 +569,12 @@ func (msg MsgUpdateConsumer) ValidateBasic() error {
 		}
 	}
 
+	if msg.ThrottlingParameters != nil {
+		if err := ValidateThrottlingParameters(*msg.ThrottlingParameters); err != nil {
+			return errorsmod.Wrapf(ErrInvalidMsgUpdateConsumer, "ThrottlingParameters: %s", err.Error())
+		}
+	}
+
 	if msg.AllowlistedRewardDenoms != nil {
 		if err := ValidateAllowlistedRewardDenoms(*msg.AllowlistedRewardDenoms); err != nil {
 			return errorsmod.Wrapf(ErrInvalidMsgUpdateConsumer, "AllowlistedRewardDenoms: %s", err.Error())
@@ -436,6 +600,254 @@ func (msg MsgRemoveConsumer) ValidateBasic() error {
 	return nil
 }
 
+// NewMsgSetConsumerInitialConsensusState creates a new MsgSetConsumerInitialConsensusState instance
+func NewMsgSetConsumerInitialConsensusState(owner, consumerId string, genesisHash []byte,
+	consensusState ibctmtypes.ConsensusState,
+) (*MsgSetConsumerInitialConsensusState, error) {
+	return &MsgSetConsumerInitialConsensusState{
+		Owner:      owner,
+		ConsumerId: consumerId,
+		InitialConsensusState: ConsumerInitialConsensusState{
+			GenesisHash:    genesisHash,
+			ConsensusState: consensusState,
+		},
+	}, nil
+}
+
+// ValidateBasic implements the sdk.HasValidateBasic interface.
+func (msg MsgSetConsumerInitialConsensusState) ValidateBasic() error {
+	if err := ccvtypes.ValidateConsumerId(msg.ConsumerId); err != nil {
+		return errorsmod.Wrapf(ErrInvalidMsgSetConsumerInitialConsensusState, "ConsumerId: %s", err.Error())
+	}
+
+	if err := ValidateInitialConsensusState(msg.InitialConsensusState); err != nil {
+		return errorsmod.Wrapf(ErrInvalidMsgSetConsumerInitialConsensusState, "InitialConsensusState: %s", err.Error())
+	}
+
+	return nil
+}
+
+// NewMsgSetTopNBudget creates a new MsgSetTopNBudget msg instance.
+func NewMsgSetTopNBudget(
+	maxTopNConsumers uint32,
+	providerValidatorAddress sdk.ValAddress,
+	signer string,
+) *MsgSetTopNBudget {
+	return &MsgSetTopNBudget{
+		ProviderAddr:     providerValidatorAddress.String(),
+		MaxTopNConsumers: maxTopNConsumers,
+		Signer:           signer,
+	}
+}
+
+// ValidateBasic implements the sdk.HasValidateBasic interface.
+func (msg MsgSetTopNBudget) ValidateBasic() error {
+	if err := validateProviderAddress(msg.ProviderAddr, msg.Signer); err != nil {
+		return errorsmod.Wrapf(ErrInvalidMsgSetTopNBudget, "ProviderAddr: %s", err.Error())
+	}
+
+	return nil
+}
+
+// NewMsgSendEmergencyValsetUpdate creates a new MsgSendEmergencyValsetUpdate msg instance.
+func NewMsgSendEmergencyValsetUpdate(consumerId, authority string) *MsgSendEmergencyValsetUpdate {
+	return &MsgSendEmergencyValsetUpdate{
+		ConsumerId: consumerId,
+		Authority:  authority,
+	}
+}
+
+// ValidateBasic implements the sdk.HasValidateBasic interface.
+func (msg MsgSendEmergencyValsetUpdate) ValidateBasic() error {
+	if err := ccvtypes.ValidateConsumerId(msg.ConsumerId); err != nil {
+		return errorsmod.Wrapf(ErrInvalidMsgSendEmergencyValsetUpdate, "ConsumerId: %s", err.Error())
+	}
+
+	return nil
+}
+
+// NewMsgRetryLaunch creates a new MsgRetryLaunch instance
+func NewMsgRetryLaunch(owner, consumerId string) *MsgRetryLaunch {
+	return &MsgRetryLaunch{
+		Owner:      owner,
+		ConsumerId: consumerId,
+	}
+}
+
+// ValidateBasic implements the sdk.HasValidateBasic interface.
+func (msg MsgRetryLaunch) ValidateBasic() error {
+	if err := ccvtypes.ValidateConsumerId(msg.ConsumerId); err != nil {
+		return errorsmod.Wrapf(ErrInvalidMsgRetryLaunch, "ConsumerId: %s", err.Error())
+	}
+
+	return nil
+}
+
+// NewMsgAttestConsumerHashes creates a new MsgAttestConsumerHashes instance
+func NewMsgAttestConsumerHashes(owner, consumerId string, genesisHash, binaryHash []byte) *MsgAttestConsumerHashes {
+	return &MsgAttestConsumerHashes{
+		Owner:       owner,
+		ConsumerId:  consumerId,
+		GenesisHash: genesisHash,
+		BinaryHash:  binaryHash,
+	}
+}
+
+// ValidateBasic implements the sdk.HasValidateBasic interface.
+func (msg MsgAttestConsumerHashes) ValidateBasic() error {
+	if err := ccvtypes.ValidateConsumerId(msg.ConsumerId); err != nil {
+		return errorsmod.Wrapf(ErrInvalidMsgAttestConsumerHashes, "ConsumerId: %s", err.Error())
+	}
+
+	if err := ValidateByteSlice(msg.GenesisHash, MaxHashLength); err != nil {
+		return errorsmod.Wrapf(ErrInvalidMsgAttestConsumerHashes, "GenesisHash: %s", err.Error())
+	}
+
+	if err := ValidateByteSlice(msg.BinaryHash, MaxHashLength); err != nil {
+		return errorsmod.Wrapf(ErrInvalidMsgAttestConsumerHashes, "BinaryHash: %s", err.Error())
+	}
+
+	return nil
+}
+
+// ValidateBasic implements the sdk.HasValidateBasic interface.
+func (msg MsgChangeConsumerCreatorAllowlist) ValidateBasic() error {
+	// Return error if both sets are empty or nil
+	if len(msg.AddressesToAdd) == 0 && len(msg.AddressesToRemove) == 0 {
+		return errorsmod.Wrapf(ErrInvalidMsgChangeConsumerCreatorAllowlist, "both AddressesToAdd and AddressesToRemove are empty")
+	}
+
+	addressMap := map[string]struct{}{}
+	for _, address := range msg.AddressesToAdd {
+		if _, err := sdk.AccAddressFromBech32(address); err != nil {
+			return errorsmod.Wrapf(ErrInvalidMsgChangeConsumerCreatorAllowlist, "AddressesToAdd: invalid address(%s)", address)
+		}
+		addressMap[address] = struct{}{}
+	}
+	for _, address := range msg.AddressesToRemove {
+		if _, err := sdk.AccAddressFromBech32(address); err != nil {
+			return errorsmod.Wrapf(ErrInvalidMsgChangeConsumerCreatorAllowlist, "AddressesToRemove: invalid address(%s)", address)
+		}
+		// address cannot be in both sets
+		if _, found := addressMap[address]; found {
+			return errorsmod.Wrapf(ErrInvalidMsgChangeConsumerCreatorAllowlist,
+				"address(%s) cannot be both added and removed", address)
+		}
+	}
+
+	return nil
+}
+
+// ValidateBasic implements the sdk.HasValidateBasic interface.
+func (msg MsgForceRemoveConsumer) ValidateBasic() error {
+	if err := ccvtypes.ValidateConsumerId(msg.ConsumerId); err != nil {
+		return errorsmod.Wrapf(ErrInvalidMsgForceRemoveConsumer, "ConsumerId: %s", err.Error())
+	}
+
+	if err := ValidateStringField("Reason", msg.Reason, MaxReasonLength); err != nil {
+		return errorsmod.Wrapf(ErrInvalidMsgForceRemoveConsumer, "Reason: %s", err.Error())
+	}
+
+	return nil
+}
+
+// NewMsgChangeConsumerChainId creates a new MsgChangeConsumerChainId instance
+func NewMsgChangeConsumerChainId(owner, consumerId, newChainId string) *MsgChangeConsumerChainId {
+	return &MsgChangeConsumerChainId{
+		Owner:      owner,
+		ConsumerId: consumerId,
+		NewChainId: newChainId,
+	}
+}
+
+// ValidateBasic implements the sdk.HasValidateBasic interface.
+func (msg MsgChangeConsumerChainId) ValidateBasic() error {
+	if err := ccvtypes.ValidateConsumerId(msg.ConsumerId); err != nil {
+		return errorsmod.Wrapf(ErrInvalidMsgChangeConsumerChainId, "ConsumerId: %s", err.Error())
+	}
+
+	if err := ValidateChainId("NewChainId", msg.NewChainId); err != nil {
+		return errorsmod.Wrapf(ErrInvalidMsgChangeConsumerChainId, "NewChainId: %s", err.Error())
+	}
+
+	return nil
+}
+
+// ValidateBasic implements the sdk.HasValidateBasic interface.
+func (msg MsgPauseConsumer) ValidateBasic() error {
+	if err := ccvtypes.ValidateConsumerId(msg.ConsumerId); err != nil {
+		return errorsmod.Wrapf(ErrInvalidMsgPauseConsumer, "ConsumerId: %s", err.Error())
+	}
+
+	return nil
+}
+
+// ValidateBasic implements the sdk.HasValidateBasic interface.
+func (msg MsgResumeConsumer) ValidateBasic() error {
+	if err := ccvtypes.ValidateConsumerId(msg.ConsumerId); err != nil {
+		return errorsmod.Wrapf(ErrInvalidMsgResumeConsumer, "ConsumerId: %s", err.Error())
+	}
+
+	return nil
+}
+
+// ValidateBasic implements the sdk.HasValidateBasic interface.
+func (msg MsgEjectConsumerValidator) ValidateBasic() error {
+	if err := ccvtypes.ValidateConsumerId(msg.ConsumerId); err != nil {
+		return errorsmod.Wrapf(ErrInvalidMsgEjectConsumerValidator, "ConsumerId: %s", err.Error())
+	}
+
+	if _, err := sdk.ConsAddressFromBech32(msg.ProviderConsAddr); err != nil {
+		return errorsmod.Wrapf(ErrInvalidMsgEjectConsumerValidator, "ProviderConsAddr: invalid consensus address (%s)", msg.ProviderConsAddr)
+	}
+
+	return nil
+}
+
+// NewMsgRegisterConsumerClientUpgrade creates a new MsgRegisterConsumerClientUpgrade instance
+func NewMsgRegisterConsumerClientUpgrade(owner, consumerId string, plan ConsumerClientUpgradePlan) *MsgRegisterConsumerClientUpgrade {
+	return &MsgRegisterConsumerClientUpgrade{
+		Owner:      owner,
+		ConsumerId: consumerId,
+		Plan:       plan,
+	}
+}
+
+// ValidateBasic implements the sdk.HasValidateBasic interface.
+func (msg MsgRegisterConsumerClientUpgrade) ValidateBasic() error {
+	if err := ccvtypes.ValidateConsumerId(msg.ConsumerId); err != nil {
+		return errorsmod.Wrapf(ErrInvalidMsgRegisterConsumerClientUpgrade, "ConsumerId: %s", err.Error())
+	}
+
+	if err := ValidateConsumerClientUpgradePlan(msg.Plan); err != nil {
+		return errorsmod.Wrapf(ErrInvalidMsgRegisterConsumerClientUpgrade, "Plan: %s", err.Error())
+	}
+
+	return nil
+}
+
+// NewMsgRegisterConsumerProviderSwitch creates a new MsgRegisterConsumerProviderSwitch instance
+func NewMsgRegisterConsumerProviderSwitch(owner, consumerId string, providerSwitch ConsumerProviderSwitch) *MsgRegisterConsumerProviderSwitch {
+	return &MsgRegisterConsumerProviderSwitch{
+		Owner:      owner,
+		ConsumerId: consumerId,
+		Switch:     providerSwitch,
+	}
+}
+
+// ValidateBasic implements the sdk.HasValidateBasic interface.
+func (msg MsgRegisterConsumerProviderSwitch) ValidateBasic() error {
+	if err := ccvtypes.ValidateConsumerId(msg.ConsumerId); err != nil {
+		return errorsmod.Wrapf(ErrInvalidMsgRegisterConsumerProviderSwitch, "ConsumerId: %s", err.Error())
+	}
+
+	if err := ValidateConsumerProviderSwitch(msg.Switch); err != nil {
+		return errorsmod.Wrapf(ErrInvalidMsgRegisterConsumerProviderSwitch, "Switch: %s", err.Error())
+	}
+
+	return nil
+}
+
 //
 // Validation methods
 //
@@ -540,6 +952,29 @@ func ValidateConsAddressList(list []string, maxLength int) error {
 	return nil
 }
 
+// ValidatePrioritylistWeights validates that the weights are positive and given at most once
+// for validators in the prioritylist
+func ValidatePrioritylistWeights(weights []PrioritylistWeight, prioritylist []string) error {
+	prioritylisted := map[string]bool{}
+	for _, address := range prioritylist {
+		prioritylisted[address] = true
+	}
+	weighted := map[string]bool{}
+	for _, weight := range weights {
+		if !prioritylisted[weight.ProviderAddress] {
+			return fmt.Errorf("address %s is not in the prioritylist", weight.ProviderAddress)
+		}
+		if weighted[weight.ProviderAddress] {
+			return fmt.Errorf("duplicate weight for address %s", weight.ProviderAddress)
+		}
+		weighted[weight.ProviderAddress] = true
+		if weight.Weight == 0 {
+			return fmt.Errorf("weight of address %s has to be positive", weight.ProviderAddress)
+		}
+	}
+	return nil
+}
+
 // ValidatePowerShapingParameters validates that all the provided power-shaping parameters are in the expected range
 func ValidatePowerShapingParameters(powerShapingParameters PowerShapingParameters) error {
 	// Top N corresponds to the top N% of validators that have to validate the consumer chain and can only be 0 (for an
@@ -552,6 +987,10 @@ func ValidatePowerShapingParameters(powerShapingParameters PowerShapingParameter
 		return errorsmod.Wrap(ErrInvalidPowerShapingParameters, "ValidatorsPowerCap has to be in the range [0, 100]")
 	}
 
+	if powerShapingParameters.Top_NWeightedEpochs > MaxTopNWeightedEpochs {
+		return errorsmod.Wrapf(ErrInvalidPowerShapingParameters, "Top_NWeightedEpochs has to be in the range [0, %d]", MaxTopNWeightedEpochs)
+	}
+
 	if err := ValidateConsAddressList(powerShapingParameters.Allowlist, MaxValidatorCount); err != nil {
 		return errorsmod.Wrapf(ErrInvalidPowerShapingParameters, "Allowlist: %s", err.Error())
 	}
@@ -561,6 +1000,9 @@ func ValidatePowerShapingParameters(powerShapingParameters PowerShapingParameter
 	if err := ValidateConsAddressList(powerShapingParameters.Prioritylist, MaxValidatorCount); err != nil {
 		return errorsmod.Wrapf(ErrInvalidPowerShapingParameters, "Prioritylist: %s", err.Error())
 	}
+	if err := ValidatePrioritylistWeights(powerShapingParameters.PrioritylistWeights, powerShapingParameters.Prioritylist); err != nil {
+		return errorsmod.Wrapf(ErrInvalidPowerShapingParameters, "PrioritylistWeights: %s", err.Error())
+	}
 
 	return nil
 }
@@ -579,6 +1021,18 @@ func ValidateAllowlistedRewardDenoms(allowlistedRewardDenoms AllowlistedRewardDe
 	return nil
 }
 
+// ValidateRewardDenomHint validates that the reward denom hint is the base denom of a token native to the consumer chain
+func ValidateRewardDenomHint(denom string) error {
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return errorsmod.Wrapf(ErrInvalidAllowlistedRewardDenoms, "invalid denom (%s): %s", denom, err.Error())
+	}
+	if strings.Contains(denom, "/") {
+		return errorsmod.Wrapf(ErrInvalidAllowlistedRewardDenoms,
+			"denom (%s) must be a base denom native to the consumer chain", denom)
+	}
+	return nil
+}
+
 // ValidateInitializationParameters validates that all the provided parameters are in the expected range
 func ValidateInitializationParameters(initializationParameters ConsumerInitializationParameters) error {
 	if initializationParameters.InitialHeight.IsZero() {
@@ -625,6 +1079,16 @@ func ValidateInitializationParameters(initializationParameters ConsumerInitializ
 		return errorsmod.Wrapf(ErrInvalidConsumerInitializationParameters, "ConnectionId: %s", err.Error())
 	}
 
+	if initializationParameters.TrustLevel != nil {
+		if err := light.ValidateTrustLevel(initializationParameters.TrustLevel.ToTendermint()); err != nil {
+			return errorsmod.Wrapf(ErrInvalidConsumerInitializationParameters, "TrustLevel: %s", err.Error())
+		}
+	}
+
+	if !initializationParameters.SpawnTime.IsZero() && initializationParameters.SpawnHeight != 0 {
+		return errorsmod.Wrap(ErrInvalidConsumerInitializationParameters, "SpawnTime and SpawnHeight cannot both be set")
+	}
+
 	return nil
 }
 
@@ -651,6 +1115,137 @@ func ValidateInfractionParameters(initializationParameters InfractionParameters)
 	return nil
 }
 
+// ValidateThrottlingParameters validates that the slash meter replenish fraction is either empty,
+// i.e., the consumer chain shares the global slash meter, or a non-zero fraction, and that the
+// slash meter replenish period is not negative
+func ValidateThrottlingParameters(throttlingParameters ThrottlingParameters) error {
+	if throttlingParameters.SlashMeterReplenishFraction != "" {
+		if err := ccvtypes.ValidateStringFractionNonZero(throttlingParameters.SlashMeterReplenishFraction); err != nil {
+			return errorsmod.Wrapf(ErrInvalidConsumerThrottlingParameters, "SlashMeterReplenishFraction: %s", err.Error())
+		}
+	}
+	if throttlingParameters.SlashMeterReplenishPeriod < 0 {
+		return errorsmod.Wrap(ErrInvalidConsumerThrottlingParameters, "SlashMeterReplenishPeriod cannot be negative")
+	}
+
+	return nil
+}
+
+// ValidateInitialConsensusState validates an owner-provided initial consensus state
+func ValidateInitialConsensusState(initialConsensusState ConsumerInitialConsensusState) error {
+	if len(initialConsensusState.GenesisHash) == 0 {
+		return errorsmod.Wrap(ErrInvalidConsumerInitialConsensusState, "GenesisHash cannot be empty")
+	}
+
+	if err := ValidateByteSlice(initialConsensusState.GenesisHash, MaxHashLength); err != nil {
+		return errorsmod.Wrapf(ErrInvalidConsumerInitialConsensusState, "GenesisHash: %s", err.Error())
+	}
+
+	if err := initialConsensusState.ConsensusState.ValidateBasic(); err != nil {
+		return errorsmod.Wrapf(ErrInvalidConsumerInitialConsensusState, "ConsensusState: %s", err.Error())
+	}
+
+	return nil
+}
+
+// ValidateConsumerProviderSwitch validates an owner-provided switch of a consumer chain to a different provider chain
+func ValidateConsumerProviderSwitch(providerSwitch ConsumerProviderSwitch) error {
+	if providerSwitch.SwitchHeight.IsZero() {
+		return errorsmod.Wrap(ErrInvalidConsumerProviderSwitch, "SwitchHeight cannot be zero")
+	}
+
+	if err := ValidateStringField("NewProviderChainId", providerSwitch.NewProviderChainId, cmttypes.MaxChainIDLen); err != nil {
+		return errorsmod.Wrapf(ErrInvalidConsumerProviderSwitch, "NewProviderChainId: %s", err.Error())
+	}
+
+	return nil
+}
+
+// ValidateConsumerClientUpgradePlan validates an owner-provided consumer client upgrade plan
+func ValidateConsumerClientUpgradePlan(plan ConsumerClientUpgradePlan) error {
+	if plan.UpgradeHeight.IsZero() {
+		return errorsmod.Wrap(ErrInvalidConsumerClientUpgradePlan, "UpgradeHeight cannot be zero")
+	}
+
+	if err := ValidateChainId("NewChainId", plan.NewChainId); err != nil {
+		return errorsmod.Wrapf(ErrInvalidConsumerClientUpgradePlan, "NewChainId: %s", err.Error())
+	}
+
+	if plan.UnbondingPeriod < 0 {
+		return errorsmod.Wrap(ErrInvalidConsumerClientUpgradePlan, "UnbondingPeriod cannot be negative")
+	}
+
+	return nil
+}
+
+// ValidateSignedKeyAssignments validates the validator-signed key assignments provided in `MsgCreateConsumer`
+func ValidateSignedKeyAssignments(keyAssignments []SignedKeyAssignment) error {
+	if len(keyAssignments) > MaxValidatorCount {
+		return fmt.Errorf("too many key assignments; got: %d, max: %d", len(keyAssignments), MaxValidatorCount)
+	}
+
+	seen := map[string]struct{}{}
+	for _, keyAssignment := range keyAssignments {
+		if _, err := sdk.ValAddressFromBech32(keyAssignment.ProviderAddr); err != nil {
+			return fmt.Errorf("invalid ValAddress (%s)", keyAssignment.ProviderAddr)
+		}
+		if _, found := seen[keyAssignment.ProviderAddr]; found {
+			return fmt.Errorf("duplicate key assignment for validator (%s)", keyAssignment.ProviderAddr)
+		}
+		seen[keyAssignment.ProviderAddr] = struct{}{}
+
+		if keyAssignment.ConsumerKey == "" {
+			return fmt.Errorf("empty consumer key for validator (%s)", keyAssignment.ProviderAddr)
+		}
+		if _, _, err := ParseConsumerKeyFromJson(keyAssignment.ConsumerKey); err != nil {
+			return fmt.Errorf("invalid consumer key for validator (%s): %s", keyAssignment.ProviderAddr, err.Error())
+		}
+
+		if len(keyAssignment.Signature) == 0 {
+			return fmt.Errorf("empty signature for validator (%s)", keyAssignment.ProviderAddr)
+		}
+		if len(keyAssignment.Signature) > MaxSignatureLength {
+			return fmt.Errorf("signature is too long for validator (%s); got: %d, max: %d",
+				keyAssignment.ProviderAddr, len(keyAssignment.Signature), MaxSignatureLength)
+		}
+	}
+
+	return nil
+}
+
+// KeyAssignmentSignBytes returns the bytes a validator signs to agree to use `consumerKey`
+// on the consumer chain with `chainId` created by `owner`
+func KeyAssignmentSignBytes(chainId, owner, providerAddr, consumerKey string) ([]byte, error) {
+	signDoc := KeyAssignmentSignDoc{
+		ChainId:      chainId,
+		Owner:        owner,
+		ProviderAddr: providerAddr,
+		ConsumerKey:  consumerKey,
+	}
+	return signDoc.Marshal()
+}
+
+// KeyPossessionSignBytes returns the bytes a consumer key signs to prove that it is controlled by
+// the validator with operator address `providerAddr` assigning it on the consumer chain with `consumerId`
+// of the provider chain with `providerChainId`, i.e.,
+// `KeyPossessionSignDomain | len(providerChainId) | providerChainId | marshaled KeyPossessionSignDoc`
+func KeyPossessionSignBytes(providerChainId, consumerId, providerAddr string) ([]byte, error) {
+	signDoc := KeyPossessionSignDoc{
+		ConsumerId:   consumerId,
+		ProviderAddr: providerAddr,
+	}
+	bz, err := signDoc.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	return ccvtypes.AppendMany(
+		[]byte(KeyPossessionSignDomain),
+		sdk.Uint64ToBigEndian(uint64(len(providerChainId))),
+		[]byte(providerChainId),
+		bz,
+	), nil
+}
+
 func ValidateByteSlice(hash []byte, maxLength int) error {
 	if len(hash) > maxLength {
 		return fmt.Errorf("hash is too long; got: %d, max: %d", len(hash), maxLength)
@@ -682,10 +1277,19 @@ func validateProviderAddress(addr, signer string) error {
 	return nil
 }
 
+// ValidateInitialHeight validates that the revision number of the initial height of a consumer chain
+// matches the revision number of its chain id, i.e., the `{revision}` suffix of a chain id in the
+// `{chainID}-{revision}` format, or zero otherwise. Otherwise, the client to the consumer chain cannot be created.
 func ValidateInitialHeight(initialHeight clienttypes.Height, chainID string) error {
 	revision := clienttypes.ParseChainID(chainID)
 	if initialHeight.RevisionNumber != revision {
-		return fmt.Errorf("chain ID (%s) doesn't match revision number (%d)", chainID, initialHeight.RevisionNumber)
+		return fmt.Errorf("chain ID (%s) doesn't match revision number (%d): expected initial height %s",
+			chainID, initialHeight.RevisionNumber, ExpectedInitialHeight(initialHeight, chainID))
 	}
 	return nil
 }
+
+// ExpectedInitialHeight returns the initial height `initialHeight` with the revision number of the chain id `chainID`
+func ExpectedInitialHeight(initialHeight clienttypes.Height, chainID string) clienttypes.Height {
+	return clienttypes.NewHeight(clienttypes.ParseChainID(chainID), initialHeight.RevisionHeight)
+}
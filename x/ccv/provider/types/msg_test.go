@@ -6,12 +6,16 @@ import (
 	"time"
 
 	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v10/modules/core/23-commitment/types"
+	ibctmtypes "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
 	"github.com/stretchr/testify/require"
 
 	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/cometbft/cometbft/crypto/tmhash"
+
 	cryptoutil "github.com/cosmos/interchain-security/v7/testutil/crypto"
 	"github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
 )
@@ -353,6 +357,43 @@ func TestValidateInitializationParameters(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			name: "valid - spawn height",
+			params: types.ConsumerInitializationParameters{
+				InitialHeight:                     clienttypes.NewHeight(3, 4),
+				GenesisHash:                       []byte{0x01},
+				BinaryHash:                        []byte{0x01},
+				SpawnHeight:                       100,
+				UnbondingPeriod:                   time.Duration(100000000000),
+				CcvTimeoutPeriod:                  time.Duration(100000000000),
+				TransferTimeoutPeriod:             time.Duration(100000000000),
+				ConsumerRedistributionFraction:    "0.75",
+				BlocksPerDistributionTransmission: 10,
+				HistoricalEntries:                 10000,
+				DistributionTransmissionChannel:   "",
+				ConnectionId:                      "",
+			},
+			valid: true,
+		},
+		{
+			name: "invalid - both spawn time and spawn height",
+			params: types.ConsumerInitializationParameters{
+				InitialHeight:                     clienttypes.NewHeight(3, 4),
+				GenesisHash:                       []byte{0x01},
+				BinaryHash:                        []byte{0x01},
+				SpawnTime:                         now,
+				SpawnHeight:                       100,
+				UnbondingPeriod:                   time.Duration(100000000000),
+				CcvTimeoutPeriod:                  time.Duration(100000000000),
+				TransferTimeoutPeriod:             time.Duration(100000000000),
+				ConsumerRedistributionFraction:    "0.75",
+				BlocksPerDistributionTransmission: 10,
+				HistoricalEntries:                 10000,
+				DistributionTransmissionChannel:   "",
+				ConnectionId:                      "",
+			},
+			valid: false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -365,6 +406,76 @@ func TestValidateInitializationParameters(t *testing.T) {
 	}
 }
 
+func TestValidateInitializationParametersTrustLevel(t *testing.T) {
+	validParams := types.ConsumerInitializationParameters{
+		InitialHeight:                     clienttypes.NewHeight(3, 4),
+		GenesisHash:                       []byte{0x01},
+		BinaryHash:                        []byte{0x01},
+		SpawnTime:                         time.Now().UTC(),
+		UnbondingPeriod:                   time.Duration(100000000000),
+		CcvTimeoutPeriod:                  time.Duration(100000000000),
+		TransferTimeoutPeriod:             time.Duration(100000000000),
+		ConsumerRedistributionFraction:    "0.75",
+		BlocksPerDistributionTransmission: 10,
+		HistoricalEntries:                 10000,
+		DistributionTransmissionChannel:   "",
+		ConnectionId:                      "",
+	}
+
+	testCases := []struct {
+		name       string
+		trustLevel *ibctmtypes.Fraction
+		valid      bool
+	}{
+		{
+			name:       "valid - TrustLevel not set",
+			trustLevel: nil,
+			valid:      true,
+		},
+		{
+			name:       "valid - TrustLevel 1/3",
+			trustLevel: &ibctmtypes.Fraction{Numerator: 1, Denominator: 3},
+			valid:      true,
+		},
+		{
+			name:       "valid - TrustLevel 2/3",
+			trustLevel: &ibctmtypes.Fraction{Numerator: 2, Denominator: 3},
+			valid:      true,
+		},
+		{
+			name:       "valid - TrustLevel 1/1",
+			trustLevel: &ibctmtypes.Fraction{Numerator: 1, Denominator: 1},
+			valid:      true,
+		},
+		{
+			name:       "invalid - TrustLevel below 1/3",
+			trustLevel: &ibctmtypes.Fraction{Numerator: 1, Denominator: 4},
+			valid:      false,
+		},
+		{
+			name:       "invalid - TrustLevel above 1",
+			trustLevel: &ibctmtypes.Fraction{Numerator: 4, Denominator: 3},
+			valid:      false,
+		},
+		{
+			name:       "invalid - TrustLevel zero denominator",
+			trustLevel: &ibctmtypes.Fraction{Numerator: 1, Denominator: 0},
+			valid:      false,
+		},
+	}
+
+	for _, tc := range testCases {
+		params := validParams
+		params.TrustLevel = tc.trustLevel
+		err := types.ValidateInitializationParameters(params)
+		if tc.valid {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
 func TestValidateConsAddressList(t *testing.T) {
 	consAddr1 := "cosmosvalcons1qmq08eruchr5sf5s3rwz7djpr5a25f7xw4mceq"
 	consAddr2 := "cosmosvalcons1nx7n5uh0ztxsynn4sje6eyq2ud6rc6klc96w39"
@@ -571,6 +682,142 @@ func TestMsgCreateConsumerValidateBasic(t *testing.T) {
 	}
 }
 
+func TestValidateSignedKeyAssignments(t *testing.T) {
+	valOpAddr1 := cryptoutil.NewCryptoIdentityFromIntSeed(35443543534).SDKValOpAddress().String()
+	valOpAddr2 := cryptoutil.NewCryptoIdentityFromIntSeed(65465464564).SDKValOpAddress().String()
+	consumerKey := "{\"@type\": \"/cosmos.crypto.ed25519.PubKey\", \"key\": \"e3BehnEIlGUAnJYn9V8gBXuMh4tXO8xxlxyXD1APGyk=\"}"
+	signature := []byte("signature")
+
+	testCases := []struct {
+		name           string
+		keyAssignments []types.SignedKeyAssignment
+		expPass        bool
+	}{
+		{
+			"no key assignments",
+			nil,
+			true,
+		},
+		{
+			"valid key assignments",
+			[]types.SignedKeyAssignment{
+				{ProviderAddr: valOpAddr1, ConsumerKey: consumerKey, Signature: signature},
+				{ProviderAddr: valOpAddr2, ConsumerKey: consumerKey, Signature: signature},
+			},
+			true,
+		},
+		{
+			"invalid provider address",
+			[]types.SignedKeyAssignment{
+				{ProviderAddr: "cosmosvaloper1invalid", ConsumerKey: consumerKey, Signature: signature},
+			},
+			false,
+		},
+		{
+			"duplicate provider address",
+			[]types.SignedKeyAssignment{
+				{ProviderAddr: valOpAddr1, ConsumerKey: consumerKey, Signature: signature},
+				{ProviderAddr: valOpAddr1, ConsumerKey: consumerKey, Signature: signature},
+			},
+			false,
+		},
+		{
+			"empty consumer key",
+			[]types.SignedKeyAssignment{
+				{ProviderAddr: valOpAddr1, ConsumerKey: "", Signature: signature},
+			},
+			false,
+		},
+		{
+			"invalid consumer key",
+			[]types.SignedKeyAssignment{
+				{ProviderAddr: valOpAddr1, ConsumerKey: "key", Signature: signature},
+			},
+			false,
+		},
+		{
+			"empty signature",
+			[]types.SignedKeyAssignment{
+				{ProviderAddr: valOpAddr1, ConsumerKey: consumerKey},
+			},
+			false,
+		},
+		{
+			"signature too long",
+			[]types.SignedKeyAssignment{
+				{ProviderAddr: valOpAddr1, ConsumerKey: consumerKey, Signature: make([]byte, types.MaxSignatureLength+1)},
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := types.ValidateSignedKeyAssignments(tc.keyAssignments)
+		if tc.expPass {
+			require.NoError(t, err, "valid case: %s should not return error. got %w", tc.name, err)
+		} else {
+			require.Error(t, err, "invalid case: '%s' must return error but got none", tc.name)
+		}
+
+		msg, err := types.NewMsgCreateConsumer("submitter", "somechain-1",
+			types.ConsumerMetadata{Name: "name", Description: "description", Metadata: "metadata"}, nil, nil, nil, nil)
+		require.NoError(t, err)
+		msg.KeyAssignments = tc.keyAssignments
+		require.Equal(t, tc.expPass, msg.ValidateBasic() == nil, tc.name)
+	}
+}
+
+func TestValidateRewardDenomHint(t *testing.T) {
+	testCases := []struct {
+		name    string
+		denom   string
+		expPass bool
+	}{
+		{"valid base denom", "untrn", true},
+		{"invalid denom", "1untrn", false},
+		{"IBC denom", "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", false},
+		{"factory denom", "factory/neutron1abc/token", false},
+	}
+
+	for _, tc := range testCases {
+		err := types.ValidateRewardDenomHint(tc.denom)
+		require.Equal(t, tc.expPass, err == nil, tc.name)
+
+		msg, err := types.NewMsgCreateConsumer("submitter", "somechain-1",
+			types.ConsumerMetadata{Name: "name", Description: "description", Metadata: "metadata"}, nil, nil, nil, nil)
+		require.NoError(t, err)
+		msg.RewardDenomHint = tc.denom
+		require.Equal(t, tc.expPass, msg.ValidateBasic() == nil, tc.name)
+	}
+}
+
+func TestValidateThrottlingParameters(t *testing.T) {
+	testCases := []struct {
+		name    string
+		params  types.ThrottlingParameters
+		expPass bool
+	}{
+		{"global slash meter", types.ThrottlingParameters{}, true},
+		{"slash meter of its own", types.ThrottlingParameters{SlashMeterReplenishFraction: "0.01", SlashMeterReplenishPeriod: time.Hour}, true},
+		{"default replenish period", types.ThrottlingParameters{SlashMeterReplenishFraction: "1"}, true},
+		{"zero replenish fraction", types.ThrottlingParameters{SlashMeterReplenishFraction: "0"}, false},
+		{"replenish fraction above one", types.ThrottlingParameters{SlashMeterReplenishFraction: "1.5"}, false},
+		{"invalid replenish fraction", types.ThrottlingParameters{SlashMeterReplenishFraction: "one"}, false},
+		{"negative replenish period", types.ThrottlingParameters{SlashMeterReplenishPeriod: -time.Hour}, false},
+	}
+
+	for _, tc := range testCases {
+		err := types.ValidateThrottlingParameters(tc.params)
+		require.Equal(t, tc.expPass, err == nil, tc.name)
+
+		msg, err := types.NewMsgCreateConsumer("submitter", "somechain-1",
+			types.ConsumerMetadata{Name: "name", Description: "description", Metadata: "metadata"}, nil, nil, nil, nil)
+		require.NoError(t, err)
+		msg.ThrottlingParameters = &tc.params
+		require.Equal(t, tc.expPass, msg.ValidateBasic() == nil, tc.name)
+	}
+}
+
 func TestMsgUpdateConsumerValidateBasic(t *testing.T) {
 	consAddr1 := "cosmosvalcons1qmq08eruchr5sf5s3rwz7djpr5a25f7xw4mceq"
 	consAddr2 := "cosmosvalcons1nx7n5uh0ztxsynn4sje6eyq2ud6rc6klc96w39"
@@ -625,6 +872,66 @@ func TestMsgUpdateConsumerValidateBasic(t *testing.T) {
 			"validchainid-0",
 			false,
 		},
+		{
+			"top N weighted epochs are invalid",
+			types.PowerShapingParameters{
+				Top_N:               50,
+				Top_NWeightedEpochs: types.MaxTopNWeightedEpochs + 1,
+			},
+			"validchainid-0",
+			false,
+		},
+		{
+			"valid top N weighted epochs",
+			types.PowerShapingParameters{
+				Top_N:               50,
+				Top_NWeightedEpochs: types.MaxTopNWeightedEpochs,
+			},
+			"validchainid-0",
+			true,
+		},
+		{
+			"prioritylist weight of a validator not in the prioritylist",
+			types.PowerShapingParameters{
+				Prioritylist:        []string{"cosmosvalcons1qmq08eruchr5sf5s3rwz7djpr5a25f7xw4mceq"},
+				PrioritylistWeights: []types.PrioritylistWeight{{ProviderAddress: "cosmosvalcons1nx7n5uh0ztxsynn4sje6eyq2ud6rc6klc96w39", Weight: 2}},
+			},
+			"validchainid-0",
+			false,
+		},
+		{
+			"zero prioritylist weight",
+			types.PowerShapingParameters{
+				Prioritylist:        []string{"cosmosvalcons1qmq08eruchr5sf5s3rwz7djpr5a25f7xw4mceq"},
+				PrioritylistWeights: []types.PrioritylistWeight{{ProviderAddress: "cosmosvalcons1qmq08eruchr5sf5s3rwz7djpr5a25f7xw4mceq", Weight: 0}},
+			},
+			"validchainid-0",
+			false,
+		},
+		{
+			"duplicate prioritylist weights",
+			types.PowerShapingParameters{
+				Prioritylist: []string{"cosmosvalcons1qmq08eruchr5sf5s3rwz7djpr5a25f7xw4mceq"},
+				PrioritylistWeights: []types.PrioritylistWeight{
+					{ProviderAddress: "cosmosvalcons1qmq08eruchr5sf5s3rwz7djpr5a25f7xw4mceq", Weight: 2},
+					{ProviderAddress: "cosmosvalcons1qmq08eruchr5sf5s3rwz7djpr5a25f7xw4mceq", Weight: 3},
+				},
+			},
+			"validchainid-0",
+			false,
+		},
+		{
+			"valid prioritylist weights",
+			types.PowerShapingParameters{
+				Prioritylist: []string{
+					"cosmosvalcons1qmq08eruchr5sf5s3rwz7djpr5a25f7xw4mceq",
+					"cosmosvalcons1nx7n5uh0ztxsynn4sje6eyq2ud6rc6klc96w39",
+				},
+				PrioritylistWeights: []types.PrioritylistWeight{{ProviderAddress: "cosmosvalcons1qmq08eruchr5sf5s3rwz7djpr5a25f7xw4mceq", Weight: 2}},
+			},
+			"validchainid-0",
+			true,
+		},
 		{
 			"valid proposal",
 			types.PowerShapingParameters{
@@ -668,13 +975,14 @@ func TestMsgAssignConsumerKeyValidateBasic(t *testing.T) {
 	}
 
 	testCases := []struct {
-		name         string
-		chainId      string
-		providerAddr string
-		signer       string
-		consumerKey  string
-		consumerId   string
-		expErr       bool
+		name             string
+		chainId          string
+		providerAddr     string
+		signer           string
+		consumerKey      string
+		consumerId       string
+		activationHeight int64
+		expErr           bool
 	}{
 		{
 			name:    "invalid: chainId non-empty",
@@ -724,16 +1032,35 @@ func TestMsgAssignConsumerKeyValidateBasic(t *testing.T) {
 			consumerKey:  "{\"@type\": \"/cosmos.crypto.ed25519.PubKey\", \"key\": \"e3BehnEIlGUAnJYn9V8gBXuMh4tXO8xxlxyXD1APGyk=\"}",
 			expErr:       false,
 		},
+		{
+			name:             "invalid: negative activation height",
+			consumerId:       "1",
+			providerAddr:     valOpAddr1.String(),
+			signer:           acc1,
+			consumerKey:      "{\"@type\": \"/cosmos.crypto.ed25519.PubKey\", \"key\": \"e3BehnEIlGUAnJYn9V8gBXuMh4tXO8xxlxyXD1APGyk=\"}",
+			activationHeight: -1,
+			expErr:           true,
+		},
+		{
+			name:             "valid: scheduled key assignment",
+			consumerId:       "1",
+			providerAddr:     valOpAddr1.String(),
+			signer:           acc1,
+			consumerKey:      "{\"@type\": \"/cosmos.crypto.ed25519.PubKey\", \"key\": \"e3BehnEIlGUAnJYn9V8gBXuMh4tXO8xxlxyXD1APGyk=\"}",
+			activationHeight: 100,
+			expErr:           false,
+		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			msg := types.MsgAssignConsumerKey{
-				ChainId:      tc.chainId,
-				ConsumerKey:  tc.consumerKey,
-				ProviderAddr: tc.providerAddr,
-				Signer:       tc.signer,
-				ConsumerId:   tc.consumerId,
+				ChainId:          tc.chainId,
+				ConsumerKey:      tc.consumerKey,
+				ProviderAddr:     tc.providerAddr,
+				Signer:           tc.signer,
+				ConsumerId:       tc.consumerId,
+				ActivationHeight: tc.activationHeight,
 			}
 
 			err := msg.ValidateBasic()
@@ -746,6 +1073,49 @@ func TestMsgAssignConsumerKeyValidateBasic(t *testing.T) {
 	}
 }
 
+func TestMsgAssignConsumerKeyWithProofValidateBasic(t *testing.T) {
+	cId := cryptoutil.NewCryptoIdentityFromIntSeed(35443543534)
+	valOpAddr := cId.SDKValOpAddress()
+	acc := sdk.AccAddress(valOpAddr.Bytes()).String()
+	consumerKey := "{\"@type\": \"/cosmos.crypto.ed25519.PubKey\", \"key\": \"e3BehnEIlGUAnJYn9V8gBXuMh4tXO8xxlxyXD1APGyk=\"}"
+
+	testCases := []struct {
+		name   string
+		msg    *types.MsgAssignConsumerKeyWithProof
+		expErr bool
+	}{
+		{
+			"valid",
+			types.NewMsgAssignConsumerKeyWithProof("1", valOpAddr, consumerKey, acc, []byte("proof")),
+			false,
+		},
+		{
+			"invalid: invalid key assignment",
+			types.NewMsgAssignConsumerKeyWithProof("consumerId", valOpAddr, consumerKey, acc, []byte("proof")),
+			true,
+		},
+		{
+			"invalid: empty proof",
+			types.NewMsgAssignConsumerKeyWithProof("1", valOpAddr, consumerKey, acc, nil),
+			true,
+		},
+		{
+			"invalid: too long proof",
+			types.NewMsgAssignConsumerKeyWithProof("1", valOpAddr, consumerKey, acc, make([]byte, types.MaxSignatureLength+1)),
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expErr {
+			require.Error(t, err, tc.name)
+		} else {
+			require.NoError(t, err, tc.name)
+		}
+	}
+}
+
 func TestValidateInitialHeight(t *testing.T) {
 	testCases := []struct {
 		name          string
@@ -806,9 +1176,35 @@ func TestValidateInitialHeight(t *testing.T) {
 		} else {
 			require.Error(t, err, "invalid case: '%s' must return error but got none", tc.name)
 		}
+		// the expected initial height is always valid and keeps the revision height
+		expectedInitialHeight := types.ExpectedInitialHeight(tc.initialHeight, tc.chainId)
+		require.NoError(t, types.ValidateInitialHeight(expectedInitialHeight, tc.chainId))
+		require.Equal(t, tc.initialHeight.RevisionHeight, expectedInitialHeight.RevisionHeight)
 	}
 }
 
+// TestMsgInitialHeightValidateBasic tests that MsgCreateConsumer and MsgUpdateConsumer
+// reject an initial height that does not match the revision number of the (new) chain id
+func TestMsgInitialHeightValidateBasic(t *testing.T) {
+	initializationParameters := types.DefaultConsumerInitializationParameters()
+	initializationParameters.SpawnTime = time.Now()
+	initializationParameters.InitialHeight = clienttypes.NewHeight(1, 1)
+
+	createMsg, err := types.NewMsgCreateConsumer("submitter", "chain-1", types.ConsumerMetadata{Name: "name", Description: "description", Metadata: "metadata"},
+		&initializationParameters, nil, nil, nil)
+	require.NoError(t, err)
+	require.NoError(t, createMsg.ValidateBasic())
+	createMsg.ChainId = "chain-2"
+	require.ErrorIs(t, createMsg.ValidateBasic(), types.ErrInvalidMsgCreateConsumer)
+
+	updateMsg := types.MsgUpdateConsumer{Owner: "owner", ConsumerId: "0", InitializationParameters: &initializationParameters}
+	require.NoError(t, updateMsg.ValidateBasic())
+	updateMsg.NewChainId = "chain-1"
+	require.NoError(t, updateMsg.ValidateBasic())
+	updateMsg.NewChainId = "chain-2"
+	require.ErrorIs(t, updateMsg.ValidateBasic(), types.ErrInvalidMsgUpdateConsumer)
+}
+
 func TestValidateChainId(t *testing.T) {
 	testCases := []struct {
 		name    string
@@ -855,3 +1251,661 @@ func TestValidateChainId(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgSetConsumerInitialConsensusStateValidateBasic(t *testing.T) {
+	validConsensusState := *ibctmtypes.NewConsensusState(
+		time.Now().UTC(),
+		commitmenttypes.NewMerkleRoot([]byte("app_hash")),
+		tmhash.Sum([]byte("valset")),
+	)
+
+	testCases := []struct {
+		name           string
+		consumerId     string
+		genesisHash    []byte
+		consensusState ibctmtypes.ConsensusState
+		valid          bool
+	}{
+		{
+			name:           "valid",
+			consumerId:     "0",
+			genesisHash:    []byte("gen_hash"),
+			consensusState: validConsensusState,
+			valid:          true,
+		},
+		{
+			name:           "invalid - consumer id",
+			consumerId:     "a",
+			genesisHash:    []byte("gen_hash"),
+			consensusState: validConsensusState,
+			valid:          false,
+		},
+		{
+			name:           "invalid - empty genesis hash",
+			consumerId:     "0",
+			genesisHash:    nil,
+			consensusState: validConsensusState,
+			valid:          false,
+		},
+		{
+			name:           "invalid - genesis hash too long",
+			consumerId:     "0",
+			genesisHash:    []byte(strings.Repeat("a", types.MaxHashLength+1)),
+			consensusState: validConsensusState,
+			valid:          false,
+		},
+		{
+			name:        "invalid - next validators hash",
+			consumerId:  "0",
+			genesisHash: []byte("gen_hash"),
+			consensusState: *ibctmtypes.NewConsensusState(
+				time.Now().UTC(),
+				commitmenttypes.NewMerkleRoot([]byte("app_hash")),
+				[]byte("short"),
+			),
+			valid: false,
+		},
+		{
+			name:        "invalid - empty root",
+			consumerId:  "0",
+			genesisHash: []byte("gen_hash"),
+			consensusState: *ibctmtypes.NewConsensusState(
+				time.Now().UTC(),
+				commitmenttypes.MerkleRoot{},
+				tmhash.Sum([]byte("valset")),
+			),
+			valid: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		msg, err := types.NewMsgSetConsumerInitialConsensusState("owner", tc.consumerId, tc.genesisHash, tc.consensusState)
+		require.NoError(t, err)
+		err = msg.ValidateBasic()
+		if tc.valid {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestMsgSendEmergencyValsetUpdateValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name       string
+		consumerId string
+		valid      bool
+	}{
+		{
+			name:       "valid",
+			consumerId: "0",
+			valid:      true,
+		},
+		{
+			name:       "invalid - empty consumer id",
+			consumerId: "",
+			valid:      false,
+		},
+		{
+			name:       "invalid - consumer id",
+			consumerId: "a",
+			valid:      false,
+		},
+	}
+
+	for _, tc := range testCases {
+		msg := types.NewMsgSendEmergencyValsetUpdate(tc.consumerId, "authority")
+		err := msg.ValidateBasic()
+		if tc.valid {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestMsgRetryLaunchValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name       string
+		consumerId string
+		valid      bool
+	}{
+		{
+			name:       "valid",
+			consumerId: "0",
+			valid:      true,
+		},
+		{
+			name:       "invalid - empty consumer id",
+			consumerId: "",
+			valid:      false,
+		},
+		{
+			name:       "invalid - consumer id",
+			consumerId: "a",
+			valid:      false,
+		},
+	}
+
+	for _, tc := range testCases {
+		msg := types.NewMsgRetryLaunch("owner", tc.consumerId)
+		err := msg.ValidateBasic()
+		if tc.valid {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.ErrorIs(t, err, types.ErrInvalidMsgRetryLaunch, tc.name)
+		}
+	}
+}
+
+func TestMsgChangeConsumerChainIdValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name       string
+		consumerId string
+		newChainId string
+		valid      bool
+	}{
+		{
+			name:       "valid",
+			consumerId: "0",
+			newChainId: "chain-2",
+			valid:      true,
+		},
+		{
+			name:       "invalid - consumer id",
+			consumerId: "a",
+			newChainId: "chain-2",
+			valid:      false,
+		},
+		{
+			name:       "invalid - empty new chain id",
+			consumerId: "0",
+			newChainId: "",
+			valid:      false,
+		},
+		{
+			name:       "invalid - reserved new chain id",
+			consumerId: "0",
+			newChainId: "stride-1",
+			valid:      false,
+		},
+	}
+
+	for _, tc := range testCases {
+		msg := types.NewMsgChangeConsumerChainId("owner", tc.consumerId, tc.newChainId)
+		err := msg.ValidateBasic()
+		if tc.valid {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.ErrorIs(t, err, types.ErrInvalidMsgChangeConsumerChainId, tc.name)
+		}
+	}
+}
+
+func TestMsgPauseAndResumeConsumerValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name       string
+		consumerId string
+		valid      bool
+	}{
+		{
+			name:       "valid",
+			consumerId: "0",
+			valid:      true,
+		},
+		{
+			name:       "invalid - empty consumer id",
+			consumerId: "",
+			valid:      false,
+		},
+		{
+			name:       "invalid - consumer id",
+			consumerId: "a",
+			valid:      false,
+		},
+	}
+
+	for _, tc := range testCases {
+		pauseMsg := types.MsgPauseConsumer{ConsumerId: tc.consumerId, Authority: "authority"}
+		resumeMsg := types.MsgResumeConsumer{ConsumerId: tc.consumerId, Authority: "authority"}
+		if tc.valid {
+			require.NoError(t, pauseMsg.ValidateBasic(), tc.name)
+			require.NoError(t, resumeMsg.ValidateBasic(), tc.name)
+		} else {
+			require.ErrorIs(t, pauseMsg.ValidateBasic(), types.ErrInvalidMsgPauseConsumer, tc.name)
+			require.ErrorIs(t, resumeMsg.ValidateBasic(), types.ErrInvalidMsgResumeConsumer, tc.name)
+		}
+	}
+}
+
+func TestMsgAttestConsumerHashesValidateBasic(t *testing.T) {
+	tooLongHash := []byte("Cosmos Hub is the best place to launch a chain. Interchain Security is awesome.")
+
+	testCases := []struct {
+		name        string
+		consumerId  string
+		genesisHash []byte
+		binaryHash  []byte
+		valid       bool
+	}{
+		{
+			name:        "valid",
+			consumerId:  "0",
+			genesisHash: []byte{0x01},
+			binaryHash:  []byte{0x02},
+			valid:       true,
+		},
+		{
+			name:       "valid - empty hashes",
+			consumerId: "0",
+			valid:      true,
+		},
+		{
+			name:        "invalid - consumer id",
+			consumerId:  "a",
+			genesisHash: []byte{0x01},
+			binaryHash:  []byte{0x02},
+			valid:       false,
+		},
+		{
+			name:        "invalid - genesis hash too long",
+			consumerId:  "0",
+			genesisHash: tooLongHash,
+			binaryHash:  []byte{0x02},
+			valid:       false,
+		},
+		{
+			name:        "invalid - binary hash too long",
+			consumerId:  "0",
+			genesisHash: []byte{0x01},
+			binaryHash:  tooLongHash,
+			valid:       false,
+		},
+	}
+
+	for _, tc := range testCases {
+		msg := types.NewMsgAttestConsumerHashes("owner", tc.consumerId, tc.genesisHash, tc.binaryHash)
+		err := msg.ValidateBasic()
+		if tc.valid {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.ErrorIs(t, err, types.ErrInvalidMsgAttestConsumerHashes, tc.name)
+		}
+	}
+}
+
+func TestMsgChangeConsumerCreatorAllowlistValidateBasic(t *testing.T) {
+	addr1 := sdk.AccAddress([]byte("creator1")).String()
+	addr2 := sdk.AccAddress([]byte("creator2")).String()
+
+	testCases := []struct {
+		name              string
+		addressesToAdd    []string
+		addressesToRemove []string
+		valid             bool
+	}{
+		{
+			name:              "valid",
+			addressesToAdd:    []string{addr1},
+			addressesToRemove: []string{addr2},
+			valid:             true,
+		},
+		{
+			name:  "invalid - both lists empty",
+			valid: false,
+		},
+		{
+			name:           "invalid - address to add",
+			addressesToAdd: []string{"invalid"},
+			valid:          false,
+		},
+		{
+			name:              "invalid - address to remove",
+			addressesToRemove: []string{"invalid"},
+			valid:             false,
+		},
+		{
+			name:              "invalid - address both added and removed",
+			addressesToAdd:    []string{addr1},
+			addressesToRemove: []string{addr1},
+			valid:             false,
+		},
+	}
+
+	for _, tc := range testCases {
+		msg := types.MsgChangeConsumerCreatorAllowlist{
+			AddressesToAdd:    tc.addressesToAdd,
+			AddressesToRemove: tc.addressesToRemove,
+			Authority:         "authority",
+		}
+		err := msg.ValidateBasic()
+		if tc.valid {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.ErrorIs(t, err, types.ErrInvalidMsgChangeConsumerCreatorAllowlist, tc.name)
+		}
+	}
+}
+
+func TestMsgForceRemoveConsumerValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name       string
+		consumerId string
+		reason     string
+		valid      bool
+	}{
+		{
+			name:       "valid",
+			consumerId: "0",
+			reason:     "abandoned",
+			valid:      true,
+		},
+		{
+			name:       "invalid - consumer id",
+			consumerId: "consumer",
+			reason:     "abandoned",
+			valid:      false,
+		},
+		{
+			name:       "invalid - empty reason",
+			consumerId: "0",
+			reason:     "",
+			valid:      false,
+		},
+		{
+			name:       "invalid - reason too long",
+			consumerId: "0",
+			reason:     strings.Repeat("a", types.MaxReasonLength+1),
+			valid:      false,
+		},
+	}
+
+	for _, tc := range testCases {
+		msg := types.MsgForceRemoveConsumer{
+			ConsumerId: tc.consumerId,
+			Reason:     tc.reason,
+			Authority:  "authority",
+		}
+		err := msg.ValidateBasic()
+		if tc.valid {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.ErrorIs(t, err, types.ErrInvalidMsgForceRemoveConsumer, tc.name)
+		}
+	}
+}
+
+func TestMsgRegisterConsumerClientUpgradeValidateBasic(t *testing.T) {
+	validPlan := types.ConsumerClientUpgradePlan{
+		UpgradeHeight:   clienttypes.NewHeight(1, 100),
+		NewChainId:      "chain-2",
+		UnbondingPeriod: time.Hour,
+	}
+
+	testCases := []struct {
+		name       string
+		consumerId string
+		plan       func(plan *types.ConsumerClientUpgradePlan)
+		valid      bool
+	}{
+		{
+			name:       "valid",
+			consumerId: "0",
+			plan:       func(plan *types.ConsumerClientUpgradePlan) {},
+			valid:      true,
+		},
+		{
+			name:       "valid - unchanged unbonding period",
+			consumerId: "0",
+			plan: func(plan *types.ConsumerClientUpgradePlan) {
+				plan.UnbondingPeriod = 0
+			},
+			valid: true,
+		},
+		{
+			name:       "invalid - consumer id",
+			consumerId: "a",
+			plan:       func(plan *types.ConsumerClientUpgradePlan) {},
+			valid:      false,
+		},
+		{
+			name:       "invalid - zero upgrade height",
+			consumerId: "0",
+			plan: func(plan *types.ConsumerClientUpgradePlan) {
+				plan.UpgradeHeight = clienttypes.ZeroHeight()
+			},
+			valid: false,
+		},
+		{
+			name:       "invalid - empty new chain id",
+			consumerId: "0",
+			plan: func(plan *types.ConsumerClientUpgradePlan) {
+				plan.NewChainId = ""
+			},
+			valid: false,
+		},
+		{
+			name:       "invalid - negative unbonding period",
+			consumerId: "0",
+			plan: func(plan *types.ConsumerClientUpgradePlan) {
+				plan.UnbondingPeriod = -time.Hour
+			},
+			valid: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		plan := validPlan
+		tc.plan(&plan)
+		msg := types.NewMsgRegisterConsumerClientUpgrade("owner", tc.consumerId, plan)
+		err := msg.ValidateBasic()
+		if tc.valid {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.ErrorIs(t, err, types.ErrInvalidMsgRegisterConsumerClientUpgrade, tc.name)
+		}
+	}
+}
+
+func TestMsgRegisterConsumerProviderSwitchValidateBasic(t *testing.T) {
+	validSwitch := types.ConsumerProviderSwitch{
+		SwitchHeight:       clienttypes.NewHeight(1, 100),
+		NewProviderChainId: "provider-2",
+	}
+
+	testCases := []struct {
+		name           string
+		consumerId     string
+		providerSwitch func(providerSwitch *types.ConsumerProviderSwitch)
+		valid          bool
+	}{
+		{
+			name:           "valid",
+			consumerId:     "0",
+			providerSwitch: func(providerSwitch *types.ConsumerProviderSwitch) {},
+			valid:          true,
+		},
+		{
+			name:           "invalid - consumer id",
+			consumerId:     "a",
+			providerSwitch: func(providerSwitch *types.ConsumerProviderSwitch) {},
+			valid:          false,
+		},
+		{
+			name:       "invalid - zero switch height",
+			consumerId: "0",
+			providerSwitch: func(providerSwitch *types.ConsumerProviderSwitch) {
+				providerSwitch.SwitchHeight = clienttypes.ZeroHeight()
+			},
+			valid: false,
+		},
+		{
+			name:       "invalid - empty new provider chain id",
+			consumerId: "0",
+			providerSwitch: func(providerSwitch *types.ConsumerProviderSwitch) {
+				providerSwitch.NewProviderChainId = " "
+			},
+			valid: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		providerSwitch := validSwitch
+		tc.providerSwitch(&providerSwitch)
+		msg := types.NewMsgRegisterConsumerProviderSwitch("owner", tc.consumerId, providerSwitch)
+		err := msg.ValidateBasic()
+		if tc.valid {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.ErrorIs(t, err, types.ErrInvalidMsgRegisterConsumerProviderSwitch, tc.name)
+		}
+	}
+}
+
+func TestMsgCreateConsumersValidateBasic(t *testing.T) {
+	validConsumer := types.ConsumerCreation{
+		ChainId:  "testnet-1",
+		Metadata: types.ConsumerMetadata{Name: "name", Description: "description", Metadata: "metadata"},
+	}
+	tooManyConsumers := make([]types.ConsumerCreation, types.MaxConsumerCreations+1)
+	for i := range tooManyConsumers {
+		tooManyConsumers[i] = validConsumer
+	}
+
+	testCases := []struct {
+		name      string
+		consumers []types.ConsumerCreation
+		valid     bool
+	}{
+		{
+			name:      "valid",
+			consumers: []types.ConsumerCreation{validConsumer, validConsumer},
+			valid:     true,
+		},
+		{
+			name:      "invalid - no consumers",
+			consumers: []types.ConsumerCreation{},
+			valid:     false,
+		},
+		{
+			name:      "invalid - too many consumers",
+			consumers: tooManyConsumers,
+			valid:     false,
+		},
+		{
+			name: "invalid - empty chain id",
+			consumers: []types.ConsumerCreation{validConsumer, {
+				Metadata: types.ConsumerMetadata{Name: "name", Description: "description", Metadata: "metadata"},
+			}},
+			valid: false,
+		},
+		{
+			name: "invalid - top N chain",
+			consumers: []types.ConsumerCreation{{
+				ChainId:                "testnet-1",
+				Metadata:               types.ConsumerMetadata{Name: "name", Description: "description", Metadata: "metadata"},
+				PowerShapingParameters: &types.PowerShapingParameters{Top_N: 50},
+			}},
+			valid: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		msg := types.NewMsgCreateConsumers("submitter", tc.consumers)
+		err := msg.ValidateBasic()
+		if tc.valid {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.ErrorIs(t, err, types.ErrInvalidMsgCreateConsumers, tc.name)
+		}
+	}
+}
+
+func TestConsumerCreationToMsgCreateConsumer(t *testing.T) {
+	initializationParameters := types.DefaultConsumerInitializationParameters()
+	initializationParameters.GenesisHash = []byte("genesis_hash")
+	initializationParameters.BinaryHash = []byte("binary_hash")
+	consumer := types.ConsumerCreation{
+		ChainId:                  "testnet-1",
+		Metadata:                 types.ConsumerMetadata{Name: "name", Description: "description", Metadata: "metadata"},
+		InitializationParameters: &initializationParameters,
+		PowerShapingParameters:   &types.PowerShapingParameters{ValidatorSetCap: 10},
+		AllowlistedRewardDenoms:  &types.AllowlistedRewardDenoms{Denoms: []string{"ibc/denom"}},
+		InfractionParameters:     &types.InfractionParameters{},
+		RewardDenomHint:          "untrn",
+		ThrottlingParameters:     &types.ThrottlingParameters{SlashMeterReplenishPeriod: time.Hour, SlashMeterReplenishFraction: "0.05"},
+		KeyAssignments: []types.SignedKeyAssignment{{
+			ProviderAddr: cryptoutil.NewCryptoIdentityFromIntSeed(35443543534).SDKValOpAddress().String(),
+			ConsumerKey:  "{\"@type\": \"/cosmos.crypto.ed25519.PubKey\", \"key\": \"e3BehnEIlGUAnJYn9V8gBXuMh4tXO8xxlxyXD1APGyk=\"}",
+			Signature:    []byte("signature"),
+		}},
+	}
+
+	// the consumer survives the encoding of the message
+	bz, err := types.NewMsgCreateConsumers("submitter", []types.ConsumerCreation{consumer}).Marshal()
+	require.NoError(t, err)
+	var msg types.MsgCreateConsumers
+	require.NoError(t, msg.Unmarshal(bz))
+	require.Len(t, msg.Consumers, 1)
+
+	require.Equal(t, &types.MsgCreateConsumer{
+		Submitter:                "submitter",
+		ChainId:                  consumer.ChainId,
+		Metadata:                 consumer.Metadata,
+		InitializationParameters: consumer.InitializationParameters,
+		PowerShapingParameters:   consumer.PowerShapingParameters,
+		AllowlistedRewardDenoms:  consumer.AllowlistedRewardDenoms,
+		InfractionParameters:     consumer.InfractionParameters,
+		KeyAssignments:           consumer.KeyAssignments,
+		RewardDenomHint:          consumer.RewardDenomHint,
+		ThrottlingParameters:     consumer.ThrottlingParameters,
+	}, msg.Consumers[0].ToMsgCreateConsumer(msg.Submitter))
+}
+
+func TestMsgEjectConsumerValidatorValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name             string
+		consumerId       string
+		providerConsAddr string
+		valid            bool
+	}{
+		{
+			name:             "valid",
+			consumerId:       "0",
+			providerConsAddr: "cosmosvalcons1qmq08eruchr5sf5s3rwz7djpr5a25f7xw4mceq",
+			valid:            true,
+		},
+		{
+			name:             "invalid - consumer id",
+			consumerId:       "a",
+			providerConsAddr: "cosmosvalcons1qmq08eruchr5sf5s3rwz7djpr5a25f7xw4mceq",
+			valid:            false,
+		},
+		{
+			name:             "invalid - empty provider consensus address",
+			consumerId:       "0",
+			providerConsAddr: "",
+			valid:            false,
+		},
+		{
+			name:             "invalid - provider consensus address",
+			consumerId:       "0",
+			providerConsAddr: "cosmosvalcons1nx7n5uh0ztxsynn4sje6ey",
+			valid:            false,
+		},
+	}
+
+	for _, tc := range testCases {
+		msg := types.MsgEjectConsumerValidator{
+			ConsumerId:       tc.consumerId,
+			ProviderConsAddr: tc.providerConsAddr,
+			Authority:        "authority",
+		}
+		err := msg.ValidateBasic()
+		if tc.valid {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.ErrorIs(t, err, types.ErrInvalidMsgEjectConsumerValidator, tc.name)
+		}
+	}
+}
@@ -52,6 +52,46 @@ const (
 	// DefaultMaxProviderConsensusValidators is the default maximum number of validators that will
 	// be passed on from the staking module to the consensus engine on the provider.
 	DefaultMaxProviderConsensusValidators = 180
+
+	// DefaultMaxLaunchedConsumers is the default maximum number of launched consumer chains.
+	// Zero means that the number of launched consumer chains is not capped.
+	DefaultMaxLaunchedConsumers = uint64(0)
+
+	// DefaultMinTopNBudget is the default minimal number of Top N consumer chains
+	// that a validator can limit its automatic opt-in to
+	DefaultMinTopNBudget = uint32(3)
+
+	// DefaultImmediateValidatorUpdates is the default value of whether the changes of the provider validator set
+	// caused by the jailing, tombstoning, slashing, or bonding of validators are sent before the end of the epoch
+	DefaultImmediateValidatorUpdates = false
+
+	// DefaultImmediateDowntimeJailing is the default value of whether the validators jailed for downtime
+	// are removed from the consumer chains before the end of the epoch
+	DefaultImmediateDowntimeJailing = false
+
+	// DefaultMaxValidatorUpdatesPerPacket is the default maximum number of validator updates
+	// sent in one VSC packet. Zero means that the number of validator updates is not capped.
+	DefaultMaxValidatorUpdatesPerPacket = uint64(0)
+
+	// DefaultStaleKeyAssignmentEpochs is the default number of epochs after which an assigned consumer key
+	// that was never observed in a heartbeat of the consumer chain is stale. Zero disables the detection.
+	DefaultStaleKeyAssignmentEpochs = int64(0)
+
+	// DefaultValidatorFeeExemptionsPerEpoch is the default number of key assignment and opt-in/opt-out messages
+	// per epoch for which a bonded validator does not pay fees. Zero disables the fee exemptions.
+	DefaultValidatorFeeExemptionsPerEpoch = uint64(0)
+
+	// DefaultMaxChannelClosedDuration is the default duration after which a launched consumer chain
+	// whose CCV channel is closed or whose client is expired is removed. Zero disables the automatic removal.
+	DefaultMaxChannelClosedDuration = time.Duration(0)
+
+	// DefaultEpochDuration is the default duration of an epoch. Zero means that the epochs
+	// are expressed in blocks, i.e., that an epoch consists of BlocksPerEpoch blocks.
+	DefaultEpochDuration = time.Duration(0)
+
+	// DefaultRestrictConsumerCreation is the default value of the RestrictConsumerCreation param,
+	// i.e., anyone can create consumer chains
+	DefaultRestrictConsumerCreation = false
 )
 
 // Reflection based keys for params subspace
@@ -67,6 +107,17 @@ var (
 	KeyBlocksPerEpoch                        = []byte("BlocksPerEpoch")
 	KeyNumberOfEpochsToStartReceivingRewards = []byte("NumberOfEpochsToStartReceivingRewards")
 	KeyMaxProviderConsensusValidators        = []byte("MaxProviderConsensusValidators")
+	KeyMaxLaunchedConsumers                  = []byte("MaxLaunchedConsumers")
+	KeyMinTopNBudget                         = []byte("MinTopNBudget")
+	KeyImmediateValidatorUpdates             = []byte("ImmediateValidatorUpdates")
+	KeyImmediateDowntimeJailing              = []byte("ImmediateDowntimeJailing")
+	KeyClientExpiryWarningThreshold          = []byte("ClientExpiryWarningThreshold")
+	KeyMaxValidatorUpdatesPerPacket          = []byte("MaxValidatorUpdatesPerPacket")
+	KeyStaleKeyAssignmentEpochs              = []byte("StaleKeyAssignmentEpochs")
+	KeyValidatorFeeExemptionsPerEpoch        = []byte("ValidatorFeeExemptionsPerEpoch")
+	KeyMaxChannelClosedDuration              = []byte("MaxChannelClosedDuration")
+	KeyEpochDuration                         = []byte("EpochDuration")
+	KeyRestrictConsumerCreation              = []byte("RestrictConsumerCreation")
 )
 
 // ParamKeyTable returns a key table with the necessary registered provider params
@@ -85,6 +136,17 @@ func NewParams(
 	blocksPerEpoch int64,
 	numberOfEpochsToStartReceivingRewards int64,
 	maxProviderConsensusValidators int64,
+	maxLaunchedConsumers uint64,
+	minTopNBudget uint32,
+	immediateValidatorUpdates bool,
+	immediateDowntimeJailing bool,
+	clientExpiryWarningThreshold time.Duration,
+	maxValidatorUpdatesPerPacket uint64,
+	staleKeyAssignmentEpochs int64,
+	validatorFeeExemptionsPerEpoch uint64,
+	maxChannelClosedDuration time.Duration,
+	epochDuration time.Duration,
+	restrictConsumerCreation bool,
 ) Params {
 	return Params{
 		TemplateClient:                        cs,
@@ -96,6 +158,17 @@ func NewParams(
 		BlocksPerEpoch:                        blocksPerEpoch,
 		NumberOfEpochsToStartReceivingRewards: numberOfEpochsToStartReceivingRewards,
 		MaxProviderConsensusValidators:        maxProviderConsensusValidators,
+		MaxLaunchedConsumers:                  maxLaunchedConsumers,
+		MinTopNBudget:                         minTopNBudget,
+		ImmediateValidatorUpdates:             immediateValidatorUpdates,
+		ImmediateDowntimeJailing:              immediateDowntimeJailing,
+		ClientExpiryWarningThreshold:          clientExpiryWarningThreshold,
+		MaxValidatorUpdatesPerPacket:          maxValidatorUpdatesPerPacket,
+		StaleKeyAssignmentEpochs:              staleKeyAssignmentEpochs,
+		ValidatorFeeExemptionsPerEpoch:        validatorFeeExemptionsPerEpoch,
+		MaxChannelClosedDuration:              maxChannelClosedDuration,
+		EpochDuration:                         epochDuration,
+		RestrictConsumerCreation:              restrictConsumerCreation,
 	}
 }
 
@@ -132,6 +205,17 @@ func DefaultParams() Params {
 		DefaultBlocksPerEpoch,
 		DefaultNumberOfEpochsToStartReceivingRewards,
 		DefaultMaxProviderConsensusValidators,
+		DefaultMaxLaunchedConsumers,
+		DefaultMinTopNBudget,
+		DefaultImmediateValidatorUpdates,
+		DefaultImmediateDowntimeJailing,
+		ccvtypes.DefaultClientExpiryWarningThreshold,
+		DefaultMaxValidatorUpdatesPerPacket,
+		DefaultStaleKeyAssignmentEpochs,
+		DefaultValidatorFeeExemptionsPerEpoch,
+		DefaultMaxChannelClosedDuration,
+		DefaultEpochDuration,
+		DefaultRestrictConsumerCreation,
 	)
 }
 
@@ -158,8 +242,8 @@ func (p Params) Validate() error {
 	if err := ValidateCoin(p.ConsumerRewardDenomRegistrationFee); err != nil {
 		return fmt.Errorf("consumer reward denom registration fee is invalid: %s", err)
 	}
-	if err := ccvtypes.ValidatePositiveInt64(p.BlocksPerEpoch); err != nil {
-		return fmt.Errorf("blocks per epoch is invalid: %s", err)
+	if err := ValidateEpochLength(p.BlocksPerEpoch, p.EpochDuration); err != nil {
+		return fmt.Errorf("epoch length is invalid: %s", err)
 	}
 	if err := ccvtypes.ValidatePositiveInt64(p.NumberOfEpochsToStartReceivingRewards); err != nil {
 		return fmt.Errorf("number of epochs to start receiving rewards is invalid: %s", err)
@@ -168,6 +252,18 @@ func (p Params) Validate() error {
 	if err := ccvtypes.ValidatePositiveInt64(p.MaxProviderConsensusValidators); err != nil {
 		return fmt.Errorf("max provider consensus validators is invalid: %s", err)
 	}
+	if err := ValidateMinTopNBudget(p.MinTopNBudget); err != nil {
+		return fmt.Errorf("min top N budget is invalid: %s", err)
+	}
+	if err := ccvtypes.ValidateNonNegativeDuration(p.ClientExpiryWarningThreshold); err != nil {
+		return fmt.Errorf("client expiry warning threshold is invalid: %s", err)
+	}
+	if err := ccvtypes.ValidateNonNegativeInt64(p.StaleKeyAssignmentEpochs); err != nil {
+		return fmt.Errorf("stale key assignment epochs is invalid: %s", err)
+	}
+	if err := ccvtypes.ValidateNonNegativeDuration(p.MaxChannelClosedDuration); err != nil {
+		return fmt.Errorf("max channel closed duration is invalid: %s", err)
+	}
 	return nil
 }
 
@@ -180,10 +276,39 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeySlashMeterReplenishPeriod, p.SlashMeterReplenishPeriod, ccvtypes.ValidateDuration),
 		paramtypes.NewParamSetPair(KeySlashMeterReplenishFraction, p.SlashMeterReplenishFraction, ccvtypes.ValidateStringFraction),
 		paramtypes.NewParamSetPair(KeyConsumerRewardDenomRegistrationFee, p.ConsumerRewardDenomRegistrationFee, ValidateCoin),
-		paramtypes.NewParamSetPair(KeyBlocksPerEpoch, p.BlocksPerEpoch, ccvtypes.ValidatePositiveInt64),
+		paramtypes.NewParamSetPair(KeyBlocksPerEpoch, p.BlocksPerEpoch, ccvtypes.ValidateNonNegativeInt64),
 		paramtypes.NewParamSetPair(KeyNumberOfEpochsToStartReceivingRewards, p.NumberOfEpochsToStartReceivingRewards, ccvtypes.ValidatePositiveInt64),
 		paramtypes.NewParamSetPair(KeyMaxProviderConsensusValidators, p.MaxProviderConsensusValidators, ccvtypes.ValidatePositiveInt64),
+		paramtypes.NewParamSetPair(KeyMaxLaunchedConsumers, p.MaxLaunchedConsumers, ccvtypes.ValidateUint64),
+		paramtypes.NewParamSetPair(KeyMinTopNBudget, p.MinTopNBudget, ValidateMinTopNBudget),
+		paramtypes.NewParamSetPair(KeyImmediateValidatorUpdates, p.ImmediateValidatorUpdates, ccvtypes.ValidateBool),
+		paramtypes.NewParamSetPair(KeyImmediateDowntimeJailing, p.ImmediateDowntimeJailing, ccvtypes.ValidateBool),
+		paramtypes.NewParamSetPair(KeyClientExpiryWarningThreshold, p.ClientExpiryWarningThreshold, ccvtypes.ValidateNonNegativeDuration),
+		paramtypes.NewParamSetPair(KeyMaxValidatorUpdatesPerPacket, p.MaxValidatorUpdatesPerPacket, ccvtypes.ValidateUint64),
+		paramtypes.NewParamSetPair(KeyStaleKeyAssignmentEpochs, p.StaleKeyAssignmentEpochs, ccvtypes.ValidateNonNegativeInt64),
+		paramtypes.NewParamSetPair(KeyValidatorFeeExemptionsPerEpoch, p.ValidatorFeeExemptionsPerEpoch, ccvtypes.ValidateUint64),
+		paramtypes.NewParamSetPair(KeyMaxChannelClosedDuration, p.MaxChannelClosedDuration, ccvtypes.ValidateNonNegativeDuration),
+		paramtypes.NewParamSetPair(KeyEpochDuration, p.EpochDuration, ccvtypes.ValidateNonNegativeDuration),
+		paramtypes.NewParamSetPair(KeyRestrictConsumerCreation, p.RestrictConsumerCreation, ccvtypes.ValidateBool),
+	}
+}
+
+// ValidateEpochLength validates the length of an epoch, which is expressed either in blocks
+// or in time, i.e., exactly one of `blocksPerEpoch` and `epochDuration` must be positive
+func ValidateEpochLength(blocksPerEpoch int64, epochDuration time.Duration) error {
+	if err := ccvtypes.ValidateNonNegativeInt64(blocksPerEpoch); err != nil {
+		return fmt.Errorf("blocks per epoch is invalid: %s", err)
+	}
+	if err := ccvtypes.ValidateNonNegativeDuration(epochDuration); err != nil {
+		return fmt.Errorf("epoch duration is invalid: %s", err)
 	}
+	if blocksPerEpoch == 0 && epochDuration == 0 {
+		return fmt.Errorf("either blocks per epoch or epoch duration must be set")
+	}
+	if blocksPerEpoch != 0 && epochDuration != 0 {
+		return fmt.Errorf("blocks per epoch (%d) and epoch duration (%s) cannot be both set", blocksPerEpoch, epochDuration)
+	}
+	return nil
 }
 
 func ValidateTemplateClient(i interface{}) error {
@@ -213,6 +338,19 @@ func ValidateTemplateClient(i interface{}) error {
 	return nil
 }
 
+func ValidateMinTopNBudget(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("min top N budget must be positive")
+	}
+
+	return nil
+}
+
 func ValidateCoin(i interface{}) error {
 	v, ok := i.(sdk.Coin)
 	if !ok {
@@ -26,36 +26,64 @@ func TestValidateParams(t *testing.T) {
 		{"custom valid params", types.NewParams(
 			ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
 				time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}),
-			"0.33", time.Hour, 30*time.Minute, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 1000, 24, 180), true},
+			"0.33", time.Hour, 30*time.Minute, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 1000, 24, 180, 10, 3, true, true, 24*time.Hour, 0, 0, 0, 0, 0, false), true},
 		{"custom invalid params", types.NewParams(
 			ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
 				0, clienttypes.Height{}, nil, []string{"ibc", "upgradedIBCState"}),
-			"0.33", time.Hour, 30*time.Minute, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 1000, 24, 180), false},
+			"0.33", time.Hour, 30*time.Minute, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 1000, 24, 180, 0, 3, false, false, 24*time.Hour, 0, 0, 0, 0, 0, false), false},
 		{"blank client", types.NewParams(&ibctmtypes.ClientState{},
-			"0.33", time.Hour, 30*time.Minute, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 1000, 24, 180), false},
-		{"nil client", types.NewParams(nil, "0.33", time.Hour, 30*time.Minute, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 1000, 24, 180), false},
+			"0.33", time.Hour, 30*time.Minute, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 1000, 24, 180, 0, 3, false, false, 24*time.Hour, 0, 0, 0, 0, 0, false), false},
+		{"nil client", types.NewParams(nil, "0.33", time.Hour, 30*time.Minute, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 1000, 24, 180, 0, 3, false, false, 24*time.Hour, 0, 0, 0, 0, 0, false), false},
 		{"0 trusting period fraction", types.NewParams(ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
 			time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}),
-			"0.00", time.Hour, 30*time.Minute, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 1000, 24, 180), false},
+			"0.00", time.Hour, 30*time.Minute, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 1000, 24, 180, 0, 3, false, false, 24*time.Hour, 0, 0, 0, 0, 0, false), false},
 		{"0 ccv timeout period", types.NewParams(ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
 			time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}),
-			"0.33", 0, 30*time.Minute, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 1000, 24, 180), false},
+			"0.33", 0, 30*time.Minute, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 1000, 24, 180, 0, 3, false, false, 24*time.Hour, 0, 0, 0, 0, 0, false), false},
 		{"0 slash meter replenish period", types.NewParams(ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
 			time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}),
-			"0.33", time.Hour, 0, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 1000, 24, 180), false},
+			"0.33", time.Hour, 0, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 1000, 24, 180, 0, 3, false, false, 24*time.Hour, 0, 0, 0, 0, 0, false), false},
 		{"slash meter replenish fraction over 1", types.NewParams(ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
 			time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}),
-			"0.33", time.Hour, time.Hour, "1.5", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 1000, 24, 180), false},
+			"0.33", time.Hour, time.Hour, "1.5", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 1000, 24, 180, 0, 3, false, false, 24*time.Hour, 0, 0, 0, 0, 0, false), false},
 		{"invalid consumer reward denom registration fee denom", types.NewParams(ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
 			time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}),
-			"0.33", time.Hour, time.Hour, "0.1", sdk.Coin{Denom: "st", Amount: math.NewInt(10000000)}, 1000, 24, 180), false},
+			"0.33", time.Hour, time.Hour, "0.1", sdk.Coin{Denom: "st", Amount: math.NewInt(10000000)}, 1000, 24, 180, 0, 3, false, false, 24*time.Hour, 0, 0, 0, 0, 0, false), false},
 		{"invalid consumer reward denom registration fee amount", types.NewParams(ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
 			time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}),
-			"0.33", time.Hour, time.Hour, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(-10000000)}, 1000, 24, 180), false},
+			"0.33", time.Hour, time.Hour, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(-10000000)}, 1000, 24, 180, 0, 3, false, false, 24*time.Hour, 0, 0, 0, 0, 0, false), false},
 		{"invalid number of epochs to start receiving rewards", types.NewParams(
 			ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
 				time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}),
-			"0.33", time.Hour, 30*time.Minute, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 1000, 0, 180), false},
+			"0.33", time.Hour, 30*time.Minute, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 1000, 0, 180, 0, 3, false, false, 24*time.Hour, 0, 0, 0, 0, 0, false), false},
+		{"negative client expiry warning threshold", types.NewParams(
+			ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
+				time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}),
+			"0.33", time.Hour, 30*time.Minute, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 1000, 24, 180, 0, 3, false, false, -time.Hour, 0, 0, 0, 0, 0, false), false},
+		{"negative stale key assignment epochs", types.NewParams(
+			ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
+				time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}),
+			"0.33", time.Hour, 30*time.Minute, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 1000, 24, 180, 0, 3, false, false, 24*time.Hour, 0, -1, 0, 0, 0, false), false},
+		{"epochs expressed in time", types.NewParams(
+			ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
+				time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}),
+			"0.33", time.Hour, 30*time.Minute, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 0, 24, 180, 0, 3, false, false, 24*time.Hour, 0, 0, 0, 0, time.Hour, false), true},
+		{"both blocks per epoch and epoch duration", types.NewParams(
+			ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
+				time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}),
+			"0.33", time.Hour, 30*time.Minute, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 1000, 24, 180, 0, 3, false, false, 24*time.Hour, 0, 0, 0, 0, time.Hour, false), false},
+		{"neither blocks per epoch nor epoch duration", types.NewParams(
+			ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
+				time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}),
+			"0.33", time.Hour, 30*time.Minute, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 0, 24, 180, 0, 3, false, false, 24*time.Hour, 0, 0, 0, 0, 0, false), false},
+		{"negative epoch duration", types.NewParams(
+			ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
+				time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}),
+			"0.33", time.Hour, 30*time.Minute, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 0, 24, 180, 0, 3, false, false, 24*time.Hour, 0, 0, 0, 0, -time.Hour, false), false},
+		{"negative max channel closed duration", types.NewParams(
+			ibctmtypes.NewClientState("", ibctmtypes.DefaultTrustLevel, 0, 0,
+				time.Second*40, clienttypes.Height{}, commitmenttypes.GetSDKSpecs(), []string{"ibc", "upgradedIBCState"}),
+			"0.33", time.Hour, 30*time.Minute, "0.1", sdk.Coin{Denom: "stake", Amount: math.NewInt(10000000)}, 1000, 24, 180, 0, 3, false, false, 24*time.Hour, 0, 0, 0, -time.Hour, 0, false), false},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,80 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PowerShaper is a custom power shaping step, e.g., a geo-diversity constraint, that applications insert
+// between the built-in power shaping steps with a PowerShaperRegistration. Power shapers are executed
+// every time the validator set of a consumer chain is computed, so they must be deterministic.
+type PowerShaper interface {
+	// Name returns the name of the step, as listed in the power shaping pipeline of the consumer chains
+	Name() string
+	// ShapePower returns the validators of the consumer chain `consumerId` after the step, given its
+	// power shaping parameters and the validators `validators` resulting from the previous steps.
+	// The step can remove validators, reorder them, or change their power, but it cannot add validators.
+	ShapePower(
+		ctx sdk.Context,
+		consumerId string,
+		powerShapingParameters PowerShapingParameters,
+		validators []ConsensusValidator,
+	) ([]ConsensusValidator, error)
+}
+
+// PowerShaperRegistration inserts the power shaper `Shaper` right after the built-in power shaping step `After`.
+// The power shapers inserted after the same built-in step are executed in registration order.
+type PowerShaperRegistration struct {
+	After  string
+	Shaper PowerShaper
+}
+
+// PowerShaperAnchors are the built-in power shaping steps after which power shapers can be inserted,
+// i.e., the steps that operate on the consumer validators, in evaluation order
+var PowerShaperAnchors = []string{
+	PowerShapingStepEligibility,
+	PowerShapingStepPrioritylist,
+	PowerShapingStepValidatorSetCap,
+	PowerShapingStepValidatorsPowerCap,
+}
+
+// ValidatePowerShaperRegistrations returns an error if a power shaper is inserted after an unknown built-in step
+// or if the names of the power shapers are empty, not unique, or the names of built-in steps
+func ValidatePowerShaperRegistrations(registrations []PowerShaperRegistration) error {
+	names := map[string]bool{
+		PowerShapingStepTopNOptIn:          true,
+		PowerShapingStepActiveValidators:   true,
+		PowerShapingStepEligibility:        true,
+		PowerShapingStepPrioritylist:       true,
+		PowerShapingStepValidatorSetCap:    true,
+		PowerShapingStepValidatorsPowerCap: true,
+	}
+	for _, registration := range registrations {
+		if registration.Shaper == nil {
+			return fmt.Errorf("nil power shaper after step %s", registration.After)
+		}
+		if !isPowerShaperAnchor(registration.After) {
+			return fmt.Errorf("power shaper %s cannot be inserted after step %s; expected one of %v",
+				registration.Shaper.Name(), registration.After, PowerShaperAnchors)
+		}
+		name := registration.Shaper.Name()
+		if name == "" {
+			return fmt.Errorf("power shaper after step %s has an empty name", registration.After)
+		}
+		if names[name] {
+			return fmt.Errorf("duplicate power shaping step name: %s", name)
+		}
+		names[name] = true
+	}
+	return nil
+}
+
+func isPowerShaperAnchor(step string) bool {
+	for _, anchor := range PowerShaperAnchors {
+		if step == anchor {
+			return true
+		}
+	}
+	return false
+}
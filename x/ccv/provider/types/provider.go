@@ -2,15 +2,38 @@ package types
 
 import (
 	"context"
+	"fmt"
+	"sort"
 	"time"
 
 	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
 
 	"cosmossdk.io/math"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
 )
 
+// Names of the power shaping steps applied to compute the validator set of a consumer chain,
+// listed in evaluation order
+const (
+	// PowerShapingStepTopNOptIn automatically opts in the validators in the top N of a Top N chain
+	PowerShapingStepTopNOptIn = "top_n_opt_in"
+	// PowerShapingStepActiveValidators removes the validators that do not participate in the consensus of the provider,
+	// unless the chain allows inactive validators
+	PowerShapingStepActiveValidators = "active_validators"
+	// PowerShapingStepEligibility removes the validators that are not opted in, that are not allowlisted,
+	// that are denylisted, or that do not fulfill the minimal stake
+	PowerShapingStepEligibility = "eligibility"
+	// PowerShapingStepPrioritylist gives priority to the prioritylisted validators
+	PowerShapingStepPrioritylist = "prioritylist"
+	// PowerShapingStepValidatorSetCap removes the validators beyond the validator set cap
+	PowerShapingStepValidatorSetCap = "validator_set_cap"
+	// PowerShapingStepValidatorsPowerCap caps the power of the validators
+	PowerShapingStepValidatorsPowerCap = "validators_power_cap"
+)
+
 func DefaultConsumerInitializationParameters() ConsumerInitializationParameters {
 	return ConsumerInitializationParameters{
 		InitialHeight: clienttypes.Height{
@@ -30,7 +53,57 @@ func DefaultConsumerInitializationParameters() ConsumerInitializationParameters
 	}
 }
 
-func DefaultConsumerInfractionParameters(ctx context.Context, slashingKeeper ccv.SlashingKeeper) (InfractionParameters, error) {
+// InitializationParametersProfile is the name of a set of consumer initialization parameters
+// tailored to a kind of network
+type InitializationParametersProfile string
+
+const (
+	// InitParamsProfileMainnetConservative uses the default initialization parameters,
+	// i.e., an unbonding period of (almost) three weeks
+	InitParamsProfileMainnetConservative InitializationParametersProfile = "mainnet-conservative"
+	// InitParamsProfileTestnetFast uses short periods suited for public test networks
+	InitParamsProfileTestnetFast InitializationParametersProfile = "testnet-fast"
+	// InitParamsProfileDevnetInstant uses periods of a few minutes suited for local development networks
+	InitParamsProfileDevnetInstant InitializationParametersProfile = "devnet-instant"
+)
+
+// InitializationParametersProfiles returns the names of all the initialization parameters profiles, sorted
+func InitializationParametersProfiles() []string {
+	profiles := []string{
+		string(InitParamsProfileMainnetConservative),
+		string(InitParamsProfileTestnetFast),
+		string(InitParamsProfileDevnetInstant),
+	}
+	sort.Strings(profiles)
+	return profiles
+}
+
+// ConsumerInitializationParametersForProfile returns the default consumer initialization parameters
+// with the periods, distribution and historical entries settings of the given profile
+func ConsumerInitializationParametersForProfile(profile InitializationParametersProfile) (ConsumerInitializationParameters, error) {
+	initializationParameters := DefaultConsumerInitializationParameters()
+	switch profile {
+	case InitParamsProfileMainnetConservative:
+	case InitParamsProfileTestnetFast:
+		initializationParameters.UnbondingPeriod = 2 * 24 * time.Hour
+		initializationParameters.CcvTimeoutPeriod = 4 * 24 * time.Hour
+		initializationParameters.TransferTimeoutPeriod = 10 * time.Minute
+		initializationParameters.BlocksPerDistributionTransmission = 100
+		initializationParameters.HistoricalEntries = 1000
+	case InitParamsProfileDevnetInstant:
+		initializationParameters.UnbondingPeriod = 5 * time.Minute
+		initializationParameters.CcvTimeoutPeriod = 10 * time.Minute
+		initializationParameters.TransferTimeoutPeriod = time.Minute
+		initializationParameters.BlocksPerDistributionTransmission = 10
+		initializationParameters.HistoricalEntries = 100
+	default:
+		return ConsumerInitializationParameters{}, fmt.Errorf("unknown initialization parameters profile %q, expected one of %v",
+			profile, InitializationParametersProfiles())
+	}
+	return initializationParameters, nil
+}
+
+func DefaultConsumerInfractionParameters(ctx context.Context, slashingKeeper ccv.ProviderSlashingKeeper) (InfractionParameters, error) {
 	jailDuration, err := slashingKeeper.DowntimeJailDuration(ctx)
 	if err != nil {
 		return InfractionParameters{}, err
@@ -54,3 +127,14 @@ func DefaultConsumerInfractionParameters(ctx context.Context, slashingKeeper ccv
 		},
 	}, nil
 }
+
+// IsInflow returns true if the fund flow type transfers funds into a CCV module account
+func (t FundFlowType) IsInflow() bool {
+	return t == FUND_FLOW_TYPE_CONSUMER_REWARDS
+}
+
+// ConsumerRewardsPoolAddress returns the address of the provider module account receiving
+// the ICS rewards of all the consumer chains
+func ConsumerRewardsPoolAddress() sdk.AccAddress {
+	return ccv.ModuleAddress(ConsumerRewardsPool)
+}
@@ -57,6 +57,9 @@ const (
 	CONSUMER_PHASE_STOPPED ConsumerPhase = 4
 	// DELETED defines the phase in which the state of a stopped chain has been deleted.
 	CONSUMER_PHASE_DELETED ConsumerPhase = 5
+	// PAUSED defines the phase in which a launched consumer chain is running, but the provider
+	// does not send it VSC packets until the chain is resumed.
+	CONSUMER_PHASE_PAUSED ConsumerPhase = 6
 )
 
 var ConsumerPhase_name = map[int32]string{
@@ -66,6 +69,7 @@ var ConsumerPhase_name = map[int32]string{
 	3: "CONSUMER_PHASE_LAUNCHED",
 	4: "CONSUMER_PHASE_STOPPED",
 	5: "CONSUMER_PHASE_DELETED",
+	6: "CONSUMER_PHASE_PAUSED",
 }
 
 var ConsumerPhase_value = map[string]int32{
@@ -75,6 +79,7 @@ var ConsumerPhase_value = map[string]int32{
 	"CONSUMER_PHASE_LAUNCHED":    3,
 	"CONSUMER_PHASE_STOPPED":     4,
 	"CONSUMER_PHASE_DELETED":     5,
+	"CONSUMER_PHASE_PAUSED":      6,
 }
 
 func (x ConsumerPhase) String() string {
@@ -85,6 +90,43 @@ func (ConsumerPhase) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_f22ec409a72b7b72, []int{0}
 }
 
+// FundFlowType indicates the type of a transfer of funds in or out of a CCV module account
+type FundFlowType int32
+
+const (
+	// UNSPECIFIED defines an empty type.
+	FUND_FLOW_TYPE_UNSPECIFIED FundFlowType = 0
+	// CONSUMER_REWARDS defines ICS rewards received by the consumer rewards pool from a consumer chain (inflow).
+	FUND_FLOW_TYPE_CONSUMER_REWARDS FundFlowType = 1
+	// VALIDATOR_REWARDS defines ICS rewards sent from the consumer rewards pool to the distribution module
+	// account to be allocated to the validators of a consumer chain (outflow).
+	FUND_FLOW_TYPE_VALIDATOR_REWARDS FundFlowType = 2
+	// COMMUNITY_POOL defines ICS rewards sent from the consumer rewards pool to the community pool (outflow).
+	FUND_FLOW_TYPE_COMMUNITY_POOL FundFlowType = 3
+)
+
+var FundFlowType_name = map[int32]string{
+	0: "FUND_FLOW_TYPE_UNSPECIFIED",
+	1: "FUND_FLOW_TYPE_CONSUMER_REWARDS",
+	2: "FUND_FLOW_TYPE_VALIDATOR_REWARDS",
+	3: "FUND_FLOW_TYPE_COMMUNITY_POOL",
+}
+
+var FundFlowType_value = map[string]int32{
+	"FUND_FLOW_TYPE_UNSPECIFIED":       0,
+	"FUND_FLOW_TYPE_CONSUMER_REWARDS":  1,
+	"FUND_FLOW_TYPE_VALIDATOR_REWARDS": 2,
+	"FUND_FLOW_TYPE_COMMUNITY_POOL":    3,
+}
+
+func (x FundFlowType) String() string {
+	return proto.EnumName(FundFlowType_name, int32(x))
+}
+
+func (FundFlowType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{1}
+}
+
 // WARNING: This message is deprecated in favor of `MsgCreateConsumer`.
 // ConsumerAdditionProposal is a governance proposal on the provider chain to
 // spawn a new consumer chain. If it passes, then all validators on the provider
@@ -673,6 +715,47 @@ type Params struct {
 	// The maximal number of validators that will be passed
 	// to the consensus engine on the provider.
 	MaxProviderConsensusValidators int64 `protobuf:"varint,12,opt,name=max_provider_consensus_validators,json=maxProviderConsensusValidators,proto3" json:"max_provider_consensus_validators,omitempty"`
+	// The maximal number of consumer chains that can be launched at the same time.
+	// The cap is enforced when a consumer chain is launched and does not apply to
+	// consumer chains owned by the governance module. Zero means no cap.
+	MaxLaunchedConsumers uint64 `protobuf:"varint,13,opt,name=max_launched_consumers,json=maxLaunchedConsumers,proto3" json:"max_launched_consumers,omitempty"`
+	// The minimal number of Top N consumer chains that a validator can limit its
+	// automatic opt-in to, i.e., the floor of the Top N budget of a validator.
+	MinTopNBudget uint32 `protobuf:"varint,14,opt,name=min_top_n_budget,json=minTopNBudget,proto3" json:"min_top_n_budget,omitempty"`
+	// Whether the changes of the provider validator set caused by the jailing, tombstoning,
+	// slashing, or bonding of validators are sent to the consumer chains immediately,
+	// instead of at the end of the epoch.
+	ImmediateValidatorUpdates bool `protobuf:"varint,15,opt,name=immediate_validator_updates,json=immediateValidatorUpdates,proto3" json:"immediate_validator_updates,omitempty"`
+	// Whether the validators jailed for downtime are removed from the consumer
+	// chains in the block in which they are jailed, instead of at the end of the epoch.
+	ImmediateDowntimeJailing bool `protobuf:"varint,16,opt,name=immediate_downtime_jailing,json=immediateDowntimeJailing,proto3" json:"immediate_downtime_jailing,omitempty"`
+	// The provider emits a warning event in every block in which the client of
+	// a launched consumer chain expires in less than this duration.
+	// Zero disables the warnings.
+	ClientExpiryWarningThreshold time.Duration `protobuf:"bytes,17,opt,name=client_expiry_warning_threshold,json=clientExpiryWarningThreshold,proto3,stdduration" json:"client_expiry_warning_threshold"`
+	// The maximal number of validator updates sent to a consumer chain in one VSC packet.
+	// The validator updates of an epoch that exceed the cap are split into multiple
+	// sequential VSC packets. Zero means no cap.
+	MaxValidatorUpdatesPerPacket uint64 `protobuf:"varint,18,opt,name=max_validator_updates_per_packet,json=maxValidatorUpdatesPerPacket,proto3" json:"max_validator_updates_per_packet,omitempty"`
+	// The number of epochs after which an assigned consumer key that is used in the
+	// validator set of a consumer chain, but was never observed in a heartbeat of the
+	// consumer chain, is considered stale. Zero disables the detection of stale keys.
+	StaleKeyAssignmentEpochs int64 `protobuf:"varint,19,opt,name=stale_key_assignment_epochs,json=staleKeyAssignmentEpochs,proto3" json:"stale_key_assignment_epochs,omitempty"`
+	// The number of MsgAssignConsumerKey, MsgOptIn, and MsgOptOut messages per epoch
+	// for which a bonded validator does not pay fees, if the validator pays the fees
+	// of the transaction with its operator account. Zero disables the fee exemptions.
+	ValidatorFeeExemptionsPerEpoch uint64 `protobuf:"varint,20,opt,name=validator_fee_exemptions_per_epoch,json=validatorFeeExemptionsPerEpoch,proto3" json:"validator_fee_exemptions_per_epoch,omitempty"`
+	// The duration after which a launched consumer chain whose CCV channel is closed,
+	// or whose client is expired, is automatically stopped and scheduled for removal.
+	// Zero disables the automatic removal.
+	MaxChannelClosedDuration time.Duration `protobuf:"bytes,21,opt,name=max_channel_closed_duration,json=maxChannelClosedDuration,proto3,stdduration" json:"max_channel_closed_duration"`
+	// The duration of an epoch. If set, an epoch starts in the first block whose
+	// time passes a multiple of the duration, instead of every blocks_per_epoch
+	// blocks. Exactly one of blocks_per_epoch and epoch_duration must be set.
+	EpochDuration time.Duration `protobuf:"bytes,22,opt,name=epoch_duration,json=epochDuration,proto3,stdduration" json:"epoch_duration"`
+	// If true, only the addresses in the allowlist of consumer creators and the
+	// gov module can create consumer chains. If false, anyone can create consumer chains.
+	RestrictConsumerCreation bool `protobuf:"varint,23,opt,name=restrict_consumer_creation,json=restrictConsumerCreation,proto3" json:"restrict_consumer_creation,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -771,6 +854,83 @@ func (m *Params) GetMaxProviderConsensusValidators() int64 {
 	return 0
 }
 
+func (m *Params) GetMaxLaunchedConsumers() uint64 {
+	if m != nil {
+		return m.MaxLaunchedConsumers
+	}
+	return 0
+}
+
+func (m *Params) GetMinTopNBudget() uint32 {
+	if m != nil {
+		return m.MinTopNBudget
+	}
+	return 0
+}
+
+func (m *Params) GetImmediateValidatorUpdates() bool {
+	if m != nil {
+		return m.ImmediateValidatorUpdates
+	}
+	return false
+}
+
+func (m *Params) GetImmediateDowntimeJailing() bool {
+	if m != nil {
+		return m.ImmediateDowntimeJailing
+	}
+	return false
+}
+
+func (m *Params) GetClientExpiryWarningThreshold() time.Duration {
+	if m != nil {
+		return m.ClientExpiryWarningThreshold
+	}
+	return 0
+}
+
+func (m *Params) GetMaxValidatorUpdatesPerPacket() uint64 {
+	if m != nil {
+		return m.MaxValidatorUpdatesPerPacket
+	}
+	return 0
+}
+
+func (m *Params) GetStaleKeyAssignmentEpochs() int64 {
+	if m != nil {
+		return m.StaleKeyAssignmentEpochs
+	}
+	return 0
+}
+
+func (m *Params) GetValidatorFeeExemptionsPerEpoch() uint64 {
+	if m != nil {
+		return m.ValidatorFeeExemptionsPerEpoch
+	}
+	return 0
+}
+
+func (m *Params) GetMaxChannelClosedDuration() time.Duration {
+	if m != nil {
+		return m.MaxChannelClosedDuration
+	}
+	return 0
+}
+
+func (m *Params) GetEpochDuration() time.Duration {
+	if m != nil {
+		return m.EpochDuration
+	}
+	return 0
+}
+
+func (m *Params) GetRestrictConsumerCreation() bool {
+	if m != nil {
+		return m.RestrictConsumerCreation
+	}
+	return false
+}
+
 // SlashAcks contains cons addresses of consumer chain validators
 // successfully slashed on the provider chain.
 type SlashAcks struct {
@@ -1547,6 +1707,15 @@ type ConsumerInitializationParameters struct {
 	// Note that a standalone chain can transition to a consumer chain while
 	// maintaining existing IBC channels to other chains by providing a valid connection_id.
 	ConnectionId string `protobuf:"bytes,12,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// (optional) The trust level of the client that the provider creates for the consumer chain.
+	// If trust_level is not set, the trust level of the template client from the provider params is used.
+	// Note that trust_level must be in the range [1/3, 1] and cannot be lower than the trust level
+	// of the template client.
+	TrustLevel *_07_tendermint.Fraction `protobuf:"bytes,13,opt,name=trust_level,json=trustLevel,proto3" json:"trust_level,omitempty"`
+	// (optional) spawn height is the height on the provider chain at which the consumer chain
+	// genesis is finalized. It is an alternative to spawn_time and at most one of spawn_time
+	// and spawn_height can be set.
+	SpawnHeight uint64 `protobuf:"varint,14,opt,name=spawn_height,json=spawnHeight,proto3" json:"spawn_height,omitempty"`
 }
 
 func (m *ConsumerInitializationParameters) Reset()         { *m = ConsumerInitializationParameters{} }
@@ -1666,6 +1835,20 @@ func (m *ConsumerInitializationParameters) GetConnectionId() string {
 	return ""
 }
 
+func (m *ConsumerInitializationParameters) GetTrustLevel() *_07_tendermint.Fraction {
+	if m != nil {
+		return m.TrustLevel
+	}
+	return nil
+}
+
+func (m *ConsumerInitializationParameters) GetSpawnHeight() uint64 {
+	if m != nil {
+		return m.SpawnHeight
+	}
+	return 0
+}
+
 // PowerShapingParameters contains parameters that shape the validator set that we send to the consumer chain
 type PowerShapingParameters struct {
 	// Corresponds to the percentage of validators that have to validate the chain under the Top N case.
@@ -1718,6 +1901,16 @@ type PowerShapingParameters struct {
 	// filled with these validators first, and other validators will be added to the validator set only if there are
 	// not enough eligible priority validators.
 	Prioritylist []string `protobuf:"bytes,8,rep,name=prioritylist,proto3" json:"prioritylist,omitempty"`
+	// Corresponds to the number of epochs over which the voting powers of the provider validators are averaged
+	// to decide which validators belong to the Top N validators of a Top N chain, i.e., have to validate the chain.
+	// Averaging the voting powers stops validators close to the Top N threshold from flapping in and out of
+	// the mandatory validator set. If set to 0, the voting powers at the beginning of the current epoch are used.
+	// Only applicable to Top N chains.
+	Top_NWeightedEpochs uint32 `protobuf:"varint,9,opt,name=top_N_weighted_epochs,json=topNWeightedEpochs,proto3" json:"top_N_weighted_epochs,omitempty"`
+	// Corresponds to the weights, i.e., the tiers, of validators in the prioritylist. The validator set is filled
+	// with the prioritylisted validators in decreasing order of weight and, for the same weight, in decreasing order
+	// of voting power. The prioritylisted validators without a weight have a weight of 1.
+	PrioritylistWeights []PrioritylistWeight `protobuf:"bytes,10,rep,name=prioritylist_weights,json=prioritylistWeights,proto3" json:"prioritylist_weights"`
 }
 
 func (m *PowerShapingParameters) Reset()         { *m = PowerShapingParameters{} }
@@ -1809,6 +2002,122 @@ func (m *PowerShapingParameters) GetPrioritylist() []string {
 	return nil
 }
 
+func (m *PowerShapingParameters) GetTop_NWeightedEpochs() uint32 {
+	if m != nil {
+		return m.Top_NWeightedEpochs
+	}
+	return 0
+}
+
+func (m *PowerShapingParameters) GetPrioritylistWeights() []PrioritylistWeight {
+	if m != nil {
+		return m.PrioritylistWeights
+	}
+	return nil
+}
+
+// PrioritylistWeight is the weight of a validator in the prioritylist of a consumer chain
+type PrioritylistWeight struct {
+	// the consensus address of the validator on the provider chain, which has to be in the prioritylist
+	ProviderAddress string `protobuf:"bytes,1,opt,name=provider_address,json=providerAddress,proto3" json:"provider_address,omitempty"`
+	// the weight of the validator, which has to be positive; validators with larger weights are
+	// considered first when filling the validator set
+	Weight uint32 `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (m *PrioritylistWeight) Reset()         { *m = PrioritylistWeight{} }
+func (m *PrioritylistWeight) String() string { return proto.CompactTextString(m) }
+func (*PrioritylistWeight) ProtoMessage()    {}
+func (*PrioritylistWeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{22}
+}
+func (m *PrioritylistWeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PrioritylistWeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PrioritylistWeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PrioritylistWeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrioritylistWeight.Merge(m, src)
+}
+func (m *PrioritylistWeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *PrioritylistWeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrioritylistWeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrioritylistWeight proto.InternalMessageInfo
+
+func (m *PrioritylistWeight) GetProviderAddress() string {
+	if m != nil {
+		return m.ProviderAddress
+	}
+	return ""
+}
+
+func (m *PrioritylistWeight) GetWeight() uint32 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
+// ValidatorPowerHistory contains the voting powers of a provider validator at the beginning of the last epochs,
+// in chronological order, where the power of a validator that is not active in an epoch is zero
+type ValidatorPowerHistory struct {
+	Powers []int64 `protobuf:"varint,1,rep,packed,name=powers,proto3" json:"powers,omitempty"`
+}
+
+func (m *ValidatorPowerHistory) Reset()         { *m = ValidatorPowerHistory{} }
+func (m *ValidatorPowerHistory) String() string { return proto.CompactTextString(m) }
+func (*ValidatorPowerHistory) ProtoMessage()    {}
+func (*ValidatorPowerHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{23}
+}
+func (m *ValidatorPowerHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorPowerHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorPowerHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorPowerHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorPowerHistory.Merge(m, src)
+}
+func (m *ValidatorPowerHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorPowerHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorPowerHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorPowerHistory proto.InternalMessageInfo
+
+func (m *ValidatorPowerHistory) GetPowers() []int64 {
+	if m != nil {
+		return m.Powers
+	}
+	return nil
+}
+
 // ConsumerIds contains consumer ids of chains
 // Used so we can easily (de)serialize slices of strings
 type ConsumerIds struct {
@@ -1819,7 +2128,7 @@ func (m *ConsumerIds) Reset()         { *m = ConsumerIds{} }
 func (m *ConsumerIds) String() string { return proto.CompactTextString(m) }
 func (*ConsumerIds) ProtoMessage()    {}
 func (*ConsumerIds) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f22ec409a72b7b72, []int{22}
+	return fileDescriptor_f22ec409a72b7b72, []int{24}
 }
 func (m *ConsumerIds) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1864,7 +2173,7 @@ func (m *AllowlistedRewardDenoms) Reset()         { *m = AllowlistedRewardDenoms
 func (m *AllowlistedRewardDenoms) String() string { return proto.CompactTextString(m) }
 func (*AllowlistedRewardDenoms) ProtoMessage()    {}
 func (*AllowlistedRewardDenoms) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f22ec409a72b7b72, []int{23}
+	return fileDescriptor_f22ec409a72b7b72, []int{25}
 }
 func (m *AllowlistedRewardDenoms) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1909,7 +2218,7 @@ func (m *InfractionParameters) Reset()         { *m = InfractionParameters{} }
 func (m *InfractionParameters) String() string { return proto.CompactTextString(m) }
 func (*InfractionParameters) ProtoMessage()    {}
 func (*InfractionParameters) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f22ec409a72b7b72, []int{24}
+	return fileDescriptor_f22ec409a72b7b72, []int{26}
 }
 func (m *InfractionParameters) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1964,7 +2273,7 @@ func (m *SlashJailParameters) Reset()         { *m = SlashJailParameters{} }
 func (m *SlashJailParameters) String() string { return proto.CompactTextString(m) }
 func (*SlashJailParameters) ProtoMessage()    {}
 func (*SlashJailParameters) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f22ec409a72b7b72, []int{25}
+	return fileDescriptor_f22ec409a72b7b72, []int{27}
 }
 func (m *SlashJailParameters) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2007,1417 +2316,1755 @@ func (m *SlashJailParameters) GetTombstone() bool {
 	return false
 }
 
-func init() {
-	proto.RegisterEnum("interchain_security.ccv.provider.v1.ConsumerPhase", ConsumerPhase_name, ConsumerPhase_value)
-	proto.RegisterType((*ConsumerAdditionProposal)(nil), "interchain_security.ccv.provider.v1.ConsumerAdditionProposal")
-	proto.RegisterType((*ConsumerRemovalProposal)(nil), "interchain_security.ccv.provider.v1.ConsumerRemovalProposal")
-	proto.RegisterType((*ConsumerModificationProposal)(nil), "interchain_security.ccv.provider.v1.ConsumerModificationProposal")
-	proto.RegisterType((*EquivocationProposal)(nil), "interchain_security.ccv.provider.v1.EquivocationProposal")
-	proto.RegisterType((*ChangeRewardDenomsProposal)(nil), "interchain_security.ccv.provider.v1.ChangeRewardDenomsProposal")
-	proto.RegisterType((*GlobalSlashEntry)(nil), "interchain_security.ccv.provider.v1.GlobalSlashEntry")
-	proto.RegisterType((*Params)(nil), "interchain_security.ccv.provider.v1.Params")
-	proto.RegisterType((*SlashAcks)(nil), "interchain_security.ccv.provider.v1.SlashAcks")
-	proto.RegisterType((*ConsumerAdditionProposals)(nil), "interchain_security.ccv.provider.v1.ConsumerAdditionProposals")
-	proto.RegisterType((*ConsumerRemovalProposals)(nil), "interchain_security.ccv.provider.v1.ConsumerRemovalProposals")
-	proto.RegisterType((*AddressList)(nil), "interchain_security.ccv.provider.v1.AddressList")
-	proto.RegisterType((*ChannelToChain)(nil), "interchain_security.ccv.provider.v1.ChannelToChain")
-	proto.RegisterType((*ValidatorSetChangePackets)(nil), "interchain_security.ccv.provider.v1.ValidatorSetChangePackets")
-	proto.RegisterType((*KeyAssignmentReplacement)(nil), "interchain_security.ccv.provider.v1.KeyAssignmentReplacement")
-	proto.RegisterType((*ValidatorConsumerPubKey)(nil), "interchain_security.ccv.provider.v1.ValidatorConsumerPubKey")
-	proto.RegisterType((*ValidatorByConsumerAddr)(nil), "interchain_security.ccv.provider.v1.ValidatorByConsumerAddr")
-	proto.RegisterType((*ConsumerAddrsToPruneV2)(nil), "interchain_security.ccv.provider.v1.ConsumerAddrsToPruneV2")
-	proto.RegisterType((*ConsensusValidator)(nil), "interchain_security.ccv.provider.v1.ConsensusValidator")
-	proto.RegisterType((*ConsumerRewardsAllocation)(nil), "interchain_security.ccv.provider.v1.ConsumerRewardsAllocation")
-	proto.RegisterType((*ConsumerMetadata)(nil), "interchain_security.ccv.provider.v1.ConsumerMetadata")
-	proto.RegisterType((*ConsumerInitializationParameters)(nil), "interchain_security.ccv.provider.v1.ConsumerInitializationParameters")
-	proto.RegisterType((*PowerShapingParameters)(nil), "interchain_security.ccv.provider.v1.PowerShapingParameters")
-	proto.RegisterType((*ConsumerIds)(nil), "interchain_security.ccv.provider.v1.ConsumerIds")
-	proto.RegisterType((*AllowlistedRewardDenoms)(nil), "interchain_security.ccv.provider.v1.AllowlistedRewardDenoms")
-	proto.RegisterType((*InfractionParameters)(nil), "interchain_security.ccv.provider.v1.InfractionParameters")
-	proto.RegisterType((*SlashJailParameters)(nil), "interchain_security.ccv.provider.v1.SlashJailParameters")
+// ThrottlingParameters contains the parameters of the slash meter throttling the slash packets of a consumer chain.
+// A consumer chain without a slash meter replenish fraction shares the global slash meter of the provider chain.
+type ThrottlingParameters struct {
+	// the fraction of the total voting power that is replenished to the slash meter of the consumer chain
+	// every replenish period, which also serves as the maximum value of the meter;
+	// if empty, the slash packets of the consumer chain are throttled by the global slash meter
+	SlashMeterReplenishFraction string `protobuf:"bytes,1,opt,name=slash_meter_replenish_fraction,json=slashMeterReplenishFraction,proto3" json:"slash_meter_replenish_fraction,omitempty"`
+	// the period after which the slash meter of the consumer chain is replenished;
+	// if zero, the slash meter replenish period of the provider chain is used
+	SlashMeterReplenishPeriod time.Duration `protobuf:"bytes,2,opt,name=slash_meter_replenish_period,json=slashMeterReplenishPeriod,proto3,stdduration" json:"slash_meter_replenish_period"`
 }
 
-func init() {
-	proto.RegisterFile("interchain_security/ccv/provider/v1/provider.proto", fileDescriptor_f22ec409a72b7b72)
+func (m *ThrottlingParameters) Reset()         { *m = ThrottlingParameters{} }
+func (m *ThrottlingParameters) String() string { return proto.CompactTextString(m) }
+func (*ThrottlingParameters) ProtoMessage()    {}
+func (*ThrottlingParameters) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{28}
+}
+func (m *ThrottlingParameters) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ThrottlingParameters) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ThrottlingParameters.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ThrottlingParameters) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ThrottlingParameters.Merge(m, src)
+}
+func (m *ThrottlingParameters) XXX_Size() int {
+	return m.Size()
+}
+func (m *ThrottlingParameters) XXX_DiscardUnknown() {
+	xxx_messageInfo_ThrottlingParameters.DiscardUnknown(m)
 }
 
-var fileDescriptor_f22ec409a72b7b72 = []byte{
-	// 2443 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x19, 0x4b, 0x6f, 0x1b, 0xc7,
-	0x59, 0x2b, 0x52, 0x12, 0x39, 0xd4, 0x83, 0x1a, 0x29, 0x32, 0x25, 0x2b, 0x24, 0xbd, 0x69, 0x02,
-	0x35, 0xae, 0xc9, 0x48, 0x01, 0x5a, 0xc3, 0x6d, 0x10, 0x50, 0x24, 0x63, 0xd1, 0x0f, 0x99, 0x5d,
-	0xd2, 0x0a, 0xea, 0xa2, 0x58, 0x0c, 0x77, 0x47, 0xe4, 0x44, 0xbb, 0x3b, 0xeb, 0x9d, 0x21, 0x65,
-	0xf6, 0xd0, 0x73, 0x2e, 0x05, 0xd2, 0x5b, 0xd0, 0x4b, 0x03, 0xf4, 0x52, 0xf4, 0xd2, 0x1e, 0x82,
-	0xfc, 0x80, 0x5e, 0x9a, 0x16, 0x28, 0x90, 0xf6, 0x54, 0x14, 0x85, 0x53, 0xd8, 0x87, 0x1e, 0x0a,
-	0xb4, 0xe7, 0xde, 0x8a, 0x99, 0x7d, 0x70, 0xa9, 0x97, 0x69, 0xd8, 0xee, 0x45, 0xda, 0xf9, 0x5e,
-	0xf3, 0x7d, 0x33, 0xdf, 0x73, 0x08, 0x76, 0x88, 0xc3, 0xb1, 0x67, 0xf4, 0x10, 0x71, 0x74, 0x86,
-	0x8d, 0xbe, 0x47, 0xf8, 0xb0, 0x6c, 0x18, 0x83, 0xb2, 0xeb, 0xd1, 0x01, 0x31, 0xb1, 0x57, 0x1e,
-	0x6c, 0x47, 0xdf, 0x25, 0xd7, 0xa3, 0x9c, 0xc2, 0x37, 0xce, 0xe0, 0x29, 0x19, 0xc6, 0xa0, 0x14,
-	0xd1, 0x0d, 0xb6, 0x37, 0x96, 0x91, 0x4d, 0x1c, 0x5a, 0x96, 0x7f, 0x7d, 0xbe, 0x8d, 0xbc, 0x41,
-	0x99, 0x4d, 0x59, 0xb9, 0x83, 0x18, 0x2e, 0x0f, 0xb6, 0x3b, 0x98, 0xa3, 0xed, 0xb2, 0x41, 0x89,
-	0x13, 0xe0, 0xdf, 0x0a, 0xf0, 0x58, 0x08, 0x71, 0x8c, 0x11, 0x4d, 0x08, 0x08, 0xe8, 0xd6, 0x7d,
-	0x3a, 0x5d, 0xae, 0xca, 0xfe, 0x22, 0x40, 0xad, 0x76, 0x69, 0x97, 0xfa, 0x70, 0xf1, 0x15, 0x6e,
-	0xdc, 0xa5, 0xb4, 0x6b, 0xe1, 0xb2, 0x5c, 0x75, 0xfa, 0x87, 0x65, 0xb3, 0xef, 0x21, 0x4e, 0x68,
-	0xb8, 0x71, 0xe1, 0x24, 0x9e, 0x13, 0x1b, 0x33, 0x8e, 0x6c, 0x37, 0x24, 0x20, 0x1d, 0xa3, 0x6c,
-	0x50, 0x0f, 0x97, 0x0d, 0x8b, 0x60, 0x87, 0x8b, 0x43, 0xf1, 0xbf, 0x02, 0x82, 0xb2, 0x20, 0xb0,
-	0x48, 0xb7, 0xc7, 0x7d, 0x30, 0x2b, 0x73, 0xec, 0x98, 0xd8, 0xb3, 0x89, 0x4f, 0x3c, 0x5a, 0x05,
-	0x0c, 0x6f, 0x9e, 0x77, 0xee, 0x83, 0xed, 0xf2, 0x31, 0xf1, 0x42, 0x53, 0x37, 0x63, 0x62, 0x0c,
-	0x6f, 0xe8, 0x72, 0x5a, 0x3e, 0xc2, 0xc3, 0xc0, 0x5a, 0xf5, 0xbf, 0x29, 0x90, 0xab, 0x52, 0x87,
-	0xf5, 0x6d, 0xec, 0x55, 0x4c, 0x93, 0x08, 0x93, 0x9a, 0x1e, 0x75, 0x29, 0x43, 0x16, 0x5c, 0x05,
-	0x33, 0x9c, 0x70, 0x0b, 0xe7, 0x94, 0xa2, 0xb2, 0x95, 0xd6, 0xfc, 0x05, 0x2c, 0x82, 0x8c, 0x89,
-	0x99, 0xe1, 0x11, 0x57, 0x10, 0xe7, 0xa6, 0x25, 0x2e, 0x0e, 0x82, 0xeb, 0x20, 0xe5, 0xab, 0x45,
-	0xcc, 0x5c, 0x42, 0xa2, 0xe7, 0xe4, 0xba, 0x61, 0xc2, 0x9b, 0x60, 0x91, 0x38, 0x84, 0x13, 0x64,
-	0xe9, 0x3d, 0x2c, 0x8c, 0xcd, 0x25, 0x8b, 0xca, 0x56, 0x66, 0x67, 0xa3, 0x44, 0x3a, 0x46, 0x49,
-	0x9c, 0x4f, 0x29, 0x38, 0x95, 0xc1, 0x76, 0x69, 0x4f, 0x52, 0xec, 0x26, 0xbf, 0x7c, 0x5c, 0x98,
-	0xd2, 0x16, 0x02, 0x3e, 0x1f, 0x08, 0xaf, 0x80, 0xf9, 0x2e, 0x76, 0x30, 0x23, 0x4c, 0xef, 0x21,
-	0xd6, 0xcb, 0xcd, 0x14, 0x95, 0xad, 0x79, 0x2d, 0x13, 0xc0, 0xf6, 0x10, 0xeb, 0xc1, 0x02, 0xc8,
-	0x74, 0x88, 0x83, 0xbc, 0xa1, 0x4f, 0x31, 0x2b, 0x29, 0x80, 0x0f, 0x92, 0x04, 0x55, 0x00, 0x98,
-	0x8b, 0x8e, 0x1d, 0x5d, 0x5c, 0x56, 0x6e, 0x2e, 0x50, 0xc4, 0xbf, 0xc9, 0x52, 0x78, 0x93, 0xa5,
-	0x76, 0x78, 0x93, 0xbb, 0x29, 0xa1, 0xc8, 0x27, 0x5f, 0x17, 0x14, 0x2d, 0x2d, 0xf9, 0x04, 0x06,
-	0xee, 0x83, 0x6c, 0xdf, 0xe9, 0x50, 0xc7, 0x24, 0x4e, 0x57, 0x77, 0xb1, 0x47, 0xa8, 0x99, 0x4b,
-	0x49, 0x51, 0xeb, 0xa7, 0x44, 0xd5, 0x02, 0xa7, 0xf1, 0x25, 0x7d, 0x2a, 0x24, 0x2d, 0x45, 0xcc,
-	0x4d, 0xc9, 0x0b, 0xbf, 0x0f, 0xa0, 0x61, 0x0c, 0xa4, 0x4a, 0xb4, 0xcf, 0x43, 0x89, 0xe9, 0xc9,
-	0x25, 0x66, 0x0d, 0x63, 0xd0, 0xf6, 0xb9, 0x03, 0x91, 0x3f, 0x04, 0x97, 0xb8, 0x87, 0x1c, 0x76,
-	0x88, 0xbd, 0x93, 0x72, 0xc1, 0xe4, 0x72, 0x5f, 0x0b, 0x65, 0x8c, 0x0b, 0xdf, 0x03, 0x45, 0x23,
-	0x70, 0x20, 0xdd, 0xc3, 0x26, 0x61, 0xdc, 0x23, 0x9d, 0xbe, 0xe0, 0xd5, 0x0f, 0x3d, 0x64, 0x48,
-	0x1f, 0xc9, 0x48, 0x27, 0xc8, 0x87, 0x74, 0xda, 0x18, 0xd9, 0x07, 0x01, 0x15, 0xbc, 0x07, 0xbe,
-	0xd1, 0xb1, 0xa8, 0x71, 0xc4, 0x84, 0x72, 0xfa, 0x98, 0x24, 0xb9, 0xb5, 0x4d, 0x18, 0x13, 0xd2,
-	0xe6, 0x8b, 0xca, 0x56, 0x42, 0xbb, 0xe2, 0xd3, 0x36, 0xb1, 0x57, 0x8b, 0x51, 0xb6, 0x63, 0x84,
-	0xf0, 0x1a, 0x80, 0x3d, 0xc2, 0x38, 0xf5, 0x88, 0x81, 0x2c, 0x1d, 0x3b, 0xdc, 0x23, 0x98, 0xe5,
-	0x16, 0x24, 0xfb, 0xf2, 0x08, 0x53, 0xf7, 0x11, 0xf0, 0x16, 0xb8, 0x72, 0xee, 0xa6, 0xba, 0xd1,
-	0x43, 0x8e, 0x83, 0xad, 0xdc, 0xa2, 0x34, 0xa5, 0x60, 0x9e, 0xb3, 0x67, 0xd5, 0x27, 0x83, 0x2b,
-	0x60, 0x86, 0x53, 0x57, 0xdf, 0xcf, 0x2d, 0x15, 0x95, 0xad, 0x05, 0x2d, 0xc9, 0xa9, 0xbb, 0x0f,
-	0xdf, 0x01, 0xab, 0x03, 0x64, 0x11, 0x13, 0x71, 0xea, 0x31, 0xdd, 0xa5, 0xc7, 0xd8, 0xd3, 0x0d,
-	0xe4, 0xe6, 0xb2, 0x92, 0x06, 0x8e, 0x70, 0x4d, 0x81, 0xaa, 0x22, 0x17, 0xbe, 0x0d, 0x96, 0x23,
-	0xa8, 0xce, 0x30, 0x97, 0xe4, 0xcb, 0x92, 0x7c, 0x29, 0x42, 0xb4, 0x30, 0x17, 0xb4, 0x9b, 0x20,
-	0x8d, 0x2c, 0x8b, 0x1e, 0x5b, 0x84, 0xf1, 0x1c, 0x2c, 0x26, 0xb6, 0xd2, 0xda, 0x08, 0x00, 0x37,
-	0x40, 0xca, 0xc4, 0xce, 0x50, 0x22, 0x57, 0x24, 0x32, 0x5a, 0xc3, 0xcb, 0x20, 0x6d, 0x8b, 0x24,
-	0xc2, 0xd1, 0x11, 0xce, 0xad, 0x16, 0x95, 0xad, 0xa4, 0x96, 0xb2, 0x89, 0xd3, 0x12, 0x6b, 0x58,
-	0x02, 0x2b, 0x52, 0x8a, 0x4e, 0x1c, 0x71, 0x4f, 0x03, 0xac, 0x0f, 0x90, 0xc5, 0x72, 0xaf, 0x15,
-	0x95, 0xad, 0x94, 0xb6, 0x2c, 0x51, 0x8d, 0x00, 0x73, 0x80, 0x2c, 0x76, 0x63, 0xeb, 0xe3, 0xcf,
-	0x0a, 0x53, 0x9f, 0x7e, 0x56, 0x98, 0xfa, 0xe3, 0xe7, 0xd7, 0x36, 0x82, 0xcc, 0xda, 0xa5, 0x83,
-	0x52, 0x90, 0x89, 0x4b, 0x55, 0xea, 0x70, 0xec, 0xf0, 0x9c, 0xa2, 0xfe, 0x59, 0x01, 0x97, 0xaa,
-	0x91, 0x4b, 0xd8, 0x74, 0x80, 0xac, 0x57, 0x99, 0x7a, 0x2a, 0x20, 0xcd, 0xc4, 0x9d, 0xc8, 0x60,
-	0x4f, 0x3e, 0x47, 0xb0, 0xa7, 0x04, 0x9b, 0x40, 0xdc, 0x28, 0x3e, 0xd3, 0xa6, 0xff, 0x4c, 0x83,
-	0xcd, 0xd0, 0xa6, 0xbb, 0xd4, 0x24, 0x87, 0xc4, 0x40, 0xaf, 0x3a, 0xa7, 0x46, 0xbe, 0x96, 0x9c,
-	0xc0, 0xd7, 0x66, 0x9e, 0xcf, 0xd7, 0x66, 0x27, 0xf0, 0xb5, 0xb9, 0x8b, 0x7c, 0x2d, 0x75, 0x91,
-	0xaf, 0xa5, 0x27, 0xf3, 0x35, 0x70, 0x9e, 0xaf, 0x4d, 0xe7, 0x14, 0xf5, 0x17, 0x0a, 0x58, 0xad,
-	0x3f, 0xec, 0x93, 0x01, 0x7d, 0x49, 0x27, 0x7d, 0x1b, 0x2c, 0xe0, 0x98, 0x3c, 0x96, 0x4b, 0x14,
-	0x13, 0x5b, 0x99, 0x9d, 0x37, 0x4b, 0xc1, 0xc5, 0x47, 0xad, 0x44, 0x78, 0xfb, 0xf1, 0xdd, 0xb5,
-	0x71, 0x5e, 0xa9, 0xe1, 0xef, 0x14, 0xb0, 0x21, 0xf2, 0x42, 0x17, 0x6b, 0xf8, 0x18, 0x79, 0x66,
-	0x0d, 0x3b, 0xd4, 0x66, 0x2f, 0xac, 0xa7, 0x0a, 0x16, 0x4c, 0x29, 0x49, 0xe7, 0x54, 0x47, 0xa6,
-	0x29, 0xf5, 0x94, 0x34, 0x02, 0xd8, 0xa6, 0x15, 0xd3, 0x84, 0x5b, 0x20, 0x3b, 0xa2, 0xf1, 0x44,
-	0x8c, 0x09, 0xd7, 0x17, 0x64, 0x8b, 0x21, 0x99, 0x8c, 0x3c, 0x7c, 0x23, 0x7f, 0xb1, 0x6b, 0xab,
-	0xff, 0x52, 0x40, 0xf6, 0xa6, 0x45, 0x3b, 0xc8, 0x6a, 0x59, 0x88, 0xf5, 0x44, 0xce, 0x1c, 0x8a,
-	0x90, 0xf2, 0x70, 0x50, 0xac, 0xa4, 0xfa, 0x13, 0x87, 0x94, 0x60, 0x93, 0xe5, 0xf3, 0x7d, 0xb0,
-	0x1c, 0x95, 0x8f, 0xc8, 0xc1, 0xa5, 0xb5, 0xbb, 0x2b, 0x4f, 0x1e, 0x17, 0x96, 0xc2, 0x60, 0xaa,
-	0x4a, 0x67, 0xaf, 0x69, 0x4b, 0xc6, 0x18, 0xc0, 0x84, 0x79, 0x90, 0x21, 0x1d, 0x43, 0x67, 0xf8,
-	0xa1, 0xee, 0xf4, 0x6d, 0x19, 0x1b, 0x49, 0x2d, 0x4d, 0x3a, 0x46, 0x0b, 0x3f, 0xdc, 0xef, 0xdb,
-	0xf0, 0x5d, 0xb0, 0x16, 0x36, 0x95, 0xc2, 0x9b, 0x74, 0xc1, 0x2f, 0x8e, 0xcb, 0x93, 0xe1, 0x32,
-	0xaf, 0xad, 0x84, 0xd8, 0x03, 0x64, 0x89, 0xcd, 0x2a, 0xa6, 0xe9, 0xa9, 0xff, 0x9e, 0x01, 0xb3,
-	0x4d, 0xe4, 0x21, 0x9b, 0xc1, 0x36, 0x58, 0xe2, 0xd8, 0x76, 0x2d, 0xc4, 0xb1, 0xee, 0xb7, 0x26,
-	0x81, 0xa5, 0x57, 0x65, 0xcb, 0x12, 0xef, 0xd8, 0x4a, 0xb1, 0x1e, 0x6d, 0xb0, 0x5d, 0xaa, 0x4a,
-	0x68, 0x8b, 0x23, 0x8e, 0xb5, 0xc5, 0x50, 0x86, 0x0f, 0x84, 0xd7, 0x41, 0x8e, 0x7b, 0x7d, 0xc6,
-	0x47, 0x4d, 0xc3, 0xa8, 0x5a, 0xfa, 0x77, 0xbd, 0x16, 0xe2, 0xfd, 0x3a, 0x1b, 0x55, 0xc9, 0xb3,
-	0xfb, 0x83, 0xc4, 0x8b, 0xf4, 0x07, 0x26, 0xd8, 0x64, 0xe2, 0x52, 0x75, 0x1b, 0x73, 0x59, 0xc5,
-	0x5d, 0x0b, 0x3b, 0x84, 0xf5, 0x42, 0xe1, 0xb3, 0x93, 0x0b, 0x5f, 0x97, 0x82, 0xee, 0x0a, 0x39,
-	0x5a, 0x28, 0x26, 0xd8, 0xa5, 0x0a, 0xf2, 0x67, 0xef, 0x12, 0x19, 0x3e, 0x27, 0x0d, 0xbf, 0x7c,
-	0x86, 0x88, 0xc8, 0x7a, 0x06, 0xde, 0x8a, 0x75, 0x1b, 0x22, 0x9a, 0x74, 0xe9, 0xc8, 0xba, 0x87,
-	0xbb, 0xa2, 0x24, 0x23, 0xbf, 0xf1, 0xc0, 0x38, 0xea, 0x98, 0x02, 0x9f, 0x16, 0x13, 0x43, 0xcc,
-	0xa9, 0x89, 0x13, 0xb4, 0x95, 0xea, 0xa8, 0x29, 0x89, 0x62, 0x53, 0x8b, 0xc9, 0xfa, 0x00, 0x63,
-	0x11, 0x45, 0xb1, 0xc6, 0x04, 0xbb, 0xd4, 0xe8, 0xc9, 0x9c, 0x94, 0xd0, 0x16, 0xa3, 0x26, 0xa4,
-	0x2e, 0xa0, 0xf0, 0x01, 0xb8, 0xea, 0xf4, 0xed, 0x0e, 0xf6, 0x74, 0x7a, 0xe8, 0x13, 0xca, 0xc8,
-	0x63, 0x1c, 0x79, 0x5c, 0xf7, 0xb0, 0x81, 0xc9, 0x40, 0xdc, 0xb8, 0xaf, 0x39, 0x93, 0x7d, 0x51,
-	0x42, 0x7b, 0xd3, 0x67, 0xb9, 0x77, 0x28, 0x65, 0xb0, 0x36, 0x6d, 0x09, 0x72, 0x2d, 0xa4, 0xf6,
-	0x15, 0x63, 0xb0, 0x01, 0xae, 0xd8, 0xe8, 0x91, 0x1e, 0x39, 0xb3, 0x50, 0x1c, 0x3b, 0xac, 0xcf,
-	0xf4, 0x51, 0x32, 0x0f, 0x7a, 0xa3, 0xbc, 0x8d, 0x1e, 0x35, 0x03, 0xba, 0x6a, 0x48, 0x76, 0x10,
-	0x51, 0xdd, 0x4a, 0xa6, 0x92, 0xd9, 0x99, 0x5b, 0xc9, 0xd4, 0x4c, 0x76, 0xf6, 0x56, 0x32, 0x95,
-	0xca, 0xa6, 0xd5, 0x6f, 0x82, 0xb4, 0x8c, 0xeb, 0x8a, 0x71, 0xc4, 0x64, 0x76, 0x37, 0x4d, 0x0f,
-	0x33, 0x86, 0x59, 0x4e, 0x09, 0xb2, 0x7b, 0x08, 0x50, 0x39, 0x58, 0x3f, 0x6f, 0x62, 0x60, 0xf0,
-	0x43, 0x30, 0xe7, 0x62, 0xd9, 0xce, 0x4a, 0xc6, 0xcc, 0xce, 0x7b, 0xa5, 0x09, 0x46, 0xbd, 0xd2,
-	0x79, 0x02, 0xb5, 0x50, 0x9a, 0xea, 0x8d, 0xe6, 0x94, 0x13, 0xbd, 0x02, 0x83, 0x07, 0x27, 0x37,
-	0xfd, 0xde, 0x73, 0x6d, 0x7a, 0x42, 0xde, 0x68, 0xcf, 0xab, 0x20, 0x53, 0xf1, 0xcd, 0xbe, 0x23,
-	0x4a, 0xd7, 0xa9, 0x63, 0x99, 0x8f, 0x1f, 0xcb, 0x3e, 0x58, 0x0c, 0x9a, 0xbf, 0x36, 0x95, 0xb9,
-	0x09, 0xbe, 0x0e, 0x40, 0xd0, 0x35, 0x8a, 0x9c, 0xe6, 0x67, 0xf7, 0x74, 0x00, 0x69, 0x98, 0x63,
-	0x15, 0x7d, 0x7a, 0xac, 0xa2, 0xcb, 0xaa, 0x41, 0xc1, 0xfa, 0x41, 0xbc, 0xea, 0xca, 0x02, 0xd2,
-	0x44, 0xc6, 0x11, 0xe6, 0x0c, 0x6a, 0x20, 0x29, 0xab, 0xab, 0x6f, 0xee, 0xf5, 0x73, 0xcd, 0x1d,
-	0x6c, 0x97, 0xce, 0x13, 0x52, 0x43, 0x1c, 0x05, 0x31, 0x20, 0x65, 0xa9, 0x3f, 0x53, 0x40, 0xee,
-	0x36, 0x1e, 0x56, 0x18, 0x23, 0x5d, 0xc7, 0xc6, 0x0e, 0x17, 0xd1, 0x87, 0x0c, 0x2c, 0x3e, 0xe1,
-	0x1b, 0x60, 0x21, 0x72, 0x3c, 0x99, 0x3c, 0x15, 0x99, 0x3c, 0xe7, 0x43, 0xa0, 0x38, 0x27, 0x78,
-	0x03, 0x00, 0xd7, 0xc3, 0x03, 0xdd, 0xd0, 0x8f, 0xf0, 0x50, 0xda, 0x94, 0xd9, 0xd9, 0x8c, 0x27,
-	0x45, 0x7f, 0xfe, 0x2c, 0x35, 0xfb, 0x1d, 0x8b, 0x18, 0xb7, 0xf1, 0x50, 0x4b, 0x09, 0xfa, 0xea,
-	0x6d, 0x3c, 0x14, 0x55, 0x50, 0x36, 0x29, 0x32, 0x93, 0x25, 0x34, 0x7f, 0xa1, 0xfe, 0x5c, 0x01,
-	0x97, 0x22, 0x03, 0xc2, 0xfb, 0x6a, 0xf6, 0x3b, 0x82, 0x23, 0x7e, 0x7e, 0xca, 0x78, 0x47, 0x74,
-	0x4a, 0xdb, 0xe9, 0x33, 0xb4, 0x7d, 0x1f, 0xcc, 0x47, 0xa9, 0x44, 0xe8, 0x9b, 0x98, 0x40, 0xdf,
-	0x4c, 0xc8, 0x71, 0x1b, 0x0f, 0xd5, 0x9f, 0xc4, 0x74, 0xdb, 0x1d, 0xc6, 0x5c, 0xd8, 0x7b, 0x86,
-	0x6e, 0xd1, 0xb6, 0x71, 0xdd, 0x8c, 0x38, 0xff, 0x29, 0x03, 0x12, 0xa7, 0x0d, 0x50, 0xff, 0xa4,
-	0x80, 0xb5, 0xf8, 0xae, 0xac, 0x4d, 0x9b, 0x5e, 0xdf, 0xc1, 0x07, 0x3b, 0x17, 0xed, 0xff, 0x3e,
-	0x48, 0xb9, 0x82, 0x4a, 0xe7, 0x2c, 0xb8, 0xa2, 0xc9, 0x4a, 0xf6, 0x9c, 0xe4, 0x6a, 0x8b, 0x10,
-	0x5f, 0x1c, 0x33, 0x80, 0x05, 0x27, 0xf7, 0xce, 0x44, 0x41, 0x17, 0x0b, 0x28, 0x6d, 0x21, 0x6e,
-	0x33, 0x53, 0xbf, 0x50, 0x00, 0x3c, 0x9d, 0xad, 0xe0, 0xb7, 0x00, 0x1c, 0xcb, 0x79, 0x71, 0xff,
-	0xcb, 0xba, 0xb1, 0x2c, 0x27, 0x4f, 0x2e, 0xf2, 0xa3, 0xe9, 0x98, 0x1f, 0xc1, 0xef, 0x02, 0xe0,
-	0xca, 0x4b, 0x9c, 0xf8, 0xa6, 0xd3, 0x6e, 0xf8, 0x09, 0x0b, 0x20, 0xf3, 0x11, 0x25, 0x4e, 0xfc,
-	0xc1, 0x22, 0xa1, 0x01, 0x01, 0xf2, 0xdf, 0x22, 0xd4, 0x9f, 0x2a, 0xa3, 0x94, 0x18, 0x64, 0xeb,
-	0x8a, 0x65, 0x05, 0x3d, 0x20, 0x74, 0xc1, 0x5c, 0x98, 0xef, 0xfd, 0x70, 0xdd, 0x3c, 0xb3, 0x26,
-	0xd5, 0xb0, 0x21, 0xcb, 0xd2, 0x75, 0x71, 0xe2, 0xbf, 0xfe, 0xba, 0x70, 0xb5, 0x4b, 0x78, 0xaf,
-	0xdf, 0x29, 0x19, 0xd4, 0x0e, 0x1e, 0xa8, 0x82, 0x7f, 0xd7, 0x98, 0x79, 0x54, 0xe6, 0x43, 0x17,
-	0xb3, 0x90, 0x87, 0xfd, 0xea, 0x9f, 0xbf, 0x7d, 0x5b, 0xd1, 0xc2, 0x6d, 0x54, 0x13, 0x64, 0xa3,
-	0x19, 0x04, 0x73, 0x64, 0x22, 0x8e, 0x20, 0x04, 0x49, 0x07, 0xd9, 0x61, 0x93, 0x29, 0xbf, 0x27,
-	0xe8, 0x31, 0x37, 0x40, 0xca, 0x0e, 0x24, 0x04, 0x53, 0x47, 0xb4, 0x56, 0x7f, 0x33, 0x0b, 0x8a,
-	0xe1, 0x36, 0x0d, 0xff, 0x6d, 0x86, 0xfc, 0xd8, 0x6f, 0xc1, 0x45, 0xe7, 0x24, 0xea, 0x37, 0x3b,
-	0xe3, 0xbd, 0x47, 0x79, 0x39, 0xef, 0x3d, 0xd3, 0xcf, 0x7c, 0xef, 0x49, 0x3c, 0xe3, 0xbd, 0x27,
-	0xf9, 0xf2, 0xde, 0x7b, 0x66, 0x5e, 0xfa, 0x7b, 0xcf, 0xec, 0x2b, 0x7a, 0xef, 0x99, 0xfb, 0xbf,
-	0xbc, 0xf7, 0xa4, 0x5e, 0xea, 0x7b, 0x4f, 0xfa, 0xc5, 0xde, 0x7b, 0xc0, 0x0b, 0xbd, 0xf7, 0x64,
-	0x26, 0x7b, 0xef, 0xf1, 0xb3, 0xba, 0x83, 0xa5, 0x65, 0x22, 0xeb, 0xce, 0x4b, 0xbe, 0xf9, 0x11,
-	0xb0, 0x61, 0xaa, 0x5f, 0x4c, 0x83, 0x35, 0x39, 0x6e, 0xb7, 0x7a, 0xc8, 0x15, 0x1e, 0x30, 0x8a,
-	0x93, 0x68, 0x86, 0x57, 0x26, 0x98, 0xe1, 0xa7, 0x9f, 0x6f, 0x86, 0x4f, 0x4c, 0x30, 0xc3, 0x27,
-	0x2f, 0x9a, 0xe1, 0x67, 0x2e, 0x9a, 0xe1, 0x67, 0x27, 0x9b, 0xe1, 0xe7, 0xce, 0x99, 0xe1, 0xa1,
-	0x0a, 0xe6, 0x5d, 0x8f, 0x50, 0x51, 0x2c, 0x62, 0x0f, 0x06, 0x63, 0x30, 0xb5, 0x00, 0x32, 0x51,
-	0xa6, 0x31, 0x19, 0xcc, 0x82, 0x04, 0x31, 0xc3, 0xce, 0x54, 0x7c, 0xaa, 0xdb, 0xe0, 0x52, 0x25,
-	0x54, 0x1d, 0x9b, 0xf1, 0x31, 0x1b, 0xae, 0x81, 0x59, 0x7f, 0xd4, 0x0d, 0xe8, 0x83, 0x95, 0xfa,
-	0x7b, 0x05, 0xac, 0x36, 0x9c, 0xd0, 0x65, 0x63, 0x57, 0xf1, 0x03, 0x90, 0x31, 0x69, 0xbf, 0x63,
-	0x61, 0x5d, 0x34, 0x42, 0x41, 0xbe, 0xba, 0x3e, 0x51, 0x71, 0x93, 0x2d, 0xf4, 0x2d, 0x44, 0xac,
-	0x91, 0x38, 0x0d, 0xf8, 0xc2, 0x5a, 0xa4, 0xeb, 0xc0, 0x36, 0x48, 0x99, 0xf4, 0xd8, 0x91, 0xe9,
-	0x67, 0xfa, 0x05, 0xe5, 0x46, 0x92, 0xd4, 0xbf, 0x2b, 0x60, 0xe5, 0x0c, 0x0a, 0xf8, 0x23, 0xb0,
-	0xe8, 0x0f, 0x5c, 0x51, 0x5c, 0xca, 0xa2, 0xb9, 0xfb, 0x6d, 0x11, 0xe2, 0x7f, 0x7b, 0x5c, 0xb8,
-	0xec, 0xd7, 0x13, 0x66, 0x1e, 0x95, 0x08, 0x2d, 0xdb, 0x88, 0xf7, 0x4a, 0x77, 0x70, 0x17, 0x19,
-	0xc3, 0x1a, 0x36, 0xfe, 0xf2, 0xf9, 0x35, 0x10, 0x54, 0xa9, 0x1a, 0x36, 0xfc, 0xfa, 0xb2, 0x20,
-	0xa5, 0x45, 0xe1, 0xbb, 0x07, 0x16, 0x3e, 0x42, 0xc4, 0xd2, 0xc3, 0x5f, 0x42, 0x02, 0x8b, 0x26,
-	0xca, 0x2d, 0xf3, 0x82, 0x33, 0x84, 0x0b, 0x4f, 0xe4, 0xd4, 0xee, 0x30, 0x4e, 0x1d, 0x2c, 0xbd,
-	0x35, 0xa5, 0x8d, 0x00, 0x6f, 0xff, 0x41, 0x01, 0x0b, 0x51, 0xeb, 0xd7, 0x43, 0x0c, 0xc3, 0x3c,
-	0xd8, 0xa8, 0xde, 0xdb, 0x6f, 0xdd, 0xbf, 0x5b, 0xd7, 0xf4, 0xe6, 0x5e, 0xa5, 0x55, 0xd7, 0xef,
-	0xef, 0xb7, 0x9a, 0xf5, 0x6a, 0xe3, 0x83, 0x46, 0xbd, 0x96, 0x9d, 0x82, 0xaf, 0x83, 0xf5, 0x13,
-	0x78, 0xad, 0x7e, 0xb3, 0xd1, 0x6a, 0xd7, 0xb5, 0x7a, 0x2d, 0xab, 0x9c, 0xc1, 0xde, 0xd8, 0x6f,
-	0xb4, 0x1b, 0x95, 0x3b, 0x8d, 0x07, 0xf5, 0x5a, 0x76, 0x1a, 0x5e, 0x06, 0x97, 0x4e, 0xe0, 0xef,
-	0x54, 0xee, 0xef, 0x57, 0xf7, 0xea, 0xb5, 0x6c, 0x02, 0x6e, 0x80, 0xb5, 0x13, 0xc8, 0x56, 0xfb,
-	0x5e, 0xb3, 0x59, 0xaf, 0x65, 0x93, 0x67, 0xe0, 0x6a, 0xf5, 0x3b, 0xf5, 0x76, 0xbd, 0x96, 0x9d,
-	0xd9, 0x48, 0x7e, 0xfc, 0xcb, 0xfc, 0xd4, 0xee, 0x87, 0x5f, 0x3e, 0xc9, 0x2b, 0x5f, 0x3d, 0xc9,
-	0x2b, 0xff, 0x78, 0x92, 0x57, 0x3e, 0x79, 0x9a, 0x9f, 0xfa, 0xea, 0x69, 0x7e, 0xea, 0xaf, 0x4f,
-	0xf3, 0x53, 0x0f, 0xde, 0x3b, 0x5d, 0xee, 0x47, 0x9e, 0x71, 0x2d, 0xfa, 0x7d, 0x67, 0xf0, 0x9d,
-	0xf2, 0xa3, 0xf1, 0x1f, 0xd7, 0x64, 0x27, 0xd0, 0x99, 0x95, 0xa7, 0xfd, 0xee, 0xff, 0x02, 0x00,
-	0x00, 0xff, 0xff, 0xa2, 0x6c, 0x24, 0x24, 0x8d, 0x1b, 0x00, 0x00,
+var xxx_messageInfo_ThrottlingParameters proto.InternalMessageInfo
+
+func (m *ThrottlingParameters) GetSlashMeterReplenishFraction() string {
+	if m != nil {
+		return m.SlashMeterReplenishFraction
+	}
+	return ""
 }
 
-func (m *ConsumerAdditionProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ThrottlingParameters) GetSlashMeterReplenishPeriod() time.Duration {
+	if m != nil {
+		return m.SlashMeterReplenishPeriod
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ConsumerAdditionProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ConsumerInitialConsensusState is the initial consensus state of the client that the provider
+// creates for a consumer chain, as provided by the owner of the consumer chain
+type ConsumerInitialConsensusState struct {
+	// the genesis hash of the consumer chain the consensus state commits to;
+	// it must match the genesis hash in the initialization parameters of the consumer chain
+	GenesisHash []byte `protobuf:"bytes,1,opt,name=genesis_hash,json=genesisHash,proto3" json:"genesis_hash,omitempty"`
+	// the consensus state of the consumer chain at the initial height;
+	// its next validators hash must match the hash of the initial validator set of the consumer chain
+	ConsensusState _07_tendermint.ConsensusState `protobuf:"bytes,2,opt,name=consensus_state,json=consensusState,proto3" json:"consensus_state"`
 }
 
-func (m *ConsumerAdditionProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.AllowInactiveVals {
-		i--
-		if m.AllowInactiveVals {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *ConsumerInitialConsensusState) Reset()         { *m = ConsumerInitialConsensusState{} }
+func (m *ConsumerInitialConsensusState) String() string { return proto.CompactTextString(m) }
+func (*ConsumerInitialConsensusState) ProtoMessage()    {}
+func (*ConsumerInitialConsensusState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{29}
+}
+func (m *ConsumerInitialConsensusState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerInitialConsensusState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerInitialConsensusState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xa8
-	}
-	if m.MinStake != 0 {
-		i = encodeVarintProvider(dAtA, i, uint64(m.MinStake))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xa0
+		return b[:n], nil
 	}
-	if len(m.Denylist) > 0 {
-		for iNdEx := len(m.Denylist) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Denylist[iNdEx])
-			copy(dAtA[i:], m.Denylist[iNdEx])
-			i = encodeVarintProvider(dAtA, i, uint64(len(m.Denylist[iNdEx])))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x9a
-		}
-	}
-	if len(m.Allowlist) > 0 {
-		for iNdEx := len(m.Allowlist) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Allowlist[iNdEx])
-			copy(dAtA[i:], m.Allowlist[iNdEx])
-			i = encodeVarintProvider(dAtA, i, uint64(len(m.Allowlist[iNdEx])))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0x92
-		}
-	}
-	if m.ValidatorSetCap != 0 {
-		i = encodeVarintProvider(dAtA, i, uint64(m.ValidatorSetCap))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x88
-	}
-	if m.ValidatorsPowerCap != 0 {
-		i = encodeVarintProvider(dAtA, i, uint64(m.ValidatorsPowerCap))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x80
-	}
-	if m.Top_N != 0 {
-		i = encodeVarintProvider(dAtA, i, uint64(m.Top_N))
-		i--
-		dAtA[i] = 0x78
-	}
-	if len(m.DistributionTransmissionChannel) > 0 {
-		i -= len(m.DistributionTransmissionChannel)
-		copy(dAtA[i:], m.DistributionTransmissionChannel)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.DistributionTransmissionChannel)))
-		i--
-		dAtA[i] = 0x72
-	}
-	if m.HistoricalEntries != 0 {
-		i = encodeVarintProvider(dAtA, i, uint64(m.HistoricalEntries))
-		i--
-		dAtA[i] = 0x68
+}
+func (m *ConsumerInitialConsensusState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerInitialConsensusState.Merge(m, src)
+}
+func (m *ConsumerInitialConsensusState) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerInitialConsensusState) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerInitialConsensusState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsumerInitialConsensusState proto.InternalMessageInfo
+
+func (m *ConsumerInitialConsensusState) GetGenesisHash() []byte {
+	if m != nil {
+		return m.GenesisHash
 	}
-	if m.BlocksPerDistributionTransmission != 0 {
-		i = encodeVarintProvider(dAtA, i, uint64(m.BlocksPerDistributionTransmission))
-		i--
-		dAtA[i] = 0x60
+	return nil
+}
+
+func (m *ConsumerInitialConsensusState) GetConsensusState() _07_tendermint.ConsensusState {
+	if m != nil {
+		return m.ConsensusState
 	}
-	if len(m.ConsumerRedistributionFraction) > 0 {
-		i -= len(m.ConsumerRedistributionFraction)
-		copy(dAtA[i:], m.ConsumerRedistributionFraction)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.ConsumerRedistributionFraction)))
-		i--
-		dAtA[i] = 0x5a
+	return _07_tendermint.ConsensusState{}
+}
+
+// PowerShapingPipeline is the outcome of the last computation of the validator set of a consumer chain
+type PowerShapingPipeline struct {
+	// the block height at which the validator set was computed
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// the power shaping steps applied to compute the validator set, in evaluation order
+	Steps []PowerShapingStep `protobuf:"bytes,2,rep,name=steps,proto3" json:"steps"`
+}
+
+func (m *PowerShapingPipeline) Reset()         { *m = PowerShapingPipeline{} }
+func (m *PowerShapingPipeline) String() string { return proto.CompactTextString(m) }
+func (*PowerShapingPipeline) ProtoMessage()    {}
+func (*PowerShapingPipeline) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{30}
+}
+func (m *PowerShapingPipeline) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PowerShapingPipeline) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PowerShapingPipeline.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TransferTimeoutPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TransferTimeoutPeriod):])
-	if err1 != nil {
-		return 0, err1
+}
+func (m *PowerShapingPipeline) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PowerShapingPipeline.Merge(m, src)
+}
+func (m *PowerShapingPipeline) XXX_Size() int {
+	return m.Size()
+}
+func (m *PowerShapingPipeline) XXX_DiscardUnknown() {
+	xxx_messageInfo_PowerShapingPipeline.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PowerShapingPipeline proto.InternalMessageInfo
+
+func (m *PowerShapingPipeline) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	i -= n1
-	i = encodeVarintProvider(dAtA, i, uint64(n1))
-	i--
-	dAtA[i] = 0x52
-	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.CcvTimeoutPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.CcvTimeoutPeriod):])
-	if err2 != nil {
-		return 0, err2
+	return 0
+}
+
+func (m *PowerShapingPipeline) GetSteps() []PowerShapingStep {
+	if m != nil {
+		return m.Steps
 	}
-	i -= n2
-	i = encodeVarintProvider(dAtA, i, uint64(n2))
-	i--
-	dAtA[i] = 0x4a
-	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UnbondingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingPeriod):])
-	if err3 != nil {
-		return 0, err3
+	return nil
+}
+
+// PowerShapingStep is a power shaping step applied to compute the validator set of a consumer chain
+type PowerShapingStep struct {
+	// the name of the step, e.g., `validator_set_cap`
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// the consensus addresses on the provider of the validators added by the step
+	ValidatorsIn []string `protobuf:"bytes,2,rep,name=validators_in,json=validatorsIn,proto3" json:"validators_in,omitempty"`
+	// the consensus addresses on the provider of the validators removed by the step
+	ValidatorsOut []string `protobuf:"bytes,3,rep,name=validators_out,json=validatorsOut,proto3" json:"validators_out,omitempty"`
+}
+
+func (m *PowerShapingStep) Reset()         { *m = PowerShapingStep{} }
+func (m *PowerShapingStep) String() string { return proto.CompactTextString(m) }
+func (*PowerShapingStep) ProtoMessage()    {}
+func (*PowerShapingStep) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{31}
+}
+func (m *PowerShapingStep) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PowerShapingStep) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PowerShapingStep.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	i -= n3
-	i = encodeVarintProvider(dAtA, i, uint64(n3))
-	i--
-	dAtA[i] = 0x42
-	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.SpawnTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SpawnTime):])
-	if err4 != nil {
-		return 0, err4
+}
+func (m *PowerShapingStep) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PowerShapingStep.Merge(m, src)
+}
+func (m *PowerShapingStep) XXX_Size() int {
+	return m.Size()
+}
+func (m *PowerShapingStep) XXX_DiscardUnknown() {
+	xxx_messageInfo_PowerShapingStep.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PowerShapingStep proto.InternalMessageInfo
+
+func (m *PowerShapingStep) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	i -= n4
-	i = encodeVarintProvider(dAtA, i, uint64(n4))
-	i--
-	dAtA[i] = 0x3a
-	if len(m.BinaryHash) > 0 {
-		i -= len(m.BinaryHash)
-		copy(dAtA[i:], m.BinaryHash)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.BinaryHash)))
-		i--
-		dAtA[i] = 0x32
+	return ""
+}
+
+func (m *PowerShapingStep) GetValidatorsIn() []string {
+	if m != nil {
+		return m.ValidatorsIn
 	}
-	if len(m.GenesisHash) > 0 {
-		i -= len(m.GenesisHash)
-		copy(dAtA[i:], m.GenesisHash)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.GenesisHash)))
-		i--
-		dAtA[i] = 0x2a
+	return nil
+}
+
+func (m *PowerShapingStep) GetValidatorsOut() []string {
+	if m != nil {
+		return m.ValidatorsOut
 	}
-	{
-		size, err := m.InitialHeight.MarshalToSizedBuffer(dAtA[:i])
+	return nil
+}
+
+// FundFlowRecord records a transfer of funds in or out of a CCV module account
+type FundFlowRecord struct {
+	// the type of the transfer
+	Type FundFlowType `protobuf:"varint,1,opt,name=type,proto3,enum=interchain_security.ccv.provider.v1.FundFlowType" json:"type,omitempty"`
+	// the name of the module account the funds were transferred in or out of
+	ModuleAccount string `protobuf:"bytes,2,opt,name=module_account,json=moduleAccount,proto3" json:"module_account,omitempty"`
+	// the consumer chain the transfer is related to
+	ConsumerId string `protobuf:"bytes,3,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+	// the transferred funds
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// the block height of the transfer
+	Height int64 `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	// the block time of the transfer
+	Time time.Time `protobuf:"bytes,6,opt,name=time,proto3,stdtime" json:"time"`
+}
+
+func (m *FundFlowRecord) Reset()         { *m = FundFlowRecord{} }
+func (m *FundFlowRecord) String() string { return proto.CompactTextString(m) }
+func (*FundFlowRecord) ProtoMessage()    {}
+func (*FundFlowRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{32}
+}
+func (m *FundFlowRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FundFlowRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FundFlowRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintProvider(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0x1a
+}
+func (m *FundFlowRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FundFlowRecord.Merge(m, src)
+}
+func (m *FundFlowRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *FundFlowRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_FundFlowRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FundFlowRecord proto.InternalMessageInfo
+
+func (m *FundFlowRecord) GetType() FundFlowType {
+	if m != nil {
+		return m.Type
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
+	return FUND_FLOW_TYPE_UNSPECIFIED
+}
+
+func (m *FundFlowRecord) GetModuleAccount() string {
+	if m != nil {
+		return m.ModuleAccount
 	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *FundFlowRecord) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *ConsumerRemovalProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *FundFlowRecord) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ConsumerRemovalProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *FundFlowRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
 }
 
-func (m *ConsumerRemovalProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StopTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StopTime):])
-	if err6 != nil {
-		return 0, err6
+func (m *FundFlowRecord) GetTime() time.Time {
+	if m != nil {
+		return m.Time
 	}
-	i -= n6
-	i = encodeVarintProvider(dAtA, i, uint64(n6))
-	i--
-	dAtA[i] = 0x22
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
+	return time.Time{}
+}
+
+// ConsumerLaunchFailure records the last failed launch of a consumer chain
+type ConsumerLaunchFailure struct {
+	// the reason of the failure
+	Error string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	// the block height of the failed launch
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// the block time of the failed launch
+	Time time.Time `protobuf:"bytes,3,opt,name=time,proto3,stdtime" json:"time"`
+	// whether the launch can be retried with MsgRetryLaunch, i.e., the consumer client could not be created
+	// and the chain remains in the initialized phase; otherwise, the chain is moved back to the registered phase
+	// and a new spawn time must be set
+	Retriable bool `protobuf:"varint,4,opt,name=retriable,proto3" json:"retriable,omitempty"`
+}
+
+func (m *ConsumerLaunchFailure) Reset()         { *m = ConsumerLaunchFailure{} }
+func (m *ConsumerLaunchFailure) String() string { return proto.CompactTextString(m) }
+func (*ConsumerLaunchFailure) ProtoMessage()    {}
+func (*ConsumerLaunchFailure) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{33}
+}
+func (m *ConsumerLaunchFailure) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerLaunchFailure) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerLaunchFailure.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ConsumerLaunchFailure) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerLaunchFailure.Merge(m, src)
+}
+func (m *ConsumerLaunchFailure) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerLaunchFailure) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerLaunchFailure.DiscardUnknown(m)
 }
 
-func (m *ConsumerModificationProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ConsumerLaunchFailure proto.InternalMessageInfo
+
+func (m *ConsumerLaunchFailure) GetError() string {
+	if m != nil {
+		return m.Error
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ConsumerModificationProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ConsumerLaunchFailure) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
 }
 
-func (m *ConsumerModificationProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.AllowInactiveVals {
-		i--
-		if m.AllowInactiveVals {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x50
+func (m *ConsumerLaunchFailure) GetTime() time.Time {
+	if m != nil {
+		return m.Time
 	}
-	if m.MinStake != 0 {
-		i = encodeVarintProvider(dAtA, i, uint64(m.MinStake))
-		i--
-		dAtA[i] = 0x48
+	return time.Time{}
+}
+
+func (m *ConsumerLaunchFailure) GetRetriable() bool {
+	if m != nil {
+		return m.Retriable
 	}
-	if len(m.Denylist) > 0 {
-		for iNdEx := len(m.Denylist) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Denylist[iNdEx])
-			copy(dAtA[i:], m.Denylist[iNdEx])
-			i = encodeVarintProvider(dAtA, i, uint64(len(m.Denylist[iNdEx])))
-			i--
-			dAtA[i] = 0x42
+	return false
+}
+
+// ConsumerHashCommitment records the genesis and binary hashes of a consumer chain
+// committed at registration, as well as their updates and attestation by the owner
+type ConsumerHashCommitment struct {
+	// the genesis hash set when the consumer chain was registered
+	RegisteredGenesisHash []byte `protobuf:"bytes,1,opt,name=registered_genesis_hash,json=registeredGenesisHash,proto3" json:"registered_genesis_hash,omitempty"`
+	// the binary hash set when the consumer chain was registered
+	RegisteredBinaryHash []byte `protobuf:"bytes,2,opt,name=registered_binary_hash,json=registeredBinaryHash,proto3" json:"registered_binary_hash,omitempty"`
+	// the number of times the genesis or binary hash was updated since registration
+	UpdateCount uint64 `protobuf:"varint,3,opt,name=update_count,json=updateCount,proto3" json:"update_count,omitempty"`
+	// the block height of the last update of the genesis or binary hash
+	LastUpdateHeight int64 `protobuf:"varint,4,opt,name=last_update_height,json=lastUpdateHeight,proto3" json:"last_update_height,omitempty"`
+	// whether the owner attested the current hashes as final
+	Attested bool `protobuf:"varint,5,opt,name=attested,proto3" json:"attested,omitempty"`
+	// the block height of the attestation
+	AttestationHeight int64 `protobuf:"varint,6,opt,name=attestation_height,json=attestationHeight,proto3" json:"attestation_height,omitempty"`
+}
+
+func (m *ConsumerHashCommitment) Reset()         { *m = ConsumerHashCommitment{} }
+func (m *ConsumerHashCommitment) String() string { return proto.CompactTextString(m) }
+func (*ConsumerHashCommitment) ProtoMessage()    {}
+func (*ConsumerHashCommitment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{34}
+}
+func (m *ConsumerHashCommitment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerHashCommitment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerHashCommitment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Allowlist) > 0 {
-		for iNdEx := len(m.Allowlist) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Allowlist[iNdEx])
-			copy(dAtA[i:], m.Allowlist[iNdEx])
-			i = encodeVarintProvider(dAtA, i, uint64(len(m.Allowlist[iNdEx])))
-			i--
-			dAtA[i] = 0x3a
-		}
+}
+func (m *ConsumerHashCommitment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerHashCommitment.Merge(m, src)
+}
+func (m *ConsumerHashCommitment) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerHashCommitment) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerHashCommitment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsumerHashCommitment proto.InternalMessageInfo
+
+func (m *ConsumerHashCommitment) GetRegisteredGenesisHash() []byte {
+	if m != nil {
+		return m.RegisteredGenesisHash
 	}
-	if m.ValidatorSetCap != 0 {
-		i = encodeVarintProvider(dAtA, i, uint64(m.ValidatorSetCap))
-		i--
-		dAtA[i] = 0x30
+	return nil
+}
+
+func (m *ConsumerHashCommitment) GetRegisteredBinaryHash() []byte {
+	if m != nil {
+		return m.RegisteredBinaryHash
 	}
-	if m.ValidatorsPowerCap != 0 {
-		i = encodeVarintProvider(dAtA, i, uint64(m.ValidatorsPowerCap))
-		i--
-		dAtA[i] = 0x28
+	return nil
+}
+
+func (m *ConsumerHashCommitment) GetUpdateCount() uint64 {
+	if m != nil {
+		return m.UpdateCount
 	}
-	if m.Top_N != 0 {
-		i = encodeVarintProvider(dAtA, i, uint64(m.Top_N))
-		i--
-		dAtA[i] = 0x20
+	return 0
+}
+
+func (m *ConsumerHashCommitment) GetLastUpdateHeight() int64 {
+	if m != nil {
+		return m.LastUpdateHeight
 	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0x1a
+	return 0
+}
+
+func (m *ConsumerHashCommitment) GetAttested() bool {
+	if m != nil {
+		return m.Attested
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
+	return false
+}
+
+func (m *ConsumerHashCommitment) GetAttestationHeight() int64 {
+	if m != nil {
+		return m.AttestationHeight
 	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+// ConsumerPacketStats records the number of CCV packets exchanged with a consumer chain
+type ConsumerPacketStats struct {
+	// the number of VSC packets sent to the consumer chain
+	VscPacketsSent uint64 `protobuf:"varint,1,opt,name=vsc_packets_sent,json=vscPacketsSent,proto3" json:"vsc_packets_sent,omitempty"`
+	// the number of VSCMatured packets received from the consumer chain
+	VscMaturedPacketsReceived uint64 `protobuf:"varint,2,opt,name=vsc_matured_packets_received,json=vscMaturedPacketsReceived,proto3" json:"vsc_matured_packets_received,omitempty"`
+	// the number of slash packets received from the consumer chain
+	SlashPacketsReceived uint64 `protobuf:"varint,3,opt,name=slash_packets_received,json=slashPacketsReceived,proto3" json:"slash_packets_received,omitempty"`
+	// the number of slash packets that were handled
+	SlashPacketsHandled uint64 `protobuf:"varint,4,opt,name=slash_packets_handled,json=slashPacketsHandled,proto3" json:"slash_packets_handled,omitempty"`
+	// the number of slash packets that were bounced because the slash meter was negative
+	SlashPacketsBounced uint64 `protobuf:"varint,5,opt,name=slash_packets_bounced,json=slashPacketsBounced,proto3" json:"slash_packets_bounced,omitempty"`
+	// the number of error acknowledgements received from the consumer chain
+	ErrorAcksReceived uint64 `protobuf:"varint,6,opt,name=error_acks_received,json=errorAcksReceived,proto3" json:"error_acks_received,omitempty"`
+}
+
+func (m *ConsumerPacketStats) Reset()         { *m = ConsumerPacketStats{} }
+func (m *ConsumerPacketStats) String() string { return proto.CompactTextString(m) }
+func (*ConsumerPacketStats) ProtoMessage()    {}
+func (*ConsumerPacketStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{35}
+}
+func (m *ConsumerPacketStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerPacketStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerPacketStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ConsumerPacketStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerPacketStats.Merge(m, src)
+}
+func (m *ConsumerPacketStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerPacketStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerPacketStats.DiscardUnknown(m)
 }
 
-func (m *EquivocationProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ConsumerPacketStats proto.InternalMessageInfo
+
+func (m *ConsumerPacketStats) GetVscPacketsSent() uint64 {
+	if m != nil {
+		return m.VscPacketsSent
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *EquivocationProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ConsumerPacketStats) GetVscMaturedPacketsReceived() uint64 {
+	if m != nil {
+		return m.VscMaturedPacketsReceived
+	}
+	return 0
 }
 
-func (m *EquivocationProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Equivocations) > 0 {
-		for iNdEx := len(m.Equivocations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Equivocations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintProvider(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
+func (m *ConsumerPacketStats) GetSlashPacketsReceived() uint64 {
+	if m != nil {
+		return m.SlashPacketsReceived
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
+	return 0
+}
+
+func (m *ConsumerPacketStats) GetSlashPacketsHandled() uint64 {
+	if m != nil {
+		return m.SlashPacketsHandled
 	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *ConsumerPacketStats) GetSlashPacketsBounced() uint64 {
+	if m != nil {
+		return m.SlashPacketsBounced
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *ChangeRewardDenomsProposal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ConsumerPacketStats) GetErrorAcksReceived() uint64 {
+	if m != nil {
+		return m.ErrorAcksReceived
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ChangeRewardDenomsProposal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// PreLaunchKeyAssignment is a consumer key assignment for a consumer chain that is not yet created.
+// It is applied when a consumer chain with the given chain id is created.
+type PreLaunchKeyAssignment struct {
+	// the chain id of the consumer chain
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// the validator operator address of the provider validator
+	ProviderAddr string `protobuf:"bytes,2,opt,name=provider_addr,json=providerAddr,proto3" json:"provider_addr,omitempty"`
+	// the consumer key in JSON format, e.g., {"@type":"/cosmos.crypto.ed25519.PubKey","key":"..."}
+	ConsumerKey string `protobuf:"bytes,3,opt,name=consumer_key,json=consumerKey,proto3" json:"consumer_key,omitempty"`
 }
 
-func (m *ChangeRewardDenomsProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.DenomsToRemove) > 0 {
-		for iNdEx := len(m.DenomsToRemove) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.DenomsToRemove[iNdEx])
-			copy(dAtA[i:], m.DenomsToRemove[iNdEx])
-			i = encodeVarintProvider(dAtA, i, uint64(len(m.DenomsToRemove[iNdEx])))
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.DenomsToAdd) > 0 {
-		for iNdEx := len(m.DenomsToAdd) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.DenomsToAdd[iNdEx])
-			copy(dAtA[i:], m.DenomsToAdd[iNdEx])
-			i = encodeVarintProvider(dAtA, i, uint64(len(m.DenomsToAdd[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
+func (m *PreLaunchKeyAssignment) Reset()         { *m = PreLaunchKeyAssignment{} }
+func (m *PreLaunchKeyAssignment) String() string { return proto.CompactTextString(m) }
+func (*PreLaunchKeyAssignment) ProtoMessage()    {}
+func (*PreLaunchKeyAssignment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{36}
+}
+func (m *PreLaunchKeyAssignment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PreLaunchKeyAssignment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PreLaunchKeyAssignment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *GlobalSlashEntry) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *PreLaunchKeyAssignment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PreLaunchKeyAssignment.Merge(m, src)
 }
-
-func (m *GlobalSlashEntry) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PreLaunchKeyAssignment) XXX_Size() int {
+	return m.Size()
+}
+func (m *PreLaunchKeyAssignment) XXX_DiscardUnknown() {
+	xxx_messageInfo_PreLaunchKeyAssignment.DiscardUnknown(m)
 }
 
-func (m *GlobalSlashEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ProviderValConsAddr) > 0 {
-		i -= len(m.ProviderValConsAddr)
-		copy(dAtA[i:], m.ProviderValConsAddr)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.ProviderValConsAddr)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.IbcSeqNum != 0 {
-		i = encodeVarintProvider(dAtA, i, uint64(m.IbcSeqNum))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.ConsumerChainID) > 0 {
-		i -= len(m.ConsumerChainID)
-		copy(dAtA[i:], m.ConsumerChainID)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.ConsumerChainID)))
-		i--
-		dAtA[i] = 0x12
+var xxx_messageInfo_PreLaunchKeyAssignment proto.InternalMessageInfo
+
+func (m *PreLaunchKeyAssignment) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.RecvTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.RecvTime):])
-	if err7 != nil {
-		return 0, err7
+	return ""
+}
+
+func (m *PreLaunchKeyAssignment) GetProviderAddr() string {
+	if m != nil {
+		return m.ProviderAddr
 	}
-	i -= n7
-	i = encodeVarintProvider(dAtA, i, uint64(n7))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PreLaunchKeyAssignment) GetConsumerKey() string {
+	if m != nil {
+		return m.ConsumerKey
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ScheduledKeyAssignment is a consumer key assignment scheduled by a validator.
+// It is applied in the BeginBlock of the activation height.
+type ScheduledKeyAssignment struct {
+	// the consumer id of the consumer chain
+	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+	// the validator operator address of the provider validator
+	ProviderAddr string `protobuf:"bytes,2,opt,name=provider_addr,json=providerAddr,proto3" json:"provider_addr,omitempty"`
+	// the consumer key in JSON format, e.g., {"@type":"/cosmos.crypto.ed25519.PubKey","key":"..."}
+	ConsumerKey string `protobuf:"bytes,3,opt,name=consumer_key,json=consumerKey,proto3" json:"consumer_key,omitempty"`
+	// the height at which the key assignment is applied
+	ActivationHeight int64 `protobuf:"varint,4,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MaxProviderConsensusValidators != 0 {
-		i = encodeVarintProvider(dAtA, i, uint64(m.MaxProviderConsensusValidators))
-		i--
-		dAtA[i] = 0x60
-	}
-	if m.NumberOfEpochsToStartReceivingRewards != 0 {
-		i = encodeVarintProvider(dAtA, i, uint64(m.NumberOfEpochsToStartReceivingRewards))
-		i--
-		dAtA[i] = 0x58
-	}
-	if m.BlocksPerEpoch != 0 {
-		i = encodeVarintProvider(dAtA, i, uint64(m.BlocksPerEpoch))
-		i--
-		dAtA[i] = 0x50
-	}
-	{
-		size, err := m.ConsumerRewardDenomRegistrationFee.MarshalToSizedBuffer(dAtA[:i])
+func (m *ScheduledKeyAssignment) Reset()         { *m = ScheduledKeyAssignment{} }
+func (m *ScheduledKeyAssignment) String() string { return proto.CompactTextString(m) }
+func (*ScheduledKeyAssignment) ProtoMessage()    {}
+func (*ScheduledKeyAssignment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{37}
+}
+func (m *ScheduledKeyAssignment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledKeyAssignment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledKeyAssignment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintProvider(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x4a
-	if len(m.SlashMeterReplenishFraction) > 0 {
-		i -= len(m.SlashMeterReplenishFraction)
-		copy(dAtA[i:], m.SlashMeterReplenishFraction)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.SlashMeterReplenishFraction)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	n9, err9 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.SlashMeterReplenishPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.SlashMeterReplenishPeriod):])
-	if err9 != nil {
-		return 0, err9
+		return b[:n], nil
 	}
-	i -= n9
-	i = encodeVarintProvider(dAtA, i, uint64(n9))
-	i--
-	dAtA[i] = 0x32
-	n10, err10 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.CcvTimeoutPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.CcvTimeoutPeriod):])
-	if err10 != nil {
-		return 0, err10
+}
+func (m *ScheduledKeyAssignment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledKeyAssignment.Merge(m, src)
+}
+func (m *ScheduledKeyAssignment) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledKeyAssignment) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledKeyAssignment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledKeyAssignment proto.InternalMessageInfo
+
+func (m *ScheduledKeyAssignment) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
 	}
-	i -= n10
-	i = encodeVarintProvider(dAtA, i, uint64(n10))
-	i--
-	dAtA[i] = 0x1a
-	if len(m.TrustingPeriodFraction) > 0 {
-		i -= len(m.TrustingPeriodFraction)
-		copy(dAtA[i:], m.TrustingPeriodFraction)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.TrustingPeriodFraction)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *ScheduledKeyAssignment) GetProviderAddr() string {
+	if m != nil {
+		return m.ProviderAddr
 	}
-	if m.TemplateClient != nil {
-		{
-			size, err := m.TemplateClient.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintProvider(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *ScheduledKeyAssignment) GetConsumerKey() string {
+	if m != nil {
+		return m.ConsumerKey
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *SlashAcks) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ScheduledKeyAssignment) GetActivationHeight() int64 {
+	if m != nil {
+		return m.ActivationHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *SlashAcks) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ConsumerClientUpgradePlan is an upgrade of the consumer client registered by the owner of a
+// consumer chain that schedules an IBC client upgrade, e.g., through an upgrade plan of the consumer chain.
+// The upgraded client is verified against the plan once the client is updated past the upgrade height.
+type ConsumerClientUpgradePlan struct {
+	// the height of the consumer chain at which the upgrade is executed
+	UpgradeHeight types.Height `protobuf:"bytes,1,opt,name=upgrade_height,json=upgradeHeight,proto3" json:"upgrade_height"`
+	// the chain id of the consumer chain after the upgrade
+	NewChainId string `protobuf:"bytes,2,opt,name=new_chain_id,json=newChainId,proto3" json:"new_chain_id,omitempty"`
+	// the unbonding period of the consumer chain after the upgrade; zero if unchanged
+	UnbondingPeriod time.Duration `protobuf:"bytes,3,opt,name=unbonding_period,json=unbondingPeriod,proto3,stdduration" json:"unbonding_period"`
 }
 
-func (m *SlashAcks) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Addresses) > 0 {
-		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Addresses[iNdEx])
-			copy(dAtA[i:], m.Addresses[iNdEx])
-			i = encodeVarintProvider(dAtA, i, uint64(len(m.Addresses[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+func (m *ConsumerClientUpgradePlan) Reset()         { *m = ConsumerClientUpgradePlan{} }
+func (m *ConsumerClientUpgradePlan) String() string { return proto.CompactTextString(m) }
+func (*ConsumerClientUpgradePlan) ProtoMessage()    {}
+func (*ConsumerClientUpgradePlan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{38}
+}
+func (m *ConsumerClientUpgradePlan) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerClientUpgradePlan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerClientUpgradePlan.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ConsumerClientUpgradePlan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerClientUpgradePlan.Merge(m, src)
+}
+func (m *ConsumerClientUpgradePlan) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerClientUpgradePlan) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerClientUpgradePlan.DiscardUnknown(m)
 }
 
-func (m *ConsumerAdditionProposals) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ConsumerClientUpgradePlan proto.InternalMessageInfo
+
+func (m *ConsumerClientUpgradePlan) GetUpgradeHeight() types.Height {
+	if m != nil {
+		return m.UpgradeHeight
 	}
-	return dAtA[:n], nil
+	return types.Height{}
 }
 
-func (m *ConsumerAdditionProposals) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ConsumerClientUpgradePlan) GetNewChainId() string {
+	if m != nil {
+		return m.NewChainId
+	}
+	return ""
 }
 
-func (m *ConsumerAdditionProposals) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Pending) > 0 {
-		for iNdEx := len(m.Pending) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Pending[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintProvider(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *ConsumerClientUpgradePlan) GetUnbondingPeriod() time.Duration {
+	if m != nil {
+		return m.UnbondingPeriod
+	}
+	return 0
+}
+
+// ConsumerProviderSwitch is a switch of a launched consumer chain to a different provider chain registered
+// by the owner of the consumer chain, i.e., the switch the consumer chain scheduled through its own governance.
+// Once the consumer client reaches the switch height, the provider chain stops the consumer chain.
+type ConsumerProviderSwitch struct {
+	// the height of the consumer chain at which the consumer chain switches to the new provider chain
+	SwitchHeight types.Height `protobuf:"bytes,1,opt,name=switch_height,json=switchHeight,proto3" json:"switch_height"`
+	// the chain id of the new provider chain
+	NewProviderChainId string `protobuf:"bytes,2,opt,name=new_provider_chain_id,json=newProviderChainId,proto3" json:"new_provider_chain_id,omitempty"`
+}
+
+func (m *ConsumerProviderSwitch) Reset()         { *m = ConsumerProviderSwitch{} }
+func (m *ConsumerProviderSwitch) String() string { return proto.CompactTextString(m) }
+func (*ConsumerProviderSwitch) ProtoMessage()    {}
+func (*ConsumerProviderSwitch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{39}
+}
+func (m *ConsumerProviderSwitch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerProviderSwitch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerProviderSwitch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ConsumerProviderSwitch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerProviderSwitch.Merge(m, src)
+}
+func (m *ConsumerProviderSwitch) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerProviderSwitch) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerProviderSwitch.DiscardUnknown(m)
 }
 
-func (m *ConsumerRemovalProposals) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ConsumerProviderSwitch proto.InternalMessageInfo
+
+func (m *ConsumerProviderSwitch) GetSwitchHeight() types.Height {
+	if m != nil {
+		return m.SwitchHeight
 	}
-	return dAtA[:n], nil
+	return types.Height{}
 }
 
-func (m *ConsumerRemovalProposals) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ConsumerProviderSwitch) GetNewProviderChainId() string {
+	if m != nil {
+		return m.NewProviderChainId
+	}
+	return ""
 }
 
-func (m *ConsumerRemovalProposals) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Pending) > 0 {
-		for iNdEx := len(m.Pending) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Pending[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintProvider(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+// BouncedSlashPacket is a slash packet that was bounced because the slash meter
+// was negative, i.e., a slash packet that is pending a retry from its consumer chain
+type BouncedSlashPacket struct {
+	// the consumer id of the consumer chain that sent the slash packet
+	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+	// the consensus address of the validator on the consumer chain
+	ConsumerConsAddress string `protobuf:"bytes,2,opt,name=consumer_cons_address,json=consumerConsAddress,proto3" json:"consumer_cons_address,omitempty"`
+	// the consensus address of the validator on the provider chain
+	ProviderConsAddress string `protobuf:"bytes,3,opt,name=provider_cons_address,json=providerConsAddress,proto3" json:"provider_cons_address,omitempty"`
+	// the valset update id of the infraction
+	ValsetUpdateId uint64 `protobuf:"varint,4,opt,name=valset_update_id,json=valsetUpdateId,proto3" json:"valset_update_id,omitempty"`
+	// the time at which the slash packet was first received
+	FirstReceiveTime time.Time `protobuf:"bytes,5,opt,name=first_receive_time,json=firstReceiveTime,proto3,stdtime" json:"first_receive_time"`
+	// the time at which the slash packet was last received
+	LastReceiveTime time.Time `protobuf:"bytes,6,opt,name=last_receive_time,json=lastReceiveTime,proto3,stdtime" json:"last_receive_time"`
+	// the number of times the slash packet was bounced
+	Bounces uint32 `protobuf:"varint,7,opt,name=bounces,proto3" json:"bounces,omitempty"`
+}
+
+func (m *BouncedSlashPacket) Reset()         { *m = BouncedSlashPacket{} }
+func (m *BouncedSlashPacket) String() string { return proto.CompactTextString(m) }
+func (*BouncedSlashPacket) ProtoMessage()    {}
+func (*BouncedSlashPacket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{40}
+}
+func (m *BouncedSlashPacket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BouncedSlashPacket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BouncedSlashPacket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *BouncedSlashPacket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BouncedSlashPacket.Merge(m, src)
+}
+func (m *BouncedSlashPacket) XXX_Size() int {
+	return m.Size()
+}
+func (m *BouncedSlashPacket) XXX_DiscardUnknown() {
+	xxx_messageInfo_BouncedSlashPacket.DiscardUnknown(m)
 }
 
-func (m *AddressList) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_BouncedSlashPacket proto.InternalMessageInfo
+
+func (m *BouncedSlashPacket) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *AddressList) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *BouncedSlashPacket) GetConsumerConsAddress() string {
+	if m != nil {
+		return m.ConsumerConsAddress
+	}
+	return ""
 }
 
-func (m *AddressList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Addresses) > 0 {
-		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Addresses[iNdEx])
-			copy(dAtA[i:], m.Addresses[iNdEx])
-			i = encodeVarintProvider(dAtA, i, uint64(len(m.Addresses[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+func (m *BouncedSlashPacket) GetProviderConsAddress() string {
+	if m != nil {
+		return m.ProviderConsAddress
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *ChannelToChain) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *BouncedSlashPacket) GetValsetUpdateId() uint64 {
+	if m != nil {
+		return m.ValsetUpdateId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ChannelToChain) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *BouncedSlashPacket) GetFirstReceiveTime() time.Time {
+	if m != nil {
+		return m.FirstReceiveTime
+	}
+	return time.Time{}
 }
 
-func (m *ChannelToChain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ChannelId) > 0 {
-		i -= len(m.ChannelId)
-		copy(dAtA[i:], m.ChannelId)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.ChannelId)))
-		i--
-		dAtA[i] = 0xa
+func (m *BouncedSlashPacket) GetLastReceiveTime() time.Time {
+	if m != nil {
+		return m.LastReceiveTime
 	}
-	return len(dAtA) - i, nil
+	return time.Time{}
 }
 
-func (m *ValidatorSetChangePackets) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *BouncedSlashPacket) GetBounces() uint32 {
+	if m != nil {
+		return m.Bounces
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ValidatorSetChangePackets) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// SentVSCPacket is a VSC packet sent to a consumer chain
+type SentVSCPacket struct {
+	// the CCV channel on which the packet was sent
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// the sequence of the packet on the CCV channel
+	Sequence uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// the time at which the packet was sent
+	SendTime time.Time `protobuf:"bytes,3,opt,name=send_time,json=sendTime,proto3,stdtime" json:"send_time"`
+	// the data of the packet
+	Data types3.ValidatorSetChangePacketData `protobuf:"bytes,4,opt,name=data,proto3" json:"data"`
 }
 
-func (m *ValidatorSetChangePackets) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.List) > 0 {
-		for iNdEx := len(m.List) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.List[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintProvider(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *SentVSCPacket) Reset()         { *m = SentVSCPacket{} }
+func (m *SentVSCPacket) String() string { return proto.CompactTextString(m) }
+func (*SentVSCPacket) ProtoMessage()    {}
+func (*SentVSCPacket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{41}
+}
+func (m *SentVSCPacket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SentVSCPacket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SentVSCPacket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *SentVSCPacket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SentVSCPacket.Merge(m, src)
+}
+func (m *SentVSCPacket) XXX_Size() int {
+	return m.Size()
+}
+func (m *SentVSCPacket) XXX_DiscardUnknown() {
+	xxx_messageInfo_SentVSCPacket.DiscardUnknown(m)
 }
 
-func (m *KeyAssignmentReplacement) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SentVSCPacket proto.InternalMessageInfo
+
+func (m *SentVSCPacket) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *KeyAssignmentReplacement) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SentVSCPacket) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
 }
 
-func (m *KeyAssignmentReplacement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Power != 0 {
-		i = encodeVarintProvider(dAtA, i, uint64(m.Power))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.PrevCKey != nil {
-		{
-			size, err := m.PrevCKey.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintProvider(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ProviderAddr) > 0 {
-		i -= len(m.ProviderAddr)
-		copy(dAtA[i:], m.ProviderAddr)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.ProviderAddr)))
-		i--
-		dAtA[i] = 0xa
+func (m *SentVSCPacket) GetSendTime() time.Time {
+	if m != nil {
+		return m.SendTime
 	}
-	return len(dAtA) - i, nil
+	return time.Time{}
 }
 
-func (m *ValidatorConsumerPubKey) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SentVSCPacket) GetData() types3.ValidatorSetChangePacketData {
+	if m != nil {
+		return m.Data
 	}
-	return dAtA[:n], nil
+	return types3.ValidatorSetChangePacketData{}
 }
 
-func (m *ValidatorConsumerPubKey) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// KeyAssignmentObservation records whether an assigned consumer key
+// was observed in the heartbeats of a consumer chain
+type KeyAssignmentObservation struct {
+	// the provider height at which the key started to be tracked, i.e., the first
+	// epoch in which the key was used in the validator set of the consumer chain
+	TrackedSinceHeight int64 `protobuf:"varint,1,opt,name=tracked_since_height,json=trackedSinceHeight,proto3" json:"tracked_since_height,omitempty"`
+	// the provider height at which the key was last observed in a heartbeat,
+	// or zero if the key was never observed
+	LastObservedHeight int64 `protobuf:"varint,2,opt,name=last_observed_height,json=lastObservedHeight,proto3" json:"last_observed_height,omitempty"`
 }
 
-func (m *ValidatorConsumerPubKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ConsumerKey != nil {
-		{
-			size, err := m.ConsumerKey.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintProvider(dAtA, i, uint64(size))
+func (m *KeyAssignmentObservation) Reset()         { *m = KeyAssignmentObservation{} }
+func (m *KeyAssignmentObservation) String() string { return proto.CompactTextString(m) }
+func (*KeyAssignmentObservation) ProtoMessage()    {}
+func (*KeyAssignmentObservation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{42}
+}
+func (m *KeyAssignmentObservation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KeyAssignmentObservation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_KeyAssignmentObservation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ProviderAddr) > 0 {
-		i -= len(m.ProviderAddr)
-		copy(dAtA[i:], m.ProviderAddr)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.ProviderAddr)))
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *KeyAssignmentObservation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KeyAssignmentObservation.Merge(m, src)
+}
+func (m *KeyAssignmentObservation) XXX_Size() int {
+	return m.Size()
+}
+func (m *KeyAssignmentObservation) XXX_DiscardUnknown() {
+	xxx_messageInfo_KeyAssignmentObservation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KeyAssignmentObservation proto.InternalMessageInfo
+
+func (m *KeyAssignmentObservation) GetTrackedSinceHeight() int64 {
+	if m != nil {
+		return m.TrackedSinceHeight
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *ValidatorByConsumerAddr) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *KeyAssignmentObservation) GetLastObservedHeight() int64 {
+	if m != nil {
+		return m.LastObservedHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ValidatorByConsumerAddr) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ConsumerUpdateRecord records an accepted MsgUpdateConsumer of a consumer chain
+type ConsumerUpdateRecord struct {
+	// the owner address that submitted the update
+	Submitter string `protobuf:"bytes,1,opt,name=submitter,proto3" json:"submitter,omitempty"`
+	// the block height of the update
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// the block time of the update
+	Time time.Time `protobuf:"bytes,3,opt,name=time,proto3,stdtime" json:"time"`
+	// the fields of the consumer chain that were changed by the update
+	Changes []ConsumerFieldChange `protobuf:"bytes,4,rep,name=changes,proto3" json:"changes"`
 }
 
-func (m *ValidatorByConsumerAddr) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ProviderAddr) > 0 {
-		i -= len(m.ProviderAddr)
-		copy(dAtA[i:], m.ProviderAddr)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.ProviderAddr)))
-		i--
-		dAtA[i] = 0x1a
+func (m *ConsumerUpdateRecord) Reset()         { *m = ConsumerUpdateRecord{} }
+func (m *ConsumerUpdateRecord) String() string { return proto.CompactTextString(m) }
+func (*ConsumerUpdateRecord) ProtoMessage()    {}
+func (*ConsumerUpdateRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{43}
+}
+func (m *ConsumerUpdateRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerUpdateRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerUpdateRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.ConsumerAddr) > 0 {
-		i -= len(m.ConsumerAddr)
-		copy(dAtA[i:], m.ConsumerAddr)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.ConsumerAddr)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *ConsumerUpdateRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerUpdateRecord.Merge(m, src)
+}
+func (m *ConsumerUpdateRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerUpdateRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerUpdateRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsumerUpdateRecord proto.InternalMessageInfo
+
+func (m *ConsumerUpdateRecord) GetSubmitter() string {
+	if m != nil {
+		return m.Submitter
 	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *ConsumerUpdateRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *ConsumerAddrsToPruneV2) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ConsumerUpdateRecord) GetTime() time.Time {
+	if m != nil {
+		return m.Time
 	}
-	return dAtA[:n], nil
+	return time.Time{}
 }
 
-func (m *ConsumerAddrsToPruneV2) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ConsumerUpdateRecord) GetChanges() []ConsumerFieldChange {
+	if m != nil {
+		return m.Changes
+	}
+	return nil
 }
 
-func (m *ConsumerAddrsToPruneV2) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ConsumerAddrs != nil {
-		{
-			size, err := m.ConsumerAddrs.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintProvider(dAtA, i, uint64(size))
+// ConsumerFieldChange is a change of a field of a consumer chain
+type ConsumerFieldChange struct {
+	// the name of the field, e.g., "power_shaping_parameters.top_N"
+	Field string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	// the value of the field before the update
+	OldValue string `protobuf:"bytes,2,opt,name=old_value,json=oldValue,proto3" json:"old_value,omitempty"`
+	// the value of the field after the update
+	NewValue string `protobuf:"bytes,3,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty"`
+}
+
+func (m *ConsumerFieldChange) Reset()         { *m = ConsumerFieldChange{} }
+func (m *ConsumerFieldChange) String() string { return proto.CompactTextString(m) }
+func (*ConsumerFieldChange) ProtoMessage()    {}
+func (*ConsumerFieldChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{44}
+}
+func (m *ConsumerFieldChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerFieldChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerFieldChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	n15, err15 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PruneTs, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PruneTs):])
-	if err15 != nil {
-		return 0, err15
-	}
-	i -= n15
-	i = encodeVarintProvider(dAtA, i, uint64(n15))
-	i--
-	dAtA[i] = 0x12
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ConsumerFieldChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerFieldChange.Merge(m, src)
+}
+func (m *ConsumerFieldChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerFieldChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerFieldChange.DiscardUnknown(m)
 }
 
-func (m *ConsensusValidator) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ConsumerFieldChange proto.InternalMessageInfo
+
+func (m *ConsumerFieldChange) GetField() string {
+	if m != nil {
+		return m.Field
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ConsensusValidator) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ConsumerFieldChange) GetOldValue() string {
+	if m != nil {
+		return m.OldValue
+	}
+	return ""
 }
 
-func (m *ConsensusValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.JoinHeight != 0 {
-		i = encodeVarintProvider(dAtA, i, uint64(m.JoinHeight))
-		i--
-		dAtA[i] = 0x20
+func (m *ConsumerFieldChange) GetNewValue() string {
+	if m != nil {
+		return m.NewValue
 	}
-	if m.PublicKey != nil {
-		{
-			size, err := m.PublicKey.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintProvider(dAtA, i, uint64(size))
+	return ""
+}
+
+// ValidatorOptInRecord records that a validator opted in to or opted out from a consumer chain
+type ValidatorOptInRecord struct {
+	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+	// true if the validator opted in, false if the validator opted out
+	OptedIn bool `protobuf:"varint,2,opt,name=opted_in,json=optedIn,proto3" json:"opted_in,omitempty"`
+	// true if the validator was automatically opted in because it belongs to the Top N validators
+	ForcedByTopN bool `protobuf:"varint,3,opt,name=forced_by_top_n,json=forcedByTopN,proto3" json:"forced_by_top_n,omitempty"`
+	// the block height of the opt-in or opt-out
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	// the block time of the opt-in or opt-out
+	Time time.Time `protobuf:"bytes,5,opt,name=time,proto3,stdtime" json:"time"`
+	// the height of the first block of the epoch in which the opt-in or opt-out
+	// takes effect on the validator set of the consumer chain
+	EpochStartHeight int64 `protobuf:"varint,6,opt,name=epoch_start_height,json=epochStartHeight,proto3" json:"epoch_start_height,omitempty"`
+}
+
+func (m *ValidatorOptInRecord) Reset()         { *m = ValidatorOptInRecord{} }
+func (m *ValidatorOptInRecord) String() string { return proto.CompactTextString(m) }
+func (*ValidatorOptInRecord) ProtoMessage()    {}
+func (*ValidatorOptInRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{45}
+}
+func (m *ValidatorOptInRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorOptInRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorOptInRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
+		return b[:n], nil
 	}
-	if m.Power != 0 {
-		i = encodeVarintProvider(dAtA, i, uint64(m.Power))
-		i--
-		dAtA[i] = 0x10
+}
+func (m *ValidatorOptInRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorOptInRecord.Merge(m, src)
+}
+func (m *ValidatorOptInRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorOptInRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorOptInRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorOptInRecord proto.InternalMessageInfo
+
+func (m *ValidatorOptInRecord) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
 	}
-	if len(m.ProviderConsAddr) > 0 {
-		i -= len(m.ProviderConsAddr)
-		copy(dAtA[i:], m.ProviderConsAddr)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.ProviderConsAddr)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *ValidatorOptInRecord) GetOptedIn() bool {
+	if m != nil {
+		return m.OptedIn
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *ConsumerRewardsAllocation) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ValidatorOptInRecord) GetForcedByTopN() bool {
+	if m != nil {
+		return m.ForcedByTopN
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *ConsumerRewardsAllocation) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ValidatorOptInRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
 }
 
-func (m *ConsumerRewardsAllocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Rewards) > 0 {
-		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintProvider(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (m *ValidatorOptInRecord) GetTime() time.Time {
+	if m != nil {
+		return m.Time
 	}
-	return len(dAtA) - i, nil
+	return time.Time{}
 }
 
-func (m *ConsumerMetadata) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ValidatorOptInRecord) GetEpochStartHeight() int64 {
+	if m != nil {
+		return m.EpochStartHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *ConsumerMetadata) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ValidatorConsumerOptInHistory is the opt-in history of a validator on a consumer chain
+type ValidatorConsumerOptInHistory struct {
+	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+	// the most recent opt-ins and opt-outs of the validator, oldest first
+	Records []ValidatorOptInRecord `protobuf:"bytes,2,rep,name=records,proto3" json:"records"`
 }
 
-func (m *ConsumerMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Metadata) > 0 {
-		i -= len(m.Metadata)
-		copy(dAtA[i:], m.Metadata)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.Metadata)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
+func (m *ValidatorConsumerOptInHistory) Reset()         { *m = ValidatorConsumerOptInHistory{} }
+func (m *ValidatorConsumerOptInHistory) String() string { return proto.CompactTextString(m) }
+func (*ValidatorConsumerOptInHistory) ProtoMessage()    {}
+func (*ValidatorConsumerOptInHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{46}
+}
+func (m *ValidatorConsumerOptInHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorConsumerOptInHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorConsumerOptInHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *ValidatorConsumerOptInHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorConsumerOptInHistory.Merge(m, src)
+}
+func (m *ValidatorConsumerOptInHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorConsumerOptInHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorConsumerOptInHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorConsumerOptInHistory proto.InternalMessageInfo
+
+func (m *ValidatorConsumerOptInHistory) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *ConsumerInitializationParameters) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ValidatorConsumerOptInHistory) GetRecords() []ValidatorOptInRecord {
+	if m != nil {
+		return m.Records
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ConsumerInitializationParameters) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EpochInfo is the current epoch of the provider chain when the epochs are
+// expressed in time, i.e., when the epoch_duration param is set
+type EpochInfo struct {
+	// the height of the first block of the epoch
+	StartHeight int64 `protobuf:"varint,1,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// the epoch boundary passed by the first block of the epoch, i.e., a multiple
+	// of the epoch duration
+	StartTime time.Time `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// the number of blocks of the previous epoch
+	PreviousEpochBlocks int64 `protobuf:"varint,3,opt,name=previous_epoch_blocks,json=previousEpochBlocks,proto3" json:"previous_epoch_blocks,omitempty"`
 }
 
-func (m *ConsumerInitializationParameters) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ConnectionId) > 0 {
-		i -= len(m.ConnectionId)
-		copy(dAtA[i:], m.ConnectionId)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.ConnectionId)))
-		i--
-		dAtA[i] = 0x62
-	}
-	if len(m.DistributionTransmissionChannel) > 0 {
-		i -= len(m.DistributionTransmissionChannel)
-		copy(dAtA[i:], m.DistributionTransmissionChannel)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.DistributionTransmissionChannel)))
-		i--
-		dAtA[i] = 0x5a
-	}
-	if m.HistoricalEntries != 0 {
-		i = encodeVarintProvider(dAtA, i, uint64(m.HistoricalEntries))
-		i--
-		dAtA[i] = 0x50
-	}
-	if m.BlocksPerDistributionTransmission != 0 {
-		i = encodeVarintProvider(dAtA, i, uint64(m.BlocksPerDistributionTransmission))
-		i--
-		dAtA[i] = 0x48
-	}
-	if len(m.ConsumerRedistributionFraction) > 0 {
-		i -= len(m.ConsumerRedistributionFraction)
-		copy(dAtA[i:], m.ConsumerRedistributionFraction)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.ConsumerRedistributionFraction)))
-		i--
-		dAtA[i] = 0x42
-	}
-	n17, err17 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TransferTimeoutPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TransferTimeoutPeriod):])
-	if err17 != nil {
-		return 0, err17
-	}
-	i -= n17
-	i = encodeVarintProvider(dAtA, i, uint64(n17))
-	i--
-	dAtA[i] = 0x3a
-	n18, err18 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.CcvTimeoutPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.CcvTimeoutPeriod):])
-	if err18 != nil {
-		return 0, err18
-	}
-	i -= n18
-	i = encodeVarintProvider(dAtA, i, uint64(n18))
-	i--
-	dAtA[i] = 0x32
-	n19, err19 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UnbondingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingPeriod):])
-	if err19 != nil {
-		return 0, err19
-	}
-	i -= n19
-	i = encodeVarintProvider(dAtA, i, uint64(n19))
-	i--
-	dAtA[i] = 0x2a
-	n20, err20 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.SpawnTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SpawnTime):])
-	if err20 != nil {
-		return 0, err20
+func (m *EpochInfo) Reset()         { *m = EpochInfo{} }
+func (m *EpochInfo) String() string { return proto.CompactTextString(m) }
+func (*EpochInfo) ProtoMessage()    {}
+func (*EpochInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f22ec409a72b7b72, []int{47}
+}
+func (m *EpochInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	i -= n20
-	i = encodeVarintProvider(dAtA, i, uint64(n20))
-	i--
-	dAtA[i] = 0x22
-	if len(m.BinaryHash) > 0 {
-		i -= len(m.BinaryHash)
-		copy(dAtA[i:], m.BinaryHash)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.BinaryHash)))
-		i--
-		dAtA[i] = 0x1a
+}
+func (m *EpochInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochInfo.Merge(m, src)
+}
+func (m *EpochInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochInfo proto.InternalMessageInfo
+
+func (m *EpochInfo) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
 	}
-	if len(m.GenesisHash) > 0 {
-		i -= len(m.GenesisHash)
-		copy(dAtA[i:], m.GenesisHash)
-		i = encodeVarintProvider(dAtA, i, uint64(len(m.GenesisHash)))
-		i--
-		dAtA[i] = 0x12
+	return 0
+}
+
+func (m *EpochInfo) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
 	}
-	{
-		size, err := m.InitialHeight.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintProvider(dAtA, i, uint64(size))
+	return time.Time{}
+}
+
+func (m *EpochInfo) GetPreviousEpochBlocks() int64 {
+	if m != nil {
+		return m.PreviousEpochBlocks
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *PowerShapingParameters) Marshal() (dAtA []byte, err error) {
+func init() {
+	proto.RegisterEnum("interchain_security.ccv.provider.v1.ConsumerPhase", ConsumerPhase_name, ConsumerPhase_value)
+	proto.RegisterEnum("interchain_security.ccv.provider.v1.FundFlowType", FundFlowType_name, FundFlowType_value)
+	proto.RegisterType((*ConsumerAdditionProposal)(nil), "interchain_security.ccv.provider.v1.ConsumerAdditionProposal")
+	proto.RegisterType((*ConsumerRemovalProposal)(nil), "interchain_security.ccv.provider.v1.ConsumerRemovalProposal")
+	proto.RegisterType((*ConsumerModificationProposal)(nil), "interchain_security.ccv.provider.v1.ConsumerModificationProposal")
+	proto.RegisterType((*EquivocationProposal)(nil), "interchain_security.ccv.provider.v1.EquivocationProposal")
+	proto.RegisterType((*ChangeRewardDenomsProposal)(nil), "interchain_security.ccv.provider.v1.ChangeRewardDenomsProposal")
+	proto.RegisterType((*GlobalSlashEntry)(nil), "interchain_security.ccv.provider.v1.GlobalSlashEntry")
+	proto.RegisterType((*Params)(nil), "interchain_security.ccv.provider.v1.Params")
+	proto.RegisterType((*SlashAcks)(nil), "interchain_security.ccv.provider.v1.SlashAcks")
+	proto.RegisterType((*ConsumerAdditionProposals)(nil), "interchain_security.ccv.provider.v1.ConsumerAdditionProposals")
+	proto.RegisterType((*ConsumerRemovalProposals)(nil), "interchain_security.ccv.provider.v1.ConsumerRemovalProposals")
+	proto.RegisterType((*AddressList)(nil), "interchain_security.ccv.provider.v1.AddressList")
+	proto.RegisterType((*ChannelToChain)(nil), "interchain_security.ccv.provider.v1.ChannelToChain")
+	proto.RegisterType((*ValidatorSetChangePackets)(nil), "interchain_security.ccv.provider.v1.ValidatorSetChangePackets")
+	proto.RegisterType((*KeyAssignmentReplacement)(nil), "interchain_security.ccv.provider.v1.KeyAssignmentReplacement")
+	proto.RegisterType((*ValidatorConsumerPubKey)(nil), "interchain_security.ccv.provider.v1.ValidatorConsumerPubKey")
+	proto.RegisterType((*ValidatorByConsumerAddr)(nil), "interchain_security.ccv.provider.v1.ValidatorByConsumerAddr")
+	proto.RegisterType((*ConsumerAddrsToPruneV2)(nil), "interchain_security.ccv.provider.v1.ConsumerAddrsToPruneV2")
+	proto.RegisterType((*ConsensusValidator)(nil), "interchain_security.ccv.provider.v1.ConsensusValidator")
+	proto.RegisterType((*ConsumerRewardsAllocation)(nil), "interchain_security.ccv.provider.v1.ConsumerRewardsAllocation")
+	proto.RegisterType((*ConsumerMetadata)(nil), "interchain_security.ccv.provider.v1.ConsumerMetadata")
+	proto.RegisterType((*ConsumerInitializationParameters)(nil), "interchain_security.ccv.provider.v1.ConsumerInitializationParameters")
+	proto.RegisterType((*PowerShapingParameters)(nil), "interchain_security.ccv.provider.v1.PowerShapingParameters")
+	proto.RegisterType((*PrioritylistWeight)(nil), "interchain_security.ccv.provider.v1.PrioritylistWeight")
+	proto.RegisterType((*ValidatorPowerHistory)(nil), "interchain_security.ccv.provider.v1.ValidatorPowerHistory")
+	proto.RegisterType((*ConsumerIds)(nil), "interchain_security.ccv.provider.v1.ConsumerIds")
+	proto.RegisterType((*AllowlistedRewardDenoms)(nil), "interchain_security.ccv.provider.v1.AllowlistedRewardDenoms")
+	proto.RegisterType((*InfractionParameters)(nil), "interchain_security.ccv.provider.v1.InfractionParameters")
+	proto.RegisterType((*SlashJailParameters)(nil), "interchain_security.ccv.provider.v1.SlashJailParameters")
+	proto.RegisterType((*ThrottlingParameters)(nil), "interchain_security.ccv.provider.v1.ThrottlingParameters")
+	proto.RegisterType((*ConsumerInitialConsensusState)(nil), "interchain_security.ccv.provider.v1.ConsumerInitialConsensusState")
+	proto.RegisterType((*PowerShapingPipeline)(nil), "interchain_security.ccv.provider.v1.PowerShapingPipeline")
+	proto.RegisterType((*PowerShapingStep)(nil), "interchain_security.ccv.provider.v1.PowerShapingStep")
+	proto.RegisterType((*FundFlowRecord)(nil), "interchain_security.ccv.provider.v1.FundFlowRecord")
+	proto.RegisterType((*ConsumerLaunchFailure)(nil), "interchain_security.ccv.provider.v1.ConsumerLaunchFailure")
+	proto.RegisterType((*ConsumerHashCommitment)(nil), "interchain_security.ccv.provider.v1.ConsumerHashCommitment")
+	proto.RegisterType((*ConsumerPacketStats)(nil), "interchain_security.ccv.provider.v1.ConsumerPacketStats")
+	proto.RegisterType((*PreLaunchKeyAssignment)(nil), "interchain_security.ccv.provider.v1.PreLaunchKeyAssignment")
+	proto.RegisterType((*ScheduledKeyAssignment)(nil), "interchain_security.ccv.provider.v1.ScheduledKeyAssignment")
+	proto.RegisterType((*ConsumerClientUpgradePlan)(nil), "interchain_security.ccv.provider.v1.ConsumerClientUpgradePlan")
+	proto.RegisterType((*ConsumerProviderSwitch)(nil), "interchain_security.ccv.provider.v1.ConsumerProviderSwitch")
+	proto.RegisterType((*BouncedSlashPacket)(nil), "interchain_security.ccv.provider.v1.BouncedSlashPacket")
+	proto.RegisterType((*SentVSCPacket)(nil), "interchain_security.ccv.provider.v1.SentVSCPacket")
+	proto.RegisterType((*KeyAssignmentObservation)(nil), "interchain_security.ccv.provider.v1.KeyAssignmentObservation")
+	proto.RegisterType((*ConsumerUpdateRecord)(nil), "interchain_security.ccv.provider.v1.ConsumerUpdateRecord")
+	proto.RegisterType((*ConsumerFieldChange)(nil), "interchain_security.ccv.provider.v1.ConsumerFieldChange")
+	proto.RegisterType((*ValidatorOptInRecord)(nil), "interchain_security.ccv.provider.v1.ValidatorOptInRecord")
+	proto.RegisterType((*ValidatorConsumerOptInHistory)(nil), "interchain_security.ccv.provider.v1.ValidatorConsumerOptInHistory")
+	proto.RegisterType((*EpochInfo)(nil), "interchain_security.ccv.provider.v1.EpochInfo")
+}
+
+func init() {
+	proto.RegisterFile("interchain_security/ccv/provider/v1/provider.proto", fileDescriptor_f22ec409a72b7b72)
+}
+
+var fileDescriptor_f22ec409a72b7b72 = []byte{
+	// 4159 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x5b, 0x4b, 0x6c, 0x63, 0x59,
+	0x5a, 0xae, 0x1b, 0x3b, 0x89, 0xfd, 0x3b, 0x0f, 0xe7, 0xe4, 0x51, 0x4e, 0x2a, 0x95, 0xa4, 0x6e,
+	0x4f, 0x0f, 0x99, 0xae, 0x2e, 0xbb, 0x93, 0x79, 0x15, 0x4d, 0x37, 0xad, 0x24, 0x76, 0xba, 0x5c,
+	0x95, 0x4a, 0x3c, 0xd7, 0xae, 0x2a, 0xba, 0xd1, 0xe8, 0xea, 0xfa, 0xde, 0x93, 0xf8, 0x74, 0xdd,
+	0x57, 0xdf, 0x73, 0xed, 0x94, 0x41, 0x1a, 0x89, 0x15, 0xb3, 0x41, 0x1a, 0x58, 0x8d, 0x40, 0x23,
+	0x86, 0x61, 0x01, 0x62, 0x81, 0x58, 0x8c, 0xd8, 0xc3, 0x86, 0x11, 0x12, 0x68, 0x60, 0x81, 0x10,
+	0x83, 0x7a, 0x86, 0x6e, 0x24, 0x16, 0x2c, 0xd8, 0xd2, 0x3b, 0x74, 0x1e, 0xf7, 0x61, 0x3b, 0x55,
+	0x71, 0xa8, 0xea, 0xd9, 0x74, 0xfb, 0x9c, 0xff, 0x71, 0x5e, 0xff, 0xff, 0x9f, 0xef, 0x7c, 0x37,
+	0x05, 0xbb, 0xc4, 0x0d, 0x71, 0x60, 0x76, 0x0c, 0xe2, 0xea, 0x14, 0x9b, 0xdd, 0x80, 0x84, 0xfd,
+	0x8a, 0x69, 0xf6, 0x2a, 0x7e, 0xe0, 0xf5, 0x88, 0x85, 0x83, 0x4a, 0x6f, 0x27, 0xfe, 0x5d, 0xf6,
+	0x03, 0x2f, 0xf4, 0xd0, 0x6b, 0x17, 0xd8, 0x94, 0x4d, 0xb3, 0x57, 0x8e, 0xf5, 0x7a, 0x3b, 0x6b,
+	0x0b, 0x86, 0x43, 0x5c, 0xaf, 0xc2, 0xff, 0x2b, 0xec, 0xd6, 0x36, 0x4c, 0x8f, 0x3a, 0x1e, 0xad,
+	0xb4, 0x0d, 0x8a, 0x2b, 0xbd, 0x9d, 0x36, 0x0e, 0x8d, 0x9d, 0x8a, 0xe9, 0x11, 0x57, 0xca, 0xbf,
+	0x2c, 0xe5, 0x98, 0x39, 0x71, 0xcd, 0x44, 0x27, 0xea, 0x90, 0x7a, 0xab, 0x42, 0x4f, 0xe7, 0xad,
+	0x8a, 0x68, 0x48, 0xd1, 0xd2, 0x99, 0x77, 0xe6, 0x89, 0x7e, 0xf6, 0x2b, 0x1a, 0xf8, 0xcc, 0xf3,
+	0xce, 0x6c, 0x5c, 0xe1, 0xad, 0x76, 0xf7, 0xb4, 0x62, 0x75, 0x03, 0x23, 0x24, 0x5e, 0x34, 0xf0,
+	0xe6, 0xb0, 0x3c, 0x24, 0x0e, 0xa6, 0xa1, 0xe1, 0xf8, 0x91, 0x02, 0x69, 0x9b, 0x15, 0xd3, 0x0b,
+	0x70, 0xc5, 0xb4, 0x09, 0x76, 0x43, 0xb6, 0x29, 0xe2, 0x97, 0x54, 0xa8, 0x30, 0x05, 0x9b, 0x9c,
+	0x75, 0x42, 0xd1, 0x4d, 0x2b, 0x21, 0x76, 0x2d, 0x1c, 0x38, 0x44, 0x28, 0x27, 0x2d, 0x69, 0xf0,
+	0xfa, 0xf3, 0xf6, 0xbd, 0xb7, 0x53, 0x39, 0x27, 0x41, 0xb4, 0xd4, 0xf5, 0x94, 0x1b, 0x33, 0xe8,
+	0xfb, 0xa1, 0x57, 0x79, 0x8a, 0xfb, 0x72, 0xb5, 0xea, 0xe7, 0x39, 0x28, 0x1d, 0x78, 0x2e, 0xed,
+	0x3a, 0x38, 0xd8, 0xb3, 0x2c, 0xc2, 0x96, 0xd4, 0x08, 0x3c, 0xdf, 0xa3, 0x86, 0x8d, 0x96, 0x60,
+	0x32, 0x24, 0xa1, 0x8d, 0x4b, 0xca, 0x96, 0xb2, 0x9d, 0xd7, 0x44, 0x03, 0x6d, 0x41, 0xc1, 0xc2,
+	0xd4, 0x0c, 0x88, 0xcf, 0x94, 0x4b, 0x13, 0x5c, 0x96, 0xee, 0x42, 0xab, 0x90, 0x13, 0xd3, 0x22,
+	0x56, 0x29, 0xc3, 0xc5, 0xd3, 0xbc, 0x5d, 0xb7, 0xd0, 0xfb, 0x30, 0x47, 0x5c, 0x12, 0x12, 0xc3,
+	0xd6, 0x3b, 0x98, 0x2d, 0xb6, 0x94, 0xdd, 0x52, 0xb6, 0x0b, 0xbb, 0x6b, 0x65, 0xd2, 0x36, 0xcb,
+	0x6c, 0x7f, 0xca, 0x72, 0x57, 0x7a, 0x3b, 0xe5, 0x7b, 0x5c, 0x63, 0x3f, 0xfb, 0x93, 0x4f, 0x36,
+	0xaf, 0x69, 0xb3, 0xd2, 0x4e, 0x74, 0xa2, 0x5b, 0x30, 0x73, 0x86, 0x5d, 0x4c, 0x09, 0xd5, 0x3b,
+	0x06, 0xed, 0x94, 0x26, 0xb7, 0x94, 0xed, 0x19, 0xad, 0x20, 0xfb, 0xee, 0x19, 0xb4, 0x83, 0x36,
+	0xa1, 0xd0, 0x26, 0xae, 0x11, 0xf4, 0x85, 0xc6, 0x14, 0xd7, 0x00, 0xd1, 0xc5, 0x15, 0x0e, 0x00,
+	0xa8, 0x6f, 0x9c, 0xbb, 0x3a, 0x3b, 0xac, 0xd2, 0xb4, 0x9c, 0x88, 0x38, 0xc9, 0x72, 0x74, 0x92,
+	0xe5, 0x56, 0x74, 0x92, 0xfb, 0x39, 0x36, 0x91, 0xef, 0xfd, 0x7c, 0x53, 0xd1, 0xf2, 0xdc, 0x8e,
+	0x49, 0xd0, 0x31, 0x14, 0xbb, 0x6e, 0xdb, 0x73, 0x2d, 0xe2, 0x9e, 0xe9, 0x3e, 0x0e, 0x88, 0x67,
+	0x95, 0x72, 0xdc, 0xd5, 0xea, 0x88, 0xab, 0xaa, 0x0c, 0x1a, 0xe1, 0xe9, 0xfb, 0xcc, 0xd3, 0x7c,
+	0x6c, 0xdc, 0xe0, 0xb6, 0xe8, 0x5b, 0x80, 0x4c, 0xb3, 0xc7, 0xa7, 0xe4, 0x75, 0xc3, 0xc8, 0x63,
+	0x7e, 0x7c, 0x8f, 0x45, 0xd3, 0xec, 0xb5, 0x84, 0xb5, 0x74, 0xf9, 0x9b, 0x70, 0x3d, 0x0c, 0x0c,
+	0x97, 0x9e, 0xe2, 0x60, 0xd8, 0x2f, 0x8c, 0xef, 0x77, 0x39, 0xf2, 0x31, 0xe8, 0xfc, 0x1e, 0x6c,
+	0x99, 0x32, 0x80, 0xf4, 0x00, 0x5b, 0x84, 0x86, 0x01, 0x69, 0x77, 0x99, 0xad, 0x7e, 0x1a, 0x18,
+	0x26, 0xfb, 0x51, 0x2a, 0xf0, 0x20, 0xd8, 0x88, 0xf4, 0xb4, 0x01, 0xb5, 0x43, 0xa9, 0x85, 0x4e,
+	0xe0, 0x4b, 0x6d, 0xdb, 0x33, 0x9f, 0x52, 0x36, 0x39, 0x7d, 0xc0, 0x13, 0x1f, 0xda, 0x21, 0x94,
+	0x32, 0x6f, 0x33, 0x5b, 0xca, 0x76, 0x46, 0xbb, 0x25, 0x74, 0x1b, 0x38, 0xa8, 0xa6, 0x34, 0x5b,
+	0x29, 0x45, 0x74, 0x07, 0x50, 0x87, 0xd0, 0xd0, 0x0b, 0x88, 0x69, 0xd8, 0x3a, 0x76, 0xc3, 0x80,
+	0x60, 0x5a, 0x9a, 0xe5, 0xe6, 0x0b, 0x89, 0xa4, 0x26, 0x04, 0xe8, 0x3e, 0xdc, 0x7a, 0xee, 0xa0,
+	0xba, 0xd9, 0x31, 0x5c, 0x17, 0xdb, 0xa5, 0x39, 0xbe, 0x94, 0x4d, 0xeb, 0x39, 0x63, 0x1e, 0x08,
+	0x35, 0xb4, 0x08, 0x93, 0xa1, 0xe7, 0xeb, 0xc7, 0xa5, 0xf9, 0x2d, 0x65, 0x7b, 0x56, 0xcb, 0x86,
+	0x9e, 0x7f, 0x8c, 0xde, 0x82, 0xa5, 0x9e, 0x61, 0x13, 0xcb, 0x08, 0xbd, 0x80, 0xea, 0xbe, 0x77,
+	0x8e, 0x03, 0xdd, 0x34, 0xfc, 0x52, 0x91, 0xeb, 0xa0, 0x44, 0xd6, 0x60, 0xa2, 0x03, 0xc3, 0x47,
+	0x6f, 0xc0, 0x42, 0xdc, 0xab, 0x53, 0x1c, 0x72, 0xf5, 0x05, 0xae, 0x3e, 0x1f, 0x0b, 0x9a, 0x38,
+	0x64, 0xba, 0xeb, 0x90, 0x37, 0x6c, 0xdb, 0x3b, 0xb7, 0x09, 0x0d, 0x4b, 0x68, 0x2b, 0xb3, 0x9d,
+	0xd7, 0x92, 0x0e, 0xb4, 0x06, 0x39, 0x0b, 0xbb, 0x7d, 0x2e, 0x5c, 0xe4, 0xc2, 0xb8, 0x8d, 0x6e,
+	0x40, 0xde, 0x61, 0x45, 0x24, 0x34, 0x9e, 0xe2, 0xd2, 0xd2, 0x96, 0xb2, 0x9d, 0xd5, 0x72, 0x0e,
+	0x71, 0x9b, 0xac, 0x8d, 0xca, 0xb0, 0xc8, 0xbd, 0xe8, 0xc4, 0x65, 0xe7, 0xd4, 0xc3, 0x7a, 0xcf,
+	0xb0, 0x69, 0x69, 0x79, 0x4b, 0xd9, 0xce, 0x69, 0x0b, 0x5c, 0x54, 0x97, 0x92, 0xc7, 0x86, 0x4d,
+	0xdf, 0xde, 0xfe, 0xee, 0x0f, 0x37, 0xaf, 0x7d, 0xff, 0x87, 0x9b, 0xd7, 0xfe, 0xfe, 0xc7, 0x77,
+	0xd6, 0x64, 0x65, 0x3d, 0xf3, 0x7a, 0x65, 0x59, 0x89, 0xcb, 0x07, 0x9e, 0x1b, 0x62, 0x37, 0x2c,
+	0x29, 0xea, 0x3f, 0x29, 0x70, 0xfd, 0x20, 0x0e, 0x09, 0xc7, 0xeb, 0x19, 0xf6, 0x17, 0x59, 0x7a,
+	0xf6, 0x20, 0x4f, 0xd9, 0x99, 0xf0, 0x64, 0xcf, 0x5e, 0x21, 0xd9, 0x73, 0xcc, 0x8c, 0x09, 0xde,
+	0xde, 0xba, 0x74, 0x4d, 0xff, 0x33, 0x01, 0xeb, 0xd1, 0x9a, 0x1e, 0x7a, 0x16, 0x39, 0x25, 0xa6,
+	0xf1, 0x45, 0xd7, 0xd4, 0x38, 0xd6, 0xb2, 0x63, 0xc4, 0xda, 0xe4, 0xd5, 0x62, 0x6d, 0x6a, 0x8c,
+	0x58, 0x9b, 0x7e, 0x51, 0xac, 0xe5, 0x5e, 0x14, 0x6b, 0xf9, 0xf1, 0x62, 0x0d, 0x9e, 0x17, 0x6b,
+	0x13, 0x25, 0x45, 0xfd, 0x63, 0x05, 0x96, 0x6a, 0x1f, 0x77, 0x49, 0xcf, 0x7b, 0x45, 0x3b, 0xfd,
+	0x00, 0x66, 0x71, 0xca, 0x1f, 0x2d, 0x65, 0xb6, 0x32, 0xdb, 0x85, 0xdd, 0xd7, 0xcb, 0xf2, 0xe0,
+	0x63, 0x28, 0x11, 0x9d, 0x7e, 0x7a, 0x74, 0x6d, 0xd0, 0x96, 0xcf, 0xf0, 0x6f, 0x15, 0x58, 0x63,
+	0x75, 0xe1, 0x0c, 0x6b, 0xf8, 0xdc, 0x08, 0xac, 0x2a, 0x76, 0x3d, 0x87, 0xbe, 0xf4, 0x3c, 0x55,
+	0x98, 0xb5, 0xb8, 0x27, 0x3d, 0xf4, 0x74, 0xc3, 0xb2, 0xf8, 0x3c, 0xb9, 0x0e, 0xeb, 0x6c, 0x79,
+	0x7b, 0x96, 0x85, 0xb6, 0xa1, 0x98, 0xe8, 0x04, 0x2c, 0xc7, 0x58, 0xe8, 0x33, 0xb5, 0xb9, 0x48,
+	0x8d, 0x67, 0x1e, 0x7e, 0x7b, 0xe3, 0xc5, 0xa1, 0xad, 0xfe, 0xb7, 0x02, 0xc5, 0xf7, 0x6d, 0xaf,
+	0x6d, 0xd8, 0x4d, 0xdb, 0xa0, 0x1d, 0x56, 0x33, 0xfb, 0x2c, 0xa5, 0x02, 0x2c, 0x2f, 0xab, 0x92,
+	0x72, 0x95, 0x94, 0x62, 0x66, 0x4c, 0x80, 0xde, 0x83, 0x85, 0xf8, 0xfa, 0x88, 0x03, 0x9c, 0xaf,
+	0x76, 0x7f, 0xf1, 0xd3, 0x4f, 0x36, 0xe7, 0xa3, 0x64, 0x3a, 0xe0, 0xc1, 0x5e, 0xd5, 0xe6, 0xcd,
+	0x81, 0x0e, 0x0b, 0x6d, 0x40, 0x81, 0xb4, 0x4d, 0x9d, 0xe2, 0x8f, 0x75, 0xb7, 0xeb, 0xf0, 0xdc,
+	0xc8, 0x6a, 0x79, 0xd2, 0x36, 0x9b, 0xf8, 0xe3, 0xe3, 0xae, 0x83, 0xbe, 0x0a, 0x2b, 0x11, 0xa8,
+	0x64, 0xd1, 0xa4, 0x33, 0x7b, 0xb6, 0x5d, 0x01, 0x4f, 0x97, 0x19, 0x6d, 0x31, 0x92, 0x3e, 0x36,
+	0x6c, 0x36, 0xd8, 0x9e, 0x65, 0x05, 0xea, 0xff, 0x16, 0x60, 0xaa, 0x61, 0x04, 0x86, 0x43, 0x51,
+	0x0b, 0xe6, 0x43, 0xec, 0xf8, 0xb6, 0x11, 0x62, 0x5d, 0x40, 0x13, 0xb9, 0xd2, 0xdb, 0x1c, 0xb2,
+	0xa4, 0x11, 0x5b, 0x39, 0x85, 0xd1, 0x7a, 0x3b, 0xe5, 0x03, 0xde, 0xdb, 0x0c, 0x8d, 0x10, 0x6b,
+	0x73, 0x91, 0x0f, 0xd1, 0x89, 0xee, 0x42, 0x29, 0x0c, 0xba, 0x34, 0x4c, 0x40, 0x43, 0x72, 0x5b,
+	0x8a, 0xb3, 0x5e, 0x89, 0xe4, 0xe2, 0x9e, 0x8d, 0x6f, 0xc9, 0x8b, 0xf1, 0x41, 0xe6, 0x65, 0xf0,
+	0x81, 0x05, 0xeb, 0x94, 0x1d, 0xaa, 0xee, 0xe0, 0x90, 0xdf, 0xe2, 0xbe, 0x8d, 0x5d, 0x42, 0x3b,
+	0x91, 0xf3, 0xa9, 0xf1, 0x9d, 0xaf, 0x72, 0x47, 0x0f, 0x99, 0x1f, 0x2d, 0x72, 0x23, 0x47, 0x39,
+	0x80, 0x8d, 0x8b, 0x47, 0x89, 0x17, 0x3e, 0xcd, 0x17, 0x7e, 0xe3, 0x02, 0x17, 0xf1, 0xea, 0x29,
+	0x7c, 0x39, 0x85, 0x36, 0x58, 0x36, 0xe9, 0x3c, 0x90, 0xf5, 0x00, 0x9f, 0xb1, 0x2b, 0xd9, 0x10,
+	0xc0, 0x03, 0xe3, 0x18, 0x31, 0xc9, 0x98, 0x66, 0x2f, 0x86, 0x54, 0x50, 0x13, 0x57, 0xc2, 0x4a,
+	0x35, 0x01, 0x25, 0x71, 0x6e, 0x6a, 0x29, 0x5f, 0x87, 0x18, 0xb3, 0x2c, 0x4a, 0x01, 0x13, 0xec,
+	0x7b, 0x66, 0x87, 0xd7, 0xa4, 0x8c, 0x36, 0x17, 0x83, 0x90, 0x1a, 0xeb, 0x45, 0x1f, 0xc2, 0x6d,
+	0xb7, 0xeb, 0xb4, 0x71, 0xa0, 0x7b, 0xa7, 0x42, 0x91, 0x67, 0x1e, 0x0d, 0x8d, 0x20, 0xd4, 0x03,
+	0x6c, 0x62, 0xd2, 0x63, 0x27, 0x2e, 0x66, 0x4e, 0x39, 0x2e, 0xca, 0x68, 0xaf, 0x0b, 0x93, 0x93,
+	0x53, 0xee, 0x83, 0xb6, 0xbc, 0x26, 0x53, 0xd7, 0x22, 0x6d, 0x31, 0x31, 0x8a, 0xea, 0x70, 0xcb,
+	0x31, 0x9e, 0xe9, 0x71, 0x30, 0xb3, 0x89, 0x63, 0x97, 0x76, 0xa9, 0x9e, 0x14, 0x73, 0x89, 0x8d,
+	0x36, 0x1c, 0xe3, 0x59, 0x43, 0xea, 0x1d, 0x44, 0x6a, 0x8f, 0x63, 0x2d, 0xf4, 0x35, 0x58, 0x61,
+	0xae, 0x6c, 0xa3, 0xeb, 0x9a, 0x1d, 0x6c, 0xe9, 0xd1, 0x1e, 0x08, 0x70, 0x94, 0xd5, 0x96, 0x1c,
+	0xe3, 0xd9, 0x91, 0x14, 0x46, 0x09, 0x48, 0xd1, 0xaf, 0x40, 0x91, 0x95, 0x6e, 0x76, 0xd7, 0xb8,
+	0x7a, 0xbb, 0x6b, 0x9d, 0xe1, 0x90, 0xc3, 0xa1, 0x59, 0x6d, 0xd6, 0x21, 0x6e, 0xcb, 0xf3, 0x8f,
+	0xf7, 0x79, 0x27, 0xfa, 0x75, 0xb8, 0x41, 0x1c, 0x07, 0x5b, 0x84, 0xe5, 0x4c, 0x72, 0xa7, 0x74,
+	0x7d, 0xcb, 0x08, 0x31, 0xe5, 0x90, 0x28, 0xa7, 0xad, 0xc6, 0x2a, 0xf1, 0xc4, 0x1e, 0x09, 0x05,
+	0xf4, 0x0e, 0xac, 0x25, 0xf6, 0x96, 0x77, 0xee, 0xb2, 0x60, 0xd7, 0x3f, 0x32, 0x88, 0x4d, 0xdc,
+	0x33, 0x8e, 0x96, 0x72, 0x5a, 0x29, 0xd6, 0xa8, 0x4a, 0x85, 0xfb, 0x42, 0x8e, 0x3e, 0x82, 0x4d,
+	0x91, 0x8f, 0x3a, 0x7e, 0xe6, 0x93, 0xa0, 0xaf, 0x9f, 0x1b, 0x81, 0xcb, 0x76, 0x3d, 0xec, 0x04,
+	0x98, 0x76, 0x3c, 0xdb, 0x2a, 0x2d, 0xc8, 0xd8, 0x18, 0x23, 0xa0, 0xd7, 0x85, 0xaf, 0x1a, 0x77,
+	0xf5, 0x44, 0x78, 0x6a, 0x45, 0x8e, 0xd0, 0x21, 0x6c, 0xb1, 0x8d, 0x1c, 0x59, 0x23, 0x0f, 0x14,
+	0xdf, 0x30, 0x9f, 0x62, 0x06, 0xc5, 0xd8, 0x96, 0xae, 0x3b, 0xc6, 0xb3, 0xe1, 0x85, 0x36, 0x70,
+	0xd0, 0xe0, 0x3a, 0xe8, 0x5d, 0xb8, 0x41, 0x43, 0xc3, 0xc6, 0xfa, 0x53, 0xdc, 0xd7, 0x0d, 0x4a,
+	0xc9, 0x99, 0xeb, 0xf0, 0x15, 0xf0, 0x88, 0x28, 0x2d, 0xf2, 0x53, 0x2d, 0x71, 0x95, 0x07, 0xb8,
+	0xbf, 0x17, 0x2b, 0x88, 0x88, 0x41, 0xf7, 0x41, 0x4d, 0xa6, 0x70, 0x8a, 0xb1, 0x8e, 0x9f, 0x61,
+	0x87, 0xdf, 0x12, 0xe9, 0x90, 0x15, 0xc8, 0x6e, 0x23, 0xd6, 0x3c, 0xc4, 0xb8, 0x16, 0xeb, 0xc5,
+	0x21, 0xdc, 0x86, 0x1b, 0x6c, 0x49, 0x12, 0xef, 0xea, 0xa6, 0xed, 0x51, 0x6c, 0xe9, 0xd1, 0x73,
+	0xb7, 0xb4, 0x3c, 0xfe, 0xd6, 0x95, 0x1c, 0xe3, 0x99, 0xc4, 0xc3, 0x07, 0xdc, 0x4b, 0xa4, 0x83,
+	0xee, 0xc3, 0x1c, 0x9f, 0x52, 0xe2, 0x76, 0x65, 0x7c, 0xb7, 0xb3, 0xdc, 0x34, 0xf6, 0xf5, 0x0e,
+	0xac, 0x05, 0x98, 0x86, 0x01, 0x31, 0x43, 0x3d, 0xb9, 0x49, 0x02, 0x2c, 0xfc, 0x5e, 0x17, 0xc1,
+	0x12, 0x69, 0xc4, 0xb7, 0x89, 0x94, 0xdf, 0xcf, 0xe6, 0xb2, 0xc5, 0xc9, 0xfb, 0xd9, 0xdc, 0x64,
+	0x71, 0xea, 0x7e, 0x36, 0x97, 0x2b, 0xe6, 0xd5, 0xaf, 0x40, 0x9e, 0xdf, 0x70, 0x7b, 0xe6, 0x53,
+	0xca, 0x71, 0x8e, 0x65, 0x05, 0x98, 0x52, 0x4c, 0x4b, 0x8a, 0xc4, 0x39, 0x51, 0x87, 0x1a, 0xc2,
+	0xea, 0xf3, 0xde, 0xce, 0x14, 0x3d, 0x81, 0x69, 0x1f, 0xf3, 0x87, 0x1d, 0x37, 0x2c, 0xec, 0xbe,
+	0x5b, 0x1e, 0x83, 0xf4, 0x28, 0x3f, 0xcf, 0xa1, 0x16, 0x79, 0x53, 0x83, 0xe4, 0xc5, 0x3e, 0x84,
+	0x9a, 0x29, 0x7a, 0x3c, 0x3c, 0xe8, 0x3b, 0x57, 0x1a, 0x74, 0xc8, 0x5f, 0x32, 0xe6, 0x6d, 0x28,
+	0xec, 0x89, 0x65, 0x1f, 0x31, 0x10, 0x37, 0xb2, 0x2d, 0x33, 0xe9, 0x6d, 0x39, 0x86, 0x39, 0x79,
+	0xec, 0x2d, 0x8f, 0xdf, 0xd2, 0xe8, 0x26, 0x40, 0x14, 0x4f, 0xc4, 0x92, 0x38, 0x27, 0x2f, 0x7b,
+	0xea, 0xd6, 0x00, 0xb6, 0x9d, 0x18, 0xc0, 0xb6, 0x1c, 0x3f, 0x79, 0xb0, 0xfa, 0x38, 0x8d, 0x3f,
+	0x39, 0x94, 0x12, 0x89, 0x43, 0x91, 0x06, 0x59, 0x8e, 0x33, 0xc5, 0x72, 0xef, 0x3e, 0x77, 0xb9,
+	0xbd, 0x9d, 0xf2, 0xf3, 0x9c, 0x54, 0x8d, 0xd0, 0x90, 0xb7, 0x01, 0xf7, 0xa5, 0xfe, 0xbe, 0x02,
+	0xa5, 0x81, 0x34, 0x63, 0xf7, 0x90, 0x61, 0x62, 0xf6, 0x13, 0xbd, 0x06, 0xb3, 0x71, 0x09, 0xe6,
+	0x30, 0x42, 0xe1, 0x30, 0x62, 0x26, 0xea, 0x64, 0xfb, 0x84, 0xde, 0x06, 0xf0, 0x03, 0xdc, 0xd3,
+	0x4d, 0x96, 0xd0, 0x7c, 0x4d, 0x85, 0xdd, 0xf5, 0x34, 0x3c, 0x10, 0x4c, 0x4c, 0xb9, 0xd1, 0x6d,
+	0xdb, 0xc4, 0x7c, 0x80, 0xfb, 0x5a, 0x8e, 0xe9, 0x1f, 0x3c, 0xc0, 0x7d, 0x86, 0x07, 0x39, 0x5c,
+	0xe7, 0x77, 0x7a, 0x46, 0x13, 0x0d, 0xf5, 0x0f, 0x15, 0xb8, 0x1e, 0x2f, 0x20, 0x3a, 0xaf, 0x46,
+	0xb7, 0xcd, 0x2c, 0xd2, 0xfb, 0xa7, 0x0c, 0xbe, 0x0d, 0x46, 0x66, 0x3b, 0x71, 0xc1, 0x6c, 0xdf,
+	0x83, 0x99, 0x38, 0x73, 0xd8, 0x7c, 0x33, 0x63, 0xcc, 0xb7, 0x10, 0x59, 0x3c, 0xc0, 0x7d, 0xf5,
+	0x3b, 0xa9, 0xb9, 0xed, 0xf7, 0x53, 0x21, 0x1c, 0x5c, 0x32, 0xb7, 0x78, 0xd8, 0xf4, 0xdc, 0xcc,
+	0xb4, 0xfd, 0xc8, 0x02, 0x32, 0xa3, 0x0b, 0x50, 0xff, 0x41, 0x81, 0x95, 0xf4, 0xa8, 0xb4, 0xe5,
+	0x35, 0x82, 0xae, 0x8b, 0x1f, 0xef, 0xbe, 0x68, 0xfc, 0xf7, 0x20, 0xe7, 0x33, 0x2d, 0x3d, 0xa4,
+	0xa5, 0x89, 0x2b, 0x80, 0xd7, 0x69, 0x6e, 0xd5, 0x62, 0x29, 0x3e, 0x37, 0xb0, 0x00, 0x2a, 0x77,
+	0xee, 0xad, 0xb1, 0x92, 0x2e, 0x95, 0x50, 0xda, 0x6c, 0x7a, 0xcd, 0x54, 0xfd, 0x6b, 0x05, 0xd0,
+	0xe8, 0xbd, 0x8d, 0xde, 0x04, 0x34, 0x70, 0xfb, 0xa7, 0xe3, 0xaf, 0xe8, 0xa7, 0xee, 0x7b, 0xbe,
+	0x73, 0x71, 0x1c, 0x4d, 0xa4, 0xe2, 0x08, 0xfd, 0x1a, 0x80, 0xcf, 0x0f, 0x71, 0xec, 0x93, 0xce,
+	0xfb, 0xd1, 0x4f, 0xc6, 0xa8, 0x7d, 0xe4, 0x11, 0x37, 0x4d, 0xdd, 0x65, 0x34, 0x60, 0x5d, 0x82,
+	0x95, 0x53, 0x7f, 0x4f, 0x49, 0x4a, 0xa2, 0xc4, 0x2d, 0x7b, 0xb6, 0x2d, 0x5f, 0x43, 0xc8, 0x87,
+	0xe9, 0x08, 0xf9, 0x88, 0x74, 0x5d, 0xbf, 0x10, 0x9d, 0x55, 0xb1, 0xc9, 0x01, 0xda, 0x5d, 0xb6,
+	0xe3, 0x7f, 0xf1, 0xf3, 0xcd, 0xdb, 0x67, 0x24, 0xec, 0x74, 0xdb, 0x65, 0xd3, 0x73, 0x24, 0x55,
+	0x2b, 0xff, 0x77, 0x87, 0x5a, 0x4f, 0x2b, 0x61, 0xdf, 0xc7, 0x34, 0xb2, 0xa1, 0x7f, 0xfe, 0x5f,
+	0x7f, 0xf5, 0x86, 0xa2, 0x45, 0xc3, 0xa8, 0x16, 0x14, 0xe3, 0xd7, 0x38, 0x0e, 0x0d, 0xcb, 0x08,
+	0x0d, 0x84, 0x20, 0xeb, 0x1a, 0x4e, 0xf4, 0xdc, 0xe2, 0xbf, 0xc7, 0x78, 0x6d, 0xad, 0x41, 0xce,
+	0x91, 0x1e, 0xe4, 0xfb, 0x3b, 0x6e, 0xab, 0x3f, 0x98, 0x86, 0xad, 0x68, 0x98, 0xba, 0x60, 0x29,
+	0xc9, 0x6f, 0x89, 0xc7, 0x28, 0x7b, 0x43, 0xe0, 0x90, 0xa1, 0xa7, 0x51, 0xe6, 0x53, 0x79, 0x35,
+	0xcc, 0xe7, 0xc4, 0xa5, 0xcc, 0x67, 0xe6, 0x12, 0xe6, 0x33, 0xfb, 0xea, 0x98, 0xcf, 0xc9, 0x57,
+	0xce, 0x7c, 0x4e, 0x7d, 0x41, 0xcc, 0xe7, 0xf4, 0x2f, 0x85, 0xf9, 0xcc, 0xbd, 0x52, 0xe6, 0x33,
+	0xff, 0x72, 0xcc, 0x27, 0xbc, 0x14, 0xf3, 0x59, 0x18, 0x8f, 0xf9, 0x14, 0x55, 0xdd, 0xc5, 0x7c,
+	0x65, 0xac, 0xea, 0xce, 0x70, 0xbb, 0x99, 0xa4, 0xb3, 0x6e, 0xa1, 0x3a, 0x14, 0xf8, 0xf3, 0x56,
+	0xb7, 0x71, 0x0f, 0xdb, 0xfc, 0xd5, 0x51, 0xd8, 0xdd, 0xbe, 0xec, 0x41, 0x1d, 0xed, 0x97, 0x06,
+	0xdc, 0xf8, 0x88, 0xd9, 0xb2, 0x74, 0x10, 0xa1, 0x2c, 0xb3, 0x6a, 0x8e, 0xa3, 0xdc, 0x02, 0xef,
+	0x93, 0x55, 0xe9, 0x67, 0x19, 0x58, 0xe1, 0x34, 0x57, 0xb3, 0x63, 0xf8, 0x2c, 0xde, 0x92, 0xac,
+	0x8c, 0xb9, 0x33, 0x65, 0x0c, 0xee, 0x6c, 0xe2, 0x6a, 0xdc, 0x59, 0x66, 0x0c, 0xee, 0x2c, 0xfb,
+	0x22, 0xee, 0x6c, 0xf2, 0x45, 0xdc, 0xd9, 0xd4, 0x78, 0xdc, 0xd9, 0xf4, 0x73, 0xb8, 0x33, 0xa4,
+	0xc2, 0x8c, 0x1f, 0x10, 0x8f, 0x5d, 0x4d, 0x29, 0xa2, 0x6e, 0xa0, 0x0f, 0xed, 0xc0, 0x32, 0xdf,
+	0x1d, 0xfd, 0x9c, 0x6f, 0x24, 0xb6, 0xa2, 0x07, 0x49, 0x5e, 0xec, 0x04, 0xdb, 0xad, 0x27, 0x52,
+	0x24, 0x9f, 0x22, 0x3e, 0x2c, 0xa5, 0x5d, 0x48, 0x4b, 0x16, 0x7b, 0xac, 0xe0, 0x7f, 0x73, 0xac,
+	0x9b, 0xb1, 0x91, 0x72, 0xf0, 0x24, 0x5d, 0x09, 0x17, 0xfd, 0x11, 0x09, 0x55, 0x9f, 0x00, 0x1a,
+	0x35, 0x40, 0x5f, 0x81, 0xe2, 0x00, 0x6e, 0xc0, 0x94, 0xca, 0x8a, 0x3f, 0x9f, 0x86, 0x0e, 0x98,
+	0x52, 0xb4, 0x02, 0x53, 0x62, 0x96, 0xf2, 0x80, 0x65, 0x4b, 0xad, 0xc0, 0x72, 0x7c, 0xf7, 0xf2,
+	0x93, 0xbe, 0xc7, 0x13, 0xa7, 0xcf, 0x0c, 0x78, 0x50, 0x88, 0x6b, 0x2c, 0xa3, 0xc9, 0x96, 0xba,
+	0x09, 0x85, 0xf8, 0x1a, 0xb0, 0x28, 0x2a, 0x42, 0x86, 0x58, 0xd1, 0xb3, 0x81, 0xfd, 0x54, 0x77,
+	0xe0, 0xfa, 0x5e, 0x74, 0xd2, 0xd8, 0x4a, 0xb3, 0x81, 0xcc, 0xa7, 0x60, 0xe4, 0xa4, 0xbe, 0x6c,
+	0xa9, 0x7f, 0xa7, 0xc0, 0x52, 0xdd, 0x8d, 0xea, 0x49, 0x2a, 0x72, 0x3f, 0x80, 0x82, 0xe5, 0x75,
+	0xdb, 0x36, 0xd6, 0x19, 0x4a, 0x95, 0x97, 0xc9, 0xdd, 0xb1, 0xf6, 0x97, 0xbf, 0x6f, 0xd8, 0x73,
+	0x39, 0x71, 0xa7, 0x81, 0x70, 0xd6, 0x24, 0x67, 0x2e, 0x6a, 0x41, 0x2e, 0x7a, 0x75, 0x97, 0x26,
+	0x5e, 0xd2, 0x6f, 0xec, 0x49, 0xfd, 0x77, 0x05, 0x16, 0x2f, 0xd0, 0x40, 0xdf, 0x86, 0x39, 0xc1,
+	0x0b, 0xc5, 0x45, 0x93, 0x23, 0x9a, 0xfd, 0x6f, 0xb0, 0x23, 0xff, 0xb7, 0x4f, 0x36, 0x6f, 0x88,
+	0xcb, 0x9e, 0x5a, 0x4f, 0xcb, 0xc4, 0xab, 0x38, 0x46, 0xd8, 0x29, 0x1f, 0xe1, 0x33, 0xc3, 0xec,
+	0x57, 0xb1, 0xf9, 0xcf, 0x3f, 0xbe, 0x03, 0x42, 0xcc, 0x10, 0x80, 0xb8, 0xfc, 0x67, 0xb9, 0xb7,
+	0xb8, 0xb6, 0xde, 0x83, 0x59, 0xc6, 0x1c, 0x24, 0x4f, 0xcd, 0x89, 0xf1, 0x0b, 0xff, 0x0c, 0xb3,
+	0x8c, 0xfa, 0x59, 0xe2, 0x86, 0x9e, 0xd3, 0xa6, 0xa1, 0xe7, 0x62, 0x9e, 0xdc, 0x39, 0x2d, 0xe9,
+	0x50, 0xff, 0x46, 0x81, 0xa5, 0x56, 0x27, 0xf0, 0xc2, 0xd0, 0x1e, 0x2c, 0x31, 0x97, 0xf3, 0x5e,
+	0xca, 0xe5, 0xbc, 0xd7, 0x65, 0x14, 0xdd, 0xc4, 0xab, 0xa0, 0xe8, 0xd4, 0x3f, 0x51, 0xe0, 0xe6,
+	0x10, 0x90, 0x89, 0x61, 0x28, 0xe7, 0x31, 0x47, 0xc0, 0x87, 0x32, 0x0a, 0x3e, 0xbe, 0x0d, 0xf3,
+	0x09, 0x35, 0x45, 0x99, 0x95, 0x9c, 0x5d, 0xf9, 0x52, 0xc2, 0x74, 0x60, 0x2c, 0x99, 0xf3, 0x73,
+	0xe6, 0x40, 0xaf, 0xfa, 0x3b, 0x0a, 0x2c, 0x0d, 0x14, 0x73, 0xe2, 0x63, 0x9b, 0xb8, 0x98, 0x65,
+	0x50, 0x0a, 0x58, 0x65, 0x34, 0xd9, 0x42, 0xdf, 0x82, 0x49, 0x1a, 0x62, 0x9f, 0x61, 0x7c, 0x56,
+	0x82, 0xbe, 0x3e, 0x5e, 0x09, 0x4a, 0x8d, 0xd0, 0x0c, 0xb1, 0x2f, 0x27, 0x23, 0x3c, 0xa9, 0x01,
+	0x14, 0x87, 0x15, 0x2e, 0x84, 0x95, 0xaf, 0xc1, 0x6c, 0xea, 0x22, 0x21, 0x2e, 0x9f, 0x42, 0x5e,
+	0x9b, 0x49, 0x3a, 0xeb, 0x2e, 0x7a, 0x1d, 0xe6, 0x52, 0x4a, 0x5e, 0x37, 0x94, 0x44, 0x7e, 0xca,
+	0xf4, 0xa4, 0x1b, 0xaa, 0x3f, 0x9b, 0x80, 0xb9, 0xc3, 0xae, 0x6b, 0x1d, 0xda, 0xde, 0xb9, 0x86,
+	0x4d, 0x2f, 0xb0, 0x50, 0x0d, 0xb2, 0x0c, 0xfd, 0xf2, 0x21, 0xe7, 0x76, 0x77, 0xc6, 0x5a, 0x58,
+	0xe4, 0xa2, 0xd5, 0xf7, 0xb1, 0xc6, 0xcd, 0xd9, 0x04, 0x1c, 0xcf, 0xea, 0xda, 0x58, 0x37, 0x4c,
+	0xd3, 0xeb, 0xba, 0xa1, 0xc4, 0xbf, 0xb3, 0xa2, 0x77, 0x4f, 0x74, 0x32, 0x50, 0x19, 0xc3, 0x9d,
+	0xf8, 0x23, 0x14, 0x98, 0x71, 0xc1, 0x43, 0x1d, 0x98, 0x32, 0x1c, 0x6e, 0x9f, 0xdd, 0xca, 0xbc,
+	0x98, 0x7b, 0xfd, 0xba, 0x84, 0xf6, 0xdb, 0x63, 0x40, 0xfb, 0x14, 0xae, 0x97, 0xfe, 0x53, 0x47,
+	0x3d, 0x39, 0x70, 0xd4, 0x77, 0x21, 0xcb, 0x8b, 0xd6, 0xd4, 0x15, 0x00, 0x2d, 0xb7, 0x50, 0x7f,
+	0xa0, 0xc0, 0x72, 0x14, 0xf9, 0x82, 0xf9, 0x3c, 0x34, 0x88, 0xdd, 0x0d, 0x30, 0x7b, 0x46, 0xe1,
+	0x20, 0xf0, 0x82, 0xe8, 0xf3, 0x0c, 0x6f, 0xa4, 0x66, 0x30, 0x71, 0xe1, 0x0c, 0x32, 0x57, 0x9d,
+	0x01, 0xab, 0x2e, 0x01, 0x0e, 0x03, 0x62, 0xb4, 0x6d, 0x81, 0xc8, 0x73, 0x5a, 0xd2, 0xa1, 0xfe,
+	0x68, 0x22, 0x79, 0xe1, 0xb2, 0x2c, 0x3b, 0xf0, 0x1c, 0x87, 0x84, 0x9c, 0x90, 0xf8, 0x06, 0x5c,
+	0x17, 0xe4, 0x37, 0x0e, 0xb0, 0xa5, 0x5f, 0x90, 0x9d, 0xcb, 0x89, 0xf8, 0xfd, 0x54, 0x9e, 0x7e,
+	0x0d, 0x56, 0x52, 0x76, 0xe9, 0xf7, 0x82, 0x78, 0x51, 0x2c, 0x25, 0xd2, 0xfd, 0xe4, 0xe5, 0x70,
+	0x0b, 0x66, 0x04, 0xc7, 0xa9, 0x8b, 0x50, 0x11, 0xdf, 0x5b, 0x0a, 0xa2, 0xef, 0x80, 0x9f, 0xce,
+	0x9b, 0x80, 0x6c, 0x83, 0x86, 0x92, 0x0b, 0x1d, 0x7c, 0x2c, 0x16, 0x99, 0x44, 0xd0, 0x9f, 0xf2,
+	0x39, 0xb3, 0x06, 0x39, 0x23, 0x0c, 0x31, 0xbb, 0x10, 0xf9, 0x69, 0xe6, 0xb4, 0xb8, 0xcd, 0x60,
+	0xac, 0xf8, 0x2d, 0x68, 0x7d, 0xe9, 0x69, 0x4a, 0xc0, 0xd8, 0x94, 0x44, 0xe2, 0xbc, 0x7f, 0x9c,
+	0x80, 0xc5, 0x98, 0x1a, 0xe1, 0xd4, 0x0e, 0x2b, 0x19, 0x94, 0xf1, 0xf7, 0x3d, 0x6a, 0x4a, 0x3e,
+	0x96, 0xea, 0x34, 0xfa, 0x86, 0x93, 0xd5, 0xe6, 0x7a, 0xd4, 0x14, 0x9a, 0xb4, 0xc9, 0xf6, 0xf2,
+	0x3d, 0x58, 0x67, 0x9a, 0x8e, 0x11, 0x76, 0xd9, 0xa6, 0x44, 0x16, 0x82, 0xb9, 0xc7, 0xa2, 0xcc,
+	0x66, 0xb5, 0xd5, 0x1e, 0x35, 0x1f, 0x0a, 0x15, 0x69, 0xac, 0x49, 0x05, 0xb6, 0xa9, 0xa2, 0x4e,
+	0x8f, 0x98, 0x8a, 0x8d, 0x5a, 0xe2, 0xd2, 0x61, 0xab, 0x5d, 0x58, 0x1e, 0xb4, 0xea, 0x18, 0xae,
+	0x65, 0x63, 0x8b, 0x6f, 0x5a, 0x56, 0x5b, 0x4c, 0x1b, 0xdd, 0x13, 0xa2, 0x51, 0x9b, 0xb6, 0xd7,
+	0x75, 0x4d, 0xb9, 0x89, 0x43, 0x36, 0xfb, 0x42, 0xc4, 0x30, 0x22, 0x0f, 0x5f, 0xdd, 0x30, 0x9f,
+	0xa6, 0xa6, 0x26, 0xa0, 0xe4, 0x02, 0x17, 0x31, 0xda, 0x33, 0x9a, 0x97, 0xfa, 0xdb, 0xb0, 0xd2,
+	0x08, 0xb0, 0xc8, 0x87, 0x01, 0x42, 0xec, 0xca, 0x94, 0x53, 0x7e, 0x88, 0x72, 0xba, 0x75, 0x01,
+	0xe5, 0x94, 0x1f, 0x24, 0x95, 0xfe, 0x52, 0x81, 0x95, 0x26, 0xfb, 0x02, 0xd1, 0xb5, 0xb1, 0x35,
+	0x38, 0xfa, 0x50, 0x29, 0x52, 0x46, 0x4a, 0xd1, 0x2b, 0x9a, 0x03, 0xba, 0x0d, 0x0b, 0x1c, 0x32,
+	0x0f, 0xc4, 0x9f, 0x8c, 0xe4, 0x44, 0x20, 0xc3, 0xef, 0x5f, 0x52, 0xe4, 0x87, 0xf8, 0xcc, 0xf7,
+	0xc8, 0x3f, 0x0b, 0x0c, 0x0b, 0x37, 0x6c, 0xc3, 0x65, 0xef, 0xff, 0xae, 0x68, 0x5e, 0xf9, 0xfd,
+	0x2f, 0xed, 0x64, 0xc2, 0x6c, 0xc1, 0x8c, 0x8b, 0xcf, 0x87, 0x3e, 0x96, 0x6a, 0xe0, 0xe2, 0xf3,
+	0xe8, 0x93, 0xe8, 0x45, 0x0f, 0xf3, 0xcc, 0xff, 0xff, 0x61, 0xae, 0xfe, 0x41, 0x8a, 0x5e, 0x8b,
+	0x3e, 0x2b, 0x35, 0xcf, 0x49, 0x68, 0x76, 0x50, 0x0d, 0x66, 0x29, 0xff, 0x75, 0xd5, 0x45, 0xcd,
+	0x08, 0x33, 0xb9, 0xa6, 0x1d, 0x58, 0x66, 0x6b, 0x4a, 0xd8, 0xad, 0xc1, 0xc5, 0x21, 0x17, 0x9f,
+	0xc7, 0xdf, 0xb3, 0xc4, 0x22, 0xd5, 0xdf, 0xcd, 0x00, 0x92, 0x71, 0xdd, 0x4c, 0x42, 0xfd, 0xf2,
+	0xd0, 0xd8, 0x85, 0xe5, 0x58, 0x21, 0x26, 0xd1, 0xd8, 0xeb, 0x40, 0x0c, 0xb5, 0x18, 0x09, 0x23,
+	0x1e, 0x8d, 0xbd, 0x10, 0x76, 0x61, 0x79, 0x94, 0x78, 0x63, 0x36, 0x22, 0x64, 0x16, 0x87, 0xb9,
+	0x37, 0x4c, 0x45, 0xd1, 0x31, 0x6c, 0x8a, 0xe3, 0x3a, 0x48, 0xa2, 0x74, 0x9e, 0x13, 0xfd, 0xa2,
+	0x0a, 0xd6, 0x2d, 0xa4, 0x01, 0x3a, 0x25, 0x01, 0x8d, 0x3e, 0x10, 0x62, 0x41, 0xca, 0x4c, 0x5e,
+	0xe1, 0x06, 0x29, 0x72, 0x7b, 0x99, 0xb6, 0x4c, 0x01, 0x35, 0x60, 0xc1, 0x36, 0x86, 0x5d, 0x5e,
+	0xe5, 0x5a, 0x9c, 0xb7, 0x8d, 0x41, 0x8f, 0x25, 0x98, 0x16, 0x15, 0x46, 0xbc, 0x29, 0x67, 0xb5,
+	0xa8, 0xa9, 0xfe, 0x87, 0x02, 0xb3, 0xac, 0x7a, 0x3e, 0x6e, 0x1e, 0xc8, 0x43, 0xb8, 0x84, 0xef,
+	0x5f, 0x83, 0x1c, 0xc5, 0x1f, 0x77, 0xb1, 0x6b, 0x62, 0x59, 0x51, 0xe3, 0x36, 0xff, 0x2b, 0x1d,
+	0xec, 0x5a, 0xfa, 0x95, 0x6f, 0xd1, 0x1c, 0x33, 0xe3, 0x33, 0xd5, 0x20, 0xcb, 0x69, 0xba, 0xec,
+	0x96, 0xf2, 0x2a, 0x3e, 0x09, 0x70, 0x8a, 0xef, 0x3b, 0x43, 0x5f, 0x04, 0x4e, 0xda, 0x14, 0x07,
+	0x22, 0xfb, 0x19, 0x5d, 0x10, 0x06, 0xcc, 0xcc, 0xd2, 0x29, 0x71, 0xcd, 0x81, 0xfc, 0xce, 0x68,
+	0x48, 0xca, 0x9a, 0x4c, 0x24, 0xc3, 0xfd, 0x2d, 0x58, 0xe2, 0xa7, 0xe3, 0x71, 0x2f, 0xd8, 0xd2,
+	0x07, 0xb0, 0x04, 0xbf, 0x3d, 0x4f, 0xa4, 0x48, 0xd6, 0x96, 0x5f, 0x28, 0xb0, 0x14, 0xa5, 0xa0,
+	0x08, 0x1c, 0x89, 0x01, 0xd7, 0x21, 0x4f, 0xbb, 0x6d, 0x87, 0x84, 0x21, 0x8e, 0x20, 0x4a, 0xd2,
+	0xf1, 0x05, 0xc0, 0x94, 0xdf, 0x00, 0x56, 0xe8, 0xdd, 0x33, 0x4c, 0x25, 0xca, 0xbb, 0x7b, 0xa5,
+	0x2f, 0x4c, 0x87, 0x04, 0xdb, 0x96, 0xd8, 0x69, 0xb9, 0xbf, 0x91, 0x3b, 0x15, 0xc3, 0xe2, 0x05,
+	0x5a, 0x0c, 0x7f, 0x9d, 0xb2, 0x66, 0x84, 0xbf, 0x78, 0x83, 0x51, 0x21, 0x9e, 0x6d, 0x31, 0x8a,
+	0xa3, 0x8b, 0x65, 0xe6, 0xe6, 0x3c, 0xdb, 0x7a, 0xcc, 0xda, 0x4c, 0xc8, 0xaa, 0x89, 0x10, 0x4a,
+	0xb2, 0xd6, 0xc5, 0xe7, 0x5c, 0xa8, 0x7e, 0xae, 0xc0, 0x52, 0x7c, 0xec, 0x27, 0x7e, 0x58, 0x77,
+	0xe5, 0x4e, 0x5e, 0x5a, 0x39, 0x56, 0x21, 0xe7, 0xf9, 0x8c, 0x04, 0x21, 0xe2, 0x11, 0x99, 0xd3,
+	0xa6, 0x79, 0x9b, 0x63, 0xf8, 0xf9, 0x53, 0x2f, 0x30, 0x19, 0x8e, 0xea, 0x8b, 0x0f, 0xe4, 0xf2,
+	0x81, 0x38, 0x23, 0xba, 0xf7, 0xfb, 0xec, 0xf3, 0x78, 0xea, 0x38, 0xb2, 0x17, 0x1e, 0xc7, 0xe4,
+	0x95, 0x8f, 0xe3, 0x4d, 0x40, 0xe2, 0x4b, 0xaa, 0xf8, 0x13, 0x83, 0x01, 0x84, 0x54, 0xe4, 0x12,
+	0xfe, 0xc7, 0x04, 0x32, 0x8a, 0xfe, 0x48, 0x81, 0x9b, 0x23, 0x1f, 0x91, 0xf8, 0x1e, 0x44, 0xd4,
+	0xc6, 0xa5, 0x9b, 0xf0, 0x01, 0xe3, 0xf0, 0xd9, 0x7e, 0x45, 0xef, 0xa9, 0x5f, 0x1d, 0xeb, 0xfc,
+	0x2f, 0xda, 0xf1, 0x28, 0x00, 0xa4, 0x3f, 0xf5, 0xcf, 0x14, 0xc8, 0x73, 0x16, 0xa9, 0xee, 0x9e,
+	0x7a, 0x9c, 0xd7, 0x4b, 0xaf, 0x49, 0x64, 0x53, 0x81, 0x26, 0xcb, 0xe1, 0x2c, 0x36, 0x57, 0x49,
+	0x31, 0x15, 0xe3, 0xb2, 0xd8, 0xcc, 0x8e, 0x49, 0x44, 0x6d, 0xc7, 0x3d, 0xe2, 0x75, 0xa9, 0x60,
+	0xb7, 0x74, 0x41, 0xaf, 0xca, 0xcf, 0x6f, 0x8b, 0x91, 0x90, 0xcf, 0x6c, 0x9f, 0x8b, 0xde, 0xf8,
+	0x4f, 0x05, 0x66, 0xe3, 0x0b, 0xb1, 0x63, 0x50, 0x8c, 0x36, 0x60, 0xed, 0xe0, 0xe4, 0xb8, 0xf9,
+	0xe8, 0x61, 0x4d, 0xd3, 0x1b, 0xf7, 0xf6, 0x9a, 0x35, 0xfd, 0xd1, 0x71, 0xb3, 0x51, 0x3b, 0xa8,
+	0x1f, 0xd6, 0x6b, 0xd5, 0xe2, 0x35, 0x74, 0x13, 0x56, 0x87, 0xe4, 0x5a, 0xed, 0xfd, 0x7a, 0xb3,
+	0x55, 0xd3, 0x6a, 0xd5, 0xa2, 0x72, 0x81, 0x79, 0xfd, 0xb8, 0xde, 0xaa, 0xef, 0x1d, 0xd5, 0x3f,
+	0xac, 0x55, 0x8b, 0x13, 0xe8, 0x06, 0x5c, 0x1f, 0x92, 0x1f, 0xed, 0x3d, 0x3a, 0x3e, 0xb8, 0x57,
+	0xab, 0x16, 0x33, 0x68, 0x0d, 0x56, 0x86, 0x84, 0xcd, 0xd6, 0x49, 0xa3, 0x51, 0xab, 0x16, 0xb3,
+	0x17, 0xc8, 0xaa, 0xb5, 0xa3, 0x5a, 0xab, 0x56, 0x2d, 0x4e, 0xa2, 0x55, 0x58, 0x1e, 0x92, 0x35,
+	0xf6, 0x1e, 0x35, 0x6b, 0xd5, 0xe2, 0xd4, 0x5a, 0xf6, 0xbb, 0x7f, 0xba, 0x71, 0xed, 0x8d, 0x1f,
+	0x29, 0x30, 0x93, 0x7e, 0x2f, 0xb2, 0x69, 0x1e, 0x3e, 0x3a, 0xae, 0xea, 0x87, 0x47, 0x27, 0x4f,
+	0xf4, 0xd6, 0x07, 0x8d, 0xe1, 0x55, 0xbe, 0x06, 0x9b, 0x43, 0xf2, 0x78, 0x00, 0xad, 0xf6, 0x64,
+	0x4f, 0xab, 0x36, 0x8b, 0x0a, 0xfa, 0x12, 0x6c, 0x0d, 0x29, 0x3d, 0xde, 0x3b, 0xaa, 0x57, 0xf7,
+	0x5a, 0x27, 0x89, 0xd6, 0x04, 0xba, 0x05, 0x37, 0x47, 0x5c, 0x3d, 0x7c, 0xf8, 0xe8, 0xb8, 0xde,
+	0xfa, 0x40, 0x6f, 0x9c, 0x9c, 0x1c, 0x15, 0x33, 0x62, 0x92, 0xfb, 0x4f, 0x7e, 0xf2, 0xe9, 0x86,
+	0xf2, 0xd3, 0x4f, 0x37, 0x94, 0x5f, 0x7c, 0xba, 0xa1, 0x7c, 0xef, 0xb3, 0x8d, 0x6b, 0x3f, 0xfd,
+	0x6c, 0xe3, 0xda, 0xbf, 0x7e, 0xb6, 0x71, 0xed, 0xc3, 0x77, 0x47, 0x1f, 0x97, 0x49, 0xa8, 0xde,
+	0x89, 0xff, 0x64, 0xbe, 0xf7, 0xcd, 0xca, 0xb3, 0xc1, 0x7f, 0xaf, 0xc0, 0xdf, 0x9d, 0xed, 0x29,
+	0x1e, 0x41, 0x5f, 0xfd, 0xbf, 0x01, 0x00, 0x48, 0x5e, 0x0e, 0x3d, 0xe0, 0x30, 0x00, 0x00,
+}
+
+func (m *ConsumerAdditionProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3427,25 +4074,16 @@ func (m *PowerShapingParameters) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PowerShapingParameters) MarshalTo(dAtA []byte) (int, error) {
+func (m *ConsumerAdditionProposal) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PowerShapingParameters) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ConsumerAdditionProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Prioritylist) > 0 {
-		for iNdEx := len(m.Prioritylist) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Prioritylist[iNdEx])
-			copy(dAtA[i:], m.Prioritylist[iNdEx])
-			i = encodeVarintProvider(dAtA, i, uint64(len(m.Prioritylist[iNdEx])))
-			i--
-			dAtA[i] = 0x42
-		}
-	}
 	if m.AllowInactiveVals {
 		i--
 		if m.AllowInactiveVals {
@@ -3454,12 +4092,16 @@ func (m *PowerShapingParameters) MarshalToSizedBuffer(dAtA []byte) (int, error)
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x38
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
 	}
 	if m.MinStake != 0 {
 		i = encodeVarintProvider(dAtA, i, uint64(m.MinStake))
 		i--
-		dAtA[i] = 0x30
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
 	}
 	if len(m.Denylist) > 0 {
 		for iNdEx := len(m.Denylist) - 1; iNdEx >= 0; iNdEx-- {
@@ -3467,7 +4109,9 @@ func (m *PowerShapingParameters) MarshalToSizedBuffer(dAtA []byte) (int, error)
 			copy(dAtA[i:], m.Denylist[iNdEx])
 			i = encodeVarintProvider(dAtA, i, uint64(len(m.Denylist[iNdEx])))
 			i--
-			dAtA[i] = 0x2a
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
 		}
 	}
 	if len(m.Allowlist) > 0 {
@@ -3476,28 +4120,135 @@ func (m *PowerShapingParameters) MarshalToSizedBuffer(dAtA []byte) (int, error)
 			copy(dAtA[i:], m.Allowlist[iNdEx])
 			i = encodeVarintProvider(dAtA, i, uint64(len(m.Allowlist[iNdEx])))
 			i--
-			dAtA[i] = 0x22
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
 		}
 	}
 	if m.ValidatorSetCap != 0 {
 		i = encodeVarintProvider(dAtA, i, uint64(m.ValidatorSetCap))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
 	}
 	if m.ValidatorsPowerCap != 0 {
 		i = encodeVarintProvider(dAtA, i, uint64(m.ValidatorsPowerCap))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
 	}
 	if m.Top_N != 0 {
 		i = encodeVarintProvider(dAtA, i, uint64(m.Top_N))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x78
+	}
+	if len(m.DistributionTransmissionChannel) > 0 {
+		i -= len(m.DistributionTransmissionChannel)
+		copy(dAtA[i:], m.DistributionTransmissionChannel)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.DistributionTransmissionChannel)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.HistoricalEntries != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.HistoricalEntries))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.BlocksPerDistributionTransmission != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.BlocksPerDistributionTransmission))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.ConsumerRedistributionFraction) > 0 {
+		i -= len(m.ConsumerRedistributionFraction)
+		copy(dAtA[i:], m.ConsumerRedistributionFraction)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ConsumerRedistributionFraction)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TransferTimeoutPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TransferTimeoutPeriod):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintProvider(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x52
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.CcvTimeoutPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.CcvTimeoutPeriod):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintProvider(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x4a
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UnbondingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingPeriod):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintProvider(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x42
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.SpawnTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SpawnTime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintProvider(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x3a
+	if len(m.BinaryHash) > 0 {
+		i -= len(m.BinaryHash)
+		copy(dAtA[i:], m.BinaryHash)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.BinaryHash)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.GenesisHash) > 0 {
+		i -= len(m.GenesisHash)
+		copy(dAtA[i:], m.GenesisHash)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.GenesisHash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size, err := m.InitialHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProvider(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ConsumerIds) Marshal() (dAtA []byte, err error) {
+func (m *ConsumerRemovalProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3507,29 +4258,49 @@ func (m *ConsumerIds) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ConsumerIds) MarshalTo(dAtA []byte) (int, error) {
+func (m *ConsumerRemovalProposal) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ConsumerIds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ConsumerRemovalProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Ids) > 0 {
-		for iNdEx := len(m.Ids) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Ids[iNdEx])
-			copy(dAtA[i:], m.Ids[iNdEx])
-			i = encodeVarintProvider(dAtA, i, uint64(len(m.Ids[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StopTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StopTime):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintProvider(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x22
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *AllowlistedRewardDenoms) Marshal() (dAtA []byte, err error) {
+func (m *ConsumerModificationProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3539,29 +4310,89 @@ func (m *AllowlistedRewardDenoms) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *AllowlistedRewardDenoms) MarshalTo(dAtA []byte) (int, error) {
+func (m *ConsumerModificationProposal) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AllowlistedRewardDenoms) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ConsumerModificationProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Denoms) > 0 {
-		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Denoms[iNdEx])
-			copy(dAtA[i:], m.Denoms[iNdEx])
-			i = encodeVarintProvider(dAtA, i, uint64(len(m.Denoms[iNdEx])))
+	if m.AllowInactiveVals {
+		i--
+		if m.AllowInactiveVals {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.MinStake != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.MinStake))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.Denylist) > 0 {
+		for iNdEx := len(m.Denylist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denylist[iNdEx])
+			copy(dAtA[i:], m.Denylist[iNdEx])
+			i = encodeVarintProvider(dAtA, i, uint64(len(m.Denylist[iNdEx])))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Allowlist) > 0 {
+		for iNdEx := len(m.Allowlist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Allowlist[iNdEx])
+			copy(dAtA[i:], m.Allowlist[iNdEx])
+			i = encodeVarintProvider(dAtA, i, uint64(len(m.Allowlist[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
 		}
 	}
+	if m.ValidatorSetCap != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.ValidatorSetCap))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ValidatorsPowerCap != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.ValidatorsPowerCap))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Top_N != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.Top_N))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *InfractionParameters) Marshal() (dAtA []byte, err error) {
+func (m *EquivocationProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3571,44 +4402,48 @@ func (m *InfractionParameters) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *InfractionParameters) MarshalTo(dAtA []byte) (int, error) {
+func (m *EquivocationProposal) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *InfractionParameters) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EquivocationProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Downtime != nil {
-		{
-			size, err := m.Downtime.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
+	if len(m.Equivocations) > 0 {
+		for iNdEx := len(m.Equivocations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Equivocations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProvider(dAtA, i, uint64(size))
 			}
-			i -= size
-			i = encodeVarintProvider(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x1a
 		}
-		i--
-		dAtA[i] = 0x12
 	}
-	if m.DoubleSign != nil {
-		{
-			size, err := m.DoubleSign.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintProvider(dAtA, i, uint64(size))
-		}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.Title)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *SlashJailParameters) Marshal() (dAtA []byte, err error) {
+func (m *ChangeRewardDenomsProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3618,708 +4453,6604 @@ func (m *SlashJailParameters) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SlashJailParameters) MarshalTo(dAtA []byte) (int, error) {
+func (m *ChangeRewardDenomsProposal) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SlashJailParameters) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ChangeRewardDenomsProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Tombstone {
-		i--
-		if m.Tombstone {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if len(m.DenomsToRemove) > 0 {
+		for iNdEx := len(m.DenomsToRemove) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DenomsToRemove[iNdEx])
+			copy(dAtA[i:], m.DenomsToRemove[iNdEx])
+			i = encodeVarintProvider(dAtA, i, uint64(len(m.DenomsToRemove[iNdEx])))
+			i--
+			dAtA[i] = 0x22
 		}
-		i--
-		dAtA[i] = 0x18
-	}
-	n24, err24 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.JailDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.JailDuration):])
-	if err24 != nil {
-		return 0, err24
 	}
-	i -= n24
-	i = encodeVarintProvider(dAtA, i, uint64(n24))
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.SlashFraction.Size()
-		i -= size
-		if _, err := m.SlashFraction.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	if len(m.DenomsToAdd) > 0 {
+		for iNdEx := len(m.DenomsToAdd) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DenomsToAdd[iNdEx])
+			copy(dAtA[i:], m.DenomsToAdd[iNdEx])
+			i = encodeVarintProvider(dAtA, i, uint64(len(m.DenomsToAdd[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
 		}
-		i = encodeVarintProvider(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintProvider(dAtA []byte, offset int, v uint64) int {
-	offset -= sovProvider(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *GlobalSlashEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *ConsumerAdditionProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *GlobalSlashEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GlobalSlashEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
+	if len(m.ProviderValConsAddr) > 0 {
+		i -= len(m.ProviderValConsAddr)
+		copy(dAtA[i:], m.ProviderValConsAddr)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ProviderValConsAddr)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
+	if m.IbcSeqNum != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.IbcSeqNum))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
+	if len(m.ConsumerChainID) > 0 {
+		i -= len(m.ConsumerChainID)
+		copy(dAtA[i:], m.ConsumerChainID)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ConsumerChainID)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = m.InitialHeight.Size()
-	n += 1 + l + sovProvider(uint64(l))
-	l = len(m.GenesisHash)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
+	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.RecvTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.RecvTime):])
+	if err7 != nil {
+		return 0, err7
 	}
-	l = len(m.BinaryHash)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
+	i -= n7
+	i = encodeVarintProvider(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SpawnTime)
-	n += 1 + l + sovProvider(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingPeriod)
-	n += 1 + l + sovProvider(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.CcvTimeoutPeriod)
-	n += 1 + l + sovProvider(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TransferTimeoutPeriod)
-	n += 1 + l + sovProvider(uint64(l))
-	l = len(m.ConsumerRedistributionFraction)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RestrictConsumerCreation {
+		i--
+		if m.RestrictConsumerCreation {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
 	}
-	if m.BlocksPerDistributionTransmission != 0 {
-		n += 1 + sovProvider(uint64(m.BlocksPerDistributionTransmission))
+	n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.EpochDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.EpochDuration):])
+	if err8 != nil {
+		return 0, err8
 	}
-	if m.HistoricalEntries != 0 {
-		n += 1 + sovProvider(uint64(m.HistoricalEntries))
+	i -= n8
+	i = encodeVarintProvider(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xb2
+	n9, err9 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxChannelClosedDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxChannelClosedDuration):])
+	if err9 != nil {
+		return 0, err9
 	}
-	l = len(m.DistributionTransmissionChannel)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
+	i -= n9
+	i = encodeVarintProvider(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xaa
+	if m.ValidatorFeeExemptionsPerEpoch != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.ValidatorFeeExemptionsPerEpoch))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
 	}
-	if m.Top_N != 0 {
-		n += 1 + sovProvider(uint64(m.Top_N))
+	if m.StaleKeyAssignmentEpochs != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.StaleKeyAssignmentEpochs))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
 	}
-	if m.ValidatorsPowerCap != 0 {
-		n += 2 + sovProvider(uint64(m.ValidatorsPowerCap))
+	if m.MaxValidatorUpdatesPerPacket != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.MaxValidatorUpdatesPerPacket))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
 	}
-	if m.ValidatorSetCap != 0 {
-		n += 2 + sovProvider(uint64(m.ValidatorSetCap))
+	n10, err10 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.ClientExpiryWarningThreshold, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ClientExpiryWarningThreshold):])
+	if err10 != nil {
+		return 0, err10
 	}
-	if len(m.Allowlist) > 0 {
-		for _, s := range m.Allowlist {
-			l = len(s)
-			n += 2 + l + sovProvider(uint64(l))
+	i -= n10
+	i = encodeVarintProvider(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	if m.ImmediateDowntimeJailing {
+		i--
+		if m.ImmediateDowntimeJailing {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
 	}
-	if len(m.Denylist) > 0 {
-		for _, s := range m.Denylist {
-			l = len(s)
-			n += 2 + l + sovProvider(uint64(l))
+	if m.ImmediateValidatorUpdates {
+		i--
+		if m.ImmediateValidatorUpdates {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x78
 	}
-	if m.MinStake != 0 {
-		n += 2 + sovProvider(uint64(m.MinStake))
+	if m.MinTopNBudget != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.MinTopNBudget))
+		i--
+		dAtA[i] = 0x70
 	}
-	if m.AllowInactiveVals {
-		n += 3
+	if m.MaxLaunchedConsumers != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.MaxLaunchedConsumers))
+		i--
+		dAtA[i] = 0x68
 	}
-	return n
-}
-
-func (m *ConsumerRemovalProposal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
-	}
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
-	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StopTime)
-	n += 1 + l + sovProvider(uint64(l))
-	return n
-}
-
-func (m *ConsumerModificationProposal) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.MaxProviderConsensusValidators != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.MaxProviderConsensusValidators))
+		i--
+		dAtA[i] = 0x60
 	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
+	if m.NumberOfEpochsToStartReceivingRewards != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.NumberOfEpochsToStartReceivingRewards))
+		i--
+		dAtA[i] = 0x58
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
+	if m.BlocksPerEpoch != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.BlocksPerEpoch))
+		i--
+		dAtA[i] = 0x50
 	}
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
+	{
+		size, err := m.ConsumerRewardDenomRegistrationFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProvider(dAtA, i, uint64(size))
 	}
-	if m.Top_N != 0 {
-		n += 1 + sovProvider(uint64(m.Top_N))
+	i--
+	dAtA[i] = 0x4a
+	if len(m.SlashMeterReplenishFraction) > 0 {
+		i -= len(m.SlashMeterReplenishFraction)
+		copy(dAtA[i:], m.SlashMeterReplenishFraction)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.SlashMeterReplenishFraction)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	if m.ValidatorsPowerCap != 0 {
-		n += 1 + sovProvider(uint64(m.ValidatorsPowerCap))
+	n12, err12 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.SlashMeterReplenishPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.SlashMeterReplenishPeriod):])
+	if err12 != nil {
+		return 0, err12
 	}
-	if m.ValidatorSetCap != 0 {
-		n += 1 + sovProvider(uint64(m.ValidatorSetCap))
+	i -= n12
+	i = encodeVarintProvider(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x32
+	n13, err13 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.CcvTimeoutPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.CcvTimeoutPeriod):])
+	if err13 != nil {
+		return 0, err13
 	}
-	if len(m.Allowlist) > 0 {
-		for _, s := range m.Allowlist {
-			l = len(s)
-			n += 1 + l + sovProvider(uint64(l))
-		}
+	i -= n13
+	i = encodeVarintProvider(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.TrustingPeriodFraction) > 0 {
+		i -= len(m.TrustingPeriodFraction)
+		copy(dAtA[i:], m.TrustingPeriodFraction)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.TrustingPeriodFraction)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.Denylist) > 0 {
-		for _, s := range m.Denylist {
-			l = len(s)
-			n += 1 + l + sovProvider(uint64(l))
+	if m.TemplateClient != nil {
+		{
+			size, err := m.TemplateClient.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProvider(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.MinStake != 0 {
-		n += 1 + sovProvider(uint64(m.MinStake))
-	}
-	if m.AllowInactiveVals {
-		n += 2
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EquivocationProposal) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SlashAcks) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SlashAcks) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlashAcks) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
-	}
-	if len(m.Equivocations) > 0 {
-		for _, e := range m.Equivocations {
-			l = e.Size()
-			n += 1 + l + sovProvider(uint64(l))
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintProvider(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ChangeRewardDenomsProposal) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ConsumerAdditionProposals) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerAdditionProposals) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerAdditionProposals) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
-	}
-	if len(m.DenomsToAdd) > 0 {
-		for _, s := range m.DenomsToAdd {
-			l = len(s)
-			n += 1 + l + sovProvider(uint64(l))
-		}
-	}
-	if len(m.DenomsToRemove) > 0 {
-		for _, s := range m.DenomsToRemove {
-			l = len(s)
-			n += 1 + l + sovProvider(uint64(l))
+	if len(m.Pending) > 0 {
+		for iNdEx := len(m.Pending) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pending[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProvider(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GlobalSlashEntry) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ConsumerRemovalProposals) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerRemovalProposals) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerRemovalProposals) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.RecvTime)
-	n += 1 + l + sovProvider(uint64(l))
-	l = len(m.ConsumerChainID)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
-	}
-	if m.IbcSeqNum != 0 {
-		n += 1 + sovProvider(uint64(m.IbcSeqNum))
-	}
-	l = len(m.ProviderValConsAddr)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
+	if len(m.Pending) > 0 {
+		for iNdEx := len(m.Pending) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pending[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProvider(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *AddressList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.TemplateClient != nil {
-		l = m.TemplateClient.Size()
-		n += 1 + l + sovProvider(uint64(l))
-	}
-	l = len(m.TrustingPeriodFraction)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
-	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.CcvTimeoutPeriod)
-	n += 1 + l + sovProvider(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.SlashMeterReplenishPeriod)
-	n += 1 + l + sovProvider(uint64(l))
-	l = len(m.SlashMeterReplenishFraction)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
-	}
-	l = m.ConsumerRewardDenomRegistrationFee.Size()
-	n += 1 + l + sovProvider(uint64(l))
-	if m.BlocksPerEpoch != 0 {
-		n += 1 + sovProvider(uint64(m.BlocksPerEpoch))
-	}
-	if m.NumberOfEpochsToStartReceivingRewards != 0 {
-		n += 1 + sovProvider(uint64(m.NumberOfEpochsToStartReceivingRewards))
-	}
-	if m.MaxProviderConsensusValidators != 0 {
-		n += 1 + sovProvider(uint64(m.MaxProviderConsensusValidators))
-	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *SlashAcks) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Addresses) > 0 {
-		for _, s := range m.Addresses {
-			l = len(s)
-			n += 1 + l + sovProvider(uint64(l))
-		}
-	}
-	return n
+func (m *AddressList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ConsumerAdditionProposals) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *AddressList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Pending) > 0 {
-		for _, e := range m.Pending {
-			l = e.Size()
-			n += 1 + l + sovProvider(uint64(l))
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintProvider(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ConsumerRemovalProposals) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Pending) > 0 {
-		for _, e := range m.Pending {
-			l = e.Size()
-			n += 1 + l + sovProvider(uint64(l))
-		}
+func (m *ChannelToChain) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *AddressList) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Addresses) > 0 {
-		for _, b := range m.Addresses {
-			l = len(b)
-			n += 1 + l + sovProvider(uint64(l))
-		}
-	}
-	return n
+func (m *ChannelToChain) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ChannelToChain) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ChannelToChain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ChannelId)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ValidatorSetChangePackets) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ValidatorSetChangePackets) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorSetChangePackets) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorSetChangePackets) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if len(m.List) > 0 {
-		for _, e := range m.List {
-			l = e.Size()
-			n += 1 + l + sovProvider(uint64(l))
+		for iNdEx := len(m.List) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.List[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProvider(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *KeyAssignmentReplacement) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *KeyAssignmentReplacement) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *KeyAssignmentReplacement) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KeyAssignmentReplacement) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ProviderAddr)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
+	if m.Power != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x18
 	}
 	if m.PrevCKey != nil {
-		l = m.PrevCKey.Size()
-		n += 1 + l + sovProvider(uint64(l))
+		{
+			size, err := m.PrevCKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProvider(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Power != 0 {
-		n += 1 + sovProvider(uint64(m.Power))
+	if len(m.ProviderAddr) > 0 {
+		i -= len(m.ProviderAddr)
+		copy(dAtA[i:], m.ProviderAddr)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ProviderAddr)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ValidatorConsumerPubKey) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
-	}
-	l = len(m.ProviderAddr)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
-	}
-	if m.ConsumerKey != nil {
-		l = m.ConsumerKey.Size()
-		n += 1 + l + sovProvider(uint64(l))
+func (m *ValidatorConsumerPubKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ValidatorByConsumerAddr) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *ValidatorConsumerPubKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorConsumerPubKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
-	}
-	l = len(m.ConsumerAddr)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
+	if m.ConsumerKey != nil {
+		{
+			size, err := m.ConsumerKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProvider(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.ProviderAddr)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
+	if len(m.ProviderAddr) > 0 {
+		i -= len(m.ProviderAddr)
+		copy(dAtA[i:], m.ProviderAddr)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ProviderAddr)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ConsumerAddrsToPruneV2) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ValidatorByConsumerAddr) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorByConsumerAddr) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorByConsumerAddr) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
+	if len(m.ProviderAddr) > 0 {
+		i -= len(m.ProviderAddr)
+		copy(dAtA[i:], m.ProviderAddr)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ProviderAddr)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PruneTs)
-	n += 1 + l + sovProvider(uint64(l))
-	if m.ConsumerAddrs != nil {
-		l = m.ConsumerAddrs.Size()
-		n += 1 + l + sovProvider(uint64(l))
+	if len(m.ConsumerAddr) > 0 {
+		i -= len(m.ConsumerAddr)
+		copy(dAtA[i:], m.ConsumerAddr)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ConsumerAddr)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ConsensusValidator) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ConsumerAddrsToPruneV2) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerAddrsToPruneV2) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerAddrsToPruneV2) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ProviderConsAddr)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
-	}
-	if m.Power != 0 {
-		n += 1 + sovProvider(uint64(m.Power))
+	if m.ConsumerAddrs != nil {
+		{
+			size, err := m.ConsumerAddrs.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProvider(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.PublicKey != nil {
-		l = m.PublicKey.Size()
-		n += 1 + l + sovProvider(uint64(l))
+	n18, err18 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PruneTs, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PruneTs):])
+	if err18 != nil {
+		return 0, err18
 	}
-	if m.JoinHeight != 0 {
-		n += 1 + sovProvider(uint64(m.JoinHeight))
+	i -= n18
+	i = encodeVarintProvider(dAtA, i, uint64(n18))
+	i--
+	dAtA[i] = 0x12
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ConsumerRewardsAllocation) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ConsensusValidator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsensusValidator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsensusValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Rewards) > 0 {
-		for _, e := range m.Rewards {
-			l = e.Size()
-			n += 1 + l + sovProvider(uint64(l))
+	if m.JoinHeight != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.JoinHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PublicKey != nil {
+		{
+			size, err := m.PublicKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProvider(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if m.Power != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ProviderConsAddr) > 0 {
+		i -= len(m.ProviderConsAddr)
+		copy(dAtA[i:], m.ProviderConsAddr)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ProviderConsAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ConsumerMetadata) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ConsumerRewardsAllocation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerRewardsAllocation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerRewardsAllocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
-	}
-	l = len(m.Metadata)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProvider(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ConsumerInitializationParameters) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ConsumerMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.InitialHeight.Size()
-	n += 1 + l + sovProvider(uint64(l))
-	l = len(m.GenesisHash)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
+	if len(m.Metadata) > 0 {
+		i -= len(m.Metadata)
+		copy(dAtA[i:], m.Metadata)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.Metadata)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.BinaryHash)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SpawnTime)
-	n += 1 + l + sovProvider(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingPeriod)
-	n += 1 + l + sovProvider(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.CcvTimeoutPeriod)
-	n += 1 + l + sovProvider(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TransferTimeoutPeriod)
-	n += 1 + l + sovProvider(uint64(l))
-	l = len(m.ConsumerRedistributionFraction)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.BlocksPerDistributionTransmission != 0 {
-		n += 1 + sovProvider(uint64(m.BlocksPerDistributionTransmission))
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumerInitializationParameters) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerInitializationParameters) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerInitializationParameters) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SpawnHeight != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.SpawnHeight))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.TrustLevel != nil {
+		{
+			size, err := m.TrustLevel.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProvider(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.DistributionTransmissionChannel) > 0 {
+		i -= len(m.DistributionTransmissionChannel)
+		copy(dAtA[i:], m.DistributionTransmissionChannel)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.DistributionTransmissionChannel)))
+		i--
+		dAtA[i] = 0x5a
 	}
 	if m.HistoricalEntries != 0 {
-		n += 1 + sovProvider(uint64(m.HistoricalEntries))
+		i = encodeVarintProvider(dAtA, i, uint64(m.HistoricalEntries))
+		i--
+		dAtA[i] = 0x50
 	}
-	l = len(m.DistributionTransmissionChannel)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
+	if m.BlocksPerDistributionTransmission != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.BlocksPerDistributionTransmission))
+		i--
+		dAtA[i] = 0x48
 	}
-	l = len(m.ConnectionId)
-	if l > 0 {
-		n += 1 + l + sovProvider(uint64(l))
+	if len(m.ConsumerRedistributionFraction) > 0 {
+		i -= len(m.ConsumerRedistributionFraction)
+		copy(dAtA[i:], m.ConsumerRedistributionFraction)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ConsumerRedistributionFraction)))
+		i--
+		dAtA[i] = 0x42
 	}
-	return n
+	n21, err21 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TransferTimeoutPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TransferTimeoutPeriod):])
+	if err21 != nil {
+		return 0, err21
+	}
+	i -= n21
+	i = encodeVarintProvider(dAtA, i, uint64(n21))
+	i--
+	dAtA[i] = 0x3a
+	n22, err22 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.CcvTimeoutPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.CcvTimeoutPeriod):])
+	if err22 != nil {
+		return 0, err22
+	}
+	i -= n22
+	i = encodeVarintProvider(dAtA, i, uint64(n22))
+	i--
+	dAtA[i] = 0x32
+	n23, err23 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UnbondingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingPeriod):])
+	if err23 != nil {
+		return 0, err23
+	}
+	i -= n23
+	i = encodeVarintProvider(dAtA, i, uint64(n23))
+	i--
+	dAtA[i] = 0x2a
+	n24, err24 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.SpawnTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SpawnTime):])
+	if err24 != nil {
+		return 0, err24
+	}
+	i -= n24
+	i = encodeVarintProvider(dAtA, i, uint64(n24))
+	i--
+	dAtA[i] = 0x22
+	if len(m.BinaryHash) > 0 {
+		i -= len(m.BinaryHash)
+		copy(dAtA[i:], m.BinaryHash)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.BinaryHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.GenesisHash) > 0 {
+		i -= len(m.GenesisHash)
+		copy(dAtA[i:], m.GenesisHash)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.GenesisHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.InitialHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProvider(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *PowerShapingParameters) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PowerShapingParameters) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *PowerShapingParameters) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PowerShapingParameters) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Top_N != 0 {
-		n += 1 + sovProvider(uint64(m.Top_N))
-	}
-	if m.ValidatorsPowerCap != 0 {
-		n += 1 + sovProvider(uint64(m.ValidatorsPowerCap))
+	if len(m.PrioritylistWeights) > 0 {
+		for iNdEx := len(m.PrioritylistWeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PrioritylistWeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProvider(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
 	}
-	if m.ValidatorSetCap != 0 {
-		n += 1 + sovProvider(uint64(m.ValidatorSetCap))
+	if m.Top_NWeightedEpochs != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.Top_NWeightedEpochs))
+		i--
+		dAtA[i] = 0x48
 	}
-	if len(m.Allowlist) > 0 {
-		for _, s := range m.Allowlist {
-			l = len(s)
-			n += 1 + l + sovProvider(uint64(l))
+	if len(m.Prioritylist) > 0 {
+		for iNdEx := len(m.Prioritylist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Prioritylist[iNdEx])
+			copy(dAtA[i:], m.Prioritylist[iNdEx])
+			i = encodeVarintProvider(dAtA, i, uint64(len(m.Prioritylist[iNdEx])))
+			i--
+			dAtA[i] = 0x42
 		}
 	}
-	if len(m.Denylist) > 0 {
-		for _, s := range m.Denylist {
-			l = len(s)
-			n += 1 + l + sovProvider(uint64(l))
+	if m.AllowInactiveVals {
+		i--
+		if m.AllowInactiveVals {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x38
 	}
 	if m.MinStake != 0 {
-		n += 1 + sovProvider(uint64(m.MinStake))
-	}
-	if m.AllowInactiveVals {
-		n += 2
+		i = encodeVarintProvider(dAtA, i, uint64(m.MinStake))
+		i--
+		dAtA[i] = 0x30
 	}
-	if len(m.Prioritylist) > 0 {
-		for _, s := range m.Prioritylist {
-			l = len(s)
-			n += 1 + l + sovProvider(uint64(l))
+	if len(m.Denylist) > 0 {
+		for iNdEx := len(m.Denylist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denylist[iNdEx])
+			copy(dAtA[i:], m.Denylist[iNdEx])
+			i = encodeVarintProvider(dAtA, i, uint64(len(m.Denylist[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
 		}
 	}
-	return n
-}
-
-func (m *ConsumerIds) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Ids) > 0 {
-		for _, s := range m.Ids {
-			l = len(s)
-			n += 1 + l + sovProvider(uint64(l))
+	if len(m.Allowlist) > 0 {
+		for iNdEx := len(m.Allowlist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Allowlist[iNdEx])
+			copy(dAtA[i:], m.Allowlist[iNdEx])
+			i = encodeVarintProvider(dAtA, i, uint64(len(m.Allowlist[iNdEx])))
+			i--
+			dAtA[i] = 0x22
 		}
 	}
-	return n
-}
-
-func (m *AllowlistedRewardDenoms) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.ValidatorSetCap != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.ValidatorSetCap))
+		i--
+		dAtA[i] = 0x18
 	}
+	if m.ValidatorsPowerCap != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.ValidatorsPowerCap))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Top_N != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.Top_N))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PrioritylistWeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PrioritylistWeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PrioritylistWeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Weight != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.Weight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ProviderAddress) > 0 {
+		i -= len(m.ProviderAddress)
+		copy(dAtA[i:], m.ProviderAddress)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ProviderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorPowerHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorPowerHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorPowerHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Powers) > 0 {
+		dAtA27 := make([]byte, len(m.Powers)*10)
+		var j26 int
+		for _, num1 := range m.Powers {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA27[j26] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j26++
+			}
+			dAtA27[j26] = uint8(num)
+			j26++
+		}
+		i -= j26
+		copy(dAtA[i:], dAtA27[:j26])
+		i = encodeVarintProvider(dAtA, i, uint64(j26))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumerIds) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerIds) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerIds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ids) > 0 {
+		for iNdEx := len(m.Ids) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Ids[iNdEx])
+			copy(dAtA[i:], m.Ids[iNdEx])
+			i = encodeVarintProvider(dAtA, i, uint64(len(m.Ids[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AllowlistedRewardDenoms) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AllowlistedRewardDenoms) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllowlistedRewardDenoms) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if len(m.Denoms) > 0 {
-		for _, s := range m.Denoms {
-			l = len(s)
-			n += 1 + l + sovProvider(uint64(l))
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denoms[iNdEx])
+			copy(dAtA[i:], m.Denoms[iNdEx])
+			i = encodeVarintProvider(dAtA, i, uint64(len(m.Denoms[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *InfractionParameters) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InfractionParameters) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InfractionParameters) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Downtime != nil {
+		{
+			size, err := m.Downtime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProvider(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.DoubleSign != nil {
+		{
+			size, err := m.DoubleSign.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintProvider(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SlashJailParameters) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlashJailParameters) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlashJailParameters) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Tombstone {
+		i--
+		if m.Tombstone {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	n30, err30 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.JailDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.JailDuration):])
+	if err30 != nil {
+		return 0, err30
+	}
+	i -= n30
+	i = encodeVarintProvider(dAtA, i, uint64(n30))
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.SlashFraction.Size()
+		i -= size
+		if _, err := m.SlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintProvider(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ThrottlingParameters) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ThrottlingParameters) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ThrottlingParameters) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n31, err31 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.SlashMeterReplenishPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.SlashMeterReplenishPeriod):])
+	if err31 != nil {
+		return 0, err31
+	}
+	i -= n31
+	i = encodeVarintProvider(dAtA, i, uint64(n31))
+	i--
+	dAtA[i] = 0x12
+	if len(m.SlashMeterReplenishFraction) > 0 {
+		i -= len(m.SlashMeterReplenishFraction)
+		copy(dAtA[i:], m.SlashMeterReplenishFraction)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.SlashMeterReplenishFraction)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumerInitialConsensusState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerInitialConsensusState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerInitialConsensusState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ConsensusState.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProvider(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.GenesisHash) > 0 {
+		i -= len(m.GenesisHash)
+		copy(dAtA[i:], m.GenesisHash)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.GenesisHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PowerShapingPipeline) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PowerShapingPipeline) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PowerShapingPipeline) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Steps) > 0 {
+		for iNdEx := len(m.Steps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Steps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProvider(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PowerShapingStep) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PowerShapingStep) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PowerShapingStep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorsOut) > 0 {
+		for iNdEx := len(m.ValidatorsOut) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ValidatorsOut[iNdEx])
+			copy(dAtA[i:], m.ValidatorsOut[iNdEx])
+			i = encodeVarintProvider(dAtA, i, uint64(len(m.ValidatorsOut[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ValidatorsIn) > 0 {
+		for iNdEx := len(m.ValidatorsIn) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ValidatorsIn[iNdEx])
+			copy(dAtA[i:], m.ValidatorsIn[iNdEx])
+			i = encodeVarintProvider(dAtA, i, uint64(len(m.ValidatorsIn[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FundFlowRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FundFlowRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FundFlowRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n33, err33 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err33 != nil {
+		return 0, err33
+	}
+	i -= n33
+	i = encodeVarintProvider(dAtA, i, uint64(n33))
+	i--
+	dAtA[i] = 0x32
+	if m.Height != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProvider(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ModuleAccount) > 0 {
+		i -= len(m.ModuleAccount)
+		copy(dAtA[i:], m.ModuleAccount)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ModuleAccount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Type != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumerLaunchFailure) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerLaunchFailure) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerLaunchFailure) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Retriable {
+		i--
+		if m.Retriable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	n34, err34 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err34 != nil {
+		return 0, err34
+	}
+	i -= n34
+	i = encodeVarintProvider(dAtA, i, uint64(n34))
+	i--
+	dAtA[i] = 0x1a
+	if m.Height != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumerHashCommitment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerHashCommitment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerHashCommitment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AttestationHeight != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.AttestationHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Attested {
+		i--
+		if m.Attested {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.LastUpdateHeight != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.LastUpdateHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.UpdateCount != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.UpdateCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.RegisteredBinaryHash) > 0 {
+		i -= len(m.RegisteredBinaryHash)
+		copy(dAtA[i:], m.RegisteredBinaryHash)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.RegisteredBinaryHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RegisteredGenesisHash) > 0 {
+		i -= len(m.RegisteredGenesisHash)
+		copy(dAtA[i:], m.RegisteredGenesisHash)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.RegisteredGenesisHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumerPacketStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerPacketStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerPacketStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ErrorAcksReceived != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.ErrorAcksReceived))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.SlashPacketsBounced != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.SlashPacketsBounced))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.SlashPacketsHandled != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.SlashPacketsHandled))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SlashPacketsReceived != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.SlashPacketsReceived))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.VscMaturedPacketsReceived != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.VscMaturedPacketsReceived))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.VscPacketsSent != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.VscPacketsSent))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PreLaunchKeyAssignment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PreLaunchKeyAssignment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PreLaunchKeyAssignment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsumerKey) > 0 {
+		i -= len(m.ConsumerKey)
+		copy(dAtA[i:], m.ConsumerKey)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ConsumerKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ProviderAddr) > 0 {
+		i -= len(m.ProviderAddr)
+		copy(dAtA[i:], m.ProviderAddr)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ProviderAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduledKeyAssignment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledKeyAssignment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledKeyAssignment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ActivationHeight != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.ActivationHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ConsumerKey) > 0 {
+		i -= len(m.ConsumerKey)
+		copy(dAtA[i:], m.ConsumerKey)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ConsumerKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ProviderAddr) > 0 {
+		i -= len(m.ProviderAddr)
+		copy(dAtA[i:], m.ProviderAddr)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ProviderAddr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumerClientUpgradePlan) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerClientUpgradePlan) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerClientUpgradePlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n35, err35 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UnbondingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingPeriod):])
+	if err35 != nil {
+		return 0, err35
+	}
+	i -= n35
+	i = encodeVarintProvider(dAtA, i, uint64(n35))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.NewChainId) > 0 {
+		i -= len(m.NewChainId)
+		copy(dAtA[i:], m.NewChainId)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.NewChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.UpgradeHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProvider(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumerProviderSwitch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerProviderSwitch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerProviderSwitch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewProviderChainId) > 0 {
+		i -= len(m.NewProviderChainId)
+		copy(dAtA[i:], m.NewProviderChainId)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.NewProviderChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.SwitchHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProvider(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *BouncedSlashPacket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BouncedSlashPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BouncedSlashPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Bounces != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.Bounces))
+		i--
+		dAtA[i] = 0x38
+	}
+	n38, err38 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.LastReceiveTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastReceiveTime):])
+	if err38 != nil {
+		return 0, err38
+	}
+	i -= n38
+	i = encodeVarintProvider(dAtA, i, uint64(n38))
+	i--
+	dAtA[i] = 0x32
+	n39, err39 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.FirstReceiveTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.FirstReceiveTime):])
+	if err39 != nil {
+		return 0, err39
+	}
+	i -= n39
+	i = encodeVarintProvider(dAtA, i, uint64(n39))
+	i--
+	dAtA[i] = 0x2a
+	if m.ValsetUpdateId != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.ValsetUpdateId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ProviderConsAddress) > 0 {
+		i -= len(m.ProviderConsAddress)
+		copy(dAtA[i:], m.ProviderConsAddress)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ProviderConsAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ConsumerConsAddress) > 0 {
+		i -= len(m.ConsumerConsAddress)
+		copy(dAtA[i:], m.ConsumerConsAddress)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ConsumerConsAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SentVSCPacket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SentVSCPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SentVSCPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Data.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProvider(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	n41, err41 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.SendTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SendTime):])
+	if err41 != nil {
+		return 0, err41
+	}
+	i -= n41
+	i = encodeVarintProvider(dAtA, i, uint64(n41))
+	i--
+	dAtA[i] = 0x1a
+	if m.Sequence != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *KeyAssignmentObservation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KeyAssignmentObservation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KeyAssignmentObservation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastObservedHeight != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.LastObservedHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TrackedSinceHeight != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.TrackedSinceHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumerUpdateRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerUpdateRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerUpdateRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Changes) > 0 {
+		for iNdEx := len(m.Changes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Changes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProvider(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	n42, err42 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err42 != nil {
+		return 0, err42
+	}
+	i -= n42
+	i = encodeVarintProvider(dAtA, i, uint64(n42))
+	i--
+	dAtA[i] = 0x1a
+	if m.Height != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Submitter) > 0 {
+		i -= len(m.Submitter)
+		copy(dAtA[i:], m.Submitter)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.Submitter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumerFieldChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerFieldChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerFieldChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewValue) > 0 {
+		i -= len(m.NewValue)
+		copy(dAtA[i:], m.NewValue)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.NewValue)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OldValue) > 0 {
+		i -= len(m.OldValue)
+		copy(dAtA[i:], m.OldValue)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.OldValue)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Field) > 0 {
+		i -= len(m.Field)
+		copy(dAtA[i:], m.Field)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.Field)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorOptInRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorOptInRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorOptInRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EpochStartHeight != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.EpochStartHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	n43, err43 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err43 != nil {
+		return 0, err43
+	}
+	i -= n43
+	i = encodeVarintProvider(dAtA, i, uint64(n43))
+	i--
+	dAtA[i] = 0x2a
+	if m.Height != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ForcedByTopN {
+		i--
+		if m.ForcedByTopN {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.OptedIn {
+		i--
+		if m.OptedIn {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorConsumerOptInHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorConsumerOptInHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorConsumerOptInHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProvider(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintProvider(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PreviousEpochBlocks != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.PreviousEpochBlocks))
+		i--
+		dAtA[i] = 0x18
+	}
+	n44, err44 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err44 != nil {
+		return 0, err44
+	}
+	i -= n44
+	i = encodeVarintProvider(dAtA, i, uint64(n44))
+	i--
+	dAtA[i] = 0x12
+	if m.StartHeight != 0 {
+		i = encodeVarintProvider(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintProvider(dAtA []byte, offset int, v uint64) int {
+	offset -= sovProvider(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ConsumerAdditionProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = m.InitialHeight.Size()
+	n += 1 + l + sovProvider(uint64(l))
+	l = len(m.GenesisHash)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.BinaryHash)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SpawnTime)
+	n += 1 + l + sovProvider(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingPeriod)
+	n += 1 + l + sovProvider(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.CcvTimeoutPeriod)
+	n += 1 + l + sovProvider(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TransferTimeoutPeriod)
+	n += 1 + l + sovProvider(uint64(l))
+	l = len(m.ConsumerRedistributionFraction)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	if m.BlocksPerDistributionTransmission != 0 {
+		n += 1 + sovProvider(uint64(m.BlocksPerDistributionTransmission))
+	}
+	if m.HistoricalEntries != 0 {
+		n += 1 + sovProvider(uint64(m.HistoricalEntries))
+	}
+	l = len(m.DistributionTransmissionChannel)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	if m.Top_N != 0 {
+		n += 1 + sovProvider(uint64(m.Top_N))
+	}
+	if m.ValidatorsPowerCap != 0 {
+		n += 2 + sovProvider(uint64(m.ValidatorsPowerCap))
+	}
+	if m.ValidatorSetCap != 0 {
+		n += 2 + sovProvider(uint64(m.ValidatorSetCap))
+	}
+	if len(m.Allowlist) > 0 {
+		for _, s := range m.Allowlist {
+			l = len(s)
+			n += 2 + l + sovProvider(uint64(l))
+		}
+	}
+	if len(m.Denylist) > 0 {
+		for _, s := range m.Denylist {
+			l = len(s)
+			n += 2 + l + sovProvider(uint64(l))
+		}
+	}
+	if m.MinStake != 0 {
+		n += 2 + sovProvider(uint64(m.MinStake))
+	}
+	if m.AllowInactiveVals {
+		n += 3
+	}
+	return n
+}
+
+func (m *ConsumerRemovalProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StopTime)
+	n += 1 + l + sovProvider(uint64(l))
+	return n
+}
+
+func (m *ConsumerModificationProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	if m.Top_N != 0 {
+		n += 1 + sovProvider(uint64(m.Top_N))
+	}
+	if m.ValidatorsPowerCap != 0 {
+		n += 1 + sovProvider(uint64(m.ValidatorsPowerCap))
+	}
+	if m.ValidatorSetCap != 0 {
+		n += 1 + sovProvider(uint64(m.ValidatorSetCap))
+	}
+	if len(m.Allowlist) > 0 {
+		for _, s := range m.Allowlist {
+			l = len(s)
+			n += 1 + l + sovProvider(uint64(l))
+		}
+	}
+	if len(m.Denylist) > 0 {
+		for _, s := range m.Denylist {
+			l = len(s)
+			n += 1 + l + sovProvider(uint64(l))
+		}
+	}
+	if m.MinStake != 0 {
+		n += 1 + sovProvider(uint64(m.MinStake))
+	}
+	if m.AllowInactiveVals {
+		n += 2
+	}
+	return n
+}
+
+func (m *EquivocationProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	if len(m.Equivocations) > 0 {
+		for _, e := range m.Equivocations {
+			l = e.Size()
+			n += 1 + l + sovProvider(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ChangeRewardDenomsProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	if len(m.DenomsToAdd) > 0 {
+		for _, s := range m.DenomsToAdd {
+			l = len(s)
+			n += 1 + l + sovProvider(uint64(l))
+		}
+	}
+	if len(m.DenomsToRemove) > 0 {
+		for _, s := range m.DenomsToRemove {
+			l = len(s)
+			n += 1 + l + sovProvider(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GlobalSlashEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.RecvTime)
+	n += 1 + l + sovProvider(uint64(l))
+	l = len(m.ConsumerChainID)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	if m.IbcSeqNum != 0 {
+		n += 1 + sovProvider(uint64(m.IbcSeqNum))
+	}
+	l = len(m.ProviderValConsAddr)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TemplateClient != nil {
+		l = m.TemplateClient.Size()
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.TrustingPeriodFraction)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.CcvTimeoutPeriod)
+	n += 1 + l + sovProvider(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.SlashMeterReplenishPeriod)
+	n += 1 + l + sovProvider(uint64(l))
+	l = len(m.SlashMeterReplenishFraction)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = m.ConsumerRewardDenomRegistrationFee.Size()
+	n += 1 + l + sovProvider(uint64(l))
+	if m.BlocksPerEpoch != 0 {
+		n += 1 + sovProvider(uint64(m.BlocksPerEpoch))
+	}
+	if m.NumberOfEpochsToStartReceivingRewards != 0 {
+		n += 1 + sovProvider(uint64(m.NumberOfEpochsToStartReceivingRewards))
+	}
+	if m.MaxProviderConsensusValidators != 0 {
+		n += 1 + sovProvider(uint64(m.MaxProviderConsensusValidators))
+	}
+	if m.MaxLaunchedConsumers != 0 {
+		n += 1 + sovProvider(uint64(m.MaxLaunchedConsumers))
+	}
+	if m.MinTopNBudget != 0 {
+		n += 1 + sovProvider(uint64(m.MinTopNBudget))
+	}
+	if m.ImmediateValidatorUpdates {
+		n += 2
+	}
+	if m.ImmediateDowntimeJailing {
+		n += 3
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ClientExpiryWarningThreshold)
+	n += 2 + l + sovProvider(uint64(l))
+	if m.MaxValidatorUpdatesPerPacket != 0 {
+		n += 2 + sovProvider(uint64(m.MaxValidatorUpdatesPerPacket))
+	}
+	if m.StaleKeyAssignmentEpochs != 0 {
+		n += 2 + sovProvider(uint64(m.StaleKeyAssignmentEpochs))
+	}
+	if m.ValidatorFeeExemptionsPerEpoch != 0 {
+		n += 2 + sovProvider(uint64(m.ValidatorFeeExemptionsPerEpoch))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxChannelClosedDuration)
+	n += 2 + l + sovProvider(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.EpochDuration)
+	n += 2 + l + sovProvider(uint64(l))
+	if m.RestrictConsumerCreation {
+		n += 3
+	}
+	return n
+}
+
+func (m *SlashAcks) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovProvider(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ConsumerAdditionProposals) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pending) > 0 {
+		for _, e := range m.Pending {
+			l = e.Size()
+			n += 1 + l + sovProvider(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ConsumerRemovalProposals) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pending) > 0 {
+		for _, e := range m.Pending {
+			l = e.Size()
+			n += 1 + l + sovProvider(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AddressList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for _, b := range m.Addresses {
+			l = len(b)
+			n += 1 + l + sovProvider(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ChannelToChain) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	return n
+}
+
+func (m *ValidatorSetChangePackets) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.List) > 0 {
+		for _, e := range m.List {
+			l = e.Size()
+			n += 1 + l + sovProvider(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *KeyAssignmentReplacement) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProviderAddr)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	if m.PrevCKey != nil {
+		l = m.PrevCKey.Size()
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	if m.Power != 0 {
+		n += 1 + sovProvider(uint64(m.Power))
+	}
+	return n
+}
+
+func (m *ValidatorConsumerPubKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.ProviderAddr)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	if m.ConsumerKey != nil {
+		l = m.ConsumerKey.Size()
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	return n
+}
+
+func (m *ValidatorByConsumerAddr) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.ConsumerAddr)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.ProviderAddr)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	return n
+}
+
+func (m *ConsumerAddrsToPruneV2) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PruneTs)
+	n += 1 + l + sovProvider(uint64(l))
+	if m.ConsumerAddrs != nil {
+		l = m.ConsumerAddrs.Size()
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	return n
+}
+
+func (m *ConsensusValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProviderConsAddr)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	if m.Power != 0 {
+		n += 1 + sovProvider(uint64(m.Power))
+	}
+	if m.PublicKey != nil {
+		l = m.PublicKey.Size()
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	if m.JoinHeight != 0 {
+		n += 1 + sovProvider(uint64(m.JoinHeight))
+	}
+	return n
+}
+
+func (m *ConsumerRewardsAllocation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovProvider(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ConsumerMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.Metadata)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	return n
+}
+
+func (m *ConsumerInitializationParameters) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.InitialHeight.Size()
+	n += 1 + l + sovProvider(uint64(l))
+	l = len(m.GenesisHash)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.BinaryHash)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SpawnTime)
+	n += 1 + l + sovProvider(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingPeriod)
+	n += 1 + l + sovProvider(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.CcvTimeoutPeriod)
+	n += 1 + l + sovProvider(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TransferTimeoutPeriod)
+	n += 1 + l + sovProvider(uint64(l))
+	l = len(m.ConsumerRedistributionFraction)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	if m.BlocksPerDistributionTransmission != 0 {
+		n += 1 + sovProvider(uint64(m.BlocksPerDistributionTransmission))
+	}
+	if m.HistoricalEntries != 0 {
+		n += 1 + sovProvider(uint64(m.HistoricalEntries))
+	}
+	l = len(m.DistributionTransmissionChannel)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	if m.TrustLevel != nil {
+		l = m.TrustLevel.Size()
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	if m.SpawnHeight != 0 {
+		n += 1 + sovProvider(uint64(m.SpawnHeight))
+	}
+	return n
+}
+
+func (m *PowerShapingParameters) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Top_N != 0 {
+		n += 1 + sovProvider(uint64(m.Top_N))
+	}
+	if m.ValidatorsPowerCap != 0 {
+		n += 1 + sovProvider(uint64(m.ValidatorsPowerCap))
+	}
+	if m.ValidatorSetCap != 0 {
+		n += 1 + sovProvider(uint64(m.ValidatorSetCap))
+	}
+	if len(m.Allowlist) > 0 {
+		for _, s := range m.Allowlist {
+			l = len(s)
+			n += 1 + l + sovProvider(uint64(l))
+		}
+	}
+	if len(m.Denylist) > 0 {
+		for _, s := range m.Denylist {
+			l = len(s)
+			n += 1 + l + sovProvider(uint64(l))
+		}
+	}
+	if m.MinStake != 0 {
+		n += 1 + sovProvider(uint64(m.MinStake))
+	}
+	if m.AllowInactiveVals {
+		n += 2
+	}
+	if len(m.Prioritylist) > 0 {
+		for _, s := range m.Prioritylist {
+			l = len(s)
+			n += 1 + l + sovProvider(uint64(l))
+		}
+	}
+	if m.Top_NWeightedEpochs != 0 {
+		n += 1 + sovProvider(uint64(m.Top_NWeightedEpochs))
+	}
+	if len(m.PrioritylistWeights) > 0 {
+		for _, e := range m.PrioritylistWeights {
+			l = e.Size()
+			n += 1 + l + sovProvider(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PrioritylistWeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProviderAddress)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	if m.Weight != 0 {
+		n += 1 + sovProvider(uint64(m.Weight))
+	}
+	return n
+}
+
+func (m *ValidatorPowerHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Powers) > 0 {
+		l = 0
+		for _, e := range m.Powers {
+			l += sovProvider(uint64(e))
+		}
+		n += 1 + sovProvider(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *ConsumerIds) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Ids) > 0 {
+		for _, s := range m.Ids {
+			l = len(s)
+			n += 1 + l + sovProvider(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AllowlistedRewardDenoms) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Denoms) > 0 {
+		for _, s := range m.Denoms {
+			l = len(s)
+			n += 1 + l + sovProvider(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *InfractionParameters) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DoubleSign != nil {
+		l = m.DoubleSign.Size()
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	if m.Downtime != nil {
+		l = m.Downtime.Size()
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	return n
+}
+
+func (m *SlashJailParameters) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SlashFraction.Size()
+	n += 1 + l + sovProvider(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.JailDuration)
+	n += 1 + l + sovProvider(uint64(l))
+	if m.Tombstone {
+		n += 2
+	}
+	return n
+}
+
+func (m *ThrottlingParameters) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SlashMeterReplenishFraction)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.SlashMeterReplenishPeriod)
+	n += 1 + l + sovProvider(uint64(l))
+	return n
+}
+
+func (m *ConsumerInitialConsensusState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GenesisHash)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = m.ConsensusState.Size()
+	n += 1 + l + sovProvider(uint64(l))
+	return n
+}
+
+func (m *PowerShapingPipeline) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovProvider(uint64(m.Height))
+	}
+	if len(m.Steps) > 0 {
+		for _, e := range m.Steps {
+			l = e.Size()
+			n += 1 + l + sovProvider(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PowerShapingStep) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	if len(m.ValidatorsIn) > 0 {
+		for _, s := range m.ValidatorsIn {
+			l = len(s)
+			n += 1 + l + sovProvider(uint64(l))
+		}
+	}
+	if len(m.ValidatorsOut) > 0 {
+		for _, s := range m.ValidatorsOut {
+			l = len(s)
+			n += 1 + l + sovProvider(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FundFlowRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovProvider(uint64(m.Type))
+	}
+	l = len(m.ModuleAccount)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovProvider(uint64(l))
+		}
+	}
+	if m.Height != 0 {
+		n += 1 + sovProvider(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovProvider(uint64(l))
+	return n
+}
+
+func (m *ConsumerLaunchFailure) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovProvider(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovProvider(uint64(l))
+	if m.Retriable {
+		n += 2
+	}
+	return n
+}
+
+func (m *ConsumerHashCommitment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RegisteredGenesisHash)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.RegisteredBinaryHash)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	if m.UpdateCount != 0 {
+		n += 1 + sovProvider(uint64(m.UpdateCount))
+	}
+	if m.LastUpdateHeight != 0 {
+		n += 1 + sovProvider(uint64(m.LastUpdateHeight))
+	}
+	if m.Attested {
+		n += 2
+	}
+	if m.AttestationHeight != 0 {
+		n += 1 + sovProvider(uint64(m.AttestationHeight))
+	}
+	return n
+}
+
+func (m *ConsumerPacketStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VscPacketsSent != 0 {
+		n += 1 + sovProvider(uint64(m.VscPacketsSent))
+	}
+	if m.VscMaturedPacketsReceived != 0 {
+		n += 1 + sovProvider(uint64(m.VscMaturedPacketsReceived))
+	}
+	if m.SlashPacketsReceived != 0 {
+		n += 1 + sovProvider(uint64(m.SlashPacketsReceived))
+	}
+	if m.SlashPacketsHandled != 0 {
+		n += 1 + sovProvider(uint64(m.SlashPacketsHandled))
+	}
+	if m.SlashPacketsBounced != 0 {
+		n += 1 + sovProvider(uint64(m.SlashPacketsBounced))
+	}
+	if m.ErrorAcksReceived != 0 {
+		n += 1 + sovProvider(uint64(m.ErrorAcksReceived))
+	}
+	return n
+}
+
+func (m *PreLaunchKeyAssignment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.ProviderAddr)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.ConsumerKey)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	return n
+}
+
+func (m *ScheduledKeyAssignment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.ProviderAddr)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.ConsumerKey)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	if m.ActivationHeight != 0 {
+		n += 1 + sovProvider(uint64(m.ActivationHeight))
+	}
+	return n
+}
+
+func (m *ConsumerClientUpgradePlan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.UpgradeHeight.Size()
+	n += 1 + l + sovProvider(uint64(l))
+	l = len(m.NewChainId)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingPeriod)
+	n += 1 + l + sovProvider(uint64(l))
+	return n
+}
+
+func (m *ConsumerProviderSwitch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SwitchHeight.Size()
+	n += 1 + l + sovProvider(uint64(l))
+	l = len(m.NewProviderChainId)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	return n
+}
+
+func (m *BouncedSlashPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.ConsumerConsAddress)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.ProviderConsAddress)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	if m.ValsetUpdateId != 0 {
+		n += 1 + sovProvider(uint64(m.ValsetUpdateId))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.FirstReceiveTime)
+	n += 1 + l + sovProvider(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.LastReceiveTime)
+	n += 1 + l + sovProvider(uint64(l))
+	if m.Bounces != 0 {
+		n += 1 + sovProvider(uint64(m.Bounces))
+	}
+	return n
+}
+
+func (m *SentVSCPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovProvider(uint64(m.Sequence))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.SendTime)
+	n += 1 + l + sovProvider(uint64(l))
+	l = m.Data.Size()
+	n += 1 + l + sovProvider(uint64(l))
+	return n
+}
+
+func (m *KeyAssignmentObservation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TrackedSinceHeight != 0 {
+		n += 1 + sovProvider(uint64(m.TrackedSinceHeight))
+	}
+	if m.LastObservedHeight != 0 {
+		n += 1 + sovProvider(uint64(m.LastObservedHeight))
+	}
+	return n
+}
+
+func (m *ConsumerUpdateRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Submitter)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovProvider(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovProvider(uint64(l))
+	if len(m.Changes) > 0 {
+		for _, e := range m.Changes {
+			l = e.Size()
+			n += 1 + l + sovProvider(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ConsumerFieldChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Field)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.OldValue)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	l = len(m.NewValue)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	return n
+}
+
+func (m *ValidatorOptInRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	if m.OptedIn {
+		n += 2
+	}
+	if m.ForcedByTopN {
+		n += 2
+	}
+	if m.Height != 0 {
+		n += 1 + sovProvider(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovProvider(uint64(l))
+	if m.EpochStartHeight != 0 {
+		n += 1 + sovProvider(uint64(m.EpochStartHeight))
+	}
+	return n
+}
+
+func (m *ValidatorConsumerOptInHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovProvider(uint64(l))
+	}
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovProvider(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EpochInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		n += 1 + sovProvider(uint64(m.StartHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovProvider(uint64(l))
+	if m.PreviousEpochBlocks != 0 {
+		n += 1 + sovProvider(uint64(m.PreviousEpochBlocks))
+	}
+	return n
+}
+
+func sovProvider(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozProvider(x uint64) (n int) {
+	return sovProvider(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ConsumerAdditionProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerAdditionProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerAdditionProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InitialHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GenesisHash = append(m.GenesisHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.GenesisHash == nil {
+				m.GenesisHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BinaryHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BinaryHash = append(m.BinaryHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.BinaryHash == nil {
+				m.BinaryHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpawnTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.SpawnTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.UnbondingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CcvTimeoutPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.CcvTimeoutPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferTimeoutPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.TransferTimeoutPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerRedistributionFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerRedistributionFraction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocksPerDistributionTransmission", wireType)
+			}
+			m.BlocksPerDistributionTransmission = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlocksPerDistributionTransmission |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoricalEntries", wireType)
+			}
+			m.HistoricalEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistoricalEntries |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionTransmissionChannel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DistributionTransmissionChannel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Top_N", wireType)
+			}
+			m.Top_N = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Top_N |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsPowerCap", wireType)
+			}
+			m.ValidatorsPowerCap = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorsPowerCap |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSetCap", wireType)
+			}
+			m.ValidatorSetCap = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorSetCap |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowlist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allowlist = append(m.Allowlist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denylist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denylist = append(m.Denylist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinStake", wireType)
+			}
+			m.MinStake = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinStake |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowInactiveVals", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowInactiveVals = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsumerRemovalProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerRemovalProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerRemovalProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StopTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StopTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsumerModificationProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerModificationProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerModificationProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Top_N", wireType)
+			}
+			m.Top_N = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Top_N |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsPowerCap", wireType)
+			}
+			m.ValidatorsPowerCap = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorsPowerCap |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSetCap", wireType)
+			}
+			m.ValidatorSetCap = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorSetCap |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowlist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allowlist = append(m.Allowlist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denylist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denylist = append(m.Denylist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinStake", wireType)
+			}
+			m.MinStake = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinStake |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowInactiveVals", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowInactiveVals = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EquivocationProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EquivocationProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EquivocationProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Equivocations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Equivocations = append(m.Equivocations, &types1.Equivocation{})
+			if err := m.Equivocations[len(m.Equivocations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChangeRewardDenomsProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChangeRewardDenomsProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChangeRewardDenomsProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomsToAdd", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomsToAdd = append(m.DenomsToAdd, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomsToRemove", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomsToRemove = append(m.DenomsToRemove, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GlobalSlashEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GlobalSlashEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GlobalSlashEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecvTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.RecvTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerChainID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcSeqNum", wireType)
+			}
+			m.IbcSeqNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IbcSeqNum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderValConsAddr", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderValConsAddr = append(m.ProviderValConsAddr[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProviderValConsAddr == nil {
+				m.ProviderValConsAddr = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TemplateClient", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TemplateClient == nil {
+				m.TemplateClient = &_07_tendermint.ClientState{}
+			}
+			if err := m.TemplateClient.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrustingPeriodFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrustingPeriodFraction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CcvTimeoutPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.CcvTimeoutPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashMeterReplenishPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.SlashMeterReplenishPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashMeterReplenishFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashMeterReplenishFraction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerRewardDenomRegistrationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ConsumerRewardDenomRegistrationFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocksPerEpoch", wireType)
+			}
+			m.BlocksPerEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlocksPerEpoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumberOfEpochsToStartReceivingRewards", wireType)
+			}
+			m.NumberOfEpochsToStartReceivingRewards = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumberOfEpochsToStartReceivingRewards |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxProviderConsensusValidators", wireType)
+			}
+			m.MaxProviderConsensusValidators = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxProviderConsensusValidators |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLaunchedConsumers", wireType)
+			}
+			m.MaxLaunchedConsumers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxLaunchedConsumers |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinTopNBudget", wireType)
+			}
+			m.MinTopNBudget = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinTopNBudget |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImmediateValidatorUpdates", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ImmediateValidatorUpdates = bool(v != 0)
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImmediateDowntimeJailing", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ImmediateDowntimeJailing = bool(v != 0)
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientExpiryWarningThreshold", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.ClientExpiryWarningThreshold, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValidatorUpdatesPerPacket", wireType)
+			}
+			m.MaxValidatorUpdatesPerPacket = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxValidatorUpdatesPerPacket |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StaleKeyAssignmentEpochs", wireType)
+			}
+			m.StaleKeyAssignmentEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StaleKeyAssignmentEpochs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorFeeExemptionsPerEpoch", wireType)
+			}
+			m.ValidatorFeeExemptionsPerEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorFeeExemptionsPerEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxChannelClosedDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MaxChannelClosedDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.EpochDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestrictConsumerCreation", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RestrictConsumerCreation = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SlashAcks) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SlashAcks: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SlashAcks: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsumerAdditionProposals) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerAdditionProposals: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerAdditionProposals: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pending = append(m.Pending, &ConsumerAdditionProposal{})
+			if err := m.Pending[len(m.Pending)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsumerRemovalProposals) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerRemovalProposals: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerRemovalProposals: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pending = append(m.Pending, &ConsumerRemovalProposal{})
+			if err := m.Pending[len(m.Pending)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AddressList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddressList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddressList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, make([]byte, postIndex-iNdEx))
+			copy(m.Addresses[len(m.Addresses)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChannelToChain) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelToChain: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelToChain: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorSetChangePackets) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorSetChangePackets: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorSetChangePackets: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field List", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.List = append(m.List, types3.ValidatorSetChangePacketData{})
+			if err := m.List[len(m.List)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KeyAssignmentReplacement) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KeyAssignmentReplacement: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KeyAssignmentReplacement: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddr", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderAddr = append(m.ProviderAddr[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProviderAddr == nil {
+				m.ProviderAddr = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrevCKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PrevCKey == nil {
+				m.PrevCKey = &crypto.PublicKey{}
+			}
+			if err := m.PrevCKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorConsumerPubKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorConsumerPubKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorConsumerPubKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddr", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderAddr = append(m.ProviderAddr[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProviderAddr == nil {
+				m.ProviderAddr = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConsumerKey == nil {
+				m.ConsumerKey = &crypto.PublicKey{}
+			}
+			if err := m.ConsumerKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorByConsumerAddr) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorByConsumerAddr: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorByConsumerAddr: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerAddr", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerAddr = append(m.ConsumerAddr[:0], dAtA[iNdEx:postIndex]...)
+			if m.ConsumerAddr == nil {
+				m.ConsumerAddr = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddr", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderAddr = append(m.ProviderAddr[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProviderAddr == nil {
+				m.ProviderAddr = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
-
-func (m *InfractionParameters) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.DoubleSign != nil {
-		l = m.DoubleSign.Size()
-		n += 1 + l + sovProvider(uint64(l))
-	}
-	if m.Downtime != nil {
-		l = m.Downtime.Size()
-		n += 1 + l + sovProvider(uint64(l))
-	}
-	return n
-}
 
-func (m *SlashJailParameters) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.SlashFraction.Size()
-	n += 1 + l + sovProvider(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.JailDuration)
-	n += 1 + l + sovProvider(uint64(l))
-	if m.Tombstone {
-		n += 2
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovProvider(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozProvider(x uint64) (n int) {
-	return sovProvider(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *ConsumerAdditionProposal) Unmarshal(dAtA []byte) error {
+func (m *ConsumerAddrsToPruneV2) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4342,15 +11073,15 @@ func (m *ConsumerAdditionProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConsumerAdditionProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConsumerAddrsToPruneV2: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConsumerAdditionProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConsumerAddrsToPruneV2: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4378,13 +11109,13 @@ func (m *ConsumerAdditionProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PruneTs", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -4394,29 +11125,30 @@ func (m *ConsumerAdditionProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PruneTs, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerAddrs", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -4426,29 +11158,83 @@ func (m *ConsumerAdditionProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			if m.ConsumerAddrs == nil {
+				m.ConsumerAddrs = &AddressList{}
+			}
+			if err := m.ConsumerAddrs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsensusValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsensusValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsensusValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderConsAddr", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -4458,30 +11244,31 @@ func (m *ConsumerAdditionProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InitialHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ProviderConsAddr = append(m.ProviderConsAddr[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProviderConsAddr == nil {
+				m.ProviderConsAddr = []byte{}
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GenesisHash", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
 			}
-			var byteLen int
+			m.Power = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -4491,31 +11278,16 @@ func (m *ConsumerAdditionProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Power |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthProvider
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthProvider
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.GenesisHash = append(m.GenesisHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.GenesisHash == nil {
-				m.GenesisHash = []byte{}
-			}
-			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BinaryHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -4525,31 +11297,33 @@ func (m *ConsumerAdditionProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BinaryHash = append(m.BinaryHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.BinaryHash == nil {
-				m.BinaryHash = []byte{}
+			if m.PublicKey == nil {
+				m.PublicKey = &crypto.PublicKey{}
+			}
+			if err := m.PublicKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpawnTime", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinHeight", wireType)
 			}
-			var msglen int
+			m.JoinHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -4559,28 +11333,64 @@ func (m *ConsumerAdditionProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.JoinHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthProvider
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthProvider
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.SpawnTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsumerRewardsAllocation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
 			}
-			iNdEx = postIndex
-		case 8:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerRewardsAllocation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerRewardsAllocation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4607,15 +11417,66 @@ func (m *ConsumerAdditionProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.UnbondingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+			m.Rewards = append(m.Rewards, types2.DecCoin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsumerMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CcvTimeoutPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -4625,30 +11486,29 @@ func (m *ConsumerAdditionProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.CcvTimeoutPeriod, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TransferTimeoutPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -4658,28 +11518,27 @@ func (m *ConsumerAdditionProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.TransferTimeoutPeriod, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerRedistributionFraction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4707,51 +11566,63 @@ func (m *ConsumerAdditionProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsumerRedistributionFraction = string(dAtA[iNdEx:postIndex])
+			m.Metadata = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlocksPerDistributionTransmission", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.BlocksPerDistributionTransmission = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProvider
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BlocksPerDistributionTransmission |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
 			}
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HistoricalEntries", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.HistoricalEntries = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProvider
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.HistoricalEntries |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsumerInitializationParameters) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
 			}
-		case 14:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerInitializationParameters: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerInitializationParameters: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DistributionTransmissionChannel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialHeight", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -4761,29 +11632,30 @@ func (m *ConsumerAdditionProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DistributionTransmissionChannel = string(dAtA[iNdEx:postIndex])
+			if err := m.InitialHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 15:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Top_N", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisHash", wireType)
 			}
-			m.Top_N = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -4793,54 +11665,31 @@ func (m *ConsumerAdditionProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Top_N |= uint32(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 16:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsPowerCap", wireType)
+			if byteLen < 0 {
+				return ErrInvalidLengthProvider
 			}
-			m.ValidatorsPowerCap = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProvider
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ValidatorsPowerCap |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
 			}
-		case 17:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSetCap", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorSetCap = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProvider
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ValidatorSetCap |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.GenesisHash = append(m.GenesisHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.GenesisHash == nil {
+				m.GenesisHash = []byte{}
 			}
-		case 18:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Allowlist", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BinaryHash", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -4850,29 +11699,31 @@ func (m *ConsumerAdditionProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Allowlist = append(m.Allowlist, string(dAtA[iNdEx:postIndex]))
+			m.BinaryHash = append(m.BinaryHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.BinaryHash == nil {
+				m.BinaryHash = []byte{}
+			}
 			iNdEx = postIndex
-		case 19:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denylist", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SpawnTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -4882,29 +11733,30 @@ func (m *ConsumerAdditionProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denylist = append(m.Denylist, string(dAtA[iNdEx:postIndex]))
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.SpawnTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 20:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinStake", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingPeriod", wireType)
 			}
-			m.MinStake = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -4914,16 +11766,30 @@ func (m *ConsumerAdditionProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MinStake |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 21:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowInactiveVals", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
 			}
-			var v int
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.UnbondingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CcvTimeoutPeriod", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -4933,67 +11799,30 @@ func (m *ConsumerAdditionProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.AllowInactiveVals = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipProvider(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ConsumerRemovalProposal) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowProvider
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.CcvTimeoutPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ConsumerRemovalProposal: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConsumerRemovalProposal: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferTimeoutPeriod", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -5003,27 +11832,28 @@ func (m *ConsumerRemovalProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.TransferTimeoutPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerRedistributionFraction", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5051,13 +11881,13 @@ func (m *ConsumerRemovalProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.ConsumerRedistributionFraction = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocksPerDistributionTransmission", wireType)
 			}
-			var stringLen uint64
+			m.BlocksPerDistributionTransmission = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -5067,29 +11897,35 @@ func (m *ConsumerRemovalProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.BlocksPerDistributionTransmission |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthProvider
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthProvider
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoricalEntries", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.HistoricalEntries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistoricalEntries |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StopTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionTransmissionChannel", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -5099,78 +11935,27 @@ func (m *ConsumerRemovalProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StopTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.DistributionTransmissionChannel = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipProvider(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthProvider
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ConsumerModificationProposal) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowProvider
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ConsumerModificationProposal: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConsumerModificationProposal: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5198,13 +11983,13 @@ func (m *ConsumerModificationProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TrustLevel", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -5214,29 +11999,33 @@ func (m *ConsumerModificationProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			if m.TrustLevel == nil {
+				m.TrustLevel = &_07_tendermint.Fraction{}
+			}
+			if err := m.TrustLevel.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpawnHeight", wireType)
 			}
-			var stringLen uint64
+			m.SpawnHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -5246,25 +12035,62 @@ func (m *ConsumerModificationProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.SpawnHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthProvider
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthProvider
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PowerShapingParameters) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PowerShapingParameters: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PowerShapingParameters: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Top_N", wireType)
 			}
@@ -5283,7 +12109,7 @@ func (m *ConsumerModificationProposal) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 5:
+		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsPowerCap", wireType)
 			}
@@ -5302,7 +12128,7 @@ func (m *ConsumerModificationProposal) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 6:
+		case 3:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSetCap", wireType)
 			}
@@ -5321,7 +12147,7 @@ func (m *ConsumerModificationProposal) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 7:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Allowlist", wireType)
 			}
@@ -5353,7 +12179,7 @@ func (m *ConsumerModificationProposal) Unmarshal(dAtA []byte) error {
 			}
 			m.Allowlist = append(m.Allowlist, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 8:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Denylist", wireType)
 			}
@@ -5385,7 +12211,7 @@ func (m *ConsumerModificationProposal) Unmarshal(dAtA []byte) error {
 			}
 			m.Denylist = append(m.Denylist, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 9:
+		case 6:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field MinStake", wireType)
 			}
@@ -5404,7 +12230,7 @@ func (m *ConsumerModificationProposal) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 10:
+		case 7:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field AllowInactiveVals", wireType)
 			}
@@ -5424,59 +12250,9 @@ func (m *ConsumerModificationProposal) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.AllowInactiveVals = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipProvider(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthProvider
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EquivocationProposal) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowProvider
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EquivocationProposal: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EquivocationProposal: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Prioritylist", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5504,13 +12280,13 @@ func (m *EquivocationProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.Prioritylist = append(m.Prioritylist, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Top_NWeightedEpochs", wireType)
 			}
-			var stringLen uint64
+			m.Top_NWeightedEpochs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -5520,27 +12296,14 @@ func (m *EquivocationProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Top_NWeightedEpochs |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthProvider
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthProvider
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Equivocations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PrioritylistWeights", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5567,8 +12330,8 @@ func (m *EquivocationProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Equivocations = append(m.Equivocations, &types1.Equivocation{})
-			if err := m.Equivocations[len(m.Equivocations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PrioritylistWeights = append(m.PrioritylistWeights, PrioritylistWeight{})
+			if err := m.PrioritylistWeights[len(m.PrioritylistWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5593,7 +12356,7 @@ func (m *EquivocationProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ChangeRewardDenomsProposal) Unmarshal(dAtA []byte) error {
+func (m *PrioritylistWeight) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5616,15 +12379,15 @@ func (m *ChangeRewardDenomsProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChangeRewardDenomsProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: PrioritylistWeight: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChangeRewardDenomsProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PrioritylistWeight: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5652,13 +12415,13 @@ func (m *ChangeRewardDenomsProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.ProviderAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
 			}
-			var stringLen uint64
+			m.Weight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -5668,59 +12431,190 @@ func (m *ChangeRewardDenomsProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Weight |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthProvider
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthProvider
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DenomsToAdd", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorPowerHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProvider
-				}
-				if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorPowerHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorPowerHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowProvider
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Powers = append(m.Powers, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowProvider
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthProvider
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthProvider
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Powers) == 0 {
+					m.Powers = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowProvider
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Powers = append(m.Powers, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Powers", wireType)
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthProvider
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthProvider
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DenomsToAdd = append(m.DenomsToAdd, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 4:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsumerIds) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerIds: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerIds: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DenomsToRemove", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5748,7 +12642,7 @@ func (m *ChangeRewardDenomsProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DenomsToRemove = append(m.DenomsToRemove, string(dAtA[iNdEx:postIndex]))
+			m.Ids = append(m.Ids, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5771,7 +12665,7 @@ func (m *ChangeRewardDenomsProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GlobalSlashEntry) Unmarshal(dAtA []byte) error {
+func (m *AllowlistedRewardDenoms) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5794,48 +12688,15 @@ func (m *GlobalSlashEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GlobalSlashEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: AllowlistedRewardDenoms: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GlobalSlashEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AllowlistedRewardDenoms: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecvTime", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProvider
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthProvider
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthProvider
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.RecvTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerChainID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5863,60 +12724,7 @@ func (m *GlobalSlashEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsumerChainID = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IbcSeqNum", wireType)
-			}
-			m.IbcSeqNum = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProvider
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.IbcSeqNum |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProviderValConsAddr", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProvider
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthProvider
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthProvider
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ProviderValConsAddr = append(m.ProviderValConsAddr[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProviderValConsAddr == nil {
-				m.ProviderValConsAddr = []byte{}
-			}
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5939,7 +12747,7 @@ func (m *GlobalSlashEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *InfractionParameters) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5962,15 +12770,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: InfractionParameters: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: InfractionParameters: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TemplateClient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DoubleSign", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5997,48 +12805,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TemplateClient == nil {
-				m.TemplateClient = &_07_tendermint.ClientState{}
+			if m.DoubleSign == nil {
+				m.DoubleSign = &SlashJailParameters{}
 			}
-			if err := m.TemplateClient.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DoubleSign.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TrustingPeriodFraction", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProvider
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthProvider
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthProvider
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TrustingPeriodFraction = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CcvTimeoutPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Downtime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6065,48 +12841,68 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.CcvTimeoutPeriod, dAtA[iNdEx:postIndex]); err != nil {
+			if m.Downtime == nil {
+				m.Downtime = &SlashJailParameters{}
+			}
+			if err := m.Downtime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashMeterReplenishPeriod", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProvider
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthProvider
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SlashJailParameters) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.SlashMeterReplenishPeriod, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 7:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SlashJailParameters: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SlashJailParameters: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashMeterReplenishFraction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -6116,27 +12912,28 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SlashMeterReplenishFraction = string(dAtA[iNdEx:postIndex])
+			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 9:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerRewardDenomRegistrationFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JailDuration", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6163,53 +12960,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ConsumerRewardDenomRegistrationFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.JailDuration, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlocksPerEpoch", wireType)
-			}
-			m.BlocksPerEpoch = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProvider
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BlocksPerEpoch |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumberOfEpochsToStartReceivingRewards", wireType)
-			}
-			m.NumberOfEpochsToStartReceivingRewards = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProvider
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NumberOfEpochsToStartReceivingRewards |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 12:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxProviderConsensusValidators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tombstone", wireType)
 			}
-			m.MaxProviderConsensusValidators = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -6219,11 +12978,12 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxProviderConsensusValidators |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Tombstone = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProvider(dAtA[iNdEx:])
@@ -6245,7 +13005,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SlashAcks) Unmarshal(dAtA []byte) error {
+func (m *ThrottlingParameters) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6268,15 +13028,15 @@ func (m *SlashAcks) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SlashAcks: wiretype end group for non-group")
+			return fmt.Errorf("proto: ThrottlingParameters: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SlashAcks: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ThrottlingParameters: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashMeterReplenishFraction", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6304,7 +13064,40 @@ func (m *SlashAcks) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			m.SlashMeterReplenishFraction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashMeterReplenishPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.SlashMeterReplenishPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6327,7 +13120,7 @@ func (m *SlashAcks) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConsumerAdditionProposals) Unmarshal(dAtA []byte) error {
+func (m *ConsumerInitialConsensusState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6350,15 +13143,49 @@ func (m *ConsumerAdditionProposals) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConsumerAdditionProposals: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConsumerInitialConsensusState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConsumerAdditionProposals: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConsumerInitialConsensusState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GenesisHash = append(m.GenesisHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.GenesisHash == nil {
+				m.GenesisHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusState", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6385,8 +13212,7 @@ func (m *ConsumerAdditionProposals) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pending = append(m.Pending, &ConsumerAdditionProposal{})
-			if err := m.Pending[len(m.Pending)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ConsensusState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6411,7 +13237,7 @@ func (m *ConsumerAdditionProposals) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConsumerRemovalProposals) Unmarshal(dAtA []byte) error {
+func (m *PowerShapingPipeline) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6434,15 +13260,34 @@ func (m *ConsumerRemovalProposals) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConsumerRemovalProposals: wiretype end group for non-group")
+			return fmt.Errorf("proto: PowerShapingPipeline: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConsumerRemovalProposals: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PowerShapingPipeline: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pending", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6469,8 +13314,8 @@ func (m *ConsumerRemovalProposals) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pending = append(m.Pending, &ConsumerRemovalProposal{})
-			if err := m.Pending[len(m.Pending)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Steps = append(m.Steps, PowerShapingStep{})
+			if err := m.Steps[len(m.Steps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6495,7 +13340,7 @@ func (m *ConsumerRemovalProposals) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddressList) Unmarshal(dAtA []byte) error {
+func (m *PowerShapingStep) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6518,17 +13363,17 @@ func (m *AddressList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddressList: wiretype end group for non-group")
+			return fmt.Errorf("proto: PowerShapingStep: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddressList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PowerShapingStep: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -6538,23 +13383,87 @@ func (m *AddressList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorsIn = append(m.ValidatorsIn, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsOut", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addresses = append(m.Addresses, make([]byte, postIndex-iNdEx))
-			copy(m.Addresses[len(m.Addresses)-1], dAtA[iNdEx:postIndex])
+			m.ValidatorsOut = append(m.ValidatorsOut, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6577,7 +13486,7 @@ func (m *AddressList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ChannelToChain) Unmarshal(dAtA []byte) error {
+func (m *FundFlowRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6600,15 +13509,34 @@ func (m *ChannelToChain) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChannelToChain: wiretype end group for non-group")
+			return fmt.Errorf("proto: FundFlowRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChannelToChain: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FundFlowRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= FundFlowType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAccount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6636,11 +13564,11 @@ func (m *ChannelToChain) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			m.ModuleAccount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6668,7 +13596,93 @@ func (m *ChannelToChain) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types2.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6691,7 +13705,7 @@ func (m *ChannelToChain) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorSetChangePackets) Unmarshal(dAtA []byte) error {
+func (m *ConsumerLaunchFailure) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6714,15 +13728,66 @@ func (m *ValidatorSetChangePackets) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorSetChangePackets: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConsumerLaunchFailure: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorSetChangePackets: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConsumerLaunchFailure: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field List", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6749,11 +13814,30 @@ func (m *ValidatorSetChangePackets) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.List = append(m.List, types3.ValidatorSetChangePacketData{})
-			if err := m.List[len(m.List)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retriable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Retriable = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProvider(dAtA[iNdEx:])
@@ -6775,7 +13859,7 @@ func (m *ValidatorSetChangePackets) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KeyAssignmentReplacement) Unmarshal(dAtA []byte) error {
+func (m *ConsumerHashCommitment) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6798,17 +13882,104 @@ func (m *KeyAssignmentReplacement) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KeyAssignmentReplacement: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConsumerHashCommitment: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KeyAssignmentReplacement: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConsumerHashCommitment: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RegisteredGenesisHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RegisteredGenesisHash = append(m.RegisteredGenesisHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.RegisteredGenesisHash == nil {
+				m.RegisteredGenesisHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegisteredBinaryHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RegisteredBinaryHash = append(m.RegisteredBinaryHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.RegisteredBinaryHash == nil {
+				m.RegisteredBinaryHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateCount", wireType)
+			}
+			m.UpdateCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdateCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			var byteLen int
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastUpdateHeight", wireType)
+			}
+			m.LastUpdateHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -6818,31 +13989,16 @@ func (m *KeyAssignmentReplacement) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.LastUpdateHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthProvider
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthProvider
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ProviderAddr = append(m.ProviderAddr[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProviderAddr == nil {
-				m.ProviderAddr = []byte{}
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PrevCKey", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attested", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -6852,33 +14008,17 @@ func (m *KeyAssignmentReplacement) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthProvider
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthProvider
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.PrevCKey == nil {
-				m.PrevCKey = &crypto.PublicKey{}
-			}
-			if err := m.PrevCKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
+			m.Attested = bool(v != 0)
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationHeight", wireType)
 			}
-			m.Power = 0
+			m.AttestationHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -6888,7 +14028,7 @@ func (m *KeyAssignmentReplacement) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Power |= int64(b&0x7F) << shift
+				m.AttestationHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -6914,7 +14054,7 @@ func (m *KeyAssignmentReplacement) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorConsumerPubKey) Unmarshal(dAtA []byte) error {
+func (m *ConsumerPacketStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6937,17 +14077,17 @@ func (m *ValidatorConsumerPubKey) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorConsumerPubKey: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConsumerPacketStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorConsumerPubKey: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConsumerPacketStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VscPacketsSent", wireType)
 			}
-			var stringLen uint64
+			m.VscPacketsSent = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -6957,29 +14097,16 @@ func (m *ValidatorConsumerPubKey) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.VscPacketsSent |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthProvider
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthProvider
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddr", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VscMaturedPacketsReceived", wireType)
 			}
-			var byteLen int
+			m.VscMaturedPacketsReceived = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -6989,31 +14116,16 @@ func (m *ValidatorConsumerPubKey) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.VscMaturedPacketsReceived |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthProvider
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthProvider
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ProviderAddr = append(m.ProviderAddr[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProviderAddr == nil {
-				m.ProviderAddr = []byte{}
-			}
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerKey", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashPacketsReceived", wireType)
 			}
-			var msglen int
+			m.SlashPacketsReceived = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -7023,28 +14135,68 @@ func (m *ValidatorConsumerPubKey) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.SlashPacketsReceived |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthProvider
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashPacketsHandled", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthProvider
+			m.SlashPacketsHandled = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashPacketsHandled |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashPacketsBounced", wireType)
 			}
-			if m.ConsumerKey == nil {
-				m.ConsumerKey = &crypto.PublicKey{}
+			m.SlashPacketsBounced = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashPacketsBounced |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if err := m.ConsumerKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorAcksReceived", wireType)
+			}
+			m.ErrorAcksReceived = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ErrorAcksReceived |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProvider(dAtA[iNdEx:])
@@ -7066,7 +14218,7 @@ func (m *ValidatorConsumerPubKey) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ValidatorByConsumerAddr) Unmarshal(dAtA []byte) error {
+func (m *PreLaunchKeyAssignment) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7089,10 +14241,10 @@ func (m *ValidatorByConsumerAddr) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ValidatorByConsumerAddr: wiretype end group for non-group")
+			return fmt.Errorf("proto: PreLaunchKeyAssignment: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ValidatorByConsumerAddr: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PreLaunchKeyAssignment: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7129,9 +14281,9 @@ func (m *ValidatorByConsumerAddr) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddr", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -7141,31 +14293,29 @@ func (m *ValidatorByConsumerAddr) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsumerAddr = append(m.ConsumerAddr[:0], dAtA[iNdEx:postIndex]...)
-			if m.ConsumerAddr == nil {
-				m.ConsumerAddr = []byte{}
-			}
+			m.ProviderAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerKey", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -7175,25 +14325,23 @@ func (m *ValidatorByConsumerAddr) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProviderAddr = append(m.ProviderAddr[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProviderAddr == nil {
-				m.ProviderAddr = []byte{}
-			}
+			m.ConsumerKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7216,7 +14364,7 @@ func (m *ValidatorByConsumerAddr) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConsumerAddrsToPruneV2) Unmarshal(dAtA []byte) error {
+func (m *ScheduledKeyAssignment) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7239,15 +14387,15 @@ func (m *ConsumerAddrsToPruneV2) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConsumerAddrsToPruneV2: wiretype end group for non-group")
+			return fmt.Errorf("proto: ScheduledKeyAssignment: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConsumerAddrsToPruneV2: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ScheduledKeyAssignment: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7275,13 +14423,13 @@ func (m *ConsumerAddrsToPruneV2) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PruneTs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddr", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -7291,30 +14439,29 @@ func (m *ConsumerAddrsToPruneV2) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PruneTs, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ProviderAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerAddrs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerKey", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -7324,28 +14471,43 @@ func (m *ConsumerAddrsToPruneV2) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ConsumerAddrs == nil {
-				m.ConsumerAddrs = &AddressList{}
+			m.ConsumerKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
 			}
-			if err := m.ConsumerAddrs.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ActivationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProvider(dAtA[iNdEx:])
@@ -7367,7 +14529,7 @@ func (m *ConsumerAddrsToPruneV2) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConsensusValidator) Unmarshal(dAtA []byte) error {
+func (m *ConsumerClientUpgradePlan) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7390,17 +14552,17 @@ func (m *ConsensusValidator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConsensusValidator: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConsumerClientUpgradePlan: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConsensusValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConsumerClientUpgradePlan: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProviderConsAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradeHeight", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -7410,31 +14572,30 @@ func (m *ConsensusValidator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProviderConsAddr = append(m.ProviderConsAddr[:0], dAtA[iNdEx:postIndex]...)
-			if m.ProviderConsAddr == nil {
-				m.ProviderConsAddr = []byte{}
+			if err := m.UpgradeHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewChainId", wireType)
 			}
-			m.Power = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -7444,14 +14605,27 @@ func (m *ConsensusValidator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Power |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingPeriod", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7478,32 +14652,10 @@ func (m *ConsensusValidator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PublicKey == nil {
-				m.PublicKey = &crypto.PublicKey{}
-			}
-			if err := m.PublicKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.UnbondingPeriod, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JoinHeight", wireType)
-			}
-			m.JoinHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProvider
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.JoinHeight |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProvider(dAtA[iNdEx:])
@@ -7525,7 +14677,7 @@ func (m *ConsensusValidator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConsumerRewardsAllocation) Unmarshal(dAtA []byte) error {
+func (m *ConsumerProviderSwitch) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7548,15 +14700,15 @@ func (m *ConsumerRewardsAllocation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConsumerRewardsAllocation: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConsumerProviderSwitch: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConsumerRewardsAllocation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConsumerProviderSwitch: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SwitchHeight", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7583,11 +14735,42 @@ func (m *ConsumerRewardsAllocation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Rewards = append(m.Rewards, types2.DecCoin{})
-			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SwitchHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewProviderChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProvider
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewProviderChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProvider(dAtA[iNdEx:])
@@ -7609,7 +14792,7 @@ func (m *ConsumerRewardsAllocation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConsumerMetadata) Unmarshal(dAtA []byte) error {
+func (m *BouncedSlashPacket) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7632,15 +14815,15 @@ func (m *ConsumerMetadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConsumerMetadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: BouncedSlashPacket: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConsumerMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BouncedSlashPacket: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7668,11 +14851,11 @@ func (m *ConsumerMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerConsAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7700,11 +14883,11 @@ func (m *ConsumerMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.ConsumerConsAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderConsAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7732,61 +14915,30 @@ func (m *ConsumerMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Metadata = string(dAtA[iNdEx:postIndex])
+			m.ProviderConsAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipProvider(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthProvider
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ConsumerInitializationParameters) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowProvider
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValsetUpdateId", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.ValsetUpdateId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValsetUpdateId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ConsumerInitializationParameters: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConsumerInitializationParameters: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstReceiveTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7813,15 +14965,15 @@ func (m *ConsumerInitializationParameters) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InitialHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.FirstReceiveTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GenesisHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastReceiveTime", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -7831,31 +14983,99 @@ func (m *ConsumerInitializationParameters) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GenesisHash = append(m.GenesisHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.GenesisHash == nil {
-				m.GenesisHash = []byte{}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.LastReceiveTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bounces", wireType)
+			}
+			m.Bounces = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Bounces |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SentVSCPacket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SentVSCPacket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SentVSCPacket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BinaryHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -7865,29 +15085,46 @@ func (m *ConsumerInitializationParameters) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BinaryHash = append(m.BinaryHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.BinaryHash == nil {
-				m.BinaryHash = []byte{}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpawnTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SendTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7914,13 +15151,13 @@ func (m *ConsumerInitializationParameters) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.SpawnTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.SendTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7947,15 +15184,65 @@ func (m *ConsumerInitializationParameters) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.UnbondingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CcvTimeoutPeriod", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var msglen int
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProvider
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KeyAssignmentObservation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KeyAssignmentObservation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KeyAssignmentObservation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrackedSinceHeight", wireType)
+			}
+			m.TrackedSinceHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -7965,30 +15252,16 @@ func (m *ConsumerInitializationParameters) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TrackedSinceHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthProvider
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthProvider
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.CcvTimeoutPeriod, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TransferTimeoutPeriod", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastObservedHeight", wireType)
 			}
-			var msglen int
+			m.LastObservedHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -7998,28 +15271,64 @@ func (m *ConsumerInitializationParameters) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.LastObservedHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthProvider
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProvider(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthProvider
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.TransferTimeoutPeriod, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsumerUpdateRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProvider
 			}
-			iNdEx = postIndex
-		case 8:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerUpdateRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerUpdateRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerRedistributionFraction", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8047,32 +15356,13 @@ func (m *ConsumerInitializationParameters) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsumerRedistributionFraction = string(dAtA[iNdEx:postIndex])
+			m.Submitter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlocksPerDistributionTransmission", wireType)
-			}
-			m.BlocksPerDistributionTransmission = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProvider
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BlocksPerDistributionTransmission |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 10:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HistoricalEntries", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			m.HistoricalEntries = 0
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -8082,16 +15372,16 @@ func (m *ConsumerInitializationParameters) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.HistoricalEntries |= int64(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DistributionTransmissionChannel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -8101,29 +15391,30 @@ func (m *ConsumerInitializationParameters) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DistributionTransmissionChannel = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 12:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Changes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -8133,23 +15424,25 @@ func (m *ConsumerInitializationParameters) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			m.Changes = append(m.Changes, ConsumerFieldChange{})
+			if err := m.Changes[len(m.Changes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8172,7 +15465,7 @@ func (m *ConsumerInitializationParameters) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PowerShapingParameters) Unmarshal(dAtA []byte) error {
+func (m *ConsumerFieldChange) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8185,82 +15478,25 @@ func (m *PowerShapingParameters) Unmarshal(dAtA []byte) error {
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PowerShapingParameters: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PowerShapingParameters: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Top_N", wireType)
-			}
-			m.Top_N = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProvider
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Top_N |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsPowerCap", wireType)
-			}
-			m.ValidatorsPowerCap = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProvider
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ValidatorsPowerCap |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSetCap", wireType)
-			}
-			m.ValidatorSetCap = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProvider
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ValidatorSetCap |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 4:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerFieldChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerFieldChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Allowlist", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Field", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8288,11 +15524,11 @@ func (m *PowerShapingParameters) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Allowlist = append(m.Allowlist, string(dAtA[iNdEx:postIndex]))
+			m.Field = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denylist", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OldValue", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8320,50 +15556,11 @@ func (m *PowerShapingParameters) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denylist = append(m.Denylist, string(dAtA[iNdEx:postIndex]))
+			m.OldValue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinStake", wireType)
-			}
-			m.MinStake = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProvider
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MinStake |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowInactiveVals", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowProvider
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.AllowInactiveVals = bool(v != 0)
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prioritylist", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewValue", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8391,7 +15588,7 @@ func (m *PowerShapingParameters) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Prioritylist = append(m.Prioritylist, string(dAtA[iNdEx:postIndex]))
+			m.NewValue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8414,7 +15611,7 @@ func (m *PowerShapingParameters) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ConsumerIds) Unmarshal(dAtA []byte) error {
+func (m *ValidatorOptInRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8437,15 +15634,15 @@ func (m *ConsumerIds) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ConsumerIds: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorOptInRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ConsumerIds: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorOptInRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8473,63 +15670,72 @@ func (m *ConsumerIds) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ids = append(m.Ids, string(dAtA[iNdEx:postIndex]))
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipProvider(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptedIn", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthProvider
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			m.OptedIn = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForcedByTopN", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AllowlistedRewardDenoms) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowProvider
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.ForcedByTopN = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AllowlistedRewardDenoms: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AllowlistedRewardDenoms: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -8539,24 +15745,44 @@ func (m *AllowlistedRewardDenoms) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochStartHeight", wireType)
+			}
+			m.EpochStartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProvider
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochStartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProvider(dAtA[iNdEx:])
@@ -8578,7 +15804,7 @@ func (m *AllowlistedRewardDenoms) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *InfractionParameters) Unmarshal(dAtA []byte) error {
+func (m *ValidatorConsumerOptInHistory) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8601,17 +15827,17 @@ func (m *InfractionParameters) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: InfractionParameters: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorConsumerOptInHistory: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: InfractionParameters: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorConsumerOptInHistory: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DoubleSign", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -8621,31 +15847,27 @@ func (m *InfractionParameters) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthProvider
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthProvider
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DoubleSign == nil {
-				m.DoubleSign = &SlashJailParameters{}
-			}
-			if err := m.DoubleSign.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Downtime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8672,10 +15894,8 @@ func (m *InfractionParameters) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Downtime == nil {
-				m.Downtime = &SlashJailParameters{}
-			}
-			if err := m.Downtime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Records = append(m.Records, ValidatorOptInRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8700,7 +15920,7 @@ func (m *InfractionParameters) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SlashJailParameters) Unmarshal(dAtA []byte) error {
+func (m *EpochInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8723,17 +15943,17 @@ func (m *SlashJailParameters) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SlashJailParameters: wiretype end group for non-group")
+			return fmt.Errorf("proto: EpochInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SlashJailParameters: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EpochInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
 			}
-			var byteLen int
+			m.StartHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -8743,28 +15963,14 @@ func (m *SlashJailParameters) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.StartHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthProvider
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthProvider
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JailDuration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8791,15 +15997,15 @@ func (m *SlashJailParameters) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.JailDuration, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tombstone", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousEpochBlocks", wireType)
 			}
-			var v int
+			m.PreviousEpochBlocks = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowProvider
@@ -8809,12 +16015,11 @@ func (m *SlashJailParameters) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.PreviousEpochBlocks |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Tombstone = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProvider(dAtA[iNdEx:])
@@ -0,0 +1,38 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+	ccv "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+func TestConsumerInitializationParametersForProfile(t *testing.T) {
+	// the mainnet profile uses the default initialization parameters
+	initializationParameters, err := types.ConsumerInitializationParametersForProfile(types.InitParamsProfileMainnetConservative)
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultConsumerInitializationParameters(), initializationParameters)
+	require.Equal(t, ccv.DefaultConsumerUnbondingPeriod, initializationParameters.UnbondingPeriod)
+
+	for _, profile := range types.InitializationParametersProfiles() {
+		initializationParameters, err := types.ConsumerInitializationParametersForProfile(types.InitializationParametersProfile(profile))
+		require.NoError(t, err, profile)
+		require.NoError(t, types.ValidateInitializationParameters(initializationParameters), profile)
+		// a CCV packet times out only after the unbonding period has elapsed
+		require.Greater(t, initializationParameters.CcvTimeoutPeriod, initializationParameters.UnbondingPeriod, profile)
+		require.LessOrEqual(t, initializationParameters.UnbondingPeriod, ccv.DefaultConsumerUnbondingPeriod, profile)
+	}
+
+	// the test networks profiles do not use a week-long unbonding period
+	testnetParameters, err := types.ConsumerInitializationParametersForProfile(types.InitParamsProfileTestnetFast)
+	require.NoError(t, err)
+	devnetParameters, err := types.ConsumerInitializationParametersForProfile(types.InitParamsProfileDevnetInstant)
+	require.NoError(t, err)
+	require.Less(t, devnetParameters.UnbondingPeriod, testnetParameters.UnbondingPeriod)
+	require.Less(t, testnetParameters.UnbondingPeriod, initializationParameters.UnbondingPeriod)
+
+	_, err = types.ConsumerInitializationParametersForProfile("unknown")
+	require.Error(t, err)
+}
@@ -9,17 +9,21 @@ import (
 	fmt "fmt"
 	crypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types3 "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	types1 "github.com/cosmos/cosmos-sdk/x/staking/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	types2 "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
 	types "github.com/cosmos/interchain-security/v7/x/ccv/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -129,7 +133,7 @@ func (m *QueryConsumerGenesisResponse) GetGenesisState() types.ConsumerGenesisSt
 
 type QueryConsumerChainsRequest struct {
 	// The phase of the consumer chains returned (optional)
-	// Registered=1|Initialized=2|Launched=3|Stopped=4|Deleted=5
+	// Registered=1|Initialized=2|Launched=3|Stopped=4|Deleted=5|Paused=6
 	Phase      ConsumerPhase      `protobuf:"varint,1,opt,name=phase,proto3,enum=interchain_security.ccv.provider.v1.ConsumerPhase" json:"phase,omitempty"`
 	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
@@ -268,6 +272,11 @@ type Chain struct {
 	Prioritylist []string `protobuf:"bytes,15,rep,name=prioritylist,proto3" json:"prioritylist,omitempty"`
 	// Infraction parameters for slashing and jailing
 	InfractionParameters *InfractionParameters `protobuf:"bytes,16,opt,name=infraction_parameters,json=infractionParameters,proto3" json:"infraction_parameters,omitempty"`
+	// Corresponds to the number of epochs over which the voting powers of the validators
+	// are averaged to compute the Top N validators
+	Top_NWeightedEpochs uint32 `protobuf:"varint,17,opt,name=top_N_weighted_epochs,json=topNWeightedEpochs,proto3" json:"top_N_weighted_epochs,omitempty"`
+	// Corresponds to the weights of validators in the prioritylist
+	PrioritylistWeights []PrioritylistWeight `protobuf:"bytes,18,rep,name=prioritylist_weights,json=prioritylistWeights,proto3" json:"prioritylist_weights"`
 }
 
 func (m *Chain) Reset()         { *m = Chain{} }
@@ -415,6 +424,20 @@ func (m *Chain) GetInfractionParameters() *InfractionParameters {
 	return nil
 }
 
+func (m *Chain) GetTop_NWeightedEpochs() uint32 {
+	if m != nil {
+		return m.Top_NWeightedEpochs
+	}
+	return 0
+}
+
+func (m *Chain) GetPrioritylistWeights() []PrioritylistWeight {
+	if m != nil {
+		return m.PrioritylistWeights
+	}
+	return nil
+}
+
 type QueryValidatorConsumerAddrRequest struct {
 	// The consensus address of the validator on the provider chain
 	ProviderAddress string `protobuf:"bytes,1,opt,name=provider_address,json=providerAddress,proto3" json:"provider_address,omitempty" yaml:"address"`
@@ -1756,6 +1779,14 @@ type QueryConsumerChainResponse struct {
 	InfractionParameters *InfractionParameters             `protobuf:"bytes,8,opt,name=infraction_parameters,json=infractionParameters,proto3" json:"infraction_parameters,omitempty"`
 	// corresponds to the id of the client that is created during launch
 	ClientId string `protobuf:"bytes,9,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// the chain id the consumer chain is scheduled to change to once its client
+	// is upgraded (see MsgChangeConsumerChainId); empty if no change is pending
+	PendingChainId       string                `protobuf:"bytes,10,opt,name=pending_chain_id,json=pendingChainId,proto3" json:"pending_chain_id,omitempty"`
+	ThrottlingParameters *ThrottlingParameters `protobuf:"bytes,11,opt,name=throttling_parameters,json=throttlingParameters,proto3" json:"throttling_parameters,omitempty"`
+	// the initial height expected by the client to the consumer chain, i.e., the initial height
+	// of the initialization parameters with the revision number of the chain id; if it differs
+	// from the initial height of the initialization parameters, the consumer chain cannot launch
+	ExpectedInitialHeight types2.Height `protobuf:"bytes,12,opt,name=expected_initial_height,json=expectedInitialHeight,proto3" json:"expected_initial_height"`
 }
 
 func (m *QueryConsumerChainResponse) Reset()         { *m = QueryConsumerChainResponse{} }
@@ -1854,6 +1885,27 @@ func (m *QueryConsumerChainResponse) GetClientId() string {
 	return ""
 }
 
+func (m *QueryConsumerChainResponse) GetPendingChainId() string {
+	if m != nil {
+		return m.PendingChainId
+	}
+	return ""
+}
+
+func (m *QueryConsumerChainResponse) GetThrottlingParameters() *ThrottlingParameters {
+	if m != nil {
+		return m.ThrottlingParameters
+	}
+	return nil
+}
+
+func (m *QueryConsumerChainResponse) GetExpectedInitialHeight() types2.Height {
+	if m != nil {
+		return m.ExpectedInitialHeight
+	}
+	return types2.Height{}
+}
+
 type QueryConsumerGenesisTimeRequest struct {
 	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
 }
@@ -1942,2944 +1994,17019 @@ func (m *QueryConsumerGenesisTimeResponse) GetGenesisTime() time.Time {
 	return time.Time{}
 }
 
-func init() {
-	proto.RegisterType((*QueryConsumerGenesisRequest)(nil), "interchain_security.ccv.provider.v1.QueryConsumerGenesisRequest")
-	proto.RegisterType((*QueryConsumerGenesisResponse)(nil), "interchain_security.ccv.provider.v1.QueryConsumerGenesisResponse")
-	proto.RegisterType((*QueryConsumerChainsRequest)(nil), "interchain_security.ccv.provider.v1.QueryConsumerChainsRequest")
-	proto.RegisterType((*QueryConsumerChainsResponse)(nil), "interchain_security.ccv.provider.v1.QueryConsumerChainsResponse")
-	proto.RegisterType((*Chain)(nil), "interchain_security.ccv.provider.v1.Chain")
-	proto.RegisterType((*QueryValidatorConsumerAddrRequest)(nil), "interchain_security.ccv.provider.v1.QueryValidatorConsumerAddrRequest")
-	proto.RegisterType((*QueryValidatorConsumerAddrResponse)(nil), "interchain_security.ccv.provider.v1.QueryValidatorConsumerAddrResponse")
-	proto.RegisterType((*QueryValidatorProviderAddrRequest)(nil), "interchain_security.ccv.provider.v1.QueryValidatorProviderAddrRequest")
-	proto.RegisterType((*QueryValidatorProviderAddrResponse)(nil), "interchain_security.ccv.provider.v1.QueryValidatorProviderAddrResponse")
-	proto.RegisterType((*QueryThrottleStateRequest)(nil), "interchain_security.ccv.provider.v1.QueryThrottleStateRequest")
-	proto.RegisterType((*QueryThrottleStateResponse)(nil), "interchain_security.ccv.provider.v1.QueryThrottleStateResponse")
-	proto.RegisterType((*QueryRegisteredConsumerRewardDenomsRequest)(nil), "interchain_security.ccv.provider.v1.QueryRegisteredConsumerRewardDenomsRequest")
-	proto.RegisterType((*QueryRegisteredConsumerRewardDenomsResponse)(nil), "interchain_security.ccv.provider.v1.QueryRegisteredConsumerRewardDenomsResponse")
-	proto.RegisterType((*QueryAllPairsValConsAddrByConsumerRequest)(nil), "interchain_security.ccv.provider.v1.QueryAllPairsValConsAddrByConsumerRequest")
-	proto.RegisterType((*QueryAllPairsValConsAddrByConsumerResponse)(nil), "interchain_security.ccv.provider.v1.QueryAllPairsValConsAddrByConsumerResponse")
-	proto.RegisterType((*PairValConAddrProviderAndConsumer)(nil), "interchain_security.ccv.provider.v1.PairValConAddrProviderAndConsumer")
-	proto.RegisterType((*QueryParamsRequest)(nil), "interchain_security.ccv.provider.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "interchain_security.ccv.provider.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryConsumerChainOptedInValidatorsRequest)(nil), "interchain_security.ccv.provider.v1.QueryConsumerChainOptedInValidatorsRequest")
-	proto.RegisterType((*QueryConsumerChainOptedInValidatorsResponse)(nil), "interchain_security.ccv.provider.v1.QueryConsumerChainOptedInValidatorsResponse")
-	proto.RegisterType((*QueryConsumerValidatorsRequest)(nil), "interchain_security.ccv.provider.v1.QueryConsumerValidatorsRequest")
-	proto.RegisterType((*QueryConsumerValidatorsValidator)(nil), "interchain_security.ccv.provider.v1.QueryConsumerValidatorsValidator")
-	proto.RegisterType((*QueryConsumerValidatorsResponse)(nil), "interchain_security.ccv.provider.v1.QueryConsumerValidatorsResponse")
-	proto.RegisterType((*QueryConsumerChainsValidatorHasToValidateRequest)(nil), "interchain_security.ccv.provider.v1.QueryConsumerChainsValidatorHasToValidateRequest")
-	proto.RegisterType((*QueryConsumerChainsValidatorHasToValidateResponse)(nil), "interchain_security.ccv.provider.v1.QueryConsumerChainsValidatorHasToValidateResponse")
-	proto.RegisterType((*QueryValidatorConsumerCommissionRateRequest)(nil), "interchain_security.ccv.provider.v1.QueryValidatorConsumerCommissionRateRequest")
-	proto.RegisterType((*QueryValidatorConsumerCommissionRateResponse)(nil), "interchain_security.ccv.provider.v1.QueryValidatorConsumerCommissionRateResponse")
-	proto.RegisterType((*QueryBlocksUntilNextEpochRequest)(nil), "interchain_security.ccv.provider.v1.QueryBlocksUntilNextEpochRequest")
-	proto.RegisterType((*QueryBlocksUntilNextEpochResponse)(nil), "interchain_security.ccv.provider.v1.QueryBlocksUntilNextEpochResponse")
-	proto.RegisterType((*QueryConsumerIdFromClientIdRequest)(nil), "interchain_security.ccv.provider.v1.QueryConsumerIdFromClientIdRequest")
-	proto.RegisterType((*QueryConsumerIdFromClientIdResponse)(nil), "interchain_security.ccv.provider.v1.QueryConsumerIdFromClientIdResponse")
-	proto.RegisterType((*QueryConsumerChainRequest)(nil), "interchain_security.ccv.provider.v1.QueryConsumerChainRequest")
-	proto.RegisterType((*QueryConsumerChainResponse)(nil), "interchain_security.ccv.provider.v1.QueryConsumerChainResponse")
-	proto.RegisterType((*QueryConsumerGenesisTimeRequest)(nil), "interchain_security.ccv.provider.v1.QueryConsumerGenesisTimeRequest")
-	proto.RegisterType((*QueryConsumerGenesisTimeResponse)(nil), "interchain_security.ccv.provider.v1.QueryConsumerGenesisTimeResponse")
+type QueryNearTimeoutPacketsRequest struct {
+	// the packets that time out within this duration from the current block time are returned
+	Within time.Duration `protobuf:"bytes,1,opt,name=within,proto3,stdduration" json:"within"`
 }
 
-func init() {
-	proto.RegisterFile("interchain_security/ccv/provider/v1/query.proto", fileDescriptor_422512d7b7586cd7)
+func (m *QueryNearTimeoutPacketsRequest) Reset()         { *m = QueryNearTimeoutPacketsRequest{} }
+func (m *QueryNearTimeoutPacketsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNearTimeoutPacketsRequest) ProtoMessage()    {}
+func (*QueryNearTimeoutPacketsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{35}
 }
-
-var fileDescriptor_422512d7b7586cd7 = []byte{
-	// 2574 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x5a, 0xcf, 0x6f, 0x1b, 0xc7,
-	0xf5, 0xd7, 0x52, 0x3f, 0x4c, 0x0d, 0x2d, 0x39, 0x1e, 0xcb, 0x16, 0x4d, 0x39, 0xa2, 0xbc, 0x8e,
-	0xbf, 0x5f, 0x45, 0x8e, 0x49, 0x49, 0x45, 0xea, 0xd8, 0x89, 0x7f, 0x88, 0xb2, 0x24, 0x13, 0x8e,
-	0x6d, 0x65, 0xa5, 0x38, 0x80, 0x53, 0x77, 0x3b, 0xda, 0x1d, 0x53, 0x53, 0x91, 0xbb, 0xeb, 0x9d,
-	0x11, 0x6d, 0xd6, 0xf0, 0xa5, 0xbd, 0xe4, 0xd0, 0x02, 0x09, 0x8a, 0x9e, 0x9b, 0x73, 0x0f, 0x45,
-	0x51, 0x04, 0xfd, 0x1b, 0x72, 0xab, 0x9b, 0x5e, 0x8a, 0x14, 0x75, 0x0b, 0xbb, 0x05, 0x7a, 0xe9,
-	0xa1, 0x69, 0xd1, 0x73, 0x31, 0xb3, 0xb3, 0x4b, 0xee, 0x7a, 0x29, 0xee, 0x8a, 0xea, 0x4d, 0x3b,
-	0xf3, 0xde, 0xe7, 0xfd, 0x98, 0x37, 0x6f, 0xde, 0x7b, 0x14, 0x28, 0x13, 0x8b, 0x61, 0xd7, 0xd8,
-	0x46, 0xc4, 0xd2, 0x29, 0x36, 0x76, 0x5d, 0xc2, 0x5a, 0x65, 0xc3, 0x68, 0x96, 0x1d, 0xd7, 0x6e,
-	0x12, 0x13, 0xbb, 0xe5, 0xe6, 0x42, 0xf9, 0xe1, 0x2e, 0x76, 0x5b, 0x25, 0xc7, 0xb5, 0x99, 0x0d,
-	0xcf, 0xc4, 0x30, 0x94, 0x0c, 0xa3, 0x59, 0xf2, 0x19, 0x4a, 0xcd, 0x85, 0xc2, 0xa9, 0x9a, 0x6d,
-	0xd7, 0xea, 0xb8, 0x8c, 0x1c, 0x52, 0x46, 0x96, 0x65, 0x33, 0xc4, 0x88, 0x6d, 0x51, 0x0f, 0xa2,
-	0x30, 0x51, 0xb3, 0x6b, 0xb6, 0xf8, 0xb3, 0xcc, 0xff, 0x92, 0xab, 0x45, 0xc9, 0x23, 0xbe, 0xb6,
-	0x76, 0x1f, 0x94, 0x19, 0x69, 0x60, 0xca, 0x50, 0xc3, 0x91, 0x04, 0x8b, 0x49, 0x54, 0x0d, 0xb4,
-	0xf0, 0x78, 0xe6, 0xbb, 0xf1, 0x34, 0x17, 0xca, 0x74, 0x1b, 0xb9, 0xd8, 0xd4, 0x0d, 0xdb, 0xa2,
-	0xbb, 0x8d, 0x80, 0xe3, 0xec, 0x1e, 0x1c, 0x8f, 0x88, 0x8b, 0x25, 0xd9, 0x29, 0x86, 0x2d, 0x13,
-	0xbb, 0x0d, 0x62, 0xb1, 0xb2, 0xe1, 0xb6, 0x1c, 0x66, 0x97, 0x77, 0x70, 0xcb, 0xb7, 0xf0, 0xa4,
-	0x61, 0xd3, 0x86, 0x4d, 0x75, 0xcf, 0x48, 0xef, 0x43, 0x6e, 0xbd, 0xe1, 0x7d, 0x95, 0x29, 0x43,
-	0x3b, 0xc4, 0xaa, 0x95, 0x9b, 0x0b, 0x5b, 0x98, 0xa1, 0x05, 0xff, 0x5b, 0x52, 0xcd, 0x49, 0xaa,
-	0x2d, 0x44, 0xb1, 0xe7, 0xfe, 0x80, 0xd0, 0x41, 0x35, 0x62, 0x09, 0x7f, 0x7a, 0xb4, 0xea, 0x15,
-	0x30, 0xf5, 0x01, 0xa7, 0x58, 0x96, 0x86, 0xac, 0x61, 0x0b, 0x53, 0x42, 0x35, 0xfc, 0x70, 0x17,
-	0x53, 0x06, 0x8b, 0x20, 0xe7, 0x9b, 0xa8, 0x13, 0x33, 0xaf, 0xcc, 0x28, 0xb3, 0xa3, 0x1a, 0xf0,
-	0x97, 0xaa, 0xa6, 0xfa, 0x04, 0x9c, 0x8a, 0xe7, 0xa7, 0x8e, 0x6d, 0x51, 0x0c, 0x3f, 0x06, 0x63,
-	0x35, 0x6f, 0x49, 0xa7, 0x0c, 0x31, 0x2c, 0x20, 0x72, 0x8b, 0xf3, 0xa5, 0x6e, 0x91, 0xd0, 0x5c,
-	0x28, 0x45, 0xb0, 0x36, 0x38, 0x5f, 0x65, 0xe8, 0xcb, 0xe7, 0xc5, 0x01, 0xed, 0x70, 0xad, 0x63,
-	0x4d, 0xfd, 0xa5, 0x02, 0x0a, 0x21, 0xe9, 0xcb, 0x1c, 0x2f, 0x50, 0xfe, 0x06, 0x18, 0x76, 0xb6,
-	0x11, 0xf5, 0x64, 0x8e, 0x2f, 0x2e, 0x96, 0x12, 0x44, 0x5f, 0x20, 0x7c, 0x9d, 0x73, 0x6a, 0x1e,
-	0x00, 0x5c, 0x05, 0xa0, 0xed, 0xb9, 0x7c, 0x46, 0x98, 0xf0, 0x7f, 0x25, 0x79, 0x34, 0xdc, 0xcd,
-	0x25, 0x2f, 0xca, 0xa5, 0x9b, 0x4b, 0xeb, 0xa8, 0x86, 0xa5, 0x16, 0x5a, 0x07, 0xa7, 0xfa, 0x0b,
-	0x25, 0xe2, 0x6e, 0x5f, 0x61, 0xe9, 0xad, 0x0a, 0x18, 0x11, 0xea, 0xd1, 0xbc, 0x32, 0x33, 0x38,
-	0x9b, 0x5b, 0x9c, 0x4b, 0xa6, 0x32, 0xdf, 0xd6, 0x24, 0x27, 0x5c, 0x8b, 0xd1, 0xf5, 0xff, 0x7b,
-	0xea, 0xea, 0x29, 0x10, 0x52, 0xf6, 0x47, 0x23, 0x60, 0x58, 0x40, 0xc3, 0x93, 0x20, 0xeb, 0xa9,
-	0x10, 0x84, 0xc0, 0x21, 0xf1, 0x5d, 0x35, 0xe1, 0x14, 0x18, 0x35, 0xea, 0x04, 0x5b, 0x8c, 0xef,
-	0x65, 0xc4, 0x5e, 0xd6, 0x5b, 0xa8, 0x9a, 0xf0, 0x18, 0x18, 0x66, 0xb6, 0xa3, 0xdf, 0xce, 0x0f,
-	0xce, 0x28, 0xb3, 0x63, 0xda, 0x10, 0xb3, 0x9d, 0xdb, 0x70, 0x0e, 0xc0, 0x06, 0xb1, 0x74, 0xc7,
-	0x7e, 0xc4, 0x63, 0xca, 0xd2, 0x3d, 0x8a, 0xa1, 0x19, 0x65, 0x76, 0x50, 0x1b, 0x6f, 0x10, 0x6b,
-	0x9d, 0x6f, 0x54, 0xad, 0x4d, 0x4e, 0x3b, 0x0f, 0x26, 0x9a, 0xa8, 0x4e, 0x4c, 0xc4, 0x6c, 0x97,
-	0x4a, 0x16, 0x03, 0x39, 0xf9, 0x61, 0x81, 0x07, 0xdb, 0x7b, 0x82, 0x69, 0x19, 0x39, 0x70, 0x0e,
-	0x1c, 0x0d, 0x56, 0x75, 0x8a, 0x99, 0x20, 0x1f, 0x11, 0xe4, 0x47, 0x82, 0x8d, 0x0d, 0xcc, 0x38,
-	0xed, 0x29, 0x30, 0x8a, 0xea, 0x75, 0xfb, 0x51, 0x9d, 0x50, 0x96, 0x3f, 0x34, 0x33, 0x38, 0x3b,
-	0xaa, 0xb5, 0x17, 0x60, 0x01, 0x64, 0x4d, 0x6c, 0xb5, 0xc4, 0x66, 0x56, 0x6c, 0x06, 0xdf, 0x70,
-	0xc2, 0x8f, 0xac, 0x51, 0x61, 0xb1, 0x8c, 0x92, 0x8f, 0x40, 0xb6, 0x81, 0x19, 0x32, 0x11, 0x43,
-	0x79, 0x20, 0xfc, 0xfe, 0x76, 0xaa, 0x90, 0xbb, 0x25, 0x99, 0x65, 0xac, 0x07, 0x60, 0xdc, 0xc9,
-	0xdc, 0x65, 0xfc, 0x96, 0xe3, 0x7c, 0x6e, 0x46, 0x99, 0x1d, 0xd2, 0xb2, 0x0d, 0x62, 0x6d, 0xf0,
-	0x6f, 0x58, 0x02, 0xc7, 0x84, 0xd2, 0x3a, 0xb1, 0x90, 0xc1, 0x48, 0x13, 0xeb, 0x4d, 0x54, 0xa7,
-	0xf9, 0xc3, 0x33, 0xca, 0x6c, 0x56, 0x3b, 0x2a, 0xb6, 0xaa, 0x72, 0xe7, 0x2e, 0xaa, 0xd3, 0xe8,
-	0x95, 0x1e, 0x8b, 0x5e, 0x69, 0xf8, 0x18, 0x9c, 0x0c, 0xbc, 0x80, 0x4d, 0xdd, 0xc5, 0x8f, 0x90,
-	0x6b, 0xea, 0x26, 0xb6, 0xec, 0x06, 0xcd, 0x8f, 0x0b, 0xbb, 0xde, 0x4b, 0x64, 0xd7, 0x52, 0x1b,
-	0x45, 0x13, 0x20, 0xd7, 0x05, 0x86, 0x36, 0x89, 0xe2, 0x37, 0xa0, 0x0a, 0x0e, 0x3b, 0x2e, 0xb1,
-	0x39, 0x98, 0x70, 0xfb, 0x11, 0xe1, 0xf6, 0xd0, 0x1a, 0xb4, 0xc0, 0x71, 0x62, 0x3d, 0x70, 0xb9,
-	0x41, 0xb6, 0xa5, 0x3b, 0xc8, 0x45, 0x0d, 0xcc, 0xb0, 0x4b, 0xf3, 0xaf, 0x09, 0xcd, 0x2e, 0x26,
-	0xd2, 0xac, 0x1a, 0x20, 0xac, 0x07, 0x00, 0xda, 0x04, 0x89, 0x59, 0x55, 0x7f, 0xa2, 0x80, 0xd3,
-	0xe2, 0xca, 0xde, 0xf5, 0xa3, 0xc7, 0x3f, 0xae, 0x25, 0xd3, 0x74, 0xfd, 0x54, 0x73, 0x19, 0xbc,
-	0xe6, 0xe3, 0xeb, 0xc8, 0x34, 0x5d, 0x4c, 0xa9, 0x77, 0x53, 0x2a, 0xf0, 0x9b, 0xe7, 0xc5, 0xf1,
-	0x16, 0x6a, 0xd4, 0x2f, 0xa9, 0x72, 0x43, 0xd5, 0x8e, 0xf8, 0xb4, 0x4b, 0xde, 0x4a, 0xf4, 0x4c,
-	0x32, 0xd1, 0x33, 0xb9, 0x94, 0xfd, 0xe4, 0xf3, 0xe2, 0xc0, 0xdf, 0x3f, 0x2f, 0x0e, 0xa8, 0x77,
-	0x80, 0xba, 0x97, 0x3a, 0x32, 0x91, 0xbc, 0x09, 0x5e, 0x0b, 0x00, 0x43, 0xfa, 0x68, 0x47, 0x8c,
-	0x0e, 0x7a, 0xae, 0xcd, 0xab, 0x06, 0xae, 0x77, 0x68, 0xd7, 0x61, 0x60, 0x3c, 0x60, 0xbc, 0x81,
-	0x11, 0x21, 0x7d, 0x19, 0x18, 0x56, 0xa7, 0x6d, 0x60, 0xbc, 0xc3, 0x5f, 0x71, 0xae, 0x3a, 0x05,
-	0x4e, 0x0a, 0xc0, 0xcd, 0x6d, 0xd7, 0x66, 0xac, 0x8e, 0xc5, 0xdb, 0x21, 0xed, 0x52, 0x7f, 0xe7,
-	0x3f, 0x21, 0x91, 0x5d, 0x29, 0xa6, 0x08, 0x72, 0xb4, 0x8e, 0xe8, 0xb6, 0x2e, 0xa2, 0x41, 0x48,
-	0x18, 0xd4, 0x80, 0x58, 0xba, 0xc5, 0x57, 0xe0, 0x22, 0x38, 0xde, 0x41, 0xa0, 0x8b, 0xc8, 0x46,
-	0x96, 0x81, 0x85, 0x89, 0x83, 0xda, 0xb1, 0x36, 0xe9, 0x92, 0xbf, 0x05, 0xbf, 0x0b, 0xf2, 0x16,
-	0x7e, 0xcc, 0x74, 0x17, 0x3b, 0x75, 0x6c, 0x11, 0xba, 0xad, 0x1b, 0xc8, 0x32, 0xb9, 0xb1, 0x58,
-	0x64, 0xca, 0xdc, 0x62, 0xa1, 0xe4, 0xd5, 0x33, 0x25, 0xbf, 0x9e, 0x29, 0x6d, 0xfa, 0xf5, 0x4c,
-	0x25, 0xcb, 0x93, 0xc3, 0xa7, 0x7f, 0x2e, 0x2a, 0xda, 0x09, 0x8e, 0xa2, 0xf9, 0x20, 0xcb, 0x3e,
-	0x86, 0xfa, 0x16, 0x98, 0x13, 0x26, 0x69, 0xb8, 0xc6, 0xef, 0x98, 0x8b, 0x4d, 0x3f, 0x46, 0x42,
-	0xd7, 0x50, 0x7a, 0x60, 0x05, 0x9c, 0x4b, 0x44, 0x2d, 0x3d, 0x72, 0x02, 0x8c, 0xc8, 0x54, 0xa0,
-	0x88, 0xdb, 0x29, 0xbf, 0xd4, 0xf7, 0xc1, 0x9b, 0x02, 0x66, 0xa9, 0x5e, 0x5f, 0x47, 0xc4, 0xa5,
-	0x77, 0x51, 0x9d, 0xe3, 0xf0, 0x43, 0xa8, 0xb4, 0xda, 0x88, 0x09, 0xcb, 0x8a, 0x9f, 0x2b, 0xd2,
-	0x86, 0x1e, 0x70, 0x52, 0xa9, 0x87, 0xe0, 0xa8, 0x83, 0x88, 0xcb, 0x33, 0x1f, 0x2f, 0xc9, 0x44,
-	0x44, 0xc8, 0x27, 0x74, 0x35, 0x51, 0x42, 0xe0, 0x32, 0x3c, 0x11, 0x5c, 0x42, 0x10, 0x71, 0x56,
-	0xdb, 0x17, 0xe3, 0x4e, 0x88, 0x44, 0xfd, 0xb7, 0x02, 0x4e, 0xf7, 0xe4, 0x82, 0xab, 0x5d, 0xf3,
-	0xc2, 0xd4, 0x37, 0xcf, 0x8b, 0x93, 0xde, 0xb5, 0x89, 0x52, 0xc4, 0x24, 0x88, 0xd5, 0x98, 0xeb,
-	0x97, 0x89, 0xe2, 0x44, 0x29, 0x62, 0xee, 0xe1, 0x55, 0x70, 0x38, 0xa0, 0xda, 0xc1, 0x2d, 0x19,
-	0x6e, 0xa7, 0x4a, 0xed, 0x82, 0xb4, 0xe4, 0x15, 0xa4, 0xa5, 0xf5, 0xdd, 0xad, 0x3a, 0x31, 0x6e,
-	0xe2, 0x96, 0x16, 0x1c, 0xd5, 0x4d, 0xdc, 0x52, 0x27, 0x00, 0x14, 0xe7, 0x22, 0x32, 0x64, 0x10,
-	0x43, 0xdf, 0x03, 0xc7, 0x42, 0xab, 0xf2, 0x58, 0xaa, 0x60, 0x44, 0x24, 0x68, 0x2a, 0xab, 0xbe,
-	0x73, 0x09, 0xcf, 0x82, 0xb3, 0xc8, 0x47, 0x50, 0x02, 0xa8, 0xb7, 0x64, 0x3c, 0x84, 0x0a, 0xa7,
-	0x3b, 0x0e, 0xc3, 0x66, 0xd5, 0x0a, 0x32, 0x45, 0xf2, 0xb2, 0xf5, 0xa1, 0x0c, 0xfa, 0x5e, 0x70,
-	0x41, 0x5d, 0xf6, 0x7a, 0x67, 0x1d, 0x12, 0x39, 0x2f, 0xec, 0xdf, 0x85, 0xa9, 0x8e, 0x82, 0x24,
-	0x7c, 0x80, 0x98, 0xaa, 0x4b, 0x60, 0x3a, 0x24, 0x72, 0x1f, 0x5a, 0x7f, 0x76, 0x08, 0xcc, 0x74,
-	0xc1, 0x08, 0xfe, 0xea, 0xf7, 0x29, 0x8a, 0x46, 0x48, 0x26, 0x65, 0x84, 0xc0, 0x3c, 0x18, 0x16,
-	0x85, 0x9a, 0x88, 0xad, 0xc1, 0x4a, 0x26, 0xaf, 0x68, 0xde, 0x02, 0xbc, 0x08, 0x86, 0x5c, 0x9e,
-	0xe3, 0x86, 0x84, 0x36, 0x67, 0xf9, 0xf9, 0x7e, 0xfd, 0xbc, 0x38, 0xe5, 0x95, 0xa6, 0xd4, 0xdc,
-	0x29, 0x11, 0xbb, 0xdc, 0x40, 0x6c, 0xbb, 0xf4, 0x3e, 0xae, 0x21, 0xa3, 0x75, 0x1d, 0x1b, 0x79,
-	0x45, 0x13, 0x2c, 0xf0, 0x2c, 0x18, 0x0f, 0xb4, 0xf2, 0xd0, 0x87, 0x45, 0x7e, 0x1d, 0xf3, 0x57,
-	0x45, 0x01, 0x08, 0xef, 0x83, 0x7c, 0x40, 0x66, 0xd8, 0x8d, 0x06, 0xa1, 0x94, 0x57, 0x09, 0x42,
-	0xea, 0x88, 0x90, 0x7a, 0x26, 0x81, 0x54, 0xed, 0x84, 0x0f, 0xb2, 0x1c, 0x60, 0x68, 0x5c, 0x8b,
-	0xfb, 0x20, 0x1f, 0xb8, 0x36, 0x0a, 0x7f, 0x28, 0x05, 0xbc, 0x0f, 0x12, 0x81, 0xbf, 0x09, 0x72,
-	0x26, 0xa6, 0x86, 0x4b, 0x1c, 0x51, 0xba, 0x67, 0x85, 0xe7, 0xcf, 0xf8, 0xa5, 0xbb, 0xdf, 0xe3,
-	0xf9, 0x75, 0xfb, 0xf5, 0x36, 0xa9, 0xbc, 0x2b, 0x9d, 0xdc, 0xf0, 0x3e, 0x38, 0x19, 0xe8, 0x6a,
-	0x3b, 0xd8, 0x15, 0x05, 0xb1, 0x1f, 0x0f, 0xa2, 0x6c, 0xad, 0x9c, 0xfe, 0xea, 0x8b, 0xf3, 0xaf,
-	0x4b, 0xf4, 0x20, 0x7e, 0x64, 0x1c, 0x6c, 0x30, 0x97, 0x58, 0x35, 0x6d, 0xd2, 0xc7, 0xb8, 0x23,
-	0x21, 0xfc, 0x30, 0x39, 0x01, 0x46, 0xbe, 0x8f, 0x48, 0x1d, 0x9b, 0xa2, 0xd2, 0xcd, 0x6a, 0xf2,
-	0x0b, 0x5e, 0x02, 0x23, 0xbc, 0xcf, 0xdb, 0xa5, 0xa2, 0x4e, 0x1d, 0x5f, 0x54, 0xbb, 0xa9, 0x5f,
-	0xb1, 0x2d, 0x73, 0x43, 0x50, 0x6a, 0x92, 0x03, 0x6e, 0x82, 0x20, 0x1a, 0x75, 0x66, 0xef, 0x60,
-	0xcb, 0xab, 0x62, 0x47, 0x2b, 0xe7, 0xa4, 0x57, 0x8f, 0xbf, 0xea, 0xd5, 0xaa, 0xc5, 0xbe, 0xfa,
-	0xe2, 0x3c, 0x90, 0x42, 0xaa, 0x16, 0xd3, 0xc6, 0x7d, 0x8c, 0x4d, 0x01, 0xc1, 0x43, 0x27, 0x40,
-	0xf5, 0x42, 0x67, 0xcc, 0x0b, 0x1d, 0x7f, 0xd5, 0x0b, 0x9d, 0x6f, 0x83, 0x49, 0x79, 0x7b, 0x31,
-	0xd5, 0x8d, 0x5d, 0xd7, 0xe5, 0x3d, 0x0d, 0x76, 0x6c, 0x63, 0x5b, 0xd4, 0xbc, 0x59, 0xed, 0x78,
-	0xb0, 0xbd, 0xec, 0xed, 0xae, 0xf0, 0x4d, 0xf5, 0x13, 0x05, 0x14, 0xbb, 0xde, 0x6b, 0x99, 0x3e,
-	0x30, 0x00, 0xed, 0xcc, 0x20, 0xdf, 0xa5, 0x95, 0x44, 0xb9, 0xb0, 0xd7, 0x6d, 0xd7, 0x3a, 0x80,
-	0xd5, 0x87, 0x60, 0x3e, 0xa6, 0xb9, 0x0c, 0x68, 0x6f, 0x20, 0xba, 0x69, 0xcb, 0x2f, 0x7c, 0x30,
-	0x85, 0xab, 0x7a, 0x17, 0x2c, 0xa4, 0x10, 0x29, 0xdd, 0x71, 0xba, 0x23, 0xc5, 0x10, 0xd3, 0x4f,
-	0x9e, 0xb9, 0x76, 0xa2, 0x13, 0x45, 0xe9, 0xb9, 0xf8, 0x32, 0x37, 0x7c, 0x67, 0x92, 0xa6, 0xce,
-	0x58, 0x3b, 0x33, 0xc9, 0xed, 0xac, 0x81, 0xb7, 0x92, 0xa9, 0x23, 0x4d, 0xbc, 0x20, 0x53, 0x9d,
-	0x92, 0x3c, 0x2b, 0x08, 0x06, 0x55, 0x95, 0x19, 0xbe, 0x52, 0xb7, 0x8d, 0x1d, 0xfa, 0xa1, 0xc5,
-	0x48, 0xfd, 0x36, 0x7e, 0xec, 0xc5, 0x9a, 0xff, 0xda, 0xde, 0x93, 0x05, 0x7b, 0x3c, 0x8d, 0xd4,
-	0xe0, 0x6d, 0x30, 0xb9, 0x25, 0xf6, 0xf5, 0x5d, 0x4e, 0xa0, 0x8b, 0x8a, 0xd3, 0x8b, 0x67, 0x45,
-	0x74, 0x90, 0x13, 0x5b, 0x31, 0xec, 0xea, 0x92, 0xac, 0xbe, 0x97, 0x03, 0xd7, 0xad, 0xba, 0x76,
-	0x63, 0x59, 0x76, 0xf4, 0xbe, 0xbb, 0x43, 0x5d, 0xbf, 0x12, 0xee, 0xfa, 0xd5, 0x55, 0x70, 0x66,
-	0x4f, 0x88, 0x76, 0x69, 0xbd, 0xf7, 0x6b, 0xf7, 0x9e, 0xac, 0xdb, 0x43, 0xb1, 0x95, 0xf8, 0xad,
-	0x7c, 0x36, 0x14, 0x37, 0x1b, 0x4a, 0x2c, 0x3d, 0x34, 0xf3, 0xc8, 0x84, 0x67, 0x1e, 0x67, 0xc0,
-	0x98, 0xfd, 0xc8, 0xea, 0x08, 0xa4, 0x41, 0xb1, 0x7f, 0x58, 0x2c, 0xfa, 0x09, 0x32, 0x18, 0x11,
-	0x0c, 0x75, 0x1b, 0x11, 0x0c, 0x1f, 0xe4, 0x88, 0xe0, 0x01, 0xc8, 0x11, 0x8b, 0x30, 0x5d, 0xd6,
-	0x5b, 0x23, 0x02, 0x7b, 0x25, 0x15, 0x76, 0xd5, 0x22, 0x8c, 0xa0, 0x3a, 0xf9, 0x01, 0x8a, 0x34,
-	0xc6, 0x80, 0x23, 0x7b, 0x55, 0x19, 0x6c, 0x80, 0x09, 0x6f, 0x0c, 0x43, 0xb7, 0x91, 0x43, 0xac,
-	0x9a, 0x2f, 0xf0, 0x90, 0x10, 0xf8, 0x6e, 0xb2, 0x02, 0x8f, 0x03, 0x6c, 0x78, 0xfc, 0x1d, 0x62,
-	0xa0, 0x13, 0x5d, 0xa7, 0xdd, 0xbb, 0xfd, 0xec, 0xff, 0xa4, 0xdb, 0x0f, 0x07, 0xf6, 0x68, 0x24,
-	0xb0, 0x2b, 0x91, 0x4c, 0x2f, 0xe7, 0x93, 0xbc, 0x35, 0x4b, 0x1c, 0x96, 0x3b, 0x91, 0x0a, 0x2e,
-	0x84, 0x21, 0x63, 0x73, 0x0d, 0xf8, 0x63, 0x4e, 0x9d, 0x91, 0x86, 0x3f, 0x32, 0x4d, 0xd6, 0x13,
-	0xe6, 0x6a, 0x6d, 0xc0, 0xc5, 0xaf, 0x8b, 0x60, 0x58, 0x48, 0x83, 0x7f, 0x53, 0xc0, 0x44, 0x9c,
-	0x5c, 0x78, 0x2d, 0xfd, 0x33, 0x14, 0x1e, 0x11, 0x17, 0x96, 0xfa, 0x40, 0xf0, 0x0c, 0x56, 0x6f,
-	0xfc, 0xf0, 0xf7, 0x7f, 0xfd, 0x69, 0xa6, 0x02, 0xaf, 0xf5, 0xfe, 0x41, 0x21, 0xf0, 0xae, 0xb4,
-	0xb3, 0xfc, 0xa4, 0xc3, 0xdf, 0x4f, 0xe1, 0x1f, 0x15, 0xd9, 0x89, 0x84, 0x1f, 0x24, 0x78, 0x35,
-	0xbd, 0x92, 0xa1, 0x59, 0x72, 0xe1, 0xda, 0xfe, 0x01, 0xa4, 0x91, 0x4b, 0xc2, 0xc8, 0x77, 0xe1,
-	0xc5, 0x14, 0x46, 0x7a, 0x23, 0xdd, 0xf2, 0x13, 0x91, 0x3c, 0x9e, 0xc2, 0xcf, 0x32, 0x32, 0xa7,
-	0xc5, 0x0e, 0x7f, 0xe0, 0x6a, 0x72, 0x1d, 0xf7, 0x1a, 0x66, 0x15, 0xd6, 0xfa, 0xc6, 0x91, 0x26,
-	0x6f, 0x09, 0x93, 0xbf, 0x03, 0xef, 0x25, 0xf8, 0xa1, 0x28, 0x18, 0xda, 0x86, 0xba, 0xd8, 0xf0,
-	0xf1, 0x96, 0x9f, 0x44, 0xdf, 0xf0, 0x38, 0x9f, 0x74, 0xb6, 0x5e, 0xfb, 0xf2, 0x49, 0xcc, 0xfc,
-	0x6b, 0x5f, 0x3e, 0x89, 0x1b, 0x5c, 0xed, 0xcf, 0x27, 0x21, 0xb3, 0xa3, 0x3e, 0x89, 0xb6, 0xfd,
-	0x4f, 0xe1, 0x6f, 0x15, 0xd9, 0xa5, 0x87, 0x86, 0x5a, 0xf0, 0x4a, 0x72, 0x1b, 0xe2, 0x66, 0x65,
-	0x85, 0xab, 0xfb, 0xe6, 0x97, 0xb6, 0xbf, 0x23, 0x6c, 0x5f, 0x84, 0xf3, 0xbd, 0x6d, 0x67, 0x12,
-	0xc0, 0xfb, 0xd5, 0x08, 0xfe, 0x2c, 0x23, 0x8b, 0x8a, 0xbd, 0xa7, 0x54, 0xf0, 0x4e, 0x72, 0x15,
-	0x13, 0x4d, 0xc7, 0x0a, 0xeb, 0x07, 0x07, 0x28, 0x9d, 0x70, 0x53, 0x38, 0x61, 0x05, 0x2e, 0xf7,
-	0x76, 0x82, 0x1b, 0x20, 0xb6, 0x6f, 0x45, 0x68, 0x1c, 0x0f, 0x7f, 0x9c, 0x91, 0xf5, 0xda, 0x9e,
-	0x73, 0x32, 0x78, 0x3b, 0xb9, 0x15, 0x49, 0xe6, 0x77, 0x85, 0x3b, 0x07, 0x86, 0x27, 0x9d, 0xb2,
-	0x22, 0x9c, 0x72, 0x15, 0x5e, 0xee, 0xed, 0x14, 0x19, 0xe5, 0xba, 0xc3, 0x51, 0x23, 0xe9, 0xff,
-	0xd7, 0x0a, 0xc8, 0x75, 0x0c, 0xa2, 0xe0, 0x85, 0xe4, 0x7a, 0x86, 0x06, 0x5a, 0x85, 0x77, 0xd2,
-	0x33, 0x4a, 0x4b, 0xe6, 0x85, 0x25, 0x73, 0x70, 0xb6, 0xb7, 0x25, 0x5e, 0xe9, 0xd4, 0x8e, 0xed,
-	0xbd, 0x87, 0x51, 0x69, 0x62, 0x3b, 0xd1, 0x94, 0x2c, 0x4d, 0x6c, 0x27, 0x9b, 0x93, 0xa5, 0x89,
-	0x6d, 0x9b, 0x83, 0xe8, 0xc4, 0xd2, 0xdb, 0x0d, 0x6c, 0xe4, 0x30, 0x7f, 0x93, 0x91, 0x23, 0xe5,
-	0x24, 0xcd, 0x25, 0xfc, 0x70, 0xbf, 0x0f, 0xf4, 0x9e, 0xfd, 0x71, 0xe1, 0xee, 0x41, 0xc3, 0x4a,
-	0x4f, 0xdd, 0x13, 0x9e, 0xda, 0x84, 0x5a, 0xea, 0x6a, 0x40, 0x77, 0xb0, 0xdb, 0x76, 0x5a, 0xdc,
-	0x93, 0xf8, 0xab, 0x0c, 0x78, 0x23, 0x49, 0xb7, 0x0a, 0xd7, 0xfb, 0x78, 0xe8, 0x63, 0xfb, 0xf0,
-	0xc2, 0x07, 0x07, 0x88, 0x28, 0x3d, 0x65, 0x08, 0x4f, 0xdd, 0x87, 0x1f, 0xa7, 0xf1, 0x54, 0x78,
-	0x38, 0xd7, 0xbb, 0x8a, 0xf8, 0xa7, 0x02, 0x26, 0xbb, 0xcc, 0x5a, 0xe0, 0x72, 0x3f, 0x93, 0x1a,
-	0xdf, 0x31, 0xd7, 0xfb, 0x03, 0x49, 0x7f, 0xbf, 0x02, 0x8b, 0xbb, 0xde, 0xaf, 0x7f, 0x28, 0xb2,
-	0xc1, 0x8e, 0x9b, 0x23, 0xc0, 0x14, 0xf3, 0xa9, 0x3d, 0x66, 0x15, 0x85, 0xd5, 0x7e, 0x61, 0xd2,
-	0x57, 0xcf, 0x5d, 0xc6, 0x1e, 0xf0, 0x5f, 0xd1, 0x7f, 0xbe, 0x08, 0x0f, 0x26, 0xe0, 0x5a, 0xfa,
-	0x23, 0x8a, 0x9d, 0x8e, 0x14, 0x6e, 0xf4, 0x0f, 0xd4, 0x47, 0xcf, 0x40, 0xcc, 0xf2, 0x93, 0xa0,
-	0x87, 0x7d, 0x0a, 0xff, 0xe4, 0xd7, 0x82, 0xa1, 0xf4, 0x94, 0xa6, 0x16, 0x8c, 0x9b, 0xbf, 0x14,
-	0xae, 0xee, 0x9b, 0x5f, 0x9a, 0xb6, 0x2a, 0x4c, 0xbb, 0x06, 0xaf, 0xa4, 0x4d, 0x80, 0x91, 0x28,
-	0xfe, 0x8f, 0x02, 0xf2, 0xdd, 0x3a, 0x6a, 0x78, 0x7d, 0xdf, 0xbd, 0x69, 0x47, 0x53, 0x5f, 0x58,
-	0xe9, 0x13, 0x45, 0x5a, 0x7c, 0x4b, 0x58, 0xbc, 0x06, 0x57, 0xd2, 0x77, 0xb9, 0x62, 0x0e, 0x10,
-	0x36, 0xbc, 0xf2, 0xd1, 0x97, 0x2f, 0xa6, 0x95, 0x67, 0x2f, 0xa6, 0x95, 0xbf, 0xbc, 0x98, 0x56,
-	0x3e, 0x7d, 0x39, 0x3d, 0xf0, 0xec, 0xe5, 0xf4, 0xc0, 0x1f, 0x5e, 0x4e, 0x0f, 0xdc, 0xbb, 0x5c,
-	0x23, 0x6c, 0x7b, 0x77, 0xab, 0x64, 0xd8, 0x0d, 0xf9, 0xef, 0x63, 0x1d, 0x12, 0xcf, 0x07, 0x12,
-	0x9b, 0x17, 0xca, 0x8f, 0x23, 0x45, 0x77, 0xcb, 0xc1, 0x74, 0x6b, 0x44, 0x0c, 0x18, 0xbe, 0xf5,
-	0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xec, 0x0a, 0xe9, 0x01, 0xde, 0x27, 0x00, 0x00,
+func (m *QueryNearTimeoutPacketsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNearTimeoutPacketsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNearTimeoutPacketsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNearTimeoutPacketsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNearTimeoutPacketsRequest.Merge(m, src)
+}
+func (m *QueryNearTimeoutPacketsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNearTimeoutPacketsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNearTimeoutPacketsRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryNearTimeoutPacketsRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// ConsumerGenesis queries the genesis state needed to start a consumer chain
-	// whose proposal has been accepted
-	QueryConsumerGenesis(ctx context.Context, in *QueryConsumerGenesisRequest, opts ...grpc.CallOption) (*QueryConsumerGenesisResponse, error)
-	// ConsumerChains queries active consumer chains supported by the provider
-	// chain
-	QueryConsumerChains(ctx context.Context, in *QueryConsumerChainsRequest, opts ...grpc.CallOption) (*QueryConsumerChainsResponse, error)
-	// QueryValidatorConsumerAddr queries the address
-	// assigned by a validator for a consumer chain.
-	QueryValidatorConsumerAddr(ctx context.Context, in *QueryValidatorConsumerAddrRequest, opts ...grpc.CallOption) (*QueryValidatorConsumerAddrResponse, error)
-	// QueryProviderAddr returns the provider chain validator
-	// given a consumer chain validator address
-	QueryValidatorProviderAddr(ctx context.Context, in *QueryValidatorProviderAddrRequest, opts ...grpc.CallOption) (*QueryValidatorProviderAddrResponse, error)
-	// QueryThrottleState returns the main on-chain state relevant to currently
-	// throttled slash packets
-	QueryThrottleState(ctx context.Context, in *QueryThrottleStateRequest, opts ...grpc.CallOption) (*QueryThrottleStateResponse, error)
-	// QueryRegisteredConsumerRewardDenoms returns a list of consumer reward
-	// denoms that are registered
-	QueryRegisteredConsumerRewardDenoms(ctx context.Context, in *QueryRegisteredConsumerRewardDenomsRequest, opts ...grpc.CallOption) (*QueryRegisteredConsumerRewardDenomsResponse, error)
-	// QueryAllPairsValConsAddrByConsumer returns a list of pair valconsensus address
-	// between provider and consumer chain
-	QueryAllPairsValConsAddrByConsumer(ctx context.Context, in *QueryAllPairsValConsAddrByConsumerRequest, opts ...grpc.CallOption) (*QueryAllPairsValConsAddrByConsumerResponse, error)
-	// QueryParams returns all current values of provider parameters
-	QueryParams(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// QueryConsumerChainOptedInValidators returns a list of validators consensus addresses
-	// that opted-in to the given consumer chain
-	QueryConsumerChainOptedInValidators(ctx context.Context, in *QueryConsumerChainOptedInValidatorsRequest, opts ...grpc.CallOption) (*QueryConsumerChainOptedInValidatorsResponse, error)
-	// QueryConsumerChainsValidatorHasToValidate returns a list of consumer chains
-	// that a given validator must validate
-	QueryConsumerChainsValidatorHasToValidate(ctx context.Context, in *QueryConsumerChainsValidatorHasToValidateRequest, opts ...grpc.CallOption) (*QueryConsumerChainsValidatorHasToValidateResponse, error)
-	// QueryValidatorConsumerCommissionRate returns the commission rate a given
-	// validator charges on a given consumer chain
-	QueryValidatorConsumerCommissionRate(ctx context.Context, in *QueryValidatorConsumerCommissionRateRequest, opts ...grpc.CallOption) (*QueryValidatorConsumerCommissionRateResponse, error)
-	// QueryConsumerValidators returns the latest set consumer-validator set for a given consumer ID
-	// Note that this does not necessarily mean that the consumer chain is using this validator set at this exact moment
-	// because a VSCPacket could be delayed to be delivered on the consumer chain.
-	QueryConsumerValidators(ctx context.Context, in *QueryConsumerValidatorsRequest, opts ...grpc.CallOption) (*QueryConsumerValidatorsResponse, error)
-	// QueryBlocksUntilNextEpoch returns the number of blocks until the next epoch
-	// starts and validator updates are sent to the consumer chains
-	QueryBlocksUntilNextEpoch(ctx context.Context, in *QueryBlocksUntilNextEpochRequest, opts ...grpc.CallOption) (*QueryBlocksUntilNextEpochResponse, error)
-	// QueryConsumerIdFromClientId returns the consumer id of the chain
-	// associated with the provided client id
-	QueryConsumerIdFromClientId(ctx context.Context, in *QueryConsumerIdFromClientIdRequest, opts ...grpc.CallOption) (*QueryConsumerIdFromClientIdResponse, error)
-	// QueryConsumerChain returns the consumer chain
-	// associated with the provided consumer id
-	QueryConsumerChain(ctx context.Context, in *QueryConsumerChainRequest, opts ...grpc.CallOption) (*QueryConsumerChainResponse, error)
-	// QueryConsumerGenesisTime returns the genesis time
-	// of the consumer chain associated with the provided consumer id
-	QueryConsumerGenesisTime(ctx context.Context, in *QueryConsumerGenesisTimeRequest, opts ...grpc.CallOption) (*QueryConsumerGenesisTimeResponse, error)
+func (m *QueryNearTimeoutPacketsRequest) GetWithin() time.Duration {
+	if m != nil {
+		return m.Within
+	}
+	return 0
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+type QueryNearTimeoutPacketsResponse struct {
+	Packets []PacketTimeout `protobuf:"bytes,1,rep,name=packets,proto3" json:"packets"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryNearTimeoutPacketsResponse) Reset()         { *m = QueryNearTimeoutPacketsResponse{} }
+func (m *QueryNearTimeoutPacketsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNearTimeoutPacketsResponse) ProtoMessage()    {}
+func (*QueryNearTimeoutPacketsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{36}
 }
-
-func (c *queryClient) QueryConsumerGenesis(ctx context.Context, in *QueryConsumerGenesisRequest, opts ...grpc.CallOption) (*QueryConsumerGenesisResponse, error) {
-	out := new(QueryConsumerGenesisResponse)
-	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryConsumerGenesis", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryNearTimeoutPacketsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) QueryConsumerChains(ctx context.Context, in *QueryConsumerChainsRequest, opts ...grpc.CallOption) (*QueryConsumerChainsResponse, error) {
-	out := new(QueryConsumerChainsResponse)
-	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryConsumerChains", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryNearTimeoutPacketsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNearTimeoutPacketsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryNearTimeoutPacketsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNearTimeoutPacketsResponse.Merge(m, src)
+}
+func (m *QueryNearTimeoutPacketsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNearTimeoutPacketsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNearTimeoutPacketsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) QueryValidatorConsumerAddr(ctx context.Context, in *QueryValidatorConsumerAddrRequest, opts ...grpc.CallOption) (*QueryValidatorConsumerAddrResponse, error) {
-	out := new(QueryValidatorConsumerAddrResponse)
-	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryValidatorConsumerAddr", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryNearTimeoutPacketsResponse proto.InternalMessageInfo
+
+func (m *QueryNearTimeoutPacketsResponse) GetPackets() []PacketTimeout {
+	if m != nil {
+		return m.Packets
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) QueryValidatorProviderAddr(ctx context.Context, in *QueryValidatorProviderAddrRequest, opts ...grpc.CallOption) (*QueryValidatorProviderAddrResponse, error) {
-	out := new(QueryValidatorProviderAddrResponse)
-	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryValidatorProviderAddr", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// PacketTimeout describes a VSC packet sent to a consumer chain that is not yet acknowledged
+type PacketTimeout struct {
+	ConsumerId       string    `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+	ChannelId        string    `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Sequence         uint64    `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	TimeoutTimestamp time.Time `protobuf:"bytes,4,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3,stdtime" json:"timeout_timestamp"`
 }
 
-func (c *queryClient) QueryThrottleState(ctx context.Context, in *QueryThrottleStateRequest, opts ...grpc.CallOption) (*QueryThrottleStateResponse, error) {
-	out := new(QueryThrottleStateResponse)
-	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryThrottleState", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *PacketTimeout) Reset()         { *m = PacketTimeout{} }
+func (m *PacketTimeout) String() string { return proto.CompactTextString(m) }
+func (*PacketTimeout) ProtoMessage()    {}
+func (*PacketTimeout) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{37}
+}
+func (m *PacketTimeout) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PacketTimeout) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PacketTimeout.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *PacketTimeout) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PacketTimeout.Merge(m, src)
+}
+func (m *PacketTimeout) XXX_Size() int {
+	return m.Size()
+}
+func (m *PacketTimeout) XXX_DiscardUnknown() {
+	xxx_messageInfo_PacketTimeout.DiscardUnknown(m)
 }
 
-func (c *queryClient) QueryRegisteredConsumerRewardDenoms(ctx context.Context, in *QueryRegisteredConsumerRewardDenomsRequest, opts ...grpc.CallOption) (*QueryRegisteredConsumerRewardDenomsResponse, error) {
-	out := new(QueryRegisteredConsumerRewardDenomsResponse)
-	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryRegisteredConsumerRewardDenoms", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_PacketTimeout proto.InternalMessageInfo
+
+func (m *PacketTimeout) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) QueryAllPairsValConsAddrByConsumer(ctx context.Context, in *QueryAllPairsValConsAddrByConsumerRequest, opts ...grpc.CallOption) (*QueryAllPairsValConsAddrByConsumerResponse, error) {
-	out := new(QueryAllPairsValConsAddrByConsumerResponse)
-	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryAllPairsValConsAddrByConsumer", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *PacketTimeout) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) QueryParams(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *PacketTimeout) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) QueryConsumerChainOptedInValidators(ctx context.Context, in *QueryConsumerChainOptedInValidatorsRequest, opts ...grpc.CallOption) (*QueryConsumerChainOptedInValidatorsResponse, error) {
-	out := new(QueryConsumerChainOptedInValidatorsResponse)
-	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryConsumerChainOptedInValidators", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *PacketTimeout) GetTimeoutTimestamp() time.Time {
+	if m != nil {
+		return m.TimeoutTimestamp
 	}
-	return out, nil
+	return time.Time{}
 }
 
-func (c *queryClient) QueryConsumerChainsValidatorHasToValidate(ctx context.Context, in *QueryConsumerChainsValidatorHasToValidateRequest, opts ...grpc.CallOption) (*QueryConsumerChainsValidatorHasToValidateResponse, error) {
-	out := new(QueryConsumerChainsValidatorHasToValidateResponse)
-	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryConsumerChainsValidatorHasToValidate", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryLaunchCapacityRequest struct {
 }
 
-func (c *queryClient) QueryValidatorConsumerCommissionRate(ctx context.Context, in *QueryValidatorConsumerCommissionRateRequest, opts ...grpc.CallOption) (*QueryValidatorConsumerCommissionRateResponse, error) {
-	out := new(QueryValidatorConsumerCommissionRateResponse)
-	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryValidatorConsumerCommissionRate", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryLaunchCapacityRequest) Reset()         { *m = QueryLaunchCapacityRequest{} }
+func (m *QueryLaunchCapacityRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLaunchCapacityRequest) ProtoMessage()    {}
+func (*QueryLaunchCapacityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{38}
+}
+func (m *QueryLaunchCapacityRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLaunchCapacityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLaunchCapacityRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryLaunchCapacityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLaunchCapacityRequest.Merge(m, src)
+}
+func (m *QueryLaunchCapacityRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLaunchCapacityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLaunchCapacityRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) QueryConsumerValidators(ctx context.Context, in *QueryConsumerValidatorsRequest, opts ...grpc.CallOption) (*QueryConsumerValidatorsResponse, error) {
-	out := new(QueryConsumerValidatorsResponse)
-	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryConsumerValidators", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryLaunchCapacityRequest proto.InternalMessageInfo
+
+type QueryLaunchCapacityResponse struct {
+	// the maximal number of launched consumer chains (zero means no cap)
+	MaxLaunchedConsumers uint64 `protobuf:"varint,1,opt,name=max_launched_consumers,json=maxLaunchedConsumers,proto3" json:"max_launched_consumers,omitempty"`
+	// the number of launched consumer chains
+	LaunchedConsumers uint64 `protobuf:"varint,2,opt,name=launched_consumers,json=launchedConsumers,proto3" json:"launched_consumers,omitempty"`
+	// the number of consumer chains that can still be launched,
+	// only set if the number of launched consumer chains is capped
+	RemainingCapacity uint64 `protobuf:"varint,3,opt,name=remaining_capacity,json=remainingCapacity,proto3" json:"remaining_capacity,omitempty"`
+}
+
+func (m *QueryLaunchCapacityResponse) Reset()         { *m = QueryLaunchCapacityResponse{} }
+func (m *QueryLaunchCapacityResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLaunchCapacityResponse) ProtoMessage()    {}
+func (*QueryLaunchCapacityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{39}
+}
+func (m *QueryLaunchCapacityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLaunchCapacityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLaunchCapacityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryLaunchCapacityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLaunchCapacityResponse.Merge(m, src)
+}
+func (m *QueryLaunchCapacityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLaunchCapacityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLaunchCapacityResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) QueryBlocksUntilNextEpoch(ctx context.Context, in *QueryBlocksUntilNextEpochRequest, opts ...grpc.CallOption) (*QueryBlocksUntilNextEpochResponse, error) {
-	out := new(QueryBlocksUntilNextEpochResponse)
-	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryBlocksUntilNextEpoch", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryLaunchCapacityResponse proto.InternalMessageInfo
+
+func (m *QueryLaunchCapacityResponse) GetMaxLaunchedConsumers() uint64 {
+	if m != nil {
+		return m.MaxLaunchedConsumers
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) QueryConsumerIdFromClientId(ctx context.Context, in *QueryConsumerIdFromClientIdRequest, opts ...grpc.CallOption) (*QueryConsumerIdFromClientIdResponse, error) {
-	out := new(QueryConsumerIdFromClientIdResponse)
-	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryConsumerIdFromClientId", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryLaunchCapacityResponse) GetLaunchedConsumers() uint64 {
+	if m != nil {
+		return m.LaunchedConsumers
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) QueryConsumerChain(ctx context.Context, in *QueryConsumerChainRequest, opts ...grpc.CallOption) (*QueryConsumerChainResponse, error) {
-	out := new(QueryConsumerChainResponse)
-	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryConsumerChain", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryLaunchCapacityResponse) GetRemainingCapacity() uint64 {
+	if m != nil {
+		return m.RemainingCapacity
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) QueryConsumerGenesisTime(ctx context.Context, in *QueryConsumerGenesisTimeRequest, opts ...grpc.CallOption) (*QueryConsumerGenesisTimeResponse, error) {
-	out := new(QueryConsumerGenesisTimeResponse)
-	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryConsumerGenesisTime", in, out, opts...)
-	if err != nil {
-		return nil, err
+type QueryPowerShapingPipelineRequest struct {
+	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+}
+
+func (m *QueryPowerShapingPipelineRequest) Reset()         { *m = QueryPowerShapingPipelineRequest{} }
+func (m *QueryPowerShapingPipelineRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPowerShapingPipelineRequest) ProtoMessage()    {}
+func (*QueryPowerShapingPipelineRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{40}
+}
+func (m *QueryPowerShapingPipelineRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPowerShapingPipelineRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPowerShapingPipelineRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryPowerShapingPipelineRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPowerShapingPipelineRequest.Merge(m, src)
+}
+func (m *QueryPowerShapingPipelineRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPowerShapingPipelineRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPowerShapingPipelineRequest.DiscardUnknown(m)
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// ConsumerGenesis queries the genesis state needed to start a consumer chain
-	// whose proposal has been accepted
-	QueryConsumerGenesis(context.Context, *QueryConsumerGenesisRequest) (*QueryConsumerGenesisResponse, error)
-	// ConsumerChains queries active consumer chains supported by the provider
-	// chain
-	QueryConsumerChains(context.Context, *QueryConsumerChainsRequest) (*QueryConsumerChainsResponse, error)
-	// QueryValidatorConsumerAddr queries the address
-	// assigned by a validator for a consumer chain.
-	QueryValidatorConsumerAddr(context.Context, *QueryValidatorConsumerAddrRequest) (*QueryValidatorConsumerAddrResponse, error)
-	// QueryProviderAddr returns the provider chain validator
-	// given a consumer chain validator address
-	QueryValidatorProviderAddr(context.Context, *QueryValidatorProviderAddrRequest) (*QueryValidatorProviderAddrResponse, error)
-	// QueryThrottleState returns the main on-chain state relevant to currently
-	// throttled slash packets
-	QueryThrottleState(context.Context, *QueryThrottleStateRequest) (*QueryThrottleStateResponse, error)
-	// QueryRegisteredConsumerRewardDenoms returns a list of consumer reward
-	// denoms that are registered
-	QueryRegisteredConsumerRewardDenoms(context.Context, *QueryRegisteredConsumerRewardDenomsRequest) (*QueryRegisteredConsumerRewardDenomsResponse, error)
-	// QueryAllPairsValConsAddrByConsumer returns a list of pair valconsensus address
-	// between provider and consumer chain
-	QueryAllPairsValConsAddrByConsumer(context.Context, *QueryAllPairsValConsAddrByConsumerRequest) (*QueryAllPairsValConsAddrByConsumerResponse, error)
-	// QueryParams returns all current values of provider parameters
-	QueryParams(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// QueryConsumerChainOptedInValidators returns a list of validators consensus addresses
-	// that opted-in to the given consumer chain
-	QueryConsumerChainOptedInValidators(context.Context, *QueryConsumerChainOptedInValidatorsRequest) (*QueryConsumerChainOptedInValidatorsResponse, error)
-	// QueryConsumerChainsValidatorHasToValidate returns a list of consumer chains
-	// that a given validator must validate
-	QueryConsumerChainsValidatorHasToValidate(context.Context, *QueryConsumerChainsValidatorHasToValidateRequest) (*QueryConsumerChainsValidatorHasToValidateResponse, error)
-	// QueryValidatorConsumerCommissionRate returns the commission rate a given
-	// validator charges on a given consumer chain
-	QueryValidatorConsumerCommissionRate(context.Context, *QueryValidatorConsumerCommissionRateRequest) (*QueryValidatorConsumerCommissionRateResponse, error)
-	// QueryConsumerValidators returns the latest set consumer-validator set for a given consumer ID
-	// Note that this does not necessarily mean that the consumer chain is using this validator set at this exact moment
-	// because a VSCPacket could be delayed to be delivered on the consumer chain.
-	QueryConsumerValidators(context.Context, *QueryConsumerValidatorsRequest) (*QueryConsumerValidatorsResponse, error)
-	// QueryBlocksUntilNextEpoch returns the number of blocks until the next epoch
-	// starts and validator updates are sent to the consumer chains
-	QueryBlocksUntilNextEpoch(context.Context, *QueryBlocksUntilNextEpochRequest) (*QueryBlocksUntilNextEpochResponse, error)
-	// QueryConsumerIdFromClientId returns the consumer id of the chain
-	// associated with the provided client id
-	QueryConsumerIdFromClientId(context.Context, *QueryConsumerIdFromClientIdRequest) (*QueryConsumerIdFromClientIdResponse, error)
-	// QueryConsumerChain returns the consumer chain
-	// associated with the provided consumer id
-	QueryConsumerChain(context.Context, *QueryConsumerChainRequest) (*QueryConsumerChainResponse, error)
-	// QueryConsumerGenesisTime returns the genesis time
-	// of the consumer chain associated with the provided consumer id
-	QueryConsumerGenesisTime(context.Context, *QueryConsumerGenesisTimeRequest) (*QueryConsumerGenesisTimeResponse, error)
+var xxx_messageInfo_QueryPowerShapingPipelineRequest proto.InternalMessageInfo
+
+func (m *QueryPowerShapingPipelineRequest) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
+	}
+	return ""
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+type QueryPowerShapingPipelineResponse struct {
+	Pipeline PowerShapingPipeline `protobuf:"bytes,1,opt,name=pipeline,proto3" json:"pipeline"`
 }
 
-func (*UnimplementedQueryServer) QueryConsumerGenesis(ctx context.Context, req *QueryConsumerGenesisRequest) (*QueryConsumerGenesisResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryConsumerGenesis not implemented")
+func (m *QueryPowerShapingPipelineResponse) Reset()         { *m = QueryPowerShapingPipelineResponse{} }
+func (m *QueryPowerShapingPipelineResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPowerShapingPipelineResponse) ProtoMessage()    {}
+func (*QueryPowerShapingPipelineResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{41}
 }
-func (*UnimplementedQueryServer) QueryConsumerChains(ctx context.Context, req *QueryConsumerChainsRequest) (*QueryConsumerChainsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryConsumerChains not implemented")
+func (m *QueryPowerShapingPipelineResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) QueryValidatorConsumerAddr(ctx context.Context, req *QueryValidatorConsumerAddrRequest) (*QueryValidatorConsumerAddrResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryValidatorConsumerAddr not implemented")
+func (m *QueryPowerShapingPipelineResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPowerShapingPipelineResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) QueryValidatorProviderAddr(ctx context.Context, req *QueryValidatorProviderAddrRequest) (*QueryValidatorProviderAddrResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryValidatorProviderAddr not implemented")
+func (m *QueryPowerShapingPipelineResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPowerShapingPipelineResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) QueryThrottleState(ctx context.Context, req *QueryThrottleStateRequest) (*QueryThrottleStateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryThrottleState not implemented")
+func (m *QueryPowerShapingPipelineResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) QueryRegisteredConsumerRewardDenoms(ctx context.Context, req *QueryRegisteredConsumerRewardDenomsRequest) (*QueryRegisteredConsumerRewardDenomsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryRegisteredConsumerRewardDenoms not implemented")
+func (m *QueryPowerShapingPipelineResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPowerShapingPipelineResponse.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) QueryAllPairsValConsAddrByConsumer(ctx context.Context, req *QueryAllPairsValConsAddrByConsumerRequest) (*QueryAllPairsValConsAddrByConsumerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryAllPairsValConsAddrByConsumer not implemented")
+
+var xxx_messageInfo_QueryPowerShapingPipelineResponse proto.InternalMessageInfo
+
+func (m *QueryPowerShapingPipelineResponse) GetPipeline() PowerShapingPipeline {
+	if m != nil {
+		return m.Pipeline
+	}
+	return PowerShapingPipeline{}
 }
-func (*UnimplementedQueryServer) QueryParams(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryParams not implemented")
+
+type QueryConsumerValidatorPowerProjectionRequest struct {
+	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
 }
-func (*UnimplementedQueryServer) QueryConsumerChainOptedInValidators(ctx context.Context, req *QueryConsumerChainOptedInValidatorsRequest) (*QueryConsumerChainOptedInValidatorsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryConsumerChainOptedInValidators not implemented")
+
+func (m *QueryConsumerValidatorPowerProjectionRequest) Reset() {
+	*m = QueryConsumerValidatorPowerProjectionRequest{}
 }
-func (*UnimplementedQueryServer) QueryConsumerChainsValidatorHasToValidate(ctx context.Context, req *QueryConsumerChainsValidatorHasToValidateRequest) (*QueryConsumerChainsValidatorHasToValidateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryConsumerChainsValidatorHasToValidate not implemented")
+func (m *QueryConsumerValidatorPowerProjectionRequest) String() string {
+	return proto.CompactTextString(m)
 }
-func (*UnimplementedQueryServer) QueryValidatorConsumerCommissionRate(ctx context.Context, req *QueryValidatorConsumerCommissionRateRequest) (*QueryValidatorConsumerCommissionRateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryValidatorConsumerCommissionRate not implemented")
+func (*QueryConsumerValidatorPowerProjectionRequest) ProtoMessage() {}
+func (*QueryConsumerValidatorPowerProjectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{42}
 }
-func (*UnimplementedQueryServer) QueryConsumerValidators(ctx context.Context, req *QueryConsumerValidatorsRequest) (*QueryConsumerValidatorsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryConsumerValidators not implemented")
+func (m *QueryConsumerValidatorPowerProjectionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) QueryBlocksUntilNextEpoch(ctx context.Context, req *QueryBlocksUntilNextEpochRequest) (*QueryBlocksUntilNextEpochResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryBlocksUntilNextEpoch not implemented")
+func (m *QueryConsumerValidatorPowerProjectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerValidatorPowerProjectionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) QueryConsumerIdFromClientId(ctx context.Context, req *QueryConsumerIdFromClientIdRequest) (*QueryConsumerIdFromClientIdResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryConsumerIdFromClientId not implemented")
+func (m *QueryConsumerValidatorPowerProjectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerValidatorPowerProjectionRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) QueryConsumerChain(ctx context.Context, req *QueryConsumerChainRequest) (*QueryConsumerChainResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryConsumerChain not implemented")
+func (m *QueryConsumerValidatorPowerProjectionRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) QueryConsumerGenesisTime(ctx context.Context, req *QueryConsumerGenesisTimeRequest) (*QueryConsumerGenesisTimeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryConsumerGenesisTime not implemented")
+func (m *QueryConsumerValidatorPowerProjectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerValidatorPowerProjectionRequest.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_QueryConsumerValidatorPowerProjectionRequest proto.InternalMessageInfo
 
-func _Query_QueryConsumerGenesis_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryConsumerGenesisRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryConsumerGenesis(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryConsumerGenesis",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryConsumerGenesis(ctx, req.(*QueryConsumerGenesisRequest))
+func (m *QueryConsumerValidatorPowerProjectionRequest) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_QueryConsumerChains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryConsumerChainsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryConsumerChains(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryConsumerChains",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryConsumerChains(ctx, req.(*QueryConsumerChainsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryConsumerValidatorPowerProjectionResponse struct {
+	// the projected validator set, sorted by the consensus address of the validators on the provider chain
+	Validators []ProjectedConsumerValidator `protobuf:"bytes,1,rep,name=validators,proto3" json:"validators"`
+	// the power shaping steps applied to compute the projected validator set, in evaluation order
+	Pipeline PowerShapingPipeline `protobuf:"bytes,2,opt,name=pipeline,proto3" json:"pipeline"`
+	// the consensus addresses on the provider chain of the validators in the current
+	// validator set of the consumer chain that are not in the projected validator set
+	RemovedProviderAddresses []string `protobuf:"bytes,3,rep,name=removed_provider_addresses,json=removedProviderAddresses,proto3" json:"removed_provider_addresses,omitempty"`
 }
 
-func _Query_QueryValidatorConsumerAddr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryValidatorConsumerAddrRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryValidatorConsumerAddr(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryValidatorConsumerAddr",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryValidatorConsumerAddr(ctx, req.(*QueryValidatorConsumerAddrRequest))
+func (m *QueryConsumerValidatorPowerProjectionResponse) Reset() {
+	*m = QueryConsumerValidatorPowerProjectionResponse{}
+}
+func (m *QueryConsumerValidatorPowerProjectionResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryConsumerValidatorPowerProjectionResponse) ProtoMessage() {}
+func (*QueryConsumerValidatorPowerProjectionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{43}
+}
+func (m *QueryConsumerValidatorPowerProjectionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumerValidatorPowerProjectionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerValidatorPowerProjectionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryConsumerValidatorPowerProjectionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerValidatorPowerProjectionResponse.Merge(m, src)
+}
+func (m *QueryConsumerValidatorPowerProjectionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumerValidatorPowerProjectionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerValidatorPowerProjectionResponse.DiscardUnknown(m)
 }
 
-func _Query_QueryValidatorProviderAddr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryValidatorProviderAddrRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryValidatorProviderAddr(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryValidatorProviderAddr",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryValidatorProviderAddr(ctx, req.(*QueryValidatorProviderAddrRequest))
+var xxx_messageInfo_QueryConsumerValidatorPowerProjectionResponse proto.InternalMessageInfo
+
+func (m *QueryConsumerValidatorPowerProjectionResponse) GetValidators() []ProjectedConsumerValidator {
+	if m != nil {
+		return m.Validators
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_QueryThrottleState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryThrottleStateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryThrottleState(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryThrottleState",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryThrottleState(ctx, req.(*QueryThrottleStateRequest))
+func (m *QueryConsumerValidatorPowerProjectionResponse) GetPipeline() PowerShapingPipeline {
+	if m != nil {
+		return m.Pipeline
 	}
-	return interceptor(ctx, in, info, handler)
+	return PowerShapingPipeline{}
 }
 
-func _Query_QueryRegisteredConsumerRewardDenoms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryRegisteredConsumerRewardDenomsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryRegisteredConsumerRewardDenoms(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryRegisteredConsumerRewardDenoms",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryRegisteredConsumerRewardDenoms(ctx, req.(*QueryRegisteredConsumerRewardDenomsRequest))
+func (m *QueryConsumerValidatorPowerProjectionResponse) GetRemovedProviderAddresses() []string {
+	if m != nil {
+		return m.RemovedProviderAddresses
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_QueryAllPairsValConsAddrByConsumer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllPairsValConsAddrByConsumerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryAllPairsValConsAddrByConsumer(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryAllPairsValConsAddrByConsumer",
+// ProjectedConsumerValidator describes a validator of the projected validator set of a consumer chain
+type ProjectedConsumerValidator struct {
+	// The consensus address of the validator on the provider chain
+	ProviderAddress string `protobuf:"bytes,1,opt,name=provider_address,json=providerAddress,proto3" json:"provider_address,omitempty" yaml:"address"`
+	// The consumer public key of the validator used on the consumer chain
+	ConsumerKey *crypto.PublicKey `protobuf:"bytes,2,opt,name=consumer_key,json=consumerKey,proto3" json:"consumer_key,omitempty"`
+	// The projected power of the validator on the consumer chain
+	Power int64 `protobuf:"varint,3,opt,name=power,proto3" json:"power,omitempty"`
+	// The power of the validator in the current validator set of the consumer chain;
+	// zero if the validator is not in the current validator set
+	CurrentPower int64 `protobuf:"varint,4,opt,name=current_power,json=currentPower,proto3" json:"current_power,omitempty"`
+}
+
+func (m *ProjectedConsumerValidator) Reset()         { *m = ProjectedConsumerValidator{} }
+func (m *ProjectedConsumerValidator) String() string { return proto.CompactTextString(m) }
+func (*ProjectedConsumerValidator) ProtoMessage()    {}
+func (*ProjectedConsumerValidator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{44}
+}
+func (m *ProjectedConsumerValidator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectedConsumerValidator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProjectedConsumerValidator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryAllPairsValConsAddrByConsumer(ctx, req.(*QueryAllPairsValConsAddrByConsumerRequest))
+}
+func (m *ProjectedConsumerValidator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectedConsumerValidator.Merge(m, src)
+}
+func (m *ProjectedConsumerValidator) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectedConsumerValidator) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectedConsumerValidator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProjectedConsumerValidator proto.InternalMessageInfo
+
+func (m *ProjectedConsumerValidator) GetProviderAddress() string {
+	if m != nil {
+		return m.ProviderAddress
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_QueryParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *ProjectedConsumerValidator) GetConsumerKey() *crypto.PublicKey {
+	if m != nil {
+		return m.ConsumerKey
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryParams(ctx, in)
+	return nil
+}
+
+func (m *ProjectedConsumerValidator) GetPower() int64 {
+	if m != nil {
+		return m.Power
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryParams",
+	return 0
+}
+
+func (m *ProjectedConsumerValidator) GetCurrentPower() int64 {
+	if m != nil {
+		return m.CurrentPower
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryParams(ctx, req.(*QueryParamsRequest))
+	return 0
+}
+
+type QueryModuleAccountsSummaryRequest struct {
+	// the maximal number of fund flow records to return, starting from the most recent one;
+	// zero or values above the number of retained records return all the retained records
+	Limit uint32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (m *QueryModuleAccountsSummaryRequest) Reset()         { *m = QueryModuleAccountsSummaryRequest{} }
+func (m *QueryModuleAccountsSummaryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryModuleAccountsSummaryRequest) ProtoMessage()    {}
+func (*QueryModuleAccountsSummaryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{45}
+}
+func (m *QueryModuleAccountsSummaryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryModuleAccountsSummaryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryModuleAccountsSummaryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryModuleAccountsSummaryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryModuleAccountsSummaryRequest.Merge(m, src)
+}
+func (m *QueryModuleAccountsSummaryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryModuleAccountsSummaryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryModuleAccountsSummaryRequest.DiscardUnknown(m)
 }
 
-func _Query_QueryConsumerChainOptedInValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryConsumerChainOptedInValidatorsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_QueryModuleAccountsSummaryRequest proto.InternalMessageInfo
+
+func (m *QueryModuleAccountsSummaryRequest) GetLimit() uint32 {
+	if m != nil {
+		return m.Limit
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryConsumerChainOptedInValidators(ctx, in)
+	return 0
+}
+
+// ModuleAccountBalance is the balance of a CCV module account
+type ModuleAccountBalance struct {
+	Name    string                                   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Address string                                   `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Balance github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=balance,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"balance"`
+}
+
+func (m *ModuleAccountBalance) Reset()         { *m = ModuleAccountBalance{} }
+func (m *ModuleAccountBalance) String() string { return proto.CompactTextString(m) }
+func (*ModuleAccountBalance) ProtoMessage()    {}
+func (*ModuleAccountBalance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{46}
+}
+func (m *ModuleAccountBalance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ModuleAccountBalance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ModuleAccountBalance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryConsumerChainOptedInValidators",
+}
+func (m *ModuleAccountBalance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModuleAccountBalance.Merge(m, src)
+}
+func (m *ModuleAccountBalance) XXX_Size() int {
+	return m.Size()
+}
+func (m *ModuleAccountBalance) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModuleAccountBalance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModuleAccountBalance proto.InternalMessageInfo
+
+func (m *ModuleAccountBalance) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryConsumerChainOptedInValidators(ctx, req.(*QueryConsumerChainOptedInValidatorsRequest))
+	return ""
+}
+
+func (m *ModuleAccountBalance) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_QueryConsumerChainsValidatorHasToValidate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryConsumerChainsValidatorHasToValidateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *ModuleAccountBalance) GetBalance() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Balance
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryConsumerChainsValidatorHasToValidate(ctx, in)
+	return nil
+}
+
+type QueryModuleAccountsSummaryResponse struct {
+	// the balances of the CCV module accounts
+	Accounts []ModuleAccountBalance `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts"`
+	// the most recent fund flow records, most recent first
+	Flows []FundFlowRecord `protobuf:"bytes,2,rep,name=flows,proto3" json:"flows"`
+	// the sum of the inflows of the returned records
+	TotalInflow github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=total_inflow,json=totalInflow,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_inflow"`
+	// the sum of the outflows of the returned records
+	TotalOutflow github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=total_outflow,json=totalOutflow,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_outflow"`
+}
+
+func (m *QueryModuleAccountsSummaryResponse) Reset()         { *m = QueryModuleAccountsSummaryResponse{} }
+func (m *QueryModuleAccountsSummaryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryModuleAccountsSummaryResponse) ProtoMessage()    {}
+func (*QueryModuleAccountsSummaryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{47}
+}
+func (m *QueryModuleAccountsSummaryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryModuleAccountsSummaryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryModuleAccountsSummaryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryConsumerChainsValidatorHasToValidate",
+}
+func (m *QueryModuleAccountsSummaryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryModuleAccountsSummaryResponse.Merge(m, src)
+}
+func (m *QueryModuleAccountsSummaryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryModuleAccountsSummaryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryModuleAccountsSummaryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryModuleAccountsSummaryResponse proto.InternalMessageInfo
+
+func (m *QueryModuleAccountsSummaryResponse) GetAccounts() []ModuleAccountBalance {
+	if m != nil {
+		return m.Accounts
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryConsumerChainsValidatorHasToValidate(ctx, req.(*QueryConsumerChainsValidatorHasToValidateRequest))
+	return nil
+}
+
+func (m *QueryModuleAccountsSummaryResponse) GetFlows() []FundFlowRecord {
+	if m != nil {
+		return m.Flows
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_QueryValidatorConsumerCommissionRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryValidatorConsumerCommissionRateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *QueryModuleAccountsSummaryResponse) GetTotalInflow() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalInflow
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryValidatorConsumerCommissionRate(ctx, in)
+	return nil
+}
+
+func (m *QueryModuleAccountsSummaryResponse) GetTotalOutflow() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalOutflow
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryValidatorConsumerCommissionRate",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryValidatorConsumerCommissionRate(ctx, req.(*QueryValidatorConsumerCommissionRateRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_QueryConsumerValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryConsumerValidatorsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryConsumerValidators(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryConsumerValidators",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryConsumerValidators(ctx, req.(*QueryConsumerValidatorsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryConsumerLaunchFailureRequest struct {
+	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
 }
 
-func _Query_QueryBlocksUntilNextEpoch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBlocksUntilNextEpochRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryBlocksUntilNextEpoch(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryBlocksUntilNextEpoch",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryBlocksUntilNextEpoch(ctx, req.(*QueryBlocksUntilNextEpochRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryConsumerLaunchFailureRequest) Reset()         { *m = QueryConsumerLaunchFailureRequest{} }
+func (m *QueryConsumerLaunchFailureRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumerLaunchFailureRequest) ProtoMessage()    {}
+func (*QueryConsumerLaunchFailureRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{48}
 }
-
-func _Query_QueryConsumerIdFromClientId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryConsumerIdFromClientIdRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryConsumerIdFromClientId(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryConsumerIdFromClientId",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryConsumerIdFromClientId(ctx, req.(*QueryConsumerIdFromClientIdRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryConsumerLaunchFailureRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func _Query_QueryConsumerChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryConsumerChainRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryConsumerChain(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryConsumerChain",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryConsumerChain(ctx, req.(*QueryConsumerChainRequest))
+func (m *QueryConsumerLaunchFailureRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerLaunchFailureRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-func _Query_QueryConsumerGenesisTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryConsumerGenesisTimeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryConsumerGenesisTime(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryConsumerGenesisTime",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryConsumerGenesisTime(ctx, req.(*QueryConsumerGenesisTimeRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryConsumerLaunchFailureRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerLaunchFailureRequest.Merge(m, src)
 }
-
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "interchain_security.ccv.provider.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "QueryConsumerGenesis",
-			Handler:    _Query_QueryConsumerGenesis_Handler,
-		},
-		{
-			MethodName: "QueryConsumerChains",
-			Handler:    _Query_QueryConsumerChains_Handler,
-		},
-		{
-			MethodName: "QueryValidatorConsumerAddr",
-			Handler:    _Query_QueryValidatorConsumerAddr_Handler,
-		},
-		{
-			MethodName: "QueryValidatorProviderAddr",
-			Handler:    _Query_QueryValidatorProviderAddr_Handler,
-		},
-		{
-			MethodName: "QueryThrottleState",
-			Handler:    _Query_QueryThrottleState_Handler,
-		},
-		{
-			MethodName: "QueryRegisteredConsumerRewardDenoms",
-			Handler:    _Query_QueryRegisteredConsumerRewardDenoms_Handler,
-		},
-		{
-			MethodName: "QueryAllPairsValConsAddrByConsumer",
-			Handler:    _Query_QueryAllPairsValConsAddrByConsumer_Handler,
-		},
-		{
-			MethodName: "QueryParams",
-			Handler:    _Query_QueryParams_Handler,
-		},
-		{
-			MethodName: "QueryConsumerChainOptedInValidators",
-			Handler:    _Query_QueryConsumerChainOptedInValidators_Handler,
-		},
-		{
-			MethodName: "QueryConsumerChainsValidatorHasToValidate",
-			Handler:    _Query_QueryConsumerChainsValidatorHasToValidate_Handler,
-		},
-		{
-			MethodName: "QueryValidatorConsumerCommissionRate",
-			Handler:    _Query_QueryValidatorConsumerCommissionRate_Handler,
-		},
-		{
-			MethodName: "QueryConsumerValidators",
-			Handler:    _Query_QueryConsumerValidators_Handler,
-		},
-		{
-			MethodName: "QueryBlocksUntilNextEpoch",
-			Handler:    _Query_QueryBlocksUntilNextEpoch_Handler,
-		},
-		{
-			MethodName: "QueryConsumerIdFromClientId",
-			Handler:    _Query_QueryConsumerIdFromClientId_Handler,
-		},
-		{
-			MethodName: "QueryConsumerChain",
-			Handler:    _Query_QueryConsumerChain_Handler,
-		},
-		{
-			MethodName: "QueryConsumerGenesisTime",
-			Handler:    _Query_QueryConsumerGenesisTime_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "interchain_security/ccv/provider/v1/query.proto",
+func (m *QueryConsumerLaunchFailureRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryConsumerGenesisRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryConsumerLaunchFailureRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerLaunchFailureRequest.DiscardUnknown(m)
 }
 
-func (m *QueryConsumerGenesisRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+var xxx_messageInfo_QueryConsumerLaunchFailureRequest proto.InternalMessageInfo
 
-func (m *QueryConsumerGenesisRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ConsumerId) > 0 {
-		i -= len(m.ConsumerId)
-		copy(dAtA[i:], m.ConsumerId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryConsumerLaunchFailureRequest) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryConsumerGenesisResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type QueryConsumerLaunchFailureResponse struct {
+	Failure ConsumerLaunchFailure `protobuf:"bytes,1,opt,name=failure,proto3" json:"failure"`
 }
 
-func (m *QueryConsumerGenesisResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryConsumerLaunchFailureResponse) Reset()         { *m = QueryConsumerLaunchFailureResponse{} }
+func (m *QueryConsumerLaunchFailureResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumerLaunchFailureResponse) ProtoMessage()    {}
+func (*QueryConsumerLaunchFailureResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{49}
 }
-
-func (m *QueryConsumerGenesisResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.GenesisState.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryConsumerLaunchFailureResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumerLaunchFailureResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerLaunchFailureResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryConsumerLaunchFailureResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerLaunchFailureResponse.Merge(m, src)
+}
+func (m *QueryConsumerLaunchFailureResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumerLaunchFailureResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerLaunchFailureResponse.DiscardUnknown(m)
 }
 
-func (m *QueryConsumerChainsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryConsumerLaunchFailureResponse proto.InternalMessageInfo
+
+func (m *QueryConsumerLaunchFailureResponse) GetFailure() ConsumerLaunchFailure {
+	if m != nil {
+		return m.Failure
 	}
-	return dAtA[:n], nil
+	return ConsumerLaunchFailure{}
 }
 
-func (m *QueryConsumerChainsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryConsumerHashCommitmentRequest struct {
+	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
 }
 
-func (m *QueryConsumerChainsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryConsumerHashCommitmentRequest) Reset()         { *m = QueryConsumerHashCommitmentRequest{} }
+func (m *QueryConsumerHashCommitmentRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumerHashCommitmentRequest) ProtoMessage()    {}
+func (*QueryConsumerHashCommitmentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{50}
+}
+func (m *QueryConsumerHashCommitmentRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumerHashCommitmentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerHashCommitmentRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Phase != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Phase))
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryConsumerHashCommitmentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerHashCommitmentRequest.Merge(m, src)
+}
+func (m *QueryConsumerHashCommitmentRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumerHashCommitmentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerHashCommitmentRequest.DiscardUnknown(m)
 }
 
-func (m *QueryConsumerChainsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryConsumerHashCommitmentRequest proto.InternalMessageInfo
+
+func (m *QueryConsumerHashCommitmentRequest) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryConsumerChainsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryConsumerHashCommitmentResponse struct {
+	// the current genesis hash of the consumer chain
+	GenesisHash []byte `protobuf:"bytes,1,opt,name=genesis_hash,json=genesisHash,proto3" json:"genesis_hash,omitempty"`
+	// the current binary hash of the consumer chain
+	BinaryHash []byte `protobuf:"bytes,2,opt,name=binary_hash,json=binaryHash,proto3" json:"binary_hash,omitempty"`
+	// whether the genesis or binary hash was updated since registration
+	UpdatedSinceRegistration bool `protobuf:"varint,3,opt,name=updated_since_registration,json=updatedSinceRegistration,proto3" json:"updated_since_registration,omitempty"`
+	// whether the hashes can no longer be updated, i.e., the consumer chain was launched
+	Locked     bool                   `protobuf:"varint,4,opt,name=locked,proto3" json:"locked,omitempty"`
+	Commitment ConsumerHashCommitment `protobuf:"bytes,5,opt,name=commitment,proto3" json:"commitment"`
 }
 
-func (m *QueryConsumerChainsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryConsumerHashCommitmentResponse) Reset()         { *m = QueryConsumerHashCommitmentResponse{} }
+func (m *QueryConsumerHashCommitmentResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumerHashCommitmentResponse) ProtoMessage()    {}
+func (*QueryConsumerHashCommitmentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{51}
+}
+func (m *QueryConsumerHashCommitmentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumerHashCommitmentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerHashCommitmentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Chains) > 0 {
-		for iNdEx := len(m.Chains) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Chains[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+}
+func (m *QueryConsumerHashCommitmentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerHashCommitmentResponse.Merge(m, src)
+}
+func (m *QueryConsumerHashCommitmentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumerHashCommitmentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerHashCommitmentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConsumerHashCommitmentResponse proto.InternalMessageInfo
+
+func (m *QueryConsumerHashCommitmentResponse) GetGenesisHash() []byte {
+	if m != nil {
+		return m.GenesisHash
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *Chain) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryConsumerHashCommitmentResponse) GetBinaryHash() []byte {
+	if m != nil {
+		return m.BinaryHash
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Chain) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryConsumerHashCommitmentResponse) GetUpdatedSinceRegistration() bool {
+	if m != nil {
+		return m.UpdatedSinceRegistration
+	}
+	return false
 }
 
-func (m *Chain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.InfractionParameters != nil {
-		{
-			size, err := m.InfractionParameters.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x82
+func (m *QueryConsumerHashCommitmentResponse) GetLocked() bool {
+	if m != nil {
+		return m.Locked
 	}
-	if len(m.Prioritylist) > 0 {
-		for iNdEx := len(m.Prioritylist) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Prioritylist[iNdEx])
-			copy(dAtA[i:], m.Prioritylist[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.Prioritylist[iNdEx])))
-			i--
-			dAtA[i] = 0x7a
+	return false
+}
+
+func (m *QueryConsumerHashCommitmentResponse) GetCommitment() ConsumerHashCommitment {
+	if m != nil {
+		return m.Commitment
+	}
+	return ConsumerHashCommitment{}
+}
+
+type QueryConsumerCreatorAllowlistRequest struct {
+}
+
+func (m *QueryConsumerCreatorAllowlistRequest) Reset()         { *m = QueryConsumerCreatorAllowlistRequest{} }
+func (m *QueryConsumerCreatorAllowlistRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumerCreatorAllowlistRequest) ProtoMessage()    {}
+func (*QueryConsumerCreatorAllowlistRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{52}
+}
+func (m *QueryConsumerCreatorAllowlistRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumerCreatorAllowlistRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerCreatorAllowlistRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.AllowlistedRewardDenoms != nil {
-		{
-			size, err := m.AllowlistedRewardDenoms.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+}
+func (m *QueryConsumerCreatorAllowlistRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerCreatorAllowlistRequest.Merge(m, src)
+}
+func (m *QueryConsumerCreatorAllowlistRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumerCreatorAllowlistRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerCreatorAllowlistRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConsumerCreatorAllowlistRequest proto.InternalMessageInfo
+
+type QueryConsumerCreatorAllowlistResponse struct {
+	// the addresses allowed to create consumer chains
+	Addresses []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+}
+
+func (m *QueryConsumerCreatorAllowlistResponse) Reset()         { *m = QueryConsumerCreatorAllowlistResponse{} }
+func (m *QueryConsumerCreatorAllowlistResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumerCreatorAllowlistResponse) ProtoMessage()    {}
+func (*QueryConsumerCreatorAllowlistResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{53}
+}
+func (m *QueryConsumerCreatorAllowlistResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumerCreatorAllowlistResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerCreatorAllowlistResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x72
+		return b[:n], nil
 	}
-	if len(m.ConsumerId) > 0 {
-		i -= len(m.ConsumerId)
-		copy(dAtA[i:], m.ConsumerId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
-		i--
-		dAtA[i] = 0x6a
+}
+func (m *QueryConsumerCreatorAllowlistResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerCreatorAllowlistResponse.Merge(m, src)
+}
+func (m *QueryConsumerCreatorAllowlistResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumerCreatorAllowlistResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerCreatorAllowlistResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConsumerCreatorAllowlistResponse proto.InternalMessageInfo
+
+func (m *QueryConsumerCreatorAllowlistResponse) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
 	}
-	if m.AllowInactiveVals {
-		i--
-		if m.AllowInactiveVals {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	return nil
+}
+
+type QueryConsumerPacketStatsRequest struct {
+	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+}
+
+func (m *QueryConsumerPacketStatsRequest) Reset()         { *m = QueryConsumerPacketStatsRequest{} }
+func (m *QueryConsumerPacketStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumerPacketStatsRequest) ProtoMessage()    {}
+func (*QueryConsumerPacketStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{54}
+}
+func (m *QueryConsumerPacketStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumerPacketStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerPacketStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x60
+		return b[:n], nil
 	}
-	if m.MinStake != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.MinStake))
-		i--
-		dAtA[i] = 0x58
+}
+func (m *QueryConsumerPacketStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerPacketStatsRequest.Merge(m, src)
+}
+func (m *QueryConsumerPacketStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumerPacketStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerPacketStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConsumerPacketStatsRequest proto.InternalMessageInfo
+
+func (m *QueryConsumerPacketStatsRequest) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
 	}
-	{
-		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+	return ""
+}
+
+type QueryConsumerPacketStatsResponse struct {
+	Stats ConsumerPacketStats `protobuf:"bytes,1,opt,name=stats,proto3" json:"stats"`
+}
+
+func (m *QueryConsumerPacketStatsResponse) Reset()         { *m = QueryConsumerPacketStatsResponse{} }
+func (m *QueryConsumerPacketStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumerPacketStatsResponse) ProtoMessage()    {}
+func (*QueryConsumerPacketStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{55}
+}
+func (m *QueryConsumerPacketStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumerPacketStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerPacketStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x52
-	if len(m.Phase) > 0 {
-		i -= len(m.Phase)
-		copy(dAtA[i:], m.Phase)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Phase)))
-		i--
-		dAtA[i] = 0x4a
+}
+func (m *QueryConsumerPacketStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerPacketStatsResponse.Merge(m, src)
+}
+func (m *QueryConsumerPacketStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumerPacketStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerPacketStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConsumerPacketStatsResponse proto.InternalMessageInfo
+
+func (m *QueryConsumerPacketStatsResponse) GetStats() ConsumerPacketStats {
+	if m != nil {
+		return m.Stats
 	}
-	if len(m.Denylist) > 0 {
-		for iNdEx := len(m.Denylist) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Denylist[iNdEx])
-			copy(dAtA[i:], m.Denylist[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.Denylist[iNdEx])))
-			i--
-			dAtA[i] = 0x42
+	return ConsumerPacketStats{}
+}
+
+type QueryValidatorCCVSummaryRequest struct {
+	// the validator operator address of the provider validator
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+}
+
+func (m *QueryValidatorCCVSummaryRequest) Reset()         { *m = QueryValidatorCCVSummaryRequest{} }
+func (m *QueryValidatorCCVSummaryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorCCVSummaryRequest) ProtoMessage()    {}
+func (*QueryValidatorCCVSummaryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{56}
+}
+func (m *QueryValidatorCCVSummaryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorCCVSummaryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorCCVSummaryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Allowlist) > 0 {
-		for iNdEx := len(m.Allowlist) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Allowlist[iNdEx])
-			copy(dAtA[i:], m.Allowlist[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.Allowlist[iNdEx])))
-			i--
-			dAtA[i] = 0x3a
-		}
+}
+func (m *QueryValidatorCCVSummaryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorCCVSummaryRequest.Merge(m, src)
+}
+func (m *QueryValidatorCCVSummaryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorCCVSummaryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorCCVSummaryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorCCVSummaryRequest proto.InternalMessageInfo
+
+func (m *QueryValidatorCCVSummaryRequest) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	if m.ValidatorSetCap != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ValidatorSetCap))
-		i--
-		dAtA[i] = 0x30
+	return ""
+}
+
+type QueryValidatorCCVSummaryResponse struct {
+	// the validator operator address of the provider validator
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// the consensus address of the validator on the provider
+	ProviderAddress string                `protobuf:"bytes,2,opt,name=provider_address,json=providerAddress,proto3" json:"provider_address,omitempty"`
+	Status          types1.BondStatus     `protobuf:"varint,3,opt,name=status,proto3,enum=cosmos.staking.v1beta1.BondStatus" json:"status,omitempty"`
+	Tokens          cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=tokens,proto3,customtype=cosmossdk.io/math.Int" json:"tokens"`
+	// the voting power of the validator on the provider in the last block
+	Power      int64 `protobuf:"varint,5,opt,name=power,proto3" json:"power,omitempty"`
+	Jailed     bool  `protobuf:"varint,6,opt,name=jailed,proto3" json:"jailed,omitempty"`
+	Tombstoned bool  `protobuf:"varint,7,opt,name=tombstoned,proto3" json:"tombstoned,omitempty"`
+	// whether a double-sign infraction on a consumer chain was reported for this validator
+	ConsumerDoubleSignLogged bool `protobuf:"varint,8,opt,name=consumer_double_sign_logged,json=consumerDoubleSignLogged,proto3" json:"consumer_double_sign_logged,omitempty"`
+	// the outstanding rewards of the validator, including the ICS rewards of the consumer chains
+	OutstandingRewards github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,9,rep,name=outstanding_rewards,json=outstandingRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"outstanding_rewards"`
+	// the consumer chains the validator is opted in, validates or has to validate
+	Consumers []ValidatorConsumerSummary `protobuf:"bytes,10,rep,name=consumers,proto3" json:"consumers"`
+}
+
+func (m *QueryValidatorCCVSummaryResponse) Reset()         { *m = QueryValidatorCCVSummaryResponse{} }
+func (m *QueryValidatorCCVSummaryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorCCVSummaryResponse) ProtoMessage()    {}
+func (*QueryValidatorCCVSummaryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{57}
+}
+func (m *QueryValidatorCCVSummaryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorCCVSummaryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorCCVSummaryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.ValidatorsPowerCap != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ValidatorsPowerCap))
-		i--
-		dAtA[i] = 0x28
+}
+func (m *QueryValidatorCCVSummaryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorCCVSummaryResponse.Merge(m, src)
+}
+func (m *QueryValidatorCCVSummaryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorCCVSummaryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorCCVSummaryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorCCVSummaryResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorCCVSummaryResponse) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	if m.MinPowerInTop_N != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.MinPowerInTop_N))
-		i--
-		dAtA[i] = 0x20
+	return ""
+}
+
+func (m *QueryValidatorCCVSummaryResponse) GetProviderAddress() string {
+	if m != nil {
+		return m.ProviderAddress
 	}
-	if m.Top_N != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Top_N))
-		i--
-		dAtA[i] = 0x18
+	return ""
+}
+
+func (m *QueryValidatorCCVSummaryResponse) GetStatus() types1.BondStatus {
+	if m != nil {
+		return m.Status
 	}
-	if len(m.ClientId) > 0 {
-		i -= len(m.ClientId)
-		copy(dAtA[i:], m.ClientId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
-		i--
-		dAtA[i] = 0x12
+	return types1.Unspecified
+}
+
+func (m *QueryValidatorCCVSummaryResponse) GetPower() int64 {
+	if m != nil {
+		return m.Power
 	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *QueryValidatorCCVSummaryResponse) GetJailed() bool {
+	if m != nil {
+		return m.Jailed
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *QueryValidatorConsumerAddrRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryValidatorCCVSummaryResponse) GetTombstoned() bool {
+	if m != nil {
+		return m.Tombstoned
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *QueryValidatorConsumerAddrRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryValidatorCCVSummaryResponse) GetConsumerDoubleSignLogged() bool {
+	if m != nil {
+		return m.ConsumerDoubleSignLogged
+	}
+	return false
 }
 
-func (m *QueryValidatorConsumerAddrRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ConsumerId) > 0 {
-		i -= len(m.ConsumerId)
-		copy(dAtA[i:], m.ConsumerId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
-		i--
-		dAtA[i] = 0x12
+func (m *QueryValidatorCCVSummaryResponse) GetOutstandingRewards() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.OutstandingRewards
 	}
-	if len(m.ProviderAddress) > 0 {
-		i -= len(m.ProviderAddress)
-		copy(dAtA[i:], m.ProviderAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProviderAddress)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *QueryValidatorCCVSummaryResponse) GetConsumers() []ValidatorConsumerSummary {
+	if m != nil {
+		return m.Consumers
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryValidatorConsumerAddrResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// ValidatorConsumerSummary is the CCV data of a provider validator for a consumer chain
+type ValidatorConsumerSummary struct {
+	ConsumerId string        `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+	ChainId    string        `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Phase      ConsumerPhase `protobuf:"varint,3,opt,name=phase,proto3,enum=interchain_security.ccv.provider.v1.ConsumerPhase" json:"phase,omitempty"`
+	OptedIn    bool          `protobuf:"varint,4,opt,name=opted_in,json=optedIn,proto3" json:"opted_in,omitempty"`
+	// whether the validator is part of the consumer validator set in the current epoch
+	ConsumerValidator bool `protobuf:"varint,5,opt,name=consumer_validator,json=consumerValidator,proto3" json:"consumer_validator,omitempty"`
+	// whether the validator has to validate the consumer chain in the next epoch
+	HasToValidate bool `protobuf:"varint,6,opt,name=has_to_validate,json=hasToValidate,proto3" json:"has_to_validate,omitempty"`
+	// the consensus address of the validator on the consumer chain;
+	// empty if the validator did not assign a consumer key
+	ConsumerAddress string `protobuf:"bytes,7,opt,name=consumer_address,json=consumerAddress,proto3" json:"consumer_address,omitempty"`
+	// the commission rate of the validator on the consumer chain, as a fraction
+	CommissionRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=commission_rate,json=commissionRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"commission_rate"`
+}
+
+func (m *ValidatorConsumerSummary) Reset()         { *m = ValidatorConsumerSummary{} }
+func (m *ValidatorConsumerSummary) String() string { return proto.CompactTextString(m) }
+func (*ValidatorConsumerSummary) ProtoMessage()    {}
+func (*ValidatorConsumerSummary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{58}
+}
+func (m *ValidatorConsumerSummary) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorConsumerSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorConsumerSummary.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *ValidatorConsumerSummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorConsumerSummary.Merge(m, src)
+}
+func (m *ValidatorConsumerSummary) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorConsumerSummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorConsumerSummary.DiscardUnknown(m)
 }
 
-func (m *QueryValidatorConsumerAddrResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_ValidatorConsumerSummary proto.InternalMessageInfo
+
+func (m *ValidatorConsumerSummary) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
+	}
+	return ""
 }
 
-func (m *QueryValidatorConsumerAddrResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ConsumerAddress) > 0 {
-		i -= len(m.ConsumerAddress)
-		copy(dAtA[i:], m.ConsumerAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerAddress)))
-		i--
-		dAtA[i] = 0xa
+func (m *ValidatorConsumerSummary) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryValidatorProviderAddrRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ValidatorConsumerSummary) GetPhase() ConsumerPhase {
+	if m != nil {
+		return m.Phase
 	}
-	return dAtA[:n], nil
+	return CONSUMER_PHASE_UNSPECIFIED
 }
 
-func (m *QueryValidatorProviderAddrRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ValidatorConsumerSummary) GetOptedIn() bool {
+	if m != nil {
+		return m.OptedIn
+	}
+	return false
 }
 
-func (m *QueryValidatorProviderAddrRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ConsumerId) > 0 {
-		i -= len(m.ConsumerId)
-		copy(dAtA[i:], m.ConsumerId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
-		i--
-		dAtA[i] = 0x12
+func (m *ValidatorConsumerSummary) GetConsumerValidator() bool {
+	if m != nil {
+		return m.ConsumerValidator
 	}
-	if len(m.ConsumerAddress) > 0 {
-		i -= len(m.ConsumerAddress)
-		copy(dAtA[i:], m.ConsumerAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerAddress)))
-		i--
-		dAtA[i] = 0xa
+	return false
+}
+
+func (m *ValidatorConsumerSummary) GetHasToValidate() bool {
+	if m != nil {
+		return m.HasToValidate
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *QueryValidatorProviderAddrResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ValidatorConsumerSummary) GetConsumerAddress() string {
+	if m != nil {
+		return m.ConsumerAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryValidatorProviderAddrResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryConsumerClientUpgradePlansRequest struct {
 }
 
-func (m *QueryValidatorProviderAddrResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ProviderAddress) > 0 {
-		i -= len(m.ProviderAddress)
-		copy(dAtA[i:], m.ProviderAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProviderAddress)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryConsumerClientUpgradePlansRequest) Reset() {
+	*m = QueryConsumerClientUpgradePlansRequest{}
 }
-
-func (m *QueryThrottleStateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryConsumerClientUpgradePlansRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumerClientUpgradePlansRequest) ProtoMessage()    {}
+func (*QueryConsumerClientUpgradePlansRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{59}
+}
+func (m *QueryConsumerClientUpgradePlansRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumerClientUpgradePlansRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerClientUpgradePlansRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryConsumerClientUpgradePlansRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerClientUpgradePlansRequest.Merge(m, src)
+}
+func (m *QueryConsumerClientUpgradePlansRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumerClientUpgradePlansRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerClientUpgradePlansRequest.DiscardUnknown(m)
 }
 
-func (m *QueryThrottleStateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryConsumerClientUpgradePlansRequest proto.InternalMessageInfo
+
+type QueryConsumerClientUpgradePlansResponse struct {
+	Upgrades []ConsumerClientUpgrade `protobuf:"bytes,1,rep,name=upgrades,proto3" json:"upgrades"`
 }
 
-func (m *QueryThrottleStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryConsumerClientUpgradePlansResponse) Reset() {
+	*m = QueryConsumerClientUpgradePlansResponse{}
+}
+func (m *QueryConsumerClientUpgradePlansResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumerClientUpgradePlansResponse) ProtoMessage()    {}
+func (*QueryConsumerClientUpgradePlansResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{60}
+}
+func (m *QueryConsumerClientUpgradePlansResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumerClientUpgradePlansResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerClientUpgradePlansResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryConsumerClientUpgradePlansResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerClientUpgradePlansResponse.Merge(m, src)
+}
+func (m *QueryConsumerClientUpgradePlansResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumerClientUpgradePlansResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerClientUpgradePlansResponse.DiscardUnknown(m)
 }
 
-func (m *QueryThrottleStateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryConsumerClientUpgradePlansResponse proto.InternalMessageInfo
+
+func (m *QueryConsumerClientUpgradePlansResponse) GetUpgrades() []ConsumerClientUpgrade {
+	if m != nil {
+		return m.Upgrades
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryThrottleStateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ConsumerClientUpgrade is a pending upgrade of the client of a consumer chain
+type ConsumerClientUpgrade struct {
+	ConsumerId string                    `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+	ChainId    string                    `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	ClientId   string                    `protobuf:"bytes,3,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	Plan       ConsumerClientUpgradePlan `protobuf:"bytes,4,opt,name=plan,proto3" json:"plan"`
 }
 
-func (m *QueryThrottleStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.NextReplenishCandidate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.NextReplenishCandidate):])
-	if err7 != nil {
-		return 0, err7
+func (m *ConsumerClientUpgrade) Reset()         { *m = ConsumerClientUpgrade{} }
+func (m *ConsumerClientUpgrade) String() string { return proto.CompactTextString(m) }
+func (*ConsumerClientUpgrade) ProtoMessage()    {}
+func (*ConsumerClientUpgrade) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{61}
+}
+func (m *ConsumerClientUpgrade) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerClientUpgrade) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerClientUpgrade.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	i -= n7
-	i = encodeVarintQuery(dAtA, i, uint64(n7))
-	i--
-	dAtA[i] = 0x1a
-	if m.SlashMeterAllowance != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.SlashMeterAllowance))
-		i--
-		dAtA[i] = 0x10
+}
+func (m *ConsumerClientUpgrade) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerClientUpgrade.Merge(m, src)
+}
+func (m *ConsumerClientUpgrade) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerClientUpgrade) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerClientUpgrade.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsumerClientUpgrade proto.InternalMessageInfo
+
+func (m *ConsumerClientUpgrade) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
 	}
-	if m.SlashMeter != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.SlashMeter))
-		i--
-		dAtA[i] = 0x8
+	return ""
+}
+
+func (m *ConsumerClientUpgrade) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryRegisteredConsumerRewardDenomsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ConsumerClientUpgrade) GetClientId() string {
+	if m != nil {
+		return m.ClientId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryRegisteredConsumerRewardDenomsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ConsumerClientUpgrade) GetPlan() ConsumerClientUpgradePlan {
+	if m != nil {
+		return m.Plan
+	}
+	return ConsumerClientUpgradePlan{}
 }
 
-func (m *QueryRegisteredConsumerRewardDenomsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type QueryConsumerProviderSwitchesRequest struct {
 }
 
-func (m *QueryRegisteredConsumerRewardDenomsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryConsumerProviderSwitchesRequest) Reset()         { *m = QueryConsumerProviderSwitchesRequest{} }
+func (m *QueryConsumerProviderSwitchesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumerProviderSwitchesRequest) ProtoMessage()    {}
+func (*QueryConsumerProviderSwitchesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{62}
+}
+func (m *QueryConsumerProviderSwitchesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumerProviderSwitchesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerProviderSwitchesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryConsumerProviderSwitchesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerProviderSwitchesRequest.Merge(m, src)
+}
+func (m *QueryConsumerProviderSwitchesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumerProviderSwitchesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerProviderSwitchesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryRegisteredConsumerRewardDenomsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryConsumerProviderSwitchesRequest proto.InternalMessageInfo
+
+type QueryConsumerProviderSwitchesResponse struct {
+	Switches []RegisteredProviderSwitch `protobuf:"bytes,1,rep,name=switches,proto3" json:"switches"`
 }
 
-func (m *QueryRegisteredConsumerRewardDenomsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denoms) > 0 {
-		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Denoms[iNdEx])
-			copy(dAtA[i:], m.Denoms[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.Denoms[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+func (m *QueryConsumerProviderSwitchesResponse) Reset()         { *m = QueryConsumerProviderSwitchesResponse{} }
+func (m *QueryConsumerProviderSwitchesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumerProviderSwitchesResponse) ProtoMessage()    {}
+func (*QueryConsumerProviderSwitchesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{63}
+}
+func (m *QueryConsumerProviderSwitchesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumerProviderSwitchesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerProviderSwitchesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryConsumerProviderSwitchesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerProviderSwitchesResponse.Merge(m, src)
+}
+func (m *QueryConsumerProviderSwitchesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumerProviderSwitchesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerProviderSwitchesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllPairsValConsAddrByConsumerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryConsumerProviderSwitchesResponse proto.InternalMessageInfo
+
+func (m *QueryConsumerProviderSwitchesResponse) GetSwitches() []RegisteredProviderSwitch {
+	if m != nil {
+		return m.Switches
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAllPairsValConsAddrByConsumerRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// RegisteredProviderSwitch is a switch of a consumer chain to a different provider chain
+type RegisteredProviderSwitch struct {
+	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+	ChainId    string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// the phase of the consumer chain, i.e., stopped once the consumer chain switched
+	Phase  ConsumerPhase          `protobuf:"varint,3,opt,name=phase,proto3,enum=interchain_security.ccv.provider.v1.ConsumerPhase" json:"phase,omitempty"`
+	Switch ConsumerProviderSwitch `protobuf:"bytes,4,opt,name=switch,proto3" json:"switch"`
 }
 
-func (m *QueryAllPairsValConsAddrByConsumerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ConsumerId) > 0 {
-		i -= len(m.ConsumerId)
-		copy(dAtA[i:], m.ConsumerId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
-		i--
-		dAtA[i] = 0xa
+func (m *RegisteredProviderSwitch) Reset()         { *m = RegisteredProviderSwitch{} }
+func (m *RegisteredProviderSwitch) String() string { return proto.CompactTextString(m) }
+func (*RegisteredProviderSwitch) ProtoMessage()    {}
+func (*RegisteredProviderSwitch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{64}
+}
+func (m *RegisteredProviderSwitch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RegisteredProviderSwitch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RegisteredProviderSwitch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *RegisteredProviderSwitch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisteredProviderSwitch.Merge(m, src)
+}
+func (m *RegisteredProviderSwitch) XXX_Size() int {
+	return m.Size()
+}
+func (m *RegisteredProviderSwitch) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisteredProviderSwitch.DiscardUnknown(m)
 }
 
-func (m *QueryAllPairsValConsAddrByConsumerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_RegisteredProviderSwitch proto.InternalMessageInfo
+
+func (m *RegisteredProviderSwitch) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryAllPairsValConsAddrByConsumerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *RegisteredProviderSwitch) GetChainId() string {
+	if m != nil {
+		return m.ChainId
+	}
+	return ""
 }
 
-func (m *QueryAllPairsValConsAddrByConsumerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PairValConAddr) > 0 {
-		for iNdEx := len(m.PairValConAddr) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PairValConAddr[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (m *RegisteredProviderSwitch) GetPhase() ConsumerPhase {
+	if m != nil {
+		return m.Phase
 	}
-	return len(dAtA) - i, nil
+	return CONSUMER_PHASE_UNSPECIFIED
 }
 
-func (m *PairValConAddrProviderAndConsumer) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *RegisteredProviderSwitch) GetSwitch() ConsumerProviderSwitch {
+	if m != nil {
+		return m.Switch
 	}
-	return dAtA[:n], nil
+	return ConsumerProviderSwitch{}
 }
 
-func (m *PairValConAddrProviderAndConsumer) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryConsumerClientExpiriesRequest struct {
 }
 
-func (m *PairValConAddrProviderAndConsumer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ConsumerKey != nil {
-		{
-			size, err := m.ConsumerKey.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryConsumerClientExpiriesRequest) Reset()         { *m = QueryConsumerClientExpiriesRequest{} }
+func (m *QueryConsumerClientExpiriesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumerClientExpiriesRequest) ProtoMessage()    {}
+func (*QueryConsumerClientExpiriesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{65}
+}
+func (m *QueryConsumerClientExpiriesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumerClientExpiriesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerClientExpiriesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ConsumerAddress) > 0 {
-		i -= len(m.ConsumerAddress)
-		copy(dAtA[i:], m.ConsumerAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ProviderAddress) > 0 {
-		i -= len(m.ProviderAddress)
-		copy(dAtA[i:], m.ProviderAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProviderAddress)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryConsumerClientExpiriesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerClientExpiriesRequest.Merge(m, src)
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryConsumerClientExpiriesRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryConsumerClientExpiriesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerClientExpiriesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_QueryConsumerClientExpiriesRequest proto.InternalMessageInfo
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryConsumerClientExpiriesResponse struct {
+	Expiries []ConsumerClientExpiry `protobuf:"bytes,1,rep,name=expiries,proto3" json:"expiries"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryConsumerClientExpiriesResponse) Reset()         { *m = QueryConsumerClientExpiriesResponse{} }
+func (m *QueryConsumerClientExpiriesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumerClientExpiriesResponse) ProtoMessage()    {}
+func (*QueryConsumerClientExpiriesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{66}
+}
+func (m *QueryConsumerClientExpiriesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumerClientExpiriesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerClientExpiriesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryConsumerChainOptedInValidatorsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryConsumerClientExpiriesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerClientExpiriesResponse.Merge(m, src)
 }
-
-func (m *QueryConsumerChainOptedInValidatorsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryConsumerClientExpiriesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumerClientExpiriesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerClientExpiriesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryConsumerChainOptedInValidatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ConsumerId) > 0 {
-		i -= len(m.ConsumerId)
-		copy(dAtA[i:], m.ConsumerId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryConsumerClientExpiriesResponse proto.InternalMessageInfo
+
+func (m *QueryConsumerClientExpiriesResponse) GetExpiries() []ConsumerClientExpiry {
+	if m != nil {
+		return m.Expiries
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryConsumerChainOptedInValidatorsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// ConsumerClientExpiry is the expiry of the client of a launched consumer chain
+type ConsumerClientExpiry struct {
+	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+	ChainId    string `protobuf:"bytes,2,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	ClientId   string `protobuf:"bytes,3,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	// the time at which the client expires if it is not updated, i.e.,
+	// the end of the trusting period of its latest consensus state
+	ExpiryTime time.Time `protobuf:"bytes,4,opt,name=expiry_time,json=expiryTime,proto3,stdtime" json:"expiry_time"`
+	// the duration from the current block time until the client expires;
+	// negative if the client is expired
+	TimeUntilExpiry time.Duration `protobuf:"bytes,5,opt,name=time_until_expiry,json=timeUntilExpiry,proto3,stdduration" json:"time_until_expiry"`
+}
+
+func (m *ConsumerClientExpiry) Reset()         { *m = ConsumerClientExpiry{} }
+func (m *ConsumerClientExpiry) String() string { return proto.CompactTextString(m) }
+func (*ConsumerClientExpiry) ProtoMessage()    {}
+func (*ConsumerClientExpiry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{67}
+}
+func (m *ConsumerClientExpiry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerClientExpiry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerClientExpiry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryConsumerChainOptedInValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ConsumerClientExpiry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerClientExpiry.Merge(m, src)
+}
+func (m *ConsumerClientExpiry) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerClientExpiry) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerClientExpiry.DiscardUnknown(m)
 }
 
-func (m *QueryConsumerChainOptedInValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ValidatorsProviderAddresses) > 0 {
-		for iNdEx := len(m.ValidatorsProviderAddresses) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.ValidatorsProviderAddresses[iNdEx])
-			copy(dAtA[i:], m.ValidatorsProviderAddresses[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorsProviderAddresses[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+var xxx_messageInfo_ConsumerClientExpiry proto.InternalMessageInfo
+
+func (m *ConsumerClientExpiry) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryConsumerValidatorsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ConsumerClientExpiry) GetChainId() string {
+	if m != nil {
+		return m.ChainId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryConsumerValidatorsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ConsumerClientExpiry) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
 }
 
-func (m *QueryConsumerValidatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ConsumerId) > 0 {
-		i -= len(m.ConsumerId)
-		copy(dAtA[i:], m.ConsumerId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
-		i--
-		dAtA[i] = 0xa
+func (m *ConsumerClientExpiry) GetExpiryTime() time.Time {
+	if m != nil {
+		return m.ExpiryTime
 	}
-	return len(dAtA) - i, nil
+	return time.Time{}
 }
 
-func (m *QueryConsumerValidatorsValidator) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ConsumerClientExpiry) GetTimeUntilExpiry() time.Duration {
+	if m != nil {
+		return m.TimeUntilExpiry
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryConsumerValidatorsValidator) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryThrottleQueueStateRequest struct {
 }
 
-func (m *QueryConsumerValidatorsValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ValidatesCurrentEpoch {
-		i--
-		if m.ValidatesCurrentEpoch {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x70
-	}
-	if m.ProviderPower != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ProviderPower))
-		i--
-		dAtA[i] = 0x68
-	}
-	{
-		size := m.ProviderTokens.Size()
-		i -= size
-		if _, err := m.ProviderTokens.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x62
-	if m.Status != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x58
-	}
-	if m.Jailed {
-		i--
-		if m.Jailed {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x50
-	}
-	if len(m.ProviderOperatorAddress) > 0 {
-		i -= len(m.ProviderOperatorAddress)
-		copy(dAtA[i:], m.ProviderOperatorAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProviderOperatorAddress)))
-		i--
-		dAtA[i] = 0x4a
-	}
-	{
-		size, err := m.Description.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryThrottleQueueStateRequest) Reset()         { *m = QueryThrottleQueueStateRequest{} }
+func (m *QueryThrottleQueueStateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryThrottleQueueStateRequest) ProtoMessage()    {}
+func (*QueryThrottleQueueStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{68}
+}
+func (m *QueryThrottleQueueStateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryThrottleQueueStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryThrottleQueueStateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x42
-	{
-		size := m.ProviderCommissionRate.Size()
-		i -= size
-		if _, err := m.ProviderCommissionRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x3a
-	{
-		size := m.ConsumerCommissionRate.Size()
-		i -= size
-		if _, err := m.ConsumerCommissionRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x32
-	if m.ConsumerPower != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ConsumerPower))
-		i--
-		dAtA[i] = 0x28
-	}
-	{
-		size := m.Rate.Size()
-		i -= size
-		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if m.Power != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Power))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.ConsumerKey != nil {
-		{
-			size, err := m.ConsumerKey.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ProviderAddress) > 0 {
-		i -= len(m.ProviderAddress)
-		copy(dAtA[i:], m.ProviderAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProviderAddress)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryConsumerValidatorsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryThrottleQueueStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryThrottleQueueStateRequest.Merge(m, src)
+}
+func (m *QueryThrottleQueueStateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryThrottleQueueStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryThrottleQueueStateRequest.DiscardUnknown(m)
 }
 
-func (m *QueryConsumerValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryThrottleQueueStateRequest proto.InternalMessageInfo
+
+type QueryThrottleQueueStateResponse struct {
+	// current slash_meter state
+	SlashMeter int64 `protobuf:"varint,1,opt,name=slash_meter,json=slashMeter,proto3" json:"slash_meter,omitempty"`
+	// allowance of voting power units (int) that the slash meter is given per
+	// replenish period this also serves as the max value for the meter.
+	SlashMeterAllowance int64 `protobuf:"varint,2,opt,name=slash_meter_allowance,json=slashMeterAllowance,proto3" json:"slash_meter_allowance,omitempty"`
+	// next time the slash meter could potentially be replenished, iff it's not
+	// full
+	NextReplenishCandidate time.Time `protobuf:"bytes,3,opt,name=next_replenish_candidate,json=nextReplenishCandidate,proto3,stdtime" json:"next_replenish_candidate"`
+	// the slash packets pending a retry, at most one per consumer chain
+	PendingSlashPackets []BouncedSlashPacket `protobuf:"bytes,4,rep,name=pending_slash_packets,json=pendingSlashPackets,proto3" json:"pending_slash_packets"`
 }
 
-func (m *QueryConsumerValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Validators) > 0 {
-		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryThrottleQueueStateResponse) Reset()         { *m = QueryThrottleQueueStateResponse{} }
+func (m *QueryThrottleQueueStateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryThrottleQueueStateResponse) ProtoMessage()    {}
+func (*QueryThrottleQueueStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{69}
+}
+func (m *QueryThrottleQueueStateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryThrottleQueueStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryThrottleQueueStateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryThrottleQueueStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryThrottleQueueStateResponse.Merge(m, src)
+}
+func (m *QueryThrottleQueueStateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryThrottleQueueStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryThrottleQueueStateResponse.DiscardUnknown(m)
 }
 
-func (m *QueryConsumerChainsValidatorHasToValidateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryThrottleQueueStateResponse proto.InternalMessageInfo
+
+func (m *QueryThrottleQueueStateResponse) GetSlashMeter() int64 {
+	if m != nil {
+		return m.SlashMeter
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryConsumerChainsValidatorHasToValidateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryThrottleQueueStateResponse) GetSlashMeterAllowance() int64 {
+	if m != nil {
+		return m.SlashMeterAllowance
+	}
+	return 0
 }
 
-func (m *QueryConsumerChainsValidatorHasToValidateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ProviderAddress) > 0 {
-		i -= len(m.ProviderAddress)
-		copy(dAtA[i:], m.ProviderAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProviderAddress)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryThrottleQueueStateResponse) GetNextReplenishCandidate() time.Time {
+	if m != nil {
+		return m.NextReplenishCandidate
 	}
-	return len(dAtA) - i, nil
+	return time.Time{}
 }
 
-func (m *QueryConsumerChainsValidatorHasToValidateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryThrottleQueueStateResponse) GetPendingSlashPackets() []BouncedSlashPacket {
+	if m != nil {
+		return m.PendingSlashPackets
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryConsumerChainsValidatorHasToValidateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryLastVSCPacketRequest struct {
+	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
 }
 
-func (m *QueryConsumerChainsValidatorHasToValidateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ConsumerIds) > 0 {
-		for iNdEx := len(m.ConsumerIds) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.ConsumerIds[iNdEx])
-			copy(dAtA[i:], m.ConsumerIds[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerIds[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+func (m *QueryLastVSCPacketRequest) Reset()         { *m = QueryLastVSCPacketRequest{} }
+func (m *QueryLastVSCPacketRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLastVSCPacketRequest) ProtoMessage()    {}
+func (*QueryLastVSCPacketRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{70}
+}
+func (m *QueryLastVSCPacketRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLastVSCPacketRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLastVSCPacketRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryLastVSCPacketRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLastVSCPacketRequest.Merge(m, src)
+}
+func (m *QueryLastVSCPacketRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLastVSCPacketRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLastVSCPacketRequest.DiscardUnknown(m)
 }
 
-func (m *QueryValidatorConsumerCommissionRateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryLastVSCPacketRequest proto.InternalMessageInfo
+
+func (m *QueryLastVSCPacketRequest) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryValidatorConsumerCommissionRateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// VSCPacketValidator is a validator referred to by a VSC packet
+type VSCPacketValidator struct {
+	// the consensus address of the validator on the consumer chain
+	ConsumerConsAddress string `protobuf:"bytes,1,opt,name=consumer_cons_address,json=consumerConsAddress,proto3" json:"consumer_cons_address,omitempty"`
+	// the consensus address of the validator on the provider chain
+	ProviderConsAddress string `protobuf:"bytes,2,opt,name=provider_cons_address,json=providerConsAddress,proto3" json:"provider_cons_address,omitempty"`
+	// the moniker of the validator; empty if the validator is not found on the provider chain
+	Moniker string `protobuf:"bytes,3,opt,name=moniker,proto3" json:"moniker,omitempty"`
+	// the voting power of the validator update; zero for a slash acknowledgement
+	Power int64 `protobuf:"varint,4,opt,name=power,proto3" json:"power,omitempty"`
 }
 
-func (m *QueryValidatorConsumerCommissionRateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ProviderAddress) > 0 {
-		i -= len(m.ProviderAddress)
-		copy(dAtA[i:], m.ProviderAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProviderAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ConsumerId) > 0 {
-		i -= len(m.ConsumerId)
-		copy(dAtA[i:], m.ConsumerId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
-		i--
-		dAtA[i] = 0xa
+func (m *VSCPacketValidator) Reset()         { *m = VSCPacketValidator{} }
+func (m *VSCPacketValidator) String() string { return proto.CompactTextString(m) }
+func (*VSCPacketValidator) ProtoMessage()    {}
+func (*VSCPacketValidator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{71}
+}
+func (m *VSCPacketValidator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VSCPacketValidator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VSCPacketValidator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *VSCPacketValidator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VSCPacketValidator.Merge(m, src)
+}
+func (m *VSCPacketValidator) XXX_Size() int {
+	return m.Size()
+}
+func (m *VSCPacketValidator) XXX_DiscardUnknown() {
+	xxx_messageInfo_VSCPacketValidator.DiscardUnknown(m)
 }
 
-func (m *QueryValidatorConsumerCommissionRateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_VSCPacketValidator proto.InternalMessageInfo
+
+func (m *VSCPacketValidator) GetConsumerConsAddress() string {
+	if m != nil {
+		return m.ConsumerConsAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryValidatorConsumerCommissionRateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *VSCPacketValidator) GetProviderConsAddress() string {
+	if m != nil {
+		return m.ProviderConsAddress
+	}
+	return ""
 }
 
-func (m *QueryValidatorConsumerCommissionRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Rate.Size()
-		i -= size
-		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *VSCPacketValidator) GetMoniker() string {
+	if m != nil {
+		return m.Moniker
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryBlocksUntilNextEpochRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *VSCPacketValidator) GetPower() int64 {
+	if m != nil {
+		return m.Power
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryBlocksUntilNextEpochRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryLastVSCPacketResponse struct {
+	// the last VSC packet sent to the consumer chain
+	Packet SentVSCPacket `protobuf:"bytes,1,opt,name=packet,proto3" json:"packet"`
+	// the validator updates of the packet
+	ValidatorUpdates []VSCPacketValidator `protobuf:"bytes,2,rep,name=validator_updates,json=validatorUpdates,proto3" json:"validator_updates"`
+	// the validators whose jailing for downtime is acknowledged by the packet
+	SlashAcks []VSCPacketValidator `protobuf:"bytes,3,rep,name=slash_acks,json=slashAcks,proto3" json:"slash_acks"`
 }
 
-func (m *QueryBlocksUntilNextEpochRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryLastVSCPacketResponse) Reset()         { *m = QueryLastVSCPacketResponse{} }
+func (m *QueryLastVSCPacketResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLastVSCPacketResponse) ProtoMessage()    {}
+func (*QueryLastVSCPacketResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{72}
 }
-
-func (m *QueryBlocksUntilNextEpochResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryLastVSCPacketResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLastVSCPacketResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLastVSCPacketResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryLastVSCPacketResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLastVSCPacketResponse.Merge(m, src)
+}
+func (m *QueryLastVSCPacketResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLastVSCPacketResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLastVSCPacketResponse.DiscardUnknown(m)
 }
 
-func (m *QueryBlocksUntilNextEpochResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryLastVSCPacketResponse proto.InternalMessageInfo
+
+func (m *QueryLastVSCPacketResponse) GetPacket() SentVSCPacket {
+	if m != nil {
+		return m.Packet
+	}
+	return SentVSCPacket{}
 }
 
-func (m *QueryBlocksUntilNextEpochResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BlocksUntilNextEpoch != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.BlocksUntilNextEpoch))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryLastVSCPacketResponse) GetValidatorUpdates() []VSCPacketValidator {
+	if m != nil {
+		return m.ValidatorUpdates
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryConsumerIdFromClientIdRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryLastVSCPacketResponse) GetSlashAcks() []VSCPacketValidator {
+	if m != nil {
+		return m.SlashAcks
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryConsumerIdFromClientIdRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryConsumerThrottleStateRequest struct {
+	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
 }
 
-func (m *QueryConsumerIdFromClientIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ClientId) > 0 {
-		i -= len(m.ClientId)
-		copy(dAtA[i:], m.ClientId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryConsumerThrottleStateRequest) Reset()         { *m = QueryConsumerThrottleStateRequest{} }
+func (m *QueryConsumerThrottleStateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumerThrottleStateRequest) ProtoMessage()    {}
+func (*QueryConsumerThrottleStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{73}
+}
+func (m *QueryConsumerThrottleStateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumerThrottleStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerThrottleStateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryConsumerThrottleStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerThrottleStateRequest.Merge(m, src)
+}
+func (m *QueryConsumerThrottleStateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumerThrottleStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerThrottleStateRequest.DiscardUnknown(m)
 }
 
-func (m *QueryConsumerIdFromClientIdResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryConsumerThrottleStateRequest proto.InternalMessageInfo
+
+func (m *QueryConsumerThrottleStateRequest) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryConsumerIdFromClientIdResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryConsumerThrottleStateResponse struct {
+	ThrottlingParameters ThrottlingParameters `protobuf:"bytes,1,opt,name=throttling_parameters,json=throttlingParameters,proto3" json:"throttling_parameters"`
+	// whether the slash packets of the consumer chain are throttled by the global slash meter
+	GlobalSlashMeter bool `protobuf:"varint,2,opt,name=global_slash_meter,json=globalSlashMeter,proto3" json:"global_slash_meter,omitempty"`
+	// current state of the slash meter throttling the slash packets of the consumer chain
+	SlashMeter int64 `protobuf:"varint,3,opt,name=slash_meter,json=slashMeter,proto3" json:"slash_meter,omitempty"`
+	// allowance of voting power units (int) that the slash meter is given per
+	// replenish period this also serves as the max value for the meter.
+	SlashMeterAllowance int64 `protobuf:"varint,4,opt,name=slash_meter_allowance,json=slashMeterAllowance,proto3" json:"slash_meter_allowance,omitempty"`
+	// next time the slash meter could potentially be replenished, iff it's not
+	// full
+	NextReplenishCandidate time.Time `protobuf:"bytes,5,opt,name=next_replenish_candidate,json=nextReplenishCandidate,proto3,stdtime" json:"next_replenish_candidate"`
 }
 
-func (m *QueryConsumerIdFromClientIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ConsumerId) > 0 {
-		i -= len(m.ConsumerId)
-		copy(dAtA[i:], m.ConsumerId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryConsumerThrottleStateResponse) Reset()         { *m = QueryConsumerThrottleStateResponse{} }
+func (m *QueryConsumerThrottleStateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumerThrottleStateResponse) ProtoMessage()    {}
+func (*QueryConsumerThrottleStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{74}
 }
-
-func (m *QueryConsumerChainRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryConsumerThrottleStateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumerThrottleStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerThrottleStateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryConsumerThrottleStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerThrottleStateResponse.Merge(m, src)
+}
+func (m *QueryConsumerThrottleStateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumerThrottleStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerThrottleStateResponse.DiscardUnknown(m)
 }
 
-func (m *QueryConsumerChainRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryConsumerThrottleStateResponse proto.InternalMessageInfo
+
+func (m *QueryConsumerThrottleStateResponse) GetThrottlingParameters() ThrottlingParameters {
+	if m != nil {
+		return m.ThrottlingParameters
+	}
+	return ThrottlingParameters{}
 }
 
-func (m *QueryConsumerChainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ConsumerId) > 0 {
-		i -= len(m.ConsumerId)
-		copy(dAtA[i:], m.ConsumerId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryConsumerThrottleStateResponse) GetGlobalSlashMeter() bool {
+	if m != nil {
+		return m.GlobalSlashMeter
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *QueryConsumerChainResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryConsumerThrottleStateResponse) GetSlashMeter() int64 {
+	if m != nil {
+		return m.SlashMeter
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryConsumerChainResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryConsumerThrottleStateResponse) GetSlashMeterAllowance() int64 {
+	if m != nil {
+		return m.SlashMeterAllowance
+	}
+	return 0
 }
 
-func (m *QueryConsumerChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ClientId) > 0 {
-		i -= len(m.ClientId)
-		copy(dAtA[i:], m.ClientId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
-		i--
-		dAtA[i] = 0x4a
-	}
-	if m.InfractionParameters != nil {
-		{
-			size, err := m.InfractionParameters.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x42
-	}
-	if m.PowerShapingParams != nil {
-		{
-			size, err := m.PowerShapingParams.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.InitParams != nil {
-		{
-			size, err := m.InitParams.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
+func (m *QueryConsumerThrottleStateResponse) GetNextReplenishCandidate() time.Time {
+	if m != nil {
+		return m.NextReplenishCandidate
 	}
-	{
-		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+	return time.Time{}
+}
+
+type QueryPendingInfractionParameterUpdatesRequest struct {
+	// (optional) the consumer id of the consumer chain to return the pending update of;
+	// if empty, the pending updates of all the consumer chains are returned
+	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+}
+
+func (m *QueryPendingInfractionParameterUpdatesRequest) Reset() {
+	*m = QueryPendingInfractionParameterUpdatesRequest{}
+}
+func (m *QueryPendingInfractionParameterUpdatesRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryPendingInfractionParameterUpdatesRequest) ProtoMessage() {}
+func (*QueryPendingInfractionParameterUpdatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{75}
+}
+func (m *QueryPendingInfractionParameterUpdatesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingInfractionParameterUpdatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingInfractionParameterUpdatesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	if len(m.Phase) > 0 {
-		i -= len(m.Phase)
-		copy(dAtA[i:], m.Phase)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Phase)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.OwnerAddress) > 0 {
-		i -= len(m.OwnerAddress)
-		copy(dAtA[i:], m.OwnerAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.OwnerAddress)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ChainId) > 0 {
-		i -= len(m.ChainId)
-		copy(dAtA[i:], m.ChainId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ConsumerId) > 0 {
-		i -= len(m.ConsumerId)
-		copy(dAtA[i:], m.ConsumerId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryPendingInfractionParameterUpdatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingInfractionParameterUpdatesRequest.Merge(m, src)
+}
+func (m *QueryPendingInfractionParameterUpdatesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingInfractionParameterUpdatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingInfractionParameterUpdatesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryConsumerGenesisTimeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPendingInfractionParameterUpdatesRequest proto.InternalMessageInfo
+
+func (m *QueryPendingInfractionParameterUpdatesRequest) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryConsumerGenesisTimeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryPendingInfractionParameterUpdatesResponse struct {
+	Updates []PendingInfractionParametersUpdate `protobuf:"bytes,1,rep,name=updates,proto3" json:"updates"`
 }
 
-func (m *QueryConsumerGenesisTimeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ConsumerId) > 0 {
-		i -= len(m.ConsumerId)
-		copy(dAtA[i:], m.ConsumerId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryPendingInfractionParameterUpdatesResponse) Reset() {
+	*m = QueryPendingInfractionParameterUpdatesResponse{}
+}
+func (m *QueryPendingInfractionParameterUpdatesResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryPendingInfractionParameterUpdatesResponse) ProtoMessage() {}
+func (*QueryPendingInfractionParameterUpdatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{76}
+}
+func (m *QueryPendingInfractionParameterUpdatesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingInfractionParameterUpdatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingInfractionParameterUpdatesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryPendingInfractionParameterUpdatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingInfractionParameterUpdatesResponse.Merge(m, src)
+}
+func (m *QueryPendingInfractionParameterUpdatesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingInfractionParameterUpdatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingInfractionParameterUpdatesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryConsumerGenesisTimeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPendingInfractionParameterUpdatesResponse proto.InternalMessageInfo
+
+func (m *QueryPendingInfractionParameterUpdatesResponse) GetUpdates() []PendingInfractionParametersUpdate {
+	if m != nil {
+		return m.Updates
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryConsumerGenesisTimeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// PendingInfractionParametersUpdate is an update of the infraction parameters of a consumer chain
+// that is queued until the unbonding period elapses
+type PendingInfractionParametersUpdate struct {
+	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+	// the infraction parameters currently in effect
+	CurrentParameters InfractionParameters `protobuf:"bytes,2,opt,name=current_parameters,json=currentParameters,proto3" json:"current_parameters"`
+	// the infraction parameters that take effect at update_time
+	PendingParameters InfractionParameters `protobuf:"bytes,3,opt,name=pending_parameters,json=pendingParameters,proto3" json:"pending_parameters"`
+	// the time at which the pending parameters are applied, i.e., in the BeginBlock
+	// of the first block with a block time not before update_time
+	UpdateTime time.Time `protobuf:"bytes,4,opt,name=update_time,json=updateTime,proto3,stdtime" json:"update_time"`
+}
+
+func (m *PendingInfractionParametersUpdate) Reset()         { *m = PendingInfractionParametersUpdate{} }
+func (m *PendingInfractionParametersUpdate) String() string { return proto.CompactTextString(m) }
+func (*PendingInfractionParametersUpdate) ProtoMessage()    {}
+func (*PendingInfractionParametersUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{77}
+}
+func (m *PendingInfractionParametersUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryConsumerGenesisTimeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n16, err16 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.GenesisTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.GenesisTime):])
-	if err16 != nil {
-		return 0, err16
+func (m *PendingInfractionParametersUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingInfractionParametersUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	i -= n16
-	i = encodeVarintQuery(dAtA, i, uint64(n16))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *PendingInfractionParametersUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingInfractionParametersUpdate.Merge(m, src)
+}
+func (m *PendingInfractionParametersUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingInfractionParametersUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingInfractionParametersUpdate.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_PendingInfractionParametersUpdate proto.InternalMessageInfo
+
+func (m *PendingInfractionParametersUpdate) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *QueryConsumerGenesisRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ConsumerId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+
+func (m *PendingInfractionParametersUpdate) GetCurrentParameters() InfractionParameters {
+	if m != nil {
+		return m.CurrentParameters
 	}
-	return n
+	return InfractionParameters{}
 }
 
-func (m *QueryConsumerGenesisResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PendingInfractionParametersUpdate) GetPendingParameters() InfractionParameters {
+	if m != nil {
+		return m.PendingParameters
 	}
-	var l int
-	_ = l
-	l = m.GenesisState.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return InfractionParameters{}
 }
 
-func (m *QueryConsumerChainsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Phase != 0 {
-		n += 1 + sovQuery(uint64(m.Phase))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *PendingInfractionParametersUpdate) GetUpdateTime() time.Time {
+	if m != nil {
+		return m.UpdateTime
 	}
-	return n
+	return time.Time{}
 }
 
-func (m *QueryConsumerChainsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Chains) > 0 {
-		for _, e := range m.Chains {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+type QueryStaleKeyAssignmentsRequest struct {
+	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
 }
 
-func (m *Chain) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.ClientId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Top_N != 0 {
-		n += 1 + sovQuery(uint64(m.Top_N))
-	}
-	if m.MinPowerInTop_N != 0 {
-		n += 1 + sovQuery(uint64(m.MinPowerInTop_N))
-	}
-	if m.ValidatorsPowerCap != 0 {
-		n += 1 + sovQuery(uint64(m.ValidatorsPowerCap))
-	}
-	if m.ValidatorSetCap != 0 {
-		n += 1 + sovQuery(uint64(m.ValidatorSetCap))
-	}
-	if len(m.Allowlist) > 0 {
-		for _, s := range m.Allowlist {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if len(m.Denylist) > 0 {
-		for _, s := range m.Denylist {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryStaleKeyAssignmentsRequest) Reset()         { *m = QueryStaleKeyAssignmentsRequest{} }
+func (m *QueryStaleKeyAssignmentsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStaleKeyAssignmentsRequest) ProtoMessage()    {}
+func (*QueryStaleKeyAssignmentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{78}
+}
+func (m *QueryStaleKeyAssignmentsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStaleKeyAssignmentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStaleKeyAssignmentsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	l = len(m.Phase)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = m.Metadata.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if m.MinStake != 0 {
-		n += 1 + sovQuery(uint64(m.MinStake))
-	}
-	if m.AllowInactiveVals {
-		n += 2
-	}
-	l = len(m.ConsumerId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.AllowlistedRewardDenoms != nil {
-		l = m.AllowlistedRewardDenoms.Size()
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryStaleKeyAssignmentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStaleKeyAssignmentsRequest.Merge(m, src)
+}
+func (m *QueryStaleKeyAssignmentsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStaleKeyAssignmentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStaleKeyAssignmentsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStaleKeyAssignmentsRequest proto.InternalMessageInfo
+
+func (m *QueryStaleKeyAssignmentsRequest) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
 	}
-	if len(m.Prioritylist) > 0 {
-		for _, s := range m.Prioritylist {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+	return ""
+}
+
+type QueryStaleKeyAssignmentsResponse struct {
+	StaleKeyAssignments []StaleKeyAssignment `protobuf:"bytes,1,rep,name=stale_key_assignments,json=staleKeyAssignments,proto3" json:"stale_key_assignments"`
+}
+
+func (m *QueryStaleKeyAssignmentsResponse) Reset()         { *m = QueryStaleKeyAssignmentsResponse{} }
+func (m *QueryStaleKeyAssignmentsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStaleKeyAssignmentsResponse) ProtoMessage()    {}
+func (*QueryStaleKeyAssignmentsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{79}
+}
+func (m *QueryStaleKeyAssignmentsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStaleKeyAssignmentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStaleKeyAssignmentsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.InfractionParameters != nil {
-		l = m.InfractionParameters.Size()
-		n += 2 + l + sovQuery(uint64(l))
-	}
-	return n
+}
+func (m *QueryStaleKeyAssignmentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStaleKeyAssignmentsResponse.Merge(m, src)
+}
+func (m *QueryStaleKeyAssignmentsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStaleKeyAssignmentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStaleKeyAssignmentsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryValidatorConsumerAddrRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ProviderAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.ConsumerId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryStaleKeyAssignmentsResponse proto.InternalMessageInfo
+
+func (m *QueryStaleKeyAssignmentsResponse) GetStaleKeyAssignments() []StaleKeyAssignment {
+	if m != nil {
+		return m.StaleKeyAssignments
 	}
-	return n
+	return nil
 }
 
-func (m *QueryValidatorConsumerAddrResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ConsumerAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+// StaleKeyAssignment is an assigned consumer key that is used in the validator set
+// of a consumer chain, but was never observed in a heartbeat of the consumer chain
+type StaleKeyAssignment struct {
+	// the consensus address of the validator on the provider chain
+	ProviderAddress string `protobuf:"bytes,1,opt,name=provider_address,json=providerAddress,proto3" json:"provider_address,omitempty"`
+	// the consensus address of the assigned consumer key
+	ConsumerAddress string `protobuf:"bytes,2,opt,name=consumer_address,json=consumerAddress,proto3" json:"consumer_address,omitempty"`
+	// the provider height since which the key is used in the validator set of the consumer chain
+	TrackedSinceHeight int64 `protobuf:"varint,3,opt,name=tracked_since_height,json=trackedSinceHeight,proto3" json:"tracked_since_height,omitempty"`
 }
 
-func (m *QueryValidatorProviderAddrRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ConsumerAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.ConsumerId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *StaleKeyAssignment) Reset()         { *m = StaleKeyAssignment{} }
+func (m *StaleKeyAssignment) String() string { return proto.CompactTextString(m) }
+func (*StaleKeyAssignment) ProtoMessage()    {}
+func (*StaleKeyAssignment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{80}
+}
+func (m *StaleKeyAssignment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StaleKeyAssignment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StaleKeyAssignment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *StaleKeyAssignment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StaleKeyAssignment.Merge(m, src)
+}
+func (m *StaleKeyAssignment) XXX_Size() int {
+	return m.Size()
+}
+func (m *StaleKeyAssignment) XXX_DiscardUnknown() {
+	xxx_messageInfo_StaleKeyAssignment.DiscardUnknown(m)
 }
 
-func (m *QueryValidatorProviderAddrResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ProviderAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_StaleKeyAssignment proto.InternalMessageInfo
+
+func (m *StaleKeyAssignment) GetProviderAddress() string {
+	if m != nil {
+		return m.ProviderAddress
 	}
-	return n
+	return ""
 }
 
-func (m *QueryThrottleStateRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *StaleKeyAssignment) GetConsumerAddress() string {
+	if m != nil {
+		return m.ConsumerAddress
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *QueryThrottleStateResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.SlashMeter != 0 {
-		n += 1 + sovQuery(uint64(m.SlashMeter))
-	}
-	if m.SlashMeterAllowance != 0 {
-		n += 1 + sovQuery(uint64(m.SlashMeterAllowance))
+func (m *StaleKeyAssignment) GetTrackedSinceHeight() int64 {
+	if m != nil {
+		return m.TrackedSinceHeight
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.NextReplenishCandidate)
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return 0
 }
 
-func (m *QueryRegisteredConsumerRewardDenomsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+type QueryConsumerUpdateHistoryRequest struct {
+	ConsumerId string             `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryRegisteredConsumerRewardDenomsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Denoms) > 0 {
-		for _, s := range m.Denoms {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryConsumerUpdateHistoryRequest) Reset()         { *m = QueryConsumerUpdateHistoryRequest{} }
+func (m *QueryConsumerUpdateHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumerUpdateHistoryRequest) ProtoMessage()    {}
+func (*QueryConsumerUpdateHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{81}
+}
+func (m *QueryConsumerUpdateHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumerUpdateHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerUpdateHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryConsumerUpdateHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerUpdateHistoryRequest.Merge(m, src)
+}
+func (m *QueryConsumerUpdateHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumerUpdateHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerUpdateHistoryRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllPairsValConsAddrByConsumerRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ConsumerId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryConsumerUpdateHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryConsumerUpdateHistoryRequest) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
 	}
-	return n
+	return ""
 }
 
-func (m *QueryAllPairsValConsAddrByConsumerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryConsumerUpdateHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	if len(m.PairValConAddr) > 0 {
-		for _, e := range m.PairValConAddr {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	return n
+	return nil
 }
 
-func (m *PairValConAddrProviderAndConsumer) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ProviderAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.ConsumerAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.ConsumerKey != nil {
-		l = m.ConsumerKey.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+type QueryConsumerUpdateHistoryResponse struct {
+	Records    []ConsumerUpdateRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
+	Pagination *query.PageResponse    `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryConsumerUpdateHistoryResponse) Reset()         { *m = QueryConsumerUpdateHistoryResponse{} }
+func (m *QueryConsumerUpdateHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumerUpdateHistoryResponse) ProtoMessage()    {}
+func (*QueryConsumerUpdateHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{82}
+}
+func (m *QueryConsumerUpdateHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumerUpdateHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerUpdateHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *QueryConsumerUpdateHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerUpdateHistoryResponse.Merge(m, src)
+}
+func (m *QueryConsumerUpdateHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumerUpdateHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerUpdateHistoryResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryConsumerUpdateHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryConsumerUpdateHistoryResponse) GetRecords() []ConsumerUpdateRecord {
+	if m != nil {
+		return m.Records
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
 
-func (m *QueryConsumerChainOptedInValidatorsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ConsumerId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryConsumerUpdateHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return n
+	return nil
 }
 
-func (m *QueryConsumerChainOptedInValidatorsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.ValidatorsProviderAddresses) > 0 {
-		for _, s := range m.ValidatorsProviderAddresses {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
+type QueryValidatorOptInHistoryRequest struct {
+	// The consensus address of the validator on the provider chain
+	ProviderAddress string `protobuf:"bytes,1,opt,name=provider_address,json=providerAddress,proto3" json:"provider_address,omitempty"`
+}
+
+func (m *QueryValidatorOptInHistoryRequest) Reset()         { *m = QueryValidatorOptInHistoryRequest{} }
+func (m *QueryValidatorOptInHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorOptInHistoryRequest) ProtoMessage()    {}
+func (*QueryValidatorOptInHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{83}
+}
+func (m *QueryValidatorOptInHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorOptInHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorOptInHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryValidatorOptInHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorOptInHistoryRequest.Merge(m, src)
+}
+func (m *QueryValidatorOptInHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorOptInHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorOptInHistoryRequest.DiscardUnknown(m)
 }
 
-func (m *QueryConsumerValidatorsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ConsumerId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryValidatorOptInHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryValidatorOptInHistoryRequest) GetProviderAddress() string {
+	if m != nil {
+		return m.ProviderAddress
 	}
-	return n
+	return ""
 }
 
-func (m *QueryConsumerValidatorsValidator) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ProviderAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.ConsumerKey != nil {
-		l = m.ConsumerKey.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Power != 0 {
-		n += 1 + sovQuery(uint64(m.Power))
-	}
-	l = m.Rate.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if m.ConsumerPower != 0 {
-		n += 1 + sovQuery(uint64(m.ConsumerPower))
-	}
-	l = m.ConsumerCommissionRate.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.ProviderCommissionRate.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.Description.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = len(m.ProviderOperatorAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Jailed {
-		n += 2
-	}
-	if m.Status != 0 {
-		n += 1 + sovQuery(uint64(m.Status))
-	}
-	l = m.ProviderTokens.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if m.ProviderPower != 0 {
-		n += 1 + sovQuery(uint64(m.ProviderPower))
-	}
-	if m.ValidatesCurrentEpoch {
-		n += 2
-	}
-	return n
+type QueryValidatorOptInHistoryResponse struct {
+	Histories []ValidatorConsumerOptInHistory `protobuf:"bytes,1,rep,name=histories,proto3" json:"histories"`
 }
 
-func (m *QueryConsumerValidatorsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Validators) > 0 {
-		for _, e := range m.Validators {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryValidatorOptInHistoryResponse) Reset()         { *m = QueryValidatorOptInHistoryResponse{} }
+func (m *QueryValidatorOptInHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorOptInHistoryResponse) ProtoMessage()    {}
+func (*QueryValidatorOptInHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{84}
+}
+func (m *QueryValidatorOptInHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorOptInHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorOptInHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
 }
-
-func (m *QueryConsumerChainsValidatorHasToValidateRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ProviderAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryValidatorOptInHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorOptInHistoryResponse.Merge(m, src)
+}
+func (m *QueryValidatorOptInHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorOptInHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorOptInHistoryResponse.DiscardUnknown(m)
 }
 
-func (m *QueryConsumerChainsValidatorHasToValidateResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.ConsumerIds) > 0 {
-		for _, s := range m.ConsumerIds {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
-		}
+var xxx_messageInfo_QueryValidatorOptInHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorOptInHistoryResponse) GetHistories() []ValidatorConsumerOptInHistory {
+	if m != nil {
+		return m.Histories
 	}
-	return n
+	return nil
 }
 
-func (m *QueryValidatorConsumerCommissionRateRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ConsumerId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.ProviderAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+type QueryModuleVersionInfoRequest struct {
 }
 
-func (m *QueryValidatorConsumerCommissionRateResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryModuleVersionInfoRequest) Reset()         { *m = QueryModuleVersionInfoRequest{} }
+func (m *QueryModuleVersionInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryModuleVersionInfoRequest) ProtoMessage()    {}
+func (*QueryModuleVersionInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{85}
+}
+func (m *QueryModuleVersionInfoRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryModuleVersionInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryModuleVersionInfoRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = m.Rate.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+}
+func (m *QueryModuleVersionInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryModuleVersionInfoRequest.Merge(m, src)
+}
+func (m *QueryModuleVersionInfoRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryModuleVersionInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryModuleVersionInfoRequest.DiscardUnknown(m)
 }
 
-func (m *QueryBlocksUntilNextEpochRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+var xxx_messageInfo_QueryModuleVersionInfoRequest proto.InternalMessageInfo
+
+type QueryModuleVersionInfoResponse struct {
+	VersionInfo types.ModuleVersionInfo `protobuf:"bytes,1,opt,name=version_info,json=versionInfo,proto3" json:"version_info"`
 }
 
-func (m *QueryBlocksUntilNextEpochResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryModuleVersionInfoResponse) Reset()         { *m = QueryModuleVersionInfoResponse{} }
+func (m *QueryModuleVersionInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryModuleVersionInfoResponse) ProtoMessage()    {}
+func (*QueryModuleVersionInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{86}
+}
+func (m *QueryModuleVersionInfoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryModuleVersionInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryModuleVersionInfoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.BlocksUntilNextEpoch != 0 {
-		n += 1 + sovQuery(uint64(m.BlocksUntilNextEpoch))
+}
+func (m *QueryModuleVersionInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryModuleVersionInfoResponse.Merge(m, src)
+}
+func (m *QueryModuleVersionInfoResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryModuleVersionInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryModuleVersionInfoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryModuleVersionInfoResponse proto.InternalMessageInfo
+
+func (m *QueryModuleVersionInfoResponse) GetVersionInfo() types.ModuleVersionInfo {
+	if m != nil {
+		return m.VersionInfo
 	}
-	return n
+	return types.ModuleVersionInfo{}
 }
 
-func (m *QueryConsumerIdFromClientIdRequest) Size() (n int) {
-	if m == nil {
-		return 0
+type QueryConsumerRewardEstimateRequest struct {
+	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+	// The consensus address of the validator on the provider chain
+	ProviderAddress string `protobuf:"bytes,2,opt,name=provider_address,json=providerAddress,proto3" json:"provider_address,omitempty"`
+}
+
+func (m *QueryConsumerRewardEstimateRequest) Reset()         { *m = QueryConsumerRewardEstimateRequest{} }
+func (m *QueryConsumerRewardEstimateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumerRewardEstimateRequest) ProtoMessage()    {}
+func (*QueryConsumerRewardEstimateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{87}
+}
+func (m *QueryConsumerRewardEstimateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumerRewardEstimateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerRewardEstimateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.ClientId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryConsumerRewardEstimateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerRewardEstimateRequest.Merge(m, src)
+}
+func (m *QueryConsumerRewardEstimateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumerRewardEstimateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerRewardEstimateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryConsumerRewardEstimateRequest proto.InternalMessageInfo
+
+func (m *QueryConsumerRewardEstimateRequest) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
 	}
-	return n
+	return ""
 }
 
-func (m *QueryConsumerIdFromClientIdResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryConsumerRewardEstimateRequest) GetProviderAddress() string {
+	if m != nil {
+		return m.ProviderAddress
 	}
-	var l int
-	_ = l
-	l = len(m.ConsumerId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return ""
+}
+
+type QueryConsumerRewardEstimateResponse struct {
+	Estimate ConsumerRewardEstimate `protobuf:"bytes,1,opt,name=estimate,proto3" json:"estimate"`
+}
+
+func (m *QueryConsumerRewardEstimateResponse) Reset()         { *m = QueryConsumerRewardEstimateResponse{} }
+func (m *QueryConsumerRewardEstimateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryConsumerRewardEstimateResponse) ProtoMessage()    {}
+func (*QueryConsumerRewardEstimateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{88}
+}
+func (m *QueryConsumerRewardEstimateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryConsumerRewardEstimateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryConsumerRewardEstimateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryConsumerRewardEstimateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryConsumerRewardEstimateResponse.Merge(m, src)
+}
+func (m *QueryConsumerRewardEstimateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryConsumerRewardEstimateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryConsumerRewardEstimateResponse.DiscardUnknown(m)
 }
 
-func (m *QueryConsumerChainRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryConsumerRewardEstimateResponse proto.InternalMessageInfo
+
+func (m *QueryConsumerRewardEstimateResponse) GetEstimate() ConsumerRewardEstimate {
+	if m != nil {
+		return m.Estimate
+	}
+	return ConsumerRewardEstimate{}
+}
+
+// ConsumerRewardEstimate is the estimated annual ICS rewards of a validator for a consumer chain.
+// The estimate extrapolates the rewards received from the consumer chain during the observation
+// window to a year, assuming that the power share and the commission rate of the validator do not change.
+type ConsumerRewardEstimate struct {
+	ConsumerId      string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+	ProviderAddress string `protobuf:"bytes,2,opt,name=provider_address,json=providerAddress,proto3" json:"provider_address,omitempty"`
+	// the period of time over which the inflow of rewards from the consumer chain was observed,
+	// i.e., since the oldest retained fund flow record; no rewards are estimated if it is shorter than a day
+	ObservationWindow time.Duration `protobuf:"bytes,3,opt,name=observation_window,json=observationWindow,proto3,stdduration" json:"observation_window"`
+	// the estimated annual rewards received by the consumer rewards pool from the consumer chain
+	AnnualConsumerRewards github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,4,rep,name=annual_consumer_rewards,json=annualConsumerRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"annual_consumer_rewards"`
+	// the fraction of the voting power of the consumer validator set held by the validator
+	PowerShare cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=power_share,json=powerShare,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"power_share"`
+	// the commission rate of the validator on the consumer chain, as a fraction
+	CommissionRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=commission_rate,json=commissionRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"commission_rate"`
+	// the estimated annual rewards allocated to the validator, i.e., its commission and
+	// the rewards of its delegators, after the community tax is deducted
+	AnnualValidatorRewards github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,7,rep,name=annual_validator_rewards,json=annualValidatorRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"annual_validator_rewards"`
+	// the estimated annual commission of the validator
+	AnnualCommission github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,8,rep,name=annual_commission,json=annualCommission,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"annual_commission"`
+	// the estimated annual rewards of the delegators of the validator
+	AnnualDelegatorRewards github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,9,rep,name=annual_delegator_rewards,json=annualDelegatorRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"annual_delegator_rewards"`
+	// the estimated annual delegator rewards per token bonded to the validator, i.e.,
+	// the reward rate of the delegators if the rewards are denominated in the bond denom
+	DelegatorRewardRate github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,10,rep,name=delegator_reward_rate,json=delegatorRewardRate,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"delegator_reward_rate"`
+	// whether the validator is currently eligible for ICS rewards, i.e., it has been
+	// a consumer validator for at least NumberOfEpochsToStartReceivingRewards epochs
+	Eligible bool `protobuf:"varint,11,opt,name=eligible,proto3" json:"eligible,omitempty"`
+}
+
+func (m *ConsumerRewardEstimate) Reset()         { *m = ConsumerRewardEstimate{} }
+func (m *ConsumerRewardEstimate) String() string { return proto.CompactTextString(m) }
+func (*ConsumerRewardEstimate) ProtoMessage()    {}
+func (*ConsumerRewardEstimate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{89}
+}
+func (m *ConsumerRewardEstimate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerRewardEstimate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerRewardEstimate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.ConsumerId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *ConsumerRewardEstimate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerRewardEstimate.Merge(m, src)
+}
+func (m *ConsumerRewardEstimate) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerRewardEstimate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerRewardEstimate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsumerRewardEstimate proto.InternalMessageInfo
+
+func (m *ConsumerRewardEstimate) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
 	}
-	return n
+	return ""
 }
 
-func (m *QueryConsumerChainResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ConsumerRewardEstimate) GetProviderAddress() string {
+	if m != nil {
+		return m.ProviderAddress
 	}
-	var l int
-	_ = l
-	l = len(m.ConsumerId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return ""
+}
+
+func (m *ConsumerRewardEstimate) GetObservationWindow() time.Duration {
+	if m != nil {
+		return m.ObservationWindow
 	}
-	l = len(m.ChainId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return 0
+}
+
+func (m *ConsumerRewardEstimate) GetAnnualConsumerRewards() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.AnnualConsumerRewards
 	}
-	l = len(m.OwnerAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+func (m *ConsumerRewardEstimate) GetAnnualValidatorRewards() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.AnnualValidatorRewards
 	}
-	l = len(m.Phase)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+func (m *ConsumerRewardEstimate) GetAnnualCommission() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.AnnualCommission
 	}
-	l = m.Metadata.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if m.InitParams != nil {
-		l = m.InitParams.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+func (m *ConsumerRewardEstimate) GetAnnualDelegatorRewards() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.AnnualDelegatorRewards
 	}
-	if m.PowerShapingParams != nil {
-		l = m.PowerShapingParams.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+func (m *ConsumerRewardEstimate) GetDelegatorRewardRate() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.DelegatorRewardRate
 	}
-	if m.InfractionParameters != nil {
-		l = m.InfractionParameters.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+func (m *ConsumerRewardEstimate) GetEligible() bool {
+	if m != nil {
+		return m.Eligible
 	}
-	l = len(m.ClientId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return false
+}
+
+type StreamValidatorSetChangesRequest struct {
+	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+}
+
+func (m *StreamValidatorSetChangesRequest) Reset()         { *m = StreamValidatorSetChangesRequest{} }
+func (m *StreamValidatorSetChangesRequest) String() string { return proto.CompactTextString(m) }
+func (*StreamValidatorSetChangesRequest) ProtoMessage()    {}
+func (*StreamValidatorSetChangesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{90}
+}
+func (m *StreamValidatorSetChangesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StreamValidatorSetChangesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StreamValidatorSetChangesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *StreamValidatorSetChangesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamValidatorSetChangesRequest.Merge(m, src)
+}
+func (m *StreamValidatorSetChangesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *StreamValidatorSetChangesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamValidatorSetChangesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryConsumerGenesisTimeRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_StreamValidatorSetChangesRequest proto.InternalMessageInfo
+
+func (m *StreamValidatorSetChangesRequest) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
 	}
-	var l int
-	_ = l
-	l = len(m.ConsumerId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return ""
+}
+
+type StreamValidatorSetChangesResponse struct {
+	ConsumerId string `protobuf:"bytes,1,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+	// the height of the block in which the VSC packet was queued
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// the queued VSC packet, i.e., its VSC id, validator updates, and slash acknowledgements
+	Packet types.ValidatorSetChangePacketData `protobuf:"bytes,3,opt,name=packet,proto3" json:"packet"`
+}
+
+func (m *StreamValidatorSetChangesResponse) Reset()         { *m = StreamValidatorSetChangesResponse{} }
+func (m *StreamValidatorSetChangesResponse) String() string { return proto.CompactTextString(m) }
+func (*StreamValidatorSetChangesResponse) ProtoMessage()    {}
+func (*StreamValidatorSetChangesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_422512d7b7586cd7, []int{91}
+}
+func (m *StreamValidatorSetChangesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StreamValidatorSetChangesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StreamValidatorSetChangesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StreamValidatorSetChangesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StreamValidatorSetChangesResponse.Merge(m, src)
+}
+func (m *StreamValidatorSetChangesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *StreamValidatorSetChangesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StreamValidatorSetChangesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StreamValidatorSetChangesResponse proto.InternalMessageInfo
+
+func (m *StreamValidatorSetChangesResponse) GetConsumerId() string {
+	if m != nil {
+		return m.ConsumerId
+	}
+	return ""
+}
+
+func (m *StreamValidatorSetChangesResponse) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *StreamValidatorSetChangesResponse) GetPacket() types.ValidatorSetChangePacketData {
+	if m != nil {
+		return m.Packet
+	}
+	return types.ValidatorSetChangePacketData{}
+}
+
+func init() {
+	proto.RegisterType((*QueryConsumerGenesisRequest)(nil), "interchain_security.ccv.provider.v1.QueryConsumerGenesisRequest")
+	proto.RegisterType((*QueryConsumerGenesisResponse)(nil), "interchain_security.ccv.provider.v1.QueryConsumerGenesisResponse")
+	proto.RegisterType((*QueryConsumerChainsRequest)(nil), "interchain_security.ccv.provider.v1.QueryConsumerChainsRequest")
+	proto.RegisterType((*QueryConsumerChainsResponse)(nil), "interchain_security.ccv.provider.v1.QueryConsumerChainsResponse")
+	proto.RegisterType((*Chain)(nil), "interchain_security.ccv.provider.v1.Chain")
+	proto.RegisterType((*QueryValidatorConsumerAddrRequest)(nil), "interchain_security.ccv.provider.v1.QueryValidatorConsumerAddrRequest")
+	proto.RegisterType((*QueryValidatorConsumerAddrResponse)(nil), "interchain_security.ccv.provider.v1.QueryValidatorConsumerAddrResponse")
+	proto.RegisterType((*QueryValidatorProviderAddrRequest)(nil), "interchain_security.ccv.provider.v1.QueryValidatorProviderAddrRequest")
+	proto.RegisterType((*QueryValidatorProviderAddrResponse)(nil), "interchain_security.ccv.provider.v1.QueryValidatorProviderAddrResponse")
+	proto.RegisterType((*QueryThrottleStateRequest)(nil), "interchain_security.ccv.provider.v1.QueryThrottleStateRequest")
+	proto.RegisterType((*QueryThrottleStateResponse)(nil), "interchain_security.ccv.provider.v1.QueryThrottleStateResponse")
+	proto.RegisterType((*QueryRegisteredConsumerRewardDenomsRequest)(nil), "interchain_security.ccv.provider.v1.QueryRegisteredConsumerRewardDenomsRequest")
+	proto.RegisterType((*QueryRegisteredConsumerRewardDenomsResponse)(nil), "interchain_security.ccv.provider.v1.QueryRegisteredConsumerRewardDenomsResponse")
+	proto.RegisterType((*QueryAllPairsValConsAddrByConsumerRequest)(nil), "interchain_security.ccv.provider.v1.QueryAllPairsValConsAddrByConsumerRequest")
+	proto.RegisterType((*QueryAllPairsValConsAddrByConsumerResponse)(nil), "interchain_security.ccv.provider.v1.QueryAllPairsValConsAddrByConsumerResponse")
+	proto.RegisterType((*PairValConAddrProviderAndConsumer)(nil), "interchain_security.ccv.provider.v1.PairValConAddrProviderAndConsumer")
+	proto.RegisterType((*QueryParamsRequest)(nil), "interchain_security.ccv.provider.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "interchain_security.ccv.provider.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryConsumerChainOptedInValidatorsRequest)(nil), "interchain_security.ccv.provider.v1.QueryConsumerChainOptedInValidatorsRequest")
+	proto.RegisterType((*QueryConsumerChainOptedInValidatorsResponse)(nil), "interchain_security.ccv.provider.v1.QueryConsumerChainOptedInValidatorsResponse")
+	proto.RegisterType((*QueryConsumerValidatorsRequest)(nil), "interchain_security.ccv.provider.v1.QueryConsumerValidatorsRequest")
+	proto.RegisterType((*QueryConsumerValidatorsValidator)(nil), "interchain_security.ccv.provider.v1.QueryConsumerValidatorsValidator")
+	proto.RegisterType((*QueryConsumerValidatorsResponse)(nil), "interchain_security.ccv.provider.v1.QueryConsumerValidatorsResponse")
+	proto.RegisterType((*QueryConsumerChainsValidatorHasToValidateRequest)(nil), "interchain_security.ccv.provider.v1.QueryConsumerChainsValidatorHasToValidateRequest")
+	proto.RegisterType((*QueryConsumerChainsValidatorHasToValidateResponse)(nil), "interchain_security.ccv.provider.v1.QueryConsumerChainsValidatorHasToValidateResponse")
+	proto.RegisterType((*QueryValidatorConsumerCommissionRateRequest)(nil), "interchain_security.ccv.provider.v1.QueryValidatorConsumerCommissionRateRequest")
+	proto.RegisterType((*QueryValidatorConsumerCommissionRateResponse)(nil), "interchain_security.ccv.provider.v1.QueryValidatorConsumerCommissionRateResponse")
+	proto.RegisterType((*QueryBlocksUntilNextEpochRequest)(nil), "interchain_security.ccv.provider.v1.QueryBlocksUntilNextEpochRequest")
+	proto.RegisterType((*QueryBlocksUntilNextEpochResponse)(nil), "interchain_security.ccv.provider.v1.QueryBlocksUntilNextEpochResponse")
+	proto.RegisterType((*QueryConsumerIdFromClientIdRequest)(nil), "interchain_security.ccv.provider.v1.QueryConsumerIdFromClientIdRequest")
+	proto.RegisterType((*QueryConsumerIdFromClientIdResponse)(nil), "interchain_security.ccv.provider.v1.QueryConsumerIdFromClientIdResponse")
+	proto.RegisterType((*QueryConsumerChainRequest)(nil), "interchain_security.ccv.provider.v1.QueryConsumerChainRequest")
+	proto.RegisterType((*QueryConsumerChainResponse)(nil), "interchain_security.ccv.provider.v1.QueryConsumerChainResponse")
+	proto.RegisterType((*QueryConsumerGenesisTimeRequest)(nil), "interchain_security.ccv.provider.v1.QueryConsumerGenesisTimeRequest")
+	proto.RegisterType((*QueryConsumerGenesisTimeResponse)(nil), "interchain_security.ccv.provider.v1.QueryConsumerGenesisTimeResponse")
+	proto.RegisterType((*QueryNearTimeoutPacketsRequest)(nil), "interchain_security.ccv.provider.v1.QueryNearTimeoutPacketsRequest")
+	proto.RegisterType((*QueryNearTimeoutPacketsResponse)(nil), "interchain_security.ccv.provider.v1.QueryNearTimeoutPacketsResponse")
+	proto.RegisterType((*PacketTimeout)(nil), "interchain_security.ccv.provider.v1.PacketTimeout")
+	proto.RegisterType((*QueryLaunchCapacityRequest)(nil), "interchain_security.ccv.provider.v1.QueryLaunchCapacityRequest")
+	proto.RegisterType((*QueryLaunchCapacityResponse)(nil), "interchain_security.ccv.provider.v1.QueryLaunchCapacityResponse")
+	proto.RegisterType((*QueryPowerShapingPipelineRequest)(nil), "interchain_security.ccv.provider.v1.QueryPowerShapingPipelineRequest")
+	proto.RegisterType((*QueryPowerShapingPipelineResponse)(nil), "interchain_security.ccv.provider.v1.QueryPowerShapingPipelineResponse")
+	proto.RegisterType((*QueryConsumerValidatorPowerProjectionRequest)(nil), "interchain_security.ccv.provider.v1.QueryConsumerValidatorPowerProjectionRequest")
+	proto.RegisterType((*QueryConsumerValidatorPowerProjectionResponse)(nil), "interchain_security.ccv.provider.v1.QueryConsumerValidatorPowerProjectionResponse")
+	proto.RegisterType((*ProjectedConsumerValidator)(nil), "interchain_security.ccv.provider.v1.ProjectedConsumerValidator")
+	proto.RegisterType((*QueryModuleAccountsSummaryRequest)(nil), "interchain_security.ccv.provider.v1.QueryModuleAccountsSummaryRequest")
+	proto.RegisterType((*ModuleAccountBalance)(nil), "interchain_security.ccv.provider.v1.ModuleAccountBalance")
+	proto.RegisterType((*QueryModuleAccountsSummaryResponse)(nil), "interchain_security.ccv.provider.v1.QueryModuleAccountsSummaryResponse")
+	proto.RegisterType((*QueryConsumerLaunchFailureRequest)(nil), "interchain_security.ccv.provider.v1.QueryConsumerLaunchFailureRequest")
+	proto.RegisterType((*QueryConsumerLaunchFailureResponse)(nil), "interchain_security.ccv.provider.v1.QueryConsumerLaunchFailureResponse")
+	proto.RegisterType((*QueryConsumerHashCommitmentRequest)(nil), "interchain_security.ccv.provider.v1.QueryConsumerHashCommitmentRequest")
+	proto.RegisterType((*QueryConsumerHashCommitmentResponse)(nil), "interchain_security.ccv.provider.v1.QueryConsumerHashCommitmentResponse")
+	proto.RegisterType((*QueryConsumerCreatorAllowlistRequest)(nil), "interchain_security.ccv.provider.v1.QueryConsumerCreatorAllowlistRequest")
+	proto.RegisterType((*QueryConsumerCreatorAllowlistResponse)(nil), "interchain_security.ccv.provider.v1.QueryConsumerCreatorAllowlistResponse")
+	proto.RegisterType((*QueryConsumerPacketStatsRequest)(nil), "interchain_security.ccv.provider.v1.QueryConsumerPacketStatsRequest")
+	proto.RegisterType((*QueryConsumerPacketStatsResponse)(nil), "interchain_security.ccv.provider.v1.QueryConsumerPacketStatsResponse")
+	proto.RegisterType((*QueryValidatorCCVSummaryRequest)(nil), "interchain_security.ccv.provider.v1.QueryValidatorCCVSummaryRequest")
+	proto.RegisterType((*QueryValidatorCCVSummaryResponse)(nil), "interchain_security.ccv.provider.v1.QueryValidatorCCVSummaryResponse")
+	proto.RegisterType((*ValidatorConsumerSummary)(nil), "interchain_security.ccv.provider.v1.ValidatorConsumerSummary")
+	proto.RegisterType((*QueryConsumerClientUpgradePlansRequest)(nil), "interchain_security.ccv.provider.v1.QueryConsumerClientUpgradePlansRequest")
+	proto.RegisterType((*QueryConsumerClientUpgradePlansResponse)(nil), "interchain_security.ccv.provider.v1.QueryConsumerClientUpgradePlansResponse")
+	proto.RegisterType((*ConsumerClientUpgrade)(nil), "interchain_security.ccv.provider.v1.ConsumerClientUpgrade")
+	proto.RegisterType((*QueryConsumerProviderSwitchesRequest)(nil), "interchain_security.ccv.provider.v1.QueryConsumerProviderSwitchesRequest")
+	proto.RegisterType((*QueryConsumerProviderSwitchesResponse)(nil), "interchain_security.ccv.provider.v1.QueryConsumerProviderSwitchesResponse")
+	proto.RegisterType((*RegisteredProviderSwitch)(nil), "interchain_security.ccv.provider.v1.RegisteredProviderSwitch")
+	proto.RegisterType((*QueryConsumerClientExpiriesRequest)(nil), "interchain_security.ccv.provider.v1.QueryConsumerClientExpiriesRequest")
+	proto.RegisterType((*QueryConsumerClientExpiriesResponse)(nil), "interchain_security.ccv.provider.v1.QueryConsumerClientExpiriesResponse")
+	proto.RegisterType((*ConsumerClientExpiry)(nil), "interchain_security.ccv.provider.v1.ConsumerClientExpiry")
+	proto.RegisterType((*QueryThrottleQueueStateRequest)(nil), "interchain_security.ccv.provider.v1.QueryThrottleQueueStateRequest")
+	proto.RegisterType((*QueryThrottleQueueStateResponse)(nil), "interchain_security.ccv.provider.v1.QueryThrottleQueueStateResponse")
+	proto.RegisterType((*QueryLastVSCPacketRequest)(nil), "interchain_security.ccv.provider.v1.QueryLastVSCPacketRequest")
+	proto.RegisterType((*VSCPacketValidator)(nil), "interchain_security.ccv.provider.v1.VSCPacketValidator")
+	proto.RegisterType((*QueryLastVSCPacketResponse)(nil), "interchain_security.ccv.provider.v1.QueryLastVSCPacketResponse")
+	proto.RegisterType((*QueryConsumerThrottleStateRequest)(nil), "interchain_security.ccv.provider.v1.QueryConsumerThrottleStateRequest")
+	proto.RegisterType((*QueryConsumerThrottleStateResponse)(nil), "interchain_security.ccv.provider.v1.QueryConsumerThrottleStateResponse")
+	proto.RegisterType((*QueryPendingInfractionParameterUpdatesRequest)(nil), "interchain_security.ccv.provider.v1.QueryPendingInfractionParameterUpdatesRequest")
+	proto.RegisterType((*QueryPendingInfractionParameterUpdatesResponse)(nil), "interchain_security.ccv.provider.v1.QueryPendingInfractionParameterUpdatesResponse")
+	proto.RegisterType((*PendingInfractionParametersUpdate)(nil), "interchain_security.ccv.provider.v1.PendingInfractionParametersUpdate")
+	proto.RegisterType((*QueryStaleKeyAssignmentsRequest)(nil), "interchain_security.ccv.provider.v1.QueryStaleKeyAssignmentsRequest")
+	proto.RegisterType((*QueryStaleKeyAssignmentsResponse)(nil), "interchain_security.ccv.provider.v1.QueryStaleKeyAssignmentsResponse")
+	proto.RegisterType((*StaleKeyAssignment)(nil), "interchain_security.ccv.provider.v1.StaleKeyAssignment")
+	proto.RegisterType((*QueryConsumerUpdateHistoryRequest)(nil), "interchain_security.ccv.provider.v1.QueryConsumerUpdateHistoryRequest")
+	proto.RegisterType((*QueryConsumerUpdateHistoryResponse)(nil), "interchain_security.ccv.provider.v1.QueryConsumerUpdateHistoryResponse")
+	proto.RegisterType((*QueryValidatorOptInHistoryRequest)(nil), "interchain_security.ccv.provider.v1.QueryValidatorOptInHistoryRequest")
+	proto.RegisterType((*QueryValidatorOptInHistoryResponse)(nil), "interchain_security.ccv.provider.v1.QueryValidatorOptInHistoryResponse")
+	proto.RegisterType((*QueryModuleVersionInfoRequest)(nil), "interchain_security.ccv.provider.v1.QueryModuleVersionInfoRequest")
+	proto.RegisterType((*QueryModuleVersionInfoResponse)(nil), "interchain_security.ccv.provider.v1.QueryModuleVersionInfoResponse")
+	proto.RegisterType((*QueryConsumerRewardEstimateRequest)(nil), "interchain_security.ccv.provider.v1.QueryConsumerRewardEstimateRequest")
+	proto.RegisterType((*QueryConsumerRewardEstimateResponse)(nil), "interchain_security.ccv.provider.v1.QueryConsumerRewardEstimateResponse")
+	proto.RegisterType((*ConsumerRewardEstimate)(nil), "interchain_security.ccv.provider.v1.ConsumerRewardEstimate")
+	proto.RegisterType((*StreamValidatorSetChangesRequest)(nil), "interchain_security.ccv.provider.v1.StreamValidatorSetChangesRequest")
+	proto.RegisterType((*StreamValidatorSetChangesResponse)(nil), "interchain_security.ccv.provider.v1.StreamValidatorSetChangesResponse")
+}
+
+func init() {
+	proto.RegisterFile("interchain_security/ccv/provider/v1/query.proto", fileDescriptor_422512d7b7586cd7)
+}
+
+var fileDescriptor_422512d7b7586cd7 = []byte{
+	// 5601 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x7c, 0x5b, 0x6c, 0x1c, 0xc9,
+	0x75, 0xb6, 0x7a, 0x78, 0x1b, 0x1e, 0xea, 0x5a, 0x22, 0xa5, 0x51, 0x4b, 0x2b, 0x52, 0x2d, 0x6b,
+	0x4d, 0x4b, 0x16, 0x47, 0xe2, 0xfe, 0xf6, 0x5e, 0xac, 0xcb, 0xf2, 0x4e, 0x5a, 0x17, 0x72, 0x9b,
+	0x92, 0xd6, 0x5e, 0x5b, 0x7f, 0xa7, 0xd9, 0x53, 0x1a, 0xb6, 0x39, 0xd3, 0x3d, 0xea, 0xee, 0xa1,
+	0xc4, 0x08, 0x1b, 0x04, 0x0b, 0x1b, 0x76, 0x60, 0x07, 0xb0, 0x11, 0x18, 0xf0, 0x43, 0x80, 0x38,
+	0x79, 0x0a, 0x1c, 0x23, 0x88, 0x83, 0x45, 0x90, 0xa7, 0x3c, 0x25, 0xc0, 0x26, 0x2f, 0x71, 0xec,
+	0x87, 0x04, 0x09, 0xb2, 0x36, 0x76, 0x1d, 0x20, 0x40, 0x10, 0xc0, 0x71, 0x8c, 0x3c, 0x24, 0x4e,
+	0x10, 0x74, 0xd5, 0xa9, 0xbe, 0x4d, 0xcf, 0x4c, 0xf7, 0x0c, 0x85, 0x24, 0x4f, 0x64, 0xd7, 0xe5,
+	0xab, 0x3a, 0xa7, 0x4e, 0x9d, 0x3a, 0x75, 0xea, 0x9c, 0x81, 0xb2, 0x69, 0x79, 0xd4, 0x31, 0xb6,
+	0x75, 0xd3, 0xd2, 0x5c, 0x6a, 0x34, 0x1d, 0xd3, 0xdb, 0x2b, 0x1b, 0xc6, 0x6e, 0xb9, 0xe1, 0xd8,
+	0xbb, 0x66, 0x85, 0x3a, 0xe5, 0xdd, 0xab, 0xe5, 0xc7, 0x4d, 0xea, 0xec, 0xcd, 0x34, 0x1c, 0xdb,
+	0xb3, 0xc9, 0xf9, 0x94, 0x0e, 0x33, 0x86, 0xb1, 0x3b, 0x23, 0x3a, 0xcc, 0xec, 0x5e, 0x95, 0xcf,
+	0x54, 0x6d, 0xbb, 0x5a, 0xa3, 0x65, 0xbd, 0x61, 0x96, 0x75, 0xcb, 0xb2, 0x3d, 0xdd, 0x33, 0x6d,
+	0xcb, 0xe5, 0x10, 0xf2, 0x78, 0xd5, 0xae, 0xda, 0xec, 0xdf, 0xb2, 0xff, 0x1f, 0x96, 0x4e, 0x62,
+	0x1f, 0xf6, 0xb5, 0xd5, 0x7c, 0x54, 0xf6, 0xcc, 0x3a, 0x75, 0x3d, 0xbd, 0xde, 0xc0, 0x06, 0x67,
+	0x93, 0x0d, 0x2a, 0x4d, 0x87, 0xe1, 0x62, 0xfd, 0x6c, 0x16, 0x52, 0x82, 0x59, 0xf2, 0x3e, 0x57,
+	0xda, 0xf5, 0xd9, 0xbd, 0x5a, 0x76, 0xb7, 0x75, 0x87, 0x56, 0x34, 0xc3, 0xb6, 0xdc, 0x66, 0x3d,
+	0xe8, 0x71, 0xa1, 0x43, 0x8f, 0x27, 0xa6, 0x43, 0xb1, 0xd9, 0x74, 0x87, 0x66, 0xbb, 0xd4, 0x71,
+	0xc3, 0x69, 0x9f, 0xf1, 0xa8, 0x55, 0xa1, 0x4e, 0xdd, 0xb4, 0xbc, 0xb2, 0xe1, 0xec, 0x35, 0x3c,
+	0xbb, 0xbc, 0x43, 0xf7, 0x04, 0xaf, 0x26, 0xcd, 0x2d, 0xa3, 0x6c, 0xd8, 0x0e, 0x2d, 0x1b, 0x35,
+	0x93, 0x5a, 0x9e, 0xdf, 0x9f, 0xff, 0x87, 0x0d, 0x4e, 0x19, 0xb6, 0x5b, 0xb7, 0x5d, 0x8d, 0xf3,
+	0x93, 0x7f, 0x60, 0xd5, 0x47, 0xf8, 0x57, 0xd9, 0xf5, 0xf4, 0x1d, 0xd3, 0xaa, 0x96, 0x77, 0xaf,
+	0x6e, 0x51, 0x4f, 0xbf, 0x2a, 0xbe, 0xb1, 0xd5, 0x45, 0x6c, 0xb5, 0xa5, 0xbb, 0x94, 0xaf, 0x74,
+	0xd0, 0xb0, 0xa1, 0x57, 0x4d, 0x2b, 0xca, 0xe2, 0xb3, 0xd1, 0xb6, 0xa2, 0x95, 0x61, 0x9b, 0x58,
+	0xaf, 0xdc, 0x80, 0xd3, 0x6f, 0xf8, 0x08, 0x0b, 0xc8, 0xb3, 0x15, 0x6a, 0x51, 0xd7, 0x74, 0x55,
+	0xfa, 0xb8, 0x49, 0x5d, 0x8f, 0x4c, 0xc2, 0x98, 0xe0, 0xa6, 0x66, 0x56, 0x4a, 0xd2, 0x94, 0x34,
+	0x3d, 0xaa, 0x82, 0x28, 0x5a, 0xab, 0x28, 0xcf, 0xe0, 0x4c, 0x7a, 0x7f, 0xb7, 0x61, 0x5b, 0x2e,
+	0x25, 0x9f, 0x83, 0x43, 0x55, 0x5e, 0xa4, 0xb9, 0x9e, 0xee, 0x51, 0x06, 0x31, 0x36, 0x7b, 0x65,
+	0xa6, 0x9d, 0x50, 0xee, 0x5e, 0x9d, 0x49, 0x60, 0x6d, 0xfa, 0xfd, 0xe6, 0x07, 0xdf, 0x7b, 0x7f,
+	0xf2, 0x80, 0x7a, 0xb0, 0x1a, 0x29, 0x53, 0x7e, 0x5f, 0x02, 0x39, 0x36, 0xfa, 0x82, 0x8f, 0x17,
+	0x4c, 0x7e, 0x15, 0x86, 0x1a, 0xdb, 0xba, 0xcb, 0xc7, 0x3c, 0x3c, 0x3b, 0x3b, 0x93, 0x61, 0x23,
+	0x04, 0x83, 0x6f, 0xf8, 0x3d, 0x55, 0x0e, 0x40, 0x96, 0x01, 0x42, 0xce, 0x96, 0x0a, 0x8c, 0x84,
+	0x17, 0x67, 0x70, 0xe9, 0x7c, 0xd6, 0xce, 0xf0, 0x0d, 0x87, 0x0c, 0x9e, 0xd9, 0xd0, 0xab, 0x14,
+	0x67, 0xa1, 0x46, 0x7a, 0x2a, 0xdf, 0x91, 0xe0, 0x74, 0xea, 0x84, 0x91, 0x5b, 0xf3, 0x30, 0xcc,
+	0xa6, 0xe7, 0x96, 0xa4, 0xa9, 0x81, 0xe9, 0xb1, 0xd9, 0x8b, 0xd9, 0xa6, 0xec, 0x57, 0xab, 0xd8,
+	0x93, 0xac, 0xa4, 0xcc, 0xf5, 0xa3, 0x5d, 0xe7, 0xca, 0x27, 0x10, 0x9b, 0xec, 0xef, 0x8e, 0xc0,
+	0x10, 0x83, 0x26, 0xa7, 0xa0, 0xc8, 0xa7, 0x10, 0x88, 0xc0, 0x08, 0xfb, 0x5e, 0xab, 0x90, 0xd3,
+	0x30, 0xca, 0x85, 0xdb, 0xaf, 0x2b, 0xb0, 0xba, 0x22, 0x2f, 0x58, 0xab, 0x90, 0xe3, 0x30, 0xe4,
+	0xd9, 0x0d, 0xed, 0x6e, 0x69, 0x60, 0x4a, 0x9a, 0x3e, 0xa4, 0x0e, 0x7a, 0x76, 0xe3, 0x2e, 0xb9,
+	0x08, 0xa4, 0x6e, 0x5a, 0x5a, 0xc3, 0x7e, 0xe2, 0xcb, 0x94, 0xa5, 0xf1, 0x16, 0x83, 0x53, 0xd2,
+	0xf4, 0x80, 0x7a, 0xb8, 0x6e, 0x5a, 0x1b, 0x7e, 0xc5, 0x9a, 0x75, 0xcf, 0x6f, 0x7b, 0x05, 0xc6,
+	0x77, 0xf5, 0x9a, 0x59, 0xd1, 0x3d, 0xdb, 0x71, 0xb1, 0x8b, 0xa1, 0x37, 0x4a, 0x43, 0x0c, 0x8f,
+	0x84, 0x75, 0xac, 0xd3, 0x82, 0xde, 0x20, 0x17, 0xe1, 0x58, 0x50, 0xaa, 0xb9, 0xd4, 0x63, 0xcd,
+	0x87, 0x59, 0xf3, 0x23, 0x41, 0xc5, 0x26, 0xf5, 0xfc, 0xb6, 0x67, 0x60, 0x54, 0xaf, 0xd5, 0xec,
+	0x27, 0x35, 0xd3, 0xf5, 0x4a, 0x23, 0x53, 0x03, 0xd3, 0xa3, 0x6a, 0x58, 0x40, 0x64, 0x28, 0x56,
+	0xa8, 0xb5, 0xc7, 0x2a, 0x8b, 0xac, 0x32, 0xf8, 0x26, 0xe3, 0x42, 0xb2, 0x46, 0x19, 0xc5, 0xfc,
+	0x83, 0xbc, 0x09, 0xc5, 0x3a, 0xf5, 0xf4, 0x8a, 0xee, 0xe9, 0x25, 0x60, 0x7c, 0xff, 0x44, 0x2e,
+	0x91, 0xbb, 0x83, 0x9d, 0x51, 0xd6, 0x03, 0x30, 0x9f, 0xc9, 0x3e, 0xcb, 0x7c, 0x2d, 0x40, 0x4b,
+	0x63, 0x53, 0xd2, 0xf4, 0xa0, 0x5a, 0xac, 0x9b, 0xd6, 0xa6, 0xff, 0x4d, 0x66, 0xe0, 0x38, 0x9b,
+	0xb4, 0x66, 0x5a, 0xba, 0xe1, 0x99, 0xbb, 0x54, 0xdb, 0xd5, 0x6b, 0x6e, 0xe9, 0xe0, 0x94, 0x34,
+	0x5d, 0x54, 0x8f, 0xb1, 0xaa, 0x35, 0xac, 0x79, 0xa0, 0xd7, 0xdc, 0xe4, 0x96, 0x3e, 0x94, 0xdc,
+	0xd2, 0xe4, 0x29, 0x9c, 0x0a, 0xb8, 0x40, 0x2b, 0x9a, 0x43, 0x9f, 0xe8, 0x4e, 0x45, 0xab, 0x50,
+	0xcb, 0xae, 0xbb, 0xa5, 0xc3, 0x8c, 0xae, 0x6b, 0x99, 0xe8, 0x9a, 0x0b, 0x51, 0x54, 0x06, 0xb2,
+	0xc8, 0x30, 0xd4, 0x93, 0x7a, 0x7a, 0x05, 0x51, 0xe0, 0x60, 0xc3, 0x31, 0x6d, 0x1f, 0x8c, 0xb1,
+	0xfd, 0x08, 0x63, 0x7b, 0xac, 0x8c, 0x58, 0x30, 0x61, 0x5a, 0x8f, 0x1c, 0x9f, 0x20, 0xdb, 0xd2,
+	0x1a, 0xba, 0xa3, 0xd7, 0xa9, 0x47, 0x1d, 0xb7, 0x74, 0x94, 0xcd, 0xec, 0xd5, 0x4c, 0x33, 0x5b,
+	0x0b, 0x10, 0x36, 0x02, 0x00, 0x75, 0xdc, 0x4c, 0x29, 0x25, 0x57, 0x61, 0x82, 0x49, 0xa8, 0xf6,
+	0x84, 0x9a, 0xd5, 0x6d, 0x9f, 0x21, 0xb4, 0x61, 0x1b, 0xdb, 0x6e, 0xe9, 0x18, 0x97, 0x41, 0x5f,
+	0xa6, 0xdf, 0xc4, 0xaa, 0x25, 0x56, 0x43, 0x1a, 0x30, 0x1e, 0x9d, 0x32, 0xf6, 0x74, 0x4b, 0x84,
+	0xed, 0xe9, 0x97, 0x33, 0xcd, 0x70, 0x23, 0x02, 0xc0, 0xe1, 0x51, 0x2a, 0x8e, 0x37, 0x5a, 0x6a,
+	0x5c, 0xe5, 0xd7, 0x25, 0x38, 0xc7, 0xf4, 0xca, 0x03, 0x21, 0xe2, 0x42, 0xa6, 0xe6, 0x2a, 0x15,
+	0x47, 0xe8, 0xc3, 0xeb, 0x70, 0x54, 0x0c, 0xa1, 0xe9, 0x95, 0x8a, 0x43, 0x5d, 0x97, 0x6f, 0xe7,
+	0x79, 0xf2, 0xb3, 0xf7, 0x27, 0x0f, 0xef, 0xe9, 0xf5, 0xda, 0x6b, 0x0a, 0x56, 0x28, 0xea, 0x11,
+	0xd1, 0x76, 0x8e, 0x97, 0x24, 0x05, 0xa7, 0x90, 0x14, 0x9c, 0xd7, 0x8a, 0x5f, 0xf9, 0xf6, 0xe4,
+	0x81, 0x7f, 0xfc, 0xf6, 0xe4, 0x01, 0x65, 0x1d, 0x94, 0x4e, 0xd3, 0x41, 0x6d, 0xf7, 0x31, 0x38,
+	0x1a, 0x00, 0xc6, 0xe6, 0xa3, 0x1e, 0x31, 0x22, 0xed, 0xa9, 0x9b, 0x46, 0xe0, 0x46, 0x64, 0x76,
+	0x11, 0x02, 0xd3, 0x01, 0xd3, 0x09, 0x4c, 0x0c, 0xd2, 0x17, 0x81, 0xf1, 0xe9, 0x84, 0x04, 0xa6,
+	0x33, 0xbc, 0x85, 0xb9, 0xca, 0x69, 0x38, 0xc5, 0x00, 0xef, 0x6d, 0x3b, 0xb6, 0xe7, 0xd5, 0x28,
+	0x3b, 0xe0, 0x90, 0x2e, 0xe5, 0xaf, 0xc4, 0x39, 0x97, 0xa8, 0xc5, 0x61, 0x26, 0x61, 0xcc, 0xad,
+	0xe9, 0xee, 0xb6, 0xc6, 0x44, 0x96, 0x8d, 0x30, 0xa0, 0x02, 0x2b, 0xba, 0xe3, 0x97, 0x90, 0x59,
+	0x98, 0x88, 0x34, 0xd0, 0xd8, 0xf6, 0xd3, 0x2d, 0x83, 0x32, 0x12, 0x07, 0xd4, 0xe3, 0x61, 0xd3,
+	0x39, 0x51, 0x45, 0xfe, 0x3f, 0x94, 0x2c, 0xfa, 0xd4, 0xd3, 0x1c, 0xda, 0xa8, 0x51, 0xcb, 0x74,
+	0xb7, 0x35, 0x43, 0xb7, 0x2a, 0x3e, 0xb1, 0x94, 0xa9, 0xf3, 0xb1, 0x59, 0x79, 0x86, 0x9b, 0x77,
+	0x33, 0xc2, 0xbc, 0x9b, 0xb9, 0x27, 0xec, 0xbf, 0xf9, 0xa2, 0x2f, 0xab, 0x5f, 0xff, 0xd1, 0xa4,
+	0xa4, 0x9e, 0xf0, 0x51, 0x54, 0x01, 0xb2, 0x20, 0x30, 0x94, 0x8f, 0xc3, 0x45, 0x46, 0x92, 0x4a,
+	0xab, 0xa6, 0xeb, 0x51, 0x87, 0x56, 0x84, 0x8c, 0xc4, 0x74, 0x05, 0x72, 0x60, 0x09, 0x2e, 0x65,
+	0x6a, 0x8d, 0x1c, 0x39, 0x01, 0xc3, 0xa8, 0xaf, 0x24, 0xa6, 0x42, 0xf0, 0x4b, 0xb9, 0x0d, 0x1f,
+	0x63, 0x30, 0x73, 0xb5, 0xda, 0x86, 0x6e, 0x3a, 0xee, 0x03, 0xbd, 0xe6, 0xe3, 0xf8, 0x8b, 0x30,
+	0xbf, 0x17, 0x22, 0x66, 0xb4, 0x7d, 0x7e, 0x4b, 0x82, 0x8b, 0x59, 0xe0, 0x70, 0x52, 0x8f, 0xe1,
+	0x58, 0x43, 0x37, 0x1d, 0x5f, 0x3d, 0xfb, 0x26, 0x2a, 0x93, 0x08, 0x3c, 0xe7, 0x97, 0xb3, 0xe9,
+	0x04, 0xdd, 0x74, 0xf8, 0x10, 0xfe, 0x08, 0x81, 0xc4, 0x59, 0x21, 0x2f, 0x0e, 0x37, 0x62, 0x4d,
+	0x94, 0x9f, 0x4b, 0x70, 0xae, 0x6b, 0x2f, 0xb2, 0xdc, 0x56, 0x2f, 0x9c, 0xfe, 0xd9, 0xfb, 0x93,
+	0x27, 0xf9, 0xb6, 0x49, 0xb6, 0x48, 0x51, 0x10, 0xcb, 0x29, 0xdb, 0xaf, 0x90, 0xc4, 0x49, 0xb6,
+	0x48, 0xd9, 0x87, 0x37, 0xe1, 0x60, 0xd0, 0x6a, 0x87, 0xee, 0xa1, 0xb8, 0x9d, 0x99, 0x09, 0xcd,
+	0xee, 0x19, 0x6e, 0x76, 0xcf, 0x6c, 0x34, 0xb7, 0x6a, 0xa6, 0x71, 0x8b, 0xee, 0xa9, 0xc1, 0x52,
+	0xdd, 0xa2, 0x7b, 0xca, 0x38, 0x10, 0xb6, 0x2e, 0x4c, 0x8d, 0x07, 0x32, 0xf4, 0x4b, 0x70, 0x3c,
+	0x56, 0x8a, 0xcb, 0xb2, 0x06, 0xc3, 0xec, 0x14, 0x71, 0xd1, 0x34, 0xbd, 0x94, 0x71, 0x2d, 0xfc,
+	0x2e, 0xa8, 0x93, 0x11, 0x40, 0xb9, 0x83, 0xf2, 0x10, 0xb3, 0xee, 0xd6, 0x1b, 0x1e, 0xad, 0xac,
+	0x59, 0x81, 0xa6, 0xc8, 0x6e, 0x5b, 0x3f, 0x86, 0x4b, 0x99, 0xe0, 0x02, 0xe3, 0xf1, 0x85, 0xa8,
+	0xb1, 0x94, 0x58, 0x2f, 0x2a, 0xf6, 0xc2, 0xe9, 0x88, 0xd5, 0x14, 0x5f, 0x40, 0xea, 0x2a, 0x73,
+	0x70, 0x36, 0x36, 0x64, 0x0f, 0xb3, 0xfe, 0xc6, 0x08, 0x4c, 0xb5, 0xc1, 0x08, 0xfe, 0xeb, 0xf7,
+	0x28, 0x4a, 0x4a, 0x48, 0x21, 0xa7, 0x84, 0x90, 0x12, 0x0c, 0x31, 0x6b, 0x92, 0xc9, 0xd6, 0xc0,
+	0x7c, 0xa1, 0x24, 0xa9, 0xbc, 0x80, 0xbc, 0x0a, 0x83, 0x8e, 0xaf, 0xe3, 0x06, 0xd9, 0x6c, 0x2e,
+	0xf8, 0xeb, 0xfb, 0xb7, 0xef, 0x4f, 0x9e, 0xe6, 0xf6, 0xb3, 0x5b, 0xd9, 0x99, 0x31, 0xed, 0x72,
+	0x5d, 0xf7, 0xb6, 0x67, 0x6e, 0xd3, 0xaa, 0x6e, 0xec, 0x2d, 0x52, 0xa3, 0x24, 0xa9, 0xac, 0x0b,
+	0xb9, 0x00, 0x87, 0x83, 0x59, 0x71, 0xf4, 0x21, 0xa6, 0x5f, 0x0f, 0x89, 0x52, 0x66, 0xa5, 0x92,
+	0x87, 0x50, 0x0a, 0x9a, 0x19, 0x76, 0xbd, 0x6e, 0xba, 0xae, 0x6f, 0xca, 0xb0, 0x51, 0x87, 0xd9,
+	0xa8, 0xe7, 0x33, 0x8c, 0xaa, 0x9e, 0x10, 0x20, 0x0b, 0x01, 0x86, 0xea, 0xcf, 0xe2, 0x21, 0x94,
+	0x02, 0xd6, 0x26, 0xe1, 0x47, 0x72, 0xc0, 0x0b, 0x90, 0x04, 0xfc, 0x2d, 0x18, 0xab, 0x50, 0xd7,
+	0x70, 0xcc, 0x06, 0xbb, 0x5f, 0x14, 0x19, 0xe7, 0xcf, 0x8b, 0xfb, 0x85, 0xb8, 0xa8, 0x8a, 0xcb,
+	0xc5, 0x62, 0xd8, 0x14, 0xf7, 0x4a, 0xb4, 0x37, 0x79, 0x08, 0xa7, 0x82, 0xb9, 0xda, 0x0d, 0xea,
+	0x30, 0xab, 0x5d, 0xc8, 0x03, 0xb3, 0xad, 0xe7, 0xcf, 0xfd, 0xe0, 0xdd, 0xcb, 0x2f, 0x20, 0x7a,
+	0x20, 0x3f, 0x28, 0x07, 0x9b, 0x9e, 0x63, 0x5a, 0x55, 0xf5, 0xa4, 0xc0, 0x58, 0x47, 0x08, 0x21,
+	0x26, 0x27, 0x60, 0xf8, 0x0b, 0xba, 0x59, 0xa3, 0x15, 0x66, 0x8e, 0x17, 0x55, 0xfc, 0x22, 0xaf,
+	0xc1, 0xb0, 0xeb, 0xe9, 0x5e, 0xd3, 0x65, 0xc6, 0xf4, 0xe1, 0x59, 0xa5, 0xdd, 0xf4, 0xe7, 0x6d,
+	0xab, 0xb2, 0xc9, 0x5a, 0xaa, 0xd8, 0x83, 0xdc, 0x83, 0x40, 0x1a, 0x35, 0xcf, 0xde, 0xa1, 0x16,
+	0x37, 0xb5, 0x47, 0xe7, 0x2f, 0x21, 0x57, 0x27, 0x5a, 0xb9, 0xba, 0x66, 0x79, 0x3f, 0x78, 0xf7,
+	0x32, 0xe0, 0x20, 0x6b, 0x96, 0xa7, 0x1e, 0x16, 0x18, 0xf7, 0x18, 0x84, 0x2f, 0x3a, 0x01, 0x2a,
+	0x17, 0x9d, 0x43, 0x5c, 0x74, 0x44, 0x29, 0x17, 0x9d, 0x4f, 0xc2, 0x49, 0xdc, 0xbd, 0xd4, 0xd5,
+	0x8c, 0xa6, 0xe3, 0xf8, 0x17, 0x2f, 0x66, 0x8f, 0x32, 0xc3, 0xbc, 0xa8, 0x4e, 0x04, 0xd5, 0x0b,
+	0xbc, 0x96, 0x99, 0xa4, 0xca, 0x57, 0x24, 0x98, 0x6c, 0xbb, 0xaf, 0x51, 0x7d, 0x50, 0x80, 0x50,
+	0x33, 0xe0, 0xb9, 0xb4, 0x94, 0x49, 0x17, 0x76, 0xdb, 0xed, 0x6a, 0x04, 0x58, 0x79, 0x0c, 0x57,
+	0x52, 0x6e, 0xc0, 0x41, 0xdb, 0x55, 0xdd, 0xbd, 0x67, 0xe3, 0x17, 0xdd, 0x1f, 0xc3, 0x55, 0x79,
+	0x00, 0x57, 0x73, 0x0c, 0x89, 0xec, 0x38, 0x17, 0x51, 0x31, 0x66, 0x45, 0x28, 0xcf, 0xb1, 0x50,
+	0xd1, 0x31, 0xa3, 0xf4, 0x52, 0xba, 0x99, 0x1b, 0xdf, 0x33, 0x59, 0x55, 0x67, 0x2a, 0x9d, 0x85,
+	0xec, 0x74, 0x56, 0xe1, 0xe3, 0xd9, 0xa6, 0x83, 0x24, 0xbe, 0x8c, 0xaa, 0x4e, 0xca, 0xae, 0x15,
+	0x58, 0x07, 0x45, 0x41, 0x0d, 0x3f, 0x5f, 0xb3, 0x8d, 0x1d, 0xf7, 0xbe, 0xe5, 0x99, 0xb5, 0xbb,
+	0xf4, 0x29, 0x97, 0x35, 0x71, 0xda, 0xbe, 0x05, 0xe7, 0x3a, 0xb4, 0xc1, 0x19, 0x7c, 0x02, 0x4e,
+	0x6e, 0xb1, 0x7a, 0xad, 0xe9, 0x37, 0xd0, 0x98, 0xc5, 0xc9, 0xe5, 0x59, 0x62, 0xd7, 0xdc, 0xf1,
+	0xad, 0x94, 0xee, 0xca, 0x1c, 0x5a, 0xdf, 0x0b, 0x01, 0xeb, 0x96, 0x1d, 0xbb, 0xbe, 0x80, 0x6e,
+	0x07, 0xc1, 0xee, 0x98, 0x6b, 0x42, 0x8a, 0xbb, 0x26, 0x94, 0x65, 0x38, 0xdf, 0x11, 0x22, 0x34,
+	0xad, 0x3b, 0x9f, 0x76, 0xd7, 0xe0, 0x54, 0x0c, 0x87, 0xfb, 0x62, 0xb2, 0x9e, 0x95, 0x7f, 0x3a,
+	0x9c, 0xe6, 0xc0, 0xca, 0x3c, 0x7a, 0xcc, 0x31, 0x53, 0x88, 0x3b, 0x66, 0xce, 0xc3, 0x21, 0xfb,
+	0x89, 0x15, 0x11, 0xa4, 0x01, 0x56, 0x7f, 0x90, 0x15, 0x0a, 0x05, 0x19, 0xf8, 0x31, 0x06, 0xdb,
+	0xf9, 0x31, 0x86, 0xf6, 0xd3, 0x8f, 0xf1, 0x08, 0xc6, 0x4c, 0xcb, 0xf4, 0x34, 0xb4, 0xb7, 0x86,
+	0xa7, 0xa4, 0xcc, 0x3a, 0x26, 0x58, 0x27, 0xcb, 0xf4, 0x4c, 0xbd, 0x66, 0xfe, 0xb2, 0x9e, 0xb8,
+	0xbd, 0x83, 0x8f, 0xcc, 0xbe, 0x5d, 0x52, 0x87, 0x71, 0xee, 0x2b, 0x72, 0xb7, 0xf5, 0x86, 0x69,
+	0x55, 0xc5, 0x80, 0x23, 0x6c, 0xc0, 0x4f, 0x65, 0x33, 0xf0, 0x7c, 0x80, 0x4d, 0xde, 0x3f, 0x32,
+	0x0c, 0x69, 0x24, 0xcb, 0xdd, 0xf6, 0x2e, 0x89, 0xe2, 0xf3, 0x71, 0x49, 0xc4, 0x04, 0x7b, 0x34,
+	0xe1, 0x73, 0x9b, 0x86, 0xa3, 0x0d, 0x6a, 0x55, 0x7c, 0xaa, 0x03, 0xd1, 0x00, 0xd6, 0xe6, 0x30,
+	0x96, 0x2f, 0xa0, 0x84, 0x58, 0x30, 0xe1, 0xf1, 0xfb, 0x64, 0xc0, 0x22, 0x3e, 0xed, 0xb1, 0x1c,
+	0xd3, 0xbe, 0x17, 0x20, 0x44, 0xa7, 0xed, 0xa5, 0x94, 0x92, 0xcf, 0xc0, 0x49, 0xfa, 0xb4, 0x41,
+	0x0d, 0xdf, 0x87, 0x62, 0xf2, 0x65, 0xd4, 0xb6, 0x99, 0x03, 0xa3, 0x74, 0x10, 0x2f, 0x94, 0xe6,
+	0x96, 0x31, 0x63, 0xd8, 0x0e, 0x9d, 0xe1, 0xe4, 0xf8, 0x03, 0xac, 0x46, 0x9d, 0x1f, 0x13, 0x02,
+	0x00, 0xc5, 0x80, 0x57, 0x2a, 0xf3, 0x89, 0xd3, 0x0d, 0x1d, 0xc7, 0xfe, 0x75, 0x34, 0xf3, 0x56,
+	0xdc, 0x81, 0xa9, 0xf6, 0x18, 0xb8, 0x1f, 0x57, 0x40, 0xf8, 0x9f, 0x35, 0xcf, 0xac, 0x0b, 0x5f,
+	0x76, 0xb6, 0x7b, 0xf0, 0x58, 0x35, 0x04, 0x54, 0x1e, 0xa2, 0x99, 0x7d, 0x97, 0xea, 0x8e, 0x5f,
+	0x60, 0x37, 0xbd, 0x0d, 0xdd, 0xd8, 0xa1, 0x5e, 0x60, 0x66, 0x7f, 0x0a, 0x86, 0x9f, 0x98, 0xde,
+	0xb6, 0x69, 0xe1, 0x20, 0xa7, 0x5a, 0x06, 0x59, 0xc4, 0xb7, 0x14, 0x3e, 0xc6, 0xb7, 0xfc, 0x31,
+	0xb0, 0x8b, 0xd2, 0x84, 0xc9, 0xb6, 0xf0, 0x48, 0x8a, 0x0a, 0x23, 0x0d, 0x5e, 0x84, 0x47, 0xfd,
+	0x6c, 0xc6, 0x6b, 0x8f, 0xdf, 0x07, 0x31, 0x71, 0x51, 0x04, 0x90, 0xf2, 0x27, 0x12, 0x1c, 0x8a,
+	0x35, 0xe8, 0xae, 0xc0, 0x5e, 0x00, 0x30, 0xb6, 0x75, 0xcb, 0xa2, 0xb5, 0x50, 0x85, 0x8d, 0x62,
+	0xc9, 0x5a, 0xc5, 0xf7, 0xc1, 0xba, 0x3e, 0x43, 0x7c, 0x5f, 0xc5, 0x00, 0xf7, 0x7b, 0x8a, 0x6f,
+	0xf2, 0x06, 0x1c, 0xf3, 0xf8, 0x30, 0x5a, 0xf0, 0xee, 0x54, 0x1a, 0xcc, 0xb1, 0x22, 0x47, 0xb1,
+	0x7b, 0x50, 0xa7, 0x9c, 0x41, 0x6d, 0x7c, 0x5b, 0x6f, 0x5a, 0xc6, 0xf6, 0x82, 0xde, 0xd0, 0x0d,
+	0xd3, 0xdb, 0x13, 0x27, 0xda, 0xf7, 0x84, 0xf3, 0x3e, 0x59, 0x8d, 0x2c, 0xfd, 0x7f, 0x70, 0xa2,
+	0xae, 0x3f, 0xd5, 0x6a, 0xac, 0x36, 0xf2, 0x0c, 0xe5, 0x8a, 0xb3, 0xac, 0xae, 0x3f, 0xbd, 0x8d,
+	0x95, 0x42, 0xca, 0x5c, 0x72, 0x19, 0x48, 0x4a, 0x8f, 0x02, 0xeb, 0x71, 0xac, 0x96, 0xd6, 0xdc,
+	0xa1, 0x75, 0xdd, 0xb4, 0xd8, 0x06, 0xc7, 0x29, 0x20, 0x6f, 0x8e, 0x05, 0x35, 0x62, 0x6e, 0xca,
+	0x02, 0x4a, 0x75, 0x4c, 0x9b, 0x99, 0x0d, 0x5a, 0x33, 0xad, 0xec, 0x5b, 0xe3, 0x57, 0x85, 0xf3,
+	0x2d, 0x1d, 0x25, 0x78, 0xe9, 0x29, 0x36, 0xb0, 0xac, 0x24, 0xe5, 0xd0, 0x20, 0x69, 0xa0, 0xe2,
+	0xe4, 0x10, 0x80, 0xca, 0x3a, 0x9a, 0x36, 0x2d, 0x56, 0x26, 0xeb, 0xbd, 0xe1, 0xd8, 0x5f, 0xa0,
+	0x4c, 0x4b, 0x66, 0xa6, 0xe9, 0x7b, 0x05, 0xb8, 0x9c, 0x11, 0xb1, 0x83, 0x7d, 0x7c, 0x33, 0x1b,
+	0x85, 0x1c, 0x8c, 0x56, 0x5a, 0xc6, 0x42, 0x3a, 0x23, 0xc0, 0x31, 0x36, 0x16, 0xf6, 0x99, 0x8d,
+	0xe4, 0x1a, 0xc8, 0x0e, 0xad, 0xdb, 0xbb, 0xb4, 0x92, 0xe6, 0x1f, 0x18, 0x60, 0x26, 0x6e, 0x09,
+	0x5b, 0xb4, 0x3a, 0x07, 0xfe, 0x5a, 0x02, 0xb9, 0x3d, 0x2d, 0xff, 0xe3, 0x77, 0xfa, 0xf1, 0xd8,
+	0x9d, 0x5e, 0xdc, 0xe7, 0xcf, 0xc3, 0x21, 0x71, 0x51, 0xe2, 0xb5, 0xfc, 0xa5, 0xe9, 0x20, 0x16,
+	0x32, 0xb6, 0x29, 0xaf, 0xa2, 0x80, 0xdf, 0xb1, 0x2b, 0xcd, 0x1a, 0x9d, 0x33, 0x0c, 0xbb, 0x69,
+	0x79, 0xee, 0x66, 0xb3, 0x5e, 0xd7, 0x1d, 0xb1, 0xff, 0x7d, 0xfc, 0x9a, 0x59, 0x37, 0x3d, 0x46,
+	0xd4, 0x21, 0x95, 0x7f, 0x28, 0x7f, 0x26, 0xc1, 0x78, 0xac, 0xdb, 0xbc, 0x5e, 0x63, 0x0e, 0x54,
+	0x02, 0x83, 0x96, 0x8e, 0x87, 0xc4, 0xa8, 0xca, 0xfe, 0x27, 0xb3, 0x30, 0x12, 0xb7, 0xeb, 0x4b,
+	0x3f, 0x78, 0xf7, 0xf2, 0x38, 0xde, 0x0b, 0xe3, 0x97, 0x5a, 0xd1, 0x90, 0x50, 0x18, 0xd9, 0xe2,
+	0x90, 0x6c, 0x81, 0xfc, 0xa3, 0x20, 0xfa, 0x98, 0x27, 0xae, 0xaa, 0x0b, 0xb6, 0x69, 0xcd, 0x5f,
+	0xf1, 0xd7, 0xfb, 0x3b, 0x3f, 0x9a, 0x9c, 0xae, 0x9a, 0xde, 0x76, 0x73, 0x6b, 0xc6, 0xb0, 0xeb,
+	0xf8, 0xc0, 0x8c, 0x7f, 0x2e, 0xbb, 0x95, 0x9d, 0xb2, 0xb7, 0xd7, 0xa0, 0x2e, 0xeb, 0xe0, 0xaa,
+	0x02, 0x5b, 0x79, 0x77, 0x00, 0x8d, 0xea, 0x36, 0x3c, 0x08, 0x77, 0xb9, 0x8e, 0x55, 0xb8, 0x07,
+	0xb2, 0x89, 0x67, 0x1a, 0x8b, 0x84, 0x78, 0x0a, 0x40, 0xb2, 0x0e, 0x43, 0x8f, 0x6a, 0xf6, 0x13,
+	0x9f, 0x39, 0x3e, 0xf2, 0x4b, 0x99, 0x90, 0x97, 0x9b, 0x56, 0x65, 0xb9, 0x66, 0x3f, 0x51, 0xa9,
+	0x61, 0x3b, 0x15, 0xc4, 0xe4, 0x38, 0xc4, 0x82, 0x83, 0x9e, 0xed, 0xe9, 0x35, 0xcd, 0xb4, 0xfc,
+	0x82, 0xe7, 0xc1, 0xc0, 0x31, 0x36, 0xc0, 0x1a, 0xc3, 0x27, 0x0d, 0x38, 0xc4, 0xc7, 0xb3, 0x9b,
+	0x1e, 0x1b, 0x70, 0x70, 0xff, 0x07, 0xe4, 0x14, 0xad, 0xf3, 0x01, 0x94, 0x45, 0x94, 0x5c, 0xb1,
+	0x1d, 0xf9, 0x01, 0xb3, 0xac, 0x9b, 0xb5, 0xa6, 0x93, 0x4b, 0xc3, 0x2b, 0x9d, 0x60, 0x70, 0xf1,
+	0xdf, 0x82, 0x91, 0x47, 0xbc, 0x08, 0x35, 0xfc, 0x6b, 0xb9, 0x6c, 0xf7, 0x18, 0xa8, 0x30, 0x1e,
+	0x10, 0x50, 0x59, 0x4a, 0xcc, 0x60, 0x55, 0x77, 0xb7, 0xd9, 0xbd, 0xd5, 0xab, 0x53, 0xcb, 0xcb,
+	0x4c, 0xc9, 0xef, 0x14, 0xe0, 0x7c, 0x47, 0x9c, 0xf0, 0x7a, 0x2f, 0x4c, 0xb9, 0x6d, 0xdd, 0xe5,
+	0xd7, 0xcd, 0x83, 0x81, 0x91, 0xe6, 0x77, 0xf2, 0xc7, 0xda, 0x32, 0x2d, 0xdd, 0xd9, 0xe3, 0x2d,
+	0x0a, 0xac, 0x05, 0xf0, 0x22, 0xd6, 0xe0, 0x1a, 0xc8, 0xcd, 0x46, 0x45, 0xf7, 0xed, 0x59, 0xd7,
+	0xb4, 0x0c, 0xaa, 0x39, 0xec, 0x75, 0x82, 0x9b, 0x65, 0x4c, 0x0b, 0x15, 0xd5, 0x12, 0xb6, 0xd8,
+	0xf4, 0x1b, 0xa8, 0x91, 0x7a, 0xdf, 0x39, 0xe5, 0xdf, 0x6d, 0x69, 0x85, 0x69, 0xa4, 0xa2, 0x8a,
+	0x5f, 0x44, 0x07, 0x30, 0x82, 0xf9, 0x96, 0x86, 0x72, 0x5c, 0x59, 0xd2, 0x49, 0x16, 0x67, 0x4c,
+	0x08, 0xaa, 0xbc, 0x08, 0x1f, 0x89, 0xdf, 0x3a, 0x1d, 0xca, 0xdc, 0x66, 0xe2, 0x59, 0x36, 0x7c,
+	0x75, 0xb9, 0xd0, 0xa5, 0x1d, 0x72, 0xd3, 0x7f, 0x49, 0x4f, 0xb8, 0x99, 0xc3, 0x82, 0x16, 0xf3,
+	0x9c, 0xdb, 0x88, 0xbe, 0x5f, 0x2d, 0xbb, 0x57, 0xf9, 0x29, 0x4c, 0xb5, 0xc7, 0xc0, 0x59, 0xdc,
+	0x83, 0x21, 0xd7, 0x2f, 0x40, 0xe1, 0x7c, 0x25, 0x5f, 0xbc, 0x47, 0x08, 0x28, 0x74, 0x08, 0x03,
+	0x53, 0xee, 0xe2, 0xec, 0x43, 0xaf, 0xca, 0xc2, 0x83, 0xc4, 0xc9, 0x70, 0x29, 0x1a, 0x74, 0x10,
+	0x7f, 0xe8, 0x3b, 0xba, 0x9b, 0xf0, 0x59, 0x2a, 0x3f, 0x1d, 0x84, 0xa9, 0xf6, 0x80, 0x48, 0x4a,
+	0x1e, 0xc4, 0xd4, 0x67, 0xc6, 0x42, 0xea, 0x33, 0x63, 0xc4, 0xf3, 0x39, 0x90, 0xdb, 0xf3, 0xb9,
+	0x00, 0xc3, 0xe8, 0xf0, 0x1c, 0xcc, 0xef, 0xf0, 0xc4, 0xae, 0xe1, 0x21, 0x3d, 0x14, 0x3d, 0xa4,
+	0x43, 0x47, 0xed, 0x70, 0xcc, 0x51, 0x7b, 0x16, 0xc0, 0xb3, 0xeb, 0x5b, 0xae, 0x67, 0x5b, 0xb4,
+	0xc2, 0xae, 0xef, 0x45, 0x35, 0x52, 0x42, 0xae, 0xc3, 0xe9, 0x40, 0x6c, 0x2a, 0x76, 0x73, 0xab,
+	0x46, 0x35, 0xd7, 0xac, 0x5a, 0x5a, 0xcd, 0xae, 0x56, 0x69, 0x85, 0xdd, 0xbf, 0x8b, 0x6a, 0xe0,
+	0x6d, 0x5f, 0x64, 0x2d, 0x36, 0xcd, 0xaa, 0x75, 0x9b, 0xd5, 0x93, 0x77, 0x24, 0x38, 0x6e, 0x37,
+	0x3d, 0xd7, 0xd3, 0xf9, 0x85, 0x99, 0x87, 0x3a, 0xf8, 0x9e, 0xe7, 0x01, 0x66, 0x7a, 0xa4, 0x69,
+	0xed, 0x45, 0x6a, 0x30, 0xc5, 0xfd, 0x12, 0x2a, 0xee, 0x4b, 0x19, 0x14, 0x37, 0xf6, 0x71, 0x55,
+	0x12, 0x19, 0x8d, 0x3f, 0x5c, 0xba, 0x44, 0x87, 0xd1, 0xd0, 0xee, 0x07, 0x36, 0xf2, 0xf5, 0x4c,
+	0x92, 0xdb, 0xe2, 0xe6, 0x43, 0x21, 0x42, 0xf1, 0x0d, 0x51, 0x95, 0xaf, 0x0e, 0x40, 0xa9, 0x5d,
+	0xeb, 0xbe, 0x9c, 0x4c, 0x41, 0x84, 0xd5, 0x40, 0xbf, 0x11, 0x56, 0xa7, 0xa0, 0x68, 0x37, 0xb8,
+	0x67, 0x00, 0xf5, 0xe1, 0x88, 0xcd, 0x5f, 0xba, 0xfc, 0x2b, 0x4f, 0x30, 0xc1, 0x40, 0xf6, 0x99,
+	0xfc, 0x14, 0xd5, 0x63, 0x46, 0x8b, 0x19, 0xfa, 0x22, 0x1c, 0xd9, 0xd6, 0x5d, 0xcd, 0xb3, 0x45,
+	0x63, 0x8a, 0x42, 0x75, 0x68, 0x3b, 0xea, 0xe8, 0x4d, 0x8d, 0x3e, 0x18, 0x49, 0x8d, 0x3e, 0x20,
+	0xb7, 0xe1, 0x48, 0xf2, 0x25, 0xa5, 0x98, 0xdd, 0x67, 0x7a, 0xd8, 0x88, 0xb9, 0x5f, 0x95, 0x69,
+	0x78, 0x31, 0xae, 0x55, 0x99, 0xaf, 0xe3, 0x7e, 0xa3, 0xea, 0xe8, 0x15, 0xba, 0x51, 0xd3, 0x83,
+	0x00, 0x36, 0xe5, 0xcb, 0x12, 0x7c, 0xb4, 0x6b, 0x53, 0xd4, 0x18, 0x9f, 0x87, 0x62, 0x93, 0x97,
+	0x0b, 0xc3, 0x2c, 0xdf, 0xe1, 0x1c, 0x83, 0x16, 0x96, 0x99, 0x40, 0x54, 0xfe, 0x42, 0x82, 0x89,
+	0xd4, 0x96, 0x7d, 0x89, 0x4f, 0xcc, 0x91, 0x35, 0x90, 0x70, 0x64, 0x7d, 0x06, 0x06, 0x1b, 0x35,
+	0xdd, 0xc2, 0x2b, 0xfd, 0x8d, 0xde, 0x89, 0xf1, 0xf9, 0x84, 0x04, 0x31, 0xc4, 0x96, 0xe3, 0x4f,
+	0xdc, 0x74, 0x36, 0x9f, 0x98, 0x9e, 0xb1, 0x4d, 0x03, 0xf6, 0x7f, 0x45, 0x82, 0x0b, 0x5d, 0x1a,
+	0x22, 0xf3, 0x35, 0x28, 0xba, 0x58, 0x56, 0x92, 0x72, 0x6c, 0xe1, 0x30, 0x9c, 0x21, 0x0e, 0x2d,
+	0xf8, 0x2f, 0x40, 0x95, 0xff, 0x90, 0xa0, 0xd4, 0xae, 0xf1, 0xff, 0x92, 0x1d, 0xfc, 0x59, 0x18,
+	0xe6, 0xd3, 0x2d, 0x0d, 0xf6, 0x60, 0xb3, 0xa4, 0xd2, 0x8f, 0x80, 0xca, 0x47, 0x12, 0xb6, 0x21,
+	0x5f, 0xde, 0xa5, 0xa7, 0x0d, 0xd3, 0x31, 0xc3, 0xe5, 0x7a, 0x47, 0x82, 0xf3, 0x1d, 0x9b, 0x85,
+	0x57, 0x18, 0x8a, 0x65, 0xb9, 0xae, 0x30, 0x29, 0xb0, 0x42, 0xd7, 0x06, 0x80, 0xca, 0x97, 0x0b,
+	0x30, 0x9e, 0xd6, 0xf0, 0xf9, 0xed, 0x93, 0x25, 0x18, 0x63, 0xa3, 0xef, 0x71, 0x9f, 0x64, 0x1e,
+	0x0f, 0x18, 0xf0, 0x8e, 0x7e, 0x15, 0x59, 0xe7, 0xee, 0x34, 0x7c, 0x88, 0xe1, 0x15, 0xa5, 0xa1,
+	0xec, 0xbe, 0xc7, 0x23, 0x7e, 0x6f, 0xf6, 0x4e, 0xc3, 0x09, 0x56, 0xa6, 0xd0, 0xc7, 0x29, 0x62,
+	0x96, 0xde, 0x68, 0xd2, 0x66, 0x3c, 0xac, 0xe9, 0xcf, 0x0b, 0x30, 0xd9, 0xb6, 0xc9, 0xff, 0xe1,
+	0xd8, 0x26, 0xf2, 0x18, 0x26, 0x84, 0x0f, 0x9e, 0xcf, 0x4d, 0xb8, 0x5a, 0x07, 0x73, 0x44, 0x00,
+	0xce, 0xdb, 0x4d, 0xcb, 0xa0, 0x95, 0x4d, 0x1f, 0x80, 0x1b, 0xa7, 0x41, 0x04, 0x20, 0xc7, 0x8e,
+	0xd4, 0xb8, 0xc1, 0x3b, 0xd4, 0x6d, 0xdd, 0xf5, 0x1e, 0x6c, 0x2e, 0xf0, 0xe2, 0xcc, 0xd6, 0xf5,
+	0x77, 0x25, 0x20, 0x41, 0xaf, 0xf0, 0x28, 0x9d, 0x85, 0x09, 0x23, 0x8c, 0x54, 0xb0, 0xdc, 0x84,
+	0x25, 0x7a, 0x3c, 0x8c, 0x40, 0xb0, 0x5c, 0x71, 0x56, 0xce, 0xc2, 0x44, 0x24, 0xfc, 0x20, 0xd2,
+	0x87, 0xcb, 0xf4, 0xf1, 0x30, 0xac, 0x20, 0xec, 0x53, 0x82, 0x91, 0xba, 0x6d, 0x99, 0x3b, 0xe8,
+	0xbb, 0x19, 0x55, 0xc5, 0x67, 0x68, 0x2e, 0x0e, 0x46, 0xcc, 0x45, 0xe5, 0x8f, 0x0b, 0x20, 0xa7,
+	0x51, 0x8b, 0x32, 0xb3, 0xe1, 0x47, 0xf4, 0xf8, 0x25, 0x78, 0x11, 0xc8, 0xa6, 0xd4, 0x36, 0xa9,
+	0x15, 0x62, 0x85, 0x81, 0x3d, 0xfe, 0x17, 0xf9, 0x42, 0xd4, 0x1c, 0xe7, 0x37, 0x3a, 0xe1, 0xa4,
+	0xc8, 0xb6, 0x98, 0xad, 0xcc, 0xc5, 0x11, 0x42, 0x6b, 0xfe, 0x3e, 0x87, 0x25, 0x9f, 0x07, 0x2e,
+	0xde, 0x9a, 0x6e, 0xec, 0xb8, 0xa5, 0x81, 0xfd, 0x18, 0x64, 0x94, 0x01, 0xce, 0x19, 0x3b, 0x6e,
+	0x8b, 0xbf, 0x20, 0x2d, 0xde, 0xb0, 0xbb, 0xbc, 0xfc, 0x67, 0x01, 0x94, 0x4e, 0x30, 0xb8, 0x10,
+	0x5e, 0xbb, 0x17, 0x26, 0xa9, 0xcf, 0x17, 0x26, 0xa4, 0x2b, 0xfd, 0x9d, 0xe9, 0xe3, 0x40, 0xaa,
+	0x35, 0x7b, 0x4b, 0xaf, 0x69, 0x51, 0xcd, 0x51, 0x60, 0x36, 0xe0, 0x51, 0x5e, 0xb3, 0x19, 0xea,
+	0x8f, 0x84, 0x82, 0x19, 0xc8, 0xae, 0x60, 0x06, 0x7b, 0x53, 0x30, 0x43, 0xfb, 0x10, 0x3c, 0xb9,
+	0x81, 0xce, 0xeb, 0x0d, 0xae, 0x09, 0x52, 0xde, 0x0e, 0x51, 0x9a, 0x32, 0xaf, 0xe8, 0xb7, 0x24,
+	0x98, 0xc9, 0x0a, 0x89, 0xab, 0xfb, 0x08, 0x46, 0xc4, 0x56, 0xc8, 0x15, 0xc5, 0xd8, 0x76, 0x00,
+	0x97, 0x8f, 0x20, 0x3c, 0x43, 0x08, 0xae, 0xfc, 0x53, 0x01, 0xce, 0x75, 0xed, 0xd4, 0xfd, 0x7c,
+	0xb5, 0x80, 0x04, 0x8e, 0xe0, 0x50, 0x12, 0x0b, 0x7d, 0x3e, 0xd1, 0xe2, 0x64, 0x8f, 0x09, 0x77,
+	0x72, 0x28, 0x86, 0x16, 0x10, 0x71, 0x08, 0x44, 0xc6, 0x1b, 0xd8, 0xa7, 0xf1, 0x10, 0x3a, 0x32,
+	0xde, 0x12, 0x8c, 0x71, 0x8e, 0xf5, 0x60, 0x07, 0xf0, 0x8e, 0x7e, 0x55, 0xe0, 0xac, 0xd9, 0xf4,
+	0xf4, 0x1a, 0xbd, 0x45, 0xf7, 0xe6, 0x5c, 0xff, 0x46, 0x5d, 0xa7, 0x56, 0x0e, 0x67, 0xcd, 0x37,
+	0x25, 0x98, 0x6a, 0x0f, 0x12, 0x84, 0xc3, 0x4e, 0xb8, 0x7e, 0xb5, 0xef, 0xec, 0xd7, 0xf4, 0xb0,
+	0x41, 0x49, 0xca, 0xa1, 0xf2, 0x5a, 0x07, 0x10, 0x87, 0xa4, 0xdb, 0x3a, 0xb4, 0xf2, 0x9b, 0x12,
+	0x90, 0xd6, 0x1e, 0x39, 0xc2, 0xb4, 0x53, 0x2f, 0x8d, 0x85, 0xf4, 0x4b, 0xe3, 0x15, 0x18, 0xf7,
+	0x1c, 0x5f, 0x1d, 0x0b, 0xef, 0x20, 0xbe, 0x75, 0x73, 0x0d, 0x43, 0xb0, 0x8e, 0xf9, 0x05, 0xf1,
+	0x19, 0xfb, 0x6b, 0x52, 0x42, 0x39, 0x73, 0xd1, 0x5e, 0x35, 0x5d, 0xcf, 0x76, 0xf6, 0xb2, 0x72,
+	0x7f, 0xdf, 0x92, 0x95, 0xde, 0x4b, 0x3a, 0x85, 0x13, 0xd3, 0xc1, 0x75, 0xfc, 0x2c, 0x8c, 0x38,
+	0xcc, 0xf5, 0xde, 0x9b, 0x35, 0xcd, 0x41, 0x63, 0xce, 0x7b, 0x81, 0xb7, 0x7f, 0xa9, 0x4c, 0x77,
+	0x93, 0xd9, 0x03, 0xeb, 0x0d, 0x6f, 0xcd, 0x4a, 0x30, 0x36, 0x47, 0xb4, 0xfe, 0xd7, 0x24, 0x50,
+	0x3a, 0x01, 0x06, 0x1a, 0x72, 0x74, 0x9b, 0x15, 0x85, 0x57, 0x8d, 0xf9, 0xde, 0x5c, 0x3b, 0x51,
+	0x78, 0x71, 0xa8, 0x07, 0xd0, 0xca, 0x24, 0xbc, 0x10, 0x79, 0xba, 0x79, 0xc0, 0x93, 0x15, 0xd7,
+	0xac, 0x47, 0xb6, 0xb0, 0xb4, 0x9f, 0xc2, 0xd9, 0x76, 0x0d, 0x70, 0xaa, 0x0f, 0xe0, 0x20, 0x26,
+	0x39, 0xfa, 0x6f, 0x25, 0x36, 0x9e, 0xd0, 0x97, 0x3b, 0xa5, 0xe9, 0xb5, 0x80, 0x89, 0x08, 0xcf,
+	0xdd, 0xb0, 0x48, 0x69, 0x24, 0x64, 0x88, 0x7b, 0xbd, 0x96, 0x5c, 0xcf, 0xac, 0xe7, 0x89, 0x8c,
+	0xcb, 0xee, 0xe2, 0x54, 0xbe, 0x98, 0xbc, 0x06, 0x26, 0x87, 0x44, 0x8a, 0x1f, 0x42, 0x91, 0x62,
+	0x59, 0x49, 0xea, 0xe1, 0xc6, 0x1a, 0x87, 0x0d, 0x2e, 0x82, 0xf8, 0xad, 0x7c, 0xab, 0x08, 0x27,
+	0xd2, 0x9b, 0xee, 0x27, 0xb5, 0x44, 0x05, 0x62, 0x6f, 0xb9, 0xd4, 0xd9, 0x65, 0x82, 0xae, 0x3d,
+	0x31, 0xad, 0x0a, 0x7b, 0xe7, 0xca, 0x7c, 0x6f, 0x3b, 0x16, 0xe9, 0xfe, 0x26, 0xeb, 0x4d, 0x7e,
+	0x4d, 0x82, 0x93, 0xba, 0x65, 0x35, 0x79, 0x9e, 0x02, 0x9f, 0xa7, 0x70, 0x8d, 0x0e, 0x3e, 0x2f,
+	0xd7, 0xe8, 0x04, 0x1f, 0x31, 0xce, 0x32, 0x97, 0x2c, 0xc2, 0x58, 0x10, 0xca, 0xe5, 0x70, 0xe3,
+	0x29, 0xa3, 0xdb, 0x0d, 0x44, 0xa4, 0x96, 0x43, 0xd3, 0x1c, 0x78, 0xc3, 0x3d, 0x3b, 0xf0, 0xc8,
+	0x57, 0x25, 0x28, 0x21, 0x7f, 0xc2, 0x5b, 0x81, 0x60, 0xd0, 0xc8, 0xf3, 0x62, 0xd0, 0x09, 0x3e,
+	0x64, 0x18, 0x55, 0x8b, 0x1c, 0xfa, 0x15, 0x38, 0x16, 0x2c, 0x96, 0x98, 0x66, 0xa9, 0xf8, 0xbc,
+	0x66, 0x71, 0x54, 0x2c, 0x93, 0x18, 0x2a, 0xca, 0x8d, 0x0a, 0xad, 0xd1, 0x6a, 0x8c, 0x1b, 0xa3,
+	0xcf, 0x99, 0x1b, 0x8b, 0x62, 0x44, 0xc1, 0x8d, 0x2f, 0x49, 0x30, 0x91, 0x9c, 0x06, 0x5f, 0x70,
+	0x78, 0x5e, 0x53, 0x39, 0x5e, 0x89, 0x4f, 0x82, 0xc9, 0x88, 0x0c, 0x45, 0x5a, 0x33, 0xab, 0xe6,
+	0x56, 0x8d, 0x67, 0x6c, 0x16, 0xd5, 0xe0, 0xdb, 0x0f, 0xca, 0xd9, 0xf4, 0x1c, 0xaa, 0xd7, 0x1f,
+	0x44, 0x13, 0x52, 0xb7, 0x75, 0xab, 0x9a, 0xc3, 0x60, 0x7f, 0x57, 0x82, 0x73, 0x1d, 0x50, 0xb2,
+	0x46, 0x90, 0x9e, 0x80, 0x61, 0xb4, 0x4b, 0xb8, 0xbf, 0x04, 0xbf, 0xc8, 0x83, 0xe0, 0x0e, 0x3d,
+	0xd0, 0xe5, 0x31, 0x2d, 0x7a, 0x5c, 0x05, 0x33, 0xe0, 0xf7, 0xd0, 0xc5, 0x30, 0x08, 0x14, 0xd1,
+	0x66, 0xdf, 0xbb, 0x0d, 0x43, 0x4c, 0x3b, 0x93, 0x7f, 0x90, 0x60, 0x3c, 0x2d, 0xe2, 0x8e, 0xbc,
+	0x9e, 0x3f, 0xe8, 0x3c, 0x9e, 0xb5, 0x2e, 0xcf, 0xf5, 0x81, 0xc0, 0x19, 0xa7, 0xac, 0xbe, 0xf3,
+	0xc3, 0x9f, 0xfc, 0x46, 0x61, 0x9e, 0xbc, 0xde, 0xfd, 0xe7, 0x16, 0x02, 0x06, 0xe3, 0xe3, 0x71,
+	0xf9, 0x59, 0x84, 0xe5, 0x6f, 0x93, 0xbf, 0x93, 0xe0, 0x78, 0x6c, 0x28, 0x1e, 0x7e, 0x4e, 0x6e,
+	0xe6, 0x9f, 0x64, 0x2c, 0xbd, 0x5d, 0x7e, 0xbd, 0x77, 0x00, 0x24, 0x72, 0x8e, 0x11, 0xf9, 0x29,
+	0xf2, 0x6a, 0x0e, 0x22, 0x59, 0x23, 0xb7, 0xfc, 0x8c, 0x39, 0x7d, 0xdf, 0x26, 0xdf, 0x10, 0xae,
+	0x98, 0xd4, 0x54, 0x4f, 0xb2, 0x9c, 0x7d, 0x8e, 0x9d, 0x52, 0x57, 0xe5, 0x95, 0xbe, 0x71, 0x90,
+	0xe4, 0x2d, 0x46, 0xf2, 0xe7, 0xc9, 0x5b, 0xdd, 0x49, 0x0e, 0x75, 0x7b, 0xcc, 0xe4, 0x8f, 0x2f,
+	0x6f, 0xf9, 0x59, 0xf2, 0xa4, 0x4e, 0xe3, 0x49, 0x34, 0x96, 0xaa, 0x27, 0x9e, 0xa4, 0x64, 0xbb,
+	0xca, 0x2b, 0x7d, 0xe3, 0xf4, 0xc3, 0x93, 0x18, 0xd9, 0x49, 0x9e, 0x24, 0xef, 0x48, 0x6f, 0x93,
+	0xbf, 0x94, 0x30, 0x27, 0x2f, 0xe6, 0x29, 0x22, 0x37, 0xb2, 0xd3, 0x90, 0xe6, 0xa9, 0x92, 0x6f,
+	0xf6, 0xdc, 0x1f, 0x69, 0x7f, 0x85, 0xd1, 0x3e, 0x4b, 0xae, 0x74, 0xa7, 0x1d, 0x9d, 0x4d, 0x94,
+	0xff, 0x90, 0x05, 0xf9, 0xa6, 0x88, 0x34, 0xe9, 0x9c, 0x93, 0x4a, 0xd6, 0xb3, 0x4f, 0x31, 0x53,
+	0x2e, 0xac, 0xbc, 0xb1, 0x7f, 0x80, 0xc8, 0x84, 0x5b, 0x8c, 0x09, 0x4b, 0x64, 0xa1, 0x3b, 0x13,
+	0x9c, 0x00, 0x31, 0x69, 0x1b, 0xe2, 0x2f, 0x04, 0x90, 0xaf, 0x09, 0xdf, 0x60, 0xc7, 0xac, 0x58,
+	0x72, 0x37, 0x3b, 0x15, 0x59, 0xb2, 0x75, 0xe5, 0xf5, 0x7d, 0xc3, 0x43, 0xa6, 0x2c, 0x31, 0xa6,
+	0xdc, 0x24, 0xd7, 0xbb, 0x33, 0x05, 0xa5, 0x5c, 0x6b, 0xf8, 0xa8, 0x09, 0xf5, 0xff, 0x87, 0x12,
+	0x8c, 0x45, 0xd2, 0x4e, 0xc9, 0xcb, 0xd9, 0xe7, 0x19, 0x4b, 0x5f, 0x95, 0x5f, 0xc9, 0xdf, 0x11,
+	0x29, 0xb9, 0xc2, 0x28, 0xb9, 0x48, 0xa6, 0xbb, 0x53, 0xc2, 0x13, 0x25, 0x42, 0xd9, 0xee, 0x9c,
+	0x7a, 0x9a, 0x47, 0xb6, 0x33, 0xe5, 0xc4, 0xca, 0x1b, 0xfb, 0x07, 0x98, 0x5f, 0xb6, 0x45, 0x00,
+	0x42, 0x68, 0xd5, 0x27, 0x17, 0xf3, 0x8f, 0x0a, 0xf0, 0xb1, 0xd6, 0xc1, 0xdb, 0xa4, 0x92, 0x91,
+	0xfb, 0xbd, 0x1e, 0xd0, 0x1d, 0xb3, 0xe1, 0xe4, 0x07, 0xfb, 0x0d, 0x8b, 0x9c, 0x7a, 0x8b, 0x71,
+	0xea, 0x1e, 0x51, 0x73, 0x5b, 0x03, 0x5a, 0x23, 0x1a, 0x9a, 0x91, 0x76, 0x24, 0xfe, 0x41, 0x01,
+	0xdf, 0xdc, 0xbb, 0xe4, 0xa6, 0x91, 0x8d, 0x3e, 0x0e, 0xfa, 0xd4, 0xac, 0x3b, 0xf9, 0x8d, 0x7d,
+	0x44, 0x44, 0x4e, 0x19, 0x8c, 0x53, 0x0f, 0xc9, 0xe7, 0xf2, 0x70, 0x2a, 0x7e, 0xff, 0xec, 0x6e,
+	0x45, 0xfc, 0x8b, 0x04, 0x27, 0xdb, 0x64, 0x56, 0x92, 0x85, 0x7e, 0xf2, 0x32, 0x05, 0x63, 0x16,
+	0xfb, 0x03, 0xc9, 0xbf, 0xbf, 0x5a, 0xa3, 0x78, 0x92, 0xfb, 0xeb, 0x9f, 0x25, 0x38, 0xd5, 0x36,
+	0x6b, 0x90, 0xe4, 0xc8, 0x46, 0xed, 0x90, 0x99, 0x28, 0x2f, 0xf7, 0x0b, 0x93, 0xdf, 0x7a, 0x6e,
+	0x93, 0xe4, 0x48, 0xfe, 0x35, 0xf9, 0x7b, 0x50, 0xf1, 0x34, 0x44, 0xb2, 0x92, 0x7f, 0x89, 0x52,
+	0x73, 0x21, 0xe5, 0xd5, 0xfe, 0x81, 0xfa, 0xb8, 0x33, 0x98, 0x95, 0xf2, 0xb3, 0x20, 0x80, 0xe1,
+	0x6d, 0xf2, 0xf7, 0xc2, 0x16, 0x8c, 0xa9, 0xa7, 0x3c, 0xb6, 0x60, 0x5a, 0xb6, 0xa5, 0x7c, 0xb3,
+	0xe7, 0xfe, 0x48, 0xda, 0x32, 0x23, 0xed, 0x75, 0x72, 0x23, 0xaf, 0x02, 0x4c, 0x48, 0xf1, 0xbf,
+	0x49, 0x50, 0x6a, 0x97, 0x4b, 0x46, 0x16, 0x7b, 0xbe, 0x9b, 0x46, 0xd2, 0xd9, 0xe4, 0xa5, 0x3e,
+	0x51, 0x90, 0xe2, 0x3b, 0x8c, 0xe2, 0x15, 0xb2, 0x94, 0xff, 0x96, 0xcb, 0x5e, 0x99, 0x12, 0x84,
+	0xff, 0x44, 0xa8, 0xac, 0xd6, 0xc4, 0xb3, 0x3c, 0x2a, 0xab, 0x6d, 0x56, 0x9c, 0xbc, 0xd8, 0x1f,
+	0x08, 0x52, 0x7d, 0x83, 0x51, 0xfd, 0x0a, 0xf9, 0x64, 0x77, 0xaa, 0x2d, 0xaa, 0x3b, 0x9a, 0x48,
+	0x33, 0xc3, 0x28, 0x0e, 0xf2, 0x43, 0x71, 0xa3, 0x8f, 0x27, 0x82, 0xe5, 0xb9, 0xd1, 0xa7, 0x66,
+	0x98, 0xc9, 0xaf, 0xf7, 0x0e, 0x80, 0xa4, 0xbd, 0xca, 0x48, 0x7b, 0x89, 0x5c, 0xed, 0x4e, 0x1a,
+	0xcf, 0x2d, 0x0b, 0x72, 0xc8, 0xc8, 0xbf, 0x0b, 0xdd, 0x9b, 0x96, 0x49, 0x94, 0x47, 0xf7, 0x76,
+	0xc8, 0x35, 0x93, 0x97, 0xfb, 0x85, 0x41, 0x3a, 0xef, 0x32, 0x3a, 0x57, 0xc9, 0x72, 0x06, 0x93,
+	0x36, 0x9e, 0x09, 0x8c, 0x48, 0x09, 0xc9, 0xfd, 0xbd, 0x02, 0x5c, 0x48, 0x3f, 0xe9, 0x12, 0xe9,
+	0x60, 0xe4, 0x8d, 0x3e, 0x4e, 0xcd, 0xf4, 0x64, 0x35, 0x59, 0xdd, 0x4f, 0x48, 0x64, 0xd0, 0xe7,
+	0x18, 0x83, 0xee, 0x93, 0xcd, 0x5e, 0x8e, 0x65, 0xfc, 0xa5, 0xbd, 0x46, 0x00, 0x9b, 0xe0, 0xd6,
+	0x4f, 0xc5, 0xef, 0x51, 0xa5, 0xe6, 0x0a, 0xe5, 0x71, 0x70, 0x74, 0x4a, 0xb8, 0x92, 0x57, 0xfa,
+	0xc6, 0xc9, 0x7f, 0x66, 0xd5, 0x19, 0x90, 0x26, 0x52, 0x92, 0x34, 0x17, 0x69, 0xfa, 0xaf, 0xe4,
+	0x4f, 0x4d, 0xc6, 0x92, 0x59, 0xf2, 0x90, 0xdc, 0x29, 0x53, 0x47, 0x5e, 0xe9, 0x1b, 0x07, 0x49,
+	0x5e, 0x67, 0x24, 0xaf, 0x91, 0x95, 0x1c, 0xeb, 0x8f, 0x1a, 0x01, 0x33, 0x72, 0x12, 0x6b, 0xfe,
+	0x4e, 0x21, 0x61, 0xaa, 0xc4, 0xb3, 0x4c, 0x7a, 0x31, 0x55, 0x52, 0x53, 0x7c, 0xe4, 0xd5, 0xfe,
+	0x81, 0x90, 0x07, 0x1b, 0x8c, 0x07, 0x9f, 0x26, 0xab, 0x39, 0x78, 0xe0, 0xa7, 0xfa, 0x68, 0x61,
+	0xaa, 0x4c, 0x82, 0x09, 0xbf, 0x90, 0xe0, 0x85, 0xd8, 0xc8, 0xc9, 0x8c, 0x18, 0xb2, 0xd6, 0x83,
+	0x11, 0x92, 0x9e, 0x7d, 0x23, 0x7f, 0x7a, 0x3f, 0xa0, 0x90, 0x15, 0x8b, 0x8c, 0x15, 0x37, 0xc8,
+	0xb5, 0x3c, 0xa6, 0x0d, 0x07, 0xd3, 0xc2, 0x9f, 0xc4, 0xfc, 0x85, 0x30, 0x6c, 0x52, 0x52, 0x57,
+	0xf2, 0x18, 0x36, 0xed, 0x53, 0x69, 0xe4, 0xa5, 0x3e, 0x51, 0x90, 0xde, 0x4d, 0x46, 0xef, 0x1d,
+	0x72, 0x2b, 0x97, 0x9b, 0xd7, 0xd8, 0x15, 0xfb, 0xbd, 0xfc, 0xac, 0x25, 0xfd, 0x26, 0xc5, 0xae,
+	0x8b, 0xe4, 0x0c, 0xf5, 0x62, 0xd7, 0xb5, 0xe6, 0x41, 0xc9, 0x4b, 0x7d, 0xa2, 0xf4, 0x61, 0xd7,
+	0x71, 0xeb, 0x86, 0x39, 0x37, 0x93, 0xd7, 0xb2, 0x2f, 0x15, 0x12, 0x19, 0x5c, 0xad, 0x79, 0x08,
+	0xe4, 0x56, 0x0f, 0xd2, 0xda, 0x2e, 0xf1, 0x41, 0xbe, 0xbd, 0x3f, 0x60, 0xc8, 0x8d, 0x15, 0xc6,
+	0x8d, 0x39, 0x72, 0x33, 0x8f, 0xf0, 0xf3, 0xeb, 0x0a, 0x26, 0x40, 0x68, 0x0d, 0x46, 0x63, 0xcb,
+	0xf6, 0x4f, 0x26, 0x04, 0xf4, 0xb2, 0xfd, 0xdb, 0x64, 0x1f, 0xc8, 0x9f, 0xde, 0x0f, 0xa8, 0x3e,
+	0xb6, 0xbf, 0x28, 0xd1, 0x44, 0x12, 0x02, 0xf9, 0x79, 0xcb, 0xaf, 0x17, 0xc7, 0x02, 0xec, 0x7b,
+	0x39, 0x02, 0x52, 0x23, 0xf9, 0xe5, 0xd5, 0xfe, 0x81, 0x90, 0xf0, 0x79, 0x46, 0xf8, 0x35, 0xf2,
+	0x5a, 0xfe, 0xa5, 0x17, 0x21, 0xfd, 0xe1, 0xad, 0xa6, 0x35, 0x4c, 0x3d, 0xcf, 0xad, 0xa6, 0x6d,
+	0x1c, 0xbc, 0xbc, 0xd8, 0x1f, 0x48, 0xfe, 0x5b, 0x4d, 0xf0, 0x92, 0xf1, 0xd8, 0x87, 0xc1, 0xf7,
+	0x8c, 0xf7, 0xc5, 0xad, 0x3c, 0x16, 0x54, 0x9d, 0xe7, 0x56, 0x9e, 0x16, 0x7b, 0x2e, 0xdf, 0xec,
+	0xb9, 0x7f, 0xfe, 0xdd, 0x5b, 0xd3, 0x5d, 0x4f, 0xdb, 0x75, 0x0d, 0xd4, 0x65, 0x09, 0x2d, 0xd6,
+	0x62, 0xc2, 0xc5, 0x9f, 0xa2, 0x7a, 0x30, 0xe1, 0x52, 0x9f, 0xa4, 0x56, 0xfa, 0xc6, 0xe9, 0xc3,
+	0x84, 0x8b, 0xbf, 0x51, 0x25, 0x18, 0xf0, 0xdb, 0x05, 0xcc, 0x3c, 0xeb, 0x1a, 0xe3, 0x4b, 0x72,
+	0x5c, 0x49, 0xb2, 0xc6, 0x20, 0xcb, 0x9b, 0xfb, 0x8a, 0xd9, 0xc3, 0x45, 0x90, 0x83, 0x6a, 0x69,
+	0xbf, 0xd5, 0x24, 0x82, 0xfa, 0xc3, 0x33, 0x3e, 0x25, 0x74, 0x35, 0xcf, 0x19, 0xdf, 0x3e, 0x7c,
+	0x56, 0x5e, 0xea, 0x13, 0x25, 0xff, 0x19, 0x9f, 0x1a, 0x67, 0xdb, 0x6d, 0x77, 0xc4, 0xa2, 0x3d,
+	0x7b, 0xd9, 0x1d, 0x69, 0xd1, 0xab, 0xf2, 0x4a, 0xdf, 0x38, 0x7d, 0xec, 0x0e, 0x8c, 0x8f, 0xe6,
+	0x81, 0x93, 0x7b, 0x09, 0x06, 0x7c, 0xb1, 0xe5, 0xd5, 0x3e, 0x1a, 0x74, 0xd9, 0xd3, 0xab, 0x7d,
+	0x4a, 0x94, 0xa9, 0xbc, 0xd2, 0x37, 0x0e, 0x32, 0xe0, 0x3e, 0x63, 0xc0, 0x3a, 0xb9, 0x93, 0xc7,
+	0xc4, 0xb5, 0x1b, 0x9e, 0xff, 0xc0, 0x15, 0x70, 0xa0, 0xf5, 0xd9, 0xe1, 0xc7, 0x12, 0x9c, 0x48,
+	0x8f, 0x15, 0x25, 0xf3, 0x79, 0xef, 0xe3, 0xad, 0x91, 0xa8, 0xf2, 0x42, 0x5f, 0x18, 0x48, 0xfa,
+	0x75, 0x46, 0xfa, 0xcb, 0xe4, 0x13, 0x99, 0xef, 0xf3, 0xd1, 0xd8, 0x56, 0xff, 0x75, 0xf3, 0x74,
+	0x87, 0x08, 0xd1, 0x5e, 0xec, 0x98, 0xd4, 0xb0, 0x56, 0x79, 0xb5, 0x7f, 0xa0, 0x3e, 0x5e, 0x9c,
+	0xf0, 0x59, 0x5e, 0x44, 0xa4, 0x76, 0x7f, 0x71, 0xfa, 0xae, 0x04, 0xa7, 0xda, 0x86, 0x94, 0x65,
+	0xf4, 0x00, 0x76, 0x0b, 0x6c, 0x93, 0x97, 0xfb, 0x85, 0xe1, 0x1c, 0xb9, 0x22, 0xcd, 0xbf, 0xf9,
+	0xde, 0x07, 0x67, 0xa5, 0xef, 0x7f, 0x70, 0x56, 0xfa, 0xf1, 0x07, 0x67, 0xa5, 0xaf, 0x7f, 0x78,
+	0xf6, 0xc0, 0xf7, 0x3f, 0x3c, 0x7b, 0xe0, 0x6f, 0x3e, 0x3c, 0x7b, 0xe0, 0xad, 0xeb, 0xad, 0xe1,
+	0x7b, 0xe1, 0xa0, 0x97, 0x03, 0xb6, 0xed, 0xbe, 0x5c, 0x7e, 0x9a, 0x30, 0x8c, 0xfc, 0xc8, 0xbe,
+	0xad, 0x61, 0x16, 0x2f, 0xfb, 0xd2, 0x7f, 0x0f, 0x00, 0xef, 0x7a, 0xf7, 0xe5, 0x6a, 0x67, 0x00,
+	0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// ConsumerGenesis queries the genesis state needed to start a consumer chain
+	// whose proposal has been accepted
+	QueryConsumerGenesis(ctx context.Context, in *QueryConsumerGenesisRequest, opts ...grpc.CallOption) (*QueryConsumerGenesisResponse, error)
+	// ConsumerChains queries active consumer chains supported by the provider
+	// chain
+	QueryConsumerChains(ctx context.Context, in *QueryConsumerChainsRequest, opts ...grpc.CallOption) (*QueryConsumerChainsResponse, error)
+	// QueryValidatorConsumerAddr queries the address
+	// assigned by a validator for a consumer chain.
+	QueryValidatorConsumerAddr(ctx context.Context, in *QueryValidatorConsumerAddrRequest, opts ...grpc.CallOption) (*QueryValidatorConsumerAddrResponse, error)
+	// QueryProviderAddr returns the provider chain validator
+	// given a consumer chain validator address
+	QueryValidatorProviderAddr(ctx context.Context, in *QueryValidatorProviderAddrRequest, opts ...grpc.CallOption) (*QueryValidatorProviderAddrResponse, error)
+	// QueryThrottleState returns the main on-chain state relevant to currently
+	// throttled slash packets
+	QueryThrottleState(ctx context.Context, in *QueryThrottleStateRequest, opts ...grpc.CallOption) (*QueryThrottleStateResponse, error)
+	// QueryRegisteredConsumerRewardDenoms returns a list of consumer reward
+	// denoms that are registered
+	QueryRegisteredConsumerRewardDenoms(ctx context.Context, in *QueryRegisteredConsumerRewardDenomsRequest, opts ...grpc.CallOption) (*QueryRegisteredConsumerRewardDenomsResponse, error)
+	// QueryAllPairsValConsAddrByConsumer returns a list of pair valconsensus address
+	// between provider and consumer chain
+	QueryAllPairsValConsAddrByConsumer(ctx context.Context, in *QueryAllPairsValConsAddrByConsumerRequest, opts ...grpc.CallOption) (*QueryAllPairsValConsAddrByConsumerResponse, error)
+	// QueryParams returns all current values of provider parameters
+	QueryParams(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// QueryConsumerChainOptedInValidators returns a list of validators consensus addresses
+	// that opted-in to the given consumer chain
+	QueryConsumerChainOptedInValidators(ctx context.Context, in *QueryConsumerChainOptedInValidatorsRequest, opts ...grpc.CallOption) (*QueryConsumerChainOptedInValidatorsResponse, error)
+	// QueryConsumerChainsValidatorHasToValidate returns a list of consumer chains
+	// that a given validator must validate
+	QueryConsumerChainsValidatorHasToValidate(ctx context.Context, in *QueryConsumerChainsValidatorHasToValidateRequest, opts ...grpc.CallOption) (*QueryConsumerChainsValidatorHasToValidateResponse, error)
+	// QueryValidatorConsumerCommissionRate returns the commission rate a given
+	// validator charges on a given consumer chain
+	QueryValidatorConsumerCommissionRate(ctx context.Context, in *QueryValidatorConsumerCommissionRateRequest, opts ...grpc.CallOption) (*QueryValidatorConsumerCommissionRateResponse, error)
+	// QueryConsumerValidators returns the latest set consumer-validator set for a given consumer ID
+	// Note that this does not necessarily mean that the consumer chain is using this validator set at this exact moment
+	// because a VSCPacket could be delayed to be delivered on the consumer chain.
+	QueryConsumerValidators(ctx context.Context, in *QueryConsumerValidatorsRequest, opts ...grpc.CallOption) (*QueryConsumerValidatorsResponse, error)
+	// QueryBlocksUntilNextEpoch returns the number of blocks until the next epoch
+	// starts and validator updates are sent to the consumer chains
+	QueryBlocksUntilNextEpoch(ctx context.Context, in *QueryBlocksUntilNextEpochRequest, opts ...grpc.CallOption) (*QueryBlocksUntilNextEpochResponse, error)
+	// QueryConsumerIdFromClientId returns the consumer id of the chain
+	// associated with the provided client id
+	QueryConsumerIdFromClientId(ctx context.Context, in *QueryConsumerIdFromClientIdRequest, opts ...grpc.CallOption) (*QueryConsumerIdFromClientIdResponse, error)
+	// QueryConsumerChain returns the consumer chain
+	// associated with the provided consumer id
+	QueryConsumerChain(ctx context.Context, in *QueryConsumerChainRequest, opts ...grpc.CallOption) (*QueryConsumerChainResponse, error)
+	// QueryConsumerGenesisTime returns the genesis time
+	// of the consumer chain associated with the provided consumer id
+	QueryConsumerGenesisTime(ctx context.Context, in *QueryConsumerGenesisTimeRequest, opts ...grpc.CallOption) (*QueryConsumerGenesisTimeResponse, error)
+	// QueryNearTimeoutPackets returns the VSC packets sent to consumer chains
+	// that are not yet acknowledged and that time out within the given duration
+	QueryNearTimeoutPackets(ctx context.Context, in *QueryNearTimeoutPacketsRequest, opts ...grpc.CallOption) (*QueryNearTimeoutPacketsResponse, error)
+	// QueryLaunchCapacity returns the number of launched consumer chains
+	// and the number of consumer chains that can still be launched
+	QueryLaunchCapacity(ctx context.Context, in *QueryLaunchCapacityRequest, opts ...grpc.CallOption) (*QueryLaunchCapacityResponse, error)
+	// QueryPowerShapingPipeline returns the power shaping steps applied, in evaluation order,
+	// the last time the validator set of a consumer chain was computed
+	QueryPowerShapingPipeline(ctx context.Context, in *QueryPowerShapingPipelineRequest, opts ...grpc.CallOption) (*QueryPowerShapingPipelineResponse, error)
+	// QueryConsumerValidatorPowerProjection returns the validator set that would be sent
+	// to a launched consumer chain in the next VSCPacket, after applying the power shaping
+	// parameters, without committing it
+	QueryConsumerValidatorPowerProjection(ctx context.Context, in *QueryConsumerValidatorPowerProjectionRequest, opts ...grpc.CallOption) (*QueryConsumerValidatorPowerProjectionResponse, error)
+	// QueryModuleAccountsSummary returns the balances of the CCV module accounts
+	// and the most recent transfers of funds in and out of them
+	QueryModuleAccountsSummary(ctx context.Context, in *QueryModuleAccountsSummaryRequest, opts ...grpc.CallOption) (*QueryModuleAccountsSummaryResponse, error)
+	// QueryConsumerLaunchFailure returns the last failed launch of a consumer chain
+	QueryConsumerLaunchFailure(ctx context.Context, in *QueryConsumerLaunchFailureRequest, opts ...grpc.CallOption) (*QueryConsumerLaunchFailureResponse, error)
+	// QueryConsumerHashCommitment returns the genesis and binary hashes of a consumer chain,
+	// whether they were updated since registration and whether the owner attested them
+	QueryConsumerHashCommitment(ctx context.Context, in *QueryConsumerHashCommitmentRequest, opts ...grpc.CallOption) (*QueryConsumerHashCommitmentResponse, error)
+	// QueryConsumerCreatorAllowlist returns the list of addresses allowed to create consumer chains;
+	// an empty list means that anyone can create a consumer chain
+	QueryConsumerCreatorAllowlist(ctx context.Context, in *QueryConsumerCreatorAllowlistRequest, opts ...grpc.CallOption) (*QueryConsumerCreatorAllowlistResponse, error)
+	// QueryValidatorCCVSummary returns the staking and CCV data of a provider validator,
+	// i.e., the consumer chains it validates or has to validate, its assigned consumer keys,
+	// its per-consumer commission rates, its outstanding rewards and its infractions
+	QueryValidatorCCVSummary(ctx context.Context, in *QueryValidatorCCVSummaryRequest, opts ...grpc.CallOption) (*QueryValidatorCCVSummaryResponse, error)
+	// QueryConsumerPacketStats returns the number of CCV packets exchanged with a consumer chain
+	QueryConsumerPacketStats(ctx context.Context, in *QueryConsumerPacketStatsRequest, opts ...grpc.CallOption) (*QueryConsumerPacketStatsResponse, error)
+	// QueryConsumerClientUpgradePlans returns the pending upgrades of the consumer clients
+	QueryConsumerClientUpgradePlans(ctx context.Context, in *QueryConsumerClientUpgradePlansRequest, opts ...grpc.CallOption) (*QueryConsumerClientUpgradePlansResponse, error)
+	// QueryConsumerProviderSwitches returns the switches of the consumer chains
+	// to different provider chains registered by their owners
+	QueryConsumerProviderSwitches(ctx context.Context, in *QueryConsumerProviderSwitchesRequest, opts ...grpc.CallOption) (*QueryConsumerProviderSwitchesResponse, error)
+	// QueryConsumerClientExpiries returns the times at which the clients of the
+	// launched consumer chains expire if they are not updated
+	QueryConsumerClientExpiries(ctx context.Context, in *QueryConsumerClientExpiriesRequest, opts ...grpc.CallOption) (*QueryConsumerClientExpiriesResponse, error)
+	// QueryThrottleQueueState returns the state of the slash meter together with
+	// the slash packets that were bounced because the slash meter was negative
+	// and are pending a retry from their consumer chains
+	QueryThrottleQueueState(ctx context.Context, in *QueryThrottleQueueStateRequest, opts ...grpc.CallOption) (*QueryThrottleQueueStateResponse, error)
+	// QueryLastVSCPacket returns the last VSC packet sent to a consumer chain,
+	// with the validators of its validator updates and slash acknowledgements resolved
+	QueryLastVSCPacket(ctx context.Context, in *QueryLastVSCPacketRequest, opts ...grpc.CallOption) (*QueryLastVSCPacketResponse, error)
+	// QueryConsumerThrottleState returns the throttling parameters of a consumer chain
+	// and the state of the slash meter throttling its slash packets
+	QueryConsumerThrottleState(ctx context.Context, in *QueryConsumerThrottleStateRequest, opts ...grpc.CallOption) (*QueryConsumerThrottleStateResponse, error)
+	// QueryPendingInfractionParameterUpdates returns the infraction parameter updates
+	// that are queued for consumer chains, ordered by the time at which they are applied
+	QueryPendingInfractionParameterUpdates(ctx context.Context, in *QueryPendingInfractionParameterUpdatesRequest, opts ...grpc.CallOption) (*QueryPendingInfractionParameterUpdatesResponse, error)
+	// QueryStaleKeyAssignments returns the assigned consumer keys of a consumer chain
+	// that were not observed in any heartbeat of the consumer chain for the
+	// StaleKeyAssignmentEpochs param
+	QueryStaleKeyAssignments(ctx context.Context, in *QueryStaleKeyAssignmentsRequest, opts ...grpc.CallOption) (*QueryStaleKeyAssignmentsResponse, error)
+	// QueryConsumerUpdateHistory returns the most recent accepted updates of a consumer chain,
+	// oldest first, together with the fields changed by every update
+	QueryConsumerUpdateHistory(ctx context.Context, in *QueryConsumerUpdateHistoryRequest, opts ...grpc.CallOption) (*QueryConsumerUpdateHistoryResponse, error)
+	// QueryValidatorOptInHistory returns, per consumer chain, the most recent opt-ins
+	// and opt-outs of a validator, oldest first
+	QueryValidatorOptInHistory(ctx context.Context, in *QueryValidatorOptInHistoryRequest, opts ...grpc.CallOption) (*QueryValidatorOptInHistoryResponse, error)
+	// QueryModuleVersionInfo returns the version of the provider module
+	// and the features enabled on the provider chain
+	QueryModuleVersionInfo(ctx context.Context, in *QueryModuleVersionInfoRequest, opts ...grpc.CallOption) (*QueryModuleVersionInfoResponse, error)
+	// QueryConsumerRewardEstimate returns the estimated annual ICS rewards of a validator
+	// for a consumer chain, based on the recent inflow of rewards from the consumer chain,
+	// the power share of the validator on the consumer chain and its commission rate
+	QueryConsumerRewardEstimate(ctx context.Context, in *QueryConsumerRewardEstimateRequest, opts ...grpc.CallOption) (*QueryConsumerRewardEstimateResponse, error)
+	// StreamValidatorSetChanges streams the VSC packets queued for a consumer chain
+	// once the blocks in which they are queued are committed. Note that this query is served only over gRPC,
+	// i.e., neither through the REST gateway nor through ABCI queries.
+	StreamValidatorSetChanges(ctx context.Context, in *StreamValidatorSetChangesRequest, opts ...grpc.CallOption) (Query_StreamValidatorSetChangesClient, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) QueryConsumerGenesis(ctx context.Context, in *QueryConsumerGenesisRequest, opts ...grpc.CallOption) (*QueryConsumerGenesisResponse, error) {
+	out := new(QueryConsumerGenesisResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryConsumerGenesis", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryConsumerChains(ctx context.Context, in *QueryConsumerChainsRequest, opts ...grpc.CallOption) (*QueryConsumerChainsResponse, error) {
+	out := new(QueryConsumerChainsResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryConsumerChains", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryValidatorConsumerAddr(ctx context.Context, in *QueryValidatorConsumerAddrRequest, opts ...grpc.CallOption) (*QueryValidatorConsumerAddrResponse, error) {
+	out := new(QueryValidatorConsumerAddrResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryValidatorConsumerAddr", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryValidatorProviderAddr(ctx context.Context, in *QueryValidatorProviderAddrRequest, opts ...grpc.CallOption) (*QueryValidatorProviderAddrResponse, error) {
+	out := new(QueryValidatorProviderAddrResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryValidatorProviderAddr", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryThrottleState(ctx context.Context, in *QueryThrottleStateRequest, opts ...grpc.CallOption) (*QueryThrottleStateResponse, error) {
+	out := new(QueryThrottleStateResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryThrottleState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryRegisteredConsumerRewardDenoms(ctx context.Context, in *QueryRegisteredConsumerRewardDenomsRequest, opts ...grpc.CallOption) (*QueryRegisteredConsumerRewardDenomsResponse, error) {
+	out := new(QueryRegisteredConsumerRewardDenomsResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryRegisteredConsumerRewardDenoms", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryAllPairsValConsAddrByConsumer(ctx context.Context, in *QueryAllPairsValConsAddrByConsumerRequest, opts ...grpc.CallOption) (*QueryAllPairsValConsAddrByConsumerResponse, error) {
+	out := new(QueryAllPairsValConsAddrByConsumerResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryAllPairsValConsAddrByConsumer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryParams(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryConsumerChainOptedInValidators(ctx context.Context, in *QueryConsumerChainOptedInValidatorsRequest, opts ...grpc.CallOption) (*QueryConsumerChainOptedInValidatorsResponse, error) {
+	out := new(QueryConsumerChainOptedInValidatorsResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryConsumerChainOptedInValidators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryConsumerChainsValidatorHasToValidate(ctx context.Context, in *QueryConsumerChainsValidatorHasToValidateRequest, opts ...grpc.CallOption) (*QueryConsumerChainsValidatorHasToValidateResponse, error) {
+	out := new(QueryConsumerChainsValidatorHasToValidateResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryConsumerChainsValidatorHasToValidate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryValidatorConsumerCommissionRate(ctx context.Context, in *QueryValidatorConsumerCommissionRateRequest, opts ...grpc.CallOption) (*QueryValidatorConsumerCommissionRateResponse, error) {
+	out := new(QueryValidatorConsumerCommissionRateResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryValidatorConsumerCommissionRate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryConsumerValidators(ctx context.Context, in *QueryConsumerValidatorsRequest, opts ...grpc.CallOption) (*QueryConsumerValidatorsResponse, error) {
+	out := new(QueryConsumerValidatorsResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryConsumerValidators", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryBlocksUntilNextEpoch(ctx context.Context, in *QueryBlocksUntilNextEpochRequest, opts ...grpc.CallOption) (*QueryBlocksUntilNextEpochResponse, error) {
+	out := new(QueryBlocksUntilNextEpochResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryBlocksUntilNextEpoch", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryConsumerIdFromClientId(ctx context.Context, in *QueryConsumerIdFromClientIdRequest, opts ...grpc.CallOption) (*QueryConsumerIdFromClientIdResponse, error) {
+	out := new(QueryConsumerIdFromClientIdResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryConsumerIdFromClientId", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryConsumerChain(ctx context.Context, in *QueryConsumerChainRequest, opts ...grpc.CallOption) (*QueryConsumerChainResponse, error) {
+	out := new(QueryConsumerChainResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryConsumerChain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryConsumerGenesisTime(ctx context.Context, in *QueryConsumerGenesisTimeRequest, opts ...grpc.CallOption) (*QueryConsumerGenesisTimeResponse, error) {
+	out := new(QueryConsumerGenesisTimeResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryConsumerGenesisTime", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryNearTimeoutPackets(ctx context.Context, in *QueryNearTimeoutPacketsRequest, opts ...grpc.CallOption) (*QueryNearTimeoutPacketsResponse, error) {
+	out := new(QueryNearTimeoutPacketsResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryNearTimeoutPackets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryLaunchCapacity(ctx context.Context, in *QueryLaunchCapacityRequest, opts ...grpc.CallOption) (*QueryLaunchCapacityResponse, error) {
+	out := new(QueryLaunchCapacityResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryLaunchCapacity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryPowerShapingPipeline(ctx context.Context, in *QueryPowerShapingPipelineRequest, opts ...grpc.CallOption) (*QueryPowerShapingPipelineResponse, error) {
+	out := new(QueryPowerShapingPipelineResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryPowerShapingPipeline", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryConsumerValidatorPowerProjection(ctx context.Context, in *QueryConsumerValidatorPowerProjectionRequest, opts ...grpc.CallOption) (*QueryConsumerValidatorPowerProjectionResponse, error) {
+	out := new(QueryConsumerValidatorPowerProjectionResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryConsumerValidatorPowerProjection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryModuleAccountsSummary(ctx context.Context, in *QueryModuleAccountsSummaryRequest, opts ...grpc.CallOption) (*QueryModuleAccountsSummaryResponse, error) {
+	out := new(QueryModuleAccountsSummaryResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryModuleAccountsSummary", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryConsumerLaunchFailure(ctx context.Context, in *QueryConsumerLaunchFailureRequest, opts ...grpc.CallOption) (*QueryConsumerLaunchFailureResponse, error) {
+	out := new(QueryConsumerLaunchFailureResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryConsumerLaunchFailure", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryConsumerHashCommitment(ctx context.Context, in *QueryConsumerHashCommitmentRequest, opts ...grpc.CallOption) (*QueryConsumerHashCommitmentResponse, error) {
+	out := new(QueryConsumerHashCommitmentResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryConsumerHashCommitment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryConsumerCreatorAllowlist(ctx context.Context, in *QueryConsumerCreatorAllowlistRequest, opts ...grpc.CallOption) (*QueryConsumerCreatorAllowlistResponse, error) {
+	out := new(QueryConsumerCreatorAllowlistResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryConsumerCreatorAllowlist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryValidatorCCVSummary(ctx context.Context, in *QueryValidatorCCVSummaryRequest, opts ...grpc.CallOption) (*QueryValidatorCCVSummaryResponse, error) {
+	out := new(QueryValidatorCCVSummaryResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryValidatorCCVSummary", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryConsumerPacketStats(ctx context.Context, in *QueryConsumerPacketStatsRequest, opts ...grpc.CallOption) (*QueryConsumerPacketStatsResponse, error) {
+	out := new(QueryConsumerPacketStatsResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryConsumerPacketStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryConsumerClientUpgradePlans(ctx context.Context, in *QueryConsumerClientUpgradePlansRequest, opts ...grpc.CallOption) (*QueryConsumerClientUpgradePlansResponse, error) {
+	out := new(QueryConsumerClientUpgradePlansResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryConsumerClientUpgradePlans", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryConsumerProviderSwitches(ctx context.Context, in *QueryConsumerProviderSwitchesRequest, opts ...grpc.CallOption) (*QueryConsumerProviderSwitchesResponse, error) {
+	out := new(QueryConsumerProviderSwitchesResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryConsumerProviderSwitches", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryConsumerClientExpiries(ctx context.Context, in *QueryConsumerClientExpiriesRequest, opts ...grpc.CallOption) (*QueryConsumerClientExpiriesResponse, error) {
+	out := new(QueryConsumerClientExpiriesResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryConsumerClientExpiries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryThrottleQueueState(ctx context.Context, in *QueryThrottleQueueStateRequest, opts ...grpc.CallOption) (*QueryThrottleQueueStateResponse, error) {
+	out := new(QueryThrottleQueueStateResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryThrottleQueueState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryLastVSCPacket(ctx context.Context, in *QueryLastVSCPacketRequest, opts ...grpc.CallOption) (*QueryLastVSCPacketResponse, error) {
+	out := new(QueryLastVSCPacketResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryLastVSCPacket", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryConsumerThrottleState(ctx context.Context, in *QueryConsumerThrottleStateRequest, opts ...grpc.CallOption) (*QueryConsumerThrottleStateResponse, error) {
+	out := new(QueryConsumerThrottleStateResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryConsumerThrottleState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryPendingInfractionParameterUpdates(ctx context.Context, in *QueryPendingInfractionParameterUpdatesRequest, opts ...grpc.CallOption) (*QueryPendingInfractionParameterUpdatesResponse, error) {
+	out := new(QueryPendingInfractionParameterUpdatesResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryPendingInfractionParameterUpdates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryStaleKeyAssignments(ctx context.Context, in *QueryStaleKeyAssignmentsRequest, opts ...grpc.CallOption) (*QueryStaleKeyAssignmentsResponse, error) {
+	out := new(QueryStaleKeyAssignmentsResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryStaleKeyAssignments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryConsumerUpdateHistory(ctx context.Context, in *QueryConsumerUpdateHistoryRequest, opts ...grpc.CallOption) (*QueryConsumerUpdateHistoryResponse, error) {
+	out := new(QueryConsumerUpdateHistoryResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryConsumerUpdateHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryValidatorOptInHistory(ctx context.Context, in *QueryValidatorOptInHistoryRequest, opts ...grpc.CallOption) (*QueryValidatorOptInHistoryResponse, error) {
+	out := new(QueryValidatorOptInHistoryResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryValidatorOptInHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryModuleVersionInfo(ctx context.Context, in *QueryModuleVersionInfoRequest, opts ...grpc.CallOption) (*QueryModuleVersionInfoResponse, error) {
+	out := new(QueryModuleVersionInfoResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryModuleVersionInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) QueryConsumerRewardEstimate(ctx context.Context, in *QueryConsumerRewardEstimateRequest, opts ...grpc.CallOption) (*QueryConsumerRewardEstimateResponse, error) {
+	out := new(QueryConsumerRewardEstimateResponse)
+	err := c.cc.Invoke(ctx, "/interchain_security.ccv.provider.v1.Query/QueryConsumerRewardEstimate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StreamValidatorSetChanges(ctx context.Context, in *StreamValidatorSetChangesRequest, opts ...grpc.CallOption) (Query_StreamValidatorSetChangesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Query_serviceDesc.Streams[0], "/interchain_security.ccv.provider.v1.Query/StreamValidatorSetChanges", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &queryStreamValidatorSetChangesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Query_StreamValidatorSetChangesClient interface {
+	Recv() (*StreamValidatorSetChangesResponse, error)
+	grpc.ClientStream
+}
+
+type queryStreamValidatorSetChangesClient struct {
+	grpc.ClientStream
+}
+
+func (x *queryStreamValidatorSetChangesClient) Recv() (*StreamValidatorSetChangesResponse, error) {
+	m := new(StreamValidatorSetChangesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// ConsumerGenesis queries the genesis state needed to start a consumer chain
+	// whose proposal has been accepted
+	QueryConsumerGenesis(context.Context, *QueryConsumerGenesisRequest) (*QueryConsumerGenesisResponse, error)
+	// ConsumerChains queries active consumer chains supported by the provider
+	// chain
+	QueryConsumerChains(context.Context, *QueryConsumerChainsRequest) (*QueryConsumerChainsResponse, error)
+	// QueryValidatorConsumerAddr queries the address
+	// assigned by a validator for a consumer chain.
+	QueryValidatorConsumerAddr(context.Context, *QueryValidatorConsumerAddrRequest) (*QueryValidatorConsumerAddrResponse, error)
+	// QueryProviderAddr returns the provider chain validator
+	// given a consumer chain validator address
+	QueryValidatorProviderAddr(context.Context, *QueryValidatorProviderAddrRequest) (*QueryValidatorProviderAddrResponse, error)
+	// QueryThrottleState returns the main on-chain state relevant to currently
+	// throttled slash packets
+	QueryThrottleState(context.Context, *QueryThrottleStateRequest) (*QueryThrottleStateResponse, error)
+	// QueryRegisteredConsumerRewardDenoms returns a list of consumer reward
+	// denoms that are registered
+	QueryRegisteredConsumerRewardDenoms(context.Context, *QueryRegisteredConsumerRewardDenomsRequest) (*QueryRegisteredConsumerRewardDenomsResponse, error)
+	// QueryAllPairsValConsAddrByConsumer returns a list of pair valconsensus address
+	// between provider and consumer chain
+	QueryAllPairsValConsAddrByConsumer(context.Context, *QueryAllPairsValConsAddrByConsumerRequest) (*QueryAllPairsValConsAddrByConsumerResponse, error)
+	// QueryParams returns all current values of provider parameters
+	QueryParams(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// QueryConsumerChainOptedInValidators returns a list of validators consensus addresses
+	// that opted-in to the given consumer chain
+	QueryConsumerChainOptedInValidators(context.Context, *QueryConsumerChainOptedInValidatorsRequest) (*QueryConsumerChainOptedInValidatorsResponse, error)
+	// QueryConsumerChainsValidatorHasToValidate returns a list of consumer chains
+	// that a given validator must validate
+	QueryConsumerChainsValidatorHasToValidate(context.Context, *QueryConsumerChainsValidatorHasToValidateRequest) (*QueryConsumerChainsValidatorHasToValidateResponse, error)
+	// QueryValidatorConsumerCommissionRate returns the commission rate a given
+	// validator charges on a given consumer chain
+	QueryValidatorConsumerCommissionRate(context.Context, *QueryValidatorConsumerCommissionRateRequest) (*QueryValidatorConsumerCommissionRateResponse, error)
+	// QueryConsumerValidators returns the latest set consumer-validator set for a given consumer ID
+	// Note that this does not necessarily mean that the consumer chain is using this validator set at this exact moment
+	// because a VSCPacket could be delayed to be delivered on the consumer chain.
+	QueryConsumerValidators(context.Context, *QueryConsumerValidatorsRequest) (*QueryConsumerValidatorsResponse, error)
+	// QueryBlocksUntilNextEpoch returns the number of blocks until the next epoch
+	// starts and validator updates are sent to the consumer chains
+	QueryBlocksUntilNextEpoch(context.Context, *QueryBlocksUntilNextEpochRequest) (*QueryBlocksUntilNextEpochResponse, error)
+	// QueryConsumerIdFromClientId returns the consumer id of the chain
+	// associated with the provided client id
+	QueryConsumerIdFromClientId(context.Context, *QueryConsumerIdFromClientIdRequest) (*QueryConsumerIdFromClientIdResponse, error)
+	// QueryConsumerChain returns the consumer chain
+	// associated with the provided consumer id
+	QueryConsumerChain(context.Context, *QueryConsumerChainRequest) (*QueryConsumerChainResponse, error)
+	// QueryConsumerGenesisTime returns the genesis time
+	// of the consumer chain associated with the provided consumer id
+	QueryConsumerGenesisTime(context.Context, *QueryConsumerGenesisTimeRequest) (*QueryConsumerGenesisTimeResponse, error)
+	// QueryNearTimeoutPackets returns the VSC packets sent to consumer chains
+	// that are not yet acknowledged and that time out within the given duration
+	QueryNearTimeoutPackets(context.Context, *QueryNearTimeoutPacketsRequest) (*QueryNearTimeoutPacketsResponse, error)
+	// QueryLaunchCapacity returns the number of launched consumer chains
+	// and the number of consumer chains that can still be launched
+	QueryLaunchCapacity(context.Context, *QueryLaunchCapacityRequest) (*QueryLaunchCapacityResponse, error)
+	// QueryPowerShapingPipeline returns the power shaping steps applied, in evaluation order,
+	// the last time the validator set of a consumer chain was computed
+	QueryPowerShapingPipeline(context.Context, *QueryPowerShapingPipelineRequest) (*QueryPowerShapingPipelineResponse, error)
+	// QueryConsumerValidatorPowerProjection returns the validator set that would be sent
+	// to a launched consumer chain in the next VSCPacket, after applying the power shaping
+	// parameters, without committing it
+	QueryConsumerValidatorPowerProjection(context.Context, *QueryConsumerValidatorPowerProjectionRequest) (*QueryConsumerValidatorPowerProjectionResponse, error)
+	// QueryModuleAccountsSummary returns the balances of the CCV module accounts
+	// and the most recent transfers of funds in and out of them
+	QueryModuleAccountsSummary(context.Context, *QueryModuleAccountsSummaryRequest) (*QueryModuleAccountsSummaryResponse, error)
+	// QueryConsumerLaunchFailure returns the last failed launch of a consumer chain
+	QueryConsumerLaunchFailure(context.Context, *QueryConsumerLaunchFailureRequest) (*QueryConsumerLaunchFailureResponse, error)
+	// QueryConsumerHashCommitment returns the genesis and binary hashes of a consumer chain,
+	// whether they were updated since registration and whether the owner attested them
+	QueryConsumerHashCommitment(context.Context, *QueryConsumerHashCommitmentRequest) (*QueryConsumerHashCommitmentResponse, error)
+	// QueryConsumerCreatorAllowlist returns the list of addresses allowed to create consumer chains;
+	// an empty list means that anyone can create a consumer chain
+	QueryConsumerCreatorAllowlist(context.Context, *QueryConsumerCreatorAllowlistRequest) (*QueryConsumerCreatorAllowlistResponse, error)
+	// QueryValidatorCCVSummary returns the staking and CCV data of a provider validator,
+	// i.e., the consumer chains it validates or has to validate, its assigned consumer keys,
+	// its per-consumer commission rates, its outstanding rewards and its infractions
+	QueryValidatorCCVSummary(context.Context, *QueryValidatorCCVSummaryRequest) (*QueryValidatorCCVSummaryResponse, error)
+	// QueryConsumerPacketStats returns the number of CCV packets exchanged with a consumer chain
+	QueryConsumerPacketStats(context.Context, *QueryConsumerPacketStatsRequest) (*QueryConsumerPacketStatsResponse, error)
+	// QueryConsumerClientUpgradePlans returns the pending upgrades of the consumer clients
+	QueryConsumerClientUpgradePlans(context.Context, *QueryConsumerClientUpgradePlansRequest) (*QueryConsumerClientUpgradePlansResponse, error)
+	// QueryConsumerProviderSwitches returns the switches of the consumer chains
+	// to different provider chains registered by their owners
+	QueryConsumerProviderSwitches(context.Context, *QueryConsumerProviderSwitchesRequest) (*QueryConsumerProviderSwitchesResponse, error)
+	// QueryConsumerClientExpiries returns the times at which the clients of the
+	// launched consumer chains expire if they are not updated
+	QueryConsumerClientExpiries(context.Context, *QueryConsumerClientExpiriesRequest) (*QueryConsumerClientExpiriesResponse, error)
+	// QueryThrottleQueueState returns the state of the slash meter together with
+	// the slash packets that were bounced because the slash meter was negative
+	// and are pending a retry from their consumer chains
+	QueryThrottleQueueState(context.Context, *QueryThrottleQueueStateRequest) (*QueryThrottleQueueStateResponse, error)
+	// QueryLastVSCPacket returns the last VSC packet sent to a consumer chain,
+	// with the validators of its validator updates and slash acknowledgements resolved
+	QueryLastVSCPacket(context.Context, *QueryLastVSCPacketRequest) (*QueryLastVSCPacketResponse, error)
+	// QueryConsumerThrottleState returns the throttling parameters of a consumer chain
+	// and the state of the slash meter throttling its slash packets
+	QueryConsumerThrottleState(context.Context, *QueryConsumerThrottleStateRequest) (*QueryConsumerThrottleStateResponse, error)
+	// QueryPendingInfractionParameterUpdates returns the infraction parameter updates
+	// that are queued for consumer chains, ordered by the time at which they are applied
+	QueryPendingInfractionParameterUpdates(context.Context, *QueryPendingInfractionParameterUpdatesRequest) (*QueryPendingInfractionParameterUpdatesResponse, error)
+	// QueryStaleKeyAssignments returns the assigned consumer keys of a consumer chain
+	// that were not observed in any heartbeat of the consumer chain for the
+	// StaleKeyAssignmentEpochs param
+	QueryStaleKeyAssignments(context.Context, *QueryStaleKeyAssignmentsRequest) (*QueryStaleKeyAssignmentsResponse, error)
+	// QueryConsumerUpdateHistory returns the most recent accepted updates of a consumer chain,
+	// oldest first, together with the fields changed by every update
+	QueryConsumerUpdateHistory(context.Context, *QueryConsumerUpdateHistoryRequest) (*QueryConsumerUpdateHistoryResponse, error)
+	// QueryValidatorOptInHistory returns, per consumer chain, the most recent opt-ins
+	// and opt-outs of a validator, oldest first
+	QueryValidatorOptInHistory(context.Context, *QueryValidatorOptInHistoryRequest) (*QueryValidatorOptInHistoryResponse, error)
+	// QueryModuleVersionInfo returns the version of the provider module
+	// and the features enabled on the provider chain
+	QueryModuleVersionInfo(context.Context, *QueryModuleVersionInfoRequest) (*QueryModuleVersionInfoResponse, error)
+	// QueryConsumerRewardEstimate returns the estimated annual ICS rewards of a validator
+	// for a consumer chain, based on the recent inflow of rewards from the consumer chain,
+	// the power share of the validator on the consumer chain and its commission rate
+	QueryConsumerRewardEstimate(context.Context, *QueryConsumerRewardEstimateRequest) (*QueryConsumerRewardEstimateResponse, error)
+	// StreamValidatorSetChanges streams the VSC packets queued for a consumer chain
+	// once the blocks in which they are queued are committed. Note that this query is served only over gRPC,
+	// i.e., neither through the REST gateway nor through ABCI queries.
+	StreamValidatorSetChanges(*StreamValidatorSetChangesRequest, Query_StreamValidatorSetChangesServer) error
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) QueryConsumerGenesis(ctx context.Context, req *QueryConsumerGenesisRequest) (*QueryConsumerGenesisResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryConsumerGenesis not implemented")
+}
+func (*UnimplementedQueryServer) QueryConsumerChains(ctx context.Context, req *QueryConsumerChainsRequest) (*QueryConsumerChainsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryConsumerChains not implemented")
+}
+func (*UnimplementedQueryServer) QueryValidatorConsumerAddr(ctx context.Context, req *QueryValidatorConsumerAddrRequest) (*QueryValidatorConsumerAddrResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryValidatorConsumerAddr not implemented")
+}
+func (*UnimplementedQueryServer) QueryValidatorProviderAddr(ctx context.Context, req *QueryValidatorProviderAddrRequest) (*QueryValidatorProviderAddrResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryValidatorProviderAddr not implemented")
+}
+func (*UnimplementedQueryServer) QueryThrottleState(ctx context.Context, req *QueryThrottleStateRequest) (*QueryThrottleStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryThrottleState not implemented")
+}
+func (*UnimplementedQueryServer) QueryRegisteredConsumerRewardDenoms(ctx context.Context, req *QueryRegisteredConsumerRewardDenomsRequest) (*QueryRegisteredConsumerRewardDenomsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryRegisteredConsumerRewardDenoms not implemented")
+}
+func (*UnimplementedQueryServer) QueryAllPairsValConsAddrByConsumer(ctx context.Context, req *QueryAllPairsValConsAddrByConsumerRequest) (*QueryAllPairsValConsAddrByConsumerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryAllPairsValConsAddrByConsumer not implemented")
+}
+func (*UnimplementedQueryServer) QueryParams(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryParams not implemented")
+}
+func (*UnimplementedQueryServer) QueryConsumerChainOptedInValidators(ctx context.Context, req *QueryConsumerChainOptedInValidatorsRequest) (*QueryConsumerChainOptedInValidatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryConsumerChainOptedInValidators not implemented")
+}
+func (*UnimplementedQueryServer) QueryConsumerChainsValidatorHasToValidate(ctx context.Context, req *QueryConsumerChainsValidatorHasToValidateRequest) (*QueryConsumerChainsValidatorHasToValidateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryConsumerChainsValidatorHasToValidate not implemented")
+}
+func (*UnimplementedQueryServer) QueryValidatorConsumerCommissionRate(ctx context.Context, req *QueryValidatorConsumerCommissionRateRequest) (*QueryValidatorConsumerCommissionRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryValidatorConsumerCommissionRate not implemented")
+}
+func (*UnimplementedQueryServer) QueryConsumerValidators(ctx context.Context, req *QueryConsumerValidatorsRequest) (*QueryConsumerValidatorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryConsumerValidators not implemented")
+}
+func (*UnimplementedQueryServer) QueryBlocksUntilNextEpoch(ctx context.Context, req *QueryBlocksUntilNextEpochRequest) (*QueryBlocksUntilNextEpochResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryBlocksUntilNextEpoch not implemented")
+}
+func (*UnimplementedQueryServer) QueryConsumerIdFromClientId(ctx context.Context, req *QueryConsumerIdFromClientIdRequest) (*QueryConsumerIdFromClientIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryConsumerIdFromClientId not implemented")
+}
+func (*UnimplementedQueryServer) QueryConsumerChain(ctx context.Context, req *QueryConsumerChainRequest) (*QueryConsumerChainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryConsumerChain not implemented")
+}
+func (*UnimplementedQueryServer) QueryConsumerGenesisTime(ctx context.Context, req *QueryConsumerGenesisTimeRequest) (*QueryConsumerGenesisTimeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryConsumerGenesisTime not implemented")
+}
+func (*UnimplementedQueryServer) QueryNearTimeoutPackets(ctx context.Context, req *QueryNearTimeoutPacketsRequest) (*QueryNearTimeoutPacketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryNearTimeoutPackets not implemented")
+}
+func (*UnimplementedQueryServer) QueryLaunchCapacity(ctx context.Context, req *QueryLaunchCapacityRequest) (*QueryLaunchCapacityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryLaunchCapacity not implemented")
+}
+func (*UnimplementedQueryServer) QueryPowerShapingPipeline(ctx context.Context, req *QueryPowerShapingPipelineRequest) (*QueryPowerShapingPipelineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryPowerShapingPipeline not implemented")
+}
+func (*UnimplementedQueryServer) QueryConsumerValidatorPowerProjection(ctx context.Context, req *QueryConsumerValidatorPowerProjectionRequest) (*QueryConsumerValidatorPowerProjectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryConsumerValidatorPowerProjection not implemented")
+}
+func (*UnimplementedQueryServer) QueryModuleAccountsSummary(ctx context.Context, req *QueryModuleAccountsSummaryRequest) (*QueryModuleAccountsSummaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryModuleAccountsSummary not implemented")
+}
+func (*UnimplementedQueryServer) QueryConsumerLaunchFailure(ctx context.Context, req *QueryConsumerLaunchFailureRequest) (*QueryConsumerLaunchFailureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryConsumerLaunchFailure not implemented")
+}
+func (*UnimplementedQueryServer) QueryConsumerHashCommitment(ctx context.Context, req *QueryConsumerHashCommitmentRequest) (*QueryConsumerHashCommitmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryConsumerHashCommitment not implemented")
+}
+func (*UnimplementedQueryServer) QueryConsumerCreatorAllowlist(ctx context.Context, req *QueryConsumerCreatorAllowlistRequest) (*QueryConsumerCreatorAllowlistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryConsumerCreatorAllowlist not implemented")
+}
+func (*UnimplementedQueryServer) QueryValidatorCCVSummary(ctx context.Context, req *QueryValidatorCCVSummaryRequest) (*QueryValidatorCCVSummaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryValidatorCCVSummary not implemented")
+}
+func (*UnimplementedQueryServer) QueryConsumerPacketStats(ctx context.Context, req *QueryConsumerPacketStatsRequest) (*QueryConsumerPacketStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryConsumerPacketStats not implemented")
+}
+func (*UnimplementedQueryServer) QueryConsumerClientUpgradePlans(ctx context.Context, req *QueryConsumerClientUpgradePlansRequest) (*QueryConsumerClientUpgradePlansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryConsumerClientUpgradePlans not implemented")
+}
+func (*UnimplementedQueryServer) QueryConsumerProviderSwitches(ctx context.Context, req *QueryConsumerProviderSwitchesRequest) (*QueryConsumerProviderSwitchesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryConsumerProviderSwitches not implemented")
+}
+func (*UnimplementedQueryServer) QueryConsumerClientExpiries(ctx context.Context, req *QueryConsumerClientExpiriesRequest) (*QueryConsumerClientExpiriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryConsumerClientExpiries not implemented")
+}
+func (*UnimplementedQueryServer) QueryThrottleQueueState(ctx context.Context, req *QueryThrottleQueueStateRequest) (*QueryThrottleQueueStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryThrottleQueueState not implemented")
+}
+func (*UnimplementedQueryServer) QueryLastVSCPacket(ctx context.Context, req *QueryLastVSCPacketRequest) (*QueryLastVSCPacketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryLastVSCPacket not implemented")
+}
+func (*UnimplementedQueryServer) QueryConsumerThrottleState(ctx context.Context, req *QueryConsumerThrottleStateRequest) (*QueryConsumerThrottleStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryConsumerThrottleState not implemented")
+}
+func (*UnimplementedQueryServer) QueryPendingInfractionParameterUpdates(ctx context.Context, req *QueryPendingInfractionParameterUpdatesRequest) (*QueryPendingInfractionParameterUpdatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryPendingInfractionParameterUpdates not implemented")
+}
+func (*UnimplementedQueryServer) QueryStaleKeyAssignments(ctx context.Context, req *QueryStaleKeyAssignmentsRequest) (*QueryStaleKeyAssignmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryStaleKeyAssignments not implemented")
+}
+func (*UnimplementedQueryServer) QueryConsumerUpdateHistory(ctx context.Context, req *QueryConsumerUpdateHistoryRequest) (*QueryConsumerUpdateHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryConsumerUpdateHistory not implemented")
+}
+func (*UnimplementedQueryServer) QueryValidatorOptInHistory(ctx context.Context, req *QueryValidatorOptInHistoryRequest) (*QueryValidatorOptInHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryValidatorOptInHistory not implemented")
+}
+func (*UnimplementedQueryServer) QueryModuleVersionInfo(ctx context.Context, req *QueryModuleVersionInfoRequest) (*QueryModuleVersionInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryModuleVersionInfo not implemented")
+}
+func (*UnimplementedQueryServer) QueryConsumerRewardEstimate(ctx context.Context, req *QueryConsumerRewardEstimateRequest) (*QueryConsumerRewardEstimateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryConsumerRewardEstimate not implemented")
+}
+func (*UnimplementedQueryServer) StreamValidatorSetChanges(req *StreamValidatorSetChangesRequest, srv Query_StreamValidatorSetChangesServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamValidatorSetChanges not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_QueryConsumerGenesis_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsumerGenesisRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryConsumerGenesis(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryConsumerGenesis",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryConsumerGenesis(ctx, req.(*QueryConsumerGenesisRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryConsumerChains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsumerChainsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryConsumerChains(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryConsumerChains",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryConsumerChains(ctx, req.(*QueryConsumerChainsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryValidatorConsumerAddr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorConsumerAddrRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryValidatorConsumerAddr(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryValidatorConsumerAddr",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryValidatorConsumerAddr(ctx, req.(*QueryValidatorConsumerAddrRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryValidatorProviderAddr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorProviderAddrRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryValidatorProviderAddr(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryValidatorProviderAddr",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryValidatorProviderAddr(ctx, req.(*QueryValidatorProviderAddrRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryThrottleState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryThrottleStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryThrottleState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryThrottleState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryThrottleState(ctx, req.(*QueryThrottleStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryRegisteredConsumerRewardDenoms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRegisteredConsumerRewardDenomsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryRegisteredConsumerRewardDenoms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryRegisteredConsumerRewardDenoms",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryRegisteredConsumerRewardDenoms(ctx, req.(*QueryRegisteredConsumerRewardDenomsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryAllPairsValConsAddrByConsumer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllPairsValConsAddrByConsumerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryAllPairsValConsAddrByConsumer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryAllPairsValConsAddrByConsumer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryAllPairsValConsAddrByConsumer(ctx, req.(*QueryAllPairsValConsAddrByConsumerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryParams(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryConsumerChainOptedInValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsumerChainOptedInValidatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryConsumerChainOptedInValidators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryConsumerChainOptedInValidators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryConsumerChainOptedInValidators(ctx, req.(*QueryConsumerChainOptedInValidatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryConsumerChainsValidatorHasToValidate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsumerChainsValidatorHasToValidateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryConsumerChainsValidatorHasToValidate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryConsumerChainsValidatorHasToValidate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryConsumerChainsValidatorHasToValidate(ctx, req.(*QueryConsumerChainsValidatorHasToValidateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryValidatorConsumerCommissionRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorConsumerCommissionRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryValidatorConsumerCommissionRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryValidatorConsumerCommissionRate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryValidatorConsumerCommissionRate(ctx, req.(*QueryValidatorConsumerCommissionRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryConsumerValidators_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsumerValidatorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryConsumerValidators(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryConsumerValidators",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryConsumerValidators(ctx, req.(*QueryConsumerValidatorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryBlocksUntilNextEpoch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlocksUntilNextEpochRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryBlocksUntilNextEpoch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryBlocksUntilNextEpoch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryBlocksUntilNextEpoch(ctx, req.(*QueryBlocksUntilNextEpochRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryConsumerIdFromClientId_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsumerIdFromClientIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryConsumerIdFromClientId(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryConsumerIdFromClientId",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryConsumerIdFromClientId(ctx, req.(*QueryConsumerIdFromClientIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryConsumerChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsumerChainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryConsumerChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryConsumerChain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryConsumerChain(ctx, req.(*QueryConsumerChainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryConsumerGenesisTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsumerGenesisTimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryConsumerGenesisTime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryConsumerGenesisTime",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryConsumerGenesisTime(ctx, req.(*QueryConsumerGenesisTimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryNearTimeoutPackets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNearTimeoutPacketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryNearTimeoutPackets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryNearTimeoutPackets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryNearTimeoutPackets(ctx, req.(*QueryNearTimeoutPacketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryLaunchCapacity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLaunchCapacityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryLaunchCapacity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryLaunchCapacity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryLaunchCapacity(ctx, req.(*QueryLaunchCapacityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryPowerShapingPipeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPowerShapingPipelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryPowerShapingPipeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryPowerShapingPipeline",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryPowerShapingPipeline(ctx, req.(*QueryPowerShapingPipelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryConsumerValidatorPowerProjection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsumerValidatorPowerProjectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryConsumerValidatorPowerProjection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryConsumerValidatorPowerProjection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryConsumerValidatorPowerProjection(ctx, req.(*QueryConsumerValidatorPowerProjectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryModuleAccountsSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryModuleAccountsSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryModuleAccountsSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryModuleAccountsSummary",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryModuleAccountsSummary(ctx, req.(*QueryModuleAccountsSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryConsumerLaunchFailure_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsumerLaunchFailureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryConsumerLaunchFailure(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryConsumerLaunchFailure",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryConsumerLaunchFailure(ctx, req.(*QueryConsumerLaunchFailureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryConsumerHashCommitment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsumerHashCommitmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryConsumerHashCommitment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryConsumerHashCommitment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryConsumerHashCommitment(ctx, req.(*QueryConsumerHashCommitmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryConsumerCreatorAllowlist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsumerCreatorAllowlistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryConsumerCreatorAllowlist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryConsumerCreatorAllowlist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryConsumerCreatorAllowlist(ctx, req.(*QueryConsumerCreatorAllowlistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryValidatorCCVSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorCCVSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryValidatorCCVSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryValidatorCCVSummary",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryValidatorCCVSummary(ctx, req.(*QueryValidatorCCVSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryConsumerPacketStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsumerPacketStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryConsumerPacketStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryConsumerPacketStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryConsumerPacketStats(ctx, req.(*QueryConsumerPacketStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryConsumerClientUpgradePlans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsumerClientUpgradePlansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryConsumerClientUpgradePlans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryConsumerClientUpgradePlans",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryConsumerClientUpgradePlans(ctx, req.(*QueryConsumerClientUpgradePlansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryConsumerProviderSwitches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsumerProviderSwitchesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryConsumerProviderSwitches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryConsumerProviderSwitches",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryConsumerProviderSwitches(ctx, req.(*QueryConsumerProviderSwitchesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryConsumerClientExpiries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsumerClientExpiriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryConsumerClientExpiries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryConsumerClientExpiries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryConsumerClientExpiries(ctx, req.(*QueryConsumerClientExpiriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryThrottleQueueState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryThrottleQueueStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryThrottleQueueState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryThrottleQueueState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryThrottleQueueState(ctx, req.(*QueryThrottleQueueStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryLastVSCPacket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLastVSCPacketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryLastVSCPacket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryLastVSCPacket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryLastVSCPacket(ctx, req.(*QueryLastVSCPacketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryConsumerThrottleState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsumerThrottleStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryConsumerThrottleState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryConsumerThrottleState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryConsumerThrottleState(ctx, req.(*QueryConsumerThrottleStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryPendingInfractionParameterUpdates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingInfractionParameterUpdatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryPendingInfractionParameterUpdates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryPendingInfractionParameterUpdates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryPendingInfractionParameterUpdates(ctx, req.(*QueryPendingInfractionParameterUpdatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryStaleKeyAssignments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStaleKeyAssignmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryStaleKeyAssignments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryStaleKeyAssignments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryStaleKeyAssignments(ctx, req.(*QueryStaleKeyAssignmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryConsumerUpdateHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsumerUpdateHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryConsumerUpdateHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryConsumerUpdateHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryConsumerUpdateHistory(ctx, req.(*QueryConsumerUpdateHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryValidatorOptInHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorOptInHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryValidatorOptInHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryValidatorOptInHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryValidatorOptInHistory(ctx, req.(*QueryValidatorOptInHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryModuleVersionInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryModuleVersionInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryModuleVersionInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryModuleVersionInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryModuleVersionInfo(ctx, req.(*QueryModuleVersionInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_QueryConsumerRewardEstimate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryConsumerRewardEstimateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryConsumerRewardEstimate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/interchain_security.ccv.provider.v1.Query/QueryConsumerRewardEstimate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryConsumerRewardEstimate(ctx, req.(*QueryConsumerRewardEstimateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StreamValidatorSetChanges_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamValidatorSetChangesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(QueryServer).StreamValidatorSetChanges(m, &queryStreamValidatorSetChangesServer{stream})
+}
+
+type Query_StreamValidatorSetChangesServer interface {
+	Send(*StreamValidatorSetChangesResponse) error
+	grpc.ServerStream
+}
+
+type queryStreamValidatorSetChangesServer struct {
+	grpc.ServerStream
+}
+
+func (x *queryStreamValidatorSetChangesServer) Send(m *StreamValidatorSetChangesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "interchain_security.ccv.provider.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "QueryConsumerGenesis",
+			Handler:    _Query_QueryConsumerGenesis_Handler,
+		},
+		{
+			MethodName: "QueryConsumerChains",
+			Handler:    _Query_QueryConsumerChains_Handler,
+		},
+		{
+			MethodName: "QueryValidatorConsumerAddr",
+			Handler:    _Query_QueryValidatorConsumerAddr_Handler,
+		},
+		{
+			MethodName: "QueryValidatorProviderAddr",
+			Handler:    _Query_QueryValidatorProviderAddr_Handler,
+		},
+		{
+			MethodName: "QueryThrottleState",
+			Handler:    _Query_QueryThrottleState_Handler,
+		},
+		{
+			MethodName: "QueryRegisteredConsumerRewardDenoms",
+			Handler:    _Query_QueryRegisteredConsumerRewardDenoms_Handler,
+		},
+		{
+			MethodName: "QueryAllPairsValConsAddrByConsumer",
+			Handler:    _Query_QueryAllPairsValConsAddrByConsumer_Handler,
+		},
+		{
+			MethodName: "QueryParams",
+			Handler:    _Query_QueryParams_Handler,
+		},
+		{
+			MethodName: "QueryConsumerChainOptedInValidators",
+			Handler:    _Query_QueryConsumerChainOptedInValidators_Handler,
+		},
+		{
+			MethodName: "QueryConsumerChainsValidatorHasToValidate",
+			Handler:    _Query_QueryConsumerChainsValidatorHasToValidate_Handler,
+		},
+		{
+			MethodName: "QueryValidatorConsumerCommissionRate",
+			Handler:    _Query_QueryValidatorConsumerCommissionRate_Handler,
+		},
+		{
+			MethodName: "QueryConsumerValidators",
+			Handler:    _Query_QueryConsumerValidators_Handler,
+		},
+		{
+			MethodName: "QueryBlocksUntilNextEpoch",
+			Handler:    _Query_QueryBlocksUntilNextEpoch_Handler,
+		},
+		{
+			MethodName: "QueryConsumerIdFromClientId",
+			Handler:    _Query_QueryConsumerIdFromClientId_Handler,
+		},
+		{
+			MethodName: "QueryConsumerChain",
+			Handler:    _Query_QueryConsumerChain_Handler,
+		},
+		{
+			MethodName: "QueryConsumerGenesisTime",
+			Handler:    _Query_QueryConsumerGenesisTime_Handler,
+		},
+		{
+			MethodName: "QueryNearTimeoutPackets",
+			Handler:    _Query_QueryNearTimeoutPackets_Handler,
+		},
+		{
+			MethodName: "QueryLaunchCapacity",
+			Handler:    _Query_QueryLaunchCapacity_Handler,
+		},
+		{
+			MethodName: "QueryPowerShapingPipeline",
+			Handler:    _Query_QueryPowerShapingPipeline_Handler,
+		},
+		{
+			MethodName: "QueryConsumerValidatorPowerProjection",
+			Handler:    _Query_QueryConsumerValidatorPowerProjection_Handler,
+		},
+		{
+			MethodName: "QueryModuleAccountsSummary",
+			Handler:    _Query_QueryModuleAccountsSummary_Handler,
+		},
+		{
+			MethodName: "QueryConsumerLaunchFailure",
+			Handler:    _Query_QueryConsumerLaunchFailure_Handler,
+		},
+		{
+			MethodName: "QueryConsumerHashCommitment",
+			Handler:    _Query_QueryConsumerHashCommitment_Handler,
+		},
+		{
+			MethodName: "QueryConsumerCreatorAllowlist",
+			Handler:    _Query_QueryConsumerCreatorAllowlist_Handler,
+		},
+		{
+			MethodName: "QueryValidatorCCVSummary",
+			Handler:    _Query_QueryValidatorCCVSummary_Handler,
+		},
+		{
+			MethodName: "QueryConsumerPacketStats",
+			Handler:    _Query_QueryConsumerPacketStats_Handler,
+		},
+		{
+			MethodName: "QueryConsumerClientUpgradePlans",
+			Handler:    _Query_QueryConsumerClientUpgradePlans_Handler,
+		},
+		{
+			MethodName: "QueryConsumerProviderSwitches",
+			Handler:    _Query_QueryConsumerProviderSwitches_Handler,
+		},
+		{
+			MethodName: "QueryConsumerClientExpiries",
+			Handler:    _Query_QueryConsumerClientExpiries_Handler,
+		},
+		{
+			MethodName: "QueryThrottleQueueState",
+			Handler:    _Query_QueryThrottleQueueState_Handler,
+		},
+		{
+			MethodName: "QueryLastVSCPacket",
+			Handler:    _Query_QueryLastVSCPacket_Handler,
+		},
+		{
+			MethodName: "QueryConsumerThrottleState",
+			Handler:    _Query_QueryConsumerThrottleState_Handler,
+		},
+		{
+			MethodName: "QueryPendingInfractionParameterUpdates",
+			Handler:    _Query_QueryPendingInfractionParameterUpdates_Handler,
+		},
+		{
+			MethodName: "QueryStaleKeyAssignments",
+			Handler:    _Query_QueryStaleKeyAssignments_Handler,
+		},
+		{
+			MethodName: "QueryConsumerUpdateHistory",
+			Handler:    _Query_QueryConsumerUpdateHistory_Handler,
+		},
+		{
+			MethodName: "QueryValidatorOptInHistory",
+			Handler:    _Query_QueryValidatorOptInHistory_Handler,
+		},
+		{
+			MethodName: "QueryModuleVersionInfo",
+			Handler:    _Query_QueryModuleVersionInfo_Handler,
+		},
+		{
+			MethodName: "QueryConsumerRewardEstimate",
+			Handler:    _Query_QueryConsumerRewardEstimate_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamValidatorSetChanges",
+			Handler:       _Query_StreamValidatorSetChanges_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "interchain_security/ccv/provider/v1/query.proto",
+}
+
+func (m *QueryConsumerGenesisRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerGenesisRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerGenesisRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerGenesisResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerGenesisResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerGenesisResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.GenesisState.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerChainsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerChainsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerChainsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Phase != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Phase))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerChainsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerChainsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerChainsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Chains) > 0 {
+		for iNdEx := len(m.Chains) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Chains[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Chain) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Chain) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Chain) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PrioritylistWeights) > 0 {
+		for iNdEx := len(m.PrioritylistWeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PrioritylistWeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if m.Top_NWeightedEpochs != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Top_NWeightedEpochs))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.InfractionParameters != nil {
+		{
+			size, err := m.InfractionParameters.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.Prioritylist) > 0 {
+		for iNdEx := len(m.Prioritylist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Prioritylist[iNdEx])
+			copy(dAtA[i:], m.Prioritylist[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Prioritylist[iNdEx])))
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if m.AllowlistedRewardDenoms != nil {
+		{
+			size, err := m.AllowlistedRewardDenoms.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.AllowInactiveVals {
+		i--
+		if m.AllowInactiveVals {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.MinStake != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MinStake))
+		i--
+		dAtA[i] = 0x58
+	}
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if len(m.Phase) > 0 {
+		i -= len(m.Phase)
+		copy(dAtA[i:], m.Phase)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Phase)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Denylist) > 0 {
+		for iNdEx := len(m.Denylist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denylist[iNdEx])
+			copy(dAtA[i:], m.Denylist[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Denylist[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Allowlist) > 0 {
+		for iNdEx := len(m.Allowlist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Allowlist[iNdEx])
+			copy(dAtA[i:], m.Allowlist[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Allowlist[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.ValidatorSetCap != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ValidatorSetCap))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ValidatorsPowerCap != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ValidatorsPowerCap))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MinPowerInTop_N != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MinPowerInTop_N))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Top_N != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Top_N))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorConsumerAddrRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorConsumerAddrRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorConsumerAddrRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProviderAddress) > 0 {
+		i -= len(m.ProviderAddress)
+		copy(dAtA[i:], m.ProviderAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProviderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorConsumerAddrResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorConsumerAddrResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorConsumerAddrResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsumerAddress) > 0 {
+		i -= len(m.ConsumerAddress)
+		copy(dAtA[i:], m.ConsumerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorProviderAddrRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorProviderAddrRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorProviderAddrRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConsumerAddress) > 0 {
+		i -= len(m.ConsumerAddress)
+		copy(dAtA[i:], m.ConsumerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorProviderAddrResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorProviderAddrResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorProviderAddrResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProviderAddress) > 0 {
+		i -= len(m.ProviderAddress)
+		copy(dAtA[i:], m.ProviderAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProviderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryThrottleStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryThrottleStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryThrottleStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryThrottleStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryThrottleStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryThrottleStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.NextReplenishCandidate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.NextReplenishCandidate):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintQuery(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x1a
+	if m.SlashMeterAllowance != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SlashMeterAllowance))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SlashMeter != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SlashMeter))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRegisteredConsumerRewardDenomsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRegisteredConsumerRewardDenomsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRegisteredConsumerRewardDenomsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRegisteredConsumerRewardDenomsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRegisteredConsumerRewardDenomsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRegisteredConsumerRewardDenomsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denoms[iNdEx])
+			copy(dAtA[i:], m.Denoms[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Denoms[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllPairsValConsAddrByConsumerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllPairsValConsAddrByConsumerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllPairsValConsAddrByConsumerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllPairsValConsAddrByConsumerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllPairsValConsAddrByConsumerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllPairsValConsAddrByConsumerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PairValConAddr) > 0 {
+		for iNdEx := len(m.PairValConAddr) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PairValConAddr[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PairValConAddrProviderAndConsumer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PairValConAddrProviderAndConsumer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PairValConAddrProviderAndConsumer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ConsumerKey != nil {
+		{
+			size, err := m.ConsumerKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ConsumerAddress) > 0 {
+		i -= len(m.ConsumerAddress)
+		copy(dAtA[i:], m.ConsumerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProviderAddress) > 0 {
+		i -= len(m.ProviderAddress)
+		copy(dAtA[i:], m.ProviderAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProviderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerChainOptedInValidatorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerChainOptedInValidatorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerChainOptedInValidatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerChainOptedInValidatorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerChainOptedInValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerChainOptedInValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorsProviderAddresses) > 0 {
+		for iNdEx := len(m.ValidatorsProviderAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ValidatorsProviderAddresses[iNdEx])
+			copy(dAtA[i:], m.ValidatorsProviderAddresses[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorsProviderAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerValidatorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerValidatorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerValidatorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerValidatorsValidator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerValidatorsValidator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerValidatorsValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ValidatesCurrentEpoch {
+		i--
+		if m.ValidatesCurrentEpoch {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.ProviderPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProviderPower))
+		i--
+		dAtA[i] = 0x68
+	}
+	{
+		size := m.ProviderTokens.Size()
+		i -= size
+		if _, err := m.ProviderTokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.Jailed {
+		i--
+		if m.Jailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.ProviderOperatorAddress) > 0 {
+		i -= len(m.ProviderOperatorAddress)
+		copy(dAtA[i:], m.ProviderOperatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProviderOperatorAddress)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	{
+		size, err := m.Description.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.ProviderCommissionRate.Size()
+		i -= size
+		if _, err := m.ProviderCommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.ConsumerCommissionRate.Size()
+		i -= size
+		if _, err := m.ConsumerCommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.ConsumerPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ConsumerPower))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Power != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ConsumerKey != nil {
+		{
+			size, err := m.ConsumerKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProviderAddress) > 0 {
+		i -= len(m.ProviderAddress)
+		copy(dAtA[i:], m.ProviderAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProviderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerValidatorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerValidatorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerValidatorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerChainsValidatorHasToValidateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerChainsValidatorHasToValidateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerChainsValidatorHasToValidateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProviderAddress) > 0 {
+		i -= len(m.ProviderAddress)
+		copy(dAtA[i:], m.ProviderAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProviderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerChainsValidatorHasToValidateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerChainsValidatorHasToValidateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerChainsValidatorHasToValidateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsumerIds) > 0 {
+		for iNdEx := len(m.ConsumerIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ConsumerIds[iNdEx])
+			copy(dAtA[i:], m.ConsumerIds[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorConsumerCommissionRateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorConsumerCommissionRateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorConsumerCommissionRateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProviderAddress) > 0 {
+		i -= len(m.ProviderAddress)
+		copy(dAtA[i:], m.ProviderAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProviderAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorConsumerCommissionRateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorConsumerCommissionRateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorConsumerCommissionRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlocksUntilNextEpochRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlocksUntilNextEpochRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlocksUntilNextEpochRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlocksUntilNextEpochResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlocksUntilNextEpochResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlocksUntilNextEpochResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlocksUntilNextEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlocksUntilNextEpoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerIdFromClientIdRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerIdFromClientIdRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerIdFromClientIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerIdFromClientIdResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerIdFromClientIdResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerIdFromClientIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerChainRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerChainRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerChainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerChainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerChainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ExpectedInitialHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if m.ThrottlingParameters != nil {
+		{
+			size, err := m.ThrottlingParameters.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.PendingChainId) > 0 {
+		i -= len(m.PendingChainId)
+		copy(dAtA[i:], m.PendingChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PendingChainId)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.InfractionParameters != nil {
+		{
+			size, err := m.InfractionParameters.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.PowerShapingParams != nil {
+		{
+			size, err := m.PowerShapingParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.InitParams != nil {
+		{
+			size, err := m.InitParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Phase) > 0 {
+		i -= len(m.Phase)
+		copy(dAtA[i:], m.Phase)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Phase)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerGenesisTimeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerGenesisTimeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerGenesisTimeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerGenesisTimeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerGenesisTimeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerGenesisTimeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n18, err18 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.GenesisTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.GenesisTime):])
+	if err18 != nil {
+		return 0, err18
+	}
+	i -= n18
+	i = encodeVarintQuery(dAtA, i, uint64(n18))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNearTimeoutPacketsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNearTimeoutPacketsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNearTimeoutPacketsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n19, err19 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Within, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Within):])
+	if err19 != nil {
+		return 0, err19
+	}
+	i -= n19
+	i = encodeVarintQuery(dAtA, i, uint64(n19))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNearTimeoutPacketsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNearTimeoutPacketsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNearTimeoutPacketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Packets) > 0 {
+		for iNdEx := len(m.Packets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Packets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PacketTimeout) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PacketTimeout) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PacketTimeout) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n20, err20 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.TimeoutTimestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.TimeoutTimestamp):])
+	if err20 != nil {
+		return 0, err20
+	}
+	i -= n20
+	i = encodeVarintQuery(dAtA, i, uint64(n20))
+	i--
+	dAtA[i] = 0x22
+	if m.Sequence != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLaunchCapacityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLaunchCapacityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLaunchCapacityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLaunchCapacityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLaunchCapacityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLaunchCapacityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RemainingCapacity != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RemainingCapacity))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.LaunchedConsumers != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LaunchedConsumers))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxLaunchedConsumers != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxLaunchedConsumers))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPowerShapingPipelineRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPowerShapingPipelineRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPowerShapingPipelineRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPowerShapingPipelineResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPowerShapingPipelineResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPowerShapingPipelineResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Pipeline.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerValidatorPowerProjectionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerValidatorPowerProjectionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerValidatorPowerProjectionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerValidatorPowerProjectionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerValidatorPowerProjectionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerValidatorPowerProjectionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RemovedProviderAddresses) > 0 {
+		for iNdEx := len(m.RemovedProviderAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RemovedProviderAddresses[iNdEx])
+			copy(dAtA[i:], m.RemovedProviderAddresses[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.RemovedProviderAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Pipeline.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Validators) > 0 {
+		for iNdEx := len(m.Validators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Validators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProjectedConsumerValidator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProjectedConsumerValidator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProjectedConsumerValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CurrentPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentPower))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Power != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ConsumerKey != nil {
+		{
+			size, err := m.ConsumerKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProviderAddress) > 0 {
+		i -= len(m.ProviderAddress)
+		copy(dAtA[i:], m.ProviderAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProviderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryModuleAccountsSummaryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryModuleAccountsSummaryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryModuleAccountsSummaryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ModuleAccountBalance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ModuleAccountBalance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModuleAccountBalance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Balance) > 0 {
+		for iNdEx := len(m.Balance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryModuleAccountsSummaryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryModuleAccountsSummaryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryModuleAccountsSummaryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TotalOutflow) > 0 {
+		for iNdEx := len(m.TotalOutflow) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalOutflow[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.TotalInflow) > 0 {
+		for iNdEx := len(m.TotalInflow) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalInflow[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Flows) > 0 {
+		for iNdEx := len(m.Flows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Flows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Accounts) > 0 {
+		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerLaunchFailureRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerLaunchFailureRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerLaunchFailureRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerLaunchFailureResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerLaunchFailureResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerLaunchFailureResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Failure.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerHashCommitmentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerHashCommitmentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerHashCommitmentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerHashCommitmentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerHashCommitmentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerHashCommitmentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Commitment.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.Locked {
+		i--
+		if m.Locked {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.UpdatedSinceRegistration {
+		i--
+		if m.UpdatedSinceRegistration {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.BinaryHash) > 0 {
+		i -= len(m.BinaryHash)
+		copy(dAtA[i:], m.BinaryHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BinaryHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.GenesisHash) > 0 {
+		i -= len(m.GenesisHash)
+		copy(dAtA[i:], m.GenesisHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.GenesisHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerCreatorAllowlistRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerCreatorAllowlistRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerCreatorAllowlistRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerCreatorAllowlistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerCreatorAllowlistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerCreatorAllowlistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerPacketStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerPacketStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerPacketStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerPacketStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerPacketStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerPacketStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Stats.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorCCVSummaryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorCCVSummaryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorCCVSummaryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorCCVSummaryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorCCVSummaryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorCCVSummaryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Consumers) > 0 {
+		for iNdEx := len(m.Consumers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Consumers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.OutstandingRewards) > 0 {
+		for iNdEx := len(m.OutstandingRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OutstandingRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.ConsumerDoubleSignLogged {
+		i--
+		if m.ConsumerDoubleSignLogged {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Tombstoned {
+		i--
+		if m.Tombstoned {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Jailed {
+		i--
+		if m.Jailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Power != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.Tokens.Size()
+		i -= size
+		if _, err := m.Tokens.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ProviderAddress) > 0 {
+		i -= len(m.ProviderAddress)
+		copy(dAtA[i:], m.ProviderAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProviderAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorConsumerSummary) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorConsumerSummary) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorConsumerSummary) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CommissionRate.Size()
+		i -= size
+		if _, err := m.CommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if len(m.ConsumerAddress) > 0 {
+		i -= len(m.ConsumerAddress)
+		copy(dAtA[i:], m.ConsumerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerAddress)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.HasToValidate {
+		i--
+		if m.HasToValidate {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ConsumerValidator {
+		i--
+		if m.ConsumerValidator {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.OptedIn {
+		i--
+		if m.OptedIn {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Phase != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Phase))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerClientUpgradePlansRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerClientUpgradePlansRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerClientUpgradePlansRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerClientUpgradePlansResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerClientUpgradePlansResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerClientUpgradePlansResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Upgrades) > 0 {
+		for iNdEx := len(m.Upgrades) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Upgrades[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumerClientUpgrade) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerClientUpgrade) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerClientUpgrade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Plan.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerProviderSwitchesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerProviderSwitchesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerProviderSwitchesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerProviderSwitchesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerProviderSwitchesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerProviderSwitchesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Switches) > 0 {
+		for iNdEx := len(m.Switches) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Switches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RegisteredProviderSwitch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisteredProviderSwitch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisteredProviderSwitch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Switch.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Phase != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Phase))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerClientExpiriesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerClientExpiriesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerClientExpiriesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerClientExpiriesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerClientExpiriesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerClientExpiriesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Expiries) > 0 {
+		for iNdEx := len(m.Expiries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Expiries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumerClientExpiry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerClientExpiry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerClientExpiry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n29, err29 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TimeUntilExpiry, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TimeUntilExpiry):])
+	if err29 != nil {
+		return 0, err29
+	}
+	i -= n29
+	i = encodeVarintQuery(dAtA, i, uint64(n29))
+	i--
+	dAtA[i] = 0x2a
+	n30, err30 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExpiryTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpiryTime):])
+	if err30 != nil {
+		return 0, err30
+	}
+	i -= n30
+	i = encodeVarintQuery(dAtA, i, uint64(n30))
+	i--
+	dAtA[i] = 0x22
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryThrottleQueueStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryThrottleQueueStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryThrottleQueueStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryThrottleQueueStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryThrottleQueueStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryThrottleQueueStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PendingSlashPackets) > 0 {
+		for iNdEx := len(m.PendingSlashPackets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingSlashPackets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	n31, err31 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.NextReplenishCandidate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.NextReplenishCandidate):])
+	if err31 != nil {
+		return 0, err31
+	}
+	i -= n31
+	i = encodeVarintQuery(dAtA, i, uint64(n31))
+	i--
+	dAtA[i] = 0x1a
+	if m.SlashMeterAllowance != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SlashMeterAllowance))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SlashMeter != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SlashMeter))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLastVSCPacketRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLastVSCPacketRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLastVSCPacketRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VSCPacketValidator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VSCPacketValidator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VSCPacketValidator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Power != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Moniker) > 0 {
+		i -= len(m.Moniker)
+		copy(dAtA[i:], m.Moniker)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Moniker)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ProviderConsAddress) > 0 {
+		i -= len(m.ProviderConsAddress)
+		copy(dAtA[i:], m.ProviderConsAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProviderConsAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConsumerConsAddress) > 0 {
+		i -= len(m.ConsumerConsAddress)
+		copy(dAtA[i:], m.ConsumerConsAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerConsAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLastVSCPacketResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLastVSCPacketResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLastVSCPacketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SlashAcks) > 0 {
+		for iNdEx := len(m.SlashAcks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SlashAcks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ValidatorUpdates) > 0 {
+		for iNdEx := len(m.ValidatorUpdates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorUpdates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Packet.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerThrottleStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerThrottleStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerThrottleStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerThrottleStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerThrottleStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerThrottleStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n33, err33 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.NextReplenishCandidate, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.NextReplenishCandidate):])
+	if err33 != nil {
+		return 0, err33
+	}
+	i -= n33
+	i = encodeVarintQuery(dAtA, i, uint64(n33))
+	i--
+	dAtA[i] = 0x2a
+	if m.SlashMeterAllowance != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SlashMeterAllowance))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SlashMeter != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SlashMeter))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.GlobalSlashMeter {
+		i--
+		if m.GlobalSlashMeter {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.ThrottlingParameters.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingInfractionParameterUpdatesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingInfractionParameterUpdatesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingInfractionParameterUpdatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingInfractionParameterUpdatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingInfractionParameterUpdatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingInfractionParameterUpdatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Updates) > 0 {
+		for iNdEx := len(m.Updates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Updates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingInfractionParametersUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingInfractionParametersUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingInfractionParametersUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n35, err35 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.UpdateTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UpdateTime):])
+	if err35 != nil {
+		return 0, err35
+	}
+	i -= n35
+	i = encodeVarintQuery(dAtA, i, uint64(n35))
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.PendingParameters.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.CurrentParameters.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStaleKeyAssignmentsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStaleKeyAssignmentsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStaleKeyAssignmentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStaleKeyAssignmentsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStaleKeyAssignmentsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStaleKeyAssignmentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StaleKeyAssignments) > 0 {
+		for iNdEx := len(m.StaleKeyAssignments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StaleKeyAssignments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StaleKeyAssignment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StaleKeyAssignment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StaleKeyAssignment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TrackedSinceHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TrackedSinceHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ConsumerAddress) > 0 {
+		i -= len(m.ConsumerAddress)
+		copy(dAtA[i:], m.ConsumerAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProviderAddress) > 0 {
+		i -= len(m.ProviderAddress)
+		copy(dAtA[i:], m.ProviderAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProviderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerUpdateHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerUpdateHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerUpdateHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerUpdateHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerUpdateHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerUpdateHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorOptInHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorOptInHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorOptInHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProviderAddress) > 0 {
+		i -= len(m.ProviderAddress)
+		copy(dAtA[i:], m.ProviderAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProviderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorOptInHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorOptInHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorOptInHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Histories) > 0 {
+		for iNdEx := len(m.Histories) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Histories[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryModuleVersionInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryModuleVersionInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryModuleVersionInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryModuleVersionInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryModuleVersionInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryModuleVersionInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.VersionInfo.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerRewardEstimateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerRewardEstimateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerRewardEstimateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProviderAddress) > 0 {
+		i -= len(m.ProviderAddress)
+		copy(dAtA[i:], m.ProviderAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProviderAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryConsumerRewardEstimateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryConsumerRewardEstimateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryConsumerRewardEstimateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Estimate.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumerRewardEstimate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerRewardEstimate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerRewardEstimate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Eligible {
+		i--
+		if m.Eligible {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.DelegatorRewardRate) > 0 {
+		for iNdEx := len(m.DelegatorRewardRate) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegatorRewardRate[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.AnnualDelegatorRewards) > 0 {
+		for iNdEx := len(m.AnnualDelegatorRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AnnualDelegatorRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.AnnualCommission) > 0 {
+		for iNdEx := len(m.AnnualCommission) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AnnualCommission[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.AnnualValidatorRewards) > 0 {
+		for iNdEx := len(m.AnnualValidatorRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AnnualValidatorRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	{
+		size := m.CommissionRate.Size()
+		i -= size
+		if _, err := m.CommissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.PowerShare.Size()
+		i -= size
+		if _, err := m.PowerShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.AnnualConsumerRewards) > 0 {
+		for iNdEx := len(m.AnnualConsumerRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AnnualConsumerRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	n42, err42 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.ObservationWindow, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ObservationWindow):])
+	if err42 != nil {
+		return 0, err42
+	}
+	i -= n42
+	i = encodeVarintQuery(dAtA, i, uint64(n42))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ProviderAddress) > 0 {
+		i -= len(m.ProviderAddress)
+		copy(dAtA[i:], m.ProviderAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProviderAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StreamValidatorSetChangesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamValidatorSetChangesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamValidatorSetChangesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StreamValidatorSetChangesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StreamValidatorSetChangesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StreamValidatorSetChangesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Packet.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ConsumerId) > 0 {
+		i -= len(m.ConsumerId)
+		copy(dAtA[i:], m.ConsumerId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsumerId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryConsumerGenesisRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConsumerGenesisResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.GenesisState.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryConsumerChainsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Phase != 0 {
+		n += 1 + sovQuery(uint64(m.Phase))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConsumerChainsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Chains) > 0 {
+		for _, e := range m.Chains {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *Chain) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Top_N != 0 {
+		n += 1 + sovQuery(uint64(m.Top_N))
+	}
+	if m.MinPowerInTop_N != 0 {
+		n += 1 + sovQuery(uint64(m.MinPowerInTop_N))
+	}
+	if m.ValidatorsPowerCap != 0 {
+		n += 1 + sovQuery(uint64(m.ValidatorsPowerCap))
+	}
+	if m.ValidatorSetCap != 0 {
+		n += 1 + sovQuery(uint64(m.ValidatorSetCap))
+	}
+	if len(m.Allowlist) > 0 {
+		for _, s := range m.Allowlist {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Denylist) > 0 {
+		for _, s := range m.Denylist {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.Phase)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Metadata.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.MinStake != 0 {
+		n += 1 + sovQuery(uint64(m.MinStake))
+	}
+	if m.AllowInactiveVals {
+		n += 2
+	}
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.AllowlistedRewardDenoms != nil {
+		l = m.AllowlistedRewardDenoms.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Prioritylist) > 0 {
+		for _, s := range m.Prioritylist {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.InfractionParameters != nil {
+		l = m.InfractionParameters.Size()
+		n += 2 + l + sovQuery(uint64(l))
+	}
+	if m.Top_NWeightedEpochs != 0 {
+		n += 2 + sovQuery(uint64(m.Top_NWeightedEpochs))
+	}
+	if len(m.PrioritylistWeights) > 0 {
+		for _, e := range m.PrioritylistWeights {
+			l = e.Size()
+			n += 2 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryValidatorConsumerAddrRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProviderAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorConsumerAddrResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorProviderAddrRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorProviderAddrResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProviderAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryThrottleStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryThrottleStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SlashMeter != 0 {
+		n += 1 + sovQuery(uint64(m.SlashMeter))
+	}
+	if m.SlashMeterAllowance != 0 {
+		n += 1 + sovQuery(uint64(m.SlashMeterAllowance))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.NextReplenishCandidate)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRegisteredConsumerRewardDenomsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryRegisteredConsumerRewardDenomsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Denoms) > 0 {
+		for _, s := range m.Denoms {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAllPairsValConsAddrByConsumerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllPairsValConsAddrByConsumerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PairValConAddr) > 0 {
+		for _, e := range m.PairValConAddr {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PairValConAddrProviderAndConsumer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProviderAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ConsumerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ConsumerKey != nil {
+		l = m.ConsumerKey.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryConsumerChainOptedInValidatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConsumerChainOptedInValidatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ValidatorsProviderAddresses) > 0 {
+		for _, s := range m.ValidatorsProviderAddresses {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryConsumerValidatorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConsumerValidatorsValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProviderAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ConsumerKey != nil {
+		l = m.ConsumerKey.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Power != 0 {
+		n += 1 + sovQuery(uint64(m.Power))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.ConsumerPower != 0 {
+		n += 1 + sovQuery(uint64(m.ConsumerPower))
+	}
+	l = m.ConsumerCommissionRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ProviderCommissionRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Description.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.ProviderOperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Jailed {
+		n += 2
+	}
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	l = m.ProviderTokens.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.ProviderPower != 0 {
+		n += 1 + sovQuery(uint64(m.ProviderPower))
+	}
+	if m.ValidatesCurrentEpoch {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryConsumerValidatorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryConsumerChainsValidatorHasToValidateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProviderAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConsumerChainsValidatorHasToValidateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ConsumerIds) > 0 {
+		for _, s := range m.ConsumerIds {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryValidatorConsumerCommissionRateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ProviderAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorConsumerCommissionRateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Rate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBlocksUntilNextEpochRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBlocksUntilNextEpochResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlocksUntilNextEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.BlocksUntilNextEpoch))
+	}
+	return n
+}
+
+func (m *QueryConsumerIdFromClientIdRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConsumerIdFromClientIdResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConsumerChainRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConsumerChainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Phase)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Metadata.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.InitParams != nil {
+		l = m.InitParams.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PowerShapingParams != nil {
+		l = m.PowerShapingParams.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.InfractionParameters != nil {
+		l = m.InfractionParameters.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.PendingChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ThrottlingParameters != nil {
+		l = m.ThrottlingParameters.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.ExpectedInitialHeight.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryConsumerGenesisTimeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConsumerGenesisTimeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.GenesisTime)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryNearTimeoutPacketsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Within)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryNearTimeoutPacketsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Packets) > 0 {
+		for _, e := range m.Packets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PacketTimeout) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovQuery(uint64(m.Sequence))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.TimeoutTimestamp)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryLaunchCapacityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryLaunchCapacityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxLaunchedConsumers != 0 {
+		n += 1 + sovQuery(uint64(m.MaxLaunchedConsumers))
+	}
+	if m.LaunchedConsumers != 0 {
+		n += 1 + sovQuery(uint64(m.LaunchedConsumers))
+	}
+	if m.RemainingCapacity != 0 {
+		n += 1 + sovQuery(uint64(m.RemainingCapacity))
+	}
+	return n
+}
+
+func (m *QueryPowerShapingPipelineRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPowerShapingPipelineResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pipeline.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryConsumerValidatorPowerProjectionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConsumerValidatorPowerProjectionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Validators) > 0 {
+		for _, e := range m.Validators {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.Pipeline.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.RemovedProviderAddresses) > 0 {
+		for _, s := range m.RemovedProviderAddresses {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ProjectedConsumerValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProviderAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ConsumerKey != nil {
+		l = m.ConsumerKey.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Power != 0 {
+		n += 1 + sovQuery(uint64(m.Power))
+	}
+	if m.CurrentPower != 0 {
+		n += 1 + sovQuery(uint64(m.CurrentPower))
+	}
+	return n
+}
+
+func (m *QueryModuleAccountsSummaryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		n += 1 + sovQuery(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *ModuleAccountBalance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Balance) > 0 {
+		for _, e := range m.Balance {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryModuleAccountsSummaryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Accounts) > 0 {
+		for _, e := range m.Accounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Flows) > 0 {
+		for _, e := range m.Flows {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.TotalInflow) > 0 {
+		for _, e := range m.TotalInflow {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.TotalOutflow) > 0 {
+		for _, e := range m.TotalOutflow {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryConsumerLaunchFailureRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConsumerLaunchFailureResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Failure.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryConsumerHashCommitmentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConsumerHashCommitmentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GenesisHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BinaryHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.UpdatedSinceRegistration {
+		n += 2
+	}
+	if m.Locked {
+		n += 2
+	}
+	l = m.Commitment.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryConsumerCreatorAllowlistRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryConsumerCreatorAllowlistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryConsumerPacketStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConsumerPacketStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Stats.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryValidatorCCVSummaryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorCCVSummaryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ProviderAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	l = m.Tokens.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Power != 0 {
+		n += 1 + sovQuery(uint64(m.Power))
+	}
+	if m.Jailed {
+		n += 2
+	}
+	if m.Tombstoned {
+		n += 2
+	}
+	if m.ConsumerDoubleSignLogged {
+		n += 2
+	}
+	if len(m.OutstandingRewards) > 0 {
+		for _, e := range m.OutstandingRewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Consumers) > 0 {
+		for _, e := range m.Consumers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ValidatorConsumerSummary) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Phase != 0 {
+		n += 1 + sovQuery(uint64(m.Phase))
+	}
+	if m.OptedIn {
+		n += 2
+	}
+	if m.ConsumerValidator {
+		n += 2
+	}
+	if m.HasToValidate {
+		n += 2
+	}
+	l = len(m.ConsumerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.CommissionRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryConsumerClientUpgradePlansRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryConsumerClientUpgradePlansResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Upgrades) > 0 {
+		for _, e := range m.Upgrades {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ConsumerClientUpgrade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Plan.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryConsumerProviderSwitchesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryConsumerProviderSwitchesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Switches) > 0 {
+		for _, e := range m.Switches {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RegisteredProviderSwitch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Phase != 0 {
+		n += 1 + sovQuery(uint64(m.Phase))
+	}
+	l = m.Switch.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryConsumerClientExpiriesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryConsumerClientExpiriesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Expiries) > 0 {
+		for _, e := range m.Expiries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ConsumerClientExpiry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpiryTime)
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TimeUntilExpiry)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryThrottleQueueStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryThrottleQueueStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SlashMeter != 0 {
+		n += 1 + sovQuery(uint64(m.SlashMeter))
+	}
+	if m.SlashMeterAllowance != 0 {
+		n += 1 + sovQuery(uint64(m.SlashMeterAllowance))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.NextReplenishCandidate)
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.PendingSlashPackets) > 0 {
+		for _, e := range m.PendingSlashPackets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryLastVSCPacketRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *VSCPacketValidator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerConsAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ProviderConsAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Moniker)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Power != 0 {
+		n += 1 + sovQuery(uint64(m.Power))
+	}
+	return n
+}
+
+func (m *QueryLastVSCPacketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Packet.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.ValidatorUpdates) > 0 {
+		for _, e := range m.ValidatorUpdates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.SlashAcks) > 0 {
+		for _, e := range m.SlashAcks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryConsumerThrottleStateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConsumerThrottleStateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ThrottlingParameters.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.GlobalSlashMeter {
+		n += 2
+	}
+	if m.SlashMeter != 0 {
+		n += 1 + sovQuery(uint64(m.SlashMeter))
+	}
+	if m.SlashMeterAllowance != 0 {
+		n += 1 + sovQuery(uint64(m.SlashMeterAllowance))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.NextReplenishCandidate)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPendingInfractionParameterUpdatesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingInfractionParameterUpdatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Updates) > 0 {
+		for _, e := range m.Updates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PendingInfractionParametersUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.CurrentParameters.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.PendingParameters.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.UpdateTime)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryStaleKeyAssignmentsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStaleKeyAssignmentsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.StaleKeyAssignments) > 0 {
+		for _, e := range m.StaleKeyAssignments {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *StaleKeyAssignment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProviderAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ConsumerAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.TrackedSinceHeight != 0 {
+		n += 1 + sovQuery(uint64(m.TrackedSinceHeight))
+	}
+	return n
+}
+
+func (m *QueryConsumerUpdateHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConsumerUpdateHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorOptInHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProviderAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorOptInHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Histories) > 0 {
+		for _, e := range m.Histories {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryModuleVersionInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryModuleVersionInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.VersionInfo.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryConsumerRewardEstimateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ProviderAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryConsumerRewardEstimateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Estimate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *ConsumerRewardEstimate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ProviderAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ObservationWindow)
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.AnnualConsumerRewards) > 0 {
+		for _, e := range m.AnnualConsumerRewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.PowerShare.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.CommissionRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.AnnualValidatorRewards) > 0 {
+		for _, e := range m.AnnualValidatorRewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.AnnualCommission) > 0 {
+		for _, e := range m.AnnualCommission {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.AnnualDelegatorRewards) > 0 {
+		for _, e := range m.AnnualDelegatorRewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.DelegatorRewardRate) > 0 {
+		for _, e := range m.DelegatorRewardRate {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Eligible {
+		n += 2
+	}
+	return n
+}
+
+func (m *StreamValidatorSetChangesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *StreamValidatorSetChangesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsumerId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	l = m.Packet.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryConsumerGenesisRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerGenesisRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerGenesisRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerGenesisResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerGenesisResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerGenesisResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GenesisState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerChainsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerChainsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerChainsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			m.Phase = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Phase |= ConsumerPhase(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerChainsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerChainsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerChainsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chains", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chains = append(m.Chains, &Chain{})
+			if err := m.Chains[len(m.Chains)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Chain) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Chain: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Chain: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Top_N", wireType)
+			}
+			m.Top_N = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Top_N |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinPowerInTop_N", wireType)
+			}
+			m.MinPowerInTop_N = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinPowerInTop_N |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsPowerCap", wireType)
+			}
+			m.ValidatorsPowerCap = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorsPowerCap |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSetCap", wireType)
+			}
+			m.ValidatorSetCap = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValidatorSetCap |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowlist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allowlist = append(m.Allowlist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denylist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denylist = append(m.Denylist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinStake", wireType)
+			}
+			m.MinStake = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinStake |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowInactiveVals", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowInactiveVals = bool(v != 0)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowlistedRewardDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AllowlistedRewardDenoms == nil {
+				m.AllowlistedRewardDenoms = &AllowlistedRewardDenoms{}
+			}
+			if err := m.AllowlistedRewardDenoms.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prioritylist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prioritylist = append(m.Prioritylist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InfractionParameters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InfractionParameters == nil {
+				m.InfractionParameters = &InfractionParameters{}
+			}
+			if err := m.InfractionParameters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Top_NWeightedEpochs", wireType)
+			}
+			m.Top_NWeightedEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Top_NWeightedEpochs |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrioritylistWeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrioritylistWeights = append(m.PrioritylistWeights, PrioritylistWeight{})
+			if err := m.PrioritylistWeights[len(m.PrioritylistWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorConsumerAddrRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorConsumerAddrRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorConsumerAddrRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorConsumerAddrResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorConsumerAddrResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorConsumerAddrResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorProviderAddrRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorProviderAddrRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorProviderAddrRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorProviderAddrResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorProviderAddrResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorProviderAddrResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryThrottleStateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryThrottleStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryThrottleStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryThrottleStateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryThrottleStateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryThrottleStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashMeter", wireType)
+			}
+			m.SlashMeter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashMeter |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashMeterAllowance", wireType)
+			}
+			m.SlashMeterAllowance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashMeterAllowance |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextReplenishCandidate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.NextReplenishCandidate, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRegisteredConsumerRewardDenomsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRegisteredConsumerRewardDenomsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRegisteredConsumerRewardDenomsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRegisteredConsumerRewardDenomsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRegisteredConsumerRewardDenomsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRegisteredConsumerRewardDenomsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllPairsValConsAddrByConsumerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllPairsValConsAddrByConsumerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllPairsValConsAddrByConsumerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllPairsValConsAddrByConsumerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllPairsValConsAddrByConsumerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllPairsValConsAddrByConsumerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairValConAddr", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PairValConAddr = append(m.PairValConAddr, &PairValConAddrProviderAndConsumer{})
+			if err := m.PairValConAddr[len(m.PairValConAddr)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PairValConAddrProviderAndConsumer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PairValConAddrProviderAndConsumer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PairValConAddrProviderAndConsumer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConsumerKey == nil {
+				m.ConsumerKey = &crypto.PublicKey{}
+			}
+			if err := m.ConsumerKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerChainOptedInValidatorsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerChainOptedInValidatorsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerChainOptedInValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerChainOptedInValidatorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerChainOptedInValidatorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerChainOptedInValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsProviderAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorsProviderAddresses = append(m.ValidatorsProviderAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerValidatorsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerValidatorsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerValidatorsValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerValidatorsValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerValidatorsValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConsumerKey == nil {
+				m.ConsumerKey = &crypto.PublicKey{}
+			}
+			if err := m.ConsumerKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerPower", wireType)
+			}
+			m.ConsumerPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsumerPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerCommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ConsumerCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderCommissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProviderCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderOperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderOperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Jailed = bool(v != 0)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= types1.BondStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderTokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProviderTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderPower", wireType)
+			}
+			m.ProviderPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProviderPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatesCurrentEpoch", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ValidatesCurrentEpoch = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerValidatorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerValidatorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, &QueryConsumerValidatorsValidator{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerChainsValidatorHasToValidateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerChainsValidatorHasToValidateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerChainsValidatorHasToValidateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerChainsValidatorHasToValidateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerChainsValidatorHasToValidateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerChainsValidatorHasToValidateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerIds = append(m.ConsumerIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorConsumerCommissionRateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorConsumerCommissionRateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorConsumerCommissionRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorConsumerCommissionRateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorConsumerCommissionRateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorConsumerCommissionRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlocksUntilNextEpochRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlocksUntilNextEpochRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlocksUntilNextEpochRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlocksUntilNextEpochResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlocksUntilNextEpochResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlocksUntilNextEpochResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocksUntilNextEpoch", wireType)
+			}
+			m.BlocksUntilNextEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlocksUntilNextEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerIdFromClientIdRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerIdFromClientIdRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerIdFromClientIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerIdFromClientIdResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerIdFromClientIdResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerIdFromClientIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerChainRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerChainRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerChainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerChainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InitParams == nil {
+				m.InitParams = &ConsumerInitializationParameters{}
+			}
+			if err := m.InitParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PowerShapingParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PowerShapingParams == nil {
+				m.PowerShapingParams = &PowerShapingParameters{}
+			}
+			if err := m.PowerShapingParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InfractionParameters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.InfractionParameters == nil {
+				m.InfractionParameters = &InfractionParameters{}
+			}
+			if err := m.InfractionParameters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ThrottlingParameters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ThrottlingParameters == nil {
+				m.ThrottlingParameters = &ThrottlingParameters{}
+			}
+			if err := m.ThrottlingParameters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedInitialHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExpectedInitialHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerGenesisTimeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerGenesisTimeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerGenesisTimeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerGenesisTimeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerGenesisTimeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerGenesisTimeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.GenesisTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNearTimeoutPacketsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNearTimeoutPacketsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNearTimeoutPacketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Within", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Within, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNearTimeoutPacketsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNearTimeoutPacketsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNearTimeoutPacketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Packets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Packets = append(m.Packets, PacketTimeout{})
+			if err := m.Packets[len(m.Packets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PacketTimeout) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PacketTimeout: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PacketTimeout: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.TimeoutTimestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLaunchCapacityRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLaunchCapacityRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLaunchCapacityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLaunchCapacityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLaunchCapacityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLaunchCapacityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLaunchedConsumers", wireType)
+			}
+			m.MaxLaunchedConsumers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxLaunchedConsumers |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LaunchedConsumers", wireType)
+			}
+			m.LaunchedConsumers = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LaunchedConsumers |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingCapacity", wireType)
+			}
+			m.RemainingCapacity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemainingCapacity |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPowerShapingPipelineRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPowerShapingPipelineRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPowerShapingPipelineRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPowerShapingPipelineResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPowerShapingPipelineResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPowerShapingPipelineResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerValidatorPowerProjectionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerValidatorPowerProjectionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerValidatorPowerProjectionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerValidatorPowerProjectionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerValidatorPowerProjectionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerValidatorPowerProjectionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validators = append(m.Validators, ProjectedConsumerValidator{})
+			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pipeline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Pipeline.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedProviderAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemovedProviderAddresses = append(m.RemovedProviderAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProjectedConsumerValidator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProjectedConsumerValidator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProjectedConsumerValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConsumerKey == nil {
+				m.ConsumerKey = &crypto.PublicKey{}
+			}
+			if err := m.ConsumerKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentPower", wireType)
+			}
+			m.CurrentPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryModuleAccountsSummaryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryModuleAccountsSummaryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryModuleAccountsSummaryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ModuleAccountBalance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ModuleAccountBalance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ModuleAccountBalance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balance = append(m.Balance, types3.Coin{})
+			if err := m.Balance[len(m.Balance)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryModuleAccountsSummaryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryModuleAccountsSummaryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryModuleAccountsSummaryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accounts = append(m.Accounts, ModuleAccountBalance{})
+			if err := m.Accounts[len(m.Accounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Flows = append(m.Flows, FundFlowRecord{})
+			if err := m.Flows[len(m.Flows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalInflow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalInflow = append(m.TotalInflow, types3.Coin{})
+			if err := m.TotalInflow[len(m.TotalInflow)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalOutflow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalOutflow = append(m.TotalOutflow, types3.Coin{})
+			if err := m.TotalOutflow[len(m.TotalOutflow)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerLaunchFailureRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerLaunchFailureRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerLaunchFailureRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerLaunchFailureResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerLaunchFailureResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerLaunchFailureResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failure", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Failure.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerHashCommitmentRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerHashCommitmentRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerHashCommitmentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerHashCommitmentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerHashCommitmentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerHashCommitmentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GenesisHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GenesisHash = append(m.GenesisHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.GenesisHash == nil {
+				m.GenesisHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BinaryHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BinaryHash = append(m.BinaryHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.BinaryHash == nil {
+				m.BinaryHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedSinceRegistration", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UpdatedSinceRegistration = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locked", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Locked = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Commitment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerCreatorAllowlistRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerCreatorAllowlistRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerCreatorAllowlistRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerCreatorAllowlistResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerCreatorAllowlistResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerCreatorAllowlistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *QueryConsumerGenesisTimeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.GenesisTime)
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryConsumerGenesisRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryConsumerPacketStatsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4902,10 +19029,10 @@ func (m *QueryConsumerGenesisRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryConsumerGenesisRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryConsumerPacketStatsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryConsumerGenesisRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryConsumerPacketStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4961,7 +19088,7 @@ func (m *QueryConsumerGenesisRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryConsumerGenesisResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryConsumerPacketStatsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4984,15 +19111,15 @@ func (m *QueryConsumerGenesisResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryConsumerGenesisResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryConsumerPacketStatsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryConsumerGenesisResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryConsumerPacketStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GenesisState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5019,7 +19146,7 @@ func (m *QueryConsumerGenesisResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GenesisState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5044,7 +19171,7 @@ func (m *QueryConsumerGenesisResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryConsumerChainsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorCCVSummaryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5067,36 +19194,17 @@ func (m *QueryConsumerChainsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryConsumerChainsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorCCVSummaryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryConsumerChainsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorCCVSummaryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
-			}
-			m.Phase = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Phase |= ConsumerPhase(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5106,27 +19214,23 @@ func (m *QueryConsumerChainsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5149,7 +19253,7 @@ func (m *QueryConsumerChainsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryConsumerChainsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorCCVSummaryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5172,15 +19276,211 @@ func (m *QueryConsumerChainsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryConsumerChainsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorCCVSummaryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryConsumerChainsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorCCVSummaryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Chains", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= types1.BondStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Tokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Jailed = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tombstoned", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Tombstoned = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerDoubleSignLogged", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ConsumerDoubleSignLogged = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutstandingRewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5207,14 +19507,14 @@ func (m *QueryConsumerChainsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Chains = append(m.Chains, &Chain{})
-			if err := m.Chains[len(m.Chains)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.OutstandingRewards = append(m.OutstandingRewards, types3.DecCoin{})
+			if err := m.OutstandingRewards[len(m.OutstandingRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Consumers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5241,10 +19541,8 @@ func (m *QueryConsumerChainsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Consumers = append(m.Consumers, ValidatorConsumerSummary{})
+			if err := m.Consumers[len(m.Consumers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5269,7 +19567,7 @@ func (m *QueryConsumerChainsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Chain) Unmarshal(dAtA []byte) error {
+func (m *ValidatorConsumerSummary) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5292,15 +19590,15 @@ func (m *Chain) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Chain: wiretype end group for non-group")
+			return fmt.Errorf("proto: ValidatorConsumerSummary: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Chain: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ValidatorConsumerSummary: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5328,11 +19626,11 @@ func (m *Chain) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5360,13 +19658,13 @@ func (m *Chain) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientId = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Top_N", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
 			}
-			m.Top_N = 0
+			m.Phase = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5376,16 +19674,16 @@ func (m *Chain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Top_N |= uint32(b&0x7F) << shift
+				m.Phase |= ConsumerPhase(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinPowerInTop_N", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OptedIn", wireType)
 			}
-			m.MinPowerInTop_N = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5395,16 +19693,17 @@ func (m *Chain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MinPowerInTop_N |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.OptedIn = bool(v != 0)
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsPowerCap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerValidator", wireType)
 			}
-			m.ValidatorsPowerCap = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5414,16 +19713,17 @@ func (m *Chain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ValidatorsPowerCap |= uint32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.ConsumerValidator = bool(v != 0)
 		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorSetCap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HasToValidate", wireType)
 			}
-			m.ValidatorSetCap = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5433,14 +19733,15 @@ func (m *Chain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ValidatorSetCap |= uint32(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.HasToValidate = bool(v != 0)
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Allowlist", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5468,11 +19769,11 @@ func (m *Chain) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Allowlist = append(m.Allowlist, string(dAtA[iNdEx:postIndex]))
+			m.ConsumerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denylist", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5500,43 +19801,113 @@ func (m *Chain) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denylist = append(m.Denylist, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			if err := m.CommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerClientUpgradePlansRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerClientUpgradePlansRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerClientUpgradePlansRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Phase = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 10:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerClientUpgradePlansResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerClientUpgradePlansResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerClientUpgradePlansResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Upgrades", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5563,34 +19934,66 @@ func (m *Chain) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Upgrades = append(m.Upgrades, ConsumerClientUpgrade{})
+			if err := m.Upgrades[len(m.Upgrades)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinStake", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.MinStake = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MinStake |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
 			}
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowInactiveVals", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsumerClientUpgrade) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerClientUpgrade: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerClientUpgrade: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5600,15 +20003,27 @@ func (m *Chain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.AllowInactiveVals = bool(v != 0)
-		case 13:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5636,13 +20051,13 @@ func (m *Chain) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 14:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowlistedRewardDenoms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5652,33 +20067,29 @@ func (m *Chain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AllowlistedRewardDenoms == nil {
-				m.AllowlistedRewardDenoms = &AllowlistedRewardDenoms{}
-			}
-			if err := m.AllowlistedRewardDenoms.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 15:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prioritylist", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Plan", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5688,27 +20099,128 @@ func (m *Chain) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Prioritylist = append(m.Prioritylist, string(dAtA[iNdEx:postIndex]))
+			if err := m.Plan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 16:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerProviderSwitchesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerProviderSwitchesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerProviderSwitchesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerProviderSwitchesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerProviderSwitchesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerProviderSwitchesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InfractionParameters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Switches", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5735,10 +20247,8 @@ func (m *Chain) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.InfractionParameters == nil {
-				m.InfractionParameters = &InfractionParameters{}
-			}
-			if err := m.InfractionParameters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Switches = append(m.Switches, RegisteredProviderSwitch{})
+			if err := m.Switches[len(m.Switches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5763,7 +20273,7 @@ func (m *Chain) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValidatorConsumerAddrRequest) Unmarshal(dAtA []byte) error {
+func (m *RegisteredProviderSwitch) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5786,15 +20296,15 @@ func (m *QueryValidatorConsumerAddrRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorConsumerAddrRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RegisteredProviderSwitch: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorConsumerAddrRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RegisteredProviderSwitch: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5822,11 +20332,11 @@ func (m *QueryValidatorConsumerAddrRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProviderAddress = string(dAtA[iNdEx:postIndex])
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5854,7 +20364,59 @@ func (m *QueryValidatorConsumerAddrRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			m.Phase = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Phase |= ConsumerPhase(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Switch", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Switch.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5877,7 +20439,7 @@ func (m *QueryValidatorConsumerAddrRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValidatorConsumerAddrResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryConsumerClientExpiriesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5900,17 +20462,67 @@ func (m *QueryValidatorConsumerAddrResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorConsumerAddrResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryConsumerClientExpiriesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorConsumerAddrResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryConsumerClientExpiriesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryConsumerClientExpiriesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryConsumerClientExpiriesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryConsumerClientExpiriesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiries", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5920,23 +20532,25 @@ func (m *QueryValidatorConsumerAddrResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsumerAddress = string(dAtA[iNdEx:postIndex])
+			m.Expiries = append(m.Expiries, ConsumerClientExpiry{})
+			if err := m.Expiries[len(m.Expiries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5959,7 +20573,7 @@ func (m *QueryValidatorConsumerAddrResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryValidatorProviderAddrRequest) Unmarshal(dAtA []byte) error {
+func (m *ConsumerClientExpiry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5982,15 +20596,15 @@ func (m *QueryValidatorProviderAddrRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorProviderAddrRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConsumerClientExpiry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorProviderAddrRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConsumerClientExpiry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6018,11 +20632,11 @@ func (m *QueryValidatorProviderAddrRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsumerAddress = string(dAtA[iNdEx:postIndex])
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6050,63 +20664,78 @@ func (m *QueryValidatorProviderAddrRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			m.ChainId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryValidatorProviderAddrResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTime", wireType)
 			}
-			if iNdEx >= l {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ExpiryTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorProviderAddrResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorProviderAddrResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeUntilExpiry", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6116,23 +20745,24 @@ func (m *QueryValidatorProviderAddrResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProviderAddress = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.TimeUntilExpiry, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6155,7 +20785,7 @@ func (m *QueryValidatorProviderAddrResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryThrottleStateRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryThrottleQueueStateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6178,10 +20808,10 @@ func (m *QueryThrottleStateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryThrottleStateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryThrottleQueueStateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryThrottleStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryThrottleQueueStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6205,7 +20835,7 @@ func (m *QueryThrottleStateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryThrottleStateResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryThrottleQueueStateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6228,10 +20858,10 @@ func (m *QueryThrottleStateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryThrottleStateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryThrottleQueueStateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryThrottleStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryThrottleQueueStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6305,56 +20935,40 @@ func (m *QueryThrottleStateResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingSlashPackets", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryRegisteredConsumerRewardDenomsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.PendingSlashPackets = append(m.PendingSlashPackets, BouncedSlashPacket{})
+			if err := m.PendingSlashPackets[len(m.PendingSlashPackets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRegisteredConsumerRewardDenomsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRegisteredConsumerRewardDenomsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6376,7 +20990,7 @@ func (m *QueryRegisteredConsumerRewardDenomsRequest) Unmarshal(dAtA []byte) erro
 	}
 	return nil
 }
-func (m *QueryRegisteredConsumerRewardDenomsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryLastVSCPacketRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6399,15 +21013,15 @@ func (m *QueryRegisteredConsumerRewardDenomsResponse) Unmarshal(dAtA []byte) err
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRegisteredConsumerRewardDenomsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLastVSCPacketRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRegisteredConsumerRewardDenomsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLastVSCPacketRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6435,7 +21049,7 @@ func (m *QueryRegisteredConsumerRewardDenomsResponse) Unmarshal(dAtA []byte) err
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6458,7 +21072,7 @@ func (m *QueryRegisteredConsumerRewardDenomsResponse) Unmarshal(dAtA []byte) err
 	}
 	return nil
 }
-func (m *QueryAllPairsValConsAddrByConsumerRequest) Unmarshal(dAtA []byte) error {
+func (m *VSCPacketValidator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6481,15 +21095,15 @@ func (m *QueryAllPairsValConsAddrByConsumerRequest) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllPairsValConsAddrByConsumerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: VSCPacketValidator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllPairsValConsAddrByConsumerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VSCPacketValidator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerConsAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6517,63 +21131,45 @@ func (m *QueryAllPairsValConsAddrByConsumerRequest) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			m.ConsumerConsAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderConsAddress", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryAllPairsValConsAddrByConsumerResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllPairsValConsAddrByConsumerResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllPairsValConsAddrByConsumerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderConsAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PairValConAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6583,26 +21179,43 @@ func (m *QueryAllPairsValConsAddrByConsumerResponse) Unmarshal(dAtA []byte) erro
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PairValConAddr = append(m.PairValConAddr, &PairValConAddrProviderAndConsumer{})
-			if err := m.PairValConAddr[len(m.PairValConAddr)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Moniker = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6624,7 +21237,7 @@ func (m *QueryAllPairsValConsAddrByConsumerResponse) Unmarshal(dAtA []byte) erro
 	}
 	return nil
 }
-func (m *PairValConAddrProviderAndConsumer) Unmarshal(dAtA []byte) error {
+func (m *QueryLastVSCPacketResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6647,17 +21260,17 @@ func (m *PairValConAddrProviderAndConsumer) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PairValConAddrProviderAndConsumer: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLastVSCPacketResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PairValConAddrProviderAndConsumer: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLastVSCPacketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6667,29 +21280,30 @@ func (m *PairValConAddrProviderAndConsumer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProviderAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.Packet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorUpdates", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6699,27 +21313,29 @@ func (m *PairValConAddrProviderAndConsumer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsumerAddress = string(dAtA[iNdEx:postIndex])
+			m.ValidatorUpdates = append(m.ValidatorUpdates, VSCPacketValidator{})
+			if err := m.ValidatorUpdates[len(m.ValidatorUpdates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashAcks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6746,10 +21362,8 @@ func (m *PairValConAddrProviderAndConsumer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ConsumerKey == nil {
-				m.ConsumerKey = &crypto.PublicKey{}
-			}
-			if err := m.ConsumerKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SlashAcks = append(m.SlashAcks, VSCPacketValidator{})
+			if err := m.SlashAcks[len(m.SlashAcks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6774,7 +21388,7 @@ func (m *PairValConAddrProviderAndConsumer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryConsumerThrottleStateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6797,12 +21411,44 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryConsumerThrottleStateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryConsumerThrottleStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6824,7 +21470,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryConsumerThrottleStateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6847,15 +21493,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryConsumerThrottleStateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryConsumerThrottleStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ThrottlingParameters", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6882,65 +21528,73 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ThrottlingParameters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalSlashMeter", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			m.GlobalSlashMeter = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashMeter", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryConsumerChainOptedInValidatorsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.SlashMeter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashMeter |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashMeterAllowance", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.SlashMeterAllowance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SlashMeterAllowance |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryConsumerChainOptedInValidatorsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryConsumerChainOptedInValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextReplenishCandidate", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6950,23 +21604,24 @@ func (m *QueryConsumerChainOptedInValidatorsRequest) Unmarshal(dAtA []byte) erro
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.NextReplenishCandidate, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6989,7 +21644,7 @@ func (m *QueryConsumerChainOptedInValidatorsRequest) Unmarshal(dAtA []byte) erro
 	}
 	return nil
 }
-func (m *QueryConsumerChainOptedInValidatorsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingInfractionParameterUpdatesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7012,15 +21667,15 @@ func (m *QueryConsumerChainOptedInValidatorsResponse) Unmarshal(dAtA []byte) err
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryConsumerChainOptedInValidatorsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingInfractionParameterUpdatesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryConsumerChainOptedInValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingInfractionParameterUpdatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorsProviderAddresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7048,7 +21703,7 @@ func (m *QueryConsumerChainOptedInValidatorsResponse) Unmarshal(dAtA []byte) err
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorsProviderAddresses = append(m.ValidatorsProviderAddresses, string(dAtA[iNdEx:postIndex]))
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7071,7 +21726,7 @@ func (m *QueryConsumerChainOptedInValidatorsResponse) Unmarshal(dAtA []byte) err
 	}
 	return nil
 }
-func (m *QueryConsumerValidatorsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingInfractionParameterUpdatesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7094,17 +21749,17 @@ func (m *QueryConsumerValidatorsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryConsumerValidatorsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingInfractionParameterUpdatesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryConsumerValidatorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingInfractionParameterUpdatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Updates", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7114,23 +21769,25 @@ func (m *QueryConsumerValidatorsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			m.Updates = append(m.Updates, PendingInfractionParametersUpdate{})
+			if err := m.Updates[len(m.Updates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7153,7 +21810,7 @@ func (m *QueryConsumerValidatorsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryConsumerValidatorsValidator) Unmarshal(dAtA []byte) error {
+func (m *PendingInfractionParametersUpdate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7176,15 +21833,15 @@ func (m *QueryConsumerValidatorsValidator) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryConsumerValidatorsValidator: wiretype end group for non-group")
+			return fmt.Errorf("proto: PendingInfractionParametersUpdate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryConsumerValidatorsValidator: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PendingInfractionParametersUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7212,11 +21869,11 @@ func (m *QueryConsumerValidatorsValidator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProviderAddress = string(dAtA[iNdEx:postIndex])
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentParameters", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7243,124 +21900,15 @@ func (m *QueryConsumerValidatorsValidator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ConsumerKey == nil {
-				m.ConsumerKey = &crypto.PublicKey{}
-			}
-			if err := m.ConsumerKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CurrentParameters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
-			}
-			m.Power = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Power |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerPower", wireType)
-			}
-			m.ConsumerPower = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ConsumerPower |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerCommissionRate", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ConsumerCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProviderCommissionRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingParameters", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7370,29 +21918,28 @@ func (m *QueryConsumerValidatorsValidator) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ProviderCommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PendingParameters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7419,84 +21966,63 @@ func (m *QueryConsumerValidatorsValidator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Description.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.UpdateTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProviderOperatorAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProviderOperatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStaleKeyAssignmentsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			m.Jailed = bool(v != 0)
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= types1.BondStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-		case 12:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStaleKeyAssignmentsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStaleKeyAssignmentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProviderTokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7524,49 +22050,8 @@ func (m *QueryConsumerValidatorsValidator) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ProviderTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProviderPower", wireType)
-			}
-			m.ProviderPower = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ProviderPower |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 14:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatesCurrentEpoch", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.ValidatesCurrentEpoch = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7588,7 +22073,7 @@ func (m *QueryConsumerValidatorsValidator) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryConsumerValidatorsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryStaleKeyAssignmentsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7611,15 +22096,15 @@ func (m *QueryConsumerValidatorsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryConsumerValidatorsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStaleKeyAssignmentsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryConsumerValidatorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStaleKeyAssignmentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validators", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StaleKeyAssignments", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7646,8 +22131,8 @@ func (m *QueryConsumerValidatorsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Validators = append(m.Validators, &QueryConsumerValidatorsValidator{})
-			if err := m.Validators[len(m.Validators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.StaleKeyAssignments = append(m.StaleKeyAssignments, StaleKeyAssignment{})
+			if err := m.StaleKeyAssignments[len(m.StaleKeyAssignments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7672,7 +22157,7 @@ func (m *QueryConsumerValidatorsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryConsumerChainsValidatorHasToValidateRequest) Unmarshal(dAtA []byte) error {
+func (m *StaleKeyAssignment) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7695,10 +22180,10 @@ func (m *QueryConsumerChainsValidatorHasToValidateRequest) Unmarshal(dAtA []byte
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryConsumerChainsValidatorHasToValidateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: StaleKeyAssignment: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryConsumerChainsValidatorHasToValidateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StaleKeyAssignment: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7733,6 +22218,57 @@ func (m *QueryConsumerChainsValidatorHasToValidateRequest) Unmarshal(dAtA []byte
 			}
 			m.ProviderAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrackedSinceHeight", wireType)
+			}
+			m.TrackedSinceHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TrackedSinceHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7754,7 +22290,7 @@ func (m *QueryConsumerChainsValidatorHasToValidateRequest) Unmarshal(dAtA []byte
 	}
 	return nil
 }
-func (m *QueryConsumerChainsValidatorHasToValidateResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryConsumerUpdateHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7777,15 +22313,15 @@ func (m *QueryConsumerChainsValidatorHasToValidateResponse) Unmarshal(dAtA []byt
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryConsumerChainsValidatorHasToValidateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryConsumerUpdateHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryConsumerChainsValidatorHasToValidateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryConsumerUpdateHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7813,7 +22349,43 @@ func (m *QueryConsumerChainsValidatorHasToValidateResponse) Unmarshal(dAtA []byt
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsumerIds = append(m.ConsumerIds, string(dAtA[iNdEx:postIndex]))
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7836,7 +22408,7 @@ func (m *QueryConsumerChainsValidatorHasToValidateResponse) Unmarshal(dAtA []byt
 	}
 	return nil
 }
-func (m *QueryValidatorConsumerCommissionRateRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryConsumerUpdateHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7859,17 +22431,17 @@ func (m *QueryValidatorConsumerCommissionRateRequest) Unmarshal(dAtA []byte) err
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorConsumerCommissionRateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryConsumerUpdateHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorConsumerCommissionRateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryConsumerUpdateHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7879,29 +22451,31 @@ func (m *QueryValidatorConsumerCommissionRateRequest) Unmarshal(dAtA []byte) err
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			m.Records = append(m.Records, ConsumerUpdateRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7911,23 +22485,27 @@ func (m *QueryValidatorConsumerCommissionRateRequest) Unmarshal(dAtA []byte) err
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProviderAddress = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7950,7 +22528,7 @@ func (m *QueryValidatorConsumerCommissionRateRequest) Unmarshal(dAtA []byte) err
 	}
 	return nil
 }
-func (m *QueryValidatorConsumerCommissionRateResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorOptInHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7973,15 +22551,15 @@ func (m *QueryValidatorConsumerCommissionRateResponse) Unmarshal(dAtA []byte) er
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryValidatorConsumerCommissionRateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorOptInHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryValidatorConsumerCommissionRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorOptInHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8009,9 +22587,7 @@ func (m *QueryValidatorConsumerCommissionRateResponse) Unmarshal(dAtA []byte) er
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ProviderAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8034,7 +22610,7 @@ func (m *QueryValidatorConsumerCommissionRateResponse) Unmarshal(dAtA []byte) er
 	}
 	return nil
 }
-func (m *QueryBlocksUntilNextEpochRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryValidatorOptInHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8057,12 +22633,46 @@ func (m *QueryBlocksUntilNextEpochRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBlocksUntilNextEpochRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryValidatorOptInHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBlocksUntilNextEpochRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryValidatorOptInHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Histories", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Histories = append(m.Histories, ValidatorConsumerOptInHistory{})
+			if err := m.Histories[len(m.Histories)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8084,7 +22694,7 @@ func (m *QueryBlocksUntilNextEpochRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBlocksUntilNextEpochResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryModuleVersionInfoRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8099,39 +22709,20 @@ func (m *QueryBlocksUntilNextEpochResponse) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBlocksUntilNextEpochResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBlocksUntilNextEpochResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlocksUntilNextEpoch", wireType)
-			}
-			m.BlocksUntilNextEpoch = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BlocksUntilNextEpoch |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryModuleVersionInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryModuleVersionInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8153,7 +22744,7 @@ func (m *QueryBlocksUntilNextEpochResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryConsumerIdFromClientIdRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryModuleVersionInfoResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8176,17 +22767,17 @@ func (m *QueryConsumerIdFromClientIdRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryConsumerIdFromClientIdRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryModuleVersionInfoResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryConsumerIdFromClientIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryModuleVersionInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VersionInfo", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8196,23 +22787,24 @@ func (m *QueryConsumerIdFromClientIdRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientId = string(dAtA[iNdEx:postIndex])
+			if err := m.VersionInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8235,7 +22827,7 @@ func (m *QueryConsumerIdFromClientIdRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryConsumerIdFromClientIdResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryConsumerRewardEstimateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8258,10 +22850,10 @@ func (m *QueryConsumerIdFromClientIdResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryConsumerIdFromClientIdResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryConsumerRewardEstimateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryConsumerIdFromClientIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryConsumerRewardEstimateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8296,6 +22888,38 @@ func (m *QueryConsumerIdFromClientIdResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.ConsumerId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProviderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8317,7 +22941,7 @@ func (m *QueryConsumerIdFromClientIdResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryConsumerChainRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryConsumerRewardEstimateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8340,17 +22964,17 @@ func (m *QueryConsumerChainRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryConsumerChainRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryConsumerRewardEstimateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryConsumerChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryConsumerRewardEstimateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Estimate", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8360,23 +22984,24 @@ func (m *QueryConsumerChainRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			if err := m.Estimate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8399,7 +23024,7 @@ func (m *QueryConsumerChainRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryConsumerChainResponse) Unmarshal(dAtA []byte) error {
+func (m *ConsumerRewardEstimate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8422,10 +23047,10 @@ func (m *QueryConsumerChainResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryConsumerChainResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConsumerRewardEstimate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryConsumerChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConsumerRewardEstimate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8462,7 +23087,7 @@ func (m *QueryConsumerChainResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8490,13 +23115,13 @@ func (m *QueryConsumerChainResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ChainId = string(dAtA[iNdEx:postIndex])
+			m.ProviderAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservationWindow", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8506,27 +23131,62 @@ func (m *QueryConsumerChainResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.ObservationWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnualConsumerRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AnnualConsumerRewards = append(m.AnnualConsumerRewards, types3.DecCoin{})
+			if err := m.AnnualConsumerRewards[len(m.AnnualConsumerRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PowerShare", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8554,13 +23214,15 @@ func (m *QueryConsumerChainResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Phase = string(dAtA[iNdEx:postIndex])
+			if err := m.PowerShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionRate", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8570,28 +23232,29 @@ func (m *QueryConsumerChainResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CommissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitParams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnualValidatorRewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8618,16 +23281,14 @@ func (m *QueryConsumerChainResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.InitParams == nil {
-				m.InitParams = &ConsumerInitializationParameters{}
-			}
-			if err := m.InitParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AnnualValidatorRewards = append(m.AnnualValidatorRewards, types3.DecCoin{})
+			if err := m.AnnualValidatorRewards[len(m.AnnualValidatorRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PowerShapingParams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnualCommission", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8654,16 +23315,14 @@ func (m *QueryConsumerChainResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PowerShapingParams == nil {
-				m.PowerShapingParams = &PowerShapingParameters{}
-			}
-			if err := m.PowerShapingParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AnnualCommission = append(m.AnnualCommission, types3.DecCoin{})
+			if err := m.AnnualCommission[len(m.AnnualCommission)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InfractionParameters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnualDelegatorRewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8690,18 +23349,16 @@ func (m *QueryConsumerChainResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.InfractionParameters == nil {
-				m.InfractionParameters = &InfractionParameters{}
-			}
-			if err := m.InfractionParameters.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AnnualDelegatorRewards = append(m.AnnualDelegatorRewards, types3.DecCoin{})
+			if err := m.AnnualDelegatorRewards[len(m.AnnualDelegatorRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorRewardRate", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8711,24 +23368,46 @@ func (m *QueryConsumerChainResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClientId = string(dAtA[iNdEx:postIndex])
+			m.DelegatorRewardRate = append(m.DelegatorRewardRate, types3.DecCoin{})
+			if err := m.DelegatorRewardRate[len(m.DelegatorRewardRate)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Eligible", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Eligible = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8750,7 +23429,7 @@ func (m *QueryConsumerChainResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryConsumerGenesisTimeRequest) Unmarshal(dAtA []byte) error {
+func (m *StreamValidatorSetChangesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8773,10 +23452,10 @@ func (m *QueryConsumerGenesisTimeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryConsumerGenesisTimeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: StreamValidatorSetChangesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryConsumerGenesisTimeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StreamValidatorSetChangesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8832,7 +23511,7 @@ func (m *QueryConsumerGenesisTimeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryConsumerGenesisTimeResponse) Unmarshal(dAtA []byte) error {
+func (m *StreamValidatorSetChangesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8855,15 +23534,66 @@ func (m *QueryConsumerGenesisTimeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryConsumerGenesisTimeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: StreamValidatorSetChangesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryConsumerGenesisTimeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StreamValidatorSetChangesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GenesisTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsumerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8890,7 +23620,7 @@ func (m *QueryConsumerGenesisTimeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.GenesisTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Packet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,24 @@
+package types
+
+import (
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// The helpers in this file derive the addresses of the accounts involved in the CCV
+// reward distribution. The derivations are part of the protocol and must not change.
+
+// ModuleAddress returns the address of the module account with the given name,
+// i.e., the first 20 bytes of sha256(name)
+func ModuleAddress(moduleName string) sdk.AccAddress {
+	return authtypes.NewModuleAddress(moduleName)
+}
+
+// TransferEscrowAddress returns the address of the ICS-20 escrow account of the given transfer channel,
+// i.e., the account holding the tokens sent through the channel, e.g., the ICS rewards a consumer chain
+// sends to the provider on the distribution transmission channel
+func TransferEscrowAddress(channelId string) sdk.AccAddress {
+	return transfertypes.GetEscrowAddress(transfertypes.PortID, channelId)
+}
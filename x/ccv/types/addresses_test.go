@@ -0,0 +1,62 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	consumertypes "github.com/cosmos/interchain-security/v7/x/ccv/consumer/types"
+	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+	"github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// TestDerivedAddresses checks the derived CCV addresses against golden vectors.
+// A failure of this test means that the address derivations changed, which breaks
+// the integrations relying on them.
+func TestDerivedAddresses(t *testing.T) {
+	testCases := []struct {
+		name    string
+		address sdk.AccAddress
+		expAddr string
+	}{
+		{
+			"provider consumer rewards pool",
+			providertypes.ConsumerRewardsPoolAddress(),
+			"cosmos1ap0mh6xzfn8943urr84q6ae7zfnar48am2erhd",
+		},
+		{
+			"consumer redistribute account",
+			consumertypes.ConsumerRedistributeAddress(),
+			"cosmos1x69dz0c0emw8m2c6kp5v6c08kgjxmu30d2595n",
+		},
+		{
+			"consumer to send to provider account",
+			consumertypes.ConsumerToSendToProviderAddress(),
+			"cosmos1ywtansy6ss0jtq8ckrcv6jzkps8yh8mfc8xucq",
+		},
+		{
+			"transfer escrow of channel-0",
+			types.TransferEscrowAddress("channel-0"),
+			"cosmos1a53udazy8ayufvy0s434pfwjcedzqv34kvz9tw",
+		},
+		{
+			"transfer escrow of channel-1",
+			types.TransferEscrowAddress("channel-1"),
+			"cosmos1kq2rzz6fq2q7fsu75a9g7cpzjeanmk68g99lm5",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			addr, err := sdk.Bech32ifyAddressBytes("cosmos", tc.address)
+			require.NoError(t, err)
+			require.Equal(t, tc.expAddr, addr)
+		})
+	}
+
+	// the module addresses are derived from the module names only
+	require.Equal(t, providertypes.ConsumerRewardsPoolAddress(), types.ModuleAddress(providertypes.ConsumerRewardsPool))
+	require.NotEqual(t, types.TransferEscrowAddress("channel-0"), types.TransferEscrowAddress("channel-1"))
+}
@@ -0,0 +1,85 @@
+package types
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+)
+
+// QueryBlockEvents returns the events of the block at `height`, queried through the node of `clientCtx`
+// (see BlockEvents)
+func QueryBlockEvents(ctx context.Context, clientCtx client.Context, height int64) ([]abci.Event, error) {
+	node, err := clientCtx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+	res, err := node.BlockResults(ctx, &height)
+	if err != nil {
+		return nil, err
+	}
+	return BlockEvents(res), nil
+}
+
+// BlockEvents returns the events of a block in the order they were emitted, i.e., the events
+// of the begin blockers, the events of the transactions, and the events of the end blockers
+func BlockEvents(res *coretypes.ResultBlockResults) []abci.Event {
+	events := []abci.Event{}
+	blockEvents := []abci.Event{}
+	for _, event := range res.FinalizeBlockEvents {
+		if eventMode(event) == "BeginBlock" {
+			events = append(events, event)
+		} else {
+			blockEvents = append(blockEvents, event)
+		}
+	}
+	for _, txResult := range res.TxsResults {
+		events = append(events, txResult.Events...)
+	}
+	return append(events, blockEvents...)
+}
+
+// eventMode returns the mode attribute added by the SDK to the events of the begin and end blockers
+func eventMode(event abci.Event) string {
+	for _, attr := range event.Attributes {
+		if attr.Key == "mode" {
+			return attr.Value
+		}
+	}
+	return ""
+}
+
+// NewBlockOperationsHandler returns an HTTP handler that returns, as JSON, the balance operations
+// extracted by `operationsFromEvents` from the events of the block at the `height` query parameter
+func NewBlockOperationsHandler[T any](
+	clientCtx client.Context,
+	operationsFromEvents func(ctx context.Context, clientCtx client.Context, events []abci.Event) ([]T, error),
+) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		height, err := strconv.ParseInt(r.URL.Query().Get("height"), 10, 64)
+		if err != nil || height <= 0 {
+			http.Error(w, "invalid height", http.StatusBadRequest)
+			return
+		}
+		clientCtx := clientCtx.WithHeight(height)
+		events, err := QueryBlockEvents(r.Context(), clientCtx, height)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		operations, err := operationsFromEvents(r.Context(), clientCtx, events)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(operations); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	}
+}
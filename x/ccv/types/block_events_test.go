@@ -0,0 +1,36 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+func TestBlockEvents(t *testing.T) {
+	blockEvent := func(eventType, mode string) abci.Event {
+		return abci.Event{Type: eventType, Attributes: []abci.EventAttribute{{Key: "mode", Value: mode}}}
+	}
+	res := &coretypes.ResultBlockResults{
+		FinalizeBlockEvents: []abci.Event{
+			blockEvent("begin_1", "BeginBlock"),
+			blockEvent("end_1", "EndBlock"),
+			blockEvent("begin_2", "BeginBlock"),
+			blockEvent("end_2", "EndBlock"),
+		},
+		TxsResults: []*abci.ExecTxResult{
+			{Events: []abci.Event{{Type: "tx_1"}, {Type: "tx_2"}}},
+			{Events: []abci.Event{{Type: "tx_3"}}},
+		},
+	}
+
+	eventTypes := []string{}
+	for _, event := range types.BlockEvents(res) {
+		eventTypes = append(eventTypes, event.Type)
+	}
+	require.Equal(t, []string{"begin_1", "begin_2", "tx_1", "tx_2", "tx_3", "end_1", "end_2"}, eventTypes)
+}
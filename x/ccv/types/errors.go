@@ -25,4 +25,7 @@ var (
 	ErrStoreKeyNotFound            = errorsmod.Register(ModuleName, 17, "store key not found")
 	ErrStoreUnmarshal              = errorsmod.Register(ModuleName, 18, "cannot unmarshal value from store")
 	ErrInvalidConsumerId           = errorsmod.Register(ModuleName, 19, "invalid consumer id")
+	ErrUnknownChannel              = errorsmod.Register(ModuleName, 20, "packet received on unknown CCV channel")
+	ErrSlashRecordNotFound         = errorsmod.Register(ModuleName, 21, "slash record not found")
+	ErrRecoveredPanic              = errorsmod.Register(ModuleName, 22, "recovered from panic in CCV packet handler")
 )
@@ -14,6 +14,10 @@ const (
 	EventTypeSubmitConsumerDoubleVoting = "submit_consumer_double_voting"
 	EventTypeExecuteConsumerChainSlash  = "execute_consumer_chain_slash"
 	EventTypeConsumerSlashRequest       = "consumer_slash_request"
+	EventTypeChannelMigrated            = "ccv_channel_migrated"
+	EventTypePriorityPacket             = "ccv_priority_packet"
+	EventTypeUnexpectedState            = "ccv_unexpected_state"
+	EventTypeClientExpiryWarning        = "ccv_client_expiry_warning"
 
 	AttributeKeyAckSuccess            = "success"
 	AttributeKeyAck                   = "acknowledgement"
@@ -36,4 +40,20 @@ const (
 	AttributeValidatorAddress         = "validator_address"
 	AttributeInfractionType           = "infraction_type"
 	AttributeValSetUpdateID           = "valset_update_id"
+	AttributePreviousChannelID        = "previous_channel_id"
+	AttributePacketType               = "packet_type"
+	AttributePacketSequence           = "packet_sequence"
+	AttributeTimeoutTimestamp         = "timeout_timestamp"
+	AttributePriorityReason           = "priority_reason"
+	AttributeRecoveryPolicy           = "recovery_policy"
+	AttributeClientExpiry             = "client_expiry"
+	AttributeTimeUntilClientExpiry    = "time_until_client_expiry"
+
+	// Values of the packet_type attribute
+	PacketTypeSlash = "slash"
+	PacketTypeVSC   = "vsc"
+
+	// Values of the priority_reason attribute
+	PriorityReasonSlashPacket = "slash_packet"
+	PriorityReasonNearTimeout = "near_timeout"
 )
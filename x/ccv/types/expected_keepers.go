@@ -21,6 +21,57 @@ import (
 	abci "github.com/cometbft/cometbft/abci/types"
 )
 
+// ProviderStakingKeeper defines exactly the staking keeper methods used by the provider module.
+// Chains running a staking module other than the SDK one, e.g., a fork, can integrate the provider
+// module by implementing this interface; see CheckProviderKeepersConformance in testutil/integration.
+type ProviderStakingKeeper interface {
+	UnbondingCanComplete(ctx context.Context, id uint64) error
+	UnbondingTime(ctx context.Context) (time.Duration, error)
+	GetValidatorByConsAddr(ctx context.Context, consAddr sdk.ConsAddress) (stakingtypes.Validator, error)
+	GetLastValidatorPower(ctx context.Context, operator sdk.ValAddress) (int64, error)
+	Jail(context.Context, sdk.ConsAddress) error
+	Slash(ctx context.Context, consAddr sdk.ConsAddress, infractionHeight, power int64, slashFactor math.LegacyDec) (math.Int, error)
+	SlashWithInfractionReason(ctx context.Context, consAddr sdk.ConsAddress, infractionHeight, power int64, slashFactor math.LegacyDec, infraction stakingtypes.Infraction) (math.Int, error)
+	SlashUnbondingDelegation(ctx context.Context, unbondingDelegation stakingtypes.UnbondingDelegation, infractionHeight int64, slashFactor math.LegacyDec) (math.Int, error)
+	SlashRedelegation(ctx context.Context, srcValidator stakingtypes.Validator, redelegation stakingtypes.Redelegation, infractionHeight int64, slashFactor math.LegacyDec) (math.Int, error)
+	GetValidator(ctx context.Context, addr sdk.ValAddress) (stakingtypes.Validator, error)
+	PowerReduction(ctx context.Context) math.Int
+	IsValidatorJailed(ctx context.Context, addr sdk.ConsAddress) (bool, error)
+	MaxValidators(ctx context.Context) (uint32, error)
+	GetLastTotalPower(ctx context.Context) (math.Int, error)
+	BondDenom(ctx context.Context) (string, error)
+	GetUnbondingDelegationsFromValidator(ctx context.Context, valAddr sdk.ValAddress) ([]stakingtypes.UnbondingDelegation, error)
+	GetRedelegationsFromSrcValidator(ctx context.Context, valAddr sdk.ValAddress) ([]stakingtypes.Redelegation, error)
+	MinCommissionRate(ctx context.Context) (math.LegacyDec, error)
+	GetBondedValidatorsByPower(ctx context.Context) ([]stakingtypes.Validator, error)
+	IterateDelegations(
+		ctx context.Context, delegator sdk.AccAddress,
+		fn func(index int64, delegation stakingtypes.DelegationI) (stop bool),
+	) error
+	IterateBondedValidatorsByPower(
+		context.Context, func(index int64, validator stakingtypes.ValidatorI) (stop bool),
+	) error
+	StakingTokenSupply(ctx context.Context) (math.Int, error)
+	BondedRatio(ctx context.Context) (math.LegacyDec, error)
+	TotalBondedTokens(ctx context.Context) (math.Int, error)
+	GetHistoricalInfo(ctx context.Context, height int64) (stakingtypes.HistoricalInfo, error)
+}
+
+// ProviderSlashingKeeper defines exactly the slashing keeper methods used by the provider module
+type ProviderSlashingKeeper interface {
+	JailUntil(context.Context, sdk.ConsAddress, time.Time) error
+	DowntimeJailDuration(context.Context) (time.Duration, error)
+	SlashFractionDoubleSign(context.Context) (math.LegacyDec, error)
+	Tombstone(context.Context, sdk.ConsAddress) error
+	IsTombstoned(context.Context, sdk.ConsAddress) bool
+}
+
+// the full interfaces must remain supersets of the provider ones
+var (
+	_ ProviderStakingKeeper  = StakingKeeper(nil)
+	_ ProviderSlashingKeeper = SlashingKeeper(nil)
+)
+
 // StakingKeeper defines the contract expected by provider-chain ccv module from a Staking Module that will keep track
 // of the provider validator set. This version of the interchain-security protocol will mirror the provider chain's changes
 // so we do not need a registry module between the staking module and CCV.
@@ -86,6 +137,7 @@ type SlashingKeeper interface {
 type ChannelKeeper interface {
 	GetChannel(ctx sdk.Context, srcPort, srcChan string) (channel channeltypes.Channel, found bool)
 	GetNextSequenceSend(ctx sdk.Context, portID, channelID string) (uint64, bool)
+	GetNextSequenceAck(ctx sdk.Context, portID, channelID string) (uint64, bool)
 	SendPacket(
 		ctx sdk.Context,
 		sourcePort string,
@@ -113,7 +165,9 @@ type ClientKeeper interface {
 		bool)
 	ClientStore(ctx sdk.Context, clientID string) storetypes.KVStore
 	SetClientState(ctx sdk.Context, clientID string, clientState ibcexported.ClientState)
+	SetClientConsensusState(ctx sdk.Context, clientID string, height ibcexported.Height, consensusState ibcexported.ConsensusState)
 	GetStoreProvider() clienttypes.StoreProvider
+	UpdateClient(ctx sdk.Context, clientID string, clientMsg ibcexported.ClientMessage) error
 }
 
 // DistributionKeeper defines the expected interface of the distribution keeper
@@ -121,6 +175,7 @@ type DistributionKeeper interface {
 	FundCommunityPool(ctx context.Context, amount sdk.Coins, sender sdk.AccAddress) error
 	GetCommunityTax(ctx context.Context) (math.LegacyDec, error)
 	AllocateTokensToValidator(ctx context.Context, validator stakingtypes.ValidatorI, reward sdk.DecCoins) error
+	GetValidatorOutstandingRewardsCoins(ctx context.Context, val sdk.ValAddress) (sdk.DecCoins, error)
 }
 
 // ConsumerHooks event hooks for newly bonded cross-chain validators
@@ -132,12 +187,15 @@ type ConsumerHooks interface {
 type BankKeeper interface {
 	GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin
 	GetAllBalances(ctx context.Context, addr sdk.AccAddress) sdk.Coins
+	SpendableCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins
 	SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 }
 
 // AccountKeeper defines the expected account keeper used for simulations
 type AccountKeeper interface {
 	GetModuleAccount(ctx context.Context, name string) sdk.ModuleAccountI
+	GetAccount(ctx context.Context, addr sdk.AccAddress) sdk.AccountI
 	AddressCodec() addresscodec.Codec
 }
 
@@ -147,6 +205,16 @@ type IBCTransferKeeper interface {
 	Transfer(context.Context, *transfertypes.MsgTransfer) (*transfertypes.MsgTransferResponse, error)
 }
 
+// RateLimitKeeper defines the expected interface of the keeper of an IBC rate-limiting middleware
+// needed for exempting the distribution transfers of tokens from the consumer to the provider chain
+// from the rate limits. Both methods are expected to be idempotent.
+type RateLimitKeeper interface {
+	// SetRateLimitExemption exempts the ICS-20 transfers from `sender` to `receiver` on `channelID` from the rate limits
+	SetRateLimitExemption(ctx context.Context, channelID, sender, receiver string) error
+	// RemoveRateLimitExemption removes an exemption set with SetRateLimitExemption
+	RemoveRateLimitExemption(ctx context.Context, channelID, sender, receiver string) error
+}
+
 // IBCCoreKeeper defines the expected interface needed for opening a
 // channel
 type IBCCoreKeeper interface {
@@ -0,0 +1,14 @@
+package types_test
+
+import (
+	slashingkeeper "github.com/cosmos/cosmos-sdk/x/slashing/keeper"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// the keepers of the SDK must implement the interfaces expected by the provider module
+var (
+	_ types.ProviderStakingKeeper  = (*stakingkeeper.Keeper)(nil)
+	_ types.ProviderSlashingKeeper = slashingkeeper.Keeper{}
+)
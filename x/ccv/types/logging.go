@@ -0,0 +1,234 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+
+	"github.com/spf13/cast"
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/log"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+)
+
+// LogSubsystem identifies a group of high-frequency logs of the CCV modules
+type LogSubsystem string
+
+const (
+	// LogSubsystemVSC covers the logs of sending and receiving VSC packets
+	LogSubsystemVSC LogSubsystem = "vsc"
+	// LogSubsystemSlash covers the logs of sending and receiving slash packets
+	LogSubsystemSlash LogSubsystem = "slash"
+	// LogSubsystemRewards covers the logs of sending and receiving ICS rewards
+	LogSubsystemRewards LogSubsystem = "rewards"
+)
+
+// LogSubsystems are all the logging subsystems of the CCV modules
+var LogSubsystems = []LogSubsystem{LogSubsystemVSC, LogSubsystemSlash, LogSubsystemRewards}
+
+// LogLevel is the minimum level of the logs of a subsystem
+type LogLevel int
+
+const (
+	LogLevelDebug LogLevel = iota
+	LogLevelInfo
+	LogLevelWarn
+	LogLevelError
+)
+
+// ParseLogLevel parses a log level, i.e., debug, info, warn, or error
+func ParseLogLevel(level string) (LogLevel, error) {
+	switch strings.ToLower(strings.TrimSpace(level)) {
+	case "debug":
+		return LogLevelDebug, nil
+	case "info":
+		return LogLevelInfo, nil
+	case "warn":
+		return LogLevelWarn, nil
+	case "error":
+		return LogLevelError, nil
+	default:
+		return 0, fmt.Errorf("invalid log level %q: expected one of debug, info, warn, error", level)
+	}
+}
+
+const (
+	FlagLogLevels      = "ccv.log-levels"
+	FlagLogSampleRates = "ccv.log-sample-rates"
+)
+
+// LogConfig configures the logs of the CCV subsystems. Subsystems without a level
+// log at all levels, i.e., the level is set by the node logger. Subsystems with
+// a sample rate N log only every Nth debug and info log, while warnings and errors
+// are never sampled.
+type LogConfig struct {
+	Levels      map[LogSubsystem]LogLevel
+	SampleRates map[LogSubsystem]uint64
+}
+
+// DefaultLogConfig returns a config that does not filter any logs
+func DefaultLogConfig() LogConfig {
+	return LogConfig{
+		Levels:      map[LogSubsystem]LogLevel{},
+		SampleRates: map[LogSubsystem]uint64{},
+	}
+}
+
+// AddLogFlags adds the CCV logging flags to the start command
+func AddLogFlags(startCmd *cobra.Command) {
+	startCmd.Flags().String(FlagLogLevels, "", "Comma-separated minimum log levels of the CCV subsystems (vsc, slash, rewards), e.g., vsc:warn,slash:info")
+	startCmd.Flags().String(FlagLogSampleRates, "", "Comma-separated sample rates of the debug and info logs of the CCV subsystems, e.g., vsc:100 logs every 100th VSC log")
+}
+
+// LogConfigFromAppOptions returns the CCV logging config set through the app options
+func LogConfigFromAppOptions(appOpts servertypes.AppOptions) (LogConfig, error) {
+	config := DefaultLogConfig()
+
+	levels, err := parseSubsystemValues(cast.ToString(appOpts.Get(FlagLogLevels)))
+	if err != nil {
+		return LogConfig{}, fmt.Errorf("invalid %s: %w", FlagLogLevels, err)
+	}
+	for subsystem, value := range levels {
+		level, err := ParseLogLevel(value)
+		if err != nil {
+			return LogConfig{}, fmt.Errorf("invalid %s: %w", FlagLogLevels, err)
+		}
+		config.Levels[subsystem] = level
+	}
+
+	rates, err := parseSubsystemValues(cast.ToString(appOpts.Get(FlagLogSampleRates)))
+	if err != nil {
+		return LogConfig{}, fmt.Errorf("invalid %s: %w", FlagLogSampleRates, err)
+	}
+	for subsystem, value := range rates {
+		rate, err := strconv.ParseUint(value, 10, 64)
+		if err != nil || rate == 0 {
+			return LogConfig{}, fmt.Errorf("invalid %s: sample rate of %s must be a positive integer, got %q", FlagLogSampleRates, subsystem, value)
+		}
+		config.SampleRates[subsystem] = rate
+	}
+
+	return config, nil
+}
+
+// parseSubsystemValues parses a comma-separated list of subsystem:value pairs
+func parseSubsystemValues(s string) (map[LogSubsystem]string, error) {
+	values := map[LogSubsystem]string{}
+	if strings.TrimSpace(s) == "" {
+		return values, nil
+	}
+	for _, pair := range strings.Split(s, ",") {
+		subsystem, value, ok := strings.Cut(strings.TrimSpace(pair), ":")
+		if !ok {
+			return nil, fmt.Errorf("expected subsystem:value, got %q", pair)
+		}
+		if !isLogSubsystem(LogSubsystem(subsystem)) {
+			return nil, fmt.Errorf("unknown subsystem %q", subsystem)
+		}
+		values[LogSubsystem(subsystem)] = value
+	}
+	return values, nil
+}
+
+func isLogSubsystem(subsystem LogSubsystem) bool {
+	for _, s := range LogSubsystems {
+		if s == subsystem {
+			return true
+		}
+	}
+	return false
+}
+
+// SubsystemLoggers filters and samples the logs of the CCV subsystems. The keepers hold
+// a pointer to it, so that the config applies to all the copies of a keeper.
+//
+// NOTE: The sampling counters are local to the node, i.e., logs are not part of the consensus.
+type SubsystemLoggers struct {
+	mu       sync.RWMutex
+	config   LogConfig
+	counters map[LogSubsystem]*atomic.Uint64
+}
+
+// NewSubsystemLoggers returns the loggers of the CCV subsystems for a given config
+func NewSubsystemLoggers(config LogConfig) *SubsystemLoggers {
+	l := &SubsystemLoggers{}
+	l.SetConfig(config)
+	return l
+}
+
+// SetConfig replaces the logging config and resets the sampling counters
+func (l *SubsystemLoggers) SetConfig(config LogConfig) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.config = config
+	l.counters = map[LogSubsystem]*atomic.Uint64{}
+	for _, subsystem := range LogSubsystems {
+		l.counters[subsystem] = &atomic.Uint64{}
+	}
+}
+
+// Logger wraps the base logger with the level and the sample rate of the subsystem
+func (l *SubsystemLoggers) Logger(base log.Logger, subsystem LogSubsystem) log.Logger {
+	if l == nil {
+		return base
+	}
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	level, hasLevel := l.config.Levels[subsystem]
+	if !hasLevel {
+		level = LogLevelDebug
+	}
+	return subsystemLogger{
+		Logger:     base,
+		level:      level,
+		sampleRate: l.config.SampleRates[subsystem],
+		counter:    l.counters[subsystem],
+	}
+}
+
+// subsystemLogger is a log.Logger that drops the logs below the level of the subsystem
+// and, if a sample rate N is set, all but every Nth debug and info log
+type subsystemLogger struct {
+	log.Logger
+	level      LogLevel
+	sampleRate uint64
+	counter    *atomic.Uint64
+}
+
+func (l subsystemLogger) sampled() bool {
+	if l.sampleRate <= 1 || l.counter == nil {
+		return true
+	}
+	return (l.counter.Add(1)-1)%l.sampleRate == 0
+}
+
+func (l subsystemLogger) Debug(msg string, keyVals ...any) {
+	if l.level <= LogLevelDebug && l.sampled() {
+		l.Logger.Debug(msg, keyVals...)
+	}
+}
+
+func (l subsystemLogger) Info(msg string, keyVals ...any) {
+	if l.level <= LogLevelInfo && l.sampled() {
+		l.Logger.Info(msg, keyVals...)
+	}
+}
+
+func (l subsystemLogger) Warn(msg string, keyVals ...any) {
+	if l.level <= LogLevelWarn {
+		l.Logger.Warn(msg, keyVals...)
+	}
+}
+
+func (l subsystemLogger) Error(msg string, keyVals ...any) {
+	l.Logger.Error(msg, keyVals...)
+}
+
+func (l subsystemLogger) With(keyVals ...any) log.Logger {
+	l.Logger = l.Logger.With(keyVals...)
+	return l
+}
@@ -0,0 +1,124 @@
+package types_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+type appOptions map[string]interface{}
+
+func (o appOptions) Get(key string) interface{} {
+	return o[key]
+}
+
+func TestLogConfigFromAppOptions(t *testing.T) {
+	testCases := []struct {
+		name     string
+		opts     appOptions
+		expected types.LogConfig
+		expError bool
+	}{
+		{
+			name:     "no options",
+			opts:     appOptions{},
+			expected: types.DefaultLogConfig(),
+		},
+		{
+			name: "levels and sample rates",
+			opts: appOptions{
+				types.FlagLogLevels:      "vsc:warn, slash:info",
+				types.FlagLogSampleRates: "vsc:100",
+			},
+			expected: types.LogConfig{
+				Levels: map[types.LogSubsystem]types.LogLevel{
+					types.LogSubsystemVSC:   types.LogLevelWarn,
+					types.LogSubsystemSlash: types.LogLevelInfo,
+				},
+				SampleRates: map[types.LogSubsystem]uint64{types.LogSubsystemVSC: 100},
+			},
+		},
+		{
+			name:     "unknown subsystem",
+			opts:     appOptions{types.FlagLogLevels: "foo:info"},
+			expError: true,
+		},
+		{
+			name:     "invalid level",
+			opts:     appOptions{types.FlagLogLevels: "vsc:verbose"},
+			expError: true,
+		},
+		{
+			name:     "missing value",
+			opts:     appOptions{types.FlagLogLevels: "vsc"},
+			expError: true,
+		},
+		{
+			name:     "zero sample rate",
+			opts:     appOptions{types.FlagLogSampleRates: "vsc:0"},
+			expError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			config, err := types.LogConfigFromAppOptions(tc.opts)
+			if tc.expError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, config)
+		})
+	}
+}
+
+func TestSubsystemLoggers(t *testing.T) {
+	var buf bytes.Buffer
+	base := log.NewLogger(&buf, log.ColorOption(false))
+
+	loggers := types.NewSubsystemLoggers(types.LogConfig{
+		Levels:      map[types.LogSubsystem]types.LogLevel{types.LogSubsystemSlash: types.LogLevelWarn},
+		SampleRates: map[types.LogSubsystem]uint64{types.LogSubsystemVSC: 3},
+	})
+
+	// every 3rd VSC info log is logged
+	for i := 0; i < 7; i++ {
+		loggers.Logger(base, types.LogSubsystemVSC).Info("vsc info")
+	}
+	require.Equal(t, 3, strings.Count(buf.String(), "vsc info"))
+
+	// warnings are never sampled
+	for i := 0; i < 3; i++ {
+		loggers.Logger(base, types.LogSubsystemVSC).Warn("vsc warn")
+	}
+	require.Equal(t, 3, strings.Count(buf.String(), "vsc warn"))
+
+	// logs below the level of the subsystem are dropped
+	slashLogger := loggers.Logger(base, types.LogSubsystemSlash).With("consumerId", "0")
+	slashLogger.Info("slash info")
+	slashLogger.Warn("slash warn")
+	slashLogger.Error("slash error")
+	require.NotContains(t, buf.String(), "slash info")
+	require.Contains(t, buf.String(), "slash warn")
+	require.Contains(t, buf.String(), "slash error")
+
+	// subsystems without config are not filtered
+	loggers.Logger(base, types.LogSubsystemRewards).Info("rewards info")
+	require.Contains(t, buf.String(), "rewards info")
+
+	// the config can be replaced
+	loggers.SetConfig(types.DefaultLogConfig())
+	loggers.Logger(base, types.LogSubsystemSlash).Info("slash info")
+	require.Contains(t, buf.String(), "slash info")
+
+	// nil loggers return the base logger
+	var nilLoggers *types.SubsystemLoggers
+	require.Equal(t, base, nilLoggers.Logger(base, types.LogSubsystemVSC))
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	fmt "fmt"
+	"strings"
 	time "time"
 
 	"cosmossdk.io/math"
@@ -41,6 +42,19 @@ const (
 
 	// Default retry delay period is 1 hour.
 	DefaultRetryDelayPeriod = time.Hour
+
+	// By default, all the pending validator updates are sent to the consensus engine in the same block.
+	DefaultMaxValidatorUpdatesPerBlock = uint64(0)
+
+	// By default, the delay before retrying to send a bounced slash packet is fixed.
+	DefaultRetryDelayMultiplier = "1"
+
+	// By default, there is no maximum delay before retrying to send a bounced slash packet.
+	DefaultMaxRetryDelayPeriod = time.Duration(0)
+
+	// By default, a warning is emitted when a CCV client, i.e., the client to the provider or
+	// the client of a consumer chain, expires in less than 3 days.
+	DefaultClientExpiryWarningThreshold = 3 * 24 * time.Hour
 )
 
 // Reflection based keys for params subspace
@@ -78,7 +92,11 @@ func NewParams(enabled bool, blocksPerDistributionTransmission int64,
 	consumerRedistributionFraction string, historicalEntries int64,
 	consumerUnbondingPeriod time.Duration,
 	rewardDenoms, providerRewardDenoms []string, retryDelayPeriod time.Duration,
-	consumerId string,
+	consumerId string, strictVscIdOrdering bool, maxValidatorUpdatesPerBlock uint64,
+	retryDelayMultiplier string, maxRetryDelayPeriod time.Duration,
+	clientExpiryWarningThreshold time.Duration,
+	denomRedistributionFractions []DenomRedistributionFraction,
+	rewardSplits []RewardSplit,
 ) ConsumerParams {
 	return ConsumerParams{
 		Enabled:                           enabled,
@@ -91,11 +109,18 @@ func NewParams(enabled bool, blocksPerDistributionTransmission int64,
 		HistoricalEntries:                 historicalEntries,
 		UnbondingPeriod:                   consumerUnbondingPeriod,
 		// DEPRECATED but setting here to 0 (i.e., disabled) for older versions of interchain-security
-		SoftOptOutThreshold:  "0",
-		RewardDenoms:         rewardDenoms,
-		ProviderRewardDenoms: providerRewardDenoms,
-		RetryDelayPeriod:     retryDelayPeriod,
-		ConsumerId:           consumerId,
+		SoftOptOutThreshold:          "0",
+		RewardDenoms:                 rewardDenoms,
+		ProviderRewardDenoms:         providerRewardDenoms,
+		RetryDelayPeriod:             retryDelayPeriod,
+		ConsumerId:                   consumerId,
+		StrictVscIdOrdering:          strictVscIdOrdering,
+		MaxValidatorUpdatesPerBlock:  maxValidatorUpdatesPerBlock,
+		RetryDelayMultiplier:         retryDelayMultiplier,
+		MaxRetryDelayPeriod:          maxRetryDelayPeriod,
+		ClientExpiryWarningThreshold: clientExpiryWarningThreshold,
+		DenomRedistributionFractions: denomRedistributionFractions,
+		RewardSplits:                 rewardSplits,
 	}
 }
 
@@ -103,6 +128,8 @@ func NewParams(enabled bool, blocksPerDistributionTransmission int64,
 func DefaultParams() ConsumerParams {
 	var rewardDenoms []string
 	var provideRewardDenoms []string
+	var denomRedistributionFractions []DenomRedistributionFraction
+	var rewardSplits []RewardSplit
 	return NewParams(
 		false,
 		DefaultBlocksPerDistributionTransmission,
@@ -117,6 +144,13 @@ func DefaultParams() ConsumerParams {
 		provideRewardDenoms,
 		DefaultRetryDelayPeriod,
 		"0",
+		false,
+		DefaultMaxValidatorUpdatesPerBlock,
+		DefaultRetryDelayMultiplier,
+		DefaultMaxRetryDelayPeriod,
+		DefaultClientExpiryWarningThreshold,
+		denomRedistributionFractions,
+		rewardSplits,
 	)
 }
 
@@ -161,6 +195,27 @@ func (p ConsumerParams) Validate() error {
 	if err := ValidateConsumerId(p.ConsumerId); err != nil {
 		return err
 	}
+	if err := ValidateBool(p.StrictVscIdOrdering); err != nil {
+		return err
+	}
+	if err := ValidateUint64(p.MaxValidatorUpdatesPerBlock); err != nil {
+		return err
+	}
+	if err := ValidateRetryDelayMultiplier(p.RetryDelayMultiplier); err != nil {
+		return err
+	}
+	if err := ValidateNonNegativeDuration(p.MaxRetryDelayPeriod); err != nil {
+		return err
+	}
+	if err := ValidateNonNegativeDuration(p.ClientExpiryWarningThreshold); err != nil {
+		return err
+	}
+	if err := ValidateDenomRedistributionFractions(p.DenomRedistributionFractions); err != nil {
+		return err
+	}
+	if err := ValidateRewardSplits(p.RewardSplits); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -222,3 +277,60 @@ func ValidateDenoms(i interface{}) error {
 
 	return nil
 }
+
+// ValidateDenomRedistributionFractions validates that the per-denom redistribution fractions
+// have valid and unique denoms and fractions between 0 and 1
+func ValidateDenomRedistributionFractions(i interface{}) error {
+	v, ok := i.([]DenomRedistributionFraction)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	denoms := map[string]bool{}
+	for _, f := range v {
+		if err := sdktypes.ValidateDenom(f.Denom); err != nil {
+			return err
+		}
+		if denoms[f.Denom] {
+			return fmt.Errorf("duplicate redistribution fraction for denom %s", f.Denom)
+		}
+		denoms[f.Denom] = true
+		if err := ValidateStringFraction(f.Fraction); err != nil {
+			return fmt.Errorf("invalid redistribution fraction for denom %s: %w", f.Denom, err)
+		}
+	}
+
+	return nil
+}
+
+// ValidateRewardSplits validates that the reward splits have non-empty and unique addresses
+// and fractions between 0 and 1 that sum up to at most 1. The addresses are decoded with the
+// address codec of the consumer chain by the consumer keeper.
+func ValidateRewardSplits(i interface{}) error {
+	v, ok := i.([]RewardSplit)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	addresses := map[string]bool{}
+	total := math.LegacyZeroDec()
+	for _, split := range v {
+		if strings.TrimSpace(split.Address) == "" {
+			return fmt.Errorf("reward split address cannot be empty")
+		}
+		if addresses[split.Address] {
+			return fmt.Errorf("duplicate reward split for address %s", split.Address)
+		}
+		addresses[split.Address] = true
+		if err := ValidateStringFraction(split.Fraction); err != nil {
+			return fmt.Errorf("invalid reward split fraction for address %s: %w", split.Address, err)
+		}
+		fraction, _ := math.LegacyNewDecFromStr(split.Fraction)
+		total = total.Add(fraction)
+	}
+	if total.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("reward split fractions sum up to %s, which is more than 1", total)
+	}
+
+	return nil
+}
@@ -0,0 +1,119 @@
+package types
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RecoveryPolicy defines how the CCV packet handlers recover from unexpected, but reachable
+// states, e.g., a packet received on an unknown channel. Panicking in such states is avoided,
+// as a panic in a handler that is not run in a transaction, e.g., in EndBlock, halts the chain.
+type RecoveryPolicy int
+
+const (
+	// RecoveryPolicyErrorAck returns the error to the caller, i.e., for received packets
+	// the error is written as an error acknowledgement
+	RecoveryPolicyErrorAck RecoveryPolicy = iota
+	// RecoveryPolicyEmitEvent logs the error, emits a ccv_unexpected_state event, and skips
+	// the rest of the handling
+	RecoveryPolicyEmitEvent
+	// RecoveryPolicyHalt panics, i.e., the previous behavior of the CCV packet handlers
+	RecoveryPolicyHalt
+)
+
+func (p RecoveryPolicy) String() string {
+	switch p {
+	case RecoveryPolicyErrorAck:
+		return "error_ack"
+	case RecoveryPolicyEmitEvent:
+		return "emit_event"
+	case RecoveryPolicyHalt:
+		return "halt"
+	default:
+		return fmt.Sprintf("unknown(%d)", int(p))
+	}
+}
+
+// recoveryPolicies maps the errors of unexpected, but reachable states to their recovery policy
+var recoveryPolicies = []struct {
+	err    *errorsmod.Error
+	policy RecoveryPolicy
+}{
+	// a slash packet or a VSC packet received on a channel different than the CCV channel
+	{err: ErrUnknownChannel, policy: RecoveryPolicyErrorAck},
+	// a bounce acknowledgement received by a consumer that is not waiting on a reply
+	{err: ErrSlashRecordNotFound, policy: RecoveryPolicyEmitEvent},
+	// a panic in a packet handler, see RunWithRecovery
+	{err: ErrRecoveredPanic, policy: RecoveryPolicyErrorAck},
+}
+
+// GetRecoveryPolicy returns the recovery policy of an error. Errors that do not
+// correspond to an unexpected state are returned to the caller.
+func GetRecoveryPolicy(err error) RecoveryPolicy {
+	for _, p := range recoveryPolicies {
+		if errorsmod.IsOf(err, p.err) {
+			return p.policy
+		}
+	}
+	return RecoveryPolicyErrorAck
+}
+
+// HandleUnexpectedState applies the recovery policy of err, see GetRecoveryPolicy,
+// and returns the error that should be returned to the caller, if any
+func HandleUnexpectedState(ctx sdk.Context, module string, err error) error {
+	if err == nil {
+		return nil
+	}
+	policy := GetRecoveryPolicy(err)
+	switch policy {
+	case RecoveryPolicyEmitEvent:
+		ctx.Logger().With("module", "x/"+module).Error("unexpected state, skipping", "error", err)
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				EventTypeUnexpectedState,
+				sdk.NewAttribute(sdk.AttributeKeyModule, module),
+				sdk.NewAttribute(AttributeKeyAckError, err.Error()),
+				sdk.NewAttribute(AttributeRecoveryPolicy, policy.String()),
+			),
+		)
+		return nil
+	case RecoveryPolicyHalt:
+		panic(err)
+	default:
+		return err
+	}
+}
+
+// RunWithRecovery runs a packet handler on a cached context. The state changes of the handler
+// are written only if the handler succeeds. If the handler panics, the panic is recovered into
+// an ErrRecoveredPanic error, and a ccv_unexpected_state event is emitted.
+// Out of gas panics are not recovered, so that the gas exhaustion is handled by the caller.
+func RunWithRecovery(ctx sdk.Context, module string, handler func(ctx sdk.Context) error) (err error) {
+	cacheCtx, writeCache := ctx.CacheContext()
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(storetypes.ErrorOutOfGas); ok {
+				panic(r)
+			}
+			err = errorsmod.Wrapf(ErrRecoveredPanic, "%v", r)
+			ctx.Logger().With("module", "x/"+module).Error("recovered from panic in packet handler", "panic", r)
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					EventTypeUnexpectedState,
+					sdk.NewAttribute(sdk.AttributeKeyModule, module),
+					sdk.NewAttribute(AttributeKeyAckError, err.Error()),
+					sdk.NewAttribute(AttributeRecoveryPolicy, GetRecoveryPolicy(err).String()),
+				),
+			)
+		}
+	}()
+	if err := handler(cacheCtx); err != nil {
+		return err
+	}
+	writeCache()
+	return nil
+}
@@ -0,0 +1,88 @@
+package types_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+func TestGetRecoveryPolicy(t *testing.T) {
+	require.Equal(t, types.RecoveryPolicyErrorAck, types.GetRecoveryPolicy(errorsmod.Wrap(types.ErrUnknownChannel, "channel-1")))
+	require.Equal(t, types.RecoveryPolicyEmitEvent, types.GetRecoveryPolicy(errorsmod.Wrap(types.ErrSlashRecordNotFound, "bounce")))
+	require.Equal(t, types.RecoveryPolicyErrorAck, types.GetRecoveryPolicy(errorsmod.Wrap(types.ErrRecoveredPanic, "panic")))
+	require.Equal(t, types.RecoveryPolicyErrorAck, types.GetRecoveryPolicy(errors.New("other error")))
+}
+
+func TestHandleUnexpectedState(t *testing.T) {
+	key := storetypes.NewKVStoreKey("test")
+	ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test"))
+
+	require.NoError(t, types.HandleUnexpectedState(ctx, "consumer", nil))
+
+	// error ack policy returns the error
+	err := errorsmod.Wrap(types.ErrUnknownChannel, "channel-1")
+	require.ErrorIs(t, types.HandleUnexpectedState(ctx, "consumer", err), types.ErrUnknownChannel)
+	require.Empty(t, ctx.EventManager().Events())
+
+	// emit event policy emits an event and skips
+	err = errorsmod.Wrap(types.ErrSlashRecordNotFound, "bounce")
+	require.NoError(t, types.HandleUnexpectedState(ctx, "consumer", err))
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, types.EventTypeUnexpectedState, events[0].Type)
+}
+
+func TestRunWithRecovery(t *testing.T) {
+	key := storetypes.NewKVStoreKey("test")
+	ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test"))
+
+	// state changes of a successful handler are written
+	err := types.RunWithRecovery(ctx, "provider", func(ctx sdk.Context) error {
+		ctx.KVStore(key).Set([]byte("a"), []byte("1"))
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []byte("1"), ctx.KVStore(key).Get([]byte("a")))
+
+	// state changes of a failing handler are discarded
+	err = types.RunWithRecovery(ctx, "provider", func(ctx sdk.Context) error {
+		ctx.KVStore(key).Set([]byte("b"), []byte("1"))
+		return errors.New("failure")
+	})
+	require.Error(t, err)
+	require.Nil(t, ctx.KVStore(key).Get([]byte("b")))
+	require.Empty(t, ctx.EventManager().Events())
+
+	// panics are recovered and state changes are discarded
+	require.NotPanics(t, func() {
+		err = types.RunWithRecovery(ctx, "provider", func(ctx sdk.Context) error {
+			ctx.KVStore(key).Set([]byte("c"), []byte("1"))
+			panic("slash meter not set")
+		})
+	})
+	require.ErrorIs(t, err, types.ErrRecoveredPanic)
+	require.Contains(t, err.Error(), "slash meter not set")
+	require.Nil(t, ctx.KVStore(key).Get([]byte("c")))
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, types.EventTypeUnexpectedState, events[0].Type)
+
+	// out of gas panics are not recovered
+	require.PanicsWithValue(t, storetypes.ErrorOutOfGas{Descriptor: "test"}, func() {
+		_ = types.RunWithRecovery(ctx, "provider", func(ctx sdk.Context) error {
+			ctx.KVStore(key).Set([]byte("d"), []byte("1"))
+			panic(storetypes.ErrorOutOfGas{Descriptor: "test"})
+		})
+	})
+	require.Nil(t, ctx.KVStore(key).Get([]byte("d")))
+	require.Len(t, ctx.EventManager().Events(), 1)
+}
@@ -78,6 +78,33 @@ type ConsumerParams struct {
 	// The consumer ID of this consumer chain. Used by the consumer module to send
 	// ICS rewards.
 	ConsumerId string `protobuf:"bytes,14,opt,name=consumer_id,json=consumerId,proto3" json:"consumer_id,omitempty"`
+	// If true, the consumer rejects the VSC packets with a valset update ID
+	// not greater than the valset update ID of the last received VSC packet,
+	// i.e., it writes an error acknowledgement instead of applying the changes.
+	StrictVscIdOrdering bool `protobuf:"varint,15,opt,name=strict_vsc_id_ordering,json=strictVscIdOrdering,proto3" json:"strict_vsc_id_ordering,omitempty"`
+	// The maximum number of validator updates sent to the consensus engine in
+	// a block. If the pending changes contain more validator updates, the rest
+	// are sent in the following blocks. Zero means no limit.
+	MaxValidatorUpdatesPerBlock uint64 `protobuf:"varint,16,opt,name=max_validator_updates_per_block,json=maxValidatorUpdatesPerBlock,proto3" json:"max_validator_updates_per_block,omitempty"`
+	// The factor by which the delay before retrying to send a bounced slash
+	// packet is multiplied after every retry, as a decimal string, e.g., "2.0".
+	// An empty string is equivalent to "1", i.e., the retry delay is fixed.
+	RetryDelayMultiplier string `protobuf:"bytes,17,opt,name=retry_delay_multiplier,json=retryDelayMultiplier,proto3" json:"retry_delay_multiplier,omitempty"`
+	// The maximum delay before retrying to send a bounced slash packet.
+	// Zero means no maximum.
+	MaxRetryDelayPeriod time.Duration `protobuf:"bytes,18,opt,name=max_retry_delay_period,json=maxRetryDelayPeriod,proto3,stdduration" json:"max_retry_delay_period"`
+	// The consumer emits a warning event in every block in which the client
+	// to the provider expires in less than this duration. Zero disables the warnings.
+	ClientExpiryWarningThreshold time.Duration `protobuf:"bytes,19,opt,name=client_expiry_warning_threshold,json=clientExpiryWarningThreshold,proto3,stdduration" json:"client_expiry_warning_threshold"`
+	// The fractions of tokens allocated to the consumer redistribution address
+	// during distribution events for specific denoms, which override the
+	// consumer_redistribution_fraction for these denoms. For example, a fraction
+	// of "1" keeps all the tokens of a denom on the consumer chain.
+	DenomRedistributionFractions []DenomRedistributionFraction `protobuf:"bytes,20,rep,name=denom_redistribution_fractions,json=denomRedistributionFractions,proto3" json:"denom_redistribution_fractions"`
+	// The fractions of the rewards allocated to the provider chain that are
+	// instead sent to local addresses on the consumer chain, e.g., a dev fund,
+	// before the remaining rewards are sent to the provider chain.
+	RewardSplits []RewardSplit `protobuf:"bytes,21,rep,name=reward_splits,json=rewardSplits,proto3" json:"reward_splits"`
 }
 
 func (m *ConsumerParams) Reset()         { *m = ConsumerParams{} }
@@ -212,6 +239,168 @@ func (m *ConsumerParams) GetConsumerId() string {
 	return ""
 }
 
+func (m *ConsumerParams) GetStrictVscIdOrdering() bool {
+	if m != nil {
+		return m.StrictVscIdOrdering
+	}
+	return false
+}
+
+func (m *ConsumerParams) GetMaxValidatorUpdatesPerBlock() uint64 {
+	if m != nil {
+		return m.MaxValidatorUpdatesPerBlock
+	}
+	return 0
+}
+
+func (m *ConsumerParams) GetRetryDelayMultiplier() string {
+	if m != nil {
+		return m.RetryDelayMultiplier
+	}
+	return ""
+}
+
+func (m *ConsumerParams) GetMaxRetryDelayPeriod() time.Duration {
+	if m != nil {
+		return m.MaxRetryDelayPeriod
+	}
+	return 0
+}
+
+func (m *ConsumerParams) GetClientExpiryWarningThreshold() time.Duration {
+	if m != nil {
+		return m.ClientExpiryWarningThreshold
+	}
+	return 0
+}
+
+func (m *ConsumerParams) GetDenomRedistributionFractions() []DenomRedistributionFraction {
+	if m != nil {
+		return m.DenomRedistributionFractions
+	}
+	return nil
+}
+
+func (m *ConsumerParams) GetRewardSplits() []RewardSplit {
+	if m != nil {
+		return m.RewardSplits
+	}
+	return nil
+}
+
+// DenomRedistributionFraction defines the fraction of the tokens of a denom
+// allocated to the consumer redistribution address during distribution events
+type DenomRedistributionFraction struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// The fraction is a string representing a decimal number,
+	// e.g., "0.75" would represent 75%.
+	Fraction string `protobuf:"bytes,2,opt,name=fraction,proto3" json:"fraction,omitempty"`
+}
+
+func (m *DenomRedistributionFraction) Reset()         { *m = DenomRedistributionFraction{} }
+func (m *DenomRedistributionFraction) String() string { return proto.CompactTextString(m) }
+func (*DenomRedistributionFraction) ProtoMessage()    {}
+func (*DenomRedistributionFraction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0a8be0efc64dfbc, []int{1}
+}
+func (m *DenomRedistributionFraction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomRedistributionFraction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomRedistributionFraction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomRedistributionFraction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomRedistributionFraction.Merge(m, src)
+}
+func (m *DenomRedistributionFraction) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomRedistributionFraction) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomRedistributionFraction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomRedistributionFraction proto.InternalMessageInfo
+
+func (m *DenomRedistributionFraction) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *DenomRedistributionFraction) GetFraction() string {
+	if m != nil {
+		return m.Fraction
+	}
+	return ""
+}
+
+// RewardSplit defines the fraction of the rewards allocated to the provider
+// chain that is sent to a local address on the consumer chain
+type RewardSplit struct {
+	// The bech32 address of the recipient on the consumer chain
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// The fraction is a string representing a decimal number,
+	// e.g., "0.1" would represent 10%.
+	Fraction string `protobuf:"bytes,2,opt,name=fraction,proto3" json:"fraction,omitempty"`
+}
+
+func (m *RewardSplit) Reset()         { *m = RewardSplit{} }
+func (m *RewardSplit) String() string { return proto.CompactTextString(m) }
+func (*RewardSplit) ProtoMessage()    {}
+func (*RewardSplit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0a8be0efc64dfbc, []int{2}
+}
+func (m *RewardSplit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardSplit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardSplit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RewardSplit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardSplit.Merge(m, src)
+}
+func (m *RewardSplit) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardSplit) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardSplit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardSplit proto.InternalMessageInfo
+
+func (m *RewardSplit) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *RewardSplit) GetFraction() string {
+	if m != nil {
+		return m.Fraction
+	}
+	return ""
+}
+
 // ConsumerGenesisState defines shared genesis information between provider and
 // consumer
 type ConsumerGenesisState struct {
@@ -234,7 +423,7 @@ func (m *ConsumerGenesisState) Reset()         { *m = ConsumerGenesisState{} }
 func (m *ConsumerGenesisState) String() string { return proto.CompactTextString(m) }
 func (*ConsumerGenesisState) ProtoMessage()    {}
 func (*ConsumerGenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d0a8be0efc64dfbc, []int{1}
+	return fileDescriptor_d0a8be0efc64dfbc, []int{3}
 }
 func (m *ConsumerGenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -315,7 +504,7 @@ func (m *ProviderInfo) Reset()         { *m = ProviderInfo{} }
 func (m *ProviderInfo) String() string { return proto.CompactTextString(m) }
 func (*ProviderInfo) ProtoMessage()    {}
 func (*ProviderInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d0a8be0efc64dfbc, []int{2}
+	return fileDescriptor_d0a8be0efc64dfbc, []int{4}
 }
 func (m *ProviderInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -367,6 +556,8 @@ func (m *ProviderInfo) GetInitialValSet() []types.ValidatorUpdate {
 
 func init() {
 	proto.RegisterType((*ConsumerParams)(nil), "interchain_security.ccv.v1.ConsumerParams")
+	proto.RegisterType((*DenomRedistributionFraction)(nil), "interchain_security.ccv.v1.DenomRedistributionFraction")
+	proto.RegisterType((*RewardSplit)(nil), "interchain_security.ccv.v1.RewardSplit")
 	proto.RegisterType((*ConsumerGenesisState)(nil), "interchain_security.ccv.v1.ConsumerGenesisState")
 	proto.RegisterType((*ProviderInfo)(nil), "interchain_security.ccv.v1.ProviderInfo")
 }
@@ -376,61 +567,76 @@ func init() {
 }
 
 var fileDescriptor_d0a8be0efc64dfbc = []byte{
-	// 864 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0x41, 0x6f, 0xe4, 0x34,
-	0x14, 0x6e, 0x3a, 0xbb, 0xed, 0xd4, 0x33, 0x6d, 0x17, 0x53, 0x4a, 0xe8, 0x4a, 0xd3, 0xd9, 0xc2,
-	0x61, 0x04, 0xda, 0x84, 0x96, 0x95, 0x56, 0xe2, 0x46, 0xa7, 0x2c, 0xdb, 0x3d, 0xb4, 0xb3, 0x69,
-	0x29, 0x12, 0x1c, 0x2c, 0xc7, 0x7e, 0x33, 0x63, 0x91, 0xb1, 0x23, 0xdb, 0x49, 0xe9, 0x1d, 0xc1,
-	0x95, 0x23, 0x3f, 0x69, 0x8f, 0x7b, 0xe4, 0x04, 0xa8, 0xfd, 0x23, 0x28, 0x4e, 0x32, 0xcd, 0x20,
-	0x0a, 0xe5, 0x96, 0xf7, 0xfc, 0x7d, 0x5f, 0xfc, 0xbe, 0xe7, 0x67, 0xa3, 0x4f, 0x85, 0xb4, 0xa0,
-	0xd9, 0x94, 0x0a, 0x49, 0x0c, 0xb0, 0x4c, 0x0b, 0x7b, 0x15, 0x32, 0x96, 0x87, 0xf9, 0x7e, 0x68,
-	0xa6, 0x54, 0x03, 0x27, 0x4c, 0x49, 0x93, 0xcd, 0x40, 0x07, 0xa9, 0x56, 0x56, 0xe1, 0x9d, 0x7f,
-	0x60, 0x04, 0x8c, 0xe5, 0x41, 0xbe, 0xbf, 0xf3, 0xd8, 0x82, 0xe4, 0xa0, 0x67, 0x42, 0xda, 0x90,
-	0xc6, 0x4c, 0x84, 0xf6, 0x2a, 0x05, 0x53, 0x12, 0x77, 0x42, 0x11, 0xb3, 0x30, 0x11, 0x93, 0xa9,
-	0x65, 0x89, 0x00, 0x69, 0x4d, 0xd8, 0x40, 0xe7, 0xfb, 0x8d, 0xa8, 0x22, 0xf4, 0x26, 0x4a, 0x4d,
-	0x12, 0x08, 0x5d, 0x14, 0x67, 0xe3, 0x90, 0x67, 0x9a, 0x5a, 0xa1, 0x64, 0xb5, 0xbe, 0x35, 0x51,
-	0x13, 0xe5, 0x3e, 0xc3, 0xe2, 0xab, 0xcc, 0xee, 0xfd, 0xb8, 0x8a, 0x36, 0x86, 0xd5, 0x96, 0x47,
-	0x54, 0xd3, 0x99, 0xc1, 0x3e, 0x5a, 0x05, 0x49, 0xe3, 0x04, 0xb8, 0xef, 0xf5, 0xbd, 0x41, 0x3b,
-	0xaa, 0x43, 0x7c, 0x8a, 0x3e, 0x8a, 0x13, 0xc5, 0xbe, 0x37, 0x24, 0x05, 0x4d, 0xb8, 0x30, 0x56,
-	0x8b, 0x38, 0x2b, 0xfe, 0x41, 0xac, 0xa6, 0xd2, 0xcc, 0x84, 0x31, 0x42, 0x49, 0x7f, 0xb9, 0xef,
-	0x0d, 0x5a, 0xd1, 0x93, 0x12, 0x3b, 0x02, 0x7d, 0xd4, 0x40, 0x9e, 0x37, 0x80, 0xf8, 0x15, 0x7a,
-	0x72, 0xa7, 0x0a, 0x61, 0x53, 0x2a, 0x25, 0x24, 0x7e, 0xab, 0xef, 0x0d, 0xd6, 0xa2, 0x5d, 0x7e,
-	0x87, 0xc8, 0xb0, 0x84, 0xe1, 0xcf, 0xd1, 0x4e, 0xaa, 0x55, 0x2e, 0x38, 0x68, 0x32, 0x06, 0x20,
-	0xa9, 0x52, 0x09, 0xa1, 0x9c, 0x6b, 0x62, 0xac, 0xf6, 0x1f, 0x38, 0x91, 0xed, 0x1a, 0xf1, 0x02,
-	0x60, 0xa4, 0x54, 0xf2, 0x05, 0xe7, 0xfa, 0xcc, 0x6a, 0xfc, 0x1a, 0x61, 0xc6, 0x72, 0x62, 0xc5,
-	0x0c, 0x54, 0x66, 0x8b, 0xea, 0x84, 0xe2, 0xfe, 0xc3, 0xbe, 0x37, 0xe8, 0x1c, 0x7c, 0x10, 0x94,
-	0xc6, 0x06, 0xb5, 0xb1, 0xc1, 0x51, 0x65, 0xec, 0x61, 0xfb, 0xcd, 0xef, 0xbb, 0x4b, 0xbf, 0xfe,
-	0xb1, 0xeb, 0x45, 0x8f, 0x18, 0xcb, 0xcf, 0x4b, 0xf6, 0xc8, 0x91, 0xf1, 0x77, 0xe8, 0x7d, 0x57,
-	0xcd, 0x18, 0xf4, 0xdf, 0x75, 0x57, 0xee, 0xaf, 0xfb, 0x5e, 0xad, 0xb1, 0x28, 0xfe, 0x12, 0xf5,
-	0xeb, 0x73, 0x46, 0x34, 0x2c, 0x58, 0x38, 0xd6, 0x94, 0x15, 0x1f, 0xfe, 0xaa, 0xab, 0xb8, 0x57,
-	0xe3, 0xa2, 0x05, 0xd8, 0x8b, 0x0a, 0x85, 0x9f, 0x22, 0x3c, 0x15, 0xc6, 0x2a, 0x2d, 0x18, 0x4d,
-	0x08, 0x48, 0xab, 0x05, 0x18, 0xbf, 0xed, 0x1a, 0xf8, 0xce, 0xed, 0xca, 0x97, 0xe5, 0x02, 0x3e,
-	0x41, 0x8f, 0x32, 0x19, 0x2b, 0xc9, 0x85, 0x9c, 0xd4, 0xe5, 0xac, 0xdd, 0xbf, 0x9c, 0xcd, 0x39,
-	0xb9, 0x2a, 0xe4, 0x39, 0xda, 0x36, 0x6a, 0x6c, 0x89, 0x4a, 0x2d, 0x29, 0x1c, 0xb2, 0x53, 0x0d,
-	0x66, 0xaa, 0x12, 0xee, 0xa3, 0x62, 0xfb, 0x87, 0xcb, 0xbe, 0x17, 0xbd, 0x5b, 0x20, 0x4e, 0x53,
-	0x7b, 0x9a, 0xd9, 0xf3, 0x7a, 0x19, 0x7f, 0x88, 0xd6, 0x35, 0x5c, 0x52, 0xcd, 0x09, 0x07, 0xa9,
-	0x66, 0xc6, 0xef, 0xf4, 0x5b, 0x83, 0xb5, 0xa8, 0x5b, 0x26, 0x8f, 0x5c, 0x0e, 0x3f, 0x43, 0xf3,
-	0x86, 0x93, 0x45, 0x74, 0xd7, 0xa1, 0xb7, 0xea, 0xd5, 0xa8, 0xc9, 0x7a, 0x8d, 0xb0, 0x06, 0xab,
-	0xaf, 0x08, 0x87, 0x84, 0x5e, 0xd5, 0x55, 0xae, 0xff, 0x8f, 0xc3, 0xe0, 0xe8, 0x47, 0x05, 0xbb,
-	0x2a, 0x73, 0x17, 0x75, 0xe6, 0xfd, 0x12, 0xdc, 0xdf, 0x70, 0xad, 0x41, 0x75, 0xea, 0x98, 0xef,
-	0xfd, 0xb4, 0x8c, 0xb6, 0xea, 0x31, 0xfc, 0x0a, 0x24, 0x18, 0x61, 0xce, 0x2c, 0xb5, 0x80, 0x5f,
-	0xa2, 0x95, 0xd4, 0x8d, 0xa5, 0x9b, 0xc5, 0xce, 0xc1, 0xc7, 0xc1, 0xdd, 0x17, 0x4a, 0xb0, 0x38,
-	0xc8, 0x87, 0x0f, 0x8a, 0x1d, 0x45, 0x15, 0x1f, 0xbf, 0x42, 0xed, 0xba, 0x5c, 0x37, 0xa0, 0x9d,
-	0x83, 0xc1, 0xbf, 0x69, 0x8d, 0x2a, 0xec, 0xb1, 0x1c, 0xab, 0x4a, 0x69, 0xce, 0xc7, 0x8f, 0xd1,
-	0x9a, 0x84, 0x4b, 0xe2, 0x98, 0x6e, 0x3e, 0xdb, 0x51, 0x5b, 0xc2, 0xe5, 0xb0, 0x88, 0xf1, 0x36,
-	0x5a, 0x49, 0x35, 0x0c, 0x87, 0x17, 0x6e, 0xe8, 0xda, 0x51, 0x15, 0x15, 0x2d, 0x63, 0x4a, 0x4a,
-	0x70, 0x07, 0xaf, 0xb0, 0xe1, 0xa1, 0xb3, 0xa1, 0x7b, 0x9b, 0x3c, 0xe6, 0x7b, 0x3f, 0x2f, 0xa3,
-	0x6e, 0xf3, 0xd7, 0xf8, 0x04, 0x75, 0xcb, 0x0b, 0x90, 0x98, 0xc2, 0x90, 0xca, 0x86, 0x4f, 0x02,
-	0x11, 0xb3, 0xa0, 0x79, 0x3d, 0x06, 0x8d, 0x0b, 0xb1, 0xb0, 0xc2, 0x65, 0x9d, 0x87, 0x51, 0x87,
-	0xdd, 0x06, 0xf8, 0x1b, 0xb4, 0x59, 0xf8, 0x0e, 0xd2, 0x64, 0xa6, 0x92, 0x2c, 0xdd, 0x08, 0xfe,
-	0x53, 0xb2, 0xa6, 0x95, 0xaa, 0x1b, 0x6c, 0x21, 0xc6, 0x27, 0x68, 0x53, 0x48, 0x61, 0x05, 0x4d,
-	0x48, 0x4e, 0x13, 0x62, 0xc0, 0xfa, 0xad, 0x7e, 0x6b, 0xd0, 0x39, 0xe8, 0x37, 0x75, 0x8a, 0x7b,
-	0x3e, 0xb8, 0xa0, 0x89, 0xe0, 0xd4, 0x2a, 0xfd, 0x75, 0xca, 0xa9, 0x85, 0xca, 0xde, 0xf5, 0x8a,
-	0x7e, 0x41, 0x93, 0x33, 0xb0, 0x87, 0x27, 0x6f, 0xae, 0x7b, 0xde, 0xdb, 0xeb, 0x9e, 0xf7, 0xe7,
-	0x75, 0xcf, 0xfb, 0xe5, 0xa6, 0xb7, 0xf4, 0xf6, 0xa6, 0xb7, 0xf4, 0xdb, 0x4d, 0x6f, 0xe9, 0xdb,
-	0x67, 0x13, 0x61, 0xa7, 0x59, 0x1c, 0x30, 0x35, 0x0b, 0x99, 0x32, 0x33, 0x65, 0xc2, 0xdb, 0x46,
-	0x3e, 0x9d, 0xbf, 0x4b, 0xf9, 0xf3, 0xf0, 0x07, 0xf7, 0x38, 0xb9, 0x67, 0x25, 0x5e, 0x71, 0x47,
-	0xf6, 0xb3, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0x7d, 0x42, 0x46, 0x65, 0xc4, 0x06, 0x00, 0x00,
+	// 1103 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0x4d, 0x6f, 0x1b, 0x37,
+	0x13, 0xf6, 0xda, 0x89, 0x23, 0x51, 0x4a, 0x9c, 0xd0, 0x8a, 0xdf, 0x7d, 0xed, 0x40, 0x52, 0xdc,
+	0x02, 0x15, 0x5a, 0x64, 0xb7, 0x71, 0x02, 0x04, 0xe8, 0xad, 0x96, 0x93, 0xc6, 0x01, 0x6a, 0x2b,
+	0x6b, 0xd7, 0x29, 0xda, 0x03, 0x41, 0x91, 0x94, 0xc4, 0x76, 0x97, 0x5c, 0x90, 0x5c, 0xd9, 0x3e,
+	0x17, 0x68, 0xaf, 0x3d, 0xf6, 0x27, 0xe5, 0x98, 0x4b, 0x81, 0x9e, 0xda, 0xc2, 0xfe, 0x23, 0x05,
+	0xb9, 0xbb, 0xfa, 0x30, 0x6c, 0xd7, 0xbd, 0x71, 0x38, 0xcf, 0x3c, 0xc3, 0x19, 0x0e, 0x67, 0x08,
+	0x3e, 0xe7, 0xc2, 0x30, 0x45, 0x46, 0x98, 0x0b, 0xa4, 0x19, 0xc9, 0x14, 0x37, 0xa7, 0x21, 0x21,
+	0xe3, 0x70, 0xfc, 0x34, 0xd4, 0x23, 0xac, 0x18, 0x45, 0x44, 0x0a, 0x9d, 0x25, 0x4c, 0x05, 0xa9,
+	0x92, 0x46, 0xc2, 0xf5, 0x4b, 0x2c, 0x02, 0x42, 0xc6, 0xc1, 0xf8, 0xe9, 0xfa, 0x86, 0x61, 0x82,
+	0x32, 0x95, 0x70, 0x61, 0x42, 0xdc, 0x27, 0x3c, 0x34, 0xa7, 0x29, 0xd3, 0xb9, 0xe1, 0x7a, 0xc8,
+	0xfb, 0x24, 0x8c, 0xf9, 0x70, 0x64, 0x48, 0xcc, 0x99, 0x30, 0x3a, 0x9c, 0x41, 0x8f, 0x9f, 0xce,
+	0x48, 0x85, 0x41, 0x73, 0x28, 0xe5, 0x30, 0x66, 0xa1, 0x93, 0xfa, 0xd9, 0x20, 0xa4, 0x99, 0xc2,
+	0x86, 0x4b, 0x51, 0xe8, 0x1b, 0x43, 0x39, 0x94, 0x6e, 0x19, 0xda, 0x55, 0xbe, 0xbb, 0xf9, 0x7b,
+	0x0d, 0xdc, 0xeb, 0x16, 0x47, 0xee, 0x61, 0x85, 0x13, 0x0d, 0x7d, 0x70, 0x87, 0x09, 0xdc, 0x8f,
+	0x19, 0xf5, 0xbd, 0xb6, 0xd7, 0xa9, 0x44, 0xa5, 0x08, 0xf7, 0xc1, 0xc7, 0xfd, 0x58, 0x92, 0x1f,
+	0x35, 0x4a, 0x99, 0x42, 0x94, 0x6b, 0xa3, 0x78, 0x3f, 0xb3, 0x3e, 0x90, 0x51, 0x58, 0xe8, 0x84,
+	0x6b, 0xcd, 0xa5, 0xf0, 0x17, 0xdb, 0x5e, 0x67, 0x29, 0x7a, 0x9c, 0x63, 0x7b, 0x4c, 0xed, 0xcc,
+	0x20, 0x0f, 0x67, 0x80, 0xf0, 0x0d, 0x78, 0x7c, 0x25, 0x0b, 0x22, 0x23, 0x2c, 0x04, 0x8b, 0xfd,
+	0xa5, 0xb6, 0xd7, 0xa9, 0x46, 0x2d, 0x7a, 0x05, 0x49, 0x37, 0x87, 0xc1, 0x2f, 0xc0, 0x7a, 0xaa,
+	0xe4, 0x98, 0x53, 0xa6, 0xd0, 0x80, 0x31, 0x94, 0x4a, 0x19, 0x23, 0x4c, 0xa9, 0x42, 0xda, 0x28,
+	0xff, 0x96, 0x23, 0x59, 0x2b, 0x11, 0xaf, 0x18, 0xeb, 0x49, 0x19, 0x7f, 0x49, 0xa9, 0x3a, 0x30,
+	0x0a, 0xbe, 0x05, 0x90, 0x90, 0x31, 0x32, 0x3c, 0x61, 0x32, 0x33, 0x36, 0x3a, 0x2e, 0xa9, 0x7f,
+	0xbb, 0xed, 0x75, 0x6a, 0x5b, 0xff, 0x0f, 0xf2, 0xc4, 0x06, 0x65, 0x62, 0x83, 0x9d, 0x22, 0xb1,
+	0xdb, 0x95, 0xf7, 0x7f, 0xb6, 0x16, 0x7e, 0xfb, 0xab, 0xe5, 0x45, 0xf7, 0x09, 0x19, 0x1f, 0xe6,
+	0xd6, 0x3d, 0x67, 0x0c, 0xbf, 0x07, 0xff, 0x73, 0xd1, 0x0c, 0x98, 0xba, 0xc8, 0xbb, 0x7c, 0x73,
+	0xde, 0x87, 0x25, 0xc7, 0x3c, 0xf9, 0x6b, 0xd0, 0x2e, 0xeb, 0x0c, 0x29, 0x36, 0x97, 0xc2, 0x81,
+	0xc2, 0xc4, 0x2e, 0xfc, 0x3b, 0x2e, 0xe2, 0x66, 0x89, 0x8b, 0xe6, 0x60, 0xaf, 0x0a, 0x14, 0x7c,
+	0x02, 0xe0, 0x88, 0x6b, 0x23, 0x15, 0x27, 0x38, 0x46, 0x4c, 0x18, 0xc5, 0x99, 0xf6, 0x2b, 0xee,
+	0x02, 0x1f, 0x4c, 0x35, 0x2f, 0x73, 0x05, 0xdc, 0x03, 0xf7, 0x33, 0xd1, 0x97, 0x82, 0x72, 0x31,
+	0x2c, 0xc3, 0xa9, 0xde, 0x3c, 0x9c, 0x95, 0x89, 0x71, 0x11, 0xc8, 0x0b, 0xb0, 0xa6, 0xe5, 0xc0,
+	0x20, 0x99, 0x1a, 0x64, 0x33, 0x64, 0x46, 0x8a, 0xe9, 0x91, 0x8c, 0xa9, 0x0f, 0xec, 0xf1, 0xb7,
+	0x17, 0x7d, 0x2f, 0x5a, 0xb5, 0x88, 0xfd, 0xd4, 0xec, 0x67, 0xe6, 0xb0, 0x54, 0xc3, 0x8f, 0xc0,
+	0x5d, 0xc5, 0x8e, 0xb1, 0xa2, 0x88, 0x32, 0x21, 0x13, 0xed, 0xd7, 0xda, 0x4b, 0x9d, 0x6a, 0x54,
+	0xcf, 0x37, 0x77, 0xdc, 0x1e, 0x7c, 0x0e, 0x26, 0x17, 0x8e, 0xe6, 0xd1, 0x75, 0x87, 0x6e, 0x94,
+	0xda, 0x68, 0xd6, 0xea, 0x2d, 0x80, 0x8a, 0x19, 0x75, 0x8a, 0x28, 0x8b, 0xf1, 0x69, 0x19, 0xe5,
+	0xdd, 0xff, 0x50, 0x0c, 0xce, 0x7c, 0xc7, 0x5a, 0x17, 0x61, 0xb6, 0x40, 0x6d, 0x72, 0x5f, 0x9c,
+	0xfa, 0xf7, 0xdc, 0xd5, 0x80, 0x72, 0x6b, 0x97, 0xc2, 0x67, 0x60, 0xcd, 0x5e, 0x0e, 0x31, 0x68,
+	0xac, 0x09, 0xe2, 0x14, 0x49, 0x45, 0x99, 0xe2, 0x62, 0xe8, 0xaf, 0xb8, 0x27, 0xb8, 0x9a, 0x6b,
+	0x8f, 0x34, 0xd9, 0xa5, 0xfb, 0x85, 0x0a, 0xee, 0x80, 0x56, 0x82, 0x4f, 0xd0, 0x18, 0xc7, 0x9c,
+	0x62, 0x23, 0x15, 0xca, 0x52, 0x8a, 0x0d, 0xcb, 0x5f, 0xa7, 0x7b, 0x7c, 0xfe, 0xfd, 0xb6, 0xd7,
+	0xb9, 0x15, 0x6d, 0x24, 0xf8, 0xe4, 0xa8, 0x44, 0x7d, 0x93, 0x83, 0x7a, 0x4c, 0x6d, 0x5b, 0x88,
+	0x4d, 0xd2, 0x6c, 0xb8, 0x49, 0x16, 0x1b, 0x9e, 0xc6, 0x9c, 0x29, 0xff, 0x81, 0x3b, 0x66, 0x63,
+	0x1a, 0xcd, 0xd7, 0x13, 0x1d, 0xfc, 0x16, 0xac, 0x59, 0xdf, 0x97, 0x24, 0x0a, 0xde, 0x3c, 0x51,
+	0xab, 0x09, 0x3e, 0x89, 0x2e, 0xe6, 0xea, 0x07, 0xd0, 0xca, 0x3b, 0x1e, 0x62, 0x27, 0x29, 0x57,
+	0xa7, 0xe8, 0x18, 0x2b, 0x61, 0xcb, 0x6d, 0x5a, 0x1b, 0xab, 0x37, 0x77, 0xf1, 0x28, 0xe7, 0x7a,
+	0xe9, 0xa8, 0xde, 0xe5, 0x4c, 0xd3, 0x2a, 0xfa, 0xc9, 0x03, 0x4d, 0x57, 0x11, 0x57, 0xbd, 0x22,
+	0xed, 0x37, 0xda, 0x4b, 0x9d, 0xda, 0xd6, 0x8b, 0xe0, 0xea, 0x3e, 0x1e, 0xb8, 0xba, 0xb9, 0xfc,
+	0x7d, 0x6d, 0xdf, 0xb2, 0x27, 0x89, 0x1e, 0xd1, 0xab, 0x21, 0x1a, 0x46, 0x93, 0x5a, 0xd6, 0x69,
+	0xcc, 0x8d, 0xf6, 0x1f, 0x3a, 0x9f, 0x9f, 0x5c, 0xe7, 0x33, 0xaf, 0xd8, 0x03, 0x8b, 0x2f, 0x7c,
+	0xd4, 0xd5, 0x74, 0x4b, 0x6f, 0xee, 0x83, 0x8d, 0x6b, 0x8e, 0x05, 0x1b, 0xe0, 0xb6, 0x3b, 0x92,
+	0xeb, 0xf0, 0xd5, 0x28, 0x17, 0xe0, 0x3a, 0xa8, 0x4c, 0xda, 0xc7, 0xa2, 0x53, 0x4c, 0xe4, 0xcd,
+	0x2e, 0xa8, 0xcd, 0xf8, 0xb4, 0x43, 0xc2, 0xf6, 0x56, 0xa6, 0x75, 0x41, 0x51, 0x8a, 0xd7, 0x92,
+	0xfc, 0xbc, 0x08, 0x1a, 0xe5, 0xb4, 0xf9, 0x8a, 0x09, 0xa6, 0xb9, 0x3e, 0x30, 0xd8, 0x30, 0xf8,
+	0x1a, 0x2c, 0xa7, 0x6e, 0xfa, 0x38, 0xb6, 0xda, 0xd6, 0xa7, 0xd7, 0xc5, 0x3e, 0x3f, 0xaf, 0x8a,
+	0xf0, 0x0b, 0x7b, 0xf8, 0x06, 0x54, 0xca, 0x57, 0xed, 0xdc, 0xd7, 0xb6, 0x3a, 0xd7, 0x71, 0xf5,
+	0x0a, 0xec, 0xae, 0x18, 0xc8, 0x82, 0x69, 0x62, 0x0f, 0x37, 0x40, 0x55, 0xb0, 0x63, 0xe4, 0x2c,
+	0xdd, 0x18, 0xaa, 0x44, 0x15, 0xc1, 0x8e, 0xbb, 0x56, 0x86, 0x6b, 0x60, 0x39, 0x55, 0xac, 0xdb,
+	0x3d, 0x72, 0xb3, 0xa5, 0x12, 0x15, 0x92, 0xed, 0x4c, 0x44, 0x0a, 0xc1, 0x5c, 0xc4, 0x88, 0xe7,
+	0x63, 0xa4, 0x1a, 0xd5, 0xa7, 0x9b, 0xbb, 0x74, 0xf3, 0x97, 0x45, 0x50, 0x9f, 0x75, 0x0d, 0xf7,
+	0x40, 0xbd, 0xa8, 0x7a, 0x6d, 0x13, 0x52, 0xa4, 0xe1, 0xb3, 0x80, 0xf7, 0x49, 0x30, 0xfb, 0x0b,
+	0x08, 0x66, 0xe6, 0xbe, 0x4d, 0x85, 0xdb, 0x75, 0x39, 0x8c, 0x6a, 0x64, 0x2a, 0xc0, 0x77, 0x60,
+	0xc5, 0xb6, 0x17, 0x26, 0x74, 0xa6, 0x0b, 0xca, 0x3c, 0x1b, 0xc1, 0xbf, 0x52, 0x96, 0x66, 0x39,
+	0xeb, 0x3d, 0x32, 0x27, 0xc3, 0x3d, 0xb0, 0xc2, 0x05, 0x37, 0x1c, 0xc7, 0xb6, 0xf1, 0x20, 0xcd,
+	0x8c, 0xbf, 0xe4, 0xca, 0xb5, 0x3d, 0xcb, 0x63, 0xbf, 0x33, 0xc1, 0x85, 0x96, 0x53, 0xa4, 0xf7,
+	0x6e, 0x61, 0x7e, 0x84, 0xe3, 0x03, 0x66, 0xb6, 0xf7, 0xde, 0x9f, 0x35, 0xbd, 0x0f, 0x67, 0x4d,
+	0xef, 0xef, 0xb3, 0xa6, 0xf7, 0xeb, 0x79, 0x73, 0xe1, 0xc3, 0x79, 0x73, 0xe1, 0x8f, 0xf3, 0xe6,
+	0xc2, 0x77, 0xcf, 0x87, 0xdc, 0x8c, 0xb2, 0x7e, 0x40, 0x64, 0x12, 0x12, 0xa9, 0x13, 0xa9, 0xc3,
+	0xe9, 0x45, 0x3e, 0x99, 0x7c, 0xbf, 0xc6, 0x2f, 0xc2, 0x13, 0xf7, 0x07, 0x73, 0xbf, 0xa7, 0xfe,
+	0xb2, 0xeb, 0x06, 0xcf, 0xfe, 0x19, 0x00, 0xd2, 0xe5, 0xe7, 0xb9, 0xab, 0x09, 0x00, 0x00,
 }
 
 func (m *ConsumerParams) Marshal() (dAtA []byte, err error) {
@@ -453,6 +659,84 @@ func (m *ConsumerParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RewardSplits) > 0 {
+		for iNdEx := len(m.RewardSplits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardSplits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSharedConsumer(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if len(m.DenomRedistributionFractions) > 0 {
+		for iNdEx := len(m.DenomRedistributionFractions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomRedistributionFractions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSharedConsumer(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.ClientExpiryWarningThreshold, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ClientExpiryWarningThreshold):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintSharedConsumer(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x9a
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MaxRetryDelayPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxRetryDelayPeriod):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintSharedConsumer(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
+	if len(m.RetryDelayMultiplier) > 0 {
+		i -= len(m.RetryDelayMultiplier)
+		copy(dAtA[i:], m.RetryDelayMultiplier)
+		i = encodeVarintSharedConsumer(dAtA, i, uint64(len(m.RetryDelayMultiplier)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.MaxValidatorUpdatesPerBlock != 0 {
+		i = encodeVarintSharedConsumer(dAtA, i, uint64(m.MaxValidatorUpdatesPerBlock))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.StrictVscIdOrdering {
+		i--
+		if m.StrictVscIdOrdering {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x78
+	}
 	if len(m.ConsumerId) > 0 {
 		i -= len(m.ConsumerId)
 		copy(dAtA[i:], m.ConsumerId)
@@ -460,12 +744,12 @@ func (m *ConsumerParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x72
 	}
-	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.RetryDelayPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RetryDelayPeriod):])
-	if err1 != nil {
-		return 0, err1
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.RetryDelayPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RetryDelayPeriod):])
+	if err3 != nil {
+		return 0, err3
 	}
-	i -= n1
-	i = encodeVarintSharedConsumer(dAtA, i, uint64(n1))
+	i -= n3
+	i = encodeVarintSharedConsumer(dAtA, i, uint64(n3))
 	i--
 	dAtA[i] = 0x6a
 	if len(m.ProviderRewardDenoms) > 0 {
@@ -493,12 +777,12 @@ func (m *ConsumerParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x52
 	}
-	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UnbondingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingPeriod):])
-	if err2 != nil {
-		return 0, err2
+	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UnbondingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingPeriod):])
+	if err4 != nil {
+		return 0, err4
 	}
-	i -= n2
-	i = encodeVarintSharedConsumer(dAtA, i, uint64(n2))
+	i -= n4
+	i = encodeVarintSharedConsumer(dAtA, i, uint64(n4))
 	i--
 	dAtA[i] = 0x4a
 	if m.HistoricalEntries != 0 {
@@ -513,20 +797,20 @@ func (m *ConsumerParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x3a
 	}
-	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TransferTimeoutPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TransferTimeoutPeriod):])
-	if err3 != nil {
-		return 0, err3
+	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.TransferTimeoutPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.TransferTimeoutPeriod):])
+	if err5 != nil {
+		return 0, err5
 	}
-	i -= n3
-	i = encodeVarintSharedConsumer(dAtA, i, uint64(n3))
+	i -= n5
+	i = encodeVarintSharedConsumer(dAtA, i, uint64(n5))
 	i--
 	dAtA[i] = 0x32
-	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.CcvTimeoutPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.CcvTimeoutPeriod):])
-	if err4 != nil {
-		return 0, err4
+	n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.CcvTimeoutPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.CcvTimeoutPeriod):])
+	if err6 != nil {
+		return 0, err6
 	}
-	i -= n4
-	i = encodeVarintSharedConsumer(dAtA, i, uint64(n4))
+	i -= n6
+	i = encodeVarintSharedConsumer(dAtA, i, uint64(n6))
 	i--
 	dAtA[i] = 0x2a
 	if len(m.ProviderFeePoolAddrStr) > 0 {
@@ -561,6 +845,80 @@ func (m *ConsumerParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DenomRedistributionFraction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomRedistributionFraction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomRedistributionFraction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fraction) > 0 {
+		i -= len(m.Fraction)
+		copy(dAtA[i:], m.Fraction)
+		i = encodeVarintSharedConsumer(dAtA, i, uint64(len(m.Fraction)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintSharedConsumer(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RewardSplit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardSplit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardSplit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fraction) > 0 {
+		i -= len(m.Fraction)
+		copy(dAtA[i:], m.Fraction)
+		i = encodeVarintSharedConsumer(dAtA, i, uint64(len(m.Fraction)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintSharedConsumer(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *ConsumerGenesisState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -758,44 +1116,104 @@ func (m *ConsumerParams) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovSharedConsumer(uint64(l))
 	}
+	if m.StrictVscIdOrdering {
+		n += 2
+	}
+	if m.MaxValidatorUpdatesPerBlock != 0 {
+		n += 2 + sovSharedConsumer(uint64(m.MaxValidatorUpdatesPerBlock))
+	}
+	l = len(m.RetryDelayMultiplier)
+	if l > 0 {
+		n += 2 + l + sovSharedConsumer(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MaxRetryDelayPeriod)
+	n += 2 + l + sovSharedConsumer(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ClientExpiryWarningThreshold)
+	n += 2 + l + sovSharedConsumer(uint64(l))
+	if len(m.DenomRedistributionFractions) > 0 {
+		for _, e := range m.DenomRedistributionFractions {
+			l = e.Size()
+			n += 2 + l + sovSharedConsumer(uint64(l))
+		}
+	}
+	if len(m.RewardSplits) > 0 {
+		for _, e := range m.RewardSplits {
+			l = e.Size()
+			n += 2 + l + sovSharedConsumer(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *ConsumerGenesisState) Size() (n int) {
+func (m *DenomRedistributionFraction) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovSharedConsumer(uint64(l))
-	l = m.Provider.Size()
-	n += 1 + l + sovSharedConsumer(uint64(l))
-	if m.NewChain {
-		n += 2
-	}
-	if m.PreCCV {
-		n += 2
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovSharedConsumer(uint64(l))
 	}
-	l = len(m.ConnectionId)
+	l = len(m.Fraction)
 	if l > 0 {
 		n += 1 + l + sovSharedConsumer(uint64(l))
 	}
 	return n
 }
 
-func (m *ProviderInfo) Size() (n int) {
+func (m *RewardSplit) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.ClientState != nil {
-		l = m.ClientState.Size()
+	l = len(m.Address)
+	if l > 0 {
 		n += 1 + l + sovSharedConsumer(uint64(l))
 	}
-	if m.ConsensusState != nil {
-		l = m.ConsensusState.Size()
+	l = len(m.Fraction)
+	if l > 0 {
+		n += 1 + l + sovSharedConsumer(uint64(l))
+	}
+	return n
+}
+
+func (m *ConsumerGenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovSharedConsumer(uint64(l))
+	l = m.Provider.Size()
+	n += 1 + l + sovSharedConsumer(uint64(l))
+	if m.NewChain {
+		n += 2
+	}
+	if m.PreCCV {
+		n += 2
+	}
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovSharedConsumer(uint64(l))
+	}
+	return n
+}
+
+func (m *ProviderInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClientState != nil {
+		l = m.ClientState.Size()
+		n += 1 + l + sovSharedConsumer(uint64(l))
+	}
+	if m.ConsensusState != nil {
+		l = m.ConsensusState.Size()
 		n += 1 + l + sovSharedConsumer(uint64(l))
 	}
 	if len(m.InitialValSet) > 0 {
@@ -1256,6 +1674,439 @@ func (m *ConsumerParams) Unmarshal(dAtA []byte) error {
 			}
 			m.ConsumerId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StrictVscIdOrdering", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSharedConsumer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.StrictVscIdOrdering = bool(v != 0)
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxValidatorUpdatesPerBlock", wireType)
+			}
+			m.MaxValidatorUpdatesPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSharedConsumer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxValidatorUpdatesPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryDelayMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSharedConsumer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSharedConsumer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSharedConsumer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RetryDelayMultiplier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRetryDelayPeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSharedConsumer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSharedConsumer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSharedConsumer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MaxRetryDelayPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientExpiryWarningThreshold", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSharedConsumer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSharedConsumer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSharedConsumer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.ClientExpiryWarningThreshold, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomRedistributionFractions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSharedConsumer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSharedConsumer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSharedConsumer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomRedistributionFractions = append(m.DenomRedistributionFractions, DenomRedistributionFraction{})
+			if err := m.DenomRedistributionFractions[len(m.DenomRedistributionFractions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardSplits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSharedConsumer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSharedConsumer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSharedConsumer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardSplits = append(m.RewardSplits, RewardSplit{})
+			if err := m.RewardSplits[len(m.RewardSplits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSharedConsumer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSharedConsumer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomRedistributionFraction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSharedConsumer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomRedistributionFraction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomRedistributionFraction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSharedConsumer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSharedConsumer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSharedConsumer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSharedConsumer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSharedConsumer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSharedConsumer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fraction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSharedConsumer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSharedConsumer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RewardSplit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSharedConsumer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardSplit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardSplit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSharedConsumer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSharedConsumer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSharedConsumer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSharedConsumer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSharedConsumer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSharedConsumer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fraction = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSharedConsumer(dAtA[iNdEx:])
@@ -33,6 +33,17 @@ func ValidateDuration(i interface{}) error {
 	return nil
 }
 
+func ValidateNonNegativeDuration(i interface{}) error {
+	period, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if period < time.Duration(0) {
+		return errors.New("duration cannot be negative")
+	}
+	return nil
+}
+
 func ValidateBool(i interface{}) error {
 	if _, ok := i.(bool); !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
@@ -57,6 +68,23 @@ func ValidatePositiveInt64(i interface{}) error {
 	return nil
 }
 
+func ValidateNonNegativeInt64(i interface{}) error {
+	if err := ValidateInt64(i); err != nil {
+		return err
+	}
+	if i.(int64) < int64(0) {
+		return errors.New("int must be non-negative")
+	}
+	return nil
+}
+
+func ValidateUint64(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
 func ValidateString(i interface{}) error {
 	if _, ok := i.(string); !ok {
 		return fmt.Errorf("invalid parameter type: %T", i)
@@ -130,6 +158,26 @@ func ValidateStringFractionNonZero(i interface{}) error {
 	return nil
 }
 
+// ValidateRetryDelayMultiplier validates that the multiplier of the retry delay is
+// either empty, i.e., equivalent to 1, or a decimal not smaller than 1
+func ValidateRetryDelayMultiplier(i interface{}) error {
+	str, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	if str == "" {
+		return nil
+	}
+	dec, err := math.LegacyNewDecFromStr(str)
+	if err != nil {
+		return err
+	}
+	if dec.LT(math.LegacyOneDec()) {
+		return fmt.Errorf("param cannot be smaller than 1, got %s", str)
+	}
+	return nil
+}
+
 func ValidateFraction(dec math.LegacyDec) error {
 	if dec.IsNegative() {
 		return fmt.Errorf("param cannot be negative, got %s", dec)
@@ -0,0 +1,35 @@
+package types
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+)
+
+// BlockStage identifies a stage of the BeginBlock or EndBlock logic of the CCV modules
+type BlockStage string
+
+const (
+	// BlockStageProviderValidatorUpdates covers the computation of the provider consensus validator set
+	BlockStageProviderValidatorUpdates BlockStage = "provider_validator_updates"
+	// BlockStageQueueVSCPackets covers the computation of the consumer validator sets and the queuing of VSC packets
+	BlockStageQueueVSCPackets BlockStage = "queue_vsc_packets"
+	// BlockStageSendPackets covers the sending of the queued CCV packets
+	BlockStageSendPackets BlockStage = "send_packets"
+	// BlockStageMapVSCIdAndPruneKeys covers the mapping of VSC ids to block heights and the pruning of key assignments
+	BlockStageMapVSCIdAndPruneKeys BlockStage = "map_vsc_id_and_prune_keys"
+	// BlockStageApplyValsetChanges covers the application of the validator updates received from the provider
+	BlockStageApplyValsetChanges BlockStage = "apply_valset_changes"
+	// BlockStageRewards covers the allocation and distribution of ICS rewards
+	BlockStageRewards BlockStage = "rewards"
+	// BlockStageThrottling covers the replenishment of the slash meters
+	BlockStageThrottling BlockStage = "throttling"
+)
+
+// MeasureBlockStage reports the time elapsed since start as a sample of the `<blocker>_<stage>`
+// histogram labeled with the module name, where blocker is either telemetry.MetricKeyBeginBlocker
+// or telemetry.MetricKeyEndBlocker. Use telemetry.Now() to get start, as it avoids reading the
+// clock when telemetry is disabled.
+func MeasureBlockStage(module string, start time.Time, blocker string, stage BlockStage) {
+	telemetry.ModuleMeasureSince(module, start, blocker, string(stage))
+}
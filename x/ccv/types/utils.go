@@ -1,15 +1,19 @@
 package types
 
 import (
+	"bytes"
 	"errors"
+	"fmt"
 	"reflect"
-	"sort"
+	"strconv"
 	"strings"
 	"time"
 
 	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	ibctmtypes "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
 
+	addresscodec "cosmossdk.io/core/address"
 	errorsmod "cosmossdk.io/errors"
 
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
@@ -21,33 +25,12 @@ import (
 	tmprotocrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
 )
 
+// AccumulateChanges returns the validator updates resulting from applying `newChanges` after `currentChanges`,
+// in the order of the ValsetDiff contract.
+//
+// Deprecated: use ValsetDiff.Merge instead.
 func AccumulateChanges(currentChanges, newChanges []abci.ValidatorUpdate) []abci.ValidatorUpdate {
-	m := make(map[string]abci.ValidatorUpdate)
-
-	for i := 0; i < len(currentChanges); i++ {
-		m[currentChanges[i].PubKey.String()] = currentChanges[i]
-	}
-
-	for i := 0; i < len(newChanges); i++ {
-		m[newChanges[i].PubKey.String()] = newChanges[i]
-	}
-
-	var out []abci.ValidatorUpdate
-
-	for _, update := range m {
-		out = append(out, update)
-	}
-
-	// The list of tendermint updates should hash the same across all consensus nodes
-	// that means it is necessary to sort for determinism.
-	sort.Slice(out, func(i, j int) bool {
-		if out[i].Power != out[j].Power {
-			return out[i].Power > out[j].Power
-		}
-		return out[i].PubKey.String() > out[j].PubKey.String()
-	})
-
-	return out
+	return NewValsetDiff(currentChanges...).Merge(NewValsetDiff(newChanges...)).Updates()
 }
 
 // TMCryptoPublicKeyToConsAddr converts a TM public key to an SDK public key
@@ -60,8 +43,21 @@ func TMCryptoPublicKeyToConsAddr(k tmprotocrypto.PublicKey) (sdk.ConsAddress, er
 	return sdk.GetConsAddress(sdkK), nil
 }
 
+// PacketSender sends an IBC packet with packetData over the source channelID and portID,
+// and returns the sequence and the timeout timestamp of the sent packet.
+// SendIBCPacket is the default packet sender of the provider and consumer keepers.
+type PacketSender func(
+	ctx sdk.Context,
+	channelKeeper ChannelKeeper,
+	sourceChannelID string,
+	sourcePortID string,
+	packetData []byte,
+	timeoutPeriod time.Duration,
+) (sequence, timeoutTimestamp uint64, err error)
+
 // SendIBCPacket sends an IBC packet with packetData
-// over the source channelID and portID
+// over the source channelID and portID.
+// It returns the sequence and the timeout timestamp of the sent packet.
 func SendIBCPacket(
 	ctx sdk.Context,
 	channelKeeper ChannelKeeper,
@@ -69,20 +65,85 @@ func SendIBCPacket(
 	sourcePortID string,
 	packetData []byte,
 	timeoutPeriod time.Duration,
-) error {
+) (sequence, timeoutTimestamp uint64, err error) {
 	_, ok := channelKeeper.GetChannel(ctx, sourcePortID, sourceChannelID)
 	if !ok {
-		return errorsmod.Wrapf(channeltypes.ErrChannelNotFound, "channel not found for channel ID: %s", sourceChannelID)
+		return 0, 0, errorsmod.Wrapf(channeltypes.ErrChannelNotFound, "channel not found for channel ID: %s", sourceChannelID)
 	}
 
-	_, err := channelKeeper.SendPacket(ctx,
+	timeoutTimestamp = uint64(ctx.BlockTime().Add(timeoutPeriod).UnixNano())
+	sequence, err = channelKeeper.SendPacket(ctx,
 		sourcePortID,
 		sourceChannelID,
 		clienttypes.Height{}, //  timeout height disabled
-		uint64(ctx.BlockTime().Add(timeoutPeriod).UnixNano()), // timeout timestamp
+		timeoutTimestamp,
 		packetData,
 	)
-	return err
+	return sequence, timeoutTimestamp, err
+}
+
+// IsNearTimeout returns true if less than a quarter of the timeout period is left
+// until the given timeout timestamp
+func IsNearTimeout(now time.Time, timeoutTimestamp uint64, timeoutPeriod time.Duration) bool {
+	return time.Unix(0, int64(timeoutTimestamp)).Sub(now) <= timeoutPeriod/4
+}
+
+// NewPriorityPacketEvent returns an event that hints relayers to prioritize
+// the relaying of the given packet
+func NewPriorityPacketEvent(
+	module, portID, channelID, packetType, reason string,
+	sequence, timeoutTimestamp uint64,
+) sdk.Event {
+	return sdk.NewEvent(
+		EventTypePriorityPacket,
+		sdk.NewAttribute(sdk.AttributeKeyModule, module),
+		sdk.NewAttribute(channeltypes.AttributeKeyPortID, portID),
+		sdk.NewAttribute(channeltypes.AttributeKeyChannelID, channelID),
+		sdk.NewAttribute(AttributePacketType, packetType),
+		sdk.NewAttribute(AttributePacketSequence, strconv.FormatUint(sequence, 10)),
+		sdk.NewAttribute(AttributeTimeoutTimestamp, strconv.FormatUint(timeoutTimestamp, 10)),
+		sdk.NewAttribute(AttributePriorityReason, reason),
+	)
+}
+
+// GetClientExpiry returns the time at which the Tendermint client with the given id expires
+// if it is not updated, i.e., the end of the trusting period of its latest consensus state
+func GetClientExpiry(ctx sdk.Context, clientKeeper ClientKeeper, clientId string) (time.Time, error) {
+	clientState, found := clientKeeper.GetClientState(ctx, clientId)
+	if !found {
+		return time.Time{}, errorsmod.Wrapf(ErrClientNotFound, "cannot find client state for client %s", clientId)
+	}
+	tmClientState, ok := clientState.(*ibctmtypes.ClientState)
+	if !ok {
+		return time.Time{}, fmt.Errorf("invalid client type for client %s: %s", clientId, clientState.ClientType())
+	}
+	consensusState, found := clientKeeper.GetClientConsensusState(ctx, clientId, tmClientState.LatestHeight)
+	if !found {
+		return time.Time{}, fmt.Errorf("cannot find consensus state at height %s for client %s", tmClientState.LatestHeight, clientId)
+	}
+	tmConsensusState, ok := consensusState.(*ibctmtypes.ConsensusState)
+	if !ok {
+		return time.Time{}, fmt.Errorf("invalid consensus state type for client %s: %s", clientId, consensusState.ClientType())
+	}
+	return tmConsensusState.Timestamp.Add(tmClientState.TrustingPeriod), nil
+}
+
+// IsNearClientExpiry returns true if less than the given threshold is left until the given client expiry.
+// A zero threshold disables the check.
+func IsNearClientExpiry(now, expiry time.Time, threshold time.Duration) bool {
+	return threshold > 0 && expiry.Sub(now) < threshold
+}
+
+// NewClientExpiryWarningEvent returns an event that warns relayers and operators
+// that the client with the given id is about to expire
+func NewClientExpiryWarningEvent(module, clientId string, now, expiry time.Time) sdk.Event {
+	return sdk.NewEvent(
+		EventTypeClientExpiryWarning,
+		sdk.NewAttribute(sdk.AttributeKeyModule, module),
+		sdk.NewAttribute(clienttypes.AttributeKeyClientID, clientId),
+		sdk.NewAttribute(AttributeClientExpiry, expiry.UTC().Format(time.RFC3339)),
+		sdk.NewAttribute(AttributeTimeUntilClientExpiry, expiry.Sub(now).String()),
+	)
 }
 
 func NewErrorAcknowledgementWithLog(ctx sdk.Context, err error) channeltypes.Acknowledgement {
@@ -104,6 +165,49 @@ func PanicIfZeroOrNil(x interface{}, nameForPanicMsg string) {
 	}
 }
 
+// ValidateAddressCodecs returns an error if the validator and consensus address codecs
+// cannot round trip an address or if the validator address codec accepts consensus addresses,
+// e.g., when the same codec is passed for both
+func ValidateAddressCodecs(validatorAddressCodec, consensusAddressCodec addresscodec.Codec) error {
+	sample := make([]byte, 20)
+	for i := range sample {
+		sample[i] = byte(i + 1)
+	}
+
+	encoded := make([]string, 2)
+	for i, c := range []struct {
+		name  string
+		codec addresscodec.Codec
+	}{
+		{"validator", validatorAddressCodec},
+		{"consensus", consensusAddressCodec},
+	} {
+		if c.codec == nil {
+			return fmt.Errorf("%s address codec is nil", c.name)
+		}
+		str, err := c.codec.BytesToString(sample)
+		if err != nil {
+			return fmt.Errorf("%s address codec cannot encode an address: %w", c.name, err)
+		}
+		bz, err := c.codec.StringToBytes(str)
+		if err != nil {
+			return fmt.Errorf("%s address codec cannot decode address %s: %w", c.name, str, err)
+		}
+		if !bytes.Equal(sample, bz) {
+			return fmt.Errorf("%s address codec does not round trip address %s", c.name, str)
+		}
+		encoded[i] = str
+	}
+
+	if encoded[0] == encoded[1] {
+		return fmt.Errorf("validator and consensus address codecs encode addresses identically: %s", encoded[0])
+	}
+	if _, err := validatorAddressCodec.StringToBytes(encoded[1]); err == nil {
+		return fmt.Errorf("validator address codec accepts consensus address %s", encoded[1])
+	}
+	return nil
+}
+
 // GetConsAddrFromBech32 returns a ConsAddress from a Bech32 with an arbitrary prefix
 func GetConsAddrFromBech32(bech32str string) (sdk.ConsAddress, error) {
 	bech32Addr := strings.TrimSpace(bech32str)
@@ -120,7 +224,7 @@ func GetConsAddrFromBech32(bech32str string) (sdk.ConsAddress, error) {
 
 // GetLastBondedValidatorsUtil iterates the last validator powers in the staking module
 // and returns the first maxVals many validators with the largest powers.
-func GetLastBondedValidatorsUtil(ctx sdk.Context, stakingKeeper StakingKeeper, maxVals uint32) ([]stakingtypes.Validator, error) {
+func GetLastBondedValidatorsUtil(ctx sdk.Context, stakingKeeper ProviderStakingKeeper, maxVals uint32) ([]stakingtypes.Validator, error) {
 	// get the bonded validators from the staking module, sorted by power
 	bondedValidators, err := stakingKeeper.GetBondedValidatorsByPower(ctx)
 	if err != nil {
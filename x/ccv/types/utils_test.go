@@ -6,6 +6,7 @@ import (
 	ibctesting "github.com/cosmos/ibc-go/v10/testing"
 	"github.com/stretchr/testify/require"
 
+	"github.com/cosmos/cosmos-sdk/codec/address"
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 
 	abci "github.com/cometbft/cometbft/abci/types"
@@ -88,3 +89,28 @@ func TestAccumulateChanges(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateAddressCodecs(t *testing.T) {
+	testCases := []struct {
+		name       string
+		valPrefix  string
+		consPrefix string
+		expPass    bool
+	}{
+		{"default prefixes", "cosmosvaloper", "cosmosvalcons", true},
+		{"custom prefixes", "neutronvaloper", "neutronvalcons", true},
+		{"same prefix", "cosmosvaloper", "cosmosvaloper", false},
+		{"empty validator prefix", "", "cosmosvalcons", false},
+	}
+
+	for _, tc := range testCases {
+		err := types.ValidateAddressCodecs(address.NewBech32Codec(tc.valPrefix), address.NewBech32Codec(tc.consPrefix))
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+
+	require.Error(t, types.ValidateAddressCodecs(nil, address.NewBech32Codec("cosmosvalcons")))
+}
@@ -0,0 +1,93 @@
+package types
+
+import (
+	"sort"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+)
+
+// ValsetDiff is a diff of a validator set, i.e., a list of validator updates, with a guaranteed ordering.
+// It is the type through which the validator updates cross the boundary between the provider and consumer modules:
+// the provider builds the VSC packets from ValsetDiffs and the consumer applies the ValsetDiffs of the received packets.
+//
+// The ordering contract of a ValsetDiff is the following:
+//   - there is at most one update per validator (i.e., per public key);
+//   - the updates are sorted in decreasing order of power and, for equal powers, in decreasing order of public key,
+//     i.e., the updates that remove validators (zero power) are last.
+//
+// As a result, splitting a ValsetDiff in batches (e.g., across VSC packets or blocks) never removes validators
+// before adding the validators that replace them, and the provider and consumer chains agree on the order
+// in which the updates are applied regardless of how the updates were computed.
+type ValsetDiff struct {
+	updates []abci.ValidatorUpdate
+}
+
+// NewValsetDiff returns the ValsetDiff of the given validator updates. If a validator has multiple updates,
+// only its last update is kept, as the later updates supersede the earlier ones.
+func NewValsetDiff(updates ...abci.ValidatorUpdate) ValsetDiff {
+	lastUpdates := make(map[string]abci.ValidatorUpdate, len(updates))
+	for _, update := range updates {
+		lastUpdates[update.PubKey.String()] = update
+	}
+
+	sorted := make([]abci.ValidatorUpdate, 0, len(lastUpdates))
+	for _, update := range lastUpdates {
+		sorted = append(sorted, update)
+	}
+	// The list of tendermint updates should hash the same across all consensus nodes
+	// that means it is necessary to sort for determinism.
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].Power != sorted[j].Power {
+			return sorted[i].Power > sorted[j].Power
+		}
+		return sorted[i].PubKey.String() > sorted[j].PubKey.String()
+	})
+
+	return ValsetDiff{updates: sorted}
+}
+
+// Updates returns the validator updates of the diff, in the order of the ValsetDiff contract
+func (d ValsetDiff) Updates() []abci.ValidatorUpdate {
+	return append([]abci.ValidatorUpdate{}, d.updates...)
+}
+
+// Len returns the number of validator updates of the diff
+func (d ValsetDiff) Len() int {
+	return len(d.updates)
+}
+
+// Merge returns the diff resulting from applying the `newer` diff after this diff,
+// i.e., the updates of `newer` supersede the updates of this diff for the same validators
+func (d ValsetDiff) Merge(newer ValsetDiff) ValsetDiff {
+	return NewValsetDiff(append(d.Updates(), newer.updates...)...)
+}
+
+// Split splits the diff into consecutive diffs of at most `maxUpdates` validator updates.
+// Zero means no cap. Every returned diff satisfies the ValsetDiff contract, and so does their concatenation.
+func (d ValsetDiff) Split(maxUpdates uint64) []ValsetDiff {
+	if maxUpdates == 0 || uint64(len(d.updates)) <= maxUpdates {
+		return []ValsetDiff{d}
+	}
+
+	var batches []ValsetDiff
+	updates := d.updates
+	for uint64(len(updates)) > maxUpdates {
+		batches = append(batches, ValsetDiff{updates: updates[:maxUpdates:maxUpdates]})
+		updates = updates[maxUpdates:]
+	}
+	return append(batches, ValsetDiff{updates: updates})
+}
+
+// ValsetDiffCarrier is implemented by the types that carry validator updates between the provider and consumer modules,
+// so that the validator updates are always read through a ValsetDiff, i.e., in the order of the ValsetDiff contract
+type ValsetDiffCarrier interface {
+	GetValsetDiff() ValsetDiff
+}
+
+var _ ValsetDiffCarrier = ValidatorSetChangePacketData{}
+
+// GetValsetDiff returns the ValsetDiff of the validator updates of the packet. Note that the validator updates
+// of packets sent by providers that precede the ValsetDiff contract may not be ordered, so they are ordered here.
+func (vsc ValidatorSetChangePacketData) GetValsetDiff() ValsetDiff {
+	return NewValsetDiff(vsc.ValidatorUpdates...)
+}
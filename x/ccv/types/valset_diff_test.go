@@ -0,0 +1,144 @@
+package types_test
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/cosmos/interchain-security/v7/testutil/crypto"
+	"github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+func newValidatorUpdate(seed int, power int64) abci.ValidatorUpdate {
+	return abci.ValidatorUpdate{
+		PubKey: crypto.NewCryptoIdentityFromIntSeed(seed).TMProtoCryptoPublicKey(),
+		Power:  power,
+	}
+}
+
+// requireValsetDiffContract checks that the validator updates satisfy the ordering contract of ValsetDiff
+func requireValsetDiffContract(t *testing.T, updates []abci.ValidatorUpdate) {
+	t.Helper()
+
+	seen := map[string]bool{}
+	for _, update := range updates {
+		require.False(t, seen[update.PubKey.String()], "multiple updates of a validator")
+		seen[update.PubKey.String()] = true
+	}
+	require.True(t, sort.SliceIsSorted(updates, func(i, j int) bool {
+		if updates[i].Power != updates[j].Power {
+			return updates[i].Power > updates[j].Power
+		}
+		return updates[i].PubKey.String() > updates[j].PubKey.String()
+	}))
+}
+
+// TestNewValsetDiff tests that a ValsetDiff keeps the last update of every validator
+// and orders the updates in decreasing order of power, with the removals last
+func TestNewValsetDiff(t *testing.T) {
+	updates := []abci.ValidatorUpdate{
+		newValidatorUpdate(0, 0),
+		newValidatorUpdate(1, 10),
+		newValidatorUpdate(2, 30),
+		newValidatorUpdate(1, 20),
+		newValidatorUpdate(3, 0),
+		newValidatorUpdate(4, 20),
+	}
+
+	diff := types.NewValsetDiff(updates...)
+	require.Equal(t, 5, diff.Len())
+	requireValsetDiffContract(t, diff.Updates())
+
+	got := diff.Updates()
+	require.Equal(t, updates[2], got[0])
+	require.ElementsMatch(t, []abci.ValidatorUpdate{updates[3], updates[5]}, got[1:3])
+	require.ElementsMatch(t, []abci.ValidatorUpdate{updates[0], updates[4]}, got[3:])
+
+	// the ordering does not depend on the order of the input updates
+	reversed := []abci.ValidatorUpdate{updates[5], updates[4], updates[3], updates[2], updates[0]}
+	require.Equal(t, got, types.NewValsetDiff(reversed...).Updates())
+
+	// the diff cannot be modified through its updates
+	got[0].Power = 100
+	require.Equal(t, updates[2], diff.Updates()[0])
+
+	require.Empty(t, types.NewValsetDiff().Updates())
+	require.NotNil(t, types.NewValsetDiff().Updates())
+}
+
+// TestValsetDiffMerge tests that merging diffs keeps the updates of the newer diff
+func TestValsetDiffMerge(t *testing.T) {
+	older := types.NewValsetDiff(newValidatorUpdate(0, 10), newValidatorUpdate(1, 20))
+	newer := types.NewValsetDiff(newValidatorUpdate(1, 0), newValidatorUpdate(2, 5))
+
+	merged := older.Merge(newer)
+	requireValsetDiffContract(t, merged.Updates())
+	require.Equal(t, []abci.ValidatorUpdate{
+		newValidatorUpdate(0, 10),
+		newValidatorUpdate(2, 5),
+		newValidatorUpdate(1, 0),
+	}, merged.Updates())
+
+	// merging does not modify the merged diffs
+	require.Equal(t, 2, older.Len())
+	require.Equal(t, 2, newer.Len())
+}
+
+// TestValsetDiffSplit tests that a diff is split in diffs of capped size that preserve the ordering
+func TestValsetDiffSplit(t *testing.T) {
+	updates := make([]abci.ValidatorUpdate, 5)
+	for i := range updates {
+		updates[i] = newValidatorUpdate(i, int64(5-i))
+	}
+	diff := types.NewValsetDiff(updates...)
+
+	require.Equal(t, []types.ValsetDiff{diff}, diff.Split(0))
+	require.Equal(t, []types.ValsetDiff{diff}, diff.Split(5))
+
+	batches := diff.Split(2)
+	require.Len(t, batches, 3)
+	require.Equal(t, updates[0:2], batches[0].Updates())
+	require.Equal(t, updates[2:4], batches[1].Updates())
+	require.Equal(t, updates[4:5], batches[2].Updates())
+	for _, batch := range batches {
+		requireValsetDiffContract(t, batch.Updates())
+	}
+}
+
+// TestValsetDiffPacketRoundTrip tests that the validator updates applied by the consumer chain
+// are the validator updates of the ValsetDiff from which the provider chain built the VSC packet,
+// in the same order, and that the consumer orders the updates of packets that precede the ValsetDiff contract
+func TestValsetDiffPacketRoundTrip(t *testing.T) {
+	diff := types.NewValsetDiff(
+		newValidatorUpdate(0, 0),
+		newValidatorUpdate(1, 10),
+		newValidatorUpdate(2, 10),
+		newValidatorUpdate(3, 30),
+	)
+
+	// provider side
+	packet := types.NewValidatorSetChangePacketData(diff, 1, nil)
+	require.NoError(t, packet.Validate())
+	bz := packet.GetBytes()
+
+	// consumer side
+	var received types.ValidatorSetChangePacketData
+	require.NoError(t, types.ModuleCdc.UnmarshalJSON(bz, &received))
+	require.Equal(t, diff.Updates(), received.ValidatorUpdates)
+	require.Equal(t, diff, received.GetValsetDiff())
+
+	// a packet with unordered updates is read in the order of the contract
+	unordered := types.ValidatorSetChangePacketData{
+		ValidatorUpdates: []abci.ValidatorUpdate{
+			newValidatorUpdate(0, 0),
+			newValidatorUpdate(3, 30),
+			newValidatorUpdate(1, 10),
+			newValidatorUpdate(2, 10),
+		},
+		ValsetUpdateId: 2,
+	}
+	require.Equal(t, diff.Updates(), unordered.GetValsetDiff().Updates())
+}
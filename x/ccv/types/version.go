@@ -0,0 +1,66 @@
+package types
+
+import (
+	"runtime/debug"
+	"sort"
+)
+
+// ModulePath is the path of the Go module of Interchain Security
+const ModulePath = "github.com/cosmos/interchain-security/v7"
+
+// develVersion is the version reported by the Go toolchain for binaries built without version information
+const develVersion = "(devel)"
+
+// ICSVersion is the semantic version of Interchain Security reported by the CCV modules. It can be set at build time, i.e.,
+//
+//	-ldflags "-X github.com/cosmos/interchain-security/v7/x/ccv/types.ICSVersion=v7.0.0"
+//
+// Otherwise, the version is derived from the build info of the binary, see GetICSVersion.
+var ICSVersion = ""
+
+// GetICSVersion returns the semantic version of Interchain Security the binary is built with,
+// i.e., ICSVersion if set or the version of the ICS Go module recorded in the build info of the binary,
+// e.g., for the binaries of the chains that import ICS as a dependency
+func GetICSVersion() string {
+	if ICSVersion != "" {
+		return ICSVersion
+	}
+	buildInfo, ok := debug.ReadBuildInfo()
+	if !ok {
+		return develVersion
+	}
+	if buildInfo.Main.Path == ModulePath && buildInfo.Main.Version != "" {
+		return buildInfo.Main.Version
+	}
+	for _, dep := range buildInfo.Deps {
+		if dep.Path != ModulePath {
+			continue
+		}
+		if dep.Replace != nil && dep.Replace.Version != "" {
+			return dep.Replace.Version
+		}
+		return dep.Version
+	}
+	return develVersion
+}
+
+// NewModuleVersionInfo returns the version info of a CCV module with the given consensus version
+// and enabled features, which are deduplicated and sorted
+func NewModuleVersionInfo(consensusVersion uint64, enabledFeatures []string) ModuleVersionInfo {
+	features := []string{}
+	seen := map[string]bool{}
+	for _, feature := range enabledFeatures {
+		if !seen[feature] {
+			seen[feature] = true
+			features = append(features, feature)
+		}
+	}
+	sort.Strings(features)
+
+	return ModuleVersionInfo{
+		IcsVersion:       GetICSVersion(),
+		ConsensusVersion: consensusVersion,
+		CcvVersion:       Version,
+		EnabledFeatures:  features,
+	}
+}
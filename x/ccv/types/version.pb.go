@@ -0,0 +1,473 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: interchain_security/ccv/v1/version.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ModuleVersionInfo describes the version of a CCV module and the features
+// enabled on the chain, so that relayers, explorers, and counterparty chains
+// can adapt their behavior without relying on the names of chain upgrades
+type ModuleVersionInfo struct {
+	// the semantic version of Interchain Security, e.g., v7.0.0,
+	// or "(devel)" if the binary is built without version information
+	IcsVersion string `protobuf:"bytes,1,opt,name=ics_version,json=icsVersion,proto3" json:"ics_version,omitempty"`
+	// the consensus version of the module, which is incremented
+	// with every migration of the module state
+	ConsensusVersion uint64 `protobuf:"varint,2,opt,name=consensus_version,json=consensusVersion,proto3" json:"consensus_version,omitempty"`
+	// the CCV version negotiated in the CCV channel handshake
+	CcvVersion string `protobuf:"bytes,3,opt,name=ccv_version,json=ccvVersion,proto3" json:"ccv_version,omitempty"`
+	// the names of the features enabled on the chain, in lexicographic order
+	EnabledFeatures []string `protobuf:"bytes,4,rep,name=enabled_features,json=enabledFeatures,proto3" json:"enabled_features,omitempty"`
+}
+
+func (m *ModuleVersionInfo) Reset()         { *m = ModuleVersionInfo{} }
+func (m *ModuleVersionInfo) String() string { return proto.CompactTextString(m) }
+func (*ModuleVersionInfo) ProtoMessage()    {}
+func (*ModuleVersionInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c247cdd6ea6b3c7f, []int{0}
+}
+func (m *ModuleVersionInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ModuleVersionInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ModuleVersionInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ModuleVersionInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModuleVersionInfo.Merge(m, src)
+}
+func (m *ModuleVersionInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *ModuleVersionInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModuleVersionInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModuleVersionInfo proto.InternalMessageInfo
+
+func (m *ModuleVersionInfo) GetIcsVersion() string {
+	if m != nil {
+		return m.IcsVersion
+	}
+	return ""
+}
+
+func (m *ModuleVersionInfo) GetConsensusVersion() uint64 {
+	if m != nil {
+		return m.ConsensusVersion
+	}
+	return 0
+}
+
+func (m *ModuleVersionInfo) GetCcvVersion() string {
+	if m != nil {
+		return m.CcvVersion
+	}
+	return ""
+}
+
+func (m *ModuleVersionInfo) GetEnabledFeatures() []string {
+	if m != nil {
+		return m.EnabledFeatures
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ModuleVersionInfo)(nil), "interchain_security.ccv.v1.ModuleVersionInfo")
+}
+
+func init() {
+	proto.RegisterFile("interchain_security/ccv/v1/version.proto", fileDescriptor_c247cdd6ea6b3c7f)
+}
+
+var fileDescriptor_c247cdd6ea6b3c7f = []byte{
+	// 258 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0xc8, 0xcc, 0x2b, 0x49,
+	0x2d, 0x4a, 0xce, 0x48, 0xcc, 0xcc, 0x8b, 0x2f, 0x4e, 0x4d, 0x2e, 0x2d, 0xca, 0x2c, 0xa9, 0xd4,
+	0x4f, 0x4e, 0x2e, 0xd3, 0x2f, 0x33, 0xd4, 0x2f, 0x4b, 0x2d, 0x2a, 0xce, 0xcc, 0xcf, 0xd3, 0x2b,
+	0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0xc2, 0xa2, 0x52, 0x2f, 0x39, 0xb9, 0x4c, 0xaf, 0xcc, 0x50,
+	0x69, 0x2d, 0x23, 0x97, 0xa0, 0x6f, 0x7e, 0x4a, 0x69, 0x4e, 0x6a, 0x18, 0x44, 0x8f, 0x67, 0x5e,
+	0x5a, 0xbe, 0x90, 0x3c, 0x17, 0x77, 0x66, 0x72, 0x71, 0x3c, 0xd4, 0x18, 0x09, 0x46, 0x05, 0x46,
+	0x0d, 0xce, 0x20, 0xae, 0xcc, 0xe4, 0x62, 0xa8, 0x22, 0x21, 0x6d, 0x2e, 0xc1, 0xe4, 0xfc, 0xbc,
+	0xe2, 0xd4, 0xbc, 0xe2, 0x52, 0x84, 0x32, 0x26, 0x05, 0x46, 0x0d, 0x96, 0x20, 0x01, 0xb8, 0x04,
+	0x4c, 0xb1, 0x3c, 0x17, 0x77, 0x72, 0x72, 0x19, 0x5c, 0x19, 0x33, 0xc4, 0xb4, 0xe4, 0xe4, 0x32,
+	0x98, 0x02, 0x4d, 0x2e, 0x81, 0xd4, 0xbc, 0xc4, 0xa4, 0x9c, 0xd4, 0x94, 0xf8, 0xb4, 0xd4, 0xc4,
+	0x92, 0xd2, 0xa2, 0xd4, 0x62, 0x09, 0x16, 0x05, 0x66, 0x0d, 0xce, 0x20, 0x7e, 0xa8, 0xb8, 0x1b,
+	0x54, 0xd8, 0xc9, 0xef, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63,
+	0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0x4c, 0xd2,
+	0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5, 0x93, 0xf3, 0x8b, 0x73, 0xf3, 0x8b,
+	0xf5, 0x11, 0xfe, 0xd6, 0x85, 0x87, 0x50, 0x99, 0xb9, 0x7e, 0x05, 0x38, 0x98, 0x4a, 0x2a, 0x0b,
+	0x52, 0x8b, 0x93, 0xd8, 0xc0, 0x41, 0x64, 0x0c, 0x18, 0x00, 0x2b, 0x2d, 0xc5, 0xf2, 0x4e, 0x01,
+	0x00, 0x00,
+}
+
+func (m *ModuleVersionInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ModuleVersionInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModuleVersionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EnabledFeatures) > 0 {
+		for iNdEx := len(m.EnabledFeatures) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.EnabledFeatures[iNdEx])
+			copy(dAtA[i:], m.EnabledFeatures[iNdEx])
+			i = encodeVarintVersion(dAtA, i, uint64(len(m.EnabledFeatures[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.CcvVersion) > 0 {
+		i -= len(m.CcvVersion)
+		copy(dAtA[i:], m.CcvVersion)
+		i = encodeVarintVersion(dAtA, i, uint64(len(m.CcvVersion)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ConsensusVersion != 0 {
+		i = encodeVarintVersion(dAtA, i, uint64(m.ConsensusVersion))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.IcsVersion) > 0 {
+		i -= len(m.IcsVersion)
+		copy(dAtA[i:], m.IcsVersion)
+		i = encodeVarintVersion(dAtA, i, uint64(len(m.IcsVersion)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintVersion(dAtA []byte, offset int, v uint64) int {
+	offset -= sovVersion(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ModuleVersionInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.IcsVersion)
+	if l > 0 {
+		n += 1 + l + sovVersion(uint64(l))
+	}
+	if m.ConsensusVersion != 0 {
+		n += 1 + sovVersion(uint64(m.ConsensusVersion))
+	}
+	l = len(m.CcvVersion)
+	if l > 0 {
+		n += 1 + l + sovVersion(uint64(l))
+	}
+	if len(m.EnabledFeatures) > 0 {
+		for _, s := range m.EnabledFeatures {
+			l = len(s)
+			n += 1 + l + sovVersion(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovVersion(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozVersion(x uint64) (n int) {
+	return sovVersion(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ModuleVersionInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowVersion
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ModuleVersionInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ModuleVersionInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IcsVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVersion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVersion
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVersion
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IcsVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusVersion", wireType)
+			}
+			m.ConsensusVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVersion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsensusVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CcvVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVersion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVersion
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVersion
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CcvVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnabledFeatures", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVersion
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVersion
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVersion
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EnabledFeatures = append(m.EnabledFeatures, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipVersion(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthVersion
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipVersion(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowVersion
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowVersion
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowVersion
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthVersion
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupVersion
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthVersion
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthVersion        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowVersion          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupVersion = fmt.Errorf("proto: unexpected end of group")
+)
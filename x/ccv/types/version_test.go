@@ -0,0 +1,24 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+func TestNewModuleVersionInfo(t *testing.T) {
+	versionInfo := types.NewModuleVersionInfo(3, []string{"feature-b", "feature-a", "feature-b"})
+	require.Equal(t, uint64(3), versionInfo.ConsensusVersion)
+	require.Equal(t, types.Version, versionInfo.CcvVersion)
+	require.Equal(t, []string{"feature-a", "feature-b"}, versionInfo.EnabledFeatures)
+	// test binaries are built without version information
+	require.Equal(t, "(devel)", versionInfo.IcsVersion)
+
+	// the version set at build time takes precedence
+	defer func(version string) { types.ICSVersion = version }(types.ICSVersion)
+	types.ICSVersion = "v7.1.0"
+	require.Equal(t, "v7.1.0", types.NewModuleVersionInfo(3, nil).IcsVersion)
+	require.Empty(t, types.NewModuleVersionInfo(3, nil).EnabledFeatures)
+}
@@ -5,17 +5,25 @@ import (
 	"errors"
 	"fmt"
 
+	ibctmtypes "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
+
 	errorsmod "cosmossdk.io/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+	cryptoenc "github.com/cometbft/cometbft/crypto/encoding"
+	tmprotocrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	tmtypes "github.com/cometbft/cometbft/types"
 )
 
-func NewValidatorSetChangePacketData(valUpdates []abci.ValidatorUpdate, valUpdateID uint64, slashAcks []string) ValidatorSetChangePacketData {
+// NewValidatorSetChangePacketData returns the VSC packet data with the validator updates of the given diff,
+// so that the validator updates of the packet are in the order of the ValsetDiff contract
+func NewValidatorSetChangePacketData(valsetDiff ValsetDiff, valUpdateID uint64, slashAcks []string) ValidatorSetChangePacketData {
 	return ValidatorSetChangePacketData{
-		ValidatorUpdates: valUpdates,
+		ValidatorUpdates: valsetDiff.Updates(),
 		ValsetUpdateId:   valUpdateID,
 		SlashAcks:        slashAcks,
 	}
@@ -57,6 +65,21 @@ func (mat VSCMaturedPacketData) Validate() error {
 	return nil
 }
 
+func NewConsumerShutdownPacketData(valUpdateID uint64) *ConsumerShutdownPacketData {
+	return &ConsumerShutdownPacketData{
+		ValsetUpdateId: valUpdateID,
+	}
+}
+
+// Validate is used for validating the ConsumerShutdown packet data.
+func (sd ConsumerShutdownPacketData) Validate() error {
+	// ValsetUpdateId is strictly positive, as the consumer chain received at least one VSC packet
+	if sd.ValsetUpdateId == 0 {
+		return errorsmod.Wrap(ErrInvalidPacketData, "vscId cannot be equal to zero")
+	}
+	return nil
+}
+
 func NewSlashPacketData(validator abci.Validator, valUpdateId uint64, infractionType stakingtypes.Infraction) *SlashPacketData {
 	return &SlashPacketData{
 		Validator:      validator,
@@ -82,6 +105,71 @@ func NewSlashPacketDataV1(validator abci.Validator, valUpdateId uint64, infracti
 	}
 }
 
+func NewConsumerMisbehaviourPacketData(misbehaviour *ibctmtypes.Misbehaviour) *ConsumerMisbehaviourPacketData {
+	return &ConsumerMisbehaviourPacketData{
+		Misbehaviour: misbehaviour,
+	}
+}
+
+// Validate is used for validating the ConsumerMisbehaviour packet data.
+// Note that the misbehaviour is verified against the client to the consumer chain
+// only on the provider chain.
+func (md ConsumerMisbehaviourPacketData) Validate() error {
+	if md.Misbehaviour == nil {
+		return errorsmod.Wrap(ErrInvalidPacketData, "misbehaviour cannot be nil")
+	}
+	if err := md.Misbehaviour.ValidateBasic(); err != nil {
+		return errorsmod.Wrapf(ErrInvalidPacketData, "invalid misbehaviour: %s", err.Error())
+	}
+	return nil
+}
+
+func NewConsumerHeartbeatPacketData(validatorAddresses [][]byte) *ConsumerHeartbeatPacketData {
+	return &ConsumerHeartbeatPacketData{
+		ValidatorAddresses: validatorAddresses,
+	}
+}
+
+// Validate is used for validating the ConsumerHeartbeat packet data.
+func (hd ConsumerHeartbeatPacketData) Validate() error {
+	for _, addr := range hd.ValidatorAddresses {
+		// the validator addresses must be consensus addresses
+		if err := sdk.VerifyAddressFormat(addr); err != nil {
+			return errorsmod.Wrapf(ErrInvalidPacketData, "invalid validator address: %s", err.Error())
+		}
+	}
+	return nil
+}
+
+func NewConsumerDoubleVotePacketData(
+	evidence *tmproto.DuplicateVoteEvidence,
+	validatorPubKey *tmprotocrypto.PublicKey,
+) *ConsumerDoubleVotePacketData {
+	return &ConsumerDoubleVotePacketData{
+		DuplicateVoteEvidence: evidence,
+		ValidatorPubKey:       validatorPubKey,
+	}
+}
+
+// Validate is used for validating the ConsumerDoubleVote packet data.
+// Note that the signatures of the conflicting votes are verified only on the provider chain.
+func (dd ConsumerDoubleVotePacketData) Validate() error {
+	if dd.DuplicateVoteEvidence == nil {
+		return errorsmod.Wrap(ErrInvalidPacketData, "double voting evidence cannot be nil")
+	}
+	// DuplicateVoteEvidenceFromProto also performs the basic validation of the evidence
+	if _, err := tmtypes.DuplicateVoteEvidenceFromProto(dd.DuplicateVoteEvidence); err != nil {
+		return errorsmod.Wrapf(ErrInvalidPacketData, "invalid double voting evidence: %s", err.Error())
+	}
+	if dd.ValidatorPubKey == nil {
+		return errorsmod.Wrap(ErrInvalidPacketData, "validator public key cannot be nil")
+	}
+	if _, err := cryptoenc.PubKeyFromProto(*dd.ValidatorPubKey); err != nil {
+		return errorsmod.Wrapf(ErrInvalidPacketData, "invalid validator public key: %s", err.Error())
+	}
+	return nil
+}
+
 func (vdt SlashPacketData) Validate() error {
 	// vdt.Validator.Address must be a consensus address
 	if err := sdk.VerifyAddressFormat(vdt.Validator.Address); err != nil {
@@ -120,6 +208,34 @@ func (cp ConsumerPacketData) Validate() (err error) {
 			return errors.New("invalid consumer packet data: SlashPacketData data cannot be empty")
 		}
 		err = slashPacket.Validate()
+	case ConsumerShutdownPacket:
+		// validate ConsumerShutdownPacket
+		shutdownPacket := cp.GetConsumerShutdownPacketData()
+		if shutdownPacket == nil {
+			return errors.New("invalid consumer packet data: ConsumerShutdownPacketData data cannot be empty")
+		}
+		err = shutdownPacket.Validate()
+	case ConsumerMisbehaviourPacket:
+		// validate ConsumerMisbehaviourPacket
+		misbehaviourPacket := cp.GetConsumerMisbehaviourPacketData()
+		if misbehaviourPacket == nil {
+			return errors.New("invalid consumer packet data: ConsumerMisbehaviourPacketData data cannot be empty")
+		}
+		err = misbehaviourPacket.Validate()
+	case ConsumerHeartbeatPacket:
+		// validate ConsumerHeartbeatPacket
+		heartbeatPacket := cp.GetConsumerHeartbeatPacketData()
+		if heartbeatPacket == nil {
+			return errors.New("invalid consumer packet data: ConsumerHeartbeatPacketData data cannot be empty")
+		}
+		err = heartbeatPacket.Validate()
+	case ConsumerDoubleVotePacket:
+		// validate ConsumerDoubleVotePacket
+		doubleVotePacket := cp.GetConsumerDoubleVotePacketData()
+		if doubleVotePacket == nil {
+			return errors.New("invalid consumer packet data: ConsumerDoubleVotePacketData data cannot be empty")
+		}
+		err = doubleVotePacket.Validate()
 	default:
 		err = fmt.Errorf("invalid consumer packet type: %q", cp.Type)
 	}
@@ -170,6 +286,39 @@ func (vdt1 SlashPacketDataV1) FromV1() *SlashPacketData {
 	}
 }
 
+// UnmarshalConsumerPacketData decodes consumer packet data sent over the wire in any of
+// the historical formats into the latest ConsumerPacketData type, i.e.,
+//   - the current format, used for VSC matured, consumer shutdown, consumer misbehaviour, and consumer heartbeat packets;
+//   - the v1 format (ICS v1 and ICS v2), used for slash packets, see ToV1Bytes.
+//
+// Besides the CCV modules, relayers and indexers can use it to decode CCV packets.
+func UnmarshalConsumerPacketData(packetData []byte) (consumerPacket ConsumerPacketData, err error) {
+	// First try unmarshaling into ConsumerPacketData type
+	if err := ModuleCdc.UnmarshalJSON(packetData, &consumerPacket); err != nil {
+		// If failed, packet should be a v1 slash packet, retry for ConsumerPacketDataV1 packet type
+		var v1Packet ConsumerPacketDataV1
+		errV1 := ModuleCdc.UnmarshalJSON(packetData, &v1Packet)
+		if errV1 != nil {
+			// If neither worked, return error
+			return ConsumerPacketData{}, errV1
+		}
+
+		// only slash packets are marshaled as v1 packets
+		if v1Packet.Type != SlashPacket {
+			return ConsumerPacketData{}, fmt.Errorf("%s packets should be correctly unmarshaled", v1Packet.Type)
+		}
+
+		// Convert from v1 packet type
+		consumerPacket = ConsumerPacketData{
+			Type: v1Packet.Type,
+			Data: &ConsumerPacketData_SlashPacketData{
+				SlashPacketData: v1Packet.GetSlashPacketData().FromV1(),
+			},
+		}
+	}
+	return consumerPacket, nil
+}
+
 type PacketAckResult []byte
 
 var ( // slice types can't be const
@@ -6,9 +6,12 @@ package types
 import (
 	fmt "fmt"
 	types "github.com/cometbft/cometbft/abci/types"
+	crypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	types2 "github.com/cometbft/cometbft/proto/tendermint/types"
 	types1 "github.com/cosmos/cosmos-sdk/x/staking/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	_07_tendermint "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -35,18 +38,34 @@ const (
 	SlashPacket ConsumerPacketDataType = 1
 	// VSCMatured packet
 	VscMaturedPacket ConsumerPacketDataType = 2
+	// ConsumerShutdown packet
+	ConsumerShutdownPacket ConsumerPacketDataType = 3
+	// ConsumerMisbehaviour packet
+	ConsumerMisbehaviourPacket ConsumerPacketDataType = 4
+	// ConsumerHeartbeat packet
+	ConsumerHeartbeatPacket ConsumerPacketDataType = 5
+	// ConsumerDoubleVote packet
+	ConsumerDoubleVotePacket ConsumerPacketDataType = 6
 )
 
 var ConsumerPacketDataType_name = map[int32]string{
 	0: "CONSUMER_PACKET_TYPE_UNSPECIFIED",
 	1: "CONSUMER_PACKET_TYPE_SLASH",
 	2: "CONSUMER_PACKET_TYPE_VSCM",
+	3: "CONSUMER_PACKET_TYPE_SHUTDOWN",
+	4: "CONSUMER_PACKET_TYPE_MISBEHAVIOUR",
+	5: "CONSUMER_PACKET_TYPE_HEARTBEAT",
+	6: "CONSUMER_PACKET_TYPE_DOUBLE_VOTE",
 }
 
 var ConsumerPacketDataType_value = map[string]int32{
-	"CONSUMER_PACKET_TYPE_UNSPECIFIED": 0,
-	"CONSUMER_PACKET_TYPE_SLASH":       1,
-	"CONSUMER_PACKET_TYPE_VSCM":        2,
+	"CONSUMER_PACKET_TYPE_UNSPECIFIED":  0,
+	"CONSUMER_PACKET_TYPE_SLASH":        1,
+	"CONSUMER_PACKET_TYPE_VSCM":         2,
+	"CONSUMER_PACKET_TYPE_SHUTDOWN":     3,
+	"CONSUMER_PACKET_TYPE_MISBEHAVIOUR": 4,
+	"CONSUMER_PACKET_TYPE_HEARTBEAT":    5,
+	"CONSUMER_PACKET_TYPE_DOUBLE_VOTE":  6,
 }
 
 func (x ConsumerPacketDataType) String() string {
@@ -270,12 +289,222 @@ func (m *SlashPacketData) GetInfraction() types1.Infraction {
 	return types1.Infraction_INFRACTION_UNSPECIFIED
 }
 
+// This packet is sent from the consumer chain to the provider chain
+// to notify that the consumer chain initiated its shutdown. Upon receiving it,
+// the provider chain stops the consumer chain.
+type ConsumerShutdownPacketData struct {
+	// the id of the last VSC packet received by the consumer chain
+	ValsetUpdateId uint64 `protobuf:"varint,1,opt,name=valset_update_id,json=valsetUpdateId,proto3" json:"valset_update_id,omitempty"`
+}
+
+func (m *ConsumerShutdownPacketData) Reset()         { *m = ConsumerShutdownPacketData{} }
+func (m *ConsumerShutdownPacketData) String() string { return proto.CompactTextString(m) }
+func (*ConsumerShutdownPacketData) ProtoMessage()    {}
+func (*ConsumerShutdownPacketData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8fd0dc67df6b10ed, []int{3}
+}
+func (m *ConsumerShutdownPacketData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerShutdownPacketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerShutdownPacketData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConsumerShutdownPacketData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerShutdownPacketData.Merge(m, src)
+}
+func (m *ConsumerShutdownPacketData) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerShutdownPacketData) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerShutdownPacketData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsumerShutdownPacketData proto.InternalMessageInfo
+
+func (m *ConsumerShutdownPacketData) GetValsetUpdateId() uint64 {
+	if m != nil {
+		return m.ValsetUpdateId
+	}
+	return 0
+}
+
+// This packet is sent from the consumer chain to the provider chain
+// to report a light client attack on the consumer chain. Upon receiving it,
+// the provider chain verifies the misbehaviour against its client to the
+// consumer chain and punishes the Byzantine validators.
+type ConsumerMisbehaviourPacketData struct {
+	// the misbehaviour wrapping two conflicting headers of the consumer chain,
+	// for the client to the consumer chain on the provider chain
+	Misbehaviour *_07_tendermint.Misbehaviour `protobuf:"bytes,1,opt,name=misbehaviour,proto3" json:"misbehaviour,omitempty"`
+}
+
+func (m *ConsumerMisbehaviourPacketData) Reset()         { *m = ConsumerMisbehaviourPacketData{} }
+func (m *ConsumerMisbehaviourPacketData) String() string { return proto.CompactTextString(m) }
+func (*ConsumerMisbehaviourPacketData) ProtoMessage()    {}
+func (*ConsumerMisbehaviourPacketData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8fd0dc67df6b10ed, []int{4}
+}
+func (m *ConsumerMisbehaviourPacketData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerMisbehaviourPacketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerMisbehaviourPacketData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConsumerMisbehaviourPacketData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerMisbehaviourPacketData.Merge(m, src)
+}
+func (m *ConsumerMisbehaviourPacketData) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerMisbehaviourPacketData) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerMisbehaviourPacketData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsumerMisbehaviourPacketData proto.InternalMessageInfo
+
+func (m *ConsumerMisbehaviourPacketData) GetMisbehaviour() *_07_tendermint.Misbehaviour {
+	if m != nil {
+		return m.Misbehaviour
+	}
+	return nil
+}
+
+// This packet is sent periodically from the consumer chain to the provider chain
+// to report the consumer validators that are signing blocks. The provider chain
+// uses it to detect assigned consumer keys that are not used by any running node.
+type ConsumerHeartbeatPacketData struct {
+	// the consensus addresses of the consumer validators that signed
+	// at least one block since the previous heartbeat
+	ValidatorAddresses [][]byte `protobuf:"bytes,1,rep,name=validator_addresses,json=validatorAddresses,proto3" json:"validator_addresses,omitempty"`
+}
+
+func (m *ConsumerHeartbeatPacketData) Reset()         { *m = ConsumerHeartbeatPacketData{} }
+func (m *ConsumerHeartbeatPacketData) String() string { return proto.CompactTextString(m) }
+func (*ConsumerHeartbeatPacketData) ProtoMessage()    {}
+func (*ConsumerHeartbeatPacketData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8fd0dc67df6b10ed, []int{5}
+}
+func (m *ConsumerHeartbeatPacketData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerHeartbeatPacketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerHeartbeatPacketData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConsumerHeartbeatPacketData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerHeartbeatPacketData.Merge(m, src)
+}
+func (m *ConsumerHeartbeatPacketData) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerHeartbeatPacketData) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerHeartbeatPacketData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsumerHeartbeatPacketData proto.InternalMessageInfo
+
+func (m *ConsumerHeartbeatPacketData) GetValidatorAddresses() [][]byte {
+	if m != nil {
+		return m.ValidatorAddresses
+	}
+	return nil
+}
+
+// This packet is sent from the consumer chain to the provider chain
+// to forward the evidence of a validator double voting on the consumer chain,
+// after it is handled by the evidence module of the consumer chain. Upon receiving it,
+// the provider chain verifies the evidence and punishes the malicious validator.
+type ConsumerDoubleVotePacketData struct {
+	// the double voting evidence
+	DuplicateVoteEvidence *types2.DuplicateVoteEvidence `protobuf:"bytes,1,opt,name=duplicate_vote_evidence,json=duplicateVoteEvidence,proto3" json:"duplicate_vote_evidence,omitempty"`
+	// the consensus public key of the malicious validator on the consumer chain,
+	// used to verify the signatures of the conflicting votes
+	ValidatorPubKey *crypto.PublicKey `protobuf:"bytes,2,opt,name=validator_pub_key,json=validatorPubKey,proto3" json:"validator_pub_key,omitempty"`
+}
+
+func (m *ConsumerDoubleVotePacketData) Reset()         { *m = ConsumerDoubleVotePacketData{} }
+func (m *ConsumerDoubleVotePacketData) String() string { return proto.CompactTextString(m) }
+func (*ConsumerDoubleVotePacketData) ProtoMessage()    {}
+func (*ConsumerDoubleVotePacketData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8fd0dc67df6b10ed, []int{6}
+}
+func (m *ConsumerDoubleVotePacketData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsumerDoubleVotePacketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsumerDoubleVotePacketData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConsumerDoubleVotePacketData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsumerDoubleVotePacketData.Merge(m, src)
+}
+func (m *ConsumerDoubleVotePacketData) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsumerDoubleVotePacketData) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsumerDoubleVotePacketData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsumerDoubleVotePacketData proto.InternalMessageInfo
+
+func (m *ConsumerDoubleVotePacketData) GetDuplicateVoteEvidence() *types2.DuplicateVoteEvidence {
+	if m != nil {
+		return m.DuplicateVoteEvidence
+	}
+	return nil
+}
+
+func (m *ConsumerDoubleVotePacketData) GetValidatorPubKey() *crypto.PublicKey {
+	if m != nil {
+		return m.ValidatorPubKey
+	}
+	return nil
+}
+
 // ConsumerPacketData contains a consumer packet data and a type tag
 type ConsumerPacketData struct {
 	Type ConsumerPacketDataType `protobuf:"varint,1,opt,name=type,proto3,enum=interchain_security.ccv.v1.ConsumerPacketDataType" json:"type,omitempty"`
 	// Types that are valid to be assigned to Data:
 	//	*ConsumerPacketData_SlashPacketData
 	//	*ConsumerPacketData_VscMaturedPacketData
+	//	*ConsumerPacketData_ConsumerShutdownPacketData
+	//	*ConsumerPacketData_ConsumerMisbehaviourPacketData
+	//	*ConsumerPacketData_ConsumerHeartbeatPacketData
+	//	*ConsumerPacketData_ConsumerDoubleVotePacketData
 	Data isConsumerPacketData_Data `protobuf_oneof:"data"`
 }
 
@@ -283,7 +512,7 @@ func (m *ConsumerPacketData) Reset()         { *m = ConsumerPacketData{} }
 func (m *ConsumerPacketData) String() string { return proto.CompactTextString(m) }
 func (*ConsumerPacketData) ProtoMessage()    {}
 func (*ConsumerPacketData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8fd0dc67df6b10ed, []int{3}
+	return fileDescriptor_8fd0dc67df6b10ed, []int{7}
 }
 func (m *ConsumerPacketData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -324,9 +553,25 @@ type ConsumerPacketData_SlashPacketData struct {
 type ConsumerPacketData_VscMaturedPacketData struct {
 	VscMaturedPacketData *VSCMaturedPacketData `protobuf:"bytes,3,opt,name=vscMaturedPacketData,proto3,oneof" json:"vscMaturedPacketData,omitempty"`
 }
+type ConsumerPacketData_ConsumerShutdownPacketData struct {
+	ConsumerShutdownPacketData *ConsumerShutdownPacketData `protobuf:"bytes,4,opt,name=consumerShutdownPacketData,proto3,oneof" json:"consumerShutdownPacketData,omitempty"`
+}
+type ConsumerPacketData_ConsumerMisbehaviourPacketData struct {
+	ConsumerMisbehaviourPacketData *ConsumerMisbehaviourPacketData `protobuf:"bytes,5,opt,name=consumerMisbehaviourPacketData,proto3,oneof" json:"consumerMisbehaviourPacketData,omitempty"`
+}
+type ConsumerPacketData_ConsumerHeartbeatPacketData struct {
+	ConsumerHeartbeatPacketData *ConsumerHeartbeatPacketData `protobuf:"bytes,6,opt,name=consumerHeartbeatPacketData,proto3,oneof" json:"consumerHeartbeatPacketData,omitempty"`
+}
+type ConsumerPacketData_ConsumerDoubleVotePacketData struct {
+	ConsumerDoubleVotePacketData *ConsumerDoubleVotePacketData `protobuf:"bytes,7,opt,name=consumerDoubleVotePacketData,proto3,oneof" json:"consumerDoubleVotePacketData,omitempty"`
+}
 
-func (*ConsumerPacketData_SlashPacketData) isConsumerPacketData_Data()      {}
-func (*ConsumerPacketData_VscMaturedPacketData) isConsumerPacketData_Data() {}
+func (*ConsumerPacketData_SlashPacketData) isConsumerPacketData_Data()                {}
+func (*ConsumerPacketData_VscMaturedPacketData) isConsumerPacketData_Data()           {}
+func (*ConsumerPacketData_ConsumerShutdownPacketData) isConsumerPacketData_Data()     {}
+func (*ConsumerPacketData_ConsumerMisbehaviourPacketData) isConsumerPacketData_Data() {}
+func (*ConsumerPacketData_ConsumerHeartbeatPacketData) isConsumerPacketData_Data()    {}
+func (*ConsumerPacketData_ConsumerDoubleVotePacketData) isConsumerPacketData_Data()   {}
 
 func (m *ConsumerPacketData) GetData() isConsumerPacketData_Data {
 	if m != nil {
@@ -356,11 +601,43 @@ func (m *ConsumerPacketData) GetVscMaturedPacketData() *VSCMaturedPacketData {
 	return nil
 }
 
+func (m *ConsumerPacketData) GetConsumerShutdownPacketData() *ConsumerShutdownPacketData {
+	if x, ok := m.GetData().(*ConsumerPacketData_ConsumerShutdownPacketData); ok {
+		return x.ConsumerShutdownPacketData
+	}
+	return nil
+}
+
+func (m *ConsumerPacketData) GetConsumerMisbehaviourPacketData() *ConsumerMisbehaviourPacketData {
+	if x, ok := m.GetData().(*ConsumerPacketData_ConsumerMisbehaviourPacketData); ok {
+		return x.ConsumerMisbehaviourPacketData
+	}
+	return nil
+}
+
+func (m *ConsumerPacketData) GetConsumerHeartbeatPacketData() *ConsumerHeartbeatPacketData {
+	if x, ok := m.GetData().(*ConsumerPacketData_ConsumerHeartbeatPacketData); ok {
+		return x.ConsumerHeartbeatPacketData
+	}
+	return nil
+}
+
+func (m *ConsumerPacketData) GetConsumerDoubleVotePacketData() *ConsumerDoubleVotePacketData {
+	if x, ok := m.GetData().(*ConsumerPacketData_ConsumerDoubleVotePacketData); ok {
+		return x.ConsumerDoubleVotePacketData
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*ConsumerPacketData) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*ConsumerPacketData_SlashPacketData)(nil),
 		(*ConsumerPacketData_VscMaturedPacketData)(nil),
+		(*ConsumerPacketData_ConsumerShutdownPacketData)(nil),
+		(*ConsumerPacketData_ConsumerMisbehaviourPacketData)(nil),
+		(*ConsumerPacketData_ConsumerHeartbeatPacketData)(nil),
+		(*ConsumerPacketData_ConsumerDoubleVotePacketData)(nil),
 	}
 }
 
@@ -368,13 +645,16 @@ func (*ConsumerPacketData) XXX_OneofWrappers() []interface{} {
 type HandshakeMetadata struct {
 	ProviderFeePoolAddr string `protobuf:"bytes,1,opt,name=provider_fee_pool_addr,json=providerFeePoolAddr,proto3" json:"provider_fee_pool_addr,omitempty"`
 	Version             string `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	// (optional) the ID (on the provider) of the established CCV channel that is replaced
+	// by the channel being opened; only set if the channel being opened is a migration channel
+	PreviousChannelId string `protobuf:"bytes,3,opt,name=previous_channel_id,json=previousChannelId,proto3" json:"previous_channel_id,omitempty"`
 }
 
 func (m *HandshakeMetadata) Reset()         { *m = HandshakeMetadata{} }
 func (m *HandshakeMetadata) String() string { return proto.CompactTextString(m) }
 func (*HandshakeMetadata) ProtoMessage()    {}
 func (*HandshakeMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8fd0dc67df6b10ed, []int{4}
+	return fileDescriptor_8fd0dc67df6b10ed, []int{8}
 }
 func (m *HandshakeMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -417,6 +697,13 @@ func (m *HandshakeMetadata) GetVersion() string {
 	return ""
 }
 
+func (m *HandshakeMetadata) GetPreviousChannelId() string {
+	if m != nil {
+		return m.PreviousChannelId
+	}
+	return ""
+}
+
 // ConsumerPacketData contains a consumer packet data and a type tag
 // that is compatible with ICS v1 and v2 over the wire. It is not used for internal storage.
 type ConsumerPacketDataV1 struct {
@@ -431,7 +718,7 @@ func (m *ConsumerPacketDataV1) Reset()         { *m = ConsumerPacketDataV1{} }
 func (m *ConsumerPacketDataV1) String() string { return proto.CompactTextString(m) }
 func (*ConsumerPacketDataV1) ProtoMessage()    {}
 func (*ConsumerPacketDataV1) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8fd0dc67df6b10ed, []int{5}
+	return fileDescriptor_8fd0dc67df6b10ed, []int{9}
 }
 func (m *ConsumerPacketDataV1) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -526,7 +813,7 @@ func (m *SlashPacketDataV1) Reset()         { *m = SlashPacketDataV1{} }
 func (m *SlashPacketDataV1) String() string { return proto.CompactTextString(m) }
 func (*SlashPacketDataV1) ProtoMessage()    {}
 func (*SlashPacketDataV1) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8fd0dc67df6b10ed, []int{6}
+	return fileDescriptor_8fd0dc67df6b10ed, []int{10}
 }
 func (m *SlashPacketDataV1) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -582,6 +869,10 @@ func init() {
 	proto.RegisterType((*ValidatorSetChangePacketData)(nil), "interchain_security.ccv.v1.ValidatorSetChangePacketData")
 	proto.RegisterType((*VSCMaturedPacketData)(nil), "interchain_security.ccv.v1.VSCMaturedPacketData")
 	proto.RegisterType((*SlashPacketData)(nil), "interchain_security.ccv.v1.SlashPacketData")
+	proto.RegisterType((*ConsumerShutdownPacketData)(nil), "interchain_security.ccv.v1.ConsumerShutdownPacketData")
+	proto.RegisterType((*ConsumerMisbehaviourPacketData)(nil), "interchain_security.ccv.v1.ConsumerMisbehaviourPacketData")
+	proto.RegisterType((*ConsumerHeartbeatPacketData)(nil), "interchain_security.ccv.v1.ConsumerHeartbeatPacketData")
+	proto.RegisterType((*ConsumerDoubleVotePacketData)(nil), "interchain_security.ccv.v1.ConsumerDoubleVotePacketData")
 	proto.RegisterType((*ConsumerPacketData)(nil), "interchain_security.ccv.v1.ConsumerPacketData")
 	proto.RegisterType((*HandshakeMetadata)(nil), "interchain_security.ccv.v1.HandshakeMetadata")
 	proto.RegisterType((*ConsumerPacketDataV1)(nil), "interchain_security.ccv.v1.ConsumerPacketDataV1")
@@ -593,60 +884,86 @@ func init() {
 }
 
 var fileDescriptor_8fd0dc67df6b10ed = []byte{
-	// 834 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0xcd, 0x6e, 0xdb, 0x46,
-	0x10, 0x26, 0x65, 0x23, 0xad, 0x57, 0x85, 0x4c, 0x33, 0x6a, 0xc0, 0x32, 0xad, 0x42, 0x10, 0x2d,
-	0x20, 0xb8, 0x08, 0x59, 0xc9, 0x01, 0x0a, 0xb4, 0x97, 0xea, 0x87, 0xae, 0xd9, 0xc6, 0xb2, 0x40,
-	0x4a, 0x0a, 0xd2, 0x0b, 0xb1, 0x22, 0xd7, 0xd2, 0x42, 0x12, 0x97, 0xe0, 0xae, 0x98, 0xea, 0x0d,
-	0x0a, 0x9d, 0xfa, 0x02, 0x3a, 0x15, 0x3d, 0xe4, 0x31, 0x7a, 0xcb, 0x31, 0x40, 0x2f, 0xb9, 0x34,
-	0x28, 0xec, 0x37, 0xe8, 0x13, 0x14, 0xa4, 0x7e, 0x2d, 0xd1, 0x06, 0x02, 0x14, 0x68, 0x6e, 0xe4,
-	0xec, 0x7c, 0xdf, 0xce, 0x7c, 0xdf, 0x2c, 0x06, 0x7c, 0x81, 0x7d, 0x86, 0x42, 0xb7, 0x0f, 0xb1,
-	0xef, 0x50, 0xe4, 0x8e, 0x43, 0xcc, 0x26, 0xba, 0xeb, 0x46, 0x7a, 0x54, 0xd2, 0x5f, 0xe0, 0x10,
-	0x69, 0x41, 0x48, 0x18, 0x11, 0xe5, 0x94, 0x34, 0xcd, 0x75, 0x23, 0x2d, 0x2a, 0xc9, 0x9f, 0xbb,
-	0x84, 0x8e, 0x08, 0xd5, 0x29, 0x83, 0x03, 0xec, 0xf7, 0xf4, 0xa8, 0xd4, 0x45, 0x0c, 0x96, 0x96,
-	0xff, 0x73, 0x06, 0x39, 0xdf, 0x23, 0x3d, 0x92, 0x7c, 0xea, 0xf1, 0xd7, 0x22, 0xfa, 0x90, 0x21,
-	0xdf, 0x43, 0xe1, 0x08, 0xfb, 0x4c, 0x87, 0x5d, 0x17, 0xeb, 0x6c, 0x12, 0x20, 0x3a, 0x3f, 0x54,
-	0xdf, 0xf0, 0xe0, 0xd3, 0x0e, 0x1c, 0x62, 0x0f, 0x32, 0x12, 0xda, 0x88, 0xd5, 0xfa, 0xd0, 0xef,
-	0xa1, 0x26, 0x74, 0x07, 0x88, 0xd5, 0x21, 0x83, 0x22, 0x01, 0x47, 0xd1, 0xf2, 0xdc, 0x19, 0x07,
-	0x1e, 0x64, 0x88, 0x4a, 0xbc, 0xb2, 0x57, 0xcc, 0x96, 0x15, 0x6d, 0xcd, 0xac, 0xc5, 0xcc, 0xda,
-	0x8a, 0xa9, 0x9d, 0x24, 0x56, 0x95, 0x57, 0x6f, 0x1f, 0x71, 0xff, 0xbc, 0x7d, 0x24, 0x4d, 0xe0,
-	0x68, 0xf8, 0x8d, 0xba, 0x43, 0xa4, 0x5a, 0x42, 0x74, 0x13, 0x42, 0xc5, 0x22, 0x88, 0x63, 0x14,
-	0xb1, 0x45, 0x92, 0x83, 0x3d, 0x29, 0xa3, 0xf0, 0xc5, 0x7d, 0x2b, 0x37, 0x8f, 0xcf, 0x13, 0x4d,
-	0x4f, 0xfc, 0x0c, 0x00, 0x3a, 0x84, 0xb4, 0xef, 0x40, 0x77, 0x40, 0xa5, 0x3d, 0x65, 0xaf, 0x78,
-	0x60, 0x1d, 0x24, 0x91, 0x8a, 0x3b, 0xa0, 0xea, 0x77, 0x20, 0xdf, 0xb1, 0x6b, 0xe7, 0x90, 0x8d,
-	0x43, 0xe4, 0x6d, 0x74, 0x94, 0x76, 0x01, 0x9f, 0x76, 0x81, 0xfa, 0x27, 0x0f, 0x0e, 0xed, 0x98,
-	0x6f, 0x03, 0x6d, 0x81, 0x83, 0x55, 0xc9, 0x09, 0x2c, 0x5b, 0x96, 0x6f, 0xd7, 0xa1, 0x2a, 0x2d,
-	0x14, 0x10, 0xb6, 0x14, 0x50, 0xad, 0x35, 0xcd, 0x3b, 0xb4, 0x5c, 0x05, 0x00, 0xfb, 0x97, 0x21,
-	0x74, 0x19, 0x26, 0xbe, 0xb4, 0xa7, 0xf0, 0xc5, 0x5c, 0x59, 0xd5, 0xe6, 0xc3, 0xa1, 0x2d, 0x87,
-	0x61, 0x31, 0x1c, 0x9a, 0xb9, 0xca, 0xb4, 0x36, 0x50, 0xea, 0xef, 0x19, 0x20, 0xd6, 0x88, 0x4f,
-	0xc7, 0x23, 0x14, 0x6e, 0x34, 0x76, 0x0a, 0xf6, 0xe3, 0xc1, 0x48, 0x7a, 0xca, 0x95, 0xcb, 0xda,
-	0xed, 0xd3, 0xa8, 0xed, 0xa2, 0x5b, 0x93, 0x00, 0x59, 0x09, 0x5e, 0x7c, 0x06, 0x0e, 0xe9, 0x4d,
-	0xcd, 0x92, 0x5e, 0xb2, 0xe5, 0x2f, 0xef, 0xa2, 0xdc, 0x92, 0xf9, 0x8c, 0xb3, 0xb6, 0x59, 0xc4,
-	0x4b, 0x90, 0x8f, 0xa8, 0xbb, 0xe3, 0x67, 0xa2, 0x42, 0xb6, 0xfc, 0xd5, 0x5d, 0xec, 0x69, 0x73,
-	0x70, 0xc6, 0x59, 0xa9, 0x7c, 0xd5, 0x7b, 0x60, 0xdf, 0x83, 0x0c, 0xaa, 0x5d, 0x70, 0x74, 0x06,
-	0x7d, 0x8f, 0xf6, 0xe1, 0x00, 0x9d, 0x23, 0x06, 0xe3, 0xa0, 0x78, 0x02, 0x1e, 0x04, 0x21, 0x89,
-	0xb0, 0x87, 0x42, 0xe7, 0x12, 0x21, 0x27, 0x20, 0x64, 0xe8, 0x40, 0xcf, 0x9b, 0xcf, 0xc2, 0x81,
-	0x75, 0x7f, 0x79, 0x7a, 0x8a, 0x50, 0x93, 0x90, 0x61, 0xc5, 0xf3, 0x42, 0x51, 0x02, 0x1f, 0x44,
-	0x28, 0xa4, 0xb1, 0x65, 0x99, 0x24, 0x6b, 0xf9, 0xab, 0xbe, 0xcc, 0x80, 0xfc, 0xae, 0x9a, 0x9d,
-	0xd2, 0x7f, 0xe6, 0xc6, 0xf3, 0xdb, 0xdc, 0x78, 0xfc, 0x0e, 0x6e, 0x74, 0x4a, 0xef, 0x83, 0x1f,
-	0x7f, 0xf1, 0xe0, 0x68, 0xa7, 0xb0, 0xff, 0xf9, 0x3d, 0xfe, 0x90, 0xf2, 0x1e, 0x8f, 0xef, 0xea,
-	0x7c, 0xfd, 0x26, 0x13, 0x93, 0x36, 0xd0, 0xc7, 0x7f, 0xf0, 0xe0, 0x41, 0xba, 0x97, 0xe2, 0xb7,
-	0x40, 0xa9, 0x5d, 0x34, 0xec, 0xf6, 0xb9, 0x61, 0x39, 0xcd, 0x4a, 0xed, 0x47, 0xa3, 0xe5, 0xb4,
-	0x9e, 0x37, 0x0d, 0xa7, 0xdd, 0xb0, 0x9b, 0x46, 0xcd, 0x3c, 0x35, 0x8d, 0xba, 0xc0, 0xc9, 0x1f,
-	0x4f, 0x67, 0xca, 0x51, 0xdb, 0xa7, 0x01, 0x72, 0xf1, 0x25, 0x5e, 0x6a, 0x28, 0xea, 0x40, 0x4e,
-	0x05, 0xdb, 0x4f, 0x2b, 0xf6, 0x99, 0xc0, 0xcb, 0x87, 0xd3, 0x99, 0x92, 0xdd, 0x10, 0x56, 0x3c,
-	0x01, 0x9f, 0xa4, 0x02, 0x62, 0xd7, 0x84, 0x8c, 0x9c, 0x9f, 0xce, 0x14, 0xa1, 0xb3, 0xe5, 0x94,
-	0xbc, 0xff, 0xcb, 0x6f, 0x05, 0xee, 0xf8, 0x25, 0x0f, 0x72, 0x37, 0x5b, 0x14, 0x9f, 0x80, 0x87,
-	0x66, 0xe3, 0xd4, 0xaa, 0xd4, 0x5a, 0xe6, 0x45, 0x23, 0xad, 0xec, 0xfb, 0xd3, 0x99, 0x72, 0xb8,
-	0x06, 0x19, 0xa3, 0x80, 0x4d, 0x44, 0x7d, 0x17, 0x55, 0xbf, 0x68, 0x57, 0x9f, 0x1a, 0x8e, 0x6d,
-	0x7e, 0xdf, 0x10, 0x78, 0x39, 0x37, 0x9d, 0x29, 0xa0, 0x4e, 0xc6, 0xdd, 0x21, 0xb2, 0x71, 0xcf,
-	0x17, 0x8f, 0x81, 0xb4, 0x0b, 0x78, 0xd6, 0x68, 0x99, 0xe7, 0x86, 0x90, 0x91, 0x3f, 0x9a, 0xce,
-	0x94, 0x0f, 0xeb, 0xe4, 0x85, 0xcf, 0xf0, 0x08, 0xcd, 0x6b, 0xad, 0x36, 0x5e, 0x5d, 0x15, 0xf8,
-	0xd7, 0x57, 0x05, 0xfe, 0xef, 0xab, 0x02, 0xff, 0xeb, 0x75, 0x81, 0x7b, 0x7d, 0x5d, 0xe0, 0xde,
-	0x5c, 0x17, 0xb8, 0x9f, 0x9e, 0xf4, 0x30, 0xeb, 0x8f, 0xbb, 0x9a, 0x4b, 0x46, 0xfa, 0x62, 0xf1,
-	0xae, 0x2d, 0x7d, 0xbc, 0x5a, 0xe1, 0xd1, 0xd7, 0xfa, 0xcf, 0xc9, 0x1e, 0x4f, 0x16, 0x6a, 0xf7,
-	0x5e, 0xb2, 0x51, 0x4f, 0xfe, 0x0d, 0x00, 0x00, 0xff, 0xff, 0xac, 0x81, 0x69, 0x45, 0xef, 0x07,
-	0x00, 0x00,
+	// 1258 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0x16, 0x2d, 0xc7, 0xa9, 0xd7, 0x81, 0x2d, 0xaf, 0x9d, 0x84, 0xa5, 0x1d, 0x85, 0x25, 0x5a,
+	0xd4, 0x70, 0x1b, 0xb2, 0x52, 0x82, 0xa6, 0x48, 0x51, 0xb4, 0xfa, 0xa1, 0x4b, 0x35, 0xb1, 0x24,
+	0x50, 0x3f, 0x41, 0x7a, 0x21, 0x56, 0xcb, 0xb5, 0x44, 0x48, 0x22, 0x05, 0x72, 0xc5, 0x44, 0x28,
+	0xd0, 0x53, 0x0f, 0x85, 0x4e, 0x45, 0xef, 0x3a, 0xe5, 0x94, 0x37, 0x49, 0x81, 0x1e, 0x02, 0xf4,
+	0x92, 0x4b, 0x83, 0x22, 0x79, 0x83, 0x3e, 0x41, 0x41, 0x8a, 0x94, 0x69, 0x8b, 0x52, 0x9d, 0xa2,
+	0x40, 0x6e, 0xe2, 0xce, 0x7c, 0xdf, 0xcc, 0xce, 0xcc, 0x37, 0x14, 0xc1, 0x47, 0x86, 0x49, 0x89,
+	0x8d, 0x3b, 0xc8, 0x30, 0x35, 0x87, 0xe0, 0xa1, 0x6d, 0xd0, 0x91, 0x84, 0xb1, 0x2b, 0xb9, 0x19,
+	0xe9, 0xb1, 0x61, 0x13, 0x71, 0x60, 0x5b, 0xd4, 0x82, 0x5c, 0x8c, 0x9b, 0x88, 0xb1, 0x2b, 0xba,
+	0x19, 0xee, 0x43, 0x6c, 0x39, 0x7d, 0xcb, 0x91, 0x1c, 0x8a, 0xba, 0x86, 0xd9, 0x96, 0xdc, 0x4c,
+	0x8b, 0x50, 0x94, 0x09, 0x9f, 0xa7, 0x0c, 0xdc, 0x6e, 0xdb, 0x6a, 0x5b, 0xfe, 0x4f, 0xc9, 0xfb,
+	0x15, 0x9c, 0xee, 0x51, 0x62, 0xea, 0xc4, 0xee, 0x1b, 0x26, 0x95, 0x50, 0x0b, 0x1b, 0x12, 0x1d,
+	0x0d, 0x88, 0x13, 0x18, 0xf7, 0x23, 0x46, 0x6c, 0x8f, 0x06, 0xd4, 0x92, 0xba, 0x64, 0x14, 0x5a,
+	0x6f, 0x46, 0xac, 0x3e, 0x4a, 0x22, 0xae, 0xa1, 0x13, 0x13, 0x07, 0x39, 0x73, 0x92, 0xd1, 0xc2,
+	0x52, 0xcf, 0x68, 0x77, 0x28, 0xee, 0x19, 0xc4, 0xa4, 0x8e, 0x14, 0x41, 0xb8, 0x99, 0xc8, 0xd3,
+	0x14, 0x20, 0xbc, 0x64, 0xc0, 0x7e, 0x13, 0xf5, 0x0c, 0x1d, 0x51, 0xcb, 0xae, 0x11, 0x5a, 0xe8,
+	0x20, 0xb3, 0x4d, 0xaa, 0x08, 0x77, 0x09, 0x2d, 0x22, 0x8a, 0xa0, 0x05, 0xb6, 0xdd, 0xd0, 0xae,
+	0x0d, 0x07, 0x3a, 0xa2, 0xc4, 0x61, 0x19, 0x3e, 0x79, 0xb0, 0x91, 0xe5, 0xc5, 0x08, 0x9d, 0x77,
+	0x13, 0x71, 0xc6, 0xd4, 0xf0, 0x1d, 0xf3, 0xfc, 0xf3, 0x57, 0x37, 0x13, 0x7f, 0xbf, 0xba, 0xc9,
+	0x8e, 0x50, 0xbf, 0x77, 0x4f, 0x98, 0x23, 0x12, 0xd4, 0x94, 0x7b, 0x16, 0xe2, 0xc0, 0x03, 0xe0,
+	0x9d, 0x39, 0x84, 0x06, 0x4e, 0x9a, 0xa1, 0xb3, 0x2b, 0x3c, 0x73, 0xb0, 0xaa, 0x6e, 0x4e, 0xcf,
+	0xa7, 0x8e, 0x25, 0x1d, 0xde, 0x00, 0xc0, 0xe9, 0x21, 0xa7, 0xa3, 0x21, 0xdc, 0x75, 0xd8, 0x24,
+	0x9f, 0x3c, 0x58, 0x57, 0xd7, 0xfd, 0x93, 0x1c, 0xee, 0x3a, 0xc2, 0x37, 0x60, 0xb7, 0x59, 0x2b,
+	0x1c, 0x23, 0x3a, 0xb4, 0x89, 0x1e, 0xb9, 0x51, 0x5c, 0x00, 0x26, 0x2e, 0x80, 0xf0, 0x07, 0x03,
+	0xb6, 0x6a, 0x1e, 0x5f, 0x04, 0xad, 0x82, 0xf5, 0x59, 0xca, 0x3e, 0x6c, 0x23, 0xcb, 0x2d, 0xae,
+	0x43, 0x9e, 0x0d, 0x2a, 0x90, 0x3a, 0x57, 0x01, 0x41, 0x3d, 0xa5, 0x79, 0x8b, 0x2b, 0xe7, 0x01,
+	0x30, 0xcc, 0x13, 0x1b, 0x61, 0x6a, 0x58, 0x26, 0x9b, 0xe4, 0x99, 0x83, 0xcd, 0xac, 0x20, 0x4e,
+	0x87, 0x51, 0x0c, 0x87, 0x2f, 0x18, 0x46, 0xb1, 0x34, 0xf3, 0x54, 0x23, 0x28, 0xe1, 0x08, 0x70,
+	0x05, 0xcb, 0x74, 0x86, 0x7d, 0x62, 0xd7, 0x3a, 0x43, 0xaa, 0x5b, 0x8f, 0xcd, 0xff, 0x54, 0x1d,
+	0x1b, 0xa4, 0x43, 0x9e, 0x63, 0xc3, 0x69, 0x91, 0x0e, 0x72, 0x0d, 0x6b, 0x68, 0x47, 0xb8, 0xaa,
+	0xe0, 0x4a, 0x3f, 0x62, 0x09, 0xca, 0xf5, 0xa9, 0x68, 0xb4, 0xb0, 0x18, 0x1d, 0xd2, 0x68, 0xfd,
+	0xdc, 0x8c, 0x18, 0x65, 0x53, 0xcf, 0x30, 0x08, 0x65, 0xb0, 0x17, 0xc6, 0x54, 0x08, 0xb2, 0x69,
+	0x8b, 0x20, 0x1a, 0x09, 0x28, 0x81, 0x9d, 0xd3, 0x19, 0x43, 0xba, 0x6e, 0x13, 0xc7, 0x09, 0xc6,
+	0xf5, 0x8a, 0x0a, 0x67, 0xa6, 0x5c, 0x68, 0x11, 0x7e, 0x63, 0xc0, 0x7e, 0x48, 0x58, 0xb4, 0x86,
+	0xad, 0x1e, 0x69, 0x5a, 0x34, 0x3a, 0xfe, 0x1a, 0xb8, 0xae, 0x0f, 0x07, 0x3d, 0x03, 0x7b, 0xa5,
+	0x70, 0x2d, 0x4a, 0xb4, 0x50, 0x71, 0xc1, 0x6d, 0x3e, 0x8e, 0x26, 0x3f, 0x55, 0x72, 0x31, 0x04,
+	0x78, 0x5c, 0x72, 0xe0, 0xae, 0x5e, 0xd5, 0xe3, 0x8e, 0xa1, 0x12, 0xd5, 0xd7, 0x60, 0xd8, 0xd2,
+	0xba, 0x64, 0xe4, 0x37, 0x7f, 0x23, 0xbb, 0x1f, 0xa5, 0x9e, 0x2e, 0x03, 0xb1, 0x3a, 0x6c, 0xf5,
+	0x0c, 0x7c, 0x9f, 0x8c, 0xd4, 0xad, 0x19, 0xac, 0x3a, 0x6c, 0xdd, 0x27, 0x23, 0xe1, 0xe9, 0x1a,
+	0x80, 0xe1, 0x5d, 0x22, 0x37, 0x38, 0x02, 0xab, 0x5e, 0x5a, 0x7e, 0xba, 0x9b, 0xd9, 0xac, 0xb8,
+	0x78, 0xab, 0x89, 0xf3, 0xe8, 0xfa, 0x68, 0x40, 0x54, 0x1f, 0x0f, 0x1f, 0x82, 0x2d, 0xe7, 0xac,
+	0x16, 0x82, 0x34, 0x3f, 0x59, 0x46, 0x79, 0x4e, 0x3e, 0x4a, 0x42, 0x3d, 0xcf, 0x02, 0x4f, 0xc0,
+	0xae, 0xeb, 0xe0, 0x39, 0x9d, 0xfa, 0xd3, 0xbd, 0x91, 0xfd, 0x6c, 0x19, 0x7b, 0x9c, 0xbe, 0x95,
+	0x84, 0x1a, 0xcb, 0x07, 0x9f, 0x00, 0x0e, 0x2f, 0x9c, 0x7b, 0x76, 0xd5, 0x8f, 0xf6, 0xf9, 0x45,
+	0xca, 0x33, 0x8f, 0x56, 0x12, 0xea, 0x12, 0x6e, 0xf8, 0x13, 0x03, 0xd2, 0x78, 0xa9, 0x54, 0xd8,
+	0x4b, 0x7e, 0xf8, 0x7b, 0x17, 0x09, 0x1f, 0xcf, 0xa0, 0x24, 0xd4, 0x7f, 0x89, 0x01, 0x7f, 0x00,
+	0x7b, 0x78, 0xb1, 0x78, 0xd8, 0x35, 0x3f, 0x85, 0xbb, 0x17, 0x49, 0x21, 0x06, 0xae, 0x24, 0xd4,
+	0x65, 0xec, 0xf0, 0x47, 0xb0, 0x8f, 0x97, 0x08, 0x8d, 0xbd, 0xec, 0x47, 0xff, 0xe2, 0x22, 0xd1,
+	0xe3, 0xf0, 0x4a, 0x42, 0x5d, 0xca, 0x9f, 0x5f, 0x03, 0xab, 0x3a, 0xa2, 0x48, 0xf8, 0x95, 0x01,
+	0xdb, 0x0a, 0x32, 0x75, 0xa7, 0x83, 0xba, 0xe4, 0x98, 0x50, 0xe4, 0x9d, 0xc2, 0xdb, 0xe0, 0xda,
+	0xc0, 0xb6, 0x3c, 0x4d, 0xda, 0xda, 0x09, 0x21, 0xda, 0xc0, 0xb2, 0x7a, 0xfe, 0x02, 0xf1, 0x65,
+	0xb3, 0xae, 0xee, 0x84, 0xd6, 0x23, 0x42, 0xaa, 0x96, 0xd5, 0xf3, 0x36, 0x08, 0x64, 0xc1, 0x65,
+	0x97, 0xd8, 0x8e, 0xb7, 0x89, 0x57, 0x7c, 0xaf, 0xf0, 0x11, 0x8a, 0x60, 0x67, 0x60, 0x13, 0xaf,
+	0xfe, 0x8e, 0x86, 0x3b, 0xc8, 0x34, 0x49, 0xcf, 0xdb, 0xa3, 0x49, 0xdf, 0x6b, 0x3b, 0x34, 0x15,
+	0xa6, 0x96, 0x92, 0x2e, 0x3c, 0x5b, 0x01, 0xbb, 0xf3, 0xe2, 0x6b, 0x66, 0xfe, 0x37, 0xf1, 0x3e,
+	0x5a, 0x24, 0xde, 0x5b, 0x6f, 0x21, 0xde, 0x66, 0xe6, 0x1d, 0xca, 0x77, 0xd6, 0xc0, 0x3f, 0x19,
+	0xb0, 0x3d, 0x97, 0xd8, 0x3b, 0x7e, 0x2d, 0x7f, 0x17, 0xf3, 0x5a, 0x3e, 0x5c, 0x76, 0xf3, 0xd3,
+	0x57, 0xb3, 0xdf, 0xa4, 0x08, 0xfa, 0xf0, 0xf7, 0x24, 0xb8, 0x16, 0xdf, 0x4b, 0xf8, 0x25, 0xe0,
+	0x0b, 0x95, 0x72, 0xad, 0x71, 0x2c, 0xab, 0x5a, 0x35, 0x57, 0xb8, 0x2f, 0xd7, 0xb5, 0xfa, 0xa3,
+	0xaa, 0xac, 0x35, 0xca, 0xb5, 0xaa, 0x5c, 0x28, 0x1d, 0x95, 0xe4, 0x62, 0x2a, 0xc1, 0x5d, 0x1d,
+	0x4f, 0xf8, 0xed, 0x86, 0xe9, 0x0c, 0x08, 0x36, 0x4e, 0x8c, 0xb0, 0x86, 0x50, 0x02, 0x5c, 0x2c,
+	0xb8, 0xf6, 0x20, 0x57, 0x53, 0x52, 0x0c, 0xb7, 0x35, 0x9e, 0xf0, 0x1b, 0x91, 0xc2, 0xc2, 0xdb,
+	0xe0, 0xfd, 0x58, 0x80, 0xd7, 0xb5, 0xd4, 0x0a, 0xb7, 0x3b, 0x9e, 0xf0, 0xa9, 0xe6, 0xb9, 0x4e,
+	0xc1, 0xaf, 0xc0, 0x8d, 0xf8, 0x28, 0x4a, 0xa3, 0x5e, 0xac, 0x3c, 0x2c, 0xa7, 0x92, 0x1c, 0x37,
+	0x9e, 0xf0, 0xd7, 0xe2, 0x77, 0x29, 0x94, 0xc1, 0x07, 0xb1, 0xf0, 0xe3, 0x52, 0x2d, 0x2f, 0x2b,
+	0xb9, 0x66, 0xa9, 0xd2, 0x50, 0x53, 0xab, 0x5c, 0x7a, 0x3c, 0xe1, 0xb9, 0xc5, 0xfb, 0x10, 0x7e,
+	0x0d, 0xd2, 0xb1, 0x34, 0x8a, 0x9c, 0x53, 0xeb, 0x79, 0x39, 0x57, 0x4f, 0x5d, 0xe2, 0xf6, 0xc6,
+	0x13, 0xfe, 0xfa, 0x82, 0x85, 0x06, 0xf3, 0x0b, 0x2a, 0x5d, 0xac, 0x34, 0xf2, 0x0f, 0x64, 0xad,
+	0x59, 0xa9, 0xcb, 0xa9, 0x35, 0x6e, 0x7f, 0x3c, 0xe1, 0xd9, 0x45, 0x5b, 0x89, 0x5b, 0xfd, 0xf9,
+	0x69, 0x3a, 0x71, 0xf8, 0x8c, 0x01, 0x9b, 0x67, 0xbb, 0x0d, 0xef, 0x80, 0xbd, 0x52, 0xf9, 0x48,
+	0xcd, 0x15, 0xea, 0xa5, 0x4a, 0x39, 0xae, 0x83, 0x3b, 0xe3, 0x09, 0xbf, 0x75, 0x0a, 0x92, 0xfb,
+	0x03, 0x3a, 0x82, 0xd2, 0x3c, 0x2a, 0xc8, 0xa6, 0x56, 0xfa, 0xb6, 0x9c, 0x62, 0xb8, 0xcd, 0xf1,
+	0x84, 0x07, 0xd3, 0x2c, 0x6a, 0x46, 0xdb, 0x84, 0x87, 0x80, 0x9d, 0x07, 0x3c, 0x2c, 0xd7, 0x4b,
+	0xc7, 0x72, 0x6a, 0x85, 0xbb, 0x32, 0x9e, 0xf0, 0xef, 0x15, 0xad, 0xc7, 0x26, 0x35, 0xfa, 0x64,
+	0x9a, 0x6b, 0xbe, 0xfc, 0xfc, 0x75, 0x9a, 0x79, 0xf1, 0x3a, 0xcd, 0xfc, 0xf5, 0x3a, 0xcd, 0xfc,
+	0xf2, 0x26, 0x9d, 0x78, 0xf1, 0x26, 0x9d, 0x78, 0xf9, 0x26, 0x9d, 0xf8, 0xfe, 0x4e, 0xdb, 0xa0,
+	0x9d, 0x61, 0x4b, 0xc4, 0x56, 0x5f, 0x0a, 0x3e, 0x7d, 0x4e, 0xa7, 0xfb, 0xd6, 0xec, 0x23, 0xca,
+	0xbd, 0x2b, 0x3d, 0xf1, 0xbf, 0xa4, 0xfc, 0x3f, 0x42, 0xad, 0x35, 0xff, 0x1b, 0xe3, 0xf6, 0x3f,
+	0x03, 0x00, 0x1d, 0xae, 0x29, 0xda, 0x71, 0x0d, 0x00, 0x00,
 }
 
 func (m *ValidatorSetChangePacketData) Marshal() (dAtA []byte, err error) {
@@ -771,7 +1088,7 @@ func (m *SlashPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ConsumerPacketData) Marshal() (dAtA []byte, err error) {
+func (m *ConsumerShutdownPacketData) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -781,43 +1098,47 @@ func (m *ConsumerPacketData) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ConsumerPacketData) MarshalTo(dAtA []byte) (int, error) {
+func (m *ConsumerShutdownPacketData) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ConsumerPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ConsumerShutdownPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Data != nil {
-		{
-			size := m.Data.Size()
-			i -= size
-			if _, err := m.Data.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-		}
-	}
-	if m.Type != 0 {
-		i = encodeVarintWire(dAtA, i, uint64(m.Type))
+	if m.ValsetUpdateId != 0 {
+		i = encodeVarintWire(dAtA, i, uint64(m.ValsetUpdateId))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ConsumerPacketData_SlashPacketData) MarshalTo(dAtA []byte) (int, error) {
+func (m *ConsumerMisbehaviourPacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerMisbehaviourPacketData) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ConsumerPacketData_SlashPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ConsumerMisbehaviourPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.SlashPacketData != nil {
+	_ = i
+	var l int
+	_ = l
+	if m.Misbehaviour != nil {
 		{
-			size, err := m.SlashPacketData.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Misbehaviour.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -825,32 +1146,44 @@ func (m *ConsumerPacketData_SlashPacketData) MarshalToSizedBuffer(dAtA []byte) (
 			i = encodeVarintWire(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
-func (m *ConsumerPacketData_VscMaturedPacketData) MarshalTo(dAtA []byte) (int, error) {
+
+func (m *ConsumerHeartbeatPacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerHeartbeatPacketData) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ConsumerPacketData_VscMaturedPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ConsumerHeartbeatPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.VscMaturedPacketData != nil {
-		{
-			size, err := m.VscMaturedPacketData.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintWire(dAtA, i, uint64(size))
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValidatorAddresses) > 0 {
+		for iNdEx := len(m.ValidatorAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ValidatorAddresses[iNdEx])
+			copy(dAtA[i:], m.ValidatorAddresses[iNdEx])
+			i = encodeVarintWire(dAtA, i, uint64(len(m.ValidatorAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
 		}
-		i--
-		dAtA[i] = 0x1a
 	}
 	return len(dAtA) - i, nil
 }
-func (m *HandshakeMetadata) Marshal() (dAtA []byte, err error) {
+
+func (m *ConsumerDoubleVotePacketData) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -860,34 +1193,44 @@ func (m *HandshakeMetadata) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *HandshakeMetadata) MarshalTo(dAtA []byte) (int, error) {
+func (m *ConsumerDoubleVotePacketData) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *HandshakeMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ConsumerDoubleVotePacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Version) > 0 {
-		i -= len(m.Version)
-		copy(dAtA[i:], m.Version)
-		i = encodeVarintWire(dAtA, i, uint64(len(m.Version)))
+	if m.ValidatorPubKey != nil {
+		{
+			size, err := m.ValidatorPubKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintWire(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.ProviderFeePoolAddr) > 0 {
-		i -= len(m.ProviderFeePoolAddr)
-		copy(dAtA[i:], m.ProviderFeePoolAddr)
-		i = encodeVarintWire(dAtA, i, uint64(len(m.ProviderFeePoolAddr)))
+	if m.DuplicateVoteEvidence != nil {
+		{
+			size, err := m.DuplicateVoteEvidence.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintWire(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ConsumerPacketDataV1) Marshal() (dAtA []byte, err error) {
+func (m *ConsumerPacketData) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -897,12 +1240,12 @@ func (m *ConsumerPacketDataV1) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ConsumerPacketDataV1) MarshalTo(dAtA []byte) (int, error) {
+func (m *ConsumerPacketData) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ConsumerPacketDataV1) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ConsumerPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -924,12 +1267,12 @@ func (m *ConsumerPacketDataV1) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ConsumerPacketDataV1_SlashPacketData) MarshalTo(dAtA []byte) (int, error) {
+func (m *ConsumerPacketData_SlashPacketData) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ConsumerPacketDataV1_SlashPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ConsumerPacketData_SlashPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	if m.SlashPacketData != nil {
 		{
@@ -945,12 +1288,12 @@ func (m *ConsumerPacketDataV1_SlashPacketData) MarshalToSizedBuffer(dAtA []byte)
 	}
 	return len(dAtA) - i, nil
 }
-func (m *ConsumerPacketDataV1_VscMaturedPacketData) MarshalTo(dAtA []byte) (int, error) {
+func (m *ConsumerPacketData_VscMaturedPacketData) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ConsumerPacketDataV1_VscMaturedPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ConsumerPacketData_VscMaturedPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	if m.VscMaturedPacketData != nil {
 		{
@@ -966,22 +1309,229 @@ func (m *ConsumerPacketDataV1_VscMaturedPacketData) MarshalToSizedBuffer(dAtA []
 	}
 	return len(dAtA) - i, nil
 }
-func (m *SlashPacketDataV1) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *SlashPacketDataV1) MarshalTo(dAtA []byte) (int, error) {
+func (m *ConsumerPacketData_ConsumerShutdownPacketData) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SlashPacketDataV1) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ConsumerPacketData_ConsumerShutdownPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ConsumerShutdownPacketData != nil {
+		{
+			size, err := m.ConsumerShutdownPacketData.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintWire(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	return len(dAtA) - i, nil
+}
+func (m *ConsumerPacketData_ConsumerMisbehaviourPacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerPacketData_ConsumerMisbehaviourPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ConsumerMisbehaviourPacketData != nil {
+		{
+			size, err := m.ConsumerMisbehaviourPacketData.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintWire(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *ConsumerPacketData_ConsumerHeartbeatPacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerPacketData_ConsumerHeartbeatPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ConsumerHeartbeatPacketData != nil {
+		{
+			size, err := m.ConsumerHeartbeatPacketData.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintWire(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	return len(dAtA) - i, nil
+}
+func (m *ConsumerPacketData_ConsumerDoubleVotePacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerPacketData_ConsumerDoubleVotePacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ConsumerDoubleVotePacketData != nil {
+		{
+			size, err := m.ConsumerDoubleVotePacketData.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintWire(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *HandshakeMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HandshakeMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HandshakeMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PreviousChannelId) > 0 {
+		i -= len(m.PreviousChannelId)
+		copy(dAtA[i:], m.PreviousChannelId)
+		i = encodeVarintWire(dAtA, i, uint64(len(m.PreviousChannelId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintWire(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProviderFeePoolAddr) > 0 {
+		i -= len(m.ProviderFeePoolAddr)
+		copy(dAtA[i:], m.ProviderFeePoolAddr)
+		i = encodeVarintWire(dAtA, i, uint64(len(m.ProviderFeePoolAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumerPacketDataV1) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConsumerPacketDataV1) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerPacketDataV1) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Data != nil {
+		{
+			size := m.Data.Size()
+			i -= size
+			if _, err := m.Data.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if m.Type != 0 {
+		i = encodeVarintWire(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConsumerPacketDataV1_SlashPacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerPacketDataV1_SlashPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.SlashPacketData != nil {
+		{
+			size, err := m.SlashPacketData.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintWire(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *ConsumerPacketDataV1_VscMaturedPacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConsumerPacketDataV1_VscMaturedPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.VscMaturedPacketData != nil {
+		{
+			size, err := m.VscMaturedPacketData.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintWire(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *SlashPacketDataV1) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SlashPacketDataV1) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SlashPacketDataV1) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1073,63 +1623,64 @@ func (m *SlashPacketData) Size() (n int) {
 	return n
 }
 
-func (m *ConsumerPacketData) Size() (n int) {
+func (m *ConsumerShutdownPacketData) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Type != 0 {
-		n += 1 + sovWire(uint64(m.Type))
-	}
-	if m.Data != nil {
-		n += m.Data.Size()
+	if m.ValsetUpdateId != 0 {
+		n += 1 + sovWire(uint64(m.ValsetUpdateId))
 	}
 	return n
 }
 
-func (m *ConsumerPacketData_SlashPacketData) Size() (n int) {
+func (m *ConsumerMisbehaviourPacketData) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.SlashPacketData != nil {
-		l = m.SlashPacketData.Size()
+	if m.Misbehaviour != nil {
+		l = m.Misbehaviour.Size()
 		n += 1 + l + sovWire(uint64(l))
 	}
 	return n
 }
-func (m *ConsumerPacketData_VscMaturedPacketData) Size() (n int) {
+
+func (m *ConsumerHeartbeatPacketData) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.VscMaturedPacketData != nil {
-		l = m.VscMaturedPacketData.Size()
-		n += 1 + l + sovWire(uint64(l))
+	if len(m.ValidatorAddresses) > 0 {
+		for _, b := range m.ValidatorAddresses {
+			l = len(b)
+			n += 1 + l + sovWire(uint64(l))
+		}
 	}
 	return n
 }
-func (m *HandshakeMetadata) Size() (n int) {
+
+func (m *ConsumerDoubleVotePacketData) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.ProviderFeePoolAddr)
-	if l > 0 {
+	if m.DuplicateVoteEvidence != nil {
+		l = m.DuplicateVoteEvidence.Size()
 		n += 1 + l + sovWire(uint64(l))
 	}
-	l = len(m.Version)
-	if l > 0 {
+	if m.ValidatorPubKey != nil {
+		l = m.ValidatorPubKey.Size()
 		n += 1 + l + sovWire(uint64(l))
 	}
 	return n
 }
 
-func (m *ConsumerPacketDataV1) Size() (n int) {
+func (m *ConsumerPacketData) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1144,7 +1695,7 @@ func (m *ConsumerPacketDataV1) Size() (n int) {
 	return n
 }
 
-func (m *ConsumerPacketDataV1_SlashPacketData) Size() (n int) {
+func (m *ConsumerPacketData_SlashPacketData) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1156,7 +1707,7 @@ func (m *ConsumerPacketDataV1_SlashPacketData) Size() (n int) {
 	}
 	return n
 }
-func (m *ConsumerPacketDataV1_VscMaturedPacketData) Size() (n int) {
+func (m *ConsumerPacketData_VscMaturedPacketData) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1168,30 +1719,138 @@ func (m *ConsumerPacketDataV1_VscMaturedPacketData) Size() (n int) {
 	}
 	return n
 }
-func (m *SlashPacketDataV1) Size() (n int) {
+func (m *ConsumerPacketData_ConsumerShutdownPacketData) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Validator.Size()
-	n += 1 + l + sovWire(uint64(l))
-	if m.ValsetUpdateId != 0 {
-		n += 1 + sovWire(uint64(m.ValsetUpdateId))
-	}
-	if m.Infraction != 0 {
-		n += 1 + sovWire(uint64(m.Infraction))
+	if m.ConsumerShutdownPacketData != nil {
+		l = m.ConsumerShutdownPacketData.Size()
+		n += 1 + l + sovWire(uint64(l))
 	}
 	return n
 }
-
-func sovWire(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *ConsumerPacketData_ConsumerMisbehaviourPacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ConsumerMisbehaviourPacketData != nil {
+		l = m.ConsumerMisbehaviourPacketData.Size()
+		n += 1 + l + sovWire(uint64(l))
+	}
+	return n
 }
-func sozWire(x uint64) (n int) {
-	return sovWire(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *ConsumerPacketData_ConsumerHeartbeatPacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ConsumerHeartbeatPacketData != nil {
+		l = m.ConsumerHeartbeatPacketData.Size()
+		n += 1 + l + sovWire(uint64(l))
+	}
+	return n
 }
-func (m *ValidatorSetChangePacketData) Unmarshal(dAtA []byte) error {
+func (m *ConsumerPacketData_ConsumerDoubleVotePacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ConsumerDoubleVotePacketData != nil {
+		l = m.ConsumerDoubleVotePacketData.Size()
+		n += 1 + l + sovWire(uint64(l))
+	}
+	return n
+}
+func (m *HandshakeMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProviderFeePoolAddr)
+	if l > 0 {
+		n += 1 + l + sovWire(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovWire(uint64(l))
+	}
+	l = len(m.PreviousChannelId)
+	if l > 0 {
+		n += 1 + l + sovWire(uint64(l))
+	}
+	return n
+}
+
+func (m *ConsumerPacketDataV1) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovWire(uint64(m.Type))
+	}
+	if m.Data != nil {
+		n += m.Data.Size()
+	}
+	return n
+}
+
+func (m *ConsumerPacketDataV1_SlashPacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SlashPacketData != nil {
+		l = m.SlashPacketData.Size()
+		n += 1 + l + sovWire(uint64(l))
+	}
+	return n
+}
+func (m *ConsumerPacketDataV1_VscMaturedPacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VscMaturedPacketData != nil {
+		l = m.VscMaturedPacketData.Size()
+		n += 1 + l + sovWire(uint64(l))
+	}
+	return n
+}
+func (m *SlashPacketDataV1) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Validator.Size()
+	n += 1 + l + sovWire(uint64(l))
+	if m.ValsetUpdateId != 0 {
+		n += 1 + sovWire(uint64(m.ValsetUpdateId))
+	}
+	if m.Infraction != 0 {
+		n += 1 + sovWire(uint64(m.Infraction))
+	}
+	return n
+}
+
+func sovWire(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozWire(x uint64) (n int) {
+	return sovWire(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ValidatorSetChangePacketData) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1273,11 +1932,352 @@ func (m *ValidatorSetChangePacketData) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 3:
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashAcks", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWire
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWire
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SlashAcks = append(m.SlashAcks, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWire(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWire
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VSCMaturedPacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWire
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VSCMaturedPacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VSCMaturedPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValsetUpdateId", wireType)
+			}
+			m.ValsetUpdateId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValsetUpdateId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWire(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWire
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SlashPacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWire
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SlashPacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SlashPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWire
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWire
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Validator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValsetUpdateId", wireType)
+			}
+			m.ValsetUpdateId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValsetUpdateId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Infraction", wireType)
+			}
+			m.Infraction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Infraction |= types1.Infraction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWire(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWire
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsumerShutdownPacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWire
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerShutdownPacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerShutdownPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValsetUpdateId", wireType)
+			}
+			m.ValsetUpdateId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValsetUpdateId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipWire(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthWire
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsumerMisbehaviourPacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowWire
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsumerMisbehaviourPacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsumerMisbehaviourPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SlashAcks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Misbehaviour", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWire
@@ -1287,23 +2287,27 @@ func (m *ValidatorSetChangePacketData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthWire
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthWire
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SlashAcks = append(m.SlashAcks, string(dAtA[iNdEx:postIndex]))
+			if m.Misbehaviour == nil {
+				m.Misbehaviour = &_07_tendermint.Misbehaviour{}
+			}
+			if err := m.Misbehaviour.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1326,7 +2330,7 @@ func (m *ValidatorSetChangePacketData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *VSCMaturedPacketData) Unmarshal(dAtA []byte) error {
+func (m *ConsumerHeartbeatPacketData) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1349,17 +2353,17 @@ func (m *VSCMaturedPacketData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: VSCMaturedPacketData: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConsumerHeartbeatPacketData: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VSCMaturedPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConsumerHeartbeatPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValsetUpdateId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddresses", wireType)
 			}
-			m.ValsetUpdateId = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWire
@@ -1369,11 +2373,24 @@ func (m *VSCMaturedPacketData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ValsetUpdateId |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthWire
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWire
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddresses = append(m.ValidatorAddresses, make([]byte, postIndex-iNdEx))
+			copy(m.ValidatorAddresses[len(m.ValidatorAddresses)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipWire(dAtA[iNdEx:])
@@ -1395,7 +2412,7 @@ func (m *VSCMaturedPacketData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SlashPacketData) Unmarshal(dAtA []byte) error {
+func (m *ConsumerDoubleVotePacketData) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1418,15 +2435,15 @@ func (m *SlashPacketData) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SlashPacketData: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConsumerDoubleVotePacketData: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SlashPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConsumerDoubleVotePacketData: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DuplicateVoteEvidence", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1453,15 +2470,18 @@ func (m *SlashPacketData) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Validator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.DuplicateVoteEvidence == nil {
+				m.DuplicateVoteEvidence = &types2.DuplicateVoteEvidence{}
+			}
+			if err := m.DuplicateVoteEvidence.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValsetUpdateId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorPubKey", wireType)
 			}
-			m.ValsetUpdateId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowWire
@@ -1471,30 +2491,28 @@ func (m *SlashPacketData) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ValsetUpdateId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Infraction", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthWire
 			}
-			m.Infraction = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowWire
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Infraction |= types1.Infraction(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWire
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			if m.ValidatorPubKey == nil {
+				m.ValidatorPubKey = &crypto.PublicKey{}
+			}
+			if err := m.ValidatorPubKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipWire(dAtA[iNdEx:])
@@ -1634,6 +2652,146 @@ func (m *ConsumerPacketData) Unmarshal(dAtA []byte) error {
 			}
 			m.Data = &ConsumerPacketData_VscMaturedPacketData{v}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerShutdownPacketData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWire
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWire
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ConsumerShutdownPacketData{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Data = &ConsumerPacketData_ConsumerShutdownPacketData{v}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerMisbehaviourPacketData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWire
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWire
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ConsumerMisbehaviourPacketData{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Data = &ConsumerPacketData_ConsumerMisbehaviourPacketData{v}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerHeartbeatPacketData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWire
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWire
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ConsumerHeartbeatPacketData{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Data = &ConsumerPacketData_ConsumerHeartbeatPacketData{v}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsumerDoubleVotePacketData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthWire
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthWire
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ConsumerDoubleVotePacketData{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Data = &ConsumerPacketData_ConsumerDoubleVotePacketData{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipWire(dAtA[iNdEx:])
@@ -1748,6 +2906,38 @@ func (m *HandshakeMetadata) Unmarshal(dAtA []byte) error {
 			}
 			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowWire
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthWire
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthWire
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipWire(dAtA[iNdEx:])
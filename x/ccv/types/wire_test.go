@@ -3,7 +3,9 @@ package types_test
 import (
 	"strings"
 	"testing"
+	"time"
 
+	ibctmtypes "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
 	"github.com/stretchr/testify/require"
 
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
@@ -11,6 +13,8 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+	cryptoenc "github.com/cometbft/cometbft/crypto/encoding"
+	cmttypes "github.com/cometbft/cometbft/types"
 
 	"github.com/cosmos/interchain-security/v7/testutil/crypto"
 	"github.com/cosmos/interchain-security/v7/x/ccv/types"
@@ -28,28 +32,28 @@ func TestPacketDataValidateBasic(t *testing.T) {
 		{
 			"invalid: zero ValsetUpdateId",
 			true,
-			types.NewValidatorSetChangePacketData([]abci.ValidatorUpdate{}, 0, nil),
+			types.NewValidatorSetChangePacketData(types.NewValsetDiff(), 0, nil),
 		},
 		{
 			"invalid: nil ValidatorUpdates",
 			true,
-			types.NewValidatorSetChangePacketData(nil, 1, nil),
+			types.ValidatorSetChangePacketData{ValsetUpdateId: 1},
 		},
 		{
 			"valid: empty ValidatorUpdates",
 			false,
-			types.NewValidatorSetChangePacketData([]abci.ValidatorUpdate{}, 2, nil),
+			types.NewValidatorSetChangePacketData(types.NewValsetDiff(), 2, nil),
 		},
 		{
 			"valid: one validator update",
 			false,
 			types.NewValidatorSetChangePacketData(
-				[]abci.ValidatorUpdate{
+				types.NewValsetDiff([]abci.ValidatorUpdate{
 					{
 						PubKey: pk,
 						Power:  30,
 					},
-				},
+				}...),
 				3,
 				nil,
 			),
@@ -73,7 +77,7 @@ func TestMarshalPacketData(t *testing.T) {
 	require.NoError(t, err)
 
 	vpd := types.NewValidatorSetChangePacketData(
-		[]abci.ValidatorUpdate{
+		types.NewValsetDiff([]abci.ValidatorUpdate{
 			{
 				PubKey: pk1,
 				Power:  30,
@@ -82,7 +86,7 @@ func TestMarshalPacketData(t *testing.T) {
 				PubKey: pk2,
 				Power:  20,
 			},
-		},
+		}...),
 		1,
 		nil,
 	)
@@ -103,7 +107,7 @@ func TestVSCPacketDataWireBytes(t *testing.T) {
 	cId2 := crypto.NewCryptoIdentityFromIntSeed(4732895)
 
 	pd := types.NewValidatorSetChangePacketData(
-		[]abci.ValidatorUpdate{
+		types.NewValsetDiff([]abci.ValidatorUpdate{
 			{
 				PubKey: cId1.TMProtoCryptoPublicKey(),
 				Power:  30,
@@ -112,7 +116,7 @@ func TestVSCPacketDataWireBytes(t *testing.T) {
 				PubKey: cId2.TMProtoCryptoPublicKey(),
 				Power:  20,
 			},
-		},
+		}...),
 		73,
 		[]string{"slash", "acks", "example"},
 	)
@@ -224,6 +228,36 @@ func TestVSCMaturedPacketDataWireBytes(t *testing.T) {
 	require.Equal(t, expectedStr, str)
 }
 
+// TestConsumerShutdownPacketDataWireBytes is a regression test that the JSON schema
+// for ConsumerShutdownPacketData (sent over the wire) does not change.
+func TestConsumerShutdownPacketDataWireBytes(t *testing.T) {
+	// Construct consumer packet data wrapping consumer shutdown packet data
+	cpd := types.ConsumerPacketData{
+		Type: types.ConsumerShutdownPacket,
+		Data: &types.ConsumerPacketData_ConsumerShutdownPacketData{
+			ConsumerShutdownPacketData: types.NewConsumerShutdownPacketData(84923),
+		},
+	}
+
+	jsonBz := cpd.GetBytes()
+	str := string(jsonBz)
+
+	// Expected string formatted for human readability
+	expectedStr := `{
+		"type": "CONSUMER_PACKET_TYPE_SHUTDOWN",
+		"consumerShutdownPacketData": {
+			"valset_update_id": "84923"
+		}
+	}`
+
+	// Remove newlines, tabs, and spaces for comparison
+	expectedStr = strings.ReplaceAll(expectedStr, "\n", "")
+	expectedStr = strings.ReplaceAll(expectedStr, "\t", "")
+	expectedStr = strings.ReplaceAll(expectedStr, " ", "")
+
+	require.Equal(t, expectedStr, str)
+}
+
 func TestCreateTransferMemo(t *testing.T) {
 	consumerId := "13"
 	chainId := "chain-13"
@@ -237,3 +271,136 @@ func TestCreateTransferMemo(t *testing.T) {
 	require.Equal(t, chainId, rewardMemo.ChainId)
 	require.Equal(t, "ICS rewards", rewardMemo.Memo)
 }
+
+func TestUnmarshalConsumerPacketData(t *testing.T) {
+	slashPacketData := types.NewSlashPacketData(
+		abci.Validator{Address: ed25519.GenPrivKey().PubKey().Address(), Power: 1},
+		789,
+		stakingtypes.Infraction_INFRACTION_DOWNTIME,
+	)
+	slashPacket := types.ConsumerPacketData{
+		Type: types.SlashPacket,
+		Data: &types.ConsumerPacketData_SlashPacketData{
+			SlashPacketData: slashPacketData,
+		},
+	}
+	vscMaturedPacket := types.ConsumerPacketData{
+		Type: types.VscMaturedPacket,
+		Data: &types.ConsumerPacketData_VscMaturedPacketData{
+			VscMaturedPacketData: types.NewVSCMaturedPacketData(420),
+		},
+	}
+	shutdownPacket := types.ConsumerPacketData{
+		Type: types.ConsumerShutdownPacket,
+		Data: &types.ConsumerPacketData_ConsumerShutdownPacketData{
+			ConsumerShutdownPacketData: types.NewConsumerShutdownPacketData(421),
+		},
+	}
+	misbehaviourPacket := types.ConsumerPacketData{
+		Type: types.ConsumerMisbehaviourPacket,
+		Data: &types.ConsumerPacketData_ConsumerMisbehaviourPacketData{
+			ConsumerMisbehaviourPacketData: types.NewConsumerMisbehaviourPacketData(
+				&ibctmtypes.Misbehaviour{ClientId: "07-tendermint-0"},
+			),
+		},
+	}
+	heartbeatPacket := types.ConsumerPacketData{
+		Type: types.ConsumerHeartbeatPacket,
+		Data: &types.ConsumerPacketData_ConsumerHeartbeatPacketData{
+			ConsumerHeartbeatPacketData: types.NewConsumerHeartbeatPacketData(
+				[][]byte{ed25519.GenPrivKey().PubKey().Address()},
+			),
+		},
+	}
+
+	privVal := cmttypes.NewMockPV()
+	pubKey, err := privVal.GetPubKey()
+	require.NoError(t, err)
+	tmPubKey, err := cryptoenc.PubKeyToProto(pubKey)
+	require.NoError(t, err)
+	valSet := cmttypes.NewValidatorSet([]*cmttypes.Validator{cmttypes.NewValidator(pubKey, 10)})
+	doubleVotePacket := types.ConsumerPacketData{
+		Type: types.ConsumerDoubleVotePacket,
+		Data: &types.ConsumerPacketData_ConsumerDoubleVotePacketData{
+			ConsumerDoubleVotePacketData: types.NewConsumerDoubleVotePacketData(
+				crypto.MakeDuplicateVoteEvidence(5, time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC), valSet, privVal, "consumer-1").ToProto(),
+				&tmPubKey,
+			),
+		},
+	}
+
+	testCases := []struct {
+		name     string
+		data     []byte
+		expected types.ConsumerPacketData
+		expError bool
+	}{
+		{
+			name:     "v1 slash packet",
+			data:     slashPacket.ToV1Bytes(),
+			expected: slashPacket,
+		},
+		{
+			name:     "current slash packet",
+			data:     types.ModuleCdc.MustMarshalJSON(&slashPacket),
+			expected: slashPacket,
+		},
+		{
+			name:     "vsc matured packet",
+			data:     vscMaturedPacket.GetBytes(),
+			expected: vscMaturedPacket,
+		},
+		{
+			name:     "v1 vsc matured packet",
+			data:     []byte(`{"type":"CONSUMER_PACKET_TYPE_VSCM","slashPacketData":{"validator":{"address":null,"power":"0"},"valset_update_id":"1","infraction":"INFRACTION_TYPE_DOWNTIME"}}`),
+			expError: true,
+		},
+		{
+			name:     "consumer shutdown packet",
+			data:     shutdownPacket.GetBytes(),
+			expected: shutdownPacket,
+		},
+		{
+			name:     "v1 consumer shutdown packet",
+			data:     []byte(`{"type":"CONSUMER_PACKET_TYPE_SHUTDOWN","slashPacketData":{"validator":{"address":null,"power":"0"},"valset_update_id":"1","infraction":"INFRACTION_TYPE_DOWNTIME"}}`),
+			expError: true,
+		},
+		{
+			name:     "consumer misbehaviour packet",
+			data:     misbehaviourPacket.GetBytes(),
+			expected: misbehaviourPacket,
+		},
+		{
+			name:     "consumer heartbeat packet",
+			data:     heartbeatPacket.GetBytes(),
+			expected: heartbeatPacket,
+		},
+		{
+			name:     "consumer double vote packet",
+			data:     doubleVotePacket.GetBytes(),
+			expected: doubleVotePacket,
+		},
+		{
+			name:     "invalid JSON",
+			data:     []byte("invalid"),
+			expError: true,
+		},
+		{
+			name:     "empty data",
+			data:     []byte{},
+			expError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := types.UnmarshalConsumerPacketData(tc.data)
+			if tc.expError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, actual)
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package upgrades
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	consumerkeeper "github.com/cosmos/interchain-security/v7/x/ccv/consumer/keeper"
+	ccvtypes "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// ConsumerMigrations returns the ICS migrations to run, in order, in the upgrade handler
+// of a consumer chain upgrading from `from` to this version of ICS.
+//   - `consumerId` is the id of the consumer chain on the provider chain, e.g., as returned
+//     by the QueryConsumerIdFromClientId query, and is used when upgrading from a version before v6.2.x
+func ConsumerMigrations(from Version, consumerKeeper consumerkeeper.Keeper, consumerId string) ([]Migration, error) {
+	if err := from.Validate(); err != nil {
+		return nil, err
+	}
+
+	migrations := []Migration{}
+	if from.Before(V6_2) {
+		migrations = append(migrations, Migration{
+			Name: "InitializeConsumerId",
+			Run: func(ctx sdk.Context) error {
+				return InitializeConsumerId(ctx, consumerKeeper, consumerId)
+			},
+		})
+	}
+
+	return migrations, nil
+}
+
+// InitializeConsumerId sets the ConsumerId param of the consumer module.
+// It returns an error if the param is already set to a different consumer id.
+func InitializeConsumerId(ctx sdk.Context, consumerKeeper consumerkeeper.Keeper, consumerId string) error {
+	if err := ccvtypes.ValidateConsumerId(consumerId); err != nil {
+		return err
+	}
+
+	params := consumerKeeper.GetConsumerParams(ctx)
+	switch params.ConsumerId {
+	case consumerId:
+		return nil
+	case "":
+		params.ConsumerId = consumerId
+		consumerKeeper.SetParams(ctx, params)
+		return nil
+	default:
+		return fmt.Errorf("consumer id is already set to %s", params.ConsumerId)
+	}
+}
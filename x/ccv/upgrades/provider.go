@@ -0,0 +1,119 @@
+package upgrades
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	providerkeeper "github.com/cosmos/interchain-security/v7/x/ccv/provider/keeper"
+	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+	ccvtypes "github.com/cosmos/interchain-security/v7/x/ccv/types"
+)
+
+// ProviderMigrations returns the ICS migrations to run, in order, in the upgrade handler
+// of a provider chain upgrading from `from` to this version of ICS.
+//   - `maxProviderConsensusValidators` is the initial value of the MaxProviderConsensusValidators param
+//     when upgrading from a version before v6.0.x
+func ProviderMigrations(
+	from Version,
+	providerKeeper providerkeeper.Keeper,
+	slashingKeeper ccvtypes.SlashingKeeper,
+	maxProviderConsensusValidators int64,
+) ([]Migration, error) {
+	if err := from.Validate(); err != nil {
+		return nil, err
+	}
+	if from == V5_0 {
+		return nil, fmt.Errorf("provider chains cannot upgrade from %s, it is a consumer only release", from)
+	}
+
+	migrations := []Migration{}
+	if from.Before(V6_0) {
+		migrations = append(migrations,
+			Migration{
+				Name: "InitializeMaxProviderConsensusParam",
+				Run: func(ctx sdk.Context) error {
+					return InitializeMaxProviderConsensusParam(ctx, providerKeeper, maxProviderConsensusValidators)
+				},
+			},
+			Migration{
+				Name: "InitializeLastProviderConsensusValidatorSet",
+				Run: func(ctx sdk.Context) error {
+					return InitializeLastProviderConsensusValidatorSet(ctx, providerKeeper)
+				},
+			},
+		)
+	}
+	if from.Before(V6_4) {
+		migrations = append(migrations, Migration{
+			Name: "SetConsumerInfractionParams",
+			Run: func(ctx sdk.Context) error {
+				return SetConsumerInfractionParams(ctx, providerKeeper, slashingKeeper)
+			},
+		})
+	}
+
+	return migrations, nil
+}
+
+// InitializeMaxProviderConsensusParam initializes the MaxProviderConsensusValidators param.
+// The param is not changed if it is already set.
+func InitializeMaxProviderConsensusParam(ctx sdk.Context, providerKeeper providerkeeper.Keeper, maxProviderConsensusValidators int64) error {
+	if maxProviderConsensusValidators <= 0 {
+		return fmt.Errorf("invalid MaxProviderConsensusValidators: %d", maxProviderConsensusValidators)
+	}
+
+	params := providerKeeper.GetParams(ctx)
+	if params.MaxProviderConsensusValidators != 0 {
+		return nil
+	}
+	params.MaxProviderConsensusValidators = maxProviderConsensusValidators
+	providerKeeper.SetParams(ctx, params)
+	return nil
+}
+
+// InitializeLastProviderConsensusValidatorSet initializes the last provider consensus validator set
+// to the first MaxProviderConsensusValidators validators of the staking module.
+// The validator set is not changed if it is already set.
+func InitializeLastProviderConsensusValidatorSet(ctx sdk.Context, providerKeeper providerkeeper.Keeper) error {
+	lastValidators, err := providerKeeper.GetLastProviderConsensusValSet(ctx)
+	if err != nil {
+		return err
+	}
+	if len(lastValidators) != 0 {
+		return nil
+	}
+
+	vals, err := providerKeeper.GetLastProviderConsensusActiveValidators(ctx)
+	if err != nil {
+		return err
+	}
+	for _, val := range vals {
+		consensusVal, err := providerKeeper.CreateProviderConsensusValidator(ctx, val)
+		if err != nil {
+			return err
+		}
+		lastValidators = append(lastValidators, consensusVal)
+	}
+	return providerKeeper.SetLastProviderConsensusValSet(ctx, lastValidators)
+}
+
+// SetConsumerInfractionParams sets the default infraction parameters for the active consumer chains.
+// The infraction parameters of a consumer chain are not changed if they are already set.
+func SetConsumerInfractionParams(ctx sdk.Context, providerKeeper providerkeeper.Keeper, slashingKeeper ccvtypes.SlashingKeeper) error {
+	infractionParameters, err := providertypes.DefaultConsumerInfractionParameters(ctx, slashingKeeper)
+	if err != nil {
+		return err
+	}
+
+	for _, consumerId := range providerKeeper.GetAllActiveConsumerIds(ctx) {
+		if _, err := providerKeeper.GetInfractionParameters(ctx, consumerId); err == nil {
+			continue
+		}
+		if err := providerKeeper.SetInfractionParameters(ctx, consumerId, infractionParameters); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
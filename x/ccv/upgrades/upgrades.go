@@ -0,0 +1,90 @@
+// Package upgrades provides helpers for the upgrade handlers of chains using ICS.
+//
+// The in-place store migrations of the CCV modules are registered by the modules
+// themselves and run by the module manager. In addition, some ICS releases require
+// migrations that depend on the chain, e.g., initializing new parameters. Instead of
+// copying this code from other chains, upgrade handlers can use the migrations returned
+// by ProviderMigrations and ConsumerMigrations, e.g.,
+//
+//	func(ctx context.Context, _ upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+//		migrations, err := upgrades.ProviderMigrations(upgrades.V6_3, app.ProviderKeeper, app.SlashingKeeper, 180)
+//		if err != nil {
+//			return nil, err
+//		}
+//		return upgrades.RunMigrations(ctx, app.MM, app.Configurator(), fromVM, migrations...)
+//	}
+//
+// All the migrations are idempotent, i.e., they do not override the state initialized
+// by a previous run of the migration.
+package upgrades
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+// Version is an ICS release line a chain is upgraded from
+type Version string
+
+const (
+	V5_0 Version = "v5.0.x"
+	V5_1 Version = "v5.1.x"
+	V5_2 Version = "v5.2.x"
+	V6_0 Version = "v6.0.x"
+	V6_1 Version = "v6.1.x"
+	V6_2 Version = "v6.2.x"
+	V6_3 Version = "v6.3.x"
+	V6_4 Version = "v6.4.x"
+	V7_0 Version = "v7.0.x"
+)
+
+// versions are the supported release lines, in release order
+var versions = []Version{V5_0, V5_1, V5_2, V6_0, V6_1, V6_2, V6_3, V6_4, V7_0}
+
+// Validate returns an error if the version is not a supported release line
+func (v Version) Validate() error {
+	if !slices.Contains(versions, v) {
+		return fmt.Errorf("unsupported ICS version %q, supported versions are %v", v, versions)
+	}
+	return nil
+}
+
+// Before returns true if the version was released before `other`
+func (v Version) Before(other Version) bool {
+	return slices.Index(versions, v) < slices.Index(versions, other)
+}
+
+// Migration is a named ICS migration to run in an upgrade handler
+type Migration struct {
+	Name string
+	Run  func(ctx sdk.Context) error
+}
+
+// RunMigrations runs the in-place store migrations of all the modules, which include the
+// migrations of the CCV modules, and then the given ICS migrations, in order
+func RunMigrations(
+	ctx context.Context,
+	mm *module.Manager,
+	configurator module.Configurator,
+	fromVM module.VersionMap,
+	migrations ...Migration,
+) (module.VersionMap, error) {
+	vm, err := mm.RunMigrations(ctx, configurator, fromVM)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	for _, migration := range migrations {
+		sdkCtx.Logger().Info("running ICS migration", "name", migration.Name)
+		if err := migration.Run(sdkCtx); err != nil {
+			return nil, fmt.Errorf("running ICS migration %s: %w", migration.Name, err)
+		}
+	}
+
+	return vm, nil
+}
@@ -0,0 +1,146 @@
+package upgrades_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	testkeeper "github.com/cosmos/interchain-security/v7/testutil/keeper"
+	providertypes "github.com/cosmos/interchain-security/v7/x/ccv/provider/types"
+	"github.com/cosmos/interchain-security/v7/x/ccv/upgrades"
+)
+
+func migrationNames(migrations []upgrades.Migration) []string {
+	names := []string{}
+	for _, migration := range migrations {
+		names = append(names, migration.Name)
+	}
+	return names
+}
+
+func TestProviderMigrations(t *testing.T) {
+	providerKeeper, _, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	testCases := []struct {
+		from     upgrades.Version
+		expected []string
+		expError bool
+	}{
+		{from: "v4.4.x", expError: true},
+		{from: upgrades.V5_0, expError: true},
+		{
+			from:     upgrades.V5_1,
+			expected: []string{"InitializeMaxProviderConsensusParam", "InitializeLastProviderConsensusValidatorSet", "SetConsumerInfractionParams"},
+		},
+		{from: upgrades.V6_0, expected: []string{"SetConsumerInfractionParams"}},
+		{from: upgrades.V6_3, expected: []string{"SetConsumerInfractionParams"}},
+		{from: upgrades.V6_4, expected: []string{}},
+		{from: upgrades.V7_0, expected: []string{}},
+	}
+
+	for _, tc := range testCases {
+		migrations, err := upgrades.ProviderMigrations(tc.from, providerKeeper, mocks.MockSlashingKeeper, 180)
+		if tc.expError {
+			require.Error(t, err, tc.from)
+			continue
+		}
+		require.NoError(t, err, tc.from)
+		require.Equal(t, tc.expected, migrationNames(migrations), tc.from)
+	}
+}
+
+func TestConsumerMigrations(t *testing.T) {
+	consumerKeeper, _, ctrl, _ := testkeeper.GetConsumerKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	migrations, err := upgrades.ConsumerMigrations(upgrades.V6_1, consumerKeeper, "3")
+	require.NoError(t, err)
+	require.Equal(t, []string{"InitializeConsumerId"}, migrationNames(migrations))
+
+	migrations, err = upgrades.ConsumerMigrations(upgrades.V6_2, consumerKeeper, "3")
+	require.NoError(t, err)
+	require.Empty(t, migrations)
+
+	_, err = upgrades.ConsumerMigrations("v8.0.x", consumerKeeper, "3")
+	require.Error(t, err)
+}
+
+func TestInitializeMaxProviderConsensusParam(t *testing.T) {
+	providerKeeper, ctx, ctrl, _ := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	params := providerKeeper.GetParams(ctx)
+	params.MaxProviderConsensusValidators = 0
+	providerKeeper.SetParams(ctx, params)
+
+	require.Error(t, upgrades.InitializeMaxProviderConsensusParam(ctx, providerKeeper, 0))
+
+	require.NoError(t, upgrades.InitializeMaxProviderConsensusParam(ctx, providerKeeper, 180))
+	require.Equal(t, int64(180), providerKeeper.GetParams(ctx).MaxProviderConsensusValidators)
+
+	// the param is not changed once set
+	require.NoError(t, upgrades.InitializeMaxProviderConsensusParam(ctx, providerKeeper, 200))
+	require.Equal(t, int64(180), providerKeeper.GetParams(ctx).MaxProviderConsensusValidators)
+}
+
+func TestSetConsumerInfractionParams(t *testing.T) {
+	providerKeeper, ctx, ctrl, mocks := testkeeper.GetProviderKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	mocks.MockSlashingKeeper.EXPECT().DowntimeJailDuration(ctx).Return(time.Second*600, nil).AnyTimes()
+	mocks.MockSlashingKeeper.EXPECT().SlashFractionDoubleSign(ctx).Return(math.LegacyNewDecWithPrec(5, 2), nil).AnyTimes()
+
+	consumerIds := []string{}
+	for i := 0; i < 3; i++ {
+		consumerId := providerKeeper.FetchAndIncrementConsumerId(ctx)
+		providerKeeper.SetConsumerPhase(ctx, consumerId, providertypes.CONSUMER_PHASE_LAUNCHED)
+		consumerIds = append(consumerIds, consumerId)
+	}
+
+	// the first consumer chain already has custom infraction parameters
+	customParams, err := providertypes.DefaultConsumerInfractionParameters(ctx, mocks.MockSlashingKeeper)
+	require.NoError(t, err)
+	customParams.Downtime.JailDuration = time.Hour
+	require.NoError(t, providerKeeper.SetInfractionParameters(ctx, consumerIds[0], customParams))
+
+	require.NoError(t, upgrades.SetConsumerInfractionParams(ctx, providerKeeper, mocks.MockSlashingKeeper))
+	// running the migration again is a no-op
+	require.NoError(t, upgrades.SetConsumerInfractionParams(ctx, providerKeeper, mocks.MockSlashingKeeper))
+
+	defaultParams, err := providertypes.DefaultConsumerInfractionParameters(ctx, mocks.MockSlashingKeeper)
+	require.NoError(t, err)
+	for i, consumerId := range consumerIds {
+		params, err := providerKeeper.GetInfractionParameters(ctx, consumerId)
+		require.NoError(t, err)
+		if i == 0 {
+			require.Equal(t, customParams, params)
+		} else {
+			require.Equal(t, defaultParams, params)
+		}
+	}
+}
+
+func TestInitializeConsumerId(t *testing.T) {
+	consumerKeeper, ctx, ctrl, _ := testkeeper.GetConsumerKeeperAndCtx(t, testkeeper.NewInMemKeeperParams(t))
+	defer ctrl.Finish()
+
+	params := consumerKeeper.GetConsumerParams(ctx)
+	params.ConsumerId = ""
+	consumerKeeper.SetParams(ctx, params)
+
+	require.Error(t, upgrades.InitializeConsumerId(ctx, consumerKeeper, "invalid"))
+
+	require.NoError(t, upgrades.InitializeConsumerId(ctx, consumerKeeper, "3"))
+	require.Equal(t, "3", consumerKeeper.GetConsumerParams(ctx).ConsumerId)
+
+	// running the migration again is a no-op
+	require.NoError(t, upgrades.InitializeConsumerId(ctx, consumerKeeper, "3"))
+	require.Equal(t, "3", consumerKeeper.GetConsumerParams(ctx).ConsumerId)
+
+	// the consumer id cannot be changed
+	require.Error(t, upgrades.InitializeConsumerId(ctx, consumerKeeper, "4"))
+}